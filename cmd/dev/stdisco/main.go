@@ -51,8 +51,8 @@ func main() {
 
 	ctx := context.Background()
 
-	runbeacon(ctx, beacon.NewMulticast(mc), fake)
-	runbeacon(ctx, beacon.NewBroadcast(bc), fake)
+	runbeacon(ctx, beacon.NewMulticast(mc, nil), fake)
+	runbeacon(ctx, beacon.NewBroadcast(bc, nil), fake)
 
 	select {}
 }
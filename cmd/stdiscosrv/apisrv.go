@@ -28,14 +28,17 @@ import (
 	"sync"
 	"time"
 
+	"github.com/puzpuzpuz/xsync/v3"
+	"github.com/syncthing/syncthing/lib/discover"
 	"github.com/syncthing/syncthing/lib/protocol"
 	"github.com/syncthing/syncthing/lib/stringutil"
 )
 
 // announcement is the format received from and sent to clients
 type announcement struct {
-	Seen      time.Time `json:"seen"`
-	Addresses []string  `json:"addresses"`
+	Seen      time.Time                    `json:"seen"`
+	Addresses []string                     `json:"addresses"`
+	Signed    *discover.SignedAnnouncement `json:"signed,omitempty"`
 }
 
 type apiSrv struct {
@@ -49,6 +52,16 @@ type apiSrv struct {
 	gzipWriters    sync.Pool
 	seenTracker    *retryAfterTracker
 	notSeenTracker *retryAfterTracker
+
+	// The latest signed announcement per device, passed on to lookups so
+	// that clients can verify the addresses. Kept in memory only; devices
+	// reannounce often enough for it to be filled again after a restart.
+	signed *xsync.MapOf[protocol.DeviceID, signedRecord]
+}
+
+type signedRecord struct {
+	signed  *discover.SignedAnnouncement
+	payload discover.SignedPayload // verified
 }
 
 type replicator interface {
@@ -85,6 +98,7 @@ func newAPISrv(addr string, cert tls.Certificate, db database, repl replicator,
 			desiredRate:  250,
 			currentDelay: notFoundRetryUnknownMaxSeconds / 2,
 		},
+		signed: xsync.NewMapOf[protocol.DeviceID, signedRecord](),
 	}
 }
 
@@ -248,6 +262,7 @@ func (s *apiSrv) handleGET(w http.ResponseWriter, req *http.Request) {
 	json.NewEncoder(bw).Encode(announcement{
 		Seen:      time.Unix(0, rec.Seen).Truncate(time.Second),
 		Addresses: addressStrs(rec.Addresses),
+		Signed:    s.signedAnnouncement(deviceID),
 	})
 }
 
@@ -278,6 +293,24 @@ func (s *apiSrv) handlePOST(remoteAddr *net.TCPAddr, w http.ResponseWriter, req
 
 	deviceID := protocol.NewDeviceID(rawCert)
 
+	var payload discover.SignedPayload
+	if ann.Signed != nil {
+		// Only keep signatures that lookups will accept.
+		payload, err = ann.Signed.Verify(deviceID)
+		if err == nil {
+			err = payload.CheckTimestamp(time.Time{}, time.Now())
+		}
+		if err != nil {
+			if debug {
+				log.Println(reqID, "signature:", err)
+			}
+			announceRequestsTotal.WithLabelValues("bad_signature").Inc()
+			w.Header().Set("Retry-After", errorRetryAfterString())
+			http.Error(w, "Bad Request", http.StatusBadRequest)
+			return
+		}
+	}
+
 	addresses := fixupAddresses(remoteAddr, ann.Addresses)
 	if len(addresses) == 0 {
 		announceRequestsTotal.WithLabelValues("bad_request").Inc()
@@ -293,6 +326,12 @@ func (s *apiSrv) handlePOST(remoteAddr *net.TCPAddr, w http.ResponseWriter, req
 		return
 	}
 
+	if ann.Signed != nil {
+		s.signed.Store(deviceID, signedRecord{signed: ann.Signed, payload: payload})
+	} else {
+		s.signed.Delete(deviceID)
+	}
+
 	announceRequestsTotal.WithLabelValues("success").Inc()
 
 	w.Header().Set("Reannounce-After", reannounceAfterString())
@@ -325,6 +364,20 @@ func (s *apiSrv) handleAnnounce(deviceID protocol.DeviceID, addresses []string)
 	return s.db.merge(&deviceID, dbAddrs, seen)
 }
 
+// signedAnnouncement returns the latest signed announcement of the device,
+// or nil if there is none that clients would still accept.
+func (s *apiSrv) signedAnnouncement(deviceID protocol.DeviceID) *discover.SignedAnnouncement {
+	rec, ok := s.signed.Load(deviceID)
+	if !ok {
+		return nil
+	}
+	if err := rec.payload.CheckTimestamp(time.Time{}, time.Now()); err != nil {
+		s.signed.Delete(deviceID)
+		return nil
+	}
+	return rec.signed
+}
+
 func handlePing(w http.ResponseWriter, _ *http.Request) {
 	w.WriteHeader(204)
 }
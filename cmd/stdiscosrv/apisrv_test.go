@@ -7,8 +7,10 @@
 package main
 
 import (
+	"bytes"
 	"context"
 	"crypto/tls"
+	"encoding/json"
 	"fmt"
 	"io"
 	"net"
@@ -18,7 +20,9 @@ import (
 	"regexp"
 	"strings"
 	"testing"
+	"time"
 
+	"github.com/syncthing/syncthing/lib/discover"
 	"github.com/syncthing/syncthing/lib/protocol"
 	"github.com/syncthing/syncthing/lib/tlsutil"
 )
@@ -99,6 +103,88 @@ func TestFixupAddresses(t *testing.T) {
 	}
 }
 
+func TestAPISignedAnnouncement(t *testing.T) {
+	db := newInMemoryStore(t.TempDir(), 0, nil)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go db.Serve(ctx)
+	api := newAPISrv("127.0.0.1:0", tls.Certificate{}, db, nil, true, true)
+	srv := httptest.NewServer(http.HandlerFunc(api.handler))
+	defer srv.Close()
+
+	kf := t.TempDir() + "/cert"
+	crt, err := tlsutil.NewCertificate(kf+".crt", kf+".key", "localhost", 7)
+	if err != nil {
+		t.Fatal(err)
+	}
+	certBs, err := os.ReadFile(kf + ".crt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	certBs = regexp.MustCompile(`---[^\n]+---\n`).ReplaceAll(certBs, nil)
+	certString := strings.ReplaceAll(string(certBs), "\n", " ")
+	devID := protocol.NewDeviceID(crt.Certificate[0])
+	url := srv.URL + "/v2/?device=" + devID.String()
+
+	announce := func(signed *discover.SignedAnnouncement) int {
+		t.Helper()
+		bs, _ := json.Marshal(announcement{Addresses: []string{"tcp://10.10.10.10:42000"}, Signed: signed})
+		req, _ := http.NewRequest(http.MethodPost, url, bytes.NewReader(bs))
+		req.Header.Set("X-Forwarded-Tls-Client-Cert", certString)
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+		return resp.StatusCode
+	}
+	lookup := func() announcement {
+		t.Helper()
+		resp, err := http.Get(url)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer resp.Body.Close()
+		var ann announcement
+		if err := json.NewDecoder(resp.Body).Decode(&ann); err != nil {
+			t.Fatal(err)
+		}
+		return ann
+	}
+
+	signed, err := discover.SignAnnouncement(crt, []string{"tcp://10.10.10.10:42000"}, time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if code := announce(signed); code != http.StatusNoContent {
+		t.Fatalf("unexpected status %d for signed announcement", code)
+	}
+	if ann := lookup(); ann.Signed == nil || !bytes.Equal(ann.Signed.Signature, signed.Signature) {
+		t.Error("expected the signature to be passed on")
+	}
+
+	// Signatures by other devices are refused
+	other, err := tlsutil.NewCertificateInMemory("syncthing", 7)
+	if err != nil {
+		t.Fatal(err)
+	}
+	forged, err := discover.SignAnnouncement(other, []string{"tcp://10.10.10.11:42000"}, time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if code := announce(forged); code != http.StatusBadRequest {
+		t.Errorf("unexpected status %d for announcement signed by another device", code)
+	}
+
+	// An unsigned announcement clears the signature
+	if code := announce(nil); code != http.StatusNoContent {
+		t.Fatalf("unexpected status %d for unsigned announcement", code)
+	}
+	if ann := lookup(); ann.Signed != nil {
+		t.Error("expected no signature after unsigned announcement")
+	}
+}
+
 func addr(host string, port int) *net.TCPAddr {
 	return &net.TCPAddr{
 		IP:   net.ParseIP(host),
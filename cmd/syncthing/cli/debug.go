@@ -38,8 +38,15 @@ func (p *profileCommand) Run(ctx Context) error {
 	}
 }
 
+type connectivityCommand struct{}
+
+func (*connectivityCommand) Run(ctx Context) error {
+	return indexDumpOutput("system/connectivity", ctx.clientFactory)
+}
+
 type debugCommand struct {
-	File    fileCommand    `cmd:"" help:"Show information about a file (or directory/symlink)"`
-	Profile profileCommand `cmd:"" help:"Save a profile to help figuring out what Syncthing does"`
-	Index   indexCommand   `cmd:"" help:"Show information about the index (database)"`
+	File         fileCommand         `cmd:"" help:"Show information about a file (or directory/symlink)"`
+	Profile      profileCommand      `cmd:"" help:"Save a profile to help figuring out what Syncthing does"`
+	Index        indexCommand        `cmd:"" help:"Show information about the index (database)"`
+	Connectivity connectivityCommand `cmd:"" help:"Check the listeners and contact the discovery, relay and STUN servers"`
 }
@@ -0,0 +1,84 @@
+// Copyright (C) 2026 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package cli
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+)
+
+type folderCommand struct {
+	Clean folderCleanCommand `cmd:"" help:"Remove stale temporary files, orphaned versions and old conflict copies"`
+}
+
+type folderCleanCommand struct {
+	FolderID    string        `arg:"" help:"Folder ID"`
+	DryRun      bool          `help:"Only show what would be removed"`
+	TempAge     time.Duration `default:"24h" help:"Remove temporary files older than this (0 to keep all)"`
+	VersionAge  time.Duration `default:"720h" help:"Remove versions of deleted files older than this (0 to keep all)"`
+	ConflictAge time.Duration `default:"720h" help:"Remove conflict copies older than this (0 to keep all)"`
+}
+
+func (f *folderCleanCommand) Run(ctx Context) error {
+	client, err := ctx.clientFactory.getClient()
+	if err != nil {
+		return err
+	}
+
+	query := make(url.Values)
+	query.Set("folder", f.FolderID)
+	query.Set("tempAge", f.TempAge.String())
+	query.Set("versionAge", f.VersionAge.String())
+	query.Set("conflictAge", f.ConflictAge.String())
+	if f.DryRun {
+		query.Set("dryrun", "true")
+	}
+
+	response, err := client.Post("db/clean?"+query.Encode(), "")
+	if errors.Is(err, errNotFound) {
+		return fmt.Errorf("folder %q not found", f.FolderID)
+	}
+	if err != nil {
+		return err
+	}
+	bs, err := responseToBArray(response)
+	if err != nil {
+		return err
+	}
+
+	var res struct {
+		DryRun    bool     `json:"dryRun"`
+		Temporary []string `json:"temporary"`
+		Versions  []string `json:"versions"`
+		Conflicts []string `json:"conflicts"`
+	}
+	if err := json.Unmarshal(bs, &res); err != nil {
+		return err
+	}
+
+	verb := "Removed"
+	if res.DryRun {
+		verb = "Would remove"
+	}
+	for _, kind := range []struct {
+		name  string
+		files []string
+	}{
+		{"temporary files", res.Temporary},
+		{"orphaned versions", res.Versions},
+		{"conflict copies", res.Conflicts},
+	} {
+		fmt.Printf("%s %d %s\n", verb, len(kind.files), kind.name)
+		for _, file := range kind.files {
+			fmt.Println("  " + file)
+		}
+	}
+	return nil
+}
@@ -11,15 +11,21 @@ import (
 )
 
 type indexCommand struct {
-	Dump     struct{} `cmd:"" help:"Print the entire db"`
+	Dump struct {
+		Folder string `help:"Stream the index of this folder from the running instance, instead of printing the entire db"`
+		Format string `help:"Output format when dumping a folder (jsonl, protobuf)" default:"jsonl" enum:"jsonl,protobuf"`
+	} `cmd:"" help:"Print the entire db, or the index of a folder"`
 	DumpSize struct{} `cmd:"" help:"Print the db size of different categories of information"`
 	Check    struct{} `cmd:"" help:"Check the database for inconsistencies"`
 	Account  struct{} `cmd:"" help:"Print key and value size statistics per key type"`
 }
 
-func (*indexCommand) Run(kongCtx *kong.Context) error {
+func (i *indexCommand) Run(ctx Context, kongCtx *kong.Context) error {
 	switch kongCtx.Selected().Name {
 	case "dump":
+		if i.Dump.Folder != "" {
+			return indexDumpFolder(i.Dump.Folder, i.Dump.Format, ctx.clientFactory)
+		}
 		return indexDump()
 	case "dump-size":
 		return indexDumpSize()
@@ -8,13 +8,39 @@ package cli
 
 import (
 	"encoding/binary"
+	"errors"
 	"fmt"
+	"io"
+	"net/url"
+	"os"
 	"time"
 
 	"github.com/syncthing/syncthing/lib/db"
 	"github.com/syncthing/syncthing/lib/protocol"
 )
 
+// indexDumpFolder streams the index of the folder from the running
+// instance to stdout, as JSONL or protobuf records.
+func indexDumpFolder(folder, format string, apiClientFactory *apiClientFactory) error {
+	client, err := apiClientFactory.getClient()
+	if err != nil {
+		return err
+	}
+	query := make(url.Values)
+	query.Set("folder", folder)
+	query.Set("format", format)
+	response, err := client.Get("db/index?" + query.Encode())
+	if errors.Is(err, errNotFound) {
+		return errors.New("not found (folder not in database)")
+	}
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+	_, err = io.Copy(os.Stdout, response.Body)
+	return err
+}
+
 func indexDump() error {
 	ldb, err := getDB()
 	if err != nil {
@@ -34,7 +60,10 @@ func indexDump() error {
 			fmt.Printf("[device] F:%d D:%d N:%q", folder, device, name)
 
 			var f protocol.FileInfo
-			err := f.Unmarshal(it.Value())
+			bs, err := db.UncompressValue(it.Value())
+			if err == nil {
+				err = f.Unmarshal(bs)
+			}
 			if err != nil {
 				return err
 			}
@@ -75,7 +75,10 @@ func indexCheck() (err error) {
 			name := nulString(key[1+4+4:])
 
 			var f protocol.FileInfo
-			err := f.Unmarshal(it.Value())
+			bs, err := db.UncompressValue(it.Value())
+			if err == nil {
+				err = f.Unmarshal(bs)
+			}
 			if err != nil {
 				fmt.Println("Unable to unmarshal FileInfo:", err)
 				success = false
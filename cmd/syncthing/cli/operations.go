@@ -10,7 +10,10 @@ import (
 	"bufio"
 	"errors"
 	"fmt"
+	"io"
+	"os"
 	"path/filepath"
+	"strconv"
 
 	"github.com/alecthomas/kong"
 	"github.com/syncthing/syncthing/lib/config"
@@ -25,12 +28,17 @@ type defaultIgnoresCommand struct {
 	Path string `arg:""`
 }
 
+type downgradeConfigCommand struct {
+	Version int `arg:"" help:"Config version of the older Syncthing"`
+}
+
 type operationCommand struct {
-	Restart        struct{}              `cmd:"" help:"Restart syncthing"`
-	Shutdown       struct{}              `cmd:"" help:"Shutdown syncthing"`
-	Upgrade        struct{}              `cmd:"" help:"Upgrade syncthing (if a newer version is available)"`
-	FolderOverride folderOverrideCommand `cmd:"" help:"Override changes on folder (remote for sendonly, local for receiveonly). WARNING: Destructive - deletes/changes your data"`
-	DefaultIgnores defaultIgnoresCommand `cmd:"" help:"Set the default ignores (config) from a file"`
+	Restart         struct{}               `cmd:"" help:"Restart syncthing"`
+	Shutdown        struct{}               `cmd:"" help:"Shutdown syncthing"`
+	Upgrade         struct{}               `cmd:"" help:"Upgrade syncthing (if a newer version is available)"`
+	FolderOverride  folderOverrideCommand  `cmd:"" help:"Override changes on folder (remote for sendonly, local for receiveonly). WARNING: Destructive - deletes/changes your data"`
+	DefaultIgnores  defaultIgnoresCommand  `cmd:"" help:"Set the default ignores (config) from a file"`
+	DowngradeConfig downgradeConfigCommand `cmd:"" help:"Print the config file downgraded for an older config version, to go back to an older Syncthing"`
 }
 
 func (*operationCommand) Run(ctx Context, kongCtx *kong.Context) error {
@@ -106,3 +114,17 @@ func (d *defaultIgnoresCommand) Run(ctx Context) error {
 	_, err = client.PutJSON("config/defaults/ignores", config.Ignores{Lines: lines})
 	return err
 }
+
+func (d *downgradeConfigCommand) Run(ctx Context) error {
+	client, err := ctx.clientFactory.getClient()
+	if err != nil {
+		return err
+	}
+	response, err := client.Get("config/downgrade?version=" + strconv.Itoa(d.Version))
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+	_, err = io.Copy(os.Stdout, response.Body)
+	return err
+}
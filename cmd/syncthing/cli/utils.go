@@ -133,7 +133,12 @@ func prettyPrintResponse(response *http.Response) error {
 	return prettyPrintJSON(data)
 }
 
+// getDB opens the SQLite database if there is one, as it then has taken
+// over from LevelDB, and the LevelDB database read only otherwise.
 func getDB() (backend.Backend, error) {
+	if sqlite := locations.Get(locations.SQLiteDB); exists(sqlite) {
+		return backend.OpenSQLite(sqlite, backend.TuningAuto)
+	}
 	return backend.OpenLevelDBRO(locations.Get(locations.Database))
 }
 
@@ -149,3 +154,8 @@ func nulString(bs []byte) string {
 func normalizePath(path string) string {
 	return filepath.ToSlash(filepath.Clean(path))
 }
+
+func exists(path string) bool {
+	_, err := os.Stat(path)
+	return err == nil
+}
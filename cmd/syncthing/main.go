@@ -157,6 +157,7 @@ type serveOptions struct {
 	NoUpgrade        bool   `env:"STNOUPGRADE" help:"Disable automatic upgrades"`
 	Paths            bool   `help:"Show configuration paths"`
 	Paused           bool   `help:"Start with all devices and folders paused"`
+	PersistentEvents bool   `env:"STPERSISTENTEVENTS" help:"Keep the events available from the REST API across restarts"`
 	Unpaused         bool   `help:"Start with all devices and folders unpaused"`
 	Upgrade          bool   `help:"Perform upgrade"`
 	UpgradeCheck     bool   `help:"Check for available upgrade"`
@@ -580,8 +581,7 @@ func syncthingMain(options serveOptions) {
 		})
 	}
 
-	dbFile := locations.Get(locations.Database)
-	ldb, err := syncthing.OpenDBBackend(dbFile, cfgWrapper.Options().DatabaseTuning)
+	ldb, err := syncthing.OpenConfiguredDBBackend(cfgWrapper.Options())
 	if err != nil {
 		l.Warnln("Error opening database:", err)
 		os.Exit(1)
@@ -621,6 +621,7 @@ func syncthingMain(options serveOptions) {
 		ProfilerAddr:         options.DebugProfilerListen,
 		ResetDeltaIdxs:       options.DebugResetDeltaIdxs,
 		Verbose:              options.Verbose,
+		PersistentEvents:     options.PersistentEvents,
 		DBRecheckInterval:    options.DebugDBRecheckInterval,
 		DBIndirectGCInterval: options.DebugDBIndirectGCInterval,
 	}
@@ -750,7 +751,16 @@ func auditWriter(auditFile string) io.Writer {
 }
 
 func resetDB() error {
-	return os.RemoveAll(locations.Get(locations.Database))
+	if err := os.RemoveAll(locations.Get(locations.Database)); err != nil {
+		return err
+	}
+	sqlite := locations.Get(locations.SQLiteDB)
+	for _, suffix := range []string{"", "-wal", "-shm"} {
+		if err := os.Remove(sqlite + suffix); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+	return nil
 }
 
 func autoUpgradePossible(options serveOptions) bool {
@@ -25,6 +25,10 @@ angular.module('syncthing.core')
                 // Get index.html again (likely cached) to retrieve the version header
                 $http.get('').success(setVersionFromHeader).error(setVersionFromHeader);
 
+                $http.get(authUrlbase + '/methods').success(function (methods) {
+                    $scope.login.methods = methods;
+                });
+
                 // Can't proceed yet - wait for the page reload after successful login.
                 return;
             }
@@ -42,6 +46,7 @@ angular.module('syncthing.core')
             username: '',
             password: '',
             errors: {},
+            methods: [],
         };
         $scope.completion = {};
         $scope.config = {};
@@ -98,6 +103,10 @@ angular.module('syncthing.core')
             simpleKeep: 5,
             staggeredMaxAge: 365,
             externalCommand: "",
+            pluginCommand: "",
+            resticRepository: "",
+            resticPasswordFile: "",
+            resticKeepLast: 0,
         };
 
         $scope.localStateTotal = {
@@ -460,7 +469,7 @@ angular.module('syncthing.core')
                 console.log("Dropping folder errors event for unknown folder", arg.data.folder)
                 return;
             }
-            $scope.model[arg.data.folder].errors = arg.data.errors.length;
+            $scope.model[arg.data.folder].errors = arg.data.count;
         });
 
         $scope.$on(Events.FOLDER_SCAN_PROGRESS, function (event, arg) {
@@ -579,7 +588,10 @@ angular.module('syncthing.core')
             // This function should match IsAuthEnabled() in guiconfiguration.go
             var guiCfg = $scope.config && $scope.config.gui;
             if (guiCfg) {
-                return guiCfg.authMode === 'ldap' || (guiCfg.user && guiCfg.password);
+                return guiCfg.authMode === 'ldap' || guiCfg.authMode === 'oidc' || (guiCfg.user && guiCfg.password) ||
+                    (guiCfg.users || []).some(function (user) {
+                        return user.name && user.password;
+                    });
             }
             return false;
         };
@@ -2346,7 +2358,7 @@ angular.module('syncthing.core')
             if (!$scope.currentFolder._guiVersioning) {
                 return false;
             }
-            return ['none', 'external'].indexOf($scope.currentFolder._guiVersioning.selector) === -1;
+            return ['none', 'external', 'plugin', 'restic'].indexOf($scope.currentFolder._guiVersioning.selector) === -1;
         };
 
         function initVersioningEditing() {
@@ -2376,6 +2388,14 @@ angular.module('syncthing.core')
             case "external":
                 $scope.currentFolder._guiVersioning.externalCommand = currentVersioning.params.command;
                 break;
+            case "plugin":
+                $scope.currentFolder._guiVersioning.pluginCommand = currentVersioning.params.command;
+                break;
+            case "restic":
+                $scope.currentFolder._guiVersioning.resticRepository = currentVersioning.params.repository;
+                $scope.currentFolder._guiVersioning.resticPasswordFile = currentVersioning.params.passwordFile || "";
+                $scope.currentFolder._guiVersioning.resticKeepLast = +(currentVersioning.params.keepLast || 0);
+                break;
             }
         };
 
@@ -2561,6 +2581,14 @@ angular.module('syncthing.core')
             case "external":
                 folderCfg.versioning.params.command = '' + folderCfg._guiVersioning.externalCommand;
                 break;
+            case "plugin":
+                folderCfg.versioning.params.command = '' + folderCfg._guiVersioning.pluginCommand;
+                break;
+            case "restic":
+                folderCfg.versioning.params.repository = '' + folderCfg._guiVersioning.resticRepository;
+                folderCfg.versioning.params.passwordFile = '' + folderCfg._guiVersioning.resticPasswordFile;
+                folderCfg.versioning.params.keepLast = '' + folderCfg._guiVersioning.resticKeepLast;
+                break;
             default:
                 folderCfg.versioning = {type: ''};
             }
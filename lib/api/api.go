@@ -16,6 +16,7 @@ import (
 	"fmt"
 	"io"
 	"log"
+	"maps"
 	"net"
 	"net/http"
 	"net/url"
@@ -24,6 +25,7 @@ import (
 	"reflect"
 	"runtime"
 	"runtime/pprof"
+	"slices"
 	"sort"
 	"strconv"
 	"strings"
@@ -52,6 +54,7 @@ import (
 	"github.com/syncthing/syncthing/lib/model"
 	"github.com/syncthing/syncthing/lib/protocol"
 	"github.com/syncthing/syncthing/lib/rand"
+	"github.com/syncthing/syncthing/lib/relay/client"
 	"github.com/syncthing/syncthing/lib/svcutil"
 	"github.com/syncthing/syncthing/lib/sync"
 	"github.com/syncthing/syncthing/lib/tlsutil"
@@ -137,7 +140,7 @@ func (s *service) WaitForStart() error {
 	return s.startupErr
 }
 
-func (s *service) getListener(guiCfg config.GUIConfiguration) (net.Listener, error) {
+func (s *service) getListener(guiCfg config.GUIConfiguration, certAuth *clientCertAuth) (net.Listener, *acmeCertificates, error) {
 	httpsCertFile := locations.Get(locations.HTTPSCertFile)
 	httpsKeyFile := locations.Get(locations.HTTPSKeyFile)
 	cert, err := tls.LoadX509KeyPair(httpsCertFile, httpsKeyFile)
@@ -166,10 +169,18 @@ func (s *service) getListener(guiCfg config.GUIConfiguration) (net.Listener, err
 		cert, err = tlsutil.NewCertificate(httpsCertFile, httpsKeyFile, name, httpsCertLifetimeDays)
 	}
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	tlsCfg := tlsutil.SecureDefaultWithTLS12()
 	tlsCfg.Certificates = []tls.Certificate{cert}
+	certAuth.configureTLS(tlsCfg, guiCfg.RequireClientCertificate)
+
+	// With ACME the self-signed certificate is only the fallback.
+	var acmeCerts *acmeCertificates
+	if guiCfg.ACME.Enabled {
+		acmeCerts = newACMECertificates(guiCfg.ACME, locations.Get(locations.HTTPSACMEDir), cert)
+		tlsCfg.GetCertificate = acmeCerts.getCertificate
+	}
 
 	if guiCfg.Network() == "unix" {
 		// When listening on a UNIX socket we should unlink before bind,
@@ -179,7 +190,7 @@ func (s *service) getListener(guiCfg config.GUIConfiguration) (net.Listener, err
 	}
 	rawListener, err := net.Listen(guiCfg.Network(), guiCfg.Address())
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	if guiCfg.Network() == "unix" && guiCfg.UnixSocketPermissions() != 0 {
@@ -187,7 +198,7 @@ func (s *service) getListener(guiCfg config.GUIConfiguration) (net.Listener, err
 		// required for operation.
 		err = os.Chmod(guiCfg.Address(), guiCfg.UnixSocketPermissions())
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 	}
 
@@ -195,7 +206,7 @@ func (s *service) getListener(guiCfg config.GUIConfiguration) (net.Listener, err
 		Listener:  rawListener,
 		TLSConfig: tlsCfg,
 	}
-	return listener, nil
+	return listener, acmeCerts, nil
 }
 
 func sendJSON(w http.ResponseWriter, jsonObject interface{}) {
@@ -213,7 +224,8 @@ func sendJSON(w http.ResponseWriter, jsonObject interface{}) {
 }
 
 func (s *service) Serve(ctx context.Context) error {
-	listener, err := s.getListener(s.cfg.GUI())
+	certAuth := newClientCertAuth(s.cfg.GUI())
+	listener, acmeCerts, err := s.getListener(s.cfg.GUI(), certAuth)
 	if err != nil {
 		select {
 		case <-s.startedOnce:
@@ -240,68 +252,109 @@ func (s *service) Serve(ctx context.Context) error {
 	s.listenerAddr = listener.Addr()
 	defer listener.Close()
 
+	if acmeCerts != nil {
+		acmeCtx, cancel := context.WithCancel(ctx)
+		defer cancel()
+		go acmeCerts.serve(acmeCtx)
+	}
+
 	s.cfg.Subscribe(s)
 	defer s.cfg.Unsubscribe(s)
 
 	restMux := httprouter.New()
 
 	// The GET handlers
-	restMux.HandlerFunc(http.MethodGet, "/rest/cluster/pending/devices", s.getPendingDevices) // -
-	restMux.HandlerFunc(http.MethodGet, "/rest/cluster/pending/folders", s.getPendingFolders) // [device]
-	restMux.HandlerFunc(http.MethodGet, "/rest/db/completion", s.getDBCompletion)             // [device] [folder]
-	restMux.HandlerFunc(http.MethodGet, "/rest/db/file", s.getDBFile)                         // folder file
-	restMux.HandlerFunc(http.MethodGet, "/rest/db/ignores", s.getDBIgnores)                   // folder
-	restMux.HandlerFunc(http.MethodGet, "/rest/db/need", s.getDBNeed)                         // folder [perpage] [page]
-	restMux.HandlerFunc(http.MethodGet, "/rest/db/remoteneed", s.getDBRemoteNeed)             // device folder [perpage] [page]
-	restMux.HandlerFunc(http.MethodGet, "/rest/db/localchanged", s.getDBLocalChanged)         // folder [perpage] [page]
-	restMux.HandlerFunc(http.MethodGet, "/rest/db/status", s.getDBStatus)                     // folder
-	restMux.HandlerFunc(http.MethodGet, "/rest/db/browse", s.getDBBrowse)                     // folder [prefix] [dirsonly] [levels]
-	restMux.HandlerFunc(http.MethodGet, "/rest/folder/versions", s.getFolderVersions)         // folder
-	restMux.HandlerFunc(http.MethodGet, "/rest/folder/errors", s.getFolderErrors)             // folder [perpage] [page]
-	restMux.HandlerFunc(http.MethodGet, "/rest/folder/pullerrors", s.getFolderErrors)         // folder (deprecated)
-	restMux.HandlerFunc(http.MethodGet, "/rest/events", s.getIndexEvents)                     // [since] [limit] [timeout] [events]
-	restMux.HandlerFunc(http.MethodGet, "/rest/events/disk", s.getDiskEvents)                 // [since] [limit] [timeout]
-	restMux.HandlerFunc(http.MethodGet, "/rest/noauth/health", s.getHealth)                   // -
-	restMux.HandlerFunc(http.MethodGet, "/rest/stats/device", s.getDeviceStats)               // -
-	restMux.HandlerFunc(http.MethodGet, "/rest/stats/folder", s.getFolderStats)               // -
-	restMux.HandlerFunc(http.MethodGet, "/rest/svc/deviceid", s.getDeviceID)                  // id
-	restMux.HandlerFunc(http.MethodGet, "/rest/svc/lang", s.getLang)                          // -
-	restMux.HandlerFunc(http.MethodGet, "/rest/svc/report", s.getReport)                      // -
-	restMux.HandlerFunc(http.MethodGet, "/rest/svc/random/string", s.getRandomString)         // [length]
-	restMux.HandlerFunc(http.MethodGet, "/rest/system/browse", s.getSystemBrowse)             // current
-	restMux.HandlerFunc(http.MethodGet, "/rest/system/connections", s.getSystemConnections)   // -
-	restMux.HandlerFunc(http.MethodGet, "/rest/system/discovery", s.getSystemDiscovery)       // -
-	restMux.HandlerFunc(http.MethodGet, "/rest/system/error", s.getSystemError)               // -
-	restMux.HandlerFunc(http.MethodGet, "/rest/system/paths", s.getSystemPaths)               // -
-	restMux.HandlerFunc(http.MethodGet, "/rest/system/ping", s.restPing)                      // -
-	restMux.HandlerFunc(http.MethodGet, "/rest/system/status", s.getSystemStatus)             // -
-	restMux.HandlerFunc(http.MethodGet, "/rest/system/upgrade", s.getSystemUpgrade)           // -
-	restMux.HandlerFunc(http.MethodGet, "/rest/system/version", s.getSystemVersion)           // -
-	restMux.HandlerFunc(http.MethodGet, "/rest/system/debug", s.getSystemDebug)               // -
-	restMux.HandlerFunc(http.MethodGet, "/rest/system/log", s.getSystemLog)                   // [since]
-	restMux.HandlerFunc(http.MethodGet, "/rest/system/log.txt", s.getSystemLogTxt)            // [since]
+	restMux.HandlerFunc(http.MethodGet, "/rest/cluster/pending/devices", s.getPendingDevices)     // -
+	restMux.HandlerFunc(http.MethodGet, "/rest/cluster/pending/folders", s.getPendingFolders)     // [device]
+	restMux.HandlerFunc(http.MethodGet, "/rest/cluster/pending/pushes", s.getPendingConfigPushes) // -
+	restMux.HandlerFunc(http.MethodGet, "/rest/cluster/topology", s.getClusterTopology)           // -
+	restMux.HandlerFunc(http.MethodGet, "/rest/db/completion", s.getDBCompletion)                 // [device] [folder]
+	restMux.HandlerFunc(http.MethodGet, "/rest/db/conflicts", s.getDBConflicts)                   // folder
+	restMux.HandlerFunc(http.MethodGet, "/rest/db/file", s.getDBFile)                             // folder file
+	restMux.HandlerFunc(http.MethodGet, "/rest/db/file/history", s.getDBFileHistory)              // folder file
+	restMux.HandlerFunc(http.MethodGet, "/rest/db/ignores", s.getDBIgnores)                       // folder
+	restMux.HandlerFunc(http.MethodGet, "/rest/db/index", s.getDBIndex)                           // folder [format]
+	restMux.HandlerFunc(http.MethodGet, "/rest/db/need", s.getDBNeed)                             // folder [perpage] [page]
+	restMux.HandlerFunc(http.MethodGet, "/rest/db/queue", s.getDBQueue)                           // folder [perpage] [page]
+	restMux.HandlerFunc(http.MethodGet, "/rest/db/remoteneed", s.getDBRemoteNeed)                 // device folder [perpage] [page]
+	restMux.HandlerFunc(http.MethodGet, "/rest/db/localchanged", s.getDBLocalChanged)             // folder [perpage] [page]
+	restMux.HandlerFunc(http.MethodGet, "/rest/db/revert", s.getDBRevert)                         // folder [perpage] [page]
+	restMux.HandlerFunc(http.MethodGet, "/rest/db/status", s.getDBStatus)                         // folder
+	restMux.HandlerFunc(http.MethodGet, "/rest/db/browse", s.getDBBrowse)                         // folder [prefix] [dirsonly] [levels]
+	restMux.HandlerFunc(http.MethodGet, "/rest/db/snapshot", s.getDBSnapshot)                     // folder
+	restMux.HandlerFunc(http.MethodGet, "/rest/folder/versions", s.getFolderVersions)             // folder
+	restMux.HandlerFunc(http.MethodGet, "/rest/folder/errors", s.getFolderErrors)                 // folder [perpage] [page]
+	restMux.HandlerFunc(http.MethodGet, "/rest/folder/freeze", s.getFolderFreeze)                 // [folder]
+	restMux.HandlerFunc(http.MethodGet, "/rest/folder/pullerrors", s.getFolderErrors)             // folder (deprecated)
+	restMux.HandlerFunc(http.MethodGet, "/rest/events", s.getIndexEvents)                         // [since] [limit] [timeout] [events] [folder] [device]
+	restMux.HandlerFunc(http.MethodGet, "/rest/events/disk", s.getDiskEvents)                     // [since] [limit] [timeout] [folder] [device]
+	restMux.HandlerFunc(http.MethodGet, "/rest/events/ws", s.getEventsWebSocket)                  // [since] [events] [folder] [device]
+	restMux.HandlerFunc(http.MethodGet, "/rest/noauth/health", s.getHealth)                       // -
+	restMux.HandlerFunc(http.MethodGet, "/rest/stats/device", s.getDeviceStats)                   // -
+	restMux.HandlerFunc(http.MethodGet, "/rest/stats/folder", s.getFolderStats)                   // -
+	restMux.HandlerFunc(http.MethodGet, "/rest/stats/transfer", s.getTransferStats)               // -
+	restMux.HandlerFunc(http.MethodGet, "/rest/stats/traffic", s.getTrafficStats)                 // -
+	restMux.HandlerFunc(http.MethodGet, "/rest/svc/deviceid", s.getDeviceID)                      // id
+	restMux.HandlerFunc(http.MethodGet, "/rest/svc/lang", s.getLang)                              // -
+	restMux.HandlerFunc(http.MethodGet, "/rest/svc/report", s.getReport)                          // -
+	restMux.HandlerFunc(http.MethodGet, "/rest/svc/report/forwarded", s.getForwardedReports)      // -
+	restMux.HandlerFunc(http.MethodGet, "/rest/svc/random/string", s.getRandomString)             // [length]
+	restMux.HandlerFunc(http.MethodGet, "/rest/system/browse", s.getSystemBrowse)                 // current
+	restMux.HandlerFunc(http.MethodGet, "/rest/system/connections", s.getSystemConnections)       // -
+	restMux.HandlerFunc(http.MethodGet, "/rest/system/connectivity", s.getSystemConnectivity)     // -
+	restMux.HandlerFunc(http.MethodGet, "/rest/system/db/verify", s.getSystemDBVerify)            // -
+	restMux.HandlerFunc(http.MethodGet, "/rest/system/discovery", s.getSystemDiscovery)           // -
+	restMux.HandlerFunc(http.MethodGet, "/rest/system/identitylog", s.getSystemIdentityLog)       // [device]
+	restMux.HandlerFunc(http.MethodGet, "/rest/system/error", s.getSystemError)                   // -
+	restMux.HandlerFunc(http.MethodGet, "/rest/system/paths", s.getSystemPaths)                   // -
+	restMux.HandlerFunc(http.MethodGet, "/rest/system/ping", s.restPing)                          // -
+	restMux.HandlerFunc(http.MethodGet, "/rest/system/startup", s.getSystemStartup)               // -
+	restMux.HandlerFunc(http.MethodGet, "/rest/system/status", s.getSystemStatus)                 // -
+	restMux.HandlerFunc(http.MethodGet, "/rest/system/upgrade", s.getSystemUpgrade)               // -
+	restMux.HandlerFunc(http.MethodGet, "/rest/system/version", s.getSystemVersion)               // -
+	restMux.HandlerFunc(http.MethodGet, "/rest/system/debug", s.getSystemDebug)                   // -
+	restMux.HandlerFunc(http.MethodGet, "/rest/system/log", s.getSystemLog)                       // [since]
+	restMux.HandlerFunc(http.MethodGet, "/rest/system/log.txt", s.getSystemLogTxt)                // [since]
 
 	// The POST handlers
-	restMux.HandlerFunc(http.MethodPost, "/rest/db/prio", s.postDBPrio)                          // folder file
-	restMux.HandlerFunc(http.MethodPost, "/rest/db/ignores", s.postDBIgnores)                    // folder
-	restMux.HandlerFunc(http.MethodPost, "/rest/db/override", s.postDBOverride)                  // folder
-	restMux.HandlerFunc(http.MethodPost, "/rest/db/revert", s.postDBRevert)                      // folder
-	restMux.HandlerFunc(http.MethodPost, "/rest/db/scan", s.postDBScan)                          // folder [sub...] [delay]
-	restMux.HandlerFunc(http.MethodPost, "/rest/folder/versions", s.postFolderVersionsRestore)   // folder <body>
-	restMux.HandlerFunc(http.MethodPost, "/rest/system/error", s.postSystemError)                // <body>
-	restMux.HandlerFunc(http.MethodPost, "/rest/system/error/clear", s.postSystemErrorClear)     // -
-	restMux.HandlerFunc(http.MethodPost, "/rest/system/ping", s.restPing)                        // -
-	restMux.HandlerFunc(http.MethodPost, "/rest/system/reset", s.postSystemReset)                // [folder]
-	restMux.HandlerFunc(http.MethodPost, "/rest/system/restart", s.postSystemRestart)            // -
-	restMux.HandlerFunc(http.MethodPost, "/rest/system/shutdown", s.postSystemShutdown)          // -
-	restMux.HandlerFunc(http.MethodPost, "/rest/system/upgrade", s.postSystemUpgrade)            // -
-	restMux.HandlerFunc(http.MethodPost, "/rest/system/pause", s.makeDevicePauseHandler(true))   // [device]
-	restMux.HandlerFunc(http.MethodPost, "/rest/system/resume", s.makeDevicePauseHandler(false)) // [device]
-	restMux.HandlerFunc(http.MethodPost, "/rest/system/debug", s.postSystemDebug)                // [enable] [disable]
+	restMux.HandlerFunc(http.MethodPost, "/rest/db/clean", s.postDBClean)                                      // folder [tempAge] [versionAge] [conflictAge] [dryrun]
+	restMux.HandlerFunc(http.MethodPost, "/rest/db/conflicts/resolve", s.postDBConflictsResolve)               // folder name keep
+	restMux.Handle(http.MethodPost, "/rest/db/folders:batch", batchHandler(s.postDBFoldersBatch))              // <body>
+	restMux.HandlerFunc(http.MethodPost, "/rest/db/prio", s.postDBPrio)                                        // folder file
+	restMux.HandlerFunc(http.MethodPost, "/rest/db/prioritize", s.postDBPrioritize)                            // folder pattern... priority
+	restMux.HandlerFunc(http.MethodPost, "/rest/db/queue/front", s.postDBQueueFront)                           // folder file... [perpage] [page]
+	restMux.HandlerFunc(http.MethodPost, "/rest/db/queue/back", s.postDBQueueBack)                             // folder file... [perpage] [page]
+	restMux.HandlerFunc(http.MethodPost, "/rest/db/queue/skip", s.postDBQueueSkip)                             // folder file... [perpage] [page]
+	restMux.HandlerFunc(http.MethodPost, "/rest/db/ignores", s.postDBIgnores)                                  // folder
+	restMux.HandlerFunc(http.MethodPost, "/rest/db/override", s.postDBOverride)                                // folder
+	restMux.HandlerFunc(http.MethodPost, "/rest/db/revert", s.postDBRevert)                                    // folder [path...]
+	restMux.HandlerFunc(http.MethodPost, "/rest/db/scan", s.postDBScan)                                        // folder [sub...] [delay]
+	restMux.HandlerFunc(http.MethodPost, "/rest/db/snapshot", s.postDBSnapshot)                                // folder <body>
+	restMux.HandlerFunc(http.MethodPost, "/rest/folder/versions", s.postFolderVersionsRestore)                 // folder <body>
+	restMux.HandlerFunc(http.MethodPost, "/rest/folder/freeze", s.postFolderFreeze)                            // folder reason (until | duration)
+	restMux.HandlerFunc(http.MethodPost, "/rest/system/db/compact", s.postSystemDBCompact)                     // -
+	restMux.HandlerFunc(http.MethodPost, "/rest/system/error", s.postSystemError)                              // <body>
+	restMux.HandlerFunc(http.MethodPost, "/rest/system/error/clear", s.postSystemErrorClear)                   // -
+	restMux.HandlerFunc(http.MethodPost, "/rest/system/identitylog/compare", s.postIdentityLogCompare)         // <body>
+	restMux.HandlerFunc(http.MethodPost, "/rest/system/ping", s.restPing)                                      // -
+	restMux.HandlerFunc(http.MethodPost, "/rest/system/reset", s.postSystemReset)                              // [folder]
+	restMux.HandlerFunc(http.MethodPost, "/rest/system/restart", s.postSystemRestart)                          // -
+	restMux.HandlerFunc(http.MethodPost, "/rest/system/shutdown", s.postSystemShutdown)                        // -
+	restMux.HandlerFunc(http.MethodPost, "/rest/system/upgrade", s.postSystemUpgrade)                          // -
+	restMux.HandlerFunc(http.MethodPost, "/rest/system/pause", s.makeDevicePauseHandler(true))                 // [device]
+	restMux.HandlerFunc(http.MethodPost, "/rest/system/resume", s.makeDevicePauseHandler(false))               // [device]
+	restMux.HandlerFunc(http.MethodPost, "/rest/cluster/pending/devices/accept", s.postPendingDeviceAccept)    // device
+	restMux.HandlerFunc(http.MethodPost, "/rest/cluster/pending/folders/accept", s.postPendingFolderAccept)    // folder device
+	restMux.HandlerFunc(http.MethodPost, "/rest/cluster/pending/pushes/accept", s.postPendingConfigPushAccept) // device id
+	restMux.HandlerFunc(http.MethodPost, "/rest/cluster/push", s.postClusterPush)                              // device <body>
+	restMux.HandlerFunc(http.MethodPost, "/rest/system/debug", s.postSystemDebug)                              // [enable] [disable]
 
 	// The DELETE handlers
-	restMux.HandlerFunc(http.MethodDelete, "/rest/cluster/pending/devices", s.deletePendingDevices) // device
-	restMux.HandlerFunc(http.MethodDelete, "/rest/cluster/pending/folders", s.deletePendingFolders) // folder [device]
+	restMux.HandlerFunc(http.MethodDelete, "/rest/cluster/pending/devices", s.deletePendingDevices)     // device
+	restMux.HandlerFunc(http.MethodDelete, "/rest/cluster/pending/folders", s.deletePendingFolders)     // folder [device]
+	restMux.HandlerFunc(http.MethodDelete, "/rest/cluster/pending/pushes", s.deletePendingConfigPushes) // device id
+	restMux.HandlerFunc(http.MethodDelete, "/rest/folder/freeze", s.deleteFolderFreeze)                 // folder
+	restMux.HandlerFunc(http.MethodDelete, "/rest/svc/report/forwarded", s.deleteForwardedReport)       // device received
 
 	// Config endpoints
 
@@ -314,8 +367,10 @@ func (s *service) Serve(ctx context.Context) error {
 	configBuilder.registerConfig("/rest/config")
 	configBuilder.registerConfigInsync("/rest/config/insync") // deprecated
 	configBuilder.registerConfigRequiresRestart("/rest/config/restart-required")
+	configBuilder.registerConfigDowngrade("/rest/config/downgrade")
 	configBuilder.registerFolders("/rest/config/folders")
 	configBuilder.registerDevices("/rest/config/devices")
+	configBuilder.registerDevicesBatch("/rest/config/devices:batch")
 	configBuilder.registerFolder("/rest/config/folders/:id")
 	configBuilder.registerDevice("/rest/config/devices/:id")
 	configBuilder.registerDefaultFolder("/rest/config/defaults/folder")
@@ -324,6 +379,8 @@ func (s *service) Serve(ctx context.Context) error {
 	configBuilder.registerOptions("/rest/config/options")
 	configBuilder.registerLDAP("/rest/config/ldap")
 	configBuilder.registerGUI("/rest/config/gui")
+	configBuilder.registerAPIKeys("/rest/config/apikeys")
+	configBuilder.registerAPIKey("/rest/config/apikeys/:key")
 
 	// Deprecated config endpoints
 	configBuilder.registerConfigDeprecated("/rest/system/config") // POST instead of PUT
@@ -332,6 +389,7 @@ func (s *service) Serve(ctx context.Context) error {
 	// Debug endpoints, not for general use
 	debugMux := http.NewServeMux()
 	debugMux.HandleFunc("/rest/debug/peerCompletion", s.getPeerCompletion)
+	debugMux.HandleFunc("/rest/debug/blocksources", s.getBlockSources)
 	debugMux.HandleFunc("/rest/debug/httpmetrics", s.getSystemHTTPMetrics)
 	debugMux.HandleFunc("/rest/debug/cpuprof", s.getCPUProf) // duration
 	debugMux.HandleFunc("/rest/debug/heapprof", s.getHeapProf)
@@ -339,8 +397,10 @@ func (s *service) Serve(ctx context.Context) error {
 	debugMux.HandleFunc("/rest/debug/file", s.getDebugFile)
 	restMux.Handler(http.MethodGet, "/rest/debug/*method", s.whenDebugging(debugMux))
 
+	guiCfg := s.cfg.GUI()
+
 	// A handler that disables caching
-	noCacheRestMux := noCacheMiddleware(metricsMiddleware(restMux))
+	noCacheRestMux := noCacheMiddleware(metricsMiddleware(contentDigestMiddleware(restMux, int(guiCfg.ContentDigestMinKiB)<<10)))
 
 	// The main routing handler
 	mux := http.NewServeMux()
@@ -355,20 +415,19 @@ func (s *service) Serve(ctx context.Context) error {
 
 	// Handle Prometheus metrics
 	promHttpHandler := promhttp.Handler()
-	mux.Handle("/metrics", promHttpHandler)
-
-	guiCfg := s.cfg.GUI()
+	mux.Handle("/metrics", apiKeyMiddleware(promHttpHandler, guiCfg))
 
 	// Wrap everything in CSRF protection. The /rest prefix should be
 	// protected, other requests will grant cookies.
-	var handler http.Handler = newCsrfManager(s.id.Short().String(), "/rest", guiCfg, mux, s.miscDB)
+	csrfMgr := newCsrfManager(s.id.Short().String(), "/rest", guiCfg, mux, s.miscDB)
+	var handler http.Handler = csrfMgr
 
 	// Add our version and ID as a header to responses
 	handler = withDetailsMiddleware(s.id, handler)
 
 	// Wrap everything in basic auth, if user/password is set.
 	if guiCfg.IsAuthEnabled() {
-		tokenCookieManager := newTokenCookieManager(s.id.Short().String(), guiCfg, s.evLogger, s.miscDB)
+		tokenCookieManager := newTokenCookieManager(s.id.Short().String(), guiCfg, s.evLogger, s.miscDB, csrfMgr)
 		authMW := newBasicAuthAndSessionMiddleware(tokenCookieManager, guiCfg, s.cfg.LDAP(), handler, s.evLogger)
 		handler = authMW
 
@@ -376,10 +435,33 @@ func (s *service) Serve(ctx context.Context) error {
 
 		// Logout is a no-op without a valid session cookie, so /noauth/ is fine here
 		restMux.Handler(http.MethodPost, "/rest/noauth/auth/logout", http.HandlerFunc(authMW.handleLogout))
+
+		// The login methods to offer on the login page
+		loginMethods := []string{"password"}
+		if guiCfg.AuthMode == config.AuthModeOIDC {
+			oidc := newOIDCLogin(guiCfg, tokenCookieManager, s.evLogger)
+			restMux.Handler(http.MethodGet, "/rest/noauth/auth/oidc", http.HandlerFunc(oidc.login))
+			restMux.Handler(http.MethodGet, oidcCallbackPath, http.HandlerFunc(oidc.callback))
+			loginMethods = append(loginMethods, "oidc")
+		}
+		restMux.Handler(http.MethodGet, "/rest/noauth/auth/methods", http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			sendJSON(w, loginMethods)
+		}))
+
+		// Session management, for admins only
+		restMux.Handler(http.MethodGet, "/rest/system/sessions", http.HandlerFunc(authMW.getSessions))       // -
+		restMux.Handler(http.MethodDelete, "/rest/system/sessions", http.HandlerFunc(authMW.deleteSessions)) // [id] [user] [others]
 	}
 
-	// Redirect to HTTPS if we are supposed to
-	if guiCfg.UseTLS() {
+	// Note who is using a client certificate, before authentication
+	// and CSRF protection.
+	if certAuth.enabled() {
+		handler = clientCertMiddleware(certAuth, handler)
+	}
+
+	// Redirect to HTTPS if we are supposed to, or need to for the client
+	// certificate.
+	if guiCfg.UseTLS() || (guiCfg.RequireClientCertificate && certAuth.enabled()) {
 		handler = redirectToHTTPSMiddleware(handler)
 	}
 
@@ -473,6 +555,9 @@ func (s *service) String() string {
 }
 
 func (*service) VerifyConfiguration(_, to config.Configuration) error {
+	if err := verifyACMEConfiguration(to.GUI.ACME); err != nil {
+		return err
+	}
 	if to.GUI.Network() != "tcp" {
 		return nil
 	}
@@ -484,7 +569,7 @@ func (s *service) CommitConfiguration(from, to config.Configuration) bool {
 	// No action required when this changes, so mask the fact that it changed at all.
 	from.GUI.Debugging = to.GUI.Debugging
 
-	if to.GUI == from.GUI {
+	if guiConfigEqual(to.GUI, from.GUI) {
 		// No GUI changes, we're done here.
 		return true
 	}
@@ -499,6 +584,32 @@ func (s *service) CommitConfiguration(from, to config.Configuration) bool {
 	return true
 }
 
+// guiConfigEqual compares the GUI configs, where the lists may be either
+// nil or empty when there is nothing in them.
+func guiConfigEqual(a, b config.GUIConfiguration) bool {
+	for _, c := range []*config.GUIConfiguration{&a, &b} {
+		if len(c.Users) == 0 {
+			c.Users = nil
+		}
+		if len(c.APIKeys) == 0 {
+			c.APIKeys = nil
+		}
+		if len(c.OIDC.AllowedGroups) == 0 {
+			c.OIDC.AllowedGroups = nil
+		}
+		if len(c.OIDC.Scopes) == 0 {
+			c.OIDC.Scopes = nil
+		}
+		if len(c.ClientCertificates) == 0 {
+			c.ClientCertificates = nil
+		}
+		if len(c.ACME.Domains) == 0 {
+			c.ACME.Domains = nil
+		}
+	}
+	return reflect.DeepEqual(a, b)
+}
+
 func (s *service) fatal(err *svcutil.FatalErr) {
 	// s.exitChan is 1-buffered and whoever is first gets handled.
 	select {
@@ -595,6 +706,19 @@ func metricsMiddleware(h http.Handler) http.Handler {
 	})
 }
 
+// apiKeyMiddleware requires a valid API key for requests to the handler,
+// unless the GUI requires authentication, which then covers it.
+func apiKeyMiddleware(h http.Handler, guiCfg config.GUIConfiguration) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, scoped := scopedAPIKeyFromHeader(r, guiCfg)
+		if !guiCfg.IsAuthEnabled() && !hasValidAPIKeyHeader(r, guiCfg) && !scoped {
+			http.Error(w, "Forbidden", http.StatusForbidden)
+			return
+		}
+		h.ServeHTTP(w, r)
+	})
+}
+
 func redirectToHTTPSMiddleware(h http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		if r.TLS == nil {
@@ -656,6 +780,10 @@ func (s *service) getPendingDevices(w http.ResponseWriter, _ *http.Request) {
 	sendJSON(w, devices)
 }
 
+func (s *service) getClusterTopology(w http.ResponseWriter, _ *http.Request) {
+	sendJSON(w, s.model.ClusterTopology())
+}
+
 func (s *service) deletePendingDevices(w http.ResponseWriter, r *http.Request) {
 	qs := r.URL.Query()
 
@@ -671,6 +799,100 @@ func (s *service) deletePendingDevices(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// postPendingDeviceAccept adds a pending device to the config, based on the
+// default device configuration.
+func (s *service) postPendingDeviceAccept(w http.ResponseWriter, r *http.Request) {
+	qs := r.URL.Query()
+
+	device := qs.Get("device")
+	deviceID, err := protocol.DeviceIDFromString(device)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	devices, err := s.model.PendingDevices()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	observed, ok := devices[deviceID]
+	if !ok {
+		http.Error(w, "no such pending device", http.StatusNotFound)
+		return
+	}
+
+	cfg := s.cfg.DefaultDevice()
+	cfg.DeviceID = deviceID
+	cfg.Name = observed.Name
+	waiter, err := s.cfg.Modify(func(c *config.Configuration) {
+		c.SetDevice(cfg)
+	})
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	waiter.Wait()
+}
+
+// postPendingFolderAccept shares a folder offered by a device. An existing
+// folder with the same ID gets shared with the device, otherwise a new one
+// is added based on the default folder configuration.
+func (s *service) postPendingFolderAccept(w http.ResponseWriter, r *http.Request) {
+	qs := r.URL.Query()
+
+	device := qs.Get("device")
+	deviceID, err := protocol.DeviceIDFromString(device)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	folderID := qs.Get("folder")
+
+	if _, ok := s.cfg.Device(deviceID); !ok {
+		http.Error(w, "no such device", http.StatusNotFound)
+		return
+	}
+	folders, err := s.model.PendingFolders(deviceID)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	pending, ok := folders[folderID]
+	if !ok {
+		http.Error(w, "no such pending folder", http.StatusNotFound)
+		return
+	}
+	observed := pending.OfferedBy[deviceID]
+
+	fcfg, ok := s.cfg.Folder(folderID)
+	if !ok {
+		fcfg = s.cfg.DefaultFolder()
+		fcfg.ID = folderID
+		fcfg.Label = observed.Label
+		name := observed.Label
+		if name == "" {
+			name = folderID
+		}
+		fcfg.Path = filepath.Join(fcfg.Path, fs.SanitizePath(name))
+		if observed.ReceiveEncrypted {
+			fcfg.Type = config.FolderTypeReceiveEncrypted
+		}
+	}
+	if !fcfg.SharedWith(deviceID) {
+		fcfg.Devices = append(fcfg.Devices, config.FolderDeviceConfiguration{DeviceID: deviceID})
+	}
+
+	waiter, err := s.cfg.Modify(func(c *config.Configuration) {
+		c.SetFolder(fcfg)
+	})
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	waiter.Wait()
+}
+
 func (s *service) getPendingFolders(w http.ResponseWriter, r *http.Request) {
 	qs := r.URL.Query()
 
@@ -705,6 +927,84 @@ func (s *service) deletePendingFolders(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+func (s *service) getPendingConfigPushes(w http.ResponseWriter, _ *http.Request) {
+	pushes := s.model.PendingConfigPushes()
+	if pushes == nil {
+		pushes = []model.PendingConfigPush{}
+	}
+	sendJSON(w, pushes)
+}
+
+func (s *service) postPendingConfigPushAccept(w http.ResponseWriter, r *http.Request) {
+	deviceID, id, err := configPushFromQuery(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if !s.configPushPending(deviceID, id) {
+		http.Error(w, "no such pending config push", http.StatusNotFound)
+		return
+	}
+	if err := s.model.AcceptConfigPush(deviceID, id); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
+
+func (s *service) deletePendingConfigPushes(w http.ResponseWriter, r *http.Request) {
+	deviceID, id, err := configPushFromQuery(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if !s.configPushPending(deviceID, id) {
+		http.Error(w, "no such pending config push", http.StatusNotFound)
+		return
+	}
+	if err := s.model.DismissConfigPush(deviceID, id); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
+
+func (s *service) configPushPending(deviceID protocol.DeviceID, id int64) bool {
+	return slices.ContainsFunc(s.model.PendingConfigPushes(), func(p model.PendingConfigPush) bool {
+		return p.Device == deviceID && p.ID == id
+	})
+}
+
+func configPushFromQuery(r *http.Request) (protocol.DeviceID, int64, error) {
+	qs := r.URL.Query()
+	deviceID, err := protocol.DeviceIDFromString(qs.Get("device"))
+	if err != nil {
+		return protocol.EmptyDeviceID, 0, err
+	}
+	id, err := strconv.ParseInt(qs.Get("id"), 10, 64)
+	if err != nil {
+		return protocol.EmptyDeviceID, 0, err
+	}
+	return deviceID, id, nil
+}
+
+// postClusterPush pushes the configuration in the body to a device that
+// allows us to manage it, where it waits to be approved.
+func (s *service) postClusterPush(w http.ResponseWriter, r *http.Request) {
+	deviceID, err := protocol.DeviceIDFromString(r.URL.Query().Get("device"))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	var cfg model.PushedConfig
+	if err := unmarshalTo(r.Body, &cfg); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	id, err := s.model.PushConfig(deviceID, cfg)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	sendJSON(w, map[string]int64{"id": id})
+}
+
 func (*service) restPing(w http.ResponseWriter, _ *http.Request) {
 	sendJSON(w, map[string]string{"ping": "pong"})
 }
@@ -819,6 +1119,88 @@ func (s *service) getDBCompletion(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+func (s *service) getDBConflicts(w http.ResponseWriter, r *http.Request) {
+	qs := r.URL.Query()
+	folder := qs.Get("folder")
+
+	conflicts, err := s.model.Conflicts(folder)
+	if err != nil {
+		status := http.StatusInternalServerError
+		if isFolderNotFound(err) {
+			status = http.StatusNotFound
+		}
+		http.Error(w, err.Error(), status)
+		return
+	}
+	sendJSON(w, map[string]interface{}{
+		"folder":    folder,
+		"conflicts": conflicts,
+	})
+}
+
+func (s *service) postDBConflictsResolve(w http.ResponseWriter, r *http.Request) {
+	qs := r.URL.Query()
+	folder := qs.Get("folder")
+	name := qs.Get("name")
+
+	var keepConflict bool
+	switch keep := qs.Get("keep"); keep {
+	case "conflict":
+		keepConflict = true
+	case "current":
+	default:
+		http.Error(w, `keep must be "conflict" or "current"`, http.StatusBadRequest)
+		return
+	}
+
+	if err := s.model.ResolveConflict(folder, name, keepConflict); err != nil {
+		status := http.StatusInternalServerError
+		switch {
+		case isFolderNotFound(err), errors.Is(err, model.ErrNoSuchConflict):
+			status = http.StatusNotFound
+		case errors.Is(err, model.ErrNotConflict):
+			status = http.StatusBadRequest
+		}
+		http.Error(w, err.Error(), status)
+	}
+}
+
+func (s *service) postDBClean(w http.ResponseWriter, r *http.Request) {
+	qs := r.URL.Query()
+	folder := qs.Get("folder")
+
+	var opts model.CleanOptions
+	for _, param := range []struct {
+		name string
+		age  *time.Duration
+	}{
+		{"tempAge", &opts.TempAge},
+		{"versionAge", &opts.VersionAge},
+		{"conflictAge", &opts.ConflictAge},
+	} {
+		if val := qs.Get(param.name); val != "" {
+			age, err := time.ParseDuration(val)
+			if err != nil || age < 0 {
+				http.Error(w, fmt.Sprintf("invalid %s %q", param.name, val), http.StatusBadRequest)
+				return
+			}
+			*param.age = age
+		}
+	}
+	opts.DryRun = qs.Get("dryrun") == "true"
+
+	res, err := s.model.CleanFolder(folder, opts)
+	if err != nil {
+		status := http.StatusInternalServerError
+		if isFolderNotFound(err) {
+			status = http.StatusNotFound
+		}
+		http.Error(w, err.Error(), status)
+		return
+	}
+	sendJSON(w, res)
+}
+
 func (s *service) getDBStatus(w http.ResponseWriter, r *http.Request) {
 	qs := r.URL.Query()
 	folder := qs.Get("folder")
@@ -835,10 +1217,109 @@ func (s *service) postDBOverride(_ http.ResponseWriter, r *http.Request) {
 	go s.model.Override(folder)
 }
 
-func (s *service) postDBRevert(_ http.ResponseWriter, r *http.Request) {
+// postDBRevert reverts the local changes in a receive only folder, either
+// all of them in the background or, if paths are given, those to the paths
+// and anything below them.
+func (s *service) postDBRevert(w http.ResponseWriter, r *http.Request) {
+	qs := r.URL.Query()
+	folder := qs.Get("folder")
+	paths := qs["path"]
+	if len(paths) == 0 {
+		go s.model.Revert(folder)
+		return
+	}
+	if err := s.model.RevertPaths(folder, paths); err != nil {
+		status := http.StatusBadRequest
+		if isFolderNotFound(err) {
+			status = http.StatusNotFound
+		}
+		http.Error(w, err.Error(), status)
+	}
+}
+
+// getDBRevert lists the local changes in a receive only folder, which a
+// revert would undo, with how each differs from the global version.
+func (s *service) getDBRevert(w http.ResponseWriter, r *http.Request) {
+	qs := r.URL.Query()
+	folder := qs.Get("folder")
+	page, perpage := getPagingParams(qs)
+
+	changes, err := s.model.ReceiveOnlyChanges(folder, page, perpage)
+	if err != nil {
+		status := http.StatusBadRequest
+		if isFolderNotFound(err) {
+			status = http.StatusNotFound
+		}
+		http.Error(w, err.Error(), status)
+		return
+	}
+
+	sendJSON(w, map[string]interface{}{
+		"changes": changes,
+		"page":    page,
+		"perpage": perpage,
+	})
+}
+
+func (s *service) getDBSnapshot(w http.ResponseWriter, r *http.Request) {
+	qs := r.URL.Query()
+	folder := qs.Get("folder")
+
+	w.Header().Set("Content-Type", "application/octet-stream")
+	w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="syncthing-index-%s.stidx"`, url.PathEscape(folder)))
+	if err := s.model.ExportIndexSnapshot(folder, w); err != nil {
+		w.Header().Del("Content-Disposition")
+		status := http.StatusInternalServerError
+		if isFolderNotFound(err) {
+			status = http.StatusNotFound
+		}
+		http.Error(w, err.Error(), status)
+	}
+}
+
+// getDBIndex streams everything the database holds for the folder, as
+// JSONL or protobuf records.
+func (s *service) getDBIndex(w http.ResponseWriter, r *http.Request) {
+	qs := r.URL.Query()
+	folder := qs.Get("folder")
+	format, err := db.ParseIndexDumpFormat(qs.Get("format"))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	ext := "jsonl"
+	w.Header().Set("Content-Type", "application/jsonl")
+	if format == db.IndexDumpProtobuf {
+		ext = "pb"
+		w.Header().Set("Content-Type", "application/octet-stream")
+	}
+	w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="syncthing-index-%s.%s"`, url.PathEscape(folder), ext))
+	if err := s.model.DumpIndex(folder, w, format); err != nil {
+		w.Header().Del("Content-Disposition")
+		status := http.StatusInternalServerError
+		if isFolderNotFound(err) {
+			status = http.StatusNotFound
+		}
+		http.Error(w, err.Error(), status)
+	}
+}
+
+func (s *service) postDBSnapshot(w http.ResponseWriter, r *http.Request) {
 	qs := r.URL.Query()
 	folder := qs.Get("folder")
-	go s.model.Revert(folder)
+
+	defer r.Body.Close()
+	hdr, err := s.model.ImportIndexSnapshot(folder, r.Body)
+	if err != nil {
+		status := http.StatusBadRequest
+		if isFolderNotFound(err) {
+			status = http.StatusNotFound
+		}
+		http.Error(w, err.Error(), status)
+		return
+	}
+	sendJSON(w, hdr)
 }
 
 func getPagingParams(qs url.Values) (int, int) {
@@ -923,7 +1404,59 @@ func (s *service) getDBLocalChanged(w http.ResponseWriter, r *http.Request) {
 }
 
 func (s *service) getSystemConnections(w http.ResponseWriter, _ *http.Request) {
-	sendJSON(w, s.model.ConnectionStats())
+	res := map[string]interface{}{
+		"relays": client.Statistics(),
+	}
+	maps.Copy(res, s.model.ConnectionStats())
+	sendJSON(w, res)
+}
+
+// getSystemConnectivity checks the listeners and contacts the configured
+// discovery, relay and STUN servers. It takes a while, up to the timeout of
+// the slowest check.
+func (s *service) getSystemConnectivity(w http.ResponseWriter, r *http.Request) {
+	sendJSON(w, s.connectionsService.CheckConnectivity(r.Context()))
+}
+
+// getSystemIdentityLog exports the log of the identities devices were seen
+// with, for all devices or the given one.
+func (s *service) getSystemIdentityLog(w http.ResponseWriter, r *http.Request) {
+	var device protocol.DeviceID
+	if str := r.URL.Query().Get("device"); str != "" {
+		var err error
+		device, err = protocol.DeviceIDFromString(str)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+	}
+	entries := s.connectionsService.IdentityLog(device)
+	if entries == nil {
+		entries = []connections.IdentityLogEntry{}
+	}
+	sendJSON(w, entries)
+}
+
+// postIdentityLogCompare compares our identity log with the one exported
+// by another device, given in the body.
+func (s *service) postIdentityLogCompare(w http.ResponseWriter, r *http.Request) {
+	var remote []connections.IdentityLogEntry
+	if err := unmarshalTo(r.Body, &remote); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	res := map[string]interface{}{
+		"verified":      true,
+		"discrepancies": []connections.IdentityDiscrepancy{},
+	}
+	if err := connections.VerifyIdentityLog(remote); err != nil {
+		res["verified"] = false
+		res["error"] = err.Error()
+	}
+	if discrepancies := connections.CompareIdentityLogs(s.connectionsService.IdentityLog(protocol.EmptyDeviceID), remote); len(discrepancies) > 0 {
+		res["discrepancies"] = discrepancies
+	}
+	sendJSON(w, res)
 }
 
 func (s *service) getDeviceStats(w http.ResponseWriter, _ *http.Request) {
@@ -935,6 +1468,14 @@ func (s *service) getDeviceStats(w http.ResponseWriter, _ *http.Request) {
 	sendJSON(w, stats)
 }
 
+func (s *service) getTransferStats(w http.ResponseWriter, _ *http.Request) {
+	sendJSON(w, s.model.TransferStatistics())
+}
+
+func (s *service) getTrafficStats(w http.ResponseWriter, _ *http.Request) {
+	sendJSON(w, s.model.TrafficStatistics())
+}
+
 func (s *service) getFolderStats(w http.ResponseWriter, _ *http.Request) {
 	stats, err := s.model.FolderStatistics()
 	if err != nil {
@@ -1066,6 +1607,28 @@ func (s *service) postSystemReset(w http.ResponseWriter, r *http.Request) {
 	})
 }
 
+// postSystemDBCompact compacts the database backend, returning when done.
+// The progress is reported with DatabaseMaintenanceProgress events.
+func (s *service) postSystemDBCompact(w http.ResponseWriter, _ *http.Request) {
+	if err := s.model.CompactDatabase(); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	sendJSON(w, map[string]string{"ok": "compacted database"})
+}
+
+// getSystemDBVerify walks the index entries of all folders and returns the
+// corrupt ones found. The progress is reported with
+// DatabaseMaintenanceProgress events.
+func (s *service) getSystemDBVerify(w http.ResponseWriter, _ *http.Request) {
+	res, err := s.model.VerifyDatabase()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	sendJSON(w, res)
+}
+
 func (s *service) postSystemShutdown(w http.ResponseWriter, _ *http.Request) {
 	s.flushResponse(`{"ok": "shutting down"}`, w)
 	s.fatal(&svcutil.FatalErr{
@@ -1080,6 +1643,11 @@ func (*service) flushResponse(resp string, w http.ResponseWriter) {
 	f.Flush()
 }
 
+// getSystemStartup returns the progress of starting the folders.
+func (s *service) getSystemStartup(w http.ResponseWriter, _ *http.Request) {
+	sendJSON(w, s.model.StartupStatus())
+}
+
 func (s *service) getSystemStatus(w http.ResponseWriter, _ *http.Request) {
 	var m runtime.MemStats
 	runtime.ReadMemStats(&m)
@@ -1107,6 +1675,8 @@ func (s *service) getSystemStatus(w http.ResponseWriter, _ *http.Request) {
 
 	res["connectionServiceStatus"] = s.connectionsService.ListenerStatus()
 	res["lastDialStatus"] = s.connectionsService.ConnectionStatus()
+	res["relayBudget"] = s.connectionsService.RelayBudgetStatus()
+	res["holePunching"] = s.connectionsService.HolePunchStatus()
 	res["cpuPercent"] = 0 // deprecated from API
 	res["pathSeparator"] = string(filepath.Separator)
 	res["urVersionMax"] = ur.Version
@@ -1331,6 +1901,25 @@ func (s *service) getReport(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+func (s *service) getForwardedReports(w http.ResponseWriter, _ *http.Request) {
+	sendJSON(w, s.model.ForwardedUsageReports())
+}
+
+func (s *service) deleteForwardedReport(w http.ResponseWriter, r *http.Request) {
+	qs := r.URL.Query()
+	device, err := protocol.DeviceIDFromString(qs.Get("device"))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	received, err := time.Parse(time.RFC3339, qs.Get("received"))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	s.model.DismissForwardedUsageReport(device, received)
+}
+
 func (*service) getRandomString(w http.ResponseWriter, r *http.Request) {
 	length := 32
 	if val, _ := strconv.Atoi(r.URL.Query().Get("length")); val > 0 {
@@ -1403,6 +1992,11 @@ func (*service) getEvents(w http.ResponseWriter, r *http.Request, eventSub event
 	if timeoutSec, timeoutErr := strconv.Atoi(timeoutStr); timeoutErr == nil && timeoutSec >= 0 { // 0 is a valid timeout
 		timeout = time.Duration(timeoutSec) * time.Second
 	}
+	filter, err := newEventFilter(qs.Get("folder"), qs.Get("device"))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
 
 	// Flush before blocking, to indicate that we've received the request and
 	// that it should not be retried. Must set Content-Type header before
@@ -1413,6 +2007,22 @@ func (*service) getEvents(w http.ResponseWriter, r *http.Request, eventSub event
 
 	// If there are no events available return an empty slice, as this gets serialized as `[]`
 	evs := eventSub.Since(since, []events.Event{}, timeout)
+	if filter.active() {
+		// Keep waiting for the remainder of the timeout while everything
+		// that arrives is filtered out, as returning early would only make
+		// the client poll again straight away.
+		deadline := time.Now().Add(timeout)
+		for {
+			matching := filter.apply(evs)
+			remaining := time.Until(deadline)
+			if len(matching) > 0 || len(evs) == 0 || remaining <= 0 {
+				evs = matching
+				break
+			}
+			since = evs[len(evs)-1].SubscriptionID
+			evs = eventSub.Since(since, []events.Event{}, remaining)
+		}
+	}
 	if 0 < limit && limit < len(evs) {
 		evs = evs[len(evs)-limit:]
 	}
@@ -1420,6 +2030,88 @@ func (*service) getEvents(w http.ResponseWriter, r *http.Request, eventSub event
 	sendJSON(w, evs)
 }
 
+// eventFilter selects the events concerning a folder and/or a device.
+// Events that don't concern any folder or device, respectively, are always
+// selected.
+type eventFilter struct {
+	folder string
+	device protocol.DeviceID
+}
+
+func newEventFilter(folder, device string) (eventFilter, error) {
+	f := eventFilter{folder: folder}
+	if device != "" {
+		id, err := protocol.DeviceIDFromString(device)
+		if err != nil {
+			return eventFilter{}, err
+		}
+		f.device = id
+	}
+	return f, nil
+}
+
+func (f eventFilter) active() bool {
+	return f.folder != "" || f.device != protocol.EmptyDeviceID
+}
+
+func (f eventFilter) apply(evs []events.Event) []events.Event {
+	res := []events.Event{}
+	for _, ev := range evs {
+		if f.matches(ev) {
+			res = append(res, ev)
+		}
+	}
+	return res
+}
+
+func (f eventFilter) matches(ev events.Event) bool {
+	folderKey, deviceKey := "folder", "device"
+	switch ev.Type {
+	case events.FolderPaused, events.FolderResumed:
+		folderKey = "id"
+	case events.DeviceConnected, events.DeviceDisconnected:
+		deviceKey = "id"
+	}
+	fields := eventFields(ev.Data)
+	if f.folder != "" {
+		if folder, ok := fields[folderKey].(string); ok && folder != f.folder {
+			return false
+		}
+	}
+	if f.device != protocol.EmptyDeviceID {
+		if device, ok := fields[deviceKey].(string); ok {
+			if id, err := protocol.DeviceIDFromString(device); err == nil && id != f.device {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// eventFields returns the top level fields of the event data as they are
+// serialised, or nil if the data isn't an object.
+func eventFields(data interface{}) map[string]interface{} {
+	switch data := data.(type) {
+	case map[string]interface{}:
+		return data
+	case map[string]string:
+		fields := make(map[string]interface{}, len(data))
+		for k, v := range data {
+			fields[k] = v
+		}
+		return fields
+	}
+	bs, err := json.Marshal(data)
+	if err != nil {
+		return nil
+	}
+	var fields map[string]interface{}
+	if err := json.Unmarshal(bs, &fields); err != nil {
+		return nil
+	}
+	return fields
+}
+
 func (*service) getEventMask(evs string) events.EventType {
 	eventMask := DefaultEventMask
 	if evs != "" {
@@ -1610,6 +2302,89 @@ func (s *service) postDBPrio(w http.ResponseWriter, r *http.Request) {
 	s.getDBNeed(w, r)
 }
 
+func (s *service) getDBQueue(w http.ResponseWriter, r *http.Request) {
+	qs := r.URL.Query()
+	folder := qs.Get("folder")
+	page, perpage := getPagingParams(qs)
+
+	queue, err := s.model.PullQueue(folder, page, perpage)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+
+	sendJSON(w, map[string]interface{}{
+		"progress": toJsonFileInfoSlice(queue.Progress),
+		"queued":   toJsonFileInfoSlice(queue.Queued),
+		"skipped":  queue.Skipped,
+		"total":    queue.Total,
+		"page":     page,
+		"perpage":  perpage,
+	})
+}
+
+func (s *service) postDBQueueFront(w http.ResponseWriter, r *http.Request) {
+	qs := r.URL.Query()
+	folder := qs.Get("folder")
+	files := qs["file"]
+	// Bring the files to the front in reverse, so they end up in the given
+	// order.
+	for i := len(files) - 1; i >= 0; i-- {
+		s.model.BringToFront(folder, files[i])
+	}
+	s.getDBQueue(w, r)
+}
+
+func (s *service) postDBQueueBack(w http.ResponseWriter, r *http.Request) {
+	s.modifyDBQueue(w, r, s.model.PushToBack)
+}
+
+func (s *service) postDBQueueSkip(w http.ResponseWriter, r *http.Request) {
+	s.modifyDBQueue(w, r, s.model.SkipPull)
+}
+
+func (s *service) modifyDBQueue(w http.ResponseWriter, r *http.Request, fn func(folder, file string) error) {
+	qs := r.URL.Query()
+	folder := qs.Get("folder")
+	for _, file := range qs["file"] {
+		if err := fn(folder, file); err != nil {
+			status := http.StatusBadRequest
+			if isFolderNotFound(err) {
+				status = http.StatusNotFound
+			}
+			http.Error(w, err.Error(), status)
+			return
+		}
+	}
+	s.getDBQueue(w, r)
+}
+
+func (s *service) postDBPrioritize(w http.ResponseWriter, r *http.Request) {
+	qs := r.URL.Query()
+	folder := qs.Get("folder")
+	priority, err := strconv.Atoi(qs.Get("priority"))
+	if err != nil {
+		http.Error(w, "Invalid priority: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+	for _, pattern := range qs["pattern"] {
+		if err := s.model.SetPullPriority(folder, pattern, priority); err != nil {
+			status := http.StatusBadRequest
+			if isFolderNotFound(err) {
+				status = http.StatusNotFound
+			}
+			http.Error(w, err.Error(), status)
+			return
+		}
+	}
+	priorities, err := s.model.PullPriorities(folder)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+	sendJSON(w, priorities)
+}
+
 func (*service) getHealth(w http.ResponseWriter, _ *http.Request) {
 	sendJSON(w, map[string]string{"status": "OK"})
 }
@@ -1627,6 +2402,12 @@ func (*service) getQR(w http.ResponseWriter, r *http.Request) {
 	w.Write(code.PNG())
 }
 
+// getBlockSources returns the recent latency, throughput and error rate of
+// the requests to each device, and its weight as a source of blocks.
+func (s *service) getBlockSources(w http.ResponseWriter, _ *http.Request) {
+	sendJSON(w, s.model.BlockSourceStatistics())
+}
+
 func (s *service) getPeerCompletion(w http.ResponseWriter, _ *http.Request) {
 	tot := map[string]float64{}
 	count := map[string]float64{}
@@ -1691,6 +2472,75 @@ func (s *service) postFolderVersionsRestore(w http.ResponseWriter, r *http.Reque
 	sendJSON(w, errorStringMap(ferr))
 }
 
+// getFolderFreeze returns the frozen folders, or just the given one, which
+// is not found unless frozen.
+func (s *service) getFolderFreeze(w http.ResponseWriter, r *http.Request) {
+	freezes := s.model.FolderFreezes()
+	folder := r.URL.Query().Get("folder")
+	if folder == "" {
+		sendJSON(w, freezes)
+		return
+	}
+	for _, frz := range freezes {
+		if frz.Folder == folder && time.Now().Before(frz.Until) {
+			sendJSON(w, frz)
+			return
+		}
+	}
+	http.Error(w, model.ErrFolderNotFrozen.Error(), http.StatusNotFound)
+}
+
+// postFolderFreeze freezes a folder for the given reason, either until the
+// given time or for the given duration.
+func (s *service) postFolderFreeze(w http.ResponseWriter, r *http.Request) {
+	qs := r.URL.Query()
+
+	var until time.Time
+	switch {
+	case qs.Has("until") && qs.Has("duration"):
+		http.Error(w, "until and duration are mutually exclusive", http.StatusBadRequest)
+		return
+	case qs.Has("until"):
+		t, err := time.Parse(time.RFC3339, qs.Get("until"))
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		until = t
+	case qs.Has("duration"):
+		d, err := time.ParseDuration(qs.Get("duration"))
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		until = time.Now().Add(d).Truncate(time.Second)
+	default:
+		http.Error(w, "until or duration is required", http.StatusBadRequest)
+		return
+	}
+
+	frz, err := s.model.FreezeFolder(qs.Get("folder"), qs.Get("reason"), until)
+	if err != nil {
+		status := http.StatusBadRequest
+		if isFolderNotFound(err) {
+			status = http.StatusNotFound
+		}
+		http.Error(w, err.Error(), status)
+		return
+	}
+	sendJSON(w, frz)
+}
+
+func (s *service) deleteFolderFreeze(w http.ResponseWriter, r *http.Request) {
+	if err := s.model.UnfreezeFolder(r.URL.Query().Get("folder")); err != nil {
+		status := http.StatusInternalServerError
+		if errors.Is(err, model.ErrFolderNotFrozen) {
+			status = http.StatusNotFound
+		}
+		http.Error(w, err.Error(), status)
+	}
+}
+
 func (s *service) getFolderErrors(w http.ResponseWriter, r *http.Request) {
 	qs := r.URL.Query()
 	folder := qs.Get("folder")
@@ -1701,6 +2551,12 @@ func (s *service) getFolderErrors(w http.ResponseWriter, r *http.Request) {
 		http.Error(w, err.Error(), http.StatusNotFound)
 		return
 	}
+	digests, err := s.model.FolderErrorDigests(folder)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+	count := len(errors)
 
 	start := (page - 1) * perpage
 	if start >= len(errors) {
@@ -1715,6 +2571,8 @@ func (s *service) getFolderErrors(w http.ResponseWriter, r *http.Request) {
 	sendJSON(w, map[string]interface{}{
 		"folder":  folder,
 		"errors":  errors,
+		"count":   count,
+		"digests": digests,
 		"page":    page,
 		"perpage": perpage,
 	})
@@ -1857,6 +2715,85 @@ func toJsonFileInfoSlice(fs []db.FileInfoTruncated) []jsonFileInfoTrunc {
 
 // Type wrappers for nice JSON serialization
 
+// fileHistoryEntry is an entry in the timeline of a file, from the indexes,
+// the versioner or the disk events.
+type fileHistoryEntry struct {
+	Time           time.Time         `json:"time"`
+	Source         string            `json:"source"`
+	Device         string            `json:"device,omitempty"`
+	ModifiedBy     string            `json:"modifiedBy,omitempty"`
+	ModifiedByName string            `json:"modifiedByName,omitempty"`
+	Action         string            `json:"action,omitempty"`
+	Size           int64             `json:"size"`
+	Version        jsonVersionVector `json:"version"`
+}
+
+func (s *service) getDBFileHistory(w http.ResponseWriter, r *http.Request) {
+	qs := r.URL.Query()
+	folder := qs.Get("folder")
+	file := qs.Get("file")
+
+	known, err := s.model.FileHistory(folder, file)
+	if err != nil {
+		errStatus := http.StatusInternalServerError
+		if isFolderNotFound(err) {
+			errStatus = http.StatusNotFound
+		}
+		http.Error(w, err.Error(), errStatus)
+		return
+	}
+
+	names := make(map[string]string)
+	for id, dev := range s.cfg.Devices() {
+		names[id.Short().String()] = dev.Name
+	}
+
+	entries := make([]fileHistoryEntry, 0, len(known))
+	for _, e := range known {
+		entry := fileHistoryEntry{
+			Time:    e.Time,
+			Source:  e.Source,
+			Action:  "modified",
+			Size:    e.Size,
+			Version: jsonVersionVector(e.Version),
+		}
+		if e.Deleted {
+			entry.Action = "deleted"
+		}
+		if e.Device != protocol.EmptyDeviceID {
+			entry.Device = e.Device.String()
+		}
+		if e.ModifiedBy != 0 {
+			entry.ModifiedBy = e.ModifiedBy.String()
+			entry.ModifiedByName = names[entry.ModifiedBy]
+		}
+		entries = append(entries, entry)
+	}
+
+	// The disk events still in the buffer tell when the file changed
+	// locally or was pulled, and from whom.
+	nativeFile := filepath.FromSlash(file)
+	for _, ev := range s.getEventSub(DiskEventMask).Since(0, nil, 0) {
+		fields := eventFields(ev.Data)
+		if fields["folder"] != folder || fields["path"] != nativeFile {
+			continue
+		}
+		entry := fileHistoryEntry{
+			Time:   ev.Time,
+			Source: "event",
+		}
+		entry.ModifiedBy, _ = fields["modifiedBy"].(string)
+		entry.ModifiedByName = names[entry.ModifiedBy]
+		entry.Action, _ = fields["action"].(string)
+		entries = append(entries, entry)
+	}
+
+	slices.SortStableFunc(entries, func(a, b fileHistoryEntry) int {
+		return a.Time.Compare(b.Time)
+	})
+	sendJSON(w, entries)
+}
+
 type jsonFileInfo protocol.FileInfo
 
 func (f jsonFileInfo) MarshalJSON() ([]byte, error) {
@@ -0,0 +1,398 @@
+// Copyright (C) 2026 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package api
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net"
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/kballard/go-shellquote"
+	"golang.org/x/crypto/acme"
+
+	"github.com/syncthing/syncthing/lib/build"
+	"github.com/syncthing/syncthing/lib/config"
+	"github.com/syncthing/syncthing/lib/osutil"
+)
+
+const (
+	acmeChallengeHTTP01 = "http-01"
+	acmeChallengeDNS01  = "dns-01"
+
+	// Certificates are renewed when they expire in less than this, which
+	// is a third of the lifetime of those from Let's Encrypt.
+	acmeRenewBefore   = 30 * 24 * time.Hour
+	acmeRetryInterval = time.Hour
+	acmeOrderTimeout  = 10 * time.Minute
+
+	acmeAccountKeyFile  = "account-key.pem"
+	acmeCertificateFile = "certificate.pem" // the chain followed by the key
+)
+
+// acmeCertificates obtains and renews the certificate of the GUI listener
+// through ACME. Handshakes for its domains get it as soon as it's there,
+// without restarting the listener. Others, e.g. to localhost, and all of
+// them until the first one is obtained, get the self-signed certificate.
+type acmeCertificates struct {
+	cfg      config.ACMEConfiguration
+	dir      string
+	fallback *tls.Certificate
+	cert     atomic.Pointer[tls.Certificate]
+}
+
+func newACMECertificates(cfg config.ACMEConfiguration, dir string, fallback tls.Certificate) *acmeCertificates {
+	a := &acmeCertificates{
+		cfg:      cfg,
+		dir:      dir,
+		fallback: &fallback,
+	}
+	// A certificate from before is used if it's still for the configured
+	// domains.
+	path := filepath.Join(dir, acmeCertificateFile)
+	if cert, err := tls.LoadX509KeyPair(path, path); err == nil {
+		cert.Leaf, err = x509.ParseCertificate(cert.Certificate[0])
+		if err == nil && a.covers(cert.Leaf) {
+			a.cert.Store(&cert)
+		}
+	}
+	return a
+}
+
+// verifyACMEConfiguration returns why the configuration can't be used to
+// obtain a certificate, if it's enabled.
+func verifyACMEConfiguration(cfg config.ACMEConfiguration) error {
+	if !cfg.Enabled {
+		return nil
+	}
+	if len(cfg.Domains) == 0 {
+		return errors.New("ACME: no domains given")
+	}
+	switch cfg.Challenge {
+	case acmeChallengeHTTP01:
+		if _, err := net.ResolveTCPAddr("tcp", cfg.HTTPAddress); err != nil {
+			return fmt.Errorf("ACME: http-01 address: %w", err)
+		}
+	case acmeChallengeDNS01:
+		if cfg.DNSCommand == "" {
+			return errors.New("ACME: dns-01 requires a command to create the records")
+		}
+	default:
+		return fmt.Errorf("ACME: unsupported challenge %q", cfg.Challenge)
+	}
+	return nil
+}
+
+func (a *acmeCertificates) getCertificate(hello *tls.ClientHelloInfo) (*tls.Certificate, error) {
+	if cert := a.cert.Load(); cert != nil && hello.ServerName != "" && cert.Leaf.VerifyHostname(hello.ServerName) == nil {
+		return cert, nil
+	}
+	return a.fallback, nil
+}
+
+// covers returns whether the certificate is valid for all the configured
+// domains.
+func (a *acmeCertificates) covers(leaf *x509.Certificate) bool {
+	for _, domain := range a.cfg.Domains {
+		if leaf.VerifyHostname(domain) != nil {
+			return false
+		}
+	}
+	return true
+}
+
+// serve obtains a certificate when there is none and renews it before it
+// expires, until the context is cancelled.
+func (a *acmeCertificates) serve(ctx context.Context) {
+	for {
+		wait := a.renewIn(time.Now())
+		if wait <= 0 {
+			err := a.obtain(ctx)
+			if err == nil {
+				continue
+			}
+			if ctx.Err() != nil {
+				return
+			}
+			l.Warnf("Obtaining HTTPS certificate through ACME: %v (retrying in %v)", err, acmeRetryInterval)
+			wait = acmeRetryInterval
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+	}
+}
+
+// renewIn returns the time until the certificate should be renewed, which
+// is zero or less when there is none.
+func (a *acmeCertificates) renewIn(now time.Time) time.Duration {
+	cert := a.cert.Load()
+	if cert == nil {
+		return 0
+	}
+	return cert.Leaf.NotAfter.Add(-acmeRenewBefore).Sub(now)
+}
+
+// obtain orders a new certificate for the configured domains, and uses it
+// from then on.
+func (a *acmeCertificates) obtain(ctx context.Context) error {
+	ctx, cancel := context.WithTimeout(ctx, acmeOrderTimeout)
+	defer cancel()
+
+	accountKey, err := a.accountKey()
+	if err != nil {
+		return fmt.Errorf("account key: %w", err)
+	}
+	client := &acme.Client{
+		Key:          accountKey,
+		DirectoryURL: a.cfg.DirectoryURL,
+		UserAgent:    "syncthing/" + build.Version,
+	}
+	var contact []string
+	if a.cfg.Email != "" {
+		contact = []string{"mailto:" + a.cfg.Email}
+	}
+	if _, err := client.Register(ctx, &acme.Account{Contact: contact}, acme.AcceptTOS); err != nil && !errors.Is(err, acme.ErrAccountAlreadyExists) {
+		return fmt.Errorf("registering account: %w", err)
+	}
+
+	order, err := client.AuthorizeOrder(ctx, acme.DomainIDs(a.cfg.Domains...))
+	if err != nil {
+		return fmt.Errorf("creating order: %w", err)
+	}
+	for _, url := range order.AuthzURLs {
+		if err := a.authorize(ctx, client, url); err != nil {
+			return err
+		}
+	}
+	order, err = client.WaitOrder(ctx, order.URI)
+	if err != nil {
+		return fmt.Errorf("waiting for order: %w", err)
+	}
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return err
+	}
+	csr, err := x509.CreateCertificateRequest(rand.Reader, &x509.CertificateRequest{DNSNames: a.cfg.Domains}, key)
+	if err != nil {
+		return err
+	}
+	der, _, err := client.CreateOrderCert(ctx, order.FinalizeURL, csr, true)
+	if err != nil {
+		return fmt.Errorf("finalizing order: %w", err)
+	}
+	leaf, err := x509.ParseCertificate(der[0])
+	if err != nil {
+		return err
+	}
+	cert := &tls.Certificate{Certificate: der, PrivateKey: key, Leaf: leaf}
+	if err := a.save(cert); err != nil {
+		return fmt.Errorf("saving certificate: %w", err)
+	}
+	a.cert.Store(cert)
+	l.Infof("Obtained HTTPS certificate for %s through ACME, valid until %s", strings.Join(a.cfg.Domains, ", "), leaf.NotAfter.Format(time.RFC3339))
+	return nil
+}
+
+// authorize completes the configured challenge for an authorization of the
+// order, unless it's already valid.
+func (a *acmeCertificates) authorize(ctx context.Context, client *acme.Client, url string) error {
+	z, err := client.GetAuthorization(ctx, url)
+	if err != nil {
+		return fmt.Errorf("getting authorization: %w", err)
+	}
+	if z.Status == acme.StatusValid {
+		return nil
+	}
+	var chal *acme.Challenge
+	for _, c := range z.Challenges {
+		if c.Type == a.cfg.Challenge {
+			chal = c
+			break
+		}
+	}
+	if chal == nil {
+		return fmt.Errorf("no %s challenge offered for %s", a.cfg.Challenge, z.Identifier.Value)
+	}
+
+	switch a.cfg.Challenge {
+	case acmeChallengeHTTP01:
+		response, err := client.HTTP01ChallengeResponse(chal.Token)
+		if err != nil {
+			return err
+		}
+		stop, err := serveHTTP01Challenge(a.cfg.HTTPAddress, client.HTTP01ChallengePath(chal.Token), response)
+		if err != nil {
+			return fmt.Errorf("answering http-01 challenge: %w", err)
+		}
+		defer stop()
+
+	case acmeChallengeDNS01:
+		value, err := client.DNS01ChallengeRecord(chal.Token)
+		if err != nil {
+			return err
+		}
+		name := "_acme-challenge." + z.Identifier.Value
+		if err := a.runDNSCommand(ctx, "present", name, value); err != nil {
+			return fmt.Errorf("creating dns-01 record: %w", err)
+		}
+		defer func() {
+			if err := a.runDNSCommand(context.Background(), "cleanup", name, value); err != nil {
+				l.Warnln("Removing ACME dns-01 record:", err)
+			}
+		}()
+
+	default:
+		return fmt.Errorf("unsupported challenge %q", a.cfg.Challenge)
+	}
+
+	if _, err := client.Accept(ctx, chal); err != nil {
+		return fmt.Errorf("accepting %s challenge: %w", chal.Type, err)
+	}
+	if _, err := client.WaitAuthorization(ctx, z.URI); err != nil {
+		return fmt.Errorf("authorizing %s: %w", z.Identifier.Value, err)
+	}
+	return nil
+}
+
+// serveHTTP01Challenge answers requests for the path with the response,
+// until stopped.
+func serveHTTP01Challenge(addr, path, response string) (stop func(), err error) {
+	listener, err := net.Listen("tcp", addr)
+	if err != nil {
+		return nil, err
+	}
+	srv := &http.Server{
+		Handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path != path {
+				http.NotFound(w, r)
+				return
+			}
+			w.Header().Set("Content-Type", "text/plain")
+			io.WriteString(w, response)
+		}),
+		ReadHeaderTimeout: 10 * time.Second,
+		ErrorLog:          log.New(io.Discard, "", 0),
+	}
+	go srv.Serve(listener)
+	return func() { srv.Close() }, nil
+}
+
+// runDNSCommand runs the configured command with the arguments appended.
+func (a *acmeCertificates) runDNSCommand(ctx context.Context, args ...string) error {
+	words, err := shellquote.Split(a.cfg.DNSCommand)
+	if err != nil {
+		return fmt.Errorf("command is invalid: %w", err)
+	}
+	if len(words) == 0 {
+		return errors.New("command is empty")
+	}
+
+	cmd := exec.CommandContext(ctx, words[0], append(words[1:], args...)...)
+	// The command has no business with our credentials.
+	for _, env := range os.Environ() {
+		if !strings.HasPrefix(env, "STGUIAUTH=") && !strings.HasPrefix(env, "STGUIAPIKEY=") {
+			cmd.Env = append(cmd.Env, env)
+		}
+	}
+	out, err := cmd.CombinedOutput()
+	l.Debugln("ACME DNS command output:", string(out))
+	if err != nil {
+		if out = bytes.TrimSpace(out); len(out) > 0 {
+			return fmt.Errorf("%w: %s", err, out)
+		}
+		return err
+	}
+	return nil
+}
+
+// accountKey returns the key of our account with the certificate
+// authority, creating it the first time.
+func (a *acmeCertificates) accountKey() (*ecdsa.PrivateKey, error) {
+	path := filepath.Join(a.dir, acmeAccountKeyFile)
+	bs, err := os.ReadFile(path)
+	if err == nil {
+		block, _ := pem.Decode(bs)
+		if block == nil {
+			return nil, fmt.Errorf("%s: no key found", path)
+		}
+		return x509.ParseECPrivateKey(block.Bytes)
+	}
+	if !os.IsNotExist(err) {
+		return nil, err
+	}
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+	block, err := ecKeyPEM(key)
+	if err != nil {
+		return nil, err
+	}
+	if err := a.writeFile(acmeAccountKeyFile, block); err != nil {
+		return nil, err
+	}
+	return key, nil
+}
+
+func (a *acmeCertificates) save(cert *tls.Certificate) error {
+	var buf bytes.Buffer
+	for _, der := range cert.Certificate {
+		pem.Encode(&buf, &pem.Block{Type: "CERTIFICATE", Bytes: der})
+	}
+	block, err := ecKeyPEM(cert.PrivateKey.(*ecdsa.PrivateKey))
+	if err != nil {
+		return err
+	}
+	buf.Write(block)
+	return a.writeFile(acmeCertificateFile, buf.Bytes())
+}
+
+func (a *acmeCertificates) writeFile(name string, data []byte) error {
+	if err := os.MkdirAll(a.dir, 0o700); err != nil {
+		return err
+	}
+	fd, err := osutil.CreateAtomic(filepath.Join(a.dir, name))
+	if err != nil {
+		return err
+	}
+	if _, err := fd.Write(data); err != nil {
+		return err
+	}
+	return fd.Close()
+}
+
+func ecKeyPEM(key *ecdsa.PrivateKey) ([]byte, error) {
+	bs, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		return nil, err
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: bs}), nil
+}
@@ -0,0 +1,460 @@
+// Copyright (C) 2026 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package api
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"io"
+	"math/big"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"golang.org/x/crypto/acme"
+
+	"github.com/syncthing/syncthing/lib/build"
+	"github.com/syncthing/syncthing/lib/config"
+	"github.com/syncthing/syncthing/lib/tlsutil"
+)
+
+// fakeACMEServer is a certificate authority implementing just enough of RFC
+// 8555 for one account, without checking signatures. It validates http-01
+// challenges by fetching the response from httpAddress, and dns-01 ones
+// with validateDNS.
+type fakeACMEServer struct {
+	*httptest.Server
+	httpAddress string
+	validateDNS func(name, value string) bool
+
+	caKey  *ecdsa.PrivateKey
+	caCert *x509.Certificate
+
+	mut        sync.Mutex
+	accountKey crypto.PublicKey
+	authzs     []*fakeAuthz
+	orders     []*fakeOrder
+}
+
+type fakeAuthz struct {
+	domain, challenge, token string
+	valid                    bool
+}
+
+type fakeOrder struct {
+	authzs []int
+	cert   []byte
+}
+
+func newFakeACMEServer(t *testing.T) *fakeACMEServer {
+	t.Helper()
+	s := &fakeACMEServer{}
+	var err error
+	s.caKey, err = ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "fake CA"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, s.caKey.Public(), s.caKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s.caCert, _ = x509.ParseCertificate(der)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /directory", s.directory)
+	mux.HandleFunc("HEAD /nonce", func(http.ResponseWriter, *http.Request) {})
+	mux.HandleFunc("POST /account", s.newAccount)
+	mux.HandleFunc("POST /order", s.newOrder)
+	mux.HandleFunc("POST /order/{id}", s.getOrder)
+	mux.HandleFunc("POST /authz/{id}", s.getAuthz)
+	mux.HandleFunc("POST /challenge/{id}", s.accept)
+	mux.HandleFunc("POST /finalize/{id}", s.finalize)
+	mux.HandleFunc("POST /cert/{id}", s.getCert)
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Replay-Nonce", fmt.Sprint(time.Now().UnixNano()))
+		mux.ServeHTTP(w, r)
+	}))
+	t.Cleanup(s.Close)
+	return s
+}
+
+func (s *fakeACMEServer) directory(w http.ResponseWriter, _ *http.Request) {
+	json.NewEncoder(w).Encode(map[string]string{
+		"newNonce":   s.URL + "/nonce",
+		"newAccount": s.URL + "/account",
+		"newOrder":   s.URL + "/order",
+	})
+}
+
+// request returns the protected header and payload of the JWS.
+func (*fakeACMEServer) request(r *http.Request) (header, payload []byte) {
+	var jws struct {
+		Protected string `json:"protected"`
+		Payload   string `json:"payload"`
+	}
+	json.NewDecoder(r.Body).Decode(&jws)
+	header, _ = base64.RawURLEncoding.DecodeString(jws.Protected)
+	payload, _ = base64.RawURLEncoding.DecodeString(jws.Payload)
+	return header, payload
+}
+
+func (s *fakeACMEServer) newAccount(w http.ResponseWriter, r *http.Request) {
+	header, _ := s.request(r)
+	var protected struct {
+		JWK struct {
+			X string `json:"x"`
+			Y string `json:"y"`
+		} `json:"jwk"`
+	}
+	json.Unmarshal(header, &protected)
+	x, _ := base64.RawURLEncoding.DecodeString(protected.JWK.X)
+	y, _ := base64.RawURLEncoding.DecodeString(protected.JWK.Y)
+
+	s.mut.Lock()
+	defer s.mut.Unlock()
+	status := http.StatusOK // already exists
+	if s.accountKey == nil {
+		s.accountKey = &ecdsa.PublicKey{Curve: elliptic.P256(), X: new(big.Int).SetBytes(x), Y: new(big.Int).SetBytes(y)}
+		status = http.StatusCreated
+	}
+	w.Header().Set("Location", s.URL+"/account/1")
+	w.WriteHeader(status)
+	io.WriteString(w, `{"status":"valid"}`)
+}
+
+func (s *fakeACMEServer) newOrder(w http.ResponseWriter, r *http.Request) {
+	_, payload := s.request(r)
+	var req struct {
+		Identifiers []struct {
+			Value string `json:"value"`
+		} `json:"identifiers"`
+	}
+	json.Unmarshal(payload, &req)
+
+	s.mut.Lock()
+	order := &fakeOrder{}
+	for _, id := range req.Identifiers {
+		order.authzs = append(order.authzs, len(s.authzs))
+		s.authzs = append(s.authzs, &fakeAuthz{domain: id.Value, token: fmt.Sprintf("token%d", len(s.authzs))})
+	}
+	s.orders = append(s.orders, order)
+	id := len(s.orders) - 1
+	s.mut.Unlock()
+
+	w.Header().Set("Location", fmt.Sprintf("%s/order/%d", s.URL, id))
+	w.WriteHeader(http.StatusCreated)
+	s.writeOrder(w, id)
+}
+
+func (s *fakeACMEServer) getOrder(w http.ResponseWriter, r *http.Request) {
+	s.writeOrder(w, s.id(r))
+}
+
+func (s *fakeACMEServer) writeOrder(w io.Writer, id int) {
+	s.mut.Lock()
+	defer s.mut.Unlock()
+	order := s.orders[id]
+	status := "ready"
+	var urls []string
+	for _, a := range order.authzs {
+		urls = append(urls, fmt.Sprintf("%s/authz/%d", s.URL, a))
+		if !s.authzs[a].valid {
+			status = "pending"
+		}
+	}
+	res := map[string]any{
+		"status":         status,
+		"authorizations": urls,
+		"finalize":       fmt.Sprintf("%s/finalize/%d", s.URL, id),
+	}
+	if order.cert != nil {
+		res["status"] = "valid"
+		res["certificate"] = fmt.Sprintf("%s/cert/%d", s.URL, id)
+	}
+	json.NewEncoder(w).Encode(res)
+}
+
+func (s *fakeACMEServer) getAuthz(w http.ResponseWriter, r *http.Request) {
+	id := s.id(r)
+	s.mut.Lock()
+	defer s.mut.Unlock()
+	authz := s.authzs[id]
+	status := "pending"
+	if authz.valid {
+		status = "valid"
+	}
+	var challenges []map[string]string
+	for _, typ := range []string{acmeChallengeHTTP01, acmeChallengeDNS01} {
+		challenges = append(challenges, map[string]string{
+			"type":   typ,
+			"url":    fmt.Sprintf("%s/challenge/%d?type=%s", s.URL, id, typ),
+			"token":  authz.token,
+			"status": status,
+		})
+	}
+	json.NewEncoder(w).Encode(map[string]any{
+		"status":     status,
+		"identifier": map[string]string{"type": "dns", "value": authz.domain},
+		"challenges": challenges,
+	})
+}
+
+func (s *fakeACMEServer) accept(w http.ResponseWriter, r *http.Request) {
+	id := s.id(r)
+	typ := r.URL.Query().Get("type")
+	s.mut.Lock()
+	authz := s.authzs[id]
+	thumbprint, _ := acme.JWKThumbprint(s.accountKey)
+	s.mut.Unlock()
+	keyAuth := authz.token + "." + thumbprint
+
+	var valid bool
+	switch typ {
+	case acmeChallengeHTTP01:
+		resp, err := http.Get("http://" + s.httpAddress + "/.well-known/acme-challenge/" + authz.token)
+		if err == nil {
+			bs, _ := io.ReadAll(resp.Body)
+			resp.Body.Close()
+			valid = string(bs) == keyAuth
+		}
+	case acmeChallengeDNS01:
+		sum := sha256.Sum256([]byte(keyAuth))
+		valid = s.validateDNS("_acme-challenge."+authz.domain, base64.RawURLEncoding.EncodeToString(sum[:]))
+	}
+
+	s.mut.Lock()
+	authz.valid = valid
+	authz.challenge = typ
+	s.mut.Unlock()
+	status := "invalid"
+	if valid {
+		status = "valid"
+	}
+	json.NewEncoder(w).Encode(map[string]string{"type": typ, "status": status, "token": authz.token})
+}
+
+func (s *fakeACMEServer) finalize(w http.ResponseWriter, r *http.Request) {
+	id := s.id(r)
+	_, payload := s.request(r)
+	var req struct {
+		CSR string `json:"csr"`
+	}
+	json.Unmarshal(payload, &req)
+	der, _ := base64.RawURLEncoding.DecodeString(req.CSR)
+	csr, err := x509.ParseCertificateRequest(der)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(int64(id) + 2),
+		DNSNames:     csr.DNSNames,
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(90 * 24 * time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}
+	cert, err := x509.CreateCertificate(rand.Reader, tmpl, s.caCert, csr.PublicKey, s.caKey)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	s.mut.Lock()
+	s.orders[id].cert = append(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: cert}), pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: s.caCert.Raw})...)
+	s.mut.Unlock()
+	s.writeOrder(w, id)
+}
+
+func (s *fakeACMEServer) getCert(w http.ResponseWriter, r *http.Request) {
+	id := s.id(r)
+	s.mut.Lock()
+	defer s.mut.Unlock()
+	w.Header().Set("Content-Type", "application/pem-certificate-chain")
+	w.Write(s.orders[id].cert)
+}
+
+func (*fakeACMEServer) id(r *http.Request) int {
+	var id int
+	fmt.Sscan(r.PathValue("id"), &id)
+	return id
+}
+
+// freeAddress returns a local address that nothing listens on.
+func freeAddress(t *testing.T) string {
+	t.Helper()
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer l.Close()
+	return l.Addr().String()
+}
+
+func TestACMECertificatesHTTP01(t *testing.T) {
+	t.Parallel()
+
+	ca := newFakeACMEServer(t)
+	ca.httpAddress = freeAddress(t)
+	cfg := config.ACMEConfiguration{
+		Enabled:      true,
+		Domains:      []string{"syncthing.test", "www.syncthing.test"},
+		DirectoryURL: ca.URL + "/directory",
+		Challenge:    acmeChallengeHTTP01,
+		HTTPAddress:  ca.httpAddress,
+	}
+	fallback, err := tlsutil.NewCertificateInMemory("syncthing", 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	dir := t.TempDir()
+
+	certs := newACMECertificates(cfg, dir, fallback)
+	if certs.renewIn(time.Now()) > 0 {
+		t.Fatal("expected a certificate to be needed")
+	}
+	if cert, _ := certs.getCertificate(&tls.ClientHelloInfo{ServerName: "syncthing.test"}); cert != certs.fallback {
+		t.Fatal("expected the self-signed certificate before obtaining one")
+	}
+
+	if err := certs.obtain(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	for _, name := range cfg.Domains {
+		cert, _ := certs.getCertificate(&tls.ClientHelloInfo{ServerName: name})
+		if cert == certs.fallback || cert.Leaf.VerifyHostname(name) != nil || len(cert.Certificate) != 2 {
+			t.Errorf("expected the obtained certificate with its chain for %s", name)
+		}
+	}
+	for _, name := range []string{"localhost", ""} {
+		if cert, _ := certs.getCertificate(&tls.ClientHelloInfo{ServerName: name}); cert != certs.fallback {
+			t.Errorf("expected the self-signed certificate for %q", name)
+		}
+	}
+	if d := certs.renewIn(time.Now()); d < 59*24*time.Hour || d > 60*24*time.Hour {
+		t.Errorf("unexpected renewal in %v", d)
+	}
+
+	// The certificate is used again after a restart, unless the domains
+	// changed.
+	if newACMECertificates(cfg, dir, fallback).cert.Load() == nil {
+		t.Error("expected the saved certificate to be loaded")
+	}
+	cfg.Domains = append(cfg.Domains, "other.syncthing.test")
+	if newACMECertificates(cfg, dir, fallback).cert.Load() != nil {
+		t.Error("expected the saved certificate not to be used for other domains")
+	}
+
+	// A renewal uses the same account, and the challenge listener is gone
+	// after each authorization.
+	certs = newACMECertificates(cfg, dir, fallback)
+	if err := certs.obtain(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if ca.accountKey == nil || len(ca.orders) != 2 {
+		t.Errorf("unexpected CA state: %d orders", len(ca.orders))
+	}
+	l, err := net.Listen("tcp", ca.httpAddress)
+	if err != nil {
+		t.Fatal("challenge listener still open:", err)
+	}
+	l.Close()
+}
+
+func TestACMECertificatesDNS01(t *testing.T) {
+	t.Parallel()
+
+	if build.IsWindows {
+		t.Skip("the DNS command is a shell script")
+	}
+
+	dir := t.TempDir()
+	logFile := filepath.Join(dir, "records")
+	ca := newFakeACMEServer(t)
+	ca.validateDNS = func(name, value string) bool {
+		bs, _ := os.ReadFile(logFile)
+		return strings.HasSuffix(string(bs), fmt.Sprintf("present %s %s\n", name, value))
+	}
+	cfg := config.ACMEConfiguration{
+		Enabled:      true,
+		Domains:      []string{"syncthing.test"},
+		DirectoryURL: ca.URL + "/directory",
+		Challenge:    acmeChallengeDNS01,
+		DNSCommand:   fmt.Sprintf(`sh -c 'echo "$1 $2 $3" >> %s' sh`, logFile),
+	}
+	fallback, err := tlsutil.NewCertificateInMemory("syncthing", 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	certs := newACMECertificates(cfg, dir, fallback)
+	if err := certs.obtain(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if cert, _ := certs.getCertificate(&tls.ClientHelloInfo{ServerName: "syncthing.test"}); cert == certs.fallback {
+		t.Error("expected the obtained certificate")
+	}
+	bs, _ := os.ReadFile(logFile)
+	if lines := strings.Split(strings.TrimSpace(string(bs)), "\n"); len(lines) != 2 || !strings.HasPrefix(lines[1], "cleanup _acme-challenge.syncthing.test ") {
+		t.Errorf("expected the record to be created and removed, got %q", lines)
+	}
+
+	// A failing command fails the authorization, with its output.
+	cfg.DNSCommand = `sh -c 'echo no credentials; exit 1' sh`
+	err = newACMECertificates(cfg, dir, fallback).obtain(context.Background())
+	if err == nil || !strings.Contains(err.Error(), "no credentials") {
+		t.Errorf("expected the command to fail, got %v", err)
+	}
+}
+
+func TestVerifyACMEConfiguration(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		cfg config.ACMEConfiguration
+		ok  bool
+	}{
+		{config.ACMEConfiguration{}, true},
+		{config.ACMEConfiguration{Enabled: true, Challenge: acmeChallengeHTTP01, HTTPAddress: ":80"}, false},
+		{config.ACMEConfiguration{Enabled: true, Domains: []string{"a.test"}, Challenge: acmeChallengeHTTP01, HTTPAddress: ":80"}, true},
+		{config.ACMEConfiguration{Enabled: true, Domains: []string{"a.test"}, Challenge: acmeChallengeHTTP01, HTTPAddress: "nope"}, false},
+		{config.ACMEConfiguration{Enabled: true, Domains: []string{"a.test"}, Challenge: acmeChallengeDNS01}, false},
+		{config.ACMEConfiguration{Enabled: true, Domains: []string{"a.test"}, Challenge: acmeChallengeDNS01, DNSCommand: "hook"}, true},
+		{config.ACMEConfiguration{Enabled: true, Domains: []string{"a.test"}, Challenge: "tls-alpn-01"}, false},
+	}
+	for i, tc := range cases {
+		if err := verifyACMEConfiguration(tc.cfg); (err == nil) != tc.ok {
+			t.Errorf("case %d: unexpected result %v", i, err)
+		}
+	}
+}
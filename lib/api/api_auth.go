@@ -7,11 +7,14 @@
 package api
 
 import (
+	"crypto/sha256"
 	"crypto/tls"
+	"encoding/hex"
 	"fmt"
 	"net"
 	"net/http"
 	"slices"
+	"strconv"
 	"strings"
 	"time"
 
@@ -97,21 +100,43 @@ func newBasicAuthAndSessionMiddleware(tokenCookieManager *tokenCookieManager, gu
 }
 
 func (m *basicAuthAndSessionMiddleware) ServeHTTP(w http.ResponseWriter, r *http.Request) {
-	if hasValidAPIKeyHeader(r, m.guiCfg) {
+	if hasValidAPIKeyHeader(r, m.guiCfg) || hasValidPendingAPIKeyHeader(r, m.guiCfg) {
 		m.next.ServeHTTP(w, r)
 		return
 	}
 
-	if m.tokenCookieManager.hasValidSession(r) {
+	// Scoped API keys are checked further by the CSRF manager.
+	if _, ok := scopedAPIKeyFromHeader(r, m.guiCfg); ok {
 		m.next.ServeHTTP(w, r)
 		return
 	}
 
+	if user, ok := clientCertUser(r); ok {
+		m.serveAs(user, w, r)
+		return
+	}
+
+	if token, username, ok := m.tokenCookieManager.validSession(r); ok {
+		// The user may have been removed since the session was created.
+		if user, ok := m.userFor(username); ok {
+			if r.Header.Get("Authorization") == "" {
+				// Bind the CSRF token to the session. Clients sending
+				// credentials with every request needn't keep the
+				// session cookie, and use the shared CSRF tokens.
+				r = withSession(r, token)
+			}
+			m.serveAs(user, w, r)
+			return
+		}
+	}
+
 	// Fall back to Basic auth if provided
 	if username, ok := attemptBasicAuth(r, m.guiCfg, m.ldapCfg, m.evLogger); ok {
 		m.tokenCookieManager.createSession(username, false, w, r)
-		m.next.ServeHTTP(w, r)
-		return
+		if user, ok := m.userFor(username); ok {
+			m.serveAs(user, w, r)
+			return
+		}
 	}
 
 	// Exception for static assets and REST calls that don't require authentication.
@@ -130,6 +155,30 @@ func (m *basicAuthAndSessionMiddleware) ServeHTTP(w http.ResponseWriter, r *http
 	forbidden(w)
 }
 
+// userFor returns the configured user with the given name. Everyone else
+// who can log in, i.e. the original user, those authenticated by LDAP and
+// members of the allowed OpenID Connect groups, is an admin. So are
+// sessions from before the user was recorded.
+func (m *basicAuthAndSessionMiddleware) userFor(username string) (config.GUIUser, bool) {
+	if user, ok := m.guiCfg.UserByName(username); ok {
+		return user, true
+	}
+	oidcGroups := m.guiCfg.AuthMode == config.AuthModeOIDC && len(m.guiCfg.OIDC.AllowedGroups) > 0
+	if username == "" || username == m.guiCfg.User || m.guiCfg.AuthMode == config.AuthModeLDAP || oidcGroups {
+		return config.GUIUser{Name: username, Role: config.UserRoleAdmin}, true
+	}
+	return config.GUIUser{}, false
+}
+
+func (m *basicAuthAndSessionMiddleware) serveAs(user config.GUIUser, w http.ResponseWriter, r *http.Request) {
+	if !roleAllows(user, r) {
+		l.Debugf("Denying %s %s to user %q with role %v", r.Method, r.URL.Path, user.Name, user.Role)
+		forbidden(w)
+		return
+	}
+	m.next.ServeHTTP(w, withUser(r, user))
+}
+
 func (m *basicAuthAndSessionMiddleware) passwordAuthHandler(w http.ResponseWriter, r *http.Request) {
 	var req struct {
 		Username     string
@@ -143,7 +192,7 @@ func (m *basicAuthAndSessionMiddleware) passwordAuthHandler(w http.ResponseWrite
 	}
 
 	if auth(req.Username, req.Password, m.guiCfg, m.ldapCfg) {
-		m.tokenCookieManager.createSession(req.Username, req.StayLoggedIn, w, r)
+		m.tokenCookieManager.login(req.Username, req.StayLoggedIn, w, r)
 		w.WriteHeader(http.StatusNoContent)
 		return
 	}
@@ -181,6 +230,66 @@ func (m *basicAuthAndSessionMiddleware) handleLogout(w http.ResponseWriter, r *h
 	w.WriteHeader(http.StatusNoContent)
 }
 
+// getSessions lists the active sessions. The tokens themselves are not
+// shown, only an ID derived from them.
+func (m *basicAuthAndSessionMiddleware) getSessions(w http.ResponseWriter, r *http.Request) {
+	current := m.tokenCookieManager.currentTokens(r)
+	type session struct {
+		ID      string    `json:"id"`
+		User    string    `json:"user"`
+		Expires time.Time `json:"expires"`
+		Current bool      `json:"current"`
+	}
+	sessions := []session{}
+	for _, info := range m.tokenCookieManager.tokens.List() {
+		sessions = append(sessions, session{
+			ID:      sessionID(info.Token),
+			User:    info.User,
+			Expires: info.Expires,
+			Current: slices.Contains(current, info.Token),
+		})
+	}
+	sendJSON(w, sessions)
+}
+
+// deleteSessions ends the session with the given ID, or all sessions of
+// the given user, or all other sessions of the current user.
+func (m *basicAuthAndSessionMiddleware) deleteSessions(w http.ResponseWriter, r *http.Request) {
+	qs := r.URL.Query()
+	if others, _ := strconv.ParseBool(qs.Get("others")); others {
+		m.deleteOtherSessions(w, r)
+		return
+	}
+	id, user := qs.Get("id"), qs.Get("user")
+	if id == "" && user == "" {
+		http.Error(w, "id or user is required", http.StatusBadRequest)
+		return
+	}
+	removed := m.tokenCookieManager.tokens.DeleteFunc(func(token, tokenUser string) bool {
+		return (id != "" && sessionID(token) == id) || (user != "" && tokenUser == user)
+	})
+	sendJSON(w, map[string]int{"removed": removed})
+}
+
+// deleteOtherSessions ends the sessions of the current user, except the
+// current one, to log out everywhere else.
+func (m *basicAuthAndSessionMiddleware) deleteOtherSessions(w http.ResponseWriter, r *http.Request) {
+	current, user, ok := m.tokenCookieManager.validSession(r)
+	if !ok {
+		http.Error(w, "no current session", http.StatusBadRequest)
+		return
+	}
+	removed := m.tokenCookieManager.tokens.DeleteFunc(func(token, tokenUser string) bool {
+		return token != current && tokenUser == user
+	})
+	sendJSON(w, map[string]int{"removed": removed})
+}
+
+func sessionID(token string) string {
+	hash := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(hash[:8])
+}
+
 func auth(username string, password string, guiCfg config.GUIConfiguration, ldapCfg config.LDAPConfiguration) bool {
 	if guiCfg.AuthMode == config.AuthModeLDAP {
 		return authLDAP(username, password, ldapCfg)
@@ -190,61 +299,124 @@ func auth(username string, password string, guiCfg config.GUIConfiguration, ldap
 }
 
 func authStatic(username string, password string, guiCfg config.GUIConfiguration) bool {
-	return guiCfg.CompareHashedPassword(password) == nil && username == guiCfg.User
+	if guiCfg.CompareHashedPassword(password) == nil && username == guiCfg.User {
+		return true
+	}
+	user, ok := guiCfg.UserByName(username)
+	return ok && user.CompareHashedPassword(password) == nil
 }
 
 func authLDAP(username string, password string, cfg config.LDAPConfiguration) bool {
+	connection, err := dialLDAP(cfg)
+	if err != nil {
+		return false
+	}
+	defer connection.Close()
+	return ldapAuthenticate(connection, username, password, cfg)
+}
+
+func dialLDAP(cfg config.LDAPConfiguration) (*ldap.Conn, error) {
 	address := cfg.Address
 	hostname, _, err := net.SplitHostPort(address)
 	if err != nil {
 		hostname = address
 	}
+	tlsCfg := &tls.Config{
+		ServerName:         hostname,
+		InsecureSkipVerify: cfg.InsecureSkipVerify,
+	}
 	var connection *ldap.Conn
 	if cfg.Transport == config.LDAPTransportTLS {
-		connection, err = ldap.DialTLS("tcp", address, &tls.Config{
-			ServerName:         hostname,
-			InsecureSkipVerify: cfg.InsecureSkipVerify,
-		})
+		connection, err = ldap.DialTLS("tcp", address, tlsCfg)
 	} else {
 		connection, err = ldap.Dial("tcp", address)
 	}
 
 	if err != nil {
 		l.Warnln("LDAP Dial:", err)
-		return false
+		return nil, err
 	}
 
 	if cfg.Transport == config.LDAPTransportStartTLS {
-		err = connection.StartTLS(&tls.Config{InsecureSkipVerify: cfg.InsecureSkipVerify})
+		err = connection.StartTLS(tlsCfg)
 		if err != nil {
 			l.Warnln("LDAP Start TLS:", err)
-			return false
+			connection.Close()
+			return nil, err
 		}
 	}
 
-	defer connection.Close()
+	return connection, nil
+}
+
+// ldapClient is the part of an LDAP connection used for authentication.
+type ldapClient interface {
+	Bind(username, password string) error
+	Search(searchRequest *ldap.SearchRequest) (*ldap.SearchResult, error)
+}
+
+func ldapAuthenticate(connection ldapClient, username string, password string, cfg config.LDAPConfiguration) bool {
+	userDN, ok := ldapBindUser(connection, username, password, cfg)
+	if !ok {
+		return false
+	}
+	if cfg.RequiredGroupDN == "" {
+		return true
+	}
+	return ldapIsGroupMember(connection, userDN, username, cfg)
+}
+
+// ldapBindUser binds as the user, either by the bind DN pattern or by the
+// DN found when searching for the user as the service account, and returns
+// the user's DN.
+func ldapBindUser(connection ldapClient, username string, password string, cfg config.LDAPConfiguration) (string, bool) {
+	if cfg.ServiceBindDN != "" {
+		if cfg.SearchFilter == "" || cfg.SearchBaseDN == "" {
+			l.Warnln("LDAP configuration: searchFilter and searchBaseDN must be set with a serviceBindDN.")
+			return "", false
+		}
+		if err := connection.Bind(cfg.ServiceBindDN, cfg.ServiceBindPassword); err != nil {
+			l.Warnln("LDAP Bind as service account:", err)
+			return "", false
+		}
+		userDN, ok := ldapSearchUser(connection, username, cfg)
+		if !ok {
+			return "", false
+		}
+		if password == "" {
+			// Would be an unauthenticated bind, which always succeeds.
+			return "", false
+		}
+		if err := connection.Bind(userDN, password); err != nil {
+			l.Warnln("LDAP Bind:", err)
+			return "", false
+		}
+		return userDN, true
+	}
 
 	bindDN := formatOptionalPercentS(cfg.BindDN, escapeForLDAPDN(username))
-	err = connection.Bind(bindDN, password)
+	err := connection.Bind(bindDN, password)
 	if err != nil {
 		l.Warnln("LDAP Bind:", err)
-		return false
+		return "", false
 	}
 
 	if cfg.SearchFilter == "" && cfg.SearchBaseDN == "" {
 		// We're done here.
-		return true
+		return bindDN, true
 	}
 
 	if cfg.SearchFilter == "" || cfg.SearchBaseDN == "" {
 		l.Warnln("LDAP configuration: both searchFilter and searchBaseDN must be set, or neither.")
-		return false
+		return "", false
 	}
 
-	// If a search filter and search base is set we do an LDAP search for
-	// the user. If this matches precisely one user then we are good to go.
-	// The search filter uses the same %s interpolation as the bind DN.
+	return ldapSearchUser(connection, username, cfg)
+}
 
+// ldapSearchUser returns the DN of the one user matching the search
+// filter. The search filter uses the same %s interpolation as the bind DN.
+func ldapSearchUser(connection ldapClient, username string, cfg config.LDAPConfiguration) (string, bool) {
 	searchString := formatOptionalPercentS(cfg.SearchFilter, escapeForLDAPFilter(username))
 	const sizeLimit = 2  // we search for up to two users -- we only want to match one, so getting any number >1 is a failure.
 	const timeLimit = 60 // Search for up to a minute...
@@ -253,10 +425,41 @@ func authLDAP(username string, password string, cfg config.LDAPConfiguration) bo
 	res, err := connection.Search(searchReq)
 	if err != nil {
 		l.Warnln("LDAP Search:", err)
-		return false
+		return "", false
 	}
 	if len(res.Entries) != 1 {
 		l.Infof("Wrong number of LDAP search results, %d != 1", len(res.Entries))
+		return "", false
+	}
+
+	return res.Entries[0].DN, true
+}
+
+// ldapIsGroupMember returns whether the user is a member of the required
+// group, listed by DN as in groupOfNames and groupOfUniqueNames, or by
+// name as in posixGroup.
+func ldapIsGroupMember(connection ldapClient, userDN string, username string, cfg config.LDAPConfiguration) bool {
+	if cfg.ServiceBindDN != "" {
+		// The user may not be allowed to read the group.
+		if err := connection.Bind(cfg.ServiceBindDN, cfg.ServiceBindPassword); err != nil {
+			l.Warnln("LDAP Bind as service account:", err)
+			return false
+		}
+	}
+
+	dn := escapeForLDAPFilter(userDN)
+	filter := fmt.Sprintf("(|(member=%s)(uniqueMember=%s)(memberUid=%s))", dn, dn, escapeForLDAPFilter(username))
+	const sizeLimit = 1
+	const timeLimit = 60
+	searchReq := ldap.NewSearchRequest(cfg.RequiredGroupDN, ldap.ScopeBaseObject, ldap.NeverDerefAliases, sizeLimit, timeLimit, false, filter, []string{"dn"}, nil)
+
+	res, err := connection.Search(searchReq)
+	if err != nil {
+		l.Warnln("LDAP Search for group membership:", err)
+		return false
+	}
+	if len(res.Entries) != 1 {
+		l.Infof("LDAP user %q is not a member of %s", username, cfg.RequiredGroupDN)
 		return false
 	}
 
@@ -7,9 +7,27 @@
 package api
 
 import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
 	"testing"
 	"time"
 
+	ldap "github.com/go-ldap/ldap/v3"
 	"github.com/syncthing/syncthing/lib/config"
 	"github.com/syncthing/syncthing/lib/db"
 	"github.com/syncthing/syncthing/lib/db/backend"
@@ -187,3 +205,495 @@ func TestTokenManager(t *testing.T) {
 		t.Errorf("token %q should be invalid", t3)
 	}
 }
+
+func TestCSRFNonces(t *testing.T) {
+	t.Parallel()
+
+	mdb, _ := db.NewLowlevel(backend.OpenMemory(), events.NoopLogger)
+	kdb := db.NewNamespacedKV(mdb, "test")
+	clock := &mockClock{now: time.Now()}
+
+	nonces := newCSRFNonces(kdb)
+	nonces.timeNow = clock.Now
+
+	token := nonces.Current("session1")
+	if !nonces.Check("session1", token) {
+		t.Error("the current token should be valid")
+	}
+	if nonces.Check("session2", token) {
+		t.Error("the token should only be valid for its session")
+	}
+
+	// The token rotates, and the previous one is still valid for a while.
+	clock.wind(csrfNonceRotation)
+	if nonces.Current("session1") == token {
+		t.Error("the token should have rotated")
+	}
+	if !nonces.Check("session1", token) {
+		t.Error("the previous token should be valid")
+	}
+	clock.wind(csrfNonceRotation)
+	if nonces.Check("session1", token) {
+		t.Error("the token should have expired")
+	}
+
+	// The key is kept, so tokens survive restarts.
+	restarted := newCSRFNonces(kdb)
+	restarted.timeNow = clock.Now
+	if restarted.Current("session1") != nonces.Current("session1") {
+		t.Error("the token should be the same after a restart")
+	}
+}
+
+func TestTokenManagerUsers(t *testing.T) {
+	t.Parallel()
+
+	mdb, _ := db.NewLowlevel(backend.OpenMemory(), events.NoopLogger)
+	kdb := db.NewNamespacedKV(mdb, "test")
+
+	tm := newTokenManager("testTokens", kdb, 24*time.Hour, 2)
+
+	t0 := tm.NewForUser("alice")
+	t1 := tm.NewForUser("bob")
+	t2 := tm.New()
+
+	// The oldest token was removed along with its user
+	if tm.Check(t0) || tm.User(t0) != "" {
+		t.Errorf("token %q should be gone", t0)
+	}
+	if user := tm.User(t1); user != "bob" {
+		t.Errorf("token %q should belong to bob, not %q", t1, user)
+	}
+	if user := tm.User(t2); user != "" {
+		t.Errorf("token %q should have no user, not %q", t2, user)
+	}
+	if l := len(tm.List()); l != 2 {
+		t.Errorf("expected two tokens, got %d", l)
+	}
+
+	if n := tm.DeleteFunc(func(_, user string) bool { return user == "bob" }); n != 1 {
+		t.Errorf("expected one removed token, got %d", n)
+	}
+	if tm.Check(t1) {
+		t.Errorf("token %q should be invalid", t1)
+	}
+	if !tm.Check(t2) {
+		t.Errorf("token %q should be valid", t2)
+	}
+}
+
+func TestOIDCLogin(t *testing.T) {
+	t.Parallel()
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	b64 := base64.RawURLEncoding.EncodeToString
+	sign := func(claims map[string]any) string {
+		header, _ := json.Marshal(map[string]string{"alg": "RS256", "kid": "k1"})
+		payload, _ := json.Marshal(claims)
+		signed := b64(header) + "." + b64(payload)
+		digest := sha256.Sum256([]byte(signed))
+		sig, err := rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, digest[:])
+		if err != nil {
+			t.Fatal(err)
+		}
+		return signed + "." + b64(sig)
+	}
+
+	// A provider that hands out a token for whatever nonce and code
+	// challenge the last login used, to the user in the groups below.
+	var issuer, nonce, challenge string
+	groups := []string{"staff"}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, _ *http.Request) {
+		json.NewEncoder(w).Encode(map[string]string{
+			"issuer":                 issuer,
+			"authorization_endpoint": issuer + "/auth",
+			"token_endpoint":         issuer + "/token",
+			"jwks_uri":               issuer + "/keys",
+		})
+	})
+	mux.HandleFunc("/keys", func(w http.ResponseWriter, _ *http.Request) {
+		json.NewEncoder(w).Encode(map[string]any{"keys": []map[string]string{{
+			"kty": "RSA",
+			"kid": "k1",
+			"use": "sig",
+			"n":   b64(key.N.Bytes()),
+			"e":   b64(big.NewInt(int64(key.E)).Bytes()),
+		}}})
+	})
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		id, secret, _ := r.BasicAuth()
+		verifier := sha256.Sum256([]byte(r.FormValue("code_verifier")))
+		if id != "syncthing" || secret != "s3cret" || r.FormValue("code") != "code" || b64(verifier[:]) != challenge {
+			http.Error(w, "bad request", http.StatusBadRequest)
+			return
+		}
+		json.NewEncoder(w).Encode(map[string]string{"id_token": sign(map[string]any{
+			"iss":                issuer,
+			"aud":                "syncthing",
+			"exp":                time.Now().Add(time.Minute).Unix(),
+			"nonce":              nonce,
+			"preferred_username": "alice",
+			"groups":             groups,
+		})})
+	})
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+	issuer = srv.URL
+
+	mdb, _ := db.NewLowlevel(backend.OpenMemory(), events.NoopLogger)
+	tcm := newTokenCookieManager("test", config.GUIConfiguration{}, events.NoopLogger, db.NewNamespacedKV(mdb, "test"), nil)
+	oidc := newOIDCLogin(config.GUIConfiguration{
+		AuthMode: config.AuthModeOIDC,
+		OIDC: config.OIDCConfiguration{
+			IssuerURL:     issuer,
+			ClientID:      "syncthing",
+			ClientSecret:  "s3cret",
+			AllowedGroups: []string{"admins", "staff"},
+			GroupsClaim:   "groups",
+			UsernameClaim: "preferred_username",
+		},
+	}, tcm, events.NoopLogger)
+
+	login := func() (string, *http.Cookie) {
+		t.Helper()
+		rec := httptest.NewRecorder()
+		oidc.login(rec, httptest.NewRequest(http.MethodGet, "http://gui.example/rest/noauth/auth/oidc", nil))
+		if rec.Code != http.StatusFound {
+			t.Fatalf("expected a redirect to the provider, got %d", rec.Code)
+		}
+		loc, err := url.Parse(rec.Header().Get("Location"))
+		if err != nil || !strings.HasPrefix(loc.String(), issuer+"/auth?") {
+			t.Fatalf("unexpected redirect to %v", loc)
+		}
+		qs := loc.Query()
+		if qs.Get("redirect_uri") != "http://gui.example"+oidcCallbackPath || qs.Get("client_id") != "syncthing" {
+			t.Errorf("unexpected authorization request %v", qs)
+		}
+		nonce, challenge = qs.Get("nonce"), qs.Get("code_challenge")
+		return qs.Get("state"), rec.Result().Cookies()[0]
+	}
+	callback := func(state string, cookie *http.Cookie) *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodGet, "http://gui.example"+oidcCallbackPath+"?code=code&state="+state, nil)
+		if cookie != nil {
+			req.AddCookie(cookie)
+		}
+		oidc.callback(rec, req)
+		return rec
+	}
+
+	// A complete login results in a session for the user.
+	state, cookie := login()
+	rec := callback(state, cookie)
+	if rec.Code != http.StatusFound {
+		t.Fatalf("expected login to succeed, got %d", rec.Code)
+	}
+	var session string
+	for _, c := range rec.Result().Cookies() {
+		if c.Name == tcm.cookieName {
+			session = c.Value
+		}
+	}
+	if user := tcm.tokens.User(session); user != "alice" {
+		t.Errorf("expected a session for alice, got %q", user)
+	}
+
+	// The state is only good once.
+	if rec := callback(state, cookie); rec.Code != http.StatusForbidden {
+		t.Errorf("expected a replayed callback to be refused, got %d", rec.Code)
+	}
+
+	// The callback must come back to the browser that started the login.
+	state, _ = login()
+	if rec := callback(state, nil); rec.Code != http.StatusForbidden {
+		t.Errorf("expected a callback without the state cookie to be refused, got %d", rec.Code)
+	}
+
+	// Users outside the allowed groups can't log in.
+	groups = []string{"guests"}
+	state, cookie = login()
+	if rec := callback(state, cookie); rec.Code != http.StatusForbidden {
+		t.Errorf("expected a user outside the allowed groups to be refused, got %d", rec.Code)
+	}
+
+	// Without allowed groups, having an account at the provider isn't
+	// enough; only configured users can log in.
+	oidc.cfg.AllowedGroups = nil
+	groups = []string{"staff"}
+	state, cookie = login()
+	if rec := callback(state, cookie); rec.Code != http.StatusForbidden {
+		t.Errorf("expected an unknown user to be refused without allowed groups, got %d", rec.Code)
+	}
+	oidc.guiCfg.Users = []config.GUIUser{{Name: "alice", Role: config.UserRoleReadOnly}}
+	state, cookie = login()
+	if rec := callback(state, cookie); rec.Code != http.StatusFound {
+		t.Errorf("expected a configured user to log in, got %d", rec.Code)
+	}
+}
+
+func TestOIDCUserRoles(t *testing.T) {
+	t.Parallel()
+
+	guiCfg := config.GUIConfiguration{
+		AuthMode: config.AuthModeOIDC,
+		Users:    []config.GUIUser{{Name: "alice", Role: config.UserRoleReadOnly}},
+	}
+	m := &basicAuthAndSessionMiddleware{guiCfg: guiCfg}
+	if user, ok := m.userFor("alice"); !ok || user.Role != config.UserRoleReadOnly {
+		t.Errorf("expected alice to be read only, got %v, %v", user, ok)
+	}
+	if _, ok := m.userFor("mallory"); ok {
+		t.Error("expected an unknown user to be refused without allowed groups")
+	}
+
+	m.guiCfg.OIDC.AllowedGroups = []string{"admins"}
+	if user, ok := m.userFor("mallory"); !ok || user.Role != config.UserRoleAdmin {
+		t.Errorf("expected a group member to be an admin, got %v, %v", user, ok)
+	}
+}
+
+func TestOIDCClaims(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	claims := oidcClaims{
+		"iss":   "https://issuer",
+		"aud":   []any{"other", "syncthing"},
+		"exp":   float64(now.Add(time.Minute).Unix()),
+		"nonce": "n",
+		"sub":   "1234",
+	}
+	if err := claims.validate("https://issuer", "syncthing", "n", now); err != nil {
+		t.Error(err)
+	}
+	if name := claims.username("preferred_username"); name != "1234" {
+		t.Errorf("expected the subject as the user name, got %q", name)
+	}
+
+	for _, tc := range []struct {
+		issuer, clientID, nonce string
+		now                     time.Time
+	}{
+		{"https://other", "syncthing", "n", now},
+		{"https://issuer", "someone", "n", now},
+		{"https://issuer", "syncthing", "x", now},
+		{"https://issuer", "syncthing", "n", now.Add(time.Hour)},
+	} {
+		if err := claims.validate(tc.issuer, tc.clientID, tc.nonce, tc.now); err == nil {
+			t.Errorf("expected claims to be invalid for %+v", tc)
+		}
+	}
+}
+
+// fakeLDAP knows the passwords of some DNs, the DN of each user name, and
+// the members of some groups.
+type fakeLDAP struct {
+	passwords map[string]string
+	users     map[string]string
+	groups    map[string][]string
+	bound     string
+}
+
+func (f *fakeLDAP) Bind(username, password string) error {
+	if pw, ok := f.passwords[username]; !ok || pw != password {
+		return ldap.NewError(ldap.LDAPResultInvalidCredentials, nil)
+	}
+	f.bound = username
+	return nil
+}
+
+func (f *fakeLDAP) Search(req *ldap.SearchRequest) (*ldap.SearchResult, error) {
+	if f.bound == "" {
+		return nil, ldap.NewError(ldap.LDAPResultInsufficientAccessRights, nil)
+	}
+	res := &ldap.SearchResult{}
+	if req.Scope == ldap.ScopeBaseObject {
+		for _, member := range f.groups[req.BaseDN] {
+			if strings.Contains(req.Filter, "(member="+member+")") {
+				res.Entries = append(res.Entries, &ldap.Entry{DN: req.BaseDN})
+			}
+		}
+		return res, nil
+	}
+	for name, dn := range f.users {
+		if req.Filter == "(uid="+name+")" {
+			res.Entries = append(res.Entries, &ldap.Entry{DN: dn})
+		}
+	}
+	return res, nil
+}
+
+func TestLDAPAuthenticate(t *testing.T) {
+	t.Parallel()
+
+	newServer := func() *fakeLDAP {
+		return &fakeLDAP{
+			passwords: map[string]string{
+				"cn=svc,dc=example":         "svcpass",
+				"uid=alice,ou=a,dc=example": "alicepass",
+				"uid=bob,ou=b,dc=example":   "bobpass",
+			},
+			users: map[string]string{
+				"alice": "uid=alice,ou=a,dc=example",
+				"bob":   "uid=bob,ou=b,dc=example",
+			},
+			groups: map[string][]string{
+				"cn=syncthing,dc=example": {"uid=alice,ou=a,dc=example"},
+			},
+		}
+	}
+
+	searchBind := config.LDAPConfiguration{
+		ServiceBindDN:       "cn=svc,dc=example",
+		ServiceBindPassword: "svcpass",
+		SearchBaseDN:        "dc=example",
+		SearchFilter:        "(uid=%s)",
+	}
+	grouped := searchBind
+	grouped.RequiredGroupDN = "cn=syncthing,dc=example"
+	direct := config.LDAPConfiguration{
+		BindDN:          "uid=%s,ou=a,dc=example",
+		RequiredGroupDN: "cn=syncthing,dc=example",
+	}
+	badService := searchBind
+	badService.ServiceBindPassword = "wrong"
+
+	cases := []struct {
+		name     string
+		cfg      config.LDAPConfiguration
+		user, pw string
+		ok       bool
+	}{
+		{"search bind", searchBind, "alice", "alicepass", true},
+		{"search bind, other user", searchBind, "bob", "bobpass", true},
+		{"search bind, wrong password", searchBind, "alice", "bobpass", false},
+		{"search bind, empty password", searchBind, "alice", "", false},
+		{"search bind, unknown user", searchBind, "carol", "alicepass", false},
+		{"search bind, bad service account", badService, "alice", "alicepass", false},
+		{"group member", grouped, "alice", "alicepass", true},
+		{"not a group member", grouped, "bob", "bobpass", false},
+		{"direct bind, group member", direct, "alice", "alicepass", true},
+		{"direct bind, wrong password", direct, "alice", "wrong", false},
+	}
+	for _, tc := range cases {
+		if ok := ldapAuthenticate(newServer(), tc.user, tc.pw, tc.cfg); ok != tc.ok {
+			t.Errorf("%s: expected %v, got %v", tc.name, tc.ok, ok)
+		}
+	}
+}
+
+func newTestCert(t *testing.T, cn string, notAfter time.Time, parent *x509.Certificate, parentKey *ecdsa.PrivateKey) (*x509.Certificate, *ecdsa.PrivateKey) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: cn},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     notAfter,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	if parent == nil {
+		tmpl.IsCA = true
+		tmpl.BasicConstraintsValid = true
+		tmpl.KeyUsage = x509.KeyUsageCertSign
+		parent, parentKey = tmpl, key
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, parent, &key.PublicKey, parentKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return cert, key
+}
+
+func TestClientCertAuth(t *testing.T) {
+	t.Parallel()
+
+	later := time.Now().Add(time.Hour)
+	ca, caKey := newTestCert(t, "ca", later, nil, nil)
+	otherCA, otherCAKey := newTestCert(t, "other", later, nil, nil)
+	alice, _ := newTestCert(t, "alice", later, ca, caKey)
+	bob, _ := newTestCert(t, "bob", later, ca, caKey)
+	mallory, _ := newTestCert(t, "alice", later, otherCA, otherCAKey)
+	expired, _ := newTestCert(t, "alice", time.Now().Add(-time.Minute), ca, caKey)
+	pinned, _ := newTestCert(t, "pinned", later, otherCA, otherCAKey)
+
+	sum := sha256.Sum256(pinned.Raw)
+	fp := strings.ToUpper(hex.EncodeToString(sum[:]))
+
+	roots := x509.NewCertPool()
+	roots.AddCert(ca)
+	auth := &clientCertAuth{
+		roots: roots,
+		certs: []config.GUIClientCertificate{
+			{CommonName: "alice", Role: config.UserRoleAdmin},
+			{Fingerprint: fp, Role: config.UserRoleReadOnly},
+		},
+	}
+
+	cases := []struct {
+		name     string
+		cert     *x509.Certificate
+		user     string
+		verified bool
+	}{
+		{"issued by the CA", alice, "cert:alice", true},
+		{"issued by the CA, not configured", bob, "", true},
+		{"other CA, same name", mallory, "", false},
+		{"expired", expired, "", false},
+		{"pinned", pinned, "cert:" + strings.ToLower(fp), true},
+	}
+	for _, tc := range cases {
+		user, ok := auth.userFor([]*x509.Certificate{tc.cert})
+		if ok != (tc.user != "") || user.Name != tc.user {
+			t.Errorf("%s: expected user %q, got %q (%v)", tc.name, tc.user, user.Name, ok)
+		}
+		// Unconfigured certificates issued by the CA may connect, but
+		// don't authenticate.
+		err := auth.verifyPeerCertificate([][]byte{tc.cert.Raw}, nil)
+		if verified := err == nil; verified != tc.verified {
+			t.Errorf("%s: unexpected verification result %v", tc.name, err)
+		}
+	}
+
+	// Only requests that can't come from another site skip the CSRF
+	// check.
+	var csrfExempt bool
+	handler := clientCertMiddleware(auth, http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+		csrfExempt = isClientCertAPIRequest(r)
+	}))
+	for _, tc := range []struct {
+		origin, fetchSite string
+		exempt            bool
+	}{
+		{"", "", true},
+		{"", "none", true},
+		{"", "same-origin", true},
+		{"https://evil.example.com", "", false},
+		{"", "cross-site", false},
+		{"", "same-site", false},
+	} {
+		req := httptest.NewRequest(http.MethodPost, "/rest/system/restart", nil)
+		req.TLS = &tls.ConnectionState{PeerCertificates: []*x509.Certificate{alice}}
+		if tc.origin != "" {
+			req.Header.Set("Origin", tc.origin)
+		}
+		if tc.fetchSite != "" {
+			req.Header.Set("Sec-Fetch-Site", tc.fetchSite)
+		}
+		handler.ServeHTTP(httptest.NewRecorder(), req)
+		if csrfExempt != tc.exempt {
+			t.Errorf("Origin %q, Sec-Fetch-Site %q: expected CSRF exemption %v", tc.origin, tc.fetchSite, tc.exempt)
+		}
+	}
+}
@@ -0,0 +1,183 @@
+// Copyright (C) 2026 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package api
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/julienschmidt/httprouter"
+
+	"github.com/syncthing/syncthing/lib/config"
+	"github.com/syncthing/syncthing/lib/protocol"
+	"github.com/syncthing/syncthing/lib/sync"
+)
+
+// The actions that can be applied to several devices or folders at once.
+const (
+	batchPause    = "pause"
+	batchResume   = "resume"
+	batchRescan   = "rescan"
+	batchOverride = "override"
+)
+
+// batchRequest is the body of a batch call: the action to apply to each of
+// the devices or folders with the given IDs.
+type batchRequest struct {
+	IDs    []string `json:"ids"`
+	Action string   `json:"action"`
+}
+
+// batchResult lists the IDs the action failed for, with the reason. It
+// succeeded for the others.
+type batchResult struct {
+	Errors map[string]string `json:"errors,omitempty"`
+}
+
+// batchHandler wraps a handler registered on a path ending in ":batch". The
+// router takes the colon to start a parameter, so the path matches
+// anything with the same prefix and we need to check the rest ourselves.
+func batchHandler(h http.HandlerFunc) httprouter.Handle {
+	return func(w http.ResponseWriter, r *http.Request, p httprouter.Params) {
+		if p.ByName("batch") != ":batch" {
+			http.NotFound(w, r)
+			return
+		}
+		h(w, r)
+	}
+}
+
+// registerDevicesBatch pauses or resumes all the given devices at once, or
+// none of them if any is unknown.
+func (c *configMuxBuilder) registerDevicesBatch(path string) {
+	c.Handle(http.MethodPost, path, batchHandler(func(w http.ResponseWriter, r *http.Request) {
+		var req batchRequest
+		if err := unmarshalTo(r.Body, &req); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		if req.Action != batchPause && req.Action != batchResume {
+			http.Error(w, "unsupported action for devices: "+req.Action, http.StatusBadRequest)
+			return
+		}
+		devices := make([]protocol.DeviceID, len(req.IDs))
+		for i, id := range req.IDs {
+			device, err := protocol.DeviceIDFromString(id)
+			if err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+			devices[i] = device
+		}
+
+		var missing []string
+		waiter, err := c.cfg.Modify(func(cfg *config.Configuration) {
+			idxs := make([]int, len(devices))
+			for i, device := range devices {
+				_, idx, ok := cfg.Device(device)
+				if !ok {
+					missing = append(missing, req.IDs[i])
+					continue
+				}
+				idxs[i] = idx
+			}
+			if len(missing) > 0 {
+				return
+			}
+			for _, idx := range idxs {
+				cfg.Devices[idx].Paused = req.Action == batchPause
+			}
+		})
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		if len(missing) > 0 {
+			http.Error(w, "no device with ID "+strings.Join(missing, ", "), http.StatusNotFound)
+			return
+		}
+		c.finish(w, waiter)
+	}))
+}
+
+// postDBFoldersBatch applies an action to all the given folders. Pausing
+// and resuming is a single config change for all of them, while folders
+// are rescanned concurrently and overrides happen in the background. No
+// action is taken if any folder is unknown.
+func (s *service) postDBFoldersBatch(w http.ResponseWriter, r *http.Request) {
+	var req batchRequest
+	if err := unmarshalTo(r.Body, &req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	var missing []string
+	for _, id := range req.IDs {
+		if _, ok := s.cfg.Folder(id); !ok {
+			missing = append(missing, id)
+		}
+	}
+	if len(missing) > 0 {
+		http.Error(w, "no folder with ID "+strings.Join(missing, ", "), http.StatusNotFound)
+		return
+	}
+
+	switch req.Action {
+	case batchPause, batchResume:
+		if key, ok := r.Context().Value(scopedAPIKeyKey{}).(config.ScopedAPIKey); ok && !key.HasScope(config.APIKeyScopeConfigWrite) {
+			// Pausing is a config change, which the key must allow.
+			http.Error(w, "Forbidden", http.StatusForbidden)
+			return
+		}
+		waiter, err := s.cfg.Modify(func(cfg *config.Configuration) {
+			for _, id := range req.IDs {
+				if folder, idx, ok := cfg.Folder(id); ok {
+					folder.Paused = req.Action == batchPause
+					cfg.Folders[idx] = folder
+				}
+			}
+		})
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		waiter.Wait()
+		if err := s.cfg.Save(); err != nil {
+			l.Warnln("Saving config:", err)
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+
+	case batchRescan:
+		var res batchResult
+		mut := sync.NewMutex()
+		wg := sync.NewWaitGroup()
+		for _, id := range req.IDs {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				if err := s.model.ScanFolder(id); err != nil {
+					mut.Lock()
+					if res.Errors == nil {
+						res.Errors = make(map[string]string)
+					}
+					res.Errors[id] = err.Error()
+					mut.Unlock()
+				}
+			}()
+		}
+		wg.Wait()
+		sendJSON(w, res)
+
+	case batchOverride:
+		for _, id := range req.IDs {
+			go s.model.Override(id)
+		}
+
+	default:
+		http.Error(w, "unsupported action for folders: "+req.Action, http.StatusBadRequest)
+	}
+}
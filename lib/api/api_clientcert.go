@@ -0,0 +1,176 @@
+// Copyright (C) 2026 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package api
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/syncthing/syncthing/lib/config"
+	"github.com/syncthing/syncthing/lib/fs"
+)
+
+type clientCertUserKey struct{}
+
+var errUntrustedClientCert = errors.New("client certificate is neither issued by the client CA nor pinned")
+
+// clientCertAuth maps TLS client certificates to users, as configured.
+type clientCertAuth struct {
+	certs []config.GUIClientCertificate
+	roots *x509.CertPool // nil when there is no client CA
+}
+
+// newClientCertAuth returns the client certificate authentication for the
+// config. If the client CA can't be loaded only pinned certificates are
+// accepted.
+func newClientCertAuth(guiCfg config.GUIConfiguration) *clientCertAuth {
+	a := &clientCertAuth{certs: guiCfg.ClientCertificates}
+	if guiCfg.ClientCAFile == "" {
+		return a
+	}
+	path, err := fs.ExpandTilde(guiCfg.ClientCAFile)
+	if err != nil {
+		l.Warnln("Loading GUI client CA:", err)
+		return a
+	}
+	bs, err := os.ReadFile(path)
+	if err != nil {
+		l.Warnln("Loading GUI client CA:", err)
+		return a
+	}
+	roots := x509.NewCertPool()
+	if !roots.AppendCertsFromPEM(bs) {
+		l.Warnf("Loading GUI client CA: no certificates found in %s", path)
+		return a
+	}
+	a.roots = roots
+	return a
+}
+
+// enabled returns true if client certificates should be asked for.
+func (a *clientCertAuth) enabled() bool {
+	return len(a.certs) > 0 || a.roots != nil
+}
+
+// issuedByCA returns true if the certificate chains to the client CA and is
+// meant for client authentication.
+func (a *clientCertAuth) issuedByCA(chain []*x509.Certificate) bool {
+	if a.roots == nil || len(chain) == 0 {
+		return false
+	}
+	intermediates := x509.NewCertPool()
+	for _, cert := range chain[1:] {
+		intermediates.AddCert(cert)
+	}
+	_, err := chain[0].Verify(x509.VerifyOptions{
+		Roots:         a.roots,
+		Intermediates: intermediates,
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	})
+	return err == nil
+}
+
+// userFor returns the user the presented certificate chain authenticates
+// as, if any.
+func (a *clientCertAuth) userFor(chain []*x509.Certificate) (config.GUIUser, bool) {
+	if len(chain) == 0 {
+		return config.GUIUser{}, false
+	}
+	leaf := chain[0]
+	if now := time.Now(); now.Before(leaf.NotBefore) || now.After(leaf.NotAfter) {
+		return config.GUIUser{}, false
+	}
+	issuedByCA := a.issuedByCA(chain)
+	for _, cert := range a.certs {
+		if cert.Matches(leaf, issuedByCA) {
+			return cert.User(), true
+		}
+	}
+	return config.GUIUser{}, false
+}
+
+// verifyPeerCertificate refuses connections with certificates that are
+// neither issued by the client CA nor pinned, for when client certificates
+// are required.
+func (a *clientCertAuth) verifyPeerCertificate(rawCerts [][]byte, _ [][]*x509.Certificate) error {
+	chain := make([]*x509.Certificate, 0, len(rawCerts))
+	for _, raw := range rawCerts {
+		cert, err := x509.ParseCertificate(raw)
+		if err != nil {
+			return err
+		}
+		chain = append(chain, cert)
+	}
+	if a.issuedByCA(chain) {
+		return nil
+	}
+	if _, ok := a.userFor(chain); ok {
+		return nil
+	}
+	return errUntrustedClientCert
+}
+
+// configureTLS sets up the listener's TLS config to ask for, or require,
+// client certificates.
+func (a *clientCertAuth) configureTLS(tlsCfg *tls.Config, require bool) {
+	if require && !a.enabled() {
+		l.Warnln("GUI client certificates are required, but there is neither a client CA nor any pinned certificate; not requiring them")
+		require = false
+	}
+	switch {
+	case require:
+		// Verification is ours, as pinned certificates needn't be
+		// issued by anyone in particular.
+		tlsCfg.ClientAuth = tls.RequireAnyClientCert
+		tlsCfg.VerifyPeerCertificate = a.verifyPeerCertificate
+	case a.enabled():
+		tlsCfg.ClientAuth = tls.RequestClientCert
+	}
+}
+
+// clientCertMiddleware notes the user the request's client certificate
+// authenticates as, for the authentication and CSRF middlewares.
+func clientCertMiddleware(a *clientCertAuth, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.TLS != nil {
+			if user, ok := a.userFor(r.TLS.PeerCertificates); ok {
+				r = r.WithContext(context.WithValue(r.Context(), clientCertUserKey{}, user))
+			}
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// clientCertUser returns the user the request's client certificate
+// authenticates as.
+func clientCertUser(r *http.Request) (config.GUIUser, bool) {
+	user, ok := r.Context().Value(clientCertUserKey{}).(config.GUIUser)
+	return user, ok
+}
+
+// isClientCertAPIRequest returns true for requests authenticated by client
+// certificate that can't be cross site request forgeries. Browsers present
+// client certificates on their own, like cookies, but send an Origin header
+// with cross site requests that change things. As not all of them always
+// do, a Sec-Fetch-Site header, which only browsers send, must also show
+// that the request wasn't made by another site.
+func isClientCertAPIRequest(r *http.Request) bool {
+	if _, ok := clientCertUser(r); !ok || r.Header.Get("Origin") != "" {
+		return false
+	}
+	switch r.Header.Get("Sec-Fetch-Site") {
+	case "", "none", "same-origin":
+		return true
+	default:
+		return false
+	}
+}
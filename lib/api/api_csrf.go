@@ -7,16 +7,24 @@
 package api
 
 import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
 	"net/http"
+	"strconv"
 	"strings"
 	"time"
 
+	"github.com/syncthing/syncthing/lib/config"
 	"github.com/syncthing/syncthing/lib/db"
+	"github.com/syncthing/syncthing/lib/rand"
 )
 
 const (
 	maxCSRFTokenLifetime = time.Hour
 	maxActiveCSRFTokens  = 25
+	csrfNonceRotation    = 30 * time.Minute
+	csrfNonceKeyLength   = 32
 )
 
 type csrfManager struct {
@@ -24,16 +32,28 @@ type csrfManager struct {
 	prefix          string
 	apiKeyValidator apiKeyValidator
 	next            http.Handler
-	tokens          *tokenManager
+	tokens          *tokenManager // for requests without a session
+	nonces          *csrfNonces   // for requests with a session
 }
 
 type apiKeyValidator interface {
 	IsValidAPIKey(key string) bool
+	IsValidPendingAPIKey(key string) bool
+	ValidScopedAPIKey(key string) (config.ScopedAPIKey, bool)
+}
+
+// pendingAPICalls are the requests allowed with the limited pending API key.
+var pendingAPICalls = map[string]string{
+	"/rest/cluster/pending/devices":        http.MethodGet,
+	"/rest/cluster/pending/folders":        http.MethodGet,
+	"/rest/cluster/pending/devices/accept": http.MethodPost,
+	"/rest/cluster/pending/folders/accept": http.MethodPost,
 }
 
 // Check for CSRF token on /rest/ URLs. If a correct one is not given, reject
 // the request with 403. For / and /index.html, set a new CSRF cookie if none
-// is currently set.
+// is currently set. Requests in a session need the session's own token, and
+// get a new cookie as it rotates.
 func newCsrfManager(unique string, prefix string, apiKeyValidator apiKeyValidator, next http.Handler, miscDB *db.NamespacedKV) *csrfManager {
 	m := &csrfManager{
 		unique:          unique,
@@ -41,13 +61,14 @@ func newCsrfManager(unique string, prefix string, apiKeyValidator apiKeyValidato
 		apiKeyValidator: apiKeyValidator,
 		next:            next,
 		tokens:          newTokenManager("csrfTokens", miscDB, maxCSRFTokenLifetime, maxActiveCSRFTokens),
+		nonces:          newCSRFNonces(miscDB),
 	}
 	return m
 }
 
 func (m *csrfManager) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	// Allow requests carrying a valid API key
-	if hasValidAPIKeyHeader(r, m.apiKeyValidator) {
+	if hasValidAPIKeyHeader(r, m.apiKeyValidator) || hasValidPendingAPIKeyHeader(r, m.apiKeyValidator) {
 		// Set the access-control-allow-origin header for CORS requests
 		// since a valid API key has been provided
 		w.Header().Add("Access-Control-Allow-Origin", "*")
@@ -55,6 +76,21 @@ func (m *csrfManager) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if key, ok := scopedAPIKeyFromHeader(r, m.apiKeyValidator); ok {
+		if !scopedAPIKeyAllows(key, r) {
+			forbidden(w)
+			return
+		}
+		w.Header().Add("Access-Control-Allow-Origin", "*")
+		m.next.ServeHTTP(w, withScopedAPIKey(r, key))
+		return
+	}
+
+	if isClientCertAPIRequest(r) {
+		m.next.ServeHTTP(w, r)
+		return
+	}
+
 	if strings.HasPrefix(r.URL.Path, "/rest/debug") {
 		// Debugging functions are only available when explicitly
 		// enabled, and can be accessed without a CSRF token
@@ -65,15 +101,7 @@ func (m *csrfManager) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	// Allow requests for anything not under the protected path prefix,
 	// and set a CSRF cookie if there isn't already a valid one.
 	if !strings.HasPrefix(r.URL.Path, m.prefix) {
-		cookie, err := r.Cookie("CSRF-Token-" + m.unique)
-		if err != nil || !m.tokens.Check(cookie.Value) {
-			l.Debugln("new CSRF cookie in response to request for", r.URL)
-			cookie = &http.Cookie{
-				Name:  "CSRF-Token-" + m.unique,
-				Value: m.tokens.New(),
-			}
-			http.SetCookie(w, cookie)
-		}
+		m.refreshCookie(w, r)
 		m.next.ServeHTTP(w, r)
 		return
 	}
@@ -87,7 +115,19 @@ func (m *csrfManager) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 
 	// Verify the CSRF token
 	token := r.Header.Get("X-CSRF-Token-" + m.unique)
-	if !m.tokens.Check(token) {
+	if token == "" && r.URL.Path == "/rest/events/ws" {
+		// Browsers can't set headers on WebSocket requests, so the token
+		// may be given as a parameter instead.
+		token = r.URL.Query().Get("csrf")
+	}
+	if session, ok := requestSession(r); ok {
+		// The token must be the session's own, which also rotates.
+		if !m.nonces.Check(session, token) {
+			http.Error(w, "CSRF Error", http.StatusForbidden)
+			return
+		}
+		m.refreshCookie(w, r)
+	} else if !m.tokens.Check(token) {
 		http.Error(w, "CSRF Error", http.StatusForbidden)
 		return
 	}
@@ -95,13 +135,127 @@ func (m *csrfManager) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	m.next.ServeHTTP(w, r)
 }
 
+// refreshCookie sets a new CSRF cookie if the request doesn't carry a
+// valid, current one.
+func (m *csrfManager) refreshCookie(w http.ResponseWriter, r *http.Request) {
+	cookie, err := r.Cookie(m.cookieName())
+	if session, ok := requestSession(r); ok {
+		if nonce := m.nonces.Current(session); err != nil || cookie.Value != nonce {
+			l.Debugln("new session CSRF cookie in response to request for", r.URL)
+			m.setCookie(w, r, nonce)
+		}
+		return
+	}
+	if err != nil || !m.tokens.Check(cookie.Value) {
+		l.Debugln("new CSRF cookie in response to request for", r.URL)
+		m.setCookie(w, r, m.tokens.New())
+	}
+}
+
+// setSessionCookie sets the CSRF cookie for a session that was just
+// created.
+func (m *csrfManager) setSessionCookie(w http.ResponseWriter, r *http.Request, session string) {
+	m.setCookie(w, r, m.nonces.Current(session))
+}
+
+func (m *csrfManager) setCookie(w http.ResponseWriter, r *http.Request, value string) {
+	// Not HttpOnly, as the GUI reads the token to send it back in a
+	// header.
+	http.SetCookie(w, &http.Cookie{
+		Name:     m.cookieName(),
+		Value:    value,
+		Secure:   isHTTPSRequest(r),
+		SameSite: http.SameSiteStrictMode,
+		Path:     "/",
+	})
+}
+
+func (m *csrfManager) cookieName() string {
+	return "CSRF-Token-" + m.unique
+}
+
+// csrfNonces derives the CSRF tokens of sessions from the session token and
+// the current period of time. The tokens thus rotate, and end with the
+// session, without anything to keep track of. The current and previous
+// tokens are valid, so that requests in flight when the token rotates
+// don't fail.
+type csrfNonces struct {
+	key     []byte
+	timeNow func() time.Time // can be overridden for testing
+}
+
+// newCSRFNonces returns the nonces using the key kept in the database,
+// creating it if there is none, so that tokens survive restarts like the
+// sessions do.
+func newCSRFNonces(miscDB *db.NamespacedKV) *csrfNonces {
+	key, ok, _ := miscDB.Bytes("csrfNonceKey")
+	if !ok || len(key) != csrfNonceKeyLength {
+		key = make([]byte, csrfNonceKeyLength)
+		_, _ = rand.Read(key)                    // can't fail
+		_ = miscDB.PutBytes("csrfNonceKey", key) // can fail, but then the tokens just don't survive restarts
+	}
+	return &csrfNonces{
+		key:     key,
+		timeNow: time.Now,
+	}
+}
+
+// Current returns the session's current token.
+func (n *csrfNonces) Current(session string) string {
+	return n.nonce(session, n.period())
+}
+
+// Check returns true if the token is the session's current or previous
+// one.
+func (n *csrfNonces) Check(session, token string) bool {
+	period := n.period()
+	return hmac.Equal([]byte(token), []byte(n.nonce(session, period))) ||
+		hmac.Equal([]byte(token), []byte(n.nonce(session, period-1)))
+}
+
+func (n *csrfNonces) period() int64 {
+	return n.timeNow().UnixNano() / int64(csrfNonceRotation)
+}
+
+func (n *csrfNonces) nonce(session string, period int64) string {
+	mac := hmac.New(sha256.New, n.key)
+	mac.Write([]byte(session))
+	mac.Write([]byte{0})
+	mac.Write(strconv.AppendInt(nil, period, 10))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
 func hasValidAPIKeyHeader(r *http.Request, validator apiKeyValidator) bool {
 	if key := r.Header.Get("X-API-Key"); validator.IsValidAPIKey(key) {
 		return true
 	}
+	return validator.IsValidAPIKey(bearerToken(r))
+}
+
+// hasValidPendingAPIKeyHeader returns true when the request carries the
+// limited pending API key and is one of the calls it allows.
+func hasValidPendingAPIKeyHeader(r *http.Request, validator apiKeyValidator) bool {
+	if method, ok := pendingAPICalls[r.URL.Path]; !ok || method != r.Method {
+		return false
+	}
+	if key := r.Header.Get("X-API-Key"); validator.IsValidPendingAPIKey(key) {
+		return true
+	}
+	return validator.IsValidPendingAPIKey(bearerToken(r))
+}
+
+// scopedAPIKeyFromHeader returns the scoped API key the request carries, if
+// it is valid.
+func scopedAPIKeyFromHeader(r *http.Request, validator apiKeyValidator) (config.ScopedAPIKey, bool) {
+	if key, ok := validator.ValidScopedAPIKey(r.Header.Get("X-API-Key")); ok {
+		return key, true
+	}
+	return validator.ValidScopedAPIKey(bearerToken(r))
+}
+
+func bearerToken(r *http.Request) string {
 	if auth := r.Header.Get("Authorization"); strings.HasPrefix(strings.ToLower(auth), "bearer ") {
-		bearerToken := auth[len("bearer "):]
-		return validator.IsValidAPIKey(bearerToken)
+		return auth[len("bearer "):]
 	}
-	return false
+	return ""
 }
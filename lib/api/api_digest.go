@@ -0,0 +1,225 @@
+// Copyright (C) 2026 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package api
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// A digest algorithm of Content-Digest and Want-Content-Digest (RFC 9530).
+type digestAlgorithm struct {
+	name string
+	new  func() hash.Hash
+}
+
+// The digest algorithms we know, the first being the one used unless the
+// client prefers another.
+var digestAlgorithms = []digestAlgorithm{
+	{"sha-256", sha256.New},
+	{"sha-512", sha512.New},
+}
+
+var errContentDigestMismatch = errors.New("request body does not match its Content-Digest")
+
+// The largest request body we read into memory to verify its digest. The
+// largest requests are configs, which are far smaller even with thousands
+// of folders.
+const maxDigestedRequestSize = 16 << 20
+
+// contentDigestMiddleware adds a Content-Digest header to responses of at
+// least minSize bytes, or to any response the client asks for one with
+// Want-Content-Digest, so that clients can tell when a proxy truncated or
+// garbled the response. It needs the whole response to be buffered, so
+// responses that are flushed while being written don't get one. Requests
+// with a Content-Digest header are refused unless their body matches it.
+func contentDigestMiddleware(h http.Handler, minSize int) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Content-Digest") != "" {
+			if err := verifyContentDigest(w, r); err != nil {
+				status := http.StatusBadRequest
+				if errors.As(err, new(*http.MaxBytesError)) {
+					status = http.StatusRequestEntityTooLarge
+				}
+				http.Error(w, err.Error(), status)
+				return
+			}
+		}
+
+		algo, wanted := wantedDigestAlgorithm(r.Header.Get("Want-Content-Digest"))
+		size := minSize
+		if wanted {
+			size = 0
+		} else if size <= 0 {
+			h.ServeHTTP(w, r)
+			return
+		}
+
+		dw := &digestingResponseWriter{ResponseWriter: w, algo: algo, minSize: size}
+		h.ServeHTTP(dw, r)
+		dw.finish()
+	})
+}
+
+// verifyContentDigest checks the request body against each digest in its
+// Content-Digest header that uses an algorithm we know, and makes the body
+// readable again for the handler. Digests with other algorithms are
+// ignored. As the body must be read in full before the handler sees any of
+// it, bodies larger than maxDigestedRequestSize are refused.
+func verifyContentDigest(w http.ResponseWriter, r *http.Request) error {
+	digests, err := parseContentDigest(r.Header.Get("Content-Digest"))
+	if err != nil {
+		return err
+	}
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxDigestedRequestSize))
+	if err != nil {
+		return err
+	}
+	r.Body.Close()
+	r.Body = io.NopCloser(bytes.NewReader(body))
+
+	for _, algo := range digestAlgorithms {
+		digest, ok := digests[algo.name]
+		if !ok {
+			continue
+		}
+		h := algo.new()
+		h.Write(body)
+		if !bytes.Equal(h.Sum(nil), digest) {
+			return errContentDigestMismatch
+		}
+	}
+	return nil
+}
+
+// parseContentDigest returns the digests of a Content-Digest header, by
+// algorithm. The header is a dictionary of byte sequences, as in
+// `sha-256=:base64:, sha-512=:base64:`.
+func parseContentDigest(header string) (map[string][]byte, error) {
+	digests := make(map[string][]byte)
+	for _, member := range strings.Split(header, ",") {
+		name, value, ok := strings.Cut(strings.TrimSpace(member), "=")
+		if !ok || len(value) < 2 || value[0] != ':' || value[len(value)-1] != ':' {
+			return nil, fmt.Errorf("invalid Content-Digest %q", member)
+		}
+		digest, err := base64.StdEncoding.DecodeString(value[1 : len(value)-1])
+		if err != nil {
+			return nil, fmt.Errorf("invalid Content-Digest %q: %w", member, err)
+		}
+		digests[strings.ToLower(name)] = digest
+	}
+	return digests, nil
+}
+
+// wantedDigestAlgorithm returns the algorithm the client prefers in a
+// Want-Content-Digest header, a dictionary of preferences from 1 to 10 as
+// in `sha-512=3, sha-256=10`, and whether it asks for one we know. A
+// preference of 0 means not acceptable.
+func wantedDigestAlgorithm(header string) (digestAlgorithm, bool) {
+	best, bestPref := digestAlgorithms[0], 0
+	if header == "" {
+		return best, false
+	}
+	for _, member := range strings.Split(header, ",") {
+		name, value, _ := strings.Cut(strings.TrimSpace(member), "=")
+		pref, err := strconv.Atoi(strings.TrimSpace(value))
+		if err != nil || pref <= bestPref {
+			continue
+		}
+		for _, algo := range digestAlgorithms {
+			if algo.name == strings.ToLower(strings.TrimSpace(name)) {
+				best, bestPref = algo, pref
+			}
+		}
+	}
+	return best, bestPref > 0
+}
+
+// digestingResponseWriter buffers the response to add its digest once it
+// is complete. If the handler flushes, the response is streamed from then
+// on, without a digest.
+type digestingResponseWriter struct {
+	http.ResponseWriter
+	algo      digestAlgorithm
+	minSize   int
+	status    int
+	buf       bytes.Buffer
+	streaming bool
+}
+
+func (w *digestingResponseWriter) WriteHeader(status int) {
+	if w.streaming {
+		w.ResponseWriter.WriteHeader(status)
+		return
+	}
+	if w.status == 0 {
+		w.status = status
+	}
+}
+
+func (w *digestingResponseWriter) Write(p []byte) (int, error) {
+	if w.streaming {
+		return w.ResponseWriter.Write(p)
+	}
+	if w.status == 0 {
+		w.status = http.StatusOK
+	}
+	return w.buf.Write(p)
+}
+
+// Unwrap gives http.ResponseController access to the underlying writer,
+// e.g. for hijacking the connection of a WebSocket. Nothing must have been
+// written through us then, and nothing is written by finish afterwards.
+func (w *digestingResponseWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
+
+func (w *digestingResponseWriter) Flush() {
+	w.stream()
+	if f, ok := w.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+// stream writes what is buffered and passes everything else through.
+func (w *digestingResponseWriter) stream() {
+	if w.streaming {
+		return
+	}
+	w.streaming = true
+	if w.status != 0 {
+		w.ResponseWriter.WriteHeader(w.status)
+	}
+	if w.buf.Len() > 0 {
+		w.ResponseWriter.Write(w.buf.Bytes())
+	}
+}
+
+// finish writes the buffered response, with its digest and length if it's
+// large enough.
+func (w *digestingResponseWriter) finish() {
+	if w.streaming {
+		return
+	}
+	bodyAllowed := w.status != http.StatusNoContent && w.status != http.StatusNotModified
+	if bodyAllowed && w.buf.Len() >= w.minSize {
+		h := w.algo.new()
+		h.Write(w.buf.Bytes())
+		w.Header().Set("Content-Digest", w.algo.name+"=:"+base64.StdEncoding.EncodeToString(h.Sum(nil))+":")
+		w.Header().Set("Content-Length", strconv.Itoa(w.buf.Len()))
+	}
+	w.stream()
+}
@@ -0,0 +1,159 @@
+// Copyright (C) 2026 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package api
+
+import (
+	"bufio"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/base64"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestContentDigestResponses(t *testing.T) {
+	body := strings.Repeat("x", 2048)
+	handler := contentDigestMiddleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/stream" {
+			w.(http.Flusher).Flush()
+		}
+		io.WriteString(w, body)
+	}), 1024)
+
+	sha256Sum := sha256.Sum256([]byte(body))
+	sha512Sum := sha512.Sum512([]byte(body))
+	sha256Digest := "sha-256=:" + base64.StdEncoding.EncodeToString(sha256Sum[:]) + ":"
+	sha512Digest := "sha-512=:" + base64.StdEncoding.EncodeToString(sha512Sum[:]) + ":"
+
+	cases := []struct {
+		path, want, digest string
+	}{
+		{"/large", "", sha256Digest},
+		{"/large", "sha-512=5, sha-256=1", sha512Digest},
+		{"/large", "sha-512=0, md5=10", sha256Digest},
+		{"/stream", "sha-256=10", ""},
+	}
+	for _, tc := range cases {
+		req := httptest.NewRequest(http.MethodGet, tc.path, nil)
+		if tc.want != "" {
+			req.Header.Set("Want-Content-Digest", tc.want)
+		}
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		if rec.Body.String() != body {
+			t.Errorf("%s %q: body changed", tc.path, tc.want)
+		}
+		if got := rec.Header().Get("Content-Digest"); got != tc.digest {
+			t.Errorf("%s %q: got digest %q, expected %q", tc.path, tc.want, got, tc.digest)
+		}
+	}
+
+	// Small responses get a digest only when asked.
+	small := contentDigestMiddleware(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusAccepted)
+		io.WriteString(w, "ok")
+	}), 1024)
+	rec := httptest.NewRecorder()
+	small.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+	if rec.Code != http.StatusAccepted || rec.Header().Get("Content-Digest") != "" {
+		t.Errorf("unexpected small response %d %v", rec.Code, rec.Header())
+	}
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.Header.Set("Want-Content-Digest", "sha-256=1")
+	rec = httptest.NewRecorder()
+	small.ServeHTTP(rec, req)
+	if rec.Code != http.StatusAccepted || !strings.HasPrefix(rec.Header().Get("Content-Digest"), "sha-256=:") {
+		t.Errorf("unexpected small response %d %v", rec.Code, rec.Header())
+	}
+}
+
+func TestContentDigestRequests(t *testing.T) {
+	handler := contentDigestMiddleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		io.Copy(w, r.Body)
+	}), 0)
+
+	body := `{"ok": true}`
+	sum := sha256.Sum256([]byte(body))
+	valid := "sha-256=:" + base64.StdEncoding.EncodeToString(sum[:]) + ":"
+
+	cases := []struct {
+		digest string
+		status int
+	}{
+		{"", http.StatusOK},
+		{valid, http.StatusOK},
+		{"unknown=:AAAA:, " + valid, http.StatusOK},
+		{"sha-256=:" + base64.StdEncoding.EncodeToString(make([]byte, 32)) + ":", http.StatusBadRequest},
+		{"sha-256=AAAA", http.StatusBadRequest},
+	}
+	for _, tc := range cases {
+		req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body))
+		if tc.digest != "" {
+			req.Header.Set("Content-Digest", tc.digest)
+		}
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		if rec.Code != tc.status {
+			t.Errorf("digest %q: got status %d, expected %d", tc.digest, rec.Code, tc.status)
+		}
+		if rec.Code == http.StatusOK && rec.Body.String() != body {
+			t.Errorf("digest %q: handler read %q", tc.digest, rec.Body.String())
+		}
+	}
+
+	// Bodies too large to buffer are refused, whatever their digest.
+	req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(strings.Repeat("x", maxDigestedRequestSize+1)))
+	req.Header.Set("Content-Digest", valid)
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	if rec.Code != http.StatusRequestEntityTooLarge {
+		t.Errorf("got status %d for a too large body, expected %d", rec.Code, http.StatusRequestEntityTooLarge)
+	}
+}
+
+func TestContentDigestWebSocket(t *testing.T) {
+	t.Parallel()
+
+	// Every response is digested, so the WebSocket handshake has to get
+	// past the buffering writer.
+	handler := contentDigestMiddleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if conn, err := upgradeWebSocket(w, r); err == nil {
+			conn.close()
+		}
+	}), 1)
+	srv := httptest.NewServer(handler)
+	defer srv.Close()
+
+	conn, err := net.Dial("tcp", srv.Listener.Addr().String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	conn.SetDeadline(time.Now().Add(10 * time.Second))
+
+	req, _ := http.NewRequest(http.MethodGet, srv.URL+"/rest/events/ws", nil)
+	req.Header.Set("Connection", "Upgrade")
+	req.Header.Set("Upgrade", "websocket")
+	req.Header.Set("Sec-WebSocket-Version", "13")
+	req.Header.Set("Sec-WebSocket-Key", "dGhlIHNhbXBsZSBub25jZQ==")
+	req.Header.Set("Want-Content-Digest", "sha-256=10")
+	if err := req.Write(conn); err != nil {
+		t.Fatal(err)
+	}
+	resp, err := http.ReadResponse(bufio.NewReader(conn), req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.StatusCode != http.StatusSwitchingProtocols {
+		t.Fatal("unexpected status", resp.Status)
+	}
+}
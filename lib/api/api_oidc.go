@@ -0,0 +1,642 @@
+// Copyright (C) 2026 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package api
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/sha256"
+	_ "crypto/sha512" // for RS384, RS512, ES384 and ES512
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/syncthing/syncthing/lib/config"
+	"github.com/syncthing/syncthing/lib/dialer"
+	"github.com/syncthing/syncthing/lib/events"
+	"github.com/syncthing/syncthing/lib/rand"
+	"github.com/syncthing/syncthing/lib/sync"
+	"github.com/syncthing/syncthing/lib/tlsutil"
+)
+
+const (
+	oidcLoginTimeout     = 10 * time.Minute
+	oidcMaxPendingLogins = 100
+	oidcRequestTimeout   = 30 * time.Second
+	oidcKeyRefetchDelay  = time.Minute
+	oidcClockSkew        = time.Minute
+	oidcMaxResponseSize  = 1 << 20
+	oidcCallbackPath     = "/rest/noauth/auth/oidc/callback"
+)
+
+var (
+	errOIDCBadToken    = errors.New("invalid ID token")
+	errOIDCUnknownKey  = errors.New("unknown ID token signing key")
+	errOIDCBadSigAlg   = errors.New("unsupported ID token signature algorithm")
+	errOIDCBadResponse = errors.New("unexpected response from the OpenID provider")
+)
+
+// oidcLogin logs users in through an OpenID Connect provider, using the
+// authorization code flow with PKCE. A successful login creates a normal
+// session, the same as logging in with a password.
+type oidcLogin struct {
+	cfg                config.OIDCConfiguration
+	guiCfg             config.GUIConfiguration
+	tokenCookieManager *tokenCookieManager
+	evLogger           events.Logger
+	client             *http.Client
+
+	mut       sync.Mutex
+	discovery *oidcDiscovery
+	keys      map[string]crypto.PublicKey // key ID -> key
+	keysAt    time.Time
+	pending   map[string]oidcPendingLogin // state -> login
+}
+
+// oidcDiscovery is the part of the provider metadata we use.
+type oidcDiscovery struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	JWKSURI               string `json:"jwks_uri"`
+}
+
+type oidcPendingLogin struct {
+	nonce       string
+	verifier    string
+	redirectURI string
+	created     time.Time
+}
+
+func newOIDCLogin(guiCfg config.GUIConfiguration, tokenCookieManager *tokenCookieManager, evLogger events.Logger) *oidcLogin {
+	return &oidcLogin{
+		cfg:                guiCfg.OIDC,
+		guiCfg:             guiCfg,
+		tokenCookieManager: tokenCookieManager,
+		evLogger:           evLogger,
+		client: &http.Client{
+			Timeout: oidcRequestTimeout,
+			Transport: &http.Transport{
+				DialContext:     dialer.DialContext,
+				Proxy:           http.ProxyFromEnvironment,
+				TLSClientConfig: tlsutil.SecureDefaultWithTLS12(),
+			},
+			// The provider endpoints are expected to answer directly.
+			CheckRedirect: func(*http.Request, []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+		},
+		mut:     sync.NewMutex(),
+		pending: make(map[string]oidcPendingLogin),
+	}
+}
+
+func (o *oidcLogin) stateCookieName() string {
+	return o.tokenCookieManager.cookieName + "-oidc"
+}
+
+// login redirects the browser to the provider.
+func (o *oidcLogin) login(w http.ResponseWriter, r *http.Request) {
+	disc, err := o.getDiscovery(r.Context())
+	if err != nil {
+		l.Warnln("OpenID Connect discovery:", err)
+		http.Error(w, "Failed to contact the OpenID provider", http.StatusBadGateway)
+		return
+	}
+
+	state := rand.String(32)
+	login := oidcPendingLogin{
+		nonce:       rand.String(32),
+		verifier:    rand.String(64),
+		redirectURI: o.redirectURI(r),
+		created:     time.Now(),
+	}
+	o.addPending(state, login)
+
+	challenge := sha256.Sum256([]byte(login.verifier))
+	scopes := append([]string{"openid", "profile", "email"}, o.cfg.Scopes...)
+	params := url.Values{
+		"response_type":         {"code"},
+		"client_id":             {o.cfg.ClientID},
+		"redirect_uri":          {login.redirectURI},
+		"scope":                 {strings.Join(scopes, " ")},
+		"state":                 {state},
+		"nonce":                 {login.nonce},
+		"code_challenge":        {base64.RawURLEncoding.EncodeToString(challenge[:])},
+		"code_challenge_method": {"S256"},
+	}
+	authURL, err := url.Parse(disc.AuthorizationEndpoint)
+	if err != nil {
+		l.Warnln("OpenID Connect authorization endpoint:", err)
+		http.Error(w, "Failed to contact the OpenID provider", http.StatusBadGateway)
+		return
+	}
+	query := authURL.Query()
+	for k, v := range params {
+		query[k] = v
+	}
+	authURL.RawQuery = query.Encode()
+
+	// The state is also kept in a cookie, so that the callback only
+	// completes in the browser that started the login.
+	http.SetCookie(w, &http.Cookie{
+		Name:     o.stateCookieName(),
+		Value:    state,
+		MaxAge:   int(oidcLoginTimeout.Seconds()),
+		Secure:   isHTTPSRequest(r),
+		HttpOnly: true,
+		SameSite: http.SameSiteLaxMode,
+		Path:     "/",
+	})
+	http.Redirect(w, r, authURL.String(), http.StatusFound)
+}
+
+// callback completes the login when the provider redirects the browser
+// back to us.
+func (o *oidcLogin) callback(w http.ResponseWriter, r *http.Request) {
+	qs := r.URL.Query()
+	if msg := qs.Get("error"); msg != "" {
+		l.Infof("OpenID Connect login failed: %s: %s", msg, qs.Get("error_description"))
+		forbidden(w)
+		return
+	}
+
+	state := qs.Get("state")
+	cookie, err := r.Cookie(o.stateCookieName())
+	if err != nil || state == "" || cookie.Value != state {
+		l.Debugln("OpenID Connect callback with missing or mismatched state")
+		forbidden(w)
+		return
+	}
+	http.SetCookie(w, &http.Cookie{
+		Name:   o.stateCookieName(),
+		MaxAge: -1,
+		Path:   "/",
+	})
+	login, ok := o.takePending(state)
+	if !ok {
+		l.Debugln("OpenID Connect callback for unknown or expired login")
+		forbidden(w)
+		return
+	}
+
+	claims, err := o.exchange(r.Context(), qs.Get("code"), login)
+	if err != nil {
+		l.Warnln("OpenID Connect login:", err)
+		emitLoginAttempt(false, "", r.RemoteAddr, o.evLogger)
+		forbidden(w)
+		return
+	}
+
+	username := claims.username(o.cfg.UsernameClaim)
+	if username == "" {
+		l.Infoln("OpenID Connect login without a user name")
+		forbidden(w)
+		return
+	}
+	if !o.allowed(username, claims.groups(o.cfg.GroupsClaim)) {
+		l.Infof("OpenID Connect user %q is neither a configured user nor in any of the allowed groups", username)
+		emitLoginAttempt(false, username, r.RemoteAddr, o.evLogger)
+		forbidden(w)
+		return
+	}
+
+	o.tokenCookieManager.login(username, false, w, r)
+
+	// Relative to the callback path, to also work behind a reverse proxy
+	// serving the GUI under a prefix.
+	w.Header().Set("Location", strings.Repeat("../", strings.Count(oidcCallbackPath, "/")-1))
+	w.WriteHeader(http.StatusFound)
+}
+
+func (o *oidcLogin) redirectURI(r *http.Request) string {
+	if o.cfg.RedirectURL != "" {
+		return o.cfg.RedirectURL
+	}
+	scheme := "http"
+	if isHTTPSRequest(r) {
+		scheme = "https"
+	}
+	return scheme + "://" + r.Host + oidcCallbackPath
+}
+
+// allowed returns whether the user may log in: configured users log in with
+// their role, and members of the allowed groups as admins. Anyone else with
+// an account at the provider is refused.
+func (o *oidcLogin) allowed(username string, groups []string) bool {
+	if _, ok := o.guiCfg.UserByName(username); ok || username == o.guiCfg.User {
+		return true
+	}
+	return slices.ContainsFunc(groups, func(group string) bool {
+		return slices.Contains(o.cfg.AllowedGroups, group)
+	})
+}
+
+func (o *oidcLogin) addPending(state string, login oidcPendingLogin) {
+	o.mut.Lock()
+	defer o.mut.Unlock()
+	for s, p := range o.pending {
+		if time.Since(p.created) > oidcLoginTimeout {
+			delete(o.pending, s)
+		}
+	}
+	if len(o.pending) >= oidcMaxPendingLogins {
+		// Forget the oldest, rather than letting anyone fill up memory by
+		// starting logins.
+		var oldest string
+		for s, p := range o.pending {
+			if oldest == "" || p.created.Before(o.pending[oldest].created) {
+				oldest = s
+			}
+		}
+		delete(o.pending, oldest)
+	}
+	o.pending[state] = login
+}
+
+func (o *oidcLogin) takePending(state string) (oidcPendingLogin, bool) {
+	o.mut.Lock()
+	defer o.mut.Unlock()
+	login, ok := o.pending[state]
+	delete(o.pending, state)
+	if !ok || time.Since(login.created) > oidcLoginTimeout {
+		return oidcPendingLogin{}, false
+	}
+	return login, true
+}
+
+func (o *oidcLogin) getDiscovery(ctx context.Context) (*oidcDiscovery, error) {
+	o.mut.Lock()
+	disc := o.discovery
+	o.mut.Unlock()
+	if disc != nil {
+		return disc, nil
+	}
+
+	issuer := strings.TrimSuffix(o.cfg.IssuerURL, "/")
+	if issuer == "" {
+		return nil, errors.New("no issuer URL configured")
+	}
+	disc = new(oidcDiscovery)
+	if err := o.getJSON(ctx, issuer+"/.well-known/openid-configuration", disc); err != nil {
+		return nil, err
+	}
+	if strings.TrimSuffix(disc.Issuer, "/") != issuer {
+		return nil, fmt.Errorf("provider claims to be issuer %q, not %q", disc.Issuer, o.cfg.IssuerURL)
+	}
+	if disc.AuthorizationEndpoint == "" || disc.TokenEndpoint == "" || disc.JWKSURI == "" {
+		return nil, errOIDCBadResponse
+	}
+
+	o.mut.Lock()
+	o.discovery = disc
+	o.mut.Unlock()
+	return disc, nil
+}
+
+// exchange trades the authorization code for an ID token, and returns its
+// claims once verified.
+func (o *oidcLogin) exchange(ctx context.Context, code string, login oidcPendingLogin) (oidcClaims, error) {
+	disc, err := o.getDiscovery(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	form := url.Values{
+		"grant_type":    {"authorization_code"},
+		"code":          {code},
+		"redirect_uri":  {login.redirectURI},
+		"code_verifier": {login.verifier},
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, disc.TokenEndpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+	req.SetBasicAuth(url.QueryEscape(o.cfg.ClientID), url.QueryEscape(o.cfg.ClientSecret))
+
+	var tokens struct {
+		IDToken string `json:"id_token"`
+	}
+	if err := o.doJSON(req, &tokens); err != nil {
+		return nil, fmt.Errorf("token request: %w", err)
+	}
+	if tokens.IDToken == "" {
+		return nil, errOIDCBadResponse
+	}
+
+	claims, err := o.verify(ctx, tokens.IDToken, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	if err := claims.validate(disc.Issuer, o.cfg.ClientID, login.nonce, time.Now()); err != nil {
+		return nil, err
+	}
+	return claims, nil
+}
+
+// verify checks the signature of the ID token against the provider keys
+// and returns the claims.
+func (o *oidcLogin) verify(ctx context.Context, token string, now time.Time) (oidcClaims, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return nil, errOIDCBadToken
+	}
+	var header struct {
+		Alg string `json:"alg"`
+		Kid string `json:"kid"`
+	}
+	if err := decodeJWTPart(parts[0], &header); err != nil {
+		return nil, err
+	}
+	sig, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, errOIDCBadToken
+	}
+
+	key, err := o.key(ctx, header.Kid, now)
+	if err != nil {
+		return nil, err
+	}
+	if err := verifyJWTSignature(header.Alg, key, parts[0]+"."+parts[1], sig); err != nil {
+		return nil, err
+	}
+
+	var claims oidcClaims
+	if err := decodeJWTPart(parts[1], &claims); err != nil {
+		return nil, err
+	}
+	return claims, nil
+}
+
+// key returns the provider signing key with the given ID, fetching the
+// keys again if it is not known, as the provider may have rotated them.
+func (o *oidcLogin) key(ctx context.Context, kid string, now time.Time) (crypto.PublicKey, error) {
+	o.mut.Lock()
+	key, ok := o.keys[kid]
+	if !ok && kid == "" && len(o.keys) == 1 {
+		for _, key = range o.keys {
+			ok = true
+		}
+	}
+	fetch := !ok && now.Sub(o.keysAt) > oidcKeyRefetchDelay
+	o.mut.Unlock()
+	if ok {
+		return key, nil
+	}
+	if !fetch {
+		return nil, errOIDCUnknownKey
+	}
+
+	disc, err := o.getDiscovery(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var set struct {
+		Keys []jsonWebKey `json:"keys"`
+	}
+	if err := o.getJSON(ctx, disc.JWKSURI, &set); err != nil {
+		return nil, fmt.Errorf("fetching keys: %w", err)
+	}
+	keys := make(map[string]crypto.PublicKey, len(set.Keys))
+	for _, jwk := range set.Keys {
+		if jwk.Use != "" && jwk.Use != "sig" {
+			continue
+		}
+		pub, err := jwk.publicKey()
+		if err != nil {
+			l.Debugf("Ignoring OpenID provider key %q: %v", jwk.Kid, err)
+			continue
+		}
+		keys[jwk.Kid] = pub
+	}
+
+	o.mut.Lock()
+	o.keys = keys
+	o.keysAt = now
+	o.mut.Unlock()
+	return o.key(ctx, kid, now)
+}
+
+func (o *oidcLogin) getJSON(ctx context.Context, url string, into any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+	return o.doJSON(req, into)
+}
+
+func (o *oidcLogin) doJSON(req *http.Request, into any) error {
+	resp, err := o.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	bs, err := io.ReadAll(io.LimitReader(resp.Body, oidcMaxResponseSize))
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("%s: %s", resp.Status, truncatedBody(bs))
+	}
+	return json.Unmarshal(bs, into)
+}
+
+func truncatedBody(bs []byte) string {
+	const maxLen = 200
+	if len(bs) > maxLen {
+		bs = bs[:maxLen]
+	}
+	return strings.TrimSpace(string(bs))
+}
+
+func decodeJWTPart(part string, into any) error {
+	bs, err := base64.RawURLEncoding.DecodeString(part)
+	if err != nil {
+		return errOIDCBadToken
+	}
+	if err := json.Unmarshal(bs, into); err != nil {
+		return errOIDCBadToken
+	}
+	return nil
+}
+
+func verifyJWTSignature(alg string, key crypto.PublicKey, signed string, sig []byte) error {
+	var hash crypto.Hash
+	switch alg[len(alg)-min(len(alg), 3):] {
+	case "256":
+		hash = crypto.SHA256
+	case "384":
+		hash = crypto.SHA384
+	case "512":
+		hash = crypto.SHA512
+	default:
+		return errOIDCBadSigAlg
+	}
+	h := hash.New()
+	h.Write([]byte(signed))
+	digest := h.Sum(nil)
+
+	switch key := key.(type) {
+	case *rsa.PublicKey:
+		var err error
+		switch alg[:2] {
+		case "RS":
+			err = rsa.VerifyPKCS1v15(key, hash, digest, sig)
+		case "PS":
+			err = rsa.VerifyPSS(key, hash, digest, sig, nil)
+		default:
+			return errOIDCBadSigAlg
+		}
+		if err != nil {
+			return errOIDCBadToken
+		}
+		return nil
+	case *ecdsa.PublicKey:
+		size := (key.Curve.Params().BitSize + 7) / 8
+		if alg[:2] != "ES" || len(sig) != 2*size {
+			return errOIDCBadSigAlg
+		}
+		r := new(big.Int).SetBytes(sig[:size])
+		s := new(big.Int).SetBytes(sig[size:])
+		if !ecdsa.Verify(key, digest, r, s) {
+			return errOIDCBadToken
+		}
+		return nil
+	default:
+		return errOIDCBadSigAlg
+	}
+}
+
+// jsonWebKey is an RSA or EC public key from a JWK set.
+type jsonWebKey struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+func (k jsonWebKey) publicKey() (crypto.PublicKey, error) {
+	num := func(s string) (*big.Int, error) {
+		bs, err := base64.RawURLEncoding.DecodeString(s)
+		if err != nil || len(bs) == 0 {
+			return nil, errors.New("bad key parameter")
+		}
+		return new(big.Int).SetBytes(bs), nil
+	}
+
+	switch k.Kty {
+	case "RSA":
+		n, err := num(k.N)
+		if err != nil {
+			return nil, err
+		}
+		e, err := num(k.E)
+		if err != nil {
+			return nil, err
+		}
+		if !e.IsInt64() || e.Int64() > 1<<31-1 {
+			return nil, errors.New("bad RSA exponent")
+		}
+		return &rsa.PublicKey{N: n, E: int(e.Int64())}, nil
+	case "EC":
+		var curve elliptic.Curve
+		switch k.Crv {
+		case "P-256":
+			curve = elliptic.P256()
+		case "P-384":
+			curve = elliptic.P384()
+		case "P-521":
+			curve = elliptic.P521()
+		default:
+			return nil, fmt.Errorf("unsupported curve %q", k.Crv)
+		}
+		x, err := num(k.X)
+		if err != nil {
+			return nil, err
+		}
+		y, err := num(k.Y)
+		if err != nil {
+			return nil, err
+		}
+		if !curve.IsOnCurve(x, y) {
+			return nil, errors.New("point not on curve")
+		}
+		return &ecdsa.PublicKey{Curve: curve, X: x, Y: y}, nil
+	default:
+		return nil, fmt.Errorf("unsupported key type %q", k.Kty)
+	}
+}
+
+// oidcClaims are the claims of an ID token.
+type oidcClaims map[string]any
+
+func (c oidcClaims) validate(issuer, clientID, nonce string, now time.Time) error {
+	if iss, _ := c["iss"].(string); iss != issuer {
+		return fmt.Errorf("%w: issuer %q", errOIDCBadToken, iss)
+	}
+	if !slices.Contains(c.strings("aud"), clientID) {
+		return fmt.Errorf("%w: not issued for us", errOIDCBadToken)
+	}
+	exp, ok := c["exp"].(float64)
+	if !ok || now.After(time.Unix(int64(exp), 0).Add(oidcClockSkew)) {
+		return fmt.Errorf("%w: expired", errOIDCBadToken)
+	}
+	if n, _ := c["nonce"].(string); n != nonce {
+		return fmt.Errorf("%w: nonce mismatch", errOIDCBadToken)
+	}
+	return nil
+}
+
+// username returns the value of the given claim, falling back to the
+// email address and the subject.
+func (c oidcClaims) username(claim string) string {
+	for _, key := range []string{claim, "email", "sub"} {
+		if name, ok := c[key].(string); ok && name != "" {
+			return name
+		}
+	}
+	return ""
+}
+
+func (c oidcClaims) groups(claim string) []string {
+	return c.strings(claim)
+}
+
+// strings returns the claim which is either a string or a list of them.
+func (c oidcClaims) strings(claim string) []string {
+	switch v := c[claim].(type) {
+	case string:
+		return []string{v}
+	case []any:
+		res := make([]string, 0, len(v))
+		for _, e := range v {
+			if s, ok := e.(string); ok {
+				res = append(res, s)
+			}
+		}
+		return res
+	}
+	return nil
+}
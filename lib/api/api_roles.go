@@ -0,0 +1,139 @@
+// Copyright (C) 2026 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package api
+
+import (
+	"context"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/syncthing/syncthing/lib/config"
+)
+
+type (
+	guiUserKey      struct{}
+	guiSessionKey   struct{}
+	scopedAPIKeyKey struct{}
+)
+
+// withUser returns the request carrying the user making it.
+func withUser(r *http.Request, user config.GUIUser) *http.Request {
+	return r.WithContext(context.WithValue(r.Context(), guiUserKey{}, user))
+}
+
+// withSession returns the request carrying the session token it was made
+// with, for the CSRF manager.
+func withSession(r *http.Request, token string) *http.Request {
+	return r.WithContext(context.WithValue(r.Context(), guiSessionKey{}, token))
+}
+
+// requestSession returns the session token the request was made with.
+func requestSession(r *http.Request) (string, bool) {
+	token, ok := r.Context().Value(guiSessionKey{}).(string)
+	return token, ok
+}
+
+// withScopedAPIKey returns the request carrying the scoped API key it was
+// made with.
+func withScopedAPIKey(r *http.Request, key config.ScopedAPIKey) *http.Request {
+	return r.WithContext(context.WithValue(r.Context(), scopedAPIKeyKey{}, key))
+}
+
+// isAdminRequest returns false if the request is made by a logged in user
+// who is not an admin, or with a scoped API key. Requests without a user,
+// because authentication is disabled or the full API key is used, have full
+// access.
+func isAdminRequest(r *http.Request) bool {
+	if _, ok := r.Context().Value(scopedAPIKeyKey{}).(config.ScopedAPIKey); ok {
+		return false
+	}
+	user, ok := r.Context().Value(guiUserKey{}).(config.GUIUser)
+	return !ok || user.Role == config.UserRoleAdmin
+}
+
+// isCredentialsPath returns true for the calls that show or change
+// credentials, which are only for admins and the full API key.
+func isCredentialsPath(path string) bool {
+	// Local variable instead of module var to prevent accidental mutation
+	prefixes := []string{
+		"/rest/config/apikeys",
+		"/rest/config/gui",
+		"/rest/config/ldap",
+		"/rest/debug/",
+		"/rest/system/debug",
+		"/rest/system/sessions",
+	}
+	return slices.ContainsFunc(prefixes, func(prefix string) bool {
+		return strings.HasPrefix(path, prefix)
+	})
+}
+
+// roleAllows returns true if the user's role permits the request.
+func roleAllows(user config.GUIUser, r *http.Request) bool {
+	if user.Role == config.UserRoleAdmin {
+		return true
+	}
+
+	path := r.URL.Path
+	if !strings.HasPrefix(path, "/rest/") || isNoAuthPath(path) {
+		return true
+	}
+
+	// Everyone may log out their own other sessions.
+	if path == "/rest/system/sessions" && r.Method == http.MethodDelete {
+		if others, _ := strconv.ParseBool(r.URL.Query().Get("others")); others {
+			return true
+		}
+	}
+
+	if isCredentialsPath(path) || strings.HasPrefix(path, "/rest/system/browse") || strings.HasPrefix(path, "/rest/system/log") {
+		return false
+	}
+
+	if r.Method == http.MethodGet || r.Method == http.MethodHead {
+		return true
+	}
+
+	// Local variable instead of module var to prevent accidental mutation
+	folderOperatorCalls := []string{
+		"/rest/db/conflicts/resolve",
+		"/rest/db/ignores",
+		"/rest/db/override",
+		"/rest/db/prio",
+		"/rest/db/prioritize",
+		"/rest/db/queue/back",
+		"/rest/db/queue/front",
+		"/rest/db/queue/skip",
+		"/rest/db/revert",
+		"/rest/db/scan",
+		"/rest/folder/versions",
+	}
+	return r.Method == http.MethodPost && slices.Contains(folderOperatorCalls, path) &&
+		user.CanOperate(r.URL.Query().Get("folder"))
+}
+
+// scopedAPIKeyAllows returns true if the key's scopes permit the request.
+// Any key may read, except credentials. Config writers may change
+// everything in the config but credentials, one part at a time, and DB
+// writers may act on folders.
+func scopedAPIKeyAllows(key config.ScopedAPIKey, r *http.Request) bool {
+	path := r.URL.Path
+	if isCredentialsPath(path) {
+		return false
+	}
+	switch r.Method {
+	case http.MethodGet, http.MethodHead:
+		return true
+	case http.MethodPost:
+		if strings.HasPrefix(path, "/rest/db/") || strings.HasPrefix(path, "/rest/folder/") {
+			return key.HasScope(config.APIKeyScopeDBWrite)
+		}
+	}
+	return strings.HasPrefix(path, "/rest/config/") && key.HasScope(config.APIKeyScopeConfigWrite)
+}
@@ -7,10 +7,13 @@
 package api
 
 import (
+	"bufio"
 	"bytes"
+	"compress/flate"
 	"compress/gzip"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net"
@@ -324,7 +327,7 @@ func TestAPIServiceRequests(t *testing.T) {
 			URL:    "/rest/system/connections",
 			Code:   200,
 			Type:   "application/json",
-			Prefix: "null",
+			Prefix: "{",
 		},
 		{
 			URL:    "/rest/system/discovery",
@@ -430,6 +433,16 @@ func TestAPIServiceRequests(t *testing.T) {
 			Type:   "application/json",
 			Prefix: "{",
 		},
+		{
+			URL:    "/rest/config/downgrade?version=36",
+			Code:   200,
+			Type:   "application/xml",
+			Prefix: "<!-- Downgraded",
+		},
+		{
+			URL:  "/rest/config/downgrade?version=1",
+			Code: 400,
+		},
 	}
 
 	for _, tc := range cases {
@@ -1094,6 +1107,535 @@ func TestCSRFRequired(t *testing.T) {
 			t.Fatal("Getting /rest/system/config with API key should succeed, not", resp.Status)
 		}
 	})
+
+	t.Run("/metrics without the API key should fail", func(t *testing.T) {
+		t.Parallel()
+		resp, err := cli.Get(baseURL + "/metrics")
+		if err != nil {
+			t.Fatal("Unexpected error from getting /metrics:", err)
+		}
+		resp.Body.Close()
+		if resp.StatusCode != http.StatusForbidden {
+			t.Fatal("Getting /metrics without API key should fail, not", resp.Status)
+		}
+	})
+
+	t.Run("/metrics with the API key should succeed", func(t *testing.T) {
+		t.Parallel()
+		req, _ := http.NewRequest("GET", baseURL+"/metrics", nil)
+		req.Header.Set("X-API-Key", testAPIKey)
+		resp, err := cli.Do(req)
+		if err != nil {
+			t.Fatal("Unexpected error from getting /metrics:", err)
+		}
+		bs, _ := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			t.Fatal("Getting /metrics with API key should succeed, not", resp.Status)
+		}
+		if !bytes.Contains(bs, []byte("syncthing_db_operations_total")) {
+			t.Error("Expected database metrics in /metrics")
+		}
+	})
+}
+
+func TestPendingAPIKey(t *testing.T) {
+	t.Parallel()
+
+	const pendingAPIKey = "pendingfoobar"
+	cfg := newMockedConfig()
+	cfg.GUIReturns(config.GUIConfiguration{
+		User:          "user",
+		RawAddress:    "127.0.0.1:0",
+		APIKey:        testAPIKey,
+		PendingAPIKey: pendingAPIKey,
+	})
+	baseURL, cancel, err := startHTTP(cfg)
+	if err != nil {
+		t.Fatal("Unexpected error from getting base URL:", err)
+	}
+	t.Cleanup(cancel)
+
+	cli := &http.Client{
+		Timeout: time.Minute,
+	}
+
+	cases := []struct {
+		method string
+		path   string
+		status int
+	}{
+		{http.MethodGet, "/rest/cluster/pending/devices", http.StatusOK},
+		// The mocked model has no pending devices, so getting past
+		// authentication results in not found.
+		{http.MethodPost, "/rest/cluster/pending/devices/accept?device=" + protocol.LocalDeviceID.String(), http.StatusNotFound},
+		{http.MethodDelete, "/rest/cluster/pending/devices?device=" + protocol.LocalDeviceID.String(), http.StatusForbidden},
+		{http.MethodGet, "/rest/system/config", http.StatusForbidden},
+		{http.MethodPost, "/rest/system/shutdown", http.StatusForbidden},
+	}
+	for _, tc := range cases {
+		for _, bearer := range []bool{false, true} {
+			req, _ := http.NewRequest(tc.method, baseURL+tc.path, nil)
+			if bearer {
+				req.Header.Set("Authorization", "Bearer "+pendingAPIKey)
+			} else {
+				req.Header.Set("X-API-Key", pendingAPIKey)
+			}
+			resp, err := cli.Do(req)
+			if err != nil {
+				t.Fatal(err)
+			}
+			resp.Body.Close()
+			if resp.StatusCode != tc.status {
+				t.Errorf("%s %s with pending API key: expected %d, got %s", tc.method, tc.path, tc.status, resp.Status)
+			}
+		}
+	}
+}
+
+func TestRedactSecrets(t *testing.T) {
+	t.Parallel()
+
+	// Each secret in the config, as set and read back.
+	fields := []struct {
+		name string
+		set  func(cfg *config.Configuration, secret string)
+		get  func(cfg config.Configuration) string
+	}{
+		{"GUI password", func(cfg *config.Configuration, s string) { cfg.GUI.Password = s }, func(cfg config.Configuration) string { return cfg.GUI.Password }},
+		{"API key", func(cfg *config.Configuration, s string) { cfg.GUI.APIKey = s }, func(cfg config.Configuration) string { return cfg.GUI.APIKey }},
+		{"pending API key", func(cfg *config.Configuration, s string) { cfg.GUI.PendingAPIKey = s }, func(cfg config.Configuration) string { return cfg.GUI.PendingAPIKey }},
+		{"OIDC client secret", func(cfg *config.Configuration, s string) { cfg.GUI.OIDC.ClientSecret = s }, func(cfg config.Configuration) string { return cfg.GUI.OIDC.ClientSecret }},
+		{"ACME DNS command", func(cfg *config.Configuration, s string) { cfg.GUI.ACME.DNSCommand = s }, func(cfg config.Configuration) string { return cfg.GUI.ACME.DNSCommand }},
+		{"GUI user password", func(cfg *config.Configuration, s string) { cfg.GUI.Users = []config.GUIUser{{Name: "u", Password: s}} }, func(cfg config.Configuration) string { return cfg.GUI.Users[0].Password }},
+		{"scoped API key", func(cfg *config.Configuration, s string) { cfg.GUI.APIKeys = []config.ScopedAPIKey{{Key: s}} }, func(cfg config.Configuration) string { return cfg.GUI.APIKeys[0].Key }},
+		{"LDAP bind password", func(cfg *config.Configuration, s string) { cfg.LDAP.ServiceBindPassword = s }, func(cfg config.Configuration) string { return cfg.LDAP.ServiceBindPassword }},
+		{"MQTT password", func(cfg *config.Configuration, s string) { cfg.Options.MQTTPassword = s }, func(cfg config.Configuration) string { return cfg.Options.MQTTPassword }},
+		{"obfuscation secret", func(cfg *config.Configuration, s string) { cfg.Options.ObfuscationSecret = s }, func(cfg config.Configuration) string { return cfg.Options.ObfuscationSecret }},
+		{"webhook secret", func(cfg *config.Configuration, s string) {
+			cfg.Options.EventWebhooks = []config.EventWebhook{{Secret: s}}
+		}, func(cfg config.Configuration) string { return cfg.Options.EventWebhooks[0].Secret }},
+		{"proxy credentials", func(cfg *config.Configuration, s string) {
+			cfg.Options.ProxyURL = proxyURLWith(s)
+		}, func(cfg config.Configuration) string { return proxyCredentials(cfg.Options.ProxyURL) }},
+		{"device proxy credentials", func(cfg *config.Configuration, s string) {
+			cfg.Devices = []config.DeviceConfiguration{{ProxyURL: proxyURLWith(s)}}
+		}, func(cfg config.Configuration) string { return proxyCredentials(cfg.Devices[0].ProxyURL) }},
+		{"folder encryption password", func(cfg *config.Configuration, s string) {
+			cfg.Folders = []config.FolderConfiguration{{Devices: []config.FolderDeviceConfiguration{{EncryptionPassword: s}}}}
+		}, func(cfg config.Configuration) string { return cfg.Folders[0].Devices[0].EncryptionPassword }},
+		{"default folder encryption password", func(cfg *config.Configuration, s string) {
+			cfg.Defaults.Folder.Devices = []config.FolderDeviceConfiguration{{EncryptionPassword: s}}
+		}, func(cfg config.Configuration) string { return cfg.Defaults.Folder.Devices[0].EncryptionPassword }},
+	}
+	for _, field := range fields {
+		var cfg config.Configuration
+		field.set(&cfg, "secret")
+		redactSecrets(&cfg)
+		if got := field.get(cfg); got != "REDACTED" {
+			t.Errorf("%s: got %q after redacting", field.name, got)
+		}
+
+		// Unset secrets stay unset, showing there is none.
+		cfg = config.Configuration{}
+		field.set(&cfg, "")
+		redactSecrets(&cfg)
+		if got := field.get(cfg); got != "" {
+			t.Errorf("%s: got %q for an empty secret", field.name, got)
+		}
+	}
+
+	// A proxy without credentials is left as it is.
+	cfg := config.Configuration{Options: config.OptionsConfiguration{ProxyURL: "socks5://proxy:1080"}}
+	redactSecrets(&cfg)
+	if cfg.Options.ProxyURL != "socks5://proxy:1080" {
+		t.Errorf("got %q for a proxy without credentials", cfg.Options.ProxyURL)
+	}
+}
+
+// proxyURLWith returns a proxy URL carrying the secret as its userinfo, or
+// no URL for an empty secret.
+func proxyURLWith(secret string) string {
+	if secret == "" {
+		return ""
+	}
+	return "http://user:" + secret + "@proxy:8080"
+}
+
+// proxyCredentials returns the userinfo of a proxy URL from proxyURLWith.
+func proxyCredentials(proxyURL string) string {
+	return strings.TrimSuffix(strings.TrimPrefix(proxyURL, "http://"), "@proxy:8080")
+}
+
+func TestGUIUserRoles(t *testing.T) {
+	t.Parallel()
+
+	gui := config.GUIConfiguration{
+		User:       "admin",
+		RawAddress: "127.0.0.1:0",
+		APIKey:     testAPIKey,
+		Users: []config.GUIUser{
+			{Name: "reader", Role: config.UserRoleReadOnly},
+			{Name: "operator", Role: config.UserRoleFolderOperator, Folders: []string{"default"}},
+		},
+	}
+	if err := gui.SetPassword("adminpass"); err != nil {
+		t.Fatal(err)
+	}
+	for i := range gui.Users {
+		if err := gui.Users[i].SetPassword(gui.Users[i].Name + "pass"); err != nil {
+			t.Fatal(err)
+		}
+	}
+	opts := config.OptionsConfiguration{
+		MQTTPassword:      "mqtt-secret",
+		ObfuscationSecret: "obfuscation-secret",
+		EventWebhooks:     []config.EventWebhook{{URL: "https://example.com/", Secret: "webhook-secret"}},
+	}
+	folder := config.FolderConfiguration{
+		ID:      "default",
+		Devices: []config.FolderDeviceConfiguration{{DeviceID: dev1, EncryptionPassword: "folder-secret"}},
+	}
+	cfg := newMockedConfig()
+	cfg.GUIReturns(gui)
+	// Fresh copies each time, as the real wrapper does, so that redacting
+	// for one request doesn't affect the next.
+	cfg.RawCopyCalls(func() config.Configuration {
+		return config.Configuration{Version: config.CurrentVersion, GUI: gui.Copy(), Options: opts.Copy(), Folders: []config.FolderConfiguration{folder.Copy()}}
+	})
+	cfg.OptionsCalls(opts.Copy)
+	cfg.FolderListCalls(func() []config.FolderConfiguration { return []config.FolderConfiguration{folder.Copy()} })
+	cfg.FolderCalls(func(string) (config.FolderConfiguration, bool) { return folder.Copy(), true })
+	cfg.DefaultFolderCalls(folder.Copy)
+	baseURL, cancel, err := startHTTP(cfg)
+	if err != nil {
+		t.Fatal("Unexpected error from getting base URL:", err)
+	}
+	t.Cleanup(cancel)
+
+	cli := &http.Client{
+		Timeout: time.Minute,
+	}
+
+	// Get a CSRF token, as logged in users need one.
+	resp, err := cli.Get(baseURL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	var csrfTokenName, csrfTokenValue string
+	for _, cookie := range resp.Cookies() {
+		if strings.HasPrefix(cookie.Name, "CSRF-Token") {
+			csrfTokenName = cookie.Name
+			csrfTokenValue = cookie.Value
+		}
+	}
+
+	do := func(user, method, path string) *http.Response {
+		t.Helper()
+		req, _ := http.NewRequest(method, baseURL+path, nil)
+		req.SetBasicAuth(user, user+"pass")
+		req.Header.Set("X-"+csrfTokenName, csrfTokenValue)
+		resp, err := cli.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return resp
+	}
+
+	cases := []struct {
+		user   string
+		method string
+		path   string
+		status int
+	}{
+		{"admin", http.MethodGet, "/rest/config/gui", http.StatusOK},
+		{"admin", http.MethodGet, "/rest/system/sessions", http.StatusOK},
+		{"admin", http.MethodPost, "/rest/db/scan?folder=other", http.StatusOK},
+		{"reader", http.MethodGet, "/rest/system/version", http.StatusOK},
+		{"reader", http.MethodGet, "/rest/config/gui", http.StatusForbidden},
+		{"reader", http.MethodGet, "/rest/system/sessions", http.StatusForbidden},
+		{"reader", http.MethodPost, "/rest/db/scan?folder=default", http.StatusForbidden},
+		{"operator", http.MethodPost, "/rest/db/scan?folder=default", http.StatusOK},
+		{"operator", http.MethodPost, "/rest/db/scan?folder=other", http.StatusForbidden},
+		{"operator", http.MethodPost, "/rest/db/scan", http.StatusForbidden},
+		{"operator", http.MethodPost, "/rest/system/shutdown", http.StatusForbidden},
+		{"operator", http.MethodPut, "/rest/config/folders/default", http.StatusForbidden},
+		{"stranger", http.MethodGet, "/rest/system/version", http.StatusForbidden},
+	}
+	for _, tc := range cases {
+		resp := do(tc.user, tc.method, tc.path)
+		resp.Body.Close()
+		if resp.StatusCode != tc.status {
+			t.Errorf("%s %s as %s: expected %d, got %s", tc.method, tc.path, tc.user, tc.status, resp.Status)
+		}
+	}
+
+	// Secrets in the config are only shown to admins.
+	for user, redacted := range map[string]bool{"admin": false, "reader": true} {
+		resp := do(user, http.MethodGet, "/rest/config")
+		var got config.Configuration
+		err := json.NewDecoder(resp.Body).Decode(&got)
+		resp.Body.Close()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if (got.GUI.APIKey == "REDACTED") != redacted || (got.GUI.Users[0].Password == "REDACTED") != redacted {
+			t.Errorf("Config as %s: expected redacted %v, got API key %q", user, redacted, got.GUI.APIKey)
+		}
+	}
+
+	// Nor anywhere else in the config.
+	all := []string{"mqtt-secret", "obfuscation-secret", "webhook-secret", "folder-secret"}
+	for path, secrets := range map[string][]string{
+		"/rest/config":                      all,
+		"/rest/config/downgrade?version=37": all,
+		"/rest/config/options":              {"mqtt-secret", "obfuscation-secret", "webhook-secret"},
+		"/rest/config/folders":              {"folder-secret"},
+		"/rest/config/folders/default":      {"folder-secret"},
+		"/rest/config/defaults/folder":      {"folder-secret"},
+	} {
+		for user, redacted := range map[string]bool{"admin": false, "reader": true} {
+			resp := do(user, http.MethodGet, path)
+			bs, err := io.ReadAll(resp.Body)
+			resp.Body.Close()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if resp.StatusCode != http.StatusOK {
+				t.Errorf("%s as %s: %s", path, user, resp.Status)
+				continue
+			}
+			for _, secret := range secrets {
+				if strings.Contains(string(bs), secret) == redacted {
+					t.Errorf("%s as %s: expected redacted %v for %s", path, user, redacted, secret)
+				}
+			}
+		}
+	}
+
+	// The sessions created by the basic auth requests are listed, and can
+	// be removed by user.
+	var sessions []struct {
+		ID   string
+		User string
+	}
+	resp = do("admin", http.MethodGet, "/rest/system/sessions")
+	err = json.NewDecoder(resp.Body).Decode(&sessions)
+	resp.Body.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+	users := make(map[string]int)
+	for _, sess := range sessions {
+		if sess.ID == "" {
+			t.Error("Session without ID")
+		}
+		users[sess.User]++
+	}
+	if users["reader"] == 0 || users["operator"] == 0 {
+		t.Errorf("Expected sessions for reader and operator, got %v", users)
+	}
+
+	resp = do("admin", http.MethodDelete, "/rest/system/sessions?user=reader")
+	var removed struct{ Removed int }
+	err = json.NewDecoder(resp.Body).Decode(&removed)
+	resp.Body.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if removed.Removed != users["reader"] {
+		t.Errorf("Expected %d removed sessions, got %d", users["reader"], removed.Removed)
+	}
+
+	resp = do("admin", http.MethodDelete, "/rest/system/sessions")
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusBadRequest {
+		t.Errorf("Removing sessions without id or user: expected %d, got %s", http.StatusBadRequest, resp.Status)
+	}
+}
+
+func TestSessionCSRF(t *testing.T) {
+	t.Parallel()
+
+	gui := config.GUIConfiguration{
+		User:       "admin",
+		RawAddress: "127.0.0.1:0",
+		APIKey:     testAPIKey,
+		Users: []config.GUIUser{
+			{Name: "reader", Role: config.UserRoleReadOnly},
+		},
+	}
+	if err := gui.SetPassword("adminpass"); err != nil {
+		t.Fatal(err)
+	}
+	if err := gui.Users[0].SetPassword("readerpass"); err != nil {
+		t.Fatal(err)
+	}
+	cfg := newMockedConfig()
+	cfg.GUIReturns(gui)
+	baseURL, cancel, err := startHTTP(cfg)
+	if err != nil {
+		t.Fatal("Unexpected error from getting base URL:", err)
+	}
+	t.Cleanup(cancel)
+
+	type session struct {
+		cookie *http.Cookie
+		csrf   *http.Cookie
+	}
+	login := func(user string) session {
+		t.Helper()
+		resp := httpPost(baseURL+"/rest/noauth/auth/password", map[string]string{"Username": user, "Password": user + "pass"}, nil, t)
+		resp.Body.Close()
+		if resp.StatusCode != http.StatusNoContent {
+			t.Fatalf("Logging in as %s: %s", user, resp.Status)
+		}
+		var sess session
+		for _, cookie := range resp.Cookies() {
+			switch {
+			case strings.HasPrefix(cookie.Name, "sessionid"):
+				sess.cookie = cookie
+			case strings.HasPrefix(cookie.Name, "CSRF-Token"):
+				sess.csrf = cookie
+			}
+		}
+		if sess.cookie == nil || sess.csrf == nil {
+			t.Fatalf("Logging in as %s: expected session and CSRF cookies, got %v", user, resp.Cookies())
+		}
+		if !sess.cookie.HttpOnly || sess.cookie.SameSite != http.SameSiteStrictMode || sess.csrf.SameSite != http.SameSiteStrictMode {
+			t.Errorf("Logging in as %s: cookies should be SameSite=Strict, and the session HttpOnly", user)
+		}
+		return sess
+	}
+	do := func(sess session, method, path, csrf string) int {
+		t.Helper()
+		req, _ := http.NewRequest(method, baseURL+path, nil)
+		req.AddCookie(sess.cookie)
+		req.Header.Set("X-"+sess.csrf.Name, csrf)
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+		return resp.StatusCode
+	}
+
+	admin1, admin2, reader := login("admin"), login("admin"), login("reader")
+
+	// Each session has its own CSRF token.
+	if admin1.csrf.Value == admin2.csrf.Value {
+		t.Fatal("Sessions should have different CSRF tokens")
+	}
+	if code := do(admin1, http.MethodGet, "/rest/system/version", admin1.csrf.Value); code != http.StatusOK {
+		t.Errorf("Request with the session's CSRF token: expected %d, got %d", http.StatusOK, code)
+	}
+	if code := do(admin1, http.MethodGet, "/rest/system/version", admin2.csrf.Value); code != http.StatusForbidden {
+		t.Errorf("Request with another session's CSRF token: expected %d, got %d", http.StatusForbidden, code)
+	}
+
+	// Logging out the other sessions leaves the current one, and the
+	// sessions of other users.
+	if code := do(admin1, http.MethodDelete, "/rest/system/sessions?others=true", admin1.csrf.Value); code != http.StatusOK {
+		t.Errorf("Logging out other sessions: expected %d, got %d", http.StatusOK, code)
+	}
+	for name, tc := range map[string]struct {
+		sess session
+		code int
+	}{
+		"current": {admin1, http.StatusOK},
+		"other":   {admin2, http.StatusForbidden},
+		"reader":  {reader, http.StatusOK},
+	} {
+		if code := do(tc.sess, http.MethodGet, "/rest/system/version", tc.sess.csrf.Value); code != tc.code {
+			t.Errorf("Request in the %s session: expected %d, got %d", name, tc.code, code)
+		}
+	}
+
+	// Anyone may log out their other sessions, but not those of others.
+	if code := do(reader, http.MethodDelete, "/rest/system/sessions?others=true", reader.csrf.Value); code != http.StatusOK {
+		t.Errorf("Logging out other sessions as reader: expected %d, got %d", http.StatusOK, code)
+	}
+	if code := do(reader, http.MethodDelete, "/rest/system/sessions?user=admin", reader.csrf.Value); code != http.StatusForbidden {
+		t.Errorf("Logging out other users as reader: expected %d, got %d", http.StatusForbidden, code)
+	}
+}
+
+func TestScopedAPIKeys(t *testing.T) {
+	t.Parallel()
+
+	cfg := newMockedConfig()
+	cfg.GUIReturns(config.GUIConfiguration{
+		RawAddress: "127.0.0.1:0",
+		APIKey:     testAPIKey,
+		APIKeys: []config.ScopedAPIKey{
+			{Key: "reader"},
+			{Key: "configwriter", Scopes: []config.APIKeyScope{config.APIKeyScopeConfigWrite}},
+			{Key: "dbwriter", Scopes: []config.APIKeyScope{config.APIKeyScopeDBWrite}},
+			{Key: "expired", Scopes: []config.APIKeyScope{config.APIKeyScopeDBWrite}, Expires: time.Now().Add(-time.Minute)},
+		},
+	})
+	baseURL, cancel, err := startHTTP(cfg)
+	if err != nil {
+		t.Fatal("Unexpected error from getting base URL:", err)
+	}
+	t.Cleanup(cancel)
+
+	cli := &http.Client{
+		Timeout: time.Minute,
+	}
+
+	cases := []struct {
+		key    string
+		method string
+		path   string
+		status int
+	}{
+		{"reader", http.MethodGet, "/rest/system/version", http.StatusOK},
+		{"reader", http.MethodGet, "/rest/config/apikeys", http.StatusForbidden},
+		{"reader", http.MethodGet, "/rest/config/gui", http.StatusForbidden},
+		{"reader", http.MethodPost, "/rest/db/scan?folder=default", http.StatusForbidden},
+		{"reader", http.MethodPatch, "/rest/config/options", http.StatusForbidden},
+		{"dbwriter", http.MethodPost, "/rest/db/scan?folder=default", http.StatusOK},
+		{"dbwriter", http.MethodPatch, "/rest/config/options", http.StatusForbidden},
+		{"dbwriter", http.MethodPost, "/rest/system/shutdown", http.StatusForbidden},
+		{"configwriter", http.MethodPatch, "/rest/config/options", http.StatusOK},
+		{"configwriter", http.MethodPut, "/rest/config", http.StatusForbidden},
+		{"configwriter", http.MethodPatch, "/rest/config/gui", http.StatusForbidden},
+		{"configwriter", http.MethodPost, "/rest/config/apikeys", http.StatusForbidden},
+		{"configwriter", http.MethodPost, "/rest/db/scan?folder=default", http.StatusForbidden},
+		{"expired", http.MethodGet, "/rest/system/version", http.StatusForbidden},
+		{testAPIKey, http.MethodGet, "/rest/config/apikeys", http.StatusOK},
+	}
+	for _, tc := range cases {
+		req, _ := http.NewRequest(tc.method, baseURL+tc.path, strings.NewReader("{}"))
+		req.Header.Set("X-API-Key", tc.key)
+		resp, err := cli.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+		if resp.StatusCode != tc.status {
+			t.Errorf("%s %s with key %s: expected %d, got %s", tc.method, tc.path, tc.key, tc.status, resp.Status)
+		}
+	}
+
+	// Adding a key without one generates it.
+	req, _ := http.NewRequest(http.MethodPost, baseURL+"/rest/config/apikeys", strings.NewReader(`{"label": "script", "scopes": ["dbWrite"]}`))
+	req.Header.Set("X-API-Key", testAPIKey)
+	resp, err := cli.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var added config.ScopedAPIKey
+	err = json.NewDecoder(resp.Body).Decode(&added)
+	resp.Body.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if added.Key == "" || added.Label != "script" || !added.HasScope(config.APIKeyScopeDBWrite) {
+		t.Errorf("unexpected added key %+v", added)
+	}
 }
 
 func TestRandomString(t *testing.T) {
@@ -1515,6 +2057,206 @@ func TestEventMasks(t *testing.T) {
 	}
 }
 
+func TestEventFilter(t *testing.T) {
+	t.Parallel()
+
+	type structData struct {
+		Folder string            `json:"folder"`
+		Device protocol.DeviceID `json:"device"`
+	}
+	filter, err := newEventFilter("default", dev1.String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	cases := []struct {
+		ev      events.Event
+		matches bool
+	}{
+		{events.Event{Type: events.StateChanged, Data: map[string]interface{}{"folder": "default"}}, true},
+		{events.Event{Type: events.StateChanged, Data: map[string]interface{}{"folder": "other"}}, false},
+		{events.Event{Type: events.FolderPaused, Data: map[string]string{"id": "other"}}, false},
+		{events.Event{Type: events.DeviceConnected, Data: map[string]string{"id": dev1.String()}}, true},
+		{events.Event{Type: events.DeviceDisconnected, Data: map[string]string{"id": protocol.LocalDeviceID.String()}}, false},
+		{events.Event{Type: events.RemoteIndexUpdated, Data: structData{"default", dev1}}, true},
+		{events.Event{Type: events.RemoteIndexUpdated, Data: structData{"default", protocol.LocalDeviceID}}, false},
+		{events.Event{Type: events.ConfigSaved, Data: config.Configuration{}}, true},
+		{events.Event{Type: events.Failure, Data: "something failed"}, true},
+	}
+	for i, tc := range cases {
+		if res := filter.matches(tc.ev); res != tc.matches {
+			t.Errorf("%d: %v matches = %v, expected %v", i, tc.ev.Data, res, tc.matches)
+		}
+	}
+
+	if _, err := newEventFilter("", "invalid"); err == nil {
+		t.Error("expected an error for an invalid device ID")
+	}
+}
+
+func TestGetEventsFiltered(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	evLogger := events.NewLogger()
+	go evLogger.Serve(ctx)
+	sub := events.NewBufferedSubscription(evLogger.Subscribe(events.StateChanged), EventSubBufferSize)
+
+	go func() {
+		evLogger.Log(events.StateChanged, map[string]interface{}{"folder": "other"})
+		time.Sleep(100 * time.Millisecond)
+		evLogger.Log(events.StateChanged, map[string]interface{}{"folder": "default"})
+	}()
+
+	svc := &service{}
+	req := httptest.NewRequest(http.MethodGet, "/rest/events?folder=default&timeout=10", nil)
+	rec := httptest.NewRecorder()
+	svc.getEvents(rec, req, sub)
+
+	var evs []events.Event
+	if err := json.Unmarshal(rec.Body.Bytes(), &evs); err != nil {
+		t.Fatal(err)
+	}
+	if len(evs) != 1 {
+		t.Fatalf("expected one event, got %d", len(evs))
+	}
+	if data := evs[0].Data.(map[string]interface{}); data["folder"] != "default" {
+		t.Errorf("got event for folder %v", data["folder"])
+	}
+}
+
+func TestConflictsResolveStatus(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		keep   string
+		err    error
+		status int
+	}{
+		{"conflict", nil, http.StatusOK},
+		{"current", nil, http.StatusOK},
+		{"neither", nil, http.StatusBadRequest},
+		{"conflict", model.ErrNotConflict, http.StatusBadRequest},
+		{"conflict", model.ErrNoSuchConflict, http.StatusNotFound},
+		{"conflict", model.ErrFolderMissing, http.StatusNotFound},
+		{"conflict", errors.New("disk on fire"), http.StatusInternalServerError},
+	}
+	for _, tc := range cases {
+		m := new(modelmocks.Model)
+		m.ResolveConflictReturns(tc.err)
+		svc := &service{model: m}
+		rec := httptest.NewRecorder()
+		svc.postDBConflictsResolve(rec, httptest.NewRequest(http.MethodPost, "/rest/db/conflicts/resolve?folder=default&name=a.txt&keep="+tc.keep, nil))
+		if rec.Code != tc.status {
+			t.Errorf("keep %s, error %v: expected status %d, got %d", tc.keep, tc.err, tc.status, rec.Code)
+		}
+	}
+}
+
+func TestEventsWebSocket(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	evLogger := events.NewLogger()
+	go evLogger.Serve(ctx)
+	sub := events.NewBufferedSubscription(evLogger.Subscribe(events.StateChanged), EventSubBufferSize)
+
+	svc := &service{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		svc.streamEvents(w, r, sub)
+	}))
+	defer srv.Close()
+
+	conn, err := net.Dial("tcp", srv.Listener.Addr().String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	conn.SetDeadline(time.Now().Add(10 * time.Second))
+
+	req, _ := http.NewRequest(http.MethodGet, srv.URL+"/rest/events/ws?folder=default", nil)
+	req.Header.Set("Connection", "Upgrade")
+	req.Header.Set("Upgrade", "websocket")
+	req.Header.Set("Sec-WebSocket-Version", "13")
+	req.Header.Set("Sec-WebSocket-Key", "dGhlIHNhbXBsZSBub25jZQ==")
+	req.Header.Set("Sec-WebSocket-Extensions", "permessage-deflate; client_max_window_bits")
+	if err := req.Write(conn); err != nil {
+		t.Fatal(err)
+	}
+	br := bufio.NewReader(conn)
+	resp, err := http.ReadResponse(br, req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.StatusCode != http.StatusSwitchingProtocols {
+		t.Fatal("unexpected status", resp.Status)
+	}
+	if accept := resp.Header.Get("Sec-WebSocket-Accept"); accept != "s3pPLMBiTxaQ9kYGzzhZRbK+xOo=" {
+		t.Errorf("unexpected accept %q", accept)
+	}
+	if !strings.HasPrefix(resp.Header.Get("Sec-WebSocket-Extensions"), "permessage-deflate") {
+		t.Fatal("deflate was not negotiated")
+	}
+
+	evLogger.Log(events.StateChanged, map[string]interface{}{"folder": "other"})
+	evLogger.Log(events.StateChanged, map[string]interface{}{"folder": "default"})
+
+	var hdr [2]byte
+	if _, err := io.ReadFull(br, hdr[:]); err != nil {
+		t.Fatal(err)
+	}
+	if hdr[0] != wsFlagFin|wsFlagRSV1|wsOpText || hdr[1] >= 126 {
+		t.Fatalf("unexpected frame header %x", hdr)
+	}
+	payload := make([]byte, hdr[1])
+	if _, err := io.ReadFull(br, payload); err != nil {
+		t.Fatal(err)
+	}
+	payload = append(payload, 0, 0, 0xff, 0xff)
+	var ev events.Event
+	if err := json.NewDecoder(flate.NewReader(bytes.NewReader(payload))).Decode(&ev); err != nil {
+		t.Fatal(err)
+	}
+	if data := ev.Data.(map[string]interface{}); ev.Type != events.StateChanged || data["folder"] != "default" {
+		t.Errorf("unexpected event %+v", ev)
+	}
+
+	// A masked close frame with status 1000 is echoed.
+	mask := []byte{1, 2, 3, 4}
+	closeFrame := []byte{wsFlagFin | wsOpClose, wsFlagMask | 2}
+	closeFrame = append(closeFrame, mask...)
+	closeFrame = append(closeFrame, 0x03^mask[0], 0xe8^mask[1])
+	if _, err := conn.Write(closeFrame); err != nil {
+		t.Fatal(err)
+	}
+	reply := make([]byte, 4)
+	if _, err := io.ReadFull(br, reply); err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(reply, []byte{wsFlagFin | wsOpClose, 2, 0x03, 0xe8}) {
+		t.Errorf("unexpected close reply %x", reply)
+	}
+}
+
+func TestAcceptsDeflate(t *testing.T) {
+	cases := []struct {
+		offer  string
+		accept bool
+	}{
+		{"", false},
+		{"permessage-deflate", true},
+		{"x-webkit-deflate-frame, permessage-deflate; client_max_window_bits", true},
+		{"permessage-deflate; server_max_window_bits=10", false},
+		{"permessage-deflate; server_max_window_bits=10, permessage-deflate", true},
+	}
+	for _, tc := range cases {
+		if accept := acceptsDeflate([]string{tc.offer}); accept != tc.accept {
+			t.Errorf("%q: got %v, expected %v", tc.offer, accept, tc.accept)
+		}
+	}
+}
+
 func TestBrowse(t *testing.T) {
 	t.Parallel()
 
@@ -1717,8 +2459,15 @@ func TestConfigChanges(t *testing.T) {
 		t.Error("Expected folder to be paused")
 	}
 
-	// Delete folder2
+	// Protected folders are only deleted with a confirmation
+	mod(http.MethodPatch, folder2Path, map[string]bool{"protected": true})
 	req, _ := http.NewRequest(http.MethodDelete, baseURL+folder2Path, nil)
+	do(req, http.StatusConflict)
+	req, _ = http.NewRequest(http.MethodDelete, baseURL+folder2Path+"?confirm=folder1", nil)
+	do(req, http.StatusConflict)
+
+	// Delete folder2
+	req, _ = http.NewRequest(http.MethodDelete, baseURL+folder2Path+"?confirm=folder2", nil)
 	do(req, http.StatusOK)
 
 	// Check folder1 is still there and folder2 gone
@@ -1726,6 +2475,32 @@ func TestConfigChanges(t *testing.T) {
 	req, _ = http.NewRequest(http.MethodGet, baseURL+folder2Path, nil)
 	do(req, http.StatusNotFound)
 
+	// Nor are they dropped by setting the folders, which only adds and
+	// replaces, or by replacing the whole config
+	put := func(path string, data interface{}, status int) {
+		t.Helper()
+		bs, err := json.Marshal(data)
+		if err != nil {
+			t.Fatal(err)
+		}
+		req, _ := http.NewRequest(http.MethodPut, baseURL+path, bytes.NewReader(bs))
+		do(req, status).Body.Close()
+	}
+	mod(http.MethodPut, folder2Path, config.FolderConfiguration{ID: "folder2", Path: "folder2", Protected: true})
+	put("/rest/config/folders", []config.FolderConfiguration{{ID: "folder1", Path: "folder1"}}, http.StatusOK)
+	get(folder2Path).Body.Close()
+	resp = get("/rest/config")
+	var full config.Configuration
+	if err := unmarshalTo(resp.Body, &full); err != nil {
+		t.Fatal(err)
+	}
+	full.Folders = slices.DeleteFunc(full.Folders, func(f config.FolderConfiguration) bool { return f.ID == "folder2" })
+	put("/rest/config", full, http.StatusConflict)
+	get(folder2Path).Body.Close()
+	put("/rest/config?confirm=folder2", full, http.StatusOK)
+	req, _ = http.NewRequest(http.MethodGet, baseURL+folder2Path, nil)
+	do(req, http.StatusNotFound)
+
 	mod(http.MethodPatch, "/rest/config/options", map[string]int{"maxSendKbps": 50})
 	resp = get("/rest/config/options")
 	var opts config.OptionsConfiguration
@@ -1735,6 +2510,106 @@ func TestConfigChanges(t *testing.T) {
 	if opts.MaxSendKbps != 50 {
 		t.Error("Expected 50 for MaxSendKbps, got", opts.MaxSendKbps)
 	}
+
+	// Same for protected devices
+	mod(http.MethodPatch, dev1Path, map[string]bool{"protected": true})
+	req, _ = http.NewRequest(http.MethodDelete, baseURL+dev1Path, nil)
+	do(req, http.StatusConflict)
+	put("/rest/config/devices", []config.DeviceConfiguration{}, http.StatusOK)
+	get(dev1Path).Body.Close()
+	resp = get("/rest/config")
+	full = config.Configuration{}
+	if err := unmarshalTo(resp.Body, &full); err != nil {
+		t.Fatal(err)
+	}
+	full.Devices = nil
+	put("/rest/config", full, http.StatusConflict)
+	get(dev1Path).Body.Close()
+	req, _ = http.NewRequest(http.MethodDelete, baseURL+dev1Path+"?confirm="+dev1.String(), nil)
+	do(req, http.StatusOK)
+}
+
+func TestBatchActions(t *testing.T) {
+	t.Parallel()
+
+	const testAPIKey = "foobarbaz"
+	dev2 := protocol.NewDeviceID([]byte("dev2"))
+	cfg := config.Configuration{
+		GUI: config.GUIConfiguration{
+			RawAddress: "127.0.0.1:0",
+			RawUseTLS:  false,
+			APIKey:     testAPIKey,
+		},
+		Devices: []config.DeviceConfiguration{{DeviceID: dev1}, {DeviceID: dev2}},
+		Folders: []config.FolderConfiguration{{ID: "folder1", Path: "folder1"}, {ID: "folder2", Path: "folder2"}},
+	}
+	tmpFile, err := os.CreateTemp("", "syncthing-testConfig-")
+	if err != nil {
+		panic(err)
+	}
+	defer os.Remove(tmpFile.Name())
+	w := config.Wrap(tmpFile.Name(), cfg, protocol.LocalDeviceID, events.NoopLogger)
+	tmpFile.Close()
+	cfgCtx, cfgCancel := context.WithCancel(context.Background())
+	go w.Serve(cfgCtx)
+	defer cfgCancel()
+	baseURL, cancel, err := startHTTP(w)
+	if err != nil {
+		t.Fatal("Unexpected error from getting base URL:", err)
+	}
+	defer cancel()
+
+	cli := &http.Client{
+		Timeout: time.Minute,
+	}
+
+	post := func(path string, ids []string, action string, status int) {
+		t.Helper()
+		bs, err := json.Marshal(batchRequest{IDs: ids, Action: action})
+		if err != nil {
+			t.Fatal(err)
+		}
+		req, _ := http.NewRequest(http.MethodPost, baseURL+path, bytes.NewReader(bs))
+		req.Header.Set("X-API-Key", testAPIKey)
+		resp, err := cli.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+		if resp.StatusCode != status {
+			t.Errorf("%s %s: expected status %v, got %v", path, action, status, resp.StatusCode)
+		}
+	}
+
+	devices := []string{dev1.String(), dev2.String()}
+	post("/rest/config/devices:batch", devices, batchPause, http.StatusOK)
+	for _, dev := range []protocol.DeviceID{dev1, dev2} {
+		if devCfg, _ := w.Device(dev); !devCfg.Paused {
+			t.Errorf("Expected device %v to be paused", dev)
+		}
+	}
+
+	// Nothing is resumed if any device is unknown.
+	post("/rest/config/devices:batch", append(devices, protocol.NewDeviceID([]byte("dev3")).String()), batchResume, http.StatusNotFound)
+	if devCfg, _ := w.Device(dev1); !devCfg.Paused {
+		t.Error("Expected device to still be paused")
+	}
+	post("/rest/config/devices:batch", devices, batchRescan, http.StatusBadRequest)
+	post("/rest/config/devices:other", devices, batchResume, http.StatusNotFound)
+
+	folders := []string{"folder1", "folder2"}
+	post("/rest/db/folders:batch", folders, batchPause, http.StatusOK)
+	for _, id := range folders {
+		if folderCfg, _ := w.Folder(id); !folderCfg.Paused {
+			t.Errorf("Expected folder %v to be paused", id)
+		}
+	}
+	post("/rest/db/folders:batch", append(folders, "folder3"), batchResume, http.StatusNotFound)
+	if folderCfg, _ := w.Folder("folder1"); !folderCfg.Paused {
+		t.Error("Expected folder to still be paused")
+	}
+	post("/rest/db/folders:batch", folders, batchRescan, http.StatusOK)
+	post("/rest/db/folders:batch", folders, "delete", http.StatusBadRequest)
 }
 
 func TestSanitizedHostname(t *testing.T) {
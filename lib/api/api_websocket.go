@@ -0,0 +1,327 @@
+// Copyright (C) 2026 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package api
+
+import (
+	"bufio"
+	"bytes"
+	"compress/flate"
+	"crypto/sha1"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/syncthing/syncthing/lib/events"
+)
+
+// The subset of RFC 6455 (WebSocket) and RFC 7692 (permessage-deflate)
+// needed to push events to clients. Messages from the client are read only
+// to answer pings and closes.
+
+const (
+	wsAcceptGUID     = "258EAFA5-E914-47DA-95CA-C5AB0DC85B11"
+	wsPingInterval   = 30 * time.Second
+	wsWriteTimeout   = 10 * time.Second
+	wsMaxControlSize = 125
+
+	wsOpText  = 0x1
+	wsOpClose = 0x8
+	wsOpPing  = 0x9
+	wsOpPong  = 0xa
+
+	wsFlagFin  = 0x80
+	wsFlagRSV1 = 0x40
+	wsFlagMask = 0x80
+
+	// We keep no compression context between messages, so that an idle
+	// connection holds no compressor state.
+	wsDeflateResponse = "permessage-deflate; server_no_context_takeover; client_no_context_takeover"
+)
+
+var errWebSocketHandshake = errors.New("not a WebSocket handshake")
+
+func (s *service) getEventsWebSocket(w http.ResponseWriter, r *http.Request) {
+	mask := s.getEventMask(r.URL.Query().Get("events"))
+	sub := s.getEventSub(mask)
+	s.streamEvents(w, r, sub)
+}
+
+// streamEvents upgrades the request to a WebSocket and sends each event as a
+// JSON text message, starting after the given since.
+func (*service) streamEvents(w http.ResponseWriter, r *http.Request, eventSub events.BufferedSubscription) {
+	qs := r.URL.Query()
+	since, _ := strconv.Atoi(qs.Get("since"))
+	filter, err := newEventFilter(qs.Get("folder"), qs.Get("device"))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	ws, err := upgradeWebSocket(w, r)
+	if err != nil {
+		l.Debugln("WebSocket upgrade:", err)
+		return
+	}
+	defer ws.close()
+	go ws.readLoop()
+
+	for {
+		evs := eventSub.Since(since, nil, wsPingInterval)
+		select {
+		case <-ws.closed:
+			return
+		default:
+		}
+		if len(evs) == 0 {
+			if err := ws.writeFrame(wsOpPing, nil, false); err != nil {
+				return
+			}
+			continue
+		}
+		since = evs[len(evs)-1].SubscriptionID
+		for _, ev := range filter.apply(evs) {
+			bs, err := json.Marshal(ev)
+			if err != nil {
+				l.Debugln("WebSocket event:", err)
+				continue
+			}
+			if err := ws.writeMessage(bs); err != nil {
+				return
+			}
+		}
+	}
+}
+
+type webSocketConn struct {
+	conn      net.Conn
+	rd        *bufio.Reader
+	deflate   bool
+	writeMut  sync.Mutex
+	closed    chan struct{}
+	closeOnce sync.Once
+}
+
+// upgradeWebSocket validates the handshake, responding with an error if it
+// isn't one, and takes over the connection.
+func upgradeWebSocket(w http.ResponseWriter, r *http.Request) (*webSocketConn, error) {
+	key := r.Header.Get("Sec-WebSocket-Key")
+	if r.Method != http.MethodGet || !headerHasToken(r.Header, "Connection", "upgrade") ||
+		!headerHasToken(r.Header, "Upgrade", "websocket") || key == "" {
+		http.Error(w, errWebSocketHandshake.Error(), http.StatusBadRequest)
+		return nil, errWebSocketHandshake
+	}
+	if r.Header.Get("Sec-WebSocket-Version") != "13" {
+		w.Header().Set("Sec-WebSocket-Version", "13")
+		http.Error(w, "unsupported WebSocket version", http.StatusUpgradeRequired)
+		return nil, errWebSocketHandshake
+	}
+	deflate := acceptsDeflate(r.Header.Values("Sec-WebSocket-Extensions"))
+
+	conn, brw, err := http.NewResponseController(w).Hijack()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return nil, err
+	}
+	// Clear the deadlines of the HTTP server.
+	conn.SetDeadline(time.Time{})
+
+	sum := sha1.Sum([]byte(key + wsAcceptGUID))
+	var resp strings.Builder
+	resp.WriteString("HTTP/1.1 101 Switching Protocols\r\n")
+	resp.WriteString("Upgrade: websocket\r\n")
+	resp.WriteString("Connection: Upgrade\r\n")
+	resp.WriteString("Sec-WebSocket-Accept: " + base64.StdEncoding.EncodeToString(sum[:]) + "\r\n")
+	if deflate {
+		resp.WriteString("Sec-WebSocket-Extensions: " + wsDeflateResponse + "\r\n")
+	}
+	resp.WriteString("\r\n")
+	conn.SetWriteDeadline(time.Now().Add(wsWriteTimeout))
+	if _, err := conn.Write([]byte(resp.String())); err != nil {
+		conn.Close()
+		return nil, err
+	}
+
+	return &webSocketConn{
+		conn:    conn,
+		rd:      brw.Reader,
+		deflate: deflate,
+		closed:  make(chan struct{}),
+	}, nil
+}
+
+// acceptsDeflate returns whether one of the offered extensions is
+// permessage-deflate with parameters we can honour. We always use the full
+// window, so an offer limiting the server window is declined.
+func acceptsDeflate(values []string) bool {
+	for _, value := range values {
+	offers:
+		for _, offer := range strings.Split(value, ",") {
+			params := strings.Split(offer, ";")
+			if strings.TrimSpace(params[0]) != "permessage-deflate" {
+				continue
+			}
+			for _, param := range params[1:] {
+				name, val, _ := strings.Cut(strings.TrimSpace(param), "=")
+				if name == "server_max_window_bits" && strings.Trim(val, `"`) != "15" {
+					continue offers
+				}
+			}
+			return true
+		}
+	}
+	return false
+}
+
+func headerHasToken(h http.Header, name, token string) bool {
+	for _, value := range h.Values(name) {
+		for _, t := range strings.Split(value, ",") {
+			if strings.EqualFold(strings.TrimSpace(t), token) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// writeMessage sends a text message, compressed if negotiated.
+func (c *webSocketConn) writeMessage(data []byte) error {
+	if !c.deflate {
+		return c.writeFrame(wsOpText, data, false)
+	}
+	var buf bytes.Buffer
+	fw, _ := flate.NewWriter(&buf, flate.DefaultCompression)
+	if _, err := fw.Write(data); err != nil {
+		return err
+	}
+	if err := fw.Flush(); err != nil {
+		return err
+	}
+	// The sync flush ends with an empty stored block, which the receiver
+	// adds back.
+	return c.writeFrame(wsOpText, bytes.TrimSuffix(buf.Bytes(), []byte{0, 0, 0xff, 0xff}), true)
+}
+
+func (c *webSocketConn) writeFrame(opcode byte, payload []byte, compressed bool) error {
+	hdr := make([]byte, 0, 10)
+	first := wsFlagFin | opcode
+	if compressed {
+		first |= wsFlagRSV1
+	}
+	hdr = append(hdr, first)
+	switch {
+	case len(payload) < 126:
+		hdr = append(hdr, byte(len(payload)))
+	case len(payload) <= 0xffff:
+		hdr = append(hdr, 126)
+		hdr = binary.BigEndian.AppendUint16(hdr, uint16(len(payload)))
+	default:
+		hdr = append(hdr, 127)
+		hdr = binary.BigEndian.AppendUint64(hdr, uint64(len(payload)))
+	}
+
+	c.writeMut.Lock()
+	defer c.writeMut.Unlock()
+	c.conn.SetWriteDeadline(time.Now().Add(wsWriteTimeout))
+	if _, err := c.conn.Write(append(hdr, payload...)); err != nil {
+		c.close()
+		return err
+	}
+	return nil
+}
+
+// readLoop answers pings and closes until the connection goes away. Since
+// we ping regularly, a client that stays silent for two intervals is gone.
+func (c *webSocketConn) readLoop() {
+	defer c.close()
+	for {
+		c.conn.SetReadDeadline(time.Now().Add(2 * wsPingInterval))
+		opcode, payload, err := c.readFrame()
+		if err != nil {
+			return
+		}
+		switch opcode {
+		case wsOpPing:
+			if c.writeFrame(wsOpPong, payload, false) != nil {
+				return
+			}
+		case wsOpClose:
+			if len(payload) >= 2 {
+				payload = payload[:2]
+			}
+			c.writeFrame(wsOpClose, payload, false)
+			return
+		}
+	}
+}
+
+// readFrame returns the opcode and payload of the next control frame,
+// discarding the payload of data frames.
+func (c *webSocketConn) readFrame() (byte, []byte, error) {
+	var hdr [2]byte
+	if _, err := io.ReadFull(c.rd, hdr[:]); err != nil {
+		return 0, nil, err
+	}
+	opcode := hdr[0] & 0x0f
+	if hdr[1]&wsFlagMask == 0 {
+		return 0, nil, errors.New("unmasked frame from client")
+	}
+
+	length := uint64(hdr[1] & 0x7f)
+	switch length {
+	case 126:
+		var ext [2]byte
+		if _, err := io.ReadFull(c.rd, ext[:]); err != nil {
+			return 0, nil, err
+		}
+		length = uint64(binary.BigEndian.Uint16(ext[:]))
+	case 127:
+		var ext [8]byte
+		if _, err := io.ReadFull(c.rd, ext[:]); err != nil {
+			return 0, nil, err
+		}
+		length = binary.BigEndian.Uint64(ext[:])
+	}
+
+	var mask [4]byte
+	if _, err := io.ReadFull(c.rd, mask[:]); err != nil {
+		return 0, nil, err
+	}
+
+	if opcode < wsOpClose {
+		if _, err := io.CopyN(io.Discard, c.rd, int64(length)); err != nil {
+			return 0, nil, err
+		}
+		return opcode, nil, nil
+	}
+	if length > wsMaxControlSize {
+		return 0, nil, fmt.Errorf("control frame of %d bytes", length)
+	}
+	payload := make([]byte, length)
+	if _, err := io.ReadFull(c.rd, payload); err != nil {
+		return 0, nil, err
+	}
+	for i := range payload {
+		payload[i] ^= mask[i%4]
+	}
+	return opcode, payload, nil
+}
+
+func (c *webSocketConn) close() {
+	c.closeOnce.Do(func() {
+		c.conn.Close()
+		close(c.closed)
+	})
+}
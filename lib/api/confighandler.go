@@ -7,14 +7,20 @@
 package api
 
 import (
+	"bytes"
 	"encoding/json"
+	"fmt"
 	"io"
 	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
 
 	"github.com/julienschmidt/httprouter"
 
 	"github.com/syncthing/syncthing/lib/config"
 	"github.com/syncthing/syncthing/lib/protocol"
+	"github.com/syncthing/syncthing/lib/rand"
 	"github.com/syncthing/syncthing/lib/structutil"
 )
 
@@ -25,8 +31,8 @@ type configMuxBuilder struct {
 }
 
 func (c *configMuxBuilder) registerConfig(path string) {
-	c.HandlerFunc(http.MethodGet, path, func(w http.ResponseWriter, _ *http.Request) {
-		sendJSON(w, c.cfg.RawCopy())
+	c.HandlerFunc(http.MethodGet, path, func(w http.ResponseWriter, r *http.Request) {
+		sendJSON(w, c.rawCopyFor(r))
 	})
 
 	c.HandlerFunc(http.MethodPut, path, func(w http.ResponseWriter, r *http.Request) {
@@ -35,8 +41,8 @@ func (c *configMuxBuilder) registerConfig(path string) {
 }
 
 func (c *configMuxBuilder) registerConfigDeprecated(path string) {
-	c.HandlerFunc(http.MethodGet, path, func(w http.ResponseWriter, _ *http.Request) {
-		sendJSON(w, c.cfg.RawCopy())
+	c.HandlerFunc(http.MethodGet, path, func(w http.ResponseWriter, r *http.Request) {
+		sendJSON(w, c.rawCopyFor(r))
 	})
 
 	c.HandlerFunc(http.MethodPost, path, func(w http.ResponseWriter, r *http.Request) {
@@ -44,6 +50,26 @@ func (c *configMuxBuilder) registerConfigDeprecated(path string) {
 	})
 }
 
+// registerConfigDowngrade serves the config file as downgraded to the config
+// version given as parameter, for going back to an older Syncthing.
+func (c *configMuxBuilder) registerConfigDowngrade(path string) {
+	c.HandlerFunc(http.MethodGet, path, func(w http.ResponseWriter, r *http.Request) {
+		version, err := strconv.Atoi(r.URL.Query().Get("version"))
+		if err != nil {
+			http.Error(w, "version: "+err.Error(), http.StatusBadRequest)
+			return
+		}
+		buf := new(bytes.Buffer)
+		if _, err := c.rawCopyFor(r).WriteXMLForVersion(buf, version); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		w.Header().Set("Content-Type", "application/xml; charset=utf-8")
+		w.Header().Set("Content-Disposition", `attachment; filename="config.xml"`)
+		_, _ = w.Write(buf.Bytes())
+	})
+}
+
 func (c *configMuxBuilder) registerConfigInsync(path string) {
 	c.HandlerFunc(http.MethodGet, path, func(w http.ResponseWriter, _ *http.Request) {
 		sendJSON(w, map[string]bool{"configInSync": !c.cfg.RequiresRestart()})
@@ -57,8 +83,10 @@ func (c *configMuxBuilder) registerConfigRequiresRestart(path string) {
 }
 
 func (c *configMuxBuilder) registerFolders(path string) {
-	c.HandlerFunc(http.MethodGet, path, func(w http.ResponseWriter, _ *http.Request) {
-		sendJSON(w, c.cfg.FolderList())
+	c.HandlerFunc(http.MethodGet, path, func(w http.ResponseWriter, r *http.Request) {
+		cfg := config.Configuration{Folders: c.cfg.FolderList()}
+		redactFor(r, &cfg)
+		sendJSON(w, cfg.Folders)
 	})
 
 	c.HandlerFunc(http.MethodPut, path, func(w http.ResponseWriter, r *http.Request) {
@@ -76,9 +104,18 @@ func (c *configMuxBuilder) registerFolders(path string) {
 				return
 			}
 		}
+		var removalErr error
 		waiter, err := c.cfg.Modify(func(cfg *config.Configuration) {
-			cfg.SetFolders(folders)
+			to := cfg.Copy()
+			to.SetFolders(folders)
+			if removalErr = unconfirmedRemoval(r, *cfg, to); removalErr == nil {
+				*cfg = to
+			}
 		})
+		if removalErr != nil {
+			http.Error(w, removalErr.Error(), http.StatusConflict)
+			return
+		}
 		if err != nil {
 			http.Error(w, err.Error(), http.StatusInternalServerError)
 			return
@@ -92,8 +129,10 @@ func (c *configMuxBuilder) registerFolders(path string) {
 }
 
 func (c *configMuxBuilder) registerDevices(path string) {
-	c.HandlerFunc(http.MethodGet, path, func(w http.ResponseWriter, _ *http.Request) {
-		sendJSON(w, c.cfg.DeviceList())
+	c.HandlerFunc(http.MethodGet, path, func(w http.ResponseWriter, r *http.Request) {
+		cfg := config.Configuration{Devices: c.cfg.DeviceList()}
+		redactFor(r, &cfg)
+		sendJSON(w, cfg.Devices)
 	})
 
 	c.HandlerFunc(http.MethodPut, path, func(w http.ResponseWriter, r *http.Request) {
@@ -111,9 +150,18 @@ func (c *configMuxBuilder) registerDevices(path string) {
 				return
 			}
 		}
+		var removalErr error
 		waiter, err := c.cfg.Modify(func(cfg *config.Configuration) {
-			cfg.SetDevices(devices)
+			to := cfg.Copy()
+			to.SetDevices(devices)
+			if removalErr = unconfirmedRemoval(r, *cfg, to); removalErr == nil {
+				*cfg = to
+			}
 		})
+		if removalErr != nil {
+			http.Error(w, removalErr.Error(), http.StatusConflict)
+			return
+		}
 		if err != nil {
 			http.Error(w, err.Error(), http.StatusInternalServerError)
 			return
@@ -127,13 +175,15 @@ func (c *configMuxBuilder) registerDevices(path string) {
 }
 
 func (c *configMuxBuilder) registerFolder(path string) {
-	c.Handle(http.MethodGet, path, func(w http.ResponseWriter, _ *http.Request, p httprouter.Params) {
+	c.Handle(http.MethodGet, path, func(w http.ResponseWriter, r *http.Request, p httprouter.Params) {
 		folder, ok := c.cfg.Folder(p.ByName("id"))
 		if !ok {
 			http.Error(w, "No folder with given ID", http.StatusNotFound)
 			return
 		}
-		sendJSON(w, folder)
+		cfg := config.Configuration{Folders: []config.FolderConfiguration{folder}}
+		redactFor(r, &cfg)
+		sendJSON(w, cfg.Folders[0])
 	})
 
 	c.Handle(http.MethodPut, path, func(w http.ResponseWriter, r *http.Request, p httprouter.Params) {
@@ -149,7 +199,10 @@ func (c *configMuxBuilder) registerFolder(path string) {
 		c.adjustFolder(w, r, folder, false)
 	})
 
-	c.Handle(http.MethodDelete, path, func(w http.ResponseWriter, _ *http.Request, p httprouter.Params) {
+	c.Handle(http.MethodDelete, path, func(w http.ResponseWriter, r *http.Request, p httprouter.Params) {
+		if folder, ok := c.cfg.Folder(p.ByName("id")); ok && !confirmedRemoval(w, r, folder.Protected, folder.ID) {
+			return
+		}
 		waiter, err := c.cfg.RemoveFolder(p.ByName("id"))
 		if err != nil {
 			http.Error(w, err.Error(), http.StatusBadRequest)
@@ -174,9 +227,11 @@ func (c *configMuxBuilder) registerDevice(path string) {
 		return device, true
 	}
 
-	c.Handle(http.MethodGet, path, func(w http.ResponseWriter, _ *http.Request, p httprouter.Params) {
+	c.Handle(http.MethodGet, path, func(w http.ResponseWriter, r *http.Request, p httprouter.Params) {
 		if device, ok := deviceFromParams(w, p); ok {
-			sendJSON(w, device)
+			cfg := config.Configuration{Devices: []config.DeviceConfiguration{device}}
+			redactFor(r, &cfg)
+			sendJSON(w, cfg.Devices[0])
 		}
 	})
 
@@ -190,12 +245,15 @@ func (c *configMuxBuilder) registerDevice(path string) {
 		}
 	})
 
-	c.Handle(http.MethodDelete, path, func(w http.ResponseWriter, _ *http.Request, p httprouter.Params) {
+	c.Handle(http.MethodDelete, path, func(w http.ResponseWriter, r *http.Request, p httprouter.Params) {
 		id, err := protocol.DeviceIDFromString(p.ByName("id"))
 		if err != nil {
 			http.Error(w, err.Error(), http.StatusBadRequest)
 			return
 		}
+		if device, ok := c.cfg.Device(id); ok && !confirmedRemoval(w, r, device.Protected, id.String()) {
+			return
+		}
 		waiter, err := c.cfg.RemoveDevice(id)
 		if err != nil {
 			http.Error(w, err.Error(), http.StatusBadRequest)
@@ -205,9 +263,50 @@ func (c *configMuxBuilder) registerDevice(path string) {
 	})
 }
 
+// confirmedRemoval returns whether a folder or device may be removed, which
+// for protected ones requires repeating the ID in the "confirm" parameter,
+// and responds with an error if not.
+func confirmedRemoval(w http.ResponseWriter, r *http.Request, protected bool, id string) bool {
+	if !protected || slices.Contains(r.URL.Query()["confirm"], id) {
+		return true
+	}
+	http.Error(w, errUnconfirmedRemoval(id).Error(), http.StatusConflict)
+	return false
+}
+
+// unconfirmedRemoval returns an error for the first protected folder or
+// device that replacing the config removes, unless its ID is given in a
+// "confirm" parameter. The parameter may be repeated, to confirm several.
+func unconfirmedRemoval(r *http.Request, from, to config.Configuration) error {
+	confirmed := r.URL.Query()["confirm"]
+	for _, folder := range from.Folders {
+		if !folder.Protected || slices.Contains(confirmed, folder.ID) {
+			continue
+		}
+		if _, _, ok := to.Folder(folder.ID); !ok {
+			return errUnconfirmedRemoval(folder.ID)
+		}
+	}
+	for _, device := range from.Devices {
+		if !device.Protected || slices.Contains(confirmed, device.DeviceID.String()) {
+			continue
+		}
+		if _, _, ok := to.Device(device.DeviceID); !ok {
+			return errUnconfirmedRemoval(device.DeviceID.String())
+		}
+	}
+	return nil
+}
+
+func errUnconfirmedRemoval(id string) error {
+	return fmt.Errorf("%s is protected, repeat the ID in the confirm parameter to remove it", id)
+}
+
 func (c *configMuxBuilder) registerDefaultFolder(path string) {
-	c.HandlerFunc(http.MethodGet, path, func(w http.ResponseWriter, _ *http.Request) {
-		sendJSON(w, c.cfg.DefaultFolder())
+	c.HandlerFunc(http.MethodGet, path, func(w http.ResponseWriter, r *http.Request) {
+		cfg := config.Configuration{Defaults: config.Defaults{Folder: c.cfg.DefaultFolder()}}
+		redactFor(r, &cfg)
+		sendJSON(w, cfg.Defaults.Folder)
 	})
 
 	c.HandlerFunc(http.MethodPut, path, func(w http.ResponseWriter, r *http.Request) {
@@ -222,8 +321,10 @@ func (c *configMuxBuilder) registerDefaultFolder(path string) {
 }
 
 func (c *configMuxBuilder) registerDefaultDevice(path string) {
-	c.HandlerFunc(http.MethodGet, path, func(w http.ResponseWriter, _ *http.Request) {
-		sendJSON(w, c.cfg.DefaultDevice())
+	c.HandlerFunc(http.MethodGet, path, func(w http.ResponseWriter, r *http.Request) {
+		cfg := config.Configuration{Defaults: config.Defaults{Device: c.cfg.DefaultDevice()}}
+		redactFor(r, &cfg)
+		sendJSON(w, cfg.Defaults.Device)
 	})
 
 	c.HandlerFunc(http.MethodPut, path, func(w http.ResponseWriter, r *http.Request) {
@@ -260,8 +361,10 @@ func (c *configMuxBuilder) registerDefaultIgnores(path string) {
 }
 
 func (c *configMuxBuilder) registerOptions(path string) {
-	c.HandlerFunc(http.MethodGet, path, func(w http.ResponseWriter, _ *http.Request) {
-		sendJSON(w, c.cfg.Options())
+	c.HandlerFunc(http.MethodGet, path, func(w http.ResponseWriter, r *http.Request) {
+		cfg := config.Configuration{Options: c.cfg.Options()}
+		redactFor(r, &cfg)
+		sendJSON(w, cfg.Options)
 	})
 
 	c.HandlerFunc(http.MethodPut, path, func(w http.ResponseWriter, r *http.Request) {
@@ -276,8 +379,10 @@ func (c *configMuxBuilder) registerOptions(path string) {
 }
 
 func (c *configMuxBuilder) registerLDAP(path string) {
-	c.HandlerFunc(http.MethodGet, path, func(w http.ResponseWriter, _ *http.Request) {
-		sendJSON(w, c.cfg.LDAP())
+	c.HandlerFunc(http.MethodGet, path, func(w http.ResponseWriter, r *http.Request) {
+		cfg := config.Configuration{LDAP: c.cfg.LDAP()}
+		redactFor(r, &cfg)
+		sendJSON(w, cfg.LDAP)
 	})
 
 	c.HandlerFunc(http.MethodPut, path, func(w http.ResponseWriter, r *http.Request) {
@@ -292,8 +397,10 @@ func (c *configMuxBuilder) registerLDAP(path string) {
 }
 
 func (c *configMuxBuilder) registerGUI(path string) {
-	c.HandlerFunc(http.MethodGet, path, func(w http.ResponseWriter, _ *http.Request) {
-		sendJSON(w, c.cfg.GUI())
+	c.HandlerFunc(http.MethodGet, path, func(w http.ResponseWriter, r *http.Request) {
+		cfg := config.Configuration{GUI: c.cfg.GUI()}
+		redactFor(r, &cfg)
+		sendJSON(w, cfg.GUI)
 	})
 
 	c.HandlerFunc(http.MethodPut, path, func(w http.ResponseWriter, r *http.Request) {
@@ -307,6 +414,151 @@ func (c *configMuxBuilder) registerGUI(path string) {
 	})
 }
 
+func (c *configMuxBuilder) registerAPIKeys(path string) {
+	c.HandlerFunc(http.MethodGet, path, func(w http.ResponseWriter, _ *http.Request) {
+		sendJSON(w, c.cfg.GUI().APIKeys)
+	})
+
+	c.HandlerFunc(http.MethodPut, path, func(w http.ResponseWriter, r *http.Request) {
+		var keys []config.ScopedAPIKey
+		if err := unmarshalTo(r.Body, &keys); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		waiter, err := c.cfg.Modify(func(cfg *config.Configuration) {
+			cfg.GUI.APIKeys = keys
+		})
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		c.finish(w, waiter)
+	})
+
+	c.HandlerFunc(http.MethodPost, path, func(w http.ResponseWriter, r *http.Request) {
+		c.adjustAPIKey(w, r, config.ScopedAPIKey{}, "")
+	})
+}
+
+func (c *configMuxBuilder) registerAPIKey(path string) {
+	keyFromParams := func(w http.ResponseWriter, p httprouter.Params) (config.ScopedAPIKey, bool) {
+		for _, key := range c.cfg.GUI().APIKeys {
+			if key.Key == p.ByName("key") {
+				return key, true
+			}
+		}
+		http.Error(w, "No API key with given key", http.StatusNotFound)
+		return config.ScopedAPIKey{}, false
+	}
+
+	c.Handle(http.MethodGet, path, func(w http.ResponseWriter, _ *http.Request, p httprouter.Params) {
+		if key, ok := keyFromParams(w, p); ok {
+			sendJSON(w, key)
+		}
+	})
+
+	c.Handle(http.MethodPut, path, func(w http.ResponseWriter, r *http.Request, p httprouter.Params) {
+		c.adjustAPIKey(w, r, config.ScopedAPIKey{}, p.ByName("key"))
+	})
+
+	c.Handle(http.MethodPatch, path, func(w http.ResponseWriter, r *http.Request, p httprouter.Params) {
+		if key, ok := keyFromParams(w, p); ok {
+			c.adjustAPIKey(w, r, key, key.Key)
+		}
+	})
+
+	c.Handle(http.MethodDelete, path, func(w http.ResponseWriter, _ *http.Request, p httprouter.Params) {
+		if _, ok := keyFromParams(w, p); !ok {
+			return
+		}
+		waiter, err := c.cfg.Modify(func(cfg *config.Configuration) {
+			cfg.GUI.APIKeys = slices.DeleteFunc(cfg.GUI.APIKeys, func(key config.ScopedAPIKey) bool {
+				return key.Key == p.ByName("key")
+			})
+		})
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		c.finish(w, waiter)
+	})
+}
+
+// rawCopyFor returns the config as seen by the user making the request,
+// i.e. without secrets for anyone but admins.
+func (c *configMuxBuilder) rawCopyFor(r *http.Request) config.Configuration {
+	cfg := c.cfg.RawCopy()
+	redactFor(r, &cfg)
+	return cfg
+}
+
+// redactFor removes the secrets from the config, or the parts of it about to
+// be sent, unless the request is made by an admin.
+func redactFor(r *http.Request, cfg *config.Configuration) {
+	if !isAdminRequest(r) {
+		redactSecrets(cfg)
+	}
+}
+
+// redactSecrets replaces every secret in the config with "REDACTED". The
+// copies the wrapper returns are deep, so this never touches the config
+// itself.
+func redactSecrets(cfg *config.Configuration) {
+	redact := func(s *string) {
+		if *s != "" {
+			*s = "REDACTED"
+		}
+	}
+	redact(&cfg.GUI.Password)
+	redact(&cfg.GUI.APIKey)
+	redact(&cfg.GUI.PendingAPIKey)
+	redact(&cfg.GUI.OIDC.ClientSecret)
+	redact(&cfg.GUI.ACME.DNSCommand)
+	for i := range cfg.GUI.Users {
+		redact(&cfg.GUI.Users[i].Password)
+	}
+	for i := range cfg.GUI.APIKeys {
+		redact(&cfg.GUI.APIKeys[i].Key)
+	}
+	redact(&cfg.LDAP.ServiceBindPassword)
+	redact(&cfg.Options.MQTTPassword)
+	redact(&cfg.Options.ObfuscationSecret)
+	for i := range cfg.Options.EventWebhooks {
+		redact(&cfg.Options.EventWebhooks[i].Secret)
+	}
+	redactProxy(&cfg.Options.ProxyURL)
+	for i := range cfg.Devices {
+		redactProxy(&cfg.Devices[i].ProxyURL)
+	}
+	redactFolder := func(folder *config.FolderConfiguration) {
+		for i := range folder.Devices {
+			redact(&folder.Devices[i].EncryptionPassword)
+		}
+	}
+	for i := range cfg.Folders {
+		redactFolder(&cfg.Folders[i])
+	}
+	redactFolder(&cfg.Defaults.Folder)
+}
+
+// redactProxy replaces the credentials in a proxy URL, which the dialer
+// sends as Proxy-Authorization, with "REDACTED". A URL that doesn't parse is
+// redacted as a whole.
+func redactProxy(s *string) {
+	if *s == "" {
+		return
+	}
+	u, err := url.Parse(*s)
+	if err != nil {
+		*s = "REDACTED"
+		return
+	}
+	if u.User != nil {
+		u.User = url.User("REDACTED")
+		*s = u.String()
+	}
+}
+
 func (c *configMuxBuilder) adjustConfig(w http.ResponseWriter, r *http.Request) {
 	to, err := config.ReadJSON(r.Body, c.id)
 	r.Body.Close()
@@ -326,10 +578,22 @@ func (c *configMuxBuilder) adjustConfig(w http.ResponseWriter, r *http.Request)
 				return
 			}
 		}
+		if err := to.GUI.HashUserPasswords(); err != nil {
+			l.Warnln("hashing password:", err)
+			errMsg = err.Error()
+			status = http.StatusInternalServerError
+			return
+		}
+		if err := unconfirmedRemoval(r, *cfg, to); err != nil {
+			errMsg = err.Error()
+			status = http.StatusConflict
+			return
+		}
 		*cfg = to
 	})
 	if errMsg != "" {
 		http.Error(w, errMsg, status)
+		return
 	} else if err != nil {
 		http.Error(w, err.Error(), http.StatusInternalServerError)
 		return
@@ -408,6 +672,12 @@ func (c *configMuxBuilder) adjustGUI(w http.ResponseWriter, r *http.Request, gui
 				return
 			}
 		}
+		if err := gui.HashUserPasswords(); err != nil {
+			l.Warnln("hashing password:", err)
+			errMsg = err.Error()
+			status = http.StatusInternalServerError
+			return
+		}
 		cfg.GUI = gui
 	})
 	if errMsg != "" {
@@ -419,6 +689,38 @@ func (c *configMuxBuilder) adjustGUI(w http.ResponseWriter, r *http.Request, gui
 	c.finish(w, waiter)
 }
 
+// adjustAPIKey adds or replaces the API key given in the request, on top of
+// the given one. The key from the path, if any, takes precedence over the
+// one in the request, and a random one is generated if neither is given.
+// The resulting API key is returned.
+func (c *configMuxBuilder) adjustAPIKey(w http.ResponseWriter, r *http.Request, key config.ScopedAPIKey, pathKey string) {
+	if err := unmarshalTo(r.Body, &key); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if pathKey != "" {
+		key.Key = pathKey
+	}
+	if key.Key == "" {
+		key.Key = rand.String(32)
+	}
+	waiter, err := c.cfg.Modify(func(cfg *config.Configuration) {
+		for i := range cfg.GUI.APIKeys {
+			if cfg.GUI.APIKeys[i].Key == key.Key {
+				cfg.GUI.APIKeys[i] = key
+				return
+			}
+		}
+		cfg.GUI.APIKeys = append(cfg.GUI.APIKeys, key)
+	})
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	c.finish(w, waiter)
+	sendJSON(w, key)
+}
+
 func (c *configMuxBuilder) adjustLDAP(w http.ResponseWriter, r *http.Request, ldap config.LDAPConfiguration) {
 	if err := unmarshalTo(r.Body, &ldap); err != nil {
 		http.Error(w, err.Error(), http.StatusBadRequest)
@@ -16,13 +16,17 @@ import (
 // getRedactedConfig redacting some parts of config
 func getRedactedConfig(s *service) config.Configuration {
 	rawConf := s.cfg.RawCopy()
+	redactSecrets(&rawConf)
 	rawConf.GUI.APIKey = "REDACTED"
-	if rawConf.GUI.Password != "" {
-		rawConf.GUI.Password = "REDACTED"
-	}
 	if rawConf.GUI.User != "" {
 		rawConf.GUI.User = "REDACTED"
 	}
+	if rawConf.GUI.ACME.Email != "" {
+		rawConf.GUI.ACME.Email = "REDACTED"
+	}
+	for i := range rawConf.GUI.Users {
+		rawConf.GUI.Users[i].Name = "REDACTED"
+	}
 	return rawConf
 }
 
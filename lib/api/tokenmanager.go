@@ -39,6 +39,9 @@ func newTokenManager(key string, miscDB *db.NamespacedKV, lifetime time.Duration
 	if bs, ok, _ := miscDB.Bytes(key); ok {
 		_ = tokens.Unmarshal(bs) // best effort
 	}
+	if tokens.Users == nil {
+		tokens.Users = make(map[string]string)
+	}
 	return &tokenManager{
 		key:      key,
 		miscDB:   miscDB,
@@ -73,17 +76,35 @@ func (m *tokenManager) Check(token string) bool {
 
 // New creates a new token and returns it.
 func (m *tokenManager) New() string {
+	return m.NewForUser("")
+}
+
+// NewForUser creates a new token belonging to the given user and returns
+// it.
+func (m *tokenManager) NewForUser(user string) string {
 	token := rand.String(randomTokenLength)
 
 	m.mut.Lock()
 	defer m.mut.Unlock()
 
 	m.tokens.Tokens[token] = m.timeNow().Add(m.lifetime).UnixNano()
+	if user != "" {
+		m.tokens.Users[token] = user
+	}
 	m.saveLocked()
 
 	return token
 }
 
+// User returns the user the token belongs to, which is empty for tokens
+// created without one.
+func (m *tokenManager) User(token string) string {
+	m.mut.Lock()
+	defer m.mut.Unlock()
+
+	return m.tokens.Users[token]
+}
+
 // Delete removes a token.
 func (m *tokenManager) Delete(token string) {
 	m.mut.Lock()
@@ -93,6 +114,52 @@ func (m *tokenManager) Delete(token string) {
 	m.saveLocked()
 }
 
+// DeleteFunc removes the tokens for which fn, given the token and its user,
+// returns true, and returns how many were removed.
+func (m *tokenManager) DeleteFunc(fn func(token, user string) bool) int {
+	m.mut.Lock()
+	defer m.mut.Unlock()
+
+	removed := 0
+	for token := range m.tokens.Tokens {
+		if fn(token, m.tokens.Users[token]) {
+			delete(m.tokens.Tokens, token)
+			removed++
+		}
+	}
+	m.saveLocked()
+	return removed
+}
+
+type tokenInfo struct {
+	Token   string
+	User    string
+	Expires time.Time
+}
+
+// List returns the tokens that have not expired.
+func (m *tokenManager) List() []tokenInfo {
+	m.mut.Lock()
+	defer m.mut.Unlock()
+
+	now := m.timeNow().UnixNano()
+	var infos []tokenInfo
+	for token, expiry := range m.tokens.Tokens {
+		if expiry < now {
+			continue
+		}
+		infos = append(infos, tokenInfo{
+			Token:   token,
+			User:    m.tokens.Users[token],
+			Expires: time.Unix(0, expiry),
+		})
+	}
+	slices.SortFunc(infos, func(a, b tokenInfo) int {
+		return a.Expires.Compare(b.Expires)
+	})
+	return infos
+}
+
 func (m *tokenManager) saveLocked() {
 	// Remove expired tokens.
 	now := m.timeNow().UnixNano()
@@ -122,6 +189,13 @@ func (m *tokenManager) saveLocked() {
 		}
 	}
 
+	// Forget the users of removed tokens.
+	for token := range m.tokens.Users {
+		if _, ok := m.tokens.Tokens[token]; !ok {
+			delete(m.tokens.Users, token)
+		}
+	}
+
 	// Postpone saving until one second of inactivity.
 	if m.saveTimer == nil {
 		m.saveTimer = time.AfterFunc(time.Second, m.scheduledSave)
@@ -146,30 +220,28 @@ type tokenCookieManager struct {
 	guiCfg     config.GUIConfiguration
 	evLogger   events.Logger
 	tokens     *tokenManager
+	csrf       *csrfManager // may be nil
 }
 
-func newTokenCookieManager(shortID string, guiCfg config.GUIConfiguration, evLogger events.Logger, miscDB *db.NamespacedKV) *tokenCookieManager {
+func newTokenCookieManager(shortID string, guiCfg config.GUIConfiguration, evLogger events.Logger, miscDB *db.NamespacedKV, csrf *csrfManager) *tokenCookieManager {
 	return &tokenCookieManager{
 		cookieName: "sessionid-" + shortID,
 		shortID:    shortID,
 		guiCfg:     guiCfg,
 		evLogger:   evLogger,
 		tokens:     newTokenManager("sessions", miscDB, maxSessionLifetime, maxActiveSessions),
+		csrf:       csrf,
 	}
 }
 
-func (m *tokenCookieManager) createSession(username string, persistent bool, w http.ResponseWriter, r *http.Request) {
-	sessionid := m.tokens.New()
+// createSession creates a session for the user, sets the session cookie
+// and returns the session token.
+func (m *tokenCookieManager) createSession(username string, persistent bool, w http.ResponseWriter, r *http.Request) string {
+	sessionid := m.tokens.NewForUser(username)
 
-	// Best effort detection of whether the connection is HTTPS --
-	// either directly to us, or as used by the client towards a reverse
-	// proxy who sends us headers.
-	connectionIsHTTPS := r.TLS != nil ||
-		strings.ToLower(r.Header.Get("x-forwarded-proto")) == "https" ||
-		strings.Contains(strings.ToLower(r.Header.Get("forwarded")), "proto=https")
 	// If the connection is HTTPS, or *should* be HTTPS, set the Secure
 	// bit in cookies.
-	useSecureCookie := connectionIsHTTPS || m.guiCfg.UseTLS()
+	useSecureCookie := isHTTPSRequest(r) || m.guiCfg.UseTLS()
 
 	maxAge := 0
 	if persistent {
@@ -182,13 +254,39 @@ func (m *tokenCookieManager) createSession(username string, persistent bool, w h
 		// but in http.Cookie MaxAge = 0 means unspecified (session) and MaxAge < 0 means delete immediately
 		MaxAge: maxAge,
 		Secure: useSecureCookie,
-		Path:   "/",
+		// The session cookie is never sent with requests from other
+		// sites, nor readable by scripts.
+		HttpOnly: true,
+		SameSite: http.SameSiteStrictMode,
+		Path:     "/",
 	})
 
 	emitLoginAttempt(true, username, r.RemoteAddr, m.evLogger)
+	return sessionid
 }
 
-func (m *tokenCookieManager) hasValidSession(r *http.Request) bool {
+// login creates a session for a user logging in to the GUI, along with the
+// session's CSRF token.
+func (m *tokenCookieManager) login(username string, persistent bool, w http.ResponseWriter, r *http.Request) {
+	sessionid := m.createSession(username, persistent, w, r)
+	if m.csrf != nil {
+		m.csrf.setSessionCookie(w, r, sessionid)
+	}
+}
+
+// isHTTPSRequest is a best effort detection of whether the connection is
+// HTTPS -- either directly to us, or as used by the client towards a
+// reverse proxy who sends us headers.
+func isHTTPSRequest(r *http.Request) bool {
+	return r.TLS != nil ||
+		strings.ToLower(r.Header.Get("x-forwarded-proto")) == "https" ||
+		strings.Contains(strings.ToLower(r.Header.Get("forwarded")), "proto=https")
+}
+
+// validSession returns the token and user of the session in the request,
+// if there is a valid one. The user is empty for sessions from before users
+// were recorded.
+func (m *tokenCookieManager) validSession(r *http.Request) (token, user string, ok bool) {
 	for _, cookie := range r.Cookies() {
 		// We iterate here since there may, historically, be multiple
 		// cookies with the same name but different path. Any "old" ones
@@ -196,11 +294,22 @@ func (m *tokenCookieManager) hasValidSession(r *http.Request) bool {
 		// later removed on logout or when timing out.
 		if cookie.Name == m.cookieName {
 			if m.tokens.Check(cookie.Value) {
-				return true
+				return cookie.Value, m.tokens.User(cookie.Value), true
 			}
 		}
 	}
-	return false
+	return "", "", false
+}
+
+// currentTokens returns the session tokens sent with the request.
+func (m *tokenCookieManager) currentTokens(r *http.Request) []string {
+	var tokens []string
+	for _, cookie := range r.Cookies() {
+		if cookie.Name == m.cookieName {
+			tokens = append(tokens, cookie.Value)
+		}
+	}
+	return tokens
 }
 
 func (m *tokenCookieManager) destroySession(w http.ResponseWriter, r *http.Request) {
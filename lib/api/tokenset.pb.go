@@ -25,6 +25,8 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type TokenSet struct {
 	// token -> expiry time (epoch nanoseconds)
 	Tokens map[string]int64 `protobuf:"bytes,1,rep,name=tokens,proto3" json:"tokens" xml:"token" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	// token -> user name
+	Users map[string]string `protobuf:"bytes,2,rep,name=users,proto3" json:"users" xml:"user" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
 func (m *TokenSet) Reset()         { *m = TokenSet{} }
@@ -63,29 +65,33 @@ var xxx_messageInfo_TokenSet proto.InternalMessageInfo
 func init() {
 	proto.RegisterType((*TokenSet)(nil), "api.TokenSet")
 	proto.RegisterMapType((map[string]int64)(nil), "api.TokenSet.TokensEntry")
+	proto.RegisterMapType((map[string]string)(nil), "api.TokenSet.UsersEntry")
 }
 
 func init() { proto.RegisterFile("lib/api/tokenset.proto", fileDescriptor_9ea8707737c33b38) }
 
 var fileDescriptor_9ea8707737c33b38 = []byte{
-	// 260 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0xcb, 0xc9, 0x4c, 0xd2,
-	0x4f, 0x2c, 0xc8, 0xd4, 0x2f, 0xc9, 0xcf, 0x4e, 0xcd, 0x2b, 0x4e, 0x2d, 0xd1, 0x2b, 0x28, 0xca,
-	0x2f, 0xc9, 0x17, 0x62, 0x4e, 0x2c, 0xc8, 0x54, 0x3a, 0xce, 0xc8, 0xc5, 0x11, 0x02, 0x12, 0x0f,
-	0x4e, 0x2d, 0x11, 0x0a, 0xe0, 0x62, 0x83, 0xa8, 0x91, 0x60, 0x54, 0x60, 0xd6, 0xe0, 0x36, 0x92,
-	0xd4, 0x4b, 0x2c, 0xc8, 0xd4, 0x83, 0x49, 0x43, 0x18, 0xc5, 0xae, 0x79, 0x25, 0x45, 0x95, 0x4e,
-	0xb2, 0x27, 0xee, 0xc9, 0x33, 0xbc, 0xba, 0x27, 0x0f, 0xd5, 0xf0, 0xe9, 0x9e, 0x3c, 0x77, 0x45,
-	0x6e, 0x8e, 0x95, 0x12, 0x98, 0xab, 0x14, 0x04, 0x15, 0x96, 0xca, 0xe4, 0xe2, 0x46, 0xd2, 0x25,
-	0xa4, 0xc6, 0xc5, 0x9c, 0x9d, 0x5a, 0x29, 0xc1, 0xa8, 0xc0, 0xa8, 0xc1, 0xe9, 0x24, 0xf2, 0xea,
-	0x9e, 0x3c, 0x88, 0xfb, 0xe9, 0x9e, 0x3c, 0x27, 0x58, 0x6f, 0x76, 0x6a, 0xa5, 0x52, 0x10, 0x48,
-	0x44, 0x48, 0x8f, 0x8b, 0xb5, 0x2c, 0x31, 0xa7, 0x34, 0x55, 0x82, 0x49, 0x81, 0x51, 0x83, 0xd9,
-	0x49, 0xe2, 0xd5, 0x3d, 0x79, 0x88, 0x00, 0xdc, 0x1e, 0x30, 0x4f, 0x29, 0x08, 0x22, 0x6a, 0xc5,
-	0x64, 0xc1, 0xe8, 0xe4, 0x71, 0xe2, 0xa1, 0x1c, 0xc3, 0x85, 0x87, 0x72, 0x0c, 0x27, 0x1e, 0xc9,
-	0x31, 0x5e, 0x78, 0x24, 0xc7, 0x38, 0xe1, 0xb1, 0x1c, 0xc3, 0x82, 0xc7, 0x72, 0x8c, 0x17, 0x1e,
-	0xcb, 0x31, 0xdc, 0x78, 0x2c, 0xc7, 0x10, 0xa5, 0x96, 0x9e, 0x59, 0x92, 0x51, 0x9a, 0xa4, 0x97,
-	0x9c, 0x9f, 0xab, 0x5f, 0x5c, 0x99, 0x97, 0x5c, 0x92, 0x91, 0x99, 0x97, 0x8e, 0xc4, 0x82, 0x86,
-	0x53, 0x12, 0x1b, 0x38, 0x7c, 0x8c, 0x01, 0x01, 0x00, 0x00, 0xff, 0xff, 0xfe, 0x25, 0x31, 0x49,
-	0x39, 0x01, 0x00, 0x00,
+	// 310 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x91, 0x31, 0x4b, 0xc3, 0x40,
+	0x14, 0xc7, 0xef, 0x12, 0x5a, 0xec, 0x75, 0x0b, 0x22, 0x67, 0xc5, 0xbb, 0x72, 0x43, 0xe9, 0x94,
+	0x82, 0x2e, 0xd2, 0x31, 0x20, 0x08, 0x2e, 0x12, 0x75, 0x71, 0x4b, 0xcb, 0xd1, 0x1e, 0x6d, 0x93,
+	0xd0, 0x5c, 0xc5, 0x7c, 0x0b, 0x3f, 0x82, 0x1f, 0x27, 0x63, 0x46, 0xa7, 0x83, 0x36, 0x5b, 0xc7,
+	0x7c, 0x00, 0x91, 0xbb, 0x8b, 0x5a, 0x37, 0x71, 0x7b, 0xff, 0xdf, 0x7b, 0xef, 0xff, 0xfe, 0xf0,
+	0xd0, 0xc9, 0x52, 0x4c, 0x46, 0x51, 0x2a, 0x46, 0x32, 0x59, 0xf0, 0x38, 0xe3, 0xd2, 0x4f, 0xd7,
+	0x89, 0x4c, 0x3c, 0x37, 0x4a, 0x05, 0xfb, 0x70, 0xd0, 0xd1, 0x83, 0xe6, 0xf7, 0x5c, 0x7a, 0x77,
+	0xa8, 0x6d, 0x67, 0x30, 0xec, 0xbb, 0xc3, 0xee, 0xc5, 0xa9, 0x1f, 0xa5, 0xc2, 0xff, 0x6a, 0xdb,
+	0x22, 0xbb, 0x8e, 0xe5, 0x3a, 0x0f, 0xce, 0x0b, 0x45, 0xc1, 0x5e, 0xd1, 0x66, 0xa1, 0x56, 0xb4,
+	0xfb, 0xb2, 0x5a, 0x8e, 0x99, 0x91, 0x2c, 0x6c, 0xb0, 0x77, 0x8b, 0x5a, 0x9b, 0x8c, 0xaf, 0x33,
+	0xec, 0x18, 0x43, 0xfc, 0xdb, 0xf0, 0x51, 0xb7, 0xac, 0xdf, 0x59, 0xe3, 0x67, 0xc7, 0x6b, 0x45,
+	0x91, 0xb1, 0xd3, 0x8a, 0x85, 0x16, 0xf6, 0x04, 0xea, 0x1e, 0x44, 0xf0, 0x06, 0xc8, 0x5d, 0xf0,
+	0x1c, 0xc3, 0x3e, 0x1c, 0x76, 0x82, 0xe3, 0xbd, 0xa2, 0x5a, 0xd6, 0x8a, 0x76, 0xcc, 0xe6, 0x82,
+	0xe7, 0x2c, 0xd4, 0xc4, 0xf3, 0x51, 0xeb, 0x39, 0x5a, 0x6e, 0x38, 0x76, 0xfa, 0x70, 0xe8, 0x06,
+	0x58, 0x5f, 0x31, 0xe0, 0x3b, 0xb4, 0x51, 0x2c, 0xb4, 0x74, 0xec, 0x5c, 0xc1, 0xde, 0x1c, 0xa1,
+	0x9f, 0x70, 0xff, 0xbb, 0xd4, 0xf9, 0xd3, 0xa5, 0xe0, 0xa6, 0xd8, 0x12, 0x50, 0x6e, 0x09, 0x28,
+	0x76, 0x04, 0x96, 0x3b, 0x02, 0x5f, 0x2b, 0x02, 0xde, 0x2a, 0x02, 0xcb, 0x8a, 0x80, 0xf7, 0x8a,
+	0x80, 0xa7, 0xc1, 0x4c, 0xc8, 0xf9, 0x66, 0xe2, 0x4f, 0x93, 0xd5, 0x28, 0xcb, 0xe3, 0xa9, 0x9c,
+	0x8b, 0x78, 0x76, 0x50, 0x35, 0xef, 0x9d, 0xb4, 0xcd, 0x5b, 0x2f, 0x3f, 0x07, 0x00, 0x84, 0x71,
+	0xa7, 0x8a, 0xf0, 0x01, 0x00, 0x00,
 }
 
 func (m *TokenSet) Marshal() (dAtA []byte, err error) {
@@ -108,6 +114,25 @@ func (m *TokenSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Users) > 0 {
+		for k := range m.Users {
+			v := m.Users[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintTokenset(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintTokenset(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintTokenset(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	if len(m.Tokens) > 0 {
 		for k := range m.Tokens {
 			v := m.Tokens[k]
@@ -153,6 +178,14 @@ func (m *TokenSet) ProtoSize() (n int) {
 			n += mapEntrySize + 1 + sovTokenset(uint64(mapEntrySize))
 		}
 	}
+	if len(m.Users) > 0 {
+		for k, v := range m.Users {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovTokenset(uint64(len(k))) + 1 + len(v) + sovTokenset(uint64(len(v)))
+			n += mapEntrySize + 1 + sovTokenset(uint64(mapEntrySize))
+		}
+	}
 	return n
 }
 
@@ -304,6 +337,133 @@ func (m *TokenSet) Unmarshal(dAtA []byte) error {
 			}
 			m.Tokens[mapkey] = mapvalue
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Users", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTokenset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTokenset
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTokenset
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Users == nil {
+				m.Users = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTokenset
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTokenset
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthTokenset
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthTokenset
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTokenset
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthTokenset
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthTokenset
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTokenset(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTokenset
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Users[mapkey] = mapvalue
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTokenset(dAtA[iNdEx:])
@@ -12,18 +12,20 @@ import (
 	"time"
 )
 
-func NewBroadcast(port int) Interface {
+// NewBroadcast returns a beacon broadcasting to and receiving from the
+// given port, on the interfaces passing the filter.
+func NewBroadcast(port int, filter InterfaceFilter) Interface {
 	c := newCast("broadcastBeacon")
 	c.addReader(func(ctx context.Context) error {
-		return readBroadcasts(ctx, c.outbox, port)
+		return readBroadcasts(ctx, c.outbox, port, filter)
 	})
 	c.addWriter(func(ctx context.Context) error {
-		return writeBroadcasts(ctx, c.inbox, port)
+		return writeBroadcasts(ctx, c.inbox, port, filter)
 	})
 	return c
 }
 
-func writeBroadcasts(ctx context.Context, inbox <-chan []byte, port int) error {
+func writeBroadcasts(ctx context.Context, inbox <-chan []byte, port int, filter InterfaceFilter) error {
 	conn, err := net.ListenUDP("udp4", nil)
 	if err != nil {
 		l.Debugln(err)
@@ -44,7 +46,7 @@ func writeBroadcasts(ctx context.Context, inbox <-chan []byte, port int) error {
 			return doneCtx.Err()
 		}
 
-		intfs, err := net.Interfaces()
+		intfs, err := filter.Interfaces()
 		if err != nil {
 			l.Debugln("Failed to list interfaces:", err)
 			// net.Interfaces() is broken on Android. see https://github.com/golang/go/issues/40569
@@ -73,6 +75,12 @@ func writeBroadcasts(ctx context.Context, inbox <-chan []byte, port int) error {
 		}
 
 		if len(dsts) == 0 {
+			if len(filter) > 0 {
+				// The general broadcast address would go out on
+				// whatever interface routing picks.
+				l.Debugln("no broadcast addresses on the filtered interfaces")
+				continue
+			}
 			// Fall back to the general IPv4 broadcast address
 			dsts = append(dsts, net.IP{0xff, 0xff, 0xff, 0xff})
 		}
@@ -111,7 +119,7 @@ func writeBroadcasts(ctx context.Context, inbox <-chan []byte, port int) error {
 	}
 }
 
-func readBroadcasts(ctx context.Context, outbox chan<- recv, port int) error {
+func readBroadcasts(ctx context.Context, outbox chan<- recv, port int, filter InterfaceFilter) error {
 	conn, err := net.ListenUDP("udp4", &net.UDPAddr{Port: port})
 	if err != nil {
 		l.Debugln(err)
@@ -133,6 +141,11 @@ func readBroadcasts(ctx context.Context, outbox chan<- recv, port int) error {
 			return err
 		}
 
+		if len(filter) > 0 && !fromNetworks(addr, filter) {
+			l.Debugf("ignoring %d bytes from %s outside of the filtered interfaces", n, addr)
+			continue
+		}
+
 		l.Debugf("recv %d bytes from %s", n, addr)
 
 		c := make([]byte, n)
@@ -147,6 +160,27 @@ func readBroadcasts(ctx context.Context, outbox chan<- recv, port int) error {
 	}
 }
 
+// fromNetworks returns true if the address is on the network of one of the
+// interfaces passing the filter. Broadcasts carry no portable indication of
+// the interface they were received on, so go by the sender instead.
+func fromNetworks(addr net.Addr, filter InterfaceFilter) bool {
+	udpAddr, ok := addr.(*net.UDPAddr)
+	if !ok {
+		return false
+	}
+	nets, err := filter.Networks()
+	if err != nil {
+		l.Debugln("Failed to list interface networks:", err)
+		return false
+	}
+	for _, ipnet := range nets {
+		if ipnet.Contains(udpAddr.IP) {
+			return true
+		}
+	}
+	return false
+}
+
 func bcast(ip *net.IPNet) *net.IPNet {
 	var bc = &net.IPNet{}
 	bc.IP = make([]byte, len(ip.IP))
@@ -0,0 +1,86 @@
+// Copyright (C) 2026 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package beacon
+
+import (
+	"net"
+	"path"
+	"strings"
+)
+
+// InterfaceFilter selects the network interfaces to send and receive on,
+// by name. Entries may be glob patterns ("docker*"), and entries prefixed
+// with "!" exclude the matching interfaces. An empty filter, or one with
+// only exclusions, allows all interfaces not otherwise excluded.
+type InterfaceFilter []string
+
+// Allows returns true if the named interface passes the filter.
+func (f InterfaceFilter) Allows(name string) bool {
+	restricted, included := false, false
+	for _, pat := range f {
+		if excl, ok := strings.CutPrefix(pat, "!"); ok {
+			if match(excl, name) {
+				return false
+			}
+			continue
+		}
+		restricted = true
+		if match(pat, name) {
+			included = true
+		}
+	}
+	return !restricted || included
+}
+
+// Interfaces returns the network interfaces that pass the filter.
+func (f InterfaceFilter) Interfaces() ([]net.Interface, error) {
+	intfs, err := net.Interfaces()
+	if err != nil {
+		return nil, err
+	}
+	if len(f) == 0 {
+		return intfs, nil
+	}
+	filtered := intfs[:0]
+	for _, intf := range intfs {
+		if f.Allows(intf.Name) {
+			filtered = append(filtered, intf)
+		} else {
+			l.Debugln("skipping filtered interface", intf.Name)
+		}
+	}
+	return filtered, nil
+}
+
+// Networks returns the networks of the interfaces that pass the filter.
+func (f InterfaceFilter) Networks() ([]*net.IPNet, error) {
+	intfs, err := f.Interfaces()
+	if err != nil {
+		return nil, err
+	}
+	var nets []*net.IPNet
+	for _, intf := range intfs {
+		addrs, err := intf.Addrs()
+		if err != nil {
+			l.Debugln("Failed to list interface addresses:", err)
+			continue
+		}
+		for _, addr := range addrs {
+			if ipnet, ok := addr.(*net.IPNet); ok {
+				nets = append(nets, ipnet)
+			}
+		}
+	}
+	return nets, nil
+}
+
+func match(pattern, name string) bool {
+	if ok, err := path.Match(pattern, name); err == nil {
+		return ok
+	}
+	return pattern == name
+}
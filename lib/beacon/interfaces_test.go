@@ -0,0 +1,34 @@
+// Copyright (C) 2026 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package beacon
+
+import "testing"
+
+func TestInterfaceFilter(t *testing.T) {
+	cases := []struct {
+		filter  InterfaceFilter
+		name    string
+		allowed bool
+	}{
+		{nil, "eth0", true},
+		{InterfaceFilter{"eth0"}, "eth0", true},
+		{InterfaceFilter{"eth0"}, "wlan0", false},
+		{InterfaceFilter{"eth*", "wlan0"}, "wlan0", true},
+		{InterfaceFilter{"eth*", "wlan0"}, "eth1", true},
+		{InterfaceFilter{"!docker*", "!tun0"}, "eth0", true},
+		{InterfaceFilter{"!docker*", "!tun0"}, "docker0", false},
+		{InterfaceFilter{"!docker*", "!tun0"}, "tun0", false},
+		{InterfaceFilter{"!eth1", "eth*"}, "eth1", false},
+		{InterfaceFilter{"!eth1", "eth*"}, "eth0", true},
+		{InterfaceFilter{"[eth0"}, "[eth0", true},
+	}
+	for _, tc := range cases {
+		if res := tc.filter.Allows(tc.name); res != tc.allowed {
+			t.Errorf("%q.Allows(%q) = %v, expected %v", tc.filter, tc.name, res, tc.allowed)
+		}
+	}
+}
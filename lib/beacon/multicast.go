@@ -12,28 +12,31 @@ import (
 	"net"
 	"time"
 
+	"golang.org/x/net/ipv4"
 	"golang.org/x/net/ipv6"
 )
 
-func NewMulticast(addr string) Interface {
+// NewMulticast returns a beacon multicasting to and receiving from the
+// given IPv4 or IPv6 group address, on the interfaces passing the filter.
+func NewMulticast(addr string, filter InterfaceFilter) Interface {
 	c := newCast("multicastBeacon")
 	c.addReader(func(ctx context.Context) error {
-		return readMulticasts(ctx, c.outbox, addr)
+		return readMulticasts(ctx, c.outbox, addr, filter)
 	})
 	c.addWriter(func(ctx context.Context) error {
-		return writeMulticasts(ctx, c.inbox, addr)
+		return writeMulticasts(ctx, c.inbox, addr, filter)
 	})
 	return c
 }
 
-func writeMulticasts(ctx context.Context, inbox <-chan []byte, addr string) error {
-	gaddr, err := net.ResolveUDPAddr("udp6", addr)
+func writeMulticasts(ctx context.Context, inbox <-chan []byte, addr string, filter InterfaceFilter) error {
+	network, gaddr, err := resolveGroup(addr)
 	if err != nil {
 		l.Debugln(err)
 		return err
 	}
 
-	conn, err := net.ListenPacket("udp6", ":0")
+	conn, err := net.ListenPacket(network, ":0")
 	if err != nil {
 		l.Debugln(err)
 		return err
@@ -45,11 +48,7 @@ func writeMulticasts(ctx context.Context, inbox <-chan []byte, addr string) erro
 		conn.Close()
 	}()
 
-	pconn := ipv6.NewPacketConn(conn)
-
-	wcm := &ipv6.ControlMessage{
-		HopLimit: 1,
-	}
+	pconn := newMulticastConn(network, conn)
 
 	for {
 		var bs []byte
@@ -59,21 +58,25 @@ func writeMulticasts(ctx context.Context, inbox <-chan []byte, addr string) erro
 			return doneCtx.Err()
 		}
 
-		intfs, err := net.Interfaces()
+		intfs, err := filter.Interfaces()
 		if err != nil {
 			l.Debugln(err)
 			return err
 		}
 
+		if len(intfs) == 0 {
+			l.Debugln("no interfaces to multicast on")
+			continue
+		}
+
 		success := 0
 		for _, intf := range intfs {
 			if intf.Flags&net.FlagRunning == 0 || intf.Flags&net.FlagMulticast == 0 {
 				continue
 			}
 
-			wcm.IfIndex = intf.Index
 			pconn.SetWriteDeadline(time.Now().Add(time.Second))
-			_, err = pconn.WriteTo(bs, wcm, gaddr)
+			err = pconn.writeTo(bs, &intf, gaddr)
 			pconn.SetWriteDeadline(time.Time{})
 
 			if err != nil {
@@ -98,14 +101,14 @@ func writeMulticasts(ctx context.Context, inbox <-chan []byte, addr string) erro
 	}
 }
 
-func readMulticasts(ctx context.Context, outbox chan<- recv, addr string) error {
-	gaddr, err := net.ResolveUDPAddr("udp6", addr)
+func readMulticasts(ctx context.Context, outbox chan<- recv, addr string, filter InterfaceFilter) error {
+	network, gaddr, err := resolveGroup(addr)
 	if err != nil {
 		l.Debugln(err)
 		return err
 	}
 
-	conn, err := net.ListenPacket("udp6", addr)
+	conn, err := net.ListenPacket(network, addr)
 	if err != nil {
 		l.Debugln(err)
 		return err
@@ -117,20 +120,22 @@ func readMulticasts(ctx context.Context, outbox chan<- recv, addr string) error
 		conn.Close()
 	}()
 
-	intfs, err := net.Interfaces()
+	intfs, err := filter.Interfaces()
 	if err != nil {
 		l.Debugln(err)
 		return err
 	}
 
-	pconn := ipv6.NewPacketConn(conn)
+	pconn := newMulticastConn(network, conn)
 	joined := 0
+	allowed := make(map[int]struct{}, len(intfs))
 	for _, intf := range intfs {
+		allowed[intf.Index] = struct{}{}
 		err := pconn.JoinGroup(&intf, &net.UDPAddr{IP: gaddr.IP})
 		if err != nil {
-			l.Debugln("IPv6 join", intf.Name, "failed:", err)
+			l.Debugln(network, "join", intf.Name, "failed:", err)
 		} else {
-			l.Debugln("IPv6 join", intf.Name, "success")
+			l.Debugln(network, "join", intf.Name, "success")
 		}
 		joined++
 	}
@@ -140,6 +145,14 @@ func readMulticasts(ctx context.Context, outbox chan<- recv, addr string) error
 		return errors.New("no multicast interfaces available")
 	}
 
+	// The group may still be joined on other interfaces by other sockets,
+	// so check where packets arrive when filtering.
+	if len(filter) > 0 {
+		if err := pconn.receiveInterface(); err != nil {
+			l.Debugln(network, "interface control messages:", err)
+		}
+	}
+
 	bs := make([]byte, 65536)
 	for {
 		select {
@@ -147,11 +160,17 @@ func readMulticasts(ctx context.Context, outbox chan<- recv, addr string) error
 			return doneCtx.Err()
 		default:
 		}
-		n, _, addr, err := pconn.ReadFrom(bs)
+		n, ifIndex, addr, err := pconn.readFrom(bs)
 		if err != nil {
 			l.Debugln(err)
 			return err
 		}
+		if ifIndex != 0 && len(filter) > 0 {
+			if _, ok := allowed[ifIndex]; !ok {
+				l.Debugf("ignoring %d bytes from %s on filtered interface %d", n, addr, ifIndex)
+				continue
+			}
+		}
 		l.Debugf("recv %d bytes from %s", n, addr)
 
 		c := make([]byte, n)
@@ -163,3 +182,86 @@ func readMulticasts(ctx context.Context, outbox chan<- recv, addr string) error
 		}
 	}
 }
+
+// resolveGroup returns the network for the group address, and the address.
+func resolveGroup(addr string) (string, *net.UDPAddr, error) {
+	gaddr, err := net.ResolveUDPAddr("udp", addr)
+	if err != nil {
+		return "", nil, err
+	}
+	if !gaddr.IP.IsMulticast() {
+		return "", nil, errors.New("not a multicast address: " + addr)
+	}
+	if gaddr.IP.To4() != nil {
+		return "udp4", gaddr, nil
+	}
+	return "udp6", gaddr, nil
+}
+
+// multicastConn is the common part of the IPv4 and IPv6 packet
+// connections used for multicasting.
+type multicastConn interface {
+	SetWriteDeadline(t time.Time) error
+	JoinGroup(intf *net.Interface, group net.Addr) error
+	// writeTo sends on the given interface, to the local link only.
+	writeTo(bs []byte, intf *net.Interface, dst net.Addr) error
+	// readFrom returns the index of the interface the packet arrived on,
+	// or zero if unknown.
+	readFrom(bs []byte) (n, ifIndex int, src net.Addr, err error)
+	// receiveInterface requests interface indexes on reads.
+	receiveInterface() error
+}
+
+func newMulticastConn(network string, conn net.PacketConn) multicastConn {
+	if network == "udp4" {
+		pconn := ipv4.NewPacketConn(conn)
+		_ = pconn.SetMulticastTTL(1)
+		return multicastConn4{pconn}
+	}
+	return multicastConn6{ipv6.NewPacketConn(conn)}
+}
+
+type multicastConn4 struct {
+	*ipv4.PacketConn
+}
+
+func (c multicastConn4) writeTo(bs []byte, intf *net.Interface, dst net.Addr) error {
+	if err := c.SetMulticastInterface(intf); err != nil {
+		return err
+	}
+	_, err := c.WriteTo(bs, nil, dst)
+	return err
+}
+
+func (c multicastConn4) readFrom(bs []byte) (int, int, net.Addr, error) {
+	n, cm, src, err := c.ReadFrom(bs)
+	if cm == nil {
+		return n, 0, src, err
+	}
+	return n, cm.IfIndex, src, err
+}
+
+func (c multicastConn4) receiveInterface() error {
+	return c.SetControlMessage(ipv4.FlagInterface, true)
+}
+
+type multicastConn6 struct {
+	*ipv6.PacketConn
+}
+
+func (c multicastConn6) writeTo(bs []byte, intf *net.Interface, dst net.Addr) error {
+	_, err := c.WriteTo(bs, &ipv6.ControlMessage{HopLimit: 1, IfIndex: intf.Index}, dst)
+	return err
+}
+
+func (c multicastConn6) readFrom(bs []byte) (int, int, net.Addr, error) {
+	n, cm, src, err := c.ReadFrom(bs)
+	if cm == nil {
+		return n, 0, src, err
+	}
+	return n, cm.IfIndex, src, err
+}
+
+func (c multicastConn6) receiveInterface() error {
+	return c.SetControlMessage(ipv6.FlagInterface, true)
+}
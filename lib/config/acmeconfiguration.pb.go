@@ -0,0 +1,606 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: lib/config/acmeconfiguration.proto
+
+package config
+
+import (
+	fmt "fmt"
+	proto "github.com/gogo/protobuf/proto"
+	_ "github.com/syncthing/syncthing/proto/ext"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Settings for obtaining the certificate of the GUI and API listener from a
+// certificate authority through ACME, e.g. Let's Encrypt, instead of using
+// the self-signed one.
+type ACMEConfiguration struct {
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled" xml:"enabled,attr"`
+	// The names the certificate is for, which must resolve to this device.
+	Domains []string `protobuf:"bytes,2,rep,name=domains,proto3" json:"domains" xml:"domain"`
+	// Given to the certificate authority for expiry notices.
+	Email        string `protobuf:"bytes,3,opt,name=email,proto3" json:"email" xml:"email,omitempty"`
+	DirectoryURL string `protobuf:"bytes,4,opt,name=directory_url,json=directoryUrl,proto3" json:"directoryURL" xml:"directoryURL,omitempty" default:"https://acme-v02.api.letsencrypt.org/directory"`
+	// Either http-01 or dns-01.
+	Challenge string `protobuf:"bytes,5,opt,name=challenge,proto3" json:"challenge" xml:"challenge,omitempty" default:"http-01"`
+	// Where to answer http-01 challenges, which the certificate authority
+	// makes on port 80 of the domains.
+	HTTPAddress string `protobuf:"bytes,6,opt,name=http_address,json=httpAddress,proto3" json:"httpAddress" xml:"httpAddress,omitempty" default:":80"`
+	// The command run to create and remove the TXT records of dns-01
+	// challenges, with the arguments "present" or "cleanup", the record
+	// name and its value.
+	DNSCommand string `protobuf:"bytes,7,opt,name=dns_command,json=dnsCommand,proto3" json:"dnsCommand" xml:"dnsCommand,omitempty"`
+}
+
+func (m *ACMEConfiguration) Reset()         { *m = ACMEConfiguration{} }
+func (m *ACMEConfiguration) String() string { return proto.CompactTextString(m) }
+func (*ACMEConfiguration) ProtoMessage()    {}
+func (*ACMEConfiguration) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e72c144b5cf05a21, []int{0}
+}
+func (m *ACMEConfiguration) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ACMEConfiguration) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ACMEConfiguration.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ACMEConfiguration) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ACMEConfiguration.Merge(m, src)
+}
+func (m *ACMEConfiguration) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *ACMEConfiguration) XXX_DiscardUnknown() {
+	xxx_messageInfo_ACMEConfiguration.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ACMEConfiguration proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*ACMEConfiguration)(nil), "config.ACMEConfiguration")
+}
+
+func init() {
+	proto.RegisterFile("lib/config/acmeconfiguration.proto", fileDescriptor_e72c144b5cf05a21)
+}
+
+var fileDescriptor_e72c144b5cf05a21 = []byte{
+	// 584 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0x4f, 0x8b, 0xd3, 0x40,
+	0x18, 0xc6, 0x9b, 0x5d, 0xb7, 0xb5, 0xb3, 0x15, 0x31, 0xba, 0x12, 0xf6, 0x90, 0x29, 0x25, 0x42,
+	0x17, 0x77, 0xd3, 0xae, 0x22, 0x2c, 0xbd, 0xc8, 0xb6, 0x2b, 0x08, 0x55, 0x91, 0xb8, 0x7b, 0xf1,
+	0x60, 0x99, 0x26, 0xb3, 0x6d, 0x20, 0x99, 0x94, 0x64, 0x2a, 0x5b, 0xf0, 0x0b, 0x88, 0x07, 0xa5,
+	0x9f, 0xc0, 0x2f, 0xe0, 0x37, 0xf0, 0x03, 0xec, 0x2d, 0x39, 0x7a, 0x1a, 0xd8, 0x16, 0x2f, 0x39,
+	0xe6, 0xd8, 0x93, 0x24, 0xd3, 0x3f, 0x53, 0xa9, 0x07, 0x6f, 0xf3, 0xfe, 0xde, 0x27, 0xcf, 0x3c,
+	0x6f, 0x66, 0x18, 0x50, 0x71, 0xec, 0x6e, 0xcd, 0xf4, 0xc8, 0xa5, 0xdd, 0xab, 0x21, 0xd3, 0xc5,
+	0x7c, 0x39, 0xf4, 0x11, 0xb5, 0x3d, 0xa2, 0x0f, 0x7c, 0x8f, 0x7a, 0x72, 0x9e, 0xc3, 0xfd, 0x22,
+	0xbe, 0xa2, 0x1c, 0x55, 0x7e, 0x16, 0xc0, 0xbd, 0xd3, 0xd6, 0xeb, 0x17, 0x2d, 0x51, 0x2e, 0xb7,
+	0x41, 0x01, 0x13, 0xd4, 0x75, 0xb0, 0xa5, 0x48, 0x65, 0xa9, 0x7a, 0xbb, 0x79, 0x1c, 0x33, 0xb8,
+	0x40, 0x09, 0x83, 0xf2, 0x95, 0xeb, 0x34, 0x2a, 0xf3, 0xfa, 0x10, 0x51, 0xea, 0x57, 0xe2, 0x50,
+	0x2b, 0x89, 0xc0, 0x58, 0xc8, 0xe5, 0xe7, 0xa0, 0x60, 0x79, 0x2e, 0xb2, 0x49, 0xa0, 0x6c, 0x95,
+	0xb7, 0xab, 0xc5, 0xe6, 0xa3, 0xd4, 0x6c, 0x8e, 0x12, 0x06, 0x4b, 0x99, 0x19, 0xaf, 0x53, 0x9b,
+	0x3c, 0x5f, 0x1a, 0x0b, 0x89, 0xdc, 0x06, 0x3b, 0xd8, 0x45, 0xb6, 0xa3, 0x6c, 0x97, 0xa5, 0x6a,
+	0xb1, 0xf9, 0x2c, 0x66, 0x90, 0x83, 0x84, 0xc1, 0x3d, 0x9e, 0x24, 0xad, 0x0e, 0x3d, 0xd7, 0xa6,
+	0xd8, 0x1d, 0xd0, 0x51, 0xea, 0x72, 0xf7, 0x2f, 0x66, 0xf0, 0x4f, 0xe4, 0x2f, 0x5b, 0xe0, 0x8e,
+	0x65, 0xfb, 0xd8, 0xa4, 0x9e, 0x3f, 0xea, 0x0c, 0x7d, 0x47, 0xb9, 0x95, 0xb9, 0xfe, 0x96, 0x26,
+	0x0c, 0x96, 0xce, 0x16, 0x9d, 0x0b, 0xe3, 0x55, 0xcc, 0x60, 0xc9, 0x12, 0xea, 0x84, 0xc1, 0x0b,
+	0x1e, 0x55, 0x80, 0xc2, 0xa6, 0x65, 0x0b, 0x5f, 0xa2, 0xa1, 0x43, 0x1b, 0x95, 0x3e, 0xa5, 0x83,
+	0xa0, 0x51, 0xcb, 0xce, 0xe1, 0xe8, 0x63, 0xfd, 0x89, 0x8e, 0x06, 0xb6, 0xee, 0x60, 0x1a, 0x60,
+	0x62, 0xfa, 0xa3, 0x01, 0xd5, 0x3d, 0xbf, 0x57, 0x5b, 0xba, 0xa4, 0x69, 0x1f, 0x6e, 0xf6, 0x4c,
+	0x42, 0x6d, 0x2d, 0xc2, 0x2c, 0xd4, 0xf4, 0xff, 0x33, 0x1f, 0x47, 0xda, 0xda, 0x50, 0x86, 0xe0,
+	0xe7, 0x3b, 0xf2, 0x27, 0x50, 0x34, 0xfb, 0xc8, 0x71, 0x30, 0xe9, 0x61, 0x65, 0x27, 0xfb, 0x11,
+	0x1f, 0x62, 0x06, 0x57, 0x30, 0x61, 0xf0, 0x71, 0x36, 0xf4, 0x92, 0xfc, 0x73, 0xe2, 0xa3, 0xfa,
+	0x71, 0x3a, 0xca, 0xfd, 0x0d, 0xca, 0x59, 0xa8, 0x15, 0xe6, 0x12, 0x63, 0xe5, 0x2d, 0xff, 0x90,
+	0x40, 0x29, 0xc5, 0x1d, 0x64, 0x59, 0x3e, 0x0e, 0x02, 0x25, 0x9f, 0x25, 0xf8, 0x9c, 0x1e, 0xc5,
+	0xee, 0xcb, 0xf3, 0xf3, 0xb7, 0xa7, 0x9c, 0xc7, 0x0c, 0xee, 0xa6, 0xba, 0x79, 0x99, 0x30, 0x78,
+	0x90, 0x65, 0x12, 0xd8, 0xc6, 0x54, 0x8d, 0x93, 0x7a, 0x9a, 0x68, 0x6f, 0xa3, 0x2e, 0x09, 0x35,
+	0xd1, 0x74, 0x16, 0x6a, 0xdb, 0x8d, 0x93, 0xfa, 0x38, 0xd2, 0xc4, 0x9d, 0x0d, 0x51, 0x22, 0x7f,
+	0x95, 0xc0, 0xae, 0x45, 0x82, 0x8e, 0xe9, 0xb9, 0x2e, 0x22, 0x96, 0x52, 0xc8, 0xe2, 0x92, 0x09,
+	0x83, 0xe0, 0xec, 0xcd, 0xbb, 0x16, 0xa7, 0x31, 0x83, 0xc0, 0x22, 0xc1, 0xbc, 0x4a, 0x18, 0xdc,
+	0xe7, 0x97, 0x66, 0x89, 0xd6, 0xef, 0xe9, 0x83, 0x4d, 0x8d, 0x24, 0xd4, 0x04, 0x8f, 0x71, 0xa4,
+	0x09, 0xfe, 0x86, 0xd0, 0x69, 0xb6, 0xaf, 0x6f, 0xd4, 0x5c, 0x74, 0xa3, 0xe6, 0xae, 0x27, 0xaa,
+	0x14, 0x4d, 0x54, 0xe9, 0xdb, 0x54, 0xcd, 0x7d, 0x9f, 0xaa, 0x52, 0x34, 0x55, 0x73, 0xbf, 0xa6,
+	0x6a, 0xee, 0xfd, 0x41, 0xcf, 0xa6, 0xfd, 0x61, 0x57, 0x37, 0x3d, 0xb7, 0x16, 0x8c, 0x88, 0x49,
+	0xfb, 0x36, 0xe9, 0x09, 0xab, 0xd5, 0xb3, 0xd1, 0xcd, 0x67, 0x4f, 0xc2, 0xd3, 0x3f, 0x03, 0x00,
+	0xa4, 0x48, 0x55, 0x86, 0x4b, 0x04, 0x00, 0x00,
+}
+
+func (m *ACMEConfiguration) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ACMEConfiguration) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ACMEConfiguration) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DNSCommand) > 0 {
+		i -= len(m.DNSCommand)
+		copy(dAtA[i:], m.DNSCommand)
+		i = encodeVarintAcmeconfiguration(dAtA, i, uint64(len(m.DNSCommand)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.HTTPAddress) > 0 {
+		i -= len(m.HTTPAddress)
+		copy(dAtA[i:], m.HTTPAddress)
+		i = encodeVarintAcmeconfiguration(dAtA, i, uint64(len(m.HTTPAddress)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Challenge) > 0 {
+		i -= len(m.Challenge)
+		copy(dAtA[i:], m.Challenge)
+		i = encodeVarintAcmeconfiguration(dAtA, i, uint64(len(m.Challenge)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.DirectoryURL) > 0 {
+		i -= len(m.DirectoryURL)
+		copy(dAtA[i:], m.DirectoryURL)
+		i = encodeVarintAcmeconfiguration(dAtA, i, uint64(len(m.DirectoryURL)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Email) > 0 {
+		i -= len(m.Email)
+		copy(dAtA[i:], m.Email)
+		i = encodeVarintAcmeconfiguration(dAtA, i, uint64(len(m.Email)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Domains) > 0 {
+		for iNdEx := len(m.Domains) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Domains[iNdEx])
+			copy(dAtA[i:], m.Domains[iNdEx])
+			i = encodeVarintAcmeconfiguration(dAtA, i, uint64(len(m.Domains[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintAcmeconfiguration(dAtA []byte, offset int, v uint64) int {
+	offset -= sovAcmeconfiguration(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ACMEConfiguration) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Enabled {
+		n += 2
+	}
+	if len(m.Domains) > 0 {
+		for _, s := range m.Domains {
+			l = len(s)
+			n += 1 + l + sovAcmeconfiguration(uint64(l))
+		}
+	}
+	l = len(m.Email)
+	if l > 0 {
+		n += 1 + l + sovAcmeconfiguration(uint64(l))
+	}
+	l = len(m.DirectoryURL)
+	if l > 0 {
+		n += 1 + l + sovAcmeconfiguration(uint64(l))
+	}
+	l = len(m.Challenge)
+	if l > 0 {
+		n += 1 + l + sovAcmeconfiguration(uint64(l))
+	}
+	l = len(m.HTTPAddress)
+	if l > 0 {
+		n += 1 + l + sovAcmeconfiguration(uint64(l))
+	}
+	l = len(m.DNSCommand)
+	if l > 0 {
+		n += 1 + l + sovAcmeconfiguration(uint64(l))
+	}
+	return n
+}
+
+func sovAcmeconfiguration(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozAcmeconfiguration(x uint64) (n int) {
+	return sovAcmeconfiguration(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ACMEConfiguration) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAcmeconfiguration
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ACMEConfiguration: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ACMEConfiguration: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAcmeconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Domains", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAcmeconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAcmeconfiguration
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAcmeconfiguration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Domains = append(m.Domains, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Email", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAcmeconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAcmeconfiguration
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAcmeconfiguration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Email = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DirectoryURL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAcmeconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAcmeconfiguration
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAcmeconfiguration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DirectoryURL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Challenge", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAcmeconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAcmeconfiguration
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAcmeconfiguration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Challenge = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HTTPAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAcmeconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAcmeconfiguration
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAcmeconfiguration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HTTPAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DNSCommand", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAcmeconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAcmeconfiguration
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAcmeconfiguration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DNSCommand = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAcmeconfiguration(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAcmeconfiguration
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipAcmeconfiguration(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowAcmeconfiguration
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAcmeconfiguration
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAcmeconfiguration
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthAcmeconfiguration
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupAcmeconfiguration
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthAcmeconfiguration
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthAcmeconfiguration        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowAcmeconfiguration          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupAcmeconfiguration = fmt.Errorf("proto: unexpected end of group")
+)
@@ -12,6 +12,8 @@ func (t AuthMode) String() string {
 		return "static"
 	case AuthModeLDAP:
 		return "ldap"
+	case AuthModeOIDC:
+		return "oidc"
 	default:
 		return "unknown"
 	}
@@ -25,6 +27,8 @@ func (t *AuthMode) UnmarshalText(bs []byte) error {
 	switch string(bs) {
 	case "ldap":
 		*t = AuthModeLDAP
+	case "oidc":
+		*t = AuthModeOIDC
 	case "static":
 		*t = AuthModeStatic
 	default:
@@ -0,0 +1,47 @@
+// Copyright (C) 2026 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package config
+
+import "github.com/syncthing/syncthing/lib/protocol"
+
+func (c CompletionCondition) String() string {
+	switch c {
+	case CompletionConditionCompleted:
+		return "completed"
+	case CompletionConditionBelow:
+		return "below"
+	default:
+		return "unknown"
+	}
+}
+
+func (c CompletionCondition) MarshalText() ([]byte, error) {
+	return []byte(c.String()), nil
+}
+
+func (c *CompletionCondition) UnmarshalText(bs []byte) error {
+	switch string(bs) {
+	case "completed":
+		*c = CompletionConditionCompleted
+	case "below":
+		*c = CompletionConditionBelow
+	default:
+		*c = CompletionConditionCompleted
+	}
+	return nil
+}
+
+// Matches returns true if the webhook applies to the given device.
+func (w CompletionWebhook) Matches(device protocol.DeviceID) bool {
+	return w.DeviceID == protocol.EmptyDeviceID || w.DeviceID == device
+}
+
+func (w *CompletionWebhook) prepare() {
+	if w.ThresholdPct <= 0 || w.ThresholdPct > 100 {
+		w.ThresholdPct = 100
+	}
+}
@@ -0,0 +1,458 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: lib/config/completionwebhook.proto
+
+package config
+
+import (
+	encoding_binary "encoding/binary"
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	github_com_syncthing_syncthing_lib_protocol "github.com/syncthing/syncthing/lib/protocol"
+	_ "github.com/syncthing/syncthing/proto/ext"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type CompletionCondition int32
+
+const (
+	CompletionConditionCompleted CompletionCondition = 0
+	CompletionConditionBelow     CompletionCondition = 1
+)
+
+var CompletionCondition_name = map[int32]string{
+	0: "COMPLETION_CONDITION_COMPLETED",
+	1: "COMPLETION_CONDITION_BELOW",
+}
+
+var CompletionCondition_value = map[string]int32{
+	"COMPLETION_CONDITION_COMPLETED": 0,
+	"COMPLETION_CONDITION_BELOW":     1,
+}
+
+func (CompletionCondition) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_ae7f5628f5e7b941, []int{0}
+}
+
+// A webhook that is called with a POST when the completion of the folder
+// on a remote device reaches 100% ("completed"), or drops below the
+// threshold ("below").
+type CompletionWebhook struct {
+	URL          string                                               `protobuf:"bytes,1,opt,name=url,proto3" json:"url" xml:"url,attr"`
+	DeviceID     github_com_syncthing_syncthing_lib_protocol.DeviceID `protobuf:"bytes,2,opt,name=device_id,json=deviceId,proto3,customtype=github.com/syncthing/syncthing/lib/protocol.DeviceID" json:"deviceID" xml:"device,attr,omitempty" nodefault:"true"`
+	Condition    CompletionCondition                                  `protobuf:"varint,3,opt,name=condition,proto3,enum=config.CompletionCondition" json:"condition" xml:"condition,attr"`
+	ThresholdPct float64                                              `protobuf:"fixed64,4,opt,name=threshold_pct,json=thresholdPct,proto3" json:"thresholdPct" xml:"thresholdPct,attr" default:"100"`
+}
+
+func (m *CompletionWebhook) Reset()         { *m = CompletionWebhook{} }
+func (m *CompletionWebhook) String() string { return proto.CompactTextString(m) }
+func (*CompletionWebhook) ProtoMessage()    {}
+func (*CompletionWebhook) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ae7f5628f5e7b941, []int{0}
+}
+func (m *CompletionWebhook) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CompletionWebhook) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CompletionWebhook.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CompletionWebhook) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CompletionWebhook.Merge(m, src)
+}
+func (m *CompletionWebhook) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *CompletionWebhook) XXX_DiscardUnknown() {
+	xxx_messageInfo_CompletionWebhook.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CompletionWebhook proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterEnum("config.CompletionCondition", CompletionCondition_name, CompletionCondition_value)
+	proto.RegisterType((*CompletionWebhook)(nil), "config.CompletionWebhook")
+}
+
+func init() {
+	proto.RegisterFile("lib/config/completionwebhook.proto", fileDescriptor_ae7f5628f5e7b941)
+}
+
+var fileDescriptor_ae7f5628f5e7b941 = []byte{
+	// 548 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x52, 0x31, 0x6b, 0xdb, 0x40,
+	0x18, 0xd5, 0xd5, 0x21, 0xd8, 0x22, 0x35, 0x89, 0xda, 0x82, 0x51, 0xc3, 0x9d, 0x50, 0x55, 0x70,
+	0x8b, 0xb1, 0x9d, 0xb6, 0x50, 0x30, 0x9d, 0x64, 0x7b, 0x30, 0x75, 0xe3, 0x60, 0x1a, 0x02, 0x5d,
+	0x4c, 0x2c, 0x9d, 0x6d, 0x51, 0x59, 0x67, 0xe4, 0x53, 0x93, 0xf4, 0x17, 0x94, 0x4c, 0x25, 0x7b,
+	0x68, 0x86, 0x0e, 0xdd, 0xfa, 0x0b, 0xba, 0x7b, 0xb3, 0xc6, 0xd2, 0xc2, 0x41, 0xec, 0xcd, 0xa3,
+	0xc6, 0x4e, 0x45, 0x27, 0x5b, 0x4e, 0xa8, 0x86, 0x6e, 0xef, 0xde, 0xf7, 0x7d, 0xef, 0x7b, 0xf7,
+	0xee, 0x44, 0xd5, 0xb6, 0xba, 0x25, 0x83, 0x38, 0x3d, 0xab, 0x5f, 0x32, 0xc8, 0x70, 0x64, 0x63,
+	0x6a, 0x11, 0xe7, 0x04, 0x77, 0x07, 0x84, 0xbc, 0x2f, 0x8e, 0x5c, 0x42, 0x89, 0xb4, 0x19, 0xd5,
+	0xe5, 0x47, 0x2e, 0x1e, 0x91, 0x71, 0x89, 0x93, 0x5d, 0xaf, 0x57, 0xea, 0x93, 0x3e, 0xe1, 0x07,
+	0x8e, 0xa2, 0x66, 0x39, 0x83, 0x4f, 0x69, 0x04, 0xd5, 0xef, 0x1b, 0xe2, 0x4e, 0x35, 0xd6, 0x3c,
+	0x8a, 0x34, 0xa5, 0xa6, 0x98, 0xf2, 0x5c, 0x3b, 0x07, 0x14, 0x90, 0xcf, 0xe8, 0x95, 0x19, 0x43,
+	0xa9, 0xc3, 0x76, 0x73, 0xc1, 0x50, 0xc8, 0x06, 0x0c, 0x65, 0x4f, 0x87, 0x76, 0x45, 0xf5, 0x5c,
+	0xbb, 0x70, 0x4c, 0xa9, 0xab, 0x2e, 0xa6, 0x5a, 0x7a, 0x75, 0x08, 0xa6, 0x5a, 0xd8, 0x74, 0xe1,
+	0x6b, 0xe1, 0x48, 0x3b, 0xc4, 0xd2, 0x6f, 0x20, 0x66, 0x4c, 0xfc, 0xc1, 0x32, 0x70, 0xc7, 0x32,
+	0x73, 0x77, 0x14, 0x90, 0xdf, 0xd2, 0x7f, 0x80, 0x09, 0x43, 0xc2, 0x2f, 0x86, 0x5e, 0xf4, 0x2d,
+	0x3a, 0xf0, 0xba, 0x45, 0x83, 0x0c, 0x4b, 0xe3, 0x33, 0xc7, 0xa0, 0x03, 0xcb, 0xe9, 0xdf, 0x40,
+	0xe1, 0xdd, 0xb9, 0x55, 0x83, 0xd8, 0xc5, 0x1a, 0x57, 0x69, 0xd4, 0x66, 0x0c, 0xa5, 0x57, 0x78,
+	0xc1, 0x50, 0xda, 0x5c, 0xe2, 0x80, 0xa1, 0x02, 0xf7, 0x16, 0x11, 0xdc, 0x51, 0x81, 0x0c, 0x2d,
+	0x8a, 0x87, 0x23, 0x7a, 0xa6, 0x2a, 0x0e, 0x31, 0x71, 0xef, 0xd8, 0xb3, 0x69, 0x45, 0xa5, 0xae,
+	0x87, 0x43, 0xe7, 0x0f, 0x12, 0x5b, 0x83, 0xa9, 0x16, 0x8b, 0x7e, 0xf2, 0x35, 0x70, 0xe1, 0x6b,
+	0xf1, 0xc2, 0x2b, 0x5f, 0x03, 0xed, 0x55, 0xd5, 0x94, 0x5c, 0x31, 0x63, 0x10, 0xc7, 0xb4, 0xc2,
+	0xfc, 0x72, 0x29, 0x05, 0xe4, 0xb3, 0xcf, 0x1e, 0x16, 0xa3, 0xd7, 0x28, 0xae, 0x93, 0xad, 0xae,
+	0x5a, 0xf4, 0x97, 0x0b, 0x86, 0xd6, 0x13, 0x01, 0x43, 0xf7, 0xb9, 0xe3, 0x98, 0x89, 0x33, 0xcd,
+	0xde, 0xa6, 0xda, 0xeb, 0x21, 0xe9, 0xa3, 0x78, 0x97, 0x0e, 0x5c, 0x3c, 0x1e, 0x10, 0xdb, 0xec,
+	0x8c, 0x0c, 0x9a, 0xdb, 0x50, 0x40, 0x1e, 0xe8, 0x87, 0x0b, 0x86, 0xb6, 0xe2, 0xc2, 0x81, 0x41,
+	0x03, 0x86, 0x1e, 0x73, 0xf5, 0x9b, 0x64, 0xb4, 0x40, 0x89, 0x93, 0xd8, 0x2b, 0x97, 0xc3, 0x75,
+	0x3b, 0xff, 0xf4, 0xfc, 0x99, 0x6a, 0xa9, 0xbd, 0x72, 0xb9, 0x7d, 0x4b, 0xf2, 0xe9, 0x17, 0x20,
+	0xde, 0x4b, 0xb8, 0x97, 0x54, 0x13, 0x61, 0xb5, 0xf5, 0xe6, 0xa0, 0x59, 0x7f, 0xdb, 0x68, 0xed,
+	0x77, 0xaa, 0xad, 0xfd, 0x5a, 0x63, 0x89, 0x38, 0x59, 0xaf, 0x6d, 0x0b, 0xb2, 0x72, 0x7e, 0xa9,
+	0xec, 0x26, 0x0c, 0x2f, 0x29, 0x6c, 0x4a, 0xaf, 0x44, 0x39, 0x51, 0x45, 0xaf, 0x37, 0x5b, 0x47,
+	0xdb, 0x40, 0xde, 0x3d, 0xbf, 0x54, 0x72, 0x49, 0xb1, 0x62, 0x9b, 0x9c, 0xc8, 0x1b, 0xdf, 0xbe,
+	0x42, 0x41, 0x7f, 0x3d, 0xb9, 0x86, 0x82, 0x7f, 0x0d, 0x85, 0xc9, 0x0c, 0x02, 0x7f, 0x06, 0xc1,
+	0xe7, 0x39, 0x14, 0xae, 0xe6, 0x10, 0xf8, 0x73, 0x28, 0xfc, 0x9c, 0x43, 0xe1, 0xdd, 0x93, 0xff,
+	0xf8, 0x74, 0xd1, 0x13, 0x76, 0x37, 0xf9, 0xe7, 0x7b, 0xfe, 0x77, 0x00, 0xfe, 0x87, 0xe8, 0x27,
+	0x85, 0x03, 0x00, 0x00,
+}
+
+func (m *CompletionWebhook) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CompletionWebhook) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CompletionWebhook) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ThresholdPct != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.ThresholdPct))))
+		i--
+		dAtA[i] = 0x21
+	}
+	if m.Condition != 0 {
+		i = encodeVarintCompletionwebhook(dAtA, i, uint64(m.Condition))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.DeviceID.ProtoSize()
+		i -= size
+		if _, err := m.DeviceID.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintCompletionwebhook(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.URL) > 0 {
+		i -= len(m.URL)
+		copy(dAtA[i:], m.URL)
+		i = encodeVarintCompletionwebhook(dAtA, i, uint64(len(m.URL)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintCompletionwebhook(dAtA []byte, offset int, v uint64) int {
+	offset -= sovCompletionwebhook(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *CompletionWebhook) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.URL)
+	if l > 0 {
+		n += 1 + l + sovCompletionwebhook(uint64(l))
+	}
+	l = m.DeviceID.ProtoSize()
+	n += 1 + l + sovCompletionwebhook(uint64(l))
+	if m.Condition != 0 {
+		n += 1 + sovCompletionwebhook(uint64(m.Condition))
+	}
+	if m.ThresholdPct != 0 {
+		n += 9
+	}
+	return n
+}
+
+func sovCompletionwebhook(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozCompletionwebhook(x uint64) (n int) {
+	return sovCompletionwebhook(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *CompletionWebhook) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCompletionwebhook
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CompletionWebhook: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CompletionWebhook: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCompletionwebhook
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCompletionwebhook
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCompletionwebhook
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.URL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeviceID", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCompletionwebhook
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCompletionwebhook
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCompletionwebhook
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DeviceID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Condition", wireType)
+			}
+			m.Condition = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCompletionwebhook
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Condition |= CompletionCondition(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ThresholdPct", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.ThresholdPct = float64(math.Float64frombits(v))
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCompletionwebhook(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCompletionwebhook
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipCompletionwebhook(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowCompletionwebhook
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowCompletionwebhook
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowCompletionwebhook
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthCompletionwebhook
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupCompletionwebhook
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthCompletionwebhook
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthCompletionwebhook        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowCompletionwebhook          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupCompletionwebhook = fmt.Errorf("proto: unexpected end of group")
+)
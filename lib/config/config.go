@@ -40,6 +40,10 @@ var (
 	DefaultTCPPort = 22000
 	// DefaultQUICPort defines default QUIC port used if the URI does not specify one, for example quic://0.0.0.0
 	DefaultQUICPort = 22000
+	// DefaultWSSPort defines default port used for WebSocket connections if the URI does not specify one, for example wss://example.com
+	DefaultWSSPort = 443
+	// DefaultObfsPort defines default port used for obfuscated connections if the URI does not specify one, for example obfs://0.0.0.0
+	DefaultObfsPort = 22001
 	// DefaultListenAddresses should be substituted when the configuration
 	// contains <listenAddress>default</listenAddress>. This is done by the
 	// "consumer" of the configuration as we don't want these saved to the
@@ -341,6 +345,7 @@ func (cfg *Configuration) prepareFolders(myID protocol.DeviceID, existingDevices
 			return nil, fmt.Errorf("folder %q: %w", folder.ID, errFolderIDDuplicate)
 		}
 
+		folder.inherit(cfg.Defaults.Folder)
 		folder.prepare(myID, existingDevices)
 
 		existingFolders[folder.ID] = folder
@@ -20,6 +20,7 @@ import (
 	"sort"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/d4l3k/messagediff"
 	"golang.org/x/crypto/bcrypt"
@@ -80,6 +81,9 @@ func TestDefaultValues(t *testing.T) {
 			URInitialDelayS:           1800,
 			URPostInsecurely:          false,
 			ReleasesURL:               "https://upgrades.syncthing.net/meta.json",
+			LocalAnnInterfaces:        []string{},
+			LocalAnnMDNSEnabled:       true,
+			RawLocalAnnAddresses:      []string{"default"},
 			AlwaysLocalNets:           []string{},
 			OverwriteRemoteDevNames:   false,
 			TempIndexMinBlocks:        10,
@@ -97,6 +101,12 @@ func TestDefaultValues(t *testing.T) {
 			ConnectionPriorityTCPWAN:  30,
 			ConnectionPriorityQUICWAN: 40,
 			ConnectionPriorityRelay:   50,
+			ConnectionPriorityWSS:     45,
+			QuotaResetDay:             1,
+			BandwidthSchedule:         []BandwidthScheduleEntry{},
+			EventWebhooks:             []EventWebhook{},
+			MQTTTopicPrefix:           "syncthing",
+			MQTTEvents:                []string{},
 		},
 		Defaults: Defaults{
 			Folder: FolderConfiguration{
@@ -118,17 +128,28 @@ func TestDefaultValues(t *testing.T) {
 				WeakHashThresholdPct: 25,
 				MarkerName:           ".stfolder",
 				MaxConcurrentWrites:  2,
+				SmallFileMaxKiB:      1024,
 				XattrFilter: XattrFilter{
 					Entries:            []XattrFilterEntry{},
 					MaxSingleEntrySize: 1024,
 					MaxTotalSize:       4096,
 				},
+				OwnershipMapping: OwnershipMapping{
+					Users:  []OwnershipMappingEntry{},
+					Groups: []OwnershipMappingEntry{},
+				},
+				CompletionWebhooks:           []CompletionWebhook{},
+				ContinuousProtectionPatterns: []string{},
+				InheritedFields:              []string{},
+				ContinuousProtectionQuotaMiB: 1024,
 			},
 			Device: DeviceConfiguration{
-				Addresses:       []string{"dynamic"},
-				AllowedNetworks: []string{},
-				Compression:     protocol.CompressionMetadata,
-				IgnoredFolders:  []ObservedFolder{},
+				Addresses:           []string{"dynamic"},
+				AllowedNetworks:     []string{},
+				RawDiscoveryServers: []string{},
+				Compression:         protocol.CompressionMetadata,
+				IgnoredFolders:      []ObservedFolder{},
+				Introductions:       []Introduction{},
 			},
 			Ignores: Ignores{
 				Lines: []string{},
@@ -194,28 +215,40 @@ func TestDeviceConfig(t *testing.T) {
 				MarkerName:           DefaultMarkerName,
 				JunctionsAsDirs:      true,
 				MaxConcurrentWrites:  maxConcurrentWritesDefault,
+				SmallFileMaxKiB:      smallFileMaxKiBDefault,
 				XattrFilter: XattrFilter{
 					Entries: []XattrFilterEntry{},
 				},
+				OwnershipMapping: OwnershipMapping{
+					Users:  []OwnershipMappingEntry{},
+					Groups: []OwnershipMappingEntry{},
+				},
+				CompletionWebhooks:           []CompletionWebhook{},
+				ContinuousProtectionPatterns: []string{},
+				InheritedFields:              []string{},
 			},
 		}
 
 		expectedDevices := []DeviceConfiguration{
 			{
-				DeviceID:        device1,
-				Name:            "node one",
-				Addresses:       []string{"tcp://a"},
-				Compression:     protocol.CompressionMetadata,
-				AllowedNetworks: []string{},
-				IgnoredFolders:  []ObservedFolder{},
+				DeviceID:            device1,
+				Name:                "node one",
+				Addresses:           []string{"tcp://a"},
+				Compression:         protocol.CompressionMetadata,
+				AllowedNetworks:     []string{},
+				RawDiscoveryServers: []string{},
+				IgnoredFolders:      []ObservedFolder{},
+				Introductions:       []Introduction{},
 			},
 			{
-				DeviceID:        device4,
-				Name:            "node two",
-				Addresses:       []string{"tcp://b"},
-				Compression:     protocol.CompressionMetadata,
-				AllowedNetworks: []string{},
-				IgnoredFolders:  []ObservedFolder{},
+				DeviceID:            device4,
+				Name:                "node two",
+				Addresses:           []string{"tcp://b"},
+				Compression:         protocol.CompressionMetadata,
+				AllowedNetworks:     []string{},
+				RawDiscoveryServers: []string{},
+				IgnoredFolders:      []ObservedFolder{},
+				Introductions:       []Introduction{},
 			},
 		}
 		expectedDeviceIDs := []protocol.DeviceID{device1, device4}
@@ -295,6 +328,24 @@ func TestOverriddenValues(t *testing.T) {
 		ConnectionPriorityTCPWAN:  50,
 		ConnectionPriorityQUICWAN: 55,
 		ConnectionPriorityRelay:   9000,
+		ConnectionPriorityWSS:     8000,
+		QuotaResetDay:             15,
+		RelayMonthlyBudgetMiB:     2048,
+		LocalAnnInterfaces:        []string{"eth0", "!docker*"},
+		LocalAnnMDNSEnabled:       false,
+		RawLocalAnnAddresses:      []string{"default", "[ff15::8384]:21028", "239.255.83.84:21027"},
+		BandwidthSchedule: []BandwidthScheduleEntry{
+			{Schedule: "0 8 * * 1-5", MaxSendKbps: 100, MaxRecvKbps: 200},
+		},
+		EventWebhooks: []EventWebhook{
+			{URL: "https://example.com/hook", Events: []string{"ItemFinished", "FolderCompletion"}, Secret: "s3cret"},
+		},
+		MQTTBrokerURL:   "tls://mqtt.example.com",
+		MQTTTopicPrefix: "home/syncthing",
+		MQTTUsername:    "user",
+		MQTTPassword:    "pass",
+		MQTTEvents:      []string{"FolderSummary", "StateChanged"},
+		ProxyURL:        "socks5://localhost:1080",
 	}
 	expectedPath := "/media/syncthing"
 
@@ -323,30 +374,38 @@ func TestDeviceAddressesDynamic(t *testing.T) {
 	name, _ := os.Hostname()
 	expected := map[protocol.DeviceID]DeviceConfiguration{
 		device1: {
-			DeviceID:        device1,
-			Addresses:       []string{"dynamic"},
-			AllowedNetworks: []string{},
-			IgnoredFolders:  []ObservedFolder{},
+			DeviceID:            device1,
+			Addresses:           []string{"dynamic"},
+			AllowedNetworks:     []string{},
+			RawDiscoveryServers: []string{},
+			IgnoredFolders:      []ObservedFolder{},
+			Introductions:       []Introduction{},
 		},
 		device2: {
-			DeviceID:        device2,
-			Addresses:       []string{"dynamic"},
-			AllowedNetworks: []string{},
-			IgnoredFolders:  []ObservedFolder{},
+			DeviceID:            device2,
+			Addresses:           []string{"dynamic"},
+			AllowedNetworks:     []string{},
+			RawDiscoveryServers: []string{},
+			IgnoredFolders:      []ObservedFolder{},
+			Introductions:       []Introduction{},
 		},
 		device3: {
-			DeviceID:        device3,
-			Addresses:       []string{"dynamic"},
-			AllowedNetworks: []string{},
-			IgnoredFolders:  []ObservedFolder{},
+			DeviceID:            device3,
+			Addresses:           []string{"dynamic"},
+			AllowedNetworks:     []string{},
+			RawDiscoveryServers: []string{},
+			IgnoredFolders:      []ObservedFolder{},
+			Introductions:       []Introduction{},
 		},
 		device4: {
-			DeviceID:        device4,
-			Name:            name, // Set when auto created
-			Addresses:       []string{"dynamic"},
-			Compression:     protocol.CompressionMetadata,
-			AllowedNetworks: []string{},
-			IgnoredFolders:  []ObservedFolder{},
+			DeviceID:            device4,
+			Name:                name, // Set when auto created
+			Addresses:           []string{"dynamic"},
+			Compression:         protocol.CompressionMetadata,
+			AllowedNetworks:     []string{},
+			RawDiscoveryServers: []string{},
+			IgnoredFolders:      []ObservedFolder{},
+			Introductions:       []Introduction{},
 		},
 	}
 
@@ -366,33 +425,41 @@ func TestDeviceCompression(t *testing.T) {
 	name, _ := os.Hostname()
 	expected := map[protocol.DeviceID]DeviceConfiguration{
 		device1: {
-			DeviceID:        device1,
-			Addresses:       []string{"dynamic"},
-			Compression:     protocol.CompressionMetadata,
-			AllowedNetworks: []string{},
-			IgnoredFolders:  []ObservedFolder{},
+			DeviceID:            device1,
+			Addresses:           []string{"dynamic"},
+			Compression:         protocol.CompressionMetadata,
+			AllowedNetworks:     []string{},
+			RawDiscoveryServers: []string{},
+			IgnoredFolders:      []ObservedFolder{},
+			Introductions:       []Introduction{},
 		},
 		device2: {
-			DeviceID:        device2,
-			Addresses:       []string{"dynamic"},
-			Compression:     protocol.CompressionMetadata,
-			AllowedNetworks: []string{},
-			IgnoredFolders:  []ObservedFolder{},
+			DeviceID:            device2,
+			Addresses:           []string{"dynamic"},
+			Compression:         protocol.CompressionMetadata,
+			AllowedNetworks:     []string{},
+			RawDiscoveryServers: []string{},
+			IgnoredFolders:      []ObservedFolder{},
+			Introductions:       []Introduction{},
 		},
 		device3: {
-			DeviceID:        device3,
-			Addresses:       []string{"dynamic"},
-			Compression:     protocol.CompressionNever,
-			AllowedNetworks: []string{},
-			IgnoredFolders:  []ObservedFolder{},
+			DeviceID:            device3,
+			Addresses:           []string{"dynamic"},
+			Compression:         protocol.CompressionNever,
+			AllowedNetworks:     []string{},
+			RawDiscoveryServers: []string{},
+			IgnoredFolders:      []ObservedFolder{},
+			Introductions:       []Introduction{},
 		},
 		device4: {
-			DeviceID:        device4,
-			Name:            name, // Set when auto created
-			Addresses:       []string{"dynamic"},
-			Compression:     protocol.CompressionMetadata,
-			AllowedNetworks: []string{},
-			IgnoredFolders:  []ObservedFolder{},
+			DeviceID:            device4,
+			Name:                name, // Set when auto created
+			Addresses:           []string{"dynamic"},
+			Compression:         protocol.CompressionMetadata,
+			AllowedNetworks:     []string{},
+			RawDiscoveryServers: []string{},
+			IgnoredFolders:      []ObservedFolder{},
+			Introductions:       []Introduction{},
 		},
 	}
 
@@ -412,30 +479,38 @@ func TestDeviceAddressesStatic(t *testing.T) {
 	name, _ := os.Hostname()
 	expected := map[protocol.DeviceID]DeviceConfiguration{
 		device1: {
-			DeviceID:        device1,
-			Addresses:       []string{"tcp://192.0.2.1", "tcp://192.0.2.2"},
-			AllowedNetworks: []string{},
-			IgnoredFolders:  []ObservedFolder{},
+			DeviceID:            device1,
+			Addresses:           []string{"tcp://192.0.2.1", "tcp://192.0.2.2"},
+			AllowedNetworks:     []string{},
+			RawDiscoveryServers: []string{},
+			IgnoredFolders:      []ObservedFolder{},
+			Introductions:       []Introduction{},
 		},
 		device2: {
-			DeviceID:        device2,
-			Addresses:       []string{"tcp://192.0.2.3:6070", "tcp://[2001:db8::42]:4242"},
-			AllowedNetworks: []string{},
-			IgnoredFolders:  []ObservedFolder{},
+			DeviceID:            device2,
+			Addresses:           []string{"tcp://192.0.2.3:6070", "tcp://[2001:db8::42]:4242"},
+			AllowedNetworks:     []string{},
+			RawDiscoveryServers: []string{},
+			IgnoredFolders:      []ObservedFolder{},
+			Introductions:       []Introduction{},
 		},
 		device3: {
-			DeviceID:        device3,
-			Addresses:       []string{"tcp://[2001:db8::44]:4444", "tcp://192.0.2.4:6090"},
-			AllowedNetworks: []string{},
-			IgnoredFolders:  []ObservedFolder{},
+			DeviceID:            device3,
+			Addresses:           []string{"tcp://[2001:db8::44]:4444", "tcp://192.0.2.4:6090"},
+			AllowedNetworks:     []string{},
+			RawDiscoveryServers: []string{},
+			IgnoredFolders:      []ObservedFolder{},
+			Introductions:       []Introduction{},
 		},
 		device4: {
-			DeviceID:        device4,
-			Name:            name, // Set when auto created
-			Addresses:       []string{"dynamic"},
-			Compression:     protocol.CompressionMetadata,
-			AllowedNetworks: []string{},
-			IgnoredFolders:  []ObservedFolder{},
+			DeviceID:            device4,
+			Name:                name, // Set when auto created
+			Addresses:           []string{"dynamic"},
+			Compression:         protocol.CompressionMetadata,
+			AllowedNetworks:     []string{},
+			RawDiscoveryServers: []string{},
+			IgnoredFolders:      []ObservedFolder{},
+			Introductions:       []Introduction{},
 		},
 	}
 
@@ -808,6 +883,120 @@ func TestGUIPasswordHash(t *testing.T) {
 	}
 }
 
+func TestGUIUsers(t *testing.T) {
+	const cfgXML = `<configuration version="37">
+    <gui enabled="true" tls="false">
+        <users>
+            <user name="alice" role="admin"><password>alicepass</password></user>
+            <user name="bob" role="folderOperator"><password>bobpass</password><folder>default</folder></user>
+            <user name="carol"><password>carolpass</password></user>
+            <user name="alice" role="readOnly"></user>
+            <user role="admin"></user>
+        </users>
+    </gui>
+</configuration>`
+
+	cfg, _, err := ReadXML(strings.NewReader(cfgXML), device1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	gui := cfg.GUI
+
+	// The duplicate and the nameless user are dropped.
+	if len(gui.Users) != 3 {
+		t.Fatalf("Expected three users, got %d", len(gui.Users))
+	}
+	if !gui.IsAuthEnabled() {
+		t.Error("Auth should be enabled by the users")
+	}
+
+	expected := map[string]UserRole{"alice": UserRoleAdmin, "bob": UserRoleFolderOperator, "carol": UserRoleReadOnly}
+	for name, role := range expected {
+		u, ok := gui.UserByName(name)
+		if !ok {
+			t.Fatalf("User %s is missing", name)
+		}
+		if u.Role != role {
+			t.Errorf("User %s has role %v, expected %v", name, u.Role, role)
+		}
+	}
+
+	bob, _ := gui.UserByName("bob")
+	if !bob.CanOperate("default") || bob.CanOperate("other") || bob.CanOperate("") {
+		t.Error("Folder operator should only operate on its folders")
+	}
+	carol, _ := gui.UserByName("carol")
+	if carol.CanOperate("default") {
+		t.Error("Read only user should not operate on folders")
+	}
+
+	if err := gui.HashUserPasswords(); err != nil {
+		t.Fatal(err)
+	}
+	if err := carol.CompareHashedPassword("carolpass"); err == nil {
+		t.Error("Password should not have been hashed in the copy")
+	}
+	carol, _ = gui.UserByName("carol")
+	if err := carol.CompareHashedPassword("carolpass"); err != nil {
+		t.Errorf("No match on hashed password: %v", err)
+	}
+
+	copied := gui.Copy()
+	copied.Users[1].Folders[0] = "changed"
+	if bob, _ := gui.UserByName("bob"); bob.Folders[0] != "default" {
+		t.Error("Copy should not share the folder lists")
+	}
+}
+
+func TestScopedAPIKeys(t *testing.T) {
+	const cfgXML = `<configuration version="37">
+    <gui enabled="true" tls="false">
+        <apiKeys>
+            <apiKey key="reader" label="Status script" expires="0001-01-01T00:00:00Z"></apiKey>
+            <apiKey key="writer" expires="2000-01-01T00:00:00Z"><scope>configWrite</scope><scope>dbWrite</scope></apiKey>
+            <apiKey expires="0001-01-01T00:00:00Z"></apiKey>
+        </apiKeys>
+    </gui>
+</configuration>`
+
+	cfg, _, err := ReadXML(strings.NewReader(cfgXML), device1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	gui := cfg.GUI
+
+	if len(gui.APIKeys) != 3 {
+		t.Fatalf("Expected three API keys, got %d", len(gui.APIKeys))
+	}
+	if gui.APIKeys[2].Key == "" {
+		t.Error("Missing key should have been generated")
+	}
+
+	reader, ok := gui.ValidScopedAPIKey("reader")
+	if !ok {
+		t.Fatal("Reader key should be valid")
+	}
+	if reader.Label != "Status script" || len(reader.Scopes) != 0 {
+		t.Errorf("Unexpected reader key %+v", reader)
+	}
+
+	// The writer key has expired.
+	if _, ok := gui.ValidScopedAPIKey("writer"); ok {
+		t.Error("Expired key should not be valid")
+	}
+	writer := gui.APIKeys[1]
+	if !writer.HasScope(APIKeyScopeConfigWrite) || !writer.HasScope(APIKeyScopeDBWrite) {
+		t.Errorf("Unexpected writer scopes %v", writer.Scopes)
+	}
+
+	if _, ok := gui.ValidScopedAPIKey(""); ok {
+		t.Error("Empty key should not be valid")
+	}
+	if _, ok := gui.ValidScopedAPIKey("unknown"); ok {
+		t.Error("Unknown key should not be valid")
+	}
+}
+
 func TestDuplicateDevices(t *testing.T) {
 	// Duplicate devices should be removed
 
@@ -1466,6 +1655,82 @@ func TestReceiveEncryptedFolderFixed(t *testing.T) {
 	}
 }
 
+func TestFolderPausedReason(t *testing.T) {
+	cfg := Configuration{
+		Folders: []FolderConfiguration{
+			{ID: "user", Path: "testdata", Paused: true},
+			{ID: "disk", Path: "testdata", Paused: true, PausedReason: FolderPauseReasonLowDisk},
+			{ID: "resumed", Path: "testdata", PausedReason: FolderPauseReasonErrors},
+		},
+	}
+
+	cfg.prepare(device1)
+
+	expected := map[string]FolderPauseReason{
+		"user":    FolderPauseReasonUser,
+		"disk":    FolderPauseReasonLowDisk,
+		"resumed": FolderPauseReasonNone,
+	}
+	for _, f := range cfg.Folders {
+		if f.PausedReason != expected[f.ID] {
+			t.Errorf("%s: expected reason %v, got %v", f.ID, expected[f.ID], f.PausedReason)
+		}
+	}
+}
+
+func TestFolderInheritsDefaults(t *testing.T) {
+	cfg := New(device1)
+	cfg.Defaults.Folder.RescanIntervalS = 600
+	cfg.Folders = []FolderConfiguration{
+		{ID: "inheriting", Path: "testdata", RescanIntervalS: 60, FSWatcherEnabled: true, FSWatcherDelayS: 10, InheritedFields: []string{"rescanIntervalS", "versioning", "rescanIntervalS", "id", "banana"}},
+		{ID: "own", Path: "testdata", RescanIntervalS: 60},
+	}
+	cfg.prepare(device1)
+
+	w := wrap("/tmp/cfg", cfg, device1)
+	defer w.stop()
+
+	inheriting, _ := w.Folder("inheriting")
+	if inheriting.RescanIntervalS != 600 {
+		t.Errorf("inherited rescan interval is %d, expected 600", inheriting.RescanIntervalS)
+	}
+	if exp := []string{"rescanIntervalS", "versioning"}; !reflect.DeepEqual(inheriting.InheritedFields, exp) {
+		t.Errorf("inherited fields are %v, expected %v", inheriting.InheritedFields, exp)
+	}
+
+	// Changes to the defaults propagate to the inherited fields only.
+	waiter, err := w.Modify(func(cfg *Configuration) {
+		cfg.Defaults.Folder.RescanIntervalS = 1800
+		cfg.Defaults.Folder.Versioning = VersioningConfiguration{Type: "trashcan", Params: map[string]string{"cleanoutDays": "7"}}
+		cfg.Defaults.Folder.FSWatcherEnabled = false
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	waiter.Wait()
+
+	inheriting, _ = w.Folder("inheriting")
+	if inheriting.RescanIntervalS != 1800 {
+		t.Errorf("inherited rescan interval is %d, expected 1800", inheriting.RescanIntervalS)
+	}
+	if inheriting.Versioning.Type != "trashcan" || inheriting.Versioning.Params["cleanoutDays"] != "7" {
+		t.Errorf("inherited versioning is %v", inheriting.Versioning)
+	}
+	if !inheriting.FSWatcherEnabled {
+		t.Error("watcher disabled, but it isn't inherited")
+	}
+	own, _ := w.Folder("own")
+	if own.RescanIntervalS != 60 {
+		t.Errorf("rescan interval of a folder without inheritance is %d, expected 60", own.RescanIntervalS)
+	}
+
+	// The versioning parameters are copied, not shared with the defaults.
+	inheriting.Versioning.Params["cleanoutDays"] = "30"
+	if w.DefaultFolder().Versioning.Params["cleanoutDays"] != "7" {
+		t.Error("versioning parameters shared with the defaults")
+	}
+}
+
 func TestXattrFilter(t *testing.T) {
 	cases := []struct {
 		in     []string
@@ -1506,6 +1771,31 @@ func TestXattrFilter(t *testing.T) {
 	}
 }
 
+func TestPermissionsProfile(t *testing.T) {
+	cases := []struct {
+		profile PermissionsProfile
+		perms   uint32
+		isDir   bool
+		out     uint32
+	}{
+		{PermissionsProfile{}, 0o600, false, 0o600},
+		{PermissionsProfile{}, 0o700, true, 0o700},
+		{PermissionsProfile{FileMode: "0644"}, 0o600, false, 0o644},
+		{PermissionsProfile{FileMode: "0644"}, 0o700, false, 0o755},
+		{PermissionsProfile{FileMode: "0640"}, 0o711, false, 0o750},
+		{PermissionsProfile{FileMode: "0755"}, 0o666, false, 0o644},
+		{PermissionsProfile{FileMode: "0644"}, 0o700, true, 0o700},
+		{PermissionsProfile{DirMode: "0750"}, 0o777, true, 0o750},
+		{PermissionsProfile{FileMode: "rw-r--r--"}, 0o600, false, 0o600},
+	}
+
+	for _, tc := range cases {
+		if out := tc.profile.ToDisk(tc.perms, tc.isDir); out != tc.out {
+			t.Errorf("%+v.ToDisk(%o, %v) == %o, expected %o", tc.profile, tc.perms, tc.isDir, out, tc.out)
+		}
+	}
+}
+
 func TestUntrustedIntroducer(t *testing.T) {
 	fd, err := os.Open("testdata/untrustedintroducer.xml")
 	if err != nil {
@@ -1565,6 +1855,32 @@ func TestUntrustedIntroducer(t *testing.T) {
 	}
 }
 
+func TestDeviceIntroductions(t *testing.T) {
+	// A device introduced before introductions were recorded.
+	cfg := DeviceConfiguration{DeviceID: device4, IntroducedBy: device2}
+	cfg.prepare(nil)
+	if len(cfg.Introductions) != 1 || cfg.Introductions[0].Introducer != device2 {
+		t.Fatalf("expected the introducer to be migrated, got %v", cfg.Introductions)
+	}
+
+	if cfg.AddIntroduction(device2, time.Now()) {
+		t.Error("expected no change when the introducer already vouches for the device")
+	}
+	if !cfg.AddIntroduction(device3, time.Now()) || !cfg.VouchedForBy(device3) || cfg.IntroducedBy != device2 {
+		t.Errorf("expected both introducers to vouch for the device, got %v", cfg.Introductions)
+	}
+
+	if !cfg.RemoveIntroduction(device2) || cfg.VouchedForBy(device2) || cfg.IntroducedBy != device3 {
+		t.Errorf("expected only device 3 to vouch for the device, got %v", cfg.Introductions)
+	}
+	if cfg.RemoveIntroduction(device2) {
+		t.Error("expected no change when the introducer doesn't vouch for the device")
+	}
+	if !cfg.RemoveIntroduction(device3) || len(cfg.Introducers()) != 0 || cfg.IntroducedBy != protocol.EmptyDeviceID {
+		t.Errorf("expected the device not to be introduced anymore, got %v", cfg.Introductions)
+	}
+}
+
 // Verify that opening a config with myID == protocol.EmptyDeviceID doesn't add that ID to the config.
 // Done in various places where config is needed, but the device ID isn't known.
 func TestLoadEmptyDeviceID(t *testing.T) {
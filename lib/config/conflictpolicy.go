@@ -0,0 +1,46 @@
+// Copyright (C) 2026 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package config
+
+func (p ConflictPolicy) String() string {
+	switch p {
+	case ConflictPolicyManual:
+		return "manual"
+	case ConflictPolicyKeepNewest:
+		return "keepNewest"
+	case ConflictPolicyKeepLargest:
+		return "keepLargest"
+	case ConflictPolicyKeepLocal:
+		return "keepLocal"
+	case ConflictPolicyKeepRemote:
+		return "keepRemote"
+	default:
+		return "unknown"
+	}
+}
+
+func (p ConflictPolicy) MarshalText() ([]byte, error) {
+	return []byte(p.String()), nil
+}
+
+func (p *ConflictPolicy) UnmarshalText(bs []byte) error {
+	switch string(bs) {
+	case "manual":
+		*p = ConflictPolicyManual
+	case "keepNewest":
+		*p = ConflictPolicyKeepNewest
+	case "keepLargest":
+		*p = ConflictPolicyKeepLargest
+	case "keepLocal":
+		*p = ConflictPolicyKeepLocal
+	case "keepRemote":
+		*p = ConflictPolicyKeepRemote
+	default:
+		*p = ConflictPolicyManual
+	}
+	return nil
+}
@@ -0,0 +1,83 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: lib/config/conflictpolicy.proto
+
+package config
+
+import (
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type ConflictPolicy int32
+
+const (
+	ConflictPolicyManual      ConflictPolicy = 0
+	ConflictPolicyKeepNewest  ConflictPolicy = 1
+	ConflictPolicyKeepLargest ConflictPolicy = 2
+	ConflictPolicyKeepLocal   ConflictPolicy = 3
+	ConflictPolicyKeepRemote  ConflictPolicy = 4
+)
+
+var ConflictPolicy_name = map[int32]string{
+	0: "CONFLICT_POLICY_MANUAL",
+	1: "CONFLICT_POLICY_KEEP_NEWEST",
+	2: "CONFLICT_POLICY_KEEP_LARGEST",
+	3: "CONFLICT_POLICY_KEEP_LOCAL",
+	4: "CONFLICT_POLICY_KEEP_REMOTE",
+}
+
+var ConflictPolicy_value = map[string]int32{
+	"CONFLICT_POLICY_MANUAL":       0,
+	"CONFLICT_POLICY_KEEP_NEWEST":  1,
+	"CONFLICT_POLICY_KEEP_LARGEST": 2,
+	"CONFLICT_POLICY_KEEP_LOCAL":   3,
+	"CONFLICT_POLICY_KEEP_REMOTE":  4,
+}
+
+func (ConflictPolicy) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_45993ab162f648a9, []int{0}
+}
+
+func init() {
+	proto.RegisterEnum("config.ConflictPolicy", ConflictPolicy_name, ConflictPolicy_value)
+}
+
+func init() { proto.RegisterFile("lib/config/conflictpolicy.proto", fileDescriptor_45993ab162f648a9) }
+
+var fileDescriptor_45993ab162f648a9 = []byte{
+	// 321 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x91, 0xb1, 0x4a, 0xc3, 0x40,
+	0x18, 0xc7, 0x2f, 0xb5, 0x74, 0xc8, 0x20, 0x21, 0x88, 0xd6, 0x6b, 0x3d, 0x03, 0x4e, 0x3a, 0x34,
+	0x83, 0x6e, 0x22, 0x12, 0x43, 0x94, 0xd2, 0x34, 0x29, 0xb5, 0x22, 0xba, 0x94, 0xe6, 0xb8, 0x5e,
+	0x0f, 0xd2, 0x5c, 0x68, 0xaf, 0x48, 0x5f, 0x21, 0x93, 0x2f, 0x10, 0x70, 0x70, 0xf0, 0x51, 0x3a,
+	0x76, 0x74, 0x6d, 0xf3, 0x22, 0xd2, 0xab, 0xa0, 0xd5, 0xe8, 0x74, 0xdf, 0xdd, 0x7d, 0xbf, 0x1f,
+	0x7f, 0xf8, 0xab, 0x87, 0x21, 0x0b, 0x4c, 0xcc, 0xa3, 0x3e, 0xa3, 0xf2, 0x08, 0x19, 0x16, 0x31,
+	0x0f, 0x19, 0x9e, 0xd6, 0xe2, 0x11, 0x17, 0x5c, 0x2f, 0xad, 0x3f, 0xe1, 0xd1, 0x88, 0xc4, 0x7c,
+	0x6c, 0xca, 0xc7, 0x60, 0xd2, 0x37, 0x29, 0xa7, 0x5c, 0x5e, 0xe4, 0xb4, 0x5e, 0x3e, 0x99, 0x15,
+	0xd4, 0x6d, 0xfb, 0xd3, 0xd2, 0x92, 0x16, 0xfd, 0x4c, 0xdd, 0xb5, 0x7d, 0xef, 0xda, 0xad, 0xdb,
+	0x9d, 0x6e, 0xcb, 0x77, 0xeb, 0xf6, 0x43, 0xb7, 0x69, 0x79, 0x77, 0x96, 0xab, 0x01, 0x58, 0x4e,
+	0x52, 0x63, 0x67, 0x73, 0xbf, 0xd9, 0x8b, 0x26, 0xbd, 0x50, 0xbf, 0x50, 0x2b, 0x3f, 0xa9, 0x86,
+	0xe3, 0xb4, 0xba, 0x9e, 0x73, 0xef, 0xdc, 0x76, 0x34, 0x05, 0x56, 0x93, 0xd4, 0x28, 0x6f, 0xa2,
+	0x0d, 0x42, 0x62, 0x8f, 0x3c, 0x91, 0xb1, 0xd0, 0x2f, 0xd5, 0x6a, 0x2e, 0xee, 0x5a, 0xed, 0x9b,
+	0x15, 0x5f, 0x80, 0x07, 0x49, 0x6a, 0xec, 0xff, 0xe6, 0xdd, 0xde, 0x88, 0xae, 0x04, 0xe7, 0x2a,
+	0xcc, 0x17, 0xf8, 0xb6, 0xe5, 0x6a, 0x5b, 0xb0, 0x92, 0xa4, 0xc6, 0x5e, 0x0e, 0xce, 0xf1, 0x3f,
+	0xe1, 0xdb, 0x4e, 0xd3, 0xef, 0x38, 0x5a, 0xf1, 0xaf, 0xf0, 0x6d, 0x32, 0xe4, 0x82, 0xc0, 0xe2,
+	0xdb, 0x2b, 0x02, 0x57, 0x8d, 0xd9, 0x02, 0x81, 0xf9, 0x02, 0x81, 0xd9, 0x12, 0x29, 0xf3, 0x25,
+	0x52, 0x9e, 0x33, 0x04, 0x5e, 0x32, 0xa4, 0xcc, 0x33, 0x04, 0xde, 0x33, 0x04, 0x1e, 0x8f, 0x29,
+	0x13, 0x83, 0x49, 0x50, 0xc3, 0x7c, 0x68, 0x8e, 0xa7, 0x11, 0x16, 0x03, 0x16, 0xd1, 0x6f, 0xd3,
+	0x57, 0xb3, 0x41, 0x49, 0xd6, 0x73, 0xfa, 0x31, 0x00, 0x73, 0xab, 0x32, 0xf1, 0xee, 0x01, 0x00,
+	0x00,
+}
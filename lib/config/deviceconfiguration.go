@@ -9,6 +9,9 @@ package config
 import (
 	"fmt"
 	"sort"
+	"time"
+
+	"github.com/syncthing/syncthing/lib/protocol"
 )
 
 const defaultNumConnections = 1 // number of connections to use by default; may change in the future.
@@ -19,8 +22,12 @@ func (cfg DeviceConfiguration) Copy() DeviceConfiguration {
 	copy(c.Addresses, cfg.Addresses)
 	c.AllowedNetworks = make([]string, len(cfg.AllowedNetworks))
 	copy(c.AllowedNetworks, cfg.AllowedNetworks)
+	c.RawDiscoveryServers = make([]string, len(cfg.RawDiscoveryServers))
+	copy(c.RawDiscoveryServers, cfg.RawDiscoveryServers)
 	c.IgnoredFolders = make([]ObservedFolder, len(cfg.IgnoredFolders))
 	copy(c.IgnoredFolders, cfg.IgnoredFolders)
+	c.Introductions = make([]Introduction, len(cfg.Introductions))
+	copy(c.Introductions, cfg.Introductions)
 	return c
 }
 
@@ -37,6 +44,15 @@ func (cfg *DeviceConfiguration) prepare(sharedFolders []string) {
 
 	cfg.IgnoredFolders = sortedObservedFolderSlice(ignoredFolders)
 
+	// Devices introduced before introductions were recorded only have the
+	// introducer, and introducedBy is kept as the first one remaining.
+	if len(cfg.Introductions) == 0 && cfg.IntroducedBy != protocol.EmptyDeviceID {
+		cfg.Introductions = []Introduction{{Introducer: cfg.IntroducedBy}}
+	}
+	if len(cfg.Introductions) > 0 {
+		cfg.IntroducedBy = cfg.Introductions[0].Introducer
+	}
+
 	// A device cannot be simultaneously untrusted and an introducer, nor
 	// auto accept folders.
 	if cfg.Untrusted {
@@ -62,6 +78,15 @@ func (cfg *DeviceConfiguration) NumConnections() int {
 	}
 }
 
+// DiscoveryServers returns the global discovery servers to look up the
+// device with, or nil when the globally configured ones should be used.
+func (cfg *DeviceConfiguration) DiscoveryServers() []string {
+	if len(cfg.RawDiscoveryServers) == 0 {
+		return nil
+	}
+	return expandDiscoveryServers(cfg.RawDiscoveryServers)
+}
+
 func (cfg *DeviceConfiguration) IgnoredFolder(folder string) bool {
 	for _, ignoredFolder := range cfg.IgnoredFolders {
 		if ignoredFolder.ID == folder {
@@ -71,6 +96,72 @@ func (cfg *DeviceConfiguration) IgnoredFolder(folder string) bool {
 	return false
 }
 
+// Introducers returns the introducers vouching for the device, in the
+// order they introduced it.
+func (cfg *DeviceConfiguration) Introducers() []protocol.DeviceID {
+	if len(cfg.Introductions) == 0 {
+		if cfg.IntroducedBy == protocol.EmptyDeviceID {
+			return nil
+		}
+		return []protocol.DeviceID{cfg.IntroducedBy}
+	}
+	introducers := make([]protocol.DeviceID, len(cfg.Introductions))
+	for i, intro := range cfg.Introductions {
+		introducers[i] = intro.Introducer
+	}
+	return introducers
+}
+
+// VouchedForBy returns true if the device was introduced by the given
+// introducer, and it hasn't retracted the introduction since.
+func (cfg *DeviceConfiguration) VouchedForBy(introducer protocol.DeviceID) bool {
+	for _, id := range cfg.Introducers() {
+		if id == introducer {
+			return true
+		}
+	}
+	return false
+}
+
+// AddIntroduction records that the introducer vouches for the device, and
+// returns false if it already did.
+func (cfg *DeviceConfiguration) AddIntroduction(introducer protocol.DeviceID, t time.Time) bool {
+	if cfg.VouchedForBy(introducer) {
+		return false
+	}
+	if len(cfg.Introductions) == 0 && cfg.IntroducedBy != protocol.EmptyDeviceID {
+		cfg.Introductions = []Introduction{{Introducer: cfg.IntroducedBy}}
+	}
+	cfg.Introductions = append(cfg.Introductions, Introduction{Introducer: introducer, Time: t})
+	cfg.IntroducedBy = cfg.Introductions[0].Introducer
+	return true
+}
+
+// RemoveIntroduction forgets that the introducer vouches for the device, and
+// returns false if it didn't. The device is no longer considered introduced
+// once no introducer vouches for it.
+func (cfg *DeviceConfiguration) RemoveIntroduction(introducer protocol.DeviceID) bool {
+	if !cfg.VouchedForBy(introducer) {
+		return false
+	}
+	if len(cfg.Introductions) == 0 {
+		cfg.IntroducedBy = protocol.EmptyDeviceID
+		return true
+	}
+	introductions := make([]Introduction, 0, len(cfg.Introductions)-1)
+	for _, intro := range cfg.Introductions {
+		if intro.Introducer != introducer {
+			introductions = append(introductions, intro)
+		}
+	}
+	cfg.Introductions = introductions
+	cfg.IntroducedBy = protocol.EmptyDeviceID
+	if len(introductions) > 0 {
+		cfg.IntroducedBy = introductions[0].Introducer
+	}
+	return true
+}
+
 func sortedObservedFolderSlice(input map[string]ObservedFolder) []ObservedFolder {
 	output := make([]ObservedFolder, 0, len(input))
 	for _, folder := range input {
@@ -6,18 +6,22 @@ package config
 import (
 	fmt "fmt"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	github_com_syncthing_syncthing_lib_protocol "github.com/syncthing/syncthing/lib/protocol"
 	protocol "github.com/syncthing/syncthing/lib/protocol"
 	_ "github.com/syncthing/syncthing/proto/ext"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -45,6 +49,15 @@ type DeviceConfiguration struct {
 	Untrusted                bool                                                 `protobuf:"varint,17,opt,name=untrusted,proto3" json:"untrusted" xml:"untrusted"`
 	RemoteGUIPort            int                                                  `protobuf:"varint,18,opt,name=remote_gui_port,json=remoteGuiPort,proto3,casttype=int" json:"remoteGUIPort" xml:"remoteGUIPort"`
 	RawNumConnections        int                                                  `protobuf:"varint,19,opt,name=num_connections,json=numConnections,proto3,casttype=int" json:"numConnections" xml:"numConnections"`
+	ProxyURL                 string                                               `protobuf:"bytes,20,opt,name=proxy_url,json=proxyUrl,proto3" json:"proxyURL" xml:"proxyURL,omitempty"`
+	RawDiscoveryServers      []string                                             `protobuf:"bytes,21,rep,name=discovery_servers,json=discoveryServers,proto3" json:"discoveryServers" xml:"discoveryServer,omitempty"`
+	AllowManagement          bool                                                 `protobuf:"varint,22,opt,name=allow_management,json=allowManagement,proto3" json:"allowManagement" xml:"allowManagement"`
+	Introductions            []Introduction                                       `protobuf:"bytes,23,rep,name=introductions,proto3" json:"introductions" xml:"introduction"`
+	CompressionAlgorithm     protocol.CompressionAlgorithm                        `protobuf:"varint,24,opt,name=compression_algorithm,json=compressionAlgorithm,proto3,enum=protocol.CompressionAlgorithm" json:"compressionAlgorithm" xml:"compressionAlgorithm,attr"`
+	ForwardUsageReports      bool                                                 `protobuf:"varint,25,opt,name=forward_usage_reports,json=forwardUsageReports,proto3" json:"forwardUsageReports" xml:"forwardUsageReports"`
+	AcceptUsageReports       bool                                                 `protobuf:"varint,26,opt,name=accept_usage_reports,json=acceptUsageReports,proto3" json:"acceptUsageReports" xml:"acceptUsageReports"`
+	ExemptFromPruning        bool                                                 `protobuf:"varint,27,opt,name=exempt_from_pruning,json=exemptFromPruning,proto3" json:"exemptFromPruning" xml:"exemptFromPruning"`
+	Protected                bool                                                 `protobuf:"varint,28,opt,name=protected,proto3" json:"protected" xml:"protected"`
 }
 
 func (m *DeviceConfiguration) Reset()         { *m = DeviceConfiguration{} }
@@ -80,8 +93,49 @@ func (m *DeviceConfiguration) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_DeviceConfiguration proto.InternalMessageInfo
 
+// An introducer vouching for a device, and since when. A device introduced
+// by several introducers is only removed when none of them vouch for it.
+type Introduction struct {
+	Introducer github_com_syncthing_syncthing_lib_protocol.DeviceID `protobuf:"bytes,1,opt,name=introducer,proto3,customtype=github.com/syncthing/syncthing/lib/protocol.DeviceID" json:"introducer" xml:"introducer,attr" nodefault:"true"`
+	Time       time.Time                                            `protobuf:"bytes,2,opt,name=time,proto3,stdtime" json:"time" xml:"time,attr"`
+}
+
+func (m *Introduction) Reset()         { *m = Introduction{} }
+func (m *Introduction) String() string { return proto.CompactTextString(m) }
+func (*Introduction) ProtoMessage()    {}
+func (*Introduction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_744b782bd13071dd, []int{1}
+}
+func (m *Introduction) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Introduction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Introduction.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Introduction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Introduction.Merge(m, src)
+}
+func (m *Introduction) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *Introduction) XXX_DiscardUnknown() {
+	xxx_messageInfo_Introduction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Introduction proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*DeviceConfiguration)(nil), "config.DeviceConfiguration")
+	proto.RegisterType((*Introduction)(nil), "config.Introduction")
 }
 
 func init() {
@@ -89,74 +143,102 @@ func init() {
 }
 
 var fileDescriptor_744b782bd13071dd = []byte{
-	// 1057 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x55, 0x41, 0x6f, 0xe3, 0x44,
-	0x14, 0x8e, 0xe9, 0x6e, 0xb7, 0x99, 0x6d, 0x9b, 0xc6, 0x65, 0xbb, 0xde, 0x4a, 0x9b, 0x89, 0x42,
-	0x0e, 0x41, 0xec, 0xa6, 0xa8, 0x70, 0xaa, 0x00, 0x89, 0xb4, 0x82, 0xad, 0x2a, 0xba, 0x65, 0x10,
-	0x97, 0xdd, 0x83, 0x71, 0x3c, 0xd3, 0xac, 0xd5, 0x78, 0xc6, 0xd8, 0xe3, 0xb4, 0x95, 0x38, 0x72,
-	0x80, 0x1b, 0xaa, 0xc4, 0x89, 0xcb, 0xc2, 0xdf, 0xe0, 0xc0, 0xb5, 0xb7, 0xe6, 0x08, 0x1c, 0x46,
-	0xda, 0xf4, 0xe6, 0xa3, 0x8f, 0x9c, 0xd0, 0x8c, 0x1d, 0xc7, 0x76, 0x37, 0x2b, 0x24, 0x6e, 0x9e,
-	0xef, 0x7b, 0xf3, 0x7d, 0xf3, 0x9e, 0xdf, 0x9b, 0x01, 0xed, 0xa1, 0xd3, 0xdf, 0xb2, 0x19, 0x3d,
-	0x76, 0x06, 0x5b, 0x98, 0x8c, 0x1c, 0x9b, 0x24, 0x8b, 0xd0, 0xb7, 0xb8, 0xc3, 0x68, 0xd7, 0xf3,
-	0x19, 0x67, 0xfa, 0x62, 0x02, 0x6e, 0x6e, 0xc8, 0x68, 0x05, 0xd9, 0x6c, 0xb8, 0xd5, 0x27, 0x5e,
-	0xc2, 0x6f, 0x3e, 0xc8, 0xa9, 0xb0, 0x7e, 0x40, 0xfc, 0x11, 0xc1, 0x29, 0x55, 0x25, 0x67, 0x3c,
-	0xf9, 0x6c, 0xfd, 0x55, 0x07, 0xeb, 0x7b, 0xca, 0x63, 0x37, 0xef, 0xa1, 0xff, 0xa1, 0x81, 0x6a,
-	0xe2, 0x6d, 0x3a, 0xd8, 0xd0, 0x9a, 0x5a, 0x67, 0xb9, 0xf7, 0xab, 0x76, 0x29, 0x60, 0xe5, 0x6f,
-	0x01, 0x3f, 0x1c, 0x38, 0xfc, 0x45, 0xd8, 0xef, 0xda, 0xcc, 0xdd, 0x0a, 0xce, 0xa9, 0xcd, 0x5f,
-	0x38, 0x74, 0x90, 0xfb, 0xca, 0x9f, 0xa8, 0x9b, 0xa8, 0xef, 0xef, 0x4d, 0x04, 0x5c, 0x9a, 0x7e,
-	0x47, 0x02, 0x2e, 0xe1, 0xf4, 0x3b, 0x16, 0xb0, 0x71, 0xe6, 0x0e, 0x77, 0x5a, 0x0e, 0x7e, 0x64,
-	0x71, 0xee, 0xb7, 0x9a, 0x94, 0x61, 0x72, 0x6c, 0x85, 0x43, 0xbe, 0xd3, 0xe2, 0x7e, 0x48, 0x5a,
-	0xd1, 0x55, 0xfb, 0x4e, 0x4a, 0xc6, 0x57, 0xed, 0x6c, 0xe3, 0x0f, 0xe3, 0xb6, 0x76, 0x31, 0x6e,
-	0x67, 0xa2, 0x2f, 0xc7, 0x6d, 0x0d, 0x4d, 0x59, 0xac, 0x1f, 0x81, 0x5b, 0xd4, 0x72, 0x89, 0xf1,
-	0x56, 0x53, 0xeb, 0x54, 0x7b, 0x1f, 0x45, 0x02, 0xaa, 0x75, 0x2c, 0xe0, 0x03, 0x65, 0x27, 0x17,
-	0x4a, 0xf3, 0x11, 0x73, 0x1d, 0x4e, 0x5c, 0x8f, 0x9f, 0x4b, 0xa7, 0xf5, 0xd7, 0xe0, 0x48, 0xed,
-	0xd4, 0x9f, 0x83, 0xaa, 0x85, 0xb1, 0x4f, 0x82, 0x80, 0x04, 0xc6, 0x42, 0x73, 0xa1, 0x53, 0xed,
-	0x7d, 0x1c, 0x09, 0x38, 0x03, 0x63, 0x01, 0xef, 0x2b, 0xed, 0x14, 0x29, 0x2a, 0xd7, 0x6f, 0xa0,
-	0x68, 0xb6, 0x55, 0x1f, 0x81, 0xbb, 0x36, 0x73, 0x3d, 0xb9, 0x72, 0x18, 0x35, 0x6e, 0x35, 0xb5,
-	0xce, 0xea, 0xf6, 0xbd, 0x6e, 0x56, 0xc6, 0xdd, 0x19, 0xa9, 0x5c, 0xf3, 0xd1, 0xb1, 0x80, 0x1b,
-	0xca, 0x37, 0x87, 0x25, 0xb5, 0x8c, 0xae, 0xda, 0x6b, 0x65, 0x10, 0xe5, 0xb7, 0xea, 0x04, 0x54,
-	0x6d, 0xe2, 0x73, 0x53, 0xd5, 0xea, 0xb6, 0xaa, 0xd5, 0x13, 0xf9, 0x7b, 0x24, 0x78, 0x98, 0xd4,
-	0xeb, 0x61, 0xa2, 0x9d, 0x02, 0xaf, 0xa9, 0xd9, 0xfd, 0x39, 0x1c, 0xca, 0x54, 0xf4, 0x67, 0x00,
-	0x38, 0x94, 0xfb, 0x0c, 0x87, 0x36, 0xf1, 0x8d, 0xc5, 0xa6, 0xd6, 0x59, 0xea, 0xed, 0x44, 0x02,
-	0xe6, 0xd0, 0x58, 0xc0, 0x7b, 0x49, 0x23, 0x64, 0x50, 0x96, 0x44, 0xad, 0x84, 0xa1, 0xdc, 0x3e,
-	0xfd, 0x37, 0x0d, 0x6c, 0x06, 0x27, 0x8e, 0x67, 0x4e, 0x31, 0xd9, 0xc1, 0xa6, 0x4f, 0x5c, 0x36,
-	0xb2, 0x86, 0x81, 0x71, 0x47, 0x99, 0xe1, 0x48, 0x40, 0x43, 0x46, 0xed, 0xe7, 0x82, 0x50, 0x1a,
-	0x13, 0x0b, 0xf8, 0x8e, 0xb2, 0x9e, 0x17, 0x90, 0x1d, 0xe4, 0xe1, 0x1b, 0x23, 0xd0, 0x5c, 0x07,
-	0xfd, 0x77, 0x0d, 0xac, 0x64, 0x67, 0xc6, 0x66, 0xff, 0xdc, 0x58, 0x52, 0x43, 0xf5, 0xf3, 0xff,
-	0x1a, 0xaa, 0x48, 0xc0, 0xe5, 0x99, 0x6a, 0xef, 0x3c, 0x16, 0xb0, 0x53, 0xac, 0x21, 0xee, 0x9d,
-	0xcf, 0x1f, 0xab, 0xfa, 0x8d, 0x30, 0x39, 0x54, 0x6a, 0x90, 0x0a, 0xb2, 0xfa, 0x36, 0x58, 0xf4,
-	0xac, 0x30, 0x20, 0xd8, 0xa8, 0xaa, 0x6a, 0x6e, 0x46, 0x02, 0xa6, 0x48, 0x2c, 0xe0, 0xb2, 0xb2,
-	0x4c, 0x96, 0x2d, 0x94, 0xe2, 0xfa, 0x77, 0x60, 0xcd, 0x1a, 0x0e, 0xd9, 0x29, 0xc1, 0x26, 0x25,
-	0xfc, 0x94, 0xf9, 0x27, 0x81, 0x01, 0xd4, 0xd4, 0x7c, 0x19, 0x09, 0x58, 0x4b, 0xb9, 0xc3, 0x94,
-	0xca, 0xae, 0x81, 0x22, 0x5e, 0x6c, 0x34, 0x63, 0x1e, 0x89, 0xca, 0x72, 0xfa, 0x37, 0x60, 0xdd,
-	0x0a, 0x39, 0x33, 0x2d, 0xdb, 0x26, 0x1e, 0x37, 0x8f, 0xd9, 0x10, 0x13, 0x3f, 0x30, 0xee, 0xaa,
-	0xe3, 0xbf, 0x1f, 0x09, 0x58, 0x97, 0xf4, 0xa7, 0x8a, 0xfd, 0x2c, 0x21, 0x67, 0xe3, 0x5b, 0x66,
-	0x5a, 0xe8, 0x66, 0xb4, 0xfe, 0x14, 0xac, 0xb8, 0xd6, 0x99, 0x19, 0x10, 0x8a, 0xcd, 0x93, 0xbe,
-	0x17, 0x18, 0xcb, 0x4d, 0xad, 0x73, 0xbb, 0xf7, 0x9e, 0x1c, 0x4e, 0xd7, 0x3a, 0xfb, 0x8a, 0x50,
-	0x7c, 0xd0, 0xf7, 0xa4, 0x6a, 0x5d, 0xa9, 0xe6, 0xb0, 0xd6, 0x3f, 0x02, 0x2e, 0x38, 0x94, 0xa3,
-	0x7c, 0xe0, 0x54, 0xd0, 0x27, 0xf6, 0x28, 0x11, 0x5c, 0x29, 0x08, 0x22, 0x62, 0x8f, 0xca, 0x82,
-	0x53, 0xac, 0x20, 0x38, 0x05, 0x75, 0x0a, 0x6a, 0xce, 0x80, 0x32, 0x9f, 0xe0, 0x2c, 0xff, 0xd5,
-	0xe6, 0x42, 0xe7, 0xee, 0xf6, 0x46, 0x37, 0x79, 0x18, 0xba, 0x4f, 0xd3, 0x87, 0x21, 0xc9, 0xa9,
-	0xf7, 0x58, 0xf6, 0x62, 0x24, 0xe0, 0x6a, 0xba, 0x6d, 0x56, 0x98, 0xf5, 0xa4, 0xab, 0xf2, 0x70,
-	0x0b, 0x95, 0xc2, 0xf4, 0x1f, 0x35, 0x50, 0xf3, 0x08, 0xc5, 0x0e, 0x1d, 0x64, 0x86, 0xb5, 0x37,
-	0x1a, 0x3e, 0x91, 0x86, 0x13, 0x01, 0x8d, 0x3d, 0xe2, 0xf9, 0xc4, 0xb6, 0x38, 0xc1, 0x47, 0x89,
-	0x40, 0xaa, 0x19, 0x09, 0xa8, 0x3d, 0xce, 0xee, 0x20, 0x2f, 0xcf, 0xe5, 0x5a, 0xc3, 0xd0, 0xd0,
-	0x6a, 0x81, 0x0b, 0xf4, 0x5f, 0x34, 0x50, 0x4b, 0xaa, 0xf9, 0x6d, 0x48, 0x02, 0x6e, 0x9e, 0x38,
-	0x7d, 0x63, 0x4d, 0xd5, 0x33, 0x98, 0x08, 0xb8, 0xf2, 0x85, 0x2c, 0x93, 0x62, 0x0e, 0x9c, 0x5e,
-	0x24, 0xe0, 0x8a, 0x9b, 0x07, 0xb2, 0x84, 0x0b, 0xe8, 0xb4, 0xc8, 0xd1, 0x55, 0xbb, 0x14, 0x5e,
-	0x06, 0x2e, 0xc6, 0xed, 0xa2, 0x03, 0x2a, 0xf0, 0x7d, 0xfd, 0x13, 0x50, 0x0d, 0x29, 0xf7, 0xc3,
-	0x80, 0x13, 0x6c, 0xd4, 0x55, 0x4f, 0x36, 0xe5, 0x53, 0x92, 0x81, 0xb1, 0x80, 0x35, 0x75, 0x82,
-	0x0c, 0x69, 0xa1, 0x19, 0xab, 0xb2, 0x93, 0x17, 0x1c, 0x27, 0xe6, 0x20, 0x74, 0x4c, 0x8f, 0xf9,
-	0xdc, 0xd0, 0x67, 0xd9, 0x21, 0x45, 0x7d, 0xfe, 0xf5, 0xfe, 0x11, 0xf3, 0xb9, 0xcc, 0xce, 0xcf,
-	0x03, 0x59, 0x76, 0x05, 0x34, 0x9f, 0x5d, 0x31, 0xbc, 0x0c, 0xc8, 0xec, 0x0a, 0x0e, 0x68, 0xca,
-	0x87, 0x8e, 0x5c, 0xea, 0xdf, 0x6b, 0xa0, 0x46, 0x43, 0xd7, 0xb4, 0x19, 0xa5, 0x44, 0x5d, 0x83,
-	0x81, 0xb1, 0xae, 0x4e, 0xf7, 0x7c, 0x22, 0x60, 0x1d, 0x59, 0xa7, 0x87, 0xa1, 0xbb, 0x3b, 0x23,
-	0x65, 0xc7, 0xd1, 0x02, 0x12, 0x0b, 0xf8, 0x76, 0xf2, 0x4a, 0x17, 0xe0, 0xe9, 0x19, 0x2f, 0xc6,
-	0xed, 0x9b, 0x2a, 0xa8, 0xa4, 0xd1, 0x3b, 0xb8, 0x7c, 0xd5, 0xa8, 0x8c, 0x5f, 0x35, 0x2a, 0x97,
-	0x93, 0x86, 0x36, 0x9e, 0x34, 0xb4, 0x9f, 0xae, 0x1b, 0x95, 0x97, 0xd7, 0x0d, 0x6d, 0x7c, 0xdd,
-	0xa8, 0xfc, 0x79, 0xdd, 0xa8, 0x3c, 0x7b, 0xf7, 0x3f, 0xdc, 0xb9, 0x49, 0xe3, 0xf6, 0x17, 0xd5,
-	0xdd, 0xfb, 0xc1, 0xbf, 0x01, 0x00, 0x00, 0xff, 0xff, 0x75, 0x19, 0xf5, 0x92, 0x9d, 0x09, 0x00,
-	0x00,
+	// 1512 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x57, 0x4b, 0x6c, 0xdc, 0x44,
+	0x18, 0x8e, 0x9b, 0x36, 0xcd, 0x3a, 0x8f, 0x4d, 0x26, 0x8f, 0x3a, 0x81, 0xee, 0xac, 0xcc, 0x0a,
+	0x2d, 0xa2, 0xdd, 0x54, 0xa1, 0xa7, 0xf2, 0x90, 0xba, 0x8d, 0x4a, 0xab, 0xd2, 0x36, 0x4c, 0xa9,
+	0x40, 0xed, 0xc1, 0xf5, 0xda, 0x93, 0x8d, 0x95, 0xf5, 0x83, 0xb1, 0x9d, 0x87, 0x84, 0xc4, 0x85,
+	0x03, 0x9c, 0xa8, 0x22, 0x95, 0x0b, 0x97, 0x02, 0x67, 0x24, 0xee, 0x1c, 0xb8, 0x70, 0xe8, 0x2d,
+	0x7b, 0x44, 0x1c, 0x06, 0x35, 0xb9, 0xf9, 0xe8, 0x23, 0x27, 0x34, 0x33, 0xb6, 0xd7, 0xf6, 0x6e,
+	0x2a, 0xa4, 0xde, 0xec, 0xef, 0xfb, 0xe7, 0xff, 0xff, 0xf9, 0xfd, 0xbf, 0x2c, 0x37, 0x7a, 0x56,
+	0x67, 0xcd, 0x70, 0x9d, 0x2d, 0xab, 0xbb, 0x66, 0xe2, 0x5d, 0xcb, 0xc0, 0xe2, 0x25, 0x24, 0x7a,
+	0x60, 0xb9, 0x4e, 0xcb, 0x23, 0x6e, 0xe0, 0x82, 0x09, 0x01, 0xae, 0x2e, 0x33, 0x69, 0x0e, 0x19,
+	0x6e, 0x6f, 0xad, 0x83, 0x3d, 0xc1, 0xaf, 0xae, 0xe4, 0xb4, 0xb8, 0x1d, 0x1f, 0x93, 0x5d, 0x6c,
+	0x26, 0x14, 0xec, 0xba, 0x6e, 0xb7, 0x87, 0xc5, 0xa9, 0x4e, 0xb8, 0xb5, 0x16, 0x58, 0x36, 0xf6,
+	0x03, 0xdd, 0x4e, 0xcf, 0x56, 0xf0, 0x7e, 0x20, 0x1e, 0xd5, 0x67, 0xab, 0xf2, 0xc2, 0x06, 0x77,
+	0xe2, 0x46, 0xde, 0x09, 0xf0, 0x87, 0x24, 0x57, 0x84, 0x73, 0x9a, 0x65, 0x2a, 0x52, 0x5d, 0x6a,
+	0x4e, 0xb7, 0x7f, 0x92, 0x5e, 0x50, 0x38, 0xf6, 0x37, 0x85, 0x57, 0xbb, 0x56, 0xb0, 0x1d, 0x76,
+	0x5a, 0x86, 0x6b, 0xaf, 0xf9, 0x07, 0x8e, 0x11, 0x6c, 0x5b, 0x4e, 0x37, 0xf7, 0x94, 0x77, 0xb9,
+	0x25, 0xb4, 0xdf, 0xde, 0x38, 0xa6, 0x70, 0x32, 0x7d, 0x8e, 0x28, 0x9c, 0x34, 0x93, 0xe7, 0x98,
+	0xc2, 0xda, 0xbe, 0xdd, 0xbb, 0xa6, 0x5a, 0xe6, 0x25, 0x3d, 0x08, 0x88, 0x5a, 0x77, 0x5c, 0x13,
+	0x6f, 0xe9, 0x61, 0x2f, 0xb8, 0xa6, 0x06, 0x24, 0xc4, 0x6a, 0x74, 0xd4, 0x38, 0x9f, 0x90, 0xf1,
+	0x51, 0x23, 0x3b, 0xf8, 0x6d, 0xbf, 0x21, 0x1d, 0xf6, 0x1b, 0x99, 0xd2, 0xe7, 0xfd, 0x86, 0x84,
+	0x52, 0xd6, 0x04, 0x9b, 0xf2, 0x59, 0x47, 0xb7, 0xb1, 0x72, 0xa6, 0x2e, 0x35, 0x2b, 0xed, 0x0f,
+	0x22, 0x0a, 0xf9, 0x7b, 0x4c, 0xe1, 0x0a, 0x37, 0xc7, 0x5e, 0xb8, 0xce, 0x4b, 0xae, 0x6d, 0x05,
+	0xd8, 0xf6, 0x82, 0x03, 0x66, 0x69, 0x61, 0x04, 0x8e, 0xf8, 0x49, 0xf0, 0x58, 0xae, 0xe8, 0xa6,
+	0x49, 0xb0, 0xef, 0x63, 0x5f, 0x19, 0xaf, 0x8f, 0x37, 0x2b, 0xed, 0x0f, 0x23, 0x0a, 0x07, 0x60,
+	0x4c, 0xe1, 0x05, 0xae, 0x3b, 0x41, 0x8a, 0x9a, 0xe7, 0x87, 0x50, 0x34, 0x38, 0x0a, 0x76, 0xe5,
+	0x29, 0xc3, 0xb5, 0x3d, 0xf6, 0x66, 0xb9, 0x8e, 0x72, 0xb6, 0x2e, 0x35, 0x67, 0xd7, 0x97, 0x5a,
+	0x59, 0x18, 0x6f, 0x0c, 0x48, 0x6e, 0x35, 0x2f, 0x1d, 0x53, 0xb8, 0xcc, 0xed, 0xe6, 0x30, 0x11,
+	0xcb, 0xe8, 0xa8, 0x31, 0x57, 0x06, 0x51, 0xfe, 0x28, 0xc0, 0x72, 0xc5, 0xc0, 0x24, 0xd0, 0x78,
+	0xac, 0xce, 0xf1, 0x58, 0xdd, 0x62, 0x9f, 0x87, 0x81, 0xf7, 0x44, 0xbc, 0x2e, 0x0a, 0xdd, 0x09,
+	0x30, 0x22, 0x66, 0x17, 0x4e, 0xe1, 0x50, 0xa6, 0x05, 0x3c, 0x92, 0x65, 0xcb, 0x09, 0x88, 0x6b,
+	0x86, 0x06, 0x26, 0xca, 0x44, 0x5d, 0x6a, 0x4e, 0xb6, 0xaf, 0x45, 0x14, 0xe6, 0xd0, 0x98, 0xc2,
+	0x25, 0x91, 0x08, 0x19, 0x94, 0x5d, 0xa2, 0x5a, 0xc2, 0x50, 0xee, 0x1c, 0xf8, 0x59, 0x92, 0x57,
+	0xfd, 0x1d, 0xcb, 0xd3, 0x52, 0x8c, 0x65, 0xb0, 0x46, 0xb0, 0xed, 0xee, 0xea, 0x3d, 0x5f, 0x39,
+	0xcf, 0x8d, 0x99, 0x11, 0x85, 0x0a, 0x93, 0xba, 0x9d, 0x13, 0x42, 0x89, 0x4c, 0x4c, 0xe1, 0x5b,
+	0xdc, 0xf4, 0x69, 0x02, 0x99, 0x23, 0x17, 0x5f, 0x29, 0x81, 0x4e, 0xb5, 0x00, 0x7e, 0x97, 0xe4,
+	0x99, 0xcc, 0x67, 0x53, 0xeb, 0x1c, 0x28, 0x93, 0xbc, 0xa8, 0x9e, 0xbd, 0x56, 0x51, 0x45, 0x14,
+	0x4e, 0x0f, 0xb4, 0xb6, 0x0f, 0x62, 0x0a, 0x9b, 0xc5, 0x18, 0x9a, 0xed, 0x83, 0xd3, 0xcb, 0x6a,
+	0x7e, 0x48, 0x8c, 0x15, 0x15, 0x2f, 0xa4, 0x82, 0x5a, 0xb0, 0x2e, 0x4f, 0x78, 0x7a, 0xe8, 0x63,
+	0x53, 0xa9, 0xf0, 0x68, 0xae, 0x46, 0x14, 0x26, 0x48, 0x4c, 0xe1, 0x34, 0x37, 0x29, 0x5e, 0x55,
+	0x94, 0xe0, 0xe0, 0x2b, 0x79, 0x4e, 0xef, 0xf5, 0xdc, 0x3d, 0x6c, 0x6a, 0x0e, 0x0e, 0xf6, 0x5c,
+	0xb2, 0xe3, 0x2b, 0x32, 0xaf, 0x9a, 0x4f, 0x23, 0x0a, 0xab, 0x09, 0x77, 0x2f, 0xa1, 0xb2, 0x36,
+	0x50, 0xc4, 0x8b, 0x89, 0xa6, 0x9c, 0x46, 0xa2, 0xb2, 0x3a, 0xf0, 0x44, 0x5e, 0xd0, 0xc3, 0xc0,
+	0xd5, 0x74, 0xc3, 0xc0, 0x5e, 0xa0, 0x6d, 0xb9, 0x3d, 0x13, 0x13, 0x5f, 0x99, 0xe2, 0xee, 0x5f,
+	0x89, 0x28, 0x9c, 0x67, 0xf4, 0x75, 0xce, 0xde, 0x14, 0xe4, 0xa0, 0x7c, 0xcb, 0x8c, 0x8a, 0x86,
+	0xa5, 0xc1, 0x7d, 0x79, 0xc6, 0xd6, 0xf7, 0x35, 0x1f, 0x3b, 0xa6, 0xb6, 0xd3, 0xf1, 0x7c, 0x65,
+	0xba, 0x2e, 0x35, 0xcf, 0xb5, 0xdf, 0x65, 0xc5, 0x69, 0xeb, 0xfb, 0x0f, 0xb0, 0x63, 0xde, 0xe9,
+	0x78, 0x4c, 0xeb, 0x3c, 0xd7, 0x9a, 0xc3, 0xd4, 0x7f, 0x29, 0x1c, 0xb7, 0x9c, 0x00, 0xe5, 0x05,
+	0x53, 0x85, 0x04, 0x1b, 0xbb, 0x42, 0xe1, 0x4c, 0x41, 0x21, 0xc2, 0xc6, 0x6e, 0x59, 0x61, 0x8a,
+	0x15, 0x14, 0xa6, 0x20, 0x70, 0xe4, 0xaa, 0xd5, 0x75, 0x5c, 0x82, 0xcd, 0xec, 0xfe, 0xb3, 0xf5,
+	0xf1, 0xe6, 0xd4, 0xfa, 0x72, 0x4b, 0x4c, 0x8e, 0xd6, 0xfd, 0x64, 0x72, 0x88, 0x3b, 0xb5, 0x2f,
+	0xb3, 0x5c, 0x8c, 0x28, 0x9c, 0x4d, 0x8e, 0x0d, 0x02, 0xb3, 0x20, 0xb2, 0x2a, 0x0f, 0xab, 0xa8,
+	0x24, 0x06, 0xbe, 0x93, 0xe4, 0xaa, 0x87, 0x1d, 0xd3, 0x72, 0xba, 0x99, 0xc1, 0xea, 0x2b, 0x0d,
+	0xde, 0x62, 0x06, 0x8f, 0x29, 0x54, 0x36, 0xb0, 0x47, 0xb0, 0xa1, 0x07, 0xd8, 0xdc, 0x14, 0x0a,
+	0x12, 0x9d, 0x11, 0x85, 0xd2, 0xe5, 0xac, 0x07, 0x79, 0x79, 0x2e, 0x97, 0x1a, 0x8a, 0x84, 0x66,
+	0x0b, 0x9c, 0x0f, 0x7e, 0x94, 0xe4, 0xaa, 0x88, 0xe6, 0x97, 0x21, 0xf6, 0x03, 0x6d, 0xc7, 0xea,
+	0x28, 0x73, 0x3c, 0x9e, 0xfe, 0x31, 0x85, 0x33, 0x77, 0x59, 0x98, 0x38, 0x73, 0xc7, 0x6a, 0x47,
+	0x14, 0xce, 0xd8, 0x79, 0x20, 0xbb, 0x70, 0x01, 0x4d, 0x83, 0x1c, 0x1d, 0x35, 0x4a, 0xe2, 0x65,
+	0xe0, 0xb0, 0xdf, 0x28, 0x5a, 0x40, 0x05, 0xbe, 0x03, 0x3e, 0x92, 0x2b, 0xa1, 0x13, 0x90, 0xd0,
+	0x0f, 0xb0, 0xa9, 0xcc, 0xf3, 0x9c, 0xac, 0xb3, 0x51, 0x92, 0x81, 0x31, 0x85, 0x55, 0xee, 0x41,
+	0x86, 0xa8, 0x68, 0xc0, 0xf2, 0xdb, 0xb1, 0x06, 0x17, 0x60, 0xad, 0x1b, 0x5a, 0x9a, 0xe7, 0x92,
+	0x40, 0x01, 0x83, 0xdb, 0x21, 0x4e, 0x7d, 0xfc, 0xf0, 0xf6, 0xa6, 0x4b, 0x02, 0x76, 0x3b, 0x92,
+	0x07, 0xb2, 0xdb, 0x15, 0xd0, 0xfc, 0xed, 0x8a, 0xe2, 0x65, 0x80, 0xdd, 0xae, 0x60, 0x01, 0xa5,
+	0x7c, 0x68, 0xb1, 0x57, 0xf0, 0x8d, 0x24, 0x57, 0x9d, 0xd0, 0xd6, 0x0c, 0xd7, 0x71, 0x30, 0x6f,
+	0x83, 0xbe, 0xb2, 0xc0, 0xbd, 0x7b, 0x7c, 0x4c, 0xe1, 0x3c, 0xd2, 0xf7, 0xee, 0x85, 0xf6, 0x8d,
+	0x01, 0xc9, 0x32, 0xce, 0x29, 0x20, 0x31, 0x85, 0x8b, 0x62, 0x4a, 0x17, 0xe0, 0xd4, 0xc7, 0xc3,
+	0x7e, 0x63, 0x58, 0x0b, 0x2a, 0xe9, 0x00, 0x5f, 0xcb, 0x15, 0x8f, 0xb8, 0xfb, 0x07, 0x5a, 0x48,
+	0x7a, 0xca, 0x22, 0x1f, 0x6d, 0x1d, 0xb6, 0x85, 0x6c, 0x32, 0xf0, 0x21, 0xfa, 0x84, 0x8d, 0x39,
+	0x2f, 0x79, 0x8e, 0x29, 0x54, 0x44, 0x8a, 0x25, 0x40, 0xb1, 0xf1, 0x80, 0x61, 0x98, 0xad, 0x22,
+	0x29, 0xca, 0xd6, 0x90, 0x54, 0x2b, 0x4a, 0x50, 0xd2, 0x03, 0x7f, 0x4a, 0xf2, 0xbc, 0x69, 0xf9,
+	0x86, 0xbb, 0x8b, 0xc9, 0x81, 0xc6, 0x13, 0x9f, 0xf8, 0xca, 0x12, 0xef, 0x81, 0x3f, 0x48, 0xc7,
+	0x14, 0x2e, 0x20, 0x7d, 0x6f, 0x23, 0x15, 0x78, 0x20, 0xf8, 0x88, 0xc2, 0x39, 0xb3, 0x84, 0xc5,
+	0x14, 0x42, 0xee, 0x5d, 0x89, 0x28, 0x3a, 0xb9, 0x72, 0x2a, 0x1b, 0x1f, 0x35, 0x86, 0x74, 0x1e,
+	0xf6, 0x1b, 0xa3, 0xcc, 0xa3, 0x21, 0x41, 0xf0, 0x79, 0xd2, 0xc8, 0x35, 0x5b, 0x77, 0xf4, 0x2e,
+	0xb6, 0xb1, 0x13, 0x28, 0xcb, 0x3c, 0x67, 0x2f, 0x65, 0x8d, 0xfc, 0x6e, 0x46, 0x65, 0x63, 0xbc,
+	0x84, 0xab, 0xa8, 0x2c, 0x09, 0xf6, 0x06, 0x23, 0x51, 0x24, 0xc9, 0x05, 0xde, 0x2c, 0x16, 0xd3,
+	0x66, 0x91, 0x1f, 0xa4, 0xed, 0xf7, 0x93, 0xde, 0x54, 0x3c, 0x12, 0x53, 0x08, 0x0a, 0x03, 0x8f,
+	0xa1, 0x2c, 0x18, 0xd3, 0x79, 0x00, 0x15, 0x0f, 0x81, 0x5f, 0x25, 0x79, 0x29, 0xb7, 0x04, 0x69,
+	0x7a, 0xaf, 0xeb, 0x12, 0x2b, 0xd8, 0xb6, 0x15, 0x85, 0xef, 0x5d, 0xb5, 0x91, 0x7b, 0xd7, 0xf5,
+	0x54, 0xaa, 0xfd, 0x45, 0x44, 0xe1, 0xa2, 0x31, 0x82, 0xc9, 0x3e, 0xd4, 0x28, 0x32, 0x5b, 0x22,
+	0x56, 0x4e, 0x65, 0xd1, 0x48, 0xad, 0x60, 0x5b, 0x5e, 0xda, 0x72, 0xc9, 0x9e, 0x4e, 0x4c, 0x2d,
+	0xf4, 0xf5, 0x2e, 0xd6, 0x08, 0x66, 0x25, 0xef, 0x2b, 0x2b, 0xfc, 0x33, 0x5c, 0x8d, 0x28, 0x5c,
+	0x48, 0x04, 0x1e, 0x32, 0x1e, 0x09, 0x3a, 0xdb, 0x75, 0x47, 0x70, 0x2a, 0x1a, 0x75, 0x02, 0x98,
+	0xf2, 0x62, 0x32, 0x31, 0x8b, 0x86, 0x56, 0xb9, 0xa1, 0xf5, 0x88, 0x42, 0x20, 0xf8, 0x92, 0x1d,
+	0x51, 0x3c, 0xc3, 0x94, 0x8a, 0x46, 0xc8, 0xb3, 0xe1, 0x8c, 0xf7, 0x59, 0x66, 0x6a, 0x5b, 0xc4,
+	0xb5, 0x35, 0x8f, 0x84, 0x8e, 0xe5, 0x74, 0x95, 0x37, 0x06, 0xc3, 0x59, 0xd0, 0x37, 0x89, 0x6b,
+	0x6f, 0x0a, 0x32, 0x1b, 0xce, 0x43, 0x8c, 0x8a, 0x86, 0xa5, 0x59, 0x83, 0x65, 0x9f, 0x10, 0x1b,
+	0xac, 0xc1, 0xbe, 0x39, 0x68, 0xb0, 0x19, 0x98, 0x35, 0xd8, 0x0c, 0x51, 0xd1, 0x80, 0x55, 0x7f,
+	0x39, 0x23, 0x4f, 0xe7, 0xd3, 0x0f, 0xfc, 0x26, 0x15, 0x36, 0x58, 0xf1, 0x47, 0xf4, 0xfd, 0xeb,
+	0x2e, 0x6f, 0xc5, 0xf5, 0xf7, 0xed, 0x91, 0xeb, 0xef, 0xa8, 0xc5, 0xad, 0xbc, 0x0f, 0x67, 0x6b,
+	0x5b, 0x7e, 0x2f, 0x7e, 0x22, 0x9f, 0x0d, 0xac, 0xe4, 0x0f, 0x68, 0x6a, 0x7d, 0xb5, 0x25, 0x7e,
+	0x0b, 0x5b, 0xe9, 0x6f, 0x61, 0xeb, 0xb3, 0xf4, 0xb7, 0xb0, 0x7d, 0x25, 0xa9, 0x2d, 0x2e, 0x9f,
+	0x45, 0x86, 0xbd, 0x08, 0x17, 0x9e, 0xfe, 0x03, 0xa5, 0xe8, 0xa8, 0x51, 0xc9, 0x10, 0xc4, 0x25,
+	0xdb, 0x77, 0x5e, 0xbc, 0xac, 0x8d, 0xf5, 0x5f, 0xd6, 0xc6, 0x5e, 0x1c, 0xd7, 0xa4, 0xfe, 0x71,
+	0x4d, 0x7a, 0x7a, 0x52, 0x1b, 0x7b, 0x7e, 0x52, 0x93, 0xfa, 0x27, 0xb5, 0xb1, 0xbf, 0x4e, 0x6a,
+	0x63, 0x8f, 0xde, 0xf9, 0x1f, 0x81, 0x11, 0xd5, 0xde, 0x99, 0xe0, 0x8e, 0xbd, 0xf7, 0xdf, 0x00,
+	0xf3, 0x07, 0xf7, 0x8c, 0x20, 0x0f, 0x00, 0x00,
 }
 
 func (m *DeviceConfiguration) Marshal() (dAtA []byte, err error) {
@@ -179,6 +261,109 @@ func (m *DeviceConfiguration) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Protected {
+		i--
+		if m.Protected {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xe0
+	}
+	if m.ExemptFromPruning {
+		i--
+		if m.ExemptFromPruning {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd8
+	}
+	if m.AcceptUsageReports {
+		i--
+		if m.AcceptUsageReports {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd0
+	}
+	if m.ForwardUsageReports {
+		i--
+		if m.ForwardUsageReports {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc8
+	}
+	if m.CompressionAlgorithm != 0 {
+		i = encodeVarintDeviceconfiguration(dAtA, i, uint64(m.CompressionAlgorithm))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc0
+	}
+	if len(m.Introductions) > 0 {
+		for iNdEx := len(m.Introductions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Introductions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDeviceconfiguration(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xba
+		}
+	}
+	if m.AllowManagement {
+		i--
+		if m.AllowManagement {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb0
+	}
+	if len(m.RawDiscoveryServers) > 0 {
+		for iNdEx := len(m.RawDiscoveryServers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RawDiscoveryServers[iNdEx])
+			copy(dAtA[i:], m.RawDiscoveryServers[iNdEx])
+			i = encodeVarintDeviceconfiguration(dAtA, i, uint64(len(m.RawDiscoveryServers[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xaa
+		}
+	}
+	if len(m.ProxyURL) > 0 {
+		i -= len(m.ProxyURL)
+		copy(dAtA[i:], m.ProxyURL)
+		i = encodeVarintDeviceconfiguration(dAtA, i, uint64(len(m.ProxyURL)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa2
+	}
 	if m.RawNumConnections != 0 {
 		i = encodeVarintDeviceconfiguration(dAtA, i, uint64(m.RawNumConnections))
 		i--
@@ -350,6 +535,47 @@ func (m *DeviceConfiguration) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *Introduction) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Introduction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Introduction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Time):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintDeviceconfiguration(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Introducer.ProtoSize()
+		i -= size
+		if _, err := m.Introducer.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDeviceconfiguration(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintDeviceconfiguration(dAtA []byte, offset int, v uint64) int {
 	offset -= sovDeviceconfiguration(v)
 	base := offset
@@ -436,6 +662,53 @@ func (m *DeviceConfiguration) ProtoSize() (n int) {
 	if m.RawNumConnections != 0 {
 		n += 2 + sovDeviceconfiguration(uint64(m.RawNumConnections))
 	}
+	l = len(m.ProxyURL)
+	if l > 0 {
+		n += 2 + l + sovDeviceconfiguration(uint64(l))
+	}
+	if len(m.RawDiscoveryServers) > 0 {
+		for _, s := range m.RawDiscoveryServers {
+			l = len(s)
+			n += 2 + l + sovDeviceconfiguration(uint64(l))
+		}
+	}
+	if m.AllowManagement {
+		n += 3
+	}
+	if len(m.Introductions) > 0 {
+		for _, e := range m.Introductions {
+			l = e.ProtoSize()
+			n += 2 + l + sovDeviceconfiguration(uint64(l))
+		}
+	}
+	if m.CompressionAlgorithm != 0 {
+		n += 2 + sovDeviceconfiguration(uint64(m.CompressionAlgorithm))
+	}
+	if m.ForwardUsageReports {
+		n += 3
+	}
+	if m.AcceptUsageReports {
+		n += 3
+	}
+	if m.ExemptFromPruning {
+		n += 3
+	}
+	if m.Protected {
+		n += 3
+	}
+	return n
+}
+
+func (m *Introduction) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Introducer.ProtoSize()
+	n += 1 + l + sovDeviceconfiguration(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovDeviceconfiguration(uint64(l))
 	return n
 }
 
@@ -950,6 +1223,339 @@ func (m *DeviceConfiguration) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProxyURL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDeviceconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDeviceconfiguration
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDeviceconfiguration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProxyURL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RawDiscoveryServers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDeviceconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDeviceconfiguration
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDeviceconfiguration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RawDiscoveryServers = append(m.RawDiscoveryServers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 22:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowManagement", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDeviceconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AllowManagement = bool(v != 0)
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Introductions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDeviceconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDeviceconfiguration
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDeviceconfiguration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Introductions = append(m.Introductions, Introduction{})
+			if err := m.Introductions[len(m.Introductions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 24:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompressionAlgorithm", wireType)
+			}
+			m.CompressionAlgorithm = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDeviceconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CompressionAlgorithm |= protocol.CompressionAlgorithm(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 25:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForwardUsageReports", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDeviceconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ForwardUsageReports = bool(v != 0)
+		case 26:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AcceptUsageReports", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDeviceconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AcceptUsageReports = bool(v != 0)
+		case 27:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExemptFromPruning", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDeviceconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ExemptFromPruning = bool(v != 0)
+		case 28:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Protected", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDeviceconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Protected = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDeviceconfiguration(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDeviceconfiguration
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Introduction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDeviceconfiguration
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Introduction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Introduction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Introducer", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDeviceconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthDeviceconfiguration
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDeviceconfiguration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Introducer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDeviceconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDeviceconfiguration
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDeviceconfiguration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDeviceconfiguration(dAtA[iNdEx:])
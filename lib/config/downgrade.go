@@ -0,0 +1,129 @@
+// Copyright (C) 2026 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package config
+
+import (
+	"fmt"
+	"io"
+	"strings"
+)
+
+// MinDowngradeVersion is the oldest config version a configuration can be
+// downgraded to.
+const MinDowngradeVersion = 29
+
+// downgrades is the set of functions that undo a config migration, keyed by
+// the version they downgrade to; the one for version 36 turns a version 37
+// config into one that a Syncthing expecting version 36 reads the same way.
+// Like for migrations, a nil function only changes the version. The
+// functions return a note for each setting that can't be kept.
+var downgrades = map[int]func(cfg *Configuration) []string{
+	36: downgradeToConfigV36,
+	35: nil, // the dropped "cleanInterval" parameter is unused
+	34: downgradeToConfigV34,
+	33: downgradeToConfigV33,
+	32: nil, // pending devices and folders aren't moved back
+	31: downgradeToConfigV31,
+	30: nil, // the notification doesn't matter
+	29: downgradeToConfigV29,
+}
+
+// Downgrade returns a copy of the configuration for an older Syncthing,
+// expecting the given config version, along with notes about the settings
+// that the older version doesn't know and are dropped.
+//
+// Older versions ignore unknown elements in the config file, but they
+// refuse to start with a config version newer than their own and read
+// some settings from where they were kept before a migration. Downgrading
+// moves those settings back and lowers the version. Settings added without
+// a version change, as most are, stay in place and are ignored.
+func (cfg Configuration) Downgrade(version int) (Configuration, []string, error) {
+	if version > CurrentVersion || version < MinDowngradeVersion {
+		return Configuration{}, nil, fmt.Errorf("can't downgrade to config version %d: supported versions are %d to %d", version, MinDowngradeVersion, CurrentVersion)
+	}
+
+	cfg = cfg.Copy()
+	notes := []string{}
+	for v := CurrentVersion - 1; v >= version; v-- {
+		if fn := downgrades[v]; fn != nil {
+			notes = append(notes, fn(&cfg)...)
+		}
+	}
+	cfg.Version = version
+	return cfg, notes, nil
+}
+
+// WriteXMLForVersion writes the configuration as downgraded to the given
+// config version, preceded by the notes about dropped settings as XML
+// comments. The notes are returned as well.
+func (cfg Configuration) WriteXMLForVersion(w io.Writer, version int) ([]string, error) {
+	downgraded, notes, err := cfg.Downgrade(version)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := fmt.Fprintf(w, "<!-- Downgraded from config version %d to %d -->\n", CurrentVersion, version); err != nil {
+		return nil, err
+	}
+	for _, note := range notes {
+		if _, err := fmt.Fprintf(w, "<!-- %s -->\n", strings.ReplaceAll(note, "--", "- -")); err != nil {
+			return nil, err
+		}
+	}
+	return notes, downgraded.WriteXML(w)
+}
+
+func downgradeToConfigV36(cfg *Configuration) []string {
+	// "send ownership" was called "scan ownership"
+	for i := range cfg.Folders {
+		cfg.Folders[i].DeprecatedScanOwnership = cfg.Folders[i].SendOwnership
+		cfg.Folders[i].SendOwnership = false
+	}
+	return nil
+}
+
+func downgradeToConfigV34(cfg *Configuration) []string {
+	// The versioning filesystem was given in the parameters
+	for i := range cfg.Folders {
+		v := &cfg.Folders[i].Versioning
+		if v.FSPath != "" {
+			if v.Params == nil {
+				v.Params = make(map[string]string)
+			}
+			v.Params["fsPath"] = v.FSPath
+			v.Params["fsType"] = v.FSType.String()
+		}
+		v.FSPath = ""
+		v.FSType = 0
+	}
+	return nil
+}
+
+func downgradeToConfigV33(cfg *Configuration) []string {
+	// The default folder path was an option, and there were no other
+	// defaults.
+	cfg.Options.DeprecatedDefaultFolderPath = cfg.Defaults.Folder.Path
+	cfg.Defaults = Defaults{}
+	return []string{"The folder and device defaults and the default ignore patterns are dropped, except for the default folder path"}
+}
+
+func downgradeToConfigV31(cfg *Configuration) []string {
+	// Junctions were always followed
+	var notes []string
+	for _, folder := range cfg.Folders {
+		if !folder.JunctionsAsDirs {
+			notes = append(notes, fmt.Sprintf("Folder %s will follow junctions as directories", folder.Description()))
+		}
+	}
+	return notes
+}
+
+func downgradeToConfigV29(cfg *Configuration) []string {
+	// The folder concurrency was the number of concurrent scans
+	cfg.Options.DeprecatedMaxConcurrentScans = cfg.Options.RawMaxFolderConcurrency
+	cfg.Options.RawMaxFolderConcurrency = 0
+	return nil
+}
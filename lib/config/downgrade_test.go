@@ -0,0 +1,98 @@
+// Copyright (C) 2026 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package config
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/syncthing/syncthing/lib/fs"
+)
+
+func TestDowngrade(t *testing.T) {
+	cfg := New(device1)
+	cfg.Folders = []FolderConfiguration{{
+		ID:              "folder",
+		Path:            "testdata",
+		SendOwnership:   true,
+		JunctionsAsDirs: true,
+		Versioning: VersioningConfiguration{
+			Type:   "simple",
+			Params: map[string]string{"keep": "5"},
+			FSPath: "/versions",
+			FSType: fs.FilesystemTypeBasic,
+		},
+	}}
+	cfg.Defaults.Folder.Path = "/data"
+	cfg.Options.RawMaxFolderConcurrency = 3
+	if err := cfg.prepare(device1); err != nil {
+		t.Fatal(err)
+	}
+
+	down, notes, err := cfg.Downgrade(29)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if down.Version != 29 {
+		t.Errorf("version is %d, expected 29", down.Version)
+	}
+	if len(notes) != 1 {
+		t.Errorf("unexpected notes %v", notes)
+	}
+	folder := down.Folders[0]
+	if !folder.DeprecatedScanOwnership || folder.SendOwnership {
+		t.Error("send ownership not moved back to scan ownership")
+	}
+	if folder.Versioning.Params["fsPath"] != "/versions" || folder.Versioning.Params["fsType"] != "basic" || folder.Versioning.FSPath != "" {
+		t.Errorf("versioning filesystem not moved back to the parameters: %v", folder.Versioning)
+	}
+	if down.Options.DeprecatedDefaultFolderPath != "/data" {
+		t.Errorf("default folder path is %q, expected %q", down.Options.DeprecatedDefaultFolderPath, "/data")
+	}
+	if down.Options.DeprecatedMaxConcurrentScans != 3 {
+		t.Errorf("max concurrent scans is %d, expected 3", down.Options.DeprecatedMaxConcurrentScans)
+	}
+	if !cfg.Folders[0].SendOwnership || cfg.Folders[0].Versioning.FSPath != "/versions" {
+		t.Error("original configuration modified")
+	}
+
+	// Migrating the downgraded config brings the settings back.
+	buf := new(bytes.Buffer)
+	if _, err := cfg.WriteXMLForVersion(buf, 29); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(buf.String(), "<!-- Downgraded from config version") {
+		t.Errorf("missing downgrade comment in %q", buf.String()[:64])
+	}
+	up, version, err := ReadXML(buf, device1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if version != 29 {
+		t.Errorf("read version %d, expected 29", version)
+	}
+	folder = up.Folders[0]
+	if !folder.SendOwnership || folder.Versioning.FSPath != "/versions" || folder.Versioning.Params["keep"] != "5" {
+		t.Errorf("folder settings not migrated back: %+v", folder)
+	}
+	if up.Defaults.Folder.Path != "/data" || up.Options.RawMaxFolderConcurrency != 3 {
+		t.Error("options not migrated back")
+	}
+}
+
+func TestDowngradeUnsupportedVersion(t *testing.T) {
+	cfg := New(device1)
+	for _, version := range []int{MinDowngradeVersion - 1, CurrentVersion + 1} {
+		if _, _, err := cfg.Downgrade(version); err == nil {
+			t.Errorf("expected error downgrading to version %d", version)
+		}
+	}
+	if _, _, err := cfg.Downgrade(CurrentVersion); err != nil {
+		t.Error(err)
+	}
+}
@@ -13,11 +13,14 @@ import (
 	"fmt"
 	"path"
 	"path/filepath"
+	"reflect"
 	"sort"
+	"strconv"
 	"strings"
 	"time"
 
 	"github.com/shirou/gopsutil/v4/disk"
+	"golang.org/x/text/unicode/norm"
 
 	"github.com/syncthing/syncthing/lib/build"
 	"github.com/syncthing/syncthing/lib/db"
@@ -36,6 +39,7 @@ const (
 	EncryptionTokenName        = "syncthing-encryption_password_token"
 	maxConcurrentWritesDefault = 2
 	maxConcurrentWritesLimit   = 64
+	smallFileMaxKiBDefault     = 1024
 )
 
 func (f FolderConfiguration) Copy() FolderConfiguration {
@@ -43,6 +47,16 @@ func (f FolderConfiguration) Copy() FolderConfiguration {
 	c.Devices = make([]FolderDeviceConfiguration, len(f.Devices))
 	copy(c.Devices, f.Devices)
 	c.Versioning = f.Versioning.Copy()
+	c.OwnershipMapping.Users = make([]OwnershipMappingEntry, len(f.OwnershipMapping.Users))
+	copy(c.OwnershipMapping.Users, f.OwnershipMapping.Users)
+	c.OwnershipMapping.Groups = make([]OwnershipMappingEntry, len(f.OwnershipMapping.Groups))
+	copy(c.OwnershipMapping.Groups, f.OwnershipMapping.Groups)
+	c.CompletionWebhooks = make([]CompletionWebhook, len(f.CompletionWebhooks))
+	copy(c.CompletionWebhooks, f.CompletionWebhooks)
+	c.ContinuousProtectionPatterns = make([]string, len(f.ContinuousProtectionPatterns))
+	copy(c.ContinuousProtectionPatterns, f.ContinuousProtectionPatterns)
+	c.InheritedFields = make([]string, len(f.InheritedFields))
+	copy(c.InheritedFields, f.InheritedFields)
 	return c
 }
 
@@ -52,10 +66,37 @@ func (f FolderConfiguration) Copy() FolderConfiguration {
 func (f FolderConfiguration) Filesystem(fset *db.FileSet) fs.Filesystem {
 	// This is intentionally not a pointer method, because things like
 	// cfg.Folders["default"].Filesystem(nil) should be valid.
-	opts := make([]fs.Option, 0, 3)
+	return f.filesystem(fset, true)
+}
+
+// DiskFilesystem creates a filesystem for the path of this folder that
+// exposes file names exactly as they are on disk, without translating them
+// according to the Unicode normalization policy.
+func (f FolderConfiguration) DiskFilesystem() fs.Filesystem {
+	return f.filesystem(nil, false)
+}
+
+func (f FolderConfiguration) filesystem(fset *db.FileSet, translateNames bool) fs.Filesystem {
+	opts := make([]fs.Option, 0, 4)
 	if f.FilesystemType == fs.FilesystemTypeBasic && f.JunctionsAsDirs {
 		opts = append(opts, new(fs.OptionJunctionsAsDirs))
 	}
+	if f.FilesystemType == fs.FilesystemTypeBasic && build.IsWindows && f.PermissionsProfile.WindowsACL != "" {
+		opts = append(opts, &fs.OptionWindowsACL{SDDL: f.PermissionsProfile.WindowsACL})
+	}
+	if form, ok := f.NormalizationForm(); ok && translateNames && form != fs.NativeNormalization() {
+		opts = append(opts, &fs.OptionUnicodeNormalization{Form: form})
+	}
+	if build.IsWindows && translateNames {
+		switch f.WindowsNamePolicy {
+		case WindowsNamePolicyEscape:
+			opts = append(opts, new(fs.OptionEscapeWindowsNames))
+		case WindowsNamePolicyMap:
+			if fset != nil {
+				opts = append(opts, fset.WindowsNameMapOption())
+			}
+		}
+	}
 	if !f.CaseSensitiveFS {
 		opts = append(opts, new(fs.OptionDetectCaseConflicts))
 	}
@@ -65,20 +106,97 @@ func (f FolderConfiguration) Filesystem(fset *db.FileSet) fs.Filesystem {
 	return fs.NewFilesystem(f.FilesystemType, f.Path, opts...)
 }
 
+// HandlesWindowsNames returns true if names that are invalid on Windows can
+// be synced, by being stored on disk under a different name.
+func (f FolderConfiguration) HandlesWindowsNames() bool {
+	switch f.WindowsNamePolicy {
+	case WindowsNamePolicyEscape, WindowsNamePolicyMap:
+		return true
+	default:
+		return false
+	}
+}
+
+// NormalizationForm returns the Unicode normalization form file names are
+// stored in on disk, or false if names are preserved as they are.
+func (f FolderConfiguration) NormalizationForm() (norm.Form, bool) {
+	switch f.UnicodeNormalization {
+	case UnicodeNormalizationNfc:
+		return norm.NFC, true
+	case UnicodeNormalizationNfd:
+		return norm.NFD, true
+	case UnicodeNormalizationPreserve:
+		return 0, false
+	default:
+		return fs.NativeNormalization(), true
+	}
+}
+
+// ModTimeWindow returns the window within which modification times are
+// considered equal.
 func (f FolderConfiguration) ModTimeWindow() time.Duration {
-	dur := time.Duration(f.RawModTimeWindowS) * time.Second
-	if f.RawModTimeWindowS < 1 && build.IsAndroid {
-		if usage, err := disk.Usage(f.Filesystem(nil).URI()); err != nil {
-			dur = 2 * time.Second
-			l.Debugf(`Detecting FS at "%v" on android: Setting mtime window to 2s: err == "%v"`, f.Path, err)
-		} else if strings.HasPrefix(strings.ToLower(usage.Fstype), "ext2") || strings.HasPrefix(strings.ToLower(usage.Fstype), "ext3") || strings.HasPrefix(strings.ToLower(usage.Fstype), "ext4") {
-			l.Debugf(`Detecting FS at %v on android: Leaving mtime window at 0: usage.Fstype == "%v"`, f.Path, usage.Fstype)
-		} else {
-			dur = 2 * time.Second
-			l.Debugf(`Detecting FS at "%v" on android: Setting mtime window to 2s: usage.Fstype == "%v"`, f.Path, usage.Fstype)
-		}
+	return f.ModTimeComparison().Window
+}
+
+// ModTimeComparison returns how modification times are to be compared for
+// this folder. Unless a window is configured, it's the detected precision of
+// the filesystem, while a negative window means times must match exactly.
+// Daylight saving time offsets are ignored on filesystems with a precision
+// of two seconds, i.e. FAT, which store local time.
+func (f FolderConfiguration) ModTimeComparison() protocol.ModTimeComparison {
+	if f.RawModTimeWindowS > 0 {
+		return protocol.ModTimeComparison{Window: time.Duration(f.RawModTimeWindowS) * time.Second}
+	} else if f.RawModTimeWindowS < 0 {
+		return protocol.ModTimeComparison{}
 	}
-	return dur
+
+	dur, err := fs.DetectModTimePrecision(f.Filesystem(nil))
+	if err != nil {
+		l.Debugf(`Detecting mtime precision at "%v" failed: %v`, f.Path, err)
+		dur = f.fallbackModTimeWindow()
+	} else {
+		l.Debugf(`Detected mtime precision at "%v": %v`, f.Path, dur)
+	}
+	return protocol.ModTimeComparison{
+		Window:           dur,
+		IgnoreDSTOffsets: dur >= 2*time.Second,
+	}
+}
+
+// RotationalStorage returns whether the folder is on a rotational disk,
+// as configured or else detected. Storage that can't be detected is taken
+// to be solid state.
+func (f FolderConfiguration) RotationalStorage() bool {
+	switch f.StorageType {
+	case StorageTypeSSD:
+		return false
+	case StorageTypeHDD:
+		return true
+	}
+
+	rotational, err := fs.DetectRotational(f.Filesystem(nil))
+	if err != nil {
+		l.Debugf(`Detecting storage type at "%v" failed: %v`, f.Path, err)
+		return false
+	}
+	l.Debugf(`Detected storage type at "%v": rotational %v`, f.Path, rotational)
+	return rotational
+}
+
+// fallbackModTimeWindow guesses the mtime window when it can't be detected.
+func (f FolderConfiguration) fallbackModTimeWindow() time.Duration {
+	if !build.IsAndroid {
+		return 0
+	}
+	if usage, err := disk.Usage(f.Filesystem(nil).URI()); err != nil {
+		l.Debugf(`Detecting FS at "%v" on android: Setting mtime window to 2s: err == "%v"`, f.Path, err)
+	} else if strings.HasPrefix(strings.ToLower(usage.Fstype), "ext2") || strings.HasPrefix(strings.ToLower(usage.Fstype), "ext3") || strings.HasPrefix(strings.ToLower(usage.Fstype), "ext4") {
+		l.Debugf(`Detecting FS at %v on android: Leaving mtime window at 0: usage.Fstype == "%v"`, f.Path, usage.Fstype)
+		return 0
+	} else {
+		l.Debugf(`Detecting FS at "%v" on android: Setting mtime window to 2s: usage.Fstype == "%v"`, f.Path, usage.Fstype)
+	}
+	return 2 * time.Second
 }
 
 func (f *FolderConfiguration) CreateMarker() error {
@@ -244,6 +362,10 @@ func (f *FolderConfiguration) prepare(myID protocol.DeviceID, existingDevices ma
 		f.WeakHashThresholdPct = 25
 	}
 
+	for i := range f.CompletionWebhooks {
+		f.CompletionWebhooks[i].prepare()
+	}
+
 	if f.MarkerName == "" {
 		f.MarkerName = DefaultMarkerName
 	}
@@ -254,10 +376,92 @@ func (f *FolderConfiguration) prepare(myID protocol.DeviceID, existingDevices ma
 		f.MaxConcurrentWrites = maxConcurrentWritesLimit
 	}
 
+	if f.SmallFileLanePct < 0 {
+		f.SmallFileLanePct = 0
+	} else if f.SmallFileLanePct > 100 {
+		f.SmallFileLanePct = 100
+	}
+	if f.SmallFileMaxKiB <= 0 {
+		f.SmallFileMaxKiB = smallFileMaxKiBDefault
+	}
+
+	if f.BlocksPerFile < 0 {
+		f.BlocksPerFile = 0
+	}
+
 	if f.Type == FolderTypeReceiveEncrypted {
 		f.DisableTempIndexes = true
 		f.IgnorePerms = true
+		// Names are encrypted, so there is no subtree to match.
+		f.IndexSubtree = ""
 	}
+
+	// The subtree is matched against names as they are on the wire.
+	f.IndexSubtree = strings.Trim(path.Clean("/"+filepath.ToSlash(f.IndexSubtree)), "/")
+
+	if !f.Paused {
+		f.PausedReason = FolderPauseReasonNone
+	} else if f.PausedReason == FolderPauseReasonNone {
+		f.PausedReason = FolderPauseReasonUser
+	}
+}
+
+// Folder fields that identify the folder or describe its state rather than
+// configure it, and so can't be inherited from the defaults. The same goes
+// for fields tagged nodefault.
+var uninheritableFolderFields = map[string]bool{
+	"label":           true,
+	"path":            true,
+	"type":            true,
+	"devices":         true,
+	"paused":          true,
+	"pausedReason":    true,
+	"inheritedFields": true,
+}
+
+// inheritableFolderFields maps the JSON names of the fields that can be
+// inherited from the folder defaults to their index in the struct.
+var inheritableFolderFields = func() map[string]int {
+	t := reflect.TypeOf(FolderConfiguration{})
+	fields := make(map[string]int, t.NumField())
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if name == "" || name == "-" || field.Tag.Get("nodefault") != "" || uninheritableFolderFields[name] {
+			continue
+		}
+		fields[name] = i
+	}
+	return fields
+}()
+
+// inherit sets the inherited fields to their values in the given folder
+// defaults, dropping duplicates and fields that can't be inherited from
+// the list.
+func (f *FolderConfiguration) inherit(defaults FolderConfiguration) {
+	if len(f.InheritedFields) == 0 {
+		return
+	}
+
+	defaults = defaults.Copy()
+	from := reflect.ValueOf(&defaults).Elem()
+	to := reflect.ValueOf(f).Elem()
+	seen := make(map[string]bool, len(f.InheritedFields))
+	inherited := f.InheritedFields[:0]
+	for _, name := range f.InheritedFields {
+		i, ok := inheritableFolderFields[name]
+		if !ok {
+			l.Warnf("Folder %s: %q is not a field that can be inherited from the defaults", f.Description(), name)
+			continue
+		}
+		if seen[name] {
+			continue
+		}
+		seen[name] = true
+		to.Field(i).Set(from.Field(i))
+		inherited = append(inherited, name)
+	}
+	f.InheritedFields = inherited
 }
 
 // RequiresRestartOnly returns a copy with only the attributes that require
@@ -328,3 +532,71 @@ func (f XattrFilter) GetMaxSingleEntrySize() int {
 func (f XattrFilter) GetMaxTotalSize() int {
 	return f.MaxTotalSize
 }
+
+// IsSet returns true if the profile translates permission bits.
+func (p PermissionsProfile) IsSet() bool {
+	return p.FileMode != "" || p.DirMode != ""
+}
+
+// ToDisk returns the permission bits to set on disk for an item with the
+// given synced permission bits.
+func (p PermissionsProfile) ToDisk(perms uint32, isDir bool) uint32 {
+	if isDir {
+		if mode, ok := parseMode(p.DirMode); ok {
+			return mode
+		}
+		return perms
+	}
+	mode, ok := parseMode(p.FileMode)
+	if !ok {
+		return perms
+	}
+	mode &^= 0o111
+	if perms&0o111 != 0 {
+		// Executable for everyone who may read it.
+		mode |= (mode & 0o444) >> 2
+	}
+	return mode
+}
+
+func parseMode(s string) (uint32, bool) {
+	if s == "" {
+		return 0, false
+	}
+	mode, err := strconv.ParseUint(s, 8, 32)
+	if err != nil {
+		return 0, false
+	}
+	return uint32(mode) & 0o777, true
+}
+
+// MapUser returns the user, as a name or numeric ID, that a synced item
+// owned by the given user should be owned by on disk, or false if there is
+// no mapping for it. A negative id means the numeric ID is not known.
+func (m OwnershipMapping) MapUser(name string, id int) (string, bool) {
+	return mapOwnership(m.Users, name, id)
+}
+
+// MapGroup is like MapUser, for groups.
+func (m OwnershipMapping) MapGroup(name string, id int) (string, bool) {
+	return mapOwnership(m.Groups, name, id)
+}
+
+func mapOwnership(entries []OwnershipMappingEntry, name string, id int) (string, bool) {
+	if name != "" {
+		for _, entry := range entries {
+			if entry.From == name {
+				return entry.To, true
+			}
+		}
+	}
+	if id >= 0 {
+		idStr := strconv.Itoa(id)
+		for _, entry := range entries {
+			if entry.From == idStr {
+				return entry.To, true
+			}
+		}
+	}
+	return "", false
+}
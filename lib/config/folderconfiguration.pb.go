@@ -9,6 +9,7 @@ import (
 	proto "github.com/gogo/protobuf/proto"
 	fs "github.com/syncthing/syncthing/lib/fs"
 	github_com_syncthing_syncthing_lib_protocol "github.com/syncthing/syncthing/lib/protocol"
+	protocol "github.com/syncthing/syncthing/lib/protocol"
 	_ "github.com/syncthing/syncthing/proto/ext"
 	io "io"
 	math "math"
@@ -30,6 +31,13 @@ type FolderDeviceConfiguration struct {
 	DeviceID           github_com_syncthing_syncthing_lib_protocol.DeviceID `protobuf:"bytes,1,opt,name=device_id,json=deviceId,proto3,customtype=github.com/syncthing/syncthing/lib/protocol.DeviceID" json:"deviceID" xml:"id,attr"`
 	IntroducedBy       github_com_syncthing_syncthing_lib_protocol.DeviceID `protobuf:"bytes,2,opt,name=introduced_by,json=introducedBy,proto3,customtype=github.com/syncthing/syncthing/lib/protocol.DeviceID" json:"introducedBy" xml:"introducedBy,attr"`
 	EncryptionPassword string                                               `protobuf:"bytes,3,opt,name=encryption_password,json=encryptionPassword,proto3" json:"encryptionPassword" xml:"encryptionPassword"`
+	// Transfer quota for this device in the folder, in MiB per month
+	// counting both directions. Zero means unlimited.
+	MonthlyQuotaMiB int64 `protobuf:"varint,4,opt,name=monthly_quota_mib,json=monthlyQuotaMib,proto3" json:"monthlyQuotaMiB" xml:"monthlyQuotaMiB,attr,omitempty"`
+	// An index only device gets our index and may request files on demand,
+	// but is not expected to have the data, is never asked for data and its
+	// own changes are not accepted.
+	IndexOnly bool `protobuf:"varint,5,opt,name=index_only,json=indexOnly,proto3" json:"indexOnly" xml:"indexOnly,attr,omitempty"`
 }
 
 func (m *FolderDeviceConfiguration) Reset()         { *m = FolderDeviceConfiguration{} }
@@ -106,6 +114,65 @@ type FolderConfiguration struct {
 	SyncXattrs              bool                        `protobuf:"varint,37,opt,name=sync_xattrs,json=syncXattrs,proto3" json:"syncXattrs" xml:"syncXattrs"`
 	SendXattrs              bool                        `protobuf:"varint,38,opt,name=send_xattrs,json=sendXattrs,proto3" json:"sendXattrs" xml:"sendXattrs"`
 	XattrFilter             XattrFilter                 `protobuf:"bytes,39,opt,name=xattr_filter,json=xattrFilter,proto3" json:"xattrFilter" xml:"xattrFilter"`
+	ConflictPolicy          ConflictPolicy              `protobuf:"varint,41,opt,name=conflict_policy,json=conflictPolicy,proto3,enum=config.ConflictPolicy" json:"conflictPolicy" xml:"conflictPolicy"`
+	UnicodeNormalization    UnicodeNormalization        `protobuf:"varint,42,opt,name=unicode_normalization,json=unicodeNormalization,proto3,enum=config.UnicodeNormalization" json:"unicodeNormalization" xml:"unicodeNormalization"`
+	WindowsNamePolicy       WindowsNamePolicy           `protobuf:"varint,43,opt,name=windows_name_policy,json=windowsNamePolicy,proto3,enum=config.WindowsNamePolicy" json:"windowsNamePolicy" xml:"windowsNamePolicy"`
+	PermissionsProfile      PermissionsProfile          `protobuf:"bytes,44,opt,name=permissions_profile,json=permissionsProfile,proto3" json:"permissionsProfile" xml:"permissionsProfile"`
+	OwnershipMapping        OwnershipMapping            `protobuf:"bytes,45,opt,name=ownership_mapping,json=ownershipMapping,proto3" json:"ownershipMapping" xml:"ownershipMapping"`
+	SmallFileLanePct        int                         `protobuf:"varint,46,opt,name=small_file_lane_pct,json=smallFileLanePct,proto3,casttype=int" json:"smallFileLanePct" xml:"smallFileLanePct"`
+	SmallFileMaxKiB         int                         `protobuf:"varint,47,opt,name=small_file_max_kib,json=smallFileMaxKib,proto3,casttype=int" json:"smallFileMaxKiB" xml:"smallFileMaxKiB" default:"1024"`
+	CompletionWebhooks      []CompletionWebhook         `protobuf:"bytes,48,rep,name=completion_webhooks,json=completionWebhooks,proto3" json:"completionWebhooks" xml:"completionWebhook"`
+	InPlaceDelta            bool                        `protobuf:"varint,49,opt,name=in_place_delta,json=inPlaceDelta,proto3" json:"inPlaceDelta" xml:"inPlaceDelta"`
+	// Why the folder is paused; none when it isn't. Pausing without a reason
+	// is taken to be a user action.
+	PausedReason FolderPauseReason `protobuf:"varint,50,opt,name=paused_reason,json=pausedReason,proto3,enum=config.FolderPauseReason" json:"pausedReason" xml:"pausedReason"`
+	// Only get index entries at or under this path from other devices, for
+	// devices that need only part of a big folder. Empty means everything.
+	IndexSubtree string `protobuf:"bytes,51,opt,name=index_subtree,json=indexSubtree,proto3" json:"indexSubtree" xml:"indexSubtree,omitempty"`
+	// Send the versioning and ignore patterns along with the folder, for
+	// devices that have us as introducer to use when they add it.
+	PropagateDefaults bool `protobuf:"varint,52,opt,name=propagate_defaults,json=propagateDefaults,proto3" json:"propagateDefaults" xml:"propagateDefaults"`
+	// Keep every locally made version of the files matching these patterns, in
+	// ignore pattern syntax, snapshotting them on each watcher event instead of
+	// only versioning what is replaced when syncing. The snapshots are kept in
+	// .stcdp, the oldest removed beyond the quota in MiB.
+	ContinuousProtectionPatterns []string `protobuf:"bytes,53,rep,name=continuous_protection_patterns,json=continuousProtectionPatterns,proto3" json:"continuousProtectionPatterns" xml:"continuousProtectionPattern,omitempty"`
+	ContinuousProtectionQuotaMiB int64    `protobuf:"varint,54,opt,name=continuous_protection_quota_mib,json=continuousProtectionQuotaMib,proto3" json:"continuousProtectionQuotaMiB" xml:"continuousProtectionQuotaMiB" default:"1024"`
+	// The number of blocks to aim for at most per file, using larger blocks up
+	// to 16 MiB for larger files. Fewer, larger blocks shrink the index and the
+	// number of requests for folders of huge files. Zero means the default of
+	// 2000. The block size is recorded per file, so all devices handle it.
+	BlocksPerFile int `protobuf:"varint,55,opt,name=blocks_per_file,json=blocksPerFile,proto3,casttype=int" json:"blocksPerFile" xml:"blocksPerFile"`
+	// Fields, by their JSON name, that follow the folder defaults: they are
+	// set to the defaults' values whenever the configuration changes,
+	// instead of only when the folder is created. Set the field on the
+	// folder itself after removing it from here to override it again.
+	InheritedFields []string `protobuf:"bytes,56,rep,name=inherited_fields,json=inheritedFields,proto3" json:"inheritedFields" xml:"inheritedField,omitempty" restart:"false"`
+	// The kind of storage the folder is on, detected where possible when
+	// auto. Folders on rotational disks are hashed by one routine at a time
+	// reading each file start to end, unless the hashers are set, to avoid
+	// seeking; folders on solid state storage use all the hashers in
+	// parallel.
+	StorageType StorageType `protobuf:"varint,57,opt,name=storage_type,json=storageType,proto3,enum=config.StorageType" json:"storageType" xml:"storageType"`
+	// Removing the folder through the REST API needs a confirmation, and
+	// introducers don't unshare it.
+	Protected bool `protobuf:"varint,58,opt,name=protected,proto3" json:"protected" xml:"protected" restart:"false"`
+	// The hash function for the blocks of new and changed files. Anything
+	// but SHA-256 is only used once all devices sharing the folder have
+	// been seen to support it, falling back to SHA-256 otherwise.
+	HashAlgorithm protocol.BlockHashAlgorithm `protobuf:"varint,59,opt,name=hash_algorithm,json=hashAlgorithm,proto3,enum=protocol.BlockHashAlgorithm" json:"hashAlgorithm" xml:"hashAlgorithm"`
+	// Rescan only the paths the filesystem's change journal recorded as
+	// changed since the last scan, instead of walking the whole folder.
+	// That's the USN journal of NTFS volumes on Windows, needing
+	// administrator privileges, and fanotify on Linux, needing the
+	// CAP_SYS_ADMIN capability and only recording changes made while
+	// Syncthing runs. Full scans are done when it's unavailable or reset.
+	ChangeJournalEnabled bool `protobuf:"varint,60,opt,name=change_journal_enabled,json=changeJournalEnabled,proto3" json:"changeJournalEnabled" xml:"changeJournalEnabled"`
+	// Interpret .stignore with the syntax and semantics of .gitignore files,
+	// so that an existing .gitignore can be used as is: the last matching
+	// pattern decides, nothing in an excluded directory can be included
+	// again, and there are no includes or (?i) and (?d) prefixes.
+	GitignoreSyntax bool `protobuf:"varint,61,opt,name=gitignore_syntax,json=gitignoreSyntax,proto3" json:"gitignoreSyntax" xml:"gitignoreSyntax"`
 	// Legacy deprecated
 	DeprecatedReadOnly       bool    `protobuf:"varint,9000,opt,name=read_only,json=readOnly,proto3" json:"-" xml:"ro,attr,omitempty"`                       // Deprecated: Do not use.
 	DeprecatedMinDiskFreePct float64 `protobuf:"fixed64,9001,opt,name=min_disk_free_pct,json=minDiskFreePct,proto3" json:"-" xml:"minDiskFreePct,omitempty"` // Deprecated: Do not use.
@@ -229,11 +296,140 @@ func (m *XattrFilterEntry) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_XattrFilterEntry proto.InternalMessageInfo
 
+// Permissions profile, translating the synced permission bits to the ones
+// set on disk. Modes are given as octal strings, e.g. "0644". An empty mode
+// means the synced permission bits are used as is. For files, the execute
+// bits of the mode are set according to whether the synced file is
+// executable. On Windows an access control list template, in SDDL format,
+// can be given to be set on items when their permissions are set.
+type PermissionsProfile struct {
+	FileMode   string `protobuf:"bytes,1,opt,name=file_mode,json=fileMode,proto3" json:"fileMode" xml:"fileMode,omitempty"`
+	DirMode    string `protobuf:"bytes,2,opt,name=dir_mode,json=dirMode,proto3" json:"dirMode" xml:"dirMode,omitempty"`
+	WindowsACL string `protobuf:"bytes,3,opt,name=windows_acl,json=windowsAcl,proto3" json:"windowsACL" xml:"windowsACL,omitempty"`
+}
+
+func (m *PermissionsProfile) Reset()         { *m = PermissionsProfile{} }
+func (m *PermissionsProfile) String() string { return proto.CompactTextString(m) }
+func (*PermissionsProfile) ProtoMessage()    {}
+func (*PermissionsProfile) Descriptor() ([]byte, []int) {
+	return fileDescriptor_44a9785876ed3afa, []int{4}
+}
+func (m *PermissionsProfile) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PermissionsProfile) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PermissionsProfile.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PermissionsProfile) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PermissionsProfile.Merge(m, src)
+}
+func (m *PermissionsProfile) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *PermissionsProfile) XXX_DiscardUnknown() {
+	xxx_messageInfo_PermissionsProfile.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PermissionsProfile proto.InternalMessageInfo
+
+// Ownership mapping, translating the owner and group of synced items to the
+// ones set on disk when syncing ownership. Entries match on the user or
+// group name first, then on the numeric ID. The target can likewise be a
+// name or a numeric ID. Items without a matching entry keep their owner and
+// group.
+type OwnershipMapping struct {
+	Users  []OwnershipMappingEntry `protobuf:"bytes,1,rep,name=users,proto3" json:"users" xml:"user"`
+	Groups []OwnershipMappingEntry `protobuf:"bytes,2,rep,name=groups,proto3" json:"groups" xml:"group"`
+}
+
+func (m *OwnershipMapping) Reset()         { *m = OwnershipMapping{} }
+func (m *OwnershipMapping) String() string { return proto.CompactTextString(m) }
+func (*OwnershipMapping) ProtoMessage()    {}
+func (*OwnershipMapping) Descriptor() ([]byte, []int) {
+	return fileDescriptor_44a9785876ed3afa, []int{5}
+}
+func (m *OwnershipMapping) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OwnershipMapping) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OwnershipMapping.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OwnershipMapping) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OwnershipMapping.Merge(m, src)
+}
+func (m *OwnershipMapping) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *OwnershipMapping) XXX_DiscardUnknown() {
+	xxx_messageInfo_OwnershipMapping.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OwnershipMapping proto.InternalMessageInfo
+
+type OwnershipMappingEntry struct {
+	From string `protobuf:"bytes,1,opt,name=from,proto3" json:"from" xml:"from,attr"`
+	To   string `protobuf:"bytes,2,opt,name=to,proto3" json:"to" xml:"to,attr"`
+}
+
+func (m *OwnershipMappingEntry) Reset()         { *m = OwnershipMappingEntry{} }
+func (m *OwnershipMappingEntry) String() string { return proto.CompactTextString(m) }
+func (*OwnershipMappingEntry) ProtoMessage()    {}
+func (*OwnershipMappingEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_44a9785876ed3afa, []int{6}
+}
+func (m *OwnershipMappingEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OwnershipMappingEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OwnershipMappingEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OwnershipMappingEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OwnershipMappingEntry.Merge(m, src)
+}
+func (m *OwnershipMappingEntry) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *OwnershipMappingEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_OwnershipMappingEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OwnershipMappingEntry proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*FolderDeviceConfiguration)(nil), "config.FolderDeviceConfiguration")
 	proto.RegisterType((*FolderConfiguration)(nil), "config.FolderConfiguration")
 	proto.RegisterType((*XattrFilter)(nil), "config.XattrFilter")
 	proto.RegisterType((*XattrFilterEntry)(nil), "config.XattrFilterEntry")
+	proto.RegisterType((*PermissionsProfile)(nil), "config.PermissionsProfile")
+	proto.RegisterType((*OwnershipMapping)(nil), "config.OwnershipMapping")
+	proto.RegisterType((*OwnershipMappingEntry)(nil), "config.OwnershipMappingEntry")
 }
 
 func init() {
@@ -241,161 +437,238 @@ func init() {
 }
 
 var fileDescriptor_44a9785876ed3afa = []byte{
-	// 2460 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x58, 0x4d, 0x6c, 0x24, 0x47,
-	0x15, 0x76, 0xdb, 0xfb, 0x63, 0x97, 0xd7, 0x5e, 0xbb, 0x6c, 0xef, 0x76, 0x9c, 0xc4, 0x35, 0xe9,
-	0xcc, 0x26, 0x93, 0x90, 0x78, 0x37, 0x4e, 0x14, 0x29, 0x11, 0x01, 0x32, 0x76, 0x46, 0x2c, 0x8b,
-	0xb3, 0x56, 0x8f, 0x21, 0x90, 0x20, 0x35, 0xed, 0xee, 0x9a, 0x99, 0x8e, 0x7b, 0xba, 0x87, 0xae,
-	0xf6, 0xda, 0xb3, 0x87, 0x28, 0xe4, 0x80, 0x90, 0xc8, 0x01, 0x99, 0x03, 0xe2, 0x80, 0x14, 0x09,
-	0x84, 0x20, 0x5c, 0x38, 0x73, 0xe1, 0xba, 0x17, 0x64, 0x9f, 0x10, 0xe2, 0x50, 0x52, 0xbc, 0xb7,
-	0x39, 0xf6, 0x71, 0x4f, 0xe8, 0xbd, 0xfe, 0x99, 0xea, 0x99, 0x89, 0x84, 0xc4, 0x6d, 0xea, 0xfb,
-	0x5e, 0xbd, 0xf7, 0x75, 0xd5, 0xab, 0x57, 0xaf, 0x86, 0x54, 0x7d, 0xef, 0xe0, 0xb6, 0x13, 0x06,
-	0x2d, 0xaf, 0x7d, 0xbb, 0x15, 0xfa, 0x2e, 0x8f, 0xd2, 0xc1, 0x51, 0x64, 0xc7, 0x5e, 0x18, 0x6c,
-	0xf6, 0xa2, 0x30, 0x0e, 0xe9, 0x95, 0x14, 0x5c, 0x7f, 0x7a, 0xcc, 0x3a, 0xee, 0xf7, 0x78, 0x6a,
-	0xb4, 0xbe, 0xa6, 0x90, 0xc2, 0x7b, 0x98, 0xc3, 0xeb, 0x0a, 0xdc, 0x3b, 0xf2, 0xfd, 0x30, 0x72,
-	0x79, 0x94, 0x71, 0x35, 0x85, 0x7b, 0xc0, 0x23, 0xe1, 0x85, 0x81, 0x17, 0xb4, 0x27, 0x28, 0x58,
-	0x67, 0x8a, 0xe5, 0x81, 0x1f, 0x3a, 0x87, 0xa3, 0xae, 0x28, 0x18, 0xb4, 0xc4, 0x6d, 0x10, 0x24,
-	0x32, 0xec, 0x99, 0x0c, 0x73, 0xc2, 0x5e, 0x3f, 0xb2, 0x83, 0x36, 0xef, 0xf2, 0xb8, 0x13, 0xba,
-	0x19, 0x3b, 0xc7, 0x4f, 0xe2, 0xf4, 0xa7, 0xf1, 0xaf, 0x19, 0xf2, 0x54, 0x03, 0xbf, 0x67, 0x87,
-	0x3f, 0xf0, 0x1c, 0xbe, 0xad, 0x2a, 0xa0, 0x5f, 0x6a, 0x64, 0xce, 0x45, 0xdc, 0xf2, 0x5c, 0x5d,
-	0xab, 0x68, 0xb5, 0x6b, 0xf5, 0xcf, 0xb5, 0x47, 0x92, 0x4d, 0xfd, 0x47, 0xb2, 0x37, 0xda, 0x5e,
-	0xdc, 0x39, 0x3a, 0xd8, 0x74, 0xc2, 0xee, 0x6d, 0xd1, 0x0f, 0x9c, 0xb8, 0xe3, 0x05, 0x6d, 0xe5,
-	0x17, 0x48, 0xc0, 0x20, 0x4e, 0xe8, 0x6f, 0xa6, 0xde, 0xef, 0xee, 0x5c, 0x48, 0x36, 0x9b, 0xff,
-	0x1e, 0x48, 0x36, 0xeb, 0x66, 0xbf, 0x13, 0xc9, 0x16, 0x4e, 0xba, 0xfe, 0xdb, 0x86, 0xe7, 0xbe,
-	0x62, 0xc7, 0x71, 0x64, 0x0c, 0xce, 0xaa, 0x57, 0xb3, 0xdf, 0xc9, 0x59, 0xb5, 0xb0, 0xfb, 0xe5,
-	0x79, 0x55, 0x3b, 0x3d, 0xaf, 0x16, 0x3e, 0xcc, 0x9c, 0x71, 0xe9, 0x9f, 0x34, 0xb2, 0xe0, 0x05,
-	0x71, 0x14, 0xba, 0x47, 0x0e, 0x77, 0xad, 0x83, 0xbe, 0x3e, 0x8d, 0x82, 0x3f, 0xfd, 0xbf, 0x04,
-	0x0f, 0x24, 0xbb, 0x36, 0xf4, 0x5a, 0xef, 0x27, 0x92, 0xdd, 0x4c, 0x85, 0x2a, 0x60, 0x21, 0x79,
-	0x79, 0x0c, 0x05, 0xc1, 0x66, 0xc9, 0x03, 0x75, 0xc8, 0x0a, 0x0f, 0x9c, 0xa8, 0xdf, 0x83, 0x35,
-	0xb6, 0x7a, 0xb6, 0x10, 0xc7, 0x61, 0xe4, 0xea, 0x33, 0x15, 0xad, 0x36, 0x57, 0xdf, 0x1a, 0x48,
-	0x46, 0x87, 0xf4, 0x5e, 0xc6, 0x26, 0x92, 0xe9, 0x18, 0x76, 0x9c, 0x32, 0xcc, 0x09, 0xf6, 0xc6,
-	0x3f, 0x6e, 0x91, 0x95, 0x74, 0x63, 0xcb, 0x5b, 0xda, 0x24, 0xd3, 0xd9, 0x56, 0xce, 0xd5, 0xb7,
-	0x2f, 0x24, 0x9b, 0xc6, 0x4f, 0x9c, 0xf6, 0x20, 0xc2, 0x46, 0x69, 0x07, 0x2a, 0x41, 0xe8, 0xf2,
-	0x96, 0x7d, 0xe4, 0xc7, 0x6f, 0x1b, 0x71, 0x74, 0xc4, 0xd5, 0x2d, 0x39, 0x3d, 0xaf, 0x4e, 0xdf,
-	0xdd, 0xf9, 0x02, 0xbe, 0x6d, 0xda, 0x73, 0xe9, 0x0f, 0xc8, 0x65, 0xdf, 0x3e, 0xe0, 0x3e, 0xae,
-	0xf8, 0x5c, 0xfd, 0xdb, 0x03, 0xc9, 0x52, 0x20, 0x91, 0xac, 0x82, 0x4e, 0x71, 0x94, 0xf9, 0x8d,
-	0xb8, 0x88, 0xed, 0x28, 0x7e, 0xdb, 0x68, 0xd9, 0xbe, 0x40, 0xb7, 0x64, 0x48, 0x7f, 0x7a, 0x5e,
-	0x9d, 0x32, 0xd3, 0xc9, 0xb4, 0x4d, 0xae, 0xb7, 0x3c, 0x9f, 0x8b, 0xbe, 0x88, 0x79, 0xd7, 0x82,
-	0xfc, 0xc6, 0x45, 0x5a, 0xdc, 0xa2, 0x9b, 0x2d, 0xb1, 0xd9, 0x28, 0xa8, 0xfd, 0x7e, 0x8f, 0xd7,
-	0x5f, 0x1e, 0x48, 0xb6, 0xd8, 0x2a, 0x61, 0x89, 0x64, 0xab, 0x18, 0xbd, 0x0c, 0x1b, 0xe6, 0x88,
-	0x1d, 0xdd, 0x25, 0x97, 0x7a, 0x76, 0xdc, 0xd1, 0x2f, 0xa1, 0xfc, 0xb7, 0x06, 0x92, 0xe1, 0x38,
-	0x91, 0xec, 0x69, 0x9c, 0x0f, 0x83, 0x4c, 0x7c, 0xb1, 0x24, 0x9f, 0x80, 0xf0, 0xb9, 0x82, 0x79,
-	0x72, 0x56, 0xd5, 0x3e, 0x31, 0x71, 0x1a, 0xdd, 0x23, 0x97, 0x50, 0xec, 0xe5, 0x4c, 0x6c, 0x7a,
-	0x7a, 0x37, 0xd3, 0xed, 0x40, 0xb1, 0x35, 0x08, 0x11, 0xa7, 0x12, 0xaf, 0x63, 0x08, 0x18, 0x14,
-	0x69, 0x34, 0x57, 0x8c, 0x4c, 0xb4, 0xa2, 0x3f, 0x21, 0x57, 0xd3, 0x3c, 0x17, 0xfa, 0x95, 0xca,
-	0x4c, 0x6d, 0x7e, 0xeb, 0xb9, 0xb2, 0xd3, 0x09, 0x87, 0xb7, 0xce, 0x20, 0xed, 0x07, 0x92, 0xe5,
-	0x33, 0x13, 0xc9, 0xae, 0x61, 0xa8, 0x74, 0x6c, 0x98, 0x39, 0x41, 0x7f, 0xa3, 0x91, 0xe5, 0x88,
-	0x0b, 0xc7, 0x0e, 0x2c, 0x2f, 0x88, 0x79, 0xf4, 0xc0, 0xf6, 0x2d, 0xa1, 0x5f, 0xad, 0x68, 0xb5,
-	0xcb, 0xf5, 0xf6, 0x40, 0xb2, 0xeb, 0x29, 0x79, 0x37, 0xe3, 0x9a, 0x89, 0x64, 0x2f, 0xa1, 0xa7,
-	0x11, 0x7c, 0x74, 0x89, 0x5e, 0x7f, 0xf3, 0xce, 0x1d, 0xe3, 0x89, 0x64, 0x33, 0x5e, 0x10, 0x0f,
-	0xce, 0xaa, 0xab, 0x93, 0xcc, 0x9f, 0x9c, 0x55, 0x2f, 0x81, 0x9d, 0x39, 0x1a, 0x84, 0xfe, 0x5d,
-	0x23, 0xb4, 0x25, 0xac, 0x63, 0x3b, 0x76, 0x3a, 0x3c, 0xb2, 0x78, 0x60, 0x1f, 0xf8, 0xdc, 0xd5,
-	0x67, 0x2b, 0x5a, 0x6d, 0xb6, 0xfe, 0x2b, 0xed, 0x42, 0xb2, 0xa5, 0x46, 0xf3, 0x83, 0x94, 0x7d,
-	0x2f, 0x25, 0x07, 0x92, 0x2d, 0xb5, 0x44, 0x19, 0x4b, 0x24, 0x7b, 0x39, 0x4d, 0x82, 0x11, 0x62,
-	0x54, 0x6d, 0x9e, 0xe3, 0x6b, 0x13, 0x0d, 0x41, 0x27, 0x58, 0x9c, 0x9e, 0x57, 0xc7, 0xc2, 0x9a,
-	0x63, 0x41, 0xe9, 0xdf, 0xca, 0xe2, 0x5d, 0xee, 0xdb, 0x7d, 0x4b, 0xe8, 0x73, 0x15, 0xad, 0xa6,
-	0xd5, 0x3f, 0x03, 0xf1, 0xd7, 0x0b, 0x2f, 0x3b, 0x40, 0x36, 0x61, 0x9d, 0x0b, 0x37, 0x29, 0x94,
-	0x48, 0xf6, 0x62, 0x59, 0x7a, 0x8a, 0x8f, 0x2a, 0x7f, 0xed, 0x0e, 0xe8, 0x5e, 0x9d, 0x64, 0xf5,
-	0xe4, 0xac, 0x3a, 0xfd, 0xda, 0x9d, 0xd3, 0xf3, 0xea, 0x68, 0x38, 0x73, 0x34, 0x18, 0x14, 0xfb,
-	0x55, 0x45, 0x72, 0xec, 0x75, 0x79, 0x78, 0x14, 0x5b, 0x42, 0xaf, 0xa1, 0xe8, 0xfe, 0x85, 0x64,
-	0xcb, 0x85, 0x93, 0xfd, 0x94, 0x05, 0xd5, 0xcb, 0x85, 0xa3, 0x1c, 0x4c, 0x24, 0x7b, 0xa6, 0xac,
-	0x3b, 0x67, 0x8a, 0x0c, 0xbf, 0x31, 0x99, 0x3a, 0x3d, 0xaf, 0x8e, 0xc7, 0x30, 0xc7, 0x23, 0xd0,
-	0x9f, 0x92, 0x6b, 0x5e, 0x3b, 0x08, 0x23, 0x6e, 0xf5, 0x78, 0xd4, 0x15, 0x3a, 0xc1, 0xac, 0x78,
-	0x67, 0x20, 0xd9, 0x7c, 0x8a, 0xef, 0x01, 0x9c, 0x48, 0x76, 0x23, 0xad, 0x69, 0x43, 0xac, 0x90,
-	0xb0, 0x34, 0x0a, 0x9a, 0xea, 0x54, 0xfa, 0x73, 0x8d, 0x2c, 0xda, 0x47, 0x71, 0x68, 0x05, 0x61,
-	0xd4, 0xb5, 0x7d, 0xef, 0x21, 0xd7, 0xe7, 0x31, 0xc8, 0x87, 0x03, 0xc9, 0x16, 0x80, 0x79, 0x3f,
-	0x27, 0x8a, 0x7d, 0x2a, 0xa1, 0x5f, 0x97, 0x5f, 0x74, 0xdc, 0x2a, 0x4f, 0x2e, 0xb3, 0xec, 0x97,
-	0x86, 0x64, 0xa1, 0xeb, 0x05, 0x96, 0xeb, 0x89, 0x43, 0xab, 0x15, 0x71, 0xae, 0x5f, 0xab, 0x68,
-	0xb5, 0xf9, 0xad, 0x6b, 0xf9, 0xe1, 0x6f, 0x7a, 0x0f, 0x79, 0xfd, 0x9d, 0xec, 0x9c, 0xcf, 0x77,
-	0xbd, 0x60, 0xc7, 0x13, 0x87, 0x8d, 0x88, 0x83, 0x22, 0x86, 0x8a, 0x14, 0x4c, 0x4d, 0x98, 0xca,
-	0x2d, 0xe3, 0xc9, 0x59, 0x75, 0xe6, 0xb5, 0xca, 0x2d, 0x53, 0x9d, 0x46, 0xdb, 0x84, 0x0c, 0xbb,
-	0x11, 0x7d, 0x01, 0xa3, 0xb1, 0x3c, 0xda, 0x0f, 0x0b, 0xa6, 0x5c, 0x68, 0x5e, 0xc8, 0x04, 0x28,
-	0x53, 0x13, 0xc9, 0x96, 0x30, 0xfe, 0x10, 0x32, 0x4c, 0x85, 0xa7, 0xef, 0x90, 0xab, 0x4e, 0xd8,
-	0xf3, 0x78, 0x24, 0xf4, 0x45, 0xac, 0x33, 0xcf, 0x43, 0xa5, 0xca, 0xa0, 0xa2, 0x19, 0xc8, 0xc6,
-	0x79, 0x0d, 0x31, 0x73, 0x03, 0xfa, 0x4f, 0x8d, 0xdc, 0x80, 0x3e, 0x88, 0x47, 0x56, 0xd7, 0x3e,
-	0xb1, 0x7a, 0x3c, 0x70, 0xbd, 0xa0, 0x6d, 0x1d, 0x7a, 0x07, 0xfa, 0x75, 0x74, 0xf7, 0x5b, 0x38,
-	0x62, 0x2b, 0x7b, 0x68, 0xb2, 0x6b, 0x9f, 0xec, 0xa5, 0x06, 0xf7, 0xbc, 0xfa, 0x40, 0xb2, 0x95,
-	0xde, 0x38, 0x9c, 0x48, 0xf6, 0x54, 0x5a, 0xea, 0xc7, 0x39, 0xa5, 0x84, 0x4d, 0x9c, 0x3a, 0x19,
-	0x3e, 0x3d, 0xaf, 0x4e, 0x8a, 0x6f, 0x4e, 0xb0, 0x3d, 0x80, 0xe5, 0xe8, 0xd8, 0xa2, 0x03, 0xcb,
-	0xb1, 0x34, 0x5c, 0x8e, 0x0c, 0x2a, 0x96, 0x23, 0x1b, 0x0f, 0x97, 0x23, 0x03, 0xe8, 0xbb, 0xe4,
-	0x32, 0x76, 0x84, 0xfa, 0x32, 0xde, 0x38, 0xcb, 0xf9, 0x8e, 0x41, 0xfc, 0xfb, 0x40, 0xd4, 0x75,
-	0xb8, 0x92, 0xd1, 0x26, 0x91, 0x6c, 0x1e, 0xbd, 0xe1, 0xc8, 0x30, 0x53, 0x94, 0xde, 0x23, 0x0b,
-	0xd9, 0x81, 0x72, 0xb9, 0xcf, 0x63, 0xae, 0x53, 0x4c, 0xf6, 0x17, 0xb0, 0xff, 0x41, 0x62, 0x07,
-	0xf1, 0x44, 0x32, 0xaa, 0x1c, 0xa9, 0x14, 0x34, 0xcc, 0x92, 0x0d, 0x3d, 0x21, 0x3a, 0xde, 0x26,
-	0xbd, 0x28, 0x6c, 0x47, 0x5c, 0x08, 0xf5, 0x5a, 0x59, 0xc1, 0xef, 0x83, 0x16, 0x61, 0x0d, 0x6c,
-	0xf6, 0x32, 0x13, 0xf5, 0x72, 0x49, 0x2f, 0xdd, 0x89, 0x6c, 0xf1, 0xed, 0x93, 0x27, 0xd3, 0x26,
-	0x59, 0xcc, 0xf2, 0xa2, 0x67, 0x1f, 0x09, 0x6e, 0x09, 0x7d, 0x15, 0xe3, 0xbd, 0x0a, 0xdf, 0x91,
-	0x32, 0x7b, 0x40, 0x34, 0x8b, 0xef, 0x50, 0xc1, 0xc2, 0x7b, 0xc9, 0x94, 0x72, 0xb2, 0x00, 0x59,
-	0x06, 0x8b, 0xea, 0x7b, 0x4e, 0x2c, 0xf4, 0x35, 0xf4, 0xf9, 0x1d, 0xf0, 0xd9, 0xb5, 0x4f, 0xb6,
-	0x73, 0x7c, 0x78, 0xea, 0x14, 0xb0, 0x5c, 0xa7, 0xb3, 0x00, 0x69, 0x59, 0x36, 0x4b, 0xb3, 0xa9,
-	0x4b, 0x56, 0x5d, 0x4f, 0xc0, 0xfd, 0x61, 0x89, 0x9e, 0x1d, 0x09, 0x6e, 0x61, 0x9b, 0xa2, 0xdf,
-	0xc0, 0x9d, 0xc0, 0xc6, 0x30, 0xe3, 0x9b, 0x48, 0x63, 0x03, 0x54, 0x34, 0x86, 0xe3, 0x94, 0x61,
-	0x4e, 0xb0, 0x57, 0xa3, 0xc4, 0xbc, 0xdb, 0xb3, 0xbc, 0xc0, 0xe5, 0x27, 0x5c, 0xe8, 0x37, 0xc7,
-	0xa2, 0xec, 0xf3, 0x6e, 0xef, 0x6e, 0xca, 0x8e, 0x46, 0x51, 0xa8, 0x61, 0x14, 0x05, 0xa4, 0x5b,
-	0xe4, 0x0a, 0x6e, 0x80, 0xab, 0xeb, 0xe8, 0x77, 0x7d, 0x20, 0x59, 0x86, 0x14, 0x7d, 0x48, 0x3a,
-	0x34, 0xcc, 0x0c, 0xa7, 0x31, 0xb9, 0x79, 0xcc, 0xed, 0x43, 0x0b, 0xb2, 0xda, 0x8a, 0x3b, 0x11,
-	0x17, 0x9d, 0xd0, 0x77, 0xad, 0x9e, 0x13, 0xeb, 0x4f, 0xe1, 0x82, 0x43, 0x79, 0x5f, 0x05, 0x93,
-	0xef, 0xda, 0xa2, 0xb3, 0x9f, 0x1b, 0xec, 0x39, 0x71, 0x22, 0xd9, 0x3a, 0xba, 0x9c, 0x44, 0x16,
-	0x9b, 0x3a, 0x71, 0x2a, 0xdd, 0x26, 0xf3, 0x5d, 0x3b, 0x3a, 0xe4, 0x91, 0x15, 0xd8, 0x5d, 0xae,
-	0xaf, 0x63, 0x0b, 0x68, 0x40, 0x39, 0x4b, 0xe1, 0xf7, 0xed, 0x2e, 0x2f, 0xca, 0xd9, 0x10, 0x32,
-	0x4c, 0x85, 0xa7, 0x7d, 0xb2, 0x0e, 0x4f, 0x2d, 0x2b, 0x3c, 0x0e, 0x78, 0x24, 0x3a, 0x5e, 0xcf,
-	0x6a, 0x45, 0x61, 0xd7, 0xea, 0xd9, 0x11, 0x0f, 0x62, 0xfd, 0x69, 0x5c, 0x82, 0x6f, 0x0e, 0x24,
-	0xbb, 0x09, 0x56, 0xf7, 0x73, 0xa3, 0x46, 0x14, 0x76, 0xf7, 0xd0, 0x24, 0x91, 0xec, 0xd9, 0xbc,
-	0xe2, 0x4d, 0xe2, 0x0d, 0xf3, 0xeb, 0x66, 0xd2, 0x5f, 0x68, 0x64, 0xb9, 0x1b, 0xba, 0x78, 0x5f,
-	0x5b, 0xc7, 0x5e, 0xe0, 0x86, 0xc7, 0x96, 0xd0, 0x9f, 0xc1, 0x05, 0xfb, 0x08, 0xee, 0x6c, 0xd3,
-	0x3e, 0xde, 0x0d, 0x5d, 0xb8, 0x39, 0x3f, 0x40, 0x16, 0xee, 0xec, 0xc5, 0x6e, 0x09, 0x29, 0x1a,
-	0xe5, 0x32, 0x9c, 0xaf, 0x1c, 0xdc, 0xca, 0x63, 0x5e, 0xcc, 0x11, 0x1f, 0xf4, 0x53, 0x8d, 0xac,
-	0x65, 0xc7, 0xc4, 0x39, 0x8a, 0x40, 0x9b, 0x75, 0x1c, 0x79, 0x31, 0x17, 0xfa, 0xb3, 0x28, 0xe6,
-	0xfb, 0x50, 0x7a, 0xd3, 0x84, 0xcf, 0xf8, 0x0f, 0x90, 0x4e, 0x24, 0xbb, 0xa5, 0x9c, 0x9a, 0x12,
-	0xa7, 0x1c, 0x9e, 0x2d, 0xe5, 0xec, 0x68, 0x5b, 0xe6, 0x24, 0x4f, 0x50, 0xc4, 0xf2, 0xdc, 0x6e,
-	0xc1, 0xbb, 0x4e, 0xdf, 0x18, 0x16, 0xb1, 0x8c, 0x68, 0x00, 0x5e, 0x1c, 0x7e, 0x15, 0x34, 0xcc,
-	0x92, 0x0d, 0xf5, 0xc9, 0x12, 0xbe, 0xb7, 0x2d, 0xa8, 0x05, 0x56, 0x5a, 0x5f, 0x19, 0xd6, 0xd7,
-	0x1b, 0x79, 0x7d, 0xad, 0x03, 0x3f, 0x2c, 0xb2, 0xf8, 0x04, 0x39, 0x28, 0x61, 0xc5, 0xca, 0x96,
-	0x61, 0xc3, 0x1c, 0xb1, 0xa3, 0x9f, 0x6b, 0x64, 0x19, 0x53, 0x08, 0x9f, 0xeb, 0x56, 0xfa, 0x5e,
-	0xd7, 0x2b, 0x18, 0x6f, 0x05, 0x9e, 0x3b, 0xdb, 0x61, 0xaf, 0x6f, 0x02, 0xb7, 0x8b, 0x54, 0xfd,
-	0x1e, 0x34, 0x8c, 0x4e, 0x19, 0x4c, 0x24, 0xab, 0x15, 0x69, 0xa4, 0xe0, 0xca, 0x32, 0x8a, 0xd8,
-	0x0e, 0x5c, 0x3b, 0x72, 0xe1, 0xfe, 0x9f, 0xcd, 0x07, 0xe6, 0xa8, 0x23, 0xfa, 0x47, 0x90, 0x63,
-	0x43, 0x01, 0xe5, 0x81, 0xf0, 0x62, 0xef, 0x01, 0xac, 0xa8, 0xfe, 0x1c, 0x2e, 0xe7, 0x09, 0x74,
-	0xaf, 0xdb, 0xb6, 0xe0, 0xcd, 0x9c, 0x6b, 0x60, 0xf7, 0xea, 0x94, 0xa1, 0x44, 0xb2, 0xb5, 0x54,
-	0x4c, 0x19, 0x87, 0x1e, 0x68, 0xcc, 0x76, 0x1c, 0x82, 0x9e, 0x75, 0x24, 0x88, 0x39, 0x62, 0x23,
-	0xe8, 0x1f, 0x34, 0xb2, 0xd4, 0x0a, 0x7d, 0x3f, 0x3c, 0xb6, 0x3e, 0x3e, 0x0a, 0x1c, 0x68, 0x47,
-	0x84, 0x6e, 0x0c, 0x55, 0x7e, 0x2f, 0x07, 0xdf, 0x15, 0x3b, 0x5e, 0x24, 0x40, 0xe5, 0xc7, 0x65,
-	0xa8, 0x50, 0x39, 0x82, 0xa3, 0xca, 0x51, 0xdb, 0x71, 0x08, 0x54, 0x8e, 0x04, 0x31, 0xaf, 0xa7,
-	0x8a, 0x0a, 0x98, 0xde, 0x27, 0x8b, 0x90, 0x51, 0xc3, 0xea, 0xa0, 0x3f, 0x8f, 0x12, 0xe1, 0x15,
-	0xb8, 0x00, 0x4c, 0x71, 0xae, 0x13, 0xc9, 0x56, 0xd2, 0xcb, 0x4f, 0x45, 0x0d, 0xb3, 0x6c, 0x85,
-	0x0e, 0x79, 0xe0, 0x2a, 0x0e, 0xab, 0x8a, 0x43, 0x1e, 0xb8, 0x13, 0x1c, 0xaa, 0x28, 0x38, 0x54,
-	0xc7, 0x50, 0x04, 0x51, 0xe1, 0x09, 0x74, 0xa3, 0x42, 0xbf, 0x85, 0xde, 0xb0, 0x08, 0x02, 0xfc,
-	0x23, 0x44, 0x8b, 0x22, 0x38, 0x84, 0x0c, 0x53, 0xe1, 0xd1, 0x09, 0xa8, 0xca, 0x9c, 0xbc, 0xa0,
-	0x38, 0xe1, 0x81, 0x3b, 0xea, 0xa4, 0x80, 0xc0, 0x49, 0x31, 0x80, 0xc6, 0x1e, 0xe7, 0xc3, 0xdd,
-	0x17, 0xf3, 0x48, 0x7f, 0x11, 0x7b, 0xd0, 0x95, 0xfc, 0xc4, 0xa1, 0x55, 0x03, 0xa9, 0x7a, 0x2d,
-	0x6f, 0x7c, 0x4f, 0x86, 0x60, 0x22, 0xd9, 0x32, 0xfa, 0x57, 0x30, 0xc3, 0x54, 0x2d, 0xe8, 0x21,
-	0x99, 0x8b, 0xb8, 0xed, 0x5a, 0x61, 0xe0, 0xf7, 0xf5, 0x3f, 0x37, 0x50, 0xe5, 0xee, 0x85, 0x64,
-	0x74, 0x87, 0xf7, 0x22, 0xee, 0xd8, 0x31, 0x77, 0x4d, 0x6e, 0xbb, 0xf7, 0x03, 0xbf, 0x3f, 0x90,
-	0x4c, 0x7b, 0xb5, 0xf8, 0xc7, 0x27, 0x0a, 0xb1, 0x59, 0x7f, 0x25, 0xec, 0x7a, 0x70, 0x73, 0xc6,
-	0x7d, 0xfc, 0xc7, 0x67, 0x0c, 0xd5, 0x35, 0x73, 0x36, 0xca, 0x1c, 0xd0, 0x9f, 0x91, 0xe5, 0x52,
-	0x07, 0x8f, 0xb7, 0xd9, 0x5f, 0x1a, 0xf8, 0xa2, 0x7a, 0xef, 0x42, 0x32, 0x7d, 0x18, 0x74, 0x77,
-	0xd8, 0x87, 0xef, 0x39, 0x71, 0x1e, 0x7a, 0x63, 0xb4, 0x8d, 0xdf, 0x73, 0x62, 0x45, 0x81, 0xae,
-	0x99, 0x8b, 0x65, 0x92, 0xfe, 0x98, 0x5c, 0x4d, 0xbb, 0x17, 0xa1, 0x7f, 0xd9, 0xc0, 0xca, 0xfb,
-	0x2d, 0xb8, 0x06, 0x86, 0x81, 0xd2, 0xae, 0x54, 0x94, 0x3f, 0x2e, 0x9b, 0xa2, 0xb8, 0xce, 0xca,
-	0xad, 0xae, 0x99, 0xb9, 0x3f, 0x7a, 0x48, 0x16, 0xb1, 0xaf, 0x1b, 0xe6, 0xdd, 0x5f, 0xd3, 0xf5,
-	0xdb, 0xbe, 0x90, 0xec, 0xe6, 0x30, 0x42, 0xd3, 0xb1, 0x83, 0x22, 0xb9, 0xf2, 0x38, 0xcf, 0x16,
-	0x5d, 0x5d, 0x41, 0x95, 0x3f, 0x64, 0xa1, 0xc4, 0x19, 0x9f, 0xcd, 0x90, 0x79, 0x65, 0xbb, 0xe9,
-	0x47, 0xe4, 0x2a, 0x0f, 0xe2, 0xc8, 0xe3, 0x42, 0xd7, 0xf0, 0x3f, 0x10, 0x7d, 0x42, 0x52, 0xbc,
-	0x17, 0xc4, 0x51, 0xbf, 0xfe, 0x62, 0xfe, 0xd7, 0x47, 0x36, 0xa1, 0xe8, 0x79, 0x61, 0x8c, 0xdb,
-	0x76, 0x19, 0x7f, 0x99, 0xb9, 0x01, 0xfd, 0x5d, 0x76, 0x79, 0x09, 0x2f, 0x68, 0xfb, 0xdc, 0x42,
-	0xd6, 0x12, 0xf0, 0xe8, 0x9b, 0xc6, 0x25, 0x6c, 0x41, 0x5f, 0xd4, 0xb5, 0x4f, 0x9a, 0xc8, 0x63,
-	0x94, 0xa6, 0xfa, 0xf2, 0x1b, 0xa7, 0x4a, 0x7d, 0xdf, 0xd6, 0x1b, 0xca, 0x23, 0x62, 0x82, 0x1f,
-	0x78, 0x00, 0x82, 0x95, 0x39, 0x81, 0xa3, 0x0f, 0xc9, 0x22, 0x48, 0x8b, 0xc3, 0x18, 0x1a, 0x68,
-	0xd0, 0x34, 0x83, 0x9a, 0xf6, 0xb3, 0xfe, 0x73, 0x1f, 0x88, 0x4c, 0xcd, 0x73, 0xb9, 0x9a, 0x02,
-	0x54, 0x74, 0xbc, 0x71, 0xe7, 0xad, 0x37, 0x15, 0x1d, 0xa5, 0xb9, 0xa0, 0x00, 0x78, 0xb3, 0x84,
-	0x1a, 0xbf, 0xd7, 0xc8, 0xd2, 0xe8, 0xf2, 0xc2, 0x73, 0xa3, 0x0b, 0xef, 0xf1, 0xec, 0x6f, 0xc4,
-	0x6f, 0xc0, 0xdb, 0x02, 0x01, 0xa5, 0x4f, 0x8a, 0x9d, 0x4e, 0xf1, 0xd2, 0x26, 0xc3, 0xa1, 0x99,
-	0x1a, 0xd2, 0x06, 0xb9, 0x02, 0x0f, 0x77, 0x2f, 0xc6, 0xf5, 0x9d, 0xad, 0x6f, 0x62, 0x7f, 0x88,
-	0x48, 0x71, 0x84, 0xd3, 0x61, 0xe1, 0x65, 0x5e, 0x19, 0x9b, 0x99, 0x6d, 0xfd, 0xde, 0xa3, 0xaf,
-	0x36, 0xa6, 0xce, 0xbf, 0xda, 0x98, 0x7a, 0x74, 0xb1, 0xa1, 0x9d, 0x5f, 0x6c, 0x68, 0xbf, 0x7e,
-	0xbc, 0x31, 0xf5, 0xc5, 0xe3, 0x0d, 0xed, 0xfc, 0xf1, 0xc6, 0xd4, 0xbf, 0x1f, 0x6f, 0x4c, 0x7d,
-	0xf8, 0xd2, 0xff, 0xf0, 0xaf, 0x6f, 0x9a, 0x47, 0x07, 0x57, 0xf0, 0xdf, 0xdf, 0xd7, 0xff, 0x1b,
-	0x00, 0x00, 0xff, 0xff, 0x68, 0x19, 0x36, 0x63, 0x1b, 0x18, 0x00, 0x00,
+	// 3693 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x5a, 0x4d, 0x6c, 0xe4, 0x46,
+	0x76, 0x1e, 0x6a, 0x7e, 0x55, 0x1a, 0xfd, 0x95, 0xe6, 0x87, 0x33, 0x3b, 0x16, 0x65, 0xba, 0xc7,
+	0x96, 0xff, 0x34, 0x33, 0xf2, 0xec, 0x24, 0x9e, 0xb5, 0x37, 0x71, 0x8f, 0x2c, 0xc4, 0x3f, 0xb2,
+	0x95, 0xd2, 0xec, 0xce, 0x66, 0xbd, 0x01, 0x43, 0x91, 0xd5, 0x6a, 0x5a, 0x6c, 0xb2, 0x97, 0xc5,
+	0xb6, 0xd4, 0x0e, 0xb0, 0x70, 0x36, 0x40, 0xb0, 0x41, 0x16, 0xc8, 0x62, 0x02, 0x6c, 0x90, 0x43,
+	0x80, 0x05, 0xf2, 0x83, 0x64, 0x73, 0xc9, 0x39, 0x87, 0x00, 0x41, 0x72, 0x30, 0x10, 0x04, 0xd2,
+	0x31, 0x48, 0x10, 0x02, 0x2b, 0xdf, 0xfa, 0xd8, 0xc7, 0x39, 0x05, 0xef, 0x15, 0x59, 0x2c, 0xb2,
+	0x39, 0x13, 0x03, 0x39, 0x35, 0xeb, 0xfb, 0x5e, 0xbd, 0xf7, 0x58, 0xac, 0x7a, 0xf5, 0xea, 0x55,
+	0x93, 0x56, 0x18, 0xec, 0xde, 0xf2, 0xe2, 0xa8, 0x13, 0xec, 0xdd, 0xea, 0xc4, 0xa1, 0xcf, 0x13,
+	0xd9, 0x18, 0x24, 0x6e, 0x1a, 0xc4, 0xd1, 0x5a, 0x3f, 0x89, 0xd3, 0x98, 0x9e, 0x93, 0xe0, 0xf5,
+	0x6f, 0x4c, 0x48, 0xa7, 0xc3, 0x3e, 0x97, 0x42, 0xd7, 0x2f, 0x6b, 0xa4, 0x08, 0x3e, 0x2f, 0xe0,
+	0xeb, 0x1a, 0xdc, 0x1f, 0x84, 0x61, 0x9c, 0xf8, 0x3c, 0xc9, 0xb9, 0x55, 0x8d, 0xfb, 0x8c, 0x27,
+	0x22, 0x88, 0xa3, 0x20, 0xda, 0x6b, 0xf0, 0xe0, 0xba, 0xa5, 0x49, 0xee, 0x86, 0xb1, 0xb7, 0x5f,
+	0x57, 0xa5, 0x0b, 0xc0, 0x4f, 0x18, 0x78, 0x69, 0x3f, 0x0e, 0x03, 0x6f, 0x98, 0x0b, 0xdc, 0xd4,
+	0x04, 0x06, 0x51, 0xe0, 0xc5, 0x3e, 0x8f, 0xe2, 0xa4, 0xe7, 0x86, 0xc1, 0xe7, 0xba, 0x21, 0x5b,
+	0x13, 0x3b, 0x08, 0x22, 0x3f, 0x3e, 0x10, 0x91, 0xdb, 0xe3, 0x15, 0x55, 0x76, 0xc5, 0x56, 0xaf,
+	0x1f, 0x72, 0x50, 0x70, 0xc0, 0x77, 0xbb, 0x71, 0xbc, 0xdf, 0x20, 0x23, 0x87, 0xaa, 0xef, 0x0e,
+	0x04, 0x4f, 0xb8, 0x2b, 0x94, 0xad, 0x1b, 0xfa, 0x88, 0xa5, 0x71, 0xe2, 0xee, 0x71, 0x6d, 0x3c,
+	0x29, 0xb0, 0x1d, 0x71, 0x0b, 0x20, 0xa1, 0xf7, 0xe8, 0x88, 0x5b, 0x5e, 0xdc, 0x1f, 0x26, 0x6e,
+	0xb4, 0xc7, 0x7b, 0x3c, 0xed, 0xc6, 0x7e, 0xce, 0x5e, 0x01, 0x16, 0x1f, 0xbd, 0x38, 0xbc, 0xb5,
+	0xcb, 0xfb, 0x39, 0x3e, 0xcd, 0x0f, 0x53, 0xf9, 0x68, 0xff, 0xe4, 0x1c, 0xb9, 0xb6, 0x89, 0xee,
+	0x6c, 0xf0, 0xcf, 0x02, 0x8f, 0x3f, 0xd0, 0xc7, 0x9a, 0xfe, 0xd2, 0x20, 0xd3, 0x3e, 0xe2, 0x4e,
+	0xe0, 0x9b, 0xc6, 0x8a, 0xb1, 0x7a, 0xb1, 0xfd, 0x53, 0xe3, 0xcb, 0xcc, 0x3a, 0xf5, 0x5f, 0x99,
+	0x75, 0x77, 0x2f, 0x48, 0xbb, 0x83, 0xdd, 0x35, 0x2f, 0xee, 0xdd, 0x12, 0xc3, 0xc8, 0x4b, 0xbb,
+	0x41, 0xb4, 0xa7, 0x3d, 0xe9, 0xc6, 0xd7, 0xa4, 0xf6, 0xf7, 0x36, 0x4e, 0x32, 0xeb, 0x42, 0xf1,
+	0x3c, 0xca, 0xac, 0x0b, 0x7e, 0xfe, 0x3c, 0xce, 0xac, 0xd9, 0xc3, 0x5e, 0x78, 0xdf, 0x0e, 0xfc,
+	0xd7, 0xdc, 0x34, 0x4d, 0xec, 0xd1, 0x51, 0xeb, 0x7c, 0xfe, 0x3c, 0x3e, 0x6a, 0x29, 0xb9, 0x9f,
+	0x1c, 0xb7, 0x8c, 0xc7, 0xc7, 0x2d, 0xa5, 0x83, 0x15, 0x8c, 0x4f, 0xff, 0xd6, 0x20, 0xb3, 0x41,
+	0x94, 0x26, 0xb1, 0x3f, 0xf0, 0xb8, 0xef, 0xec, 0x0e, 0xcd, 0x29, 0x74, 0xf8, 0x8b, 0xff, 0x97,
+	0xc3, 0xa3, 0xcc, 0xba, 0x58, 0x6a, 0x6d, 0x0f, 0xc7, 0x99, 0x75, 0x55, 0x3a, 0xaa, 0x81, 0xca,
+	0xe5, 0xc5, 0x09, 0x14, 0x1c, 0x66, 0x15, 0x0d, 0xd4, 0x23, 0x4b, 0x3c, 0xf2, 0x92, 0x61, 0x1f,
+	0xc6, 0xd8, 0xe9, 0xbb, 0x42, 0x1c, 0xc4, 0x89, 0x6f, 0x9e, 0x5e, 0x31, 0x56, 0xa7, 0xdb, 0xeb,
+	0xa3, 0xcc, 0xa2, 0x25, 0xbd, 0x9d, 0xb3, 0xe3, 0xcc, 0x32, 0xd1, 0xec, 0x24, 0x65, 0xb3, 0x06,
+	0x79, 0xfa, 0xaf, 0x06, 0x59, 0xec, 0xc5, 0x51, 0xda, 0x0d, 0x87, 0xce, 0x0f, 0x07, 0x71, 0xea,
+	0x3a, 0xbd, 0x60, 0xd7, 0x3c, 0xb3, 0x62, 0xac, 0x9e, 0x6e, 0xff, 0xdc, 0x38, 0xc9, 0xac, 0xf9,
+	0x2d, 0xc9, 0xfe, 0x36, 0x90, 0x5b, 0x41, 0x7b, 0x94, 0x59, 0xf3, 0xbd, 0x2a, 0x34, 0xce, 0xac,
+	0x16, 0x1a, 0xad, 0xe1, 0xf8, 0x62, 0xaf, 0xc5, 0xbd, 0x20, 0xe5, 0xbd, 0x7e, 0x3a, 0x84, 0x17,
+	0x5f, 0x7e, 0xb6, 0xc8, 0xf8, 0xa8, 0x55, 0x57, 0xfe, 0xf8, 0xb8, 0x55, 0x77, 0x81, 0xd5, 0x64,
+	0x76, 0xe9, 0xa7, 0x84, 0x04, 0x91, 0xcf, 0x0f, 0x9d, 0x38, 0x0a, 0x87, 0xe6, 0xd9, 0x15, 0x63,
+	0xf5, 0x42, 0xfb, 0x83, 0x51, 0x66, 0x4d, 0x23, 0xfa, 0x71, 0x14, 0xc2, 0xf7, 0x58, 0xce, 0xbf,
+	0x47, 0x8e, 0x34, 0x78, 0x67, 0x3e, 0x8d, 0x64, 0xa5, 0x22, 0xfb, 0x5f, 0xee, 0x93, 0x25, 0xb9,
+	0x14, 0xaa, 0x8b, 0x60, 0x87, 0x4c, 0xe5, 0x93, 0x7f, 0xba, 0xfd, 0xe0, 0x24, 0xb3, 0xa6, 0x70,
+	0x52, 0x4c, 0x05, 0x7e, 0x69, 0x3a, 0x9f, 0xb3, 0x2b, 0x51, 0xec, 0xf3, 0x8e, 0x3b, 0x08, 0xd3,
+	0xfb, 0x76, 0x9a, 0x0c, 0xb8, 0x3e, 0x89, 0x1f, 0x1f, 0xb7, 0xa6, 0xde, 0xdb, 0xf8, 0x05, 0xcc,
+	0x86, 0xa9, 0xc0, 0xa7, 0xdf, 0x21, 0x67, 0x43, 0x77, 0x97, 0x87, 0x38, 0x47, 0xa7, 0xdb, 0xbf,
+	0x31, 0xca, 0x2c, 0x09, 0x8c, 0x33, 0x6b, 0x05, 0x95, 0x62, 0x2b, 0xd7, 0x9b, 0x70, 0x91, 0xba,
+	0x49, 0x7a, 0xdf, 0xee, 0xb8, 0xa1, 0x40, 0xb5, 0xa4, 0xa4, 0xbf, 0x38, 0x6e, 0x9d, 0x62, 0xb2,
+	0x33, 0xdd, 0x23, 0xf3, 0x9d, 0x20, 0xe4, 0x62, 0x28, 0x52, 0xde, 0x73, 0x20, 0x52, 0xe0, 0xb4,
+	0x9a, 0x5b, 0xa7, 0x6b, 0x1d, 0xb1, 0xb6, 0xa9, 0xa8, 0x87, 0xc3, 0x3e, 0x6f, 0xbf, 0x32, 0xca,
+	0xac, 0xb9, 0x4e, 0x05, 0x1b, 0x67, 0xd6, 0x25, 0xb4, 0x5e, 0x85, 0x6d, 0x56, 0x93, 0xa3, 0x5b,
+	0xe4, 0x4c, 0xdf, 0x4d, 0xbb, 0x38, 0xa1, 0xa6, 0xdb, 0x6f, 0x8e, 0x32, 0x0b, 0xdb, 0xe3, 0xcc,
+	0xfa, 0x06, 0xf6, 0x87, 0x46, 0xee, 0xbc, 0x1a, 0x92, 0x1f, 0x81, 0xe3, 0xd3, 0x8a, 0x79, 0x72,
+	0xd4, 0x32, 0x7e, 0xc4, 0xb0, 0x1b, 0xdd, 0x26, 0x67, 0xd0, 0xd9, 0xb3, 0xb9, 0xb3, 0x32, 0x08,
+	0xae, 0xc9, 0xcf, 0x81, 0xce, 0xae, 0x82, 0x89, 0x54, 0xba, 0x38, 0x8f, 0x26, 0xa0, 0xa1, 0x16,
+	0xde, 0xb4, 0x6a, 0x31, 0x94, 0xa2, 0x3f, 0x20, 0xe7, 0x65, 0x64, 0x10, 0xe6, 0xb9, 0x95, 0xd3,
+	0xab, 0x33, 0xeb, 0xcf, 0x57, 0x95, 0x36, 0x84, 0xbb, 0xb6, 0x05, 0x81, 0x62, 0x94, 0x59, 0x45,
+	0xcf, 0x71, 0x66, 0x5d, 0x44, 0x53, 0xb2, 0x6d, 0xb3, 0x82, 0xa0, 0x7f, 0x66, 0x90, 0xc5, 0x84,
+	0x0b, 0xcf, 0x8d, 0x9c, 0x20, 0x4a, 0x79, 0xf2, 0x99, 0x1b, 0x3a, 0xc2, 0x3c, 0xbf, 0x62, 0xac,
+	0x9e, 0x6d, 0xef, 0xc1, 0x4a, 0x92, 0xe4, 0x7b, 0x39, 0xb7, 0x33, 0xce, 0xac, 0x97, 0x51, 0x53,
+	0x0d, 0xaf, 0x0f, 0xd1, 0x1b, 0xf7, 0x6e, 0xdf, 0xb6, 0x9f, 0x64, 0xd6, 0xe9, 0x20, 0x4a, 0x47,
+	0x47, 0xad, 0x4b, 0x4d, 0xe2, 0x4f, 0x8e, 0x5a, 0x67, 0x40, 0x8e, 0xd5, 0x8d, 0xd0, 0x7f, 0x32,
+	0x08, 0xed, 0x08, 0xe7, 0xc0, 0x4d, 0xbd, 0x2e, 0x4f, 0x1c, 0x1e, 0xb9, 0xbb, 0x21, 0xf7, 0xcd,
+	0x0b, 0xb8, 0x6c, 0xfe, 0x04, 0x16, 0xfd, 0xc2, 0xe6, 0xce, 0x23, 0xc9, 0xbe, 0x2b, 0xc9, 0x51,
+	0x66, 0x2d, 0x74, 0x44, 0x15, 0x1b, 0x67, 0xd6, 0x2b, 0x72, 0x12, 0xd4, 0x88, 0xba, 0xb7, 0xc5,
+	0x1c, 0xbf, 0xdc, 0x28, 0x08, 0x7e, 0x82, 0xc4, 0xe3, 0xe3, 0xd6, 0x84, 0x59, 0x36, 0x61, 0x94,
+	0xfe, 0x63, 0xd5, 0x79, 0x9f, 0x87, 0xee, 0xd0, 0x11, 0xe6, 0xf4, 0x8a, 0xb1, 0x6a, 0xb4, 0x7f,
+	0x8c, 0x11, 0x4b, 0x69, 0xd9, 0x00, 0x72, 0x07, 0xc6, 0xb9, 0x23, 0x2a, 0xd0, 0x38, 0xb3, 0x5e,
+	0xaa, 0xba, 0x2e, 0xf1, 0xba, 0xe7, 0x77, 0x6e, 0x83, 0xdf, 0x97, 0x9a, 0xa4, 0x9e, 0x1c, 0xb5,
+	0xa6, 0xee, 0xdc, 0x86, 0xe8, 0x54, 0x33, 0xc7, 0xea, 0xc6, 0x60, 0x7b, 0xbc, 0xa4, 0xb9, 0x9c,
+	0x06, 0x3d, 0x1e, 0x0f, 0x52, 0x47, 0x98, 0xab, 0xe8, 0xf4, 0xf0, 0x24, 0xb3, 0x16, 0x95, 0x92,
+	0x87, 0x92, 0x05, 0xaf, 0x17, 0x3b, 0xa2, 0x06, 0x8e, 0x33, 0xeb, 0x46, 0xd5, 0xef, 0x82, 0x51,
+	0x33, 0xfc, 0x4a, 0x33, 0xf5, 0xf8, 0xb8, 0x35, 0x69, 0x83, 0x4d, 0x5a, 0xa0, 0xbf, 0x47, 0x2e,
+	0x06, 0x7b, 0x51, 0x9c, 0x70, 0xa7, 0xcf, 0x93, 0x9e, 0x30, 0x09, 0xce, 0x8a, 0xb7, 0x47, 0x99,
+	0x35, 0x23, 0xf1, 0x6d, 0x80, 0xc7, 0x99, 0x75, 0x45, 0xc6, 0xb4, 0x12, 0x53, 0x2e, 0x2c, 0xd4,
+	0x41, 0xa6, 0x77, 0xa5, 0x7f, 0x60, 0x90, 0x39, 0x77, 0x90, 0xc6, 0x4e, 0x91, 0x47, 0x71, 0x73,
+	0x06, 0x8d, 0x7c, 0x7f, 0x94, 0x59, 0xb3, 0xc0, 0x7c, 0x54, 0x10, 0xea, 0x3b, 0x55, 0xd0, 0xa7,
+	0xcd, 0x2f, 0x3a, 0x29, 0x55, 0x4c, 0x2e, 0x56, 0xd5, 0x4b, 0x63, 0x32, 0xdb, 0x0b, 0x22, 0xc7,
+	0x0f, 0xc4, 0xbe, 0xd3, 0x49, 0x38, 0x37, 0x2f, 0xae, 0x18, 0xab, 0x33, 0xeb, 0x17, 0x8b, 0xc5,
+	0xbf, 0x13, 0x7c, 0xce, 0xdb, 0x6f, 0xe7, 0xeb, 0x7c, 0xa6, 0x17, 0x44, 0x1b, 0x81, 0xd8, 0xdf,
+	0x4c, 0x38, 0x78, 0x64, 0xc9, 0xbd, 0xae, 0xc4, 0xf4, 0x09, 0xb3, 0x72, 0xd3, 0x7e, 0x72, 0xd4,
+	0x3a, 0x7d, 0x67, 0xe5, 0x26, 0xd3, 0xbb, 0xd1, 0x3d, 0x42, 0xca, 0x4c, 0xd5, 0x9c, 0x45, 0x6b,
+	0x56, 0x61, 0xed, 0xbb, 0x8a, 0xa9, 0x06, 0x9a, 0x17, 0x73, 0x07, 0xb4, 0xae, 0xe3, 0xcc, 0x5a,
+	0x40, 0xfb, 0x25, 0x64, 0x33, 0x8d, 0xa7, 0x6f, 0x93, 0xf3, 0x5e, 0xdc, 0x0f, 0x78, 0x22, 0xcc,
+	0x39, 0x8c, 0x33, 0x2f, 0x40, 0xa4, 0xca, 0x21, 0x95, 0x3e, 0xe5, 0xed, 0x22, 0x86, 0xb0, 0x42,
+	0x80, 0xfe, 0x87, 0x41, 0xae, 0x40, 0x8e, 0xcc, 0x13, 0xa7, 0xe7, 0x1e, 0x3a, 0x7d, 0x1e, 0xf9,
+	0x41, 0xb4, 0xe7, 0xec, 0x07, 0xbb, 0xe6, 0x3c, 0xaa, 0xfb, 0x73, 0x58, 0x62, 0x4b, 0xdb, 0x28,
+	0xb2, 0xe5, 0x1e, 0x6e, 0x4b, 0x81, 0x0f, 0x30, 0x31, 0x58, 0xea, 0x4f, 0xc2, 0xe3, 0xcc, 0xba,
+	0x26, 0x43, 0xfd, 0x24, 0xa7, 0x85, 0xb0, 0xc6, 0xae, 0xcd, 0xf0, 0xe3, 0xe3, 0x56, 0x93, 0x7d,
+	0xd6, 0x20, 0xbb, 0x0b, 0xc3, 0xd1, 0x75, 0x45, 0x17, 0x86, 0x63, 0xa1, 0x1c, 0x8e, 0x1c, 0x52,
+	0xc3, 0x91, 0xb7, 0xcb, 0xe1, 0xc8, 0x01, 0xfa, 0x0e, 0x39, 0x8b, 0xa7, 0x05, 0x73, 0x11, 0x77,
+	0x9c, 0xc5, 0xe2, 0x8b, 0x81, 0xfd, 0x8f, 0x81, 0x68, 0x9b, 0xb0, 0x25, 0xa3, 0xcc, 0x38, 0xb3,
+	0x66, 0x50, 0x1b, 0xb6, 0x6c, 0x26, 0x51, 0xfa, 0x01, 0x99, 0xcd, 0x17, 0x94, 0xcf, 0x43, 0x9e,
+	0x72, 0x93, 0xe2, 0x64, 0x7f, 0x11, 0x33, 0x46, 0x24, 0x36, 0x10, 0x1f, 0x67, 0x16, 0xd5, 0x96,
+	0x94, 0x04, 0x6d, 0x56, 0x91, 0xa1, 0x87, 0xc4, 0xc4, 0xdd, 0xa4, 0x9f, 0xc4, 0x7b, 0x09, 0x17,
+	0x42, 0xdf, 0x56, 0x96, 0xf0, 0xfd, 0x20, 0x45, 0xb8, 0x0c, 0x32, 0xdb, 0xb9, 0x88, 0xbe, 0xb9,
+	0xc8, 0x4d, 0xb7, 0x91, 0x55, 0xef, 0xde, 0xdc, 0x99, 0xee, 0x90, 0xb9, 0x7c, 0x5e, 0xe0, 0x81,
+	0xc4, 0x11, 0xe6, 0x25, 0xb4, 0xf7, 0x3a, 0xbc, 0x87, 0x64, 0xb6, 0x81, 0xd8, 0x51, 0xef, 0xa1,
+	0x83, 0x4a, 0x7b, 0x45, 0x94, 0x72, 0x32, 0x0b, 0xb3, 0xac, 0x38, 0x78, 0x09, 0xf3, 0x32, 0xea,
+	0xfc, 0x4d, 0xd0, 0xd9, 0x73, 0x0f, 0x1f, 0x14, 0x78, 0xb9, 0xea, 0x34, 0xb0, 0x1a, 0xa7, 0x73,
+	0x03, 0x32, 0x2c, 0xb3, 0x4a, 0x6f, 0xea, 0x93, 0x4b, 0x7e, 0x20, 0x60, 0xff, 0x70, 0x44, 0xdf,
+	0x4d, 0x04, 0x77, 0x30, 0x4d, 0x31, 0xaf, 0xe0, 0x97, 0xc0, 0x54, 0x3a, 0xe7, 0x77, 0x90, 0xc6,
+	0x04, 0x48, 0xa5, 0xd2, 0x93, 0x94, 0xcd, 0x1a, 0xe4, 0x75, 0x2b, 0x90, 0x35, 0x3a, 0x98, 0x32,
+	0x72, 0x61, 0x5e, 0x9d, 0xb0, 0xf2, 0x90, 0xf7, 0xfa, 0xef, 0x49, 0xb6, 0x6e, 0x45, 0xa3, 0x4a,
+	0x2b, 0x1a, 0x48, 0xd7, 0xc9, 0x39, 0xfc, 0x00, 0xbe, 0x69, 0xa2, 0xde, 0xeb, 0xa3, 0xcc, 0xca,
+	0x11, 0x95, 0x87, 0xc8, 0xa6, 0xcd, 0x72, 0x9c, 0xa6, 0xe4, 0xea, 0x01, 0x77, 0xf7, 0x1d, 0x98,
+	0xd5, 0x4e, 0xda, 0x4d, 0xb8, 0xe8, 0xc6, 0xa1, 0xef, 0xf4, 0xbd, 0xd4, 0xbc, 0x86, 0x03, 0x0e,
+	0xe1, 0xfd, 0x12, 0x88, 0xfc, 0x96, 0x2b, 0xba, 0x0f, 0x0b, 0x81, 0x6d, 0x2f, 0x1d, 0x67, 0xd6,
+	0x75, 0x54, 0xd9, 0x44, 0xaa, 0x8f, 0xda, 0xd8, 0x95, 0x3e, 0x20, 0x33, 0x3d, 0x37, 0xd9, 0xe7,
+	0x89, 0x03, 0x27, 0x61, 0xf3, 0x3a, 0xa6, 0x80, 0x36, 0x84, 0x33, 0x09, 0x7f, 0xe4, 0xf6, 0xb8,
+	0x0a, 0x67, 0x25, 0x64, 0x33, 0x8d, 0xa7, 0x43, 0x72, 0x1d, 0x0e, 0xad, 0x4e, 0x7c, 0x10, 0xf1,
+	0x44, 0x74, 0x83, 0xbe, 0xd3, 0x49, 0xe2, 0x9e, 0xd3, 0x77, 0x13, 0x1e, 0xa5, 0xe6, 0x37, 0x70,
+	0x08, 0xde, 0x1a, 0x65, 0xd6, 0x55, 0x90, 0xfa, 0xb8, 0x10, 0xda, 0x4c, 0xe2, 0xde, 0x36, 0x8a,
+	0x8c, 0x33, 0xeb, 0xb9, 0x22, 0xe2, 0x35, 0xf1, 0x36, 0x7b, 0x5a, 0x4f, 0xfa, 0x47, 0x78, 0x34,
+	0xf2, 0x71, 0xbf, 0x76, 0xe4, 0x99, 0xde, 0x11, 0xe6, 0x0d, 0x1c, 0xb0, 0x4f, 0x60, 0xcf, 0x66,
+	0xee, 0xc1, 0x56, 0xec, 0xc3, 0xce, 0xf9, 0x08, 0x59, 0xd8, 0xb3, 0xe7, 0x7a, 0x15, 0x44, 0x25,
+	0xca, 0x55, 0xb8, 0x18, 0x39, 0xd8, 0x95, 0x27, 0xb4, 0xb0, 0x9a, 0x0e, 0xfa, 0x85, 0x41, 0x2e,
+	0xe7, 0xcb, 0xc4, 0x1b, 0x24, 0xe0, 0x9b, 0x73, 0x90, 0x04, 0x29, 0x17, 0xe6, 0x73, 0xe8, 0xcc,
+	0x87, 0x10, 0x7a, 0xe5, 0x84, 0xcf, 0xf9, 0x47, 0x48, 0x8f, 0x33, 0xeb, 0xa6, 0xb6, 0x6a, 0x2a,
+	0x9c, 0xb6, 0x78, 0xd6, 0xb5, 0xb5, 0x63, 0xac, 0xb3, 0x26, 0x4d, 0x10, 0xc4, 0x8a, 0xb9, 0xdd,
+	0x81, 0x93, 0xb0, 0xb9, 0x5c, 0x06, 0xb1, 0x9c, 0xd8, 0x04, 0x5c, 0x2d, 0x7e, 0x1d, 0xb4, 0x59,
+	0x45, 0x86, 0x86, 0x64, 0x01, 0x6b, 0x31, 0x0e, 0xc4, 0x02, 0x47, 0xc6, 0x57, 0x0b, 0xe3, 0xeb,
+	0x95, 0x22, 0xbe, 0xb6, 0x81, 0x2f, 0x83, 0x2c, 0x1e, 0x41, 0x76, 0x2b, 0x98, 0x1a, 0xd9, 0x2a,
+	0x6c, 0xb3, 0x9a, 0x1c, 0xfd, 0xa9, 0x41, 0x16, 0x71, 0x0a, 0x61, 0xe1, 0xc3, 0x91, 0x95, 0x0f,
+	0x73, 0x05, 0xed, 0x2d, 0xc1, 0x71, 0xe7, 0x41, 0xdc, 0x1f, 0x32, 0xe0, 0xb6, 0x90, 0xc2, 0x83,
+	0xe3, 0xbc, 0x57, 0x05, 0xc7, 0x99, 0xb5, 0xaa, 0xa6, 0x91, 0x86, 0x6b, 0xc3, 0x28, 0x52, 0x37,
+	0xf2, 0xdd, 0xc4, 0x87, 0xfd, 0xff, 0x42, 0xd1, 0x60, 0x75, 0x45, 0xf4, 0xaf, 0xc1, 0x1d, 0x17,
+	0x02, 0x28, 0x8f, 0x44, 0x90, 0x06, 0x9f, 0xc1, 0x88, 0x9a, 0xcf, 0xe3, 0x70, 0x1e, 0x42, 0xf6,
+	0xfa, 0xc0, 0x15, 0x7c, 0xa7, 0xe0, 0x36, 0x31, 0x7b, 0xf5, 0xaa, 0xd0, 0x38, 0xb3, 0x2e, 0x4b,
+	0x67, 0xaa, 0x38, 0xe4, 0x40, 0x13, 0xb2, 0x93, 0x10, 0xe4, 0xac, 0x35, 0x23, 0xac, 0x26, 0x23,
+	0xe8, 0x5f, 0x19, 0x64, 0xa1, 0x13, 0x87, 0x61, 0x7c, 0xe0, 0x7c, 0x3a, 0x88, 0xbc, 0x34, 0x88,
+	0x23, 0x61, 0xda, 0xa5, 0x97, 0xef, 0x17, 0xe0, 0x3b, 0x62, 0x23, 0x48, 0x04, 0x78, 0xf9, 0x69,
+	0x15, 0x52, 0x5e, 0xd6, 0x70, 0xf4, 0xb2, 0x2e, 0x3b, 0x09, 0x81, 0x97, 0x35, 0x23, 0x6c, 0x5e,
+	0x7a, 0xa4, 0x60, 0xfa, 0x31, 0x99, 0x83, 0x19, 0x55, 0x46, 0x07, 0xf3, 0x05, 0x74, 0x11, 0x4e,
+	0x81, 0xb3, 0xc0, 0xa8, 0x75, 0x3d, 0xce, 0xac, 0x25, 0xb9, 0xf9, 0xe9, 0xa8, 0xcd, 0xaa, 0x52,
+	0xa8, 0x90, 0x47, 0xbe, 0xa6, 0xb0, 0xa5, 0x29, 0xe4, 0x91, 0xdf, 0xa0, 0x50, 0x47, 0x41, 0xa1,
+	0xde, 0x86, 0x20, 0x88, 0x1e, 0x1e, 0x42, 0x36, 0x2a, 0xcc, 0x9b, 0xa8, 0x0d, 0x83, 0x20, 0xc0,
+	0xdf, 0x43, 0x54, 0x05, 0xc1, 0x12, 0xb2, 0x99, 0xc6, 0xa3, 0x12, 0xf0, 0x2a, 0x57, 0xf2, 0xa2,
+	0xa6, 0x84, 0x47, 0x7e, 0x5d, 0x89, 0x82, 0x40, 0x89, 0x6a, 0x40, 0x62, 0x8f, 0xfd, 0x61, 0xef,
+	0x4b, 0x79, 0x62, 0xbe, 0x84, 0x39, 0xe8, 0x52, 0xb1, 0xe2, 0x50, 0x6a, 0x13, 0xa9, 0xf6, 0x6a,
+	0x91, 0xf8, 0x1e, 0x96, 0xe0, 0x38, 0xb3, 0x16, 0x51, 0xbf, 0x86, 0xd9, 0x4c, 0x97, 0xa0, 0xfb,
+	0x64, 0xbe, 0xd8, 0xc9, 0x1d, 0x59, 0xf8, 0x34, 0x5f, 0xae, 0x2e, 0xeb, 0x62, 0x4b, 0xde, 0x46,
+	0x56, 0x2e, 0x6b, 0xaf, 0x82, 0xa9, 0x65, 0x5d, 0x85, 0x6d, 0x56, 0x93, 0xa3, 0x7f, 0x6c, 0x90,
+	0xcb, 0x79, 0x3d, 0xd6, 0xa9, 0x14, 0x64, 0xcd, 0x57, 0xd0, 0xe6, 0x8d, 0xc2, 0xe6, 0x77, 0xa4,
+	0xd0, 0x47, 0xba, 0x4c, 0xfb, 0x1e, 0x6c, 0x78, 0x83, 0x06, 0x46, 0x6d, 0x78, 0x4d, 0xa4, 0xcd,
+	0x1a, 0xfb, 0xd0, 0xdf, 0x27, 0x4b, 0x79, 0xcd, 0x17, 0xb7, 0xba, 0xe2, 0xe5, 0x5f, 0x45, 0x47,
+	0xae, 0x15, 0x8e, 0xc8, 0x70, 0x2e, 0x60, 0x5b, 0xcb, 0xdf, 0xff, 0x36, 0x1c, 0xf2, 0x0e, 0xea,
+	0xb0, 0x2a, 0x1d, 0x4e, 0x30, 0x36, 0x9b, 0x94, 0xa6, 0x7f, 0x68, 0x90, 0x25, 0x38, 0xaa, 0x05,
+	0x42, 0xc0, 0x9a, 0x80, 0xd4, 0x10, 0xb2, 0x1b, 0xf3, 0x35, 0xfc, 0xbe, 0xd7, 0x55, 0xc6, 0x5a,
+	0x8a, 0x6c, 0x4b, 0x89, 0xf6, 0xbd, 0xfc, 0x33, 0xd3, 0xfe, 0x04, 0xa7, 0xd2, 0x92, 0x49, 0xca,
+	0x66, 0x0d, 0xf2, 0x74, 0x48, 0x16, 0xcb, 0x2d, 0xba, 0xe7, 0xf6, 0xfb, 0x70, 0xcc, 0x79, 0x1d,
+	0x5d, 0x30, 0x0b, 0x17, 0xd4, 0xaa, 0xd8, 0x92, 0x7c, 0x7b, 0x3d, 0x77, 0x60, 0x21, 0xae, 0x31,
+	0xea, 0x78, 0x59, 0x27, 0x6c, 0x36, 0x21, 0x4b, 0x7d, 0xb2, 0x24, 0x7a, 0x6e, 0x18, 0x62, 0x52,
+	0xe7, 0x84, 0x6e, 0xc4, 0x31, 0xb3, 0x59, 0xc3, 0xbd, 0xf1, 0x9b, 0xa0, 0x1e, 0x69, 0x48, 0xd2,
+	0x3e, 0x74, 0x23, 0x2e, 0xb3, 0x1a, 0xa9, 0xbe, 0x4e, 0xa8, 0x8c, 0x66, 0xa2, 0x0b, 0xfd, 0x37,
+	0x83, 0x50, 0xcd, 0x0c, 0xec, 0xc7, 0x70, 0x28, 0xba, 0x85, 0x56, 0x64, 0xa5, 0x74, 0xa7, 0xe8,
+	0xb3, 0xe5, 0x1e, 0xca, 0x03, 0xd1, 0xbc, 0xa8, 0x42, 0xaa, 0x52, 0x5a, 0xc3, 0x2b, 0xa9, 0xec,
+	0xfa, 0x5d, 0xed, 0x5c, 0x34, 0xa1, 0x61, 0x12, 0x82, 0x33, 0x2e, 0xf4, 0x82, 0x88, 0x59, 0x73,
+	0x81, 0xd5, 0x64, 0x77, 0xe9, 0xcf, 0x0d, 0xb2, 0x54, 0xde, 0x3d, 0x38, 0xf9, 0xe5, 0x83, 0x30,
+	0x6f, 0x63, 0xf1, 0xeb, 0x5a, 0xb9, 0x50, 0x0b, 0x91, 0x47, 0x52, 0xa2, 0xfd, 0x7e, 0x31, 0x59,
+	0xbc, 0x3a, 0x25, 0xd4, 0x84, 0x9d, 0xa0, 0xb0, 0xd6, 0x3d, 0x81, 0xb2, 0x06, 0x1d, 0xf4, 0x43,
+	0x32, 0x17, 0x44, 0x4e, 0x3f, 0x74, 0x3d, 0x3c, 0x28, 0xa5, 0xae, 0x79, 0x47, 0x3b, 0x27, 0x45,
+	0xdb, 0x40, 0x6c, 0x00, 0x5e, 0x9e, 0x93, 0x34, 0x10, 0xce, 0x49, 0x5a, 0x93, 0x76, 0xc8, 0xac,
+	0xcc, 0x7d, 0x1d, 0x79, 0x73, 0x62, 0xae, 0x57, 0xd7, 0xa2, 0x2c, 0xee, 0xe1, 0x29, 0x84, 0xa1,
+	0x80, 0xb4, 0x23, 0xfb, 0x48, 0xa4, 0x3c, 0xc7, 0x68, 0xa0, 0xcd, 0x2a, 0x32, 0x50, 0x47, 0x90,
+	0x85, 0x67, 0x31, 0xd8, 0x4d, 0xa1, 0x8e, 0xf0, 0x06, 0x66, 0xb9, 0xef, 0x4b, 0xa7, 0x7d, 0x7e,
+	0xb8, 0x23, 0x71, 0x55, 0xb8, 0xd1, 0xc1, 0x6a, 0xf1, 0xf9, 0x4a, 0x33, 0xc5, 0x2a, 0x7a, 0xa8,
+	0x43, 0x68, 0x3f, 0x89, 0xfb, 0xee, 0x9e, 0x9b, 0x72, 0x27, 0x9f, 0x34, 0xc2, 0xbc, 0x8b, 0x43,
+	0x85, 0xe1, 0x44, 0xb1, 0x1b, 0x39, 0xa9, 0xbe, 0xce, 0x04, 0x63, 0xb3, 0x49, 0x69, 0xfa, 0xcf,
+	0x06, 0x59, 0xf6, 0xe2, 0x28, 0x0d, 0xa2, 0x41, 0x3c, 0xc0, 0x68, 0x92, 0x72, 0x2f, 0xbf, 0x81,
+	0x48, 0x53, 0x9e, 0x44, 0xc2, 0xfc, 0xe6, 0xca, 0xe9, 0xd5, 0xe9, 0xf6, 0xe1, 0x28, 0xb3, 0x6e,
+	0x94, 0x92, 0xdb, 0x4a, 0x70, 0x3b, 0x97, 0x1b, 0x67, 0xd6, 0xab, 0x45, 0x28, 0x7f, 0x9a, 0x50,
+	0x75, 0x08, 0x6e, 0x7e, 0x2d, 0x49, 0xf6, 0x4c, 0xab, 0xf4, 0x6f, 0xa6, 0x88, 0xd5, 0xfc, 0x02,
+	0xe5, 0xfd, 0xc6, 0x3d, 0xbc, 0xdf, 0xf8, 0x1f, 0x58, 0xb5, 0x37, 0x1e, 0x34, 0x28, 0xd3, 0x2e,
+	0x3b, 0x6e, 0x78, 0xcf, 0xe0, 0xc7, 0x99, 0x75, 0xe7, 0xa9, 0xaf, 0x58, 0x08, 0xd5, 0x17, 0xf7,
+	0xe8, 0xa8, 0xf5, 0x6c, 0xa5, 0xff, 0x07, 0xaf, 0xad, 0xf7, 0x67, 0x3a, 0xcf, 0x9e, 0xa5, 0x65,
+	0x97, 0x3e, 0x22, 0xf3, 0x98, 0x29, 0x0b, 0x28, 0xf4, 0x61, 0x50, 0x33, 0x7f, 0x0d, 0x83, 0xd9,
+	0x2d, 0xc8, 0x75, 0x24, 0xb5, 0xcd, 0x61, 0x6f, 0xe7, 0x2a, 0xd7, 0xa9, 0xa0, 0x2a, 0x58, 0x56,
+	0x85, 0xe9, 0xcf, 0x0c, 0xb2, 0x10, 0x44, 0x5d, 0x9e, 0x04, 0x29, 0xf7, 0x9d, 0x4e, 0xc0, 0x43,
+	0x5f, 0x98, 0xbf, 0x8e, 0x73, 0x86, 0x43, 0x4c, 0x54, 0xdc, 0x26, 0x52, 0xe3, 0xcc, 0x5a, 0xcb,
+	0x97, 0x86, 0x8e, 0x6b, 0x33, 0xa3, 0xe1, 0x5e, 0xc3, 0x7c, 0x9a, 0x30, 0xde, 0x72, 0xd4, 0x4d,
+	0xd0, 0x1f, 0x90, 0x8b, 0xf9, 0x45, 0xa9, 0xbc, 0xec, 0x78, 0x33, 0xcf, 0xfe, 0x8b, 0x6a, 0x9f,
+	0xe4, 0xf0, 0x02, 0xa1, 0x05, 0x79, 0x8f, 0x28, 0x01, 0x95, 0xf7, 0x68, 0x98, 0xcd, 0x74, 0x09,
+	0xca, 0xc8, 0x74, 0x3e, 0xcb, 0xb8, 0x6f, 0xde, 0xc7, 0xa5, 0x78, 0x17, 0x2e, 0x9f, 0x14, 0xa8,
+	0xca, 0x17, 0x0a, 0x99, 0x78, 0x27, 0xf4, 0xbb, 0xec, 0x41, 0x7b, 0x64, 0x0e, 0x4f, 0xeb, 0x6e,
+	0xb8, 0x17, 0x27, 0x41, 0xda, 0xed, 0x99, 0xdf, 0xca, 0xd3, 0x1a, 0x75, 0xf5, 0x88, 0x67, 0x24,
+	0x38, 0x75, 0xbf, 0x53, 0xc8, 0xc8, 0x34, 0xb5, 0xab, 0x43, 0xea, 0xd3, 0x55, 0x50, 0x9b, 0x55,
+	0xa5, 0x68, 0x48, 0xae, 0x78, 0x5d, 0x3c, 0x1f, 0x7d, 0x1a, 0x0f, 0x92, 0xc8, 0x0d, 0xd5, 0xad,
+	0xc0, 0x5b, 0xf8, 0x3e, 0x98, 0x2f, 0x49, 0x89, 0xf7, 0xa5, 0x40, 0x79, 0x09, 0x20, 0xf3, 0xa5,
+	0x26, 0xd2, 0x66, 0x8d, 0x7d, 0xe8, 0x23, 0xb2, 0xb0, 0x17, 0xa4, 0x79, 0x55, 0x4c, 0x0c, 0xa3,
+	0xd4, 0x3d, 0x34, 0xdf, 0x46, 0x3b, 0xaf, 0xc1, 0x04, 0x51, 0xdc, 0x0e, 0x52, 0xea, 0x20, 0x51,
+	0xc3, 0x6d, 0x56, 0x97, 0xa4, 0xfb, 0x64, 0x3a, 0xe1, 0xae, 0x2f, 0xaf, 0x01, 0xff, 0x6e, 0x13,
+	0x55, 0x6e, 0x9d, 0x64, 0x16, 0xdd, 0xe0, 0xfd, 0x84, 0x7b, 0x6e, 0x8a, 0x81, 0xdb, 0x87, 0x7b,
+	0xbc, 0x51, 0x66, 0x19, 0xaf, 0xab, 0xd8, 0x98, 0xc4, 0x0d, 0xd7, 0x81, 0x8b, 0x13, 0xa8, 0x69,
+	0xb0, 0x0b, 0x49, 0xae, 0x80, 0xfe, 0x90, 0x2c, 0x56, 0x6a, 0xc8, 0x98, 0x75, 0xfc, 0xfd, 0x26,
+	0xd6, 0xf4, 0xdf, 0x3d, 0xc9, 0x2c, 0xb3, 0x34, 0xba, 0x55, 0x56, 0x82, 0xb7, 0xbd, 0xb4, 0x30,
+	0xbd, 0x5c, 0x2f, 0x24, 0x6f, 0x7b, 0xa9, 0xe6, 0x81, 0x69, 0xb0, 0xb9, 0x2a, 0x49, 0x7f, 0x87,
+	0x9c, 0x97, 0xf5, 0x33, 0x61, 0xfe, 0x72, 0x13, 0x17, 0xeb, 0xb7, 0xa1, 0x10, 0x51, 0x1a, 0x92,
+	0x75, 0x51, 0x51, 0x7d, 0xb9, 0xbc, 0x8b, 0xa6, 0x3a, 0x5f, 0xb9, 0xa6, 0xc1, 0x0a, 0x7d, 0x74,
+	0x9f, 0xcc, 0x61, 0x65, 0xb1, 0x3c, 0xf9, 0xfc, 0x83, 0x1c, 0x3f, 0xb8, 0xcb, 0xbc, 0x5a, 0x5a,
+	0xd8, 0xf1, 0xdc, 0x48, 0x25, 0x72, 0x85, 0x9d, 0xe7, 0x54, 0x5d, 0x51, 0x51, 0xd5, 0x17, 0x99,
+	0xad, 0x70, 0xf6, 0x8f, 0x4f, 0x93, 0x19, 0xed, 0xc0, 0x41, 0x3f, 0x21, 0xe7, 0x79, 0x94, 0x26,
+	0x01, 0x17, 0xa6, 0xb1, 0x72, 0x5a, 0xcf, 0x19, 0x35, 0xa9, 0x77, 0xa3, 0x34, 0x19, 0xb6, 0x5f,
+	0x2a, 0x2e, 0xdf, 0xf2, 0x0e, 0xaa, 0xea, 0x0a, 0x6d, 0xfc, 0x6c, 0x67, 0xf1, 0x89, 0x15, 0x02,
+	0xf4, 0x2f, 0xf2, 0xf2, 0x89, 0x08, 0xa2, 0xbd, 0x90, 0x3b, 0xc8, 0x3a, 0xf0, 0x4f, 0x13, 0xbc,
+	0x54, 0x3d, 0xdb, 0xee, 0x40, 0x56, 0xd3, 0x73, 0x0f, 0x77, 0x90, 0x47, 0x2b, 0x3b, 0xfa, 0xdd,
+	0xc3, 0x24, 0xf5, 0xf4, 0x74, 0xad, 0x41, 0x4f, 0x11, 0xae, 0x59, 0x03, 0x47, 0x3f, 0x27, 0x73,
+	0xe0, 0x5a, 0x1a, 0xa7, 0x6e, 0x28, 0x7d, 0x3a, 0x8d, 0x3e, 0x3d, 0xcc, 0x2b, 0xa0, 0x0f, 0x81,
+	0xc8, 0xbd, 0x79, 0xbe, 0xf0, 0x46, 0x81, 0x9a, 0x1f, 0x77, 0x6f, 0xbf, 0x79, 0x4f, 0xf3, 0xa3,
+	0xd2, 0x17, 0x3c, 0x00, 0x9e, 0x55, 0x50, 0xfb, 0x2f, 0x0d, 0xb2, 0x50, 0x1f, 0x5e, 0x28, 0x78,
+	0xf7, 0xe0, 0x46, 0x28, 0xbf, 0xc8, 0x7e, 0x15, 0xaa, 0xdb, 0x08, 0x68, 0x95, 0xba, 0xd4, 0xeb,
+	0xaa, 0xbb, 0x1e, 0x52, 0x36, 0x99, 0x14, 0xa4, 0x9b, 0xe4, 0x1c, 0x1e, 0x10, 0x52, 0x1c, 0xdf,
+	0x0b, 0xed, 0x35, 0xac, 0x50, 0x22, 0xa2, 0x82, 0xa9, 0x6c, 0x2a, 0x2d, 0x33, 0x5a, 0x9b, 0xe5,
+	0xb2, 0xf6, 0x7f, 0x4f, 0x11, 0x3a, 0x79, 0x6a, 0xa1, 0x9f, 0x90, 0x69, 0x99, 0x81, 0xc7, 0x3e,
+	0xcf, 0xbd, 0xfc, 0x36, 0xfc, 0x45, 0x04, 0xc0, 0xad, 0xd8, 0x2f, 0x8f, 0x2e, 0x05, 0x50, 0x5d,
+	0xd4, 0x74, 0x12, 0x66, 0xaa, 0x2f, 0xfd, 0x2e, 0xb9, 0xe0, 0x07, 0x89, 0xd4, 0x2d, 0xaf, 0xdc,
+	0xbf, 0x85, 0x17, 0xbd, 0x41, 0x92, 0xab, 0xbe, 0x9a, 0x57, 0xb7, 0x92, 0x49, 0xcd, 0x8b, 0x13,
+	0x28, 0x2b, 0x3a, 0xd2, 0x3f, 0x35, 0xc8, 0x4c, 0x71, 0x44, 0x74, 0xbd, 0x30, 0xff, 0x13, 0x47,
+	0x74, 0x92, 0x59, 0x24, 0x3f, 0x16, 0xbe, 0xf3, 0x00, 0xca, 0x78, 0xe4, 0x40, 0xb5, 0xca, 0xd2,
+	0xab, 0x82, 0xaa, 0xf6, 0x2e, 0x35, 0x11, 0xe3, 0xa3, 0x96, 0xa6, 0xe3, 0xf1, 0x71, 0x4b, 0xd3,
+	0xcf, 0x14, 0xe3, 0x85, 0xf6, 0xbf, 0x1b, 0x64, 0xa1, 0x7e, 0x20, 0xa3, 0xdf, 0x23, 0x67, 0xe1,
+	0xcf, 0x46, 0xc5, 0x2a, 0x7c, 0xee, 0x69, 0x27, 0x37, 0xb9, 0x14, 0x5f, 0xc8, 0x97, 0xa2, 0xec,
+	0x33, 0xce, 0x2c, 0x22, 0x4f, 0xce, 0x82, 0xe3, 0x47, 0x3d, 0x03, 0x0f, 0x4c, 0x92, 0xf4, 0x77,
+	0xc9, 0xb9, 0xbd, 0x24, 0x1e, 0xf4, 0x85, 0x39, 0xf5, 0x75, 0x54, 0x17, 0x37, 0x5f, 0x79, 0x27,
+	0xb5, 0xc8, 0xb1, 0x89, 0x8b, 0x1c, 0x9f, 0x58, 0xce, 0xdb, 0x50, 0x0d, 0x68, 0xd4, 0x44, 0xdf,
+	0x22, 0x67, 0xa0, 0x62, 0x9c, 0xcf, 0x14, 0xfc, 0x7b, 0x00, 0xb4, 0xd5, 0xdf, 0x03, 0xa0, 0x51,
+	0xfe, 0x3d, 0x40, 0xb5, 0x18, 0x4a, 0xd1, 0x75, 0x32, 0x95, 0xc6, 0xf9, 0x4c, 0x80, 0x82, 0xcb,
+	0x54, 0x1a, 0xab, 0x4b, 0xa3, 0x34, 0x2e, 0xff, 0x82, 0x94, 0x3f, 0xb3, 0xa9, 0x34, 0x6e, 0x7f,
+	0xf0, 0xe5, 0xaf, 0x96, 0x4f, 0x1d, 0xff, 0x6a, 0xf9, 0xd4, 0x97, 0x27, 0xcb, 0xc6, 0xf1, 0xc9,
+	0xb2, 0xf1, 0xb3, 0xaf, 0x96, 0x4f, 0xfd, 0xe2, 0xab, 0x65, 0xe3, 0xf8, 0xab, 0xe5, 0x53, 0xff,
+	0xf9, 0xd5, 0xf2, 0xa9, 0xef, 0xbf, 0xfc, 0x35, 0xfe, 0x61, 0x24, 0x87, 0x67, 0xf7, 0x1c, 0x6e,
+	0xf7, 0x6f, 0xfc, 0xef, 0x00, 0x84, 0x59, 0xd3, 0x91, 0x71, 0x27, 0x00, 0x00,
 }
 
 func (m *FolderDeviceConfiguration) Marshal() (dAtA []byte, err error) {
@@ -418,6 +691,21 @@ func (m *FolderDeviceConfiguration) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	_ = i
 	var l int
 	_ = l
+	if m.IndexOnly {
+		i--
+		if m.IndexOnly {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.MonthlyQuotaMiB != 0 {
+		i = encodeVarintFolderconfiguration(dAtA, i, uint64(m.MonthlyQuotaMiB))
+		i--
+		dAtA[i] = 0x20
+	}
 	if len(m.EncryptionPassword) > 0 {
 		i -= len(m.EncryptionPassword)
 		copy(dAtA[i:], m.EncryptionPassword)
@@ -515,6 +803,207 @@ func (m *FolderConfiguration) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0xc0
 	}
+	if m.GitignoreSyntax {
+		i--
+		if m.GitignoreSyntax {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0xe8
+	}
+	if m.ChangeJournalEnabled {
+		i--
+		if m.ChangeJournalEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0xe0
+	}
+	if m.HashAlgorithm != 0 {
+		i = encodeVarintFolderconfiguration(dAtA, i, uint64(m.HashAlgorithm))
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0xd8
+	}
+	if m.Protected {
+		i--
+		if m.Protected {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0xd0
+	}
+	if m.StorageType != 0 {
+		i = encodeVarintFolderconfiguration(dAtA, i, uint64(m.StorageType))
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0xc8
+	}
+	if len(m.InheritedFields) > 0 {
+		for iNdEx := len(m.InheritedFields) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.InheritedFields[iNdEx])
+			copy(dAtA[i:], m.InheritedFields[iNdEx])
+			i = encodeVarintFolderconfiguration(dAtA, i, uint64(len(m.InheritedFields[iNdEx])))
+			i--
+			dAtA[i] = 0x3
+			i--
+			dAtA[i] = 0xc2
+		}
+	}
+	if m.BlocksPerFile != 0 {
+		i = encodeVarintFolderconfiguration(dAtA, i, uint64(m.BlocksPerFile))
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0xb8
+	}
+	if m.ContinuousProtectionQuotaMiB != 0 {
+		i = encodeVarintFolderconfiguration(dAtA, i, uint64(m.ContinuousProtectionQuotaMiB))
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0xb0
+	}
+	if len(m.ContinuousProtectionPatterns) > 0 {
+		for iNdEx := len(m.ContinuousProtectionPatterns) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ContinuousProtectionPatterns[iNdEx])
+			copy(dAtA[i:], m.ContinuousProtectionPatterns[iNdEx])
+			i = encodeVarintFolderconfiguration(dAtA, i, uint64(len(m.ContinuousProtectionPatterns[iNdEx])))
+			i--
+			dAtA[i] = 0x3
+			i--
+			dAtA[i] = 0xaa
+		}
+	}
+	if m.PropagateDefaults {
+		i--
+		if m.PropagateDefaults {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0xa0
+	}
+	if len(m.IndexSubtree) > 0 {
+		i -= len(m.IndexSubtree)
+		copy(dAtA[i:], m.IndexSubtree)
+		i = encodeVarintFolderconfiguration(dAtA, i, uint64(len(m.IndexSubtree)))
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0x9a
+	}
+	if m.PausedReason != 0 {
+		i = encodeVarintFolderconfiguration(dAtA, i, uint64(m.PausedReason))
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0x90
+	}
+	if m.InPlaceDelta {
+		i--
+		if m.InPlaceDelta {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0x88
+	}
+	if len(m.CompletionWebhooks) > 0 {
+		for iNdEx := len(m.CompletionWebhooks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CompletionWebhooks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFolderconfiguration(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if m.SmallFileMaxKiB != 0 {
+		i = encodeVarintFolderconfiguration(dAtA, i, uint64(m.SmallFileMaxKiB))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xf8
+	}
+	if m.SmallFileLanePct != 0 {
+		i = encodeVarintFolderconfiguration(dAtA, i, uint64(m.SmallFileLanePct))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xf0
+	}
+	{
+		size, err := m.OwnershipMapping.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintFolderconfiguration(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2
+	i--
+	dAtA[i] = 0xea
+	{
+		size, err := m.PermissionsProfile.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintFolderconfiguration(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2
+	i--
+	dAtA[i] = 0xe2
+	if m.WindowsNamePolicy != 0 {
+		i = encodeVarintFolderconfiguration(dAtA, i, uint64(m.WindowsNamePolicy))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xd8
+	}
+	if m.UnicodeNormalization != 0 {
+		i = encodeVarintFolderconfiguration(dAtA, i, uint64(m.UnicodeNormalization))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xd0
+	}
+	if m.ConflictPolicy != 0 {
+		i = encodeVarintFolderconfiguration(dAtA, i, uint64(m.ConflictPolicy))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xc8
+	}
 	if m.FSWatcherTimeoutS != 0 {
 		i -= 8
 		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.FSWatcherTimeoutS))))
@@ -964,50 +1453,188 @@ func (m *XattrFilterEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintFolderconfiguration(dAtA []byte, offset int, v uint64) int {
-	offset -= sovFolderconfiguration(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *PermissionsProfile) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *FolderDeviceConfiguration) ProtoSize() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.DeviceID.ProtoSize()
-	n += 1 + l + sovFolderconfiguration(uint64(l))
-	l = m.IntroducedBy.ProtoSize()
-	n += 1 + l + sovFolderconfiguration(uint64(l))
-	l = len(m.EncryptionPassword)
-	if l > 0 {
-		n += 1 + l + sovFolderconfiguration(uint64(l))
-	}
-	return n
+
+func (m *PermissionsProfile) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *FolderConfiguration) ProtoSize() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *PermissionsProfile) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ID)
-	if l > 0 {
-		n += 1 + l + sovFolderconfiguration(uint64(l))
+	if len(m.WindowsACL) > 0 {
+		i -= len(m.WindowsACL)
+		copy(dAtA[i:], m.WindowsACL)
+		i = encodeVarintFolderconfiguration(dAtA, i, uint64(len(m.WindowsACL)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.Label)
-	if l > 0 {
-		n += 1 + l + sovFolderconfiguration(uint64(l))
+	if len(m.DirMode) > 0 {
+		i -= len(m.DirMode)
+		copy(dAtA[i:], m.DirMode)
+		i = encodeVarintFolderconfiguration(dAtA, i, uint64(len(m.DirMode)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.FilesystemType != 0 {
-		n += 1 + sovFolderconfiguration(uint64(m.FilesystemType))
+	if len(m.FileMode) > 0 {
+		i -= len(m.FileMode)
+		copy(dAtA[i:], m.FileMode)
+		i = encodeVarintFolderconfiguration(dAtA, i, uint64(len(m.FileMode)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OwnershipMapping) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OwnershipMapping) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OwnershipMapping) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Groups) > 0 {
+		for iNdEx := len(m.Groups) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Groups[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFolderconfiguration(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Users) > 0 {
+		for iNdEx := len(m.Users) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Users[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFolderconfiguration(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OwnershipMappingEntry) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OwnershipMappingEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OwnershipMappingEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.To) > 0 {
+		i -= len(m.To)
+		copy(dAtA[i:], m.To)
+		i = encodeVarintFolderconfiguration(dAtA, i, uint64(len(m.To)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.From) > 0 {
+		i -= len(m.From)
+		copy(dAtA[i:], m.From)
+		i = encodeVarintFolderconfiguration(dAtA, i, uint64(len(m.From)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintFolderconfiguration(dAtA []byte, offset int, v uint64) int {
+	offset -= sovFolderconfiguration(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *FolderDeviceConfiguration) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.DeviceID.ProtoSize()
+	n += 1 + l + sovFolderconfiguration(uint64(l))
+	l = m.IntroducedBy.ProtoSize()
+	n += 1 + l + sovFolderconfiguration(uint64(l))
+	l = len(m.EncryptionPassword)
+	if l > 0 {
+		n += 1 + l + sovFolderconfiguration(uint64(l))
+	}
+	if m.MonthlyQuotaMiB != 0 {
+		n += 1 + sovFolderconfiguration(uint64(m.MonthlyQuotaMiB))
+	}
+	if m.IndexOnly {
+		n += 2
+	}
+	return n
+}
+
+func (m *FolderConfiguration) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovFolderconfiguration(uint64(l))
+	}
+	l = len(m.Label)
+	if l > 0 {
+		n += 1 + l + sovFolderconfiguration(uint64(l))
+	}
+	if m.FilesystemType != 0 {
+		n += 1 + sovFolderconfiguration(uint64(m.FilesystemType))
 	}
 	l = len(m.Path)
 	if l > 0 {
@@ -1122,6 +1749,77 @@ func (m *FolderConfiguration) ProtoSize() (n int) {
 	if m.FSWatcherTimeoutS != 0 {
 		n += 10
 	}
+	if m.ConflictPolicy != 0 {
+		n += 2 + sovFolderconfiguration(uint64(m.ConflictPolicy))
+	}
+	if m.UnicodeNormalization != 0 {
+		n += 2 + sovFolderconfiguration(uint64(m.UnicodeNormalization))
+	}
+	if m.WindowsNamePolicy != 0 {
+		n += 2 + sovFolderconfiguration(uint64(m.WindowsNamePolicy))
+	}
+	l = m.PermissionsProfile.ProtoSize()
+	n += 2 + l + sovFolderconfiguration(uint64(l))
+	l = m.OwnershipMapping.ProtoSize()
+	n += 2 + l + sovFolderconfiguration(uint64(l))
+	if m.SmallFileLanePct != 0 {
+		n += 2 + sovFolderconfiguration(uint64(m.SmallFileLanePct))
+	}
+	if m.SmallFileMaxKiB != 0 {
+		n += 2 + sovFolderconfiguration(uint64(m.SmallFileMaxKiB))
+	}
+	if len(m.CompletionWebhooks) > 0 {
+		for _, e := range m.CompletionWebhooks {
+			l = e.ProtoSize()
+			n += 2 + l + sovFolderconfiguration(uint64(l))
+		}
+	}
+	if m.InPlaceDelta {
+		n += 3
+	}
+	if m.PausedReason != 0 {
+		n += 2 + sovFolderconfiguration(uint64(m.PausedReason))
+	}
+	l = len(m.IndexSubtree)
+	if l > 0 {
+		n += 2 + l + sovFolderconfiguration(uint64(l))
+	}
+	if m.PropagateDefaults {
+		n += 3
+	}
+	if len(m.ContinuousProtectionPatterns) > 0 {
+		for _, s := range m.ContinuousProtectionPatterns {
+			l = len(s)
+			n += 2 + l + sovFolderconfiguration(uint64(l))
+		}
+	}
+	if m.ContinuousProtectionQuotaMiB != 0 {
+		n += 2 + sovFolderconfiguration(uint64(m.ContinuousProtectionQuotaMiB))
+	}
+	if m.BlocksPerFile != 0 {
+		n += 2 + sovFolderconfiguration(uint64(m.BlocksPerFile))
+	}
+	if len(m.InheritedFields) > 0 {
+		for _, s := range m.InheritedFields {
+			l = len(s)
+			n += 2 + l + sovFolderconfiguration(uint64(l))
+		}
+	}
+	if m.StorageType != 0 {
+		n += 2 + sovFolderconfiguration(uint64(m.StorageType))
+	}
+	if m.Protected {
+		n += 3
+	}
+	if m.HashAlgorithm != 0 {
+		n += 2 + sovFolderconfiguration(uint64(m.HashAlgorithm))
+	}
+	if m.ChangeJournalEnabled {
+		n += 3
+	}
+	if m.GitignoreSyntax {
+		n += 3
+	}
 	if m.DeprecatedReadOnly {
 		n += 4
 	}
@@ -1174,6 +1872,65 @@ func (m *XattrFilterEntry) ProtoSize() (n int) {
 	return n
 }
 
+func (m *PermissionsProfile) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FileMode)
+	if l > 0 {
+		n += 1 + l + sovFolderconfiguration(uint64(l))
+	}
+	l = len(m.DirMode)
+	if l > 0 {
+		n += 1 + l + sovFolderconfiguration(uint64(l))
+	}
+	l = len(m.WindowsACL)
+	if l > 0 {
+		n += 1 + l + sovFolderconfiguration(uint64(l))
+	}
+	return n
+}
+
+func (m *OwnershipMapping) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Users) > 0 {
+		for _, e := range m.Users {
+			l = e.ProtoSize()
+			n += 1 + l + sovFolderconfiguration(uint64(l))
+		}
+	}
+	if len(m.Groups) > 0 {
+		for _, e := range m.Groups {
+			l = e.ProtoSize()
+			n += 1 + l + sovFolderconfiguration(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *OwnershipMappingEntry) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovFolderconfiguration(uint64(l))
+	}
+	l = len(m.To)
+	if l > 0 {
+		n += 1 + l + sovFolderconfiguration(uint64(l))
+	}
+	return n
+}
+
 func sovFolderconfiguration(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1307,6 +2064,45 @@ func (m *FolderDeviceConfiguration) Unmarshal(dAtA []byte) error {
 			}
 			m.EncryptionPassword = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MonthlyQuotaMiB", wireType)
+			}
+			m.MonthlyQuotaMiB = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFolderconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MonthlyQuotaMiB |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexOnly", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFolderconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IndexOnly = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipFolderconfiguration(dAtA[iNdEx:])
@@ -2225,11 +3021,11 @@ func (m *FolderConfiguration) Unmarshal(dAtA []byte) error {
 			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
 			iNdEx += 8
 			m.FSWatcherTimeoutS = float64(math.Float64frombits(v))
-		case 9000:
+		case 41:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DeprecatedReadOnly", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConflictPolicy", wireType)
 			}
-			var v int
+			m.ConflictPolicy = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFolderconfiguration
@@ -2239,28 +3035,16 @@ func (m *FolderConfiguration) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.ConflictPolicy |= ConflictPolicy(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.DeprecatedReadOnly = bool(v != 0)
-		case 9001:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DeprecatedMinDiskFreePct", wireType)
-			}
-			var v uint64
-			if (iNdEx + 8) > l {
-				return io.ErrUnexpectedEOF
-			}
-			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-			iNdEx += 8
-			m.DeprecatedMinDiskFreePct = float64(math.Float64frombits(v))
-		case 9002:
+		case 42:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DeprecatedPullers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UnicodeNormalization", wireType)
 			}
-			m.DeprecatedPullers = 0
+			m.UnicodeNormalization = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFolderconfiguration
@@ -2270,16 +3054,738 @@ func (m *FolderConfiguration) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DeprecatedPullers |= int(b&0x7F) << shift
+				m.UnicodeNormalization |= UnicodeNormalization(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 9003:
+		case 43:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DeprecatedScanOwnership", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowsNamePolicy", wireType)
 			}
-			var v int
+			m.WindowsNamePolicy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFolderconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WindowsNamePolicy |= WindowsNamePolicy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 44:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PermissionsProfile", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFolderconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFolderconfiguration
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFolderconfiguration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PermissionsProfile.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 45:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OwnershipMapping", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFolderconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFolderconfiguration
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFolderconfiguration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OwnershipMapping.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 46:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SmallFileLanePct", wireType)
+			}
+			m.SmallFileLanePct = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFolderconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SmallFileLanePct |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 47:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SmallFileMaxKiB", wireType)
+			}
+			m.SmallFileMaxKiB = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFolderconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SmallFileMaxKiB |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 48:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletionWebhooks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFolderconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFolderconfiguration
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFolderconfiguration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CompletionWebhooks = append(m.CompletionWebhooks, CompletionWebhook{})
+			if err := m.CompletionWebhooks[len(m.CompletionWebhooks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 49:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InPlaceDelta", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFolderconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.InPlaceDelta = bool(v != 0)
+		case 50:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PausedReason", wireType)
+			}
+			m.PausedReason = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFolderconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PausedReason |= FolderPauseReason(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 51:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexSubtree", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFolderconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFolderconfiguration
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFolderconfiguration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IndexSubtree = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 52:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PropagateDefaults", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFolderconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.PropagateDefaults = bool(v != 0)
+		case 53:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContinuousProtectionPatterns", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFolderconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFolderconfiguration
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFolderconfiguration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContinuousProtectionPatterns = append(m.ContinuousProtectionPatterns, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 54:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContinuousProtectionQuotaMiB", wireType)
+			}
+			m.ContinuousProtectionQuotaMiB = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFolderconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ContinuousProtectionQuotaMiB |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 55:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlocksPerFile", wireType)
+			}
+			m.BlocksPerFile = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFolderconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlocksPerFile |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 56:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InheritedFields", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFolderconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFolderconfiguration
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFolderconfiguration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InheritedFields = append(m.InheritedFields, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 57:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageType", wireType)
+			}
+			m.StorageType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFolderconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StorageType |= StorageType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 58:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Protected", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFolderconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Protected = bool(v != 0)
+		case 59:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HashAlgorithm", wireType)
+			}
+			m.HashAlgorithm = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFolderconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HashAlgorithm |= protocol.BlockHashAlgorithm(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 60:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChangeJournalEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFolderconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ChangeJournalEnabled = bool(v != 0)
+		case 61:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GitignoreSyntax", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFolderconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.GitignoreSyntax = bool(v != 0)
+		case 9000:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeprecatedReadOnly", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFolderconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DeprecatedReadOnly = bool(v != 0)
+		case 9001:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeprecatedMinDiskFreePct", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.DeprecatedMinDiskFreePct = float64(math.Float64frombits(v))
+		case 9002:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeprecatedPullers", wireType)
+			}
+			m.DeprecatedPullers = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFolderconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DeprecatedPullers |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9003:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeprecatedScanOwnership", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFolderconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DeprecatedScanOwnership = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFolderconfiguration(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFolderconfiguration
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *XattrFilter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFolderconfiguration
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: XattrFilter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: XattrFilter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFolderconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFolderconfiguration
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFolderconfiguration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Entries = append(m.Entries, XattrFilterEntry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSingleEntrySize", wireType)
+			}
+			m.MaxSingleEntrySize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFolderconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxSingleEntrySize |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTotalSize", wireType)
+			}
+			m.MaxTotalSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFolderconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxTotalSize |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFolderconfiguration(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFolderconfiguration
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *XattrFilterEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFolderconfiguration
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: XattrFilterEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: XattrFilterEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Match", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFolderconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFolderconfiguration
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFolderconfiguration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Match = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Permit", wireType)
+			}
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFolderconfiguration
@@ -2294,7 +3800,7 @@ func (m *FolderConfiguration) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.DeprecatedScanOwnership = bool(v != 0)
+			m.Permit = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipFolderconfiguration(dAtA[iNdEx:])
@@ -2316,7 +3822,7 @@ func (m *FolderConfiguration) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *XattrFilter) Unmarshal(dAtA []byte) error {
+func (m *PermissionsProfile) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2339,17 +3845,17 @@ func (m *XattrFilter) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: XattrFilter: wiretype end group for non-group")
+			return fmt.Errorf("proto: PermissionsProfile: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: XattrFilter: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PermissionsProfile: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FileMode", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFolderconfiguration
@@ -2359,31 +3865,29 @@ func (m *XattrFilter) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthFolderconfiguration
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthFolderconfiguration
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Entries = append(m.Entries, XattrFilterEntry{})
-			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.FileMode = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxSingleEntrySize", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DirMode", wireType)
 			}
-			m.MaxSingleEntrySize = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFolderconfiguration
@@ -2393,16 +3897,29 @@ func (m *XattrFilter) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxSingleEntrySize |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFolderconfiguration
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFolderconfiguration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DirMode = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxTotalSize", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowsACL", wireType)
 			}
-			m.MaxTotalSize = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFolderconfiguration
@@ -2412,11 +3929,24 @@ func (m *XattrFilter) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxTotalSize |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFolderconfiguration
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFolderconfiguration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WindowsACL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipFolderconfiguration(dAtA[iNdEx:])
@@ -2438,7 +3968,7 @@ func (m *XattrFilter) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *XattrFilterEntry) Unmarshal(dAtA []byte) error {
+func (m *OwnershipMapping) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2461,15 +3991,133 @@ func (m *XattrFilterEntry) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: XattrFilterEntry: wiretype end group for non-group")
+			return fmt.Errorf("proto: OwnershipMapping: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: XattrFilterEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OwnershipMapping: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Match", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Users", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFolderconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFolderconfiguration
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFolderconfiguration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Users = append(m.Users, OwnershipMappingEntry{})
+			if err := m.Users[len(m.Users)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Groups", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFolderconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFolderconfiguration
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFolderconfiguration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Groups = append(m.Groups, OwnershipMappingEntry{})
+			if err := m.Groups[len(m.Groups)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFolderconfiguration(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFolderconfiguration
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OwnershipMappingEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFolderconfiguration
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OwnershipMappingEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OwnershipMappingEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2497,13 +4145,13 @@ func (m *XattrFilterEntry) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Match = string(dAtA[iNdEx:postIndex])
+			m.From = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Permit", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFolderconfiguration
@@ -2513,12 +4161,24 @@ func (m *XattrFilterEntry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Permit = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFolderconfiguration
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFolderconfiguration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.To = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipFolderconfiguration(dAtA[iNdEx:])
@@ -0,0 +1,44 @@
+// Copyright (C) 2026 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package config
+
+func (r FolderPauseReason) String() string {
+	switch r {
+	case FolderPauseReasonNone:
+		return "none"
+	case FolderPauseReasonUser:
+		return "user"
+	case FolderPauseReasonErrors:
+		return "errors"
+	case FolderPauseReasonSchedule:
+		return "schedule"
+	case FolderPauseReasonLowDisk:
+		return "lowDisk"
+	default:
+		return "unknown"
+	}
+}
+
+func (r FolderPauseReason) MarshalText() ([]byte, error) {
+	return []byte(r.String()), nil
+}
+
+func (r *FolderPauseReason) UnmarshalText(bs []byte) error {
+	switch string(bs) {
+	case "user":
+		*r = FolderPauseReasonUser
+	case "errors":
+		*r = FolderPauseReasonErrors
+	case "schedule":
+		*r = FolderPauseReasonSchedule
+	case "lowDisk":
+		*r = FolderPauseReasonLowDisk
+	default:
+		*r = FolderPauseReasonNone
+	}
+	return nil
+}
@@ -0,0 +1,85 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: lib/config/folderpausereason.proto
+
+package config
+
+import (
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type FolderPauseReason int32
+
+const (
+	FolderPauseReasonNone     FolderPauseReason = 0
+	FolderPauseReasonUser     FolderPauseReason = 1
+	FolderPauseReasonErrors   FolderPauseReason = 2
+	FolderPauseReasonSchedule FolderPauseReason = 3
+	FolderPauseReasonLowDisk  FolderPauseReason = 4
+)
+
+var FolderPauseReason_name = map[int32]string{
+	0: "FOLDER_PAUSE_REASON_NONE",
+	1: "FOLDER_PAUSE_REASON_USER",
+	2: "FOLDER_PAUSE_REASON_ERRORS",
+	3: "FOLDER_PAUSE_REASON_SCHEDULE",
+	4: "FOLDER_PAUSE_REASON_LOW_DISK",
+}
+
+var FolderPauseReason_value = map[string]int32{
+	"FOLDER_PAUSE_REASON_NONE":     0,
+	"FOLDER_PAUSE_REASON_USER":     1,
+	"FOLDER_PAUSE_REASON_ERRORS":   2,
+	"FOLDER_PAUSE_REASON_SCHEDULE": 3,
+	"FOLDER_PAUSE_REASON_LOW_DISK": 4,
+}
+
+func (FolderPauseReason) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_402a536b540e6bcd, []int{0}
+}
+
+func init() {
+	proto.RegisterEnum("config.FolderPauseReason", FolderPauseReason_name, FolderPauseReason_value)
+}
+
+func init() {
+	proto.RegisterFile("lib/config/folderpausereason.proto", fileDescriptor_402a536b540e6bcd)
+}
+
+var fileDescriptor_402a536b540e6bcd = []byte{
+	// 323 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x91, 0x3f, 0x4b, 0xf3, 0x40,
+	0x1c, 0xc7, 0x2f, 0x7d, 0x4a, 0x87, 0x4c, 0x79, 0x02, 0x62, 0x7b, 0xd6, 0x23, 0xe8, 0xa4, 0x43,
+	0x33, 0x38, 0x38, 0x08, 0x4a, 0x35, 0x57, 0x94, 0x86, 0xa4, 0xdc, 0x11, 0x04, 0x97, 0xd0, 0xa4,
+	0xd7, 0x34, 0x58, 0x73, 0xe5, 0xae, 0x41, 0x7c, 0x0b, 0x99, 0x7c, 0x03, 0x01, 0x07, 0x07, 0x5f,
+	0x4a, 0xdd, 0x3a, 0xba, 0xb6, 0x79, 0x23, 0x62, 0x3a, 0x28, 0xa4, 0xdd, 0xbe, 0xbf, 0x3f, 0x9f,
+	0xcf, 0xf2, 0x55, 0x8f, 0xa6, 0x71, 0x60, 0x86, 0x3c, 0x19, 0xc7, 0x91, 0x39, 0xe6, 0xd3, 0x11,
+	0x13, 0xb3, 0x61, 0x2a, 0x99, 0x60, 0x43, 0xc9, 0x93, 0xce, 0x4c, 0xf0, 0x39, 0xd7, 0x1b, 0x9b,
+	0x3b, 0x3c, 0x16, 0x6c, 0xc6, 0xa5, 0x59, 0x2e, 0x83, 0x74, 0x6c, 0x46, 0x3c, 0xe2, 0xe5, 0x50,
+	0xa6, 0xcd, 0xf3, 0xe9, 0x67, 0x4d, 0xfd, 0xdf, 0x2b, 0x45, 0x83, 0x1f, 0x11, 0x29, 0x45, 0xfa,
+	0xb9, 0xda, 0xec, 0xb9, 0xb6, 0x85, 0x89, 0x3f, 0xe8, 0x7a, 0x14, 0xfb, 0x04, 0x77, 0xa9, 0xeb,
+	0xf8, 0x8e, 0xeb, 0x60, 0x0d, 0xc0, 0x56, 0x96, 0x1b, 0x7b, 0x15, 0xc8, 0xe1, 0x09, 0xdb, 0x05,
+	0x7a, 0x14, 0x13, 0x4d, 0xd9, 0x01, 0x7a, 0x92, 0x09, 0xfd, 0x42, 0x85, 0xdb, 0x40, 0x4c, 0x88,
+	0x4b, 0xa8, 0x56, 0x83, 0x07, 0x59, 0x6e, 0xec, 0x57, 0x50, 0x2c, 0x04, 0x17, 0x52, 0xbf, 0x52,
+	0xdb, 0xdb, 0x60, 0x7a, 0x73, 0x8b, 0x2d, 0xcf, 0xc6, 0xda, 0x3f, 0x78, 0x98, 0xe5, 0x46, 0xab,
+	0x82, 0xd3, 0x70, 0xc2, 0x46, 0xe9, 0x94, 0xe9, 0x97, 0xdb, 0x05, 0xb6, 0x7b, 0xef, 0x5b, 0x77,
+	0xb4, 0xaf, 0xd5, 0x61, 0x3b, 0xcb, 0x8d, 0x66, 0x45, 0x60, 0xf3, 0x67, 0x2b, 0x96, 0x8f, 0xb0,
+	0xfe, 0xf1, 0x8e, 0xc0, 0x75, 0x7f, 0xb1, 0x42, 0x60, 0xb9, 0x42, 0x60, 0xb1, 0x46, 0xca, 0x72,
+	0x8d, 0x94, 0xd7, 0x02, 0x81, 0xb7, 0x02, 0x29, 0xcb, 0x02, 0x81, 0xaf, 0x02, 0x81, 0x87, 0x93,
+	0x28, 0x9e, 0x4f, 0xd2, 0xa0, 0x13, 0xf2, 0x27, 0x53, 0xbe, 0x24, 0xe1, 0x7c, 0x12, 0x27, 0xd1,
+	0x9f, 0xf4, 0xdb, 0x6e, 0xd0, 0x28, 0xfb, 0x39, 0xfb, 0x1e, 0x00, 0x76, 0xd9, 0x6a, 0x0a, 0xf2,
+	0x01, 0x00, 0x00,
+}
@@ -0,0 +1,53 @@
+// Copyright (C) 2026 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package config
+
+import (
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/hex"
+	"slices"
+	"strings"
+)
+
+// Matches returns true if the certificate is the configured one. Pinned
+// certificates match by fingerprint, and by common name if one is set as
+// well. Others match by common name, if issued by the client CA.
+func (c GUIClientCertificate) Matches(cert *x509.Certificate, issuedByCA bool) bool {
+	if c.Fingerprint != "" {
+		sum := sha256.Sum256(cert.Raw)
+		if normalizeFingerprint(c.Fingerprint) != hex.EncodeToString(sum[:]) {
+			return false
+		}
+		return c.CommonName == "" || c.CommonName == cert.Subject.CommonName
+	}
+	return issuedByCA && c.CommonName != "" && c.CommonName == cert.Subject.CommonName
+}
+
+// User returns the user the certificate authenticates as.
+func (c GUIClientCertificate) User() GUIUser {
+	name := c.CommonName
+	if name == "" {
+		name = normalizeFingerprint(c.Fingerprint)
+	}
+	return GUIUser{
+		Name:    "cert:" + name,
+		Role:    c.Role,
+		Folders: slices.Clone(c.Folders),
+	}
+}
+
+// normalizeFingerprint accepts fingerprints in upper or lower case, with or
+// without colons between the bytes.
+func normalizeFingerprint(fp string) string {
+	return strings.ToLower(strings.ReplaceAll(fp, ":", ""))
+}
+
+func (c GUIClientCertificate) Copy() GUIClientCertificate {
+	c.Folders = slices.Clone(c.Folders)
+	return c
+}
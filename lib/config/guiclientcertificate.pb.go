@@ -0,0 +1,447 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: lib/config/guiclientcertificate.proto
+
+package config
+
+import (
+	fmt "fmt"
+	proto "github.com/gogo/protobuf/proto"
+	_ "github.com/syncthing/syncthing/proto/ext"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// A client certificate that may use the GUI and API without other
+// credentials, with the given role. It's identified either by the SHA-256
+// fingerprint of the certificate, or by its common name when issued by the
+// client CA.
+type GUIClientCertificate struct {
+	Fingerprint string   `protobuf:"bytes,1,opt,name=fingerprint,proto3" json:"fingerprint" xml:"fingerprint,attr,omitempty"`
+	CommonName  string   `protobuf:"bytes,2,opt,name=common_name,json=commonName,proto3" json:"commonName" xml:"commonName,attr,omitempty"`
+	Role        UserRole `protobuf:"varint,3,opt,name=role,proto3,enum=config.UserRole" json:"role" xml:"role,attr"`
+	Folders     []string `protobuf:"bytes,4,rep,name=folders,proto3" json:"folders" xml:"folder"`
+}
+
+func (m *GUIClientCertificate) Reset()         { *m = GUIClientCertificate{} }
+func (m *GUIClientCertificate) String() string { return proto.CompactTextString(m) }
+func (*GUIClientCertificate) ProtoMessage()    {}
+func (*GUIClientCertificate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_65591fe877de5e24, []int{0}
+}
+func (m *GUIClientCertificate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GUIClientCertificate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GUIClientCertificate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GUIClientCertificate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GUIClientCertificate.Merge(m, src)
+}
+func (m *GUIClientCertificate) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *GUIClientCertificate) XXX_DiscardUnknown() {
+	xxx_messageInfo_GUIClientCertificate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GUIClientCertificate proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*GUIClientCertificate)(nil), "config.GUIClientCertificate")
+}
+
+func init() {
+	proto.RegisterFile("lib/config/guiclientcertificate.proto", fileDescriptor_65591fe877de5e24)
+}
+
+var fileDescriptor_65591fe877de5e24 = []byte{
+	// 361 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x91, 0xc1, 0x4a, 0xeb, 0x40,
+	0x14, 0x86, 0x93, 0xdb, 0xd2, 0x4b, 0xd2, 0xcb, 0x55, 0x82, 0x8b, 0xd8, 0xc5, 0x4c, 0x28, 0x16,
+	0x22, 0x94, 0x14, 0x74, 0xe7, 0x46, 0x48, 0x17, 0x22, 0x42, 0xc1, 0x40, 0x37, 0x6e, 0x24, 0x8d,
+	0x93, 0x74, 0x20, 0x33, 0x53, 0x26, 0x53, 0x68, 0x1f, 0x42, 0xf0, 0x11, 0x7c, 0x9c, 0xee, 0xec,
+	0xd2, 0xd5, 0x40, 0x9b, 0x5d, 0x96, 0x79, 0x02, 0xe9, 0x84, 0xda, 0x08, 0x76, 0x77, 0xfe, 0xff,
+	0xfc, 0x7c, 0x3f, 0x9c, 0x63, 0xf6, 0x52, 0x3c, 0x19, 0x44, 0x8c, 0xc6, 0x38, 0x19, 0x24, 0x73,
+	0x1c, 0xa5, 0x18, 0x51, 0x11, 0x21, 0x2e, 0x70, 0x8c, 0xa3, 0x50, 0x20, 0x6f, 0xc6, 0x99, 0x60,
+	0x56, 0xab, 0x8a, 0x74, 0xec, 0x9f, 0xf1, 0x79, 0x86, 0x78, 0x95, 0xe8, 0x18, 0x68, 0x21, 0xaa,
+	0xb1, 0xfb, 0xda, 0x30, 0xcf, 0xee, 0xc6, 0xf7, 0x43, 0xc5, 0x1a, 0x1e, 0x58, 0x56, 0x66, 0xb6,
+	0x63, 0x4c, 0x13, 0xc4, 0x67, 0x1c, 0x53, 0x61, 0xeb, 0x8e, 0xee, 0x1a, 0xfe, 0x63, 0x21, 0x61,
+	0xdd, 0x2e, 0x25, 0x74, 0x16, 0x24, 0xbd, 0xe9, 0xd6, 0xbc, 0x7e, 0x28, 0x04, 0xef, 0x33, 0x82,
+	0x05, 0x22, 0x33, 0xb1, 0xec, 0x16, 0x1f, 0x17, 0x9d, 0xe3, 0xeb, 0xa0, 0x8e, 0xb3, 0x98, 0xd9,
+	0x8e, 0x18, 0x21, 0x8c, 0x3e, 0xd3, 0x90, 0x20, 0xfb, 0x8f, 0x2a, 0x1d, 0x15, 0x12, 0x9a, 0x95,
+	0x3d, 0x0a, 0x09, 0x2a, 0x25, 0x84, 0xaa, 0xf3, 0x60, 0xfd, 0x52, 0x79, 0x7e, 0x74, 0x1b, 0xd4,
+	0x58, 0xd6, 0xc8, 0x6c, 0x72, 0x96, 0x22, 0xbb, 0xe1, 0xe8, 0xee, 0xff, 0xab, 0x53, 0xaf, 0x3a,
+	0x97, 0x37, 0xce, 0x10, 0x0f, 0x58, 0x8a, 0x7c, 0xb7, 0x90, 0x50, 0x25, 0x4a, 0x09, 0x4f, 0x54,
+	0xeb, 0x4e, 0x28, 0xe2, 0xae, 0xc5, 0xf8, 0x56, 0x81, 0x4a, 0x59, 0xb7, 0xe6, 0xdf, 0x98, 0xa5,
+	0x2f, 0x88, 0x67, 0x76, 0xd3, 0x69, 0xb8, 0x86, 0xdf, 0x2b, 0x24, 0xdc, 0x5b, 0xa5, 0x84, 0xff,
+	0xaa, 0x6b, 0x29, 0xbd, 0x03, 0xb4, 0xaa, 0x31, 0xd8, 0x47, 0xfc, 0x87, 0xd5, 0x06, 0x68, 0xeb,
+	0x0d, 0xd0, 0x56, 0x5b, 0xa0, 0xaf, 0xb7, 0x40, 0x7f, 0xcb, 0x81, 0xf6, 0x9e, 0x03, 0x7d, 0x9d,
+	0x03, 0xed, 0x33, 0x07, 0xda, 0xd3, 0x65, 0x82, 0xc5, 0x74, 0x3e, 0xf1, 0x22, 0x46, 0x06, 0xd9,
+	0x92, 0x46, 0x62, 0x8a, 0x69, 0x52, 0x9b, 0x0e, 0x5f, 0x9f, 0xb4, 0xd4, 0x8f, 0xaf, 0xbf, 0x06,
+	0x00, 0xc5, 0xfc, 0xdf, 0x30, 0x39, 0x02, 0x00, 0x00,
+}
+
+func (m *GUIClientCertificate) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GUIClientCertificate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GUIClientCertificate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Folders) > 0 {
+		for iNdEx := len(m.Folders) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Folders[iNdEx])
+			copy(dAtA[i:], m.Folders[iNdEx])
+			i = encodeVarintGuiclientcertificate(dAtA, i, uint64(len(m.Folders[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.Role != 0 {
+		i = encodeVarintGuiclientcertificate(dAtA, i, uint64(m.Role))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.CommonName) > 0 {
+		i -= len(m.CommonName)
+		copy(dAtA[i:], m.CommonName)
+		i = encodeVarintGuiclientcertificate(dAtA, i, uint64(len(m.CommonName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Fingerprint) > 0 {
+		i -= len(m.Fingerprint)
+		copy(dAtA[i:], m.Fingerprint)
+		i = encodeVarintGuiclientcertificate(dAtA, i, uint64(len(m.Fingerprint)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGuiclientcertificate(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGuiclientcertificate(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GUIClientCertificate) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Fingerprint)
+	if l > 0 {
+		n += 1 + l + sovGuiclientcertificate(uint64(l))
+	}
+	l = len(m.CommonName)
+	if l > 0 {
+		n += 1 + l + sovGuiclientcertificate(uint64(l))
+	}
+	if m.Role != 0 {
+		n += 1 + sovGuiclientcertificate(uint64(m.Role))
+	}
+	if len(m.Folders) > 0 {
+		for _, s := range m.Folders {
+			l = len(s)
+			n += 1 + l + sovGuiclientcertificate(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovGuiclientcertificate(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGuiclientcertificate(x uint64) (n int) {
+	return sovGuiclientcertificate(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GUIClientCertificate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGuiclientcertificate
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GUIClientCertificate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GUIClientCertificate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fingerprint", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGuiclientcertificate
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGuiclientcertificate
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGuiclientcertificate
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fingerprint = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommonName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGuiclientcertificate
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGuiclientcertificate
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGuiclientcertificate
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CommonName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+			}
+			m.Role = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGuiclientcertificate
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Role |= UserRole(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Folders", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGuiclientcertificate
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGuiclientcertificate
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGuiclientcertificate
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Folders = append(m.Folders, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGuiclientcertificate(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGuiclientcertificate
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipGuiclientcertificate(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowGuiclientcertificate
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGuiclientcertificate
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGuiclientcertificate
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthGuiclientcertificate
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupGuiclientcertificate
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthGuiclientcertificate
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthGuiclientcertificate        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowGuiclientcertificate          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupGuiclientcertificate = fmt.Errorf("proto: unexpected end of group")
+)
@@ -10,8 +10,10 @@ import (
 	"net/url"
 	"os"
 	"regexp"
+	"slices"
 	"strconv"
 	"strings"
+	"time"
 
 	"golang.org/x/crypto/bcrypt"
 
@@ -20,7 +22,8 @@ import (
 
 func (c GUIConfiguration) IsAuthEnabled() bool {
 	// This function should match isAuthEnabled() in syncthingController.js
-	return c.AuthMode == AuthModeLDAP || (len(c.User) > 0 && len(c.Password) > 0)
+	return c.AuthMode == AuthModeLDAP || c.AuthMode == AuthModeOIDC || (len(c.User) > 0 && len(c.Password) > 0) ||
+		slices.ContainsFunc(c.Users, func(u GUIUser) bool { return len(u.Name) > 0 && len(u.Password) > 0 })
 }
 
 func (GUIConfiguration) IsOverridden() bool {
@@ -155,12 +158,98 @@ func (c GUIConfiguration) IsValidAPIKey(apiKey string) bool {
 	}
 }
 
+// IsValidPendingAPIKey returns true when the given API key is the limited
+// one for pending devices and folders.
+func (c GUIConfiguration) IsValidPendingAPIKey(apiKey string) bool {
+	return apiKey != "" && apiKey == c.PendingAPIKey
+}
+
+// HashUserPasswords hashes the plaintext passwords of the additional users.
+func (c *GUIConfiguration) HashUserPasswords() error {
+	for i := range c.Users {
+		if c.Users[i].Password == "" {
+			continue
+		}
+		if err := c.Users[i].SetPassword(c.Users[i].Password); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// UserByName returns the additional user with the given name, if any.
+func (c GUIConfiguration) UserByName(name string) (GUIUser, bool) {
+	if name == "" {
+		return GUIUser{}, false
+	}
+	for _, u := range c.Users {
+		if u.Name == name {
+			return u, true
+		}
+	}
+	return GUIUser{}, false
+}
+
+// ValidScopedAPIKey returns the scoped API key matching the given one, if
+// it has not expired.
+func (c GUIConfiguration) ValidScopedAPIKey(apiKey string) (ScopedAPIKey, bool) {
+	if apiKey == "" {
+		return ScopedAPIKey{}, false
+	}
+	for _, k := range c.APIKeys {
+		if k.Key == apiKey {
+			return k, !k.Expired(time.Now())
+		}
+	}
+	return ScopedAPIKey{}, false
+}
+
 func (c *GUIConfiguration) prepare() {
 	if c.APIKey == "" {
 		c.APIKey = rand.String(32)
 	}
+	for i := range c.APIKeys {
+		if c.APIKeys[i].Key == "" {
+			c.APIKeys[i].Key = rand.String(32)
+		}
+	}
+
+	// Users need a name, and only the first of several with the same name
+	// can ever log in.
+	seen := make(map[string]struct{}, len(c.Users))
+	c.Users = slices.DeleteFunc(c.Users, func(u GUIUser) bool {
+		if _, ok := seen[u.Name]; ok || u.Name == "" {
+			l.Warnf("Ignoring GUI user with empty or duplicate name %q", u.Name)
+			return true
+		}
+		seen[u.Name] = struct{}{}
+		return false
+	})
 }
 
 func (c GUIConfiguration) Copy() GUIConfiguration {
+	if c.Users != nil {
+		users := make([]GUIUser, len(c.Users))
+		for i, u := range c.Users {
+			users[i] = u.Copy()
+		}
+		c.Users = users
+	}
+	if c.APIKeys != nil {
+		keys := make([]ScopedAPIKey, len(c.APIKeys))
+		for i, k := range c.APIKeys {
+			keys[i] = k.Copy()
+		}
+		c.APIKeys = keys
+	}
+	c.OIDC = c.OIDC.Copy()
+	if c.ClientCertificates != nil {
+		certs := make([]GUIClientCertificate, len(c.ClientCertificates))
+		for i, cert := range c.ClientCertificates {
+			certs[i] = cert.Copy()
+		}
+		c.ClientCertificates = certs
+	}
+	c.ACME = c.ACME.Copy()
 	return c
 }
@@ -38,6 +38,29 @@ type GUIConfiguration struct {
 	InsecureSkipHostCheck     bool     `protobuf:"varint,12,opt,name=insecure_skip_host_check,json=insecureSkipHostCheck,proto3" json:"insecureSkipHostcheck" xml:"insecureSkipHostcheck,omitempty"`
 	InsecureAllowFrameLoading bool     `protobuf:"varint,13,opt,name=insecure_allow_frame_loading,json=insecureAllowFrameLoading,proto3" json:"insecureAllowFrameLoading" xml:"insecureAllowFrameLoading,omitempty"`
 	SendBasicAuthPrompt       bool     `protobuf:"varint,14,opt,name=send_basic_auth_prompt,json=sendBasicAuthPrompt,proto3" json:"sendBasicAuthPrompt" xml:"sendBasicAuthPrompt,attr"`
+	// An API key that only allows listing and accepting pending devices and
+	// folders, for companion apps.
+	PendingAPIKey string `protobuf:"bytes,15,opt,name=pending_api_key,json=pendingApiKey,proto3" json:"pendingApiKey" xml:"pendingApikey,omitempty"`
+	// Users in addition to the one above, each with their own password and
+	// role. With LDAP authentication only the roles apply, by user name.
+	Users []GUIUser `protobuf:"bytes,16,rep,name=users,proto3" json:"users" xml:"users>user"`
+	// API keys in addition to the one above, with limited scopes.
+	APIKeys []ScopedAPIKey `protobuf:"bytes,17,rep,name=api_keys,json=apiKeys,proto3" json:"apiKeys" xml:"apiKeys>apiKey"`
+	// Used with the OIDC authentication mode.
+	OIDC OIDCConfiguration `protobuf:"bytes,18,opt,name=oidc,proto3" json:"oidc" xml:"oidc"`
+	// Client certificates issued by the CA certificates in this PEM file are
+	// accepted, as given by client_certificates.
+	ClientCAFile       string                 `protobuf:"bytes,19,opt,name=client_ca_file,json=clientCaFile,proto3" json:"clientCAFile" xml:"clientCAFile,omitempty"`
+	ClientCertificates []GUIClientCertificate `protobuf:"bytes,20,rep,name=client_certificates,json=clientCertificates,proto3" json:"clientCertificates" xml:"clientCertificates>clientCertificate"`
+	// Refuse TLS connections without a client certificate. Authentication
+	// still applies to certificates not listed in client_certificates.
+	RequireClientCertificate bool `protobuf:"varint,21,opt,name=require_client_certificate,json=requireClientCertificate,proto3" json:"requireClientCertificate" xml:"requireClientCertificate,omitempty"`
+	// REST responses at least this large carry a Content-Digest header,
+	// for clients to detect responses damaged on the way. Clients may also
+	// ask for it with Want-Content-Digest. Zero adds none unasked.
+	ContentDigestMinKiB int `protobuf:"varint,22,opt,name=content_digest_min_kib,json=contentDigestMinKib,proto3,casttype=int" json:"contentDigestMinKiB" xml:"contentDigestMinKiB,omitempty"`
+	// Obtaining and renewing the HTTPS certificate through ACME.
+	ACME ACMEConfiguration `protobuf:"bytes,23,opt,name=acme,proto3" json:"acme" xml:"acme"`
 }
 
 func (m *GUIConfiguration) Reset()         { *m = GUIConfiguration{} }
@@ -80,63 +103,95 @@ func init() {
 func init() { proto.RegisterFile("lib/config/guiconfiguration.proto", fileDescriptor_2a9586d611855d64) }
 
 var fileDescriptor_2a9586d611855d64 = []byte{
-	// 888 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x55, 0xcd, 0x6e, 0xdb, 0x46,
-	0x10, 0x16, 0x5b, 0x47, 0xb2, 0xb6, 0x89, 0x60, 0xb0, 0x4d, 0xca, 0x04, 0x0d, 0xd7, 0x51, 0xd8,
-	0xc2, 0x01, 0x02, 0x39, 0x71, 0x5a, 0x24, 0xf0, 0xa1, 0x80, 0x1c, 0x20, 0x4d, 0x60, 0x17, 0x30,
-	0xe8, 0xfa, 0x92, 0x0b, 0xb1, 0x22, 0xd7, 0xd2, 0x42, 0xfc, 0x2b, 0x77, 0x09, 0x5b, 0x87, 0xf6,
-	0x01, 0x7a, 0x2a, 0xdc, 0x73, 0x81, 0x3e, 0x43, 0x2f, 0x7d, 0x85, 0xdc, 0xa4, 0x53, 0xd1, 0xd3,
-	0x02, 0x91, 0xd1, 0x0b, 0x8f, 0x3c, 0xe6, 0x54, 0xec, 0xf2, 0x47, 0xa2, 0x4c, 0x37, 0xb9, 0xed,
-	0x7c, 0xf3, 0xcd, 0x7c, 0x33, 0xc3, 0x19, 0x10, 0xdc, 0x73, 0xc9, 0x60, 0xdb, 0x0e, 0xfc, 0x13,
-	0x32, 0xdc, 0x1e, 0xc6, 0x24, 0x7b, 0xc5, 0x11, 0x62, 0x24, 0xf0, 0x7b, 0x61, 0x14, 0xb0, 0x40,
-	0x6d, 0x66, 0xe0, 0x9d, 0xdb, 0x4b, 0x54, 0x14, 0xb3, 0x91, 0x17, 0x38, 0x38, 0xa3, 0xdc, 0x69,
-	0xe3, 0x33, 0x96, 0x3d, 0xbb, 0xff, 0xde, 0x00, 0x1b, 0xdf, 0x1d, 0xbf, 0x7a, 0xbe, 0x9c, 0x48,
-	0x1d, 0x80, 0x16, 0xf6, 0xd1, 0xc0, 0xc5, 0x8e, 0xa6, 0x6c, 0x2a, 0x5b, 0xeb, 0x7b, 0x2f, 0x13,
-	0x0e, 0x0b, 0x28, 0xe5, 0xf0, 0xde, 0x99, 0xe7, 0xee, 0x76, 0x73, 0xfb, 0x21, 0x62, 0x2c, 0xea,
-	0x6e, 0x3a, 0xf8, 0x04, 0xc5, 0x2e, 0xdb, 0xed, 0xb2, 0x28, 0xc6, 0xdd, 0x64, 0x6a, 0x5c, 0x5f,
-	0xf6, 0xbf, 0x9b, 0x1a, 0x6b, 0xc2, 0x61, 0x16, 0x59, 0xd4, 0x9f, 0x40, 0x0b, 0x39, 0x4e, 0x84,
-	0x29, 0xd5, 0x3e, 0xda, 0x54, 0xb6, 0xda, 0x7b, 0xf6, 0x9c, 0x43, 0x60, 0xa2, 0xd3, 0x7e, 0x86,
-	0x0a, 0xc5, 0x9c, 0x90, 0x72, 0xf8, 0x95, 0x54, 0xcc, 0xed, 0x25, 0xb1, 0xc7, 0x3b, 0x4f, 0x7b,
-	0x8f, 0x7a, 0x8f, 0x7a, 0x8f, 0x77, 0x9f, 0x3d, 0x79, 0xf6, 0x75, 0xf7, 0xdd, 0xd4, 0xe8, 0x54,
-	0xa1, 0xf3, 0x99, 0xb1, 0x94, 0xd4, 0x2c, 0x52, 0xaa, 0x7f, 0x2b, 0xe0, 0xf3, 0xd8, 0x27, 0x67,
-	0x16, 0x0d, 0xec, 0x31, 0x66, 0x56, 0x88, 0x23, 0x8f, 0x50, 0x4a, 0x02, 0x9f, 0x6a, 0x1f, 0xcb,
-	0x7a, 0x7e, 0x57, 0xe6, 0x1c, 0x6a, 0x26, 0x3a, 0x3d, 0xf6, 0xc9, 0xd9, 0x91, 0x64, 0x1d, 0x2e,
-	0x48, 0x09, 0x87, 0x37, 0xe3, 0x3a, 0x47, 0xca, 0xe1, 0x97, 0xb2, 0xd8, 0x5a, 0xef, 0xc3, 0xc0,
-	0x23, 0x0c, 0x7b, 0x21, 0x9b, 0x88, 0x11, 0xc1, 0xf7, 0x70, 0xce, 0x67, 0xc6, 0x95, 0x05, 0x98,
-	0xf5, 0xf2, 0xea, 0x0b, 0xb0, 0x16, 0x53, 0x1c, 0x69, 0x6b, 0xb2, 0x89, 0x9d, 0x84, 0x43, 0x69,
-	0xa7, 0x1c, 0x7e, 0x96, 0x95, 0x45, 0x71, 0x54, 0xad, 0xa2, 0x53, 0x85, 0x4c, 0xc9, 0x57, 0x5f,
-	0x83, 0xf5, 0x10, 0x51, 0x7a, 0x1a, 0x44, 0x8e, 0x76, 0x4d, 0xe6, 0xfa, 0x36, 0xe1, 0xb0, 0xc4,
-	0x52, 0x0e, 0x35, 0x99, 0xaf, 0x00, 0xaa, 0x39, 0xd5, 0xcb, 0xb0, 0x59, 0xc6, 0xaa, 0x1e, 0x68,
-	0x8b, 0x8d, 0xb4, 0xc4, 0x4a, 0x6a, 0xcd, 0x4d, 0x65, 0xab, 0xb3, 0xb3, 0xd1, 0xcb, 0x56, 0xb5,
-	0xd7, 0x8f, 0xd9, 0xe8, 0xfb, 0xc0, 0xc1, 0x99, 0x1c, 0xca, 0xad, 0x52, 0xae, 0x00, 0x56, 0xe4,
-	0x2e, 0xc3, 0x66, 0x19, 0xab, 0x62, 0xd0, 0x8a, 0x29, 0xb6, 0x98, 0x4b, 0xb5, 0x96, 0x5c, 0xe7,
-	0x83, 0x39, 0x87, 0x6d, 0x31, 0x58, 0x8a, 0x7f, 0x38, 0x38, 0x4a, 0x38, 0x6c, 0xc6, 0xf2, 0x95,
-	0x72, 0xd8, 0x91, 0x2a, 0xcc, 0xa5, 0xd9, 0x5a, 0x27, 0x53, 0x63, 0xbd, 0x30, 0xd2, 0xa9, 0x91,
-	0xf3, 0xce, 0x67, 0xc6, 0x22, 0xdc, 0x94, 0xa0, 0x4b, 0x85, 0x0c, 0x0a, 0x89, 0x35, 0xc6, 0x13,
-	0x6d, 0x5d, 0x0e, 0x4c, 0xc8, 0x34, 0xfb, 0x87, 0xaf, 0xf6, 0xf1, 0x44, 0x68, 0xa0, 0x90, 0xec,
-	0xe3, 0x49, 0xca, 0xe1, 0xad, 0xac, 0x93, 0x90, 0x8c, 0xf1, 0xa4, 0xda, 0xc7, 0xc6, 0x2a, 0x78,
-	0x3e, 0x33, 0xf2, 0x0c, 0x66, 0x1e, 0xaf, 0xfe, 0xa6, 0x80, 0x9b, 0xc4, 0xa7, 0xd8, 0x8e, 0x23,
-	0x6c, 0x21, 0xc7, 0x23, 0xbe, 0x85, 0x6c, 0x5b, 0xdc, 0x51, 0x5b, 0x36, 0x67, 0x25, 0x1c, 0x7e,
-	0x5a, 0x10, 0xfa, 0xc2, 0xdf, 0x97, 0xee, 0x94, 0xc3, 0xfb, 0x52, 0xb8, 0xc6, 0x57, 0xad, 0xe2,
-	0xee, 0xff, 0x32, 0xcc, 0xba, 0xe4, 0xea, 0x3e, 0xb8, 0xc6, 0x46, 0xd8, 0xc3, 0x1a, 0x90, 0xad,
-	0x7f, 0x93, 0x70, 0x98, 0x01, 0x29, 0x87, 0x77, 0xb3, 0x99, 0x0a, 0x6b, 0xe9, 0x74, 0xf3, 0x87,
-	0xb8, 0xd9, 0x56, 0xfe, 0x36, 0xb3, 0x10, 0xf5, 0x18, 0xb4, 0x1d, 0x3c, 0x88, 0x87, 0x43, 0xe2,
-	0x0f, 0xb5, 0x4f, 0x64, 0x57, 0x4f, 0x13, 0x0e, 0x17, 0x60, 0xb9, 0xcd, 0x25, 0x52, 0x7e, 0xae,
-	0x4e, 0x15, 0x32, 0x17, 0x41, 0xea, 0x5f, 0x0a, 0xd0, 0xca, 0xc9, 0xd1, 0x31, 0x09, 0xad, 0x51,
-	0x40, 0x99, 0x65, 0x8f, 0xb0, 0x3d, 0xd6, 0xae, 0x4b, 0x99, 0x9f, 0xc5, 0x5d, 0x17, 0x9c, 0xa3,
-	0x31, 0x09, 0x5f, 0x06, 0x94, 0x49, 0x42, 0x79, 0xd7, 0xb5, 0xde, 0x95, 0xbb, 0x7e, 0x0f, 0x27,
-	0x9d, 0x1a, 0xf5, 0x22, 0xe6, 0x25, 0xf8, 0xb9, 0x80, 0xd5, 0x3f, 0x15, 0xf0, 0xc5, 0xe2, 0x9b,
-	0xbb, 0x6e, 0x70, 0x6a, 0x9d, 0x44, 0xc8, 0xc3, 0x96, 0x1b, 0x20, 0x47, 0x0c, 0xe9, 0x86, 0xac,
-	0xfe, 0xc7, 0x84, 0xc3, 0xdb, 0xe5, 0xd7, 0x11, 0xb4, 0x17, 0x82, 0x75, 0x90, 0x91, 0x52, 0x0e,
-	0x1f, 0x54, 0x17, 0x60, 0x95, 0x51, 0xed, 0xe2, 0xfe, 0x07, 0xf0, 0xcc, 0xab, 0xe5, 0xd4, 0x5f,
-	0x14, 0x70, 0x8b, 0x62, 0xdf, 0xb1, 0x06, 0x88, 0x12, 0xdb, 0x92, 0x17, 0x1f, 0x46, 0x81, 0x17,
-	0x32, 0xad, 0x23, 0xcb, 0x3d, 0x16, 0x9b, 0x2a, 0x18, 0x7b, 0x82, 0x20, 0x0e, 0xff, 0x50, 0xba,
-	0x53, 0x0e, 0x75, 0x59, 0x68, 0x8d, 0xaf, 0xfc, 0xce, 0xda, 0x55, 0x4e, 0xb3, 0x2e, 0xe5, 0xde,
-	0xfe, 0x9b, 0xb7, 0x7a, 0x63, 0xf6, 0x56, 0x6f, 0xbc, 0x99, 0xeb, 0xca, 0x6c, 0xae, 0x2b, 0xbf,
-	0x5e, 0xe8, 0x8d, 0x3f, 0x2e, 0x74, 0x65, 0x76, 0xa1, 0x37, 0xfe, 0xb9, 0xd0, 0x1b, 0xaf, 0x1f,
-	0x0c, 0x09, 0x1b, 0xc5, 0x83, 0x9e, 0x1d, 0x78, 0xdb, 0x74, 0xe2, 0xdb, 0x6c, 0x44, 0xfc, 0xe1,
-	0xd2, 0x6b, 0xf1, 0x3b, 0x1d, 0x34, 0xe5, 0xbf, 0xf3, 0xc9, 0x7f, 0x01, 0x00, 0x00, 0xff, 0xff,
-	0xfe, 0x19, 0xb2, 0x3c, 0x8e, 0x07, 0x00, 0x00,
+	// 1398 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x57, 0x3d, 0x6c, 0xdb, 0x46,
+	0x1b, 0x36, 0xbf, 0xf8, 0xf7, 0xe2, 0x38, 0xfe, 0xe8, 0xc4, 0xb9, 0x04, 0x89, 0x4e, 0x51, 0x98,
+	0x42, 0x29, 0x02, 0x39, 0x71, 0x5a, 0x24, 0xf0, 0x60, 0x40, 0x52, 0x9a, 0xc4, 0x70, 0x82, 0x1a,
+	0x74, 0xdd, 0x21, 0x0b, 0x41, 0x91, 0x67, 0xe9, 0x20, 0x89, 0x64, 0x78, 0x24, 0x6c, 0x17, 0x68,
+	0xd1, 0xb9, 0x5d, 0x0a, 0x75, 0x2e, 0x90, 0xb5, 0x68, 0xa7, 0x2e, 0x1d, 0x3b, 0x15, 0xc8, 0x26,
+	0x4d, 0x45, 0xa7, 0x03, 0x22, 0x6f, 0x1a, 0x39, 0x66, 0x2a, 0xee, 0xf8, 0x23, 0xd2, 0xa2, 0x92,
+	0x2c, 0x12, 0xdf, 0xe7, 0x7d, 0xee, 0x7d, 0xde, 0xfb, 0x79, 0xf8, 0x03, 0x6e, 0x76, 0x48, 0x63,
+	0xc3, 0xb0, 0xad, 0x43, 0xd2, 0xdc, 0x68, 0xfa, 0x24, 0xbc, 0xf2, 0x5d, 0xdd, 0x23, 0xb6, 0x55,
+	0x71, 0x5c, 0xdb, 0xb3, 0xe5, 0xf9, 0x10, 0xbc, 0x56, 0x4a, 0x51, 0x75, 0xa3, 0x8b, 0x73, 0xb8,
+	0xd7, 0xae, 0xa6, 0x39, 0xbe, 0xd7, 0xea, 0xda, 0x26, 0x8e, 0x52, 0xb7, 0xcf, 0x28, 0x75, 0x08,
+	0xb6, 0x3c, 0x03, 0xbb, 0x1e, 0x39, 0x24, 0x86, 0xee, 0xc5, 0x34, 0x98, 0xa5, 0xf9, 0x14, 0xbb,
+	0x51, 0x26, 0xad, 0x6f, 0x13, 0xd3, 0xc8, 0xd3, 0xbf, 0x91, 0xe2, 0x50, 0xc3, 0x76, 0xb0, 0xa9,
+	0x3b, 0xa4, 0x8d, 0x4f, 0xa2, 0xf4, 0x12, 0x3e, 0xf6, 0xc2, 0xcb, 0xd2, 0xdf, 0x10, 0xac, 0x3e,
+	0x3d, 0xd8, 0xa9, 0xa7, 0x8b, 0xc8, 0x0d, 0xb0, 0x80, 0x2d, 0xbd, 0xd1, 0xc1, 0x26, 0x94, 0x8a,
+	0x52, 0x79, 0xb1, 0xf6, 0x6c, 0xc4, 0x50, 0x0c, 0x05, 0x0c, 0xdd, 0x3c, 0xee, 0x76, 0xb6, 0x4a,
+	0x51, 0x7c, 0x57, 0xf7, 0x3c, 0xb7, 0x54, 0x34, 0xf1, 0xa1, 0xee, 0x77, 0xbc, 0xad, 0x92, 0xe7,
+	0xfa, 0xb8, 0x34, 0xea, 0x2b, 0xcb, 0xe9, 0xfc, 0xbb, 0xbe, 0x32, 0xcb, 0x13, 0x6a, 0x5c, 0x45,
+	0xfe, 0x16, 0x2c, 0xe8, 0xa6, 0xe9, 0x62, 0x4a, 0xe1, 0xff, 0x8a, 0x52, 0x79, 0xa9, 0x66, 0x0c,
+	0x19, 0x02, 0xaa, 0x7e, 0x54, 0x0d, 0x51, 0xae, 0x18, 0x11, 0x02, 0x86, 0x3e, 0x11, 0x8a, 0x51,
+	0x9c, 0x12, 0xbb, 0xbf, 0xf9, 0xb0, 0x72, 0xaf, 0x72, 0xaf, 0x72, 0x7f, 0xeb, 0xd1, 0x83, 0x47,
+	0x9f, 0x95, 0xde, 0xf5, 0x95, 0x95, 0x2c, 0xd4, 0x1b, 0x28, 0xa9, 0xa2, 0x6a, 0x5c, 0x52, 0xfe,
+	0x47, 0x02, 0x57, 0x7c, 0x8b, 0x1c, 0x6b, 0xd4, 0x36, 0xda, 0xd8, 0xd3, 0x1c, 0xec, 0x76, 0x09,
+	0xa5, 0xc4, 0xb6, 0x28, 0x3c, 0x27, 0xfa, 0xf9, 0x45, 0x1a, 0x32, 0x04, 0x55, 0xfd, 0xe8, 0xc0,
+	0x22, 0xc7, 0xfb, 0x82, 0xb5, 0x37, 0x26, 0x8d, 0x18, 0xba, 0xec, 0xe7, 0x25, 0x02, 0x86, 0x6e,
+	0x8b, 0x66, 0x73, 0xb3, 0x77, 0xed, 0x2e, 0xf1, 0x70, 0xd7, 0xf1, 0x4e, 0xf8, 0x12, 0xa1, 0x0f,
+	0x70, 0x7a, 0x03, 0x65, 0x6a, 0x03, 0x6a, 0xbe, 0xbc, 0xfc, 0x04, 0xcc, 0xf2, 0xc3, 0x02, 0x67,
+	0xc5, 0x24, 0x36, 0x47, 0x0c, 0x89, 0x38, 0x60, 0xe8, 0x52, 0xd8, 0x16, 0xc5, 0x6e, 0xb6, 0x8b,
+	0x95, 0x2c, 0xa4, 0x0a, 0xbe, 0xfc, 0x12, 0x2c, 0x3a, 0x3a, 0xa5, 0x47, 0xb6, 0x6b, 0xc2, 0x39,
+	0x51, 0x6b, 0x7b, 0xc4, 0x50, 0x82, 0x05, 0x0c, 0x41, 0x51, 0x2f, 0x06, 0xb2, 0x35, 0xe5, 0x49,
+	0x58, 0x4d, 0xc6, 0xca, 0x5d, 0xb0, 0xc4, 0x5d, 0xa1, 0x71, 0x5b, 0xc0, 0xf9, 0xa2, 0x54, 0x5e,
+	0xd9, 0x5c, 0xad, 0x84, 0xc7, 0xb5, 0x52, 0xf5, 0xbd, 0xd6, 0x0b, 0xdb, 0xc4, 0xa1, 0x9c, 0x1e,
+	0x45, 0x89, 0x5c, 0x0c, 0x9c, 0x91, 0x9b, 0x84, 0xd5, 0x64, 0xac, 0x8c, 0xc1, 0x82, 0x4f, 0xb1,
+	0xe6, 0x75, 0x28, 0x5c, 0x10, 0xc7, 0xf9, 0xf9, 0x90, 0xa1, 0x25, 0xbe, 0xb0, 0x14, 0x7f, 0xf5,
+	0x7c, 0x7f, 0xc4, 0xd0, 0xbc, 0x2f, 0xae, 0x02, 0x86, 0x56, 0x84, 0x8a, 0xd7, 0xa1, 0xe1, 0xb1,
+	0x1e, 0xf5, 0x95, 0xc5, 0x38, 0x08, 0xfa, 0x4a, 0xc4, 0xeb, 0x0d, 0x94, 0xf1, 0x70, 0x55, 0x80,
+	0x1d, 0xca, 0x65, 0x74, 0x87, 0x68, 0x6d, 0x7c, 0x02, 0x17, 0xc5, 0x82, 0x71, 0x99, 0xf9, 0xea,
+	0xde, 0xce, 0x2e, 0x3e, 0xe1, 0x1a, 0xba, 0x43, 0x76, 0xf1, 0x49, 0xc0, 0xd0, 0x7a, 0x38, 0x13,
+	0xe1, 0xc8, 0xec, 0x3c, 0x56, 0xcf, 0x82, 0xbd, 0x81, 0x12, 0x55, 0x50, 0xa3, 0xf1, 0xf2, 0xcf,
+	0x12, 0xb8, 0x4c, 0x2c, 0x8a, 0x0d, 0xdf, 0xc5, 0x9a, 0x6e, 0x76, 0x89, 0xa5, 0xe9, 0x86, 0xc1,
+	0x7d, 0xb4, 0x24, 0x26, 0xa7, 0x8d, 0x18, 0x5a, 0x8b, 0x09, 0x55, 0x9e, 0xaf, 0x8a, 0x74, 0xc0,
+	0xd0, 0x2d, 0x21, 0x9c, 0x93, 0xcb, 0x76, 0x71, 0xe3, 0xbd, 0x0c, 0x35, 0xaf, 0xb8, 0xbc, 0x0b,
+	0xe6, 0xbc, 0x16, 0xee, 0x62, 0x08, 0xc4, 0xd4, 0x3f, 0x1f, 0x31, 0x14, 0x02, 0x01, 0x43, 0x37,
+	0xc2, 0x35, 0xe5, 0x51, 0xca, 0xba, 0xd1, 0x05, 0xf7, 0xec, 0x42, 0x74, 0xad, 0x86, 0x43, 0xe4,
+	0x03, 0xb0, 0x64, 0xe2, 0x86, 0xdf, 0x6c, 0x12, 0xab, 0x09, 0xcf, 0x8b, 0x59, 0x3d, 0x1c, 0x31,
+	0x34, 0x06, 0x93, 0xd3, 0x9c, 0x20, 0xc9, 0x76, 0xad, 0x64, 0x21, 0x75, 0x3c, 0x48, 0xfe, 0x53,
+	0x02, 0x30, 0x59, 0x39, 0xda, 0x26, 0x8e, 0xd6, 0xb2, 0xa9, 0xa7, 0x19, 0x2d, 0x6c, 0xb4, 0xe1,
+	0xb2, 0x90, 0xf9, 0x8e, 0xfb, 0x3a, 0xe6, 0xec, 0xb7, 0x89, 0xf3, 0xcc, 0xa6, 0x9e, 0x20, 0x24,
+	0xbe, 0xce, 0xcd, 0x9e, 0xf1, 0xf5, 0x07, 0x38, 0x41, 0x5f, 0xc9, 0x17, 0x51, 0x27, 0xe0, 0x3a,
+	0x87, 0xe5, 0x3f, 0x24, 0x70, 0x7d, 0xbc, 0xe7, 0x9d, 0x8e, 0x7d, 0xa4, 0x1d, 0xba, 0x7a, 0x17,
+	0x6b, 0x1d, 0x5b, 0x37, 0xf9, 0x22, 0x5d, 0x10, 0xdd, 0xbf, 0x1a, 0x31, 0x74, 0x35, 0xd9, 0x1d,
+	0x4e, 0x7b, 0xc2, 0x59, 0xcf, 0x43, 0x52, 0xc0, 0xd0, 0x9d, 0xec, 0x01, 0x38, 0xcb, 0xc8, 0xce,
+	0xe2, 0xd6, 0x47, 0xf0, 0xd4, 0xe9, 0x72, 0xf2, 0x0f, 0x12, 0x58, 0xa7, 0xd8, 0x32, 0xb5, 0x86,
+	0x4e, 0x89, 0xa1, 0x09, 0xc7, 0x3b, 0xae, 0xdd, 0x75, 0x3c, 0xb8, 0x22, 0xda, 0x3d, 0xe0, 0x27,
+	0x95, 0x33, 0x6a, 0x9c, 0xc0, 0x8d, 0xbf, 0x27, 0xd2, 0x01, 0x43, 0x05, 0xd1, 0x68, 0x4e, 0x2e,
+	0xd9, 0x67, 0x38, 0x2d, 0xa9, 0xe6, 0x95, 0x94, 0x7f, 0x95, 0xc0, 0x45, 0x07, 0x5b, 0xbc, 0x31,
+	0x2d, 0x76, 0xe9, 0x45, 0x71, 0x54, 0xbf, 0xe7, 0xf7, 0xf9, 0x0b, 0x7b, 0x61, 0x2e, 0x71, 0xeb,
+	0x85, 0x88, 0x5c, 0x8d, 0x4d, 0x1b, 0x1e, 0xe2, 0x31, 0x3a, 0xe1, 0xdd, 0x2b, 0x53, 0x72, 0x41,
+	0x5f, 0xc9, 0x16, 0xeb, 0x0d, 0x94, 0xac, 0x9c, 0x9a, 0xcd, 0xcb, 0x5f, 0x83, 0x39, 0x7e, 0x0b,
+	0xa6, 0x70, 0xb5, 0x78, 0xae, 0x7c, 0x7e, 0xf3, 0x62, 0x7c, 0x6b, 0x7c, 0x7a, 0xb0, 0x73, 0x40,
+	0xb1, 0x5b, 0xdb, 0x78, 0xc3, 0xd0, 0x0c, 0x37, 0x98, 0x60, 0x05, 0x0c, 0xad, 0x26, 0x77, 0x76,
+	0xba, 0xcd, 0x7f, 0x79, 0x3b, 0x60, 0x1c, 0xaa, 0x21, 0x51, 0xfe, 0x51, 0x02, 0x8b, 0xd1, 0xdc,
+	0x29, 0xfc, 0xbf, 0xa8, 0x7d, 0x29, 0xae, 0xbd, 0x2f, 0xde, 0x12, 0xc2, 0x7e, 0x6a, 0x2f, 0xb9,
+	0xc0, 0x90, 0xa1, 0x85, 0x30, 0x0e, 0x9f, 0xc5, 0xa2, 0x37, 0x9a, 0x38, 0x2f, 0x8a, 0xb7, 0xc3,
+	0x7f, 0xe1, 0xbc, 0x2c, 0x14, 0xf4, 0x95, 0x78, 0x50, 0x6f, 0xa0, 0xc4, 0xa5, 0xd4, 0x18, 0x93,
+	0x09, 0x98, 0xe5, 0xaf, 0x2f, 0x50, 0x2e, 0x4a, 0xe5, 0xf3, 0x9b, 0x57, 0xe3, 0x46, 0xbe, 0xdc,
+	0x79, 0x5c, 0xcf, 0xbc, 0x8d, 0xd4, 0xb6, 0xa2, 0x6e, 0x66, 0x79, 0x8a, 0x3f, 0xcf, 0xf8, 0xb0,
+	0x80, 0x21, 0x20, 0xfa, 0xe0, 0x01, 0x57, 0x0f, 0xd1, 0xe8, 0xbf, 0x37, 0x50, 0x04, 0x5b, 0x15,
+	0x91, 0xfc, 0x5a, 0x02, 0x2b, 0xe1, 0x8b, 0x96, 0x66, 0xe8, 0xda, 0x21, 0xe9, 0x60, 0xb8, 0x26,
+	0xf6, 0xfe, 0x9b, 0x21, 0x43, 0xcb, 0x75, 0x91, 0xa9, 0x57, 0x9f, 0x90, 0x0e, 0x1e, 0x31, 0xb4,
+	0x6c, 0xa4, 0xe2, 0x80, 0xa1, 0xeb, 0x42, 0x26, 0x0d, 0x66, 0xf7, 0x7d, 0x3d, 0x3f, 0x15, 0xf4,
+	0x95, 0x4c, 0xa5, 0xde, 0x40, 0xc9, 0x28, 0xa9, 0x71, 0x56, 0xe7, 0x91, 0xfc, 0x97, 0x04, 0xd6,
+	0xe2, 0x16, 0xc7, 0x2f, 0x83, 0x14, 0x5e, 0x12, 0xdb, 0x74, 0x3d, 0x75, 0x04, 0xa2, 0x22, 0x63,
+	0x52, 0xcd, 0x89, 0xce, 0x83, 0x6c, 0x9c, 0x4d, 0xf1, 0xed, 0xfa, 0x34, 0xdd, 0x7f, 0x2a, 0xb5,
+	0x3d, 0x01, 0xf1, 0xd9, 0x28, 0x1f, 0x43, 0x54, 0x73, 0x94, 0xe4, 0xdf, 0x25, 0x70, 0xcd, 0xc5,
+	0xaf, 0x7c, 0xe2, 0x62, 0x6d, 0x72, 0x26, 0xf0, 0xb2, 0xb0, 0xbc, 0x35, 0x62, 0x08, 0x46, 0xac,
+	0x89, 0x89, 0x04, 0x0c, 0x95, 0x45, 0xb3, 0xd3, 0x08, 0xd9, 0x85, 0xff, 0x08, 0x9a, 0x3a, 0x55,
+	0x4b, 0x7e, 0x2b, 0x81, 0x75, 0xc3, 0xb6, 0x3c, 0xde, 0xa7, 0x49, 0x9a, 0x98, 0x7a, 0x1a, 0x7f,
+	0x94, 0xb6, 0x49, 0x03, 0xae, 0x17, 0xa5, 0xf2, 0x5c, 0xed, 0x37, 0x7e, 0x5f, 0x58, 0xab, 0x87,
+	0x94, 0xc7, 0x82, 0xf1, 0x82, 0x58, 0xbb, 0xa4, 0xc6, 0xef, 0x5a, 0xc6, 0x24, 0x9c, 0x3c, 0x5f,
+	0x73, 0x72, 0xa9, 0xc6, 0xdf, 0x31, 0x74, 0x8e, 0x58, 0x1e, 0x7f, 0xcc, 0xbe, 0x97, 0x18, 0xf4,
+	0x95, 0x3c, 0x95, 0xde, 0x40, 0xc9, 0xeb, 0x49, 0xcd, 0xe1, 0x36, 0xb8, 0xc5, 0xf8, 0x17, 0x0a,
+	0xbc, 0x92, 0xb5, 0x58, 0xb5, 0xfe, 0xe2, 0x8b, 0x29, 0x16, 0xe3, 0x29, 0x6e, 0x31, 0x3e, 0x2c,
+	0xb1, 0x18, 0x0f, 0x84, 0xc5, 0x04, 0x1a, 0xfd, 0x73, 0x8b, 0x71, 0xb6, 0x2a, 0xa2, 0xda, 0xee,
+	0x9b, 0xb7, 0x85, 0x99, 0xc1, 0xdb, 0xc2, 0xcc, 0x9b, 0x61, 0x41, 0x1a, 0x0c, 0x0b, 0xd2, 0x4f,
+	0xa7, 0x85, 0x99, 0xd7, 0xa7, 0x05, 0x69, 0x70, 0x5a, 0x98, 0xf9, 0xf7, 0xb4, 0x30, 0xf3, 0xf2,
+	0x4e, 0x93, 0x78, 0x2d, 0xbf, 0x51, 0x31, 0xec, 0xee, 0x06, 0x3d, 0xb1, 0x0c, 0xaf, 0x45, 0xac,
+	0x66, 0xea, 0x6a, 0xfc, 0xc9, 0xd2, 0x98, 0x17, 0xdf, 0x26, 0x0f, 0xfe, 0x1b, 0x00, 0x67, 0x4b,
+	0x5e, 0x66, 0x96, 0x0d, 0x00, 0x00,
 }
 
 func (m *GUIConfiguration) Marshal() (dAtA []byte, err error) {
@@ -159,6 +214,113 @@ func (m *GUIConfiguration) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.ACME.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGuiconfiguration(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xba
+	if m.ContentDigestMinKiB != 0 {
+		i = encodeVarintGuiconfiguration(dAtA, i, uint64(m.ContentDigestMinKiB))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb0
+	}
+	if m.RequireClientCertificate {
+		i--
+		if m.RequireClientCertificate {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa8
+	}
+	if len(m.ClientCertificates) > 0 {
+		for iNdEx := len(m.ClientCertificates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ClientCertificates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGuiconfiguration(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xa2
+		}
+	}
+	if len(m.ClientCAFile) > 0 {
+		i -= len(m.ClientCAFile)
+		copy(dAtA[i:], m.ClientCAFile)
+		i = encodeVarintGuiconfiguration(dAtA, i, uint64(len(m.ClientCAFile)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x9a
+	}
+	{
+		size, err := m.OIDC.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGuiconfiguration(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x92
+	if len(m.APIKeys) > 0 {
+		for iNdEx := len(m.APIKeys) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.APIKeys[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGuiconfiguration(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if len(m.Users) > 0 {
+		for iNdEx := len(m.Users) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Users[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGuiconfiguration(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if len(m.PendingAPIKey) > 0 {
+		i -= len(m.PendingAPIKey)
+		copy(dAtA[i:], m.PendingAPIKey)
+		i = encodeVarintGuiconfiguration(dAtA, i, uint64(len(m.PendingAPIKey)))
+		i--
+		dAtA[i] = 0x7a
+	}
 	if m.SendBasicAuthPrompt {
 		i--
 		if m.SendBasicAuthPrompt {
@@ -344,6 +506,42 @@ func (m *GUIConfiguration) ProtoSize() (n int) {
 	if m.SendBasicAuthPrompt {
 		n += 2
 	}
+	l = len(m.PendingAPIKey)
+	if l > 0 {
+		n += 1 + l + sovGuiconfiguration(uint64(l))
+	}
+	if len(m.Users) > 0 {
+		for _, e := range m.Users {
+			l = e.ProtoSize()
+			n += 2 + l + sovGuiconfiguration(uint64(l))
+		}
+	}
+	if len(m.APIKeys) > 0 {
+		for _, e := range m.APIKeys {
+			l = e.ProtoSize()
+			n += 2 + l + sovGuiconfiguration(uint64(l))
+		}
+	}
+	l = m.OIDC.ProtoSize()
+	n += 2 + l + sovGuiconfiguration(uint64(l))
+	l = len(m.ClientCAFile)
+	if l > 0 {
+		n += 2 + l + sovGuiconfiguration(uint64(l))
+	}
+	if len(m.ClientCertificates) > 0 {
+		for _, e := range m.ClientCertificates {
+			l = e.ProtoSize()
+			n += 2 + l + sovGuiconfiguration(uint64(l))
+		}
+	}
+	if m.RequireClientCertificate {
+		n += 3
+	}
+	if m.ContentDigestMinKiB != 0 {
+		n += 2 + sovGuiconfiguration(uint64(m.ContentDigestMinKiB))
+	}
+	l = m.ACME.ProtoSize()
+	n += 2 + l + sovGuiconfiguration(uint64(l))
 	return n
 }
 
@@ -733,6 +931,277 @@ func (m *GUIConfiguration) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.SendBasicAuthPrompt = bool(v != 0)
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingAPIKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGuiconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGuiconfiguration
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGuiconfiguration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingAPIKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Users", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGuiconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGuiconfiguration
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGuiconfiguration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Users = append(m.Users, GUIUser{})
+			if err := m.Users[len(m.Users)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field APIKeys", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGuiconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGuiconfiguration
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGuiconfiguration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.APIKeys = append(m.APIKeys, ScopedAPIKey{})
+			if err := m.APIKeys[len(m.APIKeys)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OIDC", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGuiconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGuiconfiguration
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGuiconfiguration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OIDC.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientCAFile", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGuiconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGuiconfiguration
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGuiconfiguration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientCAFile = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientCertificates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGuiconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGuiconfiguration
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGuiconfiguration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientCertificates = append(m.ClientCertificates, GUIClientCertificate{})
+			if err := m.ClientCertificates[len(m.ClientCertificates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 21:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequireClientCertificate", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGuiconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RequireClientCertificate = bool(v != 0)
+		case 22:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContentDigestMinKiB", wireType)
+			}
+			m.ContentDigestMinKiB = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGuiconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ContentDigestMinKiB |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ACME", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGuiconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGuiconfiguration
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGuiconfiguration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ACME.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGuiconfiguration(dAtA[iNdEx:])
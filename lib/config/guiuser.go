@@ -0,0 +1,81 @@
+// Copyright (C) 2026 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package config
+
+import (
+	"slices"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+func (r UserRole) String() string {
+	switch r {
+	case UserRoleReadOnly:
+		return "readOnly"
+	case UserRoleAdmin:
+		return "admin"
+	case UserRoleFolderOperator:
+		return "folderOperator"
+	default:
+		return "unknown"
+	}
+}
+
+func (r UserRole) MarshalText() ([]byte, error) {
+	return []byte(r.String()), nil
+}
+
+func (r *UserRole) UnmarshalText(bs []byte) error {
+	switch string(bs) {
+	case "admin":
+		*r = UserRoleAdmin
+	case "folderOperator":
+		*r = UserRoleFolderOperator
+	default:
+		// Anything unknown gets the least privileges.
+		*r = UserRoleReadOnly
+	}
+	return nil
+}
+
+// SetPassword takes a bcrypt hash or a plaintext password and stores it.
+// Plaintext passwords are hashed.
+func (u *GUIUser) SetPassword(password string) error {
+	if bcryptExpr.MatchString(password) {
+		u.Password = password
+		return nil
+	}
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return err
+	}
+	u.Password = string(hash)
+	return nil
+}
+
+// CompareHashedPassword returns nil when the given plaintext password matches the stored hash.
+func (u GUIUser) CompareHashedPassword(password string) error {
+	return bcrypt.CompareHashAndPassword([]byte(u.Password), []byte(password))
+}
+
+// CanOperate returns true if the user may act on the given folder, beyond
+// looking at it.
+func (u GUIUser) CanOperate(folder string) bool {
+	switch u.Role {
+	case UserRoleAdmin:
+		return true
+	case UserRoleFolderOperator:
+		return folder != "" && slices.Contains(u.Folders, folder)
+	default:
+		return false
+	}
+}
+
+func (u GUIUser) Copy() GUIUser {
+	u.Folders = slices.Clone(u.Folders)
+	return u
+}
@@ -0,0 +1,476 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: lib/config/guiuser.proto
+
+package config
+
+import (
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	_ "github.com/syncthing/syncthing/proto/ext"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type UserRole int32
+
+const (
+	UserRoleReadOnly       UserRole = 0
+	UserRoleAdmin          UserRole = 1
+	UserRoleFolderOperator UserRole = 2
+)
+
+var UserRole_name = map[int32]string{
+	0: "USER_ROLE_READ_ONLY",
+	1: "USER_ROLE_ADMIN",
+	2: "USER_ROLE_FOLDER_OPERATOR",
+}
+
+var UserRole_value = map[string]int32{
+	"USER_ROLE_READ_ONLY":       0,
+	"USER_ROLE_ADMIN":           1,
+	"USER_ROLE_FOLDER_OPERATOR": 2,
+}
+
+func (UserRole) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_c32d337d5bb21b69, []int{0}
+}
+
+// An additional user of the GUI and API. Read only users can look but not
+// change anything, folder operators can in addition scan, override, revert
+// and change the ignores of the listed folders, and admins can do anything.
+type GUIUser struct {
+	Name     string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name" xml:"name,attr"`
+	Password string   `protobuf:"bytes,2,opt,name=password,proto3" json:"password" xml:"password,omitempty"`
+	Role     UserRole `protobuf:"varint,3,opt,name=role,proto3,enum=config.UserRole" json:"role" xml:"role,attr"`
+	Folders  []string `protobuf:"bytes,4,rep,name=folders,proto3" json:"folders" xml:"folder"`
+}
+
+func (m *GUIUser) Reset()         { *m = GUIUser{} }
+func (m *GUIUser) String() string { return proto.CompactTextString(m) }
+func (*GUIUser) ProtoMessage()    {}
+func (*GUIUser) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c32d337d5bb21b69, []int{0}
+}
+func (m *GUIUser) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GUIUser) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GUIUser.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GUIUser) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GUIUser.Merge(m, src)
+}
+func (m *GUIUser) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *GUIUser) XXX_DiscardUnknown() {
+	xxx_messageInfo_GUIUser.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GUIUser proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterEnum("config.UserRole", UserRole_name, UserRole_value)
+	proto.RegisterType((*GUIUser)(nil), "config.GUIUser")
+}
+
+func init() { proto.RegisterFile("lib/config/guiuser.proto", fileDescriptor_c32d337d5bb21b69) }
+
+var fileDescriptor_c32d337d5bb21b69 = []byte{
+	// 456 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x92, 0x4d, 0x8b, 0xd3, 0x40,
+	0x1c, 0xc6, 0x33, 0xdd, 0xd2, 0xdd, 0x0e, 0xea, 0xc6, 0x51, 0x24, 0xe6, 0x30, 0x09, 0xf5, 0x85,
+	0x2a, 0x6b, 0x03, 0x7a, 0x52, 0x44, 0x69, 0x69, 0x56, 0x16, 0x6b, 0x23, 0xa3, 0x3d, 0xb8, 0x97,
+	0x92, 0xb6, 0xd3, 0x6c, 0x20, 0xc9, 0x84, 0xc9, 0x14, 0xb7, 0x5f, 0xa1, 0x27, 0xbf, 0x40, 0x41,
+	0xc4, 0x83, 0x1f, 0xc0, 0x0f, 0xb1, 0x37, 0x7b, 0xf4, 0x14, 0xd8, 0xed, 0xad, 0xc7, 0x7e, 0x02,
+	0xc9, 0x84, 0xa4, 0xc2, 0xde, 0x9e, 0xe7, 0xe1, 0x37, 0x4f, 0x9e, 0xc0, 0x1f, 0x6a, 0x81, 0x3f,
+	0xb2, 0xc6, 0x2c, 0x9a, 0xfa, 0x9e, 0xe5, 0xcd, 0xfc, 0x59, 0x42, 0x79, 0x2b, 0xe6, 0x4c, 0x30,
+	0x54, 0xcb, 0x53, 0xfd, 0x01, 0xa7, 0x31, 0x4b, 0x2c, 0x19, 0x8e, 0x66, 0x53, 0xcb, 0x63, 0x1e,
+	0x93, 0x46, 0xaa, 0x1c, 0xd6, 0xeb, 0xf4, 0x5c, 0xe4, 0xb2, 0xf1, 0xbb, 0x02, 0xf7, 0xdf, 0x0d,
+	0x4e, 0x06, 0x09, 0xe5, 0xe8, 0x35, 0xac, 0x46, 0x6e, 0x48, 0x35, 0x60, 0x82, 0x66, 0xbd, 0xd3,
+	0xdc, 0xa4, 0x86, 0xf4, 0xdb, 0xd4, 0x38, 0x3c, 0x0f, 0x83, 0x57, 0x8d, 0xcc, 0x1c, 0xb9, 0x42,
+	0xf0, 0xc6, 0xe6, 0xcf, 0xc3, 0x7a, 0xe9, 0x88, 0xa4, 0xd0, 0x29, 0x3c, 0x88, 0xdd, 0x24, 0xf9,
+	0xca, 0xf8, 0x44, 0xab, 0xc8, 0x86, 0x37, 0x9b, 0xd4, 0x28, 0xb3, 0x6d, 0x6a, 0x68, 0xb2, 0xa5,
+	0x08, 0x8e, 0x58, 0xe8, 0x0b, 0x1a, 0xc6, 0x62, 0x9e, 0xd5, 0xa1, 0xeb, 0x31, 0x29, 0xdf, 0xa2,
+	0x3e, 0xac, 0x72, 0x16, 0x50, 0x6d, 0xcf, 0x04, 0xcd, 0x5b, 0xcf, 0xd5, 0x56, 0xfe, 0xb3, 0xad,
+	0x6c, 0x35, 0x61, 0x01, 0xcd, 0xb7, 0x66, 0x44, 0xb9, 0x35, 0x33, 0xbb, 0xad, 0xa5, 0x23, 0x92,
+	0x42, 0x6f, 0xe1, 0xfe, 0x94, 0x05, 0x13, 0xca, 0x13, 0xad, 0x6a, 0xee, 0x35, 0xeb, 0x9d, 0x47,
+	0x9b, 0xd4, 0x28, 0xa2, 0x6d, 0x6a, 0xdc, 0x90, 0x1d, 0xb9, 0xcf, 0x0a, 0x6a, 0xb9, 0x24, 0x05,
+	0xf2, 0xf4, 0x07, 0x80, 0x07, 0xc5, 0xd7, 0xd1, 0x33, 0x78, 0x67, 0xf0, 0xc9, 0x26, 0x43, 0xe2,
+	0xf4, 0xec, 0x21, 0xb1, 0xdb, 0xdd, 0xa1, 0xd3, 0xef, 0x7d, 0x51, 0x15, 0xfd, 0xee, 0x62, 0x69,
+	0xaa, 0x05, 0x46, 0xa8, 0x3b, 0x71, 0xa2, 0x60, 0x8e, 0x1e, 0xc3, 0xc3, 0x1d, 0xde, 0xee, 0x7e,
+	0x38, 0xe9, 0xab, 0x40, 0xbf, 0xbd, 0x58, 0x9a, 0x37, 0x0b, 0xb4, 0x3d, 0x09, 0xfd, 0x08, 0xbd,
+	0x84, 0xf7, 0x77, 0xdc, 0xb1, 0xd3, 0xeb, 0xda, 0x64, 0xe8, 0x7c, 0xb4, 0x49, 0xfb, 0xb3, 0x43,
+	0xd4, 0x8a, 0xae, 0x2f, 0x96, 0xe6, 0xbd, 0xe2, 0xc5, 0xb1, 0xdc, 0xe5, 0xc4, 0x94, 0xbb, 0x82,
+	0x71, 0xbd, 0xfa, 0xeb, 0x27, 0x56, 0x3a, 0xef, 0x2f, 0x2e, 0xb1, 0xb2, 0xba, 0xc4, 0xca, 0xc5,
+	0x15, 0x06, 0xab, 0x2b, 0x0c, 0xbe, 0xad, 0xb1, 0xf2, 0x7d, 0x8d, 0xc1, 0x6a, 0x8d, 0x95, 0xbf,
+	0x6b, 0xac, 0x9c, 0x3e, 0xf1, 0x7c, 0x71, 0x36, 0x1b, 0xb5, 0xc6, 0x2c, 0xb4, 0x92, 0x79, 0x34,
+	0x16, 0x67, 0x7e, 0xe4, 0xfd, 0xa7, 0x76, 0xe7, 0x36, 0xaa, 0xc9, 0x7b, 0x79, 0xf1, 0x6f, 0x00,
+	0x2f, 0x48, 0x2a, 0xe2, 0x83, 0x02, 0x00, 0x00,
+}
+
+func (m *GUIUser) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GUIUser) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GUIUser) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Folders) > 0 {
+		for iNdEx := len(m.Folders) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Folders[iNdEx])
+			copy(dAtA[i:], m.Folders[iNdEx])
+			i = encodeVarintGuiuser(dAtA, i, uint64(len(m.Folders[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.Role != 0 {
+		i = encodeVarintGuiuser(dAtA, i, uint64(m.Role))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Password) > 0 {
+		i -= len(m.Password)
+		copy(dAtA[i:], m.Password)
+		i = encodeVarintGuiuser(dAtA, i, uint64(len(m.Password)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintGuiuser(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGuiuser(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGuiuser(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GUIUser) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovGuiuser(uint64(l))
+	}
+	l = len(m.Password)
+	if l > 0 {
+		n += 1 + l + sovGuiuser(uint64(l))
+	}
+	if m.Role != 0 {
+		n += 1 + sovGuiuser(uint64(m.Role))
+	}
+	if len(m.Folders) > 0 {
+		for _, s := range m.Folders {
+			l = len(s)
+			n += 1 + l + sovGuiuser(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovGuiuser(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGuiuser(x uint64) (n int) {
+	return sovGuiuser(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GUIUser) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGuiuser
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GUIUser: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GUIUser: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGuiuser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGuiuser
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGuiuser
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Password", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGuiuser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGuiuser
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGuiuser
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Password = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+			}
+			m.Role = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGuiuser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Role |= UserRole(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Folders", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGuiuser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGuiuser
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGuiuser
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Folders = append(m.Folders, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGuiuser(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGuiuser
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipGuiuser(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowGuiuser
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGuiuser
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGuiuser
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthGuiuser
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupGuiuser
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthGuiuser
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthGuiuser        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowGuiuser          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupGuiuser = fmt.Errorf("proto: unexpected end of group")
+)
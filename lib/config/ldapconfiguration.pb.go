@@ -30,6 +30,12 @@ type LDAPConfiguration struct {
 	InsecureSkipVerify bool          `protobuf:"varint,4,opt,name=insecure_skip_verify,json=insecureSkipVerify,proto3" json:"insecureSkipVerify" xml:"insecureSkipVerify,omitempty" default:"false"`
 	SearchBaseDN       string        `protobuf:"bytes,5,opt,name=search_base_dn,json=searchBaseDn,proto3" json:"searchBaseDN" xml:"searchBaseDN,omitempty"`
 	SearchFilter       string        `protobuf:"bytes,6,opt,name=search_filter,json=searchFilter,proto3" json:"searchFilter" xml:"searchFilter,omitempty"`
+	// When set, this account is bound first to search for the user, whose
+	// DN is then bound with the password instead of the bind DN pattern.
+	ServiceBindDN       string `protobuf:"bytes,7,opt,name=service_bind_dn,json=serviceBindDn,proto3" json:"serviceBindDN" xml:"serviceBindDN,omitempty"`
+	ServiceBindPassword string `protobuf:"bytes,8,opt,name=service_bind_password,json=serviceBindPassword,proto3" json:"serviceBindPassword" xml:"serviceBindPassword,omitempty"`
+	// When set, only members of this group may log in.
+	RequiredGroupDN string `protobuf:"bytes,9,opt,name=required_group_dn,json=requiredGroupDn,proto3" json:"requiredGroupDN" xml:"requiredGroupDN,omitempty"`
 }
 
 func (m *LDAPConfiguration) Reset()         { *m = LDAPConfiguration{} }
@@ -74,39 +80,48 @@ func init() {
 }
 
 var fileDescriptor_9681ad7e41c73956 = []byte{
-	// 500 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x53, 0xbf, 0x6f, 0xd3, 0x40,
-	0x14, 0xb6, 0x81, 0xba, 0xc4, 0x2a, 0x15, 0x35, 0x50, 0x4c, 0x55, 0xf9, 0x22, 0xcb, 0x43, 0x90,
-	0x50, 0x22, 0x95, 0xad, 0x4c, 0x35, 0x15, 0x03, 0x20, 0x84, 0x5c, 0xe8, 0xc0, 0x12, 0xf9, 0xc7,
-	0x39, 0x39, 0xd5, 0x39, 0x5b, 0x77, 0xe7, 0xaa, 0xe1, 0xaf, 0x80, 0xfe, 0x05, 0xdd, 0xf8, 0x57,
-	0xba, 0xc5, 0x23, 0xd3, 0x49, 0x4d, 0x36, 0x8f, 0x1e, 0x99, 0x50, 0xce, 0x4e, 0x63, 0xa7, 0x51,
-	0xb7, 0xf7, 0xbe, 0xef, 0xbd, 0xef, 0x7d, 0x77, 0x4f, 0x4f, 0x35, 0x23, 0xe4, 0xf5, 0xfc, 0x18,
-	0x87, 0x68, 0xd0, 0x8b, 0x02, 0x37, 0x29, 0xc3, 0x94, 0xb8, 0x0c, 0xc5, 0xb8, 0x9b, 0x90, 0x98,
-	0xc5, 0x9a, 0x52, 0x82, 0x7b, 0xc6, 0x4a, 0x2d, 0x23, 0x2e, 0xa6, 0x49, 0x4c, 0x58, 0x59, 0xb7,
-	0xd7, 0x82, 0x17, 0x55, 0x68, 0xfe, 0x56, 0xd4, 0x9d, 0xcf, 0xc7, 0x47, 0x5f, 0xdf, 0xd7, 0xe5,
-	0xb4, 0xef, 0xea, 0xa6, 0x1b, 0x04, 0x04, 0x52, 0xaa, 0xcb, 0x6d, 0xb9, 0xd3, 0xb2, 0xdf, 0xe5,
-	0x1c, 0x2c, 0xa0, 0x82, 0x83, 0x97, 0x17, 0xa3, 0xe8, 0xd0, 0xac, 0xf2, 0x37, 0xf1, 0x08, 0x31,
-	0x38, 0x4a, 0xd8, 0xd8, 0xcc, 0x27, 0xd6, 0xce, 0x1d, 0xd4, 0x59, 0x34, 0x6a, 0xb1, 0xba, 0xe9,
-	0x21, 0x1c, 0xf4, 0x03, 0xac, 0x3f, 0x10, 0xb2, 0xa7, 0x53, 0x0e, 0x14, 0x1b, 0xe1, 0xe0, 0xf8,
-	0x4b, 0xce, 0x81, 0xe2, 0x89, 0xa8, 0xe0, 0x60, 0x57, 0xe8, 0x97, 0x69, 0x53, 0xfe, 0xe9, 0x2a,
-	0x58, 0x4c, 0xac, 0xaa, 0xef, 0x32, 0xb3, 0x2a, 0x2d, 0xa7, 0x44, 0xb0, 0x76, 0xae, 0xb6, 0x6e,
-	0xdf, 0xae, 0x3f, 0x6c, 0xcb, 0x9d, 0xed, 0x83, 0x17, 0xdd, 0xf2, 0x63, 0xba, 0xf3, 0x57, 0x7f,
-	0x5b, 0x90, 0xf6, 0x51, 0xce, 0xc1, 0xb2, 0xb6, 0xe0, 0xe0, 0x95, 0xb0, 0x70, 0x8b, 0x34, 0x5d,
-	0x3c, 0x5b, 0x83, 0x3b, 0xcb, 0x76, 0xed, 0x8f, 0xac, 0x3e, 0x47, 0x98, 0x42, 0x3f, 0x25, 0xb0,
-	0x4f, 0xcf, 0x50, 0xd2, 0x3f, 0x87, 0x04, 0x85, 0x63, 0xfd, 0x51, 0x5b, 0xee, 0x3c, 0xb6, 0xd3,
-	0x9c, 0x03, 0x6d, 0xc1, 0x9f, 0x9c, 0xa1, 0xe4, 0x54, 0xb0, 0x05, 0x07, 0x07, 0x62, 0xea, 0x5d,
-	0xaa, 0x36, 0xbe, 0x1d, 0xc0, 0xd0, 0x4d, 0x23, 0x76, 0x68, 0x86, 0x6e, 0x44, 0xe1, 0xdc, 0xce,
-	0xfe, 0x7d, 0x0d, 0xff, 0x26, 0xd6, 0x86, 0xa8, 0x74, 0xd6, 0x8c, 0xd4, 0xae, 0x64, 0x75, 0x9b,
-	0x42, 0x97, 0xf8, 0xc3, 0xbe, 0xe7, 0x52, 0x38, 0x5f, 0xcd, 0x86, 0x58, 0xcd, 0xcf, 0x29, 0x07,
-	0x5b, 0x27, 0x82, 0xb1, 0x5d, 0x0a, 0xc5, 0x82, 0xb6, 0x68, 0x2d, 0x2f, 0x38, 0xd8, 0x17, 0x6e,
-	0xeb, 0x60, 0xf3, 0x9b, 0x76, 0xd7, 0x53, 0xc5, 0xc4, 0x6a, 0x28, 0x5d, 0x66, 0x56, 0x63, 0x92,
-	0x53, 0x67, 0xb1, 0x16, 0xab, 0x4f, 0x2a, 0x87, 0x21, 0x8a, 0x18, 0x24, 0xba, 0x22, 0x0c, 0x7e,
-	0x5c, 0x1a, 0xfa, 0x20, 0xf0, 0x15, 0x43, 0x25, 0xb8, 0xd6, 0xd0, 0x2a, 0xe5, 0x34, 0x74, 0xec,
-	0x4f, 0xd7, 0x37, 0x86, 0x94, 0xdd, 0x18, 0xd2, 0xf5, 0xd4, 0x90, 0xb3, 0xa9, 0x21, 0xff, 0x9a,
-	0x19, 0xd2, 0xd5, 0xcc, 0x90, 0xb3, 0x99, 0x21, 0xfd, 0x9d, 0x19, 0xd2, 0x8f, 0xd7, 0x03, 0xc4,
-	0x86, 0xa9, 0xd7, 0xf5, 0xe3, 0x51, 0x8f, 0x8e, 0xb1, 0xcf, 0x86, 0x08, 0x0f, 0x6a, 0xd1, 0xf2,
-	0xfe, 0x3c, 0x45, 0xdc, 0xd9, 0xdb, 0xff, 0x01, 0x00, 0x00, 0xff, 0xff, 0xbf, 0x64, 0x4b, 0x05,
-	0xc0, 0x03, 0x00, 0x00,
+	// 654 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x94, 0x3f, 0x6f, 0xd3, 0x40,
+	0x18, 0xc6, 0x73, 0x40, 0xd3, 0xc6, 0xea, 0x1f, 0xea, 0xd2, 0x36, 0xad, 0x5a, 0x5f, 0x14, 0x3c,
+	0x14, 0x09, 0xa5, 0x52, 0xd9, 0xca, 0xd4, 0x50, 0x81, 0x04, 0x08, 0x55, 0x2e, 0x74, 0x60, 0xb1,
+	0x9c, 0xf8, 0x92, 0x9e, 0x9a, 0x9c, 0xcd, 0x9d, 0x5d, 0x5a, 0x26, 0x3e, 0x00, 0x12, 0x28, 0x7c,
+	0x81, 0x6e, 0x88, 0x8f, 0xc0, 0x37, 0xe8, 0x16, 0x8f, 0x4c, 0x27, 0x35, 0xd9, 0x32, 0xde, 0xc8,
+	0x84, 0x72, 0x76, 0x1a, 0x9f, 0x9b, 0x76, 0x3b, 0x3f, 0xcf, 0xfb, 0x3e, 0xef, 0xcf, 0xef, 0x59,
+	0xd6, 0xca, 0x2d, 0x5c, 0xdb, 0xae, 0x7b, 0xa4, 0x81, 0x9b, 0xdb, 0x2d, 0xd7, 0xf1, 0xe3, 0x63,
+	0x48, 0x9d, 0x00, 0x7b, 0xa4, 0xe2, 0x53, 0x2f, 0xf0, 0xf4, 0x7c, 0x2c, 0xae, 0x1b, 0x99, 0xda,
+	0x80, 0x3a, 0x84, 0xf9, 0x1e, 0x0d, 0xe2, 0xba, 0xf5, 0x02, 0x3a, 0x4b, 0x8e, 0xe5, 0xef, 0x9a,
+	0xb6, 0xf8, 0x76, 0x7f, 0xef, 0xe0, 0x45, 0x3a, 0x4e, 0xff, 0xa0, 0x4d, 0x3b, 0xae, 0x4b, 0x11,
+	0x63, 0x45, 0x50, 0x02, 0x5b, 0x85, 0xea, 0xf3, 0x01, 0x87, 0x23, 0x49, 0x70, 0xb8, 0x7a, 0xd6,
+	0x6e, 0xed, 0x96, 0x93, 0xe7, 0xa7, 0x5e, 0x1b, 0x07, 0xa8, 0xed, 0x07, 0xe7, 0xe5, 0x41, 0xd7,
+	0x5c, 0xbc, 0xa1, 0x5a, 0xa3, 0x46, 0xdd, 0xd3, 0xa6, 0x6b, 0x98, 0xb8, 0xb6, 0x4b, 0x8a, 0xf7,
+	0x64, 0xec, 0x51, 0x8f, 0xc3, 0x7c, 0x15, 0x13, 0x77, 0xff, 0xdd, 0x80, 0xc3, 0x7c, 0x4d, 0x9e,
+	0x04, 0x87, 0x2b, 0x32, 0x3f, 0x7e, 0x54, 0xe3, 0x1f, 0x66, 0x45, 0xd1, 0x35, 0x93, 0xbe, 0x4e,
+	0x64, 0x26, 0x59, 0x56, 0xac, 0x10, 0xfd, 0x54, 0x2b, 0x5c, 0xbf, 0x7b, 0xf1, 0x7e, 0x09, 0x6c,
+	0xcd, 0xef, 0x2c, 0x57, 0xe2, 0xc5, 0x54, 0x86, 0x6f, 0xfd, 0x7e, 0x64, 0x56, 0xf7, 0x06, 0x1c,
+	0x8e, 0x6b, 0x05, 0x87, 0x6b, 0x12, 0xe1, 0x5a, 0x51, 0x29, 0x96, 0x26, 0xe8, 0xd6, 0xb8, 0x5d,
+	0xff, 0x05, 0xb4, 0x47, 0x98, 0x30, 0x54, 0x0f, 0x29, 0xb2, 0xd9, 0x09, 0xf6, 0xed, 0x53, 0x44,
+	0x71, 0xe3, 0xbc, 0xf8, 0xa0, 0x04, 0xb6, 0x66, 0xaa, 0xe1, 0x80, 0x43, 0x7d, 0xe4, 0x1f, 0x9e,
+	0x60, 0xff, 0x48, 0xba, 0x82, 0xc3, 0x1d, 0x39, 0xf5, 0xa6, 0x95, 0x1a, 0x5f, 0x72, 0x51, 0xc3,
+	0x09, 0x5b, 0xc1, 0x6e, 0xb9, 0xe1, 0xb4, 0x18, 0x1a, 0xe2, 0x6c, 0xdc, 0xd5, 0xf0, 0xaf, 0x6b,
+	0x4e, 0xc9, 0x4a, 0x6b, 0xc2, 0x48, 0xfd, 0x02, 0x68, 0xf3, 0x0c, 0x39, 0xb4, 0x7e, 0x6c, 0xd7,
+	0x1c, 0x86, 0x86, 0x57, 0x33, 0x25, 0xaf, 0xe6, 0x4b, 0x8f, 0xc3, 0xd9, 0x43, 0xe9, 0x54, 0x1d,
+	0x86, 0xe4, 0x05, 0xcd, 0xb2, 0xd4, 0xb3, 0xe0, 0x70, 0x43, 0xd2, 0xa6, 0x45, 0x75, 0x4d, 0x2b,
+	0x93, 0x2d, 0xd1, 0x35, 0x95, 0xa4, 0x4e, 0x64, 0x2a, 0x93, 0xac, 0xb4, 0x4b, 0x74, 0x4f, 0x9b,
+	0x4b, 0x08, 0x1b, 0xb8, 0x15, 0x20, 0x5a, 0xcc, 0x4b, 0xc0, 0xd7, 0x63, 0xa0, 0x97, 0x52, 0xcf,
+	0x00, 0xc5, 0xe2, 0x44, 0xa0, 0xac, 0x65, 0x29, 0x39, 0xfa, 0x6f, 0xa0, 0x2d, 0x30, 0x44, 0x4f,
+	0x71, 0x1d, 0xd9, 0xa3, 0xef, 0x75, 0x5a, 0xce, 0xfc, 0x0a, 0x7a, 0x1c, 0xce, 0x1d, 0xc6, 0xde,
+	0xf5, 0x77, 0x3b, 0xc7, 0xd2, 0x82, 0xe0, 0x70, 0x33, 0xc1, 0x48, 0xa9, 0x2a, 0xc7, 0xea, 0x2d,
+	0x9e, 0xe8, 0x9a, 0x6a, 0x58, 0x27, 0x32, 0xd5, 0x71, 0x96, 0xe2, 0x13, 0xfd, 0x27, 0xd0, 0x96,
+	0x15, 0x56, 0xdf, 0x61, 0xec, 0xb3, 0x47, 0xdd, 0xe2, 0x8c, 0x24, 0xb6, 0x07, 0x1c, 0x2e, 0xa5,
+	0x5a, 0x0e, 0x12, 0x5b, 0x70, 0xf8, 0x38, 0x4b, 0x39, 0xf2, 0x54, 0xd6, 0xcd, 0x3b, 0x2b, 0xac,
+	0x49, 0xe1, 0xfa, 0x1f, 0xa0, 0x2d, 0x52, 0xf4, 0x29, 0xc4, 0x14, 0xb9, 0x76, 0x93, 0x7a, 0xa1,
+	0x3f, 0xdc, 0x61, 0x41, 0x12, 0x7d, 0x1b, 0xee, 0x70, 0xc1, 0x4a, 0xdc, 0x57, 0x43, 0x53, 0x6e,
+	0x71, 0x81, 0xaa, 0x92, 0xe0, 0x10, 0x4a, 0xc2, 0x8c, 0xae, 0xd2, 0xad, 0xdd, 0xea, 0x8a, 0xae,
+	0x99, 0x8d, 0xec, 0x44, 0x66, 0x76, 0xb0, 0x95, 0xa9, 0x21, 0xd5, 0x37, 0x97, 0x57, 0x46, 0x2e,
+	0xba, 0x32, 0x72, 0x97, 0x3d, 0x03, 0x44, 0x3d, 0x03, 0xfc, 0xe8, 0x1b, 0xb9, 0x8b, 0xbe, 0x01,
+	0xa2, 0xbe, 0x91, 0xfb, 0xdb, 0x37, 0x72, 0x1f, 0x9f, 0x34, 0x71, 0x70, 0x1c, 0xd6, 0x2a, 0x75,
+	0xaf, 0xbd, 0xcd, 0xce, 0x49, 0x3d, 0x38, 0xc6, 0xa4, 0x99, 0x3a, 0x8d, 0xff, 0xbe, 0xb5, 0xbc,
+	0xfc, 0xcb, 0x3e, 0xfb, 0x3f, 0x00, 0x6e, 0x49, 0x00, 0xb1, 0xbe, 0x05, 0x00, 0x00,
 }
 
 func (m *LDAPConfiguration) Marshal() (dAtA []byte, err error) {
@@ -129,6 +144,27 @@ func (m *LDAPConfiguration) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.RequiredGroupDN) > 0 {
+		i -= len(m.RequiredGroupDN)
+		copy(dAtA[i:], m.RequiredGroupDN)
+		i = encodeVarintLdapconfiguration(dAtA, i, uint64(len(m.RequiredGroupDN)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.ServiceBindPassword) > 0 {
+		i -= len(m.ServiceBindPassword)
+		copy(dAtA[i:], m.ServiceBindPassword)
+		i = encodeVarintLdapconfiguration(dAtA, i, uint64(len(m.ServiceBindPassword)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.ServiceBindDN) > 0 {
+		i -= len(m.ServiceBindDN)
+		copy(dAtA[i:], m.ServiceBindDN)
+		i = encodeVarintLdapconfiguration(dAtA, i, uint64(len(m.ServiceBindDN)))
+		i--
+		dAtA[i] = 0x3a
+	}
 	if len(m.SearchFilter) > 0 {
 		i -= len(m.SearchFilter)
 		copy(dAtA[i:], m.SearchFilter)
@@ -214,6 +250,18 @@ func (m *LDAPConfiguration) ProtoSize() (n int) {
 	if l > 0 {
 		n += 1 + l + sovLdapconfiguration(uint64(l))
 	}
+	l = len(m.ServiceBindDN)
+	if l > 0 {
+		n += 1 + l + sovLdapconfiguration(uint64(l))
+	}
+	l = len(m.ServiceBindPassword)
+	if l > 0 {
+		n += 1 + l + sovLdapconfiguration(uint64(l))
+	}
+	l = len(m.RequiredGroupDN)
+	if l > 0 {
+		n += 1 + l + sovLdapconfiguration(uint64(l))
+	}
 	return n
 }
 
@@ -419,6 +467,102 @@ func (m *LDAPConfiguration) Unmarshal(dAtA []byte) error {
 			}
 			m.SearchFilter = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceBindDN", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLdapconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLdapconfiguration
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLdapconfiguration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ServiceBindDN = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceBindPassword", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLdapconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLdapconfiguration
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLdapconfiguration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ServiceBindPassword = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequiredGroupDN", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLdapconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLdapconfiguration
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLdapconfiguration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RequiredGroupDN = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLdapconfiguration(dAtA[iNdEx:])
@@ -0,0 +1,15 @@
+// Copyright (C) 2026 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package config
+
+import "slices"
+
+func (c OIDCConfiguration) Copy() OIDCConfiguration {
+	c.AllowedGroups = slices.Clone(c.AllowedGroups)
+	c.Scopes = slices.Clone(c.Scopes)
+	return c
+}
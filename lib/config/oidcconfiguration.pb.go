@@ -0,0 +1,659 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: lib/config/oidcconfiguration.proto
+
+package config
+
+import (
+	fmt "fmt"
+	proto "github.com/gogo/protobuf/proto"
+	_ "github.com/syncthing/syncthing/proto/ext"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Settings for logging in to the GUI through an OpenID Connect provider,
+// used with the OIDC authentication mode.
+type OIDCConfiguration struct {
+	IssuerURL    string `protobuf:"bytes,1,opt,name=issuer_url,json=issuerUrl,proto3" json:"issuerURL" xml:"issuerURL,omitempty"`
+	ClientID     string `protobuf:"bytes,2,opt,name=client_id,json=clientId,proto3" json:"clientID" xml:"clientID,omitempty"`
+	ClientSecret string `protobuf:"bytes,3,opt,name=client_secret,json=clientSecret,proto3" json:"clientSecret" xml:"clientSecret,omitempty"`
+	// Members of any of these groups may log in as admins. Other users of
+	// the provider may only log in as a configured GUI user of the same name.
+	AllowedGroups []string `protobuf:"bytes,4,rep,name=allowed_groups,json=allowedGroups,proto3" json:"allowedGroups" xml:"allowedGroup"`
+	GroupsClaim   string   `protobuf:"bytes,5,opt,name=groups_claim,json=groupsClaim,proto3" json:"groupsClaim" xml:"groupsClaim,omitempty" default:"groups"`
+	UsernameClaim string   `protobuf:"bytes,6,opt,name=username_claim,json=usernameClaim,proto3" json:"usernameClaim" xml:"usernameClaim,omitempty" default:"preferred_username"`
+	// Requested in addition to openid, profile and email.
+	Scopes []string `protobuf:"bytes,7,rep,name=scopes,proto3" json:"scopes" xml:"scope"`
+	// The callback URL registered with the provider, when the GUI isn't
+	// reached at the address the browser uses, e.g. behind a reverse proxy.
+	RedirectURL string `protobuf:"bytes,8,opt,name=redirect_url,json=redirectUrl,proto3" json:"redirectURL" xml:"redirectURL,omitempty"`
+}
+
+func (m *OIDCConfiguration) Reset()         { *m = OIDCConfiguration{} }
+func (m *OIDCConfiguration) String() string { return proto.CompactTextString(m) }
+func (*OIDCConfiguration) ProtoMessage()    {}
+func (*OIDCConfiguration) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ee763e3bef38c648, []int{0}
+}
+func (m *OIDCConfiguration) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OIDCConfiguration) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OIDCConfiguration.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OIDCConfiguration) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OIDCConfiguration.Merge(m, src)
+}
+func (m *OIDCConfiguration) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *OIDCConfiguration) XXX_DiscardUnknown() {
+	xxx_messageInfo_OIDCConfiguration.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OIDCConfiguration proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*OIDCConfiguration)(nil), "config.OIDCConfiguration")
+}
+
+func init() {
+	proto.RegisterFile("lib/config/oidcconfiguration.proto", fileDescriptor_ee763e3bef38c648)
+}
+
+var fileDescriptor_ee763e3bef38c648 = []byte{
+	// 571 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x94, 0x3f, 0x6f, 0xd3, 0x40,
+	0x18, 0xc6, 0x63, 0x4a, 0xd3, 0xd8, 0x49, 0x2b, 0x71, 0xa8, 0x60, 0xfe, 0xc8, 0x17, 0x45, 0x1e,
+	0x8a, 0x54, 0x35, 0x03, 0x03, 0x52, 0xc6, 0xa4, 0x12, 0x0a, 0x20, 0x21, 0x19, 0xb1, 0x30, 0x10,
+	0x25, 0xf6, 0x25, 0x39, 0xc9, 0xf6, 0x45, 0xe7, 0xb3, 0x68, 0x27, 0x58, 0x10, 0x2b, 0xca, 0xc2,
+	0xca, 0xc8, 0x47, 0xe9, 0x66, 0x8f, 0x4c, 0x27, 0x35, 0xd9, 0x3c, 0x7a, 0x42, 0x9d, 0x90, 0xcf,
+	0x76, 0x72, 0x56, 0xd3, 0xed, 0xbd, 0xdf, 0x73, 0xef, 0xf3, 0x3e, 0x97, 0xf8, 0x4e, 0xeb, 0xb8,
+	0x78, 0xd2, 0xb5, 0x89, 0x3f, 0xc5, 0xb3, 0x2e, 0xc1, 0x8e, 0x9d, 0x97, 0x21, 0x1d, 0x33, 0x4c,
+	0xfc, 0xb3, 0x05, 0x25, 0x8c, 0x80, 0x7a, 0x0e, 0x9f, 0xaa, 0xe8, 0x82, 0xe5, 0xa8, 0xf3, 0xef,
+	0x40, 0x7b, 0xf0, 0x7e, 0x78, 0x3e, 0x18, 0xc8, 0xdb, 0xc1, 0x0f, 0x45, 0xd3, 0x70, 0x10, 0x84,
+	0x88, 0x8e, 0x42, 0xea, 0xea, 0x4a, 0x5b, 0x39, 0x51, 0xfb, 0xf3, 0x15, 0x87, 0xea, 0x50, 0xd0,
+	0x8f, 0xd6, 0xbb, 0x84, 0x43, 0x15, 0x97, 0x8b, 0x94, 0xc3, 0x27, 0x17, 0x9e, 0xdb, 0xeb, 0x6c,
+	0xc8, 0x29, 0xf1, 0x30, 0x43, 0xde, 0x82, 0x5d, 0x76, 0x92, 0xc8, 0x7c, 0xb8, 0x83, 0xa7, 0x91,
+	0xb9, 0x35, 0x58, 0xc6, 0xe6, 0xd6, 0xda, 0x2a, 0x39, 0x75, 0xc1, 0x57, 0x4d, 0xb5, 0x5d, 0x8c,
+	0x7c, 0x36, 0xc2, 0x8e, 0x7e, 0x4f, 0xe4, 0x98, 0xac, 0x38, 0x6c, 0x0c, 0x04, 0x1c, 0x9e, 0x27,
+	0x1c, 0x36, 0xec, 0xa2, 0x4e, 0x39, 0xd4, 0x45, 0x8a, 0x12, 0x54, 0x43, 0x80, 0xdb, 0x38, 0x8d,
+	0xcc, 0x4d, 0xf7, 0x32, 0x36, 0x37, 0xae, 0x56, 0x49, 0x1d, 0x40, 0xb4, 0xc3, 0x22, 0x40, 0x80,
+	0x6c, 0x8a, 0x98, 0xbe, 0x27, 0x42, 0xbc, 0x49, 0x38, 0x6c, 0xe5, 0xc2, 0x07, 0xc1, 0x53, 0x0e,
+	0x9f, 0x4b, 0xc3, 0x73, 0x58, 0x0d, 0xf0, 0x68, 0xb7, 0x64, 0x55, 0x7c, 0xc0, 0x67, 0xed, 0x68,
+	0xec, 0xba, 0xe4, 0x0b, 0x72, 0x46, 0x33, 0x4a, 0xc2, 0x45, 0xa0, 0xdf, 0x6f, 0xef, 0x9d, 0xa8,
+	0xfd, 0x57, 0x09, 0x87, 0x87, 0x85, 0xf2, 0x5a, 0x08, 0x29, 0x87, 0x40, 0x8c, 0x94, 0x69, 0x36,
+	0xa8, 0x25, 0x03, 0xab, 0xda, 0x04, 0xbe, 0x2b, 0x5a, 0x2b, 0x37, 0x1e, 0xd9, 0xee, 0x18, 0x7b,
+	0xfa, 0x7e, 0xfe, 0xab, 0x26, 0x1c, 0x36, 0x73, 0x3e, 0xc8, 0x70, 0xca, 0xe1, 0xa9, 0x30, 0x97,
+	0x98, 0x74, 0x9c, 0xb6, 0x83, 0xa6, 0xe3, 0xd0, 0x65, 0xa5, 0x9e, 0x8d, 0x3d, 0xde, 0xb9, 0xf5,
+	0x26, 0x32, 0xeb, 0xb9, 0x60, 0xc9, 0xfe, 0xe0, 0x8f, 0xa2, 0x1d, 0x85, 0x01, 0xa2, 0xfe, 0xd8,
+	0x43, 0x45, 0x92, 0xba, 0x48, 0xf2, 0x4d, 0xc9, 0x4e, 0x5a, 0x4a, 0x65, 0x98, 0x9e, 0x08, 0x53,
+	0xa1, 0x3b, 0xe3, 0x2c, 0x28, 0x9a, 0x22, 0x4a, 0x91, 0x33, 0x2a, 0xf7, 0x66, 0xd1, 0x1e, 0xdf,
+	0xd1, 0x78, 0x13, 0x99, 0xe0, 0x76, 0x87, 0x55, 0x9d, 0x0e, 0x7a, 0x5a, 0x3d, 0xb0, 0xc9, 0x02,
+	0x05, 0xfa, 0x81, 0xf8, 0x2b, 0x3a, 0x09, 0x87, 0x05, 0x49, 0x39, 0x6c, 0x8a, 0x64, 0x62, 0x99,
+	0x8d, 0xda, 0x17, 0x95, 0x55, 0xe8, 0xe0, 0x97, 0xa2, 0xb5, 0x28, 0x72, 0x30, 0x45, 0x36, 0x13,
+	0x97, 0xa9, 0x21, 0x0e, 0xc9, 0x56, 0x1c, 0x36, 0xad, 0x82, 0xe7, 0xd7, 0xa9, 0x49, 0xb7, 0xcb,
+	0x94, 0xc3, 0x67, 0xc2, 0x56, 0x62, 0xd5, 0x8f, 0xe9, 0x78, 0xa7, 0x92, 0x46, 0xa6, 0x6c, 0xb3,
+	0x8c, 0x4d, 0x79, 0x88, 0xb5, 0xd5, 0xa8, 0xdb, 0x7f, 0x7b, 0x75, 0x6d, 0xd4, 0xe2, 0x6b, 0xa3,
+	0x76, 0xb5, 0x32, 0x94, 0x78, 0x65, 0x28, 0x3f, 0xd7, 0x46, 0xed, 0xf7, 0xda, 0x50, 0xe2, 0xb5,
+	0x51, 0xfb, 0xbb, 0x36, 0x6a, 0x9f, 0x5e, 0xcc, 0x30, 0x9b, 0x87, 0x93, 0x33, 0x9b, 0x78, 0xdd,
+	0xe0, 0xd2, 0xb7, 0xd9, 0x1c, 0xfb, 0x33, 0xa9, 0xda, 0x3e, 0x39, 0x93, 0xba, 0x78, 0x4e, 0x5e,
+	0xfe, 0x1f, 0x00, 0xbc, 0x47, 0x4b, 0x97, 0x87, 0x04, 0x00, 0x00,
+}
+
+func (m *OIDCConfiguration) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OIDCConfiguration) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OIDCConfiguration) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RedirectURL) > 0 {
+		i -= len(m.RedirectURL)
+		copy(dAtA[i:], m.RedirectURL)
+		i = encodeVarintOidcconfiguration(dAtA, i, uint64(len(m.RedirectURL)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.Scopes) > 0 {
+		for iNdEx := len(m.Scopes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Scopes[iNdEx])
+			copy(dAtA[i:], m.Scopes[iNdEx])
+			i = encodeVarintOidcconfiguration(dAtA, i, uint64(len(m.Scopes[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.UsernameClaim) > 0 {
+		i -= len(m.UsernameClaim)
+		copy(dAtA[i:], m.UsernameClaim)
+		i = encodeVarintOidcconfiguration(dAtA, i, uint64(len(m.UsernameClaim)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.GroupsClaim) > 0 {
+		i -= len(m.GroupsClaim)
+		copy(dAtA[i:], m.GroupsClaim)
+		i = encodeVarintOidcconfiguration(dAtA, i, uint64(len(m.GroupsClaim)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.AllowedGroups) > 0 {
+		for iNdEx := len(m.AllowedGroups) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedGroups[iNdEx])
+			copy(dAtA[i:], m.AllowedGroups[iNdEx])
+			i = encodeVarintOidcconfiguration(dAtA, i, uint64(len(m.AllowedGroups[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.ClientSecret) > 0 {
+		i -= len(m.ClientSecret)
+		copy(dAtA[i:], m.ClientSecret)
+		i = encodeVarintOidcconfiguration(dAtA, i, uint64(len(m.ClientSecret)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ClientID) > 0 {
+		i -= len(m.ClientID)
+		copy(dAtA[i:], m.ClientID)
+		i = encodeVarintOidcconfiguration(dAtA, i, uint64(len(m.ClientID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.IssuerURL) > 0 {
+		i -= len(m.IssuerURL)
+		copy(dAtA[i:], m.IssuerURL)
+		i = encodeVarintOidcconfiguration(dAtA, i, uint64(len(m.IssuerURL)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintOidcconfiguration(dAtA []byte, offset int, v uint64) int {
+	offset -= sovOidcconfiguration(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *OIDCConfiguration) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.IssuerURL)
+	if l > 0 {
+		n += 1 + l + sovOidcconfiguration(uint64(l))
+	}
+	l = len(m.ClientID)
+	if l > 0 {
+		n += 1 + l + sovOidcconfiguration(uint64(l))
+	}
+	l = len(m.ClientSecret)
+	if l > 0 {
+		n += 1 + l + sovOidcconfiguration(uint64(l))
+	}
+	if len(m.AllowedGroups) > 0 {
+		for _, s := range m.AllowedGroups {
+			l = len(s)
+			n += 1 + l + sovOidcconfiguration(uint64(l))
+		}
+	}
+	l = len(m.GroupsClaim)
+	if l > 0 {
+		n += 1 + l + sovOidcconfiguration(uint64(l))
+	}
+	l = len(m.UsernameClaim)
+	if l > 0 {
+		n += 1 + l + sovOidcconfiguration(uint64(l))
+	}
+	if len(m.Scopes) > 0 {
+		for _, s := range m.Scopes {
+			l = len(s)
+			n += 1 + l + sovOidcconfiguration(uint64(l))
+		}
+	}
+	l = len(m.RedirectURL)
+	if l > 0 {
+		n += 1 + l + sovOidcconfiguration(uint64(l))
+	}
+	return n
+}
+
+func sovOidcconfiguration(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozOidcconfiguration(x uint64) (n int) {
+	return sovOidcconfiguration(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *OIDCConfiguration) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOidcconfiguration
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OIDCConfiguration: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OIDCConfiguration: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IssuerURL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOidcconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOidcconfiguration
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOidcconfiguration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IssuerURL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOidcconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOidcconfiguration
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOidcconfiguration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientSecret", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOidcconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOidcconfiguration
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOidcconfiguration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientSecret = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedGroups", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOidcconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOidcconfiguration
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOidcconfiguration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedGroups = append(m.AllowedGroups, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupsClaim", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOidcconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOidcconfiguration
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOidcconfiguration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GroupsClaim = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UsernameClaim", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOidcconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOidcconfiguration
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOidcconfiguration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UsernameClaim = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Scopes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOidcconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOidcconfiguration
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOidcconfiguration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Scopes = append(m.Scopes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedirectURL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOidcconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOidcconfiguration
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOidcconfiguration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RedirectURL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOidcconfiguration(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOidcconfiguration
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipOidcconfiguration(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowOidcconfiguration
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowOidcconfiguration
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowOidcconfiguration
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthOidcconfiguration
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupOidcconfiguration
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthOidcconfiguration
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthOidcconfiguration        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowOidcconfiguration          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupOidcconfiguration = fmt.Errorf("proto: unexpected end of group")
+)
@@ -9,6 +9,8 @@ package config
 import (
 	"fmt"
 	"runtime"
+	"strings"
+	"time"
 
 	"github.com/syncthing/syncthing/lib/protocol"
 	"github.com/syncthing/syncthing/lib/rand"
@@ -24,8 +26,20 @@ func (opts OptionsConfiguration) Copy() OptionsConfiguration {
 	copy(optsCopy.RawGlobalAnnServers, opts.RawGlobalAnnServers)
 	optsCopy.AlwaysLocalNets = make([]string, len(opts.AlwaysLocalNets))
 	copy(optsCopy.AlwaysLocalNets, opts.AlwaysLocalNets)
+	optsCopy.LocalAnnInterfaces = make([]string, len(opts.LocalAnnInterfaces))
+	copy(optsCopy.LocalAnnInterfaces, opts.LocalAnnInterfaces)
+	optsCopy.RawLocalAnnAddresses = make([]string, len(opts.RawLocalAnnAddresses))
+	copy(optsCopy.RawLocalAnnAddresses, opts.RawLocalAnnAddresses)
 	optsCopy.UnackedNotificationIDs = make([]string, len(opts.UnackedNotificationIDs))
 	copy(optsCopy.UnackedNotificationIDs, opts.UnackedNotificationIDs)
+	optsCopy.BandwidthSchedule = make([]BandwidthScheduleEntry, len(opts.BandwidthSchedule))
+	copy(optsCopy.BandwidthSchedule, opts.BandwidthSchedule)
+	optsCopy.MQTTEvents = make([]string, len(opts.MQTTEvents))
+	copy(optsCopy.MQTTEvents, opts.MQTTEvents)
+	optsCopy.EventWebhooks = make([]EventWebhook, len(opts.EventWebhooks))
+	for i, hook := range opts.EventWebhooks {
+		optsCopy.EventWebhooks[i] = hook.Copy()
+	}
 	return optsCopy
 }
 
@@ -34,6 +48,9 @@ func (opts *OptionsConfiguration) prepare(guiPWIsSet bool) {
 
 	opts.RawListenAddresses = stringutil.UniqueTrimmedStrings(opts.RawListenAddresses)
 	opts.RawGlobalAnnServers = stringutil.UniqueTrimmedStrings(opts.RawGlobalAnnServers)
+	for i := range opts.EventWebhooks {
+		opts.EventWebhooks[i].prepare()
+	}
 
 	// Very short reconnection intervals are annoying
 	if opts.ReconnectIntervalS < 5 {
@@ -66,6 +83,36 @@ func (opts *OptionsConfiguration) prepare(guiPWIsSet bool) {
 		opts.ConnectionPriorityTCPWAN = opts.ConnectionPriorityTCPLAN + 1
 	}
 
+	if opts.QuotaResetDay < 1 {
+		opts.QuotaResetDay = 1
+	} else if opts.QuotaResetDay > 28 {
+		opts.QuotaResetDay = 28
+	}
+	if opts.RelayMonthlyBudgetMiB < 0 {
+		opts.RelayMonthlyBudgetMiB = 0
+	}
+	if opts.ConflictClockSkewToleranceS < 0 {
+		opts.ConflictClockSkewToleranceS = 0
+	}
+	// Negative sizes are meaningless, zero means the system default.
+	for _, v := range []*int{&opts.SocketSendBufferKiB, &opts.SocketReceiveBufferKiB, &opts.TCPNotSentLowat} {
+		if *v < 0 {
+			*v = 0
+		}
+	}
+	opts.TCPCongestionControl = strings.TrimSpace(opts.TCPCongestionControl)
+	if opts.StaleDeviceDays < 0 {
+		opts.StaleDeviceDays = 0
+	}
+	opts.ObfuscationSecret = strings.TrimSpace(opts.ObfuscationSecret)
+	// The traffic class is a single byte.
+	for _, tc := range []*int{&opts.TrafficClass, &opts.TrafficClassLAN, &opts.TrafficClassWAN} {
+		if *tc < 0 || *tc > 255 {
+			l.Warnf("Traffic class %d is out of range (0-255); not setting it", *tc)
+			*tc = 0
+		}
+	}
+
 	// If usage reporting is enabled we must have a unique ID.
 	if opts.URAccepted > 0 && opts.URUniqueID == "" {
 		opts.URUniqueID = rand.String(8)
@@ -103,6 +150,21 @@ func (opts OptionsConfiguration) ListenAddresses() []string {
 	return stringutil.UniqueTrimmedStrings(addresses)
 }
 
+// LocalAnnAddresses returns the addresses to do local discovery on, with
+// "default" replaced by the local announce port and multicast address.
+func (opts OptionsConfiguration) LocalAnnAddresses() []string {
+	var addresses []string
+	for _, addr := range opts.RawLocalAnnAddresses {
+		switch addr {
+		case "default":
+			addresses = append(addresses, fmt.Sprintf(":%d", opts.LocalAnnPort), opts.LocalAnnMCAddr)
+		default:
+			addresses = append(addresses, addr)
+		}
+	}
+	return stringutil.UniqueTrimmedStrings(addresses)
+}
+
 func (opts OptionsConfiguration) StunServers() []string {
 	var addresses []string
 	for _, addr := range opts.RawStunServers {
@@ -128,8 +190,14 @@ func (opts OptionsConfiguration) StunServers() []string {
 }
 
 func (opts OptionsConfiguration) GlobalDiscoveryServers() []string {
+	return expandDiscoveryServers(opts.RawGlobalAnnServers)
+}
+
+// expandDiscoveryServers replaces the "default" entries of a discovery
+// server list with the actual servers.
+func expandDiscoveryServers(raw []string) []string {
 	var servers []string
-	for _, srv := range opts.RawGlobalAnnServers {
+	for _, srv := range raw {
 		switch srv {
 		case "default":
 			servers = append(servers, DefaultDiscoveryServers...)
@@ -215,3 +283,38 @@ func (opts OptionsConfiguration) LowestConnectionLimit() int {
 	}
 	return limit
 }
+
+// TrafficClassFor returns the traffic class to set on connections to
+// devices on the LAN, or elsewhere.
+func (opts OptionsConfiguration) TrafficClassFor(isLAN bool) int {
+	if isLAN && opts.TrafficClassLAN != 0 {
+		return opts.TrafficClassLAN
+	}
+	if !isLAN && opts.TrafficClassWAN != 0 {
+		return opts.TrafficClassWAN
+	}
+	return opts.TrafficClass
+}
+
+// QuotaPeriodStart returns the start of the monthly quota period containing
+// now, which is local midnight of the reset day in this or the previous
+// month.
+func (opts OptionsConfiguration) QuotaPeriodStart(now time.Time) time.Time {
+	year, month, day := now.Date()
+	if day < opts.QuotaResetDay {
+		month--
+	}
+	return time.Date(year, month, opts.QuotaResetDay, 0, 0, 0, 0, now.Location())
+}
+
+func (w EventWebhook) Copy() EventWebhook {
+	wCopy := w
+	wCopy.Events = make([]string, len(w.Events))
+	copy(wCopy.Events, w.Events)
+	return wCopy
+}
+
+func (w *EventWebhook) prepare() {
+	w.URL = strings.TrimSpace(w.URL)
+	w.Events = stringutil.UniqueTrimmedStrings(w.Events)
+}
@@ -89,6 +89,91 @@ type OptionsConfiguration struct {
 	ConnectionPriorityQUICWAN          int  `protobuf:"varint,57,opt,name=connection_priority_quic_wan,json=connectionPriorityQuicWan,proto3,casttype=int" json:"connectionPriorityQuicWan" xml:"connectionPriorityQuicWan" default:"40"`
 	ConnectionPriorityRelay            int  `protobuf:"varint,58,opt,name=connection_priority_relay,json=connectionPriorityRelay,proto3,casttype=int" json:"connectionPriorityRelay" xml:"connectionPriorityRelay" default:"50"`
 	ConnectionPriorityUpgradeThreshold int  `protobuf:"varint,59,opt,name=connection_priority_upgrade_threshold,json=connectionPriorityUpgradeThreshold,proto3,casttype=int" json:"connectionPriorityUpgradeThreshold" xml:"connectionPriorityUpgradeThreshold" default:"0"`
+	// Rate limits that take effect at the times given by cron expressions,
+	// replacing max_send_kbps and max_recv_kbps until the next entry takes
+	// effect.
+	BandwidthSchedule []BandwidthScheduleEntry `protobuf:"bytes,60,rep,name=bandwidth_schedule,json=bandwidthSchedule,proto3" json:"bandwidthSchedule" xml:"bandwidthSchedule"`
+	// The SOCKS5 or HTTP proxy to dial devices through over TCP, unless
+	// overridden per device. When unset, the proxy from the environment is
+	// used.
+	ProxyURL string `protobuf:"bytes,61,opt,name=proxy_url,json=proxyUrl,proto3" json:"proxyURL" xml:"proxyURL,omitempty"`
+	// The priority of connections tunneled over WebSocket (wss://). These
+	// carry more overhead than plain TCP or QUIC, so they are preferred
+	// only over relays by default.
+	ConnectionPriorityWSS int `protobuf:"varint,62,opt,name=connection_priority_wss,json=connectionPriorityWss,proto3,casttype=int" json:"connectionPriorityWss" xml:"connectionPriorityWss" default:"45"`
+	// The day of the month on which the monthly transfer quotas of folder
+	// devices reset, at local midnight. Limited to 1 through 28, so that
+	// every month has it.
+	QuotaResetDay int `protobuf:"varint,63,opt,name=quota_reset_day,json=quotaResetDay,proto3,casttype=int" json:"quotaResetDay" xml:"quotaResetDay" default:"1"`
+	// The amount of relayed traffic, in both directions, allowed per month.
+	// Once used up, relays are not used until the next period starts on
+	// the quota reset day. Zero means unlimited.
+	RelayMonthlyBudgetMiB int64 `protobuf:"varint,64,opt,name=relay_monthly_budget_mib,json=relayMonthlyBudgetMib,proto3" json:"relayMonthlyBudgetMiB" xml:"relayMonthlyBudgetMiB"`
+	// The network interfaces to send and receive local discovery
+	// announcements on, by name. Entries may be glob patterns, and entries
+	// prefixed with "!" exclude interfaces. Empty means all interfaces.
+	LocalAnnInterfaces []string `protobuf:"bytes,65,rep,name=local_announce_interfaces,json=localAnnounceInterfaces,proto3" json:"localAnnounceInterfaces" xml:"localAnnounceInterface"`
+	// Whether to also advertise the device, and look up others, as a
+	// _syncthing._tcp DNS-SD service over multicast DNS when local
+	// discovery is enabled.
+	LocalAnnMDNSEnabled bool `protobuf:"varint,66,opt,name=local_announce_mdns_enabled,json=localAnnounceMdnsEnabled,proto3" json:"localAnnounceMDNSEnabled" xml:"localAnnounceMDNSEnabled" default:"true"`
+	// The addresses to do local discovery on: ":port" for IPv4 broadcasts,
+	// or an IPv4 or IPv6 multicast group and port. "default" means the
+	// local announce port and multicast address.
+	RawLocalAnnAddresses []string `protobuf:"bytes,67,rep,name=local_announce_addresses,json=localAnnounceAddresses,proto3" json:"localAnnounceAddresses" xml:"localAnnounceAddress" default:"default"`
+	// Webhooks to call on events.
+	EventWebhooks []EventWebhook `protobuf:"bytes,68,rep,name=event_webhooks,json=eventWebhooks,proto3" json:"eventWebhooks" xml:"eventWebhook"`
+	// The MQTT broker to publish events to, as tcp://host:port or
+	// tls://host:port. Empty disables MQTT.
+	MQTTBrokerURL string `protobuf:"bytes,69,opt,name=mqtt_broker_url,json=mqttBrokerUrl,proto3" json:"mqttBrokerURL" xml:"mqttBrokerURL,omitempty"`
+	// The topic under which everything is published.
+	MQTTTopicPrefix string `protobuf:"bytes,70,opt,name=mqtt_topic_prefix,json=mqttTopicPrefix,proto3" json:"mqttTopicPrefix" xml:"mqttTopicPrefix" default:"syncthing"`
+	MQTTUsername    string `protobuf:"bytes,71,opt,name=mqtt_username,json=mqttUsername,proto3" json:"mqttUsername" xml:"mqttUsername,omitempty"`
+	MQTTPassword    string `protobuf:"bytes,72,opt,name=mqtt_password,json=mqttPassword,proto3" json:"mqttPassword" xml:"mqttPassword,omitempty"`
+	// The event types to publish. Empty means folder summaries, device
+	// connectivity and folder errors.
+	MQTTEvents []string `protobuf:"bytes,73,rep,name=mqtt_events,json=mqttEvents,proto3" json:"mqttEvents" xml:"mqttEvent"`
+	// Keep the usage reports forwarded by other devices for manual
+	// submission, instead of uploading them.
+	URStoreForwarded bool `protobuf:"varint,74,opt,name=usage_reporting_store_forwarded,json=usageReportingStoreForwarded,proto3" json:"urStoreForwarded" xml:"urStoreForwarded"`
+	// The store for the database. Switching to SQLite copies the existing
+	// LevelDB database over on the next start.
+	DatabaseBackend DatabaseBackend `protobuf:"varint,75,opt,name=database_backend,json=databaseBackend,proto3,enum=config.DatabaseBackend" json:"databaseBackend" xml:"databaseBackend" restart:"true"`
+	// Under the keepNewest conflict policy, modification times less than
+	// this many seconds apart are too close to tell which change is newer,
+	// and both versions are kept. Remote modification times are first
+	// corrected by the clock offset measured for the device that made the
+	// change.
+	ConflictClockSkewToleranceS int `protobuf:"varint,76,opt,name=conflict_clock_skew_tolerance_s,json=conflictClockSkewToleranceS,proto3,casttype=int" json:"conflictClockSkewToleranceS" xml:"conflictClockSkewToleranceS"`
+	// The traffic class to set on TCP based connections to devices on the
+	// LAN and elsewhere, replacing traffic_class unless zero. Like it, this
+	// is the whole IPv4 TOS or IPv6 traffic class byte, i.e. the DSCP value
+	// times four: 32 marks the traffic as CS1 ("lower effort") for QoS
+	// policies to de-prioritize it.
+	TrafficClassLAN int `protobuf:"varint,77,opt,name=traffic_class_lan,json=trafficClassLan,proto3,casttype=int" json:"trafficClassLAN" xml:"trafficClassLAN"`
+	TrafficClassWAN int `protobuf:"varint,78,opt,name=traffic_class_wan,json=trafficClassWan,proto3,casttype=int" json:"trafficClassWAN" xml:"trafficClassWAN"`
+	// Socket options for tuning throughput on long fat networks, applied to
+	// TCP based connections and, for the buffer sizes, the UDP sockets used
+	// by QUIC. Zero or empty leaves the system default in place. The not
+	// sent low water mark limits how much unsent data the kernel buffers,
+	// in bytes, and the congestion control algorithm (e.g. "bbr") must be
+	// available in the kernel. Both are only supported on some platforms.
+	SocketSendBufferKiB    int    `protobuf:"varint,79,opt,name=socket_send_buffer_kib,json=socketSendBufferKib,proto3,casttype=int" json:"socketSendBufferKiB" xml:"socketSendBufferKiB"`
+	SocketReceiveBufferKiB int    `protobuf:"varint,80,opt,name=socket_receive_buffer_kib,json=socketReceiveBufferKib,proto3,casttype=int" json:"socketReceiveBufferKiB" xml:"socketReceiveBufferKiB"`
+	TCPNotSentLowat        int    `protobuf:"varint,81,opt,name=tcp_not_sent_lowat,json=tcpNotSentLowat,proto3,casttype=int" json:"tcpNotSentLowat" xml:"tcpNotSentLowat"`
+	TCPCongestionControl   string `protobuf:"bytes,82,opt,name=tcp_congestion_control,json=tcpCongestionControl,proto3" json:"tcpCongestionControl" xml:"tcpCongestionControl"`
+	// The pre-shared secret for obfuscated connections (obfs://). Both
+	// devices need the same secret; obfs listeners and dialers are disabled
+	// while it's empty. It only hides the connection from traffic analysis,
+	// the devices still authenticate each other with BEP TLS.
+	ObfuscationSecret string `protobuf:"bytes,83,opt,name=obfuscation_secret,json=obfuscationSecret,proto3" json:"obfuscationSecret" xml:"obfuscationSecret"`
+	// Devices that haven't been seen for this many days are stale, which is
+	// announced with a StaleDevice event and, when stale_device_remove is
+	// set, removes them from the configuration along with their index data.
+	// Devices that were never seen and those marked exempt_from_pruning are
+	// left alone. Zero disables the check.
+	StaleDeviceDays   int  `protobuf:"varint,84,opt,name=stale_device_days,json=staleDeviceDays,proto3,casttype=int" json:"staleDeviceDays" xml:"staleDeviceDays"`
+	StaleDeviceRemove bool `protobuf:"varint,85,opt,name=stale_device_remove,json=staleDeviceRemove,proto3" json:"staleDeviceRemove" xml:"staleDeviceRemove"`
 	// Legacy deprecated
 	DeprecatedUPnPEnabled        bool     `protobuf:"varint,9000,opt,name=upnp_enabled,json=upnpEnabled,proto3" json:"-" xml:"upnpEnabled,omitempty"`                                    // Deprecated: Do not use.
 	DeprecatedUPnPLeaseM         int      `protobuf:"varint,9001,opt,name=upnp_lease_m,json=upnpLeaseM,proto3,casttype=int" json:"-" xml:"upnpLeaseMinutes,omitempty"`                   // Deprecated: Do not use.
@@ -132,8 +217,91 @@ func (m *OptionsConfiguration) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_OptionsConfiguration proto.InternalMessageInfo
 
+type BandwidthScheduleEntry struct {
+	Schedule    string `protobuf:"bytes,1,opt,name=schedule,proto3" json:"schedule" xml:"schedule,attr"`
+	MaxSendKbps int    `protobuf:"varint,2,opt,name=max_send_kbps,json=maxSendKbps,proto3,casttype=int" json:"maxSendKbps" xml:"maxSendKbps,attr"`
+	MaxRecvKbps int    `protobuf:"varint,3,opt,name=max_recv_kbps,json=maxRecvKbps,proto3,casttype=int" json:"maxRecvKbps" xml:"maxRecvKbps,attr"`
+}
+
+func (m *BandwidthScheduleEntry) Reset()         { *m = BandwidthScheduleEntry{} }
+func (m *BandwidthScheduleEntry) String() string { return proto.CompactTextString(m) }
+func (*BandwidthScheduleEntry) ProtoMessage()    {}
+func (*BandwidthScheduleEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d09882599506ca03, []int{1}
+}
+func (m *BandwidthScheduleEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BandwidthScheduleEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BandwidthScheduleEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BandwidthScheduleEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BandwidthScheduleEntry.Merge(m, src)
+}
+func (m *BandwidthScheduleEntry) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *BandwidthScheduleEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_BandwidthScheduleEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BandwidthScheduleEntry proto.InternalMessageInfo
+
+// A webhook that is called with a POST of each event of the given types,
+// as JSON. With a secret, the body is signed using HMAC-SHA256 and the
+// signature sent in the X-Syncthing-Signature header.
+type EventWebhook struct {
+	URL    string   `protobuf:"bytes,1,opt,name=url,proto3" json:"url" xml:"url,attr"`
+	Events []string `protobuf:"bytes,2,rep,name=events,proto3" json:"events" xml:"event"`
+	Secret string   `protobuf:"bytes,3,opt,name=secret,proto3" json:"secret" xml:"secret,omitempty"`
+}
+
+func (m *EventWebhook) Reset()         { *m = EventWebhook{} }
+func (m *EventWebhook) String() string { return proto.CompactTextString(m) }
+func (*EventWebhook) ProtoMessage()    {}
+func (*EventWebhook) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d09882599506ca03, []int{2}
+}
+func (m *EventWebhook) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventWebhook) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventWebhook.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventWebhook) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventWebhook.Merge(m, src)
+}
+func (m *EventWebhook) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *EventWebhook) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventWebhook.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventWebhook proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*OptionsConfiguration)(nil), "config.OptionsConfiguration")
+	proto.RegisterType((*BandwidthScheduleEntry)(nil), "config.BandwidthScheduleEntry")
+	proto.RegisterType((*EventWebhook)(nil), "config.EventWebhook")
 }
 
 func init() {
@@ -141,228 +309,320 @@ func init() {
 }
 
 var fileDescriptor_d09882599506ca03 = []byte{
-	// 3521 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x5a, 0x5d, 0x6c, 0x1d, 0x47,
-	0x15, 0xce, 0x26, 0x4d, 0xda, 0x6c, 0x1c, 0x27, 0x1e, 0x3b, 0xf6, 0x26, 0x4e, 0xbd, 0xee, 0xcd,
-	0x4d, 0xeb, 0xb6, 0x69, 0x62, 0x3b, 0x3f, 0x4d, 0x8d, 0x50, 0xf1, 0x4f, 0x4d, 0xdd, 0xd8, 0x8e,
-	0x3b, 0xb6, 0x6b, 0x54, 0x84, 0x56, 0xe3, 0xbd, 0x73, 0xed, 0xad, 0xf7, 0xee, 0xde, 0xec, 0xce,
-	0xfa, 0xa7, 0x45, 0x50, 0x15, 0x41, 0x79, 0xa3, 0x5c, 0x15, 0x90, 0x40, 0xaa, 0x8a, 0x00, 0x89,
-	0x52, 0x8a, 0x90, 0x90, 0x90, 0x40, 0x42, 0x54, 0x48, 0x48, 0x15, 0x3c, 0xf8, 0x3e, 0x21, 0x24,
-	0xca, 0xa2, 0x3a, 0x7d, 0xba, 0x0f, 0x3c, 0xdc, 0x47, 0xf3, 0x82, 0x66, 0xf6, 0x6f, 0x76, 0x77,
-	0xd6, 0xce, 0xdb, 0xdd, 0xf3, 0x9d, 0x39, 0x73, 0xbe, 0xf9, 0x39, 0x73, 0xce, 0xcc, 0x95, 0x2f,
-	0x9b, 0xc6, 0xea, 0x35, 0xdd, 0xb6, 0xaa, 0xc6, 0xda, 0x35, 0xbb, 0x4e, 0x0c, 0xdb, 0x72, 0x83,
-	0x2f, 0xcf, 0x41, 0xf4, 0xeb, 0x6a, 0xdd, 0xb1, 0x89, 0x0d, 0x4e, 0x04, 0xc2, 0x0b, 0x7d, 0x9c,
-	0x3a, 0xf1, 0x2c, 0xc3, 0x5a, 0x0b, 0x14, 0x2e, 0x9c, 0xe3, 0x00, 0xd7, 0x78, 0x1d, 0x87, 0xe2,
-	0x93, 0x78, 0x9b, 0x04, 0x3f, 0x4b, 0xef, 0x2d, 0xca, 0x3d, 0x77, 0x83, 0x1e, 0x26, 0xf9, 0x1e,
-	0xc0, 0x7b, 0x92, 0x7c, 0xd6, 0x34, 0x5c, 0x82, 0x2d, 0x0d, 0x55, 0x2a, 0x0e, 0x76, 0x5d, 0xec,
-	0x2a, 0xd2, 0xe0, 0xb1, 0xa1, 0x93, 0x13, 0xee, 0x9e, 0xaf, 0x02, 0x88, 0xb6, 0x66, 0x19, 0x3c,
-	0x1e, 0xa1, 0x2d, 0x5f, 0x3d, 0x63, 0xa6, 0x45, 0x6d, 0x5f, 0xbd, 0xbc, 0x5d, 0x33, 0xc7, 0x4a,
-	0x29, 0x79, 0x69, 0xb0, 0x82, 0xab, 0xc8, 0x33, 0xc9, 0x58, 0x29, 0xfc, 0x51, 0xda, 0xdf, 0x2d,
-	0x3f, 0x1c, 0xfe, 0x6e, 0x34, 0xcb, 0x02, 0xe3, 0x30, 0x6b, 0x1a, 0xfc, 0x57, 0x92, 0x95, 0x35,
-	0xd3, 0x5e, 0x45, 0xa6, 0x56, 0x31, 0x5c, 0xdd, 0xde, 0xc4, 0xce, 0x8e, 0xe6, 0x62, 0x67, 0x13,
-	0x3b, 0xae, 0x72, 0x94, 0x39, 0xfa, 0x3b, 0x69, 0xcf, 0x57, 0xbb, 0x21, 0xda, 0xfa, 0x32, 0xd3,
-	0x1b, 0xb7, 0xac, 0xc5, 0x00, 0x6f, 0xf9, 0xea, 0xb9, 0xb5, 0x48, 0x66, 0x7b, 0x96, 0x8e, 0x43,
-	0xa0, 0xed, 0xab, 0x57, 0x98, 0xc3, 0x22, 0x54, 0xe0, 0x77, 0x6b, 0xb7, 0xdc, 0x23, 0x52, 0x6d,
-	0xef, 0x96, 0xc5, 0x1d, 0xa4, 0x89, 0x8a, 0x7c, 0x83, 0xbd, 0x41, 0xc3, 0xa9, 0x88, 0x54, 0x28,
-	0x07, 0x9f, 0x8b, 0x08, 0x63, 0x0b, 0xad, 0x9a, 0xb8, 0xa2, 0x1c, 0x1b, 0x94, 0x86, 0x1e, 0x99,
-	0xf8, 0x80, 0x12, 0x3e, 0x1b, 0x5b, 0x7c, 0x21, 0x00, 0xf3, 0x6c, 0x43, 0xa0, 0xed, 0xab, 0x4f,
-	0x09, 0xd8, 0x86, 0x28, 0x47, 0x97, 0x38, 0x1e, 0xa6, 0x5c, 0x0b, 0xcc, 0x14, 0x01, 0xfb, 0xbb,
-	0xe5, 0x87, 0x68, 0xd3, 0x46, 0xb3, 0x9c, 0x73, 0x2a, 0x47, 0x33, 0x94, 0x83, 0x4f, 0x25, 0xb9,
-	0xcf, 0xb4, 0x75, 0x21, 0xcb, 0x87, 0x18, 0xcb, 0x9f, 0x51, 0x96, 0x67, 0x66, 0xa9, 0x4e, 0x8a,
-	0x64, 0x8f, 0x19, 0x8a, 0x32, 0x1c, 0x9f, 0x0c, 0x96, 0xa0, 0x00, 0x14, 0x50, 0x14, 0x1b, 0x29,
-	0x90, 0x73, 0x04, 0xb3, 0xfe, 0xc0, 0x73, 0xac, 0x41, 0x8e, 0xde, 0xdf, 0x25, 0xb9, 0x3b, 0xa0,
-	0x87, 0x42, 0x5b, 0x5a, 0xdd, 0x76, 0x88, 0x72, 0x7c, 0x50, 0x1a, 0x3a, 0x3e, 0xf1, 0x63, 0x4a,
-	0xad, 0x23, 0x32, 0xb5, 0x60, 0x3b, 0xa4, 0xe5, 0xab, 0x5d, 0xa9, 0xae, 0xa9, 0xb0, 0xed, 0xab,
-	0x4f, 0xe4, 0x49, 0x51, 0x84, 0x63, 0x34, 0x3a, 0x32, 0x3c, 0xfa, 0x6c, 0x69, 0xdf, 0x57, 0x8f,
-	0x19, 0x16, 0x69, 0xed, 0x96, 0x05, 0x66, 0x44, 0xc2, 0xfd, 0xdd, 0xf2, 0x71, 0xd6, 0xb4, 0xd1,
-	0x2c, 0xa7, 0x3c, 0x81, 0x79, 0x5d, 0xf0, 0xad, 0xa3, 0xf2, 0x60, 0x86, 0x4d, 0xcd, 0x33, 0x89,
-	0xa1, 0x23, 0x97, 0x44, 0x71, 0x43, 0x39, 0x31, 0x28, 0x0d, 0x9d, 0x9c, 0xf8, 0x03, 0xa5, 0xd6,
-	0x19, 0x19, 0x9c, 0x9b, 0xa4, 0x3b, 0xb9, 0xe5, 0xab, 0xdd, 0x29, 0xa3, 0x81, 0xb8, 0xed, 0xab,
-	0xb7, 0xf2, 0xf4, 0x02, 0x8c, 0x23, 0xf8, 0xd5, 0x6a, 0x75, 0x64, 0x74, 0x6c, 0xec, 0xf6, 0xf5,
-	0xdb, 0x37, 0xbe, 0x36, 0x16, 0xb0, 0x6d, 0xed, 0x96, 0x85, 0x06, 0xc5, 0xe2, 0xfd, 0xdd, 0x32,
-	0xc8, 0x1b, 0x69, 0x34, 0xcb, 0x19, 0x37, 0xe1, 0xa3, 0xe9, 0xc6, 0x11, 0xc3, 0x30, 0x18, 0x81,
-	0xbb, 0xf2, 0xe9, 0x1a, 0xda, 0xd6, 0x5c, 0x6c, 0x55, 0xb4, 0x8d, 0xd5, 0xba, 0xab, 0x3c, 0xcc,
-	0x26, 0xf3, 0xe9, 0x96, 0xaf, 0x9e, 0xaa, 0xa1, 0xed, 0x45, 0x6c, 0x55, 0xee, 0xac, 0xd6, 0x69,
-	0x70, 0xe9, 0x62, 0xb4, 0x38, 0x59, 0x34, 0x3f, 0x90, 0x57, 0x8c, 0x0c, 0x3a, 0x58, 0xdf, 0x0c,
-	0x0c, 0x3e, 0x92, 0x32, 0x08, 0xb1, 0xbe, 0x99, 0x35, 0x18, 0xc9, 0x52, 0x06, 0x23, 0x21, 0xf8,
-	0xbd, 0x24, 0xf7, 0x39, 0x58, 0xb7, 0x2d, 0x0b, 0xeb, 0x34, 0xbc, 0x6b, 0x86, 0x45, 0xb0, 0xb3,
-	0x89, 0x4c, 0xcd, 0x55, 0x4e, 0x32, 0xdb, 0xdf, 0x60, 0x41, 0x3d, 0x52, 0x99, 0x09, 0xe1, 0x45,
-	0x1a, 0x3b, 0xf8, 0x86, 0x31, 0xd0, 0xf6, 0xd5, 0x21, 0xd6, 0xb7, 0x10, 0xe5, 0x66, 0xe9, 0xd6,
-	0x70, 0xe4, 0xd2, 0xfe, 0x6e, 0xf9, 0xe8, 0xad, 0x61, 0x16, 0xdf, 0x73, 0xfd, 0x40, 0x71, 0x2f,
-	0xa0, 0x2a, 0x77, 0x3a, 0xd8, 0x44, 0x3b, 0x6e, 0x1c, 0x03, 0x64, 0x16, 0x03, 0x9e, 0x6f, 0xf9,
-	0xea, 0xe9, 0x00, 0x49, 0x36, 0x7a, 0x29, 0x74, 0x88, 0x93, 0x66, 0x77, 0x78, 0xb4, 0x63, 0x61,
-	0xba, 0x31, 0x78, 0xeb, 0xa8, 0xdc, 0x1f, 0x76, 0x14, 0x3b, 0x92, 0x0c, 0x52, 0x4d, 0x39, 0xc5,
-	0x06, 0xe9, 0x2f, 0x74, 0x0d, 0xf7, 0x41, 0xaa, 0x97, 0xa3, 0x30, 0xd7, 0xf2, 0xd5, 0x3e, 0x47,
-	0x0c, 0xc5, 0x81, 0xb6, 0x00, 0xe7, 0xbc, 0x1c, 0x19, 0xe6, 0xb6, 0x6c, 0xa1, 0xbd, 0x62, 0x88,
-	0x0e, 0xf2, 0x08, 0x1d, 0xe4, 0x22, 0x37, 0xa1, 0x12, 0xf0, 0xcc, 0x23, 0x60, 0x55, 0x3e, 0xed,
-	0x12, 0xe4, 0x10, 0x6d, 0xd5, 0xb1, 0xb7, 0x5c, 0xec, 0x28, 0x1d, 0x6c, 0xac, 0xbf, 0xd8, 0xf2,
-	0xd5, 0x0e, 0x06, 0x4c, 0x04, 0xf2, 0xb6, 0xaf, 0x3e, 0xc6, 0xe8, 0xf0, 0xc2, 0xc2, 0x91, 0x4e,
-	0x35, 0x05, 0xbf, 0x90, 0xe4, 0x73, 0x16, 0x22, 0x1a, 0x71, 0x10, 0x3d, 0xd5, 0x90, 0x19, 0x4f,
-	0x6c, 0x27, 0xeb, 0xec, 0xde, 0x9e, 0xaf, 0xca, 0xf3, 0xe3, 0x4b, 0x49, 0x58, 0x97, 0x2d, 0x44,
-	0x92, 0x39, 0x56, 0x59, 0xc7, 0x89, 0x48, 0x10, 0xc2, 0xf9, 0x06, 0xa9, 0x2f, 0x2e, 0x5c, 0x73,
-	0x5d, 0xc0, 0x6e, 0x0b, 0x91, 0xa5, 0xc8, 0x9d, 0x68, 0x41, 0xfc, 0x31, 0xe7, 0xa7, 0x89, 0x91,
-	0x8b, 0xb5, 0x9a, 0x72, 0x86, 0x2d, 0x85, 0xef, 0xd0, 0xa5, 0x70, 0x72, 0x7e, 0x7c, 0x69, 0x96,
-	0x8a, 0xe9, 0xe4, 0x9f, 0xb1, 0x10, 0x09, 0x3e, 0x0c, 0xcb, 0x23, 0x2c, 0xf9, 0x29, 0x45, 0xce,
-	0xf2, 0x72, 0xe1, 0xde, 0x68, 0xed, 0x96, 0x73, 0xed, 0xf3, 0xa2, 0x78, 0x07, 0x25, 0x1d, 0x43,
-	0xc0, 0x7b, 0x1f, 0xc8, 0xc0, 0xdf, 0x24, 0xb9, 0x2f, 0xed, 0xbc, 0x83, 0x2d, 0xbc, 0xc5, 0x56,
-	0xf2, 0x59, 0xe6, 0x7e, 0x83, 0xba, 0x7f, 0x6a, 0x7e, 0x7c, 0x09, 0x06, 0x00, 0x25, 0xd0, 0x65,
-	0x21, 0x12, 0x7d, 0xc6, 0x14, 0xca, 0x11, 0x85, 0x34, 0xc2, 0x91, 0xb8, 0xce, 0x93, 0x10, 0xd8,
-	0x10, 0x09, 0x29, 0x91, 0xeb, 0x94, 0x08, 0xef, 0x02, 0xec, 0xe1, 0xa9, 0x44, 0x52, 0x01, 0x19,
-	0x62, 0xd4, 0xb0, 0xed, 0x11, 0xcd, 0x55, 0xba, 0xd2, 0x64, 0x96, 0x02, 0x60, 0x31, 0x24, 0x13,
-	0x7d, 0xd2, 0x95, 0x5e, 0x49, 0x91, 0x49, 0x23, 0x45, 0xdb, 0x4f, 0x60, 0x43, 0x24, 0x8c, 0xb7,
-	0x1c, 0xef, 0x42, 0x9a, 0x4c, 0x24, 0x05, 0x3f, 0x91, 0x64, 0xc5, 0x73, 0xd1, 0x1a, 0xd6, 0x1c,
-	0x4c, 0xcf, 0x7d, 0xc3, 0x5a, 0xd3, 0x90, 0xae, 0xe3, 0x3a, 0xc1, 0x15, 0x05, 0x30, 0x36, 0x88,
-	0xee, 0x80, 0x65, 0x38, 0x1e, 0x4a, 0xe9, 0x0e, 0xf0, 0x9c, 0xe8, 0xab, 0xed, 0xab, 0x67, 0x19,
-	0x89, 0x44, 0xc4, 0x39, 0xcc, 0x2b, 0xa6, 0xbe, 0xe8, 0x8a, 0x4f, 0x4c, 0xc2, 0x5e, 0xe6, 0x02,
-	0x8c, 0x3c, 0x88, 0xe4, 0xe0, 0x0d, 0xb9, 0x27, 0xeb, 0x9c, 0x8b, 0xb1, 0xa5, 0x74, 0x33, 0xc7,
-	0x66, 0xf6, 0x7c, 0xf5, 0xc4, 0x32, 0x5c, 0xc4, 0xd8, 0x6a, 0xf9, 0xea, 0x09, 0xcf, 0xa1, 0xbf,
-	0xda, 0xbe, 0xda, 0x11, 0x3a, 0x44, 0x3f, 0x39, 0x67, 0x22, 0x85, 0xf8, 0x57, 0xa3, 0x59, 0x0e,
-	0x9b, 0x43, 0x90, 0x76, 0x80, 0xca, 0xc0, 0x0f, 0x24, 0xf9, 0x7c, 0xb6, 0x77, 0xcf, 0x32, 0xee,
-	0x79, 0x58, 0x33, 0x2a, 0x4a, 0x0f, 0x4b, 0x22, 0x5e, 0x0d, 0xc6, 0x66, 0x99, 0x89, 0x67, 0xa6,
-	0x82, 0xb1, 0x09, 0xbf, 0xf8, 0xb1, 0x89, 0x14, 0x4a, 0xc1, 0xa0, 0x44, 0x9f, 0x6d, 0xfe, 0x2b,
-	0x1c, 0x94, 0x08, 0xcb, 0x0e, 0x4a, 0xa4, 0x05, 0x3e, 0x96, 0xe4, 0xee, 0x9c, 0x5f, 0x8e, 0xa9,
-	0x9c, 0x63, 0x1e, 0x7d, 0x8f, 0xae, 0xbd, 0xe3, 0xcb, 0x70, 0x19, 0xce, 0xb6, 0x7c, 0xf5, 0xb8,
-	0xe7, 0x2c, 0xc3, 0xd9, 0xb6, 0xaf, 0xde, 0x8e, 0x1c, 0x81, 0xb3, 0xdc, 0xea, 0x5a, 0x27, 0xa4,
-	0xee, 0x8e, 0x5d, 0xbb, 0x56, 0x41, 0x04, 0x5d, 0x75, 0x77, 0x2c, 0x9d, 0xac, 0xd3, 0x62, 0xcd,
-	0xc2, 0xe4, 0x9a, 0x85, 0xb7, 0xa8, 0x94, 0x3a, 0x1c, 0x1a, 0x89, 0x7e, 0xec, 0xef, 0x96, 0x1f,
-	0xa0, 0x61, 0xa3, 0x59, 0x0e, 0xbc, 0x80, 0x5d, 0x19, 0x1e, 0x8e, 0x09, 0xfe, 0x23, 0xc9, 0x6a,
-	0x96, 0x42, 0xdd, 0x76, 0xe9, 0x09, 0xe7, 0x62, 0xdd, 0x73, 0xb0, 0xb9, 0xa3, 0xf4, 0xb2, 0xf0,
-	0xfb, 0x23, 0x56, 0x41, 0x2c, 0xc3, 0x05, 0xdb, 0x25, 0x33, 0x31, 0xd8, 0xf2, 0xd5, 0xb3, 0x9e,
-	0x93, 0x96, 0xb5, 0x7d, 0xf5, 0xf1, 0x90, 0x64, 0x1a, 0xe0, 0xf8, 0x56, 0x91, 0xe9, 0xb2, 0x90,
-	0x9c, 0x6f, 0x2d, 0x90, 0xd1, 0xcc, 0x93, 0xb5, 0xa0, 0xf5, 0x42, 0xd6, 0x05, 0x78, 0x31, 0x4d,
-	0x2b, 0x8d, 0x82, 0x7f, 0x0b, 0x18, 0x1a, 0x96, 0x41, 0x0c, 0x5a, 0x47, 0xd0, 0xf3, 0x4e, 0x73,
-	0x95, 0x3e, 0xb6, 0x8a, 0x7f, 0xc8, 0xaa, 0x87, 0x65, 0x38, 0x13, 0xa0, 0x53, 0x14, 0xa4, 0x01,
-	0xe3, 0x8c, 0xe7, 0xa4, 0x44, 0x71, 0xb8, 0xc8, 0xc8, 0xf9, 0x60, 0x71, 0x7b, 0x38, 0x15, 0xc0,
-	0xb3, 0x16, 0xf2, 0x22, 0x7a, 0x02, 0xd1, 0x56, 0xb4, 0x60, 0xc8, 0xb8, 0x00, 0xfb, 0xd3, 0x04,
-	0x53, 0x20, 0x78, 0x5b, 0x92, 0xfb, 0x90, 0x47, 0x6c, 0xcd, 0xab, 0xaf, 0x39, 0xa8, 0x82, 0x93,
-	0xdc, 0x64, 0x5d, 0x39, 0xcf, 0x78, 0x2d, 0xd0, 0x0a, 0x88, 0xaa, 0x2c, 0x07, 0x1a, 0xd1, 0xb1,
-	0xfe, 0x62, 0x5c, 0x2c, 0x88, 0x40, 0x9e, 0xcd, 0x28, 0x9f, 0xa8, 0x8d, 0x8c, 0x42, 0xa1, 0x35,
-	0x50, 0x93, 0xfb, 0x22, 0x1f, 0x88, 0xad, 0xd5, 0x1d, 0x3a, 0xe2, 0xec, 0x68, 0x74, 0x95, 0x0b,
-	0x6c, 0x09, 0xdd, 0xa2, 0x8e, 0x84, 0x2a, 0x4b, 0xf6, 0x82, 0x83, 0x61, 0x88, 0xb7, 0x7d, 0xf5,
-	0x42, 0x30, 0xa2, 0x02, 0xb0, 0x04, 0x85, 0x6d, 0xc0, 0xa6, 0x0c, 0x36, 0x30, 0xae, 0x6b, 0x04,
-	0xd7, 0xea, 0xb6, 0x83, 0x1c, 0x03, 0xbb, 0xda, 0xba, 0xd2, 0xcf, 0x28, 0xbf, 0x48, 0xd7, 0x25,
-	0x45, 0x97, 0x12, 0x90, 0xd2, 0xbd, 0xc4, 0x7a, 0xc9, 0x02, 0x7c, 0x69, 0x74, 0x83, 0xa7, 0x3a,
-	0x7a, 0x03, 0xe6, 0xac, 0x80, 0x1d, 0xb9, 0x5b, 0x47, 0xfa, 0x3a, 0xd6, 0x8c, 0x35, 0xcb, 0x76,
-	0x70, 0x45, 0xab, 0x1a, 0x26, 0x76, 0x95, 0x8b, 0x8c, 0xe2, 0x0c, 0x3d, 0x60, 0x18, 0x3c, 0x13,
-	0xa0, 0xd3, 0x14, 0x8c, 0x07, 0x3a, 0x87, 0xe4, 0xb6, 0x44, 0xbc, 0xd4, 0x61, 0xde, 0x0c, 0xf8,
-	0xbe, 0x24, 0x5f, 0xa8, 0x3b, 0xf6, 0x1a, 0xad, 0x2d, 0x34, 0xaf, 0x5e, 0x41, 0x04, 0xf3, 0xf9,
-	0xfa, 0xa3, 0x8c, 0xfb, 0x12, 0x4d, 0x37, 0x23, 0xad, 0x65, 0xa6, 0xc4, 0xe7, 0xe6, 0x41, 0xcd,
-	0x5b, 0x80, 0x73, 0xee, 0xdc, 0xe4, 0x06, 0x42, 0xba, 0x09, 0x8b, 0x2c, 0x82, 0xb7, 0x24, 0xb9,
-	0xd7, 0x34, 0x6a, 0x06, 0xd1, 0x56, 0x91, 0x55, 0xd9, 0x32, 0x2a, 0x64, 0x5d, 0x33, 0x2c, 0xcd,
-	0x44, 0x96, 0x32, 0xc0, 0x86, 0x64, 0x8e, 0xd5, 0x72, 0x54, 0x63, 0x22, 0x52, 0x98, 0xb1, 0x66,
-	0x91, 0x95, 0xd4, 0xdf, 0x79, 0xec, 0x80, 0x61, 0x11, 0x99, 0x02, 0x6f, 0x4a, 0x32, 0xa8, 0x19,
-	0x96, 0xb6, 0x6e, 0xd7, 0xb0, 0x56, 0x31, 0xdc, 0x0d, 0xad, 0xea, 0x60, 0xac, 0xa8, 0x83, 0xd2,
-	0xd0, 0xa9, 0xd1, 0x8e, 0xab, 0xc1, 0x45, 0xd7, 0xd5, 0x45, 0xe3, 0x75, 0x3c, 0xf1, 0xc2, 0x27,
-	0xbe, 0x7a, 0x84, 0xee, 0xea, 0x9a, 0x61, 0xbd, 0x68, 0xd7, 0xf0, 0x94, 0xe1, 0x6e, 0x4c, 0x3b,
-	0x18, 0xc7, 0xab, 0x23, 0x23, 0xe7, 0xf7, 0xc1, 0xe0, 0x65, 0xea, 0xc8, 0xb1, 0x91, 0xc1, 0xcb,
-	0x30, 0xdb, 0x1c, 0xdc, 0x97, 0xe4, 0x8e, 0x68, 0xbd, 0xb3, 0x53, 0x60, 0x90, 0x9d, 0x02, 0x7f,
-	0x66, 0x19, 0x48, 0xb4, 0x68, 0x83, 0xb3, 0xe0, 0x94, 0x93, 0x7c, 0xb6, 0x7d, 0x75, 0x2a, 0x2a,
-	0x00, 0x22, 0x99, 0xe0, 0x5c, 0x08, 0x77, 0x80, 0x9b, 0x09, 0xf1, 0x35, 0x4c, 0xd0, 0xd5, 0xd7,
-	0x5c, 0xdb, 0xa2, 0xa1, 0x34, 0x65, 0x36, 0xfd, 0xb9, 0xbf, 0x5b, 0x1e, 0x7a, 0x50, 0x53, 0x34,
-	0x5d, 0xe1, 0xfc, 0x85, 0x89, 0x1d, 0xc7, 0x04, 0x2b, 0x72, 0x17, 0x32, 0xb7, 0x68, 0x31, 0x14,
-	0x14, 0xf7, 0x16, 0x26, 0xae, 0xf2, 0x18, 0xbb, 0x53, 0xa3, 0x35, 0xe8, 0x99, 0x00, 0x64, 0x45,
-	0xf2, 0x3c, 0x26, 0x74, 0xe1, 0xf7, 0x04, 0x11, 0x26, 0x25, 0x2f, 0xc1, 0xac, 0x22, 0xf8, 0x9f,
-	0x24, 0x0f, 0xd9, 0x9b, 0xd8, 0xd9, 0x72, 0x0c, 0x42, 0x03, 0x47, 0xcd, 0x26, 0x58, 0xab, 0xe0,
-	0x4d, 0x43, 0xc7, 0x9a, 0x85, 0x6a, 0xd8, 0xd5, 0x6c, 0x4b, 0x0b, 0xeb, 0x12, 0xa5, 0x94, 0xdc,
-	0xf6, 0xf4, 0xdd, 0x8d, 0x1a, 0x41, 0xd6, 0x66, 0x0a, 0x6f, 0xce, 0x53, 0xf5, 0x96, 0xaf, 0x5e,
-	0xb2, 0x73, 0x90, 0xa1, 0x63, 0x86, 0xde, 0xb5, 0x26, 0x03, 0x53, 0x6d, 0x5f, 0x7d, 0x8e, 0x39,
-	0xf8, 0x00, 0xba, 0xc5, 0x8b, 0x92, 0x16, 0x55, 0x05, 0x7e, 0xc0, 0x07, 0xf1, 0x02, 0x7c, 0x53,
-	0x3e, 0x47, 0xc3, 0x98, 0x66, 0x58, 0x15, 0xbc, 0xad, 0xd1, 0x95, 0xbc, 0x6a, 0xda, 0xfa, 0x86,
-	0xab, 0x5c, 0x62, 0x5b, 0x9a, 0x2e, 0x1a, 0x40, 0x15, 0x66, 0x28, 0x3e, 0x67, 0x58, 0x13, 0x0c,
-	0x8d, 0x2f, 0x51, 0xf3, 0x90, 0x30, 0x71, 0x0d, 0xd2, 0x51, 0x28, 0xb0, 0x04, 0xfe, 0x45, 0xb3,
-	0x4f, 0x0b, 0xe9, 0x1b, 0xb8, 0xa2, 0x59, 0x36, 0x31, 0xaa, 0x86, 0x8e, 0x82, 0xeb, 0x80, 0x8a,
-	0xab, 0x94, 0xd9, 0xfc, 0xbe, 0x4f, 0x87, 0xbb, 0x77, 0x39, 0x50, 0x9a, 0xe7, 0x74, 0x66, 0xa6,
-	0xe8, 0x68, 0xf7, 0x7a, 0x42, 0xa4, 0xed, 0xab, 0xfd, 0x41, 0x68, 0x17, 0xc1, 0xec, 0xea, 0x50,
-	0x88, 0xb4, 0x77, 0xcb, 0x05, 0x16, 0x1b, 0xcd, 0x72, 0x81, 0x17, 0x50, 0xd8, 0xa2, 0xe2, 0x02,
-	0x28, 0x9f, 0x26, 0x0e, 0xaa, 0x56, 0x0d, 0x5d, 0xd3, 0x4d, 0xe4, 0xba, 0xca, 0x65, 0x36, 0xac,
-	0xcf, 0xd0, 0xf2, 0x35, 0x04, 0x26, 0xa9, 0xbc, 0xed, 0xab, 0x20, 0x18, 0x50, 0x4e, 0x18, 0xdf,
-	0x9b, 0xa4, 0x54, 0xc1, 0x1b, 0x72, 0x77, 0x38, 0xc4, 0x5a, 0xd5, 0x36, 0x2b, 0xd8, 0xd1, 0xea,
-	0x88, 0xac, 0x2b, 0x8f, 0xb3, 0x5d, 0x7f, 0x67, 0xcf, 0x57, 0xfb, 0xa7, 0x70, 0xdd, 0xc1, 0x3a,
-	0x22, 0xb8, 0x32, 0x15, 0x28, 0x4e, 0x33, 0xbd, 0x05, 0x44, 0xd6, 0x5b, 0xbe, 0x2a, 0x3d, 0x13,
-	0x17, 0xcb, 0x95, 0x2c, 0x7c, 0xc5, 0xae, 0x19, 0x74, 0x92, 0xc8, 0x4e, 0x49, 0x91, 0x60, 0x57,
-	0x0e, 0x07, 0x1b, 0xf2, 0x59, 0x17, 0x13, 0xcd, 0xb4, 0xb7, 0xb4, 0xba, 0x63, 0xd8, 0x8e, 0x41,
-	0x76, 0x94, 0x27, 0xd8, 0xa6, 0x18, 0x6f, 0xf9, 0x6a, 0xa7, 0x8b, 0xc9, 0xac, 0xbd, 0xb5, 0x10,
-	0x22, 0x71, 0x64, 0x4b, 0x8b, 0x0b, 0xcb, 0xf2, 0x4c, 0x73, 0xf0, 0x81, 0x24, 0xf7, 0xd6, 0xd0,
-	0x76, 0x44, 0x53, 0xb7, 0x2d, 0xdd, 0x73, 0x1c, 0x6c, 0xe9, 0x3b, 0xca, 0x10, 0x1b, 0x47, 0x97,
-	0xdd, 0x7d, 0xa0, 0xad, 0x39, 0xb4, 0x1d, 0xf8, 0x38, 0x99, 0xa8, 0xd0, 0x23, 0xbf, 0x26, 0x90,
-	0xc7, 0x47, 0xbe, 0x08, 0x8c, 0x86, 0x9c, 0x5d, 0x56, 0x88, 0xed, 0x42, 0xa1, 0x55, 0xf0, 0xa9,
-	0x24, 0x77, 0xeb, 0x0e, 0x72, 0xd7, 0x33, 0x29, 0xf9, 0x93, 0x6c, 0x5a, 0x3e, 0x64, 0x29, 0xf9,
-	0x64, 0x94, 0x92, 0xeb, 0x61, 0x4a, 0x3e, 0x1d, 0x9c, 0xcd, 0xb4, 0x59, 0x92, 0x1c, 0x0b, 0xc3,
-	0x30, 0xd3, 0xc9, 0xa7, 0xd9, 0x4c, 0x4c, 0xd7, 0x72, 0x57, 0xce, 0x08, 0x4d, 0xd6, 0xf5, 0x30,
-	0x59, 0x2f, 0x3f, 0x88, 0x19, 0x9a, 0xae, 0x4f, 0x06, 0xe9, 0x7a, 0xc6, 0x98, 0x63, 0x82, 0x9f,
-	0x4a, 0x72, 0x5f, 0x96, 0x5e, 0x74, 0x4b, 0xf2, 0x14, 0x9b, 0x7f, 0x63, 0xcf, 0x57, 0x4f, 0x4e,
-	0x42, 0xee, 0x82, 0x3f, 0x6d, 0x25, 0x7b, 0xc1, 0x2f, 0x44, 0x8b, 0x96, 0x46, 0xa3, 0x59, 0x4e,
-	0x6c, 0x43, 0xb1, 0x65, 0xf0, 0x6d, 0x49, 0xee, 0x75, 0x89, 0x67, 0x69, 0x34, 0x73, 0x42, 0xa6,
-	0xb1, 0x89, 0xb5, 0xe0, 0xee, 0xc8, 0x55, 0x9e, 0x8e, 0xf3, 0xd1, 0x6e, 0xaa, 0x71, 0x27, 0x52,
-	0x58, 0xa4, 0xf8, 0x62, 0x9c, 0x25, 0x09, 0xb0, 0x74, 0x6e, 0xcd, 0x05, 0xb4, 0x63, 0x23, 0xb7,
-	0x87, 0xa1, 0xc8, 0x1a, 0x2d, 0x59, 0x33, 0x6e, 0xd0, 0xb8, 0xea, 0x2a, 0x57, 0x98, 0x13, 0x2f,
-	0xd1, 0x44, 0x2d, 0xd5, 0x6c, 0xce, 0xb0, 0x92, 0xd4, 0x3e, 0x87, 0xf0, 0x39, 0x62, 0x2a, 0xa0,
-	0x8e, 0x0e, 0xc3, 0xbc, 0x1d, 0x9a, 0x95, 0x77, 0xb0, 0xde, 0xa3, 0x77, 0xa7, 0x67, 0x58, 0x0c,
-	0xad, 0xec, 0xf9, 0x6a, 0x27, 0x44, 0x5b, 0x8b, 0xc4, 0xe3, 0x5e, 0x9c, 0x4e, 0xb9, 0xc9, 0x67,
-	0x7c, 0x37, 0x94, 0xc8, 0x0e, 0x7d, 0x15, 0xcb, 0x58, 0x84, 0xbc, 0x3d, 0xb0, 0x29, 0x9f, 0xa1,
-	0x55, 0xe0, 0x2a, 0x72, 0xb1, 0x16, 0x3c, 0x01, 0x2a, 0x57, 0x07, 0xa5, 0xa1, 0xce, 0xd1, 0xce,
-	0x28, 0x2d, 0x5a, 0x62, 0x52, 0x76, 0x99, 0xd7, 0x19, 0xa9, 0x06, 0xb2, 0x38, 0x72, 0xa4, 0xc5,
-	0xa5, 0x41, 0x07, 0xb3, 0x29, 0x0d, 0x97, 0xc7, 0x9b, 0xcd, 0xb2, 0x04, 0x33, 0x4d, 0xc1, 0xbb,
-	0x47, 0xe5, 0x4b, 0x34, 0x6a, 0xc4, 0xe1, 0x82, 0xd6, 0x94, 0xba, 0x5d, 0xa3, 0x4b, 0xd6, 0xc1,
-	0xf7, 0x3c, 0xec, 0x12, 0x6d, 0xc3, 0x58, 0x55, 0xae, 0xb1, 0xe9, 0xf8, 0xab, 0x14, 0x3e, 0x1d,
-	0xce, 0xa1, 0xed, 0xc9, 0x19, 0x18, 0xe0, 0x77, 0x8c, 0x89, 0x96, 0xaf, 0xaa, 0x35, 0xb4, 0x1d,
-	0x6f, 0x71, 0x32, 0x13, 0xda, 0x48, 0x54, 0xe2, 0x53, 0xf0, 0x10, 0x3d, 0xae, 0x1e, 0x3b, 0xd4,
-	0xe4, 0xe1, 0x2a, 0xe1, 0x63, 0x64, 0xc6, 0x5d, 0x78, 0x48, 0xb3, 0x55, 0xf0, 0xb9, 0x24, 0xf7,
-	0xc6, 0x2f, 0x22, 0x26, 0xe2, 0xdf, 0x50, 0x87, 0xd9, 0x06, 0xfe, 0x88, 0x8e, 0x44, 0x4f, 0xf4,
-	0xa2, 0x30, 0x3b, 0x3e, 0xcf, 0x3f, 0xa3, 0xf6, 0x20, 0x81, 0x3c, 0x4e, 0xa4, 0x45, 0xa0, 0xe8,
-	0x21, 0x4b, 0x68, 0xa4, 0x40, 0xce, 0x6d, 0x7d, 0xa1, 0x53, 0x30, 0x69, 0x85, 0xb8, 0x37, 0xd8,
-	0x4d, 0xf9, 0x02, 0x7b, 0xf4, 0xa8, 0x7a, 0xa6, 0x19, 0x66, 0x35, 0xb6, 0x15, 0x95, 0xa8, 0xca,
-	0x08, 0x63, 0x3a, 0x46, 0xb3, 0x06, 0xaa, 0x35, 0xed, 0x99, 0x26, 0xcb, 0x47, 0xee, 0x5a, 0x61,
-	0x51, 0xd9, 0xf6, 0xd5, 0x8b, 0xe1, 0x91, 0x25, 0x82, 0x4b, 0xb0, 0xa0, 0x1d, 0x78, 0x49, 0x3e,
-	0x5d, 0xc5, 0x88, 0x78, 0x0e, 0xd6, 0xaa, 0x26, 0x5a, 0x73, 0x95, 0x51, 0xb6, 0xef, 0x2e, 0xd3,
-	0x93, 0x3e, 0x04, 0xa6, 0xa9, 0x3c, 0x7e, 0x20, 0xe1, 0x84, 0x25, 0x98, 0x52, 0x01, 0x5b, 0x72,
-	0x1f, 0xf7, 0x2e, 0x12, 0xd4, 0x38, 0xd8, 0xb2, 0xbd, 0xb5, 0x75, 0xe5, 0x3a, 0x5b, 0xb4, 0xcf,
-	0xb3, 0xf0, 0x1a, 0xab, 0xcc, 0x52, 0x8d, 0x17, 0x98, 0x42, 0x9c, 0xf5, 0x08, 0xd1, 0x38, 0xa3,
-	0x10, 0x37, 0x06, 0x1b, 0x72, 0x4f, 0xae, 0xe3, 0x1a, 0xda, 0x56, 0x6e, 0xb0, 0x5e, 0x9f, 0xa3,
-	0xc9, 0x60, 0xa6, 0xe1, 0x1c, 0xda, 0x6e, 0xfb, 0xaa, 0x22, 0xea, 0x72, 0x0e, 0x6d, 0xc7, 0xfd,
-	0x09, 0x9a, 0x81, 0xb7, 0x8f, 0xca, 0x6a, 0x74, 0xd9, 0xa3, 0x21, 0x93, 0xa6, 0x14, 0xb6, 0x59,
-	0xd1, 0x88, 0xe9, 0x6a, 0x34, 0x7e, 0x18, 0xb6, 0xe5, 0x2a, 0x37, 0xd9, 0x7c, 0x7d, 0x4c, 0x57,
-	0x66, 0x7f, 0x74, 0xb5, 0x32, 0x4e, 0x55, 0xef, 0x9a, 0x95, 0xa5, 0xd9, 0xc5, 0x57, 0x42, 0xbd,
-	0x96, 0xaf, 0xf6, 0x1b, 0xc5, 0x70, 0x9c, 0xef, 0x1c, 0xa0, 0x43, 0xd7, 0xe7, 0x81, 0x36, 0x0e,
-	0x86, 0x1b, 0xcd, 0xf2, 0x41, 0x0e, 0xc2, 0x7c, 0x5b, 0xd3, 0x8d, 0x40, 0xd0, 0x94, 0xe4, 0x7e,
-	0x6e, 0xdc, 0xa3, 0xc4, 0x4a, 0x23, 0x7a, 0x9d, 0x95, 0xb3, 0xb7, 0xd8, 0xf0, 0xbf, 0x43, 0x47,
-	0x41, 0x99, 0x8c, 0xf5, 0xa2, 0x34, 0x69, 0x69, 0x72, 0x61, 0x76, 0x7c, 0xbe, 0xe5, 0xab, 0x8a,
-	0x9e, 0xc7, 0xf4, 0x7a, 0x50, 0xf0, 0x3e, 0x9d, 0x99, 0xa1, 0xb4, 0xc2, 0x01, 0x49, 0x7b, 0xa3,
-	0x59, 0x2e, 0xec, 0x13, 0x16, 0xf6, 0x08, 0xfe, 0x21, 0xc9, 0x17, 0x45, 0x94, 0xee, 0x79, 0x86,
-	0xce, 0x38, 0x3d, 0xcb, 0x38, 0xbd, 0x4b, 0x39, 0x9d, 0xcf, 0xdb, 0x7f, 0x79, 0x79, 0x66, 0x32,
-	0x20, 0x75, 0x3e, 0xdf, 0xc5, 0xcb, 0x9e, 0xa1, 0x07, 0xac, 0xae, 0x14, 0xb0, 0x0a, 0x35, 0x0e,
-	0x38, 0x3a, 0x1b, 0xcd, 0x72, 0x71, 0xb7, 0xb0, 0xb8, 0xd3, 0x03, 0xe7, 0x6a, 0x0b, 0x59, 0xca,
-	0xed, 0xc3, 0xe6, 0x6a, 0xe5, 0x80, 0xb9, 0x5a, 0x39, 0x6c, 0xae, 0x56, 0x52, 0xa4, 0xae, 0xa7,
-	0x48, 0x5d, 0x2f, 0x9e, 0xab, 0x95, 0xc2, 0xb9, 0x5a, 0x39, 0x6c, 0xae, 0x28, 0xa7, 0xe7, 0x0e,
-	0x9d, 0xab, 0x95, 0x83, 0xe6, 0x6a, 0xe5, 0xd0, 0xb9, 0x4a, 0xd3, 0xba, 0x91, 0xa2, 0x75, 0xe3,
-	0x80, 0xb9, 0x5a, 0x29, 0x9e, 0x2b, 0x4a, 0xac, 0x21, 0xc9, 0xe7, 0x45, 0xc4, 0xd8, 0x6b, 0xa3,
-	0x32, 0xc6, 0x58, 0xbd, 0xd2, 0xf2, 0xd5, 0xbe, 0xbc, 0x09, 0xf6, 0x52, 0x99, 0xe4, 0xaa, 0x62,
-	0x9c, 0xbf, 0xb4, 0x4a, 0xf9, 0x7c, 0x73, 0x18, 0x16, 0xd9, 0x04, 0x7f, 0x92, 0xe4, 0xcb, 0x22,
-	0xa7, 0xe2, 0x1b, 0xcc, 0x75, 0x07, 0xbb, 0xeb, 0xb6, 0x59, 0x51, 0xbe, 0xc0, 0x1c, 0x7c, 0xad,
-	0xe5, 0xab, 0x02, 0x07, 0xc2, 0x73, 0x67, 0x29, 0xd2, 0x6e, 0xfb, 0xea, 0x8d, 0x02, 0x5f, 0xb3,
-	0xaa, 0x9c, 0xdb, 0xbc, 0xd7, 0xd2, 0x30, 0x7c, 0x80, 0xc6, 0xe0, 0xeb, 0x72, 0x87, 0x57, 0xb7,
-	0xea, 0x71, 0xf6, 0xff, 0xcb, 0x69, 0x16, 0xa3, 0xbf, 0xb2, 0xe7, 0xab, 0xe7, 0x92, 0xc2, 0x73,
-	0x79, 0xc1, 0x5a, 0x48, 0x4a, 0x01, 0x56, 0x72, 0x86, 0xd5, 0x78, 0xdd, 0xaa, 0x87, 0x00, 0x57,
-	0x6c, 0x36, 0x9a, 0x65, 0x71, 0x63, 0x45, 0x82, 0xa7, 0xb8, 0x26, 0xe0, 0xe7, 0x52, 0xd8, 0x7d,
-	0xf4, 0xf4, 0xf9, 0xc1, 0x34, 0x1b, 0xa5, 0x37, 0x59, 0xf2, 0x92, 0x36, 0x11, 0x3f, 0x83, 0xb2,
-	0xee, 0x07, 0xe3, 0xee, 0xf9, 0xe7, 0x4b, 0xce, 0x87, 0x24, 0x4b, 0xbb, 0x50, 0xac, 0x45, 0xb3,
-	0x11, 0x51, 0x2f, 0x8a, 0x04, 0xe5, 0xa4, 0x15, 0xf8, 0xad, 0x24, 0x77, 0x32, 0x37, 0x93, 0x47,
-	0xce, 0x5f, 0x05, 0x8e, 0x7e, 0x97, 0x5d, 0x66, 0xa4, 0x4d, 0x70, 0x0f, 0x9e, 0xcc, 0xd5, 0x52,
-	0xec, 0x6a, 0xfa, 0x89, 0x52, 0xe8, 0xec, 0xc5, 0x83, 0xf4, 0x1a, 0xcd, 0x72, 0x41, 0x5f, 0x8a,
-	0x04, 0x3b, 0xf8, 0x96, 0x89, 0xcb, 0xc9, 0x53, 0xe6, 0x87, 0xc5, 0x2e, 0x73, 0xcf, 0x9a, 0x19,
-	0x97, 0xd3, 0x0f, 0x91, 0xc5, 0x2e, 0x17, 0xe9, 0xe5, 0x5d, 0x8e, 0x34, 0x23, 0x97, 0xe3, 0x97,
-	0xcb, 0xaa, 0x1c, 0xfc, 0x65, 0x22, 0xae, 0x75, 0x7e, 0x3d, 0xcd, 0x92, 0xae, 0x2f, 0xa5, 0xfd,
-	0x65, 0xfb, 0x2e, 0x29, 0x7a, 0xb8, 0xc5, 0xe8, 0x24, 0x48, 0xfa, 0xe6, 0xa3, 0x83, 0x43, 0x5c,
-	0x76, 0xd3, 0x9c, 0xbf, 0xe4, 0xd5, 0xea, 0x3a, 0x51, 0x3e, 0xa2, 0x43, 0x24, 0x4d, 0xcc, 0xed,
-	0xf9, 0xea, 0xc5, 0xa4, 0xc7, 0xb9, 0xf4, 0x15, 0xed, 0x82, 0x4e, 0xd2, 0xe3, 0x54, 0xcb, 0xe1,
-	0xe9, 0xee, 0x41, 0x5e, 0x81, 0x16, 0x76, 0x3d, 0x99, 0xb2, 0xc6, 0xd5, 0x91, 0xe5, 0x2a, 0xbf,
-	0x09, 0x66, 0x69, 0x29, 0xe3, 0x02, 0x5f, 0x0e, 0x2c, 0x52, 0xc5, 0x8c, 0x0b, 0x39, 0x3c, 0x3f,
-	0x55, 0xcc, 0x93, 0x9c, 0xde, 0xc4, 0x9d, 0x4f, 0x3e, 0x1b, 0x38, 0xd2, 0xfc, 0x6c, 0xe0, 0xc8,
-	0x27, 0x7b, 0x03, 0x52, 0x73, 0x6f, 0x40, 0x7a, 0xe7, 0xfe, 0xc0, 0x91, 0xf7, 0xef, 0x0f, 0x48,
-	0xcd, 0xfb, 0x03, 0x47, 0xfe, 0x79, 0x7f, 0xe0, 0xc8, 0xab, 0x4f, 0xae, 0x19, 0x64, 0xdd, 0x5b,
-	0xbd, 0xaa, 0xdb, 0xb5, 0x6b, 0xf1, 0x65, 0x03, 0xf7, 0x2b, 0xf9, 0x0f, 0xe8, 0xea, 0x09, 0xf6,
-	0xa7, 0xcf, 0xeb, 0xff, 0x0f, 0x00, 0x00, 0xff, 0xff, 0xc9, 0x24, 0x93, 0x46, 0x60, 0x2a, 0x00,
-	0x00,
+	// 5000 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x5b, 0x6f, 0x6c, 0x1d, 0xd9,
+	0x55, 0xcf, 0x24, 0xdd, 0x34, 0x99, 0x38, 0x71, 0x7c, 0xed, 0xd8, 0x93, 0x3f, 0xcd, 0xb8, 0xde,
+	0x97, 0xd6, 0xdb, 0xcd, 0x1f, 0xc7, 0x49, 0xb6, 0xd9, 0x94, 0xb2, 0xeb, 0x3f, 0x71, 0xd7, 0x1b,
+	0xdb, 0xf1, 0x5e, 0xdb, 0x35, 0x2a, 0x42, 0xc3, 0xbc, 0x79, 0xf7, 0xd9, 0x53, 0xcf, 0x9b, 0x79,
+	0x99, 0x99, 0xe7, 0x3f, 0x5b, 0xd4, 0x5d, 0xb5, 0x40, 0xe1, 0x13, 0xc5, 0x2a, 0xff, 0x11, 0x14,
+	0x01, 0x12, 0xcb, 0xb6, 0x08, 0x09, 0x09, 0x04, 0x08, 0xa8, 0x90, 0x8a, 0x56, 0x20, 0x64, 0x7f,
+	0x42, 0x20, 0x60, 0x50, 0xb3, 0xf0, 0xe5, 0x7d, 0xe0, 0xc3, 0xfb, 0x18, 0xbe, 0xa0, 0x73, 0xe6,
+	0xdf, 0xbd, 0x33, 0x77, 0x9c, 0x7c, 0x7b, 0x73, 0x7e, 0xe7, 0x9c, 0x7b, 0xce, 0xbd, 0x77, 0xce,
+	0x9c, 0x73, 0xee, 0x7d, 0xea, 0x35, 0xc7, 0xae, 0xdf, 0xb2, 0x3c, 0xb7, 0x69, 0x6f, 0xdc, 0xf2,
+	0xda, 0xa1, 0xed, 0xb9, 0x41, 0xfc, 0xd4, 0xf1, 0x4d, 0x78, 0xba, 0xd9, 0xf6, 0xbd, 0xd0, 0x23,
+	0x27, 0x63, 0xe2, 0xa5, 0x11, 0x8e, 0x3d, 0xec, 0xb8, 0xb6, 0xbb, 0x11, 0x33, 0x5c, 0x1a, 0xe5,
+	0x80, 0x86, 0x19, 0x9a, 0x75, 0x33, 0x60, 0x75, 0xd3, 0xda, 0x62, 0x6e, 0x23, 0xe1, 0xb8, 0xc0,
+	0x71, 0x04, 0xf6, 0xbb, 0x2c, 0x21, 0x9f, 0x66, 0xbb, 0x61, 0xfc, 0x73, 0x6c, 0x7f, 0x5b, 0x1d,
+	0x7a, 0x1c, 0xdb, 0x30, 0xc3, 0xdb, 0x40, 0x7e, 0x47, 0x51, 0xcf, 0x3b, 0x76, 0x10, 0x32, 0xd7,
+	0x30, 0x1b, 0x0d, 0x9f, 0x05, 0x01, 0x0b, 0x34, 0x65, 0xf4, 0xc4, 0xf8, 0xe9, 0xe9, 0xe0, 0x69,
+	0xa4, 0x13, 0x6a, 0xee, 0x2c, 0x20, 0x3c, 0x95, 0xa2, 0xdd, 0x48, 0xef, 0x77, 0x44, 0x52, 0x2f,
+	0xd2, 0xaf, 0xed, 0xb6, 0x9c, 0x07, 0x63, 0x02, 0x7d, 0x6c, 0xb4, 0xc1, 0x9a, 0x66, 0xc7, 0x09,
+	0x1f, 0x8c, 0x25, 0x3f, 0xc6, 0x9e, 0x1d, 0xd4, 0x3e, 0x99, 0xfc, 0xde, 0x3f, 0xac, 0x49, 0x94,
+	0xd3, 0xa2, 0x6a, 0xf2, 0xbf, 0x8a, 0xaa, 0x6d, 0x38, 0x5e, 0xdd, 0x74, 0x8c, 0x86, 0x1d, 0x58,
+	0xde, 0x36, 0xf3, 0xf7, 0x8c, 0x80, 0xf9, 0xdb, 0xcc, 0x0f, 0xb4, 0xe3, 0x68, 0xe8, 0x9f, 0x29,
+	0x4f, 0x23, 0x7d, 0x90, 0x9a, 0x3b, 0x5f, 0x42, 0xbe, 0x29, 0xd7, 0x5d, 0x89, 0xf1, 0x6e, 0xa4,
+	0x5f, 0xd8, 0x48, 0x69, 0x5e, 0xc7, 0xb5, 0x58, 0x02, 0xf4, 0x22, 0xfd, 0x3a, 0x1a, 0x2c, 0x43,
+	0x25, 0x76, 0x77, 0x0f, 0x6a, 0x43, 0x32, 0xd6, 0xde, 0x41, 0x4d, 0x3e, 0x80, 0xe8, 0xa8, 0xcc,
+	0x36, 0x3a, 0x1c, 0x0b, 0xce, 0xa6, 0x4e, 0x25, 0x74, 0xf2, 0xdf, 0x32, 0x87, 0x99, 0x6b, 0xd6,
+	0x1d, 0xd6, 0xd0, 0x4e, 0x8c, 0x2a, 0xe3, 0xa7, 0xa6, 0x3f, 0x00, 0x87, 0xcf, 0x67, 0x1a, 0x1f,
+	0xc6, 0x60, 0xd9, 0xdb, 0x04, 0xe8, 0x45, 0xfa, 0xe7, 0x24, 0xde, 0x26, 0x28, 0xe7, 0x6e, 0xe8,
+	0x77, 0x18, 0xf8, 0x5a, 0xa1, 0xa6, 0x0a, 0x78, 0x76, 0x50, 0xfb, 0x04, 0x88, 0xee, 0x1f, 0xd6,
+	0x4a, 0x46, 0x95, 0xdc, 0x4c, 0xe8, 0xe4, 0x3f, 0x14, 0x75, 0xc4, 0xf1, 0x2c, 0xa9, 0x97, 0x9f,
+	0x40, 0x2f, 0x7f, 0x1f, 0xbc, 0xec, 0x5f, 0xf0, 0x2c, 0x5e, 0x5f, 0x37, 0xd2, 0x87, 0x1c, 0xcf,
+	0x2a, 0xd9, 0xd0, 0x8b, 0xf4, 0x57, 0xe2, 0x2d, 0xe8, 0x59, 0x2f, 0xe2, 0xa2, 0x5c, 0x49, 0x05,
+	0x9d, 0x73, 0xb0, 0x68, 0x0f, 0xbd, 0x80, 0x02, 0x25, 0xf7, 0xfe, 0x49, 0x51, 0x07, 0x63, 0xf7,
+	0xcc, 0x44, 0x97, 0xd1, 0xf6, 0xfc, 0x50, 0x7b, 0x69, 0x54, 0x19, 0x7f, 0x69, 0xfa, 0x37, 0xc1,
+	0xb5, 0xbe, 0x54, 0xd5, 0xb2, 0xe7, 0x87, 0xdd, 0x48, 0x1f, 0x10, 0x86, 0x06, 0x62, 0x2f, 0xd2,
+	0x3f, 0x5b, 0x76, 0x0a, 0x10, 0xce, 0xa3, 0xc9, 0xdb, 0x13, 0x93, 0x9f, 0x1f, 0x7b, 0x16, 0xe9,
+	0x27, 0x6c, 0x37, 0xec, 0x1e, 0xd4, 0x24, 0x6a, 0x64, 0xc4, 0x67, 0x07, 0xb5, 0x97, 0x50, 0x74,
+	0xff, 0xb0, 0x26, 0x58, 0x42, 0xcb, 0xbc, 0xe4, 0x9b, 0xc7, 0xd5, 0xd1, 0x82, 0x37, 0xad, 0x8e,
+	0x13, 0xda, 0x96, 0x19, 0x84, 0x69, 0xdc, 0xd0, 0x4e, 0x8e, 0x2a, 0xe3, 0xa7, 0xa7, 0xff, 0x12,
+	0x5c, 0x3b, 0x97, 0x2a, 0x5c, 0x9c, 0x81, 0x37, 0xb9, 0x1b, 0xe9, 0x83, 0x82, 0xd2, 0x98, 0xdc,
+	0x8b, 0xf4, 0xd7, 0xca, 0xee, 0xc5, 0x18, 0xe7, 0xe0, 0x4f, 0x36, 0x9b, 0xb7, 0x27, 0x1f, 0x3c,
+	0xb8, 0x7f, 0xe7, 0xfe, 0xdd, 0x9f, 0x7a, 0x10, 0x7b, 0xdb, 0x3d, 0xa8, 0x49, 0x15, 0xca, 0xc9,
+	0xcf, 0x0e, 0x6a, 0xa4, 0xac, 0x64, 0xff, 0xb0, 0x56, 0x30, 0x93, 0x7e, 0x4a, 0x14, 0x4e, 0x3d,
+	0x4c, 0x82, 0x11, 0x79, 0xac, 0x9e, 0x6d, 0x99, 0xbb, 0x46, 0xc0, 0xdc, 0x86, 0xb1, 0x55, 0x6f,
+	0x07, 0xda, 0x27, 0x71, 0x31, 0x5f, 0xed, 0x46, 0xfa, 0x99, 0x96, 0xb9, 0xbb, 0xc2, 0xdc, 0xc6,
+	0xa3, 0x7a, 0x1b, 0x82, 0xcb, 0x00, 0xba, 0xc5, 0xd1, 0xd2, 0xf5, 0xa1, 0x3c, 0x63, 0xaa, 0xd0,
+	0x67, 0xd6, 0x76, 0xac, 0xf0, 0x94, 0xa0, 0x90, 0x32, 0x6b, 0xbb, 0xa8, 0x30, 0xa5, 0x09, 0x0a,
+	0x53, 0x22, 0xf9, 0x73, 0x45, 0x1d, 0xf1, 0x99, 0xe5, 0xb9, 0x2e, 0xb3, 0x20, 0xbc, 0x1b, 0xb6,
+	0x1b, 0x32, 0x7f, 0xdb, 0x74, 0x8c, 0x40, 0x3b, 0x8d, 0xba, 0xbf, 0x8e, 0x41, 0x3d, 0x65, 0x99,
+	0x4f, 0xe0, 0x15, 0x88, 0x1d, 0xbc, 0x60, 0x06, 0xf4, 0x22, 0x7d, 0x1c, 0xc7, 0x96, 0xa2, 0xdc,
+	0x2a, 0xbd, 0x36, 0x91, 0x9a, 0xf4, 0xec, 0xa0, 0x76, 0xfc, 0xb5, 0x09, 0x8c, 0xef, 0xa5, 0x71,
+	0xa8, 0x7c, 0x14, 0xd2, 0x54, 0xcf, 0xf9, 0xcc, 0x31, 0xf7, 0x82, 0x2c, 0x06, 0xa8, 0x18, 0x03,
+	0xde, 0xe8, 0x46, 0xfa, 0xd9, 0x18, 0xc9, 0x5f, 0xf4, 0xb1, 0xc4, 0x20, 0x8e, 0x5a, 0x7c, 0xc3,
+	0xd3, 0x37, 0x96, 0x8a, 0xc2, 0xe4, 0x1b, 0xc7, 0xd5, 0xcb, 0xc9, 0x40, 0x99, 0x21, 0xf9, 0x24,
+	0xb5, 0xb4, 0x33, 0x38, 0x49, 0x7f, 0x0f, 0x7b, 0x78, 0x84, 0x02, 0x5f, 0xc9, 0x85, 0xc5, 0x6e,
+	0xa4, 0x8f, 0xf8, 0x72, 0x28, 0x0b, 0xb4, 0x15, 0x38, 0x67, 0xe5, 0xed, 0x09, 0xee, 0x95, 0xad,
+	0xd4, 0x57, 0x0d, 0xc1, 0x24, 0xdf, 0x86, 0x49, 0xae, 0x32, 0x93, 0x6a, 0xb1, 0x9f, 0x65, 0x84,
+	0xd4, 0xd5, 0xb3, 0x41, 0x68, 0xfa, 0xa1, 0x51, 0xf7, 0xbd, 0x9d, 0x80, 0xf9, 0x5a, 0x1f, 0xce,
+	0xf5, 0x17, 0xbb, 0x91, 0xde, 0x87, 0xc0, 0x74, 0x4c, 0xef, 0x45, 0xfa, 0xa7, 0xd1, 0x1d, 0x9e,
+	0x58, 0x39, 0xd3, 0x82, 0x28, 0xf9, 0x43, 0x45, 0xbd, 0xe0, 0x9a, 0xa1, 0x11, 0xfa, 0x26, 0x7c,
+	0xd5, 0x4c, 0x27, 0x5b, 0xd8, 0x73, 0x38, 0xd8, 0x93, 0xa7, 0x91, 0xae, 0x2e, 0x4d, 0xad, 0xe6,
+	0x61, 0x5d, 0x75, 0xcd, 0x30, 0x5f, 0x63, 0x1d, 0x07, 0xce, 0x49, 0x92, 0x10, 0xce, 0x0b, 0x08,
+	0x4f, 0x5c, 0xb8, 0xe6, 0x86, 0xa0, 0x83, 0xae, 0x19, 0xae, 0xa6, 0xe6, 0xa4, 0x1b, 0xe2, 0xaf,
+	0x4a, 0x76, 0x3a, 0xcc, 0x0c, 0x98, 0xd1, 0xd2, 0xfa, 0x71, 0x2b, 0xfc, 0x3c, 0x6c, 0x85, 0xd3,
+	0x4b, 0x53, 0xab, 0x0b, 0x40, 0x86, 0xc5, 0xef, 0x77, 0xcd, 0x30, 0x7e, 0xb0, 0xdd, 0x4e, 0xc8,
+	0x82, 0x6c, 0x43, 0x16, 0xe8, 0xd2, 0x77, 0xa3, 0x7b, 0x50, 0x2b, 0xc9, 0x97, 0x49, 0xd9, 0x1b,
+	0x94, 0x0f, 0x4c, 0x09, 0x6f, 0x7d, 0x4c, 0x23, 0xff, 0xa8, 0xa8, 0x23, 0xa2, 0xf1, 0x3e, 0x73,
+	0xd9, 0x0e, 0xee, 0xe4, 0xf3, 0x68, 0xfe, 0x3e, 0x98, 0x7f, 0x66, 0x69, 0x6a, 0x95, 0xc6, 0x00,
+	0x38, 0x30, 0xe0, 0x9a, 0x61, 0xfa, 0x98, 0xb9, 0x50, 0x4b, 0x5d, 0x10, 0x11, 0xce, 0x89, 0x3b,
+	0xbc, 0x13, 0x12, 0x1d, 0x32, 0x22, 0x38, 0x72, 0x07, 0x1c, 0xe1, 0x4d, 0xa0, 0x43, 0xbc, 0x2b,
+	0x29, 0x55, 0xe2, 0x4c, 0x68, 0xb7, 0x98, 0xd7, 0x09, 0x8d, 0x40, 0x1b, 0x10, 0x9d, 0x59, 0x8d,
+	0x81, 0x95, 0xc4, 0x99, 0xf4, 0x11, 0x76, 0x7a, 0x43, 0x70, 0x46, 0x44, 0xaa, 0x5e, 0x3f, 0x89,
+	0x0e, 0x19, 0x31, 0x7b, 0xe5, 0x78, 0x13, 0x44, 0x67, 0x52, 0x2a, 0xf9, 0x2d, 0x45, 0xd5, 0x3a,
+	0x81, 0xb9, 0xc1, 0x0c, 0x9f, 0xc1, 0x77, 0xdf, 0x76, 0x37, 0x0c, 0xd3, 0xb2, 0x58, 0x3b, 0x64,
+	0x0d, 0x8d, 0xa0, 0x37, 0x26, 0xbc, 0x01, 0x6b, 0x74, 0x2a, 0xa1, 0xc2, 0x1b, 0xd0, 0xf1, 0xd3,
+	0xa7, 0x5e, 0xa4, 0x9f, 0x47, 0x27, 0x72, 0x12, 0x67, 0x30, 0xcf, 0x28, 0x3c, 0xc1, 0x8e, 0xcf,
+	0x55, 0xd2, 0x61, 0x34, 0x81, 0xa6, 0x16, 0xa4, 0x74, 0xf2, 0x35, 0x75, 0xa8, 0x68, 0x5c, 0xc0,
+	0x98, 0xab, 0x0d, 0xa2, 0x61, 0xf3, 0x4f, 0x23, 0xfd, 0xe4, 0x1a, 0x5d, 0x61, 0xcc, 0xed, 0x46,
+	0xfa, 0xc9, 0x8e, 0x0f, 0xbf, 0x7a, 0x91, 0xde, 0x97, 0x18, 0x04, 0x8f, 0x9c, 0x31, 0x29, 0x43,
+	0xf6, 0x6b, 0xff, 0xb0, 0x96, 0x88, 0x53, 0x22, 0x1a, 0x00, 0x34, 0xf2, 0x2b, 0x8a, 0x7a, 0xb1,
+	0x38, 0x7a, 0xc7, 0xb5, 0x9f, 0x74, 0x98, 0x61, 0x37, 0xb4, 0x21, 0x4c, 0x22, 0xbe, 0x12, 0xcf,
+	0xcd, 0x1a, 0x92, 0xe7, 0x67, 0xe3, 0xb9, 0x49, 0x9e, 0xf8, 0xb9, 0x49, 0x19, 0xc6, 0xe2, 0x49,
+	0x49, 0x1f, 0x7b, 0xfc, 0x53, 0x32, 0x29, 0x29, 0x56, 0x9c, 0x94, 0x94, 0x8b, 0xfc, 0x40, 0x51,
+	0x07, 0x4b, 0x76, 0xf9, 0x8e, 0x76, 0x01, 0x2d, 0xfa, 0x25, 0xd8, 0x7b, 0x2f, 0xad, 0xd1, 0x35,
+	0xba, 0xd0, 0x8d, 0xf4, 0x97, 0x3a, 0xfe, 0x1a, 0x5d, 0xe8, 0x45, 0xfa, 0xfd, 0xd4, 0x10, 0xba,
+	0xc0, 0xed, 0xae, 0xcd, 0x30, 0x6c, 0x07, 0x0f, 0x6e, 0x61, 0xb5, 0x76, 0x33, 0xd8, 0x73, 0xad,
+	0x70, 0x13, 0xca, 0x39, 0x97, 0x85, 0xb7, 0x5c, 0xb6, 0x03, 0x54, 0x30, 0x38, 0x51, 0x92, 0xfe,
+	0x78, 0x76, 0x50, 0x7b, 0x01, 0xc1, 0xfd, 0xc3, 0x5a, 0x6c, 0x05, 0x1d, 0x28, 0xf8, 0xe1, 0x3b,
+	0xe4, 0xbf, 0x14, 0x55, 0x2f, 0xba, 0xd0, 0xf6, 0x02, 0xf8, 0xc2, 0x05, 0xcc, 0xea, 0xf8, 0xcc,
+	0xd9, 0xd3, 0x86, 0x31, 0xfc, 0xfe, 0x1a, 0x56, 0x10, 0x6b, 0x74, 0xd9, 0x0b, 0xc2, 0xf9, 0x0c,
+	0xec, 0x46, 0xfa, 0xf9, 0x8e, 0x2f, 0xd2, 0x7a, 0x91, 0xfe, 0x99, 0xc4, 0x49, 0x11, 0xe0, 0xfc,
+	0x6d, 0x9a, 0x4e, 0x80, 0x21, 0xb9, 0x2c, 0x2d, 0xa1, 0x41, 0xe6, 0x89, 0x12, 0x50, 0x2f, 0x14,
+	0x4d, 0xa0, 0x57, 0x44, 0xb7, 0x44, 0x94, 0xfc, 0xa7, 0xc4, 0x43, 0xdb, 0xb5, 0x43, 0x1b, 0xea,
+	0x08, 0xf8, 0xde, 0x19, 0x81, 0x36, 0x82, 0xbb, 0xf8, 0x57, 0xb1, 0x7a, 0x58, 0xa3, 0xf3, 0x31,
+	0x3a, 0x0b, 0x20, 0x04, 0x8c, 0xfe, 0x8e, 0x2f, 0x90, 0xb2, 0x70, 0x51, 0xa0, 0xf3, 0xc1, 0xe2,
+	0xfe, 0x84, 0x10, 0xc0, 0x8b, 0x1a, 0xca, 0x24, 0xf8, 0x02, 0x81, 0x14, 0x14, 0x0c, 0x05, 0x13,
+	0xe8, 0x65, 0xd1, 0x41, 0x01, 0x24, 0xdf, 0x52, 0xd4, 0x11, 0xb3, 0x13, 0x7a, 0x46, 0xa7, 0xbd,
+	0xe1, 0x9b, 0x0d, 0x96, 0xe7, 0x26, 0x9b, 0xda, 0x45, 0xf4, 0x6b, 0x19, 0x2a, 0x20, 0x60, 0x59,
+	0x8b, 0x39, 0xd2, 0xcf, 0xfa, 0x5b, 0x59, 0xb1, 0x20, 0x03, 0x79, 0x6f, 0x26, 0xf9, 0x44, 0xed,
+	0xf6, 0x24, 0x95, 0x6a, 0x23, 0x2d, 0x75, 0x24, 0xb5, 0x21, 0xf4, 0x8c, 0xb6, 0x0f, 0x33, 0x8e,
+	0x9f, 0xc6, 0x40, 0xbb, 0x84, 0x5b, 0xe8, 0x35, 0x30, 0x24, 0x61, 0x59, 0xf5, 0x96, 0x7d, 0x46,
+	0x13, 0xbc, 0x17, 0xe9, 0x97, 0xe2, 0x19, 0x95, 0x80, 0x63, 0x54, 0x2a, 0x43, 0xb6, 0x55, 0xb2,
+	0xc5, 0x58, 0xdb, 0x08, 0x59, 0xab, 0xed, 0xf9, 0xa6, 0x6f, 0xb3, 0xc0, 0xd8, 0xd4, 0x2e, 0xa3,
+	0xcb, 0x6f, 0xc1, 0xbe, 0x04, 0x74, 0x35, 0x07, 0xc1, 0xdd, 0x97, 0x71, 0x94, 0x22, 0xc0, 0x97,
+	0x46, 0x77, 0x79, 0x57, 0x27, 0xef, 0xd2, 0x92, 0x16, 0xb2, 0xa7, 0x0e, 0x5a, 0xa6, 0xb5, 0xc9,
+	0x0c, 0x7b, 0xc3, 0xf5, 0x7c, 0xd6, 0x30, 0x9a, 0xb6, 0xc3, 0x02, 0xed, 0x0a, 0xba, 0x38, 0x0f,
+	0x1f, 0x18, 0x84, 0xe7, 0x63, 0x74, 0x0e, 0xc0, 0x6c, 0xa2, 0x4b, 0x48, 0xe9, 0x95, 0xc8, 0xb6,
+	0x3a, 0x2d, 0xab, 0x21, 0xbf, 0xac, 0xa8, 0x97, 0xda, 0xbe, 0xb7, 0x01, 0xb5, 0x85, 0xd1, 0x69,
+	0x37, 0xcc, 0x90, 0xf1, 0xf9, 0xfa, 0xa7, 0xd0, 0xf7, 0x55, 0x48, 0x37, 0x53, 0xae, 0x35, 0x64,
+	0xe2, 0x73, 0xf3, 0xb8, 0xe6, 0xad, 0xc0, 0x39, 0x73, 0xee, 0x71, 0x13, 0xa1, 0xdc, 0xa3, 0x55,
+	0x1a, 0xc9, 0x37, 0x14, 0x75, 0xd8, 0xb1, 0x5b, 0x76, 0x68, 0xd4, 0x4d, 0xb7, 0xb1, 0x63, 0x37,
+	0xc2, 0x4d, 0xc3, 0x76, 0x0d, 0xc7, 0x74, 0xb5, 0xab, 0x38, 0x25, 0x8b, 0x58, 0xcb, 0x01, 0xc7,
+	0x74, 0xca, 0x30, 0xef, 0x2e, 0x98, 0x6e, 0x5e, 0x7f, 0x97, 0xb1, 0x23, 0xa6, 0x45, 0xa6, 0x8a,
+	0xbc, 0xaf, 0xa8, 0xa4, 0x65, 0xbb, 0xc6, 0xa6, 0xd7, 0x62, 0xd0, 0x1d, 0xd8, 0x32, 0x9a, 0x3e,
+	0x63, 0x9a, 0x3e, 0xaa, 0x8c, 0x9f, 0x99, 0xec, 0xbb, 0x19, 0x37, 0xba, 0x6e, 0xae, 0xd8, 0xef,
+	0xb2, 0xe9, 0x87, 0x1f, 0x45, 0xfa, 0x31, 0x78, 0xab, 0x5b, 0xb6, 0xfb, 0x96, 0xd7, 0x62, 0xb3,
+	0x76, 0xb0, 0x35, 0xe7, 0x33, 0x96, 0xed, 0x8e, 0x02, 0x9d, 0x7f, 0x0f, 0x46, 0xaf, 0x81, 0x21,
+	0x27, 0x6e, 0x8f, 0x5e, 0xa3, 0x45, 0x71, 0xf2, 0xb1, 0xa2, 0xf6, 0xa5, 0xfb, 0x1d, 0xbf, 0x02,
+	0xa3, 0xf8, 0x15, 0xf8, 0x3b, 0xcc, 0x40, 0xd2, 0x4d, 0x1b, 0x7f, 0x0b, 0xce, 0xf8, 0xf9, 0x63,
+	0x2f, 0xd2, 0x67, 0xd3, 0x02, 0x20, 0xa5, 0x49, 0xbe, 0x0b, 0xc9, 0x1b, 0x10, 0x14, 0x42, 0x7c,
+	0x8b, 0x85, 0xe6, 0xcd, 0xaf, 0x06, 0x9e, 0x0b, 0xa1, 0x54, 0x50, 0x2b, 0x3e, 0x3e, 0x3b, 0xa8,
+	0x8d, 0xbf, 0xa8, 0x2a, 0x48, 0x57, 0x38, 0x7b, 0x69, 0xae, 0xc7, 0x77, 0xc8, 0xba, 0x3a, 0x60,
+	0x3a, 0x3b, 0x50, 0x0c, 0xc5, 0xc5, 0xbd, 0xcb, 0xc2, 0x40, 0xfb, 0x34, 0xf6, 0xd4, 0xa0, 0x06,
+	0xed, 0x8f, 0x41, 0x2c, 0x92, 0x97, 0x58, 0x08, 0x1b, 0x7f, 0x28, 0x8e, 0x30, 0x02, 0x7d, 0x8c,
+	0x16, 0x19, 0xc9, 0xff, 0x29, 0xea, 0x38, 0xb4, 0x43, 0x76, 0x7c, 0x3b, 0x84, 0xc0, 0xd1, 0xf2,
+	0x42, 0x66, 0x34, 0xd8, 0xb6, 0x6d, 0x31, 0xc3, 0x35, 0x5b, 0x2c, 0x30, 0x3c, 0xd7, 0x48, 0xea,
+	0x12, 0x6d, 0x2c, 0xef, 0xf6, 0x8c, 0x3c, 0x4e, 0x85, 0x28, 0xca, 0xcc, 0xb2, 0xed, 0x25, 0x60,
+	0xef, 0x46, 0xfa, 0xcb, 0x5e, 0x09, 0xb2, 0x2d, 0x86, 0xe8, 0x63, 0x77, 0x26, 0x56, 0xd5, 0x8b,
+	0xf4, 0xd7, 0xd1, 0xc0, 0x17, 0xe0, 0xad, 0xde, 0x94, 0x50, 0x54, 0x55, 0xd8, 0x41, 0x5f, 0xc4,
+	0x0a, 0xf2, 0x9e, 0x7a, 0x01, 0xc2, 0x98, 0x61, 0xbb, 0x0d, 0xb6, 0x6b, 0xc0, 0x4e, 0xae, 0x3b,
+	0x9e, 0xb5, 0x15, 0x68, 0x2f, 0xe3, 0x2b, 0x0d, 0x9b, 0x86, 0x00, 0xc3, 0x3c, 0xe0, 0x8b, 0xb6,
+	0x3b, 0x8d, 0x68, 0xd6, 0x44, 0x2d, 0x43, 0xd2, 0xc4, 0x35, 0x4e, 0x47, 0xa9, 0x44, 0x13, 0xf9,
+	0x77, 0xc8, 0x3e, 0x5d, 0x68, 0x11, 0x37, 0x0c, 0xd7, 0x0b, 0xed, 0xa6, 0x6d, 0x99, 0x71, 0x3b,
+	0xa0, 0x11, 0x68, 0x35, 0x5c, 0xdf, 0xef, 0xc2, 0x74, 0x0f, 0xaf, 0xc5, 0x4c, 0x4b, 0x1c, 0xcf,
+	0xfc, 0x2c, 0xcc, 0xf6, 0x70, 0x47, 0x8a, 0xf4, 0x22, 0xfd, 0x72, 0x1c, 0xda, 0x65, 0x30, 0xb6,
+	0x0e, 0xa5, 0x48, 0xef, 0xa0, 0x56, 0xa1, 0x71, 0xff, 0xb0, 0x56, 0x61, 0x05, 0x95, 0x4a, 0x34,
+	0x02, 0x42, 0xd5, 0xb3, 0xa1, 0x6f, 0x36, 0x9b, 0xb6, 0x65, 0x58, 0x8e, 0x19, 0x04, 0xda, 0x35,
+	0x9c, 0xd6, 0x1b, 0x50, 0xbe, 0x26, 0xc0, 0x0c, 0xd0, 0x7b, 0x91, 0x4e, 0xe2, 0x09, 0xe5, 0x88,
+	0x59, 0xdf, 0x44, 0x60, 0x25, 0x5f, 0x53, 0x07, 0x93, 0x29, 0x36, 0x9a, 0x9e, 0xd3, 0x60, 0xbe,
+	0xd1, 0x36, 0xc3, 0x4d, 0xed, 0x33, 0xf8, 0xd6, 0x3f, 0x7a, 0x1a, 0xe9, 0x97, 0x67, 0x59, 0xdb,
+	0x67, 0x96, 0x19, 0xb2, 0xc6, 0x6c, 0xcc, 0x38, 0x87, 0x7c, 0xcb, 0x66, 0xb8, 0xd9, 0x8d, 0x74,
+	0xe5, 0x46, 0x56, 0x2c, 0x37, 0x8a, 0xf0, 0x75, 0xaf, 0x65, 0xc3, 0x22, 0x85, 0x7b, 0x63, 0x9a,
+	0x42, 0x07, 0x4a, 0x38, 0xd9, 0x52, 0xcf, 0x07, 0x2c, 0x34, 0x1c, 0x6f, 0xc7, 0x68, 0xfb, 0xb6,
+	0xe7, 0xdb, 0xe1, 0x9e, 0xf6, 0x59, 0x7c, 0x29, 0xa6, 0xba, 0x91, 0x7e, 0x2e, 0x60, 0xe1, 0x82,
+	0xb7, 0xb3, 0x9c, 0x20, 0x59, 0x64, 0x13, 0xc9, 0x95, 0x65, 0x79, 0x41, 0x9c, 0x7c, 0xa0, 0xa8,
+	0xc3, 0xd0, 0x74, 0x4a, 0xdc, 0xb4, 0x3c, 0xd7, 0xea, 0xf8, 0x3e, 0x73, 0xad, 0x3d, 0x6d, 0x1c,
+	0xe7, 0x31, 0xc0, 0xde, 0x87, 0xb9, 0xb3, 0x68, 0xee, 0xc6, 0x36, 0xce, 0xe4, 0x2c, 0xf0, 0xc9,
+	0x6f, 0x49, 0xe8, 0xd9, 0x27, 0x5f, 0x06, 0xa6, 0x53, 0x8e, 0xcd, 0x0a, 0xb9, 0x5e, 0x2a, 0xd5,
+	0x0a, 0x3d, 0xe2, 0x41, 0xcb, 0x37, 0x83, 0xcd, 0x42, 0x4a, 0xfe, 0x0a, 0x2e, 0xcb, 0x87, 0x98,
+	0x92, 0xcf, 0xa4, 0x29, 0xb9, 0x95, 0xa4, 0xe4, 0x73, 0xf1, 0xb7, 0x19, 0xc4, 0xf2, 0xe4, 0x58,
+	0x1a, 0x86, 0x91, 0xa7, 0x9c, 0x66, 0x23, 0x19, 0xf6, 0xf2, 0x40, 0x49, 0x09, 0x24, 0xeb, 0x56,
+	0x92, 0xac, 0xd7, 0x5e, 0x44, 0x0d, 0xa4, 0xeb, 0x33, 0x71, 0xba, 0x5e, 0x50, 0xe6, 0x3b, 0xe4,
+	0xf7, 0x14, 0x75, 0xa4, 0xe8, 0x5e, 0xda, 0x25, 0xf9, 0x1c, 0xae, 0xbf, 0x0d, 0xcd, 0x87, 0x19,
+	0xca, 0x35, 0xf8, 0x45, 0x2d, 0xc5, 0x06, 0xbf, 0x14, 0xad, 0xda, 0x1a, 0xd0, 0x5f, 0xc8, 0x74,
+	0x53, 0xb9, 0x66, 0xf2, 0x73, 0x8a, 0x3a, 0x1c, 0x84, 0x1d, 0xd7, 0x80, 0xcc, 0xc9, 0x74, 0xec,
+	0x6d, 0x66, 0xc4, 0xbd, 0xa3, 0x40, 0x7b, 0x35, 0xcb, 0x47, 0x07, 0x81, 0xe3, 0x51, 0xca, 0xb0,
+	0x02, 0xf8, 0x4a, 0x96, 0x25, 0x49, 0x30, 0x31, 0xb7, 0xe6, 0x02, 0xda, 0x89, 0xdb, 0xf7, 0x27,
+	0xa8, 0x4c, 0x1b, 0x94, 0xac, 0x05, 0x33, 0x20, 0xae, 0x06, 0xda, 0x75, 0x34, 0xe2, 0x6d, 0x48,
+	0xd4, 0x04, 0xb1, 0x45, 0xdb, 0xcd, 0x53, 0xfb, 0x12, 0xc2, 0xe7, 0x88, 0x42, 0x40, 0x9d, 0x9c,
+	0xa0, 0x65, 0x3d, 0x90, 0x95, 0xf7, 0xe1, 0xe8, 0xe9, 0xb9, 0xd3, 0x0d, 0x8c, 0xa1, 0x0d, 0xe8,
+	0x74, 0x53, 0x73, 0x67, 0x25, 0xec, 0x70, 0x27, 0x4e, 0x67, 0x82, 0xfc, 0x31, 0xeb, 0x0d, 0xe5,
+	0xb4, 0xe7, 0x9e, 0x8a, 0x15, 0x34, 0x52, 0x5e, 0x1f, 0xd9, 0x56, 0xfb, 0xd3, 0x23, 0x40, 0x23,
+	0x3e, 0x24, 0xd4, 0x6e, 0x8e, 0x2a, 0xe3, 0xe7, 0x26, 0xcf, 0xa5, 0x69, 0xd1, 0x2a, 0x52, 0xb1,
+	0x99, 0x77, 0x2e, 0x65, 0x8d, 0x69, 0x59, 0xe4, 0x10, 0xc9, 0x63, 0xa3, 0x3e, 0xc3, 0x25, 0x4d,
+	0xb6, 0xc7, 0xfb, 0x87, 0x35, 0x85, 0x16, 0x44, 0xc9, 0x77, 0x8e, 0xab, 0x2f, 0x43, 0xd4, 0xc8,
+	0xc2, 0x05, 0xd4, 0x94, 0x96, 0xd7, 0x82, 0x2d, 0xeb, 0xb3, 0x27, 0x1d, 0x16, 0x84, 0xc6, 0x96,
+	0x5d, 0xd7, 0x6e, 0xe1, 0x72, 0xfc, 0x50, 0x49, 0x8e, 0x0e, 0x17, 0xcd, 0xdd, 0x99, 0x79, 0x1a,
+	0xe3, 0x8f, 0xec, 0xe9, 0x6e, 0xa4, 0xeb, 0x2d, 0x73, 0x37, 0x7b, 0xc5, 0xc3, 0xf9, 0x44, 0x47,
+	0xce, 0x92, 0x7d, 0x05, 0x9f, 0xc3, 0xc7, 0xd5, 0x63, 0xcf, 0x55, 0xf9, 0x7c, 0x96, 0xe4, 0x30,
+	0xb2, 0x60, 0x2e, 0x7d, 0x8e, 0x58, 0x1d, 0xce, 0xea, 0x86, 0xb3, 0x13, 0x11, 0xc7, 0xe4, 0xcf,
+	0x50, 0x27, 0xf0, 0x05, 0xfe, 0x3e, 0xcc, 0xc4, 0x50, 0x7a, 0xa2, 0xb0, 0x30, 0xb5, 0xc4, 0x1f,
+	0xa3, 0x0e, 0x99, 0x12, 0x7a, 0x96, 0x48, 0xcb, 0x40, 0xd9, 0x41, 0x96, 0x54, 0x49, 0x05, 0x9d,
+	0x7b, 0xf5, 0xa5, 0x46, 0xd1, 0x5c, 0xca, 0xe4, 0xce, 0x60, 0xb7, 0xd5, 0x4b, 0x78, 0xe8, 0xd1,
+	0xec, 0x38, 0x4e, 0x92, 0xd5, 0x78, 0x6e, 0x5a, 0xa2, 0x6a, 0xb7, 0xd1, 0xd3, 0x07, 0x90, 0x35,
+	0x00, 0xd7, 0x5c, 0xc7, 0x71, 0x30, 0x1f, 0x79, 0xec, 0x26, 0x45, 0x65, 0x2f, 0xd2, 0xaf, 0x24,
+	0x9f, 0x2c, 0x19, 0x3c, 0x46, 0x2b, 0xe4, 0xc8, 0xdb, 0xea, 0xd9, 0x26, 0x33, 0xc3, 0x8e, 0xcf,
+	0x8c, 0xa6, 0x63, 0x6e, 0x04, 0xda, 0x24, 0xbe, 0x77, 0xd7, 0xe0, 0x4b, 0x9f, 0x00, 0x73, 0x40,
+	0xcf, 0x0e, 0x48, 0x38, 0xe2, 0x18, 0x15, 0x58, 0xc8, 0x8e, 0x3a, 0xc2, 0x9d, 0x8b, 0xc4, 0x35,
+	0x0e, 0x73, 0xbd, 0xce, 0xc6, 0xa6, 0x76, 0x07, 0x37, 0xed, 0x1b, 0x18, 0x5e, 0x33, 0x96, 0x05,
+	0xe0, 0x78, 0x88, 0x0c, 0x59, 0xd6, 0x23, 0x45, 0xb3, 0x8c, 0x42, 0x2e, 0x4c, 0xb6, 0xd4, 0xa1,
+	0xd2, 0xc0, 0x2d, 0x73, 0x57, 0xbb, 0x8b, 0xa3, 0xbe, 0x0e, 0xc9, 0x60, 0x41, 0x70, 0xd1, 0xdc,
+	0xed, 0x45, 0xba, 0x26, 0x1b, 0x72, 0xd1, 0xdc, 0xcd, 0xc6, 0x93, 0x88, 0x91, 0x6f, 0x1d, 0x57,
+	0xf5, 0xb4, 0xd9, 0x63, 0x98, 0x0e, 0xa4, 0x14, 0x9e, 0xd3, 0x30, 0x42, 0x27, 0x30, 0x20, 0x7e,
+	0xd8, 0x9e, 0x1b, 0x68, 0xf7, 0x70, 0xbd, 0x7e, 0x00, 0x3b, 0xf3, 0x72, 0xda, 0x5a, 0x99, 0x02,
+	0xd6, 0xc7, 0x4e, 0x63, 0x75, 0x61, 0xe5, 0xcb, 0x09, 0x5f, 0x37, 0xd2, 0x2f, 0xdb, 0xd5, 0x70,
+	0x96, 0xef, 0x1c, 0xc1, 0x03, 0xfb, 0xf3, 0x48, 0x1d, 0x47, 0xc3, 0xfb, 0x87, 0xb5, 0xa3, 0x0c,
+	0xa4, 0x65, 0x59, 0x27, 0x48, 0x41, 0x72, 0xa8, 0xa8, 0x97, 0xb9, 0x79, 0x4f, 0x13, 0x2b, 0x23,
+	0xb4, 0xda, 0x58, 0xce, 0xbe, 0x86, 0xd3, 0xff, 0x6d, 0x98, 0x05, 0x6d, 0x26, 0xe3, 0x4b, 0xd3,
+	0xa4, 0xd5, 0x99, 0xe5, 0x85, 0xa9, 0xa5, 0x6e, 0xa4, 0x6b, 0x56, 0x19, 0xb3, 0xda, 0x71, 0xc1,
+	0xfb, 0x6a, 0x61, 0x85, 0x44, 0x86, 0x23, 0x92, 0xf6, 0xfd, 0xc3, 0x5a, 0xe5, 0x98, 0xb4, 0x72,
+	0x44, 0xf2, 0x2f, 0x8a, 0x7a, 0x45, 0xe6, 0xd2, 0x93, 0x8e, 0x6d, 0xa1, 0x4f, 0x9f, 0x47, 0x9f,
+	0xbe, 0x03, 0x3e, 0x5d, 0x2c, 0xeb, 0x7f, 0x67, 0x6d, 0x7e, 0x26, 0x76, 0xea, 0x62, 0x79, 0x88,
+	0x77, 0x3a, 0xb6, 0x15, 0x7b, 0x75, 0xbd, 0xc2, 0xab, 0x84, 0xe3, 0x88, 0x4f, 0xe7, 0xfe, 0x61,
+	0xad, 0x7a, 0x58, 0x5a, 0x3d, 0xe8, 0x91, 0x6b, 0xb5, 0x63, 0xba, 0xda, 0xfd, 0xe7, 0xad, 0xd5,
+	0xfa, 0x11, 0x6b, 0xb5, 0xfe, 0xbc, 0xb5, 0x5a, 0x37, 0x5d, 0xe9, 0x31, 0x47, 0x76, 0x78, 0x51,
+	0x39, 0x26, 0xad, 0x1c, 0xf1, 0xe8, 0xb5, 0x02, 0x9f, 0x5e, 0x7f, 0xee, 0x5a, 0xad, 0x1f, 0xb5,
+	0x56, 0xeb, 0xcf, 0x5d, 0x2b, 0xd1, 0xad, 0xbb, 0x82, 0x5b, 0x77, 0x8f, 0x58, 0xab, 0xf5, 0xea,
+	0xb5, 0x02, 0xc7, 0xf6, 0x15, 0xf5, 0xa2, 0xcc, 0x31, 0x3c, 0x6d, 0xd4, 0x1e, 0xa0, 0x57, 0x5f,
+	0x86, 0xa6, 0x55, 0x59, 0x05, 0x9e, 0x54, 0xe6, 0xb9, 0xaa, 0x1c, 0xe7, 0x9b, 0x56, 0x82, 0xcd,
+	0xf7, 0x26, 0x68, 0x95, 0x4e, 0xf2, 0x37, 0x8a, 0x7a, 0x4d, 0x66, 0x54, 0xd6, 0xc1, 0xdc, 0xf4,
+	0x59, 0xb0, 0xe9, 0x39, 0x0d, 0xed, 0x0b, 0x68, 0xe0, 0x57, 0xbb, 0x91, 0x2e, 0x31, 0x20, 0xf9,
+	0xee, 0xac, 0xa6, 0xdc, 0xbd, 0x48, 0xbf, 0x5b, 0x61, 0x6b, 0x91, 0x95, 0x33, 0x9b, 0xb7, 0x5a,
+	0x99, 0xa0, 0x2f, 0x20, 0x4c, 0x7e, 0x5d, 0x51, 0x49, 0xde, 0x70, 0x0b, 0xac, 0x4d, 0xd6, 0xe8,
+	0x38, 0x4c, 0xfb, 0xb1, 0xd1, 0x13, 0xe3, 0x67, 0x26, 0xaf, 0xa6, 0xa9, 0x5d, 0xd6, 0x26, 0x5b,
+	0x49, 0x18, 0x1e, 0xba, 0xa1, 0xbf, 0x37, 0x3d, 0x9f, 0xf4, 0xc0, 0x06, 0xea, 0x45, 0xbc, 0x17,
+	0xe9, 0x23, 0x68, 0x7f, 0x09, 0xc1, 0xf2, 0xa6, 0x44, 0xa5, 0x65, 0x12, 0x79, 0x4f, 0x3d, 0xdd,
+	0xf6, 0xbd, 0xdd, 0x3d, 0x2c, 0xbc, 0xbe, 0x88, 0x85, 0x57, 0xfd, 0x69, 0xa4, 0x9f, 0x5a, 0x06,
+	0x62, 0x5c, 0x7a, 0x9d, 0x6a, 0x27, 0xbf, 0xb3, 0xaf, 0x56, 0x4a, 0xe0, 0x4a, 0xdf, 0xee, 0x41,
+	0x8d, 0x94, 0xc9, 0xbd, 0x83, 0x5a, 0x26, 0xbd, 0x7f, 0x58, 0xcb, 0xb4, 0xd2, 0x84, 0xea, 0x3b,
+	0xb0, 0xb6, 0x23, 0xb2, 0xb5, 0xdd, 0x09, 0x02, 0xed, 0xc7, 0x71, 0x35, 0x7f, 0x16, 0x5e, 0xa2,
+	0x0b, 0xe5, 0xdd, 0xbc, 0xbe, 0xb2, 0x22, 0x7e, 0xd3, 0x33, 0x20, 0x08, 0xb2, 0x7b, 0x0d, 0x52,
+	0x94, 0x7f, 0x71, 0xee, 0x09, 0x2f, 0xce, 0xbd, 0xfd, 0xc3, 0x9a, 0x7c, 0x28, 0x2a, 0x1f, 0x88,
+	0x6c, 0xaa, 0xfd, 0x4f, 0x3a, 0x5e, 0x68, 0x1a, 0x3e, 0x83, 0x2a, 0xbf, 0x61, 0xee, 0x69, 0x6f,
+	0xa0, 0xd9, 0x6f, 0xc2, 0xdd, 0x06, 0x84, 0x28, 0x20, 0xb3, 0xe6, 0x5e, 0x76, 0xee, 0x2d, 0x50,
+	0xf9, 0x0f, 0x09, 0xbf, 0xb5, 0x6e, 0x53, 0x51, 0x1a, 0x62, 0x4e, 0x7c, 0xe8, 0x6f, 0xb4, 0x3c,
+	0x37, 0xdc, 0x74, 0xf6, 0x8c, 0x7a, 0xa7, 0xb1, 0xc1, 0x42, 0xa3, 0x65, 0xd7, 0xb5, 0x37, 0x47,
+	0x95, 0xf1, 0x13, 0xd3, 0xbf, 0x8d, 0x53, 0x85, 0x2f, 0xcd, 0x62, 0xcc, 0x33, 0x8d, 0x2c, 0x8b,
+	0x98, 0x9c, 0x5f, 0xf0, 0x65, 0x40, 0x96, 0xfe, 0x48, 0x51, 0x6c, 0xfa, 0xc8, 0xe5, 0xaa, 0x00,
+	0x98, 0x42, 0xa9, 0x09, 0x54, 0xca, 0x5f, 0x27, 0xff, 0xa6, 0xa8, 0x17, 0x0b, 0xd7, 0x8f, 0xb0,
+	0x51, 0xde, 0x34, 0x2d, 0x16, 0x68, 0x53, 0x98, 0x14, 0xa2, 0x67, 0x24, 0xbd, 0xd0, 0x33, 0x9f,
+	0xc1, 0x10, 0x8a, 0x84, 0x6b, 0x3d, 0x39, 0x94, 0xe5, 0xa5, 0x72, 0x1c, 0x3c, 0x1b, 0x96, 0x43,
+	0x70, 0x31, 0xa3, 0x42, 0x29, 0x94, 0x12, 0x65, 0x2b, 0x68, 0x15, 0x3b, 0xb4, 0x4a, 0x2f, 0x17,
+	0x7c, 0x6b, 0x35, 0xdc, 0xfc, 0x1e, 0xcc, 0x34, 0x66, 0x6b, 0x7f, 0x8d, 0x57, 0x1c, 0xb3, 0xeb,
+	0x4a, 0xb3, 0x4b, 0x2b, 0x79, 0x4f, 0x40, 0x13, 0x6f, 0x2d, 0xe5, 0x58, 0x2f, 0xd2, 0x6f, 0x48,
+	0xee, 0x57, 0xe5, 0x0c, 0x92, 0x72, 0xa2, 0x5a, 0xd9, 0x11, 0x18, 0x57, 0x56, 0xc8, 0x6c, 0xa4,
+	0x05, 0xc1, 0x86, 0x9b, 0xdd, 0xc7, 0xe9, 0x29, 0xaa, 0x56, 0xf0, 0x3e, 0x2f, 0xa1, 0x66, 0x70,
+	0x61, 0xff, 0x02, 0x4b, 0x28, 0xb8, 0x2a, 0x9a, 0x28, 0xe1, 0x4b, 0x28, 0x71, 0x7d, 0xf8, 0x22,
+	0xea, 0x7a, 0xd9, 0xf3, 0xea, 0x7b, 0xa9, 0xa5, 0x0b, 0x81, 0x09, 0x6b, 0xaf, 0xb8, 0x03, 0xf8,
+	0x4a, 0x8a, 0xab, 0xd9, 0xa5, 0xe6, 0xd1, 0x0a, 0x51, 0xb2, 0xab, 0x9e, 0x63, 0xdb, 0x50, 0x42,
+	0xef, 0xb0, 0xfa, 0xa6, 0xe7, 0x6d, 0x05, 0xda, 0x2c, 0x06, 0xfa, 0xa1, 0x34, 0xd0, 0x3f, 0x04,
+	0x74, 0x3d, 0x06, 0xa7, 0xbf, 0x90, 0x84, 0xf7, 0xb3, 0x8c, 0xa3, 0xe6, 0xcd, 0x4d, 0x9e, 0x0a,
+	0x7e, 0xf4, 0xf1, 0x04, 0x2a, 0x0a, 0x41, 0xf3, 0xaf, 0xbf, 0xf5, 0x24, 0xc4, 0x9b, 0x3f, 0x5b,
+	0xcc, 0xc7, 0x98, 0xfe, 0x10, 0x63, 0xfa, 0xfb, 0x30, 0xcb, 0x67, 0x17, 0xdf, 0x59, 0x5d, 0x9d,
+	0x46, 0x28, 0x8e, 0xec, 0x67, 0x81, 0x39, 0x23, 0xf4, 0x22, 0xfd, 0x53, 0x71, 0x6d, 0xce, 0x53,
+	0xc5, 0x18, 0x3f, 0x52, 0x81, 0xf5, 0x0e, 0x6a, 0xa2, 0xb2, 0xfd, 0xc3, 0x9a, 0x38, 0x1c, 0xe5,
+	0x71, 0xdf, 0x21, 0xff, 0xa0, 0xa8, 0x03, 0x68, 0x6b, 0xe8, 0xb5, 0x6d, 0x0b, 0x4e, 0x20, 0x9b,
+	0xf6, 0xae, 0x36, 0x87, 0xd6, 0xfe, 0x06, 0x1e, 0xee, 0x82, 0xf8, 0x2a, 0x80, 0xcb, 0x88, 0xe1,
+	0x31, 0xd0, 0x93, 0x30, 0xe4, 0x48, 0x59, 0x31, 0x5d, 0xa0, 0x73, 0x5b, 0x20, 0xeb, 0xdb, 0x81,
+	0xf5, 0x25, 0xf9, 0x32, 0xe9, 0xd9, 0x41, 0xed, 0x74, 0x26, 0x03, 0xe7, 0xbb, 0x05, 0x2b, 0x68,
+	0x51, 0x80, 0xfc, 0xae, 0xa2, 0xa2, 0x6b, 0x46, 0x27, 0x60, 0xbe, 0x6b, 0xb6, 0x98, 0xf6, 0x25,
+	0x74, 0xe2, 0x5d, 0xb8, 0x03, 0x0a, 0xd2, 0x6b, 0x09, 0x1d, 0xca, 0x5a, 0x60, 0x4c, 0x9f, 0xb3,
+	0xf8, 0xc4, 0x13, 0xc5, 0xe9, 0x1e, 0x96, 0x43, 0xbd, 0x83, 0x9a, 0xa0, 0x09, 0xee, 0x78, 0xf2,
+	0x23, 0x51, 0x01, 0xcd, 0x2d, 0x6c, 0x9b, 0x41, 0xb0, 0xe3, 0xf9, 0x0d, 0xed, 0x2d, 0xd1, 0xc2,
+	0xe5, 0x84, 0x9e, 0x5a, 0x98, 0x3e, 0x0b, 0x16, 0xa6, 0x44, 0x89, 0x85, 0x65, 0x28, 0xb5, 0x30,
+	0x45, 0x52, 0x0b, 0xd3, 0x67, 0x2a, 0xa0, 0x64, 0x4f, 0x3d, 0x83, 0x06, 0xe2, 0x76, 0x0e, 0xb4,
+	0x79, 0x8c, 0x0c, 0x3f, 0x01, 0xb7, 0x44, 0x40, 0x08, 0xdf, 0x17, 0x08, 0x07, 0x2a, 0x30, 0xc5,
+	0x4f, 0xbd, 0x48, 0xef, 0xcf, 0x4c, 0x43, 0x12, 0x58, 0x73, 0x3a, 0x7b, 0x82, 0x3b, 0x22, 0x39,
+	0x37, 0xdc, 0x11, 0xc9, 0x35, 0x51, 0x0e, 0x21, 0x3f, 0x94, 0x5c, 0x39, 0x08, 0x42, 0x0f, 0x7a,
+	0x12, 0x9e, 0xbf, 0x63, 0xfa, 0x0d, 0xd6, 0xd0, 0xde, 0xc6, 0x20, 0xfd, 0xf5, 0xf8, 0x4e, 0xc5,
+	0x0a, 0x80, 0x73, 0x29, 0x16, 0xdf, 0xa9, 0x10, 0x69, 0xbd, 0x48, 0x1f, 0x4e, 0x2f, 0xd3, 0x08,
+	0x40, 0x72, 0x87, 0xa2, 0xc0, 0x2d, 0xa1, 0xc5, 0x57, 0x27, 0x44, 0x5a, 0xf1, 0xea, 0x84, 0x88,
+	0x92, 0x6f, 0x2a, 0xea, 0xf9, 0xac, 0x77, 0x98, 0xfc, 0x7f, 0x40, 0x7b, 0x84, 0xcd, 0xc3, 0x91,
+	0x34, 0xf0, 0xcc, 0x26, 0xf8, 0x74, 0x0c, 0x63, 0x4f, 0xa4, 0xbf, 0x21, 0x12, 0xb3, 0xae, 0x6a,
+	0x81, 0x2e, 0xed, 0x23, 0x16, 0x85, 0xa1, 0xd4, 0xd3, 0x61, 0x30, 0xc7, 0xb6, 0x42, 0xc3, 0x82,
+	0xe3, 0x2a, 0x23, 0xd8, 0x62, 0x3b, 0x46, 0xe8, 0x39, 0xcc, 0x37, 0x21, 0xfe, 0x07, 0xda, 0x02,
+	0xa6, 0x47, 0xbf, 0x88, 0x0d, 0x8a, 0x99, 0x84, 0x77, 0x06, 0x58, 0x57, 0xb6, 0xd8, 0xce, 0x6a,
+	0xca, 0x08, 0xb9, 0xdd, 0x65, 0xab, 0x1a, 0xce, 0x1a, 0x14, 0x47, 0xf0, 0x70, 0x47, 0x13, 0x47,
+	0x8d, 0x44, 0x8f, 0x1a, 0x87, 0x7c, 0xa8, 0xa8, 0x03, 0xc2, 0x81, 0x14, 0xd6, 0xe2, 0x8b, 0xe8,
+	0xc4, 0x7b, 0x10, 0xa7, 0x56, 0xb9, 0x93, 0xa6, 0xb8, 0x00, 0xef, 0x0f, 0x45, 0x52, 0x2f, 0xd2,
+	0x2f, 0x94, 0x8e, 0xaa, 0x16, 0xa6, 0x96, 0xf8, 0x5b, 0x27, 0x45, 0x91, 0x32, 0x09, 0xa2, 0x51,
+	0x61, 0x2c, 0x2a, 0xf2, 0x98, 0xae, 0xc4, 0x5a, 0xa8, 0x46, 0x97, 0xe4, 0xd6, 0xae, 0x97, 0xad,
+	0x5d, 0xaf, 0xb0, 0x76, 0xbd, 0xda, 0xda, 0xf5, 0xb2, 0xb5, 0xeb, 0x65, 0x6b, 0xd7, 0x8b, 0xd6,
+	0x42, 0xb5, 0xf9, 0xcf, 0x70, 0xfc, 0xe0, 0x59, 0x5b, 0x2c, 0x8c, 0xaf, 0x5d, 0xd7, 0x3b, 0xcd,
+	0x26, 0xf3, 0xb1, 0xd5, 0xfc, 0x18, 0x4d, 0xc6, 0x8b, 0x4c, 0x83, 0x2b, 0xc8, 0x02, 0xf7, 0xaa,
+	0xa7, 0x91, 0x21, 0xee, 0x35, 0x0f, 0x06, 0x65, 0x72, 0x2f, 0xd2, 0x2f, 0xa2, 0xed, 0x12, 0x8c,
+	0xb3, 0x5f, 0x2a, 0x2a, 0x27, 0x43, 0xd2, 0x23, 0x19, 0x9f, 0x4a, 0x78, 0xeb, 0xe4, 0x7f, 0x14,
+	0xf5, 0x62, 0xe2, 0x8f, 0xcf, 0x2c, 0x06, 0xe7, 0x18, 0x9c, 0x4b, 0xcb, 0xe8, 0x12, 0xfe, 0xbb,
+	0x63, 0x38, 0x56, 0x49, 0x63, 0x26, 0xde, 0xab, 0xe1, 0x40, 0x8a, 0xe4, 0x4d, 0x56, 0x29, 0xcc,
+	0xf9, 0x56, 0xa5, 0xa0, 0x12, 0x81, 0x33, 0x5a, 0xb9, 0x39, 0x54, 0x2e, 0x51, 0x27, 0xdf, 0x53,
+	0x54, 0x02, 0xdd, 0x1b, 0xd7, 0xc3, 0x85, 0xc3, 0xc3, 0x4d, 0x33, 0xd4, 0xde, 0xe1, 0xb6, 0xd9,
+	0xcc, 0xf2, 0x92, 0x07, 0xb3, 0x03, 0xa7, 0x93, 0x66, 0x88, 0xdb, 0xcc, 0x6a, 0xf3, 0xa4, 0x7c,
+	0x9b, 0x89, 0x74, 0x61, 0x9b, 0x15, 0x44, 0xca, 0x24, 0xdc, 0x66, 0xe2, 0x58, 0xb4, 0xc8, 0x83,
+	0xdb, 0x0c, 0xcc, 0xb5, 0x3c, 0x77, 0x83, 0x05, 0x58, 0x67, 0x5a, 0x9e, 0x1b, 0xfa, 0x9e, 0xa3,
+	0x51, 0xfc, 0x12, 0xe2, 0x6d, 0xb2, 0xa1, 0xd5, 0x99, 0xe5, 0x99, 0x8c, 0x63, 0x26, 0x66, 0x80,
+	0x3e, 0x7e, 0x68, 0xb5, 0x4b, 0xf4, 0xec, 0x48, 0x54, 0x06, 0x62, 0xc2, 0x29, 0x95, 0xaa, 0xa0,
+	0x43, 0x8e, 0x29, 0x1b, 0x9d, 0x4a, 0xb9, 0x89, 0xa1, 0x12, 0xaf, 0xde, 0xec, 0x04, 0xc9, 0xb1,
+	0x7f, 0xc0, 0x2c, 0x9f, 0x85, 0xda, 0x0a, 0xba, 0x32, 0x01, 0xad, 0x02, 0x0e, 0x5d, 0x41, 0x30,
+	0x6b, 0x15, 0x94, 0x90, 0x31, 0x5a, 0xe6, 0x26, 0x86, 0x3a, 0x10, 0x84, 0xa6, 0x93, 0x5d, 0xea,
+	0x68, 0x98, 0x7b, 0x81, 0xb6, 0x8a, 0xab, 0x7b, 0x07, 0x96, 0x12, 0xc1, 0xf8, 0x72, 0xc4, 0xac,
+	0xb9, 0x17, 0x64, 0x4b, 0x59, 0xa0, 0x67, 0xbd, 0xec, 0xa2, 0x00, 0xf9, 0x69, 0x75, 0x50, 0x18,
+	0xc0, 0x67, 0x2d, 0x6f, 0x9b, 0x69, 0x6b, 0xf8, 0xa1, 0x9d, 0x88, 0x8f, 0xfb, 0x32, 0x09, 0x8a,
+	0x60, 0xe6, 0x42, 0x09, 0x19, 0xa3, 0x65, 0x6e, 0xf2, 0x33, 0x6a, 0x5f, 0xa7, 0xed, 0xb6, 0xb3,
+	0x42, 0xeb, 0x8f, 0xe6, 0x50, 0x37, 0x24, 0x15, 0x17, 0xf2, 0xc3, 0xfe, 0xb5, 0x65, 0x77, 0x39,
+	0x2f, 0xb5, 0x94, 0x1b, 0x59, 0x31, 0x0c, 0xb2, 0x09, 0xc0, 0x25, 0x3c, 0x50, 0xda, 0x4a, 0x85,
+	0x35, 0x85, 0x9e, 0xe1, 0x44, 0xc8, 0x1f, 0x28, 0xc9, 0xf0, 0xe9, 0x75, 0xf3, 0x0f, 0xe6, 0x70,
+	0xf6, 0x30, 0x0f, 0x1f, 0x12, 0x55, 0x64, 0x57, 0xcf, 0x71, 0xf8, 0xd1, 0x6c, 0x78, 0xfe, 0xca,
+	0x38, 0x67, 0x43, 0xfe, 0x7a, 0x5c, 0xaa, 0xe6, 0x82, 0x0d, 0x25, 0x1b, 0x45, 0x53, 0xa8, 0x9a,
+	0x4b, 0x91, 0x3f, 0x55, 0xd4, 0x73, 0x68, 0x66, 0x7e, 0xb1, 0xfc, 0x8f, 0x63, 0x43, 0x7f, 0x01,
+	0xa3, 0x94, 0xa8, 0x82, 0xbb, 0x64, 0xae, 0xdc, 0xc8, 0xce, 0x3e, 0x41, 0x5e, 0xbc, 0x16, 0x2e,
+	0x35, 0xf6, 0xca, 0x51, 0x7c, 0x10, 0x82, 0xe4, 0x63, 0x69, 0x0a, 0xed, 0xe3, 0x25, 0x73, 0x93,
+	0xf3, 0xeb, 0xe3, 0x1f, 0x56, 0x9b, 0xcc, 0x5d, 0x25, 0x2f, 0x98, 0x2c, 0x5e, 0xfe, 0xae, 0x36,
+	0xb9, 0x8a, 0xaf, 0x6c, 0x72, 0xca, 0x99, 0x9a, 0x9c, 0x3e, 0x93, 0xa6, 0x1a, 0xff, 0x4d, 0x25,
+	0x3b, 0x5f, 0xfe, 0xde, 0x1c, 0x26, 0xb8, 0x6f, 0x8a, 0xf6, 0x62, 0xcf, 0x24, 0x3f, 0x68, 0xe6,
+	0x36, 0xa3, 0x9f, 0x23, 0xe2, 0x6d, 0x93, 0x3e, 0x0e, 0x09, 0xf0, 0x76, 0x5f, 0xf9, 0x62, 0x9d,
+	0xd1, 0xb6, 0x42, 0xed, 0xfb, 0x30, 0x45, 0xca, 0xf4, 0xe2, 0xd3, 0x48, 0xbf, 0x92, 0x8f, 0xb8,
+	0x28, 0x5e, 0x8b, 0x5b, 0xb6, 0x42, 0x71, 0x9e, 0x5a, 0x25, 0x5c, 0x1c, 0x9e, 0x94, 0x19, 0xe0,
+	0x30, 0x7d, 0xa8, 0x70, 0x94, 0x1c, 0x58, 0xa6, 0x1b, 0x68, 0x7f, 0x12, 0xaf, 0xd2, 0x6a, 0xc1,
+	0x04, 0xfe, 0x08, 0x76, 0x05, 0x18, 0x0b, 0x26, 0x94, 0xf0, 0xf2, 0x52, 0xa1, 0x25, 0x25, 0xbe,
+	0xb1, 0xbf, 0x3d, 0xae, 0x0e, 0xcb, 0x7b, 0xaa, 0x64, 0x59, 0x3d, 0x95, 0x75, 0x61, 0x15, 0x0c,
+	0x9b, 0x77, 0xa1, 0xd1, 0x19, 0xe4, 0x8d, 0xd5, 0xc1, 0x38, 0xd4, 0x24, 0x84, 0xeb, 0x66, 0x18,
+	0xfa, 0x10, 0xd5, 0xcf, 0x0a, 0x14, 0x9a, 0x49, 0x90, 0xcd, 0xe2, 0x9f, 0xc7, 0x8e, 0xa3, 0xb7,
+	0xb3, 0xe5, 0x3f, 0x8f, 0x0d, 0x17, 0xff, 0x3c, 0x16, 0x2b, 0xcf, 0xb7, 0xdd, 0xf9, 0x22, 0x26,
+	0xfe, 0xab, 0x6c, 0xb3, 0xf8, 0xaf, 0xb2, 0x13, 0xc2, 0x48, 0xdc, 0xbf, 0xca, 0x86, 0x8b, 0xff,
+	0x2a, 0x93, 0x8d, 0x24, 0x60, 0xc2, 0xdf, 0xcd, 0xc6, 0xba, 0x8a, 0xda, 0xc7, 0xf7, 0x2a, 0xc8,
+	0x82, 0x7a, 0x02, 0x5a, 0x0a, 0xf1, 0x8c, 0x3d, 0x78, 0x1a, 0xe9, 0x27, 0xe2, 0x3e, 0x02, 0x50,
+	0x7b, 0x91, 0x7e, 0x2e, 0x29, 0x7a, 0x9c, 0x6c, 0xba, 0x4e, 0xa5, 0x0f, 0xbd, 0x83, 0x1a, 0x30,
+	0xed, 0x1f, 0xd6, 0x40, 0x84, 0xc2, 0x6f, 0xf2, 0x40, 0x3d, 0x99, 0xd4, 0x7b, 0xf1, 0xff, 0x7c,
+	0xc7, 0xe0, 0xef, 0x08, 0x2c, 0xad, 0xee, 0xce, 0xe4, 0xed, 0x0f, 0xbc, 0x4d, 0x8f, 0xbf, 0x68,
+	0x82, 0x93, 0x65, 0xf5, 0x64, 0xf2, 0xd5, 0x3b, 0x81, 0xc6, 0xdc, 0x07, 0xd9, 0x20, 0xfd, 0xd4,
+	0xc5, 0x8e, 0xc7, 0x8f, 0x62, 0xb9, 0x7a, 0xbe, 0x48, 0xa4, 0x89, 0xd4, 0xf4, 0xa3, 0x8f, 0x7e,
+	0x74, 0xf5, 0xd8, 0xe1, 0x8f, 0xae, 0x1e, 0xfb, 0xe8, 0xe9, 0x55, 0xe5, 0xf0, 0xe9, 0x55, 0xe5,
+	0xdb, 0x1f, 0x5f, 0x3d, 0xf6, 0xdd, 0x8f, 0xaf, 0x2a, 0x87, 0x1f, 0x5f, 0x3d, 0xf6, 0xaf, 0x1f,
+	0x5f, 0x3d, 0xf6, 0x95, 0x57, 0x36, 0xec, 0x70, 0xb3, 0x53, 0xbf, 0x69, 0x79, 0xad, 0x5b, 0x59,
+	0x8b, 0x80, 0xfb, 0x95, 0xff, 0x4b, 0xbb, 0x7e, 0x12, 0xff, 0x96, 0x7d, 0xe7, 0xff, 0x07, 0x00,
+	0x71, 0xc5, 0x59, 0x4f, 0x24, 0x3e, 0x00, 0x00,
 }
 
 func (m *OptionsConfiguration) Marshal() (dAtA []byte, err error) {
@@ -458,6 +718,247 @@ func (m *OptionsConfiguration) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0xc0
 	}
+	if m.StaleDeviceRemove {
+		i--
+		if m.StaleDeviceRemove {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x5
+		i--
+		dAtA[i] = 0xa8
+	}
+	if m.StaleDeviceDays != 0 {
+		i = encodeVarintOptionsconfiguration(dAtA, i, uint64(m.StaleDeviceDays))
+		i--
+		dAtA[i] = 0x5
+		i--
+		dAtA[i] = 0xa0
+	}
+	if len(m.ObfuscationSecret) > 0 {
+		i -= len(m.ObfuscationSecret)
+		copy(dAtA[i:], m.ObfuscationSecret)
+		i = encodeVarintOptionsconfiguration(dAtA, i, uint64(len(m.ObfuscationSecret)))
+		i--
+		dAtA[i] = 0x5
+		i--
+		dAtA[i] = 0x9a
+	}
+	if len(m.TCPCongestionControl) > 0 {
+		i -= len(m.TCPCongestionControl)
+		copy(dAtA[i:], m.TCPCongestionControl)
+		i = encodeVarintOptionsconfiguration(dAtA, i, uint64(len(m.TCPCongestionControl)))
+		i--
+		dAtA[i] = 0x5
+		i--
+		dAtA[i] = 0x92
+	}
+	if m.TCPNotSentLowat != 0 {
+		i = encodeVarintOptionsconfiguration(dAtA, i, uint64(m.TCPNotSentLowat))
+		i--
+		dAtA[i] = 0x5
+		i--
+		dAtA[i] = 0x88
+	}
+	if m.SocketReceiveBufferKiB != 0 {
+		i = encodeVarintOptionsconfiguration(dAtA, i, uint64(m.SocketReceiveBufferKiB))
+		i--
+		dAtA[i] = 0x5
+		i--
+		dAtA[i] = 0x80
+	}
+	if m.SocketSendBufferKiB != 0 {
+		i = encodeVarintOptionsconfiguration(dAtA, i, uint64(m.SocketSendBufferKiB))
+		i--
+		dAtA[i] = 0x4
+		i--
+		dAtA[i] = 0xf8
+	}
+	if m.TrafficClassWAN != 0 {
+		i = encodeVarintOptionsconfiguration(dAtA, i, uint64(m.TrafficClassWAN))
+		i--
+		dAtA[i] = 0x4
+		i--
+		dAtA[i] = 0xf0
+	}
+	if m.TrafficClassLAN != 0 {
+		i = encodeVarintOptionsconfiguration(dAtA, i, uint64(m.TrafficClassLAN))
+		i--
+		dAtA[i] = 0x4
+		i--
+		dAtA[i] = 0xe8
+	}
+	if m.ConflictClockSkewToleranceS != 0 {
+		i = encodeVarintOptionsconfiguration(dAtA, i, uint64(m.ConflictClockSkewToleranceS))
+		i--
+		dAtA[i] = 0x4
+		i--
+		dAtA[i] = 0xe0
+	}
+	if m.DatabaseBackend != 0 {
+		i = encodeVarintOptionsconfiguration(dAtA, i, uint64(m.DatabaseBackend))
+		i--
+		dAtA[i] = 0x4
+		i--
+		dAtA[i] = 0xd8
+	}
+	if m.URStoreForwarded {
+		i--
+		if m.URStoreForwarded {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x4
+		i--
+		dAtA[i] = 0xd0
+	}
+	if len(m.MQTTEvents) > 0 {
+		for iNdEx := len(m.MQTTEvents) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.MQTTEvents[iNdEx])
+			copy(dAtA[i:], m.MQTTEvents[iNdEx])
+			i = encodeVarintOptionsconfiguration(dAtA, i, uint64(len(m.MQTTEvents[iNdEx])))
+			i--
+			dAtA[i] = 0x4
+			i--
+			dAtA[i] = 0xca
+		}
+	}
+	if len(m.MQTTPassword) > 0 {
+		i -= len(m.MQTTPassword)
+		copy(dAtA[i:], m.MQTTPassword)
+		i = encodeVarintOptionsconfiguration(dAtA, i, uint64(len(m.MQTTPassword)))
+		i--
+		dAtA[i] = 0x4
+		i--
+		dAtA[i] = 0xc2
+	}
+	if len(m.MQTTUsername) > 0 {
+		i -= len(m.MQTTUsername)
+		copy(dAtA[i:], m.MQTTUsername)
+		i = encodeVarintOptionsconfiguration(dAtA, i, uint64(len(m.MQTTUsername)))
+		i--
+		dAtA[i] = 0x4
+		i--
+		dAtA[i] = 0xba
+	}
+	if len(m.MQTTTopicPrefix) > 0 {
+		i -= len(m.MQTTTopicPrefix)
+		copy(dAtA[i:], m.MQTTTopicPrefix)
+		i = encodeVarintOptionsconfiguration(dAtA, i, uint64(len(m.MQTTTopicPrefix)))
+		i--
+		dAtA[i] = 0x4
+		i--
+		dAtA[i] = 0xb2
+	}
+	if len(m.MQTTBrokerURL) > 0 {
+		i -= len(m.MQTTBrokerURL)
+		copy(dAtA[i:], m.MQTTBrokerURL)
+		i = encodeVarintOptionsconfiguration(dAtA, i, uint64(len(m.MQTTBrokerURL)))
+		i--
+		dAtA[i] = 0x4
+		i--
+		dAtA[i] = 0xaa
+	}
+	if len(m.EventWebhooks) > 0 {
+		for iNdEx := len(m.EventWebhooks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EventWebhooks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOptionsconfiguration(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4
+			i--
+			dAtA[i] = 0xa2
+		}
+	}
+	if len(m.RawLocalAnnAddresses) > 0 {
+		for iNdEx := len(m.RawLocalAnnAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RawLocalAnnAddresses[iNdEx])
+			copy(dAtA[i:], m.RawLocalAnnAddresses[iNdEx])
+			i = encodeVarintOptionsconfiguration(dAtA, i, uint64(len(m.RawLocalAnnAddresses[iNdEx])))
+			i--
+			dAtA[i] = 0x4
+			i--
+			dAtA[i] = 0x9a
+		}
+	}
+	if m.LocalAnnMDNSEnabled {
+		i--
+		if m.LocalAnnMDNSEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x4
+		i--
+		dAtA[i] = 0x90
+	}
+	if len(m.LocalAnnInterfaces) > 0 {
+		for iNdEx := len(m.LocalAnnInterfaces) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.LocalAnnInterfaces[iNdEx])
+			copy(dAtA[i:], m.LocalAnnInterfaces[iNdEx])
+			i = encodeVarintOptionsconfiguration(dAtA, i, uint64(len(m.LocalAnnInterfaces[iNdEx])))
+			i--
+			dAtA[i] = 0x4
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if m.RelayMonthlyBudgetMiB != 0 {
+		i = encodeVarintOptionsconfiguration(dAtA, i, uint64(m.RelayMonthlyBudgetMiB))
+		i--
+		dAtA[i] = 0x4
+		i--
+		dAtA[i] = 0x80
+	}
+	if m.QuotaResetDay != 0 {
+		i = encodeVarintOptionsconfiguration(dAtA, i, uint64(m.QuotaResetDay))
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0xf8
+	}
+	if m.ConnectionPriorityWSS != 0 {
+		i = encodeVarintOptionsconfiguration(dAtA, i, uint64(m.ConnectionPriorityWSS))
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0xf0
+	}
+	if len(m.ProxyURL) > 0 {
+		i -= len(m.ProxyURL)
+		copy(dAtA[i:], m.ProxyURL)
+		i = encodeVarintOptionsconfiguration(dAtA, i, uint64(len(m.ProxyURL)))
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0xea
+	}
+	if len(m.BandwidthSchedule) > 0 {
+		for iNdEx := len(m.BandwidthSchedule) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BandwidthSchedule[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOptionsconfiguration(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3
+			i--
+			dAtA[i] = 0xe2
+		}
+	}
 	if m.ConnectionPriorityUpgradeThreshold != 0 {
 		i = encodeVarintOptionsconfiguration(dAtA, i, uint64(m.ConnectionPriorityUpgradeThreshold))
 		i--
@@ -948,6 +1449,92 @@ func (m *OptionsConfiguration) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *BandwidthScheduleEntry) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BandwidthScheduleEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BandwidthScheduleEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxRecvKbps != 0 {
+		i = encodeVarintOptionsconfiguration(dAtA, i, uint64(m.MaxRecvKbps))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MaxSendKbps != 0 {
+		i = encodeVarintOptionsconfiguration(dAtA, i, uint64(m.MaxSendKbps))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Schedule) > 0 {
+		i -= len(m.Schedule)
+		copy(dAtA[i:], m.Schedule)
+		i = encodeVarintOptionsconfiguration(dAtA, i, uint64(len(m.Schedule)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventWebhook) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventWebhook) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventWebhook) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Secret) > 0 {
+		i -= len(m.Secret)
+		copy(dAtA[i:], m.Secret)
+		i = encodeVarintOptionsconfiguration(dAtA, i, uint64(len(m.Secret)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Events) > 0 {
+		for iNdEx := len(m.Events) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Events[iNdEx])
+			copy(dAtA[i:], m.Events[iNdEx])
+			i = encodeVarintOptionsconfiguration(dAtA, i, uint64(len(m.Events[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.URL) > 0 {
+		i -= len(m.URL)
+		copy(dAtA[i:], m.URL)
+		i = encodeVarintOptionsconfiguration(dAtA, i, uint64(len(m.URL)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintOptionsconfiguration(dAtA []byte, offset int, v uint64) int {
 	offset -= sovOptionsconfiguration(v)
 	base := offset
@@ -1159,20 +1746,120 @@ func (m *OptionsConfiguration) ProtoSize() (n int) {
 	if m.ConnectionPriorityUpgradeThreshold != 0 {
 		n += 2 + sovOptionsconfiguration(uint64(m.ConnectionPriorityUpgradeThreshold))
 	}
-	if m.DeprecatedUPnPEnabled {
-		n += 4
+	if len(m.BandwidthSchedule) > 0 {
+		for _, e := range m.BandwidthSchedule {
+			l = e.ProtoSize()
+			n += 2 + l + sovOptionsconfiguration(uint64(l))
+		}
 	}
-	if m.DeprecatedUPnPLeaseM != 0 {
-		n += 3 + sovOptionsconfiguration(uint64(m.DeprecatedUPnPLeaseM))
+	l = len(m.ProxyURL)
+	if l > 0 {
+		n += 2 + l + sovOptionsconfiguration(uint64(l))
 	}
-	if m.DeprecatedUPnPRenewalM != 0 {
-		n += 3 + sovOptionsconfiguration(uint64(m.DeprecatedUPnPRenewalM))
+	if m.ConnectionPriorityWSS != 0 {
+		n += 2 + sovOptionsconfiguration(uint64(m.ConnectionPriorityWSS))
 	}
-	if m.DeprecatedUPnPTimeoutS != 0 {
-		n += 3 + sovOptionsconfiguration(uint64(m.DeprecatedUPnPTimeoutS))
+	if m.QuotaResetDay != 0 {
+		n += 2 + sovOptionsconfiguration(uint64(m.QuotaResetDay))
 	}
-	if len(m.DeprecatedRelayServers) > 0 {
-		for _, s := range m.DeprecatedRelayServers {
+	if m.RelayMonthlyBudgetMiB != 0 {
+		n += 2 + sovOptionsconfiguration(uint64(m.RelayMonthlyBudgetMiB))
+	}
+	if len(m.LocalAnnInterfaces) > 0 {
+		for _, s := range m.LocalAnnInterfaces {
+			l = len(s)
+			n += 2 + l + sovOptionsconfiguration(uint64(l))
+		}
+	}
+	if m.LocalAnnMDNSEnabled {
+		n += 3
+	}
+	if len(m.RawLocalAnnAddresses) > 0 {
+		for _, s := range m.RawLocalAnnAddresses {
+			l = len(s)
+			n += 2 + l + sovOptionsconfiguration(uint64(l))
+		}
+	}
+	if len(m.EventWebhooks) > 0 {
+		for _, e := range m.EventWebhooks {
+			l = e.ProtoSize()
+			n += 2 + l + sovOptionsconfiguration(uint64(l))
+		}
+	}
+	l = len(m.MQTTBrokerURL)
+	if l > 0 {
+		n += 2 + l + sovOptionsconfiguration(uint64(l))
+	}
+	l = len(m.MQTTTopicPrefix)
+	if l > 0 {
+		n += 2 + l + sovOptionsconfiguration(uint64(l))
+	}
+	l = len(m.MQTTUsername)
+	if l > 0 {
+		n += 2 + l + sovOptionsconfiguration(uint64(l))
+	}
+	l = len(m.MQTTPassword)
+	if l > 0 {
+		n += 2 + l + sovOptionsconfiguration(uint64(l))
+	}
+	if len(m.MQTTEvents) > 0 {
+		for _, s := range m.MQTTEvents {
+			l = len(s)
+			n += 2 + l + sovOptionsconfiguration(uint64(l))
+		}
+	}
+	if m.URStoreForwarded {
+		n += 3
+	}
+	if m.DatabaseBackend != 0 {
+		n += 2 + sovOptionsconfiguration(uint64(m.DatabaseBackend))
+	}
+	if m.ConflictClockSkewToleranceS != 0 {
+		n += 2 + sovOptionsconfiguration(uint64(m.ConflictClockSkewToleranceS))
+	}
+	if m.TrafficClassLAN != 0 {
+		n += 2 + sovOptionsconfiguration(uint64(m.TrafficClassLAN))
+	}
+	if m.TrafficClassWAN != 0 {
+		n += 2 + sovOptionsconfiguration(uint64(m.TrafficClassWAN))
+	}
+	if m.SocketSendBufferKiB != 0 {
+		n += 2 + sovOptionsconfiguration(uint64(m.SocketSendBufferKiB))
+	}
+	if m.SocketReceiveBufferKiB != 0 {
+		n += 2 + sovOptionsconfiguration(uint64(m.SocketReceiveBufferKiB))
+	}
+	if m.TCPNotSentLowat != 0 {
+		n += 2 + sovOptionsconfiguration(uint64(m.TCPNotSentLowat))
+	}
+	l = len(m.TCPCongestionControl)
+	if l > 0 {
+		n += 2 + l + sovOptionsconfiguration(uint64(l))
+	}
+	l = len(m.ObfuscationSecret)
+	if l > 0 {
+		n += 2 + l + sovOptionsconfiguration(uint64(l))
+	}
+	if m.StaleDeviceDays != 0 {
+		n += 2 + sovOptionsconfiguration(uint64(m.StaleDeviceDays))
+	}
+	if m.StaleDeviceRemove {
+		n += 3
+	}
+	if m.DeprecatedUPnPEnabled {
+		n += 4
+	}
+	if m.DeprecatedUPnPLeaseM != 0 {
+		n += 3 + sovOptionsconfiguration(uint64(m.DeprecatedUPnPLeaseM))
+	}
+	if m.DeprecatedUPnPRenewalM != 0 {
+		n += 3 + sovOptionsconfiguration(uint64(m.DeprecatedUPnPRenewalM))
+	}
+	if m.DeprecatedUPnPTimeoutS != 0 {
+		n += 3 + sovOptionsconfiguration(uint64(m.DeprecatedUPnPTimeoutS))
+	}
+	if len(m.DeprecatedRelayServers) > 0 {
+		for _, s := range m.DeprecatedRelayServers {
 			l = len(s)
 			n += 3 + l + sovOptionsconfiguration(uint64(l))
 		}
@@ -1186,6 +1873,48 @@ func (m *OptionsConfiguration) ProtoSize() (n int) {
 	return n
 }
 
+func (m *BandwidthScheduleEntry) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Schedule)
+	if l > 0 {
+		n += 1 + l + sovOptionsconfiguration(uint64(l))
+	}
+	if m.MaxSendKbps != 0 {
+		n += 1 + sovOptionsconfiguration(uint64(m.MaxSendKbps))
+	}
+	if m.MaxRecvKbps != 0 {
+		n += 1 + sovOptionsconfiguration(uint64(m.MaxRecvKbps))
+	}
+	return n
+}
+
+func (m *EventWebhook) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.URL)
+	if l > 0 {
+		n += 1 + l + sovOptionsconfiguration(uint64(l))
+	}
+	if len(m.Events) > 0 {
+		for _, s := range m.Events {
+			l = len(s)
+			n += 1 + l + sovOptionsconfiguration(uint64(l))
+		}
+	}
+	l = len(m.Secret)
+	if l > 0 {
+		n += 1 + l + sovOptionsconfiguration(uint64(l))
+	}
+	return n
+}
+
 func sovOptionsconfiguration(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2489,11 +3218,77 @@ func (m *OptionsConfiguration) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 9000:
+		case 60:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BandwidthSchedule", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOptionsconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOptionsconfiguration
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOptionsconfiguration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BandwidthSchedule = append(m.BandwidthSchedule, BandwidthScheduleEntry{})
+			if err := m.BandwidthSchedule[len(m.BandwidthSchedule)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 61:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProxyURL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOptionsconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOptionsconfiguration
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOptionsconfiguration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProxyURL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 62:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DeprecatedUPnPEnabled", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionPriorityWSS", wireType)
 			}
-			var v int
+			m.ConnectionPriorityWSS = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOptionsconfiguration
@@ -2503,17 +3298,16 @@ func (m *OptionsConfiguration) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.ConnectionPriorityWSS |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.DeprecatedUPnPEnabled = bool(v != 0)
-		case 9001:
+		case 63:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DeprecatedUPnPLeaseM", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field QuotaResetDay", wireType)
 			}
-			m.DeprecatedUPnPLeaseM = 0
+			m.QuotaResetDay = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOptionsconfiguration
@@ -2523,16 +3317,16 @@ func (m *OptionsConfiguration) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DeprecatedUPnPLeaseM |= int(b&0x7F) << shift
+				m.QuotaResetDay |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 9002:
+		case 64:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DeprecatedUPnPRenewalM", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RelayMonthlyBudgetMiB", wireType)
 			}
-			m.DeprecatedUPnPRenewalM = 0
+			m.RelayMonthlyBudgetMiB = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOptionsconfiguration
@@ -2542,16 +3336,48 @@ func (m *OptionsConfiguration) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DeprecatedUPnPRenewalM |= int(b&0x7F) << shift
+				m.RelayMonthlyBudgetMiB |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 9003:
+		case 65:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LocalAnnInterfaces", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOptionsconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOptionsconfiguration
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOptionsconfiguration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LocalAnnInterfaces = append(m.LocalAnnInterfaces, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 66:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DeprecatedUPnPTimeoutS", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LocalAnnMDNSEnabled", wireType)
 			}
-			m.DeprecatedUPnPTimeoutS = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOptionsconfiguration
@@ -2561,14 +3387,15 @@ func (m *OptionsConfiguration) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DeprecatedUPnPTimeoutS |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 9004:
+			m.LocalAnnMDNSEnabled = bool(v != 0)
+		case 67:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DeprecatedRelayServers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RawLocalAnnAddresses", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2596,24 +3423,47 @@ func (m *OptionsConfiguration) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DeprecatedRelayServers = append(m.DeprecatedRelayServers, string(dAtA[iNdEx:postIndex]))
+			m.RawLocalAnnAddresses = append(m.RawLocalAnnAddresses, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 9005:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DeprecatedMinHomeDiskFreePct", wireType)
+		case 68:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventWebhooks", wireType)
 			}
-			var v uint64
-			if (iNdEx + 8) > l {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOptionsconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOptionsconfiguration
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOptionsconfiguration
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-			iNdEx += 8
-			m.DeprecatedMinHomeDiskFreePct = float64(math.Float64frombits(v))
-		case 9006:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DeprecatedMaxConcurrentScans", wireType)
+			m.EventWebhooks = append(m.EventWebhooks, EventWebhook{})
+			if err := m.EventWebhooks[len(m.EventWebhooks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.DeprecatedMaxConcurrentScans = 0
+			iNdEx = postIndex
+		case 69:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MQTTBrokerURL", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOptionsconfiguration
@@ -2623,11 +3473,813 @@ func (m *OptionsConfiguration) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DeprecatedMaxConcurrentScans |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOptionsconfiguration
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOptionsconfiguration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MQTTBrokerURL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 70:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MQTTTopicPrefix", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOptionsconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOptionsconfiguration
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOptionsconfiguration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MQTTTopicPrefix = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 71:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MQTTUsername", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOptionsconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOptionsconfiguration
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOptionsconfiguration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MQTTUsername = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 72:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MQTTPassword", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOptionsconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOptionsconfiguration
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOptionsconfiguration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MQTTPassword = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 73:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MQTTEvents", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOptionsconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOptionsconfiguration
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOptionsconfiguration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MQTTEvents = append(m.MQTTEvents, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 74:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field URStoreForwarded", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOptionsconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.URStoreForwarded = bool(v != 0)
+		case 75:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DatabaseBackend", wireType)
+			}
+			m.DatabaseBackend = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOptionsconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DatabaseBackend |= DatabaseBackend(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 76:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConflictClockSkewToleranceS", wireType)
+			}
+			m.ConflictClockSkewToleranceS = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOptionsconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConflictClockSkewToleranceS |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 77:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrafficClassLAN", wireType)
+			}
+			m.TrafficClassLAN = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOptionsconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TrafficClassLAN |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 78:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrafficClassWAN", wireType)
+			}
+			m.TrafficClassWAN = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOptionsconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TrafficClassWAN |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 79:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SocketSendBufferKiB", wireType)
+			}
+			m.SocketSendBufferKiB = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOptionsconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SocketSendBufferKiB |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 80:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SocketReceiveBufferKiB", wireType)
+			}
+			m.SocketReceiveBufferKiB = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOptionsconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SocketReceiveBufferKiB |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 81:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TCPNotSentLowat", wireType)
+			}
+			m.TCPNotSentLowat = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOptionsconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TCPNotSentLowat |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 82:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TCPCongestionControl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOptionsconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOptionsconfiguration
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOptionsconfiguration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TCPCongestionControl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 83:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObfuscationSecret", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOptionsconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOptionsconfiguration
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOptionsconfiguration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ObfuscationSecret = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 84:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StaleDeviceDays", wireType)
+			}
+			m.StaleDeviceDays = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOptionsconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StaleDeviceDays |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 85:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StaleDeviceRemove", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOptionsconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.StaleDeviceRemove = bool(v != 0)
+		case 9000:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeprecatedUPnPEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOptionsconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DeprecatedUPnPEnabled = bool(v != 0)
+		case 9001:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeprecatedUPnPLeaseM", wireType)
+			}
+			m.DeprecatedUPnPLeaseM = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOptionsconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DeprecatedUPnPLeaseM |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9002:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeprecatedUPnPRenewalM", wireType)
+			}
+			m.DeprecatedUPnPRenewalM = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOptionsconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DeprecatedUPnPRenewalM |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9003:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeprecatedUPnPTimeoutS", wireType)
+			}
+			m.DeprecatedUPnPTimeoutS = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOptionsconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DeprecatedUPnPTimeoutS |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9004:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeprecatedRelayServers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOptionsconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOptionsconfiguration
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOptionsconfiguration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeprecatedRelayServers = append(m.DeprecatedRelayServers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 9005:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeprecatedMinHomeDiskFreePct", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.DeprecatedMinHomeDiskFreePct = float64(math.Float64frombits(v))
+		case 9006:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeprecatedMaxConcurrentScans", wireType)
+			}
+			m.DeprecatedMaxConcurrentScans = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOptionsconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DeprecatedMaxConcurrentScans |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOptionsconfiguration(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOptionsconfiguration
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BandwidthScheduleEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOptionsconfiguration
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BandwidthScheduleEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BandwidthScheduleEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schedule", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOptionsconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOptionsconfiguration
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOptionsconfiguration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Schedule = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSendKbps", wireType)
+			}
+			m.MaxSendKbps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOptionsconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxSendKbps |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRecvKbps", wireType)
+			}
+			m.MaxRecvKbps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOptionsconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxRecvKbps |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOptionsconfiguration(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOptionsconfiguration
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventWebhook) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOptionsconfiguration
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventWebhook: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventWebhook: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOptionsconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOptionsconfiguration
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOptionsconfiguration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.URL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Events", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOptionsconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOptionsconfiguration
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOptionsconfiguration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Events = append(m.Events, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Secret", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOptionsconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOptionsconfiguration
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOptionsconfiguration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Secret = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOptionsconfiguration(dAtA[iNdEx:])
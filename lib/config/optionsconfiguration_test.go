@@ -0,0 +1,63 @@
+// Copyright (C) 2026 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package config
+
+import (
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestQuotaPeriodStart(t *testing.T) {
+	cases := []struct {
+		now      time.Time
+		resetDay int
+		expected time.Time
+	}{
+		{time.Date(2026, 3, 15, 12, 0, 0, 0, time.UTC), 1, time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)},
+		{time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC), 1, time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)},
+		{time.Date(2026, 3, 14, 23, 59, 0, 0, time.UTC), 15, time.Date(2026, 2, 15, 0, 0, 0, 0, time.UTC)},
+		{time.Date(2026, 1, 10, 0, 0, 0, 0, time.UTC), 28, time.Date(2025, 12, 28, 0, 0, 0, 0, time.UTC)},
+	}
+	for _, tc := range cases {
+		if res := (OptionsConfiguration{QuotaResetDay: tc.resetDay}).QuotaPeriodStart(tc.now); !res.Equal(tc.expected) {
+			t.Errorf("QuotaPeriodStart(%v) with reset day %d = %v, expected %v", tc.now, tc.resetDay, res, tc.expected)
+		}
+	}
+}
+
+func TestLocalAnnAddresses(t *testing.T) {
+	opts := OptionsConfiguration{
+		LocalAnnPort:         21027,
+		LocalAnnMCAddr:       "[ff12::8384]:21027",
+		RawLocalAnnAddresses: []string{"default", ":21028", "239.255.83.84:21027", ":21027"},
+	}
+	expected := []string{":21027", "[ff12::8384]:21027", ":21028", "239.255.83.84:21027"}
+	if res := opts.LocalAnnAddresses(); !slices.Equal(res, expected) {
+		t.Errorf("LocalAnnAddresses() = %v, expected %v", res, expected)
+	}
+}
+
+func TestTrafficClassFor(t *testing.T) {
+	opts := OptionsConfiguration{TrafficClass: 4, TrafficClassWAN: 32}
+	if tc := opts.TrafficClassFor(true); tc != 4 {
+		t.Errorf("LAN traffic class = %d, expected the general one", tc)
+	}
+	if tc := opts.TrafficClassFor(false); tc != 32 {
+		t.Errorf("WAN traffic class = %d, expected 32", tc)
+	}
+
+	opts.TrafficClassLAN = 184
+	opts.TrafficClassWAN = 300
+	opts.prepare(false)
+	if tc := opts.TrafficClassFor(true); tc != 184 {
+		t.Errorf("LAN traffic class = %d, expected 184", tc)
+	}
+	if tc := opts.TrafficClassFor(false); tc != 4 {
+		t.Errorf("out of range WAN traffic class should be ignored, got %d", tc)
+	}
+}
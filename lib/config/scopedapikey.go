@@ -0,0 +1,56 @@
+// Copyright (C) 2026 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package config
+
+import (
+	"slices"
+	"time"
+)
+
+func (s APIKeyScope) String() string {
+	switch s {
+	case APIKeyScopeReadOnly:
+		return "readOnly"
+	case APIKeyScopeConfigWrite:
+		return "configWrite"
+	case APIKeyScopeDBWrite:
+		return "dbWrite"
+	default:
+		return "unknown"
+	}
+}
+
+func (s APIKeyScope) MarshalText() ([]byte, error) {
+	return []byte(s.String()), nil
+}
+
+func (s *APIKeyScope) UnmarshalText(bs []byte) error {
+	switch string(bs) {
+	case "configWrite":
+		*s = APIKeyScopeConfigWrite
+	case "dbWrite":
+		*s = APIKeyScopeDBWrite
+	default:
+		*s = APIKeyScopeReadOnly
+	}
+	return nil
+}
+
+// HasScope returns true if the key has the given scope.
+func (k ScopedAPIKey) HasScope(scope APIKeyScope) bool {
+	return slices.Contains(k.Scopes, scope)
+}
+
+// Expired returns true if the key has an expiry time before now.
+func (k ScopedAPIKey) Expired(now time.Time) bool {
+	return !k.Expires.IsZero() && k.Expires.Before(now)
+}
+
+func (k ScopedAPIKey) Copy() ScopedAPIKey {
+	k.Scopes = slices.Clone(k.Scopes)
+	return k
+}
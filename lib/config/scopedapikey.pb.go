@@ -0,0 +1,547 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: lib/config/scopedapikey.proto
+
+package config
+
+import (
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "github.com/syncthing/syncthing/proto/ext"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type APIKeyScope int32
+
+const (
+	APIKeyScopeReadOnly    APIKeyScope = 0
+	APIKeyScopeConfigWrite APIKeyScope = 1
+	APIKeyScopeDBWrite     APIKeyScope = 2
+)
+
+var APIKeyScope_name = map[int32]string{
+	0: "API_KEY_SCOPE_READ_ONLY",
+	1: "API_KEY_SCOPE_CONFIG_WRITE",
+	2: "API_KEY_SCOPE_DB_WRITE",
+}
+
+var APIKeyScope_value = map[string]int32{
+	"API_KEY_SCOPE_READ_ONLY":    0,
+	"API_KEY_SCOPE_CONFIG_WRITE": 1,
+	"API_KEY_SCOPE_DB_WRITE":     2,
+}
+
+func (APIKeyScope) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_634ec08f0bc19e22, []int{0}
+}
+
+// An API key limited to the given scopes, until it expires. Reading is
+// always allowed, except for credentials.
+type ScopedAPIKey struct {
+	Key    string        `protobuf:"bytes,1,opt,name=key,proto3" json:"key" xml:"key,attr"`
+	Label  string        `protobuf:"bytes,2,opt,name=label,proto3" json:"label" xml:"label,attr,omitempty"`
+	Scopes []APIKeyScope `protobuf:"varint,3,rep,packed,name=scopes,proto3,enum=config.APIKeyScope" json:"scopes" xml:"scope"`
+	// Never expires when zero.
+	Expires time.Time `protobuf:"bytes,4,opt,name=expires,proto3,stdtime" json:"expires" xml:"expires,attr"`
+}
+
+func (m *ScopedAPIKey) Reset()         { *m = ScopedAPIKey{} }
+func (m *ScopedAPIKey) String() string { return proto.CompactTextString(m) }
+func (*ScopedAPIKey) ProtoMessage()    {}
+func (*ScopedAPIKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_634ec08f0bc19e22, []int{0}
+}
+func (m *ScopedAPIKey) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScopedAPIKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScopedAPIKey.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ScopedAPIKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScopedAPIKey.Merge(m, src)
+}
+func (m *ScopedAPIKey) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *ScopedAPIKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScopedAPIKey.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScopedAPIKey proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterEnum("config.APIKeyScope", APIKeyScope_name, APIKeyScope_value)
+	proto.RegisterType((*ScopedAPIKey)(nil), "config.ScopedAPIKey")
+}
+
+func init() { proto.RegisterFile("lib/config/scopedapikey.proto", fileDescriptor_634ec08f0bc19e22) }
+
+var fileDescriptor_634ec08f0bc19e22 = []byte{
+	// 519 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x53, 0xc1, 0x6a, 0xdb, 0x4c,
+	0x18, 0x94, 0xec, 0xc4, 0xff, 0x9f, 0x75, 0x08, 0x61, 0x53, 0x52, 0x23, 0xa8, 0x56, 0xb8, 0x31,
+	0xb8, 0xc5, 0xc8, 0x90, 0x16, 0x4a, 0x7b, 0x29, 0x96, 0xed, 0xb6, 0xc6, 0x25, 0x36, 0x8a, 0x4b,
+	0x48, 0x2f, 0x46, 0x72, 0x36, 0x8a, 0xb0, 0xe4, 0x15, 0xd2, 0x06, 0xac, 0x5b, 0xcf, 0x3e, 0x84,
+	0xbc, 0x80, 0xa1, 0x87, 0x1e, 0xfa, 0x28, 0xbe, 0xc5, 0xe4, 0xd4, 0x93, 0xda, 0xc4, 0x37, 0x1d,
+	0xfd, 0x04, 0xc5, 0x2b, 0x89, 0x28, 0xe0, 0xde, 0xbe, 0x99, 0x6f, 0x66, 0xf6, 0x63, 0x84, 0xc0,
+	0x33, 0xcb, 0xd4, 0xab, 0x03, 0x32, 0x3a, 0x37, 0x8d, 0xaa, 0x37, 0x20, 0x0e, 0x3e, 0xd3, 0x1c,
+	0x73, 0x88, 0x7d, 0xd9, 0x71, 0x09, 0x25, 0x30, 0x17, 0xad, 0x84, 0xe7, 0x2e, 0x76, 0x88, 0x57,
+	0x65, 0xa4, 0x7e, 0x79, 0x5e, 0x35, 0x88, 0x41, 0x18, 0x60, 0x53, 0x24, 0x16, 0x90, 0x41, 0x88,
+	0x61, 0xe1, 0x07, 0x15, 0x35, 0x6d, 0xec, 0x51, 0xcd, 0x76, 0x62, 0xc1, 0x16, 0x1e, 0xd3, 0x68,
+	0x2c, 0xfe, 0xc9, 0x80, 0xed, 0x63, 0xf6, 0x5e, 0xad, 0xdb, 0x6a, 0x63, 0x1f, 0xbe, 0x01, 0xd9,
+	0x21, 0xf6, 0x0b, 0xbc, 0xc4, 0x97, 0xb7, 0x94, 0x52, 0x18, 0xa0, 0x15, 0x5c, 0x06, 0x68, 0x67,
+	0x6c, 0x5b, 0xef, 0x8a, 0x43, 0xec, 0x57, 0x34, 0x4a, 0xdd, 0x62, 0x78, 0x73, 0xf0, 0x7f, 0x02,
+	0xd4, 0x95, 0x04, 0x7e, 0x01, 0x9b, 0x96, 0xa6, 0x63, 0xab, 0x90, 0x61, 0xd6, 0xf7, 0x61, 0x80,
+	0x22, 0x62, 0x19, 0x20, 0x81, 0x99, 0x19, 0x62, 0x8e, 0x0a, 0xb1, 0x4d, 0x8a, 0x6d, 0x87, 0xfa,
+	0xab, 0xa0, 0x27, 0xeb, 0x16, 0x6a, 0x64, 0x86, 0x1d, 0x90, 0x63, 0x7d, 0x78, 0x85, 0xac, 0x94,
+	0x2d, 0xef, 0x1c, 0xee, 0xc9, 0x51, 0x15, 0x72, 0x74, 0x2f, 0xbb, 0x5d, 0x29, 0x86, 0x01, 0x8a,
+	0x65, 0xcb, 0x00, 0xe5, 0xd9, 0x6b, 0x0c, 0xae, 0xe2, 0x37, 0xd9, 0xa4, 0xc6, 0x7b, 0x48, 0xc0,
+	0x7f, 0x78, 0xec, 0x98, 0x2e, 0xf6, 0x0a, 0x1b, 0x12, 0x5f, 0xce, 0x1f, 0x0a, 0x72, 0xd4, 0x97,
+	0x9c, 0xf4, 0x25, 0xf7, 0x92, 0xbe, 0x94, 0xb7, 0xb3, 0x00, 0x71, 0x61, 0x80, 0x12, 0xcb, 0x32,
+	0x40, 0x90, 0xa5, 0xc7, 0x38, 0x2a, 0xe3, 0xfa, 0x37, 0xe2, 0xc3, 0x9b, 0x83, 0xed, 0x34, 0xa9,
+	0x26, 0x96, 0x97, 0xdf, 0x32, 0x20, 0x9f, 0x3a, 0x16, 0xb6, 0xc1, 0xd3, 0x5a, 0xb7, 0xd5, 0x6f,
+	0x37, 0x4f, 0xfb, 0xc7, 0xf5, 0x4e, 0xb7, 0xd9, 0x57, 0x9b, 0xb5, 0x46, 0xbf, 0x73, 0xf4, 0xf9,
+	0x74, 0x97, 0x13, 0xe4, 0xc9, 0x54, 0xda, 0x4b, 0xa9, 0x55, 0xac, 0x9d, 0x75, 0x46, 0x96, 0x7f,
+	0x7b, 0x55, 0x5a, 0x47, 0xc3, 0x1e, 0x10, 0x1e, 0x87, 0xd5, 0x3b, 0x47, 0x1f, 0x5a, 0x1f, 0xfb,
+	0x27, 0x6a, 0xab, 0xd7, 0xdc, 0xe5, 0x85, 0xd7, 0x93, 0xa9, 0xb4, 0x9f, 0x32, 0xd6, 0x59, 0x79,
+	0x27, 0xae, 0x49, 0xf1, 0xed, 0x55, 0xe9, 0x1f, 0x1b, 0xf8, 0x09, 0xec, 0x3f, 0x4e, 0x6d, 0x28,
+	0x71, 0x62, 0x46, 0xa8, 0x4c, 0xa6, 0x12, 0x4c, 0xf9, 0x1a, 0x4a, 0x92, 0xb6, 0x86, 0x15, 0x36,
+	0x7e, 0xfe, 0x10, 0x39, 0xa5, 0x3d, 0xbb, 0x13, 0xb9, 0xf9, 0x9d, 0xc8, 0xcd, 0xee, 0x45, 0x7e,
+	0x7e, 0x2f, 0xf2, 0xd7, 0x0b, 0x91, 0xfb, 0xbe, 0x10, 0xf9, 0xf9, 0x42, 0xe4, 0x7e, 0x2d, 0x44,
+	0xee, 0xeb, 0x0b, 0xc3, 0xa4, 0x17, 0x97, 0xba, 0x3c, 0x20, 0x76, 0xd5, 0xf3, 0x47, 0x03, 0x7a,
+	0x61, 0x8e, 0x8c, 0xd4, 0xf4, 0xf0, 0x7b, 0xe8, 0x39, 0xf6, 0x9d, 0x5e, 0xfd, 0x1d, 0x00, 0x93,
+	0x4f, 0x94, 0x71, 0x33, 0x03, 0x00, 0x00,
+}
+
+func (m *ScopedAPIKey) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScopedAPIKey) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScopedAPIKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Expires, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Expires):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintScopedapikey(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x22
+	if len(m.Scopes) > 0 {
+		dAtA3 := make([]byte, len(m.Scopes)*10)
+		var j2 int
+		for _, num := range m.Scopes {
+			for num >= 1<<7 {
+				dAtA3[j2] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j2++
+			}
+			dAtA3[j2] = uint8(num)
+			j2++
+		}
+		i -= j2
+		copy(dAtA[i:], dAtA3[:j2])
+		i = encodeVarintScopedapikey(dAtA, i, uint64(j2))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Label) > 0 {
+		i -= len(m.Label)
+		copy(dAtA[i:], m.Label)
+		i = encodeVarintScopedapikey(dAtA, i, uint64(len(m.Label)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintScopedapikey(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintScopedapikey(dAtA []byte, offset int, v uint64) int {
+	offset -= sovScopedapikey(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ScopedAPIKey) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovScopedapikey(uint64(l))
+	}
+	l = len(m.Label)
+	if l > 0 {
+		n += 1 + l + sovScopedapikey(uint64(l))
+	}
+	if len(m.Scopes) > 0 {
+		l = 0
+		for _, e := range m.Scopes {
+			l += sovScopedapikey(uint64(e))
+		}
+		n += 1 + sovScopedapikey(uint64(l)) + l
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Expires)
+	n += 1 + l + sovScopedapikey(uint64(l))
+	return n
+}
+
+func sovScopedapikey(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozScopedapikey(x uint64) (n int) {
+	return sovScopedapikey(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ScopedAPIKey) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowScopedapikey
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScopedAPIKey: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScopedAPIKey: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScopedapikey
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthScopedapikey
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthScopedapikey
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Label", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScopedapikey
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthScopedapikey
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthScopedapikey
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Label = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType == 0 {
+				var v APIKeyScope
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowScopedapikey
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= APIKeyScope(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Scopes = append(m.Scopes, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowScopedapikey
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthScopedapikey
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthScopedapikey
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.Scopes) == 0 {
+					m.Scopes = make([]APIKeyScope, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v APIKeyScope
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowScopedapikey
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= APIKeyScope(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Scopes = append(m.Scopes, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Scopes", wireType)
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expires", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScopedapikey
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthScopedapikey
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthScopedapikey
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Expires, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipScopedapikey(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthScopedapikey
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipScopedapikey(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowScopedapikey
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowScopedapikey
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowScopedapikey
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthScopedapikey
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupScopedapikey
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthScopedapikey
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthScopedapikey        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowScopedapikey          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupScopedapikey = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,38 @@
+// Copyright (C) 2026 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package config
+
+func (t StorageType) String() string {
+	switch t {
+	case StorageTypeAuto:
+		return "auto"
+	case StorageTypeSSD:
+		return "ssd"
+	case StorageTypeHDD:
+		return "hdd"
+	default:
+		return "unknown"
+	}
+}
+
+func (t StorageType) MarshalText() ([]byte, error) {
+	return []byte(t.String()), nil
+}
+
+func (t *StorageType) UnmarshalText(bs []byte) error {
+	switch string(bs) {
+	case "auto":
+		*t = StorageTypeAuto
+	case "ssd":
+		*t = StorageTypeSSD
+	case "hdd":
+		*t = StorageTypeHDD
+	default:
+		*t = StorageTypeAuto
+	}
+	return nil
+}
@@ -0,0 +1,73 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: lib/config/storagetype.proto
+
+package config
+
+import (
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	_ "github.com/syncthing/syncthing/proto/ext"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type StorageType int32
+
+const (
+	StorageTypeAuto StorageType = 0
+	StorageTypeSSD  StorageType = 1
+	StorageTypeHDD  StorageType = 2
+)
+
+var StorageType_name = map[int32]string{
+	0: "STORAGE_TYPE_AUTO",
+	1: "STORAGE_TYPE_SSD",
+	2: "STORAGE_TYPE_HDD",
+}
+
+var StorageType_value = map[string]int32{
+	"STORAGE_TYPE_AUTO": 0,
+	"STORAGE_TYPE_SSD":  1,
+	"STORAGE_TYPE_HDD":  2,
+}
+
+func (StorageType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_410d1dd2d175ef49, []int{0}
+}
+
+func init() {
+	proto.RegisterEnum("config.StorageType", StorageType_name, StorageType_value)
+}
+
+func init() { proto.RegisterFile("lib/config/storagetype.proto", fileDescriptor_410d1dd2d175ef49) }
+
+var fileDescriptor_410d1dd2d175ef49 = []byte{
+	// 250 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0xc9, 0xc9, 0x4c, 0xd2,
+	0x4f, 0xce, 0xcf, 0x4b, 0xcb, 0x4c, 0xd7, 0x2f, 0x2e, 0xc9, 0x2f, 0x4a, 0x4c, 0x4f, 0x2d, 0xa9,
+	0x2c, 0x48, 0xd5, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x62, 0x83, 0xc8, 0x48, 0x29, 0x17, 0xa5,
+	0x16, 0xe4, 0x17, 0xeb, 0x83, 0x05, 0x93, 0x4a, 0xd3, 0xf4, 0xd3, 0xf3, 0xd3, 0xf3, 0xc1, 0x1c,
+	0x30, 0x0b, 0xa2, 0x58, 0x8a, 0x33, 0xb5, 0xa2, 0x04, 0xc2, 0xd4, 0xda, 0xce, 0xc8, 0xc5, 0x1d,
+	0x0c, 0x31, 0x2d, 0xa4, 0xb2, 0x20, 0x55, 0x48, 0x8b, 0x4b, 0x30, 0x38, 0xc4, 0x3f, 0xc8, 0xd1,
+	0xdd, 0x35, 0x3e, 0x24, 0x32, 0xc0, 0x35, 0xde, 0x31, 0x34, 0xc4, 0x5f, 0x80, 0x41, 0x4a, 0xb8,
+	0x6b, 0xae, 0x02, 0x3f, 0x92, 0x3a, 0xc7, 0xd2, 0x92, 0x7c, 0x21, 0x2b, 0x2e, 0x01, 0x14, 0xb5,
+	0xc1, 0xc1, 0x2e, 0x02, 0x8c, 0x52, 0x2a, 0x5d, 0x73, 0x15, 0xf8, 0x90, 0x94, 0x06, 0x07, 0xbb,
+	0x5c, 0xea, 0x53, 0x45, 0x13, 0xc1, 0xd0, 0xeb, 0xe1, 0xe2, 0x22, 0xc0, 0x84, 0xa1, 0xd7, 0xc3,
+	0x05, 0x5d, 0xaf, 0x87, 0x8b, 0x8b, 0x14, 0xcb, 0x8a, 0x25, 0x72, 0x0c, 0x4e, 0xde, 0x27, 0x1e,
+	0xca, 0x31, 0x5c, 0x78, 0x28, 0xc7, 0x70, 0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72, 0x8c, 0x13,
+	0x1e, 0xcb, 0x31, 0x2c, 0x78, 0x2c, 0xc7, 0x78, 0xe1, 0xb1, 0x1c, 0xc3, 0x8d, 0xc7, 0x72, 0x0c,
+	0x51, 0x9a, 0xe9, 0x99, 0x25, 0x19, 0xa5, 0x49, 0x7a, 0xc9, 0xf9, 0xb9, 0xfa, 0xc5, 0x95, 0x79,
+	0xc9, 0x25, 0x19, 0x99, 0x79, 0xe9, 0x48, 0x2c, 0x44, 0x80, 0x26, 0xb1, 0x81, 0x43, 0xc3, 0x18,
+	0x30, 0x00, 0x39, 0x0b, 0x60, 0x58, 0x65, 0x01, 0x00, 0x00,
+}
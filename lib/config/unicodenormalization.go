@@ -0,0 +1,42 @@
+// Copyright (C) 2026 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package config
+
+func (n UnicodeNormalization) String() string {
+	switch n {
+	case UnicodeNormalizationNative:
+		return "native"
+	case UnicodeNormalizationNfc:
+		return "nfc"
+	case UnicodeNormalizationNfd:
+		return "nfd"
+	case UnicodeNormalizationPreserve:
+		return "preserve"
+	default:
+		return "unknown"
+	}
+}
+
+func (n UnicodeNormalization) MarshalText() ([]byte, error) {
+	return []byte(n.String()), nil
+}
+
+func (n *UnicodeNormalization) UnmarshalText(bs []byte) error {
+	switch string(bs) {
+	case "native":
+		*n = UnicodeNormalizationNative
+	case "nfc":
+		*n = UnicodeNormalizationNfc
+	case "nfd":
+		*n = UnicodeNormalizationNfd
+	case "preserve":
+		*n = UnicodeNormalizationPreserve
+	default:
+		*n = UnicodeNormalizationNative
+	}
+	return nil
+}
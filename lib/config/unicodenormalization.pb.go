@@ -0,0 +1,79 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: lib/config/unicodenormalization.proto
+
+package config
+
+import (
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type UnicodeNormalization int32
+
+const (
+	UnicodeNormalizationNative   UnicodeNormalization = 0
+	UnicodeNormalizationNfc      UnicodeNormalization = 1
+	UnicodeNormalizationNfd      UnicodeNormalization = 2
+	UnicodeNormalizationPreserve UnicodeNormalization = 3
+)
+
+var UnicodeNormalization_name = map[int32]string{
+	0: "UNICODE_NORMALIZATION_NATIVE",
+	1: "UNICODE_NORMALIZATION_NFC",
+	2: "UNICODE_NORMALIZATION_NFD",
+	3: "UNICODE_NORMALIZATION_PRESERVE",
+}
+
+var UnicodeNormalization_value = map[string]int32{
+	"UNICODE_NORMALIZATION_NATIVE":   0,
+	"UNICODE_NORMALIZATION_NFC":      1,
+	"UNICODE_NORMALIZATION_NFD":      2,
+	"UNICODE_NORMALIZATION_PRESERVE": 3,
+}
+
+func (UnicodeNormalization) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_79f58d86d89e65ab, []int{0}
+}
+
+func init() {
+	proto.RegisterEnum("config.UnicodeNormalization", UnicodeNormalization_name, UnicodeNormalization_value)
+}
+
+func init() {
+	proto.RegisterFile("lib/config/unicodenormalization.proto", fileDescriptor_79f58d86d89e65ab)
+}
+
+var fileDescriptor_79f58d86d89e65ab = []byte{
+	// 283 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0xcd, 0xc9, 0x4c, 0xd2,
+	0x4f, 0xce, 0xcf, 0x4b, 0xcb, 0x4c, 0xd7, 0x2f, 0xcd, 0xcb, 0x4c, 0xce, 0x4f, 0x49, 0xcd, 0xcb,
+	0x2f, 0xca, 0x4d, 0xcc, 0xc9, 0xac, 0x4a, 0x2c, 0xc9, 0xcc, 0xcf, 0xd3, 0x2b, 0x28, 0xca, 0x2f,
+	0xc9, 0x17, 0x62, 0x83, 0x28, 0x91, 0x52, 0x2e, 0x4a, 0x2d, 0xc8, 0x2f, 0xd6, 0x07, 0x0b, 0x26,
+	0x95, 0xa6, 0xe9, 0xa7, 0xe7, 0xa7, 0xe7, 0x83, 0x39, 0x60, 0x16, 0x44, 0xb1, 0xd6, 0x1c, 0x26,
+	0x2e, 0x91, 0x50, 0x88, 0x59, 0x7e, 0xc8, 0x66, 0x09, 0x39, 0x70, 0xc9, 0x84, 0xfa, 0x79, 0x3a,
+	0xfb, 0xbb, 0xb8, 0xc6, 0xfb, 0xf9, 0x07, 0xf9, 0x3a, 0xfa, 0x78, 0x46, 0x39, 0x86, 0x78, 0xfa,
+	0xfb, 0xc5, 0xfb, 0x39, 0x86, 0x78, 0x86, 0xb9, 0x0a, 0x30, 0x48, 0xc9, 0x75, 0xcd, 0x55, 0x90,
+	0xc2, 0xa6, 0xd7, 0x2f, 0xb1, 0x24, 0xb3, 0x2c, 0x55, 0xc8, 0x8a, 0x4b, 0x12, 0x87, 0x09, 0x6e,
+	0xce, 0x02, 0x8c, 0x52, 0xd2, 0x5d, 0x73, 0x15, 0xc4, 0xb1, 0x6a, 0x4f, 0x4b, 0xc6, 0xa7, 0xd7,
+	0x45, 0x80, 0x09, 0x9f, 0xde, 0x14, 0x21, 0x17, 0x2e, 0x39, 0xec, 0x7a, 0x03, 0x82, 0x5c, 0x83,
+	0x5d, 0x83, 0xc2, 0x5c, 0x05, 0x98, 0xa5, 0x14, 0xba, 0xe6, 0x2a, 0xc8, 0x60, 0x33, 0x20, 0xa0,
+	0x28, 0xb5, 0x38, 0xb5, 0xa8, 0x2c, 0x55, 0x8a, 0x65, 0xc5, 0x12, 0x39, 0x06, 0x27, 0xef, 0x13,
+	0x0f, 0xe5, 0x18, 0x2e, 0x3c, 0x94, 0x63, 0x38, 0xf1, 0x48, 0x8e, 0xf1, 0xc2, 0x23, 0x39, 0xc6,
+	0x09, 0x8f, 0xe5, 0x18, 0x16, 0x3c, 0x96, 0x63, 0xbc, 0xf0, 0x58, 0x8e, 0xe1, 0xc6, 0x63, 0x39,
+	0x86, 0x28, 0xcd, 0xf4, 0xcc, 0x92, 0x8c, 0xd2, 0x24, 0xbd, 0xe4, 0xfc, 0x5c, 0xfd, 0xe2, 0xca,
+	0xbc, 0xe4, 0x92, 0x8c, 0xcc, 0xbc, 0x74, 0x24, 0x16, 0x22, 0xce, 0x92, 0xd8, 0xc0, 0x41, 0x6e,
+	0x0c, 0x18, 0x00, 0x3b, 0xaa, 0x47, 0x9b, 0xc8, 0x01, 0x00, 0x00,
+}
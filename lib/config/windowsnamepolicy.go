@@ -0,0 +1,38 @@
+// Copyright (C) 2026 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package config
+
+func (p WindowsNamePolicy) String() string {
+	switch p {
+	case WindowsNamePolicySkip:
+		return "skip"
+	case WindowsNamePolicyEscape:
+		return "escape"
+	case WindowsNamePolicyMap:
+		return "map"
+	default:
+		return "unknown"
+	}
+}
+
+func (p WindowsNamePolicy) MarshalText() ([]byte, error) {
+	return []byte(p.String()), nil
+}
+
+func (p *WindowsNamePolicy) UnmarshalText(bs []byte) error {
+	switch string(bs) {
+	case "skip":
+		*p = WindowsNamePolicySkip
+	case "escape":
+		*p = WindowsNamePolicyEscape
+	case "map":
+		*p = WindowsNamePolicyMap
+	default:
+		*p = WindowsNamePolicySkip
+	}
+	return nil
+}
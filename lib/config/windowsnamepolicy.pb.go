@@ -0,0 +1,75 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: lib/config/windowsnamepolicy.proto
+
+package config
+
+import (
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type WindowsNamePolicy int32
+
+const (
+	WindowsNamePolicySkip   WindowsNamePolicy = 0
+	WindowsNamePolicyEscape WindowsNamePolicy = 1
+	WindowsNamePolicyMap    WindowsNamePolicy = 2
+)
+
+var WindowsNamePolicy_name = map[int32]string{
+	0: "WINDOWS_NAME_POLICY_SKIP",
+	1: "WINDOWS_NAME_POLICY_ESCAPE",
+	2: "WINDOWS_NAME_POLICY_MAP",
+}
+
+var WindowsNamePolicy_value = map[string]int32{
+	"WINDOWS_NAME_POLICY_SKIP":   0,
+	"WINDOWS_NAME_POLICY_ESCAPE": 1,
+	"WINDOWS_NAME_POLICY_MAP":    2,
+}
+
+func (WindowsNamePolicy) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_ef8216c77e1dac62, []int{0}
+}
+
+func init() {
+	proto.RegisterEnum("config.WindowsNamePolicy", WindowsNamePolicy_name, WindowsNamePolicy_value)
+}
+
+func init() {
+	proto.RegisterFile("lib/config/windowsnamepolicy.proto", fileDescriptor_ef8216c77e1dac62)
+}
+
+var fileDescriptor_ef8216c77e1dac62 = []byte{
+	// 267 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0xca, 0xc9, 0x4c, 0xd2,
+	0x4f, 0xce, 0xcf, 0x4b, 0xcb, 0x4c, 0xd7, 0x2f, 0xcf, 0xcc, 0x4b, 0xc9, 0x2f, 0x2f, 0xce, 0x4b,
+	0xcc, 0x4d, 0x2d, 0xc8, 0xcf, 0xc9, 0x4c, 0xae, 0xd4, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x62,
+	0x83, 0xc8, 0x4b, 0x29, 0x17, 0xa5, 0x16, 0xe4, 0x17, 0xeb, 0x83, 0x05, 0x93, 0x4a, 0xd3, 0xf4,
+	0xd3, 0xf3, 0xd3, 0xf3, 0xc1, 0x1c, 0x30, 0x0b, 0xa2, 0x58, 0xeb, 0x18, 0x23, 0x97, 0x60, 0x38,
+	0xc4, 0x20, 0xbf, 0xc4, 0xdc, 0xd4, 0x00, 0xb0, 0x41, 0x42, 0xe6, 0x5c, 0x12, 0xe1, 0x9e, 0x7e,
+	0x2e, 0xfe, 0xe1, 0xc1, 0xf1, 0x7e, 0x8e, 0xbe, 0xae, 0xf1, 0x01, 0xfe, 0x3e, 0x9e, 0xce, 0x91,
+	0xf1, 0xc1, 0xde, 0x9e, 0x01, 0x02, 0x0c, 0x52, 0x92, 0x5d, 0x73, 0x15, 0x44, 0x31, 0x34, 0x05,
+	0x67, 0x67, 0x16, 0x08, 0x59, 0x73, 0x49, 0x61, 0xd3, 0xe8, 0x1a, 0xec, 0xec, 0x18, 0xe0, 0x2a,
+	0xc0, 0x28, 0x25, 0xdd, 0x35, 0x57, 0x41, 0x1c, 0x43, 0xab, 0x6b, 0x71, 0x72, 0x62, 0x41, 0xaa,
+	0x90, 0x29, 0x97, 0x38, 0x36, 0xcd, 0xbe, 0x8e, 0x01, 0x02, 0x4c, 0x52, 0x12, 0x5d, 0x73, 0x15,
+	0x44, 0x30, 0x74, 0xfa, 0x26, 0x16, 0x48, 0xb1, 0xac, 0x58, 0x22, 0xc7, 0xe0, 0xe4, 0x7d, 0xe2,
+	0xa1, 0x1c, 0xc3, 0x85, 0x87, 0x72, 0x0c, 0x27, 0x1e, 0xc9, 0x31, 0x5e, 0x78, 0x24, 0xc7, 0x38,
+	0xe1, 0xb1, 0x1c, 0xc3, 0x82, 0xc7, 0x72, 0x8c, 0x17, 0x1e, 0xcb, 0x31, 0xdc, 0x78, 0x2c, 0xc7,
+	0x10, 0xa5, 0x99, 0x9e, 0x59, 0x92, 0x51, 0x9a, 0xa4, 0x97, 0x9c, 0x9f, 0xab, 0x5f, 0x5c, 0x99,
+	0x97, 0x5c, 0x92, 0x91, 0x99, 0x97, 0x8e, 0xc4, 0x42, 0x04, 0x6d, 0x12, 0x1b, 0x38, 0x70, 0x8c,
+	0x01, 0x03, 0x00, 0x2b, 0x69, 0xd3, 0x1c, 0x6f, 0x01, 0x00, 0x00,
+}
@@ -158,6 +158,7 @@ func TestGetDialer(t *testing.T) {
 		{mustParseURI("tcp4://1.2.3.4:5678"), true, false, false},  // ok
 		{mustParseURI("kcp://1.2.3.4:5678"), false, false, true},   // deprecated
 		{mustParseURI("relay://1.2.3.4:5678"), false, true, false}, // disabled
+		{mustParseURI("obfs://1.2.3.4:5678"), false, true, false},  // disabled
 		{mustParseURI("http://1.2.3.4:5678"), false, false, false}, // generally bad
 		{mustParseURI("bananas!"), false, false, false},            // wat
 	}
@@ -232,6 +233,31 @@ func TestConnectionStatus(t *testing.T) {
 	check(nil, nil)
 }
 
+func TestMeasureClockOffset(t *testing.T) {
+	sec := int64(time.Second)
+	cases := []struct {
+		sent, remote, received int64
+		exp                    time.Duration
+	}{
+		// The remote timestamp is halfway through the exchange.
+		{100 * sec, 101 * sec, 102 * sec, 0},
+		// Ahead or behind by more than the uncertainty.
+		{100 * sec, 161 * sec, 102 * sec, time.Minute},
+		{100 * sec, 41 * sec, 102 * sec, -time.Minute},
+		// Within the uncertainty of a slow exchange.
+		{100 * sec, 105 * sec, 110 * sec, 0},
+		{100 * sec, 109 * sec, 110 * sec, 0},
+		// No timestamp from the remote, or our clock jumped back.
+		{100 * sec, 0, 102 * sec, 0},
+		{100 * sec, 161 * sec, 99 * sec, 0},
+	}
+	for _, tc := range cases {
+		if res := measureClockOffset(tc.sent, tc.remote, tc.received); res != tc.exp {
+			t.Errorf("measureClockOffset(%d, %d, %d) == %v, expected %v", tc.sent, tc.remote, tc.received, res, tc.exp)
+		}
+	}
+}
+
 func TestNextDialRegistryCleanup(t *testing.T) {
 	now := time.Now()
 	firsts := []time.Time{
@@ -301,6 +327,7 @@ func BenchmarkConnections(b *testing.B) {
 	addrs := []string{
 		"tcp://127.0.0.1:0",
 		"quic://127.0.0.1:0",
+		"wss://127.0.0.1:0",
 		"relay://127.0.0.1:22067",
 	}
 	sizes := []int{
@@ -370,10 +397,46 @@ func BenchmarkConnections(b *testing.B) {
 	}
 }
 
+func TestDialMoreConnections(t *testing.T) {
+	now := time.Now()
+	r := make(nextDialRegistry)
+	r.set(protocol.LocalDeviceID, "tcp://192.0.2.1:22000", now.Add(time.Hour))
+
+	// Forced redials cool down, dials for further connections don't.
+	for i := 0; i < dialCoolDownMaxAttempts; i++ {
+		r.redialDevice(protocol.LocalDeviceID, now)
+	}
+	r.set(protocol.LocalDeviceID, "tcp://192.0.2.1:22000", now.Add(time.Hour))
+	r.redialDevice(protocol.LocalDeviceID, now)
+	if next := r.get(protocol.LocalDeviceID, "tcp://192.0.2.1:22000"); next.IsZero() {
+		t.Fatal("expected the forced redial to be cooling down")
+	}
+	r.redialForMoreConnections(protocol.LocalDeviceID)
+	if next := r.get(protocol.LocalDeviceID, "tcp://192.0.2.1:22000"); !next.IsZero() {
+		t.Errorf("expected an immediate dial for more connections, got %v", next)
+	}
+
+	// Raising the number of connections to a device dials it.
+	s := &service{
+		dialNow:           make(chan struct{}, 1),
+		dialNowDevices:    make(map[protocol.DeviceID]struct{}),
+		dialMoreDevices:   make(map[protocol.DeviceID]struct{}),
+		dialNowDevicesMut: sync.NewMutex(),
+	}
+	from := config.Configuration{Devices: []config.DeviceConfiguration{{DeviceID: protocol.LocalDeviceID}}}
+	to := from.Copy()
+	to.Devices[0].RawNumConnections = 4
+	s.checkAndSignalConnectLoopOnUpdatedDevices(from, to)
+	if _, ok := s.dialMoreDevices[protocol.LocalDeviceID]; !ok || len(s.dialNow) != 1 {
+		t.Error("expected a dial for more connections after raising their number")
+	}
+}
+
 func TestConnectionEstablishment(t *testing.T) {
 	addrs := []string{
 		"tcp://127.0.0.1:0",
 		"quic://127.0.0.1:0",
+		"wss://127.0.0.1:0/bep",
 	}
 
 	send := make([]byte, 128<<10)
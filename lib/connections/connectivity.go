@@ -0,0 +1,164 @@
+// Copyright (C) 2026 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package connections
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/syncthing/syncthing/lib/discover"
+	"github.com/syncthing/syncthing/lib/relay/client"
+	"github.com/syncthing/syncthing/lib/stun"
+)
+
+// The time each connectivity check gets before it fails.
+const connectivityCheckTimeout = 10 * time.Second
+
+// The kinds of connectivity checks, in the order they are reported.
+const (
+	ConnectivityListener  = "listener"
+	ConnectivityDiscovery = "discovery"
+	ConnectivityRelay     = "relay"
+	ConnectivitySTUN      = "stun"
+)
+
+var connectivityKindOrder = map[string]int{
+	ConnectivityListener:  0,
+	ConnectivityDiscovery: 1,
+	ConnectivityRelay:     2,
+	ConnectivitySTUN:      3,
+}
+
+// ConnectivityCheck is the outcome of checking one listener or server.
+type ConnectivityCheck struct {
+	Kind     string  `json:"kind"`
+	Target   string  `json:"target"`
+	OK       bool    `json:"ok"`
+	LatencyS float64 `json:"latencyS"`
+	Details  string  `json:"details,omitempty"`
+	Error    string  `json:"error,omitempty"`
+}
+
+// ConnectivityReport is the outcome of checking all the configured
+// listeners, global discovery servers, relays and STUN servers.
+type ConnectivityReport struct {
+	When   time.Time           `json:"when"`
+	OK     bool                `json:"ok"`
+	Checks []ConnectivityCheck `json:"checks"`
+}
+
+// CheckConnectivity checks the listeners and contacts each configured
+// global discovery server, relay and STUN server, to help tell why devices
+// can't connect. The servers are checked concurrently and independently of
+// the running services, so a check can fail while the service using the
+// server is fine, or the other way around.
+func (s *service) CheckConnectivity(ctx context.Context) ConnectivityReport {
+	report := ConnectivityReport{When: time.Now().Truncate(time.Second)}
+
+	for addr, status := range s.ListenerStatus() {
+		check := ConnectivityCheck{
+			Kind:    ConnectivityListener,
+			Target:  addr,
+			OK:      status.Error == nil,
+			Details: strings.Join(append(status.LANAddresses, status.WANAddresses...), " "),
+		}
+		if status.Error != nil {
+			check.Error = *status.Error
+		}
+		report.Checks = append(report.Checks, check)
+	}
+
+	opts := s.cfg.Options()
+	var checkFns []func(context.Context) ConnectivityCheck
+	if opts.GlobalAnnEnabled {
+		for _, srv := range opts.GlobalDiscoveryServers() {
+			checkFns = append(checkFns, func(ctx context.Context) ConnectivityCheck {
+				return runConnectivityCheck(ctx, ConnectivityDiscovery, srv, func(ctx context.Context) (string, error) {
+					return "", discover.CheckGlobal(ctx, srv, s.myID)
+				})
+			})
+		}
+	}
+	if opts.RelaysEnabled {
+		for _, addr := range opts.ListenAddresses() {
+			uri, err := url.Parse(addr)
+			if err != nil || (uri.Scheme != "relay" && !strings.HasPrefix(uri.Scheme, "dynamic+")) {
+				continue
+			}
+			checkFns = append(checkFns, func(ctx context.Context) ConnectivityCheck {
+				return runConnectivityCheck(ctx, ConnectivityRelay, addr, func(ctx context.Context) (string, error) {
+					return client.CheckRelay(ctx, uri, s.tlsCfg.Certificates, connectivityCheckTimeout)
+				})
+			})
+		}
+	}
+	if !opts.IsStunDisabled() {
+		for _, addr := range opts.StunServers() {
+			checkFns = append(checkFns, func(ctx context.Context) ConnectivityCheck {
+				return runConnectivityCheck(ctx, ConnectivitySTUN, addr, func(ctx context.Context) (string, error) {
+					host, err := stun.Check(ctx, addr)
+					if err != nil {
+						return "", err
+					}
+					return host.TransportAddr(), nil
+				})
+			})
+		}
+	}
+
+	results := make([]ConnectivityCheck, len(checkFns))
+	var wg sync.WaitGroup
+	for i, fn := range checkFns {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			results[i] = fn(ctx)
+		}()
+	}
+	wg.Wait()
+	report.Checks = append(report.Checks, results...)
+
+	sort.Slice(report.Checks, func(a, b int) bool {
+		ca, cb := report.Checks[a], report.Checks[b]
+		if ca.Kind != cb.Kind {
+			return connectivityKindOrder[ca.Kind] < connectivityKindOrder[cb.Kind]
+		}
+		return ca.Target < cb.Target
+	})
+	report.OK = true
+	for _, check := range report.Checks {
+		report.OK = report.OK && check.OK
+	}
+	return report
+}
+
+// runConnectivityCheck times the check, giving up after
+// connectivityCheckTimeout.
+func runConnectivityCheck(ctx context.Context, kind, target string, fn func(context.Context) (string, error)) ConnectivityCheck {
+	ctx, cancel := context.WithTimeout(ctx, connectivityCheckTimeout)
+	defer cancel()
+
+	check := ConnectivityCheck{Kind: kind, Target: target}
+	t0 := time.Now()
+	details, err := fn(ctx)
+	check.LatencyS = time.Since(t0).Seconds()
+	if errors.Is(err, context.DeadlineExceeded) {
+		err = errors.New("timed out")
+	}
+	if err != nil {
+		check.Error = err.Error()
+	} else {
+		check.OK = true
+		check.Details = details
+	}
+	return check
+}
@@ -0,0 +1,50 @@
+// Copyright (C) 2026 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package connections
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestRunConnectivityCheck(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	check := runConnectivityCheck(ctx, ConnectivitySTUN, "stun.example.com:3478", func(context.Context) (string, error) {
+		return "192.0.2.42:22000", nil
+	})
+	if !check.OK || check.Details != "192.0.2.42:22000" || check.Error != "" {
+		t.Errorf("unexpected result for a passing check: %+v", check)
+	}
+	if check.Kind != ConnectivitySTUN || check.Target != "stun.example.com:3478" {
+		t.Errorf("kind or target not kept: %+v", check)
+	}
+
+	check = runConnectivityCheck(ctx, ConnectivityRelay, "relay://192.0.2.43:443", func(context.Context) (string, error) {
+		return "ignored", errors.New("connection refused")
+	})
+	if check.OK || check.Details != "" || check.Error != "connection refused" {
+		t.Errorf("unexpected result for a failing check: %+v", check)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+	defer cancel()
+	check = runConnectivityCheck(ctx, ConnectivityDiscovery, "https://discovery.example.com/", func(ctx context.Context) (string, error) {
+		<-ctx.Done()
+		return "", ctx.Err()
+	})
+	if check.OK || check.Error != "timed out" {
+		t.Errorf("unexpected result for a check timing out: %+v", check)
+	}
+	if check.LatencyS <= 0 {
+		t.Errorf("latency not measured: %+v", check)
+	}
+}
@@ -0,0 +1,209 @@
+// Copyright (C) 2026 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package connections
+
+import (
+	"context"
+	"net"
+	"net/url"
+	"slices"
+	"strings"
+	stdsync "sync"
+	"time"
+
+	"github.com/syncthing/syncthing/lib/config"
+	"github.com/syncthing/syncthing/lib/protocol"
+	"github.com/syncthing/syncthing/lib/semaphore"
+	"github.com/syncthing/syncthing/lib/stun"
+	"github.com/syncthing/syncthing/lib/sync"
+)
+
+// Devices we are connected to over relays only are dialed over QUIC at the
+// start of every slot. As both sides do this at the same time, given
+// reasonably synchronised clocks, the packets they send open the mappings
+// in their NATs for each other, and one of the handshakes gets through. The
+// relay connection tells both that the other is there, and the addresses
+// come from discovery, where they were put by STUN.
+const holePunchSlot = 2 * time.Minute
+
+// HolePunchStatus is the result of the hole punching attempts so far.
+type HolePunchStatus struct {
+	NATType     string    `json:"natType"`
+	Attempts    int       `json:"attempts"`
+	Successes   int       `json:"successes"`
+	SuccessRate float64   `json:"successRate"` // percent
+	LastSuccess time.Time `json:"lastSuccess"`
+}
+
+type holePunchStats struct {
+	mut         sync.Mutex
+	attempts    int
+	successes   int
+	lastSuccess time.Time
+}
+
+func newHolePunchStats() *holePunchStats {
+	return &holePunchStats{mut: sync.NewMutex()}
+}
+
+func (h *holePunchStats) record(success bool, now time.Time) {
+	h.mut.Lock()
+	defer h.mut.Unlock()
+	h.attempts++
+	if success {
+		h.successes++
+		h.lastSuccess = now
+	}
+}
+
+func (h *holePunchStats) status() HolePunchStatus {
+	h.mut.Lock()
+	defer h.mut.Unlock()
+	status := HolePunchStatus{
+		Attempts:    h.attempts,
+		Successes:   h.successes,
+		LastSuccess: h.lastSuccess,
+	}
+	if h.attempts > 0 {
+		status.SuccessRate = 100 * float64(h.successes) / float64(h.attempts)
+	}
+	return status
+}
+
+func (s *service) HolePunchStatus() HolePunchStatus {
+	status := s.holePunch.status()
+	status.NATType = s.NATType()
+	return status
+}
+
+// nextHolePunchSlot returns the start of the slot following now.
+func nextHolePunchSlot(now time.Time) time.Time {
+	return now.Truncate(holePunchSlot).Add(holePunchSlot)
+}
+
+func (s *service) punchHoles(ctx context.Context) error {
+	timer := time.NewTimer(time.Until(nextHolePunchSlot(time.Now())))
+	defer timer.Stop()
+	for {
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+		s.punchRound(ctx)
+		timer.Reset(time.Until(nextHolePunchSlot(time.Now())))
+	}
+}
+
+func (s *service) punchRound(ctx context.Context) {
+	if !s.holePunchable() {
+		return
+	}
+
+	cfg := s.cfg.RawCopy()
+	sema := semaphore.New(dialMaxParallel)
+	var wg stdsync.WaitGroup
+	for _, deviceID := range s.relayOnlyDevices() {
+		deviceCfg, _, ok := cfg.Device(deviceID)
+		if !ok || deviceCfg.Paused {
+			continue
+		}
+		targets := s.holePunchTargets(ctx, cfg, deviceCfg)
+		if len(targets) == 0 {
+			continue
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			s.punchDevice(ctx, deviceID, targets, sema)
+		}()
+	}
+	wg.Wait()
+}
+
+func (s *service) punchDevice(ctx context.Context, deviceID protocol.DeviceID, targets []dialTarget, sema *semaphore.Semaphore) {
+	l.Debugf("Trying to punch a hole to %s via %d addresses", deviceID.Short(), len(targets))
+	conn, ok := s.dialParallel(ctx, deviceID, targets, sema)
+	s.holePunch.record(ok, time.Now())
+	if !ok {
+		l.Debugf("Hole punching to %s failed", deviceID.Short())
+		return
+	}
+	l.Infof("Established direct connection to %s by hole punching (%s)", deviceID.Short(), conn.RemoteAddr())
+	select {
+	case s.conns <- conn:
+	case <-ctx.Done():
+		conn.Close()
+	}
+}
+
+// holePunchTargets returns the QUIC addresses of the device on the
+// internet, which are the ones that can be punched through to.
+func (s *service) holePunchTargets(ctx context.Context, cfg config.Configuration, deviceCfg config.DeviceConfiguration) []dialTarget {
+	var targets []dialTarget
+	for _, addr := range s.resolveDeviceAddrs(ctx, deviceCfg) {
+		uri, err := url.Parse(addr)
+		if err != nil || !strings.HasPrefix(uri.Scheme, "quic") {
+			continue
+		}
+		host, _, err := net.SplitHostPort(uri.Host)
+		if err != nil {
+			continue
+		}
+		if ip := net.ParseIP(host); ip == nil || !ip.IsGlobalUnicast() || ip.IsPrivate() {
+			continue
+		}
+		if len(deviceCfg.AllowedNetworks) > 0 && !IsAllowedNetwork(uri.Host, deviceCfg.AllowedNetworks) {
+			continue
+		}
+		dialerFactory, err := getDialerFactory(cfg, uri)
+		if err != nil {
+			continue
+		}
+		dialer := dialerFactory.New(cfg.Options, s.tlsCfg, s.registry, s.lanChecker)
+		targets = append(targets, dialTarget{
+			addr:     addr,
+			dialer:   dialer,
+			priority: dialer.Priority(uri.Host),
+			deviceID: deviceCfg.DeviceID,
+			uri:      uri,
+		})
+	}
+	return targets
+}
+
+// holePunchable returns true if any of the QUIC listeners is behind a NAT
+// that keeps the same external port for all destinations, which is what
+// makes hole punching work.
+func (s *service) holePunchable() bool {
+	s.listenersMut.RLock()
+	defer s.listenersMut.RUnlock()
+	for _, listener := range s.listeners {
+		if nl, ok := listener.(interface{ natType() stun.NATType }); ok && stun.IsPunchable(nl.natType()) {
+			return true
+		}
+	}
+	return false
+}
+
+func isDirect(conn protocol.Connection) bool {
+	t := conn.Type()
+	return t != connTypeRelayClient.String() && t != connTypeRelayServer.String()
+}
+
+// relayOnlyDevices returns the devices connected to over relays only.
+func (c *deviceConnectionTracker) relayOnlyDevices() []protocol.DeviceID {
+	c.connectionsMut.Lock()
+	defer c.connectionsMut.Unlock()
+	var res []protocol.DeviceID
+	for d, conns := range c.connections {
+		if !slices.ContainsFunc(conns, isDirect) {
+			res = append(res, d)
+		}
+	}
+	return res
+}
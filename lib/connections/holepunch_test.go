@@ -0,0 +1,72 @@
+// Copyright (C) 2026 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package connections
+
+import (
+	"testing"
+	"time"
+
+	"github.com/syncthing/syncthing/lib/protocol"
+	protocolmocks "github.com/syncthing/syncthing/lib/protocol/mocks"
+)
+
+func TestNextHolePunchSlot(t *testing.T) {
+	base := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	cases := []struct {
+		now, next time.Time
+	}{
+		{base, base.Add(holePunchSlot)},
+		{base.Add(time.Second), base.Add(holePunchSlot)},
+		{base.Add(holePunchSlot - time.Millisecond), base.Add(holePunchSlot)},
+		{base.Add(holePunchSlot), base.Add(2 * holePunchSlot)},
+	}
+	for _, tc := range cases {
+		if next := nextHolePunchSlot(tc.now); !next.Equal(tc.next) {
+			t.Errorf("next slot after %v: got %v, expected %v", tc.now, next, tc.next)
+		}
+	}
+}
+
+func TestHolePunchStats(t *testing.T) {
+	h := newHolePunchStats()
+	if status := h.status(); status.Attempts != 0 || status.SuccessRate != 0 {
+		t.Errorf("unexpected initial status %+v", status)
+	}
+	now := time.Now()
+	h.record(false, now)
+	h.record(true, now)
+	h.record(false, now)
+	h.record(true, now)
+	status := h.status()
+	if status.Attempts != 4 || status.Successes != 2 || status.SuccessRate != 50 || !status.LastSuccess.Equal(now) {
+		t.Errorf("unexpected status %+v", status)
+	}
+}
+
+func TestRelayOnlyDevices(t *testing.T) {
+	newConn := func(id protocol.DeviceID, typ connType) protocol.Connection {
+		c := &protocolmocks.Connection{}
+		c.DeviceIDReturns(id)
+		c.TypeReturns(typ.String())
+		c.ConnectionIDReturns(id.String() + typ.String())
+		return c
+	}
+	relayed := protocol.DeviceID{1}
+	mixed := protocol.DeviceID{2}
+	direct := protocol.DeviceID{3}
+
+	var c deviceConnectionTracker
+	c.accountAddedConnection(newConn(relayed, connTypeRelayClient), protocol.Hello{}, 0)
+	c.accountAddedConnection(newConn(mixed, connTypeRelayServer), protocol.Hello{}, 0)
+	c.accountAddedConnection(newConn(mixed, connTypeTCPClient), protocol.Hello{}, 0)
+	c.accountAddedConnection(newConn(direct, connTypeQUICServer), protocol.Hello{}, 0)
+
+	devices := c.relayOnlyDevices()
+	if len(devices) != 1 || devices[0] != relayed {
+		t.Errorf("expected only %v, got %v", relayed, devices)
+	}
+}
@@ -0,0 +1,252 @@
+// Copyright (C) 2026 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package connections
+
+import (
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"slices"
+	"time"
+
+	"github.com/syncthing/syncthing/lib/db"
+	"github.com/syncthing/syncthing/lib/protocol"
+	"github.com/syncthing/syncthing/lib/sync"
+)
+
+// The identity log keeps at most this many entries, dropping the oldest.
+const maxIdentityLogEntries = 10000
+
+var errIdentityLogBroken = errors.New("identity log hash chain is broken")
+
+// IdentityLogEntry is an observation of a device with a given certificate,
+// name, client and address, the first time it was made. Like in a
+// certificate transparency log, each entry includes the hash of the
+// previous one, so that the log can't be changed after the fact without
+// that showing.
+type IdentityLogEntry struct {
+	Seq           int64             `json:"seq"`
+	DeviceID      protocol.DeviceID `json:"deviceID"`
+	CertSHA256    string            `json:"certSHA256"`
+	CertNotAfter  time.Time         `json:"certNotAfter"`
+	DeviceName    string            `json:"deviceName"`
+	ClientName    string            `json:"clientName"`
+	ClientVersion string            `json:"clientVersion"`
+	Address       string            `json:"address"`
+	FirstSeen     time.Time         `json:"firstSeen"`
+	PrevHash      string            `json:"prevHash"`
+	Hash          string            `json:"hash"`
+}
+
+// observation identifies what the entry is an observation of, regardless
+// of when it was made.
+func (e IdentityLogEntry) observation() string {
+	return fmt.Sprintf("%s\x00%s\x00%s\x00%s\x00%s\x00%s", e.DeviceID, e.CertSHA256, e.DeviceName, e.ClientName, e.ClientVersion, e.Address)
+}
+
+func (e IdentityLogEntry) computeHash() string {
+	e.Hash = ""
+	bs, _ := json.Marshal(e) // can't fail
+	sum := sha256.Sum256(bs)
+	return hex.EncodeToString(sum[:])
+}
+
+// identityLog is the append-only log of the identities connected devices
+// have presented, kept in the database.
+type identityLog struct {
+	store   *db.NamespacedKV
+	timeNow func() time.Time // can be overridden for testing
+
+	mut     sync.Mutex
+	entries []IdentityLogEntry
+	seen    map[string]struct{}
+}
+
+func newIdentityLog(store *db.NamespacedKV) *identityLog {
+	il := &identityLog{
+		store:   store,
+		timeNow: time.Now,
+		mut:     sync.NewMutex(),
+		seen:    make(map[string]struct{}),
+	}
+	err := store.Each(func(key string, val []byte) error {
+		var e IdentityLogEntry
+		if err := json.Unmarshal(val, &e); err != nil {
+			l.Debugf("load identity log entry %s: %v", key, err)
+			return nil
+		}
+		il.entries = append(il.entries, e)
+		il.seen[e.observation()] = struct{}{}
+		return nil
+	})
+	if err != nil {
+		l.Debugln("load identity log:", err)
+	}
+	if err := VerifyIdentityLog(il.entries); err != nil {
+		// Keep it anyway, it's evidence, but tell the user.
+		l.Warnln("Loading the device identity log:", err)
+	}
+	return il
+}
+
+// observe adds an entry for the device, unless it has been seen with the
+// same identity from the same address before.
+func (il *identityLog) observe(device protocol.DeviceID, cert *x509.Certificate, hello protocol.Hello, addr net.Addr) {
+	sum := sha256.Sum256(cert.Raw)
+	e := IdentityLogEntry{
+		DeviceID:      device,
+		CertSHA256:    hex.EncodeToString(sum[:]),
+		CertNotAfter:  cert.NotAfter.UTC(),
+		DeviceName:    hello.DeviceName,
+		ClientName:    hello.ClientName,
+		ClientVersion: hello.ClientVersion,
+		Address:       addr.String(),
+	}
+	// The port changes with every outgoing connection.
+	if host, _, err := net.SplitHostPort(e.Address); err == nil {
+		e.Address = host
+	}
+
+	il.mut.Lock()
+	defer il.mut.Unlock()
+
+	obs := e.observation()
+	if _, ok := il.seen[obs]; ok {
+		return
+	}
+	il.seen[obs] = struct{}{}
+
+	if n := len(il.entries); n > 0 {
+		e.Seq = il.entries[n-1].Seq + 1
+		e.PrevHash = il.entries[n-1].Hash
+	}
+	e.FirstSeen = il.timeNow().UTC().Truncate(time.Second)
+	e.Hash = e.computeHash()
+	il.entries = append(il.entries, e)
+
+	bs, _ := json.Marshal(e) // can't fail
+	if err := il.store.PutBytes(identityLogKey(e.Seq), bs); err != nil {
+		l.Debugln("save identity log entry:", err)
+	}
+
+	if len(il.entries) > maxIdentityLogEntries {
+		drop := il.entries[0]
+		il.entries = il.entries[1:]
+		delete(il.seen, drop.observation())
+		if err := il.store.Delete(identityLogKey(drop.Seq)); err != nil {
+			l.Debugln("delete identity log entry:", err)
+		}
+	}
+}
+
+// list returns the entries for the given device, or all entries for the
+// empty device ID, oldest first.
+func (il *identityLog) list(device protocol.DeviceID) []IdentityLogEntry {
+	il.mut.Lock()
+	defer il.mut.Unlock()
+
+	if device == protocol.EmptyDeviceID {
+		return slices.Clone(il.entries)
+	}
+	var res []IdentityLogEntry
+	for _, e := range il.entries {
+		if e.DeviceID == device {
+			res = append(res, e)
+		}
+	}
+	return res
+}
+
+func identityLogKey(seq int64) string {
+	return fmt.Sprintf("%016x", seq)
+}
+
+// VerifyIdentityLog checks the hash chain of a complete identity log, as
+// exported by a device. The oldest entries may have been dropped, so the
+// chain is verified from the first entry given.
+func VerifyIdentityLog(entries []IdentityLogEntry) error {
+	for i, e := range entries {
+		if e.Hash != e.computeHash() {
+			return fmt.Errorf("%w: entry %d doesn't match its hash", errIdentityLogBroken, e.Seq)
+		}
+		if i == 0 {
+			continue
+		}
+		if prev := entries[i-1]; e.Seq != prev.Seq+1 || e.PrevHash != prev.Hash {
+			return fmt.Errorf("%w: entry %d doesn't follow entry %d", errIdentityLogBroken, e.Seq, prev.Seq)
+		}
+	}
+	return nil
+}
+
+// IdentityDiscrepancy is a device seen with more than one value for what
+// should be stable for a device, which suggests a cloned or misconfigured
+// identity.
+type IdentityDiscrepancy struct {
+	DeviceID protocol.DeviceID `json:"deviceID"`
+	Field    string            `json:"field"`
+	Local    []string          `json:"local"`
+	Remote   []string          `json:"remote"`
+}
+
+// CompareIdentityLogs returns the discrepancies between the identities
+// devices were seen with in two logs, typically our own and one exported
+// by another device, as well as within each of them. The addresses and
+// client versions of a device are expected to change and aren't compared.
+func CompareIdentityLogs(local, remote []IdentityLogEntry) []IdentityDiscrepancy {
+	fields := []struct {
+		name  string
+		value func(IdentityLogEntry) string
+	}{
+		{"certSHA256", func(e IdentityLogEntry) string { return e.CertSHA256 }},
+		{"deviceName", func(e IdentityLogEntry) string { return e.DeviceName }},
+		{"clientName", func(e IdentityLogEntry) string { return e.ClientName }},
+	}
+
+	var devices []protocol.DeviceID
+	for _, e := range slices.Concat(local, remote) {
+		if !slices.Contains(devices, e.DeviceID) {
+			devices = append(devices, e.DeviceID)
+		}
+	}
+	slices.SortFunc(devices, func(a, b protocol.DeviceID) int {
+		return a.Compare(b)
+	})
+
+	var res []IdentityDiscrepancy
+	for _, device := range devices {
+		for _, field := range fields {
+			if len(identityValues(slices.Concat(local, remote), device, field.value)) < 2 {
+				continue
+			}
+			res = append(res, IdentityDiscrepancy{
+				DeviceID: device,
+				Field:    field.name,
+				Local:    identityValues(local, device, field.value),
+				Remote:   identityValues(remote, device, field.value),
+			})
+		}
+	}
+	return res
+}
+
+// identityValues returns the distinct values of the field in the device's
+// entries, sorted.
+func identityValues(entries []IdentityLogEntry, device protocol.DeviceID, value func(IdentityLogEntry) string) []string {
+	values := []string{}
+	for _, e := range entries {
+		if e.DeviceID == device && !slices.Contains(values, value(e)) {
+			values = append(values, value(e))
+		}
+	}
+	slices.Sort(values)
+	return values
+}
@@ -0,0 +1,129 @@
+// Copyright (C) 2026 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package connections
+
+import (
+	"crypto/x509"
+	"errors"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/syncthing/syncthing/lib/db"
+	"github.com/syncthing/syncthing/lib/db/backend"
+	"github.com/syncthing/syncthing/lib/events"
+	"github.com/syncthing/syncthing/lib/protocol"
+	"github.com/syncthing/syncthing/lib/tlsutil"
+)
+
+func TestIdentityLog(t *testing.T) {
+	t.Parallel()
+
+	ldb, err := db.NewLowlevel(backend.OpenMemory(), events.NoopLogger)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ldb.Close()
+	store := db.NewIdentityLogNamespace(ldb)
+
+	tlsCert, err := tlsutil.NewCertificateInMemory("syncthing", 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, err := x509.ParseCertificate(tlsCert.Certificate[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	device := protocol.NewDeviceID(cert.Raw)
+	hello := protocol.Hello{DeviceName: "laptop", ClientName: "syncthing", ClientVersion: "v2.0.0"}
+	addr := func(s string) net.Addr {
+		a, _ := net.ResolveTCPAddr("tcp", s)
+		return a
+	}
+
+	il := newIdentityLog(store)
+	il.observe(device, cert, hello, addr("192.0.2.1:22000"))
+	il.observe(device, cert, hello, addr("192.0.2.1:51234")) // another port is the same address
+	il.observe(device, cert, hello, addr("192.0.2.2:22000"))
+	entries := il.list(device)
+	if len(entries) != 2 {
+		t.Fatalf("expected two entries, got %d", len(entries))
+	}
+	if entries[1].PrevHash != entries[0].Hash || entries[1].Seq != 1 {
+		t.Error("entries should be chained")
+	}
+	if len(il.list(protocol.EmptyDeviceID)) != 2 || len(il.list(protocol.LocalDeviceID)) != 0 {
+		t.Error("unexpected entries for other devices")
+	}
+
+	// The log survives a restart, and continues the chain.
+	il = newIdentityLog(store)
+	il.observe(device, cert, hello, addr("192.0.2.2:22000"))
+	il.observe(device, cert, protocol.Hello{DeviceName: "clone", ClientName: "syncthing", ClientVersion: "v2.0.0"}, addr("198.51.100.1:22000"))
+	entries = il.list(protocol.EmptyDeviceID)
+	if len(entries) != 3 {
+		t.Fatalf("expected three entries after restart, got %d", len(entries))
+	}
+	if err := VerifyIdentityLog(entries); err != nil {
+		t.Fatal(err)
+	}
+
+	// Changing an entry breaks the chain.
+	tampered := append([]IdentityLogEntry(nil), entries...)
+	tampered[1].Address = "203.0.113.1"
+	if err := VerifyIdentityLog(tampered); !errors.Is(err, errIdentityLogBroken) {
+		t.Error("a changed entry should break the chain, got", err)
+	}
+	tampered[1].Hash = tampered[1].computeHash()
+	if err := VerifyIdentityLog(tampered); !errors.Is(err, errIdentityLogBroken) {
+		t.Error("a rehashed entry should break the chain, got", err)
+	}
+	if err := VerifyIdentityLog(entries[1:]); err != nil {
+		t.Error("the chain should verify from any entry, got", err)
+	}
+
+	// The device was seen under two names, locally; compared with a log
+	// that only saw one name, that's still a discrepancy.
+	discrepancies := CompareIdentityLogs(entries, entries[:1])
+	if len(discrepancies) != 1 {
+		t.Fatalf("expected one discrepancy, got %v", discrepancies)
+	}
+	if d := discrepancies[0]; d.DeviceID != device || d.Field != "deviceName" || len(d.Local) != 2 || len(d.Remote) != 1 {
+		t.Errorf("unexpected discrepancy %+v", d)
+	}
+	if discrepancies := CompareIdentityLogs(entries[:2], entries[:1]); len(discrepancies) != 0 {
+		t.Errorf("unexpected discrepancies %v", discrepancies)
+	}
+}
+
+func TestIdentityLogLimit(t *testing.T) {
+	t.Parallel()
+
+	ldb, err := db.NewLowlevel(backend.OpenMemory(), events.NoopLogger)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ldb.Close()
+	store := db.NewIdentityLogNamespace(ldb)
+
+	il := newIdentityLog(store)
+	il.timeNow = func() time.Time { return time.Unix(1700000000, 0) }
+	cert := &x509.Certificate{Raw: []byte("cert")}
+	for i := 0; i < maxIdentityLogEntries+10; i++ {
+		il.observe(protocol.LocalDeviceID, cert, protocol.Hello{ClientVersion: string(rune('a' + i))}, &net.TCPAddr{})
+	}
+	entries := il.list(protocol.EmptyDeviceID)
+	if len(entries) != maxIdentityLogEntries || entries[0].Seq != 10 {
+		t.Fatalf("expected the last %d entries, got %d from %d", maxIdentityLogEntries, len(entries), entries[0].Seq)
+	}
+	if err := VerifyIdentityLog(entries); err != nil {
+		t.Fatal(err)
+	}
+	if reloaded := newIdentityLog(store).list(protocol.EmptyDeviceID); len(reloaded) != maxIdentityLogEntries {
+		t.Errorf("expected %d entries after reload, got %d", maxIdentityLogEntries, len(reloaded))
+	}
+}
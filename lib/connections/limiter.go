@@ -10,7 +10,9 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"slices"
 	"sync/atomic"
+	"time"
 
 	"github.com/syncthing/syncthing/lib/config"
 	"github.com/syncthing/syncthing/lib/protocol"
@@ -28,6 +30,15 @@ type limiter struct {
 	limitsLAN           atomic.Bool
 	deviceReadLimiters  map[protocol.DeviceID]*rate.Limiter
 	deviceWriteLimiters map[protocol.DeviceID]*rate.Limiter
+	maxSendKbps         int
+	maxRecvKbps         int
+	schedule            []scheduledLimits
+	activeSchedule      int // index into schedule, or -1
+}
+
+type scheduledLimits struct {
+	cron *cronSchedule
+	config.BandwidthScheduleEntry
 }
 
 type waiter interface {
@@ -48,6 +59,7 @@ func newLimiter(myId protocol.DeviceID, cfg config.Wrapper) *limiter {
 		mu:                  sync.NewMutex(),
 		deviceReadLimiters:  make(map[protocol.DeviceID]*rate.Limiter),
 		deviceWriteLimiters: make(map[protocol.DeviceID]*rate.Limiter),
+		activeSchedule:      -1,
 	}
 
 	cfg.Subscribe(l)
@@ -131,45 +143,126 @@ func (lim *limiter) CommitConfiguration(from, to config.Configuration) bool {
 
 	if from.Options.MaxRecvKbps == to.Options.MaxRecvKbps &&
 		from.Options.MaxSendKbps == to.Options.MaxSendKbps &&
-		from.Options.LimitBandwidthInLan == to.Options.LimitBandwidthInLan {
+		from.Options.LimitBandwidthInLan == to.Options.LimitBandwidthInLan &&
+		slices.Equal(from.Options.BandwidthSchedule, to.Options.BandwidthSchedule) {
 		return true
 	}
 
+	lim.limitsLAN.Store(to.Options.LimitBandwidthInLan)
+	lim.maxSendKbps = to.Options.MaxSendKbps
+	lim.maxRecvKbps = to.Options.MaxRecvKbps
+	lim.schedule = parseBandwidthSchedule(to.Options.BandwidthSchedule)
+	lim.activeSchedule = lim.scheduleActiveAtLocked(time.Now())
+	lim.applyLimitsLocked()
+
+	return true
+}
+
+func parseBandwidthSchedule(entries []config.BandwidthScheduleEntry) []scheduledLimits {
+	var schedule []scheduledLimits
+	for _, entry := range entries {
+		cron, err := parseCron(entry.Schedule)
+		if err != nil {
+			l.Warnln("Ignoring bandwidth schedule entry:", err)
+			continue
+		}
+		schedule = append(schedule, scheduledLimits{cron, entry})
+	}
+	return schedule
+}
+
+// How far back we look for the schedule entry in effect when the schedule
+// changes. Schedules are expected to repeat at least weekly.
+const bandwidthScheduleLookback = 7 * 24 * time.Hour
+
+// scheduleActiveAtLocked returns the index of the schedule entry that most
+// recently took effect at the given time, or -1 if none did.
+func (lim *limiter) scheduleActiveAtLocked(t time.Time) int {
+	if len(lim.schedule) == 0 {
+		return -1
+	}
+	t = t.Truncate(time.Minute)
+	for at := t; t.Sub(at) < bandwidthScheduleLookback; at = at.Add(-time.Minute) {
+		if idx := lim.scheduleMatchingLocked(at); idx >= 0 {
+			return idx
+		}
+	}
+	return -1
+}
+
+// scheduleMatchingLocked returns the index of the schedule entry that takes
+// effect in the minute of the given time, or -1. Later entries take
+// precedence over earlier ones.
+func (lim *limiter) scheduleMatchingLocked(t time.Time) int {
+	for i := len(lim.schedule) - 1; i >= 0; i-- {
+		if lim.schedule[i].cron.matches(t) {
+			return i
+		}
+	}
+	return -1
+}
+
+// serve switches rate limits as given by the bandwidth schedule.
+func (lim *limiter) serve(ctx context.Context) error {
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+	for {
+		select {
+		case now := <-timer.C:
+			lim.mu.Lock()
+			if idx := lim.scheduleMatchingLocked(now); idx >= 0 && idx != lim.activeSchedule {
+				lim.activeSchedule = idx
+				lim.applyLimitsLocked()
+			}
+			lim.mu.Unlock()
+			timer.Reset(now.Truncate(time.Minute).Add(time.Minute).Sub(time.Now()))
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// applyLimitsLocked sets the overall rate limits to the configured ones, or
+// the ones of the active schedule entry.
+func (lim *limiter) applyLimitsLocked() {
+	maxSendKbps, maxRecvKbps := lim.maxSendKbps, lim.maxRecvKbps
+	if lim.activeSchedule >= 0 {
+		entry := lim.schedule[lim.activeSchedule]
+		maxSendKbps, maxRecvKbps = entry.MaxSendKbps, entry.MaxRecvKbps
+		l.Infof("Bandwidth schedule %q in effect", entry.Schedule)
+	}
+
 	limited := false
 	sendLimitStr := "is unlimited"
 	recvLimitStr := "is unlimited"
 
 	// The rate variables are in KiB/s in the config (despite the camel casing
 	// of the name). We multiply by 1024 to get bytes/s.
-	if to.Options.MaxRecvKbps <= 0 {
+	if maxRecvKbps <= 0 {
 		lim.read.SetLimit(rate.Inf)
 	} else {
-		lim.read.SetLimit(1024 * rate.Limit(to.Options.MaxRecvKbps))
-		recvLimitStr = fmt.Sprintf("limit is %d KiB/s", to.Options.MaxRecvKbps)
+		lim.read.SetLimit(1024 * rate.Limit(maxRecvKbps))
+		recvLimitStr = fmt.Sprintf("limit is %d KiB/s", maxRecvKbps)
 		limited = true
 	}
 
-	if to.Options.MaxSendKbps <= 0 {
+	if maxSendKbps <= 0 {
 		lim.write.SetLimit(rate.Inf)
 	} else {
-		lim.write.SetLimit(1024 * rate.Limit(to.Options.MaxSendKbps))
-		sendLimitStr = fmt.Sprintf("limit is %d KiB/s", to.Options.MaxSendKbps)
+		lim.write.SetLimit(1024 * rate.Limit(maxSendKbps))
+		sendLimitStr = fmt.Sprintf("limit is %d KiB/s", maxSendKbps)
 		limited = true
 	}
 
-	lim.limitsLAN.Store(to.Options.LimitBandwidthInLan)
-
 	l.Infof("Overall send rate %s, receive rate %s", sendLimitStr, recvLimitStr)
 
 	if limited {
-		if to.Options.LimitBandwidthInLan {
+		if lim.limitsLAN.Load() {
 			l.Infoln("Rate limits apply to LAN connections")
 		} else {
 			l.Infoln("Rate limits do not apply to LAN connections")
 		}
 	}
-
-	return true
 }
 
 func (*limiter) String() string {
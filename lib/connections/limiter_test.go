@@ -14,6 +14,7 @@ import (
 	"math/rand"
 	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/syncthing/syncthing/lib/config"
 	"github.com/syncthing/syncthing/lib/events"
@@ -214,6 +215,103 @@ func TestAddAndRemove(t *testing.T) {
 	checkActualAndExpected(t, actualR, actualW, expectedR, expectedW)
 }
 
+func TestCron(t *testing.T) {
+	// 2026-03-02 is a Monday
+	monday0830 := time.Date(2026, 3, 2, 8, 30, 0, 0, time.Local)
+	sunday0000 := time.Date(2026, 3, 1, 0, 0, 0, 0, time.Local)
+
+	cases := []struct {
+		expr    string
+		matches []time.Time
+		misses  []time.Time
+	}{
+		{"* * * * *", []time.Time{monday0830, sunday0000}, nil},
+		{"30 8 * * 1-5", []time.Time{monday0830}, []time.Time{sunday0000, monday0830.Add(time.Minute)}},
+		{"*/15 8,9 * * *", []time.Time{monday0830}, []time.Time{monday0830.Add(5 * time.Minute), monday0830.Add(-time.Hour)}},
+		{"0 0 * * 7", []time.Time{sunday0000}, []time.Time{sunday0000.Add(24 * time.Hour)}},
+		// Either day of month or day of week
+		{"0 0 15 * 0", []time.Time{sunday0000, time.Date(2026, 3, 15, 0, 0, 0, 0, time.Local)}, []time.Time{sunday0000.Add(24 * time.Hour)}},
+		{"30 8 2 3 *", []time.Time{monday0830}, []time.Time{monday0830.AddDate(0, 1, 0)}},
+	}
+
+	for _, tc := range cases {
+		s, err := parseCron(tc.expr)
+		if err != nil {
+			t.Errorf("%q: %v", tc.expr, err)
+			continue
+		}
+		for _, m := range tc.matches {
+			if !s.matches(m) {
+				t.Errorf("%q should match %v", tc.expr, m)
+			}
+		}
+		for _, m := range tc.misses {
+			if s.matches(m) {
+				t.Errorf("%q should not match %v", tc.expr, m)
+			}
+		}
+	}
+
+	for _, expr := range []string{"", "* * * *", "60 * * * *", "* 24 * * *", "* * 0 * *", "* * * 13 *", "* * * * 8", "*/0 * * * *", "5-1 * * * *", "a * * * *"} {
+		if _, err := parseCron(expr); err == nil {
+			t.Errorf("%q should not parse", expr)
+		}
+	}
+}
+
+func TestBandwidthSchedule(t *testing.T) {
+	wrapper, wrapperCancel := initConfig()
+	defer wrapperCancel()
+	lim := newLimiter(device1, wrapper)
+
+	waiter, _ := wrapper.Modify(func(cfg *config.Configuration) {
+		cfg.Options.MaxSendKbps = 1000
+		cfg.Options.BandwidthSchedule = []config.BandwidthScheduleEntry{
+			{Schedule: "0 8 * * 1-5", MaxSendKbps: 100, MaxRecvKbps: 200},
+			{Schedule: "0 18 * * *", MaxSendKbps: 0, MaxRecvKbps: 0},
+			{Schedule: "invalid"},
+		}
+	})
+	waiter.Wait()
+
+	if len(lim.schedule) != 2 {
+		t.Fatalf("expected two valid schedule entries, got %d", len(lim.schedule))
+	}
+
+	// 2026-03-02 is a Monday
+	cases := []struct {
+		at  time.Time
+		idx int
+	}{
+		{time.Date(2026, 3, 2, 7, 59, 0, 0, time.Local), 1},
+		{time.Date(2026, 3, 2, 8, 0, 0, 0, time.Local), 0},
+		{time.Date(2026, 3, 2, 17, 59, 59, 0, time.Local), 0},
+		{time.Date(2026, 3, 2, 18, 0, 0, 0, time.Local), 1},
+		{time.Date(2026, 3, 1, 12, 0, 0, 0, time.Local), 1},
+	}
+	for _, tc := range cases {
+		if idx := lim.scheduleActiveAtLocked(tc.at); idx != tc.idx {
+			t.Errorf("schedule entry at %v is %d, expected %d", tc.at, idx, tc.idx)
+		}
+	}
+
+	lim.mu.Lock()
+	lim.activeSchedule = 0
+	lim.applyLimitsLocked()
+	lim.mu.Unlock()
+	if lim.write.Limit() != 100*1024 || lim.read.Limit() != 200*1024 {
+		t.Errorf("unexpected limits %v, %v during schedule", lim.write.Limit(), lim.read.Limit())
+	}
+
+	lim.mu.Lock()
+	lim.activeSchedule = -1
+	lim.applyLimitsLocked()
+	lim.mu.Unlock()
+	if lim.write.Limit() != 1000*1024 || lim.read.Limit() != rate.Inf {
+		t.Errorf("unexpected limits %v, %v without schedule", lim.write.Limit(), lim.read.Limit())
+	}
+}
+
 func TestLimitedWriterWrite(t *testing.T) {
 	// Check that the limited writer writes the correct data in the correct manner.
 
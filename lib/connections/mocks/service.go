@@ -6,6 +6,7 @@ import (
 	"sync"
 
 	"github.com/syncthing/syncthing/lib/connections"
+	"github.com/syncthing/syncthing/lib/protocol"
 )
 
 type Service struct {
@@ -19,6 +20,17 @@ type Service struct {
 	allAddressesReturnsOnCall map[int]struct {
 		result1 []string
 	}
+	CheckConnectivityStub        func(context.Context) connections.ConnectivityReport
+	checkConnectivityMutex       sync.RWMutex
+	checkConnectivityArgsForCall []struct {
+		arg1 context.Context
+	}
+	checkConnectivityReturns struct {
+		result1 connections.ConnectivityReport
+	}
+	checkConnectivityReturnsOnCall map[int]struct {
+		result1 connections.ConnectivityReport
+	}
 	ConnectionStatusStub        func() map[string]connections.ConnectionStatusEntry
 	connectionStatusMutex       sync.RWMutex
 	connectionStatusArgsForCall []struct {
@@ -39,6 +51,27 @@ type Service struct {
 	externalAddressesReturnsOnCall map[int]struct {
 		result1 []string
 	}
+	HolePunchStatusStub        func() connections.HolePunchStatus
+	holePunchStatusMutex       sync.RWMutex
+	holePunchStatusArgsForCall []struct {
+	}
+	holePunchStatusReturns struct {
+		result1 connections.HolePunchStatus
+	}
+	holePunchStatusReturnsOnCall map[int]struct {
+		result1 connections.HolePunchStatus
+	}
+	IdentityLogStub        func(protocol.DeviceID) []connections.IdentityLogEntry
+	identityLogMutex       sync.RWMutex
+	identityLogArgsForCall []struct {
+		arg1 protocol.DeviceID
+	}
+	identityLogReturns struct {
+		result1 []connections.IdentityLogEntry
+	}
+	identityLogReturnsOnCall map[int]struct {
+		result1 []connections.IdentityLogEntry
+	}
 	ListenerStatusStub        func() map[string]connections.ListenerStatusEntry
 	listenerStatusMutex       sync.RWMutex
 	listenerStatusArgsForCall []struct {
@@ -59,6 +92,16 @@ type Service struct {
 	nATTypeReturnsOnCall map[int]struct {
 		result1 string
 	}
+	RelayBudgetStatusStub        func() connections.RelayBudgetStatus
+	relayBudgetStatusMutex       sync.RWMutex
+	relayBudgetStatusArgsForCall []struct {
+	}
+	relayBudgetStatusReturns struct {
+		result1 connections.RelayBudgetStatus
+	}
+	relayBudgetStatusReturnsOnCall map[int]struct {
+		result1 connections.RelayBudgetStatus
+	}
 	ServeStub        func(context.Context) error
 	serveMutex       sync.RWMutex
 	serveArgsForCall []struct {
@@ -127,6 +170,67 @@ func (fake *Service) AllAddressesReturnsOnCall(i int, result1 []string) {
 	}{result1}
 }
 
+func (fake *Service) CheckConnectivity(arg1 context.Context) connections.ConnectivityReport {
+	fake.checkConnectivityMutex.Lock()
+	ret, specificReturn := fake.checkConnectivityReturnsOnCall[len(fake.checkConnectivityArgsForCall)]
+	fake.checkConnectivityArgsForCall = append(fake.checkConnectivityArgsForCall, struct {
+		arg1 context.Context
+	}{arg1})
+	stub := fake.CheckConnectivityStub
+	fakeReturns := fake.checkConnectivityReturns
+	fake.recordInvocation("CheckConnectivity", []interface{}{arg1})
+	fake.checkConnectivityMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *Service) CheckConnectivityCallCount() int {
+	fake.checkConnectivityMutex.RLock()
+	defer fake.checkConnectivityMutex.RUnlock()
+	return len(fake.checkConnectivityArgsForCall)
+}
+
+func (fake *Service) CheckConnectivityCalls(stub func(context.Context) connections.ConnectivityReport) {
+	fake.checkConnectivityMutex.Lock()
+	defer fake.checkConnectivityMutex.Unlock()
+	fake.CheckConnectivityStub = stub
+}
+
+func (fake *Service) CheckConnectivityArgsForCall(i int) context.Context {
+	fake.checkConnectivityMutex.RLock()
+	defer fake.checkConnectivityMutex.RUnlock()
+	argsForCall := fake.checkConnectivityArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *Service) CheckConnectivityReturns(result1 connections.ConnectivityReport) {
+	fake.checkConnectivityMutex.Lock()
+	defer fake.checkConnectivityMutex.Unlock()
+	fake.CheckConnectivityStub = nil
+	fake.checkConnectivityReturns = struct {
+		result1 connections.ConnectivityReport
+	}{result1}
+}
+
+func (fake *Service) CheckConnectivityReturnsOnCall(i int, result1 connections.ConnectivityReport) {
+	fake.checkConnectivityMutex.Lock()
+	defer fake.checkConnectivityMutex.Unlock()
+	fake.CheckConnectivityStub = nil
+	if fake.checkConnectivityReturnsOnCall == nil {
+		fake.checkConnectivityReturnsOnCall = make(map[int]struct {
+			result1 connections.ConnectivityReport
+		})
+	}
+	fake.checkConnectivityReturnsOnCall[i] = struct {
+		result1 connections.ConnectivityReport
+	}{result1}
+}
+
 func (fake *Service) ConnectionStatus() map[string]connections.ConnectionStatusEntry {
 	fake.connectionStatusMutex.Lock()
 	ret, specificReturn := fake.connectionStatusReturnsOnCall[len(fake.connectionStatusArgsForCall)]
@@ -233,6 +337,120 @@ func (fake *Service) ExternalAddressesReturnsOnCall(i int, result1 []string) {
 	}{result1}
 }
 
+func (fake *Service) HolePunchStatus() connections.HolePunchStatus {
+	fake.holePunchStatusMutex.Lock()
+	ret, specificReturn := fake.holePunchStatusReturnsOnCall[len(fake.holePunchStatusArgsForCall)]
+	fake.holePunchStatusArgsForCall = append(fake.holePunchStatusArgsForCall, struct {
+	}{})
+	stub := fake.HolePunchStatusStub
+	fakeReturns := fake.holePunchStatusReturns
+	fake.recordInvocation("HolePunchStatus", []interface{}{})
+	fake.holePunchStatusMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *Service) HolePunchStatusCallCount() int {
+	fake.holePunchStatusMutex.RLock()
+	defer fake.holePunchStatusMutex.RUnlock()
+	return len(fake.holePunchStatusArgsForCall)
+}
+
+func (fake *Service) HolePunchStatusCalls(stub func() connections.HolePunchStatus) {
+	fake.holePunchStatusMutex.Lock()
+	defer fake.holePunchStatusMutex.Unlock()
+	fake.HolePunchStatusStub = stub
+}
+
+func (fake *Service) HolePunchStatusReturns(result1 connections.HolePunchStatus) {
+	fake.holePunchStatusMutex.Lock()
+	defer fake.holePunchStatusMutex.Unlock()
+	fake.HolePunchStatusStub = nil
+	fake.holePunchStatusReturns = struct {
+		result1 connections.HolePunchStatus
+	}{result1}
+}
+
+func (fake *Service) HolePunchStatusReturnsOnCall(i int, result1 connections.HolePunchStatus) {
+	fake.holePunchStatusMutex.Lock()
+	defer fake.holePunchStatusMutex.Unlock()
+	fake.HolePunchStatusStub = nil
+	if fake.holePunchStatusReturnsOnCall == nil {
+		fake.holePunchStatusReturnsOnCall = make(map[int]struct {
+			result1 connections.HolePunchStatus
+		})
+	}
+	fake.holePunchStatusReturnsOnCall[i] = struct {
+		result1 connections.HolePunchStatus
+	}{result1}
+}
+
+func (fake *Service) IdentityLog(arg1 protocol.DeviceID) []connections.IdentityLogEntry {
+	fake.identityLogMutex.Lock()
+	ret, specificReturn := fake.identityLogReturnsOnCall[len(fake.identityLogArgsForCall)]
+	fake.identityLogArgsForCall = append(fake.identityLogArgsForCall, struct {
+		arg1 protocol.DeviceID
+	}{arg1})
+	stub := fake.IdentityLogStub
+	fakeReturns := fake.identityLogReturns
+	fake.recordInvocation("IdentityLog", []interface{}{arg1})
+	fake.identityLogMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *Service) IdentityLogCallCount() int {
+	fake.identityLogMutex.RLock()
+	defer fake.identityLogMutex.RUnlock()
+	return len(fake.identityLogArgsForCall)
+}
+
+func (fake *Service) IdentityLogCalls(stub func(protocol.DeviceID) []connections.IdentityLogEntry) {
+	fake.identityLogMutex.Lock()
+	defer fake.identityLogMutex.Unlock()
+	fake.IdentityLogStub = stub
+}
+
+func (fake *Service) IdentityLogArgsForCall(i int) protocol.DeviceID {
+	fake.identityLogMutex.RLock()
+	defer fake.identityLogMutex.RUnlock()
+	argsForCall := fake.identityLogArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *Service) IdentityLogReturns(result1 []connections.IdentityLogEntry) {
+	fake.identityLogMutex.Lock()
+	defer fake.identityLogMutex.Unlock()
+	fake.IdentityLogStub = nil
+	fake.identityLogReturns = struct {
+		result1 []connections.IdentityLogEntry
+	}{result1}
+}
+
+func (fake *Service) IdentityLogReturnsOnCall(i int, result1 []connections.IdentityLogEntry) {
+	fake.identityLogMutex.Lock()
+	defer fake.identityLogMutex.Unlock()
+	fake.IdentityLogStub = nil
+	if fake.identityLogReturnsOnCall == nil {
+		fake.identityLogReturnsOnCall = make(map[int]struct {
+			result1 []connections.IdentityLogEntry
+		})
+	}
+	fake.identityLogReturnsOnCall[i] = struct {
+		result1 []connections.IdentityLogEntry
+	}{result1}
+}
+
 func (fake *Service) ListenerStatus() map[string]connections.ListenerStatusEntry {
 	fake.listenerStatusMutex.Lock()
 	ret, specificReturn := fake.listenerStatusReturnsOnCall[len(fake.listenerStatusArgsForCall)]
@@ -339,6 +557,59 @@ func (fake *Service) NATTypeReturnsOnCall(i int, result1 string) {
 	}{result1}
 }
 
+func (fake *Service) RelayBudgetStatus() connections.RelayBudgetStatus {
+	fake.relayBudgetStatusMutex.Lock()
+	ret, specificReturn := fake.relayBudgetStatusReturnsOnCall[len(fake.relayBudgetStatusArgsForCall)]
+	fake.relayBudgetStatusArgsForCall = append(fake.relayBudgetStatusArgsForCall, struct {
+	}{})
+	stub := fake.RelayBudgetStatusStub
+	fakeReturns := fake.relayBudgetStatusReturns
+	fake.recordInvocation("RelayBudgetStatus", []interface{}{})
+	fake.relayBudgetStatusMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *Service) RelayBudgetStatusCallCount() int {
+	fake.relayBudgetStatusMutex.RLock()
+	defer fake.relayBudgetStatusMutex.RUnlock()
+	return len(fake.relayBudgetStatusArgsForCall)
+}
+
+func (fake *Service) RelayBudgetStatusCalls(stub func() connections.RelayBudgetStatus) {
+	fake.relayBudgetStatusMutex.Lock()
+	defer fake.relayBudgetStatusMutex.Unlock()
+	fake.RelayBudgetStatusStub = stub
+}
+
+func (fake *Service) RelayBudgetStatusReturns(result1 connections.RelayBudgetStatus) {
+	fake.relayBudgetStatusMutex.Lock()
+	defer fake.relayBudgetStatusMutex.Unlock()
+	fake.RelayBudgetStatusStub = nil
+	fake.relayBudgetStatusReturns = struct {
+		result1 connections.RelayBudgetStatus
+	}{result1}
+}
+
+func (fake *Service) RelayBudgetStatusReturnsOnCall(i int, result1 connections.RelayBudgetStatus) {
+	fake.relayBudgetStatusMutex.Lock()
+	defer fake.relayBudgetStatusMutex.Unlock()
+	fake.RelayBudgetStatusStub = nil
+	if fake.relayBudgetStatusReturnsOnCall == nil {
+		fake.relayBudgetStatusReturnsOnCall = make(map[int]struct {
+			result1 connections.RelayBudgetStatus
+		})
+	}
+	fake.relayBudgetStatusReturnsOnCall[i] = struct {
+		result1 connections.RelayBudgetStatus
+	}{result1}
+}
+
 func (fake *Service) Serve(arg1 context.Context) error {
 	fake.serveMutex.Lock()
 	ret, specificReturn := fake.serveReturnsOnCall[len(fake.serveArgsForCall)]
@@ -405,14 +676,22 @@ func (fake *Service) Invocations() map[string][][]interface{} {
 	defer fake.invocationsMutex.RUnlock()
 	fake.allAddressesMutex.RLock()
 	defer fake.allAddressesMutex.RUnlock()
+	fake.checkConnectivityMutex.RLock()
+	defer fake.checkConnectivityMutex.RUnlock()
 	fake.connectionStatusMutex.RLock()
 	defer fake.connectionStatusMutex.RUnlock()
 	fake.externalAddressesMutex.RLock()
 	defer fake.externalAddressesMutex.RUnlock()
+	fake.holePunchStatusMutex.RLock()
+	defer fake.holePunchStatusMutex.RUnlock()
+	fake.identityLogMutex.RLock()
+	defer fake.identityLogMutex.RUnlock()
 	fake.listenerStatusMutex.RLock()
 	defer fake.listenerStatusMutex.RUnlock()
 	fake.nATTypeMutex.RLock()
 	defer fake.nATTypeMutex.RUnlock()
+	fake.relayBudgetStatusMutex.RLock()
+	defer fake.relayBudgetStatusMutex.RUnlock()
 	fake.serveMutex.RLock()
 	defer fake.serveMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
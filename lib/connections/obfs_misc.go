@@ -0,0 +1,194 @@
+// Copyright (C) 2026 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package connections
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/url"
+	"sync"
+
+	"golang.org/x/crypto/chacha20"
+	"golang.org/x/crypto/hkdf"
+
+	"github.com/syncthing/syncthing/lib/rand"
+)
+
+// An obfuscator wraps the raw network connection under the BEP TLS session
+// so that the handshake and records carry no recognizable fingerprint on
+// the wire. It provides no security of its own: the devices authenticate
+// each other with BEP TLS as usual, whatever the obfuscation.
+type obfuscator interface {
+	Client(conn net.Conn) net.Conn
+	Server(conn net.Conn) net.Conn
+}
+
+// An obfuscatorFactory returns an obfuscator keyed with the shared
+// obfuscation secret.
+type obfuscatorFactory func(secret string) obfuscator
+
+// Obfuscators by name, as selected with the "method" parameter of an
+// obfs:// address.
+var obfuscators = map[string]obfuscatorFactory{
+	"chacha20": newChaChaObfuscator,
+}
+
+const defaultObfuscationMethod = "chacha20"
+
+// obfuscatorFor returns the obfuscator for the method of the given address.
+func obfuscatorFor(uri *url.URL, secret string) (obfuscator, error) {
+	if secret == "" {
+		return nil, errDisabled
+	}
+	method := uri.Query().Get("method")
+	if method == "" {
+		method = defaultObfuscationMethod
+	}
+	factory, ok := obfuscators[method]
+	if !ok {
+		return nil, fmt.Errorf("unknown obfuscation method %q", method)
+	}
+	return factory(secret), nil
+}
+
+const (
+	chachaObfsNonceSize  = chacha20.NonceSizeX
+	chachaObfsHeaderSize = 6 // four zero bytes and the padding size
+	chachaObfsMaxPadding = 1024
+)
+
+var errObfsHeader = errors.New("invalid obfuscation header (mismatched obfuscation secret?)")
+
+// The chacha20 obfuscator encrypts each direction of the stream with
+// XChaCha20, keyed from the secret and a random nonce sent by the writer
+// ahead of its first data. The nonce is followed by an encrypted header,
+// which tells whether the secrets match, and a random amount of padding,
+// so that the size of the first packets doesn't give the handshake away.
+// Everything on the wire is indistinguishable from random bytes to someone
+// without the secret.
+type chachaObfuscator struct {
+	secret []byte
+}
+
+func newChaChaObfuscator(secret string) obfuscator {
+	return chachaObfuscator{secret: []byte(secret)}
+}
+
+func (o chachaObfuscator) Client(conn net.Conn) net.Conn {
+	return &chachaObfsConn{Conn: conn, secret: o.secret, sendInfo: "client", recvInfo: "server"}
+}
+
+func (o chachaObfuscator) Server(conn net.Conn) net.Conn {
+	return &chachaObfsConn{Conn: conn, secret: o.secret, sendInfo: "server", recvInfo: "client"}
+}
+
+type chachaObfsConn struct {
+	net.Conn
+	secret   []byte
+	sendInfo string
+	recvInfo string
+
+	wmut sync.Mutex
+	enc  *chacha20.Cipher
+
+	rmut sync.Mutex
+	dec  *chacha20.Cipher
+}
+
+func (c *chachaObfsConn) Write(p []byte) (int, error) {
+	c.wmut.Lock()
+	defer c.wmut.Unlock()
+
+	var hdr []byte
+	if c.enc == nil {
+		nonce := make([]byte, chachaObfsNonceSize)
+		if _, err := rand.Read(nonce); err != nil {
+			return 0, err
+		}
+		enc, err := chachaObfsCipher(c.secret, nonce, c.sendInfo)
+		if err != nil {
+			return 0, err
+		}
+		c.enc = enc
+
+		// The padding is zeroes, which encrypt to key stream.
+		padding := rand.Intn(chachaObfsMaxPadding + 1)
+		hdr = make([]byte, chachaObfsNonceSize+chachaObfsHeaderSize+padding)
+		copy(hdr, nonce)
+		binary.BigEndian.PutUint16(hdr[chachaObfsNonceSize+4:], uint16(padding))
+		c.enc.XORKeyStream(hdr[chachaObfsNonceSize:], hdr[chachaObfsNonceSize:])
+	}
+
+	buf := make([]byte, len(hdr)+len(p))
+	copy(buf, hdr)
+	c.enc.XORKeyStream(buf[len(hdr):], p)
+	n, err := c.Conn.Write(buf)
+	if n < len(hdr) {
+		return 0, err
+	}
+	return n - len(hdr), err
+}
+
+func (c *chachaObfsConn) Read(p []byte) (int, error) {
+	c.rmut.Lock()
+	defer c.rmut.Unlock()
+
+	if c.dec == nil {
+		if err := c.readHeader(); err != nil {
+			return 0, err
+		}
+	}
+
+	n, err := c.Conn.Read(p)
+	c.dec.XORKeyStream(p[:n], p[:n])
+	return n, err
+}
+
+// readHeader reads the nonce of the other side and skips its padding.
+func (c *chachaObfsConn) readHeader() error {
+	nonce := make([]byte, chachaObfsNonceSize)
+	if _, err := io.ReadFull(c.Conn, nonce); err != nil {
+		return err
+	}
+	dec, err := chachaObfsCipher(c.secret, nonce, c.recvInfo)
+	if err != nil {
+		return err
+	}
+
+	hdr := make([]byte, chachaObfsHeaderSize)
+	if _, err := io.ReadFull(c.Conn, hdr); err != nil {
+		return err
+	}
+	dec.XORKeyStream(hdr, hdr)
+	padding := int(binary.BigEndian.Uint16(hdr[4:]))
+	if binary.BigEndian.Uint32(hdr) != 0 || padding > chachaObfsMaxPadding {
+		return errObfsHeader
+	}
+	buf := make([]byte, padding)
+	if _, err := io.ReadFull(c.Conn, buf); err != nil {
+		return err
+	}
+	dec.XORKeyStream(buf, buf)
+
+	c.dec = dec
+	return nil
+}
+
+// chachaObfsCipher returns the cipher for one direction of a connection,
+// keyed from the secret and the nonce of the writing side.
+func chachaObfsCipher(secret, nonce []byte, info string) (*chacha20.Cipher, error) {
+	key := make([]byte, chacha20.KeySize)
+	kdf := hkdf.New(sha256.New, secret, nonce, []byte("syncthing obfuscation "+info))
+	if _, err := io.ReadFull(kdf, key); err != nil {
+		return nil, err
+	}
+	return chacha20.NewUnauthenticatedCipher(key, nonce)
+}
@@ -0,0 +1,109 @@
+// Copyright (C) 2026 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package connections
+
+import (
+	"bytes"
+	"io"
+	"net"
+	"net/url"
+	"testing"
+)
+
+func TestChaChaObfuscator(t *testing.T) {
+	obfs, err := obfuscatorFor(&url.URL{Scheme: "obfs", Host: "127.0.0.1:22001"}, "secret")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	c1, c2 := net.Pipe()
+	wire := &recordingConn{Conn: c1}
+	client := obfs.Client(wire)
+	server := obfs.Server(c2)
+	defer client.Close()
+	defer server.Close()
+
+	msgs := [][]byte{[]byte("hello, world"), bytes.Repeat([]byte("ping"), 1000)}
+	go func() {
+		for _, msg := range msgs {
+			if _, err := client.Write(msg); err != nil {
+				t.Error(err)
+				return
+			}
+		}
+	}()
+	for _, msg := range msgs {
+		buf := make([]byte, len(msg))
+		if _, err := io.ReadFull(server, buf); err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(buf, msg) {
+			t.Fatalf("server read %q, expected %q", buf, msg)
+		}
+	}
+
+	go func() {
+		if _, err := server.Write([]byte("pong")); err != nil {
+			t.Error(err)
+		}
+	}()
+	buf := make([]byte, 4)
+	if _, err := io.ReadFull(client, buf); err != nil {
+		t.Fatal(err)
+	}
+	if string(buf) != "pong" {
+		t.Fatalf("client read %q, expected %q", buf, "pong")
+	}
+
+	if bytes.Contains(wire.written.Bytes(), []byte("hello")) || bytes.Contains(wire.written.Bytes(), []byte("ping")) {
+		t.Error("plain text on the wire")
+	}
+}
+
+func TestChaChaObfuscatorSecretMismatch(t *testing.T) {
+	uri := &url.URL{Scheme: "obfs", Host: "127.0.0.1:22001"}
+	obfs1, err := obfuscatorFor(uri, "secret")
+	if err != nil {
+		t.Fatal(err)
+	}
+	obfs2, err := obfuscatorFor(uri, "other secret")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	c1, c2 := net.Pipe()
+	client := obfs1.Client(c1)
+	server := obfs2.Server(c2)
+	defer client.Close()
+	defer server.Close()
+
+	go client.Write([]byte("hello"))
+	if _, err := server.Read(make([]byte, 5)); err != errObfsHeader {
+		t.Errorf("got error %v, expected %v", err, errObfsHeader)
+	}
+}
+
+func TestObfuscatorFor(t *testing.T) {
+	if _, err := obfuscatorFor(&url.URL{Scheme: "obfs", Host: "127.0.0.1:22001"}, ""); err != errDisabled {
+		t.Errorf("got error %v without a secret, expected %v", err, errDisabled)
+	}
+	uri, _ := url.Parse("obfs://127.0.0.1:22001?method=banana")
+	if _, err := obfuscatorFor(uri, "secret"); err == nil {
+		t.Error("expected error for unknown method")
+	}
+}
+
+// recordingConn keeps a copy of everything written to it.
+type recordingConn struct {
+	net.Conn
+	written bytes.Buffer
+}
+
+func (c *recordingConn) Write(p []byte) (int, error) {
+	c.written.Write(p)
+	return c.Conn.Write(p)
+}
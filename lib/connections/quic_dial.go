@@ -21,6 +21,7 @@ import (
 
 	"github.com/syncthing/syncthing/lib/config"
 	"github.com/syncthing/syncthing/lib/connections/registry"
+	"github.com/syncthing/syncthing/lib/dialer"
 	"github.com/syncthing/syncthing/lib/protocol"
 )
 
@@ -61,6 +62,9 @@ func (d *quicDialer) Dial(ctx context.Context, _ protocol.DeviceID, uri *url.URL
 			return internalConn{}, err
 		} else {
 			createdConn = packetConn
+			if err := dialer.SetPacketConnOptions(packetConn, d.socketOpts); err != nil {
+				l.Debugln("Dial (BEP/quic): setting socket options:", err)
+			}
 			transport = &quic.Transport{Conn: packetConn}
 		}
 	}
@@ -92,7 +96,10 @@ func (d *quicDialer) Dial(ctx context.Context, _ protocol.DeviceID, uri *url.URL
 		priority = d.lanPriority
 	}
 
-	return newInternalConn(&quicTlsConn{session, stream, createdConn}, connTypeQUICClient, isLocal, priority), nil
+	conn := &quicTlsConn{Conn: session, Stream: stream, createdConn: createdConn, migrations: new(quicMigrations)}
+	go conn.followNetworkChanges()
+
+	return newInternalConn(conn, connTypeQUICClient, isLocal, priority), nil
 }
 
 type quicDialerFactory struct{}
@@ -107,6 +114,7 @@ func (quicDialerFactory) New(opts config.OptionsConfiguration, tlsCfg *tls.Confi
 	}
 	return &quicDialer{
 		commonDialer: commonDialer{
+			socketOpts:        socketOptions(opts),
 			reconnectInterval: time.Duration(quicInterval) * time.Second,
 			tlsCfg:            tlsCfg,
 			lanChecker:        lanChecker,
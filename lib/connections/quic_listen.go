@@ -23,6 +23,7 @@ import (
 
 	"github.com/syncthing/syncthing/lib/config"
 	"github.com/syncthing/syncthing/lib/connections/registry"
+	"github.com/syncthing/syncthing/lib/dialer"
 	"github.com/syncthing/syncthing/lib/nat"
 	"github.com/syncthing/syncthing/lib/stun"
 	"github.com/syncthing/syncthing/lib/svcutil"
@@ -97,6 +98,9 @@ func (t *quicListener) serve(ctx context.Context) error {
 		return err
 	}
 	defer udpConn.Close()
+	if err := dialer.SetPacketConnOptions(udpConn, socketOptions(t.cfg.Options())); err != nil {
+		l.Debugln("Listen (BEP/quic): setting socket options:", err)
+	}
 
 	tracer := &writeTrackingTracer{}
 	quicTransport := &quic.Transport{
@@ -182,7 +186,7 @@ func (t *quicListener) serve(ctx context.Context) error {
 		if isLocal {
 			priority = t.cfg.Options().ConnectionPriorityQUICLAN
 		}
-		t.conns <- newInternalConn(&quicTlsConn{session, stream, nil}, connTypeQUICServer, isLocal, priority)
+		t.conns <- newInternalConn(&quicTlsConn{Conn: session, Stream: stream}, connTypeQUICServer, isLocal, priority)
 	}
 }
 
@@ -218,8 +222,12 @@ func (t *quicListener) Factory() listenerFactory {
 	return t.factory
 }
 
+func (t *quicListener) natType() stun.NATType {
+	return stun.NATType(t.nat.Load())
+}
+
 func (t *quicListener) NATType() string {
-	v := stun.NATType(t.nat.Load())
+	v := t.natType()
 	if v == stun.NATUnknown || v == stun.NATError {
 		return "unknown"
 	}
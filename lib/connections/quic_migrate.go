@@ -0,0 +1,298 @@
+// Copyright (C) 2026 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+//go:build !noquic
+// +build !noquic
+
+package connections
+
+import (
+	"context"
+	"net"
+	"slices"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/quic-go/quic-go"
+	"golang.org/x/net/ipv4"
+
+	"github.com/syncthing/syncthing/lib/osutil"
+)
+
+// How often the dialing side of a QUIC connection checks whether the local
+// network addresses have changed.
+var quicNetworkCheckInterval = 5 * time.Second
+
+// How long a socket the connection has been migrated away from must go
+// without receiving anything before it's retired. The peer only moves over
+// once it has validated the new path, and keeps sending keepalives to the
+// old one until then.
+var quicMigrationRetireAfter = quicConfig.MaxIdleTimeout
+
+// quicMigrations holds the sockets a connection has been migrated to. The
+// transport serving a socket tears down all connections it has seen when
+// the socket is closed, and quic-go has no way to make it forget one, so a
+// socket the connection is done with is retired instead: the socket itself
+// is closed while the transport keeps waiting on it until the connection
+// is closed.
+type quicMigrations struct {
+	mut     sync.Mutex
+	active  []*quicMigrationConn // the last one is in use
+	retired []*quicMigrationConn
+}
+
+// switched records that the connection now uses conn.
+func (m *quicMigrations) switched(conn *quicMigrationConn) {
+	m.mut.Lock()
+	m.active = append(m.active, conn)
+	m.mut.Unlock()
+}
+
+// abandoned retires conn, which the connection failed to migrate to. It
+// can't be closed outright, as the connection has already probed from it.
+func (m *quicMigrations) abandoned(conn *quicMigrationConn) {
+	conn.retire()
+	m.mut.Lock()
+	m.retired = append(m.retired, conn)
+	m.mut.Unlock()
+}
+
+// retireIdle retires the sockets of previous migrations that haven't
+// received anything for the given time.
+func (m *quicMigrations) retireIdle(idle time.Duration) {
+	m.mut.Lock()
+	defer m.mut.Unlock()
+	if len(m.active) < 2 {
+		return
+	}
+	current := m.active[len(m.active)-1]
+	previous := m.active[:len(m.active)-1]
+	m.active = slices.DeleteFunc(previous, func(conn *quicMigrationConn) bool {
+		if conn.idle() < idle {
+			return false
+		}
+		conn.retire()
+		m.retired = append(m.retired, conn)
+		return true
+	})
+	m.active = append(m.active, current)
+}
+
+func (m *quicMigrations) close() {
+	m.mut.Lock()
+	for _, conn := range m.active {
+		_ = conn.Close()
+	}
+	for _, conn := range m.retired {
+		_ = conn.Close()
+	}
+	m.active = nil
+	m.retired = nil
+	m.mut.Unlock()
+}
+
+// quicMigrationConn is a socket a connection has been migrated to. Once
+// retired the socket is closed, writes to it are dropped and reads block
+// until it is closed as well, so that the transport using it doesn't take
+// the connection down with it. It reads batches of packets itself, as
+// quic-go would otherwise read them through the file descriptor directly.
+type quicMigrationConn struct {
+	*net.UDPConn
+	batch *ipv4.PacketConn
+
+	lastRead atomic.Int64 // unix nanoseconds
+
+	mut       sync.RWMutex
+	isRetired bool
+	closed    chan struct{}
+	closeOnce sync.Once
+}
+
+func newQUICMigrationConn(conn *net.UDPConn) *quicMigrationConn {
+	c := &quicMigrationConn{
+		UDPConn: conn,
+		batch:   ipv4.NewPacketConn(conn),
+		closed:  make(chan struct{}),
+	}
+	c.lastRead.Store(time.Now().UnixNano())
+	return c
+}
+
+func (c *quicMigrationConn) ReadBatch(ms []ipv4.Message, flags int) (int, error) {
+	n, err := c.batch.ReadBatch(ms, flags)
+	return n, c.readErr(err)
+}
+
+func (c *quicMigrationConn) ReadFrom(b []byte) (int, net.Addr, error) {
+	n, addr, err := c.UDPConn.ReadFrom(b)
+	return n, addr, c.readErr(err)
+}
+
+func (c *quicMigrationConn) ReadMsgUDP(b, oob []byte) (int, int, int, *net.UDPAddr, error) {
+	n, oobn, flags, addr, err := c.UDPConn.ReadMsgUDP(b, oob)
+	return n, oobn, flags, addr, c.readErr(err)
+}
+
+// readErr records a successful read, or waits for the socket to be closed
+// when a read fails because it has been retired.
+func (c *quicMigrationConn) readErr(err error) error {
+	if err == nil {
+		c.lastRead.Store(time.Now().UnixNano())
+	} else if c.retired() {
+		<-c.closed
+	}
+	return err
+}
+
+// idle returns the time since the socket last received something.
+func (c *quicMigrationConn) idle() time.Duration {
+	return time.Since(time.Unix(0, c.lastRead.Load()))
+}
+
+func (c *quicMigrationConn) WriteTo(b []byte, addr net.Addr) (int, error) {
+	c.mut.RLock()
+	defer c.mut.RUnlock()
+	if c.isRetired {
+		return len(b), nil
+	}
+	return c.UDPConn.WriteTo(b, addr)
+}
+
+func (c *quicMigrationConn) WriteMsgUDP(b, oob []byte, addr *net.UDPAddr) (int, int, error) {
+	c.mut.RLock()
+	defer c.mut.RUnlock()
+	if c.isRetired {
+		return len(b), len(oob), nil
+	}
+	return c.UDPConn.WriteMsgUDP(b, oob, addr)
+}
+
+func (c *quicMigrationConn) retire() {
+	c.mut.Lock()
+	defer c.mut.Unlock()
+	if !c.isRetired {
+		c.isRetired = true
+		_ = c.UDPConn.Close()
+	}
+}
+
+func (c *quicMigrationConn) retired() bool {
+	c.mut.RLock()
+	defer c.mut.RUnlock()
+	return c.isRetired
+}
+
+func (c *quicMigrationConn) Close() error {
+	c.closeOnce.Do(func() { close(c.closed) })
+	c.mut.Lock()
+	defer c.mut.Unlock()
+	if c.isRetired {
+		return nil
+	}
+	c.isRetired = true
+	return c.UDPConn.Close()
+}
+
+// followNetworkChanges migrates the connection to a new local socket
+// whenever the set of local addresses changes, e.g. when switching from
+// Wi-Fi to a wired network or getting a new address from DHCP. This keeps
+// the connection, and thus the ongoing transfers, alive instead of having
+// to reconnect and exchange indexes again. Only the dialing side of a QUIC
+// connection can migrate; the listening side follows along. It returns when
+// the connection is closed.
+func (q *quicTlsConn) followNetworkChanges() {
+	ctx := q.Conn.Context()
+	addrs := localAddressesKey()
+
+	ticker := time.NewTicker(quicNetworkCheckInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		q.migrations.retireIdle(quicMigrationRetireAfter)
+
+		current := localAddressesKey()
+		if current == addrs {
+			continue
+		}
+		addrs = current
+
+		l.Debugf("Local addresses changed, migrating QUIC connection to %s", q.Conn.RemoteAddr())
+		if err := q.migrate(ctx); err != nil {
+			l.Debugf("Migrating QUIC connection to %s: %v", q.Conn.RemoteAddr(), err)
+		}
+	}
+}
+
+// migrate moves the connection to a new socket, once the peer has been
+// verified to be reachable from it. The socket has the address family of
+// the connection.
+func (q *quicTlsConn) migrate(ctx context.Context) error {
+	packetConn, err := net.ListenUDP(quicAddrNetwork(q.Conn.RemoteAddr()), nil)
+	if err != nil {
+		return err
+	}
+	conn := newQUICMigrationConn(packetConn)
+	transport := &quic.Transport{Conn: conn}
+	path, err := q.Conn.AddPath(transport)
+	if err != nil {
+		_ = transport.Close()
+		_ = conn.Close()
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, quicOperationTimeout)
+	defer cancel()
+	if err := path.Probe(ctx); err != nil {
+		_ = path.Close()
+		q.migrations.abandoned(conn)
+		return err
+	}
+	if err := path.Switch(); err != nil {
+		_ = path.Close()
+		q.migrations.abandoned(conn)
+		return err
+	}
+	q.migrations.switched(conn)
+
+	l.Debugf("Migrated QUIC connection to %s to local address %s", q.Conn.RemoteAddr(), packetConn.LocalAddr())
+	return nil
+}
+
+// quicAddrNetwork returns "udp4" or "udp6" for the family of the address,
+// or "udp" if it's not a UDP address.
+func quicAddrNetwork(addr net.Addr) string {
+	udpAddr, ok := addr.(*net.UDPAddr)
+	switch {
+	case !ok:
+		return "udp"
+	case udpAddr.IP.To4() != nil:
+		return "udp4"
+	default:
+		return "udp6"
+	}
+}
+
+// localAddressesKey returns a string representing the current set of local
+// interface addresses, or the empty string if they can't be determined.
+func localAddressesKey() string {
+	nets, err := osutil.GetInterfaceAddrs(true)
+	if err != nil {
+		return ""
+	}
+	addrs := make([]string, 0, len(nets))
+	for _, n := range nets {
+		addrs = append(addrs, n.String())
+	}
+	slices.Sort(addrs)
+	return strings.Join(addrs, " ")
+}
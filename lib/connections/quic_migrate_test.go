@@ -0,0 +1,139 @@
+// Copyright (C) 2026 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+//go:build !noquic
+// +build !noquic
+
+package connections
+
+import (
+	"context"
+	"crypto/tls"
+	"io"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/quic-go/quic-go"
+
+	"github.com/syncthing/syncthing/lib/tlsutil"
+)
+
+func TestQUICMigration(t *testing.T) {
+	tlsCfg := tlsutil.SecureDefaultTLS13()
+	tlsCfg.Certificates = []tls.Certificate{mustGetCert(t)}
+	tlsCfg.NextProtos = []string{"bench"}
+	tlsCfg.InsecureSkipVerify = true
+
+	ctx, cancel := context.WithTimeout(context.Background(), quicOperationTimeout)
+	defer cancel()
+
+	serverConn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer serverConn.Close()
+	listener, err := (&quic.Transport{Conn: serverConn}).Listen(tlsCfg, quicConfig)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer listener.Close()
+
+	accepted := make(chan *quic.Conn, 1)
+	go func() {
+		session, err := listener.Accept(ctx)
+		if err != nil {
+			return
+		}
+		accepted <- session
+		stream, err := session.AcceptStream(ctx)
+		if err != nil {
+			return
+		}
+		_, _ = io.Copy(stream, stream)
+	}()
+
+	clientConn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	session, err := (&quic.Transport{Conn: clientConn}).Dial(ctx, serverConn.LocalAddr(), tlsCfg, quicConfig)
+	if err != nil {
+		t.Fatal(err)
+	}
+	stream, err := session.OpenStreamSync(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	conn := &quicTlsConn{Conn: session, Stream: stream, createdConn: clientConn, migrations: new(quicMigrations)}
+	defer conn.Close()
+
+	echo := func(data string) {
+		t.Helper()
+		if _, err := conn.Write([]byte(data)); err != nil {
+			t.Fatal(err)
+		}
+		buf := make([]byte, len(data))
+		if _, err := io.ReadFull(conn, buf); err != nil {
+			t.Fatal(err)
+		}
+		if string(buf) != data {
+			t.Fatalf("got %q, expected %q", buf, data)
+		}
+	}
+
+	echo("before")
+	serverSession := <-accepted
+	before := serverSession.RemoteAddr().String()
+
+	if err := conn.migrate(ctx); err != nil {
+		t.Fatal(err)
+	}
+	echo("after")
+
+	if len(conn.migrations.active) != 1 {
+		t.Fatalf("expected one migration socket, got %d", len(conn.migrations.active))
+	}
+	first := conn.migrations.active[0]
+	if !isUDP4(first.LocalAddr()) {
+		t.Errorf("migrated to a socket at %s, expected the family of the connection", first.LocalAddr())
+	}
+	if after := serverSession.RemoteAddr().String(); after == before {
+		t.Errorf("server still sees the client at %s after migration", after)
+	}
+
+	// Once the peer has moved on to the socket of a second migration, the
+	// first one is retired without taking the connection down with it.
+	if err := conn.migrate(ctx); err != nil {
+		t.Fatal(err)
+	}
+	second := conn.migrations.active[1]
+	for serverSession.RemoteAddr().(*net.UDPAddr).Port != second.LocalAddr().(*net.UDPAddr).Port {
+		echo("again")
+	}
+	conn.migrations.retireIdle(time.Hour)
+	if len(conn.migrations.retired) != 0 {
+		t.Fatal("retired a migration socket that was recently used")
+	}
+	conn.migrations.retireIdle(0)
+	if len(conn.migrations.retired) != 1 || conn.migrations.retired[0] != first {
+		t.Fatal("expected the first migration socket to be retired")
+	}
+	if err := first.SetReadBuffer(1 << 16); err == nil {
+		t.Error("the socket of the first migration is still open")
+	}
+	echo("retired")
+
+	conn.Close()
+	if err := second.SetReadBuffer(1 << 16); err == nil {
+		t.Error("the socket of the second migration is still open after closing the connection")
+	}
+}
+
+func isUDP4(addr net.Addr) bool {
+	udpAddr, ok := addr.(*net.UDPAddr)
+	return ok && udpAddr.IP.To4() != nil
+}
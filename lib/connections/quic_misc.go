@@ -40,19 +40,25 @@ func quicNetwork(uri *url.URL) string {
 }
 
 type quicTlsConn struct {
-	quic.Connection
-	quic.Stream
+	*quic.Conn
+	*quic.Stream
 	// If we created this connection, we should be the ones closing it.
 	createdConn net.PacketConn
+	// Sockets created when migrating the connection to a new network, see
+	// followNetworkChanges.
+	migrations *quicMigrations
 }
 
 func (q *quicTlsConn) Close() error {
 	sterr := q.Stream.Close()
-	seerr := q.Connection.CloseWithError(0, "closing")
+	seerr := q.Conn.CloseWithError(0, "closing")
 	var pcerr error
 	if q.createdConn != nil {
 		pcerr = q.createdConn.Close()
 	}
+	if q.migrations != nil {
+		q.migrations.close()
+	}
 	if sterr != nil {
 		return sterr
 	}
@@ -63,7 +69,7 @@ func (q *quicTlsConn) Close() error {
 }
 
 func (q *quicTlsConn) ConnectionState() tls.ConnectionState {
-	return q.Connection.ConnectionState().TLS
+	return q.Conn.ConnectionState().TLS
 }
 
 func transportConnUnspecified(conn any) bool {
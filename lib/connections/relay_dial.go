@@ -44,10 +44,13 @@ func (d *relayDialer) Dial(ctx context.Context, id protocol.DeviceID, uri *url.U
 		return internalConn{}, err
 	}
 
-	err = dialer.SetTrafficClass(conn, d.trafficClass)
+	err = dialer.SetTrafficClass(conn, d.trafficClass(false))
 	if err != nil {
 		l.Debugln("Dial (BEP/relay): setting traffic class:", err)
 	}
+	if err := dialer.SetSocketOptions(conn, d.socketOpts); err != nil {
+		l.Debugln("Dial (BEP/relay): setting socket options:", err)
+	}
 
 	var tc *tls.Conn
 	if inv.ServerSocket {
@@ -73,7 +76,8 @@ type relayDialerFactory struct{}
 
 func (relayDialerFactory) New(opts config.OptionsConfiguration, tlsCfg *tls.Config, _ *registry.Registry, _ *lanChecker) genericDialer {
 	return &relayDialer{commonDialer{
-		trafficClass:      opts.TrafficClass,
+		trafficClassWAN:   opts.TrafficClassFor(false),
+		socketOpts:        socketOptions(opts),
 		reconnectInterval: time.Duration(opts.RelayReconnectIntervalM) * time.Minute,
 		tlsCfg:            tlsCfg,
 		wanPriority:       opts.ConnectionPriorityRelay,
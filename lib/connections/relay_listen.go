@@ -87,10 +87,13 @@ func (t *relayListener) handleInvitations(ctx context.Context, clnt client.Relay
 				l.Debugln("Listen (BEP/relay): setting tcp options:", err)
 			}
 
-			err = dialer.SetTrafficClass(conn, t.cfg.Options().TrafficClass)
+			err = dialer.SetTrafficClass(conn, t.cfg.Options().TrafficClassFor(false))
 			if err != nil {
 				l.Debugln("Listen (BEP/relay): setting traffic class:", err)
 			}
+			if err := dialer.SetSocketOptions(conn, socketOptions(t.cfg.Options())); err != nil {
+				l.Debugln("Listen (BEP/relay): setting socket options:", err)
+			}
 
 			var tc *tls.Conn
 			if inv.ServerSocket {
@@ -0,0 +1,225 @@
+// Copyright (C) 2026 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package connections
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"time"
+
+	"github.com/syncthing/syncthing/lib/config"
+	"github.com/syncthing/syncthing/lib/db"
+	"github.com/syncthing/syncthing/lib/events"
+	"github.com/syncthing/syncthing/lib/sync"
+)
+
+const (
+	relayBudgetKey          = "relayBudget"
+	relayBudgetSaveInterval = time.Minute
+)
+
+// RelayBudgetStatus is the relayed traffic during the current budget
+// period.
+type RelayBudgetStatus struct {
+	UsedBytes   int64     `json:"usedBytes"`
+	BudgetBytes int64     `json:"budgetBytes"` // zero means unlimited
+	Exceeded    bool      `json:"exceeded"`
+	PeriodStart time.Time `json:"periodStart"`
+}
+
+// relayBudget counts the bytes sent and received over relay connections
+// and tells when the monthly relay budget is used up. The counter is saved
+// to the database periodically, so it survives restarts, give or take the
+// last interval.
+type relayBudget struct {
+	cfg      config.Wrapper
+	kv       *db.NamespacedKV // may be nil, in which case nothing is saved
+	evLogger events.Logger
+	check    chan struct{}
+
+	mut         sync.Mutex
+	used        int64
+	periodStart time.Time
+	exceeded    bool
+	dirty       bool
+}
+
+// relayBudgetState is the serialised form of the counter.
+type relayBudgetState struct {
+	PeriodStart time.Time `json:"periodStart"`
+	Used        int64     `json:"used"`
+}
+
+func newRelayBudget(cfg config.Wrapper, kv *db.NamespacedKV, evLogger events.Logger) *relayBudget {
+	b := &relayBudget{
+		cfg:      cfg,
+		kv:       kv,
+		evLogger: evLogger,
+		check:    make(chan struct{}, 1),
+		mut:      sync.NewMutex(),
+	}
+	b.load()
+	b.update(time.Now())
+	return b
+}
+
+func (b *relayBudget) load() {
+	if b.kv == nil {
+		return
+	}
+	bs, ok, err := b.kv.Bytes(relayBudgetKey)
+	if err != nil || !ok {
+		return
+	}
+	var state relayBudgetState
+	if err := json.Unmarshal(bs, &state); err != nil {
+		l.Debugln("Loading relay budget counter:", err)
+		return
+	}
+	b.periodStart = state.PeriodStart
+	b.used = state.Used
+}
+
+// serve saves the counter periodically and calls onChange whenever the
+// budget becomes exceeded or available again.
+func (b *relayBudget) serve(ctx context.Context, onChange func(exceeded bool)) error {
+	t := time.NewTicker(relayBudgetSaveInterval)
+	defer t.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			b.save()
+			return nil
+		case <-b.check:
+		case <-t.C:
+			b.save()
+		}
+		if changed, exceeded := b.update(time.Now()); changed {
+			onChange(exceeded)
+		}
+	}
+}
+
+func (b *relayBudget) save() {
+	if b.kv == nil {
+		return
+	}
+	b.mut.Lock()
+	if !b.dirty {
+		b.mut.Unlock()
+		return
+	}
+	state := relayBudgetState{PeriodStart: b.periodStart, Used: b.used}
+	b.dirty = false
+	b.mut.Unlock()
+
+	bs, err := json.Marshal(state)
+	if err == nil {
+		err = b.kv.PutBytes(relayBudgetKey, bs)
+	}
+	if err != nil {
+		l.Warnln("Saving relay budget counter:", err)
+	}
+}
+
+// update starts a new period if one has begun and reevaluates whether the
+// budget is exceeded against the current configuration, returning whether
+// that changed.
+func (b *relayBudget) update(now time.Time) (changed, exceeded bool) {
+	opts := b.cfg.Options()
+	start := opts.QuotaPeriodStart(now)
+
+	b.mut.Lock()
+	if !b.periodStart.Equal(start) {
+		l.Debugln("Starting new relay budget period at", start)
+		b.periodStart = start
+		b.used = 0
+		b.dirty = true
+	}
+	budget := opts.RelayMonthlyBudgetMiB << 20
+	exceeded = budget > 0 && b.used >= budget
+	changed = exceeded != b.exceeded
+	b.exceeded = exceeded
+	status := b.statusLocked(budget)
+	b.mut.Unlock()
+
+	if !changed {
+		return false, exceeded
+	}
+	if exceeded {
+		l.Warnf("Monthly relay budget of %d MiB used up; relays are disabled until %s", opts.RelayMonthlyBudgetMiB, start.AddDate(0, 1, 0).Format(time.DateOnly))
+	} else {
+		l.Infoln("Relays are enabled again, the monthly relay budget is no longer exceeded")
+	}
+	b.evLogger.Log(events.RelayBudgetChanged, status)
+	return true, exceeded
+}
+
+func (b *relayBudget) add(n int) {
+	if n <= 0 {
+		return
+	}
+	budget := b.cfg.Options().RelayMonthlyBudgetMiB << 20
+	b.mut.Lock()
+	b.used += int64(n)
+	b.dirty = true
+	crossed := budget > 0 && !b.exceeded && b.used >= budget
+	b.mut.Unlock()
+	if crossed {
+		select {
+		case b.check <- struct{}{}:
+		default:
+		}
+	}
+}
+
+func (b *relayBudget) isExceeded() bool {
+	b.mut.Lock()
+	defer b.mut.Unlock()
+	return b.exceeded
+}
+
+func (b *relayBudget) status() RelayBudgetStatus {
+	budget := b.cfg.Options().RelayMonthlyBudgetMiB << 20
+	b.mut.Lock()
+	defer b.mut.Unlock()
+	return b.statusLocked(budget)
+}
+
+func (b *relayBudget) statusLocked(budget int64) RelayBudgetStatus {
+	return RelayBudgetStatus{
+		UsedBytes:   b.used,
+		BudgetBytes: budget,
+		Exceeded:    b.exceeded,
+		PeriodStart: b.periodStart,
+	}
+}
+
+// relayBudgetReader and relayBudgetWriter count the traffic of a relay
+// connection against the budget.
+type relayBudgetReader struct {
+	io.Reader
+	budget *relayBudget
+}
+
+func (r *relayBudgetReader) Read(bs []byte) (int, error) {
+	n, err := r.Reader.Read(bs)
+	r.budget.add(n)
+	return n, err
+}
+
+type relayBudgetWriter struct {
+	io.Writer
+	budget *relayBudget
+}
+
+func (w *relayBudgetWriter) Write(bs []byte) (int, error) {
+	n, err := w.Writer.Write(bs)
+	w.budget.add(n)
+	return n, err
+}
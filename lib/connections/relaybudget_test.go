@@ -0,0 +1,110 @@
+// Copyright (C) 2026 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package connections
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/syncthing/syncthing/lib/config"
+	"github.com/syncthing/syncthing/lib/db"
+	"github.com/syncthing/syncthing/lib/db/backend"
+	"github.com/syncthing/syncthing/lib/events"
+)
+
+func TestRelayBudget(t *testing.T) {
+	wrapper, cancel := initConfig()
+	defer cancel()
+	setBudget := func(mib int64) {
+		waiter, _ := wrapper.Modify(func(cfg *config.Configuration) {
+			cfg.Options.RelayMonthlyBudgetMiB = mib
+		})
+		waiter.Wait()
+	}
+	setBudget(1)
+
+	ldb, err := db.NewLowlevel(backend.OpenMemory(), events.NoopLogger)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ldb.Close()
+	kv := db.NewMiscDataNamespace(ldb)
+
+	evLogger := events.NewLogger()
+	ctx, evCancel := context.WithCancel(context.Background())
+	defer evCancel()
+	go evLogger.Serve(ctx)
+	sub := evLogger.Subscribe(events.RelayBudgetChanged)
+	defer sub.Unsubscribe()
+
+	b := newRelayBudget(wrapper, kv, evLogger)
+
+	// Traffic through the counting reader and writer is accounted.
+	r := &relayBudgetReader{Reader: bytes.NewReader(make([]byte, 512<<10)), budget: b}
+	if _, err := io.Copy(io.Discard, r); err != nil {
+		t.Fatal(err)
+	}
+	if changed, _ := b.update(time.Now()); changed || b.isExceeded() {
+		t.Fatal("budget exceeded too early")
+	}
+	w := &relayBudgetWriter{Writer: io.Discard, budget: b}
+	if _, err := w.Write(make([]byte, 512<<10)); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case <-b.check:
+	default:
+		t.Fatal("crossing the budget didn't trigger a check")
+	}
+	if changed, exceeded := b.update(time.Now()); !changed || !exceeded {
+		t.Fatal("budget not exceeded")
+	}
+	ev, err := sub.Poll(time.Second)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if status, ok := ev.Data.(RelayBudgetStatus); !ok || !status.Exceeded || status.UsedBytes != 1<<20 {
+		t.Errorf("unexpected event data %+v", ev.Data)
+	}
+
+	// The counter survives a restart.
+	b.save()
+	b = newRelayBudget(wrapper, kv, events.NoopLogger)
+	if status := b.status(); !status.Exceeded || status.UsedBytes != 1<<20 || status.BudgetBytes != 1<<20 {
+		t.Errorf("unexpected status after restart %+v", status)
+	}
+
+	// Raising the budget makes relays available again.
+	setBudget(2)
+	if changed, exceeded := b.update(time.Now()); !changed || exceeded {
+		t.Error("budget still exceeded after raising it")
+	}
+	setBudget(1)
+	b.update(time.Now())
+
+	// A new period resets the counter.
+	if changed, exceeded := b.update(b.periodStart.AddDate(0, 1, 0)); !changed || exceeded {
+		t.Error("budget still exceeded in the next period")
+	}
+	if used := b.status().UsedBytes; used != 0 {
+		t.Errorf("expected the counter to be reset, got %d", used)
+	}
+}
+
+func TestRelayBudgetUnlimited(t *testing.T) {
+	wrapper, cancel := initConfig()
+	defer cancel()
+
+	b := newRelayBudget(wrapper, nil, events.NoopLogger)
+	b.add(10 << 30)
+	if changed, exceeded := b.update(time.Now()); changed || exceeded {
+		t.Error("budget exceeded without a budget being set")
+	}
+}
@@ -31,6 +31,7 @@ import (
 	"github.com/syncthing/syncthing/lib/build"
 	"github.com/syncthing/syncthing/lib/config"
 	"github.com/syncthing/syncthing/lib/connections/registry"
+	"github.com/syncthing/syncthing/lib/db"
 	"github.com/syncthing/syncthing/lib/discover"
 	"github.com/syncthing/syncthing/lib/events"
 	"github.com/syncthing/syncthing/lib/nat"
@@ -43,6 +44,7 @@ import (
 	"github.com/syncthing/syncthing/lib/sync"
 
 	// Registers NAT service providers
+	_ "github.com/syncthing/syncthing/lib/pcp"
 	_ "github.com/syncthing/syncthing/lib/pmp"
 	_ "github.com/syncthing/syncthing/lib/upnp"
 
@@ -65,12 +67,15 @@ var (
 	errDisabled   = fmt.Errorf("%w: disabled by configuration", errUnsupported)
 	errDeprecated = fmt.Errorf("%w: deprecated", errUnsupported)
 
+	errRelayBudgetExceeded = fmt.Errorf("%w: monthly relay budget exceeded", errUnsupported)
+
 	// Various reasons to reject a connection
 	errNetworkNotAllowed      = errors.New("network not allowed")
 	errDeviceAlreadyConnected = errors.New("already connected to this device")
 	errDeviceIgnored          = errors.New("device is ignored")
 	errConnLimitReached       = errors.New("connection limit reached")
 	errDevicePaused           = errors.New("device is paused")
+	errMalformedBackoff       = errors.New("backing off after malformed messages")
 
 	// A connection is being closed to make space for better ones
 	errReplacingConnection = errors.New("replacing connection")
@@ -134,6 +139,10 @@ type Service interface {
 	ListenerStatus() map[string]ListenerStatusEntry
 	ConnectionStatus() map[string]ConnectionStatusEntry
 	NATType() string
+	RelayBudgetStatus() RelayBudgetStatus
+	HolePunchStatus() HolePunchStatus
+	IdentityLog(device protocol.DeviceID) []IdentityLogEntry
+	CheckConnectivity(ctx context.Context) ConnectivityReport
 }
 
 type ListenerStatusEntry struct {
@@ -170,14 +179,19 @@ type service struct {
 	bepProtocolName      string
 	tlsDefaultCommonName string
 	limiter              *limiter
+	relayBudget          *relayBudget
+	holePunch            *holePunchStats
 	natService           *nat.Service
 	evLogger             events.Logger
 	registry             *registry.Registry
 	keyGen               *protocol.KeyGenerator
 	lanChecker           *lanChecker
+	malformed            *protocol.MalformedBudget
+	identityLog          *identityLog
 
 	dialNow           chan struct{}
 	dialNowDevices    map[protocol.DeviceID]struct{}
+	dialMoreDevices   map[protocol.DeviceID]struct{} // devices with fewer connections than desired
 	dialNowDevicesMut sync.Mutex
 
 	listenersMut   sync.RWMutex
@@ -185,7 +199,7 @@ type service struct {
 	listenerTokens map[string]suture.ServiceToken
 }
 
-func NewService(cfg config.Wrapper, myID protocol.DeviceID, mdl Model, tlsCfg *tls.Config, discoverer discover.Finder, bepProtocolName string, tlsDefaultCommonName string, evLogger events.Logger, registry *registry.Registry, keyGen *protocol.KeyGenerator) Service {
+func NewService(cfg config.Wrapper, myID protocol.DeviceID, mdl Model, tlsCfg *tls.Config, discoverer discover.Finder, bepProtocolName string, tlsDefaultCommonName string, evLogger events.Logger, registry *registry.Registry, keyGen *protocol.KeyGenerator, miscDB, identityLogDB *db.NamespacedKV) Service {
 	spec := svcutil.SpecWithInfoLogger(l)
 	service := &service{
 		Supervisor:              suture.New("connections.Service", spec),
@@ -201,15 +215,20 @@ func NewService(cfg config.Wrapper, myID protocol.DeviceID, mdl Model, tlsCfg *t
 		bepProtocolName:      bepProtocolName,
 		tlsDefaultCommonName: tlsDefaultCommonName,
 		limiter:              newLimiter(myID, cfg),
+		relayBudget:          newRelayBudget(cfg, miscDB, evLogger),
+		holePunch:            newHolePunchStats(),
 		natService:           nat.NewService(myID, cfg),
 		evLogger:             evLogger,
 		registry:             registry,
 		keyGen:               keyGen,
 		lanChecker:           &lanChecker{cfg},
+		malformed:            protocol.NewMalformedBudget(),
+		identityLog:          newIdentityLog(identityLogDB),
 
 		dialNowDevicesMut: sync.NewMutex(),
 		dialNow:           make(chan struct{}, 1),
 		dialNowDevices:    make(map[protocol.DeviceID]struct{}),
+		dialMoreDevices:   make(map[protocol.DeviceID]struct{}),
 
 		listenersMut:   sync.NewRWMutex(),
 		listeners:      make(map[string]genericListener),
@@ -232,6 +251,9 @@ func NewService(cfg config.Wrapper, myID protocol.DeviceID, mdl Model, tlsCfg *t
 	service.Add(svcutil.AsService(service.connect, fmt.Sprintf("%s/connect", service)))
 	service.Add(svcutil.AsService(service.handleConns, fmt.Sprintf("%s/handleConns", service)))
 	service.Add(svcutil.AsService(service.handleHellos, fmt.Sprintf("%s/handleHellos", service)))
+	service.Add(svcutil.AsService(service.limiter.serve, fmt.Sprintf("%s/limiter", service)))
+	service.Add(svcutil.AsService(service.serveRelayBudget, fmt.Sprintf("%s/relayBudget", service)))
+	service.Add(svcutil.AsService(service.punchHoles, fmt.Sprintf("%s/punchHoles", service)))
 	service.Add(service.natService)
 
 	svcutil.OnSupervisorDone(service.Supervisor, func() {
@@ -282,6 +304,12 @@ func (s *service) handleConns(ctx context.Context) error {
 			continue
 		}
 
+		if c.isRelay() && s.relayBudget.isExceeded() {
+			l.Debugf("Connection from %s at %s (%s) rejected: %v", remoteID, c.RemoteAddr(), c.Type(), errRelayBudgetExceeded)
+			c.Close()
+			continue
+		}
+
 		if err := s.connectionCheckEarly(remoteID, c); err != nil {
 			if errors.Is(err, errDeviceAlreadyConnected) {
 				l.Debugf("Connection from %s at %s (%s) rejected: %v", remoteID, c.RemoteAddr(), c.Type(), err)
@@ -297,8 +325,10 @@ func (s *service) handleConns(ctx context.Context) error {
 			// Exchange Hello messages with the peer.
 			outgoing := s.helloForDevice(remoteID)
 			incoming, err := protocol.ExchangeHello(c, outgoing)
-			// The timestamps are used to create the connection ID.
+			// The timestamps are used to create the connection ID, and to
+			// measure how far the other device's clock is off from ours.
 			c.connectionID = newConnectionID(outgoing.Timestamp, incoming.Timestamp)
+			c.clockOffset = measureClockOffset(outgoing.Timestamp, incoming.Timestamp, time.Now().UnixNano())
 
 			select {
 			case s.hellos <- &connWithHello{c, incoming, err, remoteID, remoteCert}:
@@ -313,6 +343,8 @@ func (s *service) helloForDevice(remoteID protocol.DeviceID) protocol.Hello {
 		ClientName:    "syncthing",
 		ClientVersion: build.Version,
 		Timestamp:     time.Now().UnixNano(),
+		Compressions:  protocol.SupportedMessageCompressions,
+		Extensions:    protocol.SupportedExtensions(),
 	}
 	if cfg, ok := s.cfg.Device(remoteID); ok {
 		hello.NumConnections = cfg.NumConnections()
@@ -330,6 +362,10 @@ func (s *service) connectionCheckEarly(remoteID protocol.DeviceID, c internalCon
 		return errDeviceIgnored
 	}
 
+	if s.malformed.BackingOff(remoteID) {
+		return errMalformedBackoff
+	}
+
 	if max := s.cfg.Options().ConnectionLimitMax; max > 0 && s.numConnectedDevices() >= max {
 		// We're not allowed to accept any more connections.
 		return errConnLimitReached
@@ -440,12 +476,24 @@ func (s *service) handleHellos(ctx context.Context) error {
 			continue
 		}
 
+		s.identityLog.observe(remoteID, remoteCert, hello, c.RemoteAddr())
+
 		// Wrap the connection in rate limiters. The limiter itself will
 		// keep up with config changes to the rate and whether or not LAN
 		// connections are limited.
 		rd, wr := s.limiter.getLimiters(remoteID, c, c.IsLocal())
+		if c.isRelay() {
+			// Relayed traffic counts against the monthly relay budget.
+			rd = &relayBudgetReader{Reader: rd, budget: s.relayBudget}
+			wr = &relayBudgetWriter{Writer: wr, budget: s.relayBudget}
+		}
+
+		// Compress with the preferred algorithm, if the other side
+		// supports it.
+		msgCompression := deviceCfg.CompressionAlgorithm.MessageCompression(hello.Compressions, c.IsLocal())
 
-		protoConn := protocol.NewConnection(remoteID, rd, wr, c, s.model, c, deviceCfg.Compression, s.cfg.FolderPasswords(remoteID), s.keyGen)
+		mdl := &malformedRecordingModel{Model: s.model, budget: s.malformed}
+		protoConn := protocol.NewConnection(remoteID, rd, wr, c, mdl, c, deviceCfg.Compression, msgCompression, s.cfg.FolderPasswords(remoteID), s.keyGen)
 		s.accountAddedConnection(protoConn, hello, s.cfg.Options().ConnectionPriorityUpgradeThreshold)
 		go func() {
 			<-protoConn.Closed()
@@ -459,10 +507,32 @@ func (s *service) handleHellos(ctx context.Context) error {
 		l.Infof("Established secure connection to %s at %s", remoteID.Short(), c)
 
 		s.model.AddConnection(protoConn, hello)
+
+		// Dial the further connections the device should have right away,
+		// rather than one per redial interval.
+		if s.numConnectionsForDevice(remoteID) < s.desiredConnectionsToDevice(remoteID) {
+			s.scheduleDialMore(remoteID)
+		}
 		continue
 	}
 }
 
+// malformedRecordingModel counts connections closed because of malformed
+// messages against the device's budget, before passing them on.
+type malformedRecordingModel struct {
+	protocol.Model
+	budget *protocol.MalformedBudget
+}
+
+func (m *malformedRecordingModel) Closed(conn protocol.Connection, err error) {
+	if errors.Is(err, protocol.ErrMalformedMessage) {
+		if until := m.budget.Record(conn.DeviceID()); !until.IsZero() {
+			l.Warnf("Not connecting to %s until %s, after repeated malformed messages (last: %v)", conn.DeviceID().Short(), until.Format(time.TimeOnly), err)
+		}
+	}
+	m.Model.Closed(conn, err)
+}
+
 func (s *service) connect(ctx context.Context) error {
 	// Map of when to earliest dial each given device + address again
 	nextDialAt := make(nextDialRegistry)
@@ -521,6 +591,10 @@ func (s *service) connect(ctx context.Context) error {
 				nextDialAt.redialDevice(device, now)
 			}
 			s.dialNowDevices = make(map[protocol.DeviceID]struct{})
+			for device := range s.dialMoreDevices {
+				nextDialAt.redialForMoreConnections(device)
+			}
+			s.dialMoreDevices = make(map[protocol.DeviceID]struct{})
 			s.dialNowDevicesMut.Unlock()
 			timeout.Stop()
 		case <-timeout.C:
@@ -574,6 +648,12 @@ func (s *service) dialDevices(ctx context.Context, now time.Time, cfg config.Con
 			continue
 		}
 
+		// ... nor to those that keep sending us garbage.
+		if s.malformed.BackingOff(deviceCfg.DeviceID) {
+			l.Debugf("Skipping dial to %s, backing off after malformed messages", deviceCfg.DeviceID.Short())
+			continue
+		}
+
 		// See if we are already connected and, if so, what our cutoff is
 		// for dialer priority.
 		priorityCutoff := worstDialerPriority
@@ -689,6 +769,9 @@ func (s *service) resolveDialTargets(ctx context.Context, now time.Time, cfg con
 		}
 
 		dialerFactory, err := getDialerFactory(cfg, uri)
+		if _, ok := dialerFactory.(relayDialerFactory); ok && s.relayBudget.isExceeded() {
+			err = errRelayBudgetExceeded
+		}
 		if err != nil {
 			s.setConnectionStatus(addr, err)
 		}
@@ -700,7 +783,11 @@ func (s *service) resolveDialTargets(ctx context.Context, now time.Time, cfg con
 			continue
 		}
 
-		dialer := dialerFactory.New(s.cfg.Options(), s.tlsCfg, s.registry, s.lanChecker)
+		opts := s.cfg.Options()
+		if deviceCfg.ProxyURL != "" {
+			opts.ProxyURL = deviceCfg.ProxyURL
+		}
+		dialer := dialerFactory.New(opts, s.tlsCfg, s.registry, s.lanChecker)
 		priority := dialer.Priority(uri.Host)
 		currentConns := s.numConnectionsForDevice(deviceCfg.DeviceID)
 		if priority > priorityCutoff {
@@ -864,6 +951,20 @@ func (s *service) CommitConfiguration(from, to config.Configuration) bool {
 
 	s.checkAndSignalConnectLoopOnUpdatedDevices(from, to)
 
+	// The budget itself may have changed.
+	if changed, exceeded := s.relayBudget.update(time.Now()); changed && exceeded {
+		s.closeRelayConnections()
+	}
+
+	s.updateListeners(to)
+
+	return true
+}
+
+// updateListeners starts and stops listeners to match the configuration.
+func (s *service) updateListeners(to config.Configuration) {
+	relayExceeded := s.relayBudget.isExceeded()
+
 	s.listenersMut.Lock()
 	seen := make(map[string]struct{})
 	for _, addr := range to.Options.ListenAddresses() {
@@ -897,6 +998,9 @@ func (s *service) CommitConfiguration(from, to config.Configuration) bool {
 		}
 
 		factory, err := getListenerFactory(to, uri)
+		if _, ok := factory.(*relayListenerFactory); ok && relayExceeded {
+			err = errRelayBudgetExceeded
+		}
 		if errors.Is(err, errUnsupported) {
 			l.Debugf("Listener for %v: %v", uri, err)
 			continue
@@ -910,7 +1014,8 @@ func (s *service) CommitConfiguration(from, to config.Configuration) bool {
 	}
 
 	for addr, listener := range s.listeners {
-		if _, ok := seen[addr]; !ok || listener.Factory().Valid(to) != nil {
+		_, isRelay := listener.Factory().(*relayListenerFactory)
+		if _, ok := seen[addr]; !ok || listener.Factory().Valid(to) != nil || isRelay && relayExceeded {
 			l.Debugln("Stopping listener", addr)
 			s.Remove(s.listenerTokens[addr])
 			delete(s.listenerTokens, addr)
@@ -918,8 +1023,30 @@ func (s *service) CommitConfiguration(from, to config.Configuration) bool {
 		}
 	}
 	s.listenersMut.Unlock()
+}
 
-	return true
+// serveRelayBudget keeps track of the relay budget, falling back to direct
+// connections only while it is exceeded.
+func (s *service) serveRelayBudget(ctx context.Context) error {
+	return s.relayBudget.serve(ctx, func(exceeded bool) {
+		if exceeded {
+			s.closeRelayConnections()
+		}
+		s.updateListeners(s.cfg.RawCopy())
+		if !exceeded {
+			s.scheduleDialNow()
+		}
+	})
+}
+
+func (s *service) RelayBudgetStatus() RelayBudgetStatus {
+	return s.relayBudget.status()
+}
+
+// IdentityLog returns the identity log entries for the device, or all of
+// them for the empty device ID.
+func (s *service) IdentityLog(device protocol.DeviceID) []IdentityLogEntry {
+	return s.identityLog.list(device)
 }
 
 func (s *service) checkAndSignalConnectLoopOnUpdatedDevices(from, to config.Configuration) {
@@ -935,6 +1062,9 @@ func (s *service) checkAndSignalConnectLoopOnUpdatedDevices(from, to config.Conf
 			dial = true
 		} else if !slices.Equal(oldDev.Addresses, dev.Addresses) {
 			dial = true
+		} else if dev.NumConnections() > oldDev.NumConnections() {
+			s.dialMoreDevices[dev.DeviceID] = struct{}{}
+			dial = true
 		}
 	}
 	if dial {
@@ -943,6 +1073,13 @@ func (s *service) checkAndSignalConnectLoopOnUpdatedDevices(from, to config.Conf
 	s.dialNowDevicesMut.Unlock()
 }
 
+func (s *service) scheduleDialMore(device protocol.DeviceID) {
+	s.dialNowDevicesMut.Lock()
+	s.dialMoreDevices[device] = struct{}{}
+	s.scheduleDialNow()
+	s.dialNowDevicesMut.Unlock()
+}
+
 func (s *service) scheduleDialNow() {
 	select {
 	case s.dialNow <- struct{}{}:
@@ -1292,6 +1429,17 @@ func (r nextDialRegistry) redialDevice(device protocol.DeviceID, now time.Time)
 	delete(r, device)
 }
 
+// redialForMoreConnections marks the device for immediate redial, to
+// establish further connections to it. Unlike redialDevice this isn't
+// subject to the cool down, as each dial adds a connection instead of
+// replacing one that was dropped.
+func (r nextDialRegistry) redialForMoreConnections(device protocol.DeviceID) {
+	if dev, ok := r[device]; ok {
+		dev.nextDial = make(map[string]time.Time)
+		r[device] = dev
+	}
+}
+
 func (r nextDialRegistry) set(device protocol.DeviceID, addr string, next time.Time) {
 	if _, ok := r[device]; !ok {
 		r[device] = nextDialDevice{nextDial: make(map[string]time.Time)}
@@ -1460,6 +1608,20 @@ func (c *deviceConnectionTracker) closeWorsePriorityConnectionsLocked(d protocol
 	}
 }
 
+// closeRelayConnections closes all connections that go via a relay.
+func (c *deviceConnectionTracker) closeRelayConnections() {
+	c.connectionsMut.Lock()
+	defer c.connectionsMut.Unlock()
+	for d, conns := range c.connections {
+		for _, conn := range conns {
+			if t := conn.Type(); t == connTypeRelayClient.String() || t == connTypeRelayServer.String() {
+				l.Debugf("Closing relay connection %s to %s", conn, d.Short())
+				go conn.Close(errRelayBudgetExceeded)
+			}
+		}
+	}
+}
+
 // newConnectionID generates a connection ID. The connection ID is designed
 // to be unique for each connection and chronologically sortable. It is
 // based on the sum of two timestamps: when we think the connection was
@@ -1479,3 +1641,20 @@ func newConnectionID(t0, t1 int64) string {
 	// from the random. We want the timestamp part deterministic.
 	return enc.EncodeToString(buf[:8]) + enc.EncodeToString(buf[8:])
 }
+
+// measureClockOffset estimates how far the remote clock is ahead of ours,
+// from the timestamps in the Hello messages. Both sides send their Hello
+// at about the same time, so the remote timestamp should be about halfway
+// between sending ours (sent) and receiving theirs (received). The error
+// is at most half the round trip, and zero is returned when the offset
+// isn't larger than that or the remote didn't send a timestamp.
+func measureClockOffset(sent, remote, received int64) time.Duration {
+	if remote == 0 || received < sent {
+		return 0
+	}
+	offset := time.Duration(remote - (sent+received)/2)
+	if uncertainty := time.Duration(received-sent) / 2; offset.Abs() <= uncertainty {
+		return 0
+	}
+	return offset
+}
@@ -17,6 +17,7 @@ import (
 
 	"github.com/syncthing/syncthing/lib/config"
 	"github.com/syncthing/syncthing/lib/connections/registry"
+	"github.com/syncthing/syncthing/lib/dialer"
 	"github.com/syncthing/syncthing/lib/nat"
 	"github.com/syncthing/syncthing/lib/osutil"
 	"github.com/syncthing/syncthing/lib/protocol"
@@ -42,7 +43,8 @@ type internalConn struct {
 	isLocal       bool
 	priority      int
 	establishedAt time.Time
-	connectionID  string // set after Hello exchange
+	connectionID  string        // set after Hello exchange
+	clockOffset   time.Duration // set after Hello exchange
 }
 
 type connType int
@@ -54,6 +56,10 @@ const (
 	connTypeTCPServer
 	connTypeQUICClient
 	connTypeQUICServer
+	connTypeWSSClient
+	connTypeWSSServer
+	connTypeObfsClient
+	connTypeObfsServer
 )
 
 func (t connType) String() string {
@@ -70,6 +76,14 @@ func (t connType) String() string {
 		return "quic-client"
 	case connTypeQUICServer:
 		return "quic-server"
+	case connTypeWSSClient:
+		return "wss-client"
+	case connTypeWSSServer:
+		return "wss-server"
+	case connTypeObfsClient:
+		return "obfs-client"
+	case connTypeObfsServer:
+		return "obfs-server"
 	default:
 		return "unknown-type"
 	}
@@ -83,6 +97,10 @@ func (t connType) Transport() string {
 		return "tcp"
 	case connTypeQUICClient, connTypeQUICServer:
 		return "quic"
+	case connTypeWSSClient, connTypeWSSServer:
+		return "wss"
+	case connTypeObfsClient, connTypeObfsServer:
+		return "obfs"
 	default:
 		return "unknown"
 	}
@@ -111,6 +129,10 @@ func (c internalConn) Type() string {
 	return c.connType.String()
 }
 
+func (c internalConn) isRelay() bool {
+	return c.connType == connTypeRelayClient || c.connType == connTypeRelayServer
+}
+
 func (c internalConn) IsLocal() bool {
 	return c.isLocal
 }
@@ -144,6 +166,12 @@ func (c internalConn) ConnectionID() string {
 	return c.connectionID
 }
 
+// ClockOffset returns how far the remote device's clock is ahead of ours,
+// as measured when the connection was established.
+func (c internalConn) ClockOffset() time.Duration {
+	return c.clockOffset
+}
+
 func (c internalConn) String() string {
 	t := "WAN"
 	if c.isLocal {
@@ -160,7 +188,9 @@ type dialerFactory interface {
 }
 
 type commonDialer struct {
-	trafficClass      int
+	trafficClassLAN   int
+	trafficClassWAN   int
+	socketOpts        dialer.SocketOptions
 	reconnectInterval time.Duration
 	tlsCfg            *tls.Config
 	lanChecker        *lanChecker
@@ -169,6 +199,13 @@ type commonDialer struct {
 	allowsMultiConns  bool
 }
 
+func (d *commonDialer) trafficClass(isLocal bool) int {
+	if isLocal {
+		return d.trafficClassLAN
+	}
+	return d.trafficClassWAN
+}
+
 func (d *commonDialer) RedialFrequency() time.Duration {
 	return d.reconnectInterval
 }
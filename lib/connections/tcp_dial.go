@@ -23,19 +23,46 @@ func init() {
 	for _, scheme := range []string{"tcp", "tcp4", "tcp6"} {
 		dialers[scheme] = factory
 	}
+	dialers["obfs"] = &tcpDialerFactory{obfuscated: true}
 }
 
 type tcpDialer struct {
 	commonDialer
-	registry *registry.Registry
+	registry   *registry.Registry
+	proxyURL   string
+	obfuscated bool
+	obfsSecret string
 }
 
 func (d *tcpDialer) Dial(ctx context.Context, _ protocol.DeviceID, uri *url.URL) (internalConn, error) {
-	uri = fixupPort(uri, config.DefaultTCPPort)
+	network, connType := uri.Scheme, connTypeTCPClient
+	var obfs obfuscator
+	if d.obfuscated {
+		uri = fixupPort(uri, config.DefaultObfsPort)
+		network, connType = "tcp", connTypeObfsClient
+		var err error
+		obfs, err = obfuscatorFor(uri, d.obfsSecret)
+		if err != nil {
+			return internalConn{}, err
+		}
+	} else {
+		uri = fixupPort(uri, config.DefaultTCPPort)
+	}
 
 	timeoutCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
 	defer cancel()
-	conn, err := dialer.DialContextReusePortFunc(d.registry)(timeoutCtx, uri.Scheme, uri.Host)
+	dial := dialer.DialContextReusePortFunc(d.registry)
+	if d.obfuscated {
+		dial = dialer.DialContext
+	}
+	if d.proxyURL != "" {
+		var err error
+		dial, err = dialer.DialContextProxyFunc(d.proxyURL)
+		if err != nil {
+			return internalConn{}, err
+		}
+	}
+	conn, err := dial(timeoutCtx, network, uri.Host)
 	if err != nil {
 		return internalConn{}, err
 	}
@@ -45,10 +72,18 @@ func (d *tcpDialer) Dial(ctx context.Context, _ protocol.DeviceID, uri *url.URL)
 		l.Debugln("Dial (BEP/tcp): setting tcp options:", err)
 	}
 
-	err = dialer.SetTrafficClass(conn, d.trafficClass)
+	isLocal := d.lanChecker.isLAN(conn.RemoteAddr())
+	err = dialer.SetTrafficClass(conn, d.trafficClass(isLocal))
 	if err != nil {
 		l.Debugln("Dial (BEP/tcp): setting traffic class:", err)
 	}
+	if err := dialer.SetSocketOptions(conn, d.socketOpts); err != nil {
+		l.Debugln("Dial (BEP/tcp): setting socket options:", err)
+	}
+
+	if obfs != nil {
+		conn = obfs.Client(conn)
+	}
 
 	tc := tls.Client(conn, d.tlsCfg)
 	err = tlsTimedHandshake(tc)
@@ -58,20 +93,23 @@ func (d *tcpDialer) Dial(ctx context.Context, _ protocol.DeviceID, uri *url.URL)
 	}
 
 	priority := d.wanPriority
-	isLocal := d.lanChecker.isLAN(conn.RemoteAddr())
 	if isLocal {
 		priority = d.lanPriority
 	}
 
-	return newInternalConn(tc, connTypeTCPClient, isLocal, priority), nil
+	return newInternalConn(tc, connType, isLocal, priority), nil
 }
 
-type tcpDialerFactory struct{}
+type tcpDialerFactory struct {
+	obfuscated bool
+}
 
-func (tcpDialerFactory) New(opts config.OptionsConfiguration, tlsCfg *tls.Config, registry *registry.Registry, lanChecker *lanChecker) genericDialer {
+func (f tcpDialerFactory) New(opts config.OptionsConfiguration, tlsCfg *tls.Config, registry *registry.Registry, lanChecker *lanChecker) genericDialer {
 	return &tcpDialer{
 		commonDialer: commonDialer{
-			trafficClass:      opts.TrafficClass,
+			trafficClassLAN:   opts.TrafficClassFor(true),
+			trafficClassWAN:   opts.TrafficClassFor(false),
+			socketOpts:        socketOptions(opts),
 			reconnectInterval: time.Duration(opts.ReconnectIntervalS) * time.Second,
 			tlsCfg:            tlsCfg,
 			lanChecker:        lanChecker,
@@ -79,7 +117,10 @@ func (tcpDialerFactory) New(opts config.OptionsConfiguration, tlsCfg *tls.Config
 			wanPriority:       opts.ConnectionPriorityTCPWAN,
 			allowsMultiConns:  true,
 		},
-		registry: registry,
+		registry:   registry,
+		proxyURL:   opts.ProxyURL,
+		obfuscated: f.obfuscated,
+		obfsSecret: opts.ObfuscationSecret,
 	}
 }
 
@@ -87,11 +128,16 @@ func (tcpDialerFactory) AlwaysWAN() bool {
 	return false
 }
 
-func (tcpDialerFactory) Valid(_ config.Configuration) error {
-	// Always valid
+func (f tcpDialerFactory) Valid(cfg config.Configuration) error {
+	if f.obfuscated && cfg.Options.ObfuscationSecret == "" {
+		return errDisabled
+	}
 	return nil
 }
 
-func (tcpDialerFactory) String() string {
+func (f tcpDialerFactory) String() string {
+	if f.obfuscated {
+		return "Obfuscated TCP Dialer"
+	}
 	return "TCP Dialer"
 }
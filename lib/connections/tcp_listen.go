@@ -26,6 +26,7 @@ func init() {
 	for _, scheme := range []string{"tcp", "tcp4", "tcp6"} {
 		listeners[scheme] = factory
 	}
+	listeners["obfs"] = &tcpListenerFactory{obfuscated: true}
 }
 
 type tcpListener struct {
@@ -39,6 +40,7 @@ type tcpListener struct {
 	factory    listenerFactory
 	registry   *registry.Registry
 	lanChecker *lanChecker
+	obfuscated bool
 
 	natService *nat.Service
 	mapping    *nat.Mapping
@@ -48,7 +50,8 @@ type tcpListener struct {
 }
 
 func (t *tcpListener) serve(ctx context.Context) error {
-	tcaddr, err := net.ResolveTCPAddr(t.uri.Scheme, t.uri.Host)
+	network := t.network()
+	tcaddr, err := net.ResolveTCPAddr(network, t.uri.Host)
 	if err != nil {
 		l.Infoln("Listen (BEP/tcp):", err)
 		return err
@@ -58,7 +61,7 @@ func (t *tcpListener) serve(ctx context.Context) error {
 		Control: dialer.ReusePortControl,
 	}
 
-	listener, err := lc.Listen(context.TODO(), t.uri.Scheme, tcaddr.String())
+	listener, err := lc.Listen(context.TODO(), network, tcaddr.String())
 	if err != nil {
 		l.Infoln("Listen (BEP/tcp):", err)
 		return err
@@ -71,16 +74,25 @@ func (t *tcpListener) serve(ctx context.Context) error {
 	t.notifyAddressesChanged(t)
 	defer t.clearAddresses(t)
 
-	t.registry.Register(t.uri.Scheme, tcaddr)
-	defer t.registry.Unregister(t.uri.Scheme, tcaddr)
+	// Dialing from the port of an obfuscated listener would make the
+	// connection look like what it is.
+	if !t.obfuscated {
+		t.registry.Register(network, tcaddr)
+		defer t.registry.Unregister(network, tcaddr)
+	}
 
-	l.Infof("TCP listener (%v) starting", tcaddr)
-	defer l.Infof("TCP listener (%v) shutting down", tcaddr)
+	if t.obfuscated {
+		l.Infof("Obfuscated TCP listener (%v) starting", tcaddr)
+		defer l.Infof("Obfuscated TCP listener (%v) shutting down", tcaddr)
+	} else {
+		l.Infof("TCP listener (%v) starting", tcaddr)
+		defer l.Infof("TCP listener (%v) shutting down", tcaddr)
+	}
 
 	var ipVersion nat.IPVersion
-	if t.uri.Scheme == "tcp4" {
+	if network == "tcp4" {
 		ipVersion = nat.IPv4Only
-	} else if t.uri.Scheme == "tcp6" {
+	} else if network == "tcp6" {
 		ipVersion = nat.IPv6Only
 	} else {
 		ipVersion = nat.IPvAny
@@ -144,11 +156,27 @@ func (t *tcpListener) serve(ctx context.Context) error {
 			l.Debugln("Listen (BEP/tcp): setting tcp options:", err)
 		}
 
-		if tc := t.cfg.Options().TrafficClass; tc != 0 {
+		isLocal := t.lanChecker.isLAN(conn.RemoteAddr())
+		if tc := t.cfg.Options().TrafficClassFor(isLocal); tc != 0 {
 			if err := dialer.SetTrafficClass(conn, tc); err != nil {
 				l.Debugln("Listen (BEP/tcp): setting traffic class:", err)
 			}
 		}
+		if err := dialer.SetSocketOptions(conn, socketOptions(t.cfg.Options())); err != nil {
+			l.Debugln("Listen (BEP/tcp): setting socket options:", err)
+		}
+
+		connType := connTypeTCPServer
+		if t.obfuscated {
+			obfs, err := obfuscatorFor(t.uri, t.cfg.Options().ObfuscationSecret)
+			if err != nil {
+				l.Infoln("Listen (BEP/obfs):", err)
+				conn.Close()
+				continue
+			}
+			conn = obfs.Server(conn)
+			connType = connTypeObfsServer
+		}
 
 		tc := tls.Server(conn, t.tlsCfg)
 		if err := tlsTimedHandshake(tc); err != nil {
@@ -158,11 +186,10 @@ func (t *tcpListener) serve(ctx context.Context) error {
 		}
 
 		priority := t.cfg.Options().ConnectionPriorityTCPWAN
-		isLocal := t.lanChecker.isLAN(conn.RemoteAddr())
 		if isLocal {
 			priority = t.cfg.Options().ConnectionPriorityTCPLAN
 		}
-		t.conns <- newInternalConn(tc, connTypeTCPServer, isLocal, priority)
+		t.conns <- newInternalConn(tc, connType, isLocal, priority)
 	}
 }
 
@@ -197,7 +224,7 @@ func (t *tcpListener) WANAddresses() []*url.URL {
 
 	// If we support ReusePort, add an unspecified zero port address, which will be resolved by the discovery server
 	// in hopes that TCP punch through works.
-	if dialer.SupportsReusePort {
+	if dialer.SupportsReusePort && !t.obfuscated {
 		uri := *t.uri
 		uri.Host = "0.0.0.0:0"
 		uris = append([]*url.URL{&uri}, uris...)
@@ -210,7 +237,7 @@ func (t *tcpListener) LANAddresses() []*url.URL {
 	uri := maybeReplacePort(t.uri, t.laddr)
 	t.mut.RUnlock()
 	addrs := []*url.URL{uri}
-	addrs = append(addrs, getURLsForAllAdaptersIfUnspecified(uri.Scheme, uri)...)
+	addrs = append(addrs, getURLsForAllAdaptersIfUnspecified(t.network(), uri)...)
 	return addrs
 }
 
@@ -226,11 +253,22 @@ func (*tcpListener) NATType() string {
 	return "unknown"
 }
 
-type tcpListenerFactory struct{}
+// network returns the network to listen on, which is plain TCP for
+// obfuscated listeners.
+func (t *tcpListener) network() string {
+	if t.obfuscated {
+		return "tcp"
+	}
+	return t.uri.Scheme
+}
+
+type tcpListenerFactory struct {
+	obfuscated bool
+}
 
 func (f *tcpListenerFactory) New(uri *url.URL, cfg config.Wrapper, tlsCfg *tls.Config, conns chan internalConn, natService *nat.Service, registry *registry.Registry, lanChecker *lanChecker) genericListener {
 	l := &tcpListener{
-		uri:        fixupPort(uri, config.DefaultTCPPort),
+		uri:        fixupPort(uri, f.defaultPort()),
 		cfg:        cfg,
 		tlsCfg:     tlsCfg,
 		conns:      conns,
@@ -238,12 +276,22 @@ func (f *tcpListenerFactory) New(uri *url.URL, cfg config.Wrapper, tlsCfg *tls.C
 		factory:    f,
 		registry:   registry,
 		lanChecker: lanChecker,
+		obfuscated: f.obfuscated,
 	}
 	l.ServiceWithError = svcutil.AsService(l.serve, l.String())
 	return l
 }
 
-func (tcpListenerFactory) Valid(_ config.Configuration) error {
-	// Always valid
+func (f *tcpListenerFactory) defaultPort() int {
+	if f.obfuscated {
+		return config.DefaultObfsPort
+	}
+	return config.DefaultTCPPort
+}
+
+func (f *tcpListenerFactory) Valid(cfg config.Configuration) error {
+	if f.obfuscated && cfg.Options.ObfuscationSecret == "" {
+		return errDisabled
+	}
 	return nil
 }
@@ -12,9 +12,21 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/syncthing/syncthing/lib/config"
+	"github.com/syncthing/syncthing/lib/dialer"
 	"github.com/syncthing/syncthing/lib/osutil"
 )
 
+// socketOptions returns the configured socket tuning options.
+func socketOptions(opts config.OptionsConfiguration) dialer.SocketOptions {
+	return dialer.SocketOptions{
+		SendBuffer:        opts.SocketSendBufferKiB * 1024,
+		ReceiveBuffer:     opts.SocketReceiveBufferKiB * 1024,
+		NotSentLowat:      opts.TCPNotSentLowat,
+		CongestionControl: opts.TCPCongestionControl,
+	}
+}
+
 func fixupPort(uri *url.URL, defaultPort int) *url.URL {
 	copyURI := *uri
 
@@ -0,0 +1,151 @@
+// Copyright (C) 2026 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package connections
+
+import (
+	"context"
+	"crypto/tls"
+	"net"
+	"net/url"
+	"time"
+
+	"golang.org/x/net/http/httpproxy"
+	"golang.org/x/net/websocket"
+
+	"github.com/syncthing/syncthing/lib/config"
+	"github.com/syncthing/syncthing/lib/connections/registry"
+	"github.com/syncthing/syncthing/lib/dialer"
+	"github.com/syncthing/syncthing/lib/protocol"
+)
+
+func init() {
+	dialers["wss"] = wssDialerFactory{}
+}
+
+type wssDialer struct {
+	commonDialer
+	proxyURL string
+}
+
+func (d *wssDialer) Dial(ctx context.Context, _ protocol.DeviceID, uri *url.URL) (internalConn, error) {
+	uri = fixupPort(uri, config.DefaultWSSPort)
+
+	timeoutCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+	dial, err := d.dialFunc(uri)
+	if err != nil {
+		return internalConn{}, err
+	}
+	conn, err := dial(timeoutCtx, "tcp", uri.Host)
+	if err != nil {
+		return internalConn{}, err
+	}
+
+	if err := dialer.SetTCPOptions(conn); err != nil {
+		l.Debugln("Dial (BEP/wss): setting tcp options:", err)
+	}
+	isLocal := d.lanChecker.isLAN(conn.RemoteAddr())
+	if err := dialer.SetTrafficClass(conn, d.trafficClass(isLocal)); err != nil {
+		l.Debugln("Dial (BEP/wss): setting traffic class:", err)
+	}
+	if err := dialer.SetSocketOptions(conn, d.socketOpts); err != nil {
+		l.Debugln("Dial (BEP/wss): setting socket options:", err)
+	}
+
+	// The BEP TLS session runs inside the WebSocket and authenticates both
+	// devices the usual way. The outer TLS layer only serves to look like
+	// any other HTTPS traffic, and is often terminated by an inspecting
+	// proxy anyway, so there is no point in verifying its certificate.
+	host, _, _ := net.SplitHostPort(uri.Host)
+	outer := tls.Client(conn, &tls.Config{
+		ServerName:         host,
+		InsecureSkipVerify: true,
+		MinVersion:         tls.VersionTLS12,
+		NextProtos:         wssNextProtos,
+	})
+	if err := tlsTimedHandshake(outer); err != nil {
+		outer.Close()
+		return internalConn{}, err
+	}
+
+	location := url.URL{Scheme: "wss", Host: uri.Host, Path: uri.Path}
+	if location.Path == "" {
+		location.Path = "/"
+	}
+	origin := url.URL{Scheme: "https", Host: uri.Host}
+	wsCfg, err := websocket.NewConfig(location.String(), origin.String())
+	if err != nil {
+		outer.Close()
+		return internalConn{}, err
+	}
+	_ = outer.SetDeadline(time.Now().Add(tlsHandshakeTimeout))
+	ws, err := websocket.NewClient(wsCfg, outer)
+	_ = outer.SetDeadline(time.Time{})
+	if err != nil {
+		outer.Close()
+		return internalConn{}, err
+	}
+
+	tc := tls.Client(newWSSConn(ws, conn), d.tlsCfg)
+	if err := tlsTimedHandshake(tc); err != nil {
+		tc.Close()
+		return internalConn{}, err
+	}
+
+	priority := d.wanPriority
+	if isLocal {
+		priority = d.lanPriority
+	}
+
+	return newInternalConn(tc, connTypeWSSClient, isLocal, priority), nil
+}
+
+// dialFunc returns the function to dial the given address with. Unless a
+// proxy is configured, an HTTPS proxy from the environment is used, as that
+// is commonly the only way out of the networks this transport is for.
+func (d *wssDialer) dialFunc(uri *url.URL) (func(ctx context.Context, network, addr string) (net.Conn, error), error) {
+	if d.proxyURL != "" {
+		return dialer.DialContextProxyFunc(d.proxyURL)
+	}
+	proxyURL, err := httpproxy.FromEnvironment().ProxyFunc()(&url.URL{Scheme: "https", Host: uri.Host})
+	if err == nil && proxyURL != nil {
+		return dialer.DialContextProxyFunc(proxyURL.String())
+	}
+	return dialer.DialContext, nil
+}
+
+type wssDialerFactory struct{}
+
+func (wssDialerFactory) New(opts config.OptionsConfiguration, tlsCfg *tls.Config, _ *registry.Registry, lanChecker *lanChecker) genericDialer {
+	return &wssDialer{
+		commonDialer: commonDialer{
+			trafficClassLAN:   opts.TrafficClassFor(true),
+			trafficClassWAN:   opts.TrafficClassFor(false),
+			socketOpts:        socketOptions(opts),
+			reconnectInterval: time.Duration(opts.ReconnectIntervalS) * time.Second,
+			tlsCfg:            tlsCfg,
+			lanChecker:        lanChecker,
+			lanPriority:       opts.ConnectionPriorityWSS,
+			wanPriority:       opts.ConnectionPriorityWSS,
+			allowsMultiConns:  true,
+		},
+		proxyURL: opts.ProxyURL,
+	}
+}
+
+func (wssDialerFactory) AlwaysWAN() bool {
+	return false
+}
+
+func (wssDialerFactory) Valid(_ config.Configuration) error {
+	// Always valid
+	return nil
+}
+
+func (wssDialerFactory) String() string {
+	return "WebSocket Dialer"
+}
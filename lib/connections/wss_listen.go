@@ -0,0 +1,212 @@
+// Copyright (C) 2026 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package connections
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"log"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/net/websocket"
+
+	"github.com/syncthing/syncthing/lib/config"
+	"github.com/syncthing/syncthing/lib/connections/registry"
+	"github.com/syncthing/syncthing/lib/dialer"
+	"github.com/syncthing/syncthing/lib/nat"
+	"github.com/syncthing/syncthing/lib/svcutil"
+)
+
+func init() {
+	listeners["wss"] = &wssListenerFactory{}
+}
+
+// wssNetConnKey is the request context key for the underlying network
+// connection of a WebSocket.
+type wssNetConnKey struct{}
+
+type wssListener struct {
+	svcutil.ServiceWithError
+	onAddressesChangedNotifier
+
+	uri        *url.URL
+	cfg        config.Wrapper
+	tlsCfg     *tls.Config
+	conns      chan internalConn
+	factory    listenerFactory
+	lanChecker *lanChecker
+
+	laddr net.Addr
+	mut   sync.RWMutex
+}
+
+func (t *wssListener) serve(ctx context.Context) error {
+	tcaddr, err := net.ResolveTCPAddr("tcp", t.uri.Host)
+	if err != nil {
+		l.Infoln("Listen (BEP/wss):", err)
+		return err
+	}
+
+	listener, err := net.ListenTCP("tcp", tcaddr)
+	if err != nil {
+		l.Infoln("Listen (BEP/wss):", err)
+		return err
+	}
+	defer listener.Close()
+
+	// We might bind to :0, so use the port we've been given.
+	tcaddr = listener.Addr().(*net.TCPAddr)
+
+	t.notifyAddressesChanged(t)
+	defer t.clearAddresses(t)
+
+	l.Infof("WebSocket listener (%v) starting", tcaddr)
+	defer l.Infof("WebSocket listener (%v) shutting down", tcaddr)
+
+	t.mut.Lock()
+	t.laddr = tcaddr
+	t.mut.Unlock()
+	defer func() {
+		t.mut.Lock()
+		t.laddr = nil
+		t.mut.Unlock()
+	}()
+
+	path := t.uri.Path
+	if path == "" {
+		path = "/"
+	}
+	mux := http.NewServeMux()
+	// A websocket.Server without a handshake function accepts any origin,
+	// which is what we want as the peers aren't browsers.
+	mux.Handle(path, websocket.Server{Handler: t.handle})
+
+	srv := &http.Server{
+		Handler:           mux,
+		ReadHeaderTimeout: 10 * time.Second,
+		BaseContext:       func(net.Listener) context.Context { return ctx },
+		ConnContext: func(ctx context.Context, c net.Conn) context.Context {
+			return context.WithValue(ctx, wssNetConnKey{}, c)
+		},
+		ErrorLog: log.New(wssErrorLogWriter{}, "", 0),
+	}
+	go func() {
+		<-ctx.Done()
+		srv.Close()
+	}()
+
+	err = srv.Serve(tls.NewListener(listener, wssOuterTLSConfig(t.tlsCfg)))
+	if ctx.Err() != nil || errors.Is(err, http.ErrServerClosed) {
+		return nil
+	}
+	l.Warnln("Listen (BEP/wss):", err)
+	return err
+}
+
+// handle runs the BEP TLS handshake over an accepted WebSocket, and hands
+// over the connection. The WebSocket is closed when handle returns, so it
+// waits for the connection to be closed.
+func (t *wssListener) handle(ws *websocket.Conn) {
+	ctx := ws.Request().Context()
+	netConn, _ := ctx.Value(wssNetConnKey{}).(net.Conn)
+	conn := newWSSConn(ws, netConn)
+	l.Debugln("Listen (BEP/wss): connect from", conn.RemoteAddr())
+
+	isLocal := t.lanChecker.isLAN(conn.RemoteAddr())
+	if tc := t.cfg.Options().TrafficClassFor(isLocal); tc != 0 && netConn != nil {
+		if err := dialer.SetTrafficClass(netConn, tc); err != nil {
+			l.Debugln("Listen (BEP/wss): setting traffic class:", err)
+		}
+	}
+	if netConn != nil {
+		if err := dialer.SetSocketOptions(netConn, socketOptions(t.cfg.Options())); err != nil {
+			l.Debugln("Listen (BEP/wss): setting socket options:", err)
+		}
+	}
+
+	tc := tls.Server(conn, t.tlsCfg)
+	if err := tlsTimedHandshake(tc); err != nil {
+		l.Infoln("Listen (BEP/wss): TLS handshake:", err)
+		tc.Close()
+		return
+	}
+
+	select {
+	case t.conns <- newInternalConn(tc, connTypeWSSServer, isLocal, t.cfg.Options().ConnectionPriorityWSS):
+	case <-ctx.Done():
+		tc.Close()
+		return
+	}
+	<-conn.closed
+}
+
+func (t *wssListener) URI() *url.URL {
+	return t.uri
+}
+
+func (t *wssListener) WANAddresses() []*url.URL {
+	t.mut.RLock()
+	uri := maybeReplacePort(t.uri, t.laddr)
+	t.mut.RUnlock()
+	return []*url.URL{uri}
+}
+
+func (t *wssListener) LANAddresses() []*url.URL {
+	t.mut.RLock()
+	uri := maybeReplacePort(t.uri, t.laddr)
+	t.mut.RUnlock()
+	addrs := []*url.URL{uri}
+	addrs = append(addrs, getURLsForAllAdaptersIfUnspecified("tcp", uri)...)
+	return addrs
+}
+
+func (t *wssListener) String() string {
+	return t.uri.String()
+}
+
+func (t *wssListener) Factory() listenerFactory {
+	return t.factory
+}
+
+func (*wssListener) NATType() string {
+	return "unknown"
+}
+
+// wssErrorLogWriter sends the errors of the HTTP server, mostly failed TLS
+// handshakes from scanners, to the debug log.
+type wssErrorLogWriter struct{}
+
+func (wssErrorLogWriter) Write(p []byte) (int, error) {
+	l.Debugln("Listen (BEP/wss):", strings.TrimSpace(string(p)))
+	return len(p), nil
+}
+
+type wssListenerFactory struct{}
+
+func (f *wssListenerFactory) New(uri *url.URL, cfg config.Wrapper, tlsCfg *tls.Config, conns chan internalConn, _ *nat.Service, _ *registry.Registry, lanChecker *lanChecker) genericListener {
+	l := &wssListener{
+		uri:        fixupPort(uri, config.DefaultWSSPort),
+		cfg:        cfg,
+		tlsCfg:     tlsCfg,
+		conns:      conns,
+		factory:    f,
+		lanChecker: lanChecker,
+	}
+	l.ServiceWithError = svcutil.AsService(l.serve, l.String())
+	return l
+}
+
+func (wssListenerFactory) Valid(_ config.Configuration) error {
+	// Always valid
+	return nil
+}
@@ -0,0 +1,69 @@
+// Copyright (C) 2026 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package connections
+
+import (
+	"crypto/tls"
+	"net"
+	"sync"
+
+	"golang.org/x/net/websocket"
+)
+
+// The outer TLS layer of a WebSocket connection speaks HTTP/1.1, which the
+// WebSocket upgrade requires.
+var wssNextProtos = []string{"http/1.1"}
+
+// wssConn carries the BEP TLS stream over binary WebSocket frames, with the
+// addresses of the underlying network connection.
+type wssConn struct {
+	*websocket.Conn
+	local  net.Addr
+	remote net.Addr
+
+	closeOnce sync.Once
+	closed    chan struct{}
+}
+
+func newWSSConn(ws *websocket.Conn, netConn net.Conn) *wssConn {
+	ws.PayloadType = websocket.BinaryFrame
+	c := &wssConn{
+		Conn:   ws,
+		local:  ws.LocalAddr(),
+		remote: ws.RemoteAddr(),
+		closed: make(chan struct{}),
+	}
+	if netConn != nil {
+		c.local = netConn.LocalAddr()
+		c.remote = netConn.RemoteAddr()
+	}
+	return c
+}
+
+func (c *wssConn) LocalAddr() net.Addr {
+	return c.local
+}
+
+func (c *wssConn) RemoteAddr() net.Addr {
+	return c.remote
+}
+
+func (c *wssConn) Close() error {
+	err := c.Conn.Close()
+	c.closeOnce.Do(func() { close(c.closed) })
+	return err
+}
+
+// wssOuterTLSConfig returns the configuration for the outer TLS layer of a
+// listener, presenting the same certificate as the BEP layer.
+func wssOuterTLSConfig(tlsCfg *tls.Config) *tls.Config {
+	return &tls.Config{
+		Certificates: tlsCfg.Certificates,
+		MinVersion:   tls.VersionTLS12,
+		NextProtos:   wssNextProtos,
+	}
+}
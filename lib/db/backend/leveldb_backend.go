@@ -7,6 +7,8 @@
 package backend
 
 import (
+	"time"
+
 	"github.com/syndtr/goleveldb/leveldb"
 	"github.com/syndtr/goleveldb/leveldb/iterator"
 	"github.com/syndtr/goleveldb/leveldb/util"
@@ -87,6 +89,7 @@ func (b *leveldbBackend) Close() error {
 }
 
 func (b *leveldbBackend) Get(key []byte) ([]byte, error) {
+	defer recordOperation(metricOpGet, time.Now())
 	val, err := b.ldb.Get(key, nil)
 	return val, wrapLeveldbErr(err)
 }
@@ -100,10 +103,12 @@ func (b *leveldbBackend) NewRangeIterator(first, last []byte) (Iterator, error)
 }
 
 func (b *leveldbBackend) Put(key, val []byte) error {
+	defer recordOperation(metricOpPut, time.Now())
 	return wrapLeveldbErr(b.ldb.Put(key, val, nil))
 }
 
 func (b *leveldbBackend) Delete(key []byte) error {
+	defer recordOperation(metricOpDelete, time.Now())
 	return wrapLeveldbErr(b.ldb.Delete(key, nil))
 }
 
@@ -115,6 +120,7 @@ func (b *leveldbBackend) Compact() error {
 		return err
 	}
 	defer b.closeWG.Done()
+	defer recordOperation(metricOpCompact, time.Now())
 	return wrapLeveldbErr(b.ldb.CompactRange(util.Range{}))
 }
 
@@ -129,6 +135,7 @@ type leveldbSnapshot struct {
 }
 
 func (l leveldbSnapshot) Get(key []byte) ([]byte, error) {
+	defer recordOperation(metricOpGet, time.Now())
 	val, err := l.snap.Get(key, nil)
 	return val, wrapLeveldbErr(err)
 }
@@ -206,7 +213,10 @@ func (t *leveldbTransaction) flush() error {
 	if t.batch.Len() == 0 {
 		return nil
 	}
-	if err := t.ldb.Write(t.batch, nil); err != nil {
+	t0 := time.Now()
+	err := t.ldb.Write(t.batch, nil)
+	recordOperation(metricOpWrite, t0)
+	if err != nil {
 		return wrapLeveldbErr(err)
 	}
 	t.batch.Reset()
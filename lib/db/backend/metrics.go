@@ -0,0 +1,52 @@
+// Copyright (C) 2026 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package backend
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	metricTotalOperationSeconds = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "syncthing",
+		Subsystem: "db",
+		Name:      "operation_seconds_total",
+		Help:      "Total time spent in database operations, per operation",
+	}, []string{"operation"})
+	metricTotalOperationsCount = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "syncthing",
+		Subsystem: "db",
+		Name:      "operations_total",
+		Help:      "Total number of database operations, per operation",
+	}, []string{"operation"})
+)
+
+const (
+	metricOpGet     = "get"
+	metricOpPut     = "put"
+	metricOpDelete  = "delete"
+	metricOpWrite   = "write" // a batch from a write transaction
+	metricOpCompact = "compact"
+)
+
+func init() {
+	// Register the operations, so that counters are present even when
+	// zero.
+	for _, op := range []string{metricOpGet, metricOpPut, metricOpDelete, metricOpWrite, metricOpCompact} {
+		metricTotalOperationSeconds.WithLabelValues(op)
+		metricTotalOperationsCount.WithLabelValues(op)
+	}
+}
+
+// recordOperation counts the operation that started at t0.
+func recordOperation(op string, t0 time.Time) {
+	metricTotalOperationSeconds.WithLabelValues(op).Add(time.Since(t0).Seconds())
+	metricTotalOperationsCount.WithLabelValues(op).Inc()
+}
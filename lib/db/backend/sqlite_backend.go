@@ -0,0 +1,403 @@
+// Copyright (C) 2026 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package backend
+
+import (
+	"database/sql"
+	"errors"
+	"sync/atomic"
+	"time"
+
+	"github.com/syndtr/goleveldb/leveldb/util"
+)
+
+// sqliteBackend implements Backend on top of a SQLite database with a
+// single key-value table. Writes go through a single connection, while
+// reads and snapshots use a pool of connections that see the last
+// committed state, as the database is in WAL mode.
+type sqliteBackend struct {
+	readDB   *sql.DB
+	writeDB  *sql.DB
+	closeWG  *closeWaitGroup
+	closed   atomic.Bool
+	location string
+}
+
+func newSQLiteBackend(readDB, writeDB *sql.DB, location string) *sqliteBackend {
+	return &sqliteBackend{
+		readDB:   readDB,
+		writeDB:  writeDB,
+		closeWG:  &closeWaitGroup{},
+		location: location,
+	}
+}
+
+func (b *sqliteBackend) NewReadTransaction() (ReadTransaction, error) {
+	return b.newSnapshot()
+}
+
+func (b *sqliteBackend) newSnapshot() (*sqliteSnapshot, error) {
+	rel, err := newReleaser(b.closeWG)
+	if err != nil {
+		return nil, err
+	}
+	tx, err := b.readDB.Begin()
+	if err != nil {
+		rel.Release()
+		return nil, b.wrapErr(err)
+	}
+	// A read transaction only takes its snapshot on the first read. Do
+	// that now, so that later reads don't see what was committed in the
+	// meantime, just like a leveldb snapshot.
+	var one int
+	if err := tx.QueryRow(`SELECT 1 FROM kv LIMIT 1`).Scan(&one); err != nil && !errors.Is(err, sql.ErrNoRows) {
+		_ = tx.Rollback()
+		rel.Release()
+		return nil, b.wrapErr(err)
+	}
+	return &sqliteSnapshot{
+		b:   b,
+		tx:  tx,
+		rel: rel,
+	}, nil
+}
+
+func (b *sqliteBackend) NewWriteTransaction(hooks ...CommitHook) (WriteTransaction, error) {
+	rel, err := newReleaser(b.closeWG)
+	if err != nil {
+		return nil, err
+	}
+	snap, err := b.newSnapshot()
+	if err != nil {
+		rel.Release()
+		return nil, err // already wrapped
+	}
+	return &sqliteTransaction{
+		sqliteSnapshot: snap,
+		rel:            rel,
+		commitHooks:    hooks,
+	}, nil
+}
+
+func (b *sqliteBackend) Close() error {
+	b.closeWG.CloseWait()
+	b.closed.Store(true)
+	return errors.Join(b.readDB.Close(), b.writeDB.Close())
+}
+
+func (b *sqliteBackend) Get(key []byte) ([]byte, error) {
+	if err := b.closeWG.Add(1); err != nil {
+		return nil, err
+	}
+	defer b.closeWG.Done()
+	defer recordOperation(metricOpGet, time.Now())
+	return sqliteGet(b.readDB, b, key)
+}
+
+func (b *sqliteBackend) NewPrefixIterator(prefix []byte) (Iterator, error) {
+	r := util.BytesPrefix(prefix)
+	return b.NewRangeIterator(r.Start, r.Limit)
+}
+
+func (b *sqliteBackend) NewRangeIterator(first, last []byte) (Iterator, error) {
+	rel, err := newReleaser(b.closeWG)
+	if err != nil {
+		return nil, err
+	}
+	it, err := sqliteRange(b.readDB, b, first, last)
+	if err != nil {
+		rel.Release()
+		return nil, err
+	}
+	it.rel = rel
+	return it, nil
+}
+
+func (b *sqliteBackend) Put(key, val []byte) error {
+	if err := b.closeWG.Add(1); err != nil {
+		return err
+	}
+	defer b.closeWG.Done()
+	defer recordOperation(metricOpPut, time.Now())
+	_, err := b.writeDB.Exec(sqlitePut, key, nonNil(val))
+	return b.wrapErr(err)
+}
+
+func (b *sqliteBackend) Delete(key []byte) error {
+	if err := b.closeWG.Add(1); err != nil {
+		return err
+	}
+	defer b.closeWG.Done()
+	defer recordOperation(metricOpDelete, time.Now())
+	_, err := b.writeDB.Exec(sqliteDelete, key)
+	return b.wrapErr(err)
+}
+
+// Compact returns the free pages to the file system and truncates the
+// write-ahead log, after moving its contents into the database.
+func (b *sqliteBackend) Compact() error {
+	if err := b.closeWG.Add(1); err != nil {
+		return err
+	}
+	defer b.closeWG.Done()
+	defer recordOperation(metricOpCompact, time.Now())
+	if _, err := b.writeDB.Exec(`PRAGMA incremental_vacuum`); err != nil {
+		return b.wrapErr(err)
+	}
+	_, err := b.writeDB.Exec(`PRAGMA wal_checkpoint(TRUNCATE)`)
+	return b.wrapErr(err)
+}
+
+func (b *sqliteBackend) Location() string {
+	return b.location
+}
+
+// wrapErr wraps errors so that the backend package can recognize them.
+func (b *sqliteBackend) wrapErr(err error) error {
+	switch {
+	case err == nil:
+		return nil
+	case errors.Is(err, sql.ErrNoRows):
+		return errNotFound
+	case b.closed.Load():
+		return errClosed
+	}
+	return err
+}
+
+const (
+	sqlitePut    = `INSERT INTO kv (key, value) VALUES (?, ?) ON CONFLICT (key) DO UPDATE SET value = excluded.value`
+	sqliteDelete = `DELETE FROM kv WHERE key = ?`
+)
+
+// sqliteQuerier is what sqliteGet and sqliteRange need, satisfied by both
+// the database and transactions.
+type sqliteQuerier interface {
+	QueryRow(query string, args ...any) *sql.Row
+	Query(query string, args ...any) (*sql.Rows, error)
+}
+
+func sqliteGet(q sqliteQuerier, b *sqliteBackend, key []byte) ([]byte, error) {
+	var val []byte
+	if err := q.QueryRow(`SELECT value FROM kv WHERE key = ?`, key).Scan(&val); err != nil {
+		return nil, b.wrapErr(err)
+	}
+	return val, nil
+}
+
+// sqliteRange returns an iterator over the keys from first up to, but not
+// including, last. Either may be nil, for no bound.
+func sqliteRange(q sqliteQuerier, b *sqliteBackend, first, last []byte) (*sqliteIterator, error) {
+	var rows *sql.Rows
+	var err error
+	switch {
+	case first == nil && last == nil:
+		rows, err = q.Query(`SELECT key, value FROM kv ORDER BY key`)
+	case last == nil:
+		rows, err = q.Query(`SELECT key, value FROM kv WHERE key >= ? ORDER BY key`, first)
+	case first == nil:
+		rows, err = q.Query(`SELECT key, value FROM kv WHERE key < ? ORDER BY key`, last)
+	default:
+		rows, err = q.Query(`SELECT key, value FROM kv WHERE key >= ? AND key < ? ORDER BY key`, first, last)
+	}
+	if err != nil {
+		return nil, b.wrapErr(err)
+	}
+	return &sqliteIterator{b: b, rows: rows}, nil
+}
+
+// nonNil returns the value as an empty slice rather than nil, as nil would
+// be stored as NULL.
+func nonNil(val []byte) []byte {
+	if val == nil {
+		return []byte{}
+	}
+	return val
+}
+
+// sqliteSnapshot implements backend.ReadTransaction
+type sqliteSnapshot struct {
+	b   *sqliteBackend
+	tx  *sql.Tx
+	rel *releaser
+}
+
+func (s *sqliteSnapshot) Get(key []byte) ([]byte, error) {
+	defer recordOperation(metricOpGet, time.Now())
+	return sqliteGet(s.tx, s.b, key)
+}
+
+func (s *sqliteSnapshot) NewPrefixIterator(prefix []byte) (Iterator, error) {
+	r := util.BytesPrefix(prefix)
+	return s.NewRangeIterator(r.Start, r.Limit)
+}
+
+func (s *sqliteSnapshot) NewRangeIterator(first, last []byte) (Iterator, error) {
+	return sqliteRange(s.tx, s.b, first, last)
+}
+
+func (s *sqliteSnapshot) Release() {
+	// Rolling back an already finished transaction is harmless.
+	_ = s.tx.Rollback()
+	s.rel.Release()
+}
+
+// sqliteTransaction implements backend.WriteTransaction by keeping the
+// writes in memory on top of a snapshot, like the leveldb batch, and
+// committing them in a single SQLite transaction when flushed.
+type sqliteTransaction struct {
+	*sqliteSnapshot
+	ops         []sqliteOp
+	size        int
+	rel         *releaser
+	commitHooks []CommitHook
+	inFlush     bool
+}
+
+type sqliteOp struct {
+	key    []byte
+	val    []byte
+	delete bool
+}
+
+func (t *sqliteTransaction) Delete(key []byte) error {
+	t.ops = append(t.ops, sqliteOp{key: append([]byte(nil), key...), delete: true})
+	t.size += len(key)
+	return t.checkFlush(dbFlushBatchMax)
+}
+
+func (t *sqliteTransaction) Put(key, val []byte) error {
+	t.ops = append(t.ops, sqliteOp{key: append([]byte(nil), key...), val: append([]byte{}, val...)})
+	t.size += len(key) + len(val)
+	return t.checkFlush(dbFlushBatchMax)
+}
+
+func (t *sqliteTransaction) Checkpoint() error {
+	return t.checkFlush(dbFlushBatchMin)
+}
+
+func (t *sqliteTransaction) Commit() error {
+	err := t.flush()
+	t.sqliteSnapshot.Release()
+	t.rel.Release()
+	return err
+}
+
+func (t *sqliteTransaction) Release() {
+	t.sqliteSnapshot.Release()
+	t.rel.Release()
+}
+
+// checkFlush flushes and resets the batch if its size exceeds the given size.
+func (t *sqliteTransaction) checkFlush(size int) error {
+	// Hooks might put values in the database, which triggers a checkFlush
+	// which might trigger a flush, which might trigger the hooks. Don't
+	// recurse...
+	if t.inFlush || t.size < size {
+		return nil
+	}
+	return t.flush()
+}
+
+func (t *sqliteTransaction) flush() error {
+	t.inFlush = true
+	defer func() { t.inFlush = false }()
+
+	for _, hook := range t.commitHooks {
+		if err := hook(t); err != nil {
+			return err
+		}
+	}
+	if len(t.ops) == 0 {
+		return nil
+	}
+
+	t0 := time.Now()
+	err := t.write()
+	recordOperation(metricOpWrite, t0)
+	if err != nil {
+		return t.b.wrapErr(err)
+	}
+	t.ops = t.ops[:0]
+	t.size = 0
+	return nil
+}
+
+func (t *sqliteTransaction) write() error {
+	tx, err := t.b.writeDB.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback() //nolint:errcheck
+
+	put, err := tx.Prepare(sqlitePut)
+	if err != nil {
+		return err
+	}
+	defer put.Close()
+	del, err := tx.Prepare(sqliteDelete)
+	if err != nil {
+		return err
+	}
+	defer del.Close()
+
+	for _, op := range t.ops {
+		if op.delete {
+			_, err = del.Exec(op.key)
+		} else {
+			_, err = put.Exec(op.key, op.val)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
+type sqliteIterator struct {
+	b    *sqliteBackend
+	rows *sql.Rows
+	rel  *releaser // only set for iterators on the database itself
+	key  []byte
+	val  []byte
+	err  error
+}
+
+func (it *sqliteIterator) Next() bool {
+	if it.err != nil {
+		return false
+	}
+	if !it.rows.Next() {
+		it.err = it.b.wrapErr(it.rows.Err())
+		it.key, it.val = nil, nil
+		return false
+	}
+	if err := it.rows.Scan(&it.key, &it.val); err != nil {
+		it.err = it.b.wrapErr(err)
+		return false
+	}
+	return true
+}
+
+func (it *sqliteIterator) Key() []byte {
+	return it.key
+}
+
+func (it *sqliteIterator) Value() []byte {
+	return it.val
+}
+
+func (it *sqliteIterator) Error() error {
+	return it.err
+}
+
+func (it *sqliteIterator) Release() {
+	_ = it.rows.Close()
+	if it.rel != nil {
+		it.rel.Release()
+	}
+}
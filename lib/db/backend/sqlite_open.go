@@ -0,0 +1,217 @@
+// Copyright (C) 2026 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package backend
+
+import (
+	"database/sql"
+	"fmt"
+	"net/url"
+	"os"
+	"time"
+
+	_ "modernc.org/sqlite" // register the "sqlite" driver
+)
+
+const (
+	// The page cache per connection, in KiB, for small and large databases.
+	sqliteCacheSmall = 2 << (MiB - KiB)
+	sqliteCacheLarge = 64 << (MiB - KiB)
+
+	// How long to wait for a lock held by another connection, e.g. while
+	// checkpointing, before failing.
+	sqliteBusyTimeout = 30 * time.Second
+
+	// Log the progress of a migration every so many keys.
+	migrateLogInterval = 1000000
+)
+
+// OpenSQLite opens the SQLite database at the given location, creating it
+// if it doesn't exist. Unlike leveldb, SQLite commits transactions
+// atomically and durably, so there is no recovery to run.
+func OpenSQLite(location string, tuning Tuning) (Backend, error) {
+	large := false
+	switch tuning {
+	case TuningLarge:
+		large = true
+	case TuningAuto:
+		large = sqliteIsLarge(location)
+	}
+	cache := sqliteCacheSmall
+	if large {
+		l.Infoln("Using large-database tuning")
+		cache = sqliteCacheLarge
+	}
+
+	writeDB, err := openSQLiteDB(location, cache, true)
+	if err != nil {
+		return nil, err
+	}
+	// Only one connection can write at a time, so we might as well
+	// serialise the writes here instead of waiting for locks.
+	writeDB.SetMaxOpenConns(1)
+	// The auto vacuum mode can only be changed before the first table is
+	// created, so this is a no-op on an existing database.
+	if _, err := writeDB.Exec(`PRAGMA auto_vacuum = INCREMENTAL`); err != nil {
+		writeDB.Close()
+		return nil, fmt.Errorf("setting up database: %w", err)
+	}
+	if _, err := writeDB.Exec(`CREATE TABLE IF NOT EXISTS kv (key BLOB NOT NULL PRIMARY KEY, value BLOB NOT NULL) WITHOUT ROWID`); err != nil {
+		writeDB.Close()
+		return nil, fmt.Errorf("setting up database: %w", err)
+	}
+
+	readDB, err := openSQLiteDB(location, cache, false)
+	if err != nil {
+		writeDB.Close()
+		return nil, err
+	}
+
+	return newSQLiteBackend(readDB, writeDB, location), nil
+}
+
+func openSQLiteDB(location string, cacheKiB int, write bool) (*sql.DB, error) {
+	params := url.Values{}
+	params.Add("_pragma", fmt.Sprintf("busy_timeout(%d)", sqliteBusyTimeout.Milliseconds()))
+	params.Add("_pragma", "journal_mode(WAL)")
+	// In WAL mode, a normal sync is enough to never corrupt the database.
+	// The transactions committed last may be lost on power loss.
+	params.Add("_pragma", "synchronous(NORMAL)")
+	params.Add("_pragma", fmt.Sprintf("cache_size(-%d)", cacheKiB))
+	if write {
+		// Take the write lock when beginning the transaction, instead of
+		// failing to upgrade a read lock later.
+		params.Set("_txlock", "immediate")
+	}
+	db, err := sql.Open("sqlite", "file:"+location+"?"+params.Encode())
+	if err != nil {
+		return nil, err
+	}
+	if err := db.Ping(); err != nil {
+		db.Close()
+		return nil, err
+	}
+	return db, nil
+}
+
+func sqliteIsLarge(location string) bool {
+	if ^uint(0)>>63 == 0 {
+		// We're compiled for a 32 bit architecture, where we don't want to
+		// use much memory.
+		return false
+	}
+	fi, err := os.Stat(location)
+	if err != nil {
+		return false
+	}
+	return fi.Size() > dbLargeThreshold
+}
+
+// OpenSQLiteMigrating opens the SQLite database at the given location like
+// OpenSQLite. When the SQLite database doesn't exist yet but there is a
+// leveldb database at ldbLocation, its contents are copied over first and
+// the leveldb database is then moved aside, adding ".migrated" to its name.
+// An interrupted migration is started over on the next open.
+func OpenSQLiteMigrating(location, ldbLocation string, tuning Tuning) (Backend, error) {
+	if _, err := os.Stat(location); os.IsNotExist(err) {
+		if _, err := os.Stat(ldbLocation); err == nil {
+			if err := migrateLevelDBToSQLite(ldbLocation, location, tuning); err != nil {
+				return nil, fmt.Errorf("migrating database: %w", err)
+			}
+		}
+	}
+	return OpenSQLite(location, tuning)
+}
+
+// migrateLevelDBToSQLite copies all keys from the leveldb database to a new
+// SQLite database at the given location. The SQLite database is written
+// under a temporary name and only renamed into place when complete.
+func migrateLevelDBToSQLite(ldbLocation, location string, tuning Tuning) error {
+	l.Infof("Migrating database from %s to %s", ldbLocation, location)
+	t0 := time.Now()
+
+	src, err := OpenLevelDB(ldbLocation, tuning)
+	if err != nil {
+		return err
+	}
+	defer src.Close()
+
+	tmp := location + ".tmp"
+	for _, suffix := range []string{"", "-wal", "-shm"} {
+		if err := os.Remove(tmp + suffix); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+	dst, err := OpenSQLite(tmp, tuning)
+	if err != nil {
+		return err
+	}
+	n, err := copyBackend(src, dst)
+	if err == nil {
+		// Move everything from the write-ahead log into the database
+		// file, which is all we rename.
+		err = dst.Compact()
+	}
+	if cerr := dst.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		return err
+	}
+	if err := src.Close(); err != nil {
+		return err
+	}
+
+	if err := os.Rename(tmp, location); err != nil {
+		return err
+	}
+	migrated := ldbLocation + ".migrated"
+	if err := os.RemoveAll(migrated); err != nil {
+		return err
+	}
+	if err := os.Rename(ldbLocation, migrated); err != nil {
+		return err
+	}
+
+	l.Infof("Migrated %d database keys in %v; the old database was kept at %s and can be removed", n, time.Since(t0).Truncate(time.Second), migrated)
+	return nil
+}
+
+// copyBackend copies all keys from src to dst, returning the number of
+// keys copied.
+func copyBackend(src, dst Backend) (int, error) {
+	snap, err := src.NewReadTransaction()
+	if err != nil {
+		return 0, err
+	}
+	defer snap.Release()
+	it, err := snap.NewPrefixIterator(nil)
+	if err != nil {
+		return 0, err
+	}
+	defer it.Release()
+
+	tx, err := dst.NewWriteTransaction()
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Release()
+
+	n := 0
+	for it.Next() {
+		if err := tx.Put(it.Key(), it.Value()); err != nil {
+			return n, err
+		}
+		n++
+		if n%migrateLogInterval == 0 {
+			l.Infof("Migrated %d database keys", n)
+		}
+	}
+	if err := it.Error(); err != nil {
+		return n, err
+	}
+	return n, tx.Commit()
+}
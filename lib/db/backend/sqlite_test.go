@@ -0,0 +1,188 @@
+// Copyright (C) 2026 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package backend
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func openSQLiteTemp(t *testing.T) func() Backend {
+	return func() Backend {
+		db, err := OpenSQLite(filepath.Join(t.TempDir(), "index.sqlite"), TuningAuto)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return db
+	}
+}
+
+func TestSQLiteBackendBehavior(t *testing.T) {
+	testBackendBehavior(t, openSQLiteTemp(t))
+}
+
+func TestSQLiteIterators(t *testing.T) {
+	db := openSQLiteTemp(t)()
+	defer db.Close()
+
+	for _, k := range []string{"a", "b1", "b2", "b\xff", "c"} {
+		if err := db.Put([]byte(k), []byte("v"+k)); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	keys := func(it Iterator, err error) string {
+		t.Helper()
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer it.Release()
+		var res string
+		for it.Next() {
+			if string(it.Value()) != "v"+string(it.Key()) {
+				t.Errorf("wrong value %q for key %q", it.Value(), it.Key())
+			}
+			res += fmt.Sprintf("%q", it.Key())
+		}
+		if err := it.Error(); err != nil {
+			t.Fatal(err)
+		}
+		return res
+	}
+
+	if res := keys(db.NewPrefixIterator([]byte("b"))); res != `"b1""b2""b\xff"` {
+		t.Error("prefix iterator:", res)
+	}
+	if res := keys(db.NewPrefixIterator(nil)); res != `"a""b1""b2""b\xff""c"` {
+		t.Error("nil prefix iterator:", res)
+	}
+	if res := keys(db.NewRangeIterator([]byte("a"), []byte("b2"))); res != `"a""b1"` {
+		t.Error("range iterator:", res)
+	}
+
+	// Reading from within an iteration in a transaction.
+	snap, err := db.NewReadTransaction()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer snap.Release()
+	it, err := snap.NewPrefixIterator(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for it.Next() {
+		if v, err := snap.Get(it.Key()); err != nil || string(v) != "v"+string(it.Key()) {
+			t.Errorf("get %q: %q, %v", it.Key(), v, err)
+		}
+	}
+	it.Release()
+
+	if _, err := snap.Get([]byte("b")); !IsNotFound(err) {
+		t.Error("expected not found, got", err)
+	}
+}
+
+func TestSQLiteTransactionSnapshot(t *testing.T) {
+	db := openSQLiteTemp(t)()
+	defer db.Close()
+
+	if err := db.Put([]byte("a"), []byte("1")); err != nil {
+		t.Fatal(err)
+	}
+
+	tx, err := db.NewWriteTransaction()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer tx.Release()
+
+	// Changes committed after the transaction started aren't visible in
+	// it, even before the first read.
+	if err := db.Put([]byte("a"), []byte("2")); err != nil {
+		t.Fatal(err)
+	}
+	if v, _ := tx.Get([]byte("a")); string(v) != "1" {
+		t.Errorf("read %q in transaction, expected the value at its start", v)
+	}
+
+	if err := tx.Put([]byte("b"), []byte("1")); err != nil {
+		t.Fatal(err)
+	}
+	if err := tx.Delete([]byte("a")); err != nil {
+		t.Fatal(err)
+	}
+	if err := tx.Commit(); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := db.Get([]byte("a")); !IsNotFound(err) {
+		t.Error("expected a to be deleted, got", err)
+	}
+	if v, _ := db.Get([]byte("b")); string(v) != "1" {
+		t.Errorf("read %q after commit", v)
+	}
+}
+
+func TestSQLiteMigration(t *testing.T) {
+	dir := t.TempDir()
+	ldbLocation := filepath.Join(dir, "index.db")
+	location := filepath.Join(dir, "index.sqlite")
+
+	ldb, err := OpenLevelDB(ldbLocation, TuningAuto)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 1000; i++ {
+		if err := ldb.Put([]byte(fmt.Sprintf("key%04d", i)), []byte(fmt.Sprint(i))); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := ldb.Put([]byte("empty"), nil); err != nil {
+		t.Fatal(err)
+	}
+	ldb.Close()
+
+	db, err := OpenSQLiteMigrating(location, ldbLocation, TuningAuto)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	it, err := db.NewPrefixIterator([]byte("key"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	n := 0
+	for it.Next() {
+		if exp := fmt.Sprintf("key%04d", n); string(it.Key()) != exp {
+			t.Fatalf("got key %q, expected %q", it.Key(), exp)
+		}
+		if exp := fmt.Sprint(n); string(it.Value()) != exp {
+			t.Fatalf("got value %q, expected %q", it.Value(), exp)
+		}
+		n++
+	}
+	it.Release()
+	if n != 1000 {
+		t.Errorf("migrated %d keys, expected 1000", n)
+	}
+	if v, err := db.Get([]byte("empty")); err != nil || len(v) != 0 {
+		t.Errorf("empty value: %q, %v", v, err)
+	}
+
+	if _, err := os.Stat(ldbLocation); !os.IsNotExist(err) {
+		t.Error("expected the leveldb database to be moved aside")
+	}
+	if _, err := os.Stat(ldbLocation + ".migrated"); err != nil {
+		t.Error("expected the leveldb database to be kept:", err)
+	}
+	if _, err := os.Stat(location + ".tmp"); !os.IsNotExist(err) {
+		t.Error("expected the temporary database to be renamed")
+	}
+}
@@ -0,0 +1,57 @@
+// Copyright (C) 2026 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package db
+
+import (
+	"fmt"
+
+	"github.com/klauspost/compress/zstd"
+)
+
+// File entries and block lists, which make up the bulk of the index, are
+// stored compressed when that makes them smaller. A compressed value is a
+// zero byte followed by a zstd frame. No protobuf message starts with a
+// zero byte, as that would be field number zero, so uncompressed values
+// written before are still read as they are.
+const (
+	compressedValueMarker = 0x00
+	compressValueMinSize  = 128
+	maxUncompressedValue  = 256 << 20
+)
+
+var (
+	valueEncoder, _ = zstd.NewWriter(nil, zstd.WithEncoderConcurrency(1), zstd.WithLowerEncoderMem(true))
+	valueDecoder, _ = zstd.NewReader(nil, zstd.WithDecoderConcurrency(0), zstd.WithDecoderMaxMemory(maxUncompressedValue))
+)
+
+// compressValue returns the value to store for bs, which is compressed if
+// that saves space.
+func compressValue(bs []byte) []byte {
+	if len(bs) < compressValueMinSize {
+		return bs
+	}
+	out := make([]byte, 1, len(bs))
+	out[0] = compressedValueMarker
+	out = valueEncoder.EncodeAll(bs, out)
+	if len(out) >= len(bs) {
+		return bs
+	}
+	return out
+}
+
+// UncompressValue returns the protobuf message stored as a file entry or
+// block list, uncompressing it if needed.
+func UncompressValue(bs []byte) ([]byte, error) {
+	if len(bs) == 0 || bs[0] != compressedValueMarker {
+		return bs, nil
+	}
+	out, err := valueDecoder.DecodeAll(bs[1:], nil)
+	if err != nil {
+		return nil, fmt.Errorf("uncompressing value: %w", err)
+	}
+	return out, nil
+}
@@ -10,6 +10,7 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/syncthing/syncthing/lib/db/backend"
@@ -807,6 +808,122 @@ func TestUpdateTo14(t *testing.T) {
 	}
 }
 
+func TestUpdateTo15(t *testing.T) {
+	db := newLowlevelMemory(t)
+	defer db.Close()
+
+	folder := []byte("default")
+	version := protocol.Vector{Counters: []protocol.Counter{{ID: myID, Value: 1000}}}
+	// One file deep down the tree with an inline block list, and one with
+	// an indirected block list.
+	inline := protocol.FileInfo{Name: strings.Repeat("directory/", 20) + "inline", Version: version, Blocks: genBlocks(blocksIndirectionCutoff - 1)}
+	indirected := protocol.FileInfo{Name: "indirected", Version: version, Blocks: genBlocks(2 * blocksIndirectionCutoff)}
+	indirected.BlocksHash = protocol.BlocksHash(indirected.Blocks)
+	indirectedWOBlocks := indirected
+	indirectedWOBlocks.Blocks = nil
+
+	// Store the file entries and block list uncompressed, as before schema 15.
+	trans, err := db.newReadWriteTransaction()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer trans.close()
+	inlineKey, err := db.keyer.GenerateDeviceFileKey(nil, folder, protocol.LocalDeviceID[:], []byte(inline.Name))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := trans.Put(inlineKey, mustMarshal(&inline)); err != nil {
+		t.Fatal(err)
+	}
+	indirectedKey, err := db.keyer.GenerateDeviceFileKey(nil, folder, protocol.LocalDeviceID[:], []byte(indirected.Name))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := trans.Put(indirectedKey, mustMarshal(&indirectedWOBlocks)); err != nil {
+		t.Fatal(err)
+	}
+	blocksKey := db.keyer.GenerateBlockListKey(nil, indirected.BlocksHash)
+	if err := trans.Put(blocksKey, mustMarshal(&BlockList{Blocks: indirected.Blocks})); err != nil {
+		t.Fatal(err)
+	}
+	if err := trans.Commit(); err != nil {
+		t.Fatal(err)
+	}
+	trans.close()
+
+	checkFiles := func() {
+		t.Helper()
+		ro, err := db.newReadOnlyTransaction()
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer ro.close()
+		for _, file := range []protocol.FileInfo{inline, indirected} {
+			key, err := db.keyer.GenerateDeviceFileKey(nil, folder, protocol.LocalDeviceID[:], []byte(file.Name))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if f, ok, err := ro.getFileByKey(key); err != nil {
+				t.Fatal(err)
+			} else if !ok {
+				t.Errorf("%v missing", file.Name)
+			} else if !f.IsEquivalent(file, 0) || len(f.Blocks) != len(file.Blocks) {
+				t.Errorf("%v differs after reading back", file.Name)
+			}
+		}
+	}
+
+	// Uncompressed values are read as they are.
+	checkFiles()
+
+	if err := (&schemaUpdater{db}).updateSchemaTo15(14); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, k := range [][]byte{inlineKey, blocksKey} {
+		if bs, err := db.Get(k); err != nil {
+			t.Fatal(err)
+		} else if bs[0] != compressedValueMarker {
+			t.Errorf("value for key %x not compressed", k)
+		}
+	}
+	// The remaining entry is too small to be worth compressing.
+	if bs, err := db.Get(indirectedKey); err != nil {
+		t.Fatal(err)
+	} else if !bytes.Equal(bs, mustMarshal(&indirectedWOBlocks)) {
+		t.Error("small value was rewritten")
+	}
+	checkFiles()
+
+	// Running it again, as after an interrupted migration, is harmless.
+	if err := (&schemaUpdater{db}).updateSchemaTo15(14); err != nil {
+		t.Fatal(err)
+	}
+	checkFiles()
+}
+
+func TestCompressValue(t *testing.T) {
+	small := mustMarshal(&protocol.FileInfo{Name: "foo"})
+	if bs := compressValue(small); !bytes.Equal(bs, small) {
+		t.Error("small value should be stored as is")
+	}
+
+	large := mustMarshal(&BlockList{Blocks: genBlocks(100)})
+	bs := compressValue(large)
+	if bs[0] != compressedValueMarker || len(bs) >= len(large) {
+		t.Fatal("large value should be compressed")
+	}
+	if dec, err := UncompressValue(bs); err != nil {
+		t.Fatal(err)
+	} else if !bytes.Equal(dec, large) {
+		t.Error("value differs after uncompressing")
+	}
+
+	if _, err := UncompressValue([]byte{compressedValueMarker, 1, 2, 3}); err == nil {
+		t.Error("expected error for corrupt value")
+	}
+}
+
 func TestFlushRecursion(t *testing.T) {
 	// Verify that a commit hook can write to the transaction without
 	// causing another flush and thus recursion.
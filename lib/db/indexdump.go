@@ -0,0 +1,258 @@
+// Copyright (C) 2026 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package db
+
+import (
+	"bufio"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/syncthing/syncthing/lib/protocol"
+)
+
+// An index dump is a complete copy of what the database holds for a
+// folder: the index ID and sequence of every device, the files announced
+// by every device and the global version lists. Unlike an index snapshot
+// it's meant to be read by other tools, for debugging or auditing.
+//
+// The records come in that order: first all device records, then the
+// files of each device in name order, then the global version lists in
+// name order. In the protobuf format each IndexDumpRecord is prefixed by
+// its length as a big endian uint32, and a zero length marks the end of
+// the stream. In the JSONL format each record is a JSON object on its own
+// line, with the device IDs in their usual string form.
+
+type IndexDumpFormat string
+
+const (
+	IndexDumpJSONL    IndexDumpFormat = "jsonl"
+	IndexDumpProtobuf IndexDumpFormat = "protobuf"
+)
+
+// ParseIndexDumpFormat returns the format with the given name, defaulting
+// to JSONL when empty.
+func ParseIndexDumpFormat(s string) (IndexDumpFormat, error) {
+	switch f := IndexDumpFormat(s); f {
+	case "":
+		return IndexDumpJSONL, nil
+	case IndexDumpJSONL, IndexDumpProtobuf:
+		return f, nil
+	}
+	return "", fmt.Errorf("unknown index dump format %q", s)
+}
+
+// DumpIndex writes the contents of the folder to w in the given format.
+// The local device is written with the given device ID, which should be
+// the ID of the local device.
+func (s *FileSet) DumpIndex(w io.Writer, format IndexDumpFormat, localID protocol.DeviceID) error {
+	opStr := fmt.Sprintf("%s DumpIndex(%v)", s.folder, format)
+	l.Debugf(opStr)
+
+	snap, err := s.Snapshot()
+	if err != nil {
+		return err
+	}
+	defer snap.Release()
+
+	bw := bufio.NewWriter(w)
+	var enc indexDumpEncoder
+	switch format {
+	case IndexDumpJSONL:
+		enc = &jsonIndexDumpEncoder{enc: json.NewEncoder(bw), localID: localID}
+	case IndexDumpProtobuf:
+		enc = &protoIndexDumpEncoder{w: bw, localID: localID}
+	default:
+		return fmt.Errorf("unknown index dump format %q", format)
+	}
+
+	devices := append([]protocol.DeviceID{protocol.LocalDeviceID}, snap.meta.devices()...)
+	for _, dev := range devices {
+		rec := IndexDumpRecord{
+			Type:     IndexDumpRecordTypeDevice,
+			DeviceID: dev,
+			IndexID:  s.IndexID(dev),
+			Sequence: snap.Sequence(dev),
+		}
+		if err := enc.encode(&rec); err != nil {
+			return err
+		}
+	}
+
+	for _, dev := range devices {
+		var iterErr error
+		snap.WithHave(dev, func(fi protocol.FileIntf) bool {
+			iterErr = enc.encode(&IndexDumpRecord{
+				Type:     IndexDumpRecordTypeFile,
+				DeviceID: dev,
+				File:     fi.(protocol.FileInfo),
+			})
+			return iterErr == nil
+		})
+		if iterErr != nil {
+			return iterErr
+		}
+	}
+
+	if err := s.dumpGlobals(snap, enc); err != nil {
+		return err
+	}
+	if err := enc.close(); err != nil {
+		return err
+	}
+	return bw.Flush()
+}
+
+func (s *FileSet) dumpGlobals(snap *Snapshot, enc indexDumpEncoder) error {
+	key, err := s.db.keyer.GenerateGlobalVersionKey(nil, []byte(s.folder), nil)
+	if err != nil {
+		return err
+	}
+	dbi, err := snap.t.NewPrefixIterator(key.WithoutName())
+	if err != nil {
+		return err
+	}
+	defer dbi.Release()
+
+	for dbi.Next() {
+		var vl VersionList
+		if err := vl.Unmarshal(dbi.Value()); err != nil {
+			return err
+		}
+		rec := IndexDumpRecord{
+			Type:     IndexDumpRecordTypeGlobal,
+			Name:     string(s.db.keyer.NameFromGlobalVersionKey(dbi.Key())),
+			Versions: vl,
+		}
+		if err := enc.encode(&rec); err != nil {
+			return err
+		}
+	}
+	return dbi.Error()
+}
+
+type indexDumpEncoder interface {
+	encode(rec *IndexDumpRecord) error
+	close() error
+}
+
+type protoIndexDumpEncoder struct {
+	w       io.Writer
+	localID protocol.DeviceID
+}
+
+func (e *protoIndexDumpEncoder) encode(rec *IndexDumpRecord) error {
+	if rec.DeviceID == protocol.LocalDeviceID {
+		rec.DeviceID = e.localID
+	}
+	for i := range rec.Versions.RawVersions {
+		fv := &rec.Versions.RawVersions[i]
+		fv.Devices = replaceLocalDevice(fv.Devices, e.localID)
+		fv.InvalidDevices = replaceLocalDevice(fv.InvalidDevices, e.localID)
+	}
+	return writeIndexSnapshotMessage(e.w, rec)
+}
+
+func (e *protoIndexDumpEncoder) close() error {
+	// End of stream marker
+	return binary.Write(e.w, binary.BigEndian, uint32(0))
+}
+
+func replaceLocalDevice(devices [][]byte, localID protocol.DeviceID) [][]byte {
+	for i, dev := range devices {
+		if id, err := protocol.DeviceIDFromBytes(dev); err == nil && id == protocol.LocalDeviceID {
+			devices[i] = localID[:]
+		}
+	}
+	return devices
+}
+
+type jsonIndexDumpEncoder struct {
+	enc     *json.Encoder
+	localID protocol.DeviceID
+}
+
+type jsonIndexDumpDevice struct {
+	Type     string            `json:"type"`
+	DeviceID protocol.DeviceID `json:"deviceID"`
+	IndexID  string            `json:"indexID"`
+	Sequence int64             `json:"sequence"`
+}
+
+type jsonIndexDumpFile struct {
+	Type     string            `json:"type"`
+	DeviceID protocol.DeviceID `json:"deviceID"`
+	File     protocol.FileInfo `json:"file"`
+}
+
+type jsonIndexDumpGlobal struct {
+	Type     string                 `json:"type"`
+	Name     string                 `json:"name"`
+	Versions []jsonIndexDumpVersion `json:"versions"`
+}
+
+type jsonIndexDumpVersion struct {
+	Version        protocol.Vector     `json:"version"`
+	Deleted        bool                `json:"deleted"`
+	Devices        []protocol.DeviceID `json:"devices"`
+	InvalidDevices []protocol.DeviceID `json:"invalidDevices"`
+}
+
+func (e *jsonIndexDumpEncoder) encode(rec *IndexDumpRecord) error {
+	switch rec.Type {
+	case IndexDumpRecordTypeDevice:
+		return e.enc.Encode(jsonIndexDumpDevice{
+			Type:     "device",
+			DeviceID: e.deviceID(rec.DeviceID),
+			IndexID:  rec.IndexID.String(),
+			Sequence: rec.Sequence,
+		})
+	case IndexDumpRecordTypeFile:
+		return e.enc.Encode(jsonIndexDumpFile{
+			Type:     "file",
+			DeviceID: e.deviceID(rec.DeviceID),
+			File:     rec.File,
+		})
+	case IndexDumpRecordTypeGlobal:
+		versions := make([]jsonIndexDumpVersion, len(rec.Versions.RawVersions))
+		for i, fv := range rec.Versions.RawVersions {
+			versions[i] = jsonIndexDumpVersion{
+				Version:        fv.Version,
+				Deleted:        fv.Deleted,
+				Devices:        e.deviceIDs(fv.Devices),
+				InvalidDevices: e.deviceIDs(fv.InvalidDevices),
+			}
+		}
+		return e.enc.Encode(jsonIndexDumpGlobal{
+			Type:     "global",
+			Name:     rec.Name,
+			Versions: versions,
+		})
+	}
+	return fmt.Errorf("unknown index dump record type %v", rec.Type)
+}
+
+func (*jsonIndexDumpEncoder) close() error {
+	return nil
+}
+
+func (e *jsonIndexDumpEncoder) deviceID(id protocol.DeviceID) protocol.DeviceID {
+	if id == protocol.LocalDeviceID {
+		return e.localID
+	}
+	return id
+}
+
+func (e *jsonIndexDumpEncoder) deviceIDs(devices [][]byte) []protocol.DeviceID {
+	ids := make([]protocol.DeviceID, len(devices))
+	for i, dev := range devices {
+		id, _ := protocol.DeviceIDFromBytes(dev)
+		ids[i] = e.deviceID(id)
+	}
+	return ids
+}
@@ -0,0 +1,135 @@
+// Copyright (C) 2026 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package db_test
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"io"
+	"testing"
+
+	"github.com/syncthing/syncthing/lib/db"
+	"github.com/syncthing/syncthing/lib/protocol"
+)
+
+func TestDumpIndex(t *testing.T) {
+	ldb := newLowlevelMemory(t)
+	defer ldb.Close()
+	s := newFileSet(t, "test", ldb)
+
+	localID := protocol.DeviceID{1, 2, 3}
+	v1 := protocol.Vector{Counters: []protocol.Counter{{ID: myID, Value: 1}}}
+	v2 := v1.Update(remoteDevice0.Short())
+	s.Update(protocol.LocalDeviceID, []protocol.FileInfo{
+		{Name: "a", Version: v1, Blocks: genBlocks(1)},
+		{Name: "b", Version: v1, Blocks: genBlocks(1)},
+	})
+	s.Update(remoteDevice0, []protocol.FileInfo{
+		{Name: "b", Version: v2, Blocks: genBlocks(2), Sequence: 1},
+	})
+
+	// Device records, then files per device, then globals.
+	expTypes := []db.IndexDumpRecordType{
+		db.IndexDumpRecordTypeDevice, db.IndexDumpRecordTypeDevice,
+		db.IndexDumpRecordTypeFile, db.IndexDumpRecordTypeFile, db.IndexDumpRecordTypeFile,
+		db.IndexDumpRecordTypeGlobal, db.IndexDumpRecordTypeGlobal,
+	}
+
+	t.Run("protobuf", func(t *testing.T) {
+		buf := new(bytes.Buffer)
+		if err := s.DumpIndex(buf, db.IndexDumpProtobuf, localID); err != nil {
+			t.Fatal(err)
+		}
+
+		var recs []db.IndexDumpRecord
+		for {
+			var size uint32
+			if err := binary.Read(buf, binary.BigEndian, &size); err != nil {
+				t.Fatal(err)
+			}
+			if size == 0 {
+				break
+			}
+			var rec db.IndexDumpRecord
+			if err := rec.Unmarshal(buf.Next(int(size))); err != nil {
+				t.Fatal(err)
+			}
+			recs = append(recs, rec)
+		}
+		if buf.Len() != 0 {
+			t.Errorf("%d bytes after the end of stream marker", buf.Len())
+		}
+
+		if len(recs) != len(expTypes) {
+			t.Fatalf("got %d records, expected %d", len(recs), len(expTypes))
+		}
+		for i, rec := range recs {
+			if rec.Type != expTypes[i] {
+				t.Errorf("record %d has type %v, expected %v", i, rec.Type, expTypes[i])
+			}
+		}
+		if recs[0].DeviceID != localID || recs[0].Sequence != s.Sequence(protocol.LocalDeviceID) || recs[0].IndexID != s.IndexID(protocol.LocalDeviceID) {
+			t.Errorf("unexpected local device record %v", recs[0])
+		}
+		if recs[1].DeviceID != remoteDevice0 || recs[1].Sequence != 1 {
+			t.Errorf("unexpected remote device record %v", recs[1])
+		}
+		if f := recs[4].File; recs[4].DeviceID != remoteDevice0 || f.Name != "b" || !f.Version.Equal(v2) || len(f.Blocks) != 2 {
+			t.Errorf("unexpected remote file record %v", recs[4])
+		}
+		global := recs[6]
+		if global.Name != "b" || len(global.Versions.RawVersions) != 2 {
+			t.Fatalf("unexpected global record %v", global)
+		}
+		if dev, _ := global.Versions.RawVersions[1].FirstDevice(); !bytes.Equal(dev, localID[:]) {
+			t.Errorf("local device not replaced in global record: %x", dev)
+		}
+	})
+
+	t.Run("jsonl", func(t *testing.T) {
+		buf := new(bytes.Buffer)
+		if err := s.DumpIndex(buf, db.IndexDumpJSONL, localID); err != nil {
+			t.Fatal(err)
+		}
+
+		var recs []map[string]interface{}
+		br := bufio.NewReader(buf)
+		for {
+			line, err := br.ReadBytes('\n')
+			if err == io.EOF {
+				break
+			} else if err != nil {
+				t.Fatal(err)
+			}
+			var rec map[string]interface{}
+			if err := json.Unmarshal(line, &rec); err != nil {
+				t.Fatal(err)
+			}
+			recs = append(recs, rec)
+		}
+
+		if len(recs) != len(expTypes) {
+			t.Fatalf("got %d records, expected %d", len(recs), len(expTypes))
+		}
+		expNames := []string{"device", "device", "file", "file", "file", "global", "global"}
+		for i, rec := range recs {
+			if rec["type"] != expNames[i] {
+				t.Errorf("record %d has type %v, expected %v", i, rec["type"], expNames[i])
+			}
+		}
+		if recs[0]["deviceID"] != localID.String() {
+			t.Errorf("unexpected local device record %v", recs[0])
+		}
+		versions := recs[6]["versions"].([]interface{})
+		devices := versions[0].(map[string]interface{})["devices"].([]interface{})
+		if len(devices) != 1 || devices[0] != remoteDevice0.String() {
+			t.Errorf("unexpected global record %v", recs[6])
+		}
+	})
+}
@@ -0,0 +1,231 @@
+// Copyright (C) 2026 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package db
+
+import (
+	"bufio"
+	"compress/gzip"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/syncthing/syncthing/lib/osutil"
+	"github.com/syncthing/syncthing/lib/protocol"
+)
+
+// An index snapshot is a portable copy of the local index of a folder. It
+// is a gzip compressed stream consisting of a magic number, followed by a
+// length prefixed IndexSnapshotHeader and any number of length prefixed
+// protocol.Index messages holding batches of files. A zero length marks the
+// end of the stream.
+//
+// Importing a snapshot on another device stores the files as the remote
+// index of the exporting device, together with its index ID and sequence.
+// When the devices connect they can then do a delta index exchange
+// starting from the snapshot sequence, instead of transferring the whole
+// index over the wire.
+
+const (
+	indexSnapshotMagic      uint32 = 0x1d5c4a5e
+	maxIndexSnapshotMessage        = 64 << 20
+)
+
+var (
+	ErrIndexSnapshotMagic  = errors.New("not an index snapshot (bad magic)")
+	errIndexSnapshotLength = errors.New("index snapshot message too large")
+)
+
+// ExportIndex writes the local index of the folder to w as an index
+// snapshot. The given device ID is recorded as the owner of the index, and
+// should be the ID of the local device. If prepare is non-nil it is called
+// for every file before it's written, the same way files are prepared for
+// sending in an index message.
+func (s *FileSet) ExportIndex(w io.Writer, localID protocol.DeviceID, prepare func(protocol.FileInfo) protocol.FileInfo) error {
+	opStr := fmt.Sprintf("%s ExportIndex()", s.folder)
+	l.Debugf(opStr)
+
+	snap, err := s.Snapshot()
+	if err != nil {
+		return err
+	}
+	defer snap.Release()
+
+	hdr := IndexSnapshotHeader{
+		Folder:   s.folder,
+		DeviceID: localID,
+		IndexID:  s.IndexID(protocol.LocalDeviceID),
+		Sequence: snap.Sequence(protocol.LocalDeviceID),
+		Created:  time.Now(),
+	}
+
+	gw := gzip.NewWriter(w)
+	bw := bufio.NewWriter(gw)
+	if err := binary.Write(bw, binary.BigEndian, indexSnapshotMagic); err != nil {
+		return err
+	}
+	if err := writeIndexSnapshotMessage(bw, &hdr); err != nil {
+		return err
+	}
+
+	batch := NewFileInfoBatch(func(files []protocol.FileInfo) error {
+		return writeIndexSnapshotMessage(bw, &protocol.Index{
+			Folder:       s.folder,
+			Files:        files,
+			LastSequence: files[len(files)-1].Sequence,
+		})
+	})
+	var iterErr error
+	snap.WithHave(protocol.LocalDeviceID, func(fi protocol.FileIntf) bool {
+		f := fi.(protocol.FileInfo)
+		if prepare != nil {
+			f = prepare(f)
+		}
+		// The snapshot must be portable between operating systems.
+		f.Name = osutil.NormalizedFilename(f.Name)
+		batch.Append(f)
+		iterErr = batch.FlushIfFull()
+		return iterErr == nil
+	})
+	if iterErr != nil {
+		return iterErr
+	}
+	if err := batch.Flush(); err != nil {
+		return err
+	}
+
+	// End of stream marker
+	if err := binary.Write(bw, binary.BigEndian, uint32(0)); err != nil {
+		return err
+	}
+	if err := bw.Flush(); err != nil {
+		return err
+	}
+	return gw.Close()
+}
+
+// ImportIndex reads an index snapshot from r and stores its files as the
+// index of the device that exported it, replacing whatever was previously
+// known for that device. The index ID of that device is updated to match
+// the snapshot. The snapshot header is returned. The caller is responsible
+// for verifying that the exporting device is a remote device sharing the
+// folder, which is done by passing a verify function that is called with
+// the header before any changes are made.
+func (s *FileSet) ImportIndex(r io.Reader, verify func(IndexSnapshotHeader) error) (IndexSnapshotHeader, error) {
+	opStr := fmt.Sprintf("%s ImportIndex()", s.folder)
+	l.Debugf(opStr)
+
+	gr, err := gzip.NewReader(r)
+	if err != nil {
+		return IndexSnapshotHeader{}, err
+	}
+	defer gr.Close()
+	br := bufio.NewReader(gr)
+
+	var magic uint32
+	if err := binary.Read(br, binary.BigEndian, &magic); err != nil {
+		return IndexSnapshotHeader{}, err
+	}
+	if magic != indexSnapshotMagic {
+		return IndexSnapshotHeader{}, ErrIndexSnapshotMagic
+	}
+
+	var hdr IndexSnapshotHeader
+	if ok, err := readIndexSnapshotMessage(br, &hdr); err != nil {
+		return IndexSnapshotHeader{}, err
+	} else if !ok {
+		return IndexSnapshotHeader{}, io.ErrUnexpectedEOF
+	}
+	if hdr.Folder != s.folder {
+		return hdr, fmt.Errorf("index snapshot is for folder %q, not %q", hdr.Folder, s.folder)
+	}
+	if hdr.DeviceID == protocol.LocalDeviceID || hdr.DeviceID == protocol.EmptyDeviceID {
+		return hdr, errors.New("index snapshot has no valid device ID")
+	}
+	if verify != nil {
+		if err := verify(hdr); err != nil {
+			return hdr, err
+		}
+	}
+
+	// Start from scratch for this device. The index ID is reset until the
+	// import has completed, so that a partial import results in a full
+	// index exchange rather than a delta one.
+	s.SetIndexID(hdr.DeviceID, 0)
+	s.Drop(hdr.DeviceID)
+
+	for {
+		var idx protocol.Index
+		ok, err := readIndexSnapshotMessage(br, &idx)
+		if err != nil {
+			s.Drop(hdr.DeviceID)
+			return hdr, err
+		}
+		if !ok {
+			break
+		}
+		if idx.Folder != s.folder {
+			s.Drop(hdr.DeviceID)
+			return hdr, fmt.Errorf("index snapshot contains files for folder %q", idx.Folder)
+		}
+		s.Update(hdr.DeviceID, idx.Files)
+	}
+
+	// The sequence of the imported files can be lower than the one in the
+	// header, if the highest sequence entries were never announced. It can
+	// never be higher.
+	if seq := s.Sequence(hdr.DeviceID); seq > hdr.Sequence {
+		s.Drop(hdr.DeviceID)
+		return hdr, fmt.Errorf("index snapshot contains sequence %d beyond header sequence %d", seq, hdr.Sequence)
+	}
+	s.SetIndexID(hdr.DeviceID, hdr.IndexID)
+	return hdr, nil
+}
+
+type indexSnapshotMessage interface {
+	Marshal() ([]byte, error)
+	Unmarshal([]byte) error
+}
+
+func writeIndexSnapshotMessage(w io.Writer, msg indexSnapshotMessage) error {
+	bs, err := msg.Marshal()
+	if err != nil {
+		return err
+	}
+	if len(bs) == 0 || len(bs) > maxIndexSnapshotMessage {
+		return errIndexSnapshotLength
+	}
+	if err := binary.Write(w, binary.BigEndian, uint32(len(bs))); err != nil {
+		return err
+	}
+	_, err = w.Write(bs)
+	return err
+}
+
+// readIndexSnapshotMessage reads the next message into msg, returning false
+// at the end of stream marker.
+func readIndexSnapshotMessage(r io.Reader, msg indexSnapshotMessage) (bool, error) {
+	var size uint32
+	if err := binary.Read(r, binary.BigEndian, &size); err != nil {
+		if errors.Is(err, io.EOF) {
+			err = io.ErrUnexpectedEOF
+		}
+		return false, err
+	}
+	if size == 0 {
+		return false, nil
+	}
+	if size > maxIndexSnapshotMessage {
+		return false, errIndexSnapshotLength
+	}
+	bs := make([]byte, size)
+	if _, err := io.ReadFull(r, bs); err != nil {
+		return false, err
+	}
+	return true, msg.Unmarshal(bs)
+}
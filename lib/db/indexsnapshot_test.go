@@ -0,0 +1,111 @@
+// Copyright (C) 2026 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package db_test
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/syncthing/syncthing/lib/db"
+	"github.com/syncthing/syncthing/lib/protocol"
+)
+
+func TestIndexSnapshotRoundtrip(t *testing.T) {
+	srcDB := newLowlevelMemory(t)
+	defer srcDB.Close()
+	src := newFileSet(t, "test", srcDB)
+
+	var local []protocol.FileInfo
+	for i := 0; i < 2500; i++ {
+		local = append(local, protocol.FileInfo{
+			Name:    fmt.Sprintf("dir/file%d", i),
+			Version: protocol.Vector{Counters: []protocol.Counter{{ID: myID, Value: 1000}}},
+			Blocks:  genBlocks(1),
+		})
+	}
+	local = append(local, protocol.FileInfo{
+		Name:    "deleted",
+		Deleted: true,
+		Version: protocol.Vector{Counters: []protocol.Counter{{ID: myID, Value: 1001}}},
+	})
+	src.Update(protocol.LocalDeviceID, local)
+
+	buf := new(bytes.Buffer)
+	if err := src.ExportIndex(buf, remoteDevice0, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	dstDB := newLowlevelMemory(t)
+	defer dstDB.Close()
+	dst := newFileSet(t, "test", dstDB)
+	dst.Update(remoteDevice0, []protocol.FileInfo{{Name: "stale", Version: protocol.Vector{Counters: []protocol.Counter{{ID: myID, Value: 1}}}}})
+
+	hdr, err := dst.ImportIndex(bytes.NewReader(buf.Bytes()), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if hdr.DeviceID != remoteDevice0 {
+		t.Errorf("wrong device ID in header: %v", hdr.DeviceID)
+	}
+	if got, exp := dst.IndexID(remoteDevice0), src.IndexID(protocol.LocalDeviceID); got != exp {
+		t.Errorf("index ID not imported: %v != %v", got, exp)
+	}
+	if got, exp := dst.Sequence(remoteDevice0), src.Sequence(protocol.LocalDeviceID); got != exp {
+		t.Errorf("sequence not imported: %v != %v", got, exp)
+	}
+
+	have := haveList(t, dst, remoteDevice0)
+	if len(have) != len(local) {
+		t.Fatalf("expected %d files, got %d", len(local), len(have))
+	}
+	snap := snapshot(t, dst)
+	defer snap.Release()
+	if _, ok := snap.Get(remoteDevice0, "stale"); ok {
+		t.Error("previously known file should have been dropped")
+	}
+	if f, ok := snap.Get(remoteDevice0, "deleted"); !ok || !f.IsDeleted() {
+		t.Error("deleted file should have been imported")
+	}
+}
+
+func TestIndexSnapshotVerify(t *testing.T) {
+	ldb := newLowlevelMemory(t)
+	defer ldb.Close()
+	src := newFileSet(t, "test", ldb)
+	src.Update(protocol.LocalDeviceID, []protocol.FileInfo{{Name: "a", Version: protocol.Vector{Counters: []protocol.Counter{{ID: myID, Value: 1}}}}})
+
+	buf := new(bytes.Buffer)
+	if err := src.ExportIndex(buf, remoteDevice0, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	// Wrong folder
+	other := newFileSet(t, "other", ldb)
+	if _, err := other.ImportIndex(bytes.NewReader(buf.Bytes()), nil); err == nil {
+		t.Error("expected error importing into another folder")
+	}
+
+	// Rejected by the verifier
+	errRejected := errors.New("rejected")
+	dst := newFileSet(t, "test", newLowlevelMemory(t))
+	_, err := dst.ImportIndex(bytes.NewReader(buf.Bytes()), func(db.IndexSnapshotHeader) error {
+		return errRejected
+	})
+	if !errors.Is(err, errRejected) {
+		t.Errorf("expected rejection, got %v", err)
+	}
+	if seq := dst.Sequence(remoteDevice0); seq != 0 {
+		t.Errorf("nothing should have been imported, got sequence %d", seq)
+	}
+
+	// Not a snapshot at all
+	if _, err := dst.ImportIndex(bytes.NewReader([]byte("hello world")), nil); err == nil {
+		t.Error("expected error for garbage input")
+	}
+}
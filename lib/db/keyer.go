@@ -74,6 +74,9 @@ const (
 
 	// KeyTypePendingDevice <device ID in wire format> = ObservedDevice
 	KeyTypePendingDevice byte = 17
+
+	// KeyTypeWindowsNameMap <int32 folder ID> <alternate name> = original name
+	KeyTypeWindowsNameMap byte = 18
 )
 
 type keyer interface {
@@ -108,6 +111,9 @@ type keyer interface {
 	// Mtimes
 	GenerateMtimesKey(key, folder []byte) (mtimesKey, error)
 
+	// Windows name mapping
+	GenerateWindowsNameMapKey(key, folder []byte) (windowsNameMapKey, error)
+
 	// Folder metadata
 	GenerateFolderMetaKey(key, folder []byte) (folderMetaKey, error)
 
@@ -326,6 +332,19 @@ func (k defaultKeyer) GenerateMtimesKey(key, folder []byte) (mtimesKey, error) {
 	return key, nil
 }
 
+type windowsNameMapKey []byte
+
+func (k defaultKeyer) GenerateWindowsNameMapKey(key, folder []byte) (windowsNameMapKey, error) {
+	folderID, err := k.folderIdx.ID(folder)
+	if err != nil {
+		return nil, err
+	}
+	key = resize(key, keyPrefixLen+keyFolderLen)
+	key[0] = KeyTypeWindowsNameMap
+	binary.BigEndian.PutUint32(key[keyPrefixLen:], folderID)
+	return key, nil
+}
+
 type folderMetaKey []byte
 
 func (k defaultKeyer) GenerateFolderMetaKey(key, folder []byte) (folderMetaKey, error) {
@@ -63,6 +63,7 @@ type Lowlevel struct {
 	deviceIdx          *smallIndex
 	keyer              keyer
 	gcMut              sync.RWMutex
+	maintenanceMut     sync.Mutex // serialises Verify and CompactWithProgress
 	gcKeyCount         int
 	indirectGCInterval time.Duration
 	recheckInterval    time.Duration
@@ -82,6 +83,7 @@ func NewLowlevel(backend backend.Backend, evLogger events.Logger, opts ...Option
 		folderIdx:          newSmallIndex(backend, []byte{KeyTypeFolderIdx}),
 		deviceIdx:          newSmallIndex(backend, []byte{KeyTypeDeviceIdx}),
 		gcMut:              sync.NewRWMutex(),
+		maintenanceMut:     sync.NewMutex(),
 		indirectGCInterval: indirectGCDefaultInterval,
 		recheckInterval:    recheckDefaultInterval,
 		oneFileSetCreated:  make(chan struct{}),
@@ -683,6 +685,14 @@ func (db *Lowlevel) dropMtimes(folder []byte) error {
 	return db.dropPrefix(key)
 }
 
+func (db *Lowlevel) dropWindowsNameMap(folder []byte) error {
+	key, err := db.keyer.GenerateWindowsNameMapKey(nil, folder)
+	if err != nil {
+		return err
+	}
+	return db.dropPrefix(key)
+}
+
 func (db *Lowlevel) dropFolderMeta(folder []byte) error {
 	key, err := db.keyer.GenerateFolderMetaKey(nil, folder)
 	if err != nil {
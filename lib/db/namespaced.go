@@ -124,6 +124,23 @@ func (n NamespacedKV) Delete(key string) error {
 	return n.db.Delete(n.prefixedKey(key))
 }
 
+// Each calls fn with each key, without the namespace prefix, and value in
+// the namespace, in key order. It stops at the first error, which is
+// returned.
+func (n NamespacedKV) Each(fn func(key string, val []byte) error) error {
+	it, err := n.db.NewPrefixIterator([]byte(n.prefix))
+	if err != nil {
+		return err
+	}
+	defer it.Release()
+	for it.Next() {
+		if err := fn(string(it.Key()[len(n.prefix):]), it.Value()); err != nil {
+			return err
+		}
+	}
+	return it.Error()
+}
+
 func (n NamespacedKV) prefixedKey(key string) []byte {
 	return []byte(n.prefix + key)
 }
@@ -148,6 +165,26 @@ func NewMiscDataNamespace(db backend.Backend) *NamespacedKV {
 	return NewNamespacedKV(db, string(KeyTypeMiscData))
 }
 
+// NewFinishJournalNamespace creates a KV namespace for the journal of files
+// being finished by the puller in the given folder.
+func NewFinishJournalNamespace(db backend.Backend, folder string) *NamespacedKV {
+	// The separator keeps the namespace of one folder from including
+	// those of other folders with IDs it's a prefix of.
+	return NewNamespacedKV(db, string(KeyTypeMiscData)+"finishJournal/"+folder+"\x00")
+}
+
+// NewEventLogNamespace creates a KV namespace for the persisted events of
+// the named event subscription.
+func NewEventLogNamespace(db backend.Backend, name string) *NamespacedKV {
+	return NewNamespacedKV(db, string(KeyTypeMiscData)+"eventLog/"+name+"\x00")
+}
+
+// NewIdentityLogNamespace creates a KV namespace for the log of the
+// identities devices were seen with.
+func NewIdentityLogNamespace(db backend.Backend) *NamespacedKV {
+	return NewNamespacedKV(db, string(KeyTypeMiscData)+"identityLog/")
+}
+
 func filterNotFound(err error) error {
 	if backend.IsNotFound(err) {
 		return nil
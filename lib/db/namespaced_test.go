@@ -157,6 +157,37 @@ func TestNamespacedReset(t *testing.T) {
 	}
 }
 
+func TestNamespacedEach(t *testing.T) {
+	ldb := newLowlevelMemory(t)
+	defer ldb.Close()
+
+	n1 := NewFinishJournalNamespace(ldb, "foo")
+	n2 := NewFinishJournalNamespace(ldb, "foobar")
+	for _, kv := range []struct {
+		n   *NamespacedKV
+		key string
+	}{{n1, "b"}, {n1, "a"}, {n2, "c"}} {
+		if err := kv.n.PutString(kv.key, "yo"+kv.key); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	var keys []string
+	err := n1.Each(func(key string, val []byte) error {
+		if string(val) != "yo"+key {
+			t.Errorf("Incorrect value %q for key %q", val, key)
+		}
+		keys = append(keys, key)
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(keys) != 2 || keys[0] != "a" || keys[1] != "b" {
+		t.Errorf("Incorrect keys %v != [a b]", keys)
+	}
+}
+
 // reset removes all entries in this namespace.
 func reset(n *NamespacedKV) {
 	tr, err := n.db.NewWriteTransaction()
@@ -19,9 +19,9 @@ import (
 // dbMigrationVersion is for migrations that do not change the schema and thus
 // do not put restrictions on downgrades (e.g. for repairs after a bugfix).
 const (
-	dbVersion             = 14
-	dbMigrationVersion    = 20
-	dbMinSyncthingVersion = "v1.9.0"
+	dbVersion             = 15
+	dbMigrationVersion    = 21
+	dbMinSyncthingVersion = "v1.29.0"
 )
 
 type migration struct {
@@ -104,6 +104,7 @@ func (db *schemaUpdater) updateSchema() error {
 		{14, 17, "v1.9.0", db.migration17},
 		{14, 19, "v1.9.0", db.dropAllIndexIDsMigration},
 		{14, 20, "v1.9.0", db.dropOutgoingIndexIDsMigration},
+		{15, 21, "v1.29.0", db.updateSchemaTo15},
 	}
 
 	for _, m := range migrations {
@@ -737,15 +738,21 @@ func (db *schemaUpdater) updateSchemaTo14(_ int) error {
 		}
 		defer it.Release()
 		for it.Next() {
+			// Entries were already rewritten, and thus compressed, when
+			// coming from before schema 13.
+			bs, err := UncompressValue(it.Value())
+			if err != nil {
+				return err
+			}
 			var fi protocol.FileInfo
-			if err := fi.Unmarshal(it.Value()); err != nil {
+			if err := fi.Unmarshal(bs); err != nil {
 				return err
 			}
 			if len(fi.Blocks) > 0 || len(fi.BlocksHash) == 0 {
 				continue
 			}
 			key = t.keyer.GenerateBlockListKey(key, fi.BlocksHash)
-			_, err := t.Get(key)
+			_, err = t.Get(key)
 			if err == nil {
 				continue
 			}
@@ -840,6 +847,51 @@ func (db *schemaUpdater) dropOutgoingIndexIDsMigration(_ int) error {
 	return db.dropOtherDeviceIndexIDs()
 }
 
+func (db *schemaUpdater) updateSchemaTo15(_ int) error {
+	// Compresses all file entries and block lists.
+
+	t, err := db.newReadWriteTransaction()
+	if err != nil {
+		return err
+	}
+	defer t.close()
+
+	for _, prefix := range []byte{KeyTypeDevice, KeyTypeBlockList} {
+		if err := compressValues(t, prefix); err != nil {
+			return err
+		}
+	}
+
+	return t.Commit()
+}
+
+func compressValues(t readWriteTransaction, prefix byte) error {
+	it, err := t.NewPrefixIterator([]byte{prefix})
+	if err != nil {
+		return err
+	}
+	defer it.Release()
+	for it.Next() {
+		bs := it.Value()
+		if len(bs) > 0 && bs[0] == compressedValueMarker {
+			// Already compressed by an earlier, interrupted run.
+			continue
+		}
+		cbs := compressValue(bs)
+		if len(cbs) == len(bs) {
+			continue
+		}
+		if err := t.Put(it.Key(), cbs); err != nil {
+			return err
+		}
+		if err := t.Checkpoint(); err != nil {
+			return err
+		}
+	}
+	it.Release()
+	return it.Error()
+}
+
 func rewriteGlobals(t readWriteTransaction) error {
 	it, err := t.NewPrefixIterator([]byte{KeyTypeGlobal})
 	if err != nil {
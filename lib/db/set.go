@@ -425,6 +425,19 @@ func (s *FileSet) MtimeOption() fs.Option {
 	return fs.NewMtimeOption(kv)
 }
 
+func (s *FileSet) WindowsNameMapOption() fs.Option {
+	opStr := fmt.Sprintf("%s WindowsNameMapOption()", s.folder)
+	l.Debugf(opStr)
+	prefix, err := s.db.keyer.GenerateWindowsNameMapKey(nil, []byte(s.folder))
+	if backend.IsClosed(err) {
+		return nil
+	} else if err != nil {
+		fatalError(err, opStr, s.db)
+	}
+	kv := NewNamespacedKV(s.db, string(prefix))
+	return fs.NewWindowsNameMapOption(kv)
+}
+
 func (s *FileSet) ListDevices() []protocol.DeviceID {
 	return s.meta.devices()
 }
@@ -449,6 +462,7 @@ func DropFolder(db *Lowlevel, folder string) {
 	droppers := []func([]byte) error{
 		db.dropFolder,
 		db.dropMtimes,
+		db.dropWindowsNameMap,
 		db.dropFolderMeta,
 		db.dropFolderIndexIDs,
 		db.folderIdx.Delete,
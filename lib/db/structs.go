@@ -172,6 +172,7 @@ func (f FileInfoTruncated) copyToFileInfo() protocol.FileInfo {
 		Permissions:   f.Permissions,
 		ModifiedNs:    f.ModifiedNs,
 		RawBlockSize:  f.RawBlockSize,
+		HashAlgorithm: f.HashAlgorithm,
 		LocalFlags:    f.LocalFlags,
 		Deleted:       f.Deleted,
 		RawInvalid:    f.RawInvalid,
@@ -30,6 +30,34 @@ var _ = time.Kitchen
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+type IndexDumpRecordType int32
+
+const (
+	IndexDumpRecordTypeDevice IndexDumpRecordType = 0
+	IndexDumpRecordTypeFile   IndexDumpRecordType = 1
+	IndexDumpRecordTypeGlobal IndexDumpRecordType = 2
+)
+
+var IndexDumpRecordType_name = map[int32]string{
+	0: "INDEX_DUMP_RECORD_TYPE_DEVICE",
+	1: "INDEX_DUMP_RECORD_TYPE_FILE",
+	2: "INDEX_DUMP_RECORD_TYPE_GLOBAL",
+}
+
+var IndexDumpRecordType_value = map[string]int32{
+	"INDEX_DUMP_RECORD_TYPE_DEVICE": 0,
+	"INDEX_DUMP_RECORD_TYPE_FILE":   1,
+	"INDEX_DUMP_RECORD_TYPE_GLOBAL": 2,
+}
+
+func (x IndexDumpRecordType) String() string {
+	return proto.EnumName(IndexDumpRecordType_name, int32(x))
+}
+
+func (IndexDumpRecordType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_5465d80e8cba02e3, []int{0}
+}
+
 type FileVersion struct {
 	Version        protocol.Vector `protobuf:"bytes,1,opt,name=version,proto3" json:"version" xml:"version"`
 	Deleted        bool            `protobuf:"varint,2,opt,name=deleted,proto3" json:"deleted" xml:"deleted"`
@@ -115,14 +143,15 @@ type FileInfoTruncated struct {
 	Version    protocol.Vector                                     `protobuf:"bytes,9,opt,name=version,proto3" json:"version" xml:"version"`
 	Sequence   int64                                               `protobuf:"varint,10,opt,name=sequence,proto3" json:"sequence" xml:"sequence"`
 	// repeated BlockInfo Blocks         = 16
-	SymlinkTarget string                `protobuf:"bytes,17,opt,name=symlink_target,json=symlinkTarget,proto3" json:"symlinkTarget" xml:"symlinkTarget"`
-	BlocksHash    []byte                `protobuf:"bytes,18,opt,name=blocks_hash,json=blocksHash,proto3" json:"blocksHash" xml:"blocksHash"`
-	Encrypted     []byte                `protobuf:"bytes,19,opt,name=encrypted,proto3" json:"encrypted" xml:"encrypted"`
-	Type          protocol.FileInfoType `protobuf:"varint,2,opt,name=type,proto3,enum=protocol.FileInfoType" json:"type" xml:"type"`
-	Permissions   uint32                `protobuf:"varint,4,opt,name=permissions,proto3" json:"permissions" xml:"permissions"`
-	ModifiedNs    int                   `protobuf:"varint,11,opt,name=modified_ns,json=modifiedNs,proto3,casttype=int" json:"modifiedNs" xml:"modifiedNs"`
-	RawBlockSize  int                   `protobuf:"varint,13,opt,name=block_size,json=blockSize,proto3,casttype=int" json:"blockSize" xml:"blockSize"`
-	Platform      protocol.PlatformData `protobuf:"bytes,14,opt,name=platform,proto3" json:"platform" xml:"platform"`
+	SymlinkTarget string                      `protobuf:"bytes,17,opt,name=symlink_target,json=symlinkTarget,proto3" json:"symlinkTarget" xml:"symlinkTarget"`
+	BlocksHash    []byte                      `protobuf:"bytes,18,opt,name=blocks_hash,json=blocksHash,proto3" json:"blocksHash" xml:"blocksHash"`
+	Encrypted     []byte                      `protobuf:"bytes,19,opt,name=encrypted,proto3" json:"encrypted" xml:"encrypted"`
+	Type          protocol.FileInfoType       `protobuf:"varint,2,opt,name=type,proto3,enum=protocol.FileInfoType" json:"type" xml:"type"`
+	Permissions   uint32                      `protobuf:"varint,4,opt,name=permissions,proto3" json:"permissions" xml:"permissions"`
+	ModifiedNs    int                         `protobuf:"varint,11,opt,name=modified_ns,json=modifiedNs,proto3,casttype=int" json:"modifiedNs" xml:"modifiedNs"`
+	RawBlockSize  int                         `protobuf:"varint,13,opt,name=block_size,json=blockSize,proto3,casttype=int" json:"blockSize" xml:"blockSize"`
+	HashAlgorithm protocol.BlockHashAlgorithm `protobuf:"varint,20,opt,name=hash_algorithm,json=hashAlgorithm,proto3,enum=protocol.BlockHashAlgorithm" json:"hashAlgorithm" xml:"hashAlgorithm"`
+	Platform      protocol.PlatformData       `protobuf:"bytes,14,opt,name=platform,proto3" json:"platform" xml:"platform"`
 	// see bep.proto
 	LocalFlags    uint32 `protobuf:"varint,1000,opt,name=local_flags,json=localFlags,proto3" json:"localFlags" xml:"localFlags"`
 	VersionHash   []byte `protobuf:"bytes,1001,opt,name=version_hash,json=versionHash,proto3" json:"versionHash" xml:"versionHash"`
@@ -480,7 +509,96 @@ func (m *ObservedDevice) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ObservedDevice proto.InternalMessageInfo
 
+// Header of a portable index snapshot, as written by FileSet.ExportIndex.
+type IndexSnapshotHeader struct {
+	Folder   string                                               `protobuf:"bytes,1,opt,name=folder,proto3" json:"folder" xml:"folder"`
+	DeviceID github_com_syncthing_syncthing_lib_protocol.DeviceID `protobuf:"bytes,2,opt,name=device_id,json=deviceId,proto3,customtype=github.com/syncthing/syncthing/lib/protocol.DeviceID" json:"deviceID" xml:"deviceId"`
+	IndexID  github_com_syncthing_syncthing_lib_protocol.IndexID  `protobuf:"varint,3,opt,name=index_id,json=indexId,proto3,customtype=github.com/syncthing/syncthing/lib/protocol.IndexID" json:"indexID" xml:"indexId"`
+	Sequence int64                                                `protobuf:"varint,4,opt,name=sequence,proto3" json:"sequence" xml:"sequence"`
+	Created  time.Time                                            `protobuf:"bytes,5,opt,name=created,proto3,stdtime" json:"created" xml:"created"`
+}
+
+func (m *IndexSnapshotHeader) Reset()         { *m = IndexSnapshotHeader{} }
+func (m *IndexSnapshotHeader) String() string { return proto.CompactTextString(m) }
+func (*IndexSnapshotHeader) ProtoMessage()    {}
+func (*IndexSnapshotHeader) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5465d80e8cba02e3, []int{11}
+}
+func (m *IndexSnapshotHeader) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IndexSnapshotHeader) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IndexSnapshotHeader.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *IndexSnapshotHeader) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IndexSnapshotHeader.Merge(m, src)
+}
+func (m *IndexSnapshotHeader) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *IndexSnapshotHeader) XXX_DiscardUnknown() {
+	xxx_messageInfo_IndexSnapshotHeader.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IndexSnapshotHeader proto.InternalMessageInfo
+
+// A record of an index dump. Device records hold the index ID and sequence
+// of a device, file records a file as announced by a device, and global
+// records the version list of a name.
+type IndexDumpRecord struct {
+	Type     IndexDumpRecordType                                  `protobuf:"varint,1,opt,name=type,proto3,enum=db.IndexDumpRecordType" json:"type" xml:"type"`
+	DeviceID github_com_syncthing_syncthing_lib_protocol.DeviceID `protobuf:"bytes,2,opt,name=device_id,json=deviceId,proto3,customtype=github.com/syncthing/syncthing/lib/protocol.DeviceID" json:"deviceID" xml:"deviceId"`
+	IndexID  github_com_syncthing_syncthing_lib_protocol.IndexID  `protobuf:"varint,3,opt,name=index_id,json=indexId,proto3,customtype=github.com/syncthing/syncthing/lib/protocol.IndexID" json:"indexID" xml:"indexId"`
+	Sequence int64                                                `protobuf:"varint,4,opt,name=sequence,proto3" json:"sequence" xml:"sequence"`
+	File     protocol.FileInfo                                    `protobuf:"bytes,5,opt,name=file,proto3" json:"file" xml:"file"`
+	Name     string                                               `protobuf:"bytes,6,opt,name=name,proto3" json:"name" xml:"name"`
+	Versions VersionList                                          `protobuf:"bytes,7,opt,name=versions,proto3" json:"versions" xml:"versions"`
+}
+
+func (m *IndexDumpRecord) Reset()         { *m = IndexDumpRecord{} }
+func (m *IndexDumpRecord) String() string { return proto.CompactTextString(m) }
+func (*IndexDumpRecord) ProtoMessage()    {}
+func (*IndexDumpRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5465d80e8cba02e3, []int{12}
+}
+func (m *IndexDumpRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IndexDumpRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IndexDumpRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *IndexDumpRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IndexDumpRecord.Merge(m, src)
+}
+func (m *IndexDumpRecord) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *IndexDumpRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_IndexDumpRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IndexDumpRecord proto.InternalMessageInfo
+
 func init() {
+	proto.RegisterEnum("db.IndexDumpRecordType", IndexDumpRecordType_name, IndexDumpRecordType_value)
 	proto.RegisterType((*FileVersion)(nil), "db.FileVersion")
 	proto.RegisterType((*VersionList)(nil), "db.VersionList")
 	proto.RegisterType((*FileInfoTruncated)(nil), "db.FileInfoTruncated")
@@ -492,109 +610,134 @@ func init() {
 	proto.RegisterType((*VersionListDeprecated)(nil), "db.VersionListDeprecated")
 	proto.RegisterType((*ObservedFolder)(nil), "db.ObservedFolder")
 	proto.RegisterType((*ObservedDevice)(nil), "db.ObservedDevice")
+	proto.RegisterType((*IndexSnapshotHeader)(nil), "db.IndexSnapshotHeader")
+	proto.RegisterType((*IndexDumpRecord)(nil), "db.IndexDumpRecord")
 }
 
 func init() { proto.RegisterFile("lib/db/structs.proto", fileDescriptor_5465d80e8cba02e3) }
 
 var fileDescriptor_5465d80e8cba02e3 = []byte{
-	// 1543 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0xcd, 0x6f, 0xdb, 0x46,
-	0x16, 0x37, 0x2d, 0xd9, 0x92, 0x46, 0xf2, 0x17, 0xb3, 0x36, 0xb4, 0xde, 0x5d, 0x51, 0x3b, 0x71,
-	0x00, 0xed, 0x07, 0x64, 0xc0, 0x41, 0x8c, 0x45, 0x80, 0x6d, 0x10, 0xc6, 0x75, 0xe2, 0x20, 0x75,
-	0xd2, 0x71, 0x90, 0x14, 0xed, 0x41, 0xe0, 0xc7, 0x58, 0x26, 0x42, 0x91, 0x2a, 0x49, 0xdb, 0x51,
-	0x6e, 0xbd, 0x14, 0xe8, 0x2d, 0x08, 0x7a, 0x28, 0x8a, 0xa2, 0x08, 0x50, 0xa0, 0x7f, 0x42, 0xff,
-	0x82, 0xa2, 0xc8, 0xd1, 0xc7, 0xa2, 0x07, 0x16, 0xb1, 0x2f, 0xad, 0x8e, 0x3a, 0xf6, 0x54, 0xcc,
-	0x9b, 0xe1, 0x70, 0x64, 0x23, 0x45, 0x92, 0xfa, 0xc6, 0xf7, 0x7b, 0xbf, 0x79, 0x12, 0xdf, 0xfc,
-	0xde, 0x07, 0xd1, 0x5f, 0x7c, 0xcf, 0x5e, 0x75, 0xed, 0xd5, 0x38, 0x89, 0xf6, 0x9d, 0x24, 0x6e,
-	0xf7, 0xa3, 0x30, 0x09, 0xf5, 0x49, 0xd7, 0x5e, 0xbe, 0x18, 0xd1, 0x7e, 0x18, 0xaf, 0x02, 0x60,
-	0xef, 0xef, 0xae, 0x76, 0xc3, 0x6e, 0x08, 0x06, 0x3c, 0x71, 0xe2, 0xb2, 0xd1, 0x0d, 0xc3, 0xae,
-	0x4f, 0x73, 0x56, 0xe2, 0xf5, 0x68, 0x9c, 0x58, 0xbd, 0xbe, 0x20, 0x2c, 0xb1, 0xf8, 0xf0, 0xe8,
-	0x84, 0xfe, 0xaa, 0x4d, 0x33, 0xbc, 0x42, 0x1f, 0x27, 0xfc, 0x11, 0x7f, 0x3d, 0x89, 0xaa, 0x9b,
-	0x9e, 0x4f, 0x1f, 0xd0, 0x28, 0xf6, 0xc2, 0x40, 0xbf, 0x83, 0x4a, 0x07, 0xfc, 0xb1, 0xae, 0x35,
-	0xb5, 0x56, 0x75, 0x6d, 0xbe, 0x9d, 0x05, 0x68, 0x3f, 0xa0, 0x4e, 0x12, 0x46, 0x66, 0xf3, 0x45,
-	0x6a, 0x4c, 0x0c, 0x53, 0x23, 0x23, 0x8e, 0x52, 0x63, 0xe6, 0x71, 0xcf, 0xbf, 0x8a, 0x85, 0x8d,
-	0x49, 0xe6, 0xd1, 0xd7, 0x51, 0xc9, 0xa5, 0x3e, 0x4d, 0xa8, 0x5b, 0x9f, 0x6c, 0x6a, 0xad, 0xb2,
-	0xf9, 0x77, 0x76, 0x4e, 0x40, 0xf2, 0x9c, 0xb0, 0x31, 0xc9, 0x3c, 0xfa, 0x15, 0x76, 0xee, 0xc0,
-	0x73, 0x68, 0x5c, 0x2f, 0x34, 0x0b, 0xad, 0x9a, 0xf9, 0x37, 0x7e, 0x0e, 0xa0, 0x51, 0x6a, 0xd4,
-	0xc4, 0x39, 0x66, 0xc3, 0x31, 0x70, 0xe8, 0x04, 0xcd, 0x79, 0xc1, 0x81, 0xe5, 0x7b, 0x6e, 0x27,
-	0x3b, 0x5e, 0x84, 0xe3, 0xff, 0x1a, 0xa6, 0xc6, 0xac, 0x70, 0x6d, 0xc8, 0x28, 0x17, 0x20, 0xca,
-	0x18, 0x8c, 0xc9, 0x29, 0x1a, 0xfe, 0x44, 0x43, 0x55, 0x91, 0x9c, 0x3b, 0x5e, 0x9c, 0xe8, 0x3e,
-	0x2a, 0x8b, 0xb7, 0x8b, 0xeb, 0x5a, 0xb3, 0xd0, 0xaa, 0xae, 0xcd, 0xb5, 0x5d, 0xbb, 0xad, 0xe4,
-	0xd0, 0xbc, 0xc6, 0x12, 0x74, 0x9c, 0x1a, 0x55, 0x62, 0x1d, 0x0a, 0x2c, 0x1e, 0xa6, 0x86, 0x3c,
-	0x77, 0x26, 0x61, 0xcf, 0x8e, 0x56, 0x54, 0x2e, 0x91, 0xcc, 0xab, 0xc5, 0x2f, 0x9e, 0x1b, 0x13,
-	0xf8, 0x9b, 0x1a, 0x5a, 0x60, 0x3f, 0xb0, 0x15, 0xec, 0x86, 0xf7, 0xa3, 0xfd, 0xc0, 0xb1, 0x58,
-	0x92, 0xfe, 0x8d, 0x8a, 0x81, 0xd5, 0xa3, 0x70, 0x4f, 0x15, 0x73, 0x69, 0x98, 0x1a, 0x60, 0x8f,
-	0x52, 0x03, 0x41, 0x74, 0x66, 0x60, 0x02, 0x18, 0xe3, 0xc6, 0xde, 0x13, 0x5a, 0x2f, 0x34, 0xb5,
-	0x56, 0x81, 0x73, 0x99, 0x2d, 0xb9, 0xcc, 0xc0, 0x04, 0x30, 0xfd, 0x1a, 0x42, 0xbd, 0xd0, 0xf5,
-	0x76, 0x3d, 0xea, 0x76, 0xe2, 0xfa, 0x14, 0x9c, 0x68, 0x0e, 0x53, 0xa3, 0x92, 0xa1, 0x3b, 0xa3,
-	0xd4, 0x98, 0x83, 0x63, 0x12, 0xc1, 0x24, 0xf7, 0xea, 0xdf, 0x69, 0xa8, 0x2a, 0x23, 0xd8, 0x83,
-	0x7a, 0xad, 0xa9, 0xb5, 0x8a, 0xe6, 0xe7, 0x1a, 0x4b, 0xcb, 0x4f, 0xa9, 0x71, 0xb9, 0xeb, 0x25,
-	0x7b, 0xfb, 0x76, 0xdb, 0x09, 0x7b, 0xab, 0xf1, 0x20, 0x70, 0x92, 0x3d, 0x2f, 0xe8, 0x2a, 0x4f,
-	0xaa, 0x68, 0xdb, 0x3b, 0x7b, 0x61, 0x94, 0x6c, 0x6d, 0x0c, 0x53, 0x43, 0xfe, 0x29, 0x73, 0x30,
-	0x4a, 0x8d, 0xf9, 0xb1, 0xdf, 0x37, 0x07, 0xf8, 0xcb, 0xa3, 0x95, 0xb7, 0x09, 0x4c, 0x94, 0xb0,
-	0xaa, 0xf8, 0x2b, 0x7f, 0x5e, 0xfc, 0x57, 0x51, 0x39, 0xa6, 0x1f, 0xef, 0xd3, 0xc0, 0xa1, 0x75,
-	0x04, 0x59, 0x6c, 0x30, 0x15, 0x64, 0xd8, 0x28, 0x35, 0x66, 0x79, 0xee, 0x05, 0x80, 0x89, 0xf4,
-	0xe9, 0x77, 0xd1, 0x6c, 0x3c, 0xe8, 0xf9, 0x5e, 0xf0, 0xa8, 0x93, 0x58, 0x51, 0x97, 0x26, 0xf5,
-	0x05, 0xb8, 0xe5, 0xd6, 0x30, 0x35, 0x66, 0x84, 0xe7, 0x3e, 0x38, 0xa4, 0x8e, 0xc7, 0x50, 0x4c,
-	0xc6, 0x59, 0xfa, 0x0d, 0x54, 0xb5, 0xfd, 0xd0, 0x79, 0x14, 0x77, 0xf6, 0xac, 0x78, 0xaf, 0xae,
-	0x37, 0xb5, 0x56, 0xcd, 0xc4, 0x2c, 0xad, 0x1c, 0xbe, 0x65, 0xc5, 0x7b, 0x32, 0xad, 0x39, 0x84,
-	0x89, 0xe2, 0xd7, 0xdf, 0x41, 0x15, 0x1a, 0x38, 0xd1, 0xa0, 0xcf, 0x0a, 0xfa, 0x02, 0x84, 0x00,
-	0x61, 0x48, 0x50, 0x0a, 0x43, 0x22, 0x98, 0xe4, 0x5e, 0xdd, 0x44, 0xc5, 0x64, 0xd0, 0xa7, 0xd0,
-	0x0b, 0x66, 0xd7, 0x96, 0xf2, 0xe4, 0x4a, 0x71, 0x0f, 0xfa, 0x94, 0xab, 0x93, 0xf1, 0xa4, 0x3a,
-	0x99, 0x81, 0x09, 0x60, 0xfa, 0x26, 0xaa, 0xf6, 0x69, 0xd4, 0xf3, 0x62, 0x5e, 0x82, 0xc5, 0xa6,
-	0xd6, 0x9a, 0x31, 0x57, 0x86, 0xa9, 0xa1, 0xc2, 0xa3, 0xd4, 0x58, 0x80, 0x93, 0x0a, 0x86, 0x89,
-	0xca, 0xd0, 0x6f, 0x2b, 0x1a, 0x0d, 0xe2, 0x7a, 0xb5, 0xa9, 0xb5, 0xa6, 0xa0, 0x4f, 0x48, 0x41,
-	0x6c, 0xc7, 0x67, 0x74, 0xb6, 0x1d, 0xe3, 0xdf, 0x52, 0xa3, 0xe0, 0x05, 0x09, 0x51, 0x68, 0xfa,
-	0x2e, 0xe2, 0x59, 0xea, 0x40, 0x8d, 0xcd, 0x40, 0xa8, 0x9b, 0xc7, 0xa9, 0x51, 0x23, 0xd6, 0xa1,
-	0xc9, 0x1c, 0x3b, 0xde, 0x13, 0xca, 0x12, 0x65, 0x67, 0x86, 0x4c, 0x94, 0x44, 0xb2, 0xc0, 0xcf,
-	0x8e, 0x56, 0xc6, 0x8e, 0x91, 0xfc, 0x90, 0xfe, 0x00, 0x95, 0xfb, 0xbe, 0x95, 0xec, 0x86, 0x51,
-	0xaf, 0x3e, 0x0b, 0x02, 0x55, 0x72, 0x78, 0x4f, 0x78, 0x36, 0xac, 0xc4, 0x32, 0xb1, 0x90, 0xa9,
-	0xe4, 0x4b, 0xb5, 0x65, 0x00, 0x26, 0xd2, 0xa7, 0x6f, 0xa0, 0xaa, 0x1f, 0x3a, 0x96, 0xdf, 0xd9,
-	0xf5, 0xad, 0x6e, 0x5c, 0xff, 0xa5, 0x04, 0x49, 0x05, 0x75, 0x00, 0xbe, 0xc9, 0x60, 0x99, 0x8c,
-	0x1c, 0xc2, 0x44, 0xf1, 0xeb, 0xb7, 0x50, 0x4d, 0x48, 0x9f, 0x6b, 0xec, 0xd7, 0x12, 0x28, 0x04,
-	0xee, 0x46, 0x38, 0x84, 0xca, 0x16, 0xd4, 0x8a, 0xe1, 0x32, 0x53, 0x19, 0xfa, 0xfb, 0xac, 0x8f,
-	0x87, 0x2e, 0xed, 0x38, 0x7b, 0x56, 0xd0, 0xa5, 0xec, 0x7e, 0x86, 0x25, 0xa8, 0x20, 0xd0, 0x3f,
-	0xf8, 0x6e, 0x80, 0x6b, 0x5b, 0xed, 0xe3, 0x0a, 0x8a, 0xc9, 0x38, 0x4b, 0x9d, 0x44, 0xd3, 0x6f,
-	0x32, 0x89, 0x08, 0x2a, 0x89, 0x81, 0x50, 0x2f, 0xc1, 0xb9, 0xff, 0x1d, 0xa7, 0x06, 0x22, 0xd6,
-	0xe1, 0x16, 0x47, 0x59, 0x14, 0x41, 0x90, 0x51, 0x84, 0xcd, 0xda, 0xba, 0xc2, 0x24, 0x19, 0x8f,
-	0x15, 0x77, 0x10, 0x76, 0x54, 0x15, 0x97, 0x21, 0x34, 0xbc, 0x5c, 0x10, 0xde, 0x1b, 0xd3, 0x31,
-	0x7f, 0xb9, 0x31, 0x14, 0x93, 0x71, 0x96, 0x98, 0x12, 0x0f, 0x51, 0x05, 0x54, 0x03, 0x63, 0xea,
-	0x36, 0x9a, 0xe6, 0x85, 0x2b, 0x86, 0xd4, 0x85, 0x5c, 0x28, 0x40, 0x62, 0xd5, 0x66, 0xfe, 0x43,
-	0xa8, 0x44, 0x50, 0x47, 0xa9, 0x51, 0xcd, 0x45, 0x89, 0x89, 0x80, 0xf1, 0xb7, 0x1a, 0x5a, 0xdc,
-	0x0a, 0x5c, 0x2f, 0xa2, 0x4e, 0x22, 0xae, 0x88, 0xc6, 0x77, 0x03, 0x7f, 0x70, 0x3e, 0x5d, 0xe5,
-	0xdc, 0x74, 0x83, 0xbf, 0x2a, 0xa2, 0xe9, 0x1b, 0xe1, 0x7e, 0x90, 0xc4, 0xfa, 0x15, 0x34, 0xb5,
-	0xeb, 0xf9, 0x34, 0x86, 0xe9, 0x38, 0x65, 0x1a, 0xc3, 0xd4, 0xe0, 0x80, 0x7c, 0x49, 0xb0, 0x64,
-	0x39, 0x73, 0xa7, 0xfe, 0x1e, 0xaa, 0xf2, 0xf7, 0x0c, 0x23, 0x8f, 0xc6, 0xd0, 0xa8, 0xa6, 0xcc,
-	0xff, 0xb0, 0x7f, 0xa2, 0xc0, 0xf2, 0x9f, 0x28, 0x98, 0x0c, 0xa4, 0x12, 0xf5, 0xeb, 0xa8, 0x2c,
-	0xda, 0x70, 0x0c, 0xa3, 0x77, 0xca, 0xbc, 0x04, 0x23, 0x40, 0x60, 0xf9, 0x08, 0x10, 0x80, 0x8c,
-	0x22, 0x29, 0xfa, 0xff, 0x73, 0xe1, 0x16, 0x21, 0xc2, 0xc5, 0x3f, 0x12, 0x6e, 0x76, 0x5e, 0xea,
-	0xb7, 0x8d, 0xa6, 0xec, 0x41, 0x42, 0xb3, 0x39, 0x5e, 0x67, 0x79, 0x00, 0x20, 0xbf, 0x6c, 0x66,
-	0x61, 0xc2, 0xd1, 0xb1, 0xa1, 0x35, 0xfd, 0x86, 0x43, 0x6b, 0x07, 0x55, 0xf8, 0xda, 0xd5, 0xf1,
-	0x5c, 0x98, 0x57, 0x35, 0x73, 0xfd, 0x38, 0x35, 0xca, 0x7c, 0x95, 0x82, 0x21, 0x5e, 0xe6, 0x84,
-	0x2d, 0x57, 0x06, 0xca, 0x00, 0x56, 0x2d, 0x92, 0x49, 0x24, 0x8f, 0x49, 0x4c, 0xed, 0x4d, 0xfa,
-	0xdb, 0xb4, 0x26, 0x51, 0x20, 0x9f, 0x6a, 0xa8, 0xc2, 0xe5, 0xb1, 0x43, 0x13, 0xfd, 0x3a, 0x9a,
-	0x76, 0xc0, 0x10, 0x15, 0x82, 0xd8, 0x1a, 0xc7, 0xdd, 0x79, 0x61, 0x70, 0x86, 0xcc, 0x15, 0x98,
-	0x98, 0x08, 0x98, 0x35, 0x15, 0x27, 0xa2, 0x56, 0xb6, 0xde, 0x16, 0x78, 0x53, 0x11, 0x90, 0xbc,
-	0x1b, 0x61, 0x63, 0x92, 0x79, 0xf0, 0x67, 0x93, 0x68, 0x51, 0x59, 0x18, 0x37, 0x68, 0x3f, 0xa2,
-	0x7c, 0xa7, 0x3b, 0xdf, 0xf5, 0x7b, 0x0d, 0x4d, 0xf3, 0x3c, 0xc2, 0xdf, 0xab, 0x99, 0xcb, 0xec,
-	0x95, 0x38, 0x72, 0x66, 0x89, 0x16, 0x38, 0x7b, 0xa7, 0xac, 0xe1, 0x15, 0xf2, 0x46, 0xf9, 0xaa,
-	0x16, 0x97, 0x37, 0xb5, 0xf5, 0x71, 0x9d, 0xbe, 0x6e, 0x83, 0xc5, 0x87, 0x68, 0x51, 0x59, 0xaf,
-	0x95, 0x54, 0x7c, 0x70, 0x66, 0xd1, 0xfe, 0xeb, 0xa9, 0x45, 0x3b, 0x27, 0x9b, 0xff, 0xcc, 0xe6,
-	0xdd, 0x2b, 0x77, 0xec, 0x33, 0x4b, 0xf5, 0x0f, 0x93, 0x68, 0xf6, 0xae, 0x1d, 0xd3, 0xe8, 0x80,
-	0xba, 0x9b, 0xa1, 0xef, 0xd2, 0x48, 0xdf, 0x46, 0x45, 0xf6, 0x09, 0x25, 0x52, 0xbf, 0xdc, 0xe6,
-	0xdf, 0x57, 0xed, 0xec, 0xfb, 0xaa, 0x7d, 0x3f, 0xfb, 0xbe, 0x32, 0x1b, 0xe2, 0xf7, 0x80, 0x9f,
-	0xef, 0x29, 0x5e, 0x8f, 0xe2, 0xa7, 0x3f, 0x1b, 0x1a, 0x01, 0x9c, 0x15, 0x9f, 0x6f, 0xd9, 0xd4,
-	0x87, 0xf4, 0x57, 0x78, 0xf1, 0x01, 0x20, 0x05, 0x05, 0x16, 0x26, 0x1c, 0xd5, 0x3f, 0x42, 0x0b,
-	0x11, 0x75, 0xa8, 0x77, 0x40, 0x3b, 0xf9, 0x9e, 0xc5, 0x6f, 0xa1, 0x3d, 0x4c, 0x8d, 0x79, 0xe1,
-	0x7c, 0x57, 0x59, 0xb7, 0x96, 0x20, 0xcc, 0x69, 0x07, 0x26, 0x67, 0xb8, 0xfa, 0x43, 0x34, 0x1f,
-	0xd1, 0x5e, 0x98, 0xa8, 0xb1, 0xf9, 0x4d, 0xfd, 0x77, 0x98, 0x1a, 0x73, 0xdc, 0xa7, 0x86, 0x5e,
-	0x14, 0xa1, 0xc7, 0x70, 0x4c, 0x4e, 0x33, 0xf1, 0xf7, 0x5a, 0x9e, 0x48, 0x5e, 0xc0, 0xe7, 0x9e,
-	0xc8, 0xec, 0x53, 0x67, 0xf2, 0x35, 0x3e, 0x75, 0xd6, 0x51, 0xc9, 0x72, 0xdd, 0x88, 0xc6, 0xbc,
-	0xe5, 0x56, 0xb8, 0x10, 0x05, 0x24, 0x65, 0x21, 0x6c, 0x4c, 0x32, 0x8f, 0x79, 0xf3, 0xc5, 0xcb,
-	0xc6, 0xc4, 0xd1, 0xcb, 0xc6, 0xc4, 0x8b, 0xe3, 0x86, 0x76, 0x74, 0xdc, 0xd0, 0x9e, 0x9e, 0x34,
-	0x26, 0x9e, 0x9f, 0x34, 0xb4, 0xa3, 0x93, 0xc6, 0xc4, 0x8f, 0x27, 0x8d, 0x89, 0x0f, 0x2f, 0xbd,
-	0xc6, 0xf7, 0x85, 0x6b, 0xdb, 0xd3, 0xf0, 0x9a, 0x97, 0x7f, 0x0f, 0x00, 0x00, 0xff, 0xff, 0x52,
-	0x5f, 0x14, 0xfe, 0xde, 0x0f, 0x00, 0x00,
+	// 1916 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x58, 0xcd, 0x6f, 0x24, 0x47,
+	0x15, 0x77, 0xdb, 0xe3, 0xf9, 0xa8, 0xf1, 0x67, 0x3b, 0xbb, 0x99, 0x78, 0xb3, 0xd3, 0x43, 0xc5,
+	0x91, 0x86, 0x80, 0xc6, 0x92, 0x43, 0x56, 0x68, 0x05, 0x84, 0x6d, 0x8f, 0xbd, 0x3b, 0x91, 0x63,
+	0x2f, 0xe5, 0x65, 0x13, 0xc2, 0x61, 0xd4, 0xd3, 0x5d, 0x9e, 0x69, 0xa5, 0xa7, 0x7b, 0xe8, 0x6e,
+	0x7b, 0x77, 0x72, 0x83, 0x03, 0x82, 0x9c, 0xa2, 0x15, 0x07, 0x84, 0x08, 0xda, 0x13, 0x57, 0x6e,
+	0xfc, 0x05, 0x11, 0x5a, 0x89, 0x03, 0x16, 0x17, 0x10, 0x87, 0x46, 0xf1, 0x5e, 0x60, 0x8e, 0x73,
+	0xe4, 0x84, 0xea, 0x55, 0x75, 0x75, 0x8d, 0xbd, 0x5e, 0xec, 0x65, 0x2f, 0x48, 0xdc, 0xfa, 0xfd,
+	0xde, 0xc7, 0x74, 0xbf, 0xfa, 0xbd, 0x8f, 0x1a, 0xf4, 0x8a, 0xe7, 0x76, 0xd6, 0x9d, 0xce, 0x7a,
+	0x14, 0x87, 0x87, 0x76, 0x1c, 0x35, 0x06, 0x61, 0x10, 0x07, 0xfa, 0xb4, 0xd3, 0x59, 0x7d, 0x23,
+	0xa4, 0x83, 0x20, 0x5a, 0x07, 0xa0, 0x73, 0x78, 0xb0, 0xde, 0x0d, 0xba, 0x01, 0x08, 0xf0, 0xc4,
+	0x0d, 0x57, 0x8d, 0x6e, 0x10, 0x74, 0x3d, 0x9a, 0x59, 0xc5, 0x6e, 0x9f, 0x46, 0xb1, 0xd5, 0x1f,
+	0x08, 0x83, 0xab, 0x2c, 0x3e, 0x3c, 0xda, 0x81, 0xb7, 0xde, 0xa1, 0x29, 0x5e, 0xa2, 0x0f, 0x63,
+	0xfe, 0x88, 0x7f, 0x33, 0x8d, 0xca, 0xdb, 0xae, 0x47, 0xef, 0xd3, 0x30, 0x72, 0x03, 0x5f, 0xdf,
+	0x41, 0x85, 0x23, 0xfe, 0x58, 0xd1, 0x6a, 0x5a, 0xbd, 0xbc, 0xb1, 0xd4, 0x48, 0x03, 0x34, 0xee,
+	0x53, 0x3b, 0x0e, 0x42, 0xb3, 0xf6, 0x24, 0x31, 0xa6, 0x46, 0x89, 0x91, 0x1a, 0x8e, 0x13, 0x63,
+	0xfe, 0x61, 0xdf, 0xbb, 0x89, 0x85, 0x8c, 0x49, 0xaa, 0xd1, 0x6f, 0xa0, 0x82, 0x43, 0x3d, 0x1a,
+	0x53, 0xa7, 0x32, 0x5d, 0xd3, 0xea, 0x45, 0xf3, 0x75, 0xe6, 0x27, 0x20, 0xe9, 0x27, 0x64, 0x4c,
+	0x52, 0x8d, 0xfe, 0x0e, 0xf3, 0x3b, 0x72, 0x6d, 0x1a, 0x55, 0x66, 0x6a, 0x33, 0xf5, 0x39, 0xf3,
+	0x1a, 0xf7, 0x03, 0x68, 0x9c, 0x18, 0x73, 0xc2, 0x8f, 0xc9, 0xe0, 0x06, 0x0a, 0x9d, 0xa0, 0x45,
+	0xd7, 0x3f, 0xb2, 0x3c, 0xd7, 0x69, 0xa7, 0xee, 0x39, 0x70, 0xff, 0xea, 0x28, 0x31, 0x16, 0x84,
+	0xaa, 0x29, 0xa3, 0xac, 0x40, 0x94, 0x09, 0x18, 0x93, 0x53, 0x66, 0xf8, 0xc7, 0x1a, 0x2a, 0x8b,
+	0xe4, 0xec, 0xb8, 0x51, 0xac, 0x7b, 0xa8, 0x28, 0xbe, 0x2e, 0xaa, 0x68, 0xb5, 0x99, 0x7a, 0x79,
+	0x63, 0xb1, 0xe1, 0x74, 0x1a, 0x4a, 0x0e, 0xcd, 0x77, 0x59, 0x82, 0x4e, 0x12, 0xa3, 0x4c, 0xac,
+	0x07, 0x02, 0x8b, 0x46, 0x89, 0x21, 0xfd, 0xce, 0x24, 0xec, 0xd1, 0xf1, 0x9a, 0x6a, 0x4b, 0xa4,
+	0xe5, 0xcd, 0xdc, 0x2f, 0x1f, 0x1b, 0x53, 0xf8, 0xd1, 0x3c, 0x5a, 0x66, 0x3f, 0xd0, 0xf2, 0x0f,
+	0x82, 0x7b, 0xe1, 0xa1, 0x6f, 0x5b, 0x2c, 0x49, 0x6f, 0xa1, 0x9c, 0x6f, 0xf5, 0x29, 0x9c, 0x53,
+	0xc9, 0xbc, 0x3a, 0x4a, 0x0c, 0x90, 0xc7, 0x89, 0x81, 0x20, 0x3a, 0x13, 0x30, 0x01, 0x8c, 0xd9,
+	0x46, 0xee, 0x27, 0xb4, 0x32, 0x53, 0xd3, 0xea, 0x33, 0xdc, 0x96, 0xc9, 0xd2, 0x96, 0x09, 0x98,
+	0x00, 0xa6, 0xbf, 0x8b, 0x50, 0x3f, 0x70, 0xdc, 0x03, 0x97, 0x3a, 0xed, 0xa8, 0x32, 0x0b, 0x1e,
+	0xb5, 0x51, 0x62, 0x94, 0x52, 0x74, 0x7f, 0x9c, 0x18, 0x8b, 0xe0, 0x26, 0x11, 0x4c, 0x32, 0xad,
+	0xfe, 0x7b, 0x0d, 0x95, 0x65, 0x84, 0xce, 0xb0, 0x32, 0x57, 0xd3, 0xea, 0x39, 0xf3, 0x17, 0x1a,
+	0x4b, 0xcb, 0xdf, 0x12, 0xe3, 0xed, 0xae, 0x1b, 0xf7, 0x0e, 0x3b, 0x0d, 0x3b, 0xe8, 0xaf, 0x47,
+	0x43, 0xdf, 0x8e, 0x7b, 0xae, 0xdf, 0x55, 0x9e, 0x54, 0xd2, 0x36, 0xf6, 0x7b, 0x41, 0x18, 0xb7,
+	0x9a, 0xa3, 0xc4, 0x90, 0x2f, 0x65, 0x0e, 0xc7, 0x89, 0xb1, 0x34, 0xf1, 0xfb, 0xe6, 0x10, 0xff,
+	0xea, 0x78, 0xed, 0x45, 0x02, 0x13, 0x25, 0xac, 0x4a, 0xfe, 0xd2, 0x7f, 0x4f, 0xfe, 0x9b, 0xa8,
+	0x18, 0xd1, 0x1f, 0x1d, 0x52, 0xdf, 0xa6, 0x15, 0x04, 0x59, 0xac, 0x32, 0x16, 0xa4, 0xd8, 0x38,
+	0x31, 0x16, 0x78, 0xee, 0x05, 0x80, 0x89, 0xd4, 0xe9, 0x7b, 0x68, 0x21, 0x1a, 0xf6, 0x3d, 0xd7,
+	0xff, 0xb8, 0x1d, 0x5b, 0x61, 0x97, 0xc6, 0x95, 0x65, 0x38, 0xe5, 0xfa, 0x28, 0x31, 0xe6, 0x85,
+	0xe6, 0x1e, 0x28, 0x24, 0x8f, 0x27, 0x50, 0x4c, 0x26, 0xad, 0xf4, 0x4d, 0x54, 0xee, 0x78, 0x81,
+	0xfd, 0x71, 0xd4, 0xee, 0x59, 0x51, 0xaf, 0xa2, 0xd7, 0xb4, 0xfa, 0x9c, 0x89, 0x59, 0x5a, 0x39,
+	0x7c, 0xc7, 0x8a, 0x7a, 0x32, 0xad, 0x19, 0x84, 0x89, 0xa2, 0xd7, 0xbf, 0x83, 0x4a, 0xd4, 0xb7,
+	0xc3, 0xe1, 0x80, 0x15, 0xf4, 0x0a, 0x84, 0x00, 0x62, 0x48, 0x50, 0x12, 0x43, 0x22, 0x98, 0x64,
+	0x5a, 0xdd, 0x44, 0xb9, 0x78, 0x38, 0xa0, 0xd0, 0x0b, 0x16, 0x36, 0xae, 0x66, 0xc9, 0x95, 0xe4,
+	0x1e, 0x0e, 0x28, 0x67, 0x27, 0xb3, 0x93, 0xec, 0x64, 0x02, 0x26, 0x80, 0xe9, 0xdb, 0xa8, 0x3c,
+	0xa0, 0x61, 0xdf, 0x8d, 0x78, 0x09, 0xe6, 0x6a, 0x5a, 0x7d, 0xde, 0x5c, 0x1b, 0x25, 0x86, 0x0a,
+	0x8f, 0x13, 0x63, 0x19, 0x3c, 0x15, 0x0c, 0x13, 0xd5, 0x42, 0x7f, 0x4f, 0xe1, 0xa8, 0x1f, 0x55,
+	0xca, 0x35, 0xad, 0x3e, 0x0b, 0x7d, 0x42, 0x12, 0x62, 0x37, 0x3a, 0xc3, 0xb3, 0xdd, 0x08, 0xff,
+	0x2b, 0x31, 0x66, 0x5c, 0x3f, 0x26, 0x8a, 0x99, 0x7e, 0x80, 0x78, 0x96, 0xda, 0x50, 0x63, 0xf3,
+	0x10, 0xea, 0xf6, 0x49, 0x62, 0xcc, 0x11, 0xeb, 0x81, 0xc9, 0x14, 0xfb, 0xee, 0x27, 0x94, 0x25,
+	0xaa, 0x93, 0x0a, 0x32, 0x51, 0x12, 0x49, 0x03, 0x3f, 0x3a, 0x5e, 0x9b, 0x70, 0x23, 0x99, 0x93,
+	0xde, 0x47, 0x0b, 0xec, 0xf4, 0xda, 0x96, 0xd7, 0x0d, 0x42, 0x37, 0xee, 0xf5, 0x2b, 0xaf, 0x40,
+	0x26, 0x5f, 0xcf, 0x32, 0x09, 0x7e, 0xec, 0xb0, 0x6e, 0xa5, 0x36, 0x9c, 0x33, 0x3d, 0x15, 0x92,
+	0x9c, 0x99, 0x40, 0x31, 0x99, 0xb4, 0xd2, 0xef, 0xa3, 0xe2, 0xc0, 0xb3, 0xe2, 0x83, 0x20, 0xec,
+	0x57, 0x16, 0xa0, 0x1e, 0x94, 0x23, 0xbb, 0x2b, 0x34, 0x4d, 0x2b, 0xb6, 0x4c, 0x2c, 0xaa, 0x42,
+	0xda, 0x4b, 0x72, 0xa7, 0x00, 0x26, 0x52, 0xa7, 0x37, 0x51, 0xd9, 0x0b, 0x6c, 0xcb, 0x6b, 0x1f,
+	0x78, 0x56, 0x37, 0xaa, 0xfc, 0xa3, 0x00, 0x67, 0x08, 0x64, 0x04, 0x7c, 0x9b, 0xc1, 0x32, 0xf7,
+	0x19, 0x84, 0x89, 0xa2, 0xd7, 0xef, 0xa0, 0x39, 0x51, 0x69, 0x9c, 0xd2, 0xff, 0x2c, 0x00, 0x21,
+	0x81, 0x0a, 0x42, 0x21, 0x48, 0xbd, 0xac, 0x16, 0x28, 0x67, 0xb5, 0x6a, 0xa1, 0x7f, 0x8f, 0x8d,
+	0x8d, 0xc0, 0xa1, 0x6d, 0xbb, 0x67, 0xf9, 0x5d, 0xca, 0xe8, 0x30, 0x2a, 0x40, 0xc1, 0x42, 0xea,
+	0x40, 0xb7, 0x09, 0xaa, 0x5d, 0x75, 0x6c, 0x28, 0x28, 0x26, 0x93, 0x56, 0xea, 0xe0, 0xcb, 0x5f,
+	0x66, 0xf0, 0x11, 0x54, 0x10, 0xf3, 0xa7, 0x52, 0x00, 0xbf, 0x6f, 0x9e, 0x24, 0x06, 0x22, 0xd6,
+	0x83, 0x16, 0x47, 0x59, 0x14, 0x61, 0x20, 0xa3, 0x08, 0x99, 0x4d, 0x11, 0xc5, 0x92, 0xa4, 0x76,
+	0xac, 0x97, 0xf8, 0x41, 0x5b, 0x2d, 0x9a, 0x22, 0x84, 0x86, 0x8f, 0xf3, 0x83, 0xbb, 0x13, 0x65,
+	0xc3, 0x3f, 0x6e, 0x02, 0xc5, 0x64, 0xd2, 0x4a, 0x0c, 0xa5, 0x0f, 0x50, 0x09, 0xc8, 0x06, 0x53,
+	0xf1, 0x3d, 0x94, 0xe7, 0x7d, 0x42, 0xcc, 0xc4, 0x95, 0x53, 0x8c, 0x64, 0xc5, 0x6d, 0x5e, 0x17,
+	0x2c, 0x11, 0xa6, 0xe3, 0xc4, 0x28, 0x67, 0x35, 0x80, 0x89, 0x80, 0xf1, 0x6f, 0x35, 0x74, 0xa5,
+	0xe5, 0x3b, 0x6e, 0x48, 0xed, 0x58, 0x1c, 0x11, 0x8d, 0xf6, 0x7c, 0x6f, 0xf8, 0x72, 0x9a, 0xd8,
+	0x4b, 0xe3, 0x0d, 0xfe, 0x75, 0x0e, 0xe5, 0x37, 0x83, 0x43, 0x3f, 0x8e, 0xf4, 0x77, 0xd0, 0xec,
+	0x81, 0xeb, 0xd1, 0x08, 0x86, 0xf1, 0xac, 0x69, 0x8c, 0x12, 0x83, 0x03, 0xf2, 0x23, 0x41, 0x92,
+	0xdd, 0x83, 0x2b, 0xf5, 0xf7, 0x51, 0x99, 0x7f, 0x67, 0x10, 0xba, 0x34, 0x82, 0xbe, 0x38, 0x6b,
+	0x7e, 0x8d, 0xbd, 0x89, 0x02, 0xcb, 0x37, 0x51, 0x30, 0x19, 0x48, 0x35, 0xd4, 0x6f, 0xa1, 0xa2,
+	0xe8, 0xfa, 0x11, 0x4c, 0xfa, 0x59, 0xf3, 0x4d, 0x98, 0x38, 0x02, 0xcb, 0x26, 0x8e, 0x00, 0x64,
+	0x14, 0x69, 0xa2, 0x7f, 0x3b, 0x23, 0x6e, 0x0e, 0x22, 0xbc, 0xf1, 0x3c, 0xe2, 0xa6, 0xfe, 0x92,
+	0xbf, 0x0d, 0x34, 0xdb, 0x19, 0xc6, 0x34, 0x5d, 0x1b, 0x2a, 0x2c, 0x0f, 0x00, 0x64, 0x87, 0xcd,
+	0x24, 0x4c, 0x38, 0x3a, 0x31, 0x23, 0xf3, 0x97, 0x9c, 0x91, 0xfb, 0xa8, 0xc4, 0xb7, 0xbc, 0xb6,
+	0xeb, 0xc0, 0x78, 0x9c, 0x33, 0x6f, 0x9c, 0x24, 0x46, 0x91, 0x6f, 0x6e, 0xb0, 0x33, 0x14, 0xb9,
+	0x41, 0xcb, 0x91, 0x81, 0x52, 0x80, 0x55, 0x8b, 0xb4, 0x24, 0xd2, 0x8e, 0x51, 0x4c, 0xed, 0x4d,
+	0xfa, 0x8b, 0xb4, 0x26, 0x51, 0x20, 0x3f, 0xd5, 0x50, 0x89, 0xd3, 0x63, 0x9f, 0xc6, 0xfa, 0x2d,
+	0x94, 0xb7, 0x41, 0x10, 0x15, 0x82, 0xd8, 0xd6, 0xc8, 0xd5, 0x59, 0x61, 0x70, 0x0b, 0x99, 0x2b,
+	0x10, 0x31, 0x11, 0x30, 0x6b, 0x2a, 0x76, 0x48, 0xad, 0x74, 0x9b, 0x9e, 0xe1, 0x4d, 0x45, 0x40,
+	0xf2, 0x6c, 0x84, 0x8c, 0x49, 0xaa, 0xc1, 0x3f, 0x9f, 0x46, 0x57, 0x94, 0xfd, 0xb4, 0x49, 0x07,
+	0x21, 0xe5, 0x2b, 0xe4, 0xcb, 0xdd, 0xf6, 0x37, 0x50, 0x9e, 0xe7, 0x11, 0x5e, 0x6f, 0xce, 0x5c,
+	0x65, 0x9f, 0xc4, 0x91, 0x33, 0x3b, 0xbb, 0xc0, 0xd9, 0x37, 0xa5, 0x0d, 0x6f, 0x26, 0x6b, 0x94,
+	0xe7, 0xb5, 0xb8, 0xac, 0xa9, 0xdd, 0x98, 0xe4, 0xe9, 0x45, 0x1b, 0x2c, 0x7e, 0x80, 0xae, 0x28,
+	0xdb, 0xbc, 0x92, 0x8a, 0x0f, 0xcf, 0xec, 0xf5, 0xaf, 0x9d, 0xda, 0xeb, 0x33, 0x63, 0xf3, 0x2b,
+	0xe9, 0xbc, 0x3b, 0x77, 0xa5, 0x3f, 0xb3, 0xc3, 0xff, 0x61, 0x1a, 0x2d, 0xec, 0x75, 0x22, 0x1a,
+	0x1e, 0x51, 0x67, 0x3b, 0xf0, 0x1c, 0x1a, 0xea, 0xbb, 0x28, 0xc7, 0x6e, 0x6c, 0x22, 0xf5, 0xab,
+	0x0d, 0x7e, 0x9d, 0x6b, 0xa4, 0xd7, 0xb9, 0xc6, 0xbd, 0xf4, 0x3a, 0x67, 0x56, 0xc5, 0xef, 0x81,
+	0x7d, 0xb6, 0x16, 0xb9, 0x7d, 0x8a, 0x3f, 0xfb, 0xbb, 0xa1, 0x11, 0xc0, 0x59, 0xf1, 0x79, 0x56,
+	0x87, 0x7a, 0x90, 0xfe, 0x12, 0x2f, 0x3e, 0x00, 0x24, 0xa1, 0x40, 0xc2, 0x84, 0xa3, 0xfa, 0x0f,
+	0xd1, 0x72, 0x48, 0x6d, 0xea, 0x1e, 0xd1, 0x76, 0xb6, 0xd6, 0xf1, 0x53, 0x68, 0x8c, 0x12, 0x63,
+	0x49, 0x28, 0xb7, 0x94, 0xed, 0xee, 0x2a, 0x84, 0x39, 0xad, 0xc0, 0xe4, 0x8c, 0xad, 0xfe, 0x01,
+	0x5a, 0x0a, 0x69, 0x3f, 0x88, 0xd5, 0xd8, 0xfc, 0xa4, 0xbe, 0x3e, 0x4a, 0x8c, 0x45, 0xae, 0x53,
+	0x43, 0x5f, 0x11, 0xa1, 0x27, 0x70, 0x4c, 0x4e, 0x5b, 0xe2, 0x2f, 0xb4, 0x2c, 0x91, 0xbc, 0x80,
+	0x5f, 0x7a, 0x22, 0xd3, 0x9b, 0xd5, 0xf4, 0x05, 0x6e, 0x56, 0x37, 0x50, 0xc1, 0x72, 0x9c, 0x90,
+	0x46, 0xbc, 0xe5, 0x96, 0x38, 0x11, 0x05, 0x24, 0x69, 0x21, 0x64, 0x4c, 0x52, 0x0d, 0xfe, 0x73,
+	0x0e, 0xad, 0xb4, 0x7c, 0x87, 0x3e, 0xdc, 0xf7, 0xad, 0x41, 0xd4, 0x0b, 0xe2, 0x3b, 0xd4, 0x62,
+	0xa4, 0xd8, 0x40, 0xf9, 0x03, 0xa0, 0x87, 0xb8, 0xd7, 0x41, 0x11, 0x71, 0x44, 0x16, 0x11, 0x17,
+	0x31, 0x11, 0xb8, 0xfe, 0x58, 0x53, 0x5b, 0x21, 0x2f, 0xbe, 0x9f, 0xa4, 0xd7, 0xad, 0x6f, 0x5c,
+	0xe6, 0x56, 0x94, 0x76, 0xc4, 0x73, 0xfa, 0x68, 0xf3, 0x6c, 0x1f, 0x1d, 0xff, 0x69, 0x4d, 0x6a,
+	0x7f, 0x76, 0xbc, 0xa6, 0x9d, 0xd3, 0x57, 0xff, 0xa8, 0xa1, 0xa2, 0xcb, 0x3e, 0xb7, 0x2d, 0x2a,
+	0x3d, 0x67, 0xfe, 0xee, 0x85, 0x2e, 0x84, 0x90, 0x33, 0x78, 0xc1, 0x82, 0x78, 0xe4, 0x0d, 0x03,
+	0x1e, 0x95, 0x86, 0xc1, 0x64, 0x78, 0xbb, 0x54, 0xf7, 0xe8, 0x78, 0x2d, 0xf5, 0xb8, 0xec, 0x5d,
+	0x51, 0xb8, 0x11, 0x11, 0xca, 0x99, 0x18, 0x5b, 0xb9, 0x4b, 0x8e, 0xad, 0x8f, 0xb2, 0x2e, 0x3e,
+	0xfb, 0x1f, 0xf9, 0xba, 0x96, 0x76, 0xdf, 0xf3, 0xba, 0x3c, 0xb0, 0x36, 0xd5, 0xe2, 0x2f, 0x66,
+	0xd1, 0x22, 0xbc, 0x6c, 0xf3, 0xb0, 0x3f, 0x20, 0xd4, 0x0e, 0x42, 0x36, 0xd1, 0xf8, 0xa5, 0x4b,
+	0x83, 0xab, 0xc2, 0xab, 0xac, 0xa9, 0x9d, 0x32, 0xb9, 0xc0, 0xad, 0xeb, 0xff, 0x0c, 0xfb, 0x1f,
+	0x62, 0x98, 0x89, 0x72, 0x6c, 0xbd, 0x14, 0xf4, 0xd2, 0xcf, 0x5e, 0xb3, 0xcd, 0xd5, 0xb4, 0x0d,
+	0x32, 0x3b, 0x79, 0xe0, 0x4c, 0xc0, 0x04, 0x30, 0xd9, 0x02, 0xf3, 0x17, 0x68, 0x81, 0x7b, 0xca,
+	0xe8, 0x2c, 0xd4, 0xb4, 0xf4, 0x2f, 0x31, 0x65, 0xce, 0x66, 0x17, 0x44, 0x65, 0x60, 0x2e, 0xa8,
+	0x03, 0x33, 0x52, 0x26, 0xe6, 0x5b, 0x7f, 0xd1, 0xd0, 0xca, 0x33, 0x38, 0xaa, 0x7f, 0x17, 0x5d,
+	0x6f, 0xed, 0x36, 0xb7, 0x3e, 0x6c, 0x37, 0xbf, 0xff, 0xfe, 0xdd, 0x36, 0xd9, 0xda, 0xdc, 0x23,
+	0xcd, 0xf6, 0xbd, 0x1f, 0xdc, 0xdd, 0x6a, 0x37, 0xb7, 0xee, 0xb7, 0x36, 0xb7, 0x96, 0xa6, 0x56,
+	0xaf, 0x7f, 0xfa, 0x79, 0xed, 0xb5, 0x67, 0xf8, 0x8a, 0x49, 0xf1, 0x2d, 0x74, 0xed, 0x9c, 0x08,
+	0xdb, 0xad, 0x9d, 0xad, 0x25, 0x6d, 0xf5, 0xda, 0xa7, 0x9f, 0xd7, 0x5e, 0x7d, 0x86, 0x3f, 0x4b,
+	0xdd, 0x73, 0x7e, 0xff, 0xf6, 0xce, 0x9e, 0x79, 0x6b, 0x67, 0x69, 0xfa, 0xdc, 0xdf, 0xbf, 0xed,
+	0x05, 0x1d, 0xcb, 0x33, 0x6f, 0x3f, 0xf9, 0xb2, 0x3a, 0x75, 0xfc, 0x65, 0x75, 0xea, 0xc9, 0x49,
+	0x55, 0x3b, 0x3e, 0xa9, 0x6a, 0x9f, 0x3d, 0xad, 0x4e, 0x3d, 0x7e, 0x5a, 0xd5, 0x8e, 0x9f, 0x56,
+	0xa7, 0xfe, 0xfa, 0xb4, 0x3a, 0xf5, 0xd1, 0x9b, 0x17, 0xa0, 0x8d, 0xd3, 0xe9, 0xe4, 0xe1, 0x50,
+	0xdf, 0xfe, 0xf7, 0x00, 0x72, 0x16, 0xc1, 0x05, 0x43, 0x16, 0x00, 0x00,
 }
 
 func (m *FileVersion) Marshal() (dAtA []byte, err error) {
@@ -738,6 +881,13 @@ func (m *FileInfoTruncated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0xc0
 	}
+	if m.HashAlgorithm != 0 {
+		i = encodeVarintStructs(dAtA, i, uint64(m.HashAlgorithm))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa0
+	}
 	if len(m.Encrypted) > 0 {
 		i -= len(m.Encrypted)
 		copy(dAtA[i:], m.Encrypted)
@@ -1254,6 +1404,139 @@ func (m *ObservedDevice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *IndexSnapshotHeader) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IndexSnapshotHeader) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IndexSnapshotHeader) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n7, err7 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Created, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Created):])
+	if err7 != nil {
+		return 0, err7
+	}
+	i -= n7
+	i = encodeVarintStructs(dAtA, i, uint64(n7))
+	i--
+	dAtA[i] = 0x2a
+	if m.Sequence != 0 {
+		i = encodeVarintStructs(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.IndexID != 0 {
+		i = encodeVarintStructs(dAtA, i, uint64(m.IndexID))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.DeviceID.ProtoSize()
+		i -= size
+		if _, err := m.DeviceID.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStructs(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Folder) > 0 {
+		i -= len(m.Folder)
+		copy(dAtA[i:], m.Folder)
+		i = encodeVarintStructs(dAtA, i, uint64(len(m.Folder)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *IndexDumpRecord) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IndexDumpRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IndexDumpRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Versions.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintStructs(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintStructs(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x32
+	}
+	{
+		size, err := m.File.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintStructs(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.Sequence != 0 {
+		i = encodeVarintStructs(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.IndexID != 0 {
+		i = encodeVarintStructs(dAtA, i, uint64(m.IndexID))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.DeviceID.ProtoSize()
+		i -= size
+		if _, err := m.DeviceID.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStructs(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Type != 0 {
+		i = encodeVarintStructs(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintStructs(dAtA []byte, offset int, v uint64) int {
 	offset -= sovStructs(v)
 	base := offset
@@ -1365,6 +1648,9 @@ func (m *FileInfoTruncated) ProtoSize() (n int) {
 	if l > 0 {
 		n += 2 + l + sovStructs(uint64(l))
 	}
+	if m.HashAlgorithm != 0 {
+		n += 2 + sovStructs(uint64(m.HashAlgorithm))
+	}
 	if m.LocalFlags != 0 {
 		n += 2 + sovStructs(uint64(m.LocalFlags))
 	}
@@ -1538,6 +1824,57 @@ func (m *ObservedDevice) ProtoSize() (n int) {
 	return n
 }
 
+func (m *IndexSnapshotHeader) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Folder)
+	if l > 0 {
+		n += 1 + l + sovStructs(uint64(l))
+	}
+	l = m.DeviceID.ProtoSize()
+	n += 1 + l + sovStructs(uint64(l))
+	if m.IndexID != 0 {
+		n += 1 + sovStructs(uint64(m.IndexID))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovStructs(uint64(m.Sequence))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Created)
+	n += 1 + l + sovStructs(uint64(l))
+	return n
+}
+
+func (m *IndexDumpRecord) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovStructs(uint64(m.Type))
+	}
+	l = m.DeviceID.ProtoSize()
+	n += 1 + l + sovStructs(uint64(l))
+	if m.IndexID != 0 {
+		n += 1 + sovStructs(uint64(m.IndexID))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovStructs(uint64(m.Sequence))
+	}
+	l = m.File.ProtoSize()
+	n += 1 + l + sovStructs(uint64(l))
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovStructs(uint64(l))
+	}
+	l = m.Versions.ProtoSize()
+	n += 1 + l + sovStructs(uint64(l))
+	return n
+}
+
 func sovStructs(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2234,6 +2571,25 @@ func (m *FileInfoTruncated) Unmarshal(dAtA []byte) error {
 				m.Encrypted = []byte{}
 			}
 			iNdEx = postIndex
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HashAlgorithm", wireType)
+			}
+			m.HashAlgorithm = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStructs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HashAlgorithm |= protocol.BlockHashAlgorithm(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		case 1000:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field LocalFlags", wireType)
@@ -3392,6 +3748,430 @@ func (m *ObservedDevice) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *IndexSnapshotHeader) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStructs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IndexSnapshotHeader: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IndexSnapshotHeader: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Folder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStructs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStructs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStructs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Folder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeviceID", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStructs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthStructs
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStructs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DeviceID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexID", wireType)
+			}
+			m.IndexID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStructs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IndexID |= github_com_syncthing_syncthing_lib_protocol.IndexID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStructs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStructs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStructs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStructs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Created, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStructs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStructs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IndexDumpRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStructs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IndexDumpRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IndexDumpRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStructs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= IndexDumpRecordType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeviceID", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStructs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthStructs
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStructs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DeviceID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexID", wireType)
+			}
+			m.IndexID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStructs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IndexID |= github_com_syncthing_syncthing_lib_protocol.IndexID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStructs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field File", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStructs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStructs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStructs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.File.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStructs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStructs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStructs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Versions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStructs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStructs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStructs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Versions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStructs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStructs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipStructs(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
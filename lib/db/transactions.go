@@ -85,6 +85,10 @@ func (t readOnlyTransaction) getFileTrunc(key []byte, trunc bool) (protocol.File
 }
 
 func (t readOnlyTransaction) unmarshalTrunc(bs []byte, trunc bool) (protocol.FileIntf, error) {
+	bs, err := UncompressValue(bs)
+	if err != nil {
+		return nil, err
+	}
 	if trunc {
 		var tf FileInfoTruncated
 		err := tf.Unmarshal(bs)
@@ -131,6 +135,10 @@ func (t readOnlyTransaction) fillFileInfo(fi *protocol.FileInfo) error {
 		if err != nil {
 			return &blocksIndirectionError{err}
 		}
+		bs, err = UncompressValue(bs)
+		if err != nil {
+			return err
+		}
 		var bl BlockList
 		if err := bl.Unmarshal(bs); err != nil {
 			return err
@@ -589,7 +597,7 @@ func (t readWriteTransaction) putFile(fkey []byte, fi protocol.FileInfo) error {
 		bkey = t.keyer.GenerateBlockListKey(bkey, fi.BlocksHash)
 		if _, err := t.Get(bkey); backend.IsNotFound(err) {
 			// Marshal the block list and save it
-			blocksBs := mustMarshal(&BlockList{Blocks: fi.Blocks})
+			blocksBs := compressValue(mustMarshal(&BlockList{Blocks: fi.Blocks}))
 			if err := t.Put(bkey, blocksBs); err != nil {
 				return err
 			}
@@ -619,7 +627,7 @@ func (t readWriteTransaction) putFile(fkey []byte, fi protocol.FileInfo) error {
 
 	t.indirectionTracker.recordIndirectionHashesForFile(&fi)
 
-	fiBs := mustMarshal(&fi)
+	fiBs := compressValue(mustMarshal(&fi))
 	return t.Put(fkey, fiBs)
 }
 
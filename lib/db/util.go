@@ -12,15 +12,23 @@ import "github.com/syncthing/syncthing/lib/protocol"
 const (
 	MaxBatchSizeBytes = 250 * 1024 // Aim for making index messages no larger than 250 KiB (uncompressed)
 	MaxBatchSizeFiles = 1000       // Either way, don't include more files than this
+
+	// Compressed index messages can be larger, as they shrink a lot on the
+	// wire. Fewer, larger messages also compress better and mean fewer
+	// database transactions on the receiving side.
+	MaxCompressedBatchSizeBytes = 1 << 20
+	MaxCompressedBatchSizeFiles = 4000
 )
 
 // FileInfoBatch is a utility to do file operations on the database in suitably
 // sized batches.
 type FileInfoBatch struct {
-	infos   []protocol.FileInfo
-	size    int
-	flushFn func([]protocol.FileInfo) error
-	error   error
+	infos    []protocol.FileInfo
+	size     int
+	maxFiles int
+	maxBytes int
+	flushFn  func([]protocol.FileInfo) error
+	error    error
 }
 
 // NewFileInfoBatch returns a new FileInfoBatch that calls fn when it's time
@@ -29,7 +37,19 @@ type FileInfoBatch struct {
 // any further calls to Flush will return the same error (unless Reset is
 // called).
 func NewFileInfoBatch(fn func([]protocol.FileInfo) error) *FileInfoBatch {
-	return &FileInfoBatch{flushFn: fn}
+	return &FileInfoBatch{
+		maxFiles: MaxBatchSizeFiles,
+		maxBytes: MaxBatchSizeBytes,
+		flushFn:  fn,
+	}
+}
+
+// SetLimits sets the number of files, and their total size in bytes, at
+// which the batch is full. The defaults are MaxBatchSizeFiles and
+// MaxBatchSizeBytes.
+func (b *FileInfoBatch) SetLimits(files, bytes int) {
+	b.maxFiles = files
+	b.maxBytes = bytes
 }
 
 func (b *FileInfoBatch) SetFlushFunc(fn func([]protocol.FileInfo) error) {
@@ -41,14 +61,14 @@ func (b *FileInfoBatch) Append(f protocol.FileInfo) {
 		panic("bug: calling append on a failed batch")
 	}
 	if b.infos == nil {
-		b.infos = make([]protocol.FileInfo, 0, MaxBatchSizeFiles)
+		b.infos = make([]protocol.FileInfo, 0, b.maxFiles)
 	}
 	b.infos = append(b.infos, f)
 	b.size += f.ProtoSize()
 }
 
 func (b *FileInfoBatch) Full() bool {
-	return len(b.infos) >= MaxBatchSizeFiles || b.size >= MaxBatchSizeBytes
+	return len(b.infos) >= b.maxFiles || b.size >= b.maxBytes
 }
 
 func (b *FileInfoBatch) FlushIfFull() error {
@@ -11,6 +11,7 @@ import (
 	"errors"
 	"io"
 	"os"
+	"strings"
 	"testing"
 
 	"github.com/syncthing/syncthing/lib/db/backend"
@@ -230,3 +231,22 @@ func TestFileInfoBatchError(t *testing.T) {
 		t.Fatalf("expected 3, got %d", called)
 	}
 }
+
+func TestFileInfoBatchLimits(t *testing.T) {
+	b := NewFileInfoBatch(nil)
+	for i := 0; i < MaxBatchSizeFiles; i++ {
+		b.Append(protocol.FileInfo{Name: "test"})
+	}
+	if !b.Full() {
+		t.Fatalf("expected batch to be full at %d files", MaxBatchSizeFiles)
+	}
+
+	b.SetLimits(MaxCompressedBatchSizeFiles, MaxCompressedBatchSizeBytes)
+	if b.Full() {
+		t.Fatal("expected batch not to be full with the larger limits")
+	}
+	b.Append(protocol.FileInfo{Name: strings.Repeat("a", MaxCompressedBatchSizeBytes)})
+	if !b.Full() {
+		t.Fatalf("expected batch to be full at %d bytes", b.Size())
+	}
+}
@@ -0,0 +1,207 @@
+// Copyright (C) 2026 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package db
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/syncthing/syncthing/lib/events"
+	"github.com/syncthing/syncthing/lib/protocol"
+)
+
+// Emit a progress event every so many checked database entries.
+const verifyProgressInterval = 10000
+
+// VerifyResult is the outcome of walking the index entries of all folders.
+type VerifyResult struct {
+	Checked  int                 `json:"checked"`
+	Corrupt  []CorruptIndexEntry `json:"corrupt"`
+	Duration time.Duration       `json:"duration"`
+}
+
+// A CorruptIndexEntry is an entry of the index that can't be read back, or
+// is inconsistent with itself. Device is empty for global version lists.
+type CorruptIndexEntry struct {
+	Folder string `json:"folder"`
+	Device string `json:"device,omitempty"`
+	Name   string `json:"name"`
+	Error  string `json:"error"`
+}
+
+// Verify walks the file entries and global version lists of all folders in
+// a snapshot of the database, checking that they can be decoded, that the
+// indirected block lists and version vectors exist and match their hashes,
+// and that the blocks of a file add up to its size. Progress is reported
+// with DatabaseMaintenanceProgress events. Nothing is changed; corrupt
+// entries are returned and can be healed by resetting or rescanning the
+// affected folders.
+func (db *Lowlevel) Verify() (VerifyResult, error) {
+	db.maintenanceMut.Lock()
+	defer db.maintenanceMut.Unlock()
+
+	t0 := time.Now()
+	t, err := db.newReadOnlyTransaction()
+	if err != nil {
+		return VerifyResult{}, err
+	}
+	defer t.close()
+
+	res := VerifyResult{Corrupt: []CorruptIndexEntry{}}
+	for _, folder := range db.ListFolders() {
+		db.logMaintenanceProgress("verify", folder, res.Checked, len(res.Corrupt), false)
+		if err := db.verifyFolder(t, folder, &res); err != nil {
+			return VerifyResult{}, err
+		}
+	}
+	res.Duration = time.Since(t0)
+	db.logMaintenanceProgress("verify", "", res.Checked, len(res.Corrupt), true)
+	if len(res.Corrupt) > 0 {
+		l.Warnf("Database verification found %d corrupt entries in %d checked", len(res.Corrupt), res.Checked)
+	} else {
+		l.Infof("Database verification checked %d entries in %v, no problems found", res.Checked, res.Duration.Truncate(time.Millisecond))
+	}
+	return res, nil
+}
+
+func (db *Lowlevel) verifyFolder(t readOnlyTransaction, folder string, res *VerifyResult) error {
+	if err := db.verifyFiles(t, folder, res); err != nil {
+		return err
+	}
+	return db.verifyGlobals(t, folder, res)
+}
+
+func (db *Lowlevel) verifyFiles(t readOnlyTransaction, folder string, res *VerifyResult) error {
+	dk, err := db.keyer.GenerateDeviceFileKey(nil, []byte(folder), nil, nil)
+	if err != nil {
+		return err
+	}
+	dbi, err := t.NewPrefixIterator(dk.WithoutNameAndDevice())
+	if err != nil {
+		return err
+	}
+	defer dbi.Release()
+
+	for dbi.Next() {
+		name := db.keyer.NameFromDeviceFileKey(dbi.Key())
+		device := ""
+		if dev, ok := db.keyer.DeviceFromDeviceFileKey(dbi.Key()); ok {
+			if id, err := protocol.DeviceIDFromBytes(dev); err == nil {
+				device = id.String()
+			}
+		}
+		if err := verifyFileInfo(t, name, dbi.Value()); err != nil {
+			res.Corrupt = append(res.Corrupt, CorruptIndexEntry{
+				Folder: folder,
+				Device: device,
+				Name:   string(name),
+				Error:  err.Error(),
+			})
+		}
+		db.countVerified(folder, res)
+	}
+	return dbi.Error()
+}
+
+func (db *Lowlevel) verifyGlobals(t readOnlyTransaction, folder string, res *VerifyResult) error {
+	gk, err := db.keyer.GenerateGlobalVersionKey(nil, []byte(folder), nil)
+	if err != nil {
+		return err
+	}
+	dbi, err := t.NewPrefixIterator(gk.WithoutName())
+	if err != nil {
+		return err
+	}
+	defer dbi.Release()
+
+	for dbi.Next() {
+		var vl VersionList
+		err := vl.Unmarshal(dbi.Value())
+		if err == nil && vl.Empty() {
+			err = errEmptyGlobal
+		}
+		if err != nil {
+			res.Corrupt = append(res.Corrupt, CorruptIndexEntry{
+				Folder: folder,
+				Name:   string(db.keyer.NameFromGlobalVersionKey(dbi.Key())),
+				Error:  err.Error(),
+			})
+		}
+		db.countVerified(folder, res)
+	}
+	return dbi.Error()
+}
+
+func (db *Lowlevel) countVerified(folder string, res *VerifyResult) {
+	res.Checked++
+	if res.Checked%verifyProgressInterval == 0 {
+		db.logMaintenanceProgress("verify", folder, res.Checked, len(res.Corrupt), false)
+	}
+}
+
+// verifyFileInfo checks a single file entry as stored in the database.
+func verifyFileInfo(t readOnlyTransaction, name, bs []byte) error {
+	bs, err := UncompressValue(bs)
+	if err != nil {
+		return fmt.Errorf("decoding: %w", err)
+	}
+	var fi protocol.FileInfo
+	if err := fi.Unmarshal(bs); err != nil {
+		return fmt.Errorf("decoding: %w", err)
+	}
+	if fi.Name != string(name) {
+		return fmt.Errorf("name %q doesn't match the key", fi.Name)
+	}
+	if err := t.fillFileInfo(&fi); err != nil {
+		return err
+	}
+	if len(fi.BlocksHash) != 0 && !bytes.Equal(protocol.BlocksHash(fi.Blocks), fi.BlocksHash) {
+		return errors.New("blocks hash mismatch")
+	}
+	if len(fi.VersionHash) != 0 && !bytes.Equal(protocol.VectorHash(fi.Version), fi.VersionHash) {
+		return errors.New("version hash mismatch")
+	}
+	if fi.IsDeleted() || fi.IsInvalid() || fi.Type != protocol.FileInfoTypeFile {
+		return nil
+	}
+	var size int64
+	for _, b := range fi.Blocks {
+		size += int64(b.Size)
+	}
+	if size != fi.Size {
+		return fmt.Errorf("blocks cover %d bytes of the file size %d", size, fi.Size)
+	}
+	return nil
+}
+
+// CompactWithProgress compacts the database backend, reporting the start
+// and end with DatabaseMaintenanceProgress events.
+func (db *Lowlevel) CompactWithProgress() error {
+	db.maintenanceMut.Lock()
+	defer db.maintenanceMut.Unlock()
+
+	db.logMaintenanceProgress("compact", "", 0, 0, false)
+	t0 := time.Now()
+	if err := db.Compact(); err != nil {
+		return err
+	}
+	l.Infof("Compacted the database in %v", time.Since(t0).Truncate(time.Millisecond))
+	db.logMaintenanceProgress("compact", "", 0, 0, true)
+	return nil
+}
+
+func (db *Lowlevel) logMaintenanceProgress(operation, folder string, checked, corrupt int, done bool) {
+	db.evLogger.Log(events.DatabaseMaintenanceProgress, map[string]interface{}{
+		"operation": operation,
+		"folder":    folder,
+		"checked":   checked,
+		"corrupt":   corrupt,
+		"done":      done,
+	})
+}
@@ -0,0 +1,97 @@
+// Copyright (C) 2026 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package db
+
+import (
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/syncthing/syncthing/lib/protocol"
+)
+
+func TestVerify(t *testing.T) {
+	db := newLowlevelMemory(t)
+	defer db.Close()
+
+	folder := "test"
+	fs := newFileSet(t, folder, db)
+
+	var version protocol.Vector
+	for i := 0; i < versionIndirectionCutoff+1; i++ {
+		version = version.Update(protocol.ShortID(i + 1))
+	}
+	files := []protocol.FileInfo{
+		{Name: "indirect", Version: version, Blocks: genBlocks(blocksIndirectionCutoff + 1)},
+		{Name: "inline", Version: version, Blocks: genBlocks(2)},
+		{Name: "deleted", Version: version, Deleted: true},
+		{Name: "dir", Version: version, Type: protocol.FileInfoTypeDirectory},
+	}
+	for i := range files {
+		for _, b := range files[i].Blocks {
+			files[i].Size += int64(b.Size)
+		}
+	}
+	fs.Update(protocol.LocalDeviceID, files)
+	fs.Update(remoteDevice0, files)
+
+	res, err := db.Verify()
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Each file for two devices, plus the global version lists.
+	if res.Checked != 3*len(files) {
+		t.Errorf("checked %d entries, expected %d", res.Checked, 3*len(files))
+	}
+	if len(res.Corrupt) != 0 {
+		t.Fatal("unexpected corrupt entries:", res.Corrupt)
+	}
+
+	// Corrupt the database in various ways: an undecodable entry, a file
+	// whose blocks don't cover its size, and a missing block list.
+	key, err := db.keyer.GenerateDeviceFileKey(nil, []byte(folder), protocol.LocalDeviceID[:], []byte("inline"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := db.Put(key, []byte("garbage")); err != nil {
+		t.Fatal(err)
+	}
+	truncated := files[1]
+	truncated.Blocks = truncated.Blocks[:1]
+	key, err = db.keyer.GenerateDeviceFileKey(nil, []byte(folder), remoteDevice0[:], []byte("inline"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := db.Put(key, mustMarshal(&truncated)); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.Delete(db.keyer.GenerateBlockListKey(nil, protocol.BlocksHash(files[0].Blocks))); err != nil {
+		t.Fatal(err)
+	}
+
+	res, err = db.Verify()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var found []string
+	for _, c := range res.Corrupt {
+		if c.Folder != folder {
+			t.Errorf("corrupt entry in unexpected folder %q", c.Folder)
+		}
+		found = append(found, c.Name+"@"+c.Device)
+	}
+	sort.Strings(found)
+	exp := []string{
+		"indirect@" + protocol.LocalDeviceID.String(),
+		"indirect@" + remoteDevice0.String(),
+		"inline@" + protocol.LocalDeviceID.String(),
+		"inline@" + remoteDevice0.String(),
+	}
+	if strings.Join(found, ",") != strings.Join(exp, ",") {
+		t.Errorf("found corrupt entries %v, expected %v", found, exp)
+	}
+}
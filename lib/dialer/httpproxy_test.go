@@ -0,0 +1,104 @@
+// Copyright (C) 2026 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package dialer
+
+import (
+	"context"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestHTTPProxyDialer(t *testing.T) {
+	// An echo server to reach through the proxy.
+	echo, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer echo.Close()
+	go func() {
+		for {
+			conn, err := echo.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				defer conn.Close()
+				_, _ = io.Copy(conn, conn)
+			}()
+		}
+	}()
+
+	sawAuth := make(chan string, 1)
+	proxySrv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodConnect {
+			http.Error(w, "not a CONNECT", http.StatusMethodNotAllowed)
+			return
+		}
+		sawAuth <- r.Header.Get("Proxy-Authorization")
+		if r.Host != echo.Addr().String() {
+			http.Error(w, "unexpected host", http.StatusBadGateway)
+			return
+		}
+		dst, err := net.Dial("tcp", r.Host)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadGateway)
+			return
+		}
+		src, _, err := w.(http.Hijacker).Hijack()
+		if err != nil {
+			dst.Close()
+			return
+		}
+		_, _ = src.Write([]byte("HTTP/1.1 200 Connection established\r\n\r\n"))
+		go func() {
+			_, _ = io.Copy(dst, src)
+			dst.Close()
+		}()
+		_, _ = io.Copy(src, dst)
+		src.Close()
+	}))
+	defer proxySrv.Close()
+
+	dial, err := DialContextProxyFunc("http://user:pass@" + proxySrv.Listener.Addr().String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	conn, err := dial(ctx, "tcp", echo.Addr().String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+
+	if _, err := conn.Write([]byte("hello")); err != nil {
+		t.Fatal(err)
+	}
+	buf := make([]byte, 5)
+	if _, err := io.ReadFull(conn, buf); err != nil {
+		t.Fatal(err)
+	}
+	if string(buf) != "hello" {
+		t.Errorf("got %q through proxy", buf)
+	}
+	if auth := <-sawAuth; auth != "Basic dXNlcjpwYXNz" {
+		t.Errorf("unexpected proxy authorization %q", auth)
+	}
+	if conn.RemoteAddr().String() != echo.Addr().String() {
+		t.Errorf("remote address %v is not the target %v", conn.RemoteAddr(), echo.Addr())
+	}
+}
+
+func TestDialContextProxyFuncInvalid(t *testing.T) {
+	if _, err := DialContextProxyFunc("gopher://localhost:70"); err == nil {
+		t.Error("expected error for unsupported proxy scheme")
+	}
+}
@@ -11,6 +11,7 @@ import (
 	"errors"
 	"fmt"
 	"net"
+	"net/url"
 	"time"
 
 	"github.com/syncthing/syncthing/lib/connections/registry"
@@ -21,6 +22,11 @@ import (
 
 var errUnexpectedInterfaceType = errors.New("unexpected interface type")
 
+// netConner is a connection wrapping another one, such as a *tls.Conn.
+type netConner interface {
+	NetConn() net.Conn
+}
+
 // SetTCPOptions sets our default TCP options on a TCP connection, possibly
 // digging through dialerConn to extract the *net.TCPConn
 func SetTCPOptions(conn net.Conn) error {
@@ -42,6 +48,8 @@ func SetTCPOptions(conn net.Conn) error {
 			return err
 		}
 		return nil
+	case netConner:
+		return SetTCPOptions(conn.NetConn())
 	default:
 		return fmt.Errorf("unknown connection type %T", conn)
 	}
@@ -59,13 +67,15 @@ func SetTrafficClass(conn net.Conn, class int) error {
 			return e1
 		}
 		return e2
+	case netConner:
+		return SetTrafficClass(conn.NetConn(), class)
 	default:
 		return fmt.Errorf("unknown connection type %T", conn)
 	}
 }
 
-func dialContextWithFallback(ctx context.Context, fallback proxy.ContextDialer, network, addr string) (net.Conn, error) {
-	dialer, ok := proxy.FromEnvironment().(proxy.ContextDialer)
+func dialContextWithFallback(ctx context.Context, proxyDialer proxy.Dialer, fallback proxy.ContextDialer, network, addr string) (net.Conn, error) {
+	dialer, ok := proxyDialer.(proxy.ContextDialer)
 	if !ok {
 		return nil, errUnexpectedInterfaceType
 	}
@@ -98,7 +108,35 @@ func dialContextWithFallback(ctx context.Context, fallback proxy.ContextDialer,
 // If dialing via proxy and allowing fallback, dialing for both happens simultaneously
 // and the proxy connection is returned if successful.
 func DialContext(ctx context.Context, network, addr string) (net.Conn, error) {
-	return dialContextWithFallback(ctx, proxy.Direct, network, addr)
+	return dialContextWithFallback(ctx, proxy.FromEnvironment(), proxy.Direct, network, addr)
+}
+
+// DialContextProxyFunc returns a function that dials via the given proxy
+// instead of the one from the environment. Supported are SOCKS5
+// ("socks5://host:port") and HTTP CONNECT ("http://host:port",
+// "https://host:port") proxies, with optional credentials in the URL.
+// Falling back to a direct connection works the same as for a proxy
+// from the environment.
+func DialContextProxyFunc(proxyURL string) (func(ctx context.Context, network, addr string) (net.Conn, error), error) {
+	u, err := url.Parse(proxyURL)
+	if err != nil {
+		return nil, fmt.Errorf("parsing proxy URL: %w", err)
+	}
+	var proxyDialer proxy.Dialer
+	switch u.Scheme {
+	case "http", "https":
+		// Not registered as dialer types, so as not to change the
+		// meaning of existing proxy environment variables.
+		proxyDialer = newHTTPProxyDialer(u, proxy.Direct)
+	default:
+		proxyDialer, err = proxy.FromURL(u, proxy.Direct)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("proxy %s: %w", u.Redacted(), err)
+	}
+	return func(ctx context.Context, network, addr string) (net.Conn, error) {
+		return dialContextWithFallback(ctx, proxyDialer, proxy.Direct, network, addr)
+	}, nil
 }
 
 // DialContextReusePort tries dialing via proxy if a proxy is configured, and falls back to
@@ -0,0 +1,88 @@
+// Copyright (C) 2026 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package dialer
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"runtime"
+)
+
+var errSockoptUnsupported = fmt.Errorf("not supported on %s", runtime.GOOS)
+
+// SocketOptions are tunable socket options. Zero values leave the system
+// default in place.
+type SocketOptions struct {
+	SendBuffer        int    // bytes
+	ReceiveBuffer     int    // bytes
+	NotSentLowat      int    // bytes, TCP only
+	CongestionControl string // TCP only
+}
+
+// SetSocketOptions sets the socket options on a TCP connection, possibly
+// digging through dialerConn to extract the *net.TCPConn. All options are
+// attempted even if setting one of them fails.
+func SetSocketOptions(conn net.Conn, opts SocketOptions) error {
+	switch conn := conn.(type) {
+	case dialerConn:
+		return SetSocketOptions(conn.Conn, opts)
+	case *net.TCPConn:
+		errs := []error{setBuffers(conn, opts)}
+		if opts.NotSentLowat > 0 || opts.CongestionControl != "" {
+			raw, err := conn.SyscallConn()
+			if err != nil {
+				return err
+			}
+			if opts.NotSentLowat > 0 {
+				if err := setNotSentLowat(raw, opts.NotSentLowat); err != nil {
+					errs = append(errs, fmt.Errorf("not sent low water mark: %w", err))
+				}
+			}
+			if opts.CongestionControl != "" {
+				if err := setCongestionControl(raw, opts.CongestionControl); err != nil {
+					errs = append(errs, fmt.Errorf("congestion control %q: %w", opts.CongestionControl, err))
+				}
+			}
+		}
+		return errors.Join(errs...)
+	case netConner:
+		return SetSocketOptions(conn.NetConn(), opts)
+	default:
+		return fmt.Errorf("unknown connection type %T", conn)
+	}
+}
+
+// SetPacketConnOptions sets the buffer sizes of a UDP socket. The TCP only
+// options are ignored.
+func SetPacketConnOptions(conn net.PacketConn, opts SocketOptions) error {
+	udpConn, ok := conn.(*net.UDPConn)
+	if !ok {
+		return fmt.Errorf("unknown connection type %T", conn)
+	}
+	return setBuffers(udpConn, opts)
+}
+
+type bufferSetter interface {
+	SetReadBuffer(bytes int) error
+	SetWriteBuffer(bytes int) error
+}
+
+func setBuffers(conn bufferSetter, opts SocketOptions) error {
+	var errs []error
+	if opts.SendBuffer > 0 {
+		if err := conn.SetWriteBuffer(opts.SendBuffer); err != nil {
+			errs = append(errs, fmt.Errorf("send buffer: %w", err))
+		}
+	}
+	if opts.ReceiveBuffer > 0 {
+		if err := conn.SetReadBuffer(opts.ReceiveBuffer); err != nil {
+			errs = append(errs, fmt.Errorf("receive buffer: %w", err))
+		}
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,31 @@
+// Copyright (C) 2026 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+//go:build darwin
+// +build darwin
+
+package dialer
+
+import (
+	"syscall"
+
+	"golang.org/x/sys/unix"
+)
+
+func setNotSentLowat(c syscall.RawConn, bytes int) error {
+	var opErr error
+	err := c.Control(func(fd uintptr) {
+		opErr = unix.SetsockoptInt(int(fd), unix.IPPROTO_TCP, unix.TCP_NOTSENT_LOWAT, bytes)
+	})
+	if err != nil {
+		return err
+	}
+	return opErr
+}
+
+func setCongestionControl(_ syscall.RawConn, _ string) error {
+	return errSockoptUnsupported
+}
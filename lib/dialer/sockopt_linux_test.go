@@ -0,0 +1,79 @@
+// Copyright (C) 2026 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+//go:build linux
+// +build linux
+
+package dialer
+
+import (
+	"net"
+	"testing"
+
+	"golang.org/x/sys/unix"
+)
+
+func TestSetSocketOptions(t *testing.T) {
+	lst, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer lst.Close()
+	go func() {
+		if conn, err := lst.Accept(); err == nil {
+			conn.Close()
+		}
+	}()
+
+	conn, err := net.Dial("tcp", lst.Addr().String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+
+	opts := SocketOptions{
+		SendBuffer:        256 << 10,
+		ReceiveBuffer:     256 << 10,
+		NotSentLowat:      16 << 10,
+		CongestionControl: "reno", // always built in
+	}
+	// Through a wrapper, as for the proxy dialer.
+	if err := SetSocketOptions(dialerConn{conn, conn.RemoteAddr()}, opts); err != nil {
+		t.Fatal(err)
+	}
+
+	raw, err := conn.(*net.TCPConn).SyscallConn()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var lowat int
+	var cc string
+	var opErr error
+	err = raw.Control(func(fd uintptr) {
+		if lowat, opErr = unix.GetsockoptInt(int(fd), unix.IPPROTO_TCP, unix.TCP_NOTSENT_LOWAT); opErr != nil {
+			return
+		}
+		cc, opErr = unix.GetsockoptString(int(fd), unix.IPPROTO_TCP, unix.TCP_CONGESTION)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if opErr != nil {
+		t.Fatal(opErr)
+	}
+	if lowat != opts.NotSentLowat {
+		t.Errorf("not sent low water mark is %d, expected %d", lowat, opts.NotSentLowat)
+	}
+	if cc != opts.CongestionControl {
+		t.Errorf("congestion control is %q, expected %q", cc, opts.CongestionControl)
+	}
+
+	// An algorithm missing from the kernel is reported.
+	opts.CongestionControl = "does-not-exist"
+	if err := SetSocketOptions(conn, opts); err == nil {
+		t.Error("expected an error for an unknown congestion control algorithm")
+	}
+}
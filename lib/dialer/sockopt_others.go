@@ -0,0 +1,20 @@
+// Copyright (C) 2026 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+//go:build !linux && !darwin
+// +build !linux,!darwin
+
+package dialer
+
+import "syscall"
+
+func setNotSentLowat(_ syscall.RawConn, _ int) error {
+	return errSockoptUnsupported
+}
+
+func setCongestionControl(_ syscall.RawConn, _ string) error {
+	return errSockoptUnsupported
+}
@@ -22,6 +22,8 @@ type cachedFinder struct {
 	negCacheTime time.Duration
 	cache        *cache
 	token        *suture.ServiceToken
+	server       string // the global discovery server, if any
+	deviceOnly   bool   // only used for devices that list the server
 }
 
 // An error may implement cachedError, in which case it will be interrogated
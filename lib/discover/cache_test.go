@@ -10,6 +10,7 @@ import (
 	"context"
 	"crypto/tls"
 	"reflect"
+	"slices"
 	"testing"
 	"time"
 
@@ -75,6 +76,46 @@ func TestCacheUnique(t *testing.T) {
 	}
 }
 
+func TestCacheSources(t *testing.T) {
+	c := setupCache()
+	c.addLocked("f1", &fakeDiscovery{[]string{"tcp://192.0.2.44:22000", "tcp://192.0.2.42:22000"}}, time.Minute, 0)
+	c.addLocked("f2", &fakeDiscovery{[]string{"tcp://192.0.2.43:22000", " tcp://192.0.2.42:22000"}}, time.Minute, 0)
+
+	if _, err := c.Lookup(context.Background(), protocol.LocalDeviceID); err != nil {
+		t.Fatal(err)
+	}
+
+	entry, ok := c.Cache()[protocol.LocalDeviceID]
+	if !ok {
+		t.Fatal("expected a cache entry")
+	}
+	expected := []string{"tcp://192.0.2.42:22000", "tcp://192.0.2.43:22000", "tcp://192.0.2.44:22000"}
+	if !reflect.DeepEqual(entry.Addresses, expected) {
+		t.Errorf("Incorrect addresses; %+v != %+v", entry.Addresses, expected)
+	}
+
+	finders := func(addr string) []string {
+		var res []string
+		for _, src := range entry.Sources[addr] {
+			if src.LastSeen.IsZero() {
+				t.Errorf("no last seen time for %s from %s", addr, src.Finder)
+			}
+			res = append(res, src.Finder)
+		}
+		slices.Sort(res)
+		return res
+	}
+	if res := finders("tcp://192.0.2.42:22000"); !reflect.DeepEqual(res, []string{"f1", "f2"}) {
+		t.Errorf("Incorrect sources for the shared address: %v", res)
+	}
+	if res := finders("tcp://192.0.2.43:22000"); !reflect.DeepEqual(res, []string{"f2"}) {
+		t.Errorf("Incorrect sources: %v", res)
+	}
+	if res := finders("tcp://192.0.2.44:22000"); !reflect.DeepEqual(res, []string{"f1"}) {
+		t.Errorf("Incorrect sources: %v", res)
+	}
+}
+
 type fakeDiscovery struct {
 	addresses []string
 }
@@ -144,3 +185,73 @@ func (*slowDiscovery) String() string {
 func (*slowDiscovery) Cache() map[protocol.DeviceID]CacheEntry {
 	return nil
 }
+
+func TestDeviceDiscoveryServers(t *testing.T) {
+	device1 := protocol.DeviceID{1}
+	device2 := protocol.DeviceID{2}
+
+	c := setupCache()
+	c.addEntryLocked("corp", cachedFinder{Finder: &fakeDiscovery{[]string{"tcp://192.0.2.1:22000"}}, cacheTime: time.Minute, server: "https://corp.example.com/", deviceOnly: true})
+	c.addEntryLocked("public", cachedFinder{Finder: &fakeDiscovery{[]string{"tcp://192.0.2.2:22000"}}, cacheTime: time.Minute, server: "https://public.example.com/"})
+	c.addLocked("local", &fakeDiscovery{[]string{"tcp://192.0.2.3:22000"}}, time.Minute, 0)
+	c.deviceServers[device1] = map[string]struct{}{"https://corp.example.com/": {}}
+
+	ctx := context.Background()
+	cases := []struct {
+		device   protocol.DeviceID
+		expected []string
+	}{
+		{device1, []string{"tcp://192.0.2.1:22000", "tcp://192.0.2.3:22000"}},
+		{device2, []string{"tcp://192.0.2.2:22000", "tcp://192.0.2.3:22000"}},
+	}
+	for _, tc := range cases {
+		addr, err := c.Lookup(ctx, tc.device)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(addr, tc.expected) {
+			t.Errorf("Incorrect addresses for %v; %+v != %+v", tc.device, addr, tc.expected)
+		}
+	}
+}
+
+func TestDeviceDiscoveryServersConfig(t *testing.T) {
+	device1 := protocol.DeviceID{1}
+	const public = "https://public.example.com/"
+	const corp = "https://corp.example.com/"
+
+	c := setupCache()
+	cfg := config.New(protocol.LocalDeviceID)
+	cfg.Options.LocalAnnEnabled = false
+	cfg.Options.RawGlobalAnnServers = []string{public}
+	cfg.Devices = append(cfg.Devices, config.DeviceConfiguration{DeviceID: device1, RawDiscoveryServers: []string{corp}})
+	c.CommitConfiguration(config.Configuration{}, cfg)
+
+	if f, ok := c.finders[globalDiscoveryIdentity(public)]; !ok || f.deviceOnly {
+		t.Errorf("expected %s to be used in general", public)
+	}
+	if f, ok := c.finders[globalDiscoveryIdentity(corp)]; !ok || !f.deviceOnly {
+		t.Errorf("expected %s to be used for specific devices only", corp)
+	}
+	if f := c.finders[globalDiscoveryIdentity(corp)].Finder.(*globalClient); !f.noAnnounce {
+		t.Errorf("expected no announcements to %s", corp)
+	}
+
+	// Once used in general, the server is announced to.
+	cfg.Options.RawGlobalAnnServers = []string{public, corp}
+	c.CommitConfiguration(config.Configuration{}, cfg)
+	if f, ok := c.finders[globalDiscoveryIdentity(corp)]; !ok || f.deviceOnly || f.Finder.(*globalClient).noAnnounce {
+		t.Errorf("expected %s to be used in general", corp)
+	}
+
+	// Without the device referencing it, it's gone.
+	cfg.Options.RawGlobalAnnServers = []string{public}
+	cfg.Devices[len(cfg.Devices)-1].RawDiscoveryServers = nil
+	c.CommitConfiguration(config.Configuration{}, cfg)
+	if _, ok := c.finders[globalDiscoveryIdentity(corp)]; ok {
+		t.Errorf("expected %s to be removed", corp)
+	}
+	if len(c.deviceServers) != 0 {
+		t.Errorf("expected no device specific servers, got %v", c.deviceServers)
+	}
+}
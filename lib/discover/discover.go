@@ -23,13 +23,24 @@ type Finder interface {
 }
 
 type CacheEntry struct {
-	Addresses  []string  `json:"addresses"`
+	Addresses []string `json:"addresses"`
+	// The discovery mechanisms each address was found by, when merged from
+	// several.
+	Sources map[string][]AddressSource `json:"sources,omitempty"`
+
 	when       time.Time // When did we get the result
 	found      bool      // Is it a success (cacheTime applies) or a failure (negCacheTime applies)?
 	validUntil time.Time // Validity time, overrides normal calculation
 	instanceID int64     // for local discovery, the instance ID (random on each restart)
 }
 
+// An AddressSource is a discovery mechanism that returned an address, and
+// when it last did so.
+type AddressSource struct {
+	Finder   string    `json:"finder"`
+	LastSeen time.Time `json:"lastSeen"`
+}
+
 // A FinderService is a Finder that has background activity and must be run as
 // a suture.Service.
 type FinderService interface {
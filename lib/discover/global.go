@@ -18,6 +18,7 @@ import (
 	"net/http"
 	"net/url"
 	"strconv"
+	"strings"
 	stdsync "sync"
 	"time"
 
@@ -30,13 +31,20 @@ import (
 
 type globalClient struct {
 	server         string
+	cert           tls.Certificate
 	addrList       AddressLister
 	announceClient httpClient
 	queryClient    httpClient
 	noAnnounce     bool
 	noLookup       bool
+	requireSigned  bool
 	evLogger       events.Logger
 	errorHolder
+
+	// The timestamp of the latest signed announcement seen per device, to
+	// refuse replays of older ones.
+	lastSigned    map[protocol.DeviceID]time.Time
+	lastSignedMut stdsync.Mutex
 }
 
 type httpClient interface {
@@ -52,7 +60,8 @@ const (
 )
 
 type announcement struct {
-	Addresses []string `json:"addresses"`
+	Addresses []string            `json:"addresses"`
+	Signed    *SignedAnnouncement `json:"signed,omitempty"`
 }
 
 func (a announcement) MarshalJSON() ([]byte, error) {
@@ -65,10 +74,11 @@ func (a announcement) MarshalJSON() ([]byte, error) {
 }
 
 type serverOptions struct {
-	insecure   bool   // don't check certificate
-	noAnnounce bool   // don't announce
-	noLookup   bool   // don't use for lookups
-	id         string // expected server device ID
+	insecure      bool   // don't check certificate
+	noAnnounce    bool   // don't announce
+	noLookup      bool   // don't use for lookups
+	requireSigned bool   // don't accept lookup results without a valid signature
+	id            string // expected server device ID
 }
 
 // A lookupError is any other error but with a cache validity time attached.
@@ -124,8 +134,30 @@ func NewGlobal(server string, cert tls.Certificate, addrList AddressLister, evLo
 		announceClient = newIDCheckingHTTPClient(announceClient, devID)
 	}
 
-	// The http.Client used for queries. We don't need to present our
-	// certificate here, so lets not include it. May be insecure if requested.
+	cl := &globalClient{
+		server:         server,
+		cert:           cert,
+		addrList:       addrList,
+		announceClient: announceClient,
+		queryClient:    newQueryClient(opts, devID),
+		noAnnounce:     opts.noAnnounce,
+		noLookup:       opts.noLookup,
+		requireSigned:  opts.requireSigned,
+		evLogger:       evLogger,
+		lastSigned:     make(map[protocol.DeviceID]time.Time),
+	}
+	if !opts.noAnnounce {
+		// If we are supposed to announce, it's an error until we've done so.
+		cl.setError(errors.New("not announced"))
+	}
+
+	return cl, nil
+}
+
+// newQueryClient returns the http.Client used for queries. We don't need to
+// present our certificate here, so lets not include it. May be insecure if
+// requested.
+func newQueryClient(opts serverOptions, devID protocol.DeviceID) httpClient {
 	var queryClient httpClient = &contextClient{&http.Client{
 		Timeout: requestTimeout,
 		Transport: http2EnabledTransport(&http.Transport{
@@ -142,22 +174,42 @@ func NewGlobal(server string, cert tls.Certificate, addrList AddressLister, evLo
 	if opts.id != "" {
 		queryClient = newIDCheckingHTTPClient(queryClient, devID)
 	}
+	return queryClient
+}
 
-	cl := &globalClient{
-		server:         server,
-		addrList:       addrList,
-		announceClient: announceClient,
-		queryClient:    queryClient,
-		noAnnounce:     opts.noAnnounce,
-		noLookup:       opts.noLookup,
-		evLogger:       evLogger,
+// CheckGlobal looks up the device on the global discovery server, to check
+// that the server can be reached. Whether the server knows the device or
+// not doesn't matter, only that it answers.
+func CheckGlobal(ctx context.Context, server string, device protocol.DeviceID) error {
+	server, opts, err := parseOptions(server)
+	if err != nil {
+		return err
 	}
-	if !opts.noAnnounce {
-		// If we are supposed to announce, it's an error until we've done so.
-		cl.setError(errors.New("not announced"))
+	var devID protocol.DeviceID
+	if opts.id != "" {
+		devID, err = protocol.DeviceIDFromString(opts.id)
+		if err != nil {
+			return err
+		}
 	}
 
-	return cl, nil
+	qURL, err := url.Parse(server)
+	if err != nil {
+		return err
+	}
+	q := qURL.Query()
+	q.Set("device", device.String())
+	qURL.RawQuery = q.Encode()
+
+	resp, err := newQueryClient(opts, devID).Get(ctx, qURL.String())
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNotFound {
+		return errors.New(resp.Status)
+	}
+	return nil
 }
 
 // Lookup returns the list of addresses where the given device is available
@@ -203,8 +255,46 @@ func (c *globalClient) Lookup(ctx context.Context, device protocol.DeviceID) (ad
 	resp.Body.Close()
 
 	var ann announcement
-	err = json.Unmarshal(bs, &ann)
-	return ann.Addresses, err
+	if err := json.Unmarshal(bs, &ann); err != nil {
+		return nil, err
+	}
+	addresses, err = c.verifiedAddresses(device, ann)
+	if err != nil {
+		l.Debugln("globalClient.Lookup", qURL, err)
+		return nil, err
+	}
+	return addresses, nil
+}
+
+// verifiedAddresses returns the addresses from the lookup result that are
+// covered by the signature of the device, if there is one. Results without
+// a signature are accepted unless signatures are required, as the device
+// or the server may not support them.
+func (c *globalClient) verifiedAddresses(device protocol.DeviceID, ann announcement) ([]string, error) {
+	if ann.Signed == nil {
+		if c.requireSigned {
+			return nil, errNotSigned
+		}
+		return ann.Addresses, nil
+	}
+
+	payload, err := ann.Signed.Verify(device)
+	if err != nil {
+		return nil, err
+	}
+
+	c.lastSignedMut.Lock()
+	defer c.lastSignedMut.Unlock()
+	if err := payload.CheckTimestamp(c.lastSigned[device], time.Now()); err != nil {
+		return nil, err
+	}
+	c.lastSigned[device] = payload.Timestamp
+
+	addresses := signedAddresses(ann.Addresses, payload.Addresses)
+	if len(addresses) < len(ann.Addresses) {
+		l.Debugf("globalClient.Lookup: dropping %d addresses for %s not covered by the signature", len(ann.Addresses)-len(addresses), device)
+	}
+	return addresses, nil
 }
 
 func (c *globalClient) String() string {
@@ -269,6 +359,14 @@ func (c *globalClient) sendAnnouncement(ctx context.Context, timer *time.Timer)
 		return
 	}
 
+	// Sign what the server will see, so that lookups can be verified.
+	ann.Addresses = sanitizeRelayAddresses(ann.Addresses)
+	if signed, err := SignAnnouncement(c.cert, ann.Addresses, time.Now()); err != nil {
+		l.Debugln(c, "signing announcement:", err)
+	} else {
+		ann.Signed = signed
+	}
+
 	// The marshal doesn't fail, I promise.
 	postData, _ := json.Marshal(ann)
 
@@ -337,6 +435,7 @@ func parseOptions(dsn string) (server string, opts serverOptions, err error) {
 	opts.insecure = opts.id != "" || queryBool(q, "insecure")
 	opts.noAnnounce = queryBool(q, "noannounce")
 	opts.noLookup = queryBool(q, "nolookup")
+	opts.requireSigned = queryBool(q, "requiresigned")
 
 	// Check for disallowed combinations
 	if p.Scheme == "http" {
@@ -464,12 +563,27 @@ func globalDiscoveryIdentity(addr string) string {
 	return "global discovery server " + addr
 }
 
-func ipv4Identity(port int) string {
-	return fmt.Sprintf("IPv4 local broadcast discovery on port %d", port)
+func localIdentity(addr string, intfs []string) string {
+	host, port, err := net.SplitHostPort(addr)
+	switch {
+	case err == nil && host == "":
+		return fmt.Sprintf("IPv4 local broadcast discovery on port %s%s", port, interfacesIdentity(intfs))
+	case err == nil && net.ParseIP(host).To4() != nil:
+		return fmt.Sprintf("IPv4 local multicast discovery on address %s%s", addr, interfacesIdentity(intfs))
+	default:
+		return fmt.Sprintf("IPv6 local multicast discovery on address %s%s", addr, interfacesIdentity(intfs))
+	}
+}
+
+func mdnsIdentity(addr string, intfs []string) string {
+	return fmt.Sprintf("mDNS discovery on address %s%s", addr, interfacesIdentity(intfs))
 }
 
-func ipv6Identity(addr string) string {
-	return fmt.Sprintf("IPv6 local multicast discovery on address %s", addr)
+func interfacesIdentity(intfs []string) string {
+	if len(intfs) == 0 {
+		return ""
+	}
+	return fmt.Sprintf(" on interfaces %s", strings.Join(intfs, ","))
 }
 
 func http2EnabledTransport(t *http.Transport) *http.Transport {
@@ -34,6 +34,7 @@ func TestParseOptions(t *testing.T) {
 		{"https://example.com/?insecure=yes", "https://example.com/", serverOptions{insecure: true}},
 		{"https://example.com/?insecure=false&noannounce", "https://example.com/", serverOptions{noAnnounce: true}},
 		{"https://example.com/?id=abc", "https://example.com/", serverOptions{id: "abc", insecure: true}},
+		{"https://example.com/?requiresigned", "https://example.com/", serverOptions{requireSigned: true}},
 	}
 
 	for _, tc := range testcases {
@@ -208,6 +209,46 @@ func TestGlobalAnnounce(t *testing.T) {
 	}
 }
 
+func TestCheckGlobal(t *testing.T) {
+	list, err := net.Listen("tcp4", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer list.Close()
+
+	s := new(fakeDiscoveryServer)
+	mux := http.NewServeMux()
+	mux.HandleFunc("/", s.handler)
+	mux.HandleFunc("/unknown", func(w http.ResponseWriter, _ *http.Request) {
+		http.Error(w, "not found", http.StatusNotFound)
+	})
+	mux.HandleFunc("/broken", func(w http.ResponseWriter, _ *http.Request) {
+		http.Error(w, "internal error", http.StatusInternalServerError)
+	})
+	go func() { _ = http.Serve(list, mux) }()
+
+	ctx := context.Background()
+	base := "http://" + list.Addr().String()
+
+	// Both knowing and not knowing the device are fine, any other answer
+	// is not.
+	if err := CheckGlobal(ctx, base+"/?insecure&noannounce", protocol.LocalDeviceID); err != nil {
+		t.Error("unexpected error:", err)
+	}
+	if err := CheckGlobal(ctx, base+"/unknown?insecure&noannounce", protocol.LocalDeviceID); err != nil {
+		t.Error("unexpected error:", err)
+	}
+	if err := CheckGlobal(ctx, base+"/broken?insecure&noannounce", protocol.LocalDeviceID); err == nil {
+		t.Error("unexpected nil error for a failing server")
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, 100*time.Millisecond)
+	defer cancel()
+	if err := CheckGlobal(ctx, base+"/block?insecure&noannounce", protocol.LocalDeviceID); err == nil {
+		t.Error("unexpected nil error for a blocking server")
+	}
+}
+
 func testLookup(url string) ([]string, error) {
 	disco, err := NewGlobal(url, tls.Certificate{}, nil, events.NoopLogger, registry.New())
 	if err != nil {
@@ -29,6 +29,7 @@ type localClient struct {
 	*suture.Supervisor
 	myID     protocol.DeviceID
 	addrList AddressLister
+	filter   beacon.InterfaceFilter
 	name     string
 	evLogger events.Logger
 
@@ -45,13 +46,19 @@ const (
 	CacheLifeTime     = 3 * BroadcastInterval
 	Magic             = uint32(0x2EA7D90B) // same as in BEP
 	v13Magic          = uint32(0x7D79BC40) // previous version
+
+	standardBroadcastAddr = ":21027"
+	standardMulticastAddr = "[ff12::8384]:21027"
 )
 
-func NewLocal(id protocol.DeviceID, addr string, addrList AddressLister, evLogger events.Logger) (FinderService, error) {
+// NewLocal returns a local discovery client on the given address, using
+// the interfaces passing the filter only.
+func NewLocal(id protocol.DeviceID, addr string, filter beacon.InterfaceFilter, addrList AddressLister, evLogger events.Logger) (FinderService, error) {
 	c := &localClient{
 		Supervisor:      suture.New("local", svcutil.SpecWithDebugLogger(l)),
 		myID:            id,
 		addrList:        addrList,
+		filter:          filter,
 		evLogger:        evLogger,
 		localBcastTick:  time.NewTicker(BroadcastInterval).C,
 		forcedBcastTick: make(chan time.Time),
@@ -71,11 +78,23 @@ func NewLocal(id protocol.DeviceID, addr string, addrList AddressLister, evLogge
 		if err != nil {
 			return nil, err
 		}
-		c.beacon = beacon.NewBroadcast(bcPort)
+		c.beacon = beacon.NewBroadcast(bcPort, filter)
 	} else {
 		// A multicast client
-		c.name = "IPv6 local"
-		c.beacon = beacon.NewMulticast(addr)
+		ip := net.ParseIP(host)
+		if !ip.IsMulticast() {
+			return nil, fmt.Errorf("not a multicast address: %s", addr)
+		}
+		if ip.To4() != nil {
+			c.name = "IPv4 local multicast"
+		} else {
+			c.name = "IPv6 local"
+		}
+		c.beacon = beacon.NewMulticast(addr, filter)
+	}
+	if addr != standardBroadcastAddr && addr != standardMulticastAddr {
+		// Tell apart the clients for additional addresses.
+		c.name += " " + addr
 	}
 	c.Add(c.beacon)
 	c.Add(svcutil.AsService(c.recvAnnouncements, fmt.Sprintf("%s/recv", c)))
@@ -116,6 +135,16 @@ func (c *localClient) announcementPkt(instanceID int64, msg []byte) ([]byte, boo
 	// do not leak relay tokens to discovery
 	addrs = sanitizeRelayAddresses(addrs)
 
+	// do not announce addresses of interfaces we're not discoverable on
+	if len(c.filter) > 0 {
+		nets, err := c.filter.Networks()
+		if err != nil {
+			l.Debugln("discover: failed to list interface networks:", err)
+		} else {
+			addrs = filterInterfaceAddresses(addrs, nets)
+		}
+	}
+
 	if len(addrs) == 0 {
 		// Nothing to announce
 		return msg, false
@@ -314,6 +343,37 @@ func filterUndialableLocal(addrs []string) []string {
 	return filtered
 }
 
+// filterInterfaceAddresses returns the list of addresses after removing
+// any that are for a specific IP not held by one of the given networks.
+// Unspecified addresses are kept, as the receiver replaces them with the
+// address the announcement came from.
+func filterInterfaceAddresses(addrs []string, nets []*net.IPNet) []string {
+	filtered := addrs[:0]
+	for _, addr := range addrs {
+		u, err := url.Parse(addr)
+		if err != nil {
+			continue
+		}
+
+		tcpAddr, err := net.ResolveTCPAddr("tcp", u.Host)
+		if err != nil {
+			continue
+		}
+
+		if len(tcpAddr.IP) == 0 || tcpAddr.IP.IsUnspecified() {
+			filtered = append(filtered, addr)
+			continue
+		}
+		for _, ipnet := range nets {
+			if ipnet.IP.Equal(tcpAddr.IP) {
+				filtered = append(filtered, addr)
+				break
+			}
+		}
+	}
+	return filtered
+}
+
 func sanitizeRelayAddresses(addrs []string) []string {
 	filtered := addrs[:0]
 	allowlist := []string{"id"}
@@ -18,7 +18,7 @@ import (
 )
 
 func TestLocalInstanceID(t *testing.T) {
-	c, err := NewLocal(protocol.LocalDeviceID, ":0", &fakeAddressLister{}, events.NoopLogger)
+	c, err := NewLocal(protocol.LocalDeviceID, ":0", nil, &fakeAddressLister{}, events.NoopLogger)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -42,7 +42,7 @@ func TestLocalInstanceID(t *testing.T) {
 }
 
 func TestLocalInstanceIDShouldTriggerNew(t *testing.T) {
-	c, err := NewLocal(protocol.LocalDeviceID, ":0", &fakeAddressLister{}, events.NoopLogger)
+	c, err := NewLocal(protocol.LocalDeviceID, ":0", nil, &fakeAddressLister{}, events.NoopLogger)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -122,3 +122,59 @@ func TestFilterUndialable(t *testing.T) {
 		t.Error("filterUndialableLocal returned invalid addresses")
 	}
 }
+
+func TestFilterInterfaceAddresses(t *testing.T) {
+	_, lan, _ := net.ParseCIDR("192.168.1.10/24")
+	_, linkLocal, _ := net.ParseCIDR("fe80::1/64")
+	lan.IP = net.ParseIP("192.168.1.10")
+	linkLocal.IP = net.ParseIP("fe80::1")
+	nets := []*net.IPNet{lan, linkLocal}
+
+	addrs := []string{
+		"tcp://0.0.0.0:22000",
+		"tcp://192.168.1.10:22000",
+		"tcp://192.168.1.11:22000",
+		"quic://[fe80::1]:22000",
+		"quic://[fe80::2]:22000",
+		"tcp://172.17.0.1:22000",
+	}
+	expected := []string{
+		"tcp://0.0.0.0:22000",
+		"tcp://192.168.1.10:22000",
+		"quic://[fe80::1]:22000",
+	}
+
+	res := filterInterfaceAddresses(addrs, nets)
+	if fmt.Sprint(res) != fmt.Sprint(expected) {
+		t.Errorf("got %v, expected %v", res, expected)
+	}
+}
+
+func TestLocalAddresses(t *testing.T) {
+	cases := []struct {
+		addr     string
+		name     string
+		identity string
+	}{
+		{":21027", "IPv4 local", "IPv4 local broadcast discovery on port 21027"},
+		{":21028", "IPv4 local :21028", "IPv4 local broadcast discovery on port 21028"},
+		{"[ff12::8384]:21027", "IPv6 local", "IPv6 local multicast discovery on address [ff12::8384]:21027"},
+		{"239.255.83.84:21027", "IPv4 local multicast 239.255.83.84:21027", "IPv4 local multicast discovery on address 239.255.83.84:21027"},
+	}
+	for _, tc := range cases {
+		c, err := NewLocal(protocol.LocalDeviceID, tc.addr, nil, &fakeAddressLister{}, events.NoopLogger)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if name := c.String(); name != tc.name {
+			t.Errorf("%s: got name %q, expected %q", tc.addr, name, tc.name)
+		}
+		if identity := localIdentity(tc.addr, nil); identity != tc.identity {
+			t.Errorf("%s: got identity %q, expected %q", tc.addr, identity, tc.identity)
+		}
+	}
+
+	if _, err := NewLocal(protocol.LocalDeviceID, "192.0.2.42:21027", nil, &fakeAddressLister{}, events.NoopLogger); err == nil {
+		t.Error("expected an error for a unicast address")
+	}
+}
@@ -12,12 +12,15 @@ package discover
 import (
 	"context"
 	"crypto/tls"
-	"fmt"
+	"net/url"
+	"slices"
 	"sort"
+	"strings"
 	"time"
 
 	"github.com/thejerf/suture/v4"
 
+	"github.com/syncthing/syncthing/lib/beacon"
 	"github.com/syncthing/syncthing/lib/config"
 	"github.com/syncthing/syncthing/lib/connections/registry"
 	"github.com/syncthing/syncthing/lib/events"
@@ -48,7 +51,10 @@ type manager struct {
 	registry      *registry.Registry
 
 	finders map[string]cachedFinder
-	mut     sync.RWMutex
+	// The global discovery servers overriding the default ones, for
+	// devices that have them configured.
+	deviceServers map[protocol.DeviceID]map[string]struct{}
+	mut           sync.RWMutex
 }
 
 func NewManager(myID protocol.DeviceID, cfg config.Wrapper, cert tls.Certificate, evLogger events.Logger, lister AddressLister, registry *registry.Registry) Manager {
@@ -61,8 +67,9 @@ func NewManager(myID protocol.DeviceID, cfg config.Wrapper, cert tls.Certificate
 		addressLister: lister,
 		registry:      registry,
 
-		finders: make(map[string]cachedFinder),
-		mut:     sync.NewRWMutex(),
+		finders:       make(map[string]cachedFinder),
+		deviceServers: make(map[protocol.DeviceID]map[string]struct{}),
+		mut:           sync.NewRWMutex(),
 	}
 	m.Add(svcutil.AsService(m.serve, m.String()))
 	return m
@@ -77,18 +84,24 @@ func (m *manager) serve(ctx context.Context) error {
 }
 
 func (m *manager) addLocked(identity string, finder Finder, cacheTime, negCacheTime time.Duration) {
-	entry := cachedFinder{
+	m.addEntryLocked(identity, cachedFinder{
 		Finder:       finder,
 		cacheTime:    cacheTime,
 		negCacheTime: negCacheTime,
-		cache:        newCache(),
-		token:        nil,
-	}
-	if service, ok := finder.(suture.Service); ok {
+	})
+}
+
+func (m *manager) addEntryLocked(identity string, entry cachedFinder) {
+	entry.cache = newCache()
+	if service, ok := entry.Finder.(suture.Service); ok {
 		token := m.Supervisor.Add(service)
 		entry.token = &token
 	}
 	m.finders[identity] = entry
+	if entry.deviceOnly {
+		l.Infoln("Using discovery mechanism for specific devices:", identity)
+		return
+	}
 	l.Infoln("Using discovery mechanism:", identity)
 }
 
@@ -111,7 +124,17 @@ func (m *manager) removeLocked(identity string) {
 // while obeying the cache settings.
 func (m *manager) Lookup(ctx context.Context, deviceID protocol.DeviceID) (addresses []string, err error) {
 	m.mut.RLock()
+	servers, override := m.deviceServers[deviceID]
 	for _, finder := range m.finders {
+		if finder.server != "" {
+			// A global discovery server is asked about the devices that
+			// list it, or about all devices not listing any servers,
+			// unless it's only for specific devices.
+			if _, listed := servers[finder.server]; override && !listed || !override && finder.deviceOnly {
+				continue
+			}
+		}
+
 		if cacheEntry, ok := finder.cache.Get(deviceID); ok {
 			// We have a cache entry. Lets see what it says.
 
@@ -191,63 +214,112 @@ func (m *manager) Cache() map[protocol.DeviceID]CacheEntry {
 	res := make(map[protocol.DeviceID]CacheEntry)
 
 	m.mut.RLock()
-	for _, finder := range m.finders {
+	for identity, finder := range m.finders {
 		// Each finder[i] has a corresponding cache. Go through
 		// it and populate the total, appending any addresses and keeping
 		// the newest "when" time. We skip any negative cache finders.
-		for k, v := range finder.cache.Cache() {
-			if v.found {
-				cur := res[k]
-				if v.when.After(cur.when) {
-					cur.when = v.when
-				}
-				cur.Addresses = append(cur.Addresses, v.Addresses...)
-				res[k] = cur
-			}
-		}
+		mergeCacheEntries(res, identity, finder.cache.Cache())
 
 		// Then ask the finder itself for its cache and do the same. If this
 		// finder is a global discovery client, it will have no cache. If it's
 		// a local discovery client, this will be its current state.
-		for k, v := range finder.Cache() {
-			if v.found {
-				cur := res[k]
-				if v.when.After(cur.when) {
-					cur.when = v.when
-				}
-				cur.Addresses = append(cur.Addresses, v.Addresses...)
-				res[k] = cur
-			}
-		}
+		mergeCacheEntries(res, identity, finder.Cache())
 	}
 	m.mut.RUnlock()
 
 	for k, v := range res {
 		v.Addresses = stringutil.UniqueTrimmedStrings(v.Addresses)
+		sort.Strings(v.Addresses)
+		for _, sources := range v.Sources {
+			// Most recently seen first
+			sort.Slice(sources, func(a, b int) bool {
+				if !sources[a].LastSeen.Equal(sources[b].LastSeen) {
+					return sources[a].LastSeen.After(sources[b].LastSeen)
+				}
+				return sources[a].Finder < sources[b].Finder
+			})
+		}
 		res[k] = v
 	}
 
 	return res
 }
 
+// mergeCacheEntries adds the positive entries found by the named finder to
+// res, recording the finder as a source of each address.
+func mergeCacheEntries(res map[protocol.DeviceID]CacheEntry, finder string, entries map[protocol.DeviceID]CacheEntry) {
+	for k, v := range entries {
+		if !v.found {
+			continue
+		}
+		cur := res[k]
+		if v.when.After(cur.when) {
+			cur.when = v.when
+		}
+		cur.Addresses = append(cur.Addresses, v.Addresses...)
+		if cur.Sources == nil {
+			cur.Sources = make(map[string][]AddressSource)
+		}
+		for _, addr := range v.Addresses {
+			addr = strings.TrimSpace(addr)
+			if addr == "" {
+				continue
+			}
+			sources := cur.Sources[addr]
+			if i := slices.IndexFunc(sources, func(s AddressSource) bool { return s.Finder == finder }); i >= 0 {
+				// Both the cache in front of the finder and the finder's own
+				// cache may have it.
+				if v.when.After(sources[i].LastSeen) {
+					sources[i].LastSeen = v.when
+				}
+				continue
+			}
+			cur.Sources[addr] = append(sources, AddressSource{Finder: finder, LastSeen: v.when})
+		}
+		res[k] = cur
+	}
+}
+
 func (m *manager) CommitConfiguration(_, to config.Configuration) (handled bool) {
 	m.mut.Lock()
 	defer m.mut.Unlock()
 	toIdentities := make(map[string]struct{})
+	globalServers := make(map[string]struct{})
+	clear(m.deviceServers)
 	if to.Options.GlobalAnnEnabled {
 		for _, srv := range to.Options.GlobalDiscoveryServers() {
 			toIdentities[globalDiscoveryIdentity(srv)] = struct{}{}
+			globalServers[srv] = struct{}{}
+		}
+		for _, dev := range to.Devices {
+			servers := dev.DiscoveryServers()
+			if len(servers) == 0 {
+				continue
+			}
+			m.deviceServers[dev.DeviceID] = make(map[string]struct{}, len(servers))
+			for _, srv := range servers {
+				m.deviceServers[dev.DeviceID][srv] = struct{}{}
+				toIdentities[globalDiscoveryIdentity(srv)] = struct{}{}
+			}
 		}
 	}
 
 	if to.Options.LocalAnnEnabled {
-		toIdentities[ipv4Identity(to.Options.LocalAnnPort)] = struct{}{}
-		toIdentities[ipv6Identity(to.Options.LocalAnnMCAddr)] = struct{}{}
+		for _, addr := range to.Options.LocalAnnAddresses() {
+			toIdentities[localIdentity(addr, to.Options.LocalAnnInterfaces)] = struct{}{}
+		}
+		if to.Options.LocalAnnMDNSEnabled {
+			toIdentities[mdnsIdentity(MDNSIPv4Addr, to.Options.LocalAnnInterfaces)] = struct{}{}
+			toIdentities[mdnsIdentity(MDNSIPv6Addr, to.Options.LocalAnnInterfaces)] = struct{}{}
+		}
 	}
 
-	// Remove things that we're not expected to have.
-	for identity := range m.finders {
-		if _, ok := toIdentities[identity]; !ok {
+	// Remove things that we're not expected to have, including global
+	// discovery servers that changed between being used for specific
+	// devices only and in general.
+	for identity, finder := range m.finders {
+		_, global := globalServers[finder.server]
+		if _, ok := toIdentities[identity]; !ok || finder.server != "" && finder.deviceOnly == global {
 			m.removeLocked(identity)
 		}
 	}
@@ -269,33 +341,86 @@ func (m *manager) CommitConfiguration(_, to config.Configuration) (handled bool)
 			// Each global discovery server gets its results cached for five
 			// minutes, and is not asked again for a minute when it's returned
 			// unsuccessfully.
-			m.addLocked(identity, gd, 5*time.Minute, time.Minute)
+			m.addEntryLocked(identity, cachedFinder{
+				Finder:       gd,
+				cacheTime:    5 * time.Minute,
+				negCacheTime: time.Minute,
+				server:       srv,
+			})
+		}
+
+		// Servers only used to look up specific devices. We don't announce
+		// to them, as they're not what the user wants us to be found by in
+		// general.
+		for _, servers := range m.deviceServers {
+			for srv := range servers {
+				identity := globalDiscoveryIdentity(srv)
+				if _, ok := m.finders[identity]; ok {
+					continue
+				}
+				gd, err := NewGlobal(lookupOnlyServer(srv), m.cert, m.addressLister, m.evLogger, m.registry)
+				if err != nil {
+					l.Warnln("Global discovery:", err)
+					continue
+				}
+				m.addEntryLocked(identity, cachedFinder{
+					Finder:       gd,
+					cacheTime:    5 * time.Minute,
+					negCacheTime: time.Minute,
+					server:       srv,
+					deviceOnly:   true,
+				})
+			}
 		}
 	}
 
 	if to.Options.LocalAnnEnabled {
-		// v4 broadcasts
-		v4Identity := ipv4Identity(to.Options.LocalAnnPort)
-		if _, ok := m.finders[v4Identity]; !ok {
-			bcd, err := NewLocal(m.myID, fmt.Sprintf(":%d", to.Options.LocalAnnPort), m.addressLister, m.evLogger)
+		filter := beacon.InterfaceFilter(to.Options.LocalAnnInterfaces)
+
+		// v4 broadcasts and v4 or v6 multicasts
+		for _, addr := range to.Options.LocalAnnAddresses() {
+			identity := localIdentity(addr, to.Options.LocalAnnInterfaces)
+			if _, ok := m.finders[identity]; ok {
+				continue
+			}
+			ld, err := NewLocal(m.myID, addr, filter, m.addressLister, m.evLogger)
 			if err != nil {
-				l.Warnln("IPv4 local discovery:", err)
-			} else {
-				m.addLocked(v4Identity, bcd, 0, 0)
+				l.Warnf("Local discovery on %s: %v", addr, err)
+				continue
 			}
+			m.addLocked(identity, ld, 0, 0)
 		}
 
-		// v6 multicasts
-		v6Identity := ipv6Identity(to.Options.LocalAnnMCAddr)
-		if _, ok := m.finders[v6Identity]; !ok {
-			mcd, err := NewLocal(m.myID, to.Options.LocalAnnMCAddr, m.addressLister, m.evLogger)
-			if err != nil {
-				l.Warnln("IPv6 local discovery:", err)
-			} else {
-				m.addLocked(v6Identity, mcd, 0, 0)
+		// DNS-SD over multicast DNS
+		if to.Options.LocalAnnMDNSEnabled {
+			for _, addr := range []string{MDNSIPv4Addr, MDNSIPv6Addr} {
+				identity := mdnsIdentity(addr, to.Options.LocalAnnInterfaces)
+				if _, ok := m.finders[identity]; ok {
+					continue
+				}
+				md, err := NewMDNS(m.myID, addr, filter, m.addressLister, m.evLogger)
+				if err != nil {
+					l.Warnln("mDNS discovery:", err)
+					continue
+				}
+				m.addLocked(identity, md, 0, 0)
 			}
 		}
 	}
 
 	return true
 }
+
+// lookupOnlyServer returns the global discovery server address with the
+// option set to not announce to it.
+func lookupOnlyServer(srv string) string {
+	u, err := url.Parse(srv)
+	if err != nil {
+		// NewGlobal will complain about it.
+		return srv
+	}
+	q := u.Query()
+	q.Set("noannounce", "true")
+	u.RawQuery = q.Encode()
+	return u.String()
+}
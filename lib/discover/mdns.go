@@ -0,0 +1,582 @@
+// Copyright (C) 2026 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package discover
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/thejerf/suture/v4"
+	"golang.org/x/net/dns/dnsmessage"
+	"golang.org/x/net/ipv4"
+	"golang.org/x/net/ipv6"
+
+	"github.com/syncthing/syncthing/lib/beacon"
+	"github.com/syncthing/syncthing/lib/events"
+	"github.com/syncthing/syncthing/lib/protocol"
+	"github.com/syncthing/syncthing/lib/svcutil"
+	"github.com/syncthing/syncthing/lib/sync"
+)
+
+const (
+	MDNSIPv4Addr = "224.0.0.251:5353"
+	MDNSIPv6Addr = "[ff02::fb]:5353"
+
+	mdnsService     = "_syncthing._tcp.local."
+	mdnsServiceEnum = "_services._dns-sd._udp.local."
+	mdnsTTL         = uint32(CacheLifeTime / time.Second)
+	mdnsCacheFlush  = dnsmessage.Class(1 << 15)
+)
+
+// mdnsClient advertises the device as a _syncthing._tcp DNS-SD service over
+// multicast DNS, so that it can be found by generic service browsers, and
+// looks up other devices doing the same. Instances are named by the short
+// device ID, with the full one in the "id" TXT record.
+type mdnsClient struct {
+	*suture.Supervisor
+	myID     protocol.DeviceID
+	addrList AddressLister
+	filter   beacon.InterfaceFilter
+	group    *net.UDPAddr
+	name     string
+	evLogger events.Logger
+
+	err    error
+	errMut sync.Mutex
+
+	*cache
+}
+
+// NewMDNS returns an mDNS discovery client on the given multicast group,
+// using the interfaces passing the filter only.
+func NewMDNS(id protocol.DeviceID, addr string, filter beacon.InterfaceFilter, addrList AddressLister, evLogger events.Logger) (FinderService, error) {
+	group, err := net.ResolveUDPAddr("udp", addr)
+	if err != nil {
+		return nil, err
+	}
+	if !group.IP.IsMulticast() {
+		return nil, fmt.Errorf("not a multicast address: %s", addr)
+	}
+
+	// Don't retry too frenetically, the usual reason for failure is that
+	// the port is exclusively held by the system's own responder.
+	spec := svcutil.SpecWithDebugLogger(l)
+	spec.FailureThreshold = 2
+	spec.FailureBackoff = 60 * time.Second
+
+	c := &mdnsClient{
+		Supervisor: suture.New("mdns", spec),
+		myID:       id,
+		addrList:   addrList,
+		filter:     filter,
+		group:      group,
+		evLogger:   evLogger,
+		errMut:     sync.NewMutex(),
+		cache:      newCache(),
+	}
+	if group.IP.To4() != nil {
+		c.name = "IPv4 mDNS"
+	} else {
+		c.name = "IPv6 mDNS"
+	}
+	c.Add(svcutil.AsService(c.serve, c.String()))
+	return c, nil
+}
+
+func (c *mdnsClient) String() string {
+	return c.name
+}
+
+func (c *mdnsClient) Error() error {
+	c.errMut.Lock()
+	defer c.errMut.Unlock()
+	return c.err
+}
+
+func (c *mdnsClient) setError(err error) {
+	c.errMut.Lock()
+	c.err = err
+	c.errMut.Unlock()
+}
+
+// Lookup returns a list of addresses the device is available at.
+func (c *mdnsClient) Lookup(_ context.Context, device protocol.DeviceID) (addresses []string, err error) {
+	if cache, ok := c.Get(device); ok {
+		if time.Since(cache.when) < CacheLifeTime {
+			addresses = cache.Addresses
+		}
+	}
+
+	return
+}
+
+func (c *mdnsClient) serve(ctx context.Context) error {
+	conn, err := listenMDNS(c.group, c.filter)
+	if err != nil {
+		l.Debugln("discover: mDNS:", err)
+		c.setError(err)
+		return err
+	}
+	c.setError(nil)
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	go func() {
+		<-ctx.Done()
+		conn.Close()
+	}()
+
+	recvErr := make(chan error, 1)
+	go func() {
+		recvErr <- c.recv(ctx, conn)
+	}()
+
+	// Announce ourselves once on startup, and then ask regularly for
+	// other devices. They answer with multicasts, so everyone learns of
+	// everyone from each round of queries.
+	c.respond(conn, conn.intfs, false)
+	ticker := time.NewTicker(BroadcastInterval)
+	defer ticker.Stop()
+	for {
+		c.query(conn)
+		select {
+		case <-ticker.C:
+		case err := <-recvErr:
+			c.setError(err)
+			return err
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+func (c *mdnsClient) recv(ctx context.Context, conn *mdnsConn) error {
+	bs := make([]byte, 9000)
+	for {
+		n, intf, src, err := conn.read(bs)
+		if err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			l.Debugln("discover: mDNS:", err)
+			return err
+		}
+
+		var p dnsmessage.Parser
+		hdr, err := p.Start(bs[:n])
+		if err != nil {
+			l.Debugf("discover: mDNS: bad packet from %s: %v", src, err)
+			continue
+		}
+
+		intfs := conn.intfs
+		if intf != nil {
+			intfs = []net.Interface{*intf}
+		}
+
+		if !hdr.Response {
+			if answer, enumerate := mdnsQuestions(&p, c.myID); answer {
+				l.Debugf("discover: mDNS: answering query from %s", src)
+				c.respond(conn, intfs, enumerate)
+			}
+			continue
+		}
+
+		zone := ""
+		if intf != nil {
+			zone = intf.Name
+		}
+		anns, err := parseMDNSResponse(&p, src, zone)
+		if err != nil {
+			l.Debugf("discover: mDNS: bad response from %s: %v", src, err)
+			continue
+		}
+		for _, ann := range anns {
+			if ann.ID != c.myID {
+				c.registerDevice(ann)
+			}
+		}
+	}
+}
+
+func (c *mdnsClient) registerDevice(device Announce) {
+	ce, existsAlready := c.Get(device.ID)
+	isNewDevice := !existsAlready || time.Since(ce.when) > CacheLifeTime
+
+	l.Debugln("discover: mDNS: Registering addresses for", device.ID, device.Addresses)
+	c.Set(device.ID, CacheEntry{
+		Addresses: device.Addresses,
+		when:      time.Now(),
+		found:     true,
+	})
+
+	if isNewDevice {
+		c.evLogger.Log(events.DeviceDiscovered, map[string]interface{}{
+			"device": device.ID.String(),
+			"addrs":  device.Addresses,
+		})
+	}
+}
+
+func (c *mdnsClient) query(conn *mdnsConn) {
+	b := dnsmessage.NewBuilder(nil, dnsmessage.Header{})
+	b.EnableCompression()
+	_ = b.StartQuestions()
+	_ = b.Question(dnsmessage.Question{
+		Name:  dnsmessage.MustNewName(mdnsService),
+		Type:  dnsmessage.TypePTR,
+		Class: dnsmessage.ClassINET,
+	})
+	bs, err := b.Finish()
+	if err != nil {
+		l.Debugln("discover: mDNS: building query:", err)
+		return
+	}
+	for _, intf := range conn.intfs {
+		if err := conn.write(bs, intf); err != nil {
+			l.Debugf("discover: mDNS: query on %s: %v", intf.Name, err)
+		}
+	}
+}
+
+// respond sends our records on each of the interfaces, with the addresses
+// we have on that interface.
+func (c *mdnsClient) respond(conn *mdnsConn, intfs []net.Interface, enumerate bool) {
+	ports := mdnsPorts(c.addrList.AllAddresses())
+	if len(ports) == 0 {
+		// Nothing to announce
+		return
+	}
+	for _, intf := range intfs {
+		bs, err := buildMDNSResponse(c.myID, ports, interfaceIPs(intf), enumerate)
+		if err != nil {
+			l.Debugln("discover: mDNS: building response:", err)
+			return
+		}
+		if err := conn.write(bs, intf); err != nil {
+			l.Debugf("discover: mDNS: response on %s: %v", intf.Name, err)
+		}
+	}
+}
+
+func mdnsInstanceName(id protocol.DeviceID) string {
+	return id.Short().String() + "." + mdnsService
+}
+
+func mdnsHostName(id protocol.DeviceID) string {
+	return "syncthing-" + strings.ToLower(id.Short().String()) + ".local."
+}
+
+// mdnsQuestions returns whether the query in p asks for any of our
+// records, and whether it enumerates the available services.
+func mdnsQuestions(p *dnsmessage.Parser, id protocol.DeviceID) (answer, enumerate bool) {
+	qs, err := p.AllQuestions()
+	if err != nil {
+		return false, false
+	}
+	for _, q := range qs {
+		switch strings.ToLower(q.Name.String()) {
+		case mdnsServiceEnum:
+			answer, enumerate = true, true
+		case mdnsService, strings.ToLower(mdnsInstanceName(id)), mdnsHostName(id):
+			answer = true
+		}
+	}
+	return answer, enumerate
+}
+
+// buildMDNSResponse returns a response carrying the PTR, SRV, TXT and
+// address records for the device.
+func buildMDNSResponse(id protocol.DeviceID, ports []int, ips []net.IP, enumerate bool) ([]byte, error) {
+	service := dnsmessage.MustNewName(mdnsService)
+	instance, err := dnsmessage.NewName(mdnsInstanceName(id))
+	if err != nil {
+		return nil, err
+	}
+	host, err := dnsmessage.NewName(mdnsHostName(id))
+	if err != nil {
+		return nil, err
+	}
+	header := func(name dnsmessage.Name, unique bool) dnsmessage.ResourceHeader {
+		class := dnsmessage.ClassINET
+		if unique {
+			// Tells receivers to replace what they have cached.
+			class |= mdnsCacheFlush
+		}
+		return dnsmessage.ResourceHeader{Name: name, Class: class, TTL: mdnsTTL}
+	}
+
+	b := dnsmessage.NewBuilder(nil, dnsmessage.Header{Response: true, Authoritative: true})
+	b.EnableCompression()
+	if err := b.StartAnswers(); err != nil {
+		return nil, err
+	}
+	if enumerate {
+		if err := b.PTRResource(header(dnsmessage.MustNewName(mdnsServiceEnum), false), dnsmessage.PTRResource{PTR: service}); err != nil {
+			return nil, err
+		}
+	}
+	if err := b.PTRResource(header(service, false), dnsmessage.PTRResource{PTR: instance}); err != nil {
+		return nil, err
+	}
+	for _, port := range ports {
+		if err := b.SRVResource(header(instance, true), dnsmessage.SRVResource{Target: host, Port: uint16(port)}); err != nil {
+			return nil, err
+		}
+	}
+	if err := b.TXTResource(header(instance, true), dnsmessage.TXTResource{TXT: []string{"id=" + id.String()}}); err != nil {
+		return nil, err
+	}
+	for _, ip := range ips {
+		if ip4 := ip.To4(); ip4 != nil {
+			err = b.AResource(header(host, true), dnsmessage.AResource{A: [4]byte(ip4)})
+		} else {
+			err = b.AAAAResource(header(host, true), dnsmessage.AAAAResource{AAAA: [16]byte(ip.To16())})
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	return b.Finish()
+}
+
+// parseMDNSResponse returns the devices announced in the response in p,
+// with their addresses. Devices without address records are taken to be
+// at the source address. Link local IPv6 addresses get the zone added.
+func parseMDNSResponse(p *dnsmessage.Parser, src net.Addr, zone string) ([]Announce, error) {
+	if err := p.SkipAllQuestions(); err != nil {
+		return nil, err
+	}
+	rrs, err := p.AllAnswers()
+	if err != nil {
+		return nil, err
+	}
+	if err := p.SkipAllAuthorities(); err != nil {
+		return nil, err
+	}
+	additionals, err := p.AllAdditionals()
+	if err != nil {
+		return nil, err
+	}
+	rrs = append(rrs, additionals...)
+
+	ids := make(map[string]protocol.DeviceID)
+	srvs := make(map[string][]dnsmessage.SRVResource)
+	ips := make(map[string][]net.IP)
+	for _, rr := range rrs {
+		name := strings.ToLower(rr.Header.Name.String())
+		switch body := rr.Body.(type) {
+		case *dnsmessage.TXTResource:
+			for _, txt := range body.TXT {
+				if val, ok := strings.CutPrefix(txt, "id="); ok {
+					if id, err := protocol.DeviceIDFromString(val); err == nil {
+						ids[name] = id
+					}
+				}
+			}
+		case *dnsmessage.SRVResource:
+			srvs[name] = append(srvs[name], *body)
+		case *dnsmessage.AResource:
+			ips[name] = append(ips[name], net.IP(body.A[:]))
+		case *dnsmessage.AAAAResource:
+			ips[name] = append(ips[name], net.IP(body.AAAA[:]))
+		}
+	}
+
+	var srcIP net.IP
+	if udpAddr, ok := src.(*net.UDPAddr); ok {
+		srcIP = udpAddr.IP
+	}
+
+	var anns []Announce
+	for instance, id := range ids {
+		if !strings.HasSuffix(instance, "."+mdnsService) {
+			continue
+		}
+		var addrs []string
+		for _, srv := range srvs[instance] {
+			hostIPs := ips[strings.ToLower(srv.Target.String())]
+			if len(hostIPs) == 0 && srcIP != nil {
+				hostIPs = []net.IP{srcIP}
+			}
+			for _, ip := range hostIPs {
+				host := ip.String()
+				if ip.To4() == nil && ip.IsLinkLocalUnicast() && zone != "" {
+					host += "%" + zone
+				}
+				u := url.URL{Scheme: "tcp", Host: net.JoinHostPort(host, strconv.Itoa(int(srv.Port)))}
+				addrs = append(addrs, u.String())
+			}
+		}
+		addrs = filterUndialableLocal(addrs)
+		if len(addrs) == 0 {
+			continue
+		}
+		anns = append(anns, Announce{ID: id, Addresses: addrs})
+	}
+	return anns, nil
+}
+
+// mdnsPorts returns the ports of the dialable TCP addresses, sorted.
+func mdnsPorts(addrs []string) []int {
+	var ports []int
+	for _, addr := range filterUndialableLocal(addrs) {
+		u, err := url.Parse(addr)
+		if err != nil || !strings.HasPrefix(u.Scheme, "tcp") {
+			continue
+		}
+		port, err := strconv.Atoi(u.Port())
+		if err != nil || slices.Contains(ports, port) {
+			continue
+		}
+		ports = append(ports, port)
+	}
+	slices.Sort(ports)
+	return ports
+}
+
+// interfaceIPs returns the unicast addresses of the interface.
+func interfaceIPs(intf net.Interface) []net.IP {
+	addrs, err := intf.Addrs()
+	if err != nil {
+		l.Debugln("discover: mDNS: failed to list interface addresses:", err)
+		return nil
+	}
+	var ips []net.IP
+	for _, addr := range addrs {
+		if ipnet, ok := addr.(*net.IPNet); ok && (ipnet.IP.IsGlobalUnicast() || ipnet.IP.IsLinkLocalUnicast()) {
+			ips = append(ips, ipnet.IP)
+		}
+	}
+	return ips
+}
+
+// mdnsConn is a socket on the mDNS port, joined to the group on the
+// selected interfaces, for either IPv4 or IPv6.
+type mdnsConn struct {
+	net.PacketConn
+	intfs []net.Interface
+	mut   sync.Mutex // serializes setting the interface and writing
+	read  func(bs []byte) (int, *net.Interface, net.Addr, error)
+	write func(bs []byte, intf net.Interface) error
+}
+
+func listenMDNS(group *net.UDPAddr, filter beacon.InterfaceFilter) (*mdnsConn, error) {
+	network := "udp6"
+	if group.IP.To4() != nil {
+		network = "udp4"
+	}
+	conn, err := net.ListenPacket(network, group.String())
+	if err != nil {
+		return nil, err
+	}
+	intfs, err := filter.Interfaces()
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+
+	c := &mdnsConn{PacketConn: conn, mut: sync.NewMutex()}
+	byIndex := make(map[int]*net.Interface)
+	lookup := func(ifIndex int) (*net.Interface, bool) {
+		if ifIndex == 0 {
+			// Unknown, as the platform doesn't tell
+			return nil, true
+		}
+		intf, ok := byIndex[ifIndex]
+		return intf, ok
+	}
+
+	if network == "udp4" {
+		pconn := ipv4.NewPacketConn(conn)
+		c.intfs = joinMDNS(intfs, func(intf *net.Interface) error {
+			return pconn.JoinGroup(intf, &net.UDPAddr{IP: group.IP})
+		})
+		_ = pconn.SetMulticastTTL(255)
+		_ = pconn.SetControlMessage(ipv4.FlagInterface, true)
+		c.read = func(bs []byte) (int, *net.Interface, net.Addr, error) {
+			for {
+				n, cm, src, err := pconn.ReadFrom(bs)
+				if err != nil || cm == nil {
+					return n, nil, src, err
+				}
+				if intf, ok := lookup(cm.IfIndex); ok {
+					return n, intf, src, nil
+				}
+			}
+		}
+		c.write = func(bs []byte, intf net.Interface) error {
+			c.mut.Lock()
+			defer c.mut.Unlock()
+			if err := pconn.SetMulticastInterface(&intf); err != nil {
+				return err
+			}
+			_, err := pconn.WriteTo(bs, nil, group)
+			return err
+		}
+	} else {
+		pconn := ipv6.NewPacketConn(conn)
+		c.intfs = joinMDNS(intfs, func(intf *net.Interface) error {
+			return pconn.JoinGroup(intf, &net.UDPAddr{IP: group.IP})
+		})
+		_ = pconn.SetMulticastHopLimit(255)
+		_ = pconn.SetControlMessage(ipv6.FlagInterface, true)
+		c.read = func(bs []byte) (int, *net.Interface, net.Addr, error) {
+			for {
+				n, cm, src, err := pconn.ReadFrom(bs)
+				if err != nil || cm == nil {
+					return n, nil, src, err
+				}
+				if intf, ok := lookup(cm.IfIndex); ok {
+					return n, intf, src, nil
+				}
+			}
+		}
+		c.write = func(bs []byte, intf net.Interface) error {
+			c.mut.Lock()
+			defer c.mut.Unlock()
+			if err := pconn.SetMulticastInterface(&intf); err != nil {
+				return err
+			}
+			_, err := pconn.WriteTo(bs, nil, group)
+			return err
+		}
+	}
+
+	if len(c.intfs) == 0 {
+		conn.Close()
+		return nil, errors.New("no multicast interfaces available")
+	}
+	for i := range c.intfs {
+		byIndex[c.intfs[i].Index] = &c.intfs[i]
+	}
+	return c, nil
+}
+
+// joinMDNS joins the group on the running multicast capable interfaces,
+// returning those where it succeeded.
+func joinMDNS(intfs []net.Interface, join func(*net.Interface) error) []net.Interface {
+	var joined []net.Interface
+	for _, intf := range intfs {
+		if intf.Flags&net.FlagRunning == 0 || intf.Flags&net.FlagMulticast == 0 {
+			continue
+		}
+		if err := join(&intf); err != nil {
+			l.Debugln("discover: mDNS: join on", intf.Name, "failed:", err)
+			continue
+		}
+		joined = append(joined, intf)
+	}
+	return joined
+}
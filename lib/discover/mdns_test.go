@@ -0,0 +1,121 @@
+// Copyright (C) 2026 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package discover
+
+import (
+	"net"
+	"slices"
+	"testing"
+
+	"golang.org/x/net/dns/dnsmessage"
+
+	"github.com/syncthing/syncthing/lib/protocol"
+)
+
+func TestMDNSResponseRoundtrip(t *testing.T) {
+	id := protocol.DeviceID{1, 2, 3, 4, 5}
+	ips := []net.IP{net.ParseIP("192.168.1.10"), net.ParseIP("fe80::1")}
+	bs, err := buildMDNSResponse(id, []int{22000, 22001}, ips, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var p dnsmessage.Parser
+	hdr, err := p.Start(bs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !hdr.Response {
+		t.Fatal("expected a response")
+	}
+	src := &net.UDPAddr{IP: net.ParseIP("192.168.1.10"), Port: 5353}
+	anns, err := parseMDNSResponse(&p, src, "eth0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(anns) != 1 || anns[0].ID != id {
+		t.Fatalf("expected one announcement for %v, got %v", id, anns)
+	}
+	expected := []string{
+		"tcp://192.168.1.10:22000",
+		"tcp://[fe80::1%25eth0]:22000",
+		"tcp://192.168.1.10:22001",
+		"tcp://[fe80::1%25eth0]:22001",
+	}
+	if !slices.Equal(anns[0].Addresses, expected) {
+		t.Errorf("got addresses %v, expected %v", anns[0].Addresses, expected)
+	}
+}
+
+func TestMDNSResponseSourceAddress(t *testing.T) {
+	id := protocol.DeviceID{1, 2, 3, 4, 5}
+	bs, err := buildMDNSResponse(id, []int{22000}, nil, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var p dnsmessage.Parser
+	if _, err := p.Start(bs); err != nil {
+		t.Fatal(err)
+	}
+	src := &net.UDPAddr{IP: net.ParseIP("10.0.0.5"), Port: 5353}
+	anns, err := parseMDNSResponse(&p, src, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(anns) != 1 || !slices.Equal(anns[0].Addresses, []string{"tcp://10.0.0.5:22000"}) {
+		t.Errorf("expected the source address to be used, got %v", anns)
+	}
+}
+
+func TestMDNSQuestions(t *testing.T) {
+	id := protocol.DeviceID{1, 2, 3, 4, 5}
+	cases := []struct {
+		name      string
+		answer    bool
+		enumerate bool
+	}{
+		{mdnsService, true, false},
+		{"_SYNCTHING._tcp.local.", true, false},
+		{mdnsServiceEnum, true, true},
+		{mdnsInstanceName(id), true, false},
+		{mdnsHostName(id), true, false},
+		{"_http._tcp.local.", false, false},
+		{mdnsInstanceName(protocol.DeviceID{5, 4, 3, 2, 1}), false, false},
+	}
+	for _, tc := range cases {
+		b := dnsmessage.NewBuilder(nil, dnsmessage.Header{})
+		_ = b.StartQuestions()
+		_ = b.Question(dnsmessage.Question{Name: dnsmessage.MustNewName(tc.name), Type: dnsmessage.TypePTR, Class: dnsmessage.ClassINET})
+		bs, err := b.Finish()
+		if err != nil {
+			t.Fatal(err)
+		}
+		var p dnsmessage.Parser
+		if _, err := p.Start(bs); err != nil {
+			t.Fatal(err)
+		}
+		answer, enumerate := mdnsQuestions(&p, id)
+		if answer != tc.answer || enumerate != tc.enumerate {
+			t.Errorf("%s: got %v, %v, expected %v, %v", tc.name, answer, enumerate, tc.answer, tc.enumerate)
+		}
+	}
+}
+
+func TestMDNSPorts(t *testing.T) {
+	addrs := []string{
+		"tcp://0.0.0.0:22000",
+		"tcp6://[::]:22000",
+		"quic://0.0.0.0:22000",
+		"tcp://192.168.1.10:21000",
+		"tcp://127.0.0.1:23000",
+		"relay://192.0.2.1:22067",
+	}
+	if ports := mdnsPorts(addrs); !slices.Equal(ports, []int{21000, 22000}) {
+		t.Errorf("got ports %v", ports)
+	}
+}
@@ -0,0 +1,202 @@
+// Copyright (C) 2026 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package discover
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"time"
+
+	"github.com/syncthing/syncthing/lib/protocol"
+)
+
+const (
+	// Signed announcements older than this are not accepted, as the device
+	// would have reannounced in the meantime.
+	maxSignedAnnouncementAge = 2 * time.Hour
+	// Allowed clock difference for signed announcements from the future.
+	maxSignedAnnouncementSkew = 10 * time.Minute
+)
+
+var (
+	errNotSigned        = errors.New("announcement is not signed")
+	errSignatureDevice  = errors.New("announcement signed by another device")
+	errSignatureInvalid = errors.New("invalid announcement signature")
+	errSignatureExpired = errors.New("signed announcement is too old")
+	errSignatureFuture  = errors.New("signed announcement is from the future")
+	errSignatureReplay  = errors.New("signed announcement is older than one seen before")
+)
+
+// A SignedAnnouncement is the announcement of a device signed with the key
+// of its certificate, which is included so that it can be verified with
+// nothing but the device ID. The discovery server passes it on to lookups
+// unchanged, so that clients can tell whether the addresses it returns are
+// the ones the device announced.
+type SignedAnnouncement struct {
+	Certificate []byte `json:"certificate"` // DER
+	Payload     []byte `json:"payload"`     // JSON encoded SignedPayload
+	Signature   []byte `json:"signature"`
+}
+
+// The SignedPayload is what is signed. The timestamp protects against
+// replays of old announcements.
+type SignedPayload struct {
+	Device    protocol.DeviceID `json:"device"`
+	Addresses []string          `json:"addresses"`
+	Timestamp time.Time         `json:"timestamp"`
+}
+
+// SignAnnouncement returns the addresses signed by the certificate.
+func SignAnnouncement(cert tls.Certificate, addresses []string, now time.Time) (*SignedAnnouncement, error) {
+	if len(cert.Certificate) == 0 {
+		return nil, errors.New("no certificate")
+	}
+	signer, ok := cert.PrivateKey.(crypto.Signer)
+	if !ok {
+		return nil, errors.New("unsupported private key")
+	}
+
+	payload, err := json.Marshal(SignedPayload{
+		Device:    protocol.NewDeviceID(cert.Certificate[0]),
+		Addresses: addresses,
+		Timestamp: now.UTC().Truncate(time.Second),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var sig []byte
+	if _, ok := signer.Public().(ed25519.PublicKey); ok {
+		sig, err = signer.Sign(rand.Reader, payload, crypto.Hash(0))
+	} else {
+		hash := sha256.Sum256(payload)
+		sig, err = signer.Sign(rand.Reader, hash[:], crypto.SHA256)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &SignedAnnouncement{
+		Certificate: cert.Certificate[0],
+		Payload:     payload,
+		Signature:   sig,
+	}, nil
+}
+
+// Verify checks that the announcement is signed by the given device, and
+// returns the signed payload.
+func (s *SignedAnnouncement) Verify(device protocol.DeviceID) (SignedPayload, error) {
+	if s == nil {
+		return SignedPayload{}, errNotSigned
+	}
+	if protocol.NewDeviceID(s.Certificate) != device {
+		return SignedPayload{}, errSignatureDevice
+	}
+	cert, err := x509.ParseCertificate(s.Certificate)
+	if err != nil {
+		return SignedPayload{}, fmt.Errorf("%w: %v", errSignatureInvalid, err)
+	}
+
+	var algo x509.SignatureAlgorithm
+	switch cert.PublicKey.(type) {
+	case *ecdsa.PublicKey:
+		algo = x509.ECDSAWithSHA256
+	case *rsa.PublicKey:
+		algo = x509.SHA256WithRSA
+	case ed25519.PublicKey:
+		algo = x509.PureEd25519
+	default:
+		return SignedPayload{}, fmt.Errorf("%w: unsupported public key", errSignatureInvalid)
+	}
+	if err := cert.CheckSignature(algo, s.Payload, s.Signature); err != nil {
+		return SignedPayload{}, fmt.Errorf("%w: %v", errSignatureInvalid, err)
+	}
+
+	var payload SignedPayload
+	if err := json.Unmarshal(s.Payload, &payload); err != nil {
+		return SignedPayload{}, fmt.Errorf("%w: %v", errSignatureInvalid, err)
+	}
+	if payload.Device != device {
+		return SignedPayload{}, errSignatureDevice
+	}
+	return payload, nil
+}
+
+// CheckTimestamp returns an error if the signed timestamp is not within the
+// accepted window around now, or is older than the one last seen.
+func (p SignedPayload) CheckTimestamp(last, now time.Time) error {
+	switch {
+	case now.Sub(p.Timestamp) > maxSignedAnnouncementAge:
+		return errSignatureExpired
+	case p.Timestamp.Sub(now) > maxSignedAnnouncementSkew:
+		return errSignatureFuture
+	case p.Timestamp.Before(last):
+		return errSignatureReplay
+	}
+	return nil
+}
+
+// signedAddresses returns the addresses that are covered by the signed
+// ones. The discovery server replaces unspecified hosts and zero ports with
+// those the announcement came from, so a signed address with either
+// covers any value in its place; everything else must match.
+func signedAddresses(addresses, signed []string) []string {
+	var res []string
+	for _, addr := range addresses {
+		for _, sigAddr := range signed {
+			if addressCovers(sigAddr, addr) {
+				res = append(res, addr)
+				break
+			}
+		}
+	}
+	return res
+}
+
+func addressCovers(signed, addr string) bool {
+	if signed == addr {
+		return true
+	}
+	su, err := url.Parse(signed)
+	if err != nil {
+		return false
+	}
+	au, err := url.Parse(addr)
+	if err != nil {
+		return false
+	}
+	if su.Scheme != au.Scheme || su.RawQuery != au.RawQuery {
+		return false
+	}
+	sHost, sPort, err := net.SplitHostPort(su.Host)
+	if err != nil {
+		return false
+	}
+	aHost, aPort, err := net.SplitHostPort(au.Host)
+	if err != nil {
+		return false
+	}
+	if sPort != "0" && sPort != aPort {
+		return false
+	}
+	if sHost == aHost || sHost == "" {
+		return true
+	}
+	sIP := net.ParseIP(sHost)
+	return sIP != nil && (sIP.IsUnspecified() || sIP.Equal(net.ParseIP(aHost)))
+}
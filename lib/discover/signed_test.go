@@ -0,0 +1,145 @@
+// Copyright (C) 2026 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package discover
+
+import (
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/syncthing/syncthing/lib/protocol"
+	"github.com/syncthing/syncthing/lib/tlsutil"
+)
+
+func TestSignedAnnouncement(t *testing.T) {
+	cert, err := tlsutil.NewCertificateInMemory("syncthing", 30)
+	if err != nil {
+		t.Fatal(err)
+	}
+	device := protocol.NewDeviceID(cert.Certificate[0])
+	addrs := []string{"tcp://0.0.0.0:22000", "quic://192.0.2.42:22000"}
+
+	signed, err := SignAnnouncement(cert, addrs, time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+	payload, err := signed.Verify(device)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if payload.Device != device || !slices.Equal(payload.Addresses, addrs) {
+		t.Errorf("unexpected payload %+v", payload)
+	}
+
+	if _, err := signed.Verify(protocol.DeviceID{1, 2, 3}); !errors.Is(err, errSignatureDevice) {
+		t.Errorf("expected a device mismatch, got %v", err)
+	}
+
+	tampered := *signed
+	tampered.Payload = []byte(`{"device":"` + device.String() + `","addresses":["tcp://198.51.100.1:22000"]}`)
+	if _, err := tampered.Verify(device); !errors.Is(err, errSignatureInvalid) {
+		t.Errorf("expected an invalid signature, got %v", err)
+	}
+
+	var unsigned *SignedAnnouncement
+	if _, err := unsigned.Verify(device); !errors.Is(err, errNotSigned) {
+		t.Errorf("expected not signed, got %v", err)
+	}
+}
+
+func TestSignedTimestamp(t *testing.T) {
+	now := time.Now()
+	cases := []struct {
+		ts, last time.Time
+		err      error
+	}{
+		{now, time.Time{}, nil},
+		{now.Add(-time.Hour), now.Add(-time.Hour), nil},
+		{now.Add(-3 * time.Hour), time.Time{}, errSignatureExpired},
+		{now.Add(time.Hour), time.Time{}, errSignatureFuture},
+		{now.Add(-time.Hour), now.Add(-time.Minute), errSignatureReplay},
+	}
+	for i, tc := range cases {
+		if err := (SignedPayload{Timestamp: tc.ts}).CheckTimestamp(tc.last, now); err != tc.err {
+			t.Errorf("%d: got %v, expected %v", i, err, tc.err)
+		}
+	}
+}
+
+func TestSignedAddresses(t *testing.T) {
+	signed := []string{
+		"tcp://0.0.0.0:22000",
+		"quic://192.0.2.42:22000",
+		"tcp6://:0",
+		"relay://192.0.2.1:22067/?id=ABC",
+	}
+	addrs := []string{
+		"tcp://198.51.100.7:22000",   // unspecified host
+		"tcp://198.51.100.7:22001",   // wrong port
+		"quic://192.0.2.42:22000",    // exact
+		"quic://192.0.2.43:22000",    // wrong host
+		"tcp6://[2001:db8::1]:12345", // unspecified host and port
+		"tcp4://198.51.100.7:22000",  // wrong scheme
+		"relay://192.0.2.1:22067/?id=ABC",
+		"relay://192.0.2.1:22067/?id=DEF",
+	}
+	expected := []string{
+		"tcp://198.51.100.7:22000",
+		"quic://192.0.2.42:22000",
+		"tcp6://[2001:db8::1]:12345",
+		"relay://192.0.2.1:22067/?id=ABC",
+	}
+	if res := signedAddresses(addrs, signed); !slices.Equal(res, expected) {
+		t.Errorf("got %v, expected %v", res, expected)
+	}
+}
+
+func TestGlobalVerifiedAddresses(t *testing.T) {
+	cert, err := tlsutil.NewCertificateInMemory("syncthing", 30)
+	if err != nil {
+		t.Fatal(err)
+	}
+	device := protocol.NewDeviceID(cert.Certificate[0])
+	c := &globalClient{lastSigned: make(map[protocol.DeviceID]time.Time)}
+
+	// Unsigned results are taken as is, unless signatures are required
+	ann := announcement{Addresses: []string{"tcp://192.0.2.42:22000"}}
+	if addrs, err := c.verifiedAddresses(device, ann); err != nil || len(addrs) != 1 {
+		t.Errorf("unexpected result for unsigned announcement: %v, %v", addrs, err)
+	}
+	c.requireSigned = true
+	if _, err := c.verifiedAddresses(device, ann); !errors.Is(err, errNotSigned) {
+		t.Errorf("expected not signed, got %v", err)
+	}
+
+	// Signed results lose the addresses not covered
+	old, err := SignAnnouncement(cert, []string{"tcp://0.0.0.0:22000"}, time.Now().Add(-time.Hour))
+	if err != nil {
+		t.Fatal(err)
+	}
+	ann = announcement{
+		Addresses: []string{"tcp://192.0.2.42:22000", "tcp://198.51.100.1:23000"},
+		Signed:    old,
+	}
+	if addrs, err := c.verifiedAddresses(device, ann); err != nil || !slices.Equal(addrs, []string{"tcp://192.0.2.42:22000"}) {
+		t.Errorf("unexpected result for signed announcement: %v, %v", addrs, err)
+	}
+
+	// Once a newer one has been seen, the old one is a replay
+	ann.Signed, err = SignAnnouncement(cert, []string{"tcp://0.0.0.0:22000"}, time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.verifiedAddresses(device, ann); err != nil {
+		t.Fatal(err)
+	}
+	ann.Signed = old
+	if _, err := c.verifiedAddresses(device, ann); !errors.Is(err, errSignatureReplay) {
+		t.Errorf("expected a replay, got %v", err)
+	}
+}
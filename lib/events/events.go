@@ -58,6 +58,12 @@ const (
 	ListenAddressesChanged
 	LoginAttempt
 	Failure
+	RelayBudgetChanged
+	PendingConfigPushesChanged
+	FolderFrozen
+	FolderUnfrozen
+	DatabaseMaintenanceProgress
+	StaleDevice
 
 	AllEvents = (1 << iota) - 1
 )
@@ -135,6 +141,18 @@ func (t EventType) String() string {
 		return "FolderWatchStateChanged"
 	case Failure:
 		return "Failure"
+	case RelayBudgetChanged:
+		return "RelayBudgetChanged"
+	case PendingConfigPushesChanged:
+		return "PendingConfigPushesChanged"
+	case FolderFrozen:
+		return "FolderFrozen"
+	case FolderUnfrozen:
+		return "FolderUnfrozen"
+	case DatabaseMaintenanceProgress:
+		return "DatabaseMaintenanceProgress"
+	case StaleDevice:
+		return "StaleDevice"
 	default:
 		return "Unknown"
 	}
@@ -222,6 +240,18 @@ func UnmarshalEventType(s string) EventType {
 		return FolderWatchStateChanged
 	case "Failure":
 		return Failure
+	case "RelayBudgetChanged":
+		return RelayBudgetChanged
+	case "PendingConfigPushesChanged":
+		return PendingConfigPushesChanged
+	case "FolderFrozen":
+		return FolderFrozen
+	case "FolderUnfrozen":
+		return FolderUnfrozen
+	case "DatabaseMaintenanceProgress":
+		return DatabaseMaintenanceProgress
+	case "StaleDevice":
+		return StaleDevice
 	default:
 		return 0
 	}
@@ -469,12 +499,14 @@ func (s *subscription) Unsubscribe() {
 }
 
 type bufferedSubscription struct {
-	sub  Subscription
-	buf  []Event
-	next int
-	cur  int // Current SubscriptionID
-	mut  sync.Mutex
-	cond *sync.TimeoutCond
+	sub    Subscription
+	buf    []Event
+	next   int
+	cur    int // Current SubscriptionID
+	offset int // Added to the SubscriptionIDs of the underlying subscription
+	store  EventStore
+	mut    sync.Mutex
+	cond   *sync.TimeoutCond
 }
 
 type BufferedSubscription interface {
@@ -493,8 +525,112 @@ func NewBufferedSubscription(s Subscription, size int) BufferedSubscription {
 	return bs
 }
 
+// EventStore is where a persistent buffered subscription keeps its events,
+// keyed by their subscription ID. A *db.NamespacedKV satisfies it.
+type EventStore interface {
+	PutBytes(key string, val []byte) error
+	Delete(key string) error
+	Each(fn func(key string, val []byte) error) error
+}
+
+// NewPersistentBufferedSubscription is like NewBufferedSubscription, but
+// also keeps the buffered events in the given store. The events stored by
+// a previous instance are loaded back into the buffer and the subscription
+// IDs continue from the last of them, so that clients can ask for the
+// events since an ID across a restart. The data of loaded events is the
+// raw JSON it was serialised to, and their GlobalIDs are from the previous
+// run.
+func NewPersistentBufferedSubscription(s Subscription, size int, store EventStore) BufferedSubscription {
+	bs := &bufferedSubscription{
+		sub:   s,
+		buf:   make([]Event, size),
+		store: store,
+		mut:   sync.NewMutex(),
+	}
+	bs.cond = sync.NewTimeoutCond(bs.mut)
+	bs.load()
+	go bs.pollingLoop()
+	return bs
+}
+
+// persistedEvent is an Event as loaded from an EventStore, with the data
+// left as is.
+type persistedEvent struct {
+	Event
+	Data json.RawMessage `json:"data"`
+}
+
+func (s *bufferedSubscription) load() {
+	var evs []Event
+	var invalid []string
+	err := s.store.Each(func(key string, val []byte) error {
+		var pe persistedEvent
+		if err := json.Unmarshal(val, &pe); err != nil || pe.SubscriptionID <= 0 {
+			dl.Debugf("load persisted event %s: %v", key, err)
+			invalid = append(invalid, key)
+			return nil
+		}
+		pe.Event.Data = pe.Data
+		evs = append(evs, pe.Event)
+		return nil
+	})
+	if err != nil {
+		dl.Debugln("load persisted events:", err)
+	}
+
+	// Keys are in ID order; only the last buffer full is kept.
+	if len(evs) > len(s.buf) {
+		for _, ev := range evs[:len(evs)-len(s.buf)] {
+			invalid = append(invalid, eventStoreKey(ev.SubscriptionID))
+		}
+		evs = evs[len(evs)-len(s.buf):]
+	}
+	for _, key := range invalid {
+		if err := s.store.Delete(key); err != nil {
+			dl.Debugln("delete persisted event:", err)
+		}
+	}
+
+	for _, ev := range evs {
+		s.buf[s.next] = ev
+		s.next = (s.next + 1) % len(s.buf)
+	}
+	if len(evs) > 0 {
+		s.cur = evs[len(evs)-1].SubscriptionID
+		s.offset = s.cur
+	}
+}
+
+// persist stores the event and drops the one it replaces in the buffer.
+func (s *bufferedSubscription) persist(ev Event) {
+	bs, err := json.Marshal(ev)
+	if err != nil {
+		dl.Debugln("persist event:", err)
+		return
+	}
+	if err := s.store.PutBytes(eventStoreKey(ev.SubscriptionID), bs); err != nil {
+		dl.Debugln("persist event:", err)
+		return
+	}
+	if old := ev.SubscriptionID - len(s.buf); old > 0 {
+		if err := s.store.Delete(eventStoreKey(old)); err != nil {
+			dl.Debugln("delete persisted event:", err)
+		}
+	}
+}
+
+// eventStoreKey returns the key of the event with the given ID, such that
+// keys sort in ID order.
+func eventStoreKey(id int) string {
+	return fmt.Sprintf("%016x", id)
+}
+
 func (s *bufferedSubscription) pollingLoop() {
 	for ev := range s.sub.C() {
+		ev.SubscriptionID += s.offset
+		if s.store != nil {
+			s.persist(ev)
+		}
 		s.mut.Lock()
 		s.buf[s.next] = ev
 		s.next = (s.next + 1) % len(s.buf)
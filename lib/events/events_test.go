@@ -10,6 +10,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"sort"
 	"sync"
 	"testing"
 	"time"
@@ -346,6 +347,86 @@ func TestSinceUsesSubscriptionId(t *testing.T) {
 	}
 }
 
+func TestPersistentBufferedSub(t *testing.T) {
+	store := make(mapEventStore)
+
+	// A first run logs more events than the buffer holds.
+	l, cancel := setupLogger()
+	s := l.Subscribe(AllEvents)
+	bs := NewPersistentBufferedSubscription(s, 3, store)
+	for i := 1; i <= 5; i++ {
+		l.Log(DeviceConnected, map[string]string{"id": fmt.Sprint(i)})
+	}
+	if evs := waitForEvents(bs, 2, 5); len(evs) != 3 {
+		t.Fatalf("Incorrect number of events %d != 3", len(evs))
+	}
+	s.Unsubscribe()
+	cancel()
+	if len(store) != 3 {
+		t.Fatalf("Incorrect number of persisted events %d != 3", len(store))
+	}
+
+	// The next run starts with them and continues the IDs.
+	l, cancel = setupLogger()
+	defer cancel()
+	s = l.Subscribe(AllEvents)
+	defer s.Unsubscribe()
+	bs = NewPersistentBufferedSubscription(s, 3, store)
+	evs := bs.Since(3, nil, 0)
+	if len(evs) != 2 || evs[0].SubscriptionID != 4 || evs[1].SubscriptionID != 5 {
+		t.Fatalf("Incorrect events after restart: %v", evs)
+	}
+	if data, _ := json.Marshal(evs[1].Data); string(data) != `{"id":"5"}` {
+		t.Errorf("Incorrect data %s", data)
+	}
+
+	l.Log(DeviceConnected, map[string]string{"id": "6"})
+	evs = waitForEvents(bs, 5, 6)
+	if len(evs) != 1 || evs[0].SubscriptionID != 6 {
+		t.Fatalf("Incorrect events after restart: %v", evs)
+	}
+	if len(store) != 3 {
+		t.Errorf("Incorrect number of persisted events %d != 3", len(store))
+	}
+}
+
+// waitForEvents returns the events since the given ID, once the last is
+// the expected one.
+func waitForEvents(bs BufferedSubscription, since, last int) []Event {
+	for {
+		evs := bs.Since(since, nil, timeout)
+		if len(evs) == 0 || evs[len(evs)-1].SubscriptionID >= last {
+			return evs
+		}
+	}
+}
+
+type mapEventStore map[string][]byte
+
+func (s mapEventStore) PutBytes(key string, val []byte) error {
+	s[key] = val
+	return nil
+}
+
+func (s mapEventStore) Delete(key string) error {
+	delete(s, key)
+	return nil
+}
+
+func (s mapEventStore) Each(fn func(key string, val []byte) error) error {
+	keys := make([]string, 0, len(s))
+	for key := range s {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		if err := fn(key, s[key]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func TestUnmarshalEvent(t *testing.T) {
 	var event Event
 
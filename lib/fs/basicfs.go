@@ -41,11 +41,32 @@ func (*OptionJunctionsAsDirs) String() string {
 	return "junctionsAsDirs"
 }
 
+// OptionWindowsACL sets the access control list described by the given
+// SDDL string on items whenever their permissions are changed. It has no
+// effect on other operating systems than Windows.
+type OptionWindowsACL struct {
+	SDDL string
+}
+
+func (o *OptionWindowsACL) apply(fs Filesystem) Filesystem {
+	if basic, ok := fs.(*BasicFilesystem); !ok {
+		l.Warnln("WithWindowsACL must only be used with FilesystemTypeBasic")
+	} else {
+		basic.aclTemplate = o.SDDL
+	}
+	return fs
+}
+
+func (*OptionWindowsACL) String() string {
+	return "windowsACL"
+}
+
 // The BasicFilesystem implements all aspects by delegating to package os.
 // All paths are relative to the root and cannot (should not) escape the root directory.
 type BasicFilesystem struct {
 	root            string
 	junctionsAsDirs bool
+	aclTemplate     string
 	options         []Option
 	userCache       *userCache
 	groupCache      *groupCache
@@ -136,7 +157,13 @@ func (f *BasicFilesystem) Chmod(name string, mode FileMode) error {
 	if err != nil {
 		return err
 	}
-	return os.Chmod(name, os.FileMode(mode))
+	if err := os.Chmod(name, os.FileMode(mode)); err != nil {
+		return err
+	}
+	if f.aclTemplate != "" {
+		return setACL(name, f.aclTemplate)
+	}
+	return nil
 }
 
 func (f *BasicFilesystem) Chtimes(name string, atime time.Time, mtime time.Time) error {
@@ -16,6 +16,10 @@ import (
 	"strings"
 )
 
+func setACL(_, _ string) error {
+	return nil
+}
+
 func (*BasicFilesystem) SymlinksSupported() bool {
 	return true
 }
@@ -0,0 +1,51 @@
+// Copyright (C) 2026 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package fs
+
+import "errors"
+
+var (
+	// ErrChangeJournalUnsupported is returned when there is no change
+	// journal for the filesystem, or we lack the permissions to read it.
+	ErrChangeJournalUnsupported = errors.New("change journal not supported")
+	// ErrChangeJournalReset is returned when the changes since a cursor are
+	// no longer known, as the journal was recreated, overflowed or has been
+	// truncated since, or when there are too many to be worth listing.
+	// Everything may have changed.
+	ErrChangeJournalReset = errors.New("change journal reset since cursor")
+)
+
+// The most changes listed since a cursor, beyond which walking the tree is
+// likely as cheap as scanning them one by one.
+const maxChangeJournalChanges = 100000
+
+// A ChangeJournal enumerates the paths changed on a filesystem, as recorded
+// by the operating system, so that they can be scanned without walking the
+// whole tree. Positions in the journal are opaque cursors; the changes since
+// one are those made after it was returned.
+type ChangeJournal interface {
+	// Cursor returns the current position in the journal.
+	Cursor() (string, error)
+	// Changes returns the paths, relative to the filesystem root, that
+	// were created, modified, removed or renamed since the cursor, and the
+	// cursor to pass next time. A path may be listed more than once or
+	// be that of the parent directory of what changed. It returns
+	// ErrChangeJournalReset when the changes are no longer known.
+	Changes(cursor string) ([]string, string, error)
+	Close() error
+}
+
+// OpenChangeJournal returns the change journal for the filesystem, which is
+// the USN journal of NTFS volumes on Windows, and an fanotify listener on
+// Linux, which needs the CAP_SYS_ADMIN capability and only records the
+// changes made while it's open. It's only available for basic filesystems.
+func OpenChangeJournal(filesystem Filesystem) (ChangeJournal, error) {
+	if filesystem.Type() != FilesystemTypeBasic {
+		return nil, ErrChangeJournalUnsupported
+	}
+	return openChangeJournal(filesystem.URI())
+}
@@ -0,0 +1,262 @@
+// Copyright (C) 2026 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+//go:build linux
+// +build linux
+
+package fs
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+
+	"golang.org/x/sys/unix"
+)
+
+// The events recorded: anything that changes the contents, metadata or
+// names of files and directories.
+const fanotifyMask = unix.FAN_CREATE | unix.FAN_DELETE | unix.FAN_MOVED_FROM | unix.FAN_MOVED_TO |
+	unix.FAN_MODIFY | unix.FAN_ATTRIB | unix.FAN_ONDIR
+
+// fanotifyJournal records the changes on the filesystem holding the root
+// with fanotify, which reports them by the handle of the parent directory
+// and the name of the changed entry. The handles are resolved to paths when
+// the changes are listed, so that the events of other parts of the
+// filesystem cost little. The changes are only kept in memory, so cursors
+// from before it was opened are always reset.
+type fanotifyJournal struct {
+	events   *os.File
+	rootFd   int
+	root     string
+	instance string
+
+	mut        sync.Mutex
+	generation int
+	pending    map[string]fanotifyChange
+	overflow   bool
+}
+
+// A changed entry, named within the directory with the given handle.
+type fanotifyChange struct {
+	handleType int32
+	handle     []byte
+	name       string
+}
+
+func openChangeJournal(root string) (ChangeJournal, error) {
+	fd, err := unix.FanotifyInit(unix.FAN_CLASS_NOTIF|unix.FAN_REPORT_DFID_NAME|unix.FAN_CLOEXEC|unix.FAN_NONBLOCK, unix.O_RDONLY|unix.O_CLOEXEC)
+	if err != nil {
+		if errors.Is(err, unix.EPERM) || errors.Is(err, unix.EINVAL) || errors.Is(err, unix.ENOSYS) {
+			return nil, ErrChangeJournalUnsupported
+		}
+		return nil, fmt.Errorf("fanotify_init: %w", err)
+	}
+	if err := unix.FanotifyMark(fd, unix.FAN_MARK_ADD|unix.FAN_MARK_FILESYSTEM, fanotifyMask, unix.AT_FDCWD, root); err != nil {
+		unix.Close(fd)
+		// Filesystems without file handles can't report them.
+		if errors.Is(err, unix.EXDEV) || errors.Is(err, unix.ENODEV) || errors.Is(err, unix.EOPNOTSUPP) || errors.Is(err, unix.EINVAL) {
+			return nil, ErrChangeJournalUnsupported
+		}
+		return nil, fmt.Errorf("fanotify_mark: %w", err)
+	}
+	// Handles are resolved through the root, which open_by_handle_at doesn't
+	// accept as an O_PATH descriptor.
+	rootFd, err := unix.Open(root, unix.O_RDONLY|unix.O_DIRECTORY|unix.O_CLOEXEC, 0)
+	if err != nil {
+		unix.Close(fd)
+		return nil, err
+	}
+	// Directories resolve to their path as seen through the root's mount,
+	// which may differ from the configured one.
+	resolved, err := os.Readlink("/proc/self/fd/" + strconv.Itoa(rootFd))
+	if err != nil {
+		unix.Close(fd)
+		unix.Close(rootFd)
+		return nil, err
+	}
+
+	j := &fanotifyJournal{
+		events:   os.NewFile(uintptr(fd), "fanotify"),
+		rootFd:   rootFd,
+		root:     resolved,
+		instance: strconv.FormatUint(rand.Uint64(), 36),
+		pending:  make(map[string]fanotifyChange),
+	}
+	go j.read()
+	return j, nil
+}
+
+func (j *fanotifyJournal) Cursor() (string, error) {
+	j.mut.Lock()
+	defer j.mut.Unlock()
+	j.generation++
+	clear(j.pending)
+	j.overflow = false
+	return j.cursorLocked(), nil
+}
+
+func (j *fanotifyJournal) Changes(cursor string) ([]string, string, error) {
+	j.mut.Lock()
+	if cursor != j.cursorLocked() {
+		j.mut.Unlock()
+		return nil, "", ErrChangeJournalReset
+	}
+	overflow := j.overflow
+	pending := j.pending
+	j.generation++
+	j.pending = make(map[string]fanotifyChange)
+	j.overflow = false
+	next := j.cursorLocked()
+	j.mut.Unlock()
+
+	if overflow {
+		return nil, "", ErrChangeJournalReset
+	}
+
+	dirs := make(map[string]string)
+	var paths []string
+	for key, change := range pending {
+		dirKey := key[:len(key)-len(change.name)]
+		dir, ok := dirs[dirKey]
+		if !ok {
+			// Directories removed since can't be resolved, but their
+			// removal is a change in a parent that still exists.
+			dir, _ = j.resolve(change)
+			dirs[dirKey] = dir
+		}
+		if dir == "" {
+			continue
+		}
+		if rel, ok := j.relative(filepath.Join(dir, change.name)); ok {
+			paths = append(paths, rel)
+		}
+	}
+	return paths, next, nil
+}
+
+func (j *fanotifyJournal) Close() error {
+	err := j.events.Close()
+	unix.Close(j.rootFd)
+	return err
+}
+
+func (j *fanotifyJournal) cursorLocked() string {
+	return j.instance + ":" + strconv.Itoa(j.generation)
+}
+
+// read records the events until the journal is closed.
+func (j *fanotifyJournal) read() {
+	buf := make([]byte, 64<<10)
+	for {
+		n, err := j.events.Read(buf)
+		if err != nil {
+			if !errors.Is(err, os.ErrClosed) {
+				l.Debugln("Reading fanotify events:", err)
+				j.mut.Lock()
+				j.overflow = true
+				j.mut.Unlock()
+			}
+			return
+		}
+		j.mut.Lock()
+		j.recordLocked(buf[:n])
+		j.mut.Unlock()
+	}
+}
+
+// recordLocked parses the events in buf, each a struct
+// fanotify_event_metadata followed by the info records up to its length.
+func (j *fanotifyJournal) recordLocked(buf []byte) {
+	const metadataLen = 24
+	for len(buf) >= metadataLen {
+		eventLen := int(binary.NativeEndian.Uint32(buf[0:]))
+		if eventLen < metadataLen || eventLen > len(buf) || buf[4] != unix.FANOTIFY_METADATA_VERSION {
+			j.overflow = true
+			return
+		}
+		mask := binary.NativeEndian.Uint64(buf[8:])
+		if fd := int32(binary.NativeEndian.Uint32(buf[16:])); fd >= 0 {
+			unix.Close(int(fd))
+		}
+		if mask&unix.FAN_Q_OVERFLOW != 0 {
+			j.overflow = true
+		}
+		j.recordInfoLocked(buf[binary.NativeEndian.Uint16(buf[6:]):eventLen])
+		buf = buf[eventLen:]
+	}
+}
+
+// recordInfoLocked records the change of each directory entry info record,
+// a struct fanotify_event_info_fid with the file handle of the directory
+// followed by the name of the entry.
+func (j *fanotifyJournal) recordInfoLocked(info []byte) {
+	const headerLen = 4 + 8 // info header, fsid
+	const handleHeaderLen = 8
+	for len(info) >= headerLen+handleHeaderLen {
+		infoType, infoLen := info[0], int(binary.NativeEndian.Uint16(info[2:]))
+		if infoLen < headerLen+handleHeaderLen || infoLen > len(info) {
+			return
+		}
+		record := info[headerLen:infoLen]
+		info = info[infoLen:]
+		if infoType != unix.FAN_EVENT_INFO_TYPE_DFID_NAME {
+			continue
+		}
+		handleLen := int(binary.NativeEndian.Uint32(record[0:]))
+		if handleHeaderLen+handleLen > len(record) {
+			continue
+		}
+		change := fanotifyChange{
+			handleType: int32(binary.NativeEndian.Uint32(record[4:])),
+			handle:     record[handleHeaderLen : handleHeaderLen+handleLen],
+		}
+		name := record[handleHeaderLen+handleLen:]
+		if i := bytes.IndexByte(name, 0); i >= 0 {
+			name = name[:i]
+		}
+		change.name = string(name)
+		if change.name == "." || change.name == "" {
+			continue
+		}
+
+		key := fmt.Sprintf("%d:%d:%s", change.handleType, len(change.handle), change.handle) + change.name
+		if _, ok := j.pending[key]; ok {
+			continue
+		}
+		if len(j.pending) >= maxChangeJournalChanges {
+			j.overflow = true
+			continue
+		}
+		change.handle = bytes.Clone(change.handle)
+		j.pending[key] = change
+	}
+}
+
+// resolve returns the path of the directory of the change.
+func (j *fanotifyJournal) resolve(change fanotifyChange) (string, error) {
+	fd, err := unix.OpenByHandleAt(j.rootFd, unix.NewFileHandle(change.handleType, change.handle), unix.O_PATH|unix.O_CLOEXEC)
+	if err != nil {
+		return "", err
+	}
+	defer unix.Close(fd)
+	return os.Readlink("/proc/self/fd/" + strconv.Itoa(fd))
+}
+
+// relative returns the path relative to the root, if it's within it.
+func (j *fanotifyJournal) relative(path string) (string, bool) {
+	rel, err := filepath.Rel(j.root, path)
+	if err != nil || rel == "." || !filepath.IsLocal(rel) {
+		return "", false
+	}
+	return rel, true
+}
@@ -0,0 +1,71 @@
+// Copyright (C) 2026 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package fs
+
+import (
+	"errors"
+	"path/filepath"
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestChangeJournal(t *testing.T) {
+	dir := t.TempDir()
+	ffs := NewFilesystem(FilesystemTypeBasic, dir)
+	if err := ffs.MkdirAll("a/b", 0o755); err != nil {
+		t.Fatal(err)
+	}
+
+	journal, err := OpenChangeJournal(ffs)
+	if errors.Is(err, ErrChangeJournalUnsupported) {
+		t.Skip(err)
+	} else if err != nil {
+		t.Fatal(err)
+	}
+	defer journal.Close()
+
+	cursor, err := journal.Cursor()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	fd, err := ffs.Create(filepath.Join("a", "b", "file"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	fd.Close()
+	if err := ffs.Mkdir("c", 0o755); err != nil {
+		t.Fatal(err)
+	}
+
+	// The events are recorded asynchronously.
+	var changes []string
+	for deadline := time.Now().Add(5 * time.Second); time.Now().Before(deadline); time.Sleep(50 * time.Millisecond) {
+		var paths []string
+		paths, cursor, err = journal.Changes(cursor)
+		if err != nil {
+			t.Fatal(err)
+		}
+		changes = append(changes, paths...)
+		if slices.Contains(changes, filepath.Join("a", "b", "file")) && slices.Contains(changes, "c") {
+			break
+		}
+	}
+	if !slices.Contains(changes, filepath.Join("a", "b", "file")) || !slices.Contains(changes, "c") {
+		t.Fatalf("unexpected changes %v", changes)
+	}
+
+	// An outdated cursor is reset.
+	if _, _, err := journal.Changes(cursor + "0"); !errors.Is(err, ErrChangeJournalReset) {
+		t.Errorf("got %v for an unknown cursor, expected reset", err)
+	}
+
+	if _, err := OpenChangeJournal(NewFilesystem(FilesystemTypeFake, "/x")); !errors.Is(err, ErrChangeJournalUnsupported) {
+		t.Errorf("got %v for a fake filesystem, expected unsupported", err)
+	}
+}
@@ -0,0 +1,267 @@
+// Copyright (C) 2026 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+//go:build windows
+// +build windows
+
+package fs
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+)
+
+// https://learn.microsoft.com/windows/win32/api/winioctl/ni-winioctl-fsctl_query_usn_journal
+// https://learn.microsoft.com/windows/win32/api/winioctl/ni-winioctl-fsctl_read_usn_journal
+const (
+	fsctlQueryUsnJournal = 0x000900f4
+	fsctlReadUsnJournal  = 0x000900bb
+)
+
+var procOpenFileByID = windows.NewLazySystemDLL("kernel32.dll").NewProc("OpenFileById")
+
+// usnJournalData = USN_JOURNAL_DATA_V0
+type usnJournalData struct {
+	UsnJournalID    uint64
+	FirstUsn        int64
+	NextUsn         int64
+	LowestValidUsn  int64
+	MaxUsn          int64
+	MaximumSize     uint64
+	AllocationDelta uint64
+}
+
+// readUsnJournalData = READ_USN_JOURNAL_DATA_V0
+type readUsnJournalData struct {
+	StartUsn          int64
+	ReasonMask        uint32
+	ReturnOnlyOnClose uint32
+	Timeout           uint64
+	BytesToWaitFor    uint64
+	UsnJournalID      uint64
+}
+
+// fileIDDescriptor = FILE_ID_DESCRIPTOR, for a 64 bit file ID
+type fileIDDescriptor struct {
+	Size   uint32
+	Type   uint32
+	FileID uint64
+	_      [8]byte
+}
+
+// usnJournal reads the changes from the USN journal of the NTFS volume
+// holding the root, which records them by the file reference number of
+// the parent directory and the name of the changed entry. The journal is
+// kept by the system, so cursors remain valid across restarts until it's
+// recreated or truncated beyond them. Reading it needs administrator
+// privileges.
+type usnJournal struct {
+	volume windows.Handle
+	root   string
+}
+
+func openChangeJournal(root string) (ChangeJournal, error) {
+	root, err := filepath.Abs(root)
+	if err != nil {
+		return nil, err
+	}
+	volumeName := filepath.VolumeName(root)
+	if len(volumeName) != 2 || volumeName[1] != ':' {
+		// Network shares have no journal we can read.
+		return nil, ErrChangeJournalUnsupported
+	}
+	volumePath, err := windows.UTF16PtrFromString(`\\.\` + volumeName)
+	if err != nil {
+		return nil, err
+	}
+	volume, err := windows.CreateFile(volumePath, windows.GENERIC_READ, windows.FILE_SHARE_READ|windows.FILE_SHARE_WRITE, nil, windows.OPEN_EXISTING, 0, 0)
+	if err != nil {
+		if errors.Is(err, windows.ERROR_ACCESS_DENIED) {
+			return nil, ErrChangeJournalUnsupported
+		}
+		return nil, err
+	}
+	j := &usnJournal{volume: volume}
+	if _, err := j.query(); err != nil {
+		windows.CloseHandle(volume)
+		return nil, ErrChangeJournalUnsupported
+	}
+
+	// Directories resolve to their final path, which may differ from the
+	// configured one.
+	rootPath, err := windows.UTF16PtrFromString(root)
+	if err != nil {
+		windows.CloseHandle(volume)
+		return nil, err
+	}
+	h, err := windows.CreateFile(rootPath, 0, windows.FILE_SHARE_READ|windows.FILE_SHARE_WRITE|windows.FILE_SHARE_DELETE, nil, windows.OPEN_EXISTING, windows.FILE_FLAG_BACKUP_SEMANTICS, 0)
+	if err != nil {
+		windows.CloseHandle(volume)
+		return nil, err
+	}
+	defer windows.CloseHandle(h)
+	if j.root, err = finalPath(h); err != nil {
+		windows.CloseHandle(volume)
+		return nil, err
+	}
+	return j, nil
+}
+
+func (j *usnJournal) Cursor() (string, error) {
+	data, err := j.query()
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%x:%d", data.UsnJournalID, data.NextUsn), nil
+}
+
+func (j *usnJournal) Changes(cursor string) ([]string, string, error) {
+	var id uint64
+	var usn int64
+	if _, err := fmt.Sscanf(cursor, "%x:%d", &id, &usn); err != nil {
+		return nil, "", ErrChangeJournalReset
+	}
+	data, err := j.query()
+	if err != nil {
+		return nil, "", err
+	}
+	if data.UsnJournalID != id || usn < data.FirstUsn || usn < data.LowestValidUsn {
+		return nil, "", ErrChangeJournalReset
+	}
+
+	// Entries changed, by parent directory reference and name.
+	changed := make(map[uint64]map[string]struct{})
+	count := 0
+	buf := make([]byte, 64<<10)
+	for usn < data.NextUsn {
+		req := readUsnJournalData{
+			StartUsn:     usn,
+			ReasonMask:   0xffffffff,
+			UsnJournalID: id,
+		}
+		var n uint32
+		if err := windows.DeviceIoControl(j.volume, fsctlReadUsnJournal, (*byte)(unsafe.Pointer(&req)), uint32(unsafe.Sizeof(req)), &buf[0], uint32(len(buf)), &n, nil); err != nil {
+			if errors.Is(err, windows.ERROR_JOURNAL_ENTRY_DELETED) || errors.Is(err, windows.ERROR_JOURNAL_DELETE_IN_PROGRESS) {
+				return nil, "", ErrChangeJournalReset
+			}
+			return nil, "", err
+		}
+		if n < 8 {
+			break
+		}
+		next := int64(binary.LittleEndian.Uint64(buf))
+		records := buf[8:n]
+		for len(records) >= 60 {
+			recordLen := int(binary.LittleEndian.Uint32(records))
+			if recordLen < 60 || recordLen > len(records) {
+				return nil, "", fmt.Errorf("invalid USN record of %d bytes", recordLen)
+			}
+			// Only USN_RECORD_V2 has the 64 bit file references of NTFS.
+			if major := binary.LittleEndian.Uint16(records[4:]); major != 2 {
+				return nil, "", fmt.Errorf("unsupported USN record version %d", major)
+			}
+			parent := binary.LittleEndian.Uint64(records[16:])
+			nameLen := int(binary.LittleEndian.Uint16(records[56:]))
+			nameOffset := int(binary.LittleEndian.Uint16(records[58:]))
+			if nameOffset+nameLen > recordLen {
+				return nil, "", fmt.Errorf("invalid USN record name at %d", nameOffset)
+			}
+			name := make([]uint16, nameLen/2)
+			for i := range name {
+				name[i] = binary.LittleEndian.Uint16(records[nameOffset+2*i:])
+			}
+			names, ok := changed[parent]
+			if !ok {
+				names = make(map[string]struct{})
+				changed[parent] = names
+			}
+			if nameStr := windows.UTF16ToString(name); nameStr != "" {
+				if _, ok := names[nameStr]; !ok {
+					names[nameStr] = struct{}{}
+					if count++; count > maxChangeJournalChanges {
+						return nil, "", ErrChangeJournalReset
+					}
+				}
+			}
+			records = records[recordLen:]
+		}
+		if next <= usn {
+			break
+		}
+		usn = next
+	}
+
+	var paths []string
+	for parent, names := range changed {
+		// Directories removed since can't be resolved, but their removal
+		// is a change in a parent that still exists.
+		dir, err := j.resolve(parent)
+		if err != nil {
+			continue
+		}
+		for name := range names {
+			if rel, ok := j.relative(dir + `\` + name); ok {
+				paths = append(paths, rel)
+			}
+		}
+	}
+	return paths, fmt.Sprintf("%x:%d", id, usn), nil
+}
+
+func (j *usnJournal) Close() error {
+	return windows.CloseHandle(j.volume)
+}
+
+func (j *usnJournal) query() (usnJournalData, error) {
+	var data usnJournalData
+	var n uint32
+	err := windows.DeviceIoControl(j.volume, fsctlQueryUsnJournal, nil, 0, (*byte)(unsafe.Pointer(&data)), uint32(unsafe.Sizeof(data)), &n, nil)
+	return data, err
+}
+
+// resolve returns the path of the directory with the file reference.
+func (j *usnJournal) resolve(ref uint64) (string, error) {
+	desc := fileIDDescriptor{
+		Size:   uint32(unsafe.Sizeof(fileIDDescriptor{})),
+		FileID: ref,
+	}
+	r, _, err := procOpenFileByID.Call(uintptr(j.volume), uintptr(unsafe.Pointer(&desc)), 0,
+		uintptr(windows.FILE_SHARE_READ|windows.FILE_SHARE_WRITE|windows.FILE_SHARE_DELETE), 0, uintptr(windows.FILE_FLAG_BACKUP_SEMANTICS))
+	h := windows.Handle(r)
+	if h == windows.InvalidHandle {
+		return "", err
+	}
+	defer windows.CloseHandle(h)
+	return finalPath(h)
+}
+
+// relative returns the path relative to the root, if it's within it.
+func (j *usnJournal) relative(path string) (string, bool) {
+	if len(path) <= len(j.root)+1 || path[len(j.root)] != '\\' || !strings.EqualFold(path[:len(j.root)], j.root) {
+		return "", false
+	}
+	return path[len(j.root)+1:], true
+}
+
+// finalPath returns the normalized path of the handle, without the \\?\
+// prefix.
+func finalPath(h windows.Handle) (string, error) {
+	buf := make([]uint16, windows.MAX_LONG_PATH)
+	n, err := windows.GetFinalPathNameByHandle(h, &buf[0], uint32(len(buf)), 0)
+	if err != nil {
+		return "", err
+	}
+	if int(n) > len(buf) {
+		return "", windows.ERROR_NOT_ENOUGH_MEMORY
+	}
+	return strings.TrimPrefix(windows.UTF16ToString(buf[:n]), `\\?\`), nil
+}
@@ -30,6 +30,7 @@ const (
 	filesystemWrapperTypeWalk
 	filesystemWrapperTypeLog
 	filesystemWrapperTypeMetrics
+	filesystemWrapperTypeTranslate
 )
 
 type XattrFilter interface {
@@ -229,16 +230,19 @@ type Option interface {
 func NewFilesystem(fsType FilesystemType, uri string, opts ...Option) Filesystem {
 	var caseOpt Option
 	var mtimeOpt Option
+	var normOpt Option
+	var windowsNamesOpt Option
 	i := 0
 	for _, opt := range opts {
-		if caseOpt != nil && mtimeOpt != nil {
-			break
-		}
 		switch opt.(type) {
 		case *OptionDetectCaseConflicts:
 			caseOpt = opt
 		case *optionMtime:
 			mtimeOpt = opt
+		case *OptionUnicodeNormalization:
+			normOpt = opt
+		case *OptionEscapeWindowsNames, *optionWindowsNameMap:
+			windowsNamesOpt = opt
 		default:
 			opts[i] = opt
 			i++
@@ -261,6 +265,16 @@ func NewFilesystem(fsType FilesystemType, uri string, opts ...Option) Filesystem
 		}
 	}
 
+	// Names are translated right above the actual filesystem, so that all
+	// other layers see the native normalization form and the original,
+	// unescaped names.
+	if normOpt != nil {
+		fs = normOpt.apply(fs)
+	}
+	if windowsNamesOpt != nil {
+		fs = windowsNamesOpt.apply(fs)
+	}
+
 	// mtime handling should happen inside walking, as filesystem calls while
 	// walking should be mtime-resolved too
 	if mtimeOpt != nil {
@@ -304,9 +318,11 @@ func NewFilesystem(fsType FilesystemType, uri string, opts ...Option) Filesystem
 // root, represents an internal file that should always be ignored. The file
 // path must be clean (i.e., in canonical shortest form).
 func IsInternal(file string) bool {
-	// fs cannot import config or versioner, so we hard code .stfolder
-	// (config.DefaultMarkerName) and .stversions (versioner.DefaultPath)
-	internals := []string{".stfolder", ".stignore", ".stversions"}
+	// fs cannot import config, versioner or model, so we hard code
+	// .stfolder (config.DefaultMarkerName), .stversions
+	// (versioner.DefaultPath) and .stcdp (the continuous protection
+	// snapshots)
+	internals := []string{".stfolder", ".stignore", ".stversions", ".stcdp"}
 	for _, internal := range internals {
 		if file == internal {
 			return true
@@ -26,6 +26,8 @@ func TestIsInternal(t *testing.T) {
 		{".stfolder/foo", true},
 		{".stignore/foo", true},
 		{".stversions/foo", true},
+		{".stcdp", true},
+		{".stcdp/foo", true},
 
 		{".stfolderfoo", false},
 		{".stignorefoo", false},
@@ -36,6 +38,7 @@ func TestIsInternal(t *testing.T) {
 		{"foo/.stfolder", false},
 		{"foo/.stignore", false},
 		{"foo/.stversions", false},
+		{"foo/.stcdp", false},
 	}
 
 	for _, tc := range cases {
@@ -0,0 +1,64 @@
+// Copyright (C) 2026 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package fs
+
+import (
+	"fmt"
+	"time"
+)
+
+// The modification time precisions we expect to encounter, from NTFS (100
+// ns) over exFAT (10 ms) and HFS+ (1 s) to FAT (2 s). Filesystems with
+// nanosecond precision are reported as zero.
+var modTimePrecisions = []time.Duration{
+	100 * time.Nanosecond,
+	time.Microsecond,
+	time.Millisecond,
+	10 * time.Millisecond,
+	time.Second,
+	2 * time.Second,
+}
+
+// A time with an odd number of seconds and all of the sub second digits
+// set, so that any rounding or truncation shows.
+var modTimeProbe = time.Date(2020, 2, 20, 20, 20, 21, 123456789, time.UTC)
+
+// DetectModTimePrecision returns the precision with which the filesystem
+// stores modification times, by setting the modification time of a
+// temporary file in the root and reading it back. Zero means nanosecond
+// precision.
+func DetectModTimePrecision(filesystem Filesystem) (time.Duration, error) {
+	name := TempName("mtime-precision")
+	fd, err := filesystem.Create(name)
+	if err != nil {
+		return 0, err
+	}
+	fd.Close()
+	defer filesystem.Remove(name)
+
+	if err := filesystem.Chtimes(name, modTimeProbe, modTimeProbe); err != nil {
+		return 0, err
+	}
+	info, err := filesystem.Lstat(name)
+	if err != nil {
+		return 0, err
+	}
+
+	diff := info.ModTime().Sub(modTimeProbe)
+	if diff < 0 {
+		diff = -diff
+	}
+	if diff == 0 {
+		return 0, nil
+	}
+	for _, precision := range modTimePrecisions {
+		if diff < precision {
+			return precision, nil
+		}
+	}
+	return 0, fmt.Errorf("unexpected modification time %v, set to %v", info.ModTime(), modTimeProbe)
+}
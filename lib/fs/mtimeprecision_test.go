@@ -0,0 +1,38 @@
+// Copyright (C) 2026 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package fs
+
+import (
+	"testing"
+	"time"
+)
+
+func TestDetectModTimePrecision(t *testing.T) {
+	cases := []struct {
+		uri       string
+		precision time.Duration
+	}{
+		{"?nostfolder=true", 0},
+		{"?nostfolder=true&timeprecisionsecond=true", time.Second},
+	}
+
+	for _, tc := range cases {
+		ffs := NewFilesystem(FilesystemTypeFake, t.Name()+tc.uri)
+		precision, err := DetectModTimePrecision(ffs)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if precision != tc.precision {
+			t.Errorf("%s: detected precision %v, expected %v", tc.uri, precision, tc.precision)
+		}
+
+		// The probe file is removed
+		if names, err := ffs.DirNames("."); err != nil || len(names) != 0 {
+			t.Errorf("%s: unexpected files left behind: %v, %v", tc.uri, names, err)
+		}
+	}
+}
@@ -0,0 +1,61 @@
+// Copyright (C) 2026 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package fs
+
+import (
+	"golang.org/x/text/unicode/norm"
+
+	"github.com/syncthing/syncthing/lib/build"
+)
+
+// OptionUnicodeNormalization makes the filesystem store file names on disk
+// in the given Unicode normalization form, regardless of the form native to
+// the operating system. Towards the caller all names are in the native form
+// (NFD on macOS, NFC elsewhere), like everywhere else in Syncthing. Existing
+// files are not renamed; names that are not in the given form on disk are
+// not accessible through this filesystem.
+type OptionUnicodeNormalization struct {
+	Form norm.Form
+}
+
+func (o *OptionUnicodeNormalization) apply(fs Filesystem) Filesystem {
+	return newTranslateFilesystem(fs, o, normTranslator{o.Form})
+}
+
+func (o *OptionUnicodeNormalization) String() string {
+	switch o.Form {
+	case norm.NFC:
+		return "unicodeNormalization=nfc"
+	case norm.NFD:
+		return "unicodeNormalization=nfd"
+	default:
+		return "unicodeNormalization=unknown"
+	}
+}
+
+// NativeNormalization returns the Unicode normalization form used for file
+// names on this operating system.
+func NativeNormalization() norm.Form {
+	if build.IsDarwin || build.IsIOS {
+		return norm.NFD
+	}
+	return norm.NFC
+}
+
+// normTranslator translates names between the native normalization form
+// and the one used on disk.
+type normTranslator struct {
+	form norm.Form
+}
+
+func (t normTranslator) toDisk(name string) string {
+	return t.form.String(name)
+}
+
+func (normTranslator) fromDisk(name string) string {
+	return NativeNormalization().String(name)
+}
@@ -0,0 +1,53 @@
+// Copyright (C) 2026 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package fs
+
+import (
+	"path/filepath"
+	"testing"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+func TestNormFS(t *testing.T) {
+	const (
+		dir  = "dir-\xC3\x84"
+		file = "file-\xC3\x84"
+	)
+	native := NativeNormalization()
+
+	// The fake filesystem is normalization sensitive, like most Linux
+	// filesystems.
+	disk := NewFilesystem(FilesystemTypeFake, t.Name())
+	for _, form := range []norm.Form{norm.NFC, norm.NFD} {
+		ffs := NewFilesystem(FilesystemTypeFake, t.Name(), &OptionUnicodeNormalization{Form: form})
+		if err := ffs.Mkdir(native.String(dir), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := WriteFile(ffs, native.String(filepath.Join(dir, file)), []byte("hello"), 0o644); err != nil {
+			t.Fatal(err)
+		}
+
+		// The file is stored in the configured form on disk...
+		if _, err := disk.Lstat(form.String(filepath.Join(dir, file))); err != nil {
+			t.Errorf("%v: file not stored in the configured form: %v", ffs.Options(), err)
+		}
+
+		// ...and seen in the native form through the filesystem.
+		names, err := ffs.DirNames(native.String(dir))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(names) != 1 || names[0] != native.String(file) {
+			t.Errorf("%v: unexpected names %q", ffs.Options(), names)
+		}
+
+		if err := disk.RemoveAll(form.String(dir)); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
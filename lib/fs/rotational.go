@@ -0,0 +1,21 @@
+// Copyright (C) 2026 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package fs
+
+import "errors"
+
+var errRotationalUnknown = errors.New("storage type detection not supported")
+
+// DetectRotational returns whether the filesystem is stored on a rotational
+// disk, where concurrent reads cause seeking, as opposed to solid state
+// storage. It's only known for basic filesystems on some platforms.
+func DetectRotational(filesystem Filesystem) (bool, error) {
+	if filesystem.Type() != FilesystemTypeBasic {
+		return false, errRotationalUnknown
+	}
+	return detectRotational(filesystem.URI())
+}
@@ -0,0 +1,40 @@
+// Copyright (C) 2026 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+//go:build linux
+// +build linux
+
+package fs
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+
+	"golang.org/x/sys/unix"
+)
+
+// detectRotational looks up the block device holding the root in sysfs.
+// Partitions don't have a queue of their own, it's the one of the disk
+// they're on.
+func detectRotational(root string) (bool, error) {
+	var st unix.Stat_t
+	if err := unix.Stat(root, &st); err != nil {
+		return false, err
+	}
+	dev := fmt.Sprintf("/sys/dev/block/%d:%d", unix.Major(st.Dev), unix.Minor(st.Dev))
+	bs, err := os.ReadFile(dev + "/queue/rotational")
+	if os.IsNotExist(err) {
+		bs, err = os.ReadFile(dev + "/../queue/rotational")
+	}
+	if os.IsNotExist(err) {
+		// Not a block device, e.g. a network or virtual filesystem.
+		return false, errRotationalUnknown
+	} else if err != nil {
+		return false, err
+	}
+	return string(bytes.TrimSpace(bs)) == "1", nil
+}
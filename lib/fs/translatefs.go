@@ -0,0 +1,204 @@
+// Copyright (C) 2026 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package fs
+
+import (
+	"context"
+	"time"
+
+	"github.com/syncthing/syncthing/lib/protocol"
+)
+
+// A nameTranslator translates file names between the form used by the
+// caller and the one stored on disk.
+type nameTranslator interface {
+	toDisk(name string) string
+	fromDisk(name string) string
+}
+
+// translateFilesystem translates all names passing through it using a
+// nameTranslator.
+type translateFilesystem struct {
+	Filesystem
+	option     Option
+	translator nameTranslator
+}
+
+func newTranslateFilesystem(fs Filesystem, option Option, translator nameTranslator) *translateFilesystem {
+	return &translateFilesystem{
+		Filesystem: fs,
+		option:     option,
+		translator: translator,
+	}
+}
+
+func (f *translateFilesystem) toDisk(name string) string {
+	return f.translator.toDisk(name)
+}
+
+func (f *translateFilesystem) fromDisk(name string) string {
+	return f.translator.fromDisk(name)
+}
+
+func (f *translateFilesystem) Chmod(name string, mode FileMode) error {
+	return f.Filesystem.Chmod(f.toDisk(name), mode)
+}
+
+func (f *translateFilesystem) Lchown(name, uid, gid string) error {
+	return f.Filesystem.Lchown(f.toDisk(name), uid, gid)
+}
+
+func (f *translateFilesystem) Chtimes(name string, atime time.Time, mtime time.Time) error {
+	return f.Filesystem.Chtimes(f.toDisk(name), atime, mtime)
+}
+
+func (f *translateFilesystem) Create(name string) (File, error) {
+	return f.Filesystem.Create(f.toDisk(name))
+}
+
+func (f *translateFilesystem) CreateSymlink(target, name string) error {
+	return f.Filesystem.CreateSymlink(target, f.toDisk(name))
+}
+
+func (f *translateFilesystem) DirNames(name string) ([]string, error) {
+	names, err := f.Filesystem.DirNames(f.toDisk(name))
+	if err != nil {
+		return nil, err
+	}
+	// If several names on disk translate to the same name, only one of
+	// them is accessible and returned.
+	seen := make(map[string]struct{}, len(names))
+	res := names[:0]
+	for _, n := range names {
+		n = f.fromDisk(n)
+		if _, ok := seen[n]; ok {
+			continue
+		}
+		seen[n] = struct{}{}
+		res = append(res, n)
+	}
+	return res, nil
+}
+
+func (f *translateFilesystem) Lstat(name string) (FileInfo, error) {
+	return f.Filesystem.Lstat(f.toDisk(name))
+}
+
+func (f *translateFilesystem) Mkdir(name string, perm FileMode) error {
+	return f.Filesystem.Mkdir(f.toDisk(name), perm)
+}
+
+func (f *translateFilesystem) MkdirAll(path string, perm FileMode) error {
+	return f.Filesystem.MkdirAll(f.toDisk(path), perm)
+}
+
+func (f *translateFilesystem) Open(name string) (File, error) {
+	return f.Filesystem.Open(f.toDisk(name))
+}
+
+func (f *translateFilesystem) OpenFile(name string, flags int, mode FileMode) (File, error) {
+	return f.Filesystem.OpenFile(f.toDisk(name), flags, mode)
+}
+
+func (f *translateFilesystem) ReadSymlink(name string) (string, error) {
+	return f.Filesystem.ReadSymlink(f.toDisk(name))
+}
+
+func (f *translateFilesystem) Remove(name string) error {
+	return f.Filesystem.Remove(f.toDisk(name))
+}
+
+func (f *translateFilesystem) RemoveAll(name string) error {
+	return f.Filesystem.RemoveAll(f.toDisk(name))
+}
+
+func (f *translateFilesystem) Rename(oldname, newname string) error {
+	return f.Filesystem.Rename(f.toDisk(oldname), f.toDisk(newname))
+}
+
+func (f *translateFilesystem) Stat(name string) (FileInfo, error) {
+	return f.Filesystem.Stat(f.toDisk(name))
+}
+
+func (f *translateFilesystem) Walk(name string, walkFn WalkFunc) error {
+	return f.Filesystem.Walk(f.toDisk(name), func(path string, info FileInfo, err error) error {
+		return walkFn(f.fromDisk(path), info, err)
+	})
+}
+
+func (f *translateFilesystem) Watch(path string, ignore Matcher, ctx context.Context, ignorePerms bool) (<-chan Event, <-chan error, error) {
+	events, errs, err := f.Filesystem.Watch(f.toDisk(path), ignore, ctx, ignorePerms)
+	if err != nil {
+		return nil, nil, err
+	}
+	outChan := make(chan Event)
+	go func() {
+		defer close(outChan)
+		for {
+			select {
+			case ev, ok := <-events:
+				if !ok {
+					return
+				}
+				ev.Name = f.fromDisk(ev.Name)
+				select {
+				case outChan <- ev:
+				case <-ctx.Done():
+					return
+				}
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return outChan, errs, nil
+}
+
+func (f *translateFilesystem) Hide(name string) error {
+	return f.Filesystem.Hide(f.toDisk(name))
+}
+
+func (f *translateFilesystem) Unhide(name string) error {
+	return f.Filesystem.Unhide(f.toDisk(name))
+}
+
+func (f *translateFilesystem) Glob(pattern string) ([]string, error) {
+	names, err := f.Filesystem.Glob(f.toDisk(pattern))
+	for i := range names {
+		names[i] = f.fromDisk(names[i])
+	}
+	return names, err
+}
+
+func (f *translateFilesystem) Usage(name string) (Usage, error) {
+	return f.Filesystem.Usage(f.toDisk(name))
+}
+
+func (f *translateFilesystem) PlatformData(name string, withOwnership, withXattrs bool, xattrFilter XattrFilter) (protocol.PlatformData, error) {
+	return f.Filesystem.PlatformData(f.toDisk(name), withOwnership, withXattrs, xattrFilter)
+}
+
+func (f *translateFilesystem) GetXattr(name string, xattrFilter XattrFilter) ([]protocol.Xattr, error) {
+	return f.Filesystem.GetXattr(f.toDisk(name), xattrFilter)
+}
+
+func (f *translateFilesystem) SetXattr(path string, xattrs []protocol.Xattr, xattrFilter XattrFilter) error {
+	return f.Filesystem.SetXattr(f.toDisk(path), xattrs, xattrFilter)
+}
+
+func (f *translateFilesystem) Options() []Option {
+	opts := append([]Option{}, f.Filesystem.Options()...)
+	return append(opts, f.option)
+}
+
+func (f *translateFilesystem) underlying() (Filesystem, bool) {
+	return f.Filesystem, true
+}
+
+func (*translateFilesystem) wrapperType() filesystemWrapperType {
+	return filesystemWrapperTypeTranslate
+}
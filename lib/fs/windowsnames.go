@@ -0,0 +1,186 @@
+// Copyright (C) 2026 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package fs
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+	"unicode/utf8"
+)
+
+// Characters that are invalid in Windows file names are escaped by mapping
+// them into the Unicode private use area, the same way as done by Cygwin
+// and the Windows Services for UNIX. This makes the escaping reversible and
+// interoperable with those tools.
+const windowsEscapeBase = 0xf000
+
+// OptionEscapeWindowsNames makes names that are invalid on Windows (reserved
+// characters, reserved names such as CON or NUL, trailing spaces or periods)
+// usable by storing them on disk in an escaped form. Offending characters
+// are replaced with characters from the Unicode private use area, which are
+// translated back when reading names from disk.
+type OptionEscapeWindowsNames struct{}
+
+func (o *OptionEscapeWindowsNames) apply(fs Filesystem) Filesystem {
+	return newTranslateFilesystem(fs, o, windowsEscapeTranslator{})
+}
+
+func (*OptionEscapeWindowsNames) String() string {
+	return "escapeWindowsNames"
+}
+
+type windowsEscapeTranslator struct{}
+
+func (windowsEscapeTranslator) toDisk(name string) string {
+	return translateComponents(name, escapeWindowsName)
+}
+
+func (windowsEscapeTranslator) fromDisk(name string) string {
+	return translateComponents(name, unescapeWindowsName)
+}
+
+func escapeWindowsName(part string) string {
+	if WindowsInvalidFilename(part) == nil {
+		return part
+	}
+
+	var b strings.Builder
+	b.Grow(len(part) + 8)
+	for _, c := range part {
+		if c < utf8.RuneSelf && strings.ContainsRune(windowsDisallowedCharacters, c) {
+			c += windowsEscapeBase
+		}
+		b.WriteRune(c)
+	}
+	escaped := b.String()
+
+	// Escaping the first character is enough to make the name no longer
+	// match a reserved name.
+	if windowsReservedNamePart(escaped) != "" {
+		escaped = string(rune(escaped[0])+windowsEscapeBase) + escaped[1:]
+	}
+
+	// Trailing spaces and periods, including any preceding ones as
+	// Windows strips them all.
+	trimmed := strings.TrimRight(escaped, " .")
+	if len(trimmed) < len(escaped) {
+		b.Reset()
+		b.WriteString(trimmed)
+		for _, c := range escaped[len(trimmed):] {
+			b.WriteRune(c + windowsEscapeBase)
+		}
+		escaped = b.String()
+	}
+
+	return escaped
+}
+
+func unescapeWindowsName(part string) string {
+	// All escaped characters are three byte UTF-8 sequences starting with
+	// 0xef, which is a cheap check to skip the common case.
+	if strings.IndexByte(part, 0xef) == -1 {
+		return part
+	}
+	var b strings.Builder
+	b.Grow(len(part))
+	for _, c := range part {
+		if c >= windowsEscapeBase && c < windowsEscapeBase+utf8.RuneSelf {
+			c -= windowsEscapeBase
+		}
+		b.WriteRune(c)
+	}
+	return b.String()
+}
+
+// NewWindowsNameMapOption makes names that are invalid on Windows usable by
+// storing them on disk under an alternate, valid name. The alternate name
+// is derived from the original name by replacing invalid characters and
+// appending a short hash, and the mapping back to the original name is kept
+// in the given database.
+func NewWindowsNameMapOption(db database) Option {
+	return &optionWindowsNameMap{db: db}
+}
+
+type optionWindowsNameMap struct {
+	db database
+}
+
+func (o *optionWindowsNameMap) apply(fs Filesystem) Filesystem {
+	return newTranslateFilesystem(fs, o, windowsNameMapTranslator{db: o.db})
+}
+
+func (*optionWindowsNameMap) String() string {
+	return "mapWindowsNames"
+}
+
+type windowsNameMapTranslator struct {
+	db database
+}
+
+func (t windowsNameMapTranslator) toDisk(name string) string {
+	return translateComponents(name, func(part string) string {
+		if WindowsInvalidFilename(part) == nil {
+			return part
+		}
+		alt := windowsAlternateName(part)
+		if cur, ok, err := t.db.Bytes(alt); err != nil || !ok || string(cur) != part {
+			// Errors are not fatal here; the file is still accessible
+			// under its alternate name.
+			_ = t.db.PutBytes(alt, []byte(part))
+		}
+		return alt
+	})
+}
+
+func (t windowsNameMapTranslator) fromDisk(name string) string {
+	return translateComponents(name, func(part string) string {
+		if !strings.Contains(part, windowsAlternateMarker) {
+			return part
+		}
+		if orig, ok, err := t.db.Bytes(part); err == nil && ok {
+			return string(orig)
+		}
+		return part
+	})
+}
+
+const windowsAlternateMarker = "~st"
+
+// windowsAlternateName returns a valid name to use on disk for a name that
+// is invalid on Windows, e.g. "CON~st1a2b3c.txt" for "CON.txt".
+func windowsAlternateName(part string) string {
+	hash := sha256.Sum256([]byte(part))
+	suffix := windowsAlternateMarker + hex.EncodeToString(hash[:3])
+
+	var b strings.Builder
+	for _, c := range strings.TrimRight(part, " .") {
+		if c < utf8.RuneSelf && strings.ContainsRune(windowsDisallowedCharacters, c) {
+			c = '_'
+		}
+		b.WriteRune(c)
+	}
+	alt := b.String()
+
+	// The suffix goes before the extension(s), which both keeps the file
+	// associated with the right application and breaks up reserved names.
+	if dot := strings.IndexByte(alt, '.'); dot > 0 {
+		return alt[:dot] + suffix + alt[dot:]
+	}
+	return alt + suffix
+}
+
+// translateComponents applies fn to each path component of name.
+func translateComponents(name string, fn func(string) string) string {
+	parts := strings.Split(name, string(PathSeparator))
+	for i, part := range parts {
+		if part != "" && part != "." && part != ".." {
+			parts[i] = fn(part)
+		}
+	}
+	return strings.Join(parts, string(PathSeparator))
+}
@@ -0,0 +1,113 @@
+// Copyright (C) 2026 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package fs
+
+import (
+	"path/filepath"
+	"testing"
+)
+
+var windowsInvalidNames = []string{
+	"CON",
+	"nul.txt",
+	"a:b",
+	`what?*.txt`,
+	"trailing.",
+	"trailing ",
+	"trailing. .",
+}
+
+func TestEscapeWindowsNames(t *testing.T) {
+	var tr windowsEscapeTranslator
+	for _, name := range windowsInvalidNames {
+		escaped := tr.toDisk(name)
+		if err := WindowsInvalidFilename(escaped); err != nil {
+			t.Errorf("escaped name %q for %q is invalid: %v", escaped, name, err)
+		}
+		if unescaped := tr.fromDisk(escaped); unescaped != name {
+			t.Errorf("%q escaped to %q, which unescapes to %q", name, escaped, unescaped)
+		}
+	}
+
+	// Valid names are left alone.
+	for _, name := range []string{"foo", "CONSOLE.txt", "a.b", filepath.Join("dir", "file")} {
+		if escaped := tr.toDisk(name); escaped != name {
+			t.Errorf("valid name %q escaped to %q", name, escaped)
+		}
+	}
+}
+
+func TestWindowsNameMap(t *testing.T) {
+	tr := windowsNameMapTranslator{db: make(mapStore)}
+	seen := make(map[string]string)
+	for _, name := range windowsInvalidNames {
+		alt := tr.toDisk(name)
+		if err := WindowsInvalidFilename(alt); err != nil {
+			t.Errorf("alternate name %q for %q is invalid: %v", alt, name, err)
+		}
+		if orig, ok := seen[alt]; ok {
+			t.Errorf("%q and %q map to the same alternate name %q", orig, name, alt)
+		}
+		seen[alt] = name
+		if orig := tr.fromDisk(alt); orig != name {
+			t.Errorf("%q mapped to %q, which maps back to %q", name, alt, orig)
+		}
+	}
+
+	// Names that merely look like alternate names are left alone.
+	if name := tr.fromDisk("foo~st123456"); name != "foo~st123456" {
+		t.Errorf("unknown alternate name mapped to %q", name)
+	}
+}
+
+func TestWindowsNamesFS(t *testing.T) {
+	const (
+		dir  = "aux"
+		file = "a:b."
+	)
+
+	uri := t.Name() + "?nostfolder=true"
+	disk := NewFilesystem(FilesystemTypeFake, uri)
+	for _, opt := range []Option{new(OptionEscapeWindowsNames), NewWindowsNameMapOption(make(mapStore))} {
+		ffs := NewFilesystem(FilesystemTypeFake, uri, opt)
+		if err := ffs.Mkdir(dir, 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := WriteFile(ffs, filepath.Join(dir, file), []byte("hello"), 0o644); err != nil {
+			t.Fatal(err)
+		}
+
+		// Only valid names are stored on disk...
+		dirs, err := disk.DirNames(".")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(dirs) != 1 || WindowsInvalidFilename(dirs[0]) != nil {
+			t.Fatalf("%v: unexpected names on disk %q", opt, dirs)
+		}
+		files, err := disk.DirNames(dirs[0])
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(files) != 1 || WindowsInvalidFilename(files[0]) != nil {
+			t.Errorf("%v: unexpected names on disk %q", opt, files)
+		}
+
+		// ...and the original names are seen through the filesystem.
+		names, err := ffs.DirNames(dir)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(names) != 1 || names[0] != file {
+			t.Errorf("%v: unexpected names %q", opt, names)
+		}
+
+		if err := disk.RemoveAll(dirs[0]); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
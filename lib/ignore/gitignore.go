@@ -0,0 +1,211 @@
+// Copyright (C) 2026 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package ignore
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strings"
+
+	"github.com/gobwas/glob"
+
+	"github.com/syncthing/syncthing/lib/fs"
+	"github.com/syncthing/syncthing/lib/ignore/ignoreresult"
+)
+
+// parseGitignoreFile parses the patterns of a file in .gitignore syntax.
+// Unlike ours, it has no includes, no (?i) and (?d) prefixes and no //
+// comments, and leading whitespace is part of the pattern.
+func parseGitignoreFile(fd io.Reader) ([]string, []Pattern, error) {
+	var lines []string
+	var patterns []Pattern
+	scanner := bufio.NewScanner(fd)
+	for scanner.Scan() {
+		line := strings.TrimSuffix(scanner.Text(), "\r")
+		lines = append(lines, line)
+		pattern, ok, err := parseGitignoreLine(line)
+		if err != nil {
+			return lines, nil, fmt.Errorf("invalid pattern %q in ignore file: %w", line, parseError(err))
+		}
+		if ok {
+			patterns = append(patterns, pattern)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, nil, err
+	}
+	return lines, patterns, nil
+}
+
+// parseGitignoreLine returns the pattern of the line, unless it's blank or
+// a comment.
+func parseGitignoreLine(line string) (Pattern, bool, error) {
+	line = trimUnescapedSpaces(line)
+	if line == "" || line[0] == '#' {
+		return Pattern{}, false, nil
+	}
+
+	pattern := Pattern{
+		result: ignoreresult.IgnoreAndSkip,
+	}
+	if line[0] == '!' {
+		pattern.result = ignoreresult.NotIgnored
+		line = line[1:]
+	}
+	pattern.pattern = line
+
+	// A trailing slash only matches directories, and isn't otherwise part
+	// of the pattern.
+	if strings.HasSuffix(line, "/") {
+		pattern.dirOnly = true
+		line = line[:len(line)-1]
+	}
+	if line == "" {
+		return Pattern{}, false, nil
+	}
+
+	// Patterns with a slash at the start or in the middle are relative to
+	// the root, and others match at any level.
+	anchored := strings.Contains(line, "/")
+	line = nativeUnicodeNorm(strings.TrimPrefix(line, "/"))
+	expr, err := gitignoreGlob(line)
+	if err != nil {
+		return Pattern{}, false, err
+	}
+	if !anchored {
+		expr = "{,**/}" + expr
+	}
+	pattern.match, err = glob.Compile(expr, '/')
+	if err != nil {
+		return Pattern{}, false, err
+	}
+	return pattern, true, nil
+}
+
+// trimUnescapedSpaces removes the trailing spaces that aren't escaped with
+// a backslash.
+func trimUnescapedSpaces(line string) string {
+	for strings.HasSuffix(line, " ") {
+		trimmed := line[:len(line)-1]
+		backslashes := len(trimmed) - len(strings.TrimRight(trimmed, `\`))
+		if backslashes%2 == 1 {
+			break
+		}
+		line = trimmed
+	}
+	return line
+}
+
+// gitignoreGlob converts the pattern to the syntax of the glob package. A
+// "**" is only special as a whole path component, where it matches any
+// number of directories including none, and is otherwise a "*".
+func gitignoreGlob(pattern string) (string, error) {
+	var b strings.Builder
+	segments := strings.Split(pattern, "/")
+	for i, seg := range segments {
+		last := i == len(segments)-1
+		if seg == "**" {
+			if last {
+				b.WriteString("**")
+			} else {
+				b.WriteString("{,**/}")
+			}
+			continue
+		}
+		if err := gitignoreSegmentGlob(&b, seg); err != nil {
+			return "", err
+		}
+		if !last {
+			b.WriteByte('/')
+		}
+	}
+	return b.String(), nil
+}
+
+func gitignoreSegmentGlob(b *strings.Builder, seg string) error {
+	for i := 0; i < len(seg); i++ {
+		switch c := seg[i]; c {
+		case '\\':
+			if i+1 == len(seg) {
+				return errors.New("trailing backslash")
+			}
+			b.WriteByte(c)
+			i++
+			b.WriteByte(seg[i])
+		case '*':
+			b.WriteByte('*')
+			for i+1 < len(seg) && seg[i+1] == '*' {
+				i++
+			}
+		case '[':
+			end := strings.IndexByte(seg[i+1:], ']')
+			if end == 0 {
+				// A leading ] is part of the class.
+				end = strings.IndexByte(seg[i+2:], ']') + 1
+			}
+			if end <= 0 {
+				return errors.New("unterminated character class")
+			}
+			class := seg[i+1 : i+1+end]
+			if strings.HasPrefix(class, "^") {
+				class = "!" + class[1:]
+			}
+			b.WriteByte('[')
+			b.WriteString(class)
+			b.WriteByte(']')
+			i += end + 1
+		case '{', '}', ',':
+			b.WriteByte('\\')
+			b.WriteByte(c)
+		default:
+			b.WriteByte(c)
+		}
+	}
+	return nil
+}
+
+// matchGitignoreLocked matches the file the way git does: the last
+// matching pattern decides, and as git doesn't look inside excluded
+// directories, nothing in them can be included again.
+func (m *Matcher) matchGitignoreLocked(file string) ignoreresult.R {
+	for i := strings.IndexByte(file, '/'); i >= 0; {
+		if res := m.lastGitignoreMatchLocked(file[:i], func() bool { return true }); res.IsIgnored() {
+			return res
+		}
+		next := strings.IndexByte(file[i+1:], '/')
+		if next < 0 {
+			break
+		}
+		i += next + 1
+	}
+	return m.lastGitignoreMatchLocked(file, func() bool { return m.isDir(file) })
+}
+
+func (m *Matcher) lastGitignoreMatchLocked(file string, isDir func() bool) ignoreresult.R {
+	for i := len(m.patterns) - 1; i >= 0; i-- {
+		pattern := m.patterns[i]
+		if pattern.match.Match(file) && (!pattern.dirOnly || isDir()) {
+			return pattern.result
+		}
+	}
+	return ignoreresult.NotIgnored
+}
+
+// isDir returns whether the file is a directory, for patterns that only
+// match those. Files that don't exist, as when they are only known from
+// other devices, are taken to be directories, as that's what such
+// patterns are almost always about.
+func (m *Matcher) isDir(file string) bool {
+	info, err := m.fs.Lstat(filepath.FromSlash(file))
+	if err != nil {
+		return fs.IsNotExist(err)
+	}
+	return info.IsDir()
+}
@@ -0,0 +1,119 @@
+// Copyright (C) 2026 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package ignore
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/syncthing/syncthing/lib/fs"
+	"github.com/syncthing/syncthing/lib/rand"
+)
+
+// The results are those of git check-ignore for the same patterns and
+// files.
+func TestGitignoreSyntax(t *testing.T) {
+	testFs := fs.NewFilesystem(fs.FilesystemTypeFake, rand.String(32)+"?content=true&nostfolder=true")
+	for _, dir := range []string{"build", "sub/build", "bdir"} {
+		if err := testFs.MkdirAll(filepath.FromSlash(dir), 0o777); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := fs.WriteFile(testFs, filepath.FromSlash("bdir/build"), nil, 0o666); err != nil {
+		t.Fatal(err)
+	}
+
+	stignore := strings.Join([]string{
+		"# comment",
+		`\#hash`,
+		"*.log",
+		"!important.log",
+		"build/",
+		"/rooted",
+		"docs/*.tmp",
+		"a/**/z",
+		"**/cache",
+		"foo/**",
+		"!foo/keep",
+		"bar",
+		"!bar/keep",
+		`trail\ `,
+		"x[^a]y",
+		"src/**/gen/*.go",
+		"lit{a,b}",
+		"  leading",
+	}, "\n")
+
+	pats := New(testFs, WithGitignoreSyntax(true), WithCache(true))
+	if err := pats.Parse(strings.NewReader(stignore), ".stignore"); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		f string
+		r bool
+	}{
+		{"#hash", true},
+		{"comment", false},
+		{"x.log", true},
+		{"sub/x.log", true},
+		{"important.log", false},
+		{"sub/important.log", false},
+		{"build", true},
+		{"build/x", true},
+		{"sub/build", true},
+		{"sub/build/y", true},
+		{"bdir/build", false},
+		{"rooted", true},
+		{"sub/rooted", false},
+		{"docs/a.tmp", true},
+		{"docs/x/a.tmp", false},
+		{"sub/docs/a.tmp", false},
+		{"a/z", true},
+		{"a/b/c/z", true},
+		{"b/a/z", false},
+		{"cache", true},
+		{"x/y/cache/f", true},
+		{"foo", false},
+		{"foo/a", true},
+		{"foo/keep", false},
+		{"bar/other", true},
+		{"bar/keep", true}, // can't be included again within an excluded directory
+		{"trail ", true},
+		{"trail", false},
+		{"xby", true},
+		{"xay", false},
+		{"src/gen/a.go", true},
+		{"src/x/y/gen/a.go", true},
+		{"src/gen/sub/a.go", false},
+		{"lit{a,b}", true},
+		{"lita", false},
+		{"  leading", true},
+		{"leading", false},
+	}
+	for _, tc := range tests {
+		if r := pats.Match(filepath.FromSlash(tc.f)); r.IsIgnored() != tc.r {
+			t.Errorf("Incorrect ignore result for %q: got %v, expected %v", tc.f, r.IsIgnored(), tc.r)
+		} else if r.IsIgnored() && !r.CanSkipDir() {
+			t.Errorf("Ignored %q should allow skipping", tc.f)
+		}
+	}
+
+	// The same patterns in our syntax are something else.
+	ours := New(testFs)
+	if err := ours.Parse(strings.NewReader(stignore), ".stignore"); err != nil {
+		t.Fatal(err)
+	}
+	if ours.Hash() == pats.Hash() {
+		t.Error("expected the syntax to change the hash")
+	}
+
+	if err := pats.Parse(strings.NewReader("[abc"), ".stignore"); !IsParseError(err) {
+		t.Errorf("expected a parse error for an unterminated class, got %v", err)
+	}
+}
@@ -58,6 +58,7 @@ type Pattern struct {
 	pattern string
 	match   glob.Glob
 	result  ignoreresult.R
+	dirOnly bool // only in .gitignore syntax
 }
 
 func (p Pattern) String() string {
@@ -111,6 +112,7 @@ type Matcher struct {
 	lines          []string  // exact lines read from .stignore
 	patterns       []Pattern // patterns including those from included files
 	withCache      bool
+	gitignore      bool
 	matches        *cache
 	curHash        string
 	stop           chan struct{}
@@ -128,6 +130,14 @@ func WithCache(v bool) Option {
 	}
 }
 
+// WithGitignoreSyntax enables or disables interpreting ignore files with
+// the syntax and semantics of .gitignore files. The default is disabled.
+func WithGitignoreSyntax(v bool) Option {
+	return func(m *Matcher) {
+		m.gitignore = v
+	}
+}
+
 // WithChangeDetector sets a custom ChangeDetector. The default is to simply
 // use the on disk modtime for comparison.
 func WithChangeDetector(cd ChangeDetector) Option {
@@ -192,13 +202,20 @@ func (m *Matcher) Parse(r io.Reader, file string) error {
 }
 
 func (m *Matcher) parseLocked(r io.Reader, file string) error {
-	lines, patterns, err := parseIgnoreFile(m.fs, r, file, m.changeDetector, make(map[string]struct{}))
+	var lines []string
+	var patterns []Pattern
+	var err error
+	if m.gitignore {
+		lines, patterns, err = parseGitignoreFile(r)
+	} else {
+		lines, patterns, err = parseIgnoreFile(m.fs, r, file, m.changeDetector, make(map[string]struct{}))
+	}
 	// Error is saved and returned at the end. We process the patterns
 	// (possibly blank) anyway.
 
 	m.lines = lines
 
-	newHash := hashPatterns(patterns)
+	newHash := hashPatterns(patterns, m.gitignore)
 	if newHash == m.curHash {
 		// We've already loaded exactly these patterns.
 		return err
@@ -253,6 +270,10 @@ func (m *Matcher) Match(file string) (result ignoreresult.R) {
 		}()
 	}
 
+	if m.gitignore {
+		return m.matchGitignoreLocked(file)
+	}
+
 	// Check all the patterns for a match. Track whether the patterns so far
 	// allow skipping matched directories or not. As soon as we hit an
 	// exclude pattern (with some exceptions), we can't skip directories
@@ -334,8 +355,12 @@ func (m *Matcher) clean(d time.Duration) {
 	}
 }
 
-func hashPatterns(patterns []Pattern) string {
+func hashPatterns(patterns []Pattern, gitignore bool) string {
 	h := sha256.New()
+	if gitignore {
+		// The same patterns mean something else.
+		h.Write([]byte("gitignore\n"))
+	}
 	for _, pat := range patterns {
 		h.Write([]byte(pat.String()))
 		h.Write([]byte("\n"))
@@ -27,7 +27,9 @@ const (
 	KeyFile       LocationEnum = "keyFile"
 	HTTPSCertFile LocationEnum = "httpsCertFile"
 	HTTPSKeyFile  LocationEnum = "httpsKeyFile"
+	HTTPSACMEDir  LocationEnum = "httpsACMEDir"
 	Database      LocationEnum = "database"
+	SQLiteDB      LocationEnum = "sqliteDatabase"
 	LogFile       LocationEnum = "logFile"
 	PanicLog      LocationEnum = "panicLog"
 	AuditLog      LocationEnum = "auditLog"
@@ -47,6 +49,7 @@ const (
 	UserHomeBaseDir BaseDirEnum = "userHome"
 
 	LevelDBDir          = "index-v0.14.0.db"
+	SQLiteDBFile        = "index-v0.14.0.sqlite"
 	configFileName      = "config.xml"
 	defaultStateDir     = ".local/state/syncthing"
 	oldDefaultConfigDir = ".config/syncthing"
@@ -118,7 +121,9 @@ var locationTemplates = map[LocationEnum]string{
 	KeyFile:       "${config}/key.pem",
 	HTTPSCertFile: "${config}/https-cert.pem",
 	HTTPSKeyFile:  "${config}/https-key.pem",
+	HTTPSACMEDir:  "${config}/acme",
 	Database:      "${data}/" + LevelDBDir,
+	SQLiteDB:      "${data}/" + SQLiteDBFile,
 	LogFile:       "${data}/syncthing.log", // --logfile on Windows
 	PanicLog:      "${data}/panic-%{timestamp}.log",
 	AuditLog:      "${data}/audit-%{timestamp}.log",
@@ -165,7 +170,8 @@ func PrettyPaths() string {
 	fmt.Fprintf(&b, "Configuration file:\n\t%s\n\n", Get(ConfigFile))
 	fmt.Fprintf(&b, "Device private key & certificate files:\n\t%s\n\t%s\n\n", Get(KeyFile), Get(CertFile))
 	fmt.Fprintf(&b, "GUI / API HTTPS private key & certificate files:\n\t%s\n\t%s\n\n", Get(HTTPSKeyFile), Get(HTTPSCertFile))
-	fmt.Fprintf(&b, "Database location:\n\t%s\n\n", Get(Database))
+	fmt.Fprintf(&b, "GUI / API HTTPS certificates from ACME:\n\t%s\n\n", Get(HTTPSACMEDir))
+	fmt.Fprintf(&b, "Database location:\n\t%s\n\t%s (SQLite)\n\n", Get(Database), Get(SQLiteDB))
 	fmt.Fprintf(&b, "Log file:\n\t%s\n\n", Get(LogFile))
 	fmt.Fprintf(&b, "GUI override directory:\n\t%s\n\n", Get(GUIAssets))
 	fmt.Fprintf(&b, "Default sync folder directory:\n\t%s\n\n", Get(DefFolder))
@@ -0,0 +1,104 @@
+// Copyright (C) 2026 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package model
+
+import (
+	"encoding/json"
+	"slices"
+
+	"github.com/syncthing/syncthing/lib/config"
+	"github.com/syncthing/syncthing/lib/db"
+	"github.com/syncthing/syncthing/lib/protocol"
+)
+
+// sessionExtensions keeps the extensions used in the latest session with
+// each device in the database, so that what a device supports is known
+// before it connects again, also after a restart.
+type sessionExtensions struct {
+	kv *db.NamespacedKV
+}
+
+func newSessionExtensions(kv *db.NamespacedKV) *sessionExtensions {
+	return &sessionExtensions{kv: kv}
+}
+
+func (s *sessionExtensions) get(device protocol.DeviceID) ([]string, bool) {
+	bs, ok, err := s.kv.Bytes(sessionExtensionsKey(device))
+	if err != nil || !ok {
+		return nil, false
+	}
+	var exts []string
+	if err := json.Unmarshal(bs, &exts); err != nil {
+		l.Debugln("Loading session extensions:", err)
+		return nil, false
+	}
+	return exts, true
+}
+
+func (s *sessionExtensions) put(device protocol.DeviceID, exts []string) {
+	bs, err := json.Marshal(exts)
+	if err != nil {
+		return
+	}
+	if err := s.kv.PutBytes(sessionExtensionsKey(device), bs); err != nil {
+		l.Debugln("Saving session extensions:", err)
+	}
+}
+
+func sessionExtensionsKey(device protocol.DeviceID) string {
+	return "sessionExtensions/" + device.String()
+}
+
+// blockHashFor returns the algorithm to hash the folder's new and changed
+// files with: the configured one, if every device sharing the folder used
+// the corresponding extension in its latest session, and otherwise
+// SHA-256. Devices we have never had a session with are assumed not to
+// support it; once they turn out to, the folder is rescanned.
+func (m *model) blockHashFor(folderCfg config.FolderConfiguration) protocol.BlockHashAlgorithm {
+	ext := folderCfg.HashAlgorithm.Extension()
+	if ext == "" {
+		return folderCfg.HashAlgorithm
+	}
+
+	for _, dev := range folderCfg.Devices {
+		if dev.DeviceID == m.id {
+			continue
+		}
+		if exts, _ := m.sessionExtensions.get(dev.DeviceID); !slices.Contains(exts, ext) {
+			l.Debugf("Hashing blocks of folder %s with SHA-256 as %v isn't known to support %v", folderCfg.Description(), dev.DeviceID, folderCfg.HashAlgorithm)
+			return protocol.BlockHashSHA256
+		}
+	}
+	return folderCfg.HashAlgorithm
+}
+
+// recordSessionExtensions remembers the extensions used with the device
+// beyond the session, and schedules a scan of the folders shared with it
+// whose block hash algorithm changes as a result.
+func (m *model) recordSessionExtensions(device protocol.DeviceID) {
+	m.mut.RLock()
+	exts := m.extensionsRLocked(device)
+	m.mut.RUnlock()
+	prev, _ := m.sessionExtensions.get(device)
+	m.sessionExtensions.put(device, exts)
+
+	for _, folderCfg := range m.cfg.FolderList() {
+		ext := folderCfg.HashAlgorithm.Extension()
+		if ext == "" || !folderCfg.SharedWith(device) {
+			continue
+		}
+		if slices.Contains(prev, ext) == slices.Contains(exts, ext) {
+			continue
+		}
+		m.mut.RLock()
+		runner, ok := m.folderRunners.Get(folderCfg.ID)
+		m.mut.RUnlock()
+		if ok {
+			runner.ScheduleScan()
+		}
+	}
+}
@@ -0,0 +1,70 @@
+// Copyright (C) 2026 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package model
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/syncthing/syncthing/lib/config"
+	"github.com/syncthing/syncthing/lib/db"
+	"github.com/syncthing/syncthing/lib/protocol"
+)
+
+func TestBlockHashFallback(t *testing.T) {
+	w, fcfg, wCancel := newDefaultCfgWrapper()
+	defer wCancel()
+	fcfg.HashAlgorithm = protocol.BlockHashBLAKE2b
+	setFolder(t, w, fcfg)
+	m := setupModel(t, w)
+	defer cleanupModelAndRemoveDir(m, fcfg.Filesystem(nil).URI())
+
+	// Devices we have never had a session with are assumed not to support
+	// it.
+	if algo := m.blockHashFor(fcfg); algo != protocol.BlockHashSHA256 {
+		t.Fatalf("got %v before connecting, expected sha256", algo)
+	}
+
+	fc := addFakeConn(m, device1, fcfg.ID)
+	if algo := m.blockHashFor(fcfg); algo != protocol.BlockHashBLAKE2b {
+		t.Fatalf("got %v with a supporting device, expected blake2b", algo)
+	}
+
+	// A session without the extension makes it fall back, also after the
+	// device disconnects.
+	m.ClusterConfig(fc, &protocol.ClusterConfig{
+		Folders: []protocol.Folder{{ID: fcfg.ID, Devices: []protocol.Device{{ID: myID}, {ID: device1}}}},
+	})
+	if algo := m.blockHashFor(fcfg); algo != protocol.BlockHashSHA256 {
+		t.Fatalf("got %v with a device lacking support, expected sha256", algo)
+	}
+	m.Closed(fc, protocol.ErrClosed)
+	if algo := m.blockHashFor(fcfg); algo != protocol.BlockHashSHA256 {
+		t.Fatalf("got %v after disconnecting, expected sha256", algo)
+	}
+
+	// The latest session is remembered in the database.
+	fc = addFakeConn(m, device1, fcfg.ID)
+	m.Closed(fc, protocol.ErrClosed)
+	if exts, ok := newSessionExtensions(db.NewMiscDataNamespace(m.db)).get(device1); !ok || !slices.Contains(exts, protocol.ExtensionBlockHashBLAKE2b) {
+		t.Fatalf("got extensions %v, %v from the database, expected blake2b support", exts, ok)
+	}
+	if algo := m.blockHashFor(fcfg); algo != protocol.BlockHashBLAKE2b {
+		t.Fatalf("got %v after a supporting session, expected blake2b", algo)
+	}
+
+	// Other folders depend on the devices they are shared with.
+	other := fcfg
+	other.Devices = []config.FolderDeviceConfiguration{{DeviceID: myID}, {DeviceID: device2}}
+	if algo := m.blockHashFor(other); algo != protocol.BlockHashSHA256 {
+		t.Fatalf("got %v for a folder shared with an unknown device, expected sha256", algo)
+	}
+	other.Devices = []config.FolderDeviceConfiguration{{DeviceID: myID}}
+	if algo := m.blockHashFor(other); algo != protocol.BlockHashBLAKE2b {
+		t.Fatalf("got %v for an unshared folder, expected blake2b", algo)
+	}
+}
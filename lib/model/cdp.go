@@ -0,0 +1,217 @@
+// Copyright (C) 2026 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package model
+
+import (
+	"context"
+	"io"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/syncthing/syncthing/lib/config"
+	"github.com/syncthing/syncthing/lib/fs"
+	"github.com/syncthing/syncthing/lib/ignore"
+	"github.com/syncthing/syncthing/lib/osutil"
+	"github.com/syncthing/syncthing/lib/sync"
+	"github.com/syncthing/syncthing/lib/versioner"
+)
+
+const (
+	// cdpDir is where the continuous protection snapshots are kept,
+	// relative to the folder root. It is internal, see fs.IsInternal.
+	cdpDir = ".stcdp"
+	// cdpTimeFormat tags the snapshots, finer than the versioner time
+	// format as a file may change several times a second.
+	cdpTimeFormat = "20060102-150405.000000"
+)
+
+// continuousProtection snapshots the files matching the continuous
+// protection patterns of a folder each time the watcher sees them change,
+// so that every intermediate version is kept and not just the one replaced
+// when syncing. The snapshots are kept under cdpDir at the same path as the
+// file, tagged with the time, and the oldest are removed when together they
+// are larger than the quota.
+type continuousProtection struct {
+	fs      fs.Filesystem
+	matcher *ignore.Matcher
+	quota   int64
+
+	mut  sync.Mutex
+	last map[string]cdpFileState // the files as last snapshotted
+	used int64                   // bytes used by the snapshots, -1 until counted
+}
+
+type cdpFileState struct {
+	size    int64
+	modTime time.Time
+}
+
+// newContinuousProtection returns nil when the folder has no continuous
+// protection patterns or quota. Encrypted folders are never protected, as
+// there is nothing meaningful to snapshot.
+func newContinuousProtection(cfg config.FolderConfiguration) (*continuousProtection, error) {
+	if len(cfg.ContinuousProtectionPatterns) == 0 || cfg.Type == config.FolderTypeReceiveEncrypted || cfg.ContinuousProtectionQuotaMiB <= 0 {
+		return nil, nil
+	}
+
+	ffs := cfg.Filesystem(nil)
+	matcher := ignore.New(ffs)
+	if err := matcher.Parse(strings.NewReader(strings.Join(cfg.ContinuousProtectionPatterns, "\n")), ""); err != nil {
+		return nil, err
+	}
+
+	return &continuousProtection{
+		fs:      ffs,
+		matcher: matcher,
+		quota:   cfg.ContinuousProtectionQuotaMiB << 20,
+		mut:     sync.NewMutex(),
+		last:    make(map[string]cdpFileState),
+		used:    -1,
+	}, nil
+}
+
+// intercept snapshots the changed files among the watcher events from in,
+// before passing each event on to the returned channel.
+func (p *continuousProtection) intercept(ctx context.Context, in <-chan fs.Event) <-chan fs.Event {
+	out := make(chan fs.Event)
+	go func() {
+		for {
+			select {
+			case ev := <-in:
+				if ev.Type == fs.Remove {
+					p.forget(ev.Name)
+				} else if err := p.snapshot(ev.Name); err != nil {
+					l.Infof("Continuous protection snapshot of %s failed: %v", ev.Name, err)
+				}
+				select {
+				case out <- ev:
+				case <-ctx.Done():
+					return
+				}
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return out
+}
+
+func (p *continuousProtection) forget(name string) {
+	p.mut.Lock()
+	delete(p.last, name)
+	p.mut.Unlock()
+}
+
+// snapshot copies the named file, if it matches the patterns and changed
+// since the last snapshot.
+func (p *continuousProtection) snapshot(name string) error {
+	if fs.IsInternal(name) || !p.matcher.Match(name).IsIgnored() {
+		return nil
+	}
+	info, err := p.fs.Lstat(name)
+	if fs.IsNotExist(err) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	if !info.IsRegular() || info.Size() > p.quota {
+		// Nothing to keep, or it would never fit.
+		return nil
+	}
+
+	p.mut.Lock()
+	defer p.mut.Unlock()
+
+	state := cdpFileState{size: info.Size(), modTime: info.ModTime()}
+	if last, ok := p.last[name]; ok && last.size == state.size && last.modTime.Equal(state.modTime) {
+		return nil
+	}
+
+	dst := filepath.Join(cdpDir, filepath.Dir(name), versioner.TagFilename(filepath.Base(name), time.Now().Format(cdpTimeFormat)))
+	if err := p.fs.MkdirAll(filepath.Dir(dst), 0o755); err != nil && !fs.IsExist(err) {
+		return err
+	}
+	_ = p.fs.Hide(cdpDir)
+
+	src, err := p.fs.Open(name)
+	if err != nil {
+		return err
+	}
+	defer src.Close()
+	fd, err := osutil.CreateAtomicFilesystem(p.fs, dst)
+	if err != nil {
+		return err
+	}
+	n, err := io.Copy(fd, src)
+	if err != nil {
+		// Closing commits what was written, so remove it again.
+		if fd.Close() == nil {
+			_ = p.fs.Remove(dst)
+		}
+		return err
+	}
+	if err := fd.Close(); err != nil {
+		return err
+	}
+
+	p.last[name] = state
+	if p.used >= 0 {
+		p.used += n
+	}
+	return p.enforceQuotaLocked()
+}
+
+type cdpSnapshot struct {
+	name    string
+	size    int64
+	modTime time.Time
+}
+
+// enforceQuotaLocked removes the oldest snapshots while they use more than
+// the quota.
+func (p *continuousProtection) enforceQuotaLocked() error {
+	if p.used >= 0 && p.used <= p.quota {
+		return nil
+	}
+
+	var snapshots []cdpSnapshot
+	var used int64
+	err := p.fs.Walk(cdpDir, func(path string, info fs.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsRegular() {
+			snapshots = append(snapshots, cdpSnapshot{path, info.Size(), info.ModTime()})
+			used += info.Size()
+		}
+		return nil
+	})
+	if err != nil && !fs.IsNotExist(err) {
+		return err
+	}
+
+	sort.Slice(snapshots, func(a, b int) bool {
+		if !snapshots[a].modTime.Equal(snapshots[b].modTime) {
+			return snapshots[a].modTime.Before(snapshots[b].modTime)
+		}
+		return snapshots[a].name < snapshots[b].name
+	})
+	for _, s := range snapshots {
+		if used <= p.quota {
+			break
+		}
+		if err := p.fs.Remove(s.name); err != nil && !fs.IsNotExist(err) {
+			return err
+		}
+		l.Debugln("Removed continuous protection snapshot", s.name)
+		used -= s.size
+	}
+	p.used = used
+	return nil
+}
@@ -0,0 +1,128 @@
+// Copyright (C) 2026 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package model
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/syncthing/syncthing/lib/config"
+	"github.com/syncthing/syncthing/lib/fs"
+)
+
+func TestContinuousProtection(t *testing.T) {
+	dir := t.TempDir()
+	p, err := newContinuousProtection(config.FolderConfiguration{
+		FilesystemType:               fs.FilesystemTypeBasic,
+		Path:                         dir,
+		ContinuousProtectionPatterns: []string{"!skip.txt", "*.txt"},
+		ContinuousProtectionQuotaMiB: 1,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	p.quota = 10
+
+	snapshots := func() []string {
+		t.Helper()
+		var names []string
+		err := filepath.Walk(filepath.Join(dir, cdpDir), func(path string, info os.FileInfo, err error) error {
+			if err != nil {
+				return err
+			}
+			if info.Mode().IsRegular() {
+				bs, err := os.ReadFile(path)
+				if err != nil {
+					return err
+				}
+				names = append(names, string(bs))
+			}
+			return nil
+		})
+		if err != nil && !os.IsNotExist(err) {
+			t.Fatal(err)
+		}
+		sort.Strings(names)
+		return names
+	}
+	write := func(name, content string) {
+		t.Helper()
+		if err := os.MkdirAll(filepath.Dir(filepath.Join(dir, name)), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+		if err := p.snapshot(name); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	write(filepath.Join("sub", "a.txt"), "a1")
+	write(filepath.Join("sub", "a.txt"), "a22")
+	write("b.dat", "b1")
+	write("skip.txt", "s1")
+	// Unchanged since the last snapshot.
+	if err := p.snapshot(filepath.Join("sub", "a.txt")); err != nil {
+		t.Fatal(err)
+	}
+	if got := snapshots(); len(got) != 2 || got[0] != "a1" || got[1] != "a22" {
+		t.Fatalf("unexpected snapshots %v", got)
+	}
+
+	// Going over the quota removes the oldest snapshots.
+	time.Sleep(10 * time.Millisecond)
+	write("c.txt", "c1234567")
+	if got := snapshots(); len(got) != 1 || got[0] != "c1234567" {
+		t.Fatalf("unexpected snapshots after exceeding the quota %v", got)
+	}
+
+	// Files too large for the quota are never kept.
+	write("d.txt", "d1234567890")
+	if got := snapshots(); len(got) != 1 {
+		t.Fatalf("unexpected snapshots after a large file %v", got)
+	}
+}
+
+func TestContinuousProtectionIntercept(t *testing.T) {
+	dir := t.TempDir()
+	p, err := newContinuousProtection(config.FolderConfiguration{
+		FilesystemType:               fs.FilesystemTypeBasic,
+		Path:                         dir,
+		ContinuousProtectionPatterns: []string{"*"},
+		ContinuousProtectionQuotaMiB: 1,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "file"), []byte("content"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	in := make(chan fs.Event)
+	out := p.intercept(ctx, in)
+	for _, ev := range []fs.Event{{Name: "file", Type: fs.NonRemove}, {Name: "file", Type: fs.Remove}} {
+		in <- ev
+		if got := <-out; got != ev {
+			t.Errorf("got event %v, expected %v", got, ev)
+		}
+	}
+	entries, err := os.ReadDir(filepath.Join(dir, cdpDir))
+	if err != nil || len(entries) != 1 {
+		t.Errorf("expected one snapshot, got %v, %v", entries, err)
+	}
+
+	if p, _ := newContinuousProtection(config.FolderConfiguration{Path: dir}); p != nil {
+		t.Error("expected no continuous protection without patterns")
+	}
+}
@@ -0,0 +1,118 @@
+// Copyright (C) 2026 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package model
+
+import (
+	"github.com/syncthing/syncthing/lib/config"
+	"github.com/syncthing/syncthing/lib/db"
+	"github.com/syncthing/syncthing/lib/fs"
+	"github.com/syncthing/syncthing/lib/ignore"
+)
+
+// changeJournalScans scans a folder by the paths its filesystem's change
+// journal recorded as changed since the last scan. The journal cursor of the
+// last successful scan is kept in the database, so that on Windows, where the
+// journal outlives us, the first scan after a restart needn't walk the whole
+// folder either.
+type changeJournalScans struct {
+	journal fs.ChangeJournal
+	kv      *db.NamespacedKV
+	key     string
+}
+
+func newChangeJournalScans(journal fs.ChangeJournal, kv *db.NamespacedKV, folder string) *changeJournalScans {
+	return &changeJournalScans{
+		journal: journal,
+		kv:      kv,
+		key:     "changeJournal/" + folder,
+	}
+}
+
+// changes returns the paths changed since the last scan, or false when the
+// whole folder needs scanning, and the cursor to save once they are scanned.
+func (s *changeJournalScans) changes() ([]string, string, bool) {
+	last, ok, err := s.kv.String(s.key)
+	if err == nil && ok {
+		paths, next, err := s.journal.Changes(last)
+		if err == nil {
+			return paths, next, true
+		}
+		l.Debugf("Listing changes since %v: %v", last, err)
+	}
+	cursor, err := s.journal.Cursor()
+	if err != nil {
+		l.Debugln("Getting change journal cursor:", err)
+		return nil, "", false
+	}
+	return nil, cursor, false
+}
+
+// scanned saves the cursor of a successful scan, or forgets the last one
+// when the scan failed or the journal is unavailable.
+func (s *changeJournalScans) scanned(cursor string, err error) {
+	if err != nil || cursor == "" {
+		_ = s.kv.Delete(s.key)
+		return
+	}
+	if err := s.kv.PutString(s.key, cursor); err != nil {
+		l.Debugln("Saving change journal cursor:", err)
+	}
+}
+
+func (s *changeJournalScans) close() {
+	s.journal.Close()
+}
+
+// openChangeJournal starts recording the changes of the folder, if enabled
+// and supported.
+func (f *folder) openChangeJournal() {
+	if !f.ChangeJournalEnabled {
+		return
+	}
+	journal, err := fs.OpenChangeJournal(f.mtimefs)
+	if err != nil {
+		l.Infof("Scanning folder %v without change journal: %v", f.Description(), err)
+		return
+	}
+	f.journalScans = newChangeJournalScans(journal, db.NewMiscDataNamespace(f.model.db), f.ID)
+}
+
+// scanJournalChanges scans the paths changed according to the change
+// journal, or the whole folder when they aren't known or the ignore
+// patterns changed, which can change what's ignored anywhere.
+func (f *folder) scanJournalChanges() error {
+	paths, cursor, ok := f.journalScans.changes()
+	var err error
+	switch {
+	case !ok || f.ignoresChanged():
+		l.Debugln(f, "scanning everything, changes unknown")
+		err = f.scanSubdirs(nil)
+	case len(paths) > 0:
+		l.Debugf("%v scanning %d changed paths", f, len(paths))
+		err = f.scanSubdirs(paths)
+	default:
+		l.Debugln(f, "nothing changed")
+		if err = f.getHealthErrorAndLoadIgnores(); err == nil {
+			f.setError(nil)
+		}
+	}
+	f.journalScans.scanned(cursor, err)
+	return err
+}
+
+// ignoresChanged returns whether the ignore patterns on disk differ from
+// those loaded.
+func (f *folder) ignoresChanged() bool {
+	if f.Type == config.FolderTypeReceiveEncrypted {
+		return false
+	}
+	ignores := ignore.New(f.mtimefs, ignore.WithGitignoreSyntax(f.GitignoreSyntax))
+	if err := ignores.Load(".stignore"); err != nil && !fs.IsNotExist(err) {
+		return true
+	}
+	return ignores.Hash() != f.ignores.Hash()
+}
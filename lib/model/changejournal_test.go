@@ -0,0 +1,99 @@
+// Copyright (C) 2026 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package model
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/syncthing/syncthing/lib/db"
+	"github.com/syncthing/syncthing/lib/fs"
+	"github.com/syncthing/syncthing/lib/protocol"
+)
+
+// fakeChangeJournal lists the changes given to it, resetting when it's
+// asked for those since a cursor it didn't return last.
+type fakeChangeJournal struct {
+	generation int
+	changes    []string
+}
+
+func (j *fakeChangeJournal) Cursor() (string, error) {
+	j.generation++
+	j.changes = nil
+	return strconv.Itoa(j.generation), nil
+}
+
+func (j *fakeChangeJournal) Changes(cursor string) ([]string, string, error) {
+	if cursor != strconv.Itoa(j.generation) {
+		return nil, "", fs.ErrChangeJournalReset
+	}
+	changes := j.changes
+	j.generation++
+	j.changes = nil
+	return changes, strconv.Itoa(j.generation), nil
+}
+
+func (*fakeChangeJournal) Close() error { return nil }
+
+func TestChangeJournalScan(t *testing.T) {
+	w, fcfg, wCancel := newDefaultCfgWrapper()
+	defer wCancel()
+	ffs := fcfg.Filesystem(nil)
+	m := setupModel(t, w)
+	defer cleanupModelAndRemoveDir(m, ffs.URI())
+
+	runner, _ := m.folderRunners.Get(fcfg.ID)
+	f := runner.(*sendReceiveFolder)
+	<-f.initialScanFinished
+	journal := &fakeChangeJournal{}
+	kv := db.NewMiscDataNamespace(m.db)
+	scan := func() {
+		t.Helper()
+		must(t, f.doInSync(func() error {
+			f.journalScans = newChangeJournalScans(journal, kv, fcfg.ID)
+			return f.scanJournalChanges()
+		}))
+	}
+	has := func(name string) bool {
+		snap := dbSnapshot(t, m, fcfg.ID)
+		defer snap.Release()
+		_, ok := snap.Get(protocol.LocalDeviceID, name)
+		return ok
+	}
+
+	// Without a saved cursor everything is scanned.
+	writeFile(t, ffs, "first", []byte("a"))
+	scan()
+	if !has("first") {
+		t.Fatal("first file not scanned")
+	}
+
+	// Then only the changes listed by the journal.
+	writeFile(t, ffs, "listed", []byte("b"))
+	writeFile(t, ffs, "unlisted", []byte("c"))
+	journal.changes = []string{"listed"}
+	scan()
+	if !has("listed") || has("unlisted") {
+		t.Fatal("expected only the listed change to be scanned")
+	}
+
+	// Until the journal is reset.
+	journal.generation++
+	scan()
+	if !has("unlisted") {
+		t.Fatal("unlisted file not scanned after reset")
+	}
+
+	// Changed ignore patterns need a full scan too.
+	writeFile(t, ffs, "unlisted2", []byte("d"))
+	writeFile(t, ffs, ".stignore", []byte("nothing\n"))
+	scan()
+	if !has("unlisted2") {
+		t.Fatal("unlisted file not scanned after changing ignores")
+	}
+}
@@ -0,0 +1,150 @@
+// Copyright (C) 2026 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package model
+
+import (
+	"sort"
+	"time"
+
+	"github.com/syncthing/syncthing/lib/config"
+	"github.com/syncthing/syncthing/lib/fs"
+	"github.com/syncthing/syncthing/lib/versioner"
+)
+
+// CleanOptions selects what Clean removes. Files are removed when older
+// than the respective age; a zero age leaves that kind of file alone.
+type CleanOptions struct {
+	TempAge     time.Duration // leftover temporary files from pulls
+	VersionAge  time.Duration // versions of files no longer in the folder
+	ConflictAge time.Duration // conflict copies
+	DryRun      bool          // only report what would be removed
+}
+
+// CleanResult lists the files removed, or that would be removed on a dry
+// run. Versions are relative to the versions directory.
+type CleanResult struct {
+	DryRun    bool     `json:"dryRun"`
+	Temporary []string `json:"temporary"`
+	Versions  []string `json:"versions"`
+	Conflicts []string `json:"conflicts"`
+}
+
+// Clean removes stale temporary files, orphaned versions and old conflict
+// copies. It runs in the folder's own routine, so it never races a pull
+// that might still be using a temporary file.
+func (f *folder) Clean(opts CleanOptions) (CleanResult, error) {
+	<-f.initialScanFinished
+	var res CleanResult
+	err := f.doInSync(func() error {
+		var err error
+		res, err = f.clean(opts, time.Now())
+		return err
+	})
+	return res, err
+}
+
+func (f *folder) clean(opts CleanOptions, now time.Time) (CleanResult, error) {
+	res := CleanResult{
+		DryRun:    opts.DryRun,
+		Temporary: []string{},
+		Versions:  []string{},
+		Conflicts: []string{},
+	}
+
+	if opts.TempAge > 0 {
+		temps, err := f.cleanTempFiles(now.Add(-opts.TempAge), opts.DryRun)
+		res.Temporary = append(res.Temporary, temps...)
+		if err != nil {
+			return res, err
+		}
+	}
+
+	if opts.VersionAge > 0 {
+		snap, err := f.dbSnapshot()
+		if err != nil {
+			return res, err
+		}
+		exists := func(name string) bool {
+			fi, ok := snap.GetGlobal(name)
+			return ok && !fi.IsDeleted()
+		}
+		versions, err := versioner.RemoveOrphans(f.ctx, f.FolderConfiguration, now.Add(-opts.VersionAge), exists, opts.DryRun)
+		snap.Release()
+		res.Versions = append(res.Versions, versions...)
+		if err != nil {
+			return res, err
+		}
+	}
+
+	if opts.ConflictAge > 0 && f.Type != config.FolderTypeReceiveEncrypted {
+		conflicts, err := f.cleanConflicts(now.Add(-opts.ConflictAge), opts.DryRun)
+		res.Conflicts = append(res.Conflicts, conflicts...)
+		if err != nil {
+			return res, err
+		}
+	}
+
+	if !opts.DryRun && len(res.Temporary)+len(res.Versions)+len(res.Conflicts) > 0 {
+		l.Infof("Folder %v: cleaned up %d temporary files, %d orphaned versions and %d conflict copies", f.Description(), len(res.Temporary), len(res.Versions), len(res.Conflicts))
+	}
+	return res, nil
+}
+
+// cleanTempFiles removes the temporary files last modified before the
+// cutoff. Newer ones may still be picked up to resume a pull.
+func (f *folder) cleanTempFiles(cutoff time.Time, dryRun bool) ([]string, error) {
+	var temps []string
+	err := f.mtimefs.Walk(".", func(path string, info fs.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if fs.IsInternal(path) {
+			if info.IsDir() {
+				return fs.SkipDir
+			}
+			return nil
+		}
+		if !fs.IsTemporary(path) || !info.IsRegular() || !info.ModTime().Before(cutoff) {
+			return nil
+		}
+		temps = append(temps, path)
+		if dryRun {
+			return nil
+		}
+		return inWritableDir(f.mtimefs.Remove, f.mtimefs, path, f.IgnorePerms)
+	})
+	sort.Strings(temps)
+	return temps, err
+}
+
+// cleanConflicts removes the conflict copies created before the cutoff,
+// and has the removals picked up by a scan.
+func (f *folder) cleanConflicts(cutoff time.Time, dryRun bool) ([]string, error) {
+	snap, err := f.dbSnapshot()
+	if err != nil {
+		return nil, err
+	}
+	var conflicts []string
+	for _, c := range conflictsFromSnapshot(snap) {
+		if c.Time.Before(cutoff) {
+			conflicts = append(conflicts, c.Name)
+		}
+	}
+	snap.Release()
+
+	if dryRun || len(conflicts) == 0 {
+		return conflicts, nil
+	}
+	var removed []string
+	for _, name := range conflicts {
+		if err := inWritableDir(f.mtimefs.Remove, f.mtimefs, name, f.IgnorePerms); err != nil && !fs.IsNotExist(err) {
+			return removed, err
+		}
+		removed = append(removed, name)
+	}
+	return removed, f.scanSubdirs(removed)
+}
@@ -0,0 +1,77 @@
+// Copyright (C) 2026 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package model
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/syncthing/syncthing/lib/fs"
+)
+
+func TestFolderClean(t *testing.T) {
+	now := time.Now()
+	oldTemp := fs.TempName("old.txt")
+	newTemp := fs.TempName("new.txt")
+	oldConfl := "foo.sync-conflict-20200102-150405-ABCDEFG.txt"
+	newConfl := conflictName("foo.txt", device1.Short().String())
+
+	for _, dryRun := range []bool{true, false} {
+		_, f, wcfgCancel := setupSendReceiveFolder(t)
+		ffs := f.Filesystem(nil)
+
+		for _, name := range []string{"foo.txt", oldTemp, newTemp, oldConfl, newConfl} {
+			writeFile(t, ffs, name, []byte("data"))
+		}
+		must(t, f.scanSubdirs(nil))
+		// After the scan, as it removes old temporary files by itself
+		old := now.Add(-48 * time.Hour)
+		must(t, ffs.Chtimes(oldTemp, old, old))
+
+		opts := CleanOptions{
+			TempAge:     24 * time.Hour,
+			ConflictAge: 24 * time.Hour,
+			DryRun:      dryRun,
+		}
+		res, err := f.clean(opts, now)
+		must(t, err)
+		if !slices.Equal(res.Temporary, []string{oldTemp}) {
+			t.Errorf("dry run %v: unexpected temporary files %v", dryRun, res.Temporary)
+		}
+		if !slices.Equal(res.Conflicts, []string{oldConfl}) {
+			t.Errorf("dry run %v: unexpected conflicts %v", dryRun, res.Conflicts)
+		}
+		if len(res.Versions) != 0 {
+			t.Errorf("dry run %v: unexpected versions %v", dryRun, res.Versions)
+		}
+
+		for _, name := range []string{"foo.txt", newTemp, newConfl} {
+			if _, err := ffs.Lstat(name); err != nil {
+				t.Errorf("dry run %v: %s should be kept: %v", dryRun, name, err)
+			}
+		}
+		for _, name := range []string{oldTemp, oldConfl} {
+			_, err := ffs.Lstat(name)
+			if dryRun && err != nil {
+				t.Errorf("dry run: %s should not be removed: %v", name, err)
+			} else if !dryRun && !fs.IsNotExist(err) {
+				t.Errorf("%s should be removed: %v", name, err)
+			}
+		}
+
+		if !dryRun {
+			snap, err := f.dbSnapshot()
+			must(t, err)
+			if fi, ok := snap.GetGlobal(oldConfl); !ok || !fi.IsDeleted() {
+				t.Errorf("removed conflict copy should be deleted in the index, got %v", fi)
+			}
+			snap.Release()
+		}
+		wcfgCancel()
+	}
+}
@@ -0,0 +1,434 @@
+// Copyright (C) 2026 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package model
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"slices"
+	"time"
+
+	"github.com/syncthing/syncthing/lib/config"
+	"github.com/syncthing/syncthing/lib/db"
+	"github.com/syncthing/syncthing/lib/events"
+	"github.com/syncthing/syncthing/lib/fs"
+	"github.com/syncthing/syncthing/lib/protocol"
+	"github.com/syncthing/syncthing/lib/rand"
+	"github.com/syncthing/syncthing/lib/sync"
+)
+
+const (
+	pendingConfigPushesKey = "pendingConfigPushes"
+	// Only the latest pushes from each device are kept pending.
+	maxPendingConfigPushes = 16
+	configPushTimeout      = time.Minute
+)
+
+var (
+	errNoSuchConfigPush       = errors.New("no such pending config push")
+	errManagementNotAllowed   = errors.New("device is not allowed to manage this device")
+	errNotConnected           = errors.New("device is not connected")
+	errConfigPushNotSupported = errors.New("device does not support configuration pushes")
+)
+
+// PushedConfig is configuration pushed by a managing device: folders to
+// add, or share with more devices, and devices to add. Nothing is removed,
+// and existing folders and devices are otherwise left as they are. New
+// ones are based on the defaults of the receiving device.
+type PushedConfig struct {
+	Folders []PushedFolder `json:"folders"`
+	Devices []PushedDevice `json:"devices"`
+}
+
+type PushedFolder struct {
+	ID    string `json:"id"`
+	Label string `json:"label"`
+	// The devices to share the folder with, including the managing device
+	// itself if it should be.
+	Devices []protocol.DeviceID `json:"devices"`
+}
+
+type PushedDevice struct {
+	DeviceID  protocol.DeviceID `json:"deviceID"`
+	Name      string            `json:"name"`
+	Addresses []string          `json:"addresses"`
+}
+
+// PendingConfigPush is configuration pushed by a device that is allowed to
+// manage us, waiting to be approved.
+type PendingConfigPush struct {
+	ID       int64             `json:"id"`
+	Device   protocol.DeviceID `json:"device"`
+	Received time.Time         `json:"received"`
+	PushedConfig
+}
+
+func (c PushedConfig) validate() error {
+	for _, f := range c.Folders {
+		if f.ID == "" {
+			return errors.New("pushed folder without ID")
+		}
+		if slices.Contains(f.Devices, protocol.EmptyDeviceID) {
+			return fmt.Errorf("pushed folder %s: empty device ID", f.ID)
+		}
+	}
+	for _, d := range c.Devices {
+		if d.DeviceID == protocol.EmptyDeviceID {
+			return errors.New("pushed device without ID")
+		}
+	}
+	return nil
+}
+
+func (c PushedConfig) toWire(id int64) *protocol.ConfigPush {
+	push := &protocol.ConfigPush{ID: id}
+	for _, f := range c.Folders {
+		folder := protocol.Folder{ID: f.ID, Label: f.Label}
+		for _, dev := range f.Devices {
+			folder.Devices = append(folder.Devices, protocol.Device{ID: dev})
+		}
+		push.Folders = append(push.Folders, folder)
+	}
+	for _, d := range c.Devices {
+		push.Devices = append(push.Devices, protocol.Device{ID: d.DeviceID, Name: d.Name, Addresses: d.Addresses})
+	}
+	return push
+}
+
+func pushedConfigFromWire(push *protocol.ConfigPush) PushedConfig {
+	var c PushedConfig
+	for _, f := range push.Folders {
+		folder := PushedFolder{ID: f.ID, Label: f.Label}
+		for _, dev := range f.Devices {
+			folder.Devices = append(folder.Devices, dev.ID)
+		}
+		c.Folders = append(c.Folders, folder)
+	}
+	for _, d := range push.Devices {
+		c.Devices = append(c.Devices, PushedDevice{DeviceID: d.ID, Name: d.Name, Addresses: d.Addresses})
+	}
+	return c
+}
+
+// apply adds the pushed devices and folders to the configuration. Ignored
+// devices and our own device are skipped, and folders are only shared with
+// devices we know of.
+func (c PushedConfig) apply(cfg *config.Configuration, myID protocol.DeviceID) {
+	for _, d := range c.Devices {
+		if d.DeviceID == myID || slices.ContainsFunc(cfg.IgnoredDevices, func(o config.ObservedDevice) bool { return o.ID == d.DeviceID }) {
+			continue
+		}
+		if _, _, ok := cfg.Device(d.DeviceID); ok {
+			continue
+		}
+		dcfg := cfg.Defaults.Device.Copy()
+		dcfg.DeviceID = d.DeviceID
+		dcfg.Name = d.Name
+		if len(d.Addresses) > 0 {
+			dcfg.Addresses = d.Addresses
+		}
+		l.Infof("Adding device %v to config (pushed by managing device)", d.DeviceID)
+		cfg.SetDevice(dcfg)
+	}
+
+	for _, f := range c.Folders {
+		fcfg, _, ok := cfg.Folder(f.ID)
+		if !ok {
+			fcfg = cfg.Defaults.Folder.Copy()
+			fcfg.ID = f.ID
+			fcfg.Label = f.Label
+			name := fs.SanitizePath(f.Label)
+			if name == "" {
+				name = fs.SanitizePath(f.ID)
+			}
+			if name == "" || name == "." || name == ".." {
+				l.Infof("Not adding pushed folder %s due to lack of a path", f.ID)
+				continue
+			}
+			if err := checkPushedFolderPath(cfg, fcfg, name); err != nil {
+				l.Infof("Not adding pushed folder %s at path %s: %v", f.ID, filepath.Join(fcfg.Path, name), err)
+				continue
+			}
+			fcfg.Path = filepath.Join(fcfg.Path, name)
+			l.Infof("Adding folder %s at path %s (pushed by managing device)", fcfg.Description(), fcfg.Path)
+		}
+		for _, dev := range f.Devices {
+			if dev == myID || fcfg.SharedWith(dev) {
+				continue
+			}
+			if _, _, ok := cfg.Device(dev); !ok {
+				continue
+			}
+			fcfg.Devices = append(fcfg.Devices, config.FolderDeviceConfiguration{DeviceID: dev})
+		}
+		cfg.SetFolder(fcfg)
+	}
+}
+
+// checkPushedFolderPath makes sure a pushed folder at the given name in the
+// default folder path doesn't take over existing data: the path must not
+// be a non-empty directory, nor overlap with the path of another folder.
+func checkPushedFolderPath(cfg *config.Configuration, fcfg config.FolderConfiguration, name string) error {
+	parentFs := fs.NewFilesystem(fcfg.FilesystemType, fcfg.Path)
+	if info, err := parentFs.Lstat(name); err == nil {
+		if !info.IsDir() {
+			return errors.New("path exists and is not a directory")
+		}
+		if names, err := parentFs.DirNames(name); err != nil {
+			return err
+		} else if len(names) > 0 {
+			return errors.New("directory exists and is not empty")
+		}
+	} else if !fs.IsNotExist(err) {
+		return err
+	}
+
+	path, err := canonicalFolderPath(filepath.Join(fcfg.Path, name))
+	if err != nil {
+		return err
+	}
+	for _, other := range cfg.Folders {
+		if other.FilesystemType != fcfg.FilesystemType {
+			continue
+		}
+		otherPath, err := canonicalFolderPath(other.Path)
+		if err != nil {
+			continue
+		}
+		if path == otherPath || fs.IsParent(otherPath, path) || fs.IsParent(path, otherPath) {
+			return fmt.Errorf("overlaps with folder %s", other.Description())
+		}
+	}
+	return nil
+}
+
+func canonicalFolderPath(path string) (string, error) {
+	path, err := fs.ExpandTilde(path)
+	if err != nil {
+		return "", err
+	}
+	return filepath.Abs(path)
+}
+
+// configPushes keeps the pending config pushes, saved to the database.
+type configPushes struct {
+	kv *db.NamespacedKV
+
+	mut     sync.Mutex
+	pending []PendingConfigPush
+}
+
+func newConfigPushes(kv *db.NamespacedKV) *configPushes {
+	p := &configPushes{
+		kv:  kv,
+		mut: sync.NewMutex(),
+	}
+	bs, ok, err := kv.Bytes(pendingConfigPushesKey)
+	if err != nil || !ok {
+		return p
+	}
+	if err := json.Unmarshal(bs, &p.pending); err != nil {
+		l.Debugln("Loading pending config pushes:", err)
+	}
+	return p
+}
+
+// add keeps the push pending, replacing one with the same ID from the same
+// device, and dropping the oldest from the device beyond the maximum.
+func (p *configPushes) add(push PendingConfigPush) {
+	p.mut.Lock()
+	defer p.mut.Unlock()
+
+	p.pending = slices.DeleteFunc(p.pending, func(e PendingConfigPush) bool {
+		return e.Device == push.Device && e.ID == push.ID
+	})
+	p.pending = append(p.pending, push)
+	n := 0
+	for i := len(p.pending) - 1; i >= 0; i-- {
+		if p.pending[i].Device != push.Device {
+			continue
+		}
+		if n++; n > maxPendingConfigPushes {
+			p.pending = slices.Delete(p.pending, i, i+1)
+		}
+	}
+	p.saveLocked()
+}
+
+func (p *configPushes) get(device protocol.DeviceID, id int64) (PendingConfigPush, bool) {
+	p.mut.Lock()
+	defer p.mut.Unlock()
+	for _, e := range p.pending {
+		if e.Device == device && e.ID == id {
+			return e, true
+		}
+	}
+	return PendingConfigPush{}, false
+}
+
+// remove drops the pushes matching the filter, returning them.
+func (p *configPushes) remove(match func(PendingConfigPush) bool) []PendingConfigPush {
+	p.mut.Lock()
+	defer p.mut.Unlock()
+	var removed []PendingConfigPush
+	p.pending = slices.DeleteFunc(p.pending, func(e PendingConfigPush) bool {
+		if match(e) {
+			removed = append(removed, e)
+			return true
+		}
+		return false
+	})
+	if len(removed) > 0 {
+		p.saveLocked()
+	}
+	return removed
+}
+
+func (p *configPushes) list() []PendingConfigPush {
+	p.mut.Lock()
+	defer p.mut.Unlock()
+	return slices.Clone(p.pending)
+}
+
+func (p *configPushes) saveLocked() {
+	bs, err := json.Marshal(p.pending)
+	if err == nil {
+		err = p.kv.PutBytes(pendingConfigPushesKey, bs)
+	}
+	if err != nil {
+		l.Warnln("Saving pending config pushes:", err)
+	}
+}
+
+// ConfigPush keeps configuration pushed by a device pending approval, if
+// the device is allowed to manage us.
+func (m *model) ConfigPush(conn protocol.Connection, push *protocol.ConfigPush) error {
+	deviceID := conn.DeviceID()
+	if dcfg, ok := m.cfg.Device(deviceID); !ok || !dcfg.AllowManagement {
+		l.Infof("Ignoring configuration pushed by %v, which is not allowed to manage this device", deviceID)
+		return nil
+	}
+
+	pending := PendingConfigPush{
+		ID:           push.ID,
+		Device:       deviceID,
+		Received:     time.Now().Truncate(time.Second),
+		PushedConfig: pushedConfigFromWire(push),
+	}
+	if err := pending.validate(); err != nil {
+		return err
+	}
+	m.configPushes.add(pending)
+
+	l.Infof("Device %v pushed configuration with %d folders and %d devices, pending approval", deviceID, len(pending.Folders), len(pending.Devices))
+	m.evLogger.Log(events.PendingConfigPushesChanged, map[string]interface{}{
+		"added": []PendingConfigPush{pending},
+	})
+	return nil
+}
+
+// PushConfig sends configuration to a connected device, for it to apply if
+// it allows us to manage it and approves. It returns the ID of the push.
+func (m *model) PushConfig(device protocol.DeviceID, cfg PushedConfig) (int64, error) {
+	if err := cfg.validate(); err != nil {
+		return 0, err
+	}
+
+	m.mut.RLock()
+	var conn protocol.Connection
+	if connIDs, ok := m.deviceConnIDs[device]; ok {
+		conn = m.connections[connIDs[0]]
+	}
+	m.mut.RUnlock()
+	if conn == nil {
+		return 0, errNotConnected
+	}
+	if !m.hasExtension(device, protocol.ExtensionConfigPush) {
+		// Older devices would take the message as a protocol error.
+		return 0, errConfigPushNotSupported
+	}
+
+	id := rand.Int63()
+	ctx, cancel := context.WithTimeout(context.Background(), configPushTimeout)
+	defer cancel()
+	if err := conn.ConfigPush(ctx, cfg.toWire(id)); err != nil {
+		return 0, err
+	}
+	l.Infof("Pushed configuration with %d folders and %d devices to %v", len(cfg.Folders), len(cfg.Devices), device)
+	return id, nil
+}
+
+// PendingConfigPushes lists the configuration pushed by devices, waiting to
+// be approved, oldest first.
+func (m *model) PendingConfigPushes() []PendingConfigPush {
+	return m.configPushes.list()
+}
+
+// AcceptConfigPush applies pending configuration pushed by a device, given
+// it is still allowed to manage us.
+func (m *model) AcceptConfigPush(device protocol.DeviceID, id int64) error {
+	push, ok := m.configPushes.get(device, id)
+	if !ok {
+		return errNoSuchConfigPush
+	}
+	if dcfg, ok := m.cfg.Device(device); !ok || !dcfg.AllowManagement {
+		return errManagementNotAllowed
+	}
+
+	waiter, err := m.cfg.Modify(func(cfg *config.Configuration) {
+		push.apply(cfg, m.id)
+	})
+	if err != nil {
+		return err
+	}
+	waiter.Wait()
+
+	m.removeConfigPushes(func(e PendingConfigPush) bool {
+		return e.Device == device && e.ID == id
+	})
+	return nil
+}
+
+// DismissConfigPush drops pending configuration pushed by a device.
+func (m *model) DismissConfigPush(device protocol.DeviceID, id int64) error {
+	if len(m.removeConfigPushes(func(e PendingConfigPush) bool {
+		return e.Device == device && e.ID == id
+	})) == 0 {
+		return errNoSuchConfigPush
+	}
+	return nil
+}
+
+// cleanPendingConfigPushes drops the pushes from devices that are no longer
+// allowed to manage us.
+func (m *model) cleanPendingConfigPushes(existingDevices map[protocol.DeviceID]config.DeviceConfiguration) {
+	m.removeConfigPushes(func(e PendingConfigPush) bool {
+		dcfg, ok := existingDevices[e.Device]
+		return !ok || !dcfg.AllowManagement
+	})
+}
+
+func (m *model) removeConfigPushes(match func(PendingConfigPush) bool) []PendingConfigPush {
+	removed := m.configPushes.remove(match)
+	if len(removed) == 0 {
+		return nil
+	}
+	removedPushes := make([]map[string]interface{}, 0, len(removed))
+	for _, e := range removed {
+		removedPushes = append(removedPushes, map[string]interface{}{
+			"deviceID": e.Device.String(),
+			"id":       e.ID,
+		})
+	}
+	m.evLogger.Log(events.PendingConfigPushesChanged, map[string]interface{}{
+		"removed": removedPushes,
+	})
+	return removed
+}
@@ -0,0 +1,234 @@
+// Copyright (C) 2026 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package model
+
+import (
+	"errors"
+	"path/filepath"
+	"testing"
+
+	"github.com/syncthing/syncthing/lib/config"
+	"github.com/syncthing/syncthing/lib/fs"
+	"github.com/syncthing/syncthing/lib/protocol"
+)
+
+func TestConfigPush(t *testing.T) {
+	device3, err := protocol.DeviceIDFromString("AIBAEAQ-CAIBAEC-AQCAIBA-EAQCAIA-BAEAQCA-IBAEAQC-CAIBAEA-QCAIBA7")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tcfg := defaultAutoAcceptCfg.Copy()
+	tcfg.Devices[1].AutoAcceptFolders = false
+	tcfg.Devices[1].AllowManagement = true
+	tcfg.Devices[2].AutoAcceptFolders = false
+	m, cancel := newState(t, tcfg)
+	defer cleanupModel(m)
+	defer cancel()
+
+	pushed := PushedConfig{
+		Folders: []PushedFolder{{ID: "managed", Label: "Managed", Devices: []protocol.DeviceID{myID, device1, device3}}},
+		Devices: []PushedDevice{{DeviceID: device3, Name: "device3", Addresses: []string{"tcp://192.0.2.3:22000"}}},
+	}
+
+	// Pushes from devices not allowed to manage us are ignored.
+	if err := m.ConfigPush(newFakeConnection(device2, m), pushed.toWire(1)); err != nil {
+		t.Fatal(err)
+	}
+	if pending := m.PendingConfigPushes(); len(pending) != 0 {
+		t.Fatalf("expected no pending pushes, got %v", pending)
+	}
+
+	fc := newFakeConnection(device1, m)
+	for i := 0; i < 2; i++ {
+		// The second one is a resend, replacing the first.
+		if err := m.ConfigPush(fc, pushed.toWire(1)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	pending := m.PendingConfigPushes()
+	if len(pending) != 1 {
+		t.Fatalf("expected one pending push, got %v", pending)
+	}
+	if p := pending[0]; p.ID != 1 || p.Device != device1 || len(p.Folders) != 1 || len(p.Folders[0].Devices) != 3 || p.Devices[0].Name != "device3" {
+		t.Fatalf("unexpected pending push %+v", p)
+	}
+	if _, ok := m.cfg.Folder("managed"); ok {
+		t.Fatal("pushed folder added before approval")
+	}
+
+	if err := m.AcceptConfigPush(device1, 1); err != nil {
+		t.Fatal(err)
+	}
+	dcfg, ok := m.cfg.Device(device3)
+	if !ok || dcfg.Name != "device3" || len(dcfg.Addresses) != 1 {
+		t.Fatalf("pushed device not added as expected: %+v", dcfg)
+	}
+	fcfg, ok := m.cfg.Folder("managed")
+	if !ok {
+		t.Fatal("pushed folder not added")
+	}
+	if fcfg.Label != "Managed" || !fcfg.SharedWith(device1) || !fcfg.SharedWith(device3) || fcfg.SharedWith(device2) {
+		t.Errorf("pushed folder not added as expected: %+v", fcfg)
+	}
+	if pending := m.PendingConfigPushes(); len(pending) != 0 {
+		t.Fatalf("expected no pending pushes after approval, got %v", pending)
+	}
+	if err := m.AcceptConfigPush(device1, 1); !errors.Is(err, errNoSuchConfigPush) {
+		t.Errorf("expected %v, got %v", errNoSuchConfigPush, err)
+	}
+
+	// Existing folders are only shared more widely, with known devices.
+	extra := PushedConfig{
+		Folders: []PushedFolder{{ID: "managed", Label: "Renamed", Devices: []protocol.DeviceID{device2, protocol.LocalDeviceID}}},
+	}
+	if err := m.ConfigPush(fc, extra.toWire(2)); err != nil {
+		t.Fatal(err)
+	}
+	if err := m.AcceptConfigPush(device1, 2); err != nil {
+		t.Fatal(err)
+	}
+	fcfg, _ = m.cfg.Folder("managed")
+	if fcfg.Label != "Managed" || !fcfg.SharedWith(device2) || fcfg.SharedWith(protocol.LocalDeviceID) || len(fcfg.Devices) != 4 {
+		t.Errorf("existing folder not updated as expected: %+v", fcfg)
+	}
+
+	// Pushes can be dismissed, and are dropped when the device is no
+	// longer allowed to manage us.
+	for id := int64(3); id <= 4; id++ {
+		if err := m.ConfigPush(fc, extra.toWire(id)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := m.DismissConfigPush(device1, 3); err != nil {
+		t.Fatal(err)
+	}
+	if pending := m.PendingConfigPushes(); len(pending) != 1 || pending[0].ID != 4 {
+		t.Fatalf("expected push 4 to remain pending, got %v", pending)
+	}
+	dcfg, _ = m.cfg.Device(device1)
+	dcfg.AllowManagement = false
+	setDevice(t, m.cfg, dcfg)
+	if pending := m.PendingConfigPushes(); len(pending) != 0 {
+		t.Fatalf("expected no pending pushes after revoking management, got %v", pending)
+	}
+}
+
+func TestConfigPushFolderPaths(t *testing.T) {
+	cfg := defaultAutoAcceptCfg.Copy()
+	cfg.Defaults.Folder.Path = t.TempDir()
+	cfg.Defaults.Folder.FilesystemType = fs.FilesystemTypeBasic
+	cfg.Folders = []config.FolderConfiguration{
+		{ID: "existing", FilesystemType: fs.FilesystemTypeBasic, Path: filepath.Join(cfg.Defaults.Folder.Path, "Outer", "inner")},
+		{ID: "missing", FilesystemType: fs.FilesystemTypeBasic, Path: filepath.Join(cfg.Defaults.Folder.Path, "Taken")},
+	}
+
+	ffs := fs.NewFilesystem(fs.FilesystemTypeBasic, cfg.Defaults.Folder.Path)
+	must(t, ffs.MkdirAll("Full", 0o755))
+	fd, err := ffs.Create(filepath.Join("Full", "file"))
+	must(t, err)
+	fd.Close()
+	must(t, ffs.MkdirAll("Empty", 0o755))
+
+	pushed := PushedConfig{Folders: []PushedFolder{
+		{ID: "dot", Label: "."},
+		{ID: ".."},
+		{ID: "full", Label: "Full"},
+		{ID: "outer", Label: "Outer"},
+		{ID: "taken", Label: "Taken"},
+		{ID: "empty", Label: "Empty"},
+		{ID: "new", Label: "New"},
+	}}
+	pushed.apply(&cfg, myID)
+
+	for id, added := range map[string]bool{
+		"dot":   false,
+		"..":    false,
+		"full":  false,
+		"outer": false,
+		"taken": false,
+		"empty": true,
+		"new":   true,
+	} {
+		if _, _, ok := cfg.Folder(id); ok != added {
+			t.Errorf("pushed folder %s: expected added %v, got %v", id, added, ok)
+		}
+	}
+}
+
+func TestConfigPushInvalid(t *testing.T) {
+	tcfg := defaultAutoAcceptCfg.Copy()
+	tcfg.Devices[1].AllowManagement = true
+	m, cancel := newState(t, tcfg)
+	defer cleanupModel(m)
+	defer cancel()
+
+	fc := newFakeConnection(device1, m)
+	for _, push := range []*protocol.ConfigPush{
+		{ID: 1, Folders: []protocol.Folder{{Label: "no ID"}}},
+		{ID: 2, Folders: []protocol.Folder{{ID: "a", Devices: []protocol.Device{{}}}}},
+		{ID: 3, Devices: []protocol.Device{{Name: "no ID"}}},
+	} {
+		if err := m.ConfigPush(fc, push); err == nil {
+			t.Errorf("expected push %d to be rejected", push.ID)
+		}
+	}
+	if pending := m.PendingConfigPushes(); len(pending) != 0 {
+		t.Fatalf("expected no pending pushes, got %v", pending)
+	}
+}
+
+func TestPushConfig(t *testing.T) {
+	m, cancel := newState(t, config.Configuration{
+		Version: config.CurrentVersion,
+		Devices: []config.DeviceConfiguration{{DeviceID: myID}, {DeviceID: device1}},
+	})
+	defer cleanupModel(m)
+	defer cancel()
+
+	pushed := PushedConfig{
+		Folders: []PushedFolder{{ID: "managed", Label: "Managed", Devices: []protocol.DeviceID{myID, device1}}},
+		Devices: []PushedDevice{{DeviceID: device2, Name: "device2"}},
+	}
+	m.mut.RLock()
+	fc := m.connections[m.deviceConnIDs[device1][0]].(*fakeConnection)
+	m.mut.RUnlock()
+	if err := m.ClusterConfig(fc, &protocol.ClusterConfig{Extensions: protocol.SupportedExtensions()}); err != nil {
+		t.Fatal(err)
+	}
+
+	id, err := m.PushConfig(device1, pushed)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n := fc.ConfigPushCallCount(); n != 1 {
+		t.Fatalf("expected one push to be sent, got %d", n)
+	}
+	_, push := fc.ConfigPushArgsForCall(0)
+	if push.ID != id {
+		t.Errorf("sent push %d, returned %d", push.ID, id)
+	}
+	got := pushedConfigFromWire(push)
+	if len(got.Folders) != 1 || got.Folders[0].Label != "Managed" || len(got.Folders[0].Devices) != 2 || got.Devices[0].DeviceID != device2 {
+		t.Errorf("unexpected push sent: %+v", got)
+	}
+
+	if _, err := m.PushConfig(device2, pushed); !errors.Is(err, errNotConnected) {
+		t.Errorf("expected %v, got %v", errNotConnected, err)
+	}
+
+	// Devices not announcing the extension don't get pushes.
+	if err := m.ClusterConfig(fc, &protocol.ClusterConfig{}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := m.PushConfig(device1, pushed); !errors.Is(err, errConfigPushNotSupported) {
+		t.Errorf("expected %v, got %v", errConfigPushNotSupported, err)
+	}
+	if n := fc.ConfigPushCallCount(); n != 1 {
+		t.Errorf("expected no further push to be sent, got %d", n)
+	}
+}
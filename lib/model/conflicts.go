@@ -0,0 +1,168 @@
+// Copyright (C) 2026 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package model
+
+import (
+	"errors"
+	"regexp"
+	"sort"
+	"time"
+
+	"github.com/syncthing/syncthing/lib/config"
+	"github.com/syncthing/syncthing/lib/db"
+	"github.com/syncthing/syncthing/lib/fs"
+	"github.com/syncthing/syncthing/lib/protocol"
+)
+
+var (
+	ErrNotConflict          = errors.New("not a conflict copy")
+	ErrNoSuchConflict       = errors.New("no such conflict copy")
+	errConflictOriginalType = errors.New("the original is not a regular file")
+	errConflictEncrypted    = errors.New("conflicts can not be resolved on receive-encrypted folders")
+)
+
+// A Conflict describes a conflict copy present in a folder, i.e. a local
+// version of a file that lost against a concurrent remote change and was
+// kept alongside it for manual resolution.
+type Conflict struct {
+	Name       string        `json:"name"`       // the conflict copy
+	Original   string        `json:"original"`   // the file it conflicts with
+	Time       time.Time     `json:"time"`       // when the conflict occurred
+	ModifiedBy string        `json:"modifiedBy"` // short ID of the device that made the winning change
+	Conflict   ConflictFile  `json:"conflict"`   // the conflict copy
+	Current    *ConflictFile `json:"current"`    // the original file, nil if it no longer exists
+}
+
+// A ConflictFile holds the metadata of one side of a conflict.
+type ConflictFile struct {
+	Size       int64           `json:"size"`
+	ModTime    time.Time       `json:"modTime"`
+	ModifiedBy string          `json:"modifiedBy"`
+	Version    protocol.Vector `json:"version"`
+}
+
+func newConflictFile(fi protocol.FileIntf) ConflictFile {
+	return ConflictFile{
+		Size:       fi.FileSize(),
+		ModTime:    fi.ModTime(),
+		ModifiedBy: fi.FileModifiedBy().String(),
+		Version:    fi.FileVersion(),
+	}
+}
+
+var conflictNameRe = regexp.MustCompile(`^(.*)\.sync-conflict-(\d{8}-\d{6})-([A-Z0-9]*)(.*)$`)
+
+// parseConflictName returns the original name, conflict time and the
+// device responsible for a conflict copy name as created by conflictName.
+func parseConflictName(name string) (string, time.Time, string, bool) {
+	m := conflictNameRe.FindStringSubmatch(name)
+	if m == nil {
+		return "", time.Time{}, "", false
+	}
+	t, err := time.ParseInLocation("20060102-150405", m[2], time.Local)
+	if err != nil {
+		return "", time.Time{}, "", false
+	}
+	return m[1] + m[4], t, m[3], true
+}
+
+// conflictsFromSnapshot returns all conflict copies present in the local
+// index, sorted by name.
+func conflictsFromSnapshot(snap *db.Snapshot) []Conflict {
+	var conflicts []Conflict
+	snap.WithHaveTruncated(protocol.LocalDeviceID, func(fi protocol.FileIntf) bool {
+		if fi.IsDeleted() || fi.IsInvalid() || fi.FileType() != protocol.FileInfoTypeFile || !isConflict(fi.FileName()) {
+			return true
+		}
+		original, t, id, ok := parseConflictName(fi.FileName())
+		if !ok {
+			return true
+		}
+		c := Conflict{
+			Name:       fi.FileName(),
+			Original:   original,
+			Time:       t,
+			ModifiedBy: id,
+			Conflict:   newConflictFile(fi),
+		}
+		if cur, ok := snap.Get(protocol.LocalDeviceID, original); ok && !cur.IsDeleted() && !cur.IsInvalid() {
+			cf := newConflictFile(cur)
+			c.Current = &cf
+		}
+		conflicts = append(conflicts, c)
+		return true
+	})
+	sort.Slice(conflicts, func(a, b int) bool {
+		return conflicts[a].Name < conflicts[b].Name
+	})
+	return conflicts
+}
+
+// ResolveConflict resolves the conflict represented by the given conflict
+// copy. If keepConflict is true the conflict copy replaces the original
+// file, otherwise the conflict copy is removed. The losing file is archived
+// when versioning is enabled.
+func (f *folder) ResolveConflict(name string, keepConflict bool) error {
+	<-f.initialScanFinished
+	return f.doInSync(func() error { return f.resolveConflictCopy(name, keepConflict) })
+}
+
+func (f *folder) resolveConflictCopy(name string, keepConflict bool) error {
+	if f.Type == config.FolderTypeReceiveEncrypted {
+		return errConflictEncrypted
+	}
+	original, _, _, ok := parseConflictName(name)
+	if !ok {
+		return ErrNotConflict
+	}
+
+	snap, err := f.dbSnapshot()
+	if err != nil {
+		return err
+	}
+	cf, ok := snap.Get(protocol.LocalDeviceID, name)
+	snap.Release()
+	if !ok || cf.IsDeleted() || cf.IsInvalid() || cf.Type != protocol.FileInfoTypeFile {
+		return ErrNoSuchConflict
+	}
+
+	remove := func(name string) error {
+		if f.versioner != nil {
+			return inWritableDir(f.versioner.Archive, f.mtimefs, name, f.IgnorePerms)
+		}
+		return inWritableDir(f.mtimefs.Remove, f.mtimefs, name, f.IgnorePerms)
+	}
+
+	if keepConflict {
+		info, err := f.mtimefs.Lstat(original)
+		switch {
+		case fs.IsNotExist(err):
+		case err != nil:
+			return err
+		case !info.IsRegular():
+			return errConflictOriginalType
+		default:
+			if err := remove(original); err != nil {
+				return err
+			}
+		}
+		err = inWritableDir(func(name string) error {
+			return f.mtimefs.Rename(name, original)
+		}, f.mtimefs, name, f.IgnorePerms)
+		if err != nil {
+			return err
+		}
+		l.Infof("Folder %v: resolved conflict on %q by keeping the conflict copy", f.Description(), original)
+	} else {
+		if err := remove(name); err != nil {
+			return err
+		}
+		l.Infof("Folder %v: resolved conflict on %q by keeping the current version", f.Description(), original)
+	}
+
+	return f.scanSubdirs([]string{name, original})
+}
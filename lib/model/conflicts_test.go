@@ -0,0 +1,106 @@
+// Copyright (C) 2026 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package model
+
+import (
+	"io"
+	"path/filepath"
+	"testing"
+
+	"github.com/syncthing/syncthing/lib/fs"
+)
+
+func TestParseConflictName(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		original string
+		by       string
+		ok       bool
+	}{
+		{"foo.sync-conflict-20240102-150405-ABCDEFG.txt", "foo.txt", "ABCDEFG", true},
+		{filepath.Join("a", "b", "foo.sync-conflict-20240102-150405-ABCDEFG"), filepath.Join("a", "b", "foo"), "ABCDEFG", true},
+		{"foo.sync-conflict-20240102-150405-.tar.gz", "foo.tar.gz", "", true},
+		{"foo.txt", "", "", false},
+		{"foo.sync-conflict-garbage.txt", "", "", false},
+	} {
+		original, when, by, ok := parseConflictName(tc.name)
+		if ok != tc.ok {
+			t.Errorf("%s: expected ok=%v", tc.name, tc.ok)
+			continue
+		}
+		if !ok {
+			continue
+		}
+		if original != tc.original || by != tc.by {
+			t.Errorf("%s: got %q, %q; expected %q, %q", tc.name, original, by, tc.original, tc.by)
+		}
+		if when.Year() != 2024 || when.Month() != 1 || when.Day() != 2 || when.Hour() != 15 {
+			t.Errorf("%s: wrong time %v", tc.name, when)
+		}
+	}
+}
+
+func TestConflictNameRoundtrip(t *testing.T) {
+	name := conflictName(filepath.Join("dir", "file.txt"), device1.Short().String())
+	original, _, by, ok := parseConflictName(name)
+	if !ok || original != filepath.Join("dir", "file.txt") || by != device1.Short().String() {
+		t.Errorf("failed to parse %q: %q %q %v", name, original, by, ok)
+	}
+}
+
+func TestResolveConflict(t *testing.T) {
+	for _, keepConflict := range []bool{false, true} {
+		m, f, wcfgCancel := setupSendReceiveFolder(t)
+		defer wcfgCancel()
+		ffs := f.Filesystem(nil)
+
+		name := "foo.txt"
+		confl := conflictName(name, device1.Short().String())
+		writeFile(t, ffs, name, []byte("current"))
+		writeFile(t, ffs, confl, []byte("conflict copy"))
+		must(t, f.scanSubdirs(nil))
+
+		conflicts, err := m.Conflicts(f.ID)
+		must(t, err)
+		if len(conflicts) != 1 {
+			t.Fatalf("expected one conflict, got %d", len(conflicts))
+		}
+		if c := conflicts[0]; c.Name != confl || c.Original != name || c.Current == nil || c.Current.Size != int64(len("current")) || c.Conflict.Size != int64(len("conflict copy")) {
+			t.Fatalf("unexpected conflict %+v", c)
+		}
+
+		if err := f.resolveConflictCopy(name, keepConflict); err != ErrNotConflict {
+			t.Errorf("expected %v, got %v", ErrNotConflict, err)
+		}
+		must(t, f.resolveConflictCopy(confl, keepConflict))
+
+		if _, err := ffs.Lstat(confl); !fs.IsNotExist(err) {
+			t.Error("conflict copy should be gone")
+		}
+		fd, err := ffs.Open(name)
+		must(t, err)
+		bs, err := io.ReadAll(fd)
+		fd.Close()
+		must(t, err)
+		exp := "current"
+		if keepConflict {
+			exp = "conflict copy"
+		}
+		if string(bs) != exp {
+			t.Errorf("expected content %q, got %q", exp, bs)
+		}
+
+		conflicts, err = m.Conflicts(f.ID)
+		must(t, err)
+		if len(conflicts) != 0 {
+			t.Errorf("expected no conflicts after resolving, got %v", conflicts)
+		}
+		if err := f.resolveConflictCopy(confl, keepConflict); err != ErrNoSuchConflict {
+			t.Errorf("expected %v, got %v", ErrNoSuchConflict, err)
+		}
+	}
+}
@@ -7,37 +7,76 @@
 package model
 
 import (
+	"context"
+	"errors"
+	"math"
+	"time"
+
 	"github.com/syncthing/syncthing/lib/protocol"
 	"github.com/syncthing/syncthing/lib/sync"
 )
 
-// deviceActivity tracks the number of outstanding requests per device and can
-// answer which device is least busy. It is safe for use from multiple
+const (
+	// The weight of each new request in the moving averages of a device's
+	// latency, throughput and error rate.
+	sourceStatsAlpha = 0.2
+	// The time for the error rate to halve when there are no new requests,
+	// so that devices that failed a while ago get another chance.
+	sourceErrorHalfLife = time.Minute
+)
+
+// deviceActivity tracks the number of outstanding requests per device and
+// how well the requests to each device went recently, and can answer which
+// device is the best source for a block. It is safe for use from multiple
 // goroutines.
 type deviceActivity struct {
-	act map[protocol.DeviceID]int
-	mut sync.Mutex
+	act   map[protocol.DeviceID]int
+	stats map[protocol.DeviceID]*sourceStats
+	mut   sync.Mutex
+}
+
+// sourceStats are moving averages over the recent requests to a device.
+type sourceStats struct {
+	latency     time.Duration
+	throughput  float64 // bytes per second
+	errorRate   float64
+	errorRateAt time.Time
+}
+
+// BlockSourceStats describes how well requests to a device went recently,
+// and the resulting weight when selecting a device to pull a block from.
+type BlockSourceStats struct {
+	Outstanding int     `json:"outstanding"`
+	LatencyS    float64 `json:"latencyS"`
+	Throughput  float64 `json:"throughput"`
+	ErrorRate   float64 `json:"errorRate"`
+	Weight      float64 `json:"weight"`
 }
 
 func newDeviceActivity() *deviceActivity {
 	return &deviceActivity{
-		act: make(map[protocol.DeviceID]int),
-		mut: sync.NewMutex(),
+		act:   make(map[protocol.DeviceID]int),
+		stats: make(map[protocol.DeviceID]*sourceStats),
+		mut:   sync.NewMutex(),
 	}
 }
 
-// Returns the index of the least busy device, or -1 if all are too busy.
+// Returns the index of the device with the highest weight, or -1 if there
+// are no devices. Without any history of requests this is the least busy
+// device.
 func (m *deviceActivity) leastBusy(availability []Availability) int {
 	m.mut.Lock()
-	low := 2<<30 - 1
+	defer m.mut.Unlock()
+	now := time.Now()
+	defThroughput := m.meanThroughputLocked()
 	best := -1
+	var high float64
 	for i := range availability {
-		if usage := m.act[availability[i].ID]; usage < low {
-			low = usage
+		if weight := m.weightLocked(now, availability[i].ID, defThroughput); best == -1 || weight > high {
+			high = weight
 			best = i
 		}
 	}
-	m.mut.Unlock()
 	return best
 }
 
@@ -52,3 +91,104 @@ func (m *deviceActivity) done(availability Availability) {
 	m.act[availability.ID]--
 	m.mut.Unlock()
 }
+
+// record adds the outcome of a request of the given size to the device's
+// recent history. Requests that were given up on locally aren't the
+// device's fault and don't count.
+func (m *deviceActivity) record(availability Availability, bytes int, latency time.Duration, err error) {
+	if errors.Is(err, context.Canceled) {
+		return
+	}
+	m.mut.Lock()
+	defer m.mut.Unlock()
+	m.recordLocked(time.Now(), availability.ID, bytes, latency, err)
+}
+
+func (m *deviceActivity) recordLocked(now time.Time, device protocol.DeviceID, bytes int, latency time.Duration, err error) {
+	st, ok := m.stats[device]
+	if !ok {
+		st = new(sourceStats)
+		m.stats[device] = st
+	}
+
+	failed := 0.0
+	if err != nil {
+		failed = 1
+	}
+	st.errorRate = st.currentErrorRate(now)*(1-sourceStatsAlpha) + failed*sourceStatsAlpha
+	st.errorRateAt = now
+	if err != nil {
+		return
+	}
+
+	throughput := float64(bytes) / max(latency.Seconds(), 1e-3)
+	if st.throughput == 0 {
+		st.latency = latency
+		st.throughput = throughput
+		return
+	}
+	st.latency = time.Duration(float64(st.latency)*(1-sourceStatsAlpha) + float64(latency)*sourceStatsAlpha)
+	st.throughput = st.throughput*(1-sourceStatsAlpha) + throughput*sourceStatsAlpha
+}
+
+// currentErrorRate returns the error rate, decayed by the time since it
+// was last updated.
+func (st *sourceStats) currentErrorRate(now time.Time) float64 {
+	if st.errorRate == 0 {
+		return 0
+	}
+	return st.errorRate * math.Exp2(-float64(now.Sub(st.errorRateAt))/float64(sourceErrorHalfLife))
+}
+
+// weightLocked returns how attractive the device is as a source for the
+// next request: its throughput, shared among the requests already
+// outstanding to it, discounted heavily for failing requests. Devices we
+// know nothing about are assumed to be average, so that they get tried.
+func (m *deviceActivity) weightLocked(now time.Time, device protocol.DeviceID, defThroughput float64) float64 {
+	throughput, errorRate := defThroughput, 0.0
+	if st, ok := m.stats[device]; ok {
+		if st.throughput > 0 {
+			throughput = st.throughput
+		}
+		errorRate = st.currentErrorRate(now)
+	}
+	success := 1 - errorRate
+	return throughput * success * success / float64(m.act[device]+1)
+}
+
+// meanThroughputLocked returns the mean throughput of the devices with a
+// history of successful requests, or one if there are none.
+func (m *deviceActivity) meanThroughputLocked() float64 {
+	var sum float64
+	var n int
+	for _, st := range m.stats {
+		if st.throughput > 0 {
+			sum += st.throughput
+			n++
+		}
+	}
+	if n == 0 {
+		return 1
+	}
+	return sum / float64(n)
+}
+
+// blockSourceStats returns the recent history and current weight of each device
+// that has been requested from.
+func (m *deviceActivity) blockSourceStats() map[protocol.DeviceID]BlockSourceStats {
+	m.mut.Lock()
+	defer m.mut.Unlock()
+	now := time.Now()
+	defThroughput := m.meanThroughputLocked()
+	res := make(map[protocol.DeviceID]BlockSourceStats, len(m.stats))
+	for device, st := range m.stats {
+		res[device] = BlockSourceStats{
+			Outstanding: m.act[device],
+			LatencyS:    st.latency.Seconds(),
+			Throughput:  st.throughput,
+			ErrorRate:   st.currentErrorRate(now),
+			Weight:      m.weightLocked(now, device, defThroughput),
+		}
+	}
+	return res
+}
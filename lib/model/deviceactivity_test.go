@@ -8,6 +8,7 @@ package model
 
 import (
 	"testing"
+	"time"
 
 	"github.com/syncthing/syncthing/lib/protocol"
 )
@@ -58,3 +59,63 @@ func TestDeviceActivity(t *testing.T) {
 		t.Errorf("Least busy device should be n0 (%v) not %v", n0, lb)
 	}
 }
+
+func TestDeviceActivityWeights(t *testing.T) {
+	fast := Availability{protocol.DeviceID([32]byte{1, 2, 3, 4}), false}
+	slow := Availability{protocol.DeviceID([32]byte{5, 6, 7, 8}), false}
+	fresh := Availability{protocol.DeviceID([32]byte{9, 10, 11, 12}), false}
+	devices := []Availability{slow, fast}
+	na := newDeviceActivity()
+	now := time.Now()
+
+	// A device answering ten times as fast is preferred, until it would
+	// have ten times as many requests outstanding.
+	for i := 0; i < 10; i++ {
+		na.recordLocked(now, fast.ID, 128<<10, 10*time.Millisecond, nil)
+		na.recordLocked(now, slow.ID, 128<<10, 100*time.Millisecond, nil)
+	}
+	for i := 0; i < 9; i++ {
+		if lb := na.leastBusy(devices); lb != 1 {
+			t.Fatalf("Fast device should be selected with %d requests outstanding, not %v", i, lb)
+		}
+		na.using(fast)
+	}
+	if lb := na.leastBusy(devices); lb != 0 {
+		t.Errorf("Slow device should be selected when the fast one is busy, not %v", lb)
+	}
+	for i := 0; i < 9; i++ {
+		na.done(fast)
+	}
+
+	// A device we know nothing about is taken to be average, and thus
+	// preferred over the slow one.
+	if lb := na.leastBusy([]Availability{slow, fresh}); lb != 1 {
+		t.Errorf("Fresh device should be selected over the slow one, not %v", lb)
+	}
+
+	// Failing requests make the fast device lose out...
+	for i := 0; i < 10; i++ {
+		na.recordLocked(now, fast.ID, 0, 10*time.Millisecond, protocol.ErrClosed)
+	}
+	if lb := na.leastBusy(devices); lb != 0 {
+		t.Errorf("Slow device should be selected over the failing one, not %v", lb)
+	}
+
+	// ... until the errors are some time in the past.
+	fastStats := na.stats[fast.ID]
+	fastStats.errorRateAt = fastStats.errorRateAt.Add(-10 * sourceErrorHalfLife)
+	if lb := na.leastBusy(devices); lb != 1 {
+		t.Errorf("Fast device should be selected again after a while, not %v", lb)
+	}
+
+	stats := na.blockSourceStats()
+	if len(stats) != 2 {
+		t.Fatalf("Expected stats for two devices, got %v", stats)
+	}
+	if st := stats[slow.ID]; st.ErrorRate != 0 || st.LatencyS < 0.099 || st.LatencyS > 0.101 {
+		t.Errorf("Unexpected stats for the slow device: %+v", st)
+	}
+	if stats[fast.ID].Weight <= stats[slow.ID].Weight {
+		t.Errorf("Fast device should weigh more than the slow one: %v", stats)
+	}
+}
@@ -0,0 +1,117 @@
+// Copyright (C) 2026 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package model
+
+import (
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+)
+
+// The most paths listed as examples in an error digest.
+const maxErrorDigestPaths = 10
+
+// FileErrorDigest groups the folder errors with the same cause, such as
+// all the permission problems in a subtree, which are otherwise thousands
+// of entries differing only by path.
+type FileErrorDigest struct {
+	Err       string    `json:"error"`
+	Count     int       `json:"count"`
+	Paths     []string  `json:"paths"` // the first few, sorted
+	FirstSeen time.Time `json:"firstSeen"`
+	LastSeen  time.Time `json:"lastSeen"`
+}
+
+// errorDigests tracks when errors with each cause were first and last seen.
+// A cause is forgotten once none of the folder's errors has it anymore, so
+// it's new again if it recurs.
+type errorDigests struct {
+	seen map[string]errorSeen
+}
+
+type errorSeen struct {
+	first, last time.Time
+}
+
+func newErrorDigests() *errorDigests {
+	return &errorDigests{seen: make(map[string]errorSeen)}
+}
+
+// record notes that an error occurred for the path.
+func (d *errorDigests) record(path, err string, now time.Time) {
+	cause := errorCause(path, err)
+	s, ok := d.seen[cause]
+	if !ok {
+		s.first = now
+	}
+	s.last = now
+	d.seen[cause] = s
+}
+
+// digest groups the errors by cause, those with the most errors first.
+func (d *errorDigests) digest(errs []FileError) []FileErrorDigest {
+	byCause := make(map[string]*FileErrorDigest)
+	var digests []*FileErrorDigest
+	for _, fe := range errs {
+		cause := errorCause(fe.Path, fe.Err)
+		dg, ok := byCause[cause]
+		if !ok {
+			s := d.seen[cause]
+			dg = &FileErrorDigest{Err: cause, FirstSeen: s.first, LastSeen: s.last}
+			byCause[cause] = dg
+			digests = append(digests, dg)
+		}
+		dg.Count++
+		dg.Paths = append(dg.Paths, fe.Path)
+	}
+	for cause := range d.seen {
+		if _, ok := byCause[cause]; !ok {
+			delete(d.seen, cause)
+		}
+	}
+
+	res := make([]FileErrorDigest, len(digests))
+	for i, dg := range digests {
+		sort.Strings(dg.Paths)
+		if len(dg.Paths) > maxErrorDigestPaths {
+			dg.Paths = dg.Paths[:maxErrorDigestPaths]
+		}
+		res[i] = *dg
+	}
+	sort.Slice(res, func(a, b int) bool {
+		if res[a].Count != res[b].Count {
+			return res[a].Count > res[b].Count
+		}
+		return res[a].Err < res[b].Err
+	})
+	return res
+}
+
+// errorCause returns the error without the parts naming the file it
+// occurred for, as in "syncing: permission denied" for "syncing: open
+// /data/dir/file: permission denied". Those are the parts ending in a path
+// or the file name.
+func errorCause(path, err string) string {
+	name := filepath.Base(path)
+	parts := strings.Split(err, ": ")
+	kept := parts[:0]
+	for _, part := range parts {
+		fields := strings.Fields(part)
+		if len(fields) > 0 {
+			last := fields[len(fields)-1]
+			if strings.ContainsAny(last, `/\`) || last == name || last == path {
+				continue
+			}
+		}
+		kept = append(kept, part)
+	}
+	if len(kept) == 0 {
+		return err
+	}
+	return strings.Join(kept, ": ")
+}
@@ -0,0 +1,67 @@
+// Copyright (C) 2026 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package model
+
+import (
+	"fmt"
+	"testing"
+	"time"
+)
+
+func TestErrorCause(t *testing.T) {
+	cases := []struct {
+		path, err, cause string
+	}{
+		{"dir/file", "syncing: open /data/dir/file: permission denied", "syncing: permission denied"},
+		{"dir/file", `syncing: open C:\data\dir\file: Access is denied.`, "syncing: Access is denied."},
+		{"file", "lstat file: no such file or directory", "no such file or directory"},
+		{"dir/file", "syncing: peers who had this file went away", "syncing: peers who had this file went away"},
+		{"dir/file", "open /data/dir/file", "open /data/dir/file"},
+	}
+	for _, tc := range cases {
+		if cause := errorCause(tc.path, tc.err); cause != tc.cause {
+			t.Errorf("errorCause(%q, %q) = %q, expected %q", tc.path, tc.err, cause, tc.cause)
+		}
+	}
+}
+
+func TestErrorDigests(t *testing.T) {
+	d := newErrorDigests()
+	t0 := time.Unix(1000, 0)
+	t1 := time.Unix(2000, 0)
+
+	var errs []FileError
+	for i := 0; i < 50; i++ {
+		path := fmt.Sprintf("sub/file%02d", i)
+		err := "syncing: open /data/" + path + ": permission denied"
+		d.record(path, err, t0)
+		errs = append(errs, FileError{Path: path, Err: err})
+	}
+	d.record("other", "syncing: hash mismatch", t0)
+	d.record("other", "syncing: hash mismatch", t1)
+	errs = append(errs, FileError{Path: "other", Err: "syncing: hash mismatch"})
+
+	digests := d.digest(errs)
+	if len(digests) != 2 {
+		t.Fatalf("got %d digests, expected 2: %+v", len(digests), digests)
+	}
+	perm, hash := digests[0], digests[1]
+	if perm.Err != "syncing: permission denied" || perm.Count != 50 || len(perm.Paths) != maxErrorDigestPaths || perm.Paths[0] != "sub/file00" {
+		t.Errorf("unexpected digest %+v", perm)
+	}
+	if hash.Count != 1 || !hash.FirstSeen.Equal(t0) || !hash.LastSeen.Equal(t1) {
+		t.Errorf("unexpected digest %+v", hash)
+	}
+
+	// Causes that are gone are forgotten, and new again when they recur.
+	d.digest(errs[:1])
+	d.record("other", "syncing: hash mismatch", t1)
+	digests = d.digest(errs[len(errs)-1:])
+	if len(digests) != 1 || !digests[0].FirstSeen.Equal(t1) {
+		t.Errorf("unexpected digests %+v", digests)
+	}
+}
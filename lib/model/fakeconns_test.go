@@ -168,9 +168,10 @@ func (f *fakeConnection) sendIndexUpdate() {
 func addFakeConn(m *testModel, dev protocol.DeviceID, folderID string) *fakeConnection {
 	fc := newFakeConnection(dev, m)
 	fc.folder = folderID
-	m.AddConnection(fc, protocol.Hello{})
+	m.AddConnection(fc, protocol.Hello{Extensions: protocol.SupportedExtensions()})
 
 	m.ClusterConfig(fc, &protocol.ClusterConfig{
+		Extensions: protocol.SupportedExtensions(),
 		Folders: []protocol.Folder{
 			{
 				ID: folderID,
@@ -0,0 +1,92 @@
+// Copyright (C) 2026 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package model
+
+import (
+	"slices"
+	"time"
+
+	"github.com/syncthing/syncthing/lib/protocol"
+)
+
+// Sources of file history entries.
+const (
+	FileHistoryIndex   = "index"
+	FileHistoryArchive = "archive"
+)
+
+// FileHistoryEntry is a known state of a file at some point in time. Index
+// entries are the current version of the file announced by a device and
+// archive entries are old versions kept by the versioner.
+type FileHistoryEntry struct {
+	Time       time.Time
+	Source     string
+	Device     protocol.DeviceID // the device announcing the version, for index entries
+	ModifiedBy protocol.ShortID
+	Deleted    bool
+	Size       int64
+	Version    protocol.Vector
+}
+
+// FileHistory returns what is known about the versions of the file, from
+// the indexes of all devices sharing the folder and from the versioner,
+// oldest first.
+func (m *model) FileHistory(folder, file string) ([]FileHistoryEntry, error) {
+	snap, err := m.DBSnapshot(folder)
+	if err != nil {
+		return nil, err
+	}
+	defer snap.Release()
+
+	m.mut.RLock()
+	ver := m.folderVersioners[folder]
+	m.mut.RUnlock()
+
+	var entries []FileHistoryEntry
+	fcfg, _ := m.cfg.Folder(folder)
+	devices := append([]protocol.DeviceID{protocol.LocalDeviceID}, fcfg.DeviceIDs()...)
+	for _, device := range devices {
+		if device == m.id {
+			continue
+		}
+		fi, ok := snap.Get(device, file)
+		if !ok {
+			continue
+		}
+		if device == protocol.LocalDeviceID {
+			device = m.id
+		}
+		entries = append(entries, FileHistoryEntry{
+			Time:       fi.ModTime(),
+			Source:     FileHistoryIndex,
+			Device:     device,
+			ModifiedBy: fi.ModifiedBy,
+			Deleted:    fi.IsDeleted(),
+			Size:       fi.Size,
+			Version:    fi.Version,
+		})
+	}
+
+	if ver != nil {
+		versions, err := ver.GetVersions()
+		if err != nil {
+			return nil, err
+		}
+		for _, v := range versions[file] {
+			entries = append(entries, FileHistoryEntry{
+				Time:   v.ModTime,
+				Source: FileHistoryArchive,
+				Size:   v.Size,
+			})
+		}
+	}
+
+	slices.SortStableFunc(entries, func(a, b FileHistoryEntry) int {
+		return a.Time.Compare(b.Time)
+	})
+	return entries, nil
+}
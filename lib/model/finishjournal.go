@@ -0,0 +1,92 @@
+// Copyright (C) 2026 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package model
+
+import (
+	"github.com/syncthing/syncthing/lib/db"
+	"github.com/syncthing/syncthing/lib/protocol"
+	"github.com/syncthing/syncthing/lib/sync"
+)
+
+// finishJournal is a write-ahead journal of the files the puller is
+// finishing, i.e. moving into place and setting metadata on, until they are
+// committed to the database. A crash in between would otherwise leave the
+// new file on disk with the old one in the database, and the next scan
+// would take it for a local change. Instead, on startup, the journaled
+// files matching what is on disk are committed.
+type finishJournal struct {
+	kv      *db.NamespacedKV
+	mut     sync.Mutex
+	pending map[string]struct{} // names journaled by us, not yet removed
+}
+
+func newFinishJournal(kv *db.NamespacedKV) *finishJournal {
+	return &finishJournal{
+		kv:      kv,
+		mut:     sync.NewMutex(),
+		pending: make(map[string]struct{}),
+	}
+}
+
+// add journals the file about to be finished.
+func (j *finishJournal) add(file protocol.FileInfo) error {
+	bs, err := file.Marshal()
+	if err != nil {
+		return err
+	}
+	j.mut.Lock()
+	defer j.mut.Unlock()
+	if err := j.kv.PutBytes(file.Name, bs); err != nil {
+		return err
+	}
+	j.pending[file.Name] = struct{}{}
+	return nil
+}
+
+// remove drops the journal entries of the given files, if any, which have
+// been committed or failed to finish.
+func (j *finishJournal) remove(names ...string) {
+	j.mut.Lock()
+	defer j.mut.Unlock()
+	for _, name := range names {
+		if _, ok := j.pending[name]; !ok {
+			continue
+		}
+		if err := j.kv.Delete(name); err != nil {
+			l.Debugln("Removing finish journal entry:", err)
+			continue
+		}
+		delete(j.pending, name)
+	}
+}
+
+// files returns the journaled files left over from a previous run, to be
+// removed once handled.
+func (j *finishJournal) files() []protocol.FileInfo {
+	j.mut.Lock()
+	defer j.mut.Unlock()
+	var files []protocol.FileInfo
+	var invalid []string
+	err := j.kv.Each(func(name string, bs []byte) error {
+		var file protocol.FileInfo
+		if err := file.Unmarshal(bs); err != nil {
+			l.Debugf("Loading finish journal entry for %s: %v", name, err)
+			invalid = append(invalid, name)
+			return nil
+		}
+		j.pending[name] = struct{}{}
+		files = append(files, file)
+		return nil
+	})
+	if err != nil {
+		l.Debugln("Loading finish journal:", err)
+	}
+	for _, name := range invalid {
+		_ = j.kv.Delete(name)
+	}
+	return files
+}
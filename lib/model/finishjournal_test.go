@@ -0,0 +1,70 @@
+// Copyright (C) 2026 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package model
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/syncthing/syncthing/lib/protocol"
+	"github.com/syncthing/syncthing/lib/scanner"
+)
+
+func TestFinishJournalRecover(t *testing.T) {
+	m, f, wcfgCancel := setupSendReceiveFolder(t)
+	defer wcfgCancel()
+
+	for _, name := range []string{"finished", "unfinished"} {
+		writeFile(t, f.mtimefs, name, []byte("old contents"))
+	}
+	must(t, f.scanSubdirs(nil))
+
+	// The new versions were journaled, but we stopped before committing
+	// them, after only one was moved into place.
+	newData := []byte("new contents, longer")
+	modTime := time.Unix(1234567890, 0)
+	newFile := func(name string) protocol.FileInfo {
+		file, ok := m.testCurrentFolderFile(f.ID, name)
+		if !ok {
+			t.Fatal("file missing")
+		}
+		blocks, err := scanner.Blocks(context.Background(), bytes.NewReader(newData), protocol.MinBlockSize, int64(len(newData)), nil, true)
+		must(t, err)
+		file.Blocks = blocks
+		file.BlocksHash = protocol.BlocksHash(blocks)
+		file.Size = int64(len(newData))
+		file.ModifiedS = modTime.Unix()
+		file.ModifiedNs = 0
+		file.Version = file.Version.Update(device1.Short())
+		must(t, f.finishJournal.add(file))
+		return file
+	}
+	finished := newFile("finished")
+	unfinished := newFile("unfinished")
+	writeFile(t, f.mtimefs, finished.Name, newData)
+	must(t, f.mtimefs.Chtimes(finished.Name, modTime, modTime))
+
+	f.recoverFinishJournal()
+
+	if cur, ok := m.testCurrentFolderFile(f.ID, finished.Name); !ok || !cur.Version.Equal(finished.Version) {
+		t.Errorf("expected the finished file to be committed with version %v, got %v", finished.Version, cur.Version)
+	}
+	if cur, ok := m.testCurrentFolderFile(f.ID, unfinished.Name); !ok || cur.Version.Equal(unfinished.Version) {
+		t.Error("the unfinished file should not be committed")
+	}
+	if files := f.finishJournal.files(); len(files) != 0 {
+		t.Error("unexpected journal entries", files)
+	}
+
+	// A rescan finds nothing changed
+	must(t, f.scanSubdirs(nil))
+	if cur, _ := m.testCurrentFolderFile(f.ID, finished.Name); !cur.Version.Equal(finished.Version) {
+		t.Errorf("expected version %v after rescan, got %v", finished.Version, cur.Version)
+	}
+}
@@ -44,14 +44,15 @@ type folder struct {
 
 	localFlags uint32
 
-	model         *model
-	shortID       protocol.ShortID
-	fset          *db.FileSet
-	ignores       *ignore.Matcher
-	mtimefs       fs.Filesystem
-	modTimeWindow time.Duration
-	ctx           context.Context // used internally, only accessible on serve lifetime
-	done          chan struct{}   // used externally, accessible regardless of serve
+	model      *model
+	shortID    protocol.ShortID
+	fset       *db.FileSet
+	ignores    *ignore.Matcher
+	mtimefs    fs.Filesystem
+	modTime    protocol.ModTimeComparison
+	rotational bool
+	ctx        context.Context // used internally, only accessible on serve lifetime
+	done       chan struct{}   // used externally, accessible regardless of serve
 
 	scanInterval           time.Duration
 	scanTimer              *time.Timer
@@ -65,9 +66,10 @@ type folder struct {
 	pullPause     time.Duration
 	pullFailTimer *time.Timer
 
-	scanErrors []FileError
-	pullErrors []FileError
-	errorsMut  sync.Mutex
+	scanErrors   []FileError
+	pullErrors   []FileError
+	errorDigests *errorDigests
+	errorsMut    sync.Mutex
 
 	doInSyncChan chan syncRequest
 
@@ -83,6 +85,9 @@ type folder struct {
 
 	puller    puller
 	versioner versioner.Versioner
+	cdp       *continuousProtection // nil unless enabled
+
+	journalScans *changeJournalScans // nil unless enabled and supported
 
 	warnedKqueue bool
 }
@@ -103,13 +108,14 @@ func newFolder(model *model, fset *db.FileSet, ignores *ignore.Matcher, cfg conf
 		FolderStatisticsReference: stats.NewFolderStatisticsReference(model.db, cfg.ID),
 		ioLimiter:                 ioLimiter,
 
-		model:         model,
-		shortID:       model.shortID,
-		fset:          fset,
-		ignores:       ignores,
-		mtimefs:       cfg.Filesystem(fset),
-		modTimeWindow: cfg.ModTimeWindow(),
-		done:          make(chan struct{}),
+		model:      model,
+		shortID:    model.shortID,
+		fset:       fset,
+		ignores:    ignores,
+		mtimefs:    cfg.Filesystem(fset),
+		modTime:    cfg.ModTimeComparison(),
+		rotational: cfg.RotationalStorage(),
+		done:       make(chan struct{}),
 
 		scanInterval:           time.Duration(cfg.RescanIntervalS) * time.Second,
 		scanTimer:              time.NewTimer(0), // The first scan should be done immediately.
@@ -121,7 +127,8 @@ func newFolder(model *model, fset *db.FileSet, ignores *ignore.Matcher, cfg conf
 
 		pullScheduled: make(chan struct{}, 1), // This needs to be 1-buffered so that we queue a pull if we're busy when it comes.
 
-		errorsMut: sync.NewMutex(),
+		errorDigests: newErrorDigests(),
+		errorsMut:    sync.NewMutex(),
 
 		doInSyncChan: make(chan syncRequest),
 
@@ -139,6 +146,12 @@ func newFolder(model *model, fset *db.FileSet, ignores *ignore.Matcher, cfg conf
 	f.pullFailTimer = time.NewTimer(0)
 	<-f.pullFailTimer.C
 
+	cdp, err := newContinuousProtection(cfg)
+	if err != nil {
+		l.Warnf("Continuous protection disabled for folder %s: %v", cfg.Description(), err)
+	}
+	f.cdp = cdp
+
 	registerFolderMetrics(f.ID)
 
 	return f
@@ -163,6 +176,11 @@ func (f *folder) Serve(ctx context.Context) error {
 		f.startWatch()
 	}
 
+	f.openChangeJournal()
+	if f.journalScans != nil {
+		defer f.journalScans.close()
+	}
+
 	// If we're configured to not do version cleanup, or we don't have a
 	// versioner, cancel and drain that timer now.
 	if f.versionCleanupInterval == 0 || f.versioner == nil {
@@ -241,10 +259,24 @@ func (f *folder) Serve(ctx context.Context) error {
 
 func (*folder) BringToFront(string) {}
 
+func (*folder) PushToBack(string) {}
+
+func (*folder) SkipPull(string) bool { return false }
+
+func (*folder) SkippedPulls() []string { return nil }
+
+func (*folder) SetPullPriority(string, int) error { return nil }
+
+func (*folder) PullPriorities() []PullPriority { return nil }
+
+func (*folder) CancelSupersededPulls([]protocol.FileInfo) {}
+
 func (*folder) Override() {}
 
 func (*folder) Revert() {}
 
+func (*folder) RevertPaths([]string) error { return nil }
+
 func (f *folder) DelayScan(next time.Duration) {
 	select {
 	case f.scanDelay <- next:
@@ -281,6 +313,10 @@ func (*folder) Jobs(_, _ int) ([]string, []string, int) {
 	return nil, nil, 0
 }
 
+func (*folder) JobCount() int {
+	return 0
+}
+
 func (f *folder) Scan(subdirs []string) error {
 	<-f.initialScanFinished
 	return f.doInSync(func() error { return f.scanSubdirs(subdirs) })
@@ -359,6 +395,12 @@ func (f *folder) pull() (success bool, err error) {
 		return true, nil
 	}
 
+	if _, frozen := f.model.freezes.frozen(f.ID); frozen {
+		// A pull is scheduled when the freeze ends.
+		l.Debugln(f, "not pulling, the folder is frozen")
+		return true, nil
+	}
+
 	defer func() {
 		if success {
 			// We're good, reset the pause interval.
@@ -497,6 +539,12 @@ func (f *folder) scanSubdirs(subDirs []string) error {
 	f.setState(FolderScanning)
 	f.clearScanErrors(subDirs)
 
+	// The first full scan after the Unicode normalization policy changed
+	// renames existing files to match it.
+	if len(subDirs) == 0 && f.normalizationMigrationPending() {
+		f.migrateNormalization()
+	}
+
 	batch := f.newScanBatch()
 
 	// Schedule a pull after scanning, but only if we actually detected any
@@ -542,6 +590,66 @@ func (f *folder) scanSubdirs(subDirs []string) error {
 	return nil
 }
 
+// normalizationMigrationPending returns true if the Unicode normalization
+// policy differs from the one last applied to the folder contents.
+func (f *folder) normalizationMigrationPending() bool {
+	applied, err := f.GetUnicodeNormalization()
+	if err != nil {
+		return false
+	}
+	if applied == "" {
+		// Folders that predate the policy have been scanned with the
+		// native normalization.
+		applied = config.UnicodeNormalizationNative.String()
+	}
+	return applied != f.UnicodeNormalization.String()
+}
+
+// migrateNormalization renames all files on disk that are not in the
+// normalization form required by the policy.
+func (f *folder) migrateNormalization() {
+	var normalization scanner.Normalization
+	switch f.UnicodeNormalization {
+	case config.UnicodeNormalizationNfc:
+		normalization = scanner.NormalizationNFC
+	case config.UnicodeNormalizationNfd:
+		normalization = scanner.NormalizationNFD
+	case config.UnicodeNormalizationPreserve:
+		normalization = scanner.NormalizationPreserve
+	default:
+		normalization = scanner.NormalizationNative
+	}
+
+	if normalization != scanner.NormalizationPreserve {
+		l.Infof("Folder %v: renaming files according to the %v Unicode normalization policy", f.Description(), f.UnicodeNormalization)
+		fchan := scanner.WalkWithoutHashing(f.ctx, scanner.Config{
+			Folder:                f.ID,
+			Matcher:               f.ignores,
+			TempLifetime:          time.Duration(f.model.cfg.Options().KeepTemporariesH) * time.Hour,
+			Filesystem:            f.DiskFilesystem(),
+			AutoNormalize:         true,
+			Normalization:         normalization,
+			ProgressTickIntervalS: -1,
+			EventLogger:           f.evLogger,
+		})
+		failed := false
+		for res := range fchan {
+			if res.Err != nil {
+				f.newScanError(res.Path, res.Err)
+				failed = true
+			}
+		}
+		if failed || f.ctx.Err() != nil {
+			// Try again on the next full scan.
+			return
+		}
+	}
+
+	if err := f.SetUnicodeNormalization(f.UnicodeNormalization.String()); err != nil {
+		l.Warnf("Folder %v: failed to record Unicode normalization policy: %v", f.Description(), err)
+	}
+}
+
 const maxToRemove = 1000
 
 type scanBatch struct {
@@ -613,7 +721,7 @@ func (b *scanBatch) Update(fi protocol.FileInfo, snap *db.Snapshot) bool {
 		}
 	case (b.f.Type == config.FolderTypeReceiveOnly || b.f.Type == config.FolderTypeReceiveEncrypted) &&
 		gf.IsEquivalentOptional(fi, protocol.FileInfoComparison{
-			ModTimeWindow:   b.f.modTimeWindow,
+			ModTime:         b.f.modTime,
 			IgnorePerms:     b.f.IgnorePerms,
 			IgnoreBlocks:    true,
 			IgnoreFlags:     protocol.FlagLocalReceiveOnly,
@@ -641,6 +749,18 @@ func (f *folder) scanSubdirsChangedAndNew(subDirs []string, batch *scanBatch) (i
 	scanCtx, scanCancel := context.WithCancel(f.ctx)
 	defer scanCancel()
 
+	// Unless names are preserved, the filesystem takes care of storing them
+	// in the required form on disk and we always see the native form.
+	scanNormalization := scanner.NormalizationNative
+	if f.UnicodeNormalization == config.UnicodeNormalizationPreserve {
+		scanNormalization = scanner.NormalizationPreserve
+	}
+
+	var scanPermissionsProfile scanner.PermissionsProfile
+	if f.PermissionsProfile.IsSet() {
+		scanPermissionsProfile = f.PermissionsProfile
+	}
+
 	scanConfig := scanner.Config{
 		Folder:                f.ID,
 		Subs:                  subDirs,
@@ -650,15 +770,20 @@ func (f *folder) scanSubdirsChangedAndNew(subDirs []string, batch *scanBatch) (i
 		Filesystem:            f.mtimefs,
 		IgnorePerms:           f.IgnorePerms,
 		AutoNormalize:         f.AutoNormalize,
-		Hashers:               f.model.numHashers(f.ID),
+		Normalization:         scanNormalization,
+		BlocksPerFile:         f.BlocksPerFile,
+		Hashers:               f.model.numHashers(f.ID, f.rotational),
+		Rotational:            f.rotational,
+		BlockHash:             f.model.blockHashFor(f.FolderConfiguration),
 		ShortID:               f.shortID,
 		ProgressTickIntervalS: f.ScanProgressIntervalS,
 		LocalFlags:            f.localFlags,
-		ModTimeWindow:         f.modTimeWindow,
+		ModTime:               f.modTime,
 		EventLogger:           f.evLogger,
 		ScanOwnership:         f.SendOwnership || f.SyncOwnership,
 		ScanXattrs:            f.SendXattrs || f.SyncXattrs,
 		XattrFilter:           f.XattrFilter,
+		PermissionsProfile:    scanPermissionsProfile,
 	}
 	var fchan chan scanner.ScanResult
 	if f.Type == config.FolderTypeReceiveEncrypted {
@@ -913,7 +1038,12 @@ func (f *folder) findRename(snap *db.Snapshot, file protocol.FileInfo, alreadyUs
 }
 
 func (f *folder) scanTimerFired() error {
-	err := f.scanSubdirs(nil)
+	var err error
+	if f.journalScans != nil {
+		err = f.scanJournalChanges()
+	} else {
+		err = f.scanSubdirs(nil)
+	}
 
 	select {
 	case <-f.initialScanFinished:
@@ -1034,6 +1164,9 @@ func (f *folder) monitorWatch(ctx context.Context) {
 				continue
 			}
 			lastWatch = time.Now()
+			if f.cdp != nil {
+				eventChan = f.cdp.intercept(ctx, eventChan)
+			}
 			watchaggregator.Aggregate(aggrCtx, eventChan, f.watchChan, f.FolderConfiguration, f.model.cfg, f.evLogger)
 			l.Debugln("Started filesystem watcher for folder", f.Description())
 		case err = <-errChan:
@@ -1170,6 +1303,7 @@ func (f *folder) newScanError(path string, err error) {
 		Err:  err.Error(),
 		Path: path,
 	})
+	f.errorDigests.record(path, err.Error(), time.Now())
 	f.errorsMut.Unlock()
 }
 
@@ -1196,6 +1330,17 @@ outer:
 func (f *folder) Errors() []FileError {
 	f.errorsMut.Lock()
 	defer f.errorsMut.Unlock()
+	return f.errorsLocked()
+}
+
+// ErrorDigests returns the errors grouped by cause.
+func (f *folder) ErrorDigests() []FileErrorDigest {
+	f.errorsMut.Lock()
+	defer f.errorsMut.Unlock()
+	return f.errorDigests.digest(f.errorsLocked())
+}
+
+func (f *folder) errorsLocked() []FileError {
 	scanLen := len(f.scanErrors)
 	errors := make([]FileError, scanLen+len(f.pullErrors))
 	copy(errors[:scanLen], f.scanErrors)
@@ -13,6 +13,7 @@ import (
 	"github.com/syncthing/syncthing/lib/config"
 	"github.com/syncthing/syncthing/lib/db"
 	"github.com/syncthing/syncthing/lib/events"
+	"github.com/syncthing/syncthing/lib/fs"
 	"github.com/syncthing/syncthing/lib/ignore"
 	"github.com/syncthing/syncthing/lib/protocol"
 	"github.com/syncthing/syncthing/lib/semaphore"
@@ -64,11 +65,23 @@ func newReceiveOnlyFolder(model *model, fset *db.FileSet, ignores *ignore.Matche
 }
 
 func (f *receiveOnlyFolder) Revert() {
-	f.doInSync(f.revert)
+	f.doInSync(func() error { return f.revert(nil) })
 }
 
-func (f *receiveOnlyFolder) revert() error {
-	l.Infof("Reverting folder %v", f.Description())
+// RevertPaths reverts the local changes to the given paths and anything
+// below them, keeping the other local changes.
+func (f *receiveOnlyFolder) RevertPaths(paths []string) error {
+	return f.doInSync(func() error { return f.revert(paths) })
+}
+
+// revert reverts the local changes to the given paths, or all of them if
+// paths is nil.
+func (f *receiveOnlyFolder) revert(paths []string) error {
+	if paths == nil {
+		l.Infof("Reverting folder %v", f.Description())
+	} else {
+		l.Infof("Reverting %d paths in folder %v", len(paths), f.Description())
+	}
 
 	f.setState(FolderScanning)
 	defer f.setState(FolderIdle)
@@ -94,9 +107,9 @@ func (f *receiveOnlyFolder) revert() error {
 	defer snap.Release()
 	snap.WithHave(protocol.LocalDeviceID, func(intf protocol.FileIntf) bool {
 		fi := intf.(protocol.FileInfo)
-		if !fi.IsReceiveOnlyChanged() {
+		if !fi.IsReceiveOnlyChanged() || !revertSelected(fi.Name, paths) {
 			// We're only interested in files that have changed locally in
-			// receive only mode.
+			// receive only mode, and were asked to revert.
 			return true
 		}
 
@@ -127,7 +140,7 @@ func (f *receiveOnlyFolder) revert() error {
 			fi.SetDeleted(f.shortID)
 			fi.Version = protocol.Vector{} // if this file ever resurfaces anywhere we want our delete to be strictly older
 		case gf.IsEquivalentOptional(fi, protocol.FileInfoComparison{
-			ModTimeWindow:   f.modTimeWindow,
+			ModTime:         f.modTime,
 			IgnoreFlags:     protocol.FlagLocalReceiveOnly,
 			IgnoreOwnership: !f.SyncOwnership,
 			IgnoreXattrs:    !f.SyncXattrs,
@@ -176,6 +189,20 @@ func (f *receiveOnlyFolder) revert() error {
 	return nil
 }
 
+// revertSelected returns whether the given file is one of the paths to
+// revert or below one of them. All files are selected when paths is nil.
+func revertSelected(name string, paths []string) bool {
+	if paths == nil {
+		return true
+	}
+	for _, path := range paths {
+		if name == path || fs.IsParent(name, path) {
+			return true
+		}
+	}
+	return false
+}
+
 // deleteQueue handles deletes by delegating to a handler and queuing
 // directories for last.
 type deleteQueue struct {
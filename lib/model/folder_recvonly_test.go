@@ -9,6 +9,7 @@ package model
 import (
 	"bytes"
 	"context"
+	"errors"
 	"path/filepath"
 	"testing"
 	"time"
@@ -195,6 +196,81 @@ func TestRecvOnlyRevertNeeds(t *testing.T) {
 	}
 }
 
+func TestRecvOnlyRevertPaths(t *testing.T) {
+	// Make sure that we can list the local changes, and revert some of
+	// them while keeping the rest.
+
+	m, f, wcfgCancel := setupROFolder(t)
+	defer wcfgCancel()
+	ffs := f.Filesystem(nil)
+	defer cleanupModel(m)
+	conn := addFakeConn(m, device1, f.ID)
+
+	must(t, ffs.MkdirAll(".stfolder", 0o755))
+	knownFiles := setupKnownFiles(t, ffs, []byte("hello\n"))
+	must(t, m.Index(conn, &protocol.Index{Folder: "ro", Files: knownFiles}))
+	f.updateLocalsFromScanning(knownFiles)
+
+	// Change the known file and add some others.
+
+	writeFilePerm(t, ffs, "knownDir/knownFile", []byte("totally different data\n"), 0o644)
+	must(t, ffs.MkdirAll("unknownDir", 0o755))
+	writeFilePerm(t, ffs, "unknownDir/unknownFile", []byte("hello\n"), 0o644)
+	writeFilePerm(t, ffs, "otherFile", []byte("hello\n"), 0o644)
+	must(t, m.ScanFolder("ro"))
+
+	changes, err := m.ReceiveOnlyChanges("ro", 1, 100)
+	must(t, err)
+	actions := make(map[string]ReceiveOnlyChange)
+	for _, c := range changes {
+		actions[c.Name] = c
+	}
+	for name, action := range map[string]string{
+		"knownDir/knownFile":     ReceiveOnlyModified,
+		"unknownDir":             ReceiveOnlyAdded,
+		"unknownDir/unknownFile": ReceiveOnlyAdded,
+		"otherFile":              ReceiveOnlyAdded,
+	} {
+		if c, ok := actions[filepath.FromSlash(name)]; !ok || c.Action != action {
+			t.Errorf("Expected %v to be %v, got %+v", name, action, c)
+		}
+	}
+	if len(changes) != 4 {
+		t.Errorf("Expected 4 changes, got %+v", changes)
+	}
+	if c := actions[filepath.FromSlash("knownDir/knownFile")]; c.GlobalSize != 6 || c.LocalSize != 23 || c.ChangedBlocks != 1 || c.TotalBlocks != 1 || c.ChangedBytes != 23 {
+		t.Errorf("Unexpected diff summary for the changed file: %+v", c)
+	}
+
+	// Revert only the unknown directory, with its contents.
+
+	must(t, m.RevertPaths("ro", []string{"unknownDir"}))
+
+	for _, p := range []string{"unknownDir", "unknownDir/unknownFile"} {
+		if _, err := ffs.Stat(p); !fs.IsNotExist(err) {
+			t.Error("Unexpected existing thing:", p)
+		}
+	}
+	if _, err := ffs.Stat("otherFile"); err != nil {
+		t.Error("Unexpected error:", err)
+	}
+
+	changes, err = m.ReceiveOnlyChanges("ro", 1, 100)
+	must(t, err)
+	if len(changes) != 2 {
+		t.Errorf("Expected 2 remaining changes, got %+v", changes)
+	}
+	for _, c := range changes {
+		if c.Name != filepath.FromSlash("knownDir/knownFile") && c.Name != "otherFile" {
+			t.Errorf("Unexpected remaining change %+v", c)
+		}
+	}
+
+	if err := m.RevertPaths("missing", []string{"otherFile"}); !errors.Is(err, ErrFolderMissing) {
+		t.Error("Expected an error reverting a missing folder, got", err)
+	}
+}
+
 func TestRecvOnlyUndoChanges(t *testing.T) {
 	// Get us a model up and running
 
@@ -462,7 +538,7 @@ func TestRecvOnlyRevertOwnID(t *testing.T) {
 				if file, _ := m.testCurrentFolderFile(f.ID, name); file.Deleted {
 					t.Error("local file was deleted")
 					cancel()
-				} else if file.IsEquivalent(fi, f.modTimeWindow) {
+				} else if file.IsEquivalent(fi, f.modTime.Window) {
 					cancel() // That's what we are waiting for
 				}
 			}
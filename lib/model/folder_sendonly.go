@@ -73,7 +73,7 @@ func (f *sendOnlyFolder) pull() (bool, error) {
 		}
 
 		if !file.IsEquivalentOptional(curFile, protocol.FileInfoComparison{
-			ModTimeWindow:   f.modTimeWindow,
+			ModTime:         f.modTime,
 			IgnorePerms:     f.IgnorePerms,
 			IgnoreOwnership: !f.SyncOwnership,
 			IgnoreXattrs:    !f.SyncXattrs,
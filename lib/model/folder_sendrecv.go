@@ -9,7 +9,6 @@ package model
 import (
 	"bytes"
 	"context"
-	"crypto/sha256"
 	"errors"
 	"fmt"
 	"io"
@@ -72,6 +71,7 @@ var (
 	errModified               = errors.New("file modified but not rescanned; will try again later")
 	errUnexpectedDirOnFileDel = errors.New("encountered directory when trying to remove file/symlink")
 	errIncompatibleSymlink    = errors.New("incompatible symlink entry; rescan with newer Syncthing on source")
+	errSuperseded             = errors.New("file changed again on a remote device while syncing")
 	contextRemovingOldItem    = "removing item to be replaced"
 )
 
@@ -128,6 +128,14 @@ type sendReceiveFolder struct {
 	writeLimiter       *semaphore.Semaphore
 
 	tempPullErrors map[string]string // pull errors that might be just transient
+
+	inProgress    map[string]*sharedPullerState // files currently being pulled, by name
+	inProgressMut sync.Mutex
+
+	journals      *inPlaceJournals // files being changed in place
+	finishJournal *finishJournal   // files being finished, until committed to the database
+
+	adaptivePending bool // the amount of pending request data is adjusted to the links
 }
 
 func newSendReceiveFolder(model *model, fset *db.FileSet, ignores *ignore.Matcher, cfg config.FolderConfiguration, ver versioner.Versioner, evLogger events.Logger, ioLimiter *semaphore.Semaphore) service {
@@ -136,6 +144,10 @@ func newSendReceiveFolder(model *model, fset *db.FileSet, ignores *ignore.Matche
 		queue:              newJobQueue(),
 		blockPullReorderer: newBlockPullReorderer(cfg.BlockPullOrder, model.id, cfg.DeviceIDs()),
 		writeLimiter:       semaphore.New(cfg.MaxConcurrentWrites),
+		inProgress:         make(map[string]*sharedPullerState),
+		inProgressMut:      sync.NewMutex(),
+		journals:           newInPlaceJournals(db.NewMiscDataNamespace(model.db), cfg.ID),
+		finishJournal:      newFinishJournal(db.NewFinishJournalNamespace(model.db, cfg.ID)),
 	}
 	f.folder.puller = f
 
@@ -144,10 +156,13 @@ func newSendReceiveFolder(model *model, fset *db.FileSet, ignores *ignore.Matche
 	}
 
 	// If the configured max amount of pending data is zero, we use the
-	// default. If it's configured to something non-zero but less than the
-	// protocol block size we adjust it upwards accordingly.
+	// default as the starting point and adapt it to the measured
+	// throughput and latency of the requests. If it's configured to
+	// something non-zero but less than the protocol block size we adjust
+	// it upwards accordingly.
 	if f.PullerMaxPendingKiB == 0 {
 		f.PullerMaxPendingKiB = defaultPullerPendingKiB
+		f.adaptivePending = true
 	}
 	if blockSizeKiB := protocol.MaxBlockSize / 1024; f.PullerMaxPendingKiB < blockSizeKiB {
 		f.PullerMaxPendingKiB = blockSizeKiB
@@ -156,6 +171,66 @@ func newSendReceiveFolder(model *model, fset *db.FileSet, ignores *ignore.Matche
 	return f
 }
 
+func (f *sendReceiveFolder) Serve(ctx context.Context) error {
+	f.recoverInPlaceJournals()
+	f.recoverFinishJournal()
+	return f.folder.Serve(ctx)
+}
+
+// recoverInPlaceJournals restores the files that were being changed in
+// place when we last stopped, before they get scanned.
+func (f *sendReceiveFolder) recoverInPlaceJournals() {
+	for _, name := range f.journals.list() {
+		if err := recoverInPlace(f.mtimefs, name); err != nil {
+			l.Warnf("Failed to restore %s in folder %s after interrupted in-place sync: %v", name, f.Description(), err)
+			continue
+		}
+		if err := f.journals.remove(name); err != nil {
+			l.Debugln(f, "removing in-place journal entry:", err)
+		}
+	}
+}
+
+// recoverFinishJournal commits the files that were finished on disk, but
+// not yet in the database, when we last stopped. The others are left for
+// the scan to sort out.
+func (f *sendReceiveFolder) recoverFinishJournal() {
+	files := f.finishJournal.files()
+	if len(files) == 0 {
+		return
+	}
+	snap, err := f.dbSnapshot()
+	if err != nil {
+		l.Debugln(f, "recovering finish journal:", err)
+		return
+	}
+	var commit []protocol.FileInfo
+	for _, file := range files {
+		if cur, ok := snap.Get(protocol.LocalDeviceID, file.Name); ok && cur.Version.Equal(file.Version) {
+			// Already committed
+			continue
+		}
+		stat, err := f.mtimefs.Lstat(file.Name)
+		if err != nil || f.itemChanged(stat, file, true, false) != nil {
+			continue
+		}
+		if err := f.updateFileInfoChangeTime(&file); err != nil {
+			l.Debugln(f, "recovering finish journal:", err)
+		}
+		file.Sequence = 0
+		commit = append(commit, file)
+	}
+	snap.Release()
+
+	if len(commit) > 0 {
+		l.Infof("Committing %d files in folder %s that were synced before an interruption", len(commit), f.Description())
+		f.updateLocalsFromPulling(commit)
+	}
+	for _, file := range files {
+		f.finishJournal.remove(file.Name)
+	}
+}
+
 // pull returns true if it manages to get all needed items from peers, i.e. get
 // the device in sync with the global state.
 func (f *sendReceiveFolder) pull() (bool, error) {
@@ -179,6 +254,8 @@ func (f *sendReceiveFolder) pull() (bool, error) {
 	f.pullErrors = nil
 	f.errorsMut.Unlock()
 
+	f.queue.ResetSkipped()
+
 	var err error
 	for tries := 0; tries < maxPullerIterations; tries++ {
 		select {
@@ -224,14 +301,33 @@ func (f *sendReceiveFolder) pull() (bool, error) {
 	if pullErrNum > 0 {
 		l.Infof("%v: Failed to sync %v items", f.Description(), pullErrNum)
 		f.evLogger.Log(events.FolderErrors, map[string]interface{}{
-			"folder": f.folderID,
-			"errors": f.Errors(),
+			"folder":  f.folderID,
+			"count":   len(f.Errors()),
+			"digests": f.ErrorDigests(),
 		})
 	}
 
 	return changed == 0, nil
 }
 
+// pullConcurrency returns the number of copiers and the amount of pending
+// request data for regular pulls, and for the small file lane. The small
+// file lane gets the configured share of the total, at least one copier
+// and one block, unless it's disabled.
+func (f *sendReceiveFolder) pullConcurrency() (copiers, pendingKiB, smallCopiers, smallPendingKiB int) {
+	copiers, pendingKiB = f.Copiers, f.PullerMaxPendingKiB
+	if f.SmallFileLanePct <= 0 {
+		return copiers, pendingKiB, 0, 0
+	}
+
+	pct := f.SmallFileLanePct
+	smallCopiers = max(copiers*pct/100, 1)
+	smallPendingKiB = max(pendingKiB*pct/100, protocol.MinBlockSize/1024)
+	copiers = max(copiers-smallCopiers, 1)
+	pendingKiB = max(pendingKiB-smallPendingKiB, protocol.MaxBlockSize/1024)
+	return copiers, pendingKiB, smallCopiers, smallPendingKiB
+}
+
 // pullerIteration runs a single puller iteration for the given folder and
 // returns the number items that should have been synced (even those that
 // might have failed). One puller iteration handles all files currently
@@ -257,7 +353,8 @@ func (f *sendReceiveFolder) pullerIteration(scanChan chan<- string) (int, error)
 	doneWg := sync.NewWaitGroup()
 	updateWg := sync.NewWaitGroup()
 
-	l.Debugln(f, "copiers:", f.Copiers, "pullerPendingKiB:", f.PullerMaxPendingKiB)
+	copiers, pendingKiB, smallCopiers, smallPendingKiB := f.pullConcurrency()
+	l.Debugln(f, "copiers:", copiers, "pullerPendingKiB:", pendingKiB, "small file copiers:", smallCopiers, "small file pullerPendingKiB:", smallPendingKiB)
 
 	updateWg.Add(1)
 	go func() {
@@ -266,21 +363,32 @@ func (f *sendReceiveFolder) pullerIteration(scanChan chan<- string) (int, error)
 		updateWg.Done()
 	}()
 
-	for i := 0; i < f.Copiers; i++ {
-		copyWg.Add(1)
+	startLane := func(copiers, pendingKiB int, copyChan chan copyBlocksState, pullChan chan pullBlockState) {
+		for i := 0; i < copiers; i++ {
+			copyWg.Add(1)
+			go func() {
+				// copierRoutine finishes when copyChan is closed
+				f.copierRoutine(copyChan, pullChan, finisherChan)
+				copyWg.Done()
+			}()
+		}
+
+		pullWg.Add(1)
 		go func() {
-			// copierRoutine finishes when copyChan is closed
-			f.copierRoutine(copyChan, pullChan, finisherChan)
-			copyWg.Done()
+			// pullerRoutine finishes when pullChan is closed
+			f.limitedPullerRoutine(snap, pullChan, finisherChan, pendingKiB*1024)
+			pullWg.Done()
 		}()
 	}
+	startLane(copiers, pendingKiB, copyChan, pullChan)
 
-	pullWg.Add(1)
-	go func() {
-		// pullerRoutine finishes when pullChan is closed
-		f.pullerRoutine(snap, pullChan, finisherChan)
-		pullWg.Done()
-	}()
+	var smallCopyChan chan copyBlocksState
+	var smallPullChan chan pullBlockState
+	if smallCopiers > 0 {
+		smallCopyChan = make(chan copyBlocksState)
+		smallPullChan = make(chan pullBlockState)
+		startLane(smallCopiers, smallPendingKiB, smallCopyChan, smallPullChan)
+	}
 
 	doneWg.Add(1)
 	// finisherRoutine finishes when finisherChan is closed
@@ -289,13 +397,19 @@ func (f *sendReceiveFolder) pullerIteration(scanChan chan<- string) (int, error)
 		doneWg.Done()
 	}()
 
-	changed, fileDeletions, dirDeletions, err := f.processNeeded(snap, dbUpdateChan, copyChan, scanChan)
+	changed, fileDeletions, dirDeletions, err := f.processNeeded(snap, dbUpdateChan, copyChan, smallCopyChan, scanChan)
 
 	// Signal copy and puller routines that we are done with the in data for
 	// this iteration. Wait for them to finish.
 	close(copyChan)
+	if smallCopyChan != nil {
+		close(smallCopyChan)
+	}
 	copyWg.Wait()
 	close(pullChan)
+	if smallPullChan != nil {
+		close(smallPullChan)
+	}
 	pullWg.Wait()
 
 	// Signal the finisher chan that there will be no more input and wait
@@ -316,7 +430,7 @@ func (f *sendReceiveFolder) pullerIteration(scanChan chan<- string) (int, error)
 	return changed, err
 }
 
-func (f *sendReceiveFolder) processNeeded(snap *db.Snapshot, dbUpdateChan chan<- dbUpdateJob, copyChan chan<- copyBlocksState, scanChan chan<- string) (int, map[string]protocol.FileInfo, []protocol.FileInfo, error) {
+func (f *sendReceiveFolder) processNeeded(snap *db.Snapshot, dbUpdateChan chan<- dbUpdateJob, copyChan, smallCopyChan chan<- copyBlocksState, scanChan chan<- string) (int, map[string]protocol.FileInfo, []protocol.FileInfo, error) {
 	changed := 0
 	var dirDeletions []protocol.FileInfo
 	fileDeletions := map[string]protocol.FileInfo{}
@@ -348,7 +462,7 @@ func (f *sendReceiveFolder) processNeeded(snap *db.Snapshot, dbUpdateChan chan<-
 			l.Debugln(f, "Handling ignored file", file)
 			dbUpdateChan <- dbUpdateJob{file, dbUpdateInvalidate}
 
-		case build.IsWindows && fs.WindowsInvalidFilename(file.Name) != nil:
+		case build.IsWindows && !f.HandlesWindowsNames() && fs.WindowsInvalidFilename(file.Name) != nil:
 			if file.IsDeleted() {
 				// Just pretend we deleted it, no reason to create an error
 				// about a deleted file that we can't have anyway.
@@ -388,11 +502,15 @@ func (f *sendReceiveFolder) processNeeded(snap *db.Snapshot, dbUpdateChan chan<-
 
 		case file.Type == protocol.FileInfoTypeFile:
 			curFile, hasCurFile := snap.Get(protocol.LocalDeviceID, file.Name)
-			if hasCurFile && file.BlocksEqual(curFile) {
+			if hasCurFile && isMetadataOnlyChange(curFile, file) {
 				// We are supposed to copy the entire file, and then fetch nothing. We
 				// are only updating metadata, so we don't actually *need* to make the
 				// copy.
-				f.shortcutFile(file, dbUpdateChan)
+				f.shortcutFile(file, curFile, dbUpdateChan, scanChan)
+			} else if f.queue.IsSkipped(file.Name) {
+				// Skipped on request, it will be retried on the next pull.
+				l.Debugln(f, "skipping", file.Name)
+				changed--
 			} else {
 				// Queue files for processing after directories and symlinks.
 				f.queue.Push(file.Name, file.Size, file.ModTime())
@@ -450,43 +568,41 @@ func (f *sendReceiveFolder) processNeeded(snap *db.Snapshot, dbUpdateChan chan<-
 		f.queue.SortNewestFirst()
 	}
 
-	// Process the file queue.
-
-nextFile:
-	for {
-		select {
-		case <-f.ctx.Done():
-			return changed, fileDeletions, dirDeletions, f.ctx.Err()
-		default:
-		}
-
-		fileName, ok := f.queue.Pop()
-		if !ok {
-			break
-		}
+	// Process the file queue. If enabled, small files are taken from the
+	// queue by a separate fast lane, so that they don't have to wait for
+	// large files ahead of them. The regular lane then handles the large
+	// files first, and helps out with the small ones once those are done.
 
+	deletionsMut := sync.NewMutex() // protects buckets and fileDeletions
+	processFile := func(fileName string, copyChan chan<- copyBlocksState) {
 		fi, ok := snap.GetGlobal(fileName)
 		if !ok {
 			// File is no longer in the index. Mark it as done and drop it.
 			f.queue.Done(fileName)
-			continue
+			return
 		}
 
 		if fi.IsDeleted() || fi.IsInvalid() || fi.Type != protocol.FileInfoTypeFile {
 			// The item has changed type or status in the index while we
 			// were processing directories above.
 			f.queue.Done(fileName)
-			continue
+			return
 		}
 
 		if !f.checkParent(fi.Name, scanChan) {
 			f.queue.Done(fileName)
-			continue
+			return
+		}
+
+		if f.keepLocal(fi, snap, dbUpdateChan, scanChan) {
+			f.queue.Done(fileName)
+			return
 		}
 
 		// Check our list of files to be removed for a match, in which case
 		// we can just do a rename instead.
 		key := string(fi.BlocksHash)
+		deletionsMut.Lock()
 		for candidate, ok := popCandidate(buckets, key); ok; candidate, ok = popCandidate(buckets, key) {
 			// candidate is our current state of the file, where as the
 			// desired state with the delete bit set is in the deletion
@@ -500,23 +616,65 @@ nextFile:
 
 			// Remove the pending deletion (as we performed it by renaming)
 			delete(fileDeletions, candidate.Name)
+			deletionsMut.Unlock()
 
 			f.queue.Done(fileName)
-			continue nextFile
+			return
 		}
+		deletionsMut.Unlock()
 
 		devices := snap.Availability(fileName)
 		for _, dev := range devices {
 			if f.model.ConnectedTo(dev) {
 				// Handle the file normally, by copying and pulling, etc.
 				f.handleFile(fi, snap, copyChan)
-				continue nextFile
+				return
 			}
 		}
 		f.newPullError(fileName, errNotAvailable)
 		f.queue.Done(fileName)
 	}
 
+	maxSmallSize := int64(f.SmallFileMaxKiB) * 1024
+	laneWg := sync.NewWaitGroup()
+	if smallCopyChan != nil {
+		laneWg.Add(1)
+		go func() {
+			defer laneWg.Done()
+			for f.ctx.Err() == nil {
+				fileName, ok := f.queue.PopSmall(maxSmallSize)
+				if !ok {
+					// All files are queued up front, so there won't be any
+					// more small ones.
+					return
+				}
+				processFile(fileName, smallCopyChan)
+			}
+		}()
+	}
+	defer laneWg.Wait()
+
+	for {
+		select {
+		case <-f.ctx.Done():
+			return changed, fileDeletions, dirDeletions, f.ctx.Err()
+		default:
+		}
+
+		var fileName string
+		var ok bool
+		if smallCopyChan != nil {
+			fileName, ok = f.queue.PopLarge(maxSmallSize)
+		}
+		if !ok {
+			fileName, ok = f.queue.Pop()
+		}
+		if !ok {
+			break
+		}
+		processFile(fileName, copyChan)
+	}
+
 	return changed, fileDeletions, dirDeletions, nil
 }
 
@@ -578,7 +736,7 @@ func (f *sendReceiveFolder) handleDir(file protocol.FileInfo, snap *db.Snapshot,
 		})
 	}()
 
-	mode := fs.FileMode(file.Permissions & 0o777)
+	mode := f.diskPermissions(file)
 	if f.IgnorePerms || file.NoPermissions {
 		mode = 0o777
 	}
@@ -1002,7 +1160,7 @@ func (f *sendReceiveFolder) renameFile(cur, source, target protocol.FileInfo, sn
 		var fi protocol.FileInfo
 		if fi, err = scanner.CreateFileInfo(stat, target.Name, f.mtimefs, f.SyncOwnership, f.SyncXattrs, f.XattrFilter); err == nil {
 			if !fi.IsEquivalentOptional(curTarget, protocol.FileInfoComparison{
-				ModTimeWindow:   f.modTimeWindow,
+				ModTime:         f.modTime,
 				IgnorePerms:     f.IgnorePerms,
 				IgnoreBlocks:    true,
 				IgnoreFlags:     protocol.LocalAllFlags,
@@ -1103,7 +1261,29 @@ func (f *sendReceiveFolder) handleFile(file protocol.FileInfo, snap *db.Snapshot
 	blocks := append([]protocol.BlockInfo{}, file.Blocks...)
 	reused := make([]int, 0, len(file.Blocks))
 
-	if f.Type != config.FolderTypeReceiveEncrypted {
+	appendFrom := f.appendOffset(file, curFile, hasCurFile)
+	inPlace := appendFrom == 0 && f.inPlaceDelta(file, curFile, hasCurFile)
+	if inPlace {
+		if err := f.journals.add(file.Name); err != nil {
+			l.Debugln(f, "not changing in place:", err)
+			inPlace = false
+		}
+	}
+	if appendFrom > 0 {
+		// Only the tail after the unchanged contents needs pulling, into a
+		// temporary file holding just that.
+		blocks = blocks[appendFrom/int64(file.BlockSize()):]
+	} else if inPlace {
+		// Only the changed blocks need writing into the existing file.
+		blocks = blocks[:0]
+		for i, block := range file.Blocks {
+			if i < len(curFile.Blocks) && bytes.Equal(block.Hash, curFile.Blocks[i].Hash) {
+				reused = append(reused, i)
+			} else {
+				blocks = append(blocks, block)
+			}
+		}
+	} else if f.Type != config.FolderTypeReceiveEncrypted {
 		blocks, reused = f.reuseBlocks(blocks, reused, file, tempName)
 	}
 
@@ -1127,8 +1307,14 @@ func (f *sendReceiveFolder) handleFile(file protocol.FileInfo, snap *db.Snapshot
 	})
 
 	s := newSharedPullerState(file, f.mtimefs, f.folderID, tempName, blocks, reused, f.IgnorePerms || file.NoPermissions, hasCurFile, curFile, !f.DisableSparseFiles, !f.DisableFsync)
+	s.appendFrom = appendFrom
+	s.inPlace = inPlace
 
-	l.Debugf("%v need file %s; copy %d, reused %v", f, file.Name, len(blocks), len(reused))
+	l.Debugf("%v need file %s; copy %d, reused %v, append from %d, in place %v", f, file.Name, len(blocks), len(reused), appendFrom, inPlace)
+
+	f.inProgressMut.Lock()
+	f.inProgress[file.Name] = s
+	f.inProgressMut.Unlock()
 
 	cs := copyBlocksState{
 		sharedPullerState: s,
@@ -1138,15 +1324,74 @@ func (f *sendReceiveFolder) handleFile(file protocol.FileInfo, snap *db.Snapshot
 	copyChan <- cs
 }
 
+// appendOffset returns where the new contents of the file start, when it
+// has only grown and the existing contents are unchanged, or zero. Only
+// the tail then needs to be pulled and appended to the existing file in
+// place. That bypasses versioning and conflict handling, so it's only done
+// when neither is involved.
+func (f *sendReceiveFolder) appendOffset(file, curFile protocol.FileInfo, hasCurFile bool) int64 {
+	if !hasCurFile || f.Type == config.FolderTypeReceiveEncrypted || f.versioner != nil {
+		return 0
+	}
+	if curFile.Type != protocol.FileInfoTypeFile || curFile.IsDeleted() || curFile.IsIgnored() || curFile.IsUnsupported() || curFile.MustRescan() {
+		return 0
+	}
+	if file.Size <= curFile.Size || file.BlockSize() != curFile.BlockSize() || f.inConflict(curFile.Version, file.Version) {
+		return 0
+	}
+
+	keep := 0
+	for keep < len(curFile.Blocks) && keep < len(file.Blocks) {
+		existing, updated := curFile.Blocks[keep], file.Blocks[keep]
+		if existing.Size != file.BlockSize() || existing.Size != updated.Size || !bytes.Equal(existing.Hash, updated.Hash) {
+			break
+		}
+		keep++
+	}
+	// The last, partial, block of the existing file may have been filled
+	// up; anything before it must be unchanged.
+	if keep == 0 || keep < len(curFile.Blocks)-1 {
+		return 0
+	}
+	return int64(keep) * int64(file.BlockSize())
+}
+
+// inPlaceDelta returns whether the changed blocks of the file should be
+// written into the existing file, journaled, instead of into a temporary
+// copy of it, as configured for the folder. Like appending, that bypasses
+// versioning and conflict handling. The existing file must also be
+// writable and match what we have in the database, as it's not checked
+// again once it's been changed.
+func (f *sendReceiveFolder) inPlaceDelta(file, curFile protocol.FileInfo, hasCurFile bool) bool {
+	if !f.InPlaceDelta || !hasCurFile || f.Type == config.FolderTypeReceiveEncrypted || f.versioner != nil {
+		return false
+	}
+	if curFile.Type != protocol.FileInfoTypeFile || curFile.IsDeleted() || curFile.IsIgnored() || curFile.IsUnsupported() || curFile.MustRescan() {
+		return false
+	}
+	if file.BlockSize() != curFile.BlockSize() || f.inConflict(curFile.Version, file.Version) {
+		return false
+	}
+	if f.journals.has(file.Name) {
+		// An earlier attempt that couldn't be restored yet
+		return false
+	}
+	stat, err := f.mtimefs.Lstat(file.Name)
+	if err != nil || !stat.IsRegular() || stat.Mode()&0o200 == 0 {
+		return false
+	}
+	return f.itemChanged(stat, curFile, true, false) == nil
+}
+
 func (f *sendReceiveFolder) reuseBlocks(blocks []protocol.BlockInfo, reused []int, file protocol.FileInfo, tempName string) ([]protocol.BlockInfo, []int) {
 	// Check for an old temporary file which might have some blocks we could
 	// reuse.
-	tempBlocks, err := scanner.HashFile(f.ctx, f.ID, f.mtimefs, tempName, file.BlockSize(), nil, false)
+	tempBlocks, err := scanner.HashFile(f.ctx, f.ID, f.mtimefs, tempName, file.HashAlgorithm, file.BlockSize(), nil, false)
 	if err != nil {
 		var caseErr *fs.ErrCaseConflict
 		if errors.As(err, &caseErr) {
 			if rerr := f.mtimefs.Rename(caseErr.Real, tempName); rerr == nil {
-				tempBlocks, err = scanner.HashFile(f.ctx, f.ID, f.mtimefs, tempName, file.BlockSize(), nil, false)
+				tempBlocks, err = scanner.HashFile(f.ctx, f.ID, f.mtimefs, tempName, file.HashAlgorithm, file.BlockSize(), nil, false)
 			}
 		}
 	}
@@ -1216,11 +1461,37 @@ func populateOffsets(blocks []protocol.BlockInfo) {
 	}
 }
 
+// isMetadataOnlyChange returns true if the local file has the same
+// contents as the global one, so that pulling it only means applying the
+// new metadata. Items without blocks, such as deleted files and
+// directories, have the same (empty) block list as an empty file, but
+// there is nothing on disk to apply the metadata to. The same goes for
+// items we don't track the contents of. Receive only changes however are
+// resolved this way when the contents match.
+func isMetadataOnlyChange(cur, file protocol.FileInfo) bool {
+	if cur.Type != protocol.FileInfoTypeFile || cur.IsDeleted() || cur.IsIgnored() || cur.IsUnsupported() || cur.MustRescan() {
+		return false
+	}
+	return file.BlocksEqual(cur)
+}
+
 // shortcutFile sets file metadata, when that's the only thing that has
 // changed.
-func (f *sendReceiveFolder) shortcutFile(file protocol.FileInfo, dbUpdateChan chan<- dbUpdateJob) {
+func (f *sendReceiveFolder) shortcutFile(file, curFile protocol.FileInfo, dbUpdateChan chan<- dbUpdateJob, scanChan chan<- string) {
 	l.Debugln(f, "taking shortcut on", file.Name)
 
+	// The contents on disk must still be what we have in the database, or
+	// we would record them under the new version without having them.
+	stat, err := f.mtimefs.Lstat(file.Name)
+	if err == nil {
+		err = f.scanIfItemChanged(file.Name, stat, curFile, true, false, scanChan)
+	}
+	if err != nil {
+		f.queue.Done(file.Name)
+		f.newPullError(file.Name, fmt.Errorf("shortcut file: %w", err))
+		return
+	}
+
 	f.evLogger.Log(events.ItemStarted, map[string]string{
 		"folder": f.folderID,
 		"item":   file.Name,
@@ -1228,7 +1499,6 @@ func (f *sendReceiveFolder) shortcutFile(file protocol.FileInfo, dbUpdateChan ch
 		"action": "metadata",
 	})
 
-	var err error
 	defer f.evLogger.Log(events.ItemFinished, map[string]interface{}{
 		"folder": f.folderID,
 		"item":   file.Name,
@@ -1240,7 +1510,7 @@ func (f *sendReceiveFolder) shortcutFile(file protocol.FileInfo, dbUpdateChan ch
 	f.queue.Done(file.Name)
 
 	if !f.IgnorePerms && !file.NoPermissions {
-		if err = f.mtimefs.Chmod(file.Name, fs.FileMode(file.Permissions&0o777)); err != nil {
+		if err = f.mtimefs.Chmod(file.Name, f.diskPermissions(file)); err != nil {
 			f.newPullError(file.Name, fmt.Errorf("shortcut file (setting permissions): %w", err))
 			return
 		}
@@ -1281,9 +1551,13 @@ func (f *sendReceiveFolder) shortcutFile(file protocol.FileInfo, dbUpdateChan ch
 // copierRoutine reads copierStates until the in channel closes and performs
 // the relevant copies when possible, or passes it to the puller routine.
 func (f *sendReceiveFolder) copierRoutine(in <-chan copyBlocksState, pullChan chan<- pullBlockState, out chan<- *sharedPullerState) {
-	buf := protocol.BufferPool.Get(protocol.MinBlockSize)
+	// The buffer is sized by the first block to copy and then grown as
+	// required, rather than starting small and growing right away.
+	var buf []byte
 	defer func() {
-		protocol.BufferPool.Put(buf)
+		if buf != nil {
+			protocol.BufferPool.Put(buf)
+		}
 	}()
 
 	folderFilesystems := make(map[string]fs.Filesystem)
@@ -1326,7 +1600,7 @@ func (f *sendReceiveFolder) copierRoutine(in <-chan copyBlocksState, pullChan ch
 			default:
 			}
 
-			if !f.DisableSparseFiles && state.reused == 0 && block.IsEmpty() {
+			if !f.DisableSparseFiles && state.reused == 0 && !state.inPlace && block.IsEmpty() {
 				// The block is a block of all zeroes, and we are not reusing
 				// a temp file, so there is no need to do anything with it.
 				// If we were reusing a temp file and had this block to copy,
@@ -1344,7 +1618,7 @@ func (f *sendReceiveFolder) copierRoutine(in <-chan copyBlocksState, pullChan ch
 			var found bool
 			if f.Type != config.FolderTypeReceiveEncrypted {
 				found, err = weakHashFinder.Iterate(block.WeakHash, buf, func(offset int64) bool {
-					if f.verifyBuffer(buf, block) != nil {
+					if verifyBuffer(buf, block, state.file.HashAlgorithm) != nil {
 						return true
 					}
 
@@ -1384,17 +1658,17 @@ func (f *sendReceiveFolder) copierRoutine(in <-chan copyBlocksState, pullChan ch
 					// case we can't verify the block integrity so we'll take it on
 					// trust. (The other side can and will verify.)
 					if f.Type != config.FolderTypeReceiveEncrypted {
-						if err := f.verifyBuffer(buf, block); err != nil {
+						if err := verifyBuffer(buf, block, state.file.HashAlgorithm); err != nil {
 							l.Debugln("Finder failed to verify buffer", err)
 							return false
 						}
 					}
 
-					if f.CopyRangeMethod != fs.CopyRangeMethodStandard {
+					if f.CopyRangeMethod != fs.CopyRangeMethodStandard && dstFd.journal == nil {
 						err = f.withLimiter(func() error {
 							dstFd.mut.Lock()
 							defer dstFd.mut.Unlock()
-							return fs.CopyRange(f.CopyRangeMethod, fd, dstFd.fd, srcOffset, block.Offset, int64(block.Size))
+							return fs.CopyRange(f.CopyRangeMethod, fd, dstFd.fd, srcOffset, block.Offset-dstFd.offset, int64(block.Size))
 						})
 					} else {
 						err = f.limitedWriteAt(dstFd, buf, block.Offset)
@@ -1441,6 +1715,11 @@ func (f *sendReceiveFolder) initWeakHashFinder(state copyBlocksState) (*weakhash
 		l.Debugln("not weak hashing due to folder type", f.Type)
 		return nil, nil
 	}
+	if state.appendFrom > 0 {
+		// The new data is all after the existing contents
+		l.Debugf("not weak hashing %s. appending", state.file.Name)
+		return nil, nil
+	}
 
 	blocksPercentChanged := 0
 	if tot := len(state.file.Blocks); tot > 0 {
@@ -1478,13 +1757,15 @@ func (f *sendReceiveFolder) initWeakHashFinder(state copyBlocksState) (*weakhash
 	return weakHashFinder, file
 }
 
-func (*sendReceiveFolder) verifyBuffer(buf []byte, block protocol.BlockInfo) error {
+// verifyBuffer checks the data against the block, hashed with the algorithm
+// of the file it's from.
+func verifyBuffer(buf []byte, block protocol.BlockInfo, algo protocol.BlockHashAlgorithm) error {
 	if len(buf) != int(block.Size) {
 		return fmt.Errorf("length mismatch %d != %d", len(buf), block.Size)
 	}
 
-	hash := sha256.Sum256(buf)
-	if !bytes.Equal(hash[:], block.Hash) {
+	hash := algo.Sum(buf)
+	if !bytes.Equal(hash, block.Hash) {
 		return fmt.Errorf("hash mismatch %x != %x", hash, block.Hash)
 	}
 
@@ -1492,7 +1773,14 @@ func (*sendReceiveFolder) verifyBuffer(buf []byte, block protocol.BlockInfo) err
 }
 
 func (f *sendReceiveFolder) pullerRoutine(snap *db.Snapshot, in <-chan pullBlockState, out chan<- *sharedPullerState) {
-	requestLimiter := semaphore.New(f.PullerMaxPendingKiB * 1024)
+	f.limitedPullerRoutine(snap, in, out, f.PullerMaxPendingKiB*1024)
+}
+
+// limitedPullerRoutine is pullerRoutine with a given limit on the amount
+// of data in pending requests, which is the starting point if the limit is
+// adaptive.
+func (f *sendReceiveFolder) limitedPullerRoutine(snap *db.Snapshot, in <-chan pullBlockState, out chan<- *sharedPullerState, maxPendingBytes int) {
+	requestLimiter := f.newPullerRequestLimit(maxPendingBytes)
 	wg := sync.NewWaitGroup()
 
 	for state := range in {
@@ -1522,13 +1810,13 @@ func (f *sendReceiveFolder) pullerRoutine(snap *db.Snapshot, in <-chan pullBlock
 			defer wg.Done()
 			defer requestLimiter.Give(bytes)
 
-			f.pullBlock(state, snap, out)
+			f.pullBlock(state, snap, out, requestLimiter)
 		}()
 	}
 	wg.Wait()
 }
 
-func (f *sendReceiveFolder) pullBlock(state pullBlockState, snap *db.Snapshot, out chan<- *sharedPullerState) {
+func (f *sendReceiveFolder) pullBlock(state pullBlockState, snap *db.Snapshot, out chan<- *sharedPullerState, requestLimiter *requestLimit) {
 	// Get an fd to the temporary file. Technically we don't need it until
 	// after fetching the block, but if we run into an error here there is
 	// no point in issuing the request to the network.
@@ -1556,10 +1844,15 @@ loop:
 			break loop
 		default:
 		}
+		if state.failed() != nil {
+			// No point in requesting more data for a file that has
+			// failed or been superseded in the meantime.
+			break
+		}
 
-		// Select the least busy device to pull the block from. If we found no
-		// feasible device at all, fail the block (and in the long run, the
-		// file).
+		// Select the device to pull the block from, preferring fast devices
+		// that aren't busy or failing requests. If we found no feasible
+		// device at all, fail the block (and in the long run, the file).
 		found := activity.leastBusy(candidates)
 		if found == -1 {
 			if lastError != nil {
@@ -1579,12 +1872,16 @@ loop:
 		activity.using(selected)
 		var buf []byte
 		blockNo := int(state.block.Offset / int64(state.file.BlockSize()))
+		t0 := time.Now()
 		buf, lastError = f.model.RequestGlobal(f.ctx, selected.ID, f.folderID, state.file.Name, blockNo, state.block.Offset, int(state.block.Size), state.block.Hash, state.block.WeakHash, selected.FromTemporary)
+		latency := time.Since(t0)
 		activity.done(selected)
 		if lastError != nil {
+			activity.record(selected, 0, latency, lastError)
 			l.Debugln("request:", f.folderID, state.file.Name, state.block.Offset, state.block.Size, selected.ID.Short(), "returned error:", lastError)
 			continue
 		}
+		requestLimiter.requestDone(len(buf), latency)
 
 		// Verify that the received block matches the desired hash, if not
 		// try pulling it from another device.
@@ -1593,8 +1890,9 @@ loop:
 		// integrity so we'll take it on trust. (The other side can and
 		// will verify.)
 		if f.Type != config.FolderTypeReceiveEncrypted {
-			lastError = f.verifyBuffer(buf, state.block)
+			lastError = verifyBuffer(buf, state.block, state.file.HashAlgorithm)
 		}
+		activity.record(selected, len(buf), latency, lastError)
 		if lastError != nil {
 			l.Debugln("request:", f.folderID, state.file.Name, state.block.Offset, state.block.Size, "hash mismatch")
 			continue
@@ -1615,7 +1913,7 @@ loop:
 func (f *sendReceiveFolder) performFinish(file, curFile protocol.FileInfo, hasCurFile bool, tempName string, snap *db.Snapshot, dbUpdateChan chan<- dbUpdateJob, scanChan chan<- string) error {
 	// Set the correct permission bits on the new file
 	if !f.IgnorePerms && !file.NoPermissions {
-		if err := f.mtimefs.Chmod(tempName, fs.FileMode(file.Permissions&0o777)); err != nil {
+		if err := f.mtimefs.Chmod(tempName, f.diskPermissions(file)); err != nil {
 			return fmt.Errorf("setting permissions: %w", err)
 		}
 	}
@@ -1634,14 +1932,27 @@ func (f *sendReceiveFolder) performFinish(file, curFile protocol.FileInfo, hasCu
 		}
 
 		if !curFile.IsDirectory() && !curFile.IsSymlink() && f.inConflict(curFile.Version, file.Version) {
-			// The new file has been changed in conflict with the existing one. We
-			// should file it away as a conflict instead of just removing or
-			// archiving.
+			// The new file has been changed in conflict with the existing one.
 			// Directories and symlinks aren't checked for conflicts.
-
-			err = f.inWritableDir(func(name string) error {
-				return f.moveForConflict(name, file.ModifiedBy.String(), scanChan)
-			}, curFile.Name)
+			switch f.resolveConflict(curFile, file) {
+			case conflictKeepLocal:
+				// Usually decided before pulling, by keepLocal. Throw away
+				// the new file and make sure the existing one wins.
+				if err := f.mtimefs.Remove(tempName); err != nil && !fs.IsNotExist(err) {
+					return fmt.Errorf("removing temp file: %w", err)
+				}
+				curFile.Version = keepLocalVersion(curFile.Version, file.Version, f.shortID)
+				dbUpdateChan <- dbUpdateJob{curFile, dbUpdateHandleFile}
+				return nil
+			case conflictKeepRemote:
+				err = f.deleteItemOnDisk(curFile, snap, scanChan)
+			default:
+				// We should file it away as a conflict instead of just
+				// removing or archiving.
+				err = f.inWritableDir(func(name string) error {
+					return f.moveForConflict(name, file.ModifiedBy.String(), scanChan)
+				}, curFile.Name)
+			}
 		} else {
 			err = f.deleteItemOnDisk(curFile, snap, scanChan)
 		}
@@ -1666,18 +1977,163 @@ func (f *sendReceiveFolder) performFinish(file, curFile protocol.FileInfo, hasCu
 	return nil
 }
 
+// performAppend appends the tail of the file, pulled into the temporary
+// file, to the existing file.
+func (f *sendReceiveFolder) performAppend(file, curFile protocol.FileInfo, appendFrom int64, tempName string, dbUpdateChan chan<- dbUpdateJob, scanChan chan<- string) error {
+	// The existing contents, which we didn't pull, must still be what we
+	// have in the database.
+	stat, err := f.mtimefs.Lstat(file.Name)
+	if err != nil {
+		return fmt.Errorf("checking existing file: %w", err)
+	}
+	if err := f.scanIfItemChanged(file.Name, stat, curFile, true, false, scanChan); err != nil {
+		return fmt.Errorf("checking existing file: %w", err)
+	}
+
+	err = f.inWritableDir(func(name string) error {
+		return f.appendTempFile(tempName, name, appendFrom, file.Size)
+	}, file.Name)
+	if err != nil {
+		return fmt.Errorf("appending to file: %w", err)
+	}
+	if err := f.mtimefs.Remove(tempName); err != nil && !fs.IsNotExist(err) {
+		l.Debugln(f, "removing temp file after append:", err)
+	}
+
+	if !f.IgnorePerms && !file.NoPermissions {
+		if err := f.mtimefs.Chmod(file.Name, f.diskPermissions(file)); err != nil {
+			return fmt.Errorf("setting permissions: %w", err)
+		}
+	}
+	if err := f.setPlatformData(&file, file.Name); err != nil {
+		return fmt.Errorf("setting metadata: %w", err)
+	}
+
+	f.mtimefs.Chtimes(file.Name, file.ModTime(), file.ModTime()) // never fails
+
+	dbUpdateChan <- dbUpdateJob{file, dbUpdateHandleFile}
+	return nil
+}
+
+// appendTempFile writes the contents of the temporary file to the named
+// file at the given offset, and sets its size.
+func (f *sendReceiveFolder) appendTempFile(tempName, name string, offset, size int64) error {
+	src, err := f.mtimefs.Open(tempName)
+	if err != nil {
+		return err
+	}
+	defer src.Close()
+
+	dst, err := f.mtimefs.OpenFile(name, fs.OptWriteOnly, 0o666)
+	if err != nil {
+		return err
+	}
+	if _, err := dst.Seek(offset, io.SeekStart); err != nil {
+		dst.Close()
+		return err
+	}
+	if _, err := io.Copy(dst, src); err != nil {
+		dst.Close()
+		return err
+	}
+	if err := dst.Truncate(size); err != nil {
+		dst.Close()
+		return err
+	}
+	if !f.DisableFsync {
+		if err := dst.Sync(); err != nil {
+			// Same as when finishing a temp file, not worth failing over.
+			l.Debugf("fsync failed: %v", err)
+		}
+	}
+	return dst.Close()
+}
+
+// performInPlace finishes the file changed in place, or restores the
+// original contents if pulling it failed.
+func (f *sendReceiveFolder) performInPlace(file protocol.FileInfo, pullErr error, dbUpdateChan chan<- dbUpdateJob) error {
+	err := pullErr
+	if err == nil {
+		err = f.finishInPlace(file)
+	}
+	if rerr := f.resolveInPlace(file.Name, err == nil); rerr != nil {
+		if err == nil {
+			return rerr
+		}
+		return fmt.Errorf("%w (restoring original contents: %v)", err, rerr)
+	}
+	if err != nil {
+		return err
+	}
+	dbUpdateChan <- dbUpdateJob{file, dbUpdateHandleFile}
+	return nil
+}
+
+func (f *sendReceiveFolder) finishInPlace(file protocol.FileInfo) error {
+	if !f.IgnorePerms && !file.NoPermissions {
+		if err := f.mtimefs.Chmod(file.Name, f.diskPermissions(file)); err != nil {
+			return fmt.Errorf("setting permissions: %w", err)
+		}
+	}
+	if err := f.setPlatformData(&file, file.Name); err != nil {
+		return fmt.Errorf("setting metadata: %w", err)
+	}
+	f.mtimefs.Chtimes(file.Name, file.ModTime(), file.ModTime()) // never fails
+	return nil
+}
+
+// resolveInPlace removes the journal of the file changed in place, after
+// restoring the original contents unless the change is complete.
+func (f *sendReceiveFolder) resolveInPlace(name string, complete bool) error {
+	if complete {
+		if err := f.mtimefs.Remove(inPlaceJournalName(name)); err != nil && !fs.IsNotExist(err) {
+			return fmt.Errorf("removing journal: %w", err)
+		}
+	} else if err := recoverInPlace(f.mtimefs, name); err != nil {
+		return err
+	}
+	return f.journals.remove(name)
+}
+
 func (f *sendReceiveFolder) finisherRoutine(snap *db.Snapshot, in <-chan *sharedPullerState, dbUpdateChan chan<- dbUpdateJob, scanChan chan<- string) {
 	for state := range in {
 		if closed, err := state.finalClose(); closed {
 			l.Debugln(f, "closing", state.file.Name)
 
 			f.queue.Done(state.file.Name)
+			f.inProgressMut.Lock()
+			if f.inProgress[state.file.Name] == state {
+				delete(f.inProgress, state.file.Name)
+			}
+			f.inProgressMut.Unlock()
 
 			if err == nil {
+				// Past this point the file on disk may change, ahead of
+				// the database.
+				if jerr := f.finishJournal.add(state.file); jerr != nil {
+					l.Debugln(f, "journaling finish:", jerr)
+				}
+			}
+
+			if state.inPlace {
+				err = f.performInPlace(state.file, err, dbUpdateChan)
+			} else if err == nil && state.appendFrom > 0 {
+				err = f.performAppend(state.file, state.curFile, state.appendFrom, state.tempName, dbUpdateChan, scanChan)
+			} else if err == nil {
 				err = f.performFinish(state.file, state.curFile, state.hasCurFile, state.tempName, snap, dbUpdateChan, scanChan)
 			}
 
 			if err != nil {
+				f.finishJournal.remove(state.file.Name)
+			}
+
+			if errors.Is(err, errSuperseded) {
+				// The temporary file is left in place, so that the blocks
+				// we already have can be reused when pulling the new
+				// version. That happens in the next pull iteration, which
+				// the index update that superseded this one triggered.
+				l.Debugln(f, "superseded", state.file.Name)
+			} else if err != nil {
 				f.newPullError(state.file.Name, fmt.Errorf("finishing: %w", err))
 			} else {
 				minBlocksPerBlock := state.file.BlockSize() / protocol.MinBlockSize
@@ -1713,10 +2169,76 @@ func (f *sendReceiveFolder) BringToFront(filename string) {
 	f.queue.BringToFront(filename)
 }
 
+func (f *sendReceiveFolder) SetPullPriority(pattern string, priority int) error {
+	return f.queue.SetPriority(pattern, priority)
+}
+
+func (f *sendReceiveFolder) PullPriorities() []PullPriority {
+	return f.queue.Priorities()
+}
+
+func (f *sendReceiveFolder) PushToBack(filename string) {
+	f.queue.PushToBack(filename)
+}
+
+func (f *sendReceiveFolder) SkipPull(filename string) bool {
+	return f.queue.Skip(filename)
+}
+
+func (f *sendReceiveFolder) SkippedPulls() []string {
+	return f.queue.Skipped()
+}
+
+func (f *sendReceiveFolder) JobCount() int {
+	return f.queue.Len()
+}
+
 func (f *sendReceiveFolder) Jobs(page, perpage int) ([]string, []string, int) {
 	return f.queue.Jobs(page, perpage)
 }
 
+// CancelSupersededPulls stops pulling those of the given files that are
+// currently in progress, if the version being pulled is no longer the
+// global version. Instead of completing the obsolete version, the file is
+// pulled again in its new version, reusing the data already transferred
+// where possible.
+func (f *sendReceiveFolder) CancelSupersededPulls(files []protocol.FileInfo) {
+	f.inProgressMut.Lock()
+	defer f.inProgressMut.Unlock()
+
+	if len(f.inProgress) == 0 {
+		return
+	}
+
+	var snap *db.Snapshot
+	for _, file := range files {
+		state, ok := f.inProgress[file.Name]
+		if !ok {
+			continue
+		}
+		if snap == nil {
+			var err error
+			snap, err = f.dbSnapshot()
+			if err != nil {
+				l.Debugf("%v failed to check for superseded pulls: %v", f, err)
+				return
+			}
+			defer snap.Release()
+		}
+		global, ok := snap.GetGlobal(file.Name)
+		if ok && global.Version.Equal(state.file.Version) {
+			continue
+		}
+
+		delete(f.inProgress, file.Name)
+		if cancelled, remaining := state.cancel(errSuperseded); cancelled {
+			l.Debugf("%v cancelled pull of superseded %s, %d bytes left", f, file.Name, remaining)
+			metricFolderSupersededPulls.WithLabelValues(f.ID).Inc()
+			metricFolderSupersededBytesAvoided.WithLabelValues(f.ID).Add(float64(remaining))
+		}
+	}
+}
+
 // dbUpdaterRoutine aggregates db updates and commits them in batches no
 // larger than 1000 items, and no more delayed than 2 seconds.
 func (f *sendReceiveFolder) dbUpdaterRoutine(dbUpdateChan <-chan dbUpdateJob) {
@@ -1748,6 +2270,12 @@ func (f *sendReceiveFolder) dbUpdaterRoutine(dbUpdateChan <-chan dbUpdateJob) {
 		// (across the network) use this call to updateLocals
 		f.updateLocalsFromPulling(files)
 
+		names := make([]string, len(files))
+		for i, file := range files {
+			names[i] = file.Name
+		}
+		f.finishJournal.remove(names...)
+
 		if found {
 			f.ReceivedFile(lastFile.Name, lastFile.IsDeleted())
 			found = false
@@ -1843,6 +2371,92 @@ func (f *sendReceiveFolder) inConflict(current, replacement protocol.Vector) boo
 	return false
 }
 
+// conflictResolution is the outcome of applying the folder's conflict
+// policy to a file that was changed concurrently locally and remotely.
+type conflictResolution int
+
+const (
+	conflictKeepBoth   conflictResolution = iota // keep the local file as a conflict copy
+	conflictKeepLocal                            // discard the remote change
+	conflictKeepRemote                           // discard the local change
+)
+
+// resolveConflict applies the folder's conflict policy to decide which of
+// two conflicting versions of a file to keep. Whenever the policy can't
+// decide (e.g. the modification times are within the clock skew tolerance
+// under the keepNewest policy) both versions are kept, like in manual mode.
+func (f *sendReceiveFolder) resolveConflict(local, remote protocol.FileInfo) conflictResolution {
+	switch f.ConflictPolicy {
+	case config.ConflictPolicyKeepLocal:
+		return conflictKeepLocal
+	case config.ConflictPolicyKeepRemote:
+		return conflictKeepRemote
+	case config.ConflictPolicyKeepNewest:
+		// The modification times are set by the clocks of the devices
+		// that made the changes, so we correct for what we know of their
+		// offsets before comparing.
+		localModTime := local.ModTime().Add(-f.model.clockOffset(local.ModifiedBy))
+		remoteModTime := remote.ModTime().Add(-f.model.clockOffset(remote.ModifiedBy))
+		tolerance := time.Duration(f.model.cfg.Options().ConflictClockSkewToleranceS) * time.Second
+		switch diff := localModTime.Sub(remoteModTime); {
+		case diff > tolerance:
+			return conflictKeepLocal
+		case -diff > tolerance:
+			return conflictKeepRemote
+		}
+	case config.ConflictPolicyKeepLargest:
+		switch {
+		case local.Size > remote.Size:
+			return conflictKeepLocal
+		case remote.Size > local.Size:
+			return conflictKeepRemote
+		}
+	}
+	return conflictKeepBoth
+}
+
+// keepLocal applies the folder's conflict policy to a file before it's
+// pulled, so that remote contents the policy discards aren't fetched at
+// all. It returns true if the local file was kept, or if that failed
+// because the file changed on disk, and false if the file should be pulled.
+func (f *sendReceiveFolder) keepLocal(file protocol.FileInfo, snap *db.Snapshot, dbUpdateChan chan<- dbUpdateJob, scanChan chan<- string) bool {
+	curFile, ok := snap.Get(protocol.LocalDeviceID, file.Name)
+	if !ok || curFile.IsDeleted() || curFile.Type != protocol.FileInfoTypeFile || !f.inConflict(curFile.Version, file.Version) {
+		return false
+	}
+	if f.resolveConflict(curFile, file) != conflictKeepLocal {
+		return false
+	}
+	stat, err := f.mtimefs.Lstat(file.Name)
+	if err != nil {
+		// Nothing to keep, as far as the disk is concerned.
+		return false
+	}
+	if err := f.scanIfItemChanged(file.Name, stat, curFile, true, false, scanChan); err != nil {
+		f.newPullError(file.Name, fmt.Errorf("checking existing file: %w", err))
+		return true
+	}
+
+	l.Debugf("%v keeping local %s over conflicting remote version", f, file.Name)
+	curFile.Version = keepLocalVersion(curFile.Version, file.Version, f.shortID)
+	dbUpdateChan <- dbUpdateJob{curFile, dbUpdateHandleFile}
+	return true
+}
+
+// keepLocalVersion returns the version of a local file kept over a
+// conflicting remote one. The merge of both is newer than either, so the
+// remote device takes the local file as a regular update, without a
+// conflict for its own policy to resolve and bump the version again. Only
+// when the merge isn't newer than the remote version, as the remote has
+// seen a later change by us than we have, is the version also bumped.
+func keepLocalVersion(local, remote protocol.Vector, id protocol.ShortID) protocol.Vector {
+	version := local.Copy().Merge(remote)
+	if version.Compare(remote) != protocol.Greater {
+		version = version.Update(id)
+	}
+	return version
+}
+
 func (f *sendReceiveFolder) moveForConflict(name, lastModBy string, scanChan chan<- string) error {
 	if isConflict(name) {
 		l.Infoln("Conflict for", name, "which is already a conflict copy; not copying again.")
@@ -1906,6 +2520,7 @@ func (f *sendReceiveFolder) newPullError(path string, err error) {
 	// for errors occurring specifically in the puller routine.
 	errStr := fmt.Sprintf("syncing: %s", err)
 	f.tempPullErrors[path] = errStr
+	f.errorDigests.record(path, errStr, time.Now())
 
 	l.Debugf("%v new error for %v: %v", f, path, err)
 }
@@ -2011,7 +2626,7 @@ func (f *sendReceiveFolder) deleteDirOnDiskHandleChildren(dir string, snap *db.S
 			return nil
 		}
 		if !cf.IsEquivalentOptional(diskFile, protocol.FileInfoComparison{
-			ModTimeWindow:   f.modTimeWindow,
+			ModTime:         f.modTime,
 			IgnorePerms:     f.IgnorePerms,
 			IgnoreBlocks:    true,
 			IgnoreFlags:     protocol.LocalAllFlags,
@@ -2065,13 +2680,17 @@ func (f *sendReceiveFolder) deleteDirOnDiskHandleChildren(dir string, snap *db.S
 // scanIfItemChanged schedules the given file for scanning and returns errModified
 // if it differs from the information in the database. Returns nil if the file has
 // not changed.
-func (f *sendReceiveFolder) scanIfItemChanged(name string, stat fs.FileInfo, item protocol.FileInfo, hasItem bool, fromDelete bool, scanChan chan<- string) (err error) {
-	defer func() {
-		if err == errModified {
-			scanChan <- name
-		}
-	}()
+func (f *sendReceiveFolder) scanIfItemChanged(name string, stat fs.FileInfo, item protocol.FileInfo, hasItem bool, fromDelete bool, scanChan chan<- string) error {
+	err := f.itemChanged(stat, item, hasItem, fromDelete)
+	if err == errModified {
+		scanChan <- name
+	}
+	return err
+}
 
+// itemChanged returns errModified if the item on disk isn't what we have in
+// the database.
+func (f *sendReceiveFolder) itemChanged(stat fs.FileInfo, item protocol.FileInfo, hasItem bool, fromDelete bool) error {
 	if !hasItem || item.Deleted {
 		// The item appeared from nowhere
 		return errModified
@@ -2085,8 +2704,11 @@ func (f *sendReceiveFolder) scanIfItemChanged(name string, stat fs.FileInfo, ite
 	if err != nil {
 		return fmt.Errorf("comparing item on disk to db: %w", err)
 	}
+	if !item.NoPermissions {
+		item.Permissions = uint32(f.diskPermissions(item))
+	}
 	if !statItem.IsEquivalentOptional(item, protocol.FileInfoComparison{
-		ModTimeWindow:   f.modTimeWindow,
+		ModTime:         f.modTime,
 		IgnorePerms:     f.IgnorePerms,
 		IgnoreBlocks:    true,
 		IgnoreFlags:     protocol.LocalAllFlags,
@@ -2128,6 +2750,12 @@ func (f *sendReceiveFolder) checkToBeDeleted(file, cur protocol.FileInfo, hasCur
 // setPlatformData makes adjustments to the metadata that should happen for
 // all types (files, directories, symlinks). This should be one of the last
 // things we do to a file when syncing changes to it.
+// diskPermissions returns the permission bits to set on disk for the given
+// file, as translated by the folder's permissions profile.
+func (f *sendReceiveFolder) diskPermissions(file protocol.FileInfo) fs.FileMode {
+	return fs.FileMode(f.PermissionsProfile.ToDisk(file.Permissions, file.IsDirectory()) & 0o777)
+}
+
 func (f *sendReceiveFolder) setPlatformData(file *protocol.FileInfo, name string) error {
 	if f.SyncXattrs {
 		// Set extended attributes.
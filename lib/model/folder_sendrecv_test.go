@@ -14,6 +14,7 @@ import (
 	"io"
 	"os"
 	"path/filepath"
+	"slices"
 	"strconv"
 	"strings"
 	"testing"
@@ -300,7 +301,7 @@ func TestCopierFinder(t *testing.T) {
 	}
 
 	// Verify that the fetched blocks have actually been written to the temp file
-	blks, err := scanner.HashFile(context.TODO(), f.ID, f.Filesystem(nil), tempFile, protocol.MinBlockSize, nil, false)
+	blks, err := scanner.HashFile(context.TODO(), f.ID, f.Filesystem(nil), tempFile, protocol.BlockHashSHA256, protocol.MinBlockSize, nil, false)
 	if err != nil {
 		t.Log(err)
 	}
@@ -678,6 +679,122 @@ func TestDeregisterOnFailInPull(t *testing.T) {
 	}
 }
 
+func TestCancelSupersededPulls(t *testing.T) {
+	file := setupFile("filex", []int{0, 2, 0, 0, 5, 0, 0, 8})
+	file.Version = protocol.Vector{}.Update(device1.Short())
+
+	_, f, wcfgCancel := setupSendReceiveFolder(t)
+	defer wcfgCancel()
+
+	f.fset.Update(device1, []protocol.FileInfo{file})
+	f.queue.Push(file.Name, 0, time.Time{})
+	f.queue.Pop()
+
+	copyChan := make(chan copyBlocksState, 1)
+	f.handleFile(file, fsetSnapshot(t, f.fset), copyChan)
+	state := (<-copyChan).sharedPullerState
+
+	// An update that doesn't change the global version doesn't affect the
+	// ongoing pull.
+	f.CancelSupersededPulls([]protocol.FileInfo{file})
+	if err := state.failed(); err != nil {
+		t.Fatal("Unexpected failure:", err)
+	}
+
+	// A newer version does.
+	newer := file
+	newer.Version = file.Version.Copy().Update(device1.Short())
+	f.fset.Update(device1, []protocol.FileInfo{newer})
+	f.CancelSupersededPulls([]protocol.FileInfo{newer})
+	if err := state.failed(); !errors.Is(err, errSuperseded) {
+		t.Fatal("Expected pull to be superseded, got", err)
+	}
+	if len(f.inProgress) != 0 {
+		t.Error("Superseded pull still in progress")
+	}
+
+	// Finishing the superseded pull isn't an error, and the file remains
+	// needed in its new version.
+	finisherChan := make(chan *sharedPullerState, 1)
+	finisherChan <- state
+	close(finisherChan)
+	f.finisherRoutine(fsetSnapshot(t, f.fset), finisherChan, make(chan dbUpdateJob, 1), make(chan string, 1))
+
+	if len(f.tempPullErrors) != 0 {
+		t.Error("Unexpected pull errors:", f.tempPullErrors)
+	}
+	if f.queue.lenProgress() != 0 {
+		t.Error("File still in progress in the job queue")
+	}
+}
+
+func TestPullConcurrency(t *testing.T) {
+	_, f, wcfgCancel := setupSendReceiveFolder(t)
+	defer wcfgCancel()
+
+	f.Copiers = 4
+	f.PullerMaxPendingKiB = 64 << 10
+
+	copiers, pendingKiB, smallCopiers, smallPendingKiB := f.pullConcurrency()
+	if copiers != 4 || pendingKiB != 64<<10 || smallCopiers != 0 || smallPendingKiB != 0 {
+		t.Errorf("without small file lane: got %d, %d, %d, %d", copiers, pendingKiB, smallCopiers, smallPendingKiB)
+	}
+
+	f.SmallFileLanePct = 25
+	copiers, pendingKiB, smallCopiers, smallPendingKiB = f.pullConcurrency()
+	if copiers != 3 || pendingKiB != 48<<10 || smallCopiers != 1 || smallPendingKiB != 16<<10 {
+		t.Errorf("with small file lane: got %d, %d, %d, %d", copiers, pendingKiB, smallCopiers, smallPendingKiB)
+	}
+
+	// The regular lane always keeps at least one copier.
+	f.SmallFileLanePct = 100
+	if copiers, _, smallCopiers, _ = f.pullConcurrency(); copiers != 1 || smallCopiers != 4 {
+		t.Errorf("with full small file lane: got %d, %d copiers", copiers, smallCopiers)
+	}
+}
+
+func TestSmallFileLane(t *testing.T) {
+	m, f, wcfgCancel := setupSendReceiveFolder(t)
+	defer wcfgCancel()
+	addFakeConn(m, device1, f.ID)
+
+	f.SmallFileLanePct = 50
+	f.SmallFileMaxKiB = 1
+
+	large := setupFile("large", []int{1, 2})
+	large.Size = 2 * protocol.MinBlockSize
+	small := setupFile("small", []int{3})
+	small.Size = 100
+	for _, file := range []*protocol.FileInfo{&large, &small} {
+		file.Version = protocol.Vector{}.Update(device1.Short())
+	}
+	f.fset.Update(device1, []protocol.FileInfo{large, small})
+
+	// Nothing reads from the regular lane, yet the small file gets through
+	// on its own.
+	copyChan := make(chan copyBlocksState)
+	smallCopyChan := make(chan copyBlocksState)
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		f.processNeeded(fsetSnapshot(t, f.fset), make(chan dbUpdateJob, 10), copyChan, smallCopyChan, make(chan string, 10))
+	}()
+
+	select {
+	case cs := <-smallCopyChan:
+		if cs.file.Name != small.Name {
+			t.Errorf("got %s in the small file lane", cs.file.Name)
+		}
+	case <-time.After(10 * time.Second):
+		t.Fatal("small file didn't get through")
+	}
+
+	if cs := <-copyChan; cs.file.Name != large.Name {
+		t.Errorf("got %s in the regular lane", cs.file.Name)
+	}
+	<-done
+}
+
 func TestIssue3164(t *testing.T) {
 	_, f, wcfgCancel := setupSendReceiveFolder(t)
 	defer wcfgCancel()
@@ -912,6 +1029,70 @@ func TestCopyOwner(t *testing.T) {
 
 // TestSRConflictReplaceFileByDir checks that a conflict is created when an existing file
 // is replaced with a directory and versions are conflicting
+func TestSyncOwnershipMapping(t *testing.T) {
+	if build.IsWindows {
+		t.Skip("numeric ownership not supported on Windows")
+	}
+
+	m, f, wcfgCancel := setupSendReceiveFolder(t)
+	defer wcfgCancel()
+	f.folder.FolderConfiguration = newFolderConfiguration(m.cfg, f.ID, f.Label, fs.FilesystemTypeFake, "/TestSyncOwnershipMapping")
+	f.folder.FolderConfiguration.SyncOwnership = true
+	f.folder.FolderConfiguration.OwnershipMapping = config.OwnershipMapping{
+		Users: []config.OwnershipMappingEntry{
+			{From: "1000", To: "3000"},
+			{From: "syncthing-test-user", To: "2000"},
+		},
+		Groups: []config.OwnershipMappingEntry{
+			{From: "100", To: "4000"},
+		},
+	}
+
+	f.fset = newFileSet(t, f.ID, m.db)
+	f.mtimefs = f.Filesystem(f.fset)
+
+	cases := []struct {
+		name             string
+		unix             protocol.UnixData
+		expUID, expGroup int
+	}{
+		// Names are matched before numeric IDs
+		{"named", protocol.UnixData{OwnerName: "syncthing-test-user", UID: 1000, GroupName: "syncthing-test-group", GID: 100}, 2000, 4000},
+		{"numeric", protocol.UnixData{UID: 1000, GID: 100}, 3000, 4000},
+		{"unmapped", protocol.UnixData{UID: 1234, GID: 5678}, 1234, 5678},
+	}
+
+	dbUpdateChan := make(chan dbUpdateJob, 1)
+	scanChan := make(chan string)
+	defer close(dbUpdateChan)
+	for _, tc := range cases {
+		unix := tc.unix
+		dir := protocol.FileInfo{
+			Name:        tc.name,
+			Type:        protocol.FileInfoTypeDirectory,
+			Permissions: 0o755,
+			Platform:    protocol.PlatformData{Unix: &unix},
+		}
+		f.handleDir(dir, fsetSnapshot(t, f.fset), dbUpdateChan, scanChan)
+		select {
+		case <-dbUpdateChan:
+		case toScan := <-scanChan:
+			t.Fatal("Unexpected receive on scanChan:", toScan)
+		}
+		if err, ok := f.tempPullErrors[tc.name]; ok {
+			t.Fatalf("%s: unexpected pull error: %v", tc.name, err)
+		}
+
+		info, err := f.mtimefs.Lstat(tc.name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if info.Owner() != tc.expUID || info.Group() != tc.expGroup {
+			t.Errorf("%s: expected owner/group to be %d/%d, not %d/%d", tc.name, tc.expUID, tc.expGroup, info.Owner(), info.Group())
+		}
+	}
+}
+
 func TestSRConflictReplaceFileByDir(t *testing.T) {
 	_, f, wcfgCancel := setupSendReceiveFolder(t)
 	defer wcfgCancel()
@@ -1399,3 +1580,511 @@ func startCopier(f *sendReceiveFolder, pullChan chan<- pullBlockState, finisherC
 	}()
 	return copyChan, wg
 }
+
+func TestPullConflictPolicy(t *testing.T) {
+	for _, tc := range []struct {
+		policy     config.ConflictPolicy
+		expContent string
+		expConfl   int
+	}{
+		{config.ConflictPolicyManual, "remote", 1},
+		{config.ConflictPolicyKeepLocal, "local content", 0},
+		{config.ConflictPolicyKeepRemote, "remote", 0},
+		{config.ConflictPolicyKeepLargest, "local content", 0},
+		{config.ConflictPolicyKeepNewest, "remote", 0},
+	} {
+		t.Run(tc.policy.String(), func(t *testing.T) {
+			m, f, wcfgCancel := setupSendReceiveFolder(t)
+			defer wcfgCancel()
+			f.ConflictPolicy = tc.policy
+			ffs := f.Filesystem(nil)
+
+			name := "foo"
+			writeFile(t, ffs, name, []byte("local content"))
+			old := time.Now().Add(-time.Hour)
+			must(t, ffs.Chtimes(name, old, old))
+			must(t, f.scanSubdirs(nil))
+
+			snap := dbSnapshot(t, m, f.ID)
+			defer snap.Release()
+			cur, ok := snap.Get(protocol.LocalDeviceID, name)
+			if !ok {
+				t.Fatal("file is missing")
+			}
+
+			remote := cur
+			remote.Version = protocol.Vector{}.Update(device1.Short())
+			remote.ModifiedBy = device1.Short()
+			remote.Size = int64(len("remote"))
+			remote.ModifiedS = time.Now().Unix()
+
+			temp := fs.TempName(name)
+			writeFile(t, ffs, temp, []byte("remote"))
+			scanChan := make(chan string, 1)
+			dbUpdateChan := make(chan dbUpdateJob, 1)
+
+			must(t, f.performFinish(remote, cur, true, temp, snap, dbUpdateChan, scanChan))
+
+			fd, err := ffs.Open(name)
+			must(t, err)
+			bs, err := io.ReadAll(fd)
+			fd.Close()
+			must(t, err)
+			if string(bs) != tc.expContent {
+				t.Errorf("expected content %q, got %q", tc.expContent, bs)
+			}
+			if confls := existingConflicts(name, ffs); len(confls) != tc.expConfl {
+				t.Errorf("expected %d conflicts, got %d", tc.expConfl, len(confls))
+			}
+			if _, err := ffs.Lstat(temp); !fs.IsNotExist(err) {
+				t.Error("temp file should be gone")
+			}
+
+			job := <-dbUpdateChan
+			if tc.expContent == "local content" {
+				if !job.file.Version.GreaterEqual(cur.Version) || !job.file.Version.GreaterEqual(remote.Version) {
+					t.Errorf("kept local file must have a version newer than both, got %v", job.file.Version)
+				}
+			} else if !job.file.Version.Equal(remote.Version) {
+				t.Errorf("expected remote version, got %v", job.file.Version)
+			}
+		})
+	}
+}
+
+func TestPullKeepLocalTwoPeers(t *testing.T) {
+	// Two devices that both keep their local file on a conflict must
+	// settle on one version, without fetching the remote file only to
+	// discard it or bumping the version back and forth.
+
+	w, fcfg, wcfgCancel := newDefaultCfgWrapper()
+	defer wcfgCancel()
+	fcfg.ConflictPolicy = config.ConflictPolicyKeepLocal
+	setFolder(t, w, fcfg)
+	tfs := fcfg.Filesystem(nil)
+
+	name := "foo"
+	writeFile(t, tfs, name, []byte("local content"))
+	old := time.Now().Add(-time.Hour)
+	must(t, tfs.Chtimes(name, old, old))
+
+	m, fc := setupModelWithConnectionFromWrapper(t, w)
+	defer cleanupModelAndRemoveDir(m, tfs.URI())
+
+	local, ok := m.testCurrentFolderFile(fcfg.ID, name)
+	if !ok {
+		t.Fatal("file is missing")
+	}
+
+	sent := make(chan protocol.FileInfo, 10)
+	fc.setIndexFn(func(_ context.Context, _ string, fs []protocol.FileInfo) error {
+		for _, f := range fs {
+			if f.Name == name {
+				sent <- f
+			}
+		}
+		return nil
+	})
+
+	// The other device changed the file concurrently, later, so its
+	// version is the global one and we need it.
+	fc.addFile(name, 0o644, protocol.FileInfoTypeFile, []byte("remote content"))
+	remote := fc.files[len(fc.files)-1]
+	fc.sendIndexUpdate()
+
+	var kept protocol.FileInfo
+	select {
+	case kept = <-sent:
+	case <-time.After(10 * time.Second):
+		t.Fatal("timed out")
+	}
+	if n := fc.RequestCallCount(); n != 0 {
+		t.Errorf("requested %d blocks of a file that is kept locally", n)
+	}
+	if err := equalContents(tfs, name, []byte("local content")); err != nil {
+		t.Error(err)
+	}
+	if kept.Version.Compare(remote.Version) != protocol.Greater {
+		t.Errorf("kept version %v must be newer than the remote %v", kept.Version, remote.Version)
+	}
+	if got, exp := kept.Version.Counter(myID.Short()), local.Version.Counter(myID.Short()); got != exp {
+		t.Errorf("kept version bumped our counter from %d to %d", exp, got)
+	}
+
+	// Being newer than its own, and with its own counter unchanged, the
+	// other device takes our file as a regular update rather than as a
+	// conflict for its policy, and announces the same version back. That
+	// doesn't need anything.
+	if kept.Version.Counter(device1.Short()) != remote.Version.Counter(device1.Short()) {
+		t.Fatal("the other device would see our version as a conflict")
+	}
+	fc.mut.Lock()
+	fc.files = nil
+	fc.addFileLocked(name, 0o644, protocol.FileInfoTypeFile, []byte("local content"), kept.Version, 0)
+	fc.mut.Unlock()
+	fc.sendIndexUpdate()
+	must(t, m.ScanFolder(fcfg.ID))
+
+	snap := dbSnapshot(t, m, fcfg.ID)
+	need := snap.NeedSize(protocol.LocalDeviceID)
+	snap.Release()
+	if need.Files != 0 {
+		t.Errorf("expected nothing needed, got %d files", need.Files)
+	}
+	select {
+	case f := <-sent:
+		t.Errorf("sent another version %v after settling", f.Version)
+	case <-time.After(100 * time.Millisecond):
+	}
+}
+
+func TestResolveConflictClockOffset(t *testing.T) {
+	m, f, wcfgCancel := setupSendReceiveFolder(t)
+	defer wcfgCancel()
+	f.ConflictPolicy = config.ConflictPolicyKeepNewest
+
+	now := time.Now().Truncate(time.Second)
+	local := protocol.FileInfo{Name: "foo", ModifiedS: now.Add(-time.Hour).Unix(), ModifiedBy: myID.Short()}
+	remote := protocol.FileInfo{Name: "foo", ModifiedS: now.Unix(), ModifiedBy: device1.Short()}
+
+	if res := f.resolveConflict(local, remote); res != conflictKeepRemote {
+		t.Errorf("expected the newer remote file to be kept, got %v", res)
+	}
+
+	// The remote clock is two hours ahead, so its change was made earlier.
+	m.mut.Lock()
+	m.clockOffsets[device1] = 2 * time.Hour
+	m.mut.Unlock()
+	if res := f.resolveConflict(local, remote); res != conflictKeepLocal {
+		t.Errorf("expected the local file to be kept after correcting the clock offset, got %v", res)
+	}
+
+	// An hour apart is within the tolerance, so both are kept.
+	waiter, err := m.cfg.Modify(func(cfg *config.Configuration) {
+		cfg.Options.ConflictClockSkewToleranceS = 3600
+	})
+	must(t, err)
+	waiter.Wait()
+	if res := f.resolveConflict(local, remote); res != conflictKeepBoth {
+		t.Errorf("expected both files to be kept within the tolerance, got %v", res)
+	}
+}
+
+func TestPullMetadataOnlyChange(t *testing.T) {
+	m, f, wcfgCancel := setupSendReceiveFolder(t)
+	defer wcfgCancel()
+	conn := addFakeConn(m, device1, f.ID)
+
+	name := "foo"
+	writeFile(t, f.mtimefs, name, []byte("data"))
+	must(t, f.scanSubdirs(nil))
+
+	file, ok := m.testCurrentFolderFile(f.ID, name)
+	if !ok {
+		t.Fatal("file missing")
+	}
+	mtime := file.ModTime().Add(-time.Hour).Truncate(time.Second)
+	file.ModifiedS = mtime.Unix()
+	file.ModifiedNs = 0
+	file.Version = file.Version.Update(device1.Short())
+	must(t, m.Index(conn, &protocol.Index{Folder: f.ID, Files: []protocol.FileInfo{file}}))
+
+	scanChan := make(chan string, 1)
+	changed, err := f.pullerIteration(scanChan)
+	must(t, err)
+	if changed != 1 {
+		t.Error("Expected one change in pull, got", changed)
+	}
+	if len(f.tempPullErrors) != 0 {
+		t.Error("unexpected pull errors", f.tempPullErrors)
+	}
+	if cur, ok := m.testCurrentFolderFile(f.ID, name); !ok || !cur.Version.Equal(file.Version) {
+		t.Errorf("expected local version %v, got %v", file.Version, cur.Version)
+	}
+	info, err := f.mtimefs.Lstat(name)
+	must(t, err)
+	if !info.ModTime().Equal(mtime) {
+		t.Errorf("expected modification time %v, got %v", mtime, info.ModTime())
+	}
+}
+
+func TestPullMetadataOnlyChangeModified(t *testing.T) {
+	m, f, wcfgCancel := setupSendReceiveFolder(t)
+	defer wcfgCancel()
+	conn := addFakeConn(m, device1, f.ID)
+
+	name := "foo"
+	writeFile(t, f.mtimefs, name, []byte("data"))
+	must(t, f.scanSubdirs(nil))
+
+	file, ok := m.testCurrentFolderFile(f.ID, name)
+	if !ok {
+		t.Fatal("file missing")
+	}
+	version := file.Version
+	file.ModifiedS -= 3600
+	file.Version = file.Version.Update(device1.Short())
+	must(t, m.Index(conn, &protocol.Index{Folder: f.ID, Files: []protocol.FileInfo{file}}))
+
+	// Change the contents on disk without scanning
+	writeFile(t, f.mtimefs, name, []byte("changed data"))
+
+	scanChan := make(chan string, 1)
+	_, err := f.pullerIteration(scanChan)
+	must(t, err)
+	select {
+	case scanned := <-scanChan:
+		if scanned != name {
+			t.Errorf("expected %v to be scanned, got %v", name, scanned)
+		}
+	default:
+		t.Error("expected the modified file to be scanned")
+	}
+	if _, ok := f.tempPullErrors[name]; !ok {
+		t.Error("expected a pull error")
+	}
+	if cur, ok := m.testCurrentFolderFile(f.ID, name); !ok || !cur.Version.Equal(version) {
+		t.Errorf("expected local version to remain %v, got %v", version, cur.Version)
+	}
+}
+
+func TestIsMetadataOnlyChange(t *testing.T) {
+	file := protocol.FileInfo{Name: "foo", Type: protocol.FileInfoTypeFile}
+	deleted := file
+	deleted.Deleted = true
+	dir := protocol.FileInfo{Name: "foo", Type: protocol.FileInfoTypeDirectory}
+	ignored := file
+	ignored.LocalFlags = protocol.FlagLocalIgnored
+	changed := setupFile("foo", []int{1})
+
+	if !isMetadataOnlyChange(file, file) {
+		t.Error("same empty file should be a metadata only change")
+	}
+	for _, cur := range []protocol.FileInfo{deleted, dir, ignored, changed} {
+		if isMetadataOnlyChange(cur, file) {
+			t.Errorf("%v should not be a metadata only change", cur)
+		}
+	}
+}
+
+func TestPullAppendOnly(t *testing.T) {
+	m, f, wcfgCancel := setupSendReceiveFolder(t)
+	defer wcfgCancel()
+	conn := addFakeConn(m, device1, f.ID)
+
+	name := "log"
+	oldData := make([]byte, 2*protocol.MinBlockSize+1000)
+	_, _ = rand.Read(oldData)
+	writeFile(t, f.mtimefs, name, oldData)
+	must(t, f.scanSubdirs(nil))
+
+	newData := make([]byte, len(oldData)+3*protocol.MinBlockSize/2)
+	copy(newData, oldData)
+	_, _ = rand.Read(newData[len(oldData):])
+
+	file, ok := m.testCurrentFolderFile(f.ID, name)
+	if !ok {
+		t.Fatal("file missing")
+	}
+	blocks, err := scanner.Blocks(context.Background(), bytes.NewReader(newData), protocol.MinBlockSize, int64(len(newData)), nil, true)
+	must(t, err)
+	file.Blocks = blocks
+	file.BlocksHash = protocol.BlocksHash(blocks)
+	file.Size = int64(len(newData))
+	file.Version = file.Version.Update(device1.Short())
+	must(t, m.Index(conn, &protocol.Index{Folder: f.ID, Files: []protocol.FileInfo{file}}))
+
+	// Only the tail is to be pulled, into a temporary file of its own
+	copyChan := make(chan copyBlocksState, 1)
+	snap := fsetSnapshot(t, f.fset)
+	f.handleFile(file, snap, copyChan)
+	snap.Release()
+	cs := <-copyChan
+	if cs.appendFrom != 2*protocol.MinBlockSize || len(cs.blocks) != len(blocks)-2 {
+		t.Fatalf("expected to pull %d blocks to append from %d, got %d from %d", len(blocks)-2, 2*protocol.MinBlockSize, len(cs.blocks), cs.appendFrom)
+	}
+
+	requestedMut := sync.NewMutex()
+	var requested []int64
+	conn.RequestCalls(func(_ context.Context, req *protocol.Request) ([]byte, error) {
+		requestedMut.Lock()
+		requested = append(requested, req.Offset)
+		requestedMut.Unlock()
+		return newData[req.Offset : req.Offset+int64(req.Size)], nil
+	})
+
+	scanChan := make(chan string, 1)
+	changed, err := f.pullerIteration(scanChan)
+	must(t, err)
+	if changed != 1 {
+		t.Error("Expected one change in pull, got", changed)
+	}
+	if len(f.tempPullErrors) != 0 {
+		t.Fatal("unexpected pull errors", f.tempPullErrors)
+	}
+
+	for _, off := range requested {
+		if off < 2*protocol.MinBlockSize {
+			t.Errorf("unexpected request for unchanged data at offset %d", off)
+		}
+	}
+	fd, err := f.mtimefs.Open(name)
+	must(t, err)
+	bs, err := io.ReadAll(fd)
+	fd.Close()
+	must(t, err)
+	if !bytes.Equal(bs, newData) {
+		t.Error("file contents differ after appending")
+	}
+	if _, err := f.mtimefs.Lstat(fs.TempName(name)); !fs.IsNotExist(err) {
+		t.Error("temporary file should be removed:", err)
+	}
+	if cur, ok := m.testCurrentFolderFile(f.ID, name); !ok || !cur.Version.Equal(file.Version) {
+		t.Errorf("expected local version %v, got %v", file.Version, cur.Version)
+	}
+}
+
+func TestAppendOffset(t *testing.T) {
+	_, f, wcfgCancel := setupSendReceiveFolder(t)
+	defer wcfgCancel()
+
+	block := func(b byte, size int) protocol.BlockInfo {
+		return protocol.BlockInfo{Size: size, Hash: []byte{b}}
+	}
+	full := protocol.MinBlockSize
+	cur := protocol.FileInfo{
+		Name:    "log",
+		Type:    protocol.FileInfoTypeFile,
+		Size:    int64(2*full + 10),
+		Blocks:  []protocol.BlockInfo{block(1, full), block(2, full), block(3, 10)},
+		Version: protocol.Vector{}.Update(myID.Short()),
+	}
+	grown := cur
+	grown.Size = int64(3*full + 10)
+	grown.Blocks = []protocol.BlockInfo{block(1, full), block(2, full), block(4, full), block(5, 10)}
+	grown.Version = cur.Version.Update(device1.Short())
+
+	if from := f.appendOffset(grown, cur, true); from != int64(2*full) {
+		t.Errorf("expected to append from %d, got %d", 2*full, from)
+	}
+
+	changed := grown
+	changed.Blocks = []protocol.BlockInfo{block(1, full), block(6, full), block(4, full), block(5, 10)}
+	concurrent := grown
+	concurrent.Version = protocol.Vector{}.Update(device1.Short())
+	shrunk := cur
+	shrunk.Size = int64(full)
+	shrunk.Blocks = cur.Blocks[:1]
+	shrunk.Version = grown.Version
+	for name, file := range map[string]protocol.FileInfo{"changed": changed, "concurrent": concurrent, "shrunk": shrunk} {
+		if from := f.appendOffset(file, cur, true); from != 0 {
+			t.Errorf("%s: expected no append, got %d", name, from)
+		}
+	}
+}
+
+func TestPullInPlace(t *testing.T) {
+	m, f, wcfgCancel := setupSendReceiveFolder(t)
+	defer wcfgCancel()
+	conn := addFakeConn(m, device1, f.ID)
+	f.InPlaceDelta = true
+
+	name := "image"
+	oldData := make([]byte, 3*protocol.MinBlockSize+1000)
+	_, _ = rand.Read(oldData)
+	writeFile(t, f.mtimefs, name, oldData)
+	must(t, f.scanSubdirs(nil))
+	oldInfo, err := f.mtimefs.Lstat(name)
+	must(t, err)
+
+	// Change the second block and shorten the last one
+	newData := append([]byte{}, oldData[:len(oldData)-500]...)
+	_, _ = rand.Read(newData[protocol.MinBlockSize : 2*protocol.MinBlockSize])
+
+	file, ok := m.testCurrentFolderFile(f.ID, name)
+	if !ok {
+		t.Fatal("file missing")
+	}
+	blocks, err := scanner.Blocks(context.Background(), bytes.NewReader(newData), protocol.MinBlockSize, int64(len(newData)), nil, true)
+	must(t, err)
+	file.Blocks = blocks
+	file.BlocksHash = protocol.BlocksHash(blocks)
+	file.Size = int64(len(newData))
+	file.Version = file.Version.Update(device1.Short())
+	must(t, m.Index(conn, &protocol.Index{Folder: f.ID, Files: []protocol.FileInfo{file}}))
+
+	readFile := func() []byte {
+		t.Helper()
+		fd, err := f.mtimefs.Open(name)
+		must(t, err)
+		defer fd.Close()
+		bs, err := io.ReadAll(fd)
+		must(t, err)
+		return bs
+	}
+
+	// A failure to get the last block restores the original contents
+	conn.RequestCalls(func(_ context.Context, req *protocol.Request) ([]byte, error) {
+		if req.Offset == 3*protocol.MinBlockSize {
+			return nil, errors.New("unavailable")
+		}
+		return newData[req.Offset : req.Offset+int64(req.Size)], nil
+	})
+	scanChan := make(chan string, 1)
+	_, err = f.pullerIteration(scanChan)
+	must(t, err)
+	if len(f.tempPullErrors) != 1 {
+		t.Fatal("expected a pull error, got", f.tempPullErrors)
+	}
+	if !bytes.Equal(readFile(), oldData) {
+		t.Fatal("original file contents not restored")
+	}
+	if info, err := f.mtimefs.Lstat(name); err != nil || !info.ModTime().Equal(oldInfo.ModTime()) {
+		t.Fatal("original modification time not restored", err)
+	}
+
+	requestedMut := sync.NewMutex()
+	var requested []int64
+	journaled := true
+	conn.RequestCalls(func(_ context.Context, req *protocol.Request) ([]byte, error) {
+		_, err := f.mtimefs.Lstat(inPlaceJournalName(name))
+		requestedMut.Lock()
+		requested = append(requested, req.Offset)
+		journaled = journaled && err == nil
+		requestedMut.Unlock()
+		return newData[req.Offset : req.Offset+int64(req.Size)], nil
+	})
+	changed, err := f.pullerIteration(scanChan)
+	must(t, err)
+	if changed != 1 {
+		t.Error("Expected one change in pull, got", changed)
+	}
+	if len(f.tempPullErrors) != 0 {
+		t.Fatal("unexpected pull errors", f.tempPullErrors)
+	}
+
+	slices.Sort(requested)
+	if !slices.Equal(requested, []int64{protocol.MinBlockSize, 3 * protocol.MinBlockSize}) {
+		t.Errorf("expected only the changed blocks to be requested, got offsets %v", requested)
+	}
+	if !journaled {
+		t.Error("expected the changes to be journaled while pulling")
+	}
+	if !bytes.Equal(readFile(), newData) {
+		t.Error("file contents differ after changing in place")
+	}
+	for _, tmp := range []string{fs.TempName(name), inPlaceJournalName(name)} {
+		if _, err := f.mtimefs.Lstat(tmp); !fs.IsNotExist(err) {
+			t.Errorf("%s should not exist: %v", tmp, err)
+		}
+	}
+	if names := f.journals.list(); len(names) != 0 {
+		t.Error("unexpected in-place journals", names)
+	}
+	if files := f.finishJournal.files(); len(files) != 0 {
+		t.Error("unexpected finish journal entries", files)
+	}
+	if cur, ok := m.testCurrentFolderFile(f.ID, name); !ok || !cur.Version.Equal(file.Version) {
+		t.Errorf("expected local version %v, got %v", file.Version, cur.Version)
+	}
+}
@@ -10,6 +10,7 @@
 package model
 
 import (
+	"fmt"
 	"os/user"
 	"strconv"
 
@@ -22,24 +23,65 @@ func (f *sendReceiveFolder) syncOwnership(file *protocol.FileInfo, path string)
 		return nil
 	}
 
-	// Try to look up the user and group by name, defaulting to the
-	// numerical UID and GID if there is no match.
+	// Use the mapped user and group if there is a mapping, otherwise try
+	// to look up the user and group by name, defaulting to the numerical
+	// UID and GID if there is no match.
 
-	uid := strconv.Itoa(file.Platform.Unix.UID)
-	if file.Platform.Unix.OwnerName != "" {
-		us, err := user.Lookup(file.Platform.Unix.OwnerName)
-		if err == nil && us.Uid != "" {
-			uid = us.Uid
+	var uid string
+	if to, ok := f.OwnershipMapping.MapUser(file.Platform.Unix.OwnerName, file.Platform.Unix.UID); ok {
+		var err error
+		if uid, err = lookupUID(to); err != nil {
+			return fmt.Errorf("mapped owner: %w", err)
+		}
+	} else {
+		uid = strconv.Itoa(file.Platform.Unix.UID)
+		if file.Platform.Unix.OwnerName != "" {
+			us, err := user.Lookup(file.Platform.Unix.OwnerName)
+			if err == nil && us.Uid != "" {
+				uid = us.Uid
+			}
 		}
 	}
 
-	gid := strconv.Itoa(file.Platform.Unix.GID)
-	if file.Platform.Unix.GroupName != "" {
-		gr, err := user.LookupGroup(file.Platform.Unix.GroupName)
-		if err == nil && gr.Gid != "" {
-			gid = gr.Gid
+	var gid string
+	if to, ok := f.OwnershipMapping.MapGroup(file.Platform.Unix.GroupName, file.Platform.Unix.GID); ok {
+		var err error
+		if gid, err = lookupGID(to); err != nil {
+			return fmt.Errorf("mapped group: %w", err)
+		}
+	} else {
+		gid = strconv.Itoa(file.Platform.Unix.GID)
+		if file.Platform.Unix.GroupName != "" {
+			gr, err := user.LookupGroup(file.Platform.Unix.GroupName)
+			if err == nil && gr.Gid != "" {
+				gid = gr.Gid
+			}
 		}
 	}
 
 	return f.mtimefs.Lchown(path, uid, gid)
 }
+
+// lookupUID returns the numeric user ID for the given user name or ID.
+func lookupUID(name string) (string, error) {
+	if _, err := strconv.Atoi(name); err == nil {
+		return name, nil
+	}
+	us, err := user.Lookup(name)
+	if err != nil {
+		return "", err
+	}
+	return us.Uid, nil
+}
+
+// lookupGID returns the numeric group ID for the given group name or ID.
+func lookupGID(name string) (string, error) {
+	if _, err := strconv.Atoi(name); err == nil {
+		return name, nil
+	}
+	gr, err := user.LookupGroup(name)
+	if err != nil {
+		return "", err
+	}
+	return gr.Gid, nil
+}
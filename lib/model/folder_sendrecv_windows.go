@@ -20,8 +20,17 @@ func (f *sendReceiveFolder) syncOwnership(file *protocol.FileInfo, path string)
 		return nil
 	}
 
-	l.Debugln("Owner name for %s is %s (group=%v)", path, file.Platform.Windows.OwnerName, file.Platform.Windows.OwnerIsGroup)
-	usid, gsid, err := lookupUserAndGroup(file.Platform.Windows.OwnerName, file.Platform.Windows.OwnerIsGroup)
+	ownerName := file.Platform.Windows.OwnerName
+	mapOwner := f.OwnershipMapping.MapUser
+	if file.Platform.Windows.OwnerIsGroup {
+		mapOwner = f.OwnershipMapping.MapGroup
+	}
+	if to, ok := mapOwner(ownerName, -1); ok {
+		ownerName = to
+	}
+
+	l.Debugln("Owner name for %s is %s (group=%v)", path, ownerName, file.Platform.Windows.OwnerIsGroup)
+	usid, gsid, err := lookupUserAndGroup(ownerName, file.Platform.Windows.OwnerIsGroup)
 	if err != nil {
 		return err
 	}
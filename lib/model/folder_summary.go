@@ -110,6 +110,7 @@ type FolderSummary struct {
 	State        string    `json:"state"`
 	StateChanged time.Time `json:"stateChanged"`
 	Error        string    `json:"error"`
+	PausedReason string    `json:"pausedReason"`
 
 	Version        int64                       `json:"version"` // deprecated
 	Sequence       int64                       `json:"sequence"`
@@ -185,6 +186,9 @@ func (c *folderSummaryService) Summary(folder string) (*FolderSummary, error) {
 	if err != nil {
 		res.Error = err.Error()
 	}
+	if haveFcfg {
+		res.PausedReason = fcfg.PausedReason.String()
+	}
 
 	res.Version = ourSeq // legacy
 	res.Sequence = ourSeq
@@ -411,6 +415,7 @@ func (c *folderSummaryService) sendSummary(ctx context.Context, folder string) {
 			l.Debugf("Error getting completion for folder %v, device %v: %v", folder, devCfg.DeviceID, err)
 			continue
 		}
+		metricFolderCompletion.WithLabelValues(folder, devCfg.DeviceID.String()).Set(comp.CompletionPct / 100)
 		ev := comp.Map()
 		ev["folder"] = folder
 		ev["device"] = devCfg.DeviceID.String()
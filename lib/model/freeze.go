@@ -0,0 +1,268 @@
+// Copyright (C) 2026 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package model
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/syncthing/syncthing/lib/db"
+	"github.com/syncthing/syncthing/lib/events"
+	"github.com/syncthing/syncthing/lib/sync"
+)
+
+const (
+	folderFreezesKey = "folderFreezes"
+	// MaxFolderFreeze is the longest a folder can be frozen for at once.
+	MaxFolderFreeze = 7 * 24 * time.Hour
+)
+
+var (
+	errFreezeNoReason  = errors.New("a reason for the freeze is required")
+	errFreezeWindow    = fmt.Errorf("the freeze must end in the future and within %v", MaxFolderFreeze)
+	ErrFolderNotFrozen = errors.New("folder is not frozen")
+)
+
+// FolderFreeze keeps a folder read-only for a bounded time, for audits and
+// backups that need a stable view of it: nothing is pulled from other
+// devices and local changes are not announced to them until it ends.
+// Changes are still detected and indexes received, and catch up as soon as
+// the freeze ends.
+type FolderFreeze struct {
+	Folder string    `json:"folder"`
+	Reason string    `json:"reason"`
+	Since  time.Time `json:"since"`
+	Until  time.Time `json:"until"`
+}
+
+// folderFreezes keeps track of the frozen folders, saved to the database so
+// that a freeze survives restarts, and thaws them when their time is up.
+type folderFreezes struct {
+	kv     *db.NamespacedKV
+	thawed func(FolderFreeze) // called when a freeze ends by itself
+
+	mut     sync.Mutex
+	freezes map[string]FolderFreeze
+	changed chan struct{}
+}
+
+func newFolderFreezes(kv *db.NamespacedKV, thawed func(FolderFreeze)) *folderFreezes {
+	f := &folderFreezes{
+		kv:      kv,
+		thawed:  thawed,
+		mut:     sync.NewMutex(),
+		freezes: make(map[string]FolderFreeze),
+		changed: make(chan struct{}, 1),
+	}
+	bs, ok, err := kv.Bytes(folderFreezesKey)
+	if err != nil || !ok {
+		return f
+	}
+	var freezes []FolderFreeze
+	if err := json.Unmarshal(bs, &freezes); err != nil {
+		l.Debugln("Loading folder freezes:", err)
+		return f
+	}
+	for _, frz := range freezes {
+		f.freezes[frz.Folder] = frz
+	}
+	return f
+}
+
+// serve thaws the folders as their freezes end.
+func (f *folderFreezes) serve(ctx context.Context) error {
+	t := time.NewTimer(0)
+	defer t.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-f.changed:
+		case now := <-t.C:
+			for _, frz := range f.expire(now) {
+				f.thawed(frz)
+			}
+		}
+
+		if !t.Stop() {
+			select {
+			case <-t.C:
+			default:
+			}
+		}
+		if next, ok := f.nextExpiry(); ok {
+			t.Reset(time.Until(next))
+		}
+	}
+}
+
+func (f *folderFreezes) freeze(frz FolderFreeze) {
+	f.mut.Lock()
+	f.freezes[frz.Folder] = frz
+	f.saveLocked()
+	f.mut.Unlock()
+	f.notify()
+}
+
+// thaw ends the freeze of the folder, returning it, or false if the folder
+// wasn't frozen.
+func (f *folderFreezes) thaw(folder string) (FolderFreeze, bool) {
+	f.mut.Lock()
+	frz, ok := f.freezes[folder]
+	if ok {
+		delete(f.freezes, folder)
+		f.saveLocked()
+	}
+	f.mut.Unlock()
+	if ok {
+		f.notify()
+	}
+	return frz, ok
+}
+
+// frozen returns the freeze of the folder, if it's frozen.
+func (f *folderFreezes) frozen(folder string) (FolderFreeze, bool) {
+	if f == nil {
+		return FolderFreeze{}, false
+	}
+	f.mut.Lock()
+	defer f.mut.Unlock()
+	frz, ok := f.freezes[folder]
+	if !ok || !time.Now().Before(frz.Until) {
+		// Thawing is up to serve, but an expired freeze doesn't hold in the
+		// meantime.
+		return FolderFreeze{}, false
+	}
+	return frz, true
+}
+
+// list returns the freezes sorted by folder.
+func (f *folderFreezes) list() []FolderFreeze {
+	f.mut.Lock()
+	defer f.mut.Unlock()
+	res := make([]FolderFreeze, 0, len(f.freezes))
+	for _, frz := range f.freezes {
+		res = append(res, frz)
+	}
+	sort.Slice(res, func(a, b int) bool {
+		return res[a].Folder < res[b].Folder
+	})
+	return res
+}
+
+func (f *folderFreezes) expire(now time.Time) []FolderFreeze {
+	f.mut.Lock()
+	defer f.mut.Unlock()
+	var expired []FolderFreeze
+	for folder, frz := range f.freezes {
+		if !now.Before(frz.Until) {
+			expired = append(expired, frz)
+			delete(f.freezes, folder)
+		}
+	}
+	if len(expired) > 0 {
+		f.saveLocked()
+	}
+	return expired
+}
+
+func (f *folderFreezes) nextExpiry() (time.Time, bool) {
+	f.mut.Lock()
+	defer f.mut.Unlock()
+	var next time.Time
+	for _, frz := range f.freezes {
+		if next.IsZero() || frz.Until.Before(next) {
+			next = frz.Until
+		}
+	}
+	return next, !next.IsZero()
+}
+
+func (f *folderFreezes) notify() {
+	select {
+	case f.changed <- struct{}{}:
+	default:
+	}
+}
+
+func (f *folderFreezes) saveLocked() {
+	freezes := make([]FolderFreeze, 0, len(f.freezes))
+	for _, frz := range f.freezes {
+		freezes = append(freezes, frz)
+	}
+	bs, err := json.Marshal(freezes)
+	if err == nil {
+		err = f.kv.PutBytes(folderFreezesKey, bs)
+	}
+	if err != nil {
+		l.Warnln("Saving folder freezes:", err)
+	}
+}
+
+// FreezeFolder freezes the folder until the given time, at most
+// MaxFolderFreeze from now, for the given reason. Freezing a frozen folder
+// replaces its freeze.
+func (m *model) FreezeFolder(folder, reason string, until time.Time) (FolderFreeze, error) {
+	cfg, ok := m.cfg.Folder(folder)
+	if !ok {
+		return FolderFreeze{}, fmt.Errorf("%s: %w", folder, ErrFolderMissing)
+	}
+	reason = strings.TrimSpace(reason)
+	if reason == "" {
+		return FolderFreeze{}, errFreezeNoReason
+	}
+	now := time.Now().Truncate(time.Second)
+	if !until.After(now) || until.Sub(now) > MaxFolderFreeze {
+		return FolderFreeze{}, errFreezeWindow
+	}
+
+	frz := FolderFreeze{
+		Folder: folder,
+		Reason: reason,
+		Since:  now,
+		Until:  until,
+	}
+	m.freezes.freeze(frz)
+
+	l.Infof("Froze folder %v until %v: %s", cfg.Description(), until.Format(time.RFC3339), reason)
+	m.evLogger.Log(events.FolderFrozen, frz)
+	return frz, nil
+}
+
+// UnfreezeFolder ends the freeze of the folder ahead of time.
+func (m *model) UnfreezeFolder(folder string) error {
+	frz, ok := m.freezes.thaw(folder)
+	if !ok {
+		return fmt.Errorf("%s: %w", folder, ErrFolderNotFrozen)
+	}
+	m.folderThawed(frz)
+	return nil
+}
+
+// FolderFreezes returns the frozen folders.
+func (m *model) FolderFreezes() []FolderFreeze {
+	return m.freezes.list()
+}
+
+// folderThawed catches up on what was held back while the folder was
+// frozen. The index handlers notice by themselves, from the event.
+func (m *model) folderThawed(frz FolderFreeze) {
+	l.Infof("Folder %q is no longer frozen", frz.Folder)
+	m.evLogger.Log(events.FolderUnfrozen, frz)
+
+	m.mut.RLock()
+	runner, ok := m.folderRunners.Get(frz.Folder)
+	m.mut.RUnlock()
+	if ok {
+		runner.SchedulePull()
+	}
+}
@@ -0,0 +1,133 @@
+// Copyright (C) 2026 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package model
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/syncthing/syncthing/lib/db"
+	"github.com/syncthing/syncthing/lib/db/backend"
+	"github.com/syncthing/syncthing/lib/events"
+	"github.com/syncthing/syncthing/lib/protocol"
+)
+
+func TestFolderFreeze(t *testing.T) {
+	m, fc, fcfg, wcfgCancel := setupModelWithConnection(t)
+	defer wcfgCancel()
+	tfs := fcfg.Filesystem(nil)
+	defer cleanupModelAndRemoveDir(m, tfs.URI())
+
+	until := time.Now().Add(time.Hour).Truncate(time.Second)
+	for _, tc := range []struct {
+		folder, reason string
+		until          time.Time
+	}{
+		{"missing", "audit", until},
+		{fcfg.ID, " ", until},
+		{fcfg.ID, "audit", time.Now().Add(-time.Second)},
+		{fcfg.ID, "audit", time.Now().Add(MaxFolderFreeze + time.Hour)},
+	} {
+		if _, err := m.FreezeFolder(tc.folder, tc.reason, tc.until); err == nil {
+			t.Errorf("freezing %q for %q until %v should fail", tc.folder, tc.reason, tc.until)
+		}
+	}
+	if err := m.UnfreezeFolder(fcfg.ID); !errors.Is(err, ErrFolderNotFrozen) {
+		t.Errorf("expected %v, got %v", ErrFolderNotFrozen, err)
+	}
+
+	frz, err := m.FreezeFolder(fcfg.ID, "audit", until)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if freezes := m.FolderFreezes(); len(freezes) != 1 || freezes[0] != frz || frz.Reason != "audit" || !frz.Until.Equal(until) {
+		t.Fatalf("unexpected freezes %v", freezes)
+	}
+
+	// The freeze is kept in the database.
+	if _, ok := newFolderFreezes(db.NewMiscDataNamespace(m.db), nil).frozen(fcfg.ID); !ok {
+		t.Error("freeze not persisted")
+	}
+
+	done := make(chan struct{})
+	fc.setIndexFn(func(_ context.Context, folder string, fs []protocol.FileInfo) error {
+		for _, f := range fs {
+			if f.Name == "testfile" {
+				close(done)
+			}
+		}
+		return nil
+	})
+
+	// Nothing is pulled, nor announced, while frozen.
+	contents := []byte("test file contents\n")
+	fc.addFile("testfile", 0o644, protocol.FileInfoTypeFile, contents)
+	fc.sendIndexUpdate()
+	m.ScanFolders()
+	select {
+	case <-done:
+		t.Fatal("index update sent while frozen")
+	case <-time.After(time.Second):
+	}
+	if _, err := tfs.Lstat("testfile"); err == nil {
+		t.Fatal("file pulled while frozen")
+	}
+
+	// It all catches up once thawed.
+	if err := m.UnfreezeFolder(fcfg.ID); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case <-done:
+	case <-time.After(10 * time.Second):
+		t.Fatal("timed out")
+	}
+	if err := equalContents(tfs, "testfile", contents); err != nil {
+		t.Error("File did not sync correctly:", err)
+	}
+	if freezes := m.FolderFreezes(); len(freezes) != 0 {
+		t.Errorf("expected no freezes, got %v", freezes)
+	}
+}
+
+func TestFolderFreezeExpiry(t *testing.T) {
+	ldb, err := db.NewLowlevel(backend.OpenMemory(), events.NoopLogger)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ldb.Close()
+	thawed := make(chan FolderFreeze, 1)
+	f := newFolderFreezes(db.NewMiscDataNamespace(ldb), func(frz FolderFreeze) {
+		thawed <- frz
+	})
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go f.serve(ctx)
+
+	f.freeze(FolderFreeze{Folder: "long", Reason: "backup", Since: time.Now(), Until: time.Now().Add(time.Hour)})
+	f.freeze(FolderFreeze{Folder: "short", Reason: "audit", Since: time.Now(), Until: time.Now().Add(100 * time.Millisecond)})
+	if _, ok := f.frozen("short"); !ok {
+		t.Fatal("folder should be frozen")
+	}
+
+	select {
+	case frz := <-thawed:
+		if frz.Folder != "short" {
+			t.Errorf("expected the short freeze to end, got %v", frz)
+		}
+	case <-time.After(10 * time.Second):
+		t.Fatal("timed out")
+	}
+	if _, ok := f.frozen("short"); ok {
+		t.Error("folder should no longer be frozen")
+	}
+	if freezes := f.list(); len(freezes) != 1 || freezes[0].Folder != "long" {
+		t.Errorf("expected only the long freeze to remain, got %v", freezes)
+	}
+}
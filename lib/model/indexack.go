@@ -0,0 +1,82 @@
+// Copyright (C) 2026 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package model
+
+import (
+	"encoding/json"
+
+	"github.com/syncthing/syncthing/lib/db"
+	"github.com/syncthing/syncthing/lib/protocol"
+)
+
+// indexProgress is how far a device has acknowledged our index for a
+// folder.
+type indexProgress struct {
+	// The index ID and subtree the index was sent for. The progress is
+	// only valid as long as both are unchanged.
+	IndexID protocol.IndexID `json:"indexID"`
+	Subtree string           `json:"subtree"`
+	// The last sequence sent and acknowledged, which is what the device
+	// announces as its max sequence for us afterwards.
+	Sequence int64 `json:"sequence"`
+	// How far our index had been iterated at that point. Files that
+	// weren't sent, for not being in the subtree or being unencrypted
+	// local changes, leave it ahead of the sequence.
+	Position int64 `json:"position"`
+}
+
+// indexAcks keeps the index progress acknowledged by each device for each
+// folder in the database, so that index sending resumes where it left off
+// after reconnecting.
+type indexAcks struct {
+	kv *db.NamespacedKV
+}
+
+func newIndexAcks(kv *db.NamespacedKV) *indexAcks {
+	return &indexAcks{kv: kv}
+}
+
+func (a *indexAcks) get(device protocol.DeviceID, folder string) (indexProgress, bool) {
+	var p indexProgress
+	bs, ok, err := a.kv.Bytes(indexAckKey(device, folder))
+	if err != nil || !ok {
+		return p, false
+	}
+	if err := json.Unmarshal(bs, &p); err != nil {
+		l.Debugln("Loading index progress:", err)
+		return p, false
+	}
+	return p, true
+}
+
+func (a *indexAcks) put(device protocol.DeviceID, folder string, p indexProgress) {
+	bs, err := json.Marshal(p)
+	if err != nil {
+		return
+	}
+	if err := a.kv.PutBytes(indexAckKey(device, folder), bs); err != nil {
+		l.Debugln("Saving index progress:", err)
+	}
+}
+
+func indexAckKey(device protocol.DeviceID, folder string) string {
+	return "indexAck/" + device.String() + "/" + folder
+}
+
+// IndexAck is called when the device has processed index messages we sent.
+// Implements the protocol.Model interface.
+func (m *model) IndexAck(conn protocol.Connection, ack *protocol.IndexAck) error {
+	m.mut.RLock()
+	indexHandlerRegistry, ok := m.getIndexHandlerRLocked(conn)
+	m.mut.RUnlock()
+	if !ok {
+		l.Debugf("Index acknowledgement for %q from %v without index handler", ack.Folder, conn.DeviceID().Short())
+		return nil
+	}
+	indexHandlerRegistry.IndexAck(ack.Folder, ack.Sequence)
+	return nil
+}
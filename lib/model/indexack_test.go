@@ -0,0 +1,98 @@
+// Copyright (C) 2026 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package model
+
+import (
+	"context"
+	"testing"
+
+	"github.com/syncthing/syncthing/lib/protocol"
+	protocolmocks "github.com/syncthing/syncthing/lib/protocol/mocks"
+)
+
+func TestIndexAckResume(t *testing.T) {
+	w, fcfg, wCancel := newDefaultCfgWrapper()
+	defer wCancel()
+	ffs := fcfg.Filesystem(nil)
+	must(t, ffs.MkdirAll("Camera", 0o755))
+	writeFile(t, ffs, "Camera/a.jpg", []byte("a"))
+	m := setupModel(t, w)
+	defer cleanupModelAndRemoveDir(m, ffs.URI())
+
+	// Files outside the subtree come last in our index, whatever order
+	// the hashers finish in.
+	must(t, ffs.MkdirAll("Other", 0o755))
+	writeFile(t, ffs, "Other/b.txt", []byte("b"))
+	must(t, m.ScanFolder(fcfg.ID))
+
+	fset := m.folderFiles[fcfg.ID]
+	conn := &protocolmocks.Connection{}
+	conn.DeviceIDReturns(device1)
+	startInfo := &clusterConfigDeviceInfo{
+		local:  protocol.Device{ID: myID},
+		remote: protocol.Device{ID: device1, IndexID: 1, IndexSubtree: "Camera"},
+	}
+	newHandler := func(acks *indexAcks) *indexHandler {
+		return newIndexHandler(conn, nil, fcfg, fset, nil, startInfo, protocol.CompressionNever, m.freezes, acks, m.evLogger)
+	}
+
+	// Only the camera directory is sent, so our index is iterated beyond
+	// the last sent sequence.
+	h := newHandler(m.indexAcks)
+	if err := h.sendIndexTo(context.Background(), fset); err != nil {
+		t.Fatal(err)
+	}
+	if conn.IndexCallCount() != 1 {
+		t.Fatalf("sent %d indexes, expected 1", conn.IndexCallCount())
+	}
+	_, idx := conn.IndexArgsForCall(0)
+	mySequence := fset.Sequence(protocol.LocalDeviceID)
+	if idx.LastSequence >= mySequence {
+		t.Fatalf("sent up to sequence %d, expected less than %d", idx.LastSequence, mySequence)
+	}
+
+	h.acknowledged(idx.LastSequence)
+	p, ok := m.indexAcks.get(device1, fcfg.ID)
+	if !ok || p.Sequence != idx.LastSequence || p.Position != mySequence {
+		t.Fatalf("unexpected progress %+v", p)
+	}
+
+	// Reconnecting resumes from the acknowledged position.
+	startInfo.local = protocol.Device{ID: myID, IndexID: fset.IndexID(protocol.LocalDeviceID), MaxSequence: idx.LastSequence}
+	h = newHandler(m.indexAcks)
+	if h.localPrevSequence != mySequence || h.sentPrevSequence != idx.LastSequence {
+		t.Errorf("resumed at %d/%d, expected %d/%d", h.localPrevSequence, h.sentPrevSequence, mySequence, idx.LastSequence)
+	}
+
+	// Not without acknowledgements, nor when asking for another subtree.
+	h = newHandler(nil)
+	if h.localPrevSequence != idx.LastSequence {
+		t.Errorf("resumed at %d without acknowledgements, expected %d", h.localPrevSequence, idx.LastSequence)
+	}
+	startInfo.remote.IndexSubtree = ""
+	h = newHandler(m.indexAcks)
+	if h.localPrevSequence != idx.LastSequence {
+		t.Errorf("resumed at %d for another subtree, expected %d", h.localPrevSequence, idx.LastSequence)
+	}
+}
+
+func TestIndexAckSent(t *testing.T) {
+	m, fc, fcfg, wCancel := setupModelWithConnection(t)
+	defer wCancel()
+	defer cleanupModelAndRemoveDir(m, fcfg.Filesystem(nil).URI())
+
+	files := []protocol.FileInfo{{Name: "a", Sequence: 1, Version: protocol.Vector{}.Update(device1.Short())}}
+	if err := m.Index(fc, &protocol.Index{Folder: fcfg.ID, Files: files, LastSequence: 1}); err != nil {
+		t.Fatal(err)
+	}
+	if fc.IndexAckCallCount() != 1 {
+		t.Fatalf("sent %d acknowledgements, expected 1", fc.IndexAckCallCount())
+	}
+	if _, ack := fc.IndexAckArgsForCall(0); ack.Folder != fcfg.ID || ack.Sequence != 1 {
+		t.Errorf("unexpected acknowledgement %+v", ack)
+	}
+}
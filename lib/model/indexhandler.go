@@ -9,6 +9,7 @@ package model
 import (
 	"context"
 	"fmt"
+	"strings"
 	"sync"
 	"time"
 
@@ -25,6 +26,9 @@ type indexHandler struct {
 	downloads                *deviceDownloadState
 	folder                   string
 	folderIsReceiveEncrypted bool
+	compressed               bool   // whether index messages are compressed on the wire
+	subtree                  string // only entries at or under this path are sent, when set
+	freezes                  *folderFreezes
 	evLogger                 events.Logger
 
 	// We track the latest / highest sequence number in two ways for two
@@ -43,16 +47,25 @@ type indexHandler struct {
 	localPrevSequence int64 // the highest sequence number we've seen in our FileInfos
 	sentPrevSequence  int64 // the highest sequence number we've sent to the peer
 
+	// When the peer acknowledges index messages, the progress it
+	// acknowledged is kept in acks. Unacked maps the last sequence of each
+	// message sent but not yet acknowledged to the localPrevSequence it
+	// corresponds to.
+	acks    *indexAcks
+	indexID protocol.IndexID
+	unacked map[int64]int64
+	ackMut  sync.Mutex
+
 	cond   *sync.Cond
 	paused bool
 	fset   *db.FileSet
 	runner service
 }
 
-func newIndexHandler(conn protocol.Connection, downloads *deviceDownloadState, folder config.FolderConfiguration, fset *db.FileSet, runner service, startInfo *clusterConfigDeviceInfo, evLogger events.Logger) *indexHandler {
+func newIndexHandler(conn protocol.Connection, downloads *deviceDownloadState, folder config.FolderConfiguration, fset *db.FileSet, runner service, startInfo *clusterConfigDeviceInfo, compression protocol.Compression, freezes *folderFreezes, acks *indexAcks, evLogger events.Logger) *indexHandler {
 	myIndexID := fset.IndexID(protocol.LocalDeviceID)
 	mySequence := fset.Sequence(protocol.LocalDeviceID)
-	var startSequence int64
+	var startSequence, startPosition int64
 
 	// This is the other side's description of what it knows
 	// about us. Lets check to see if we can start sending index
@@ -74,6 +87,17 @@ func newIndexHandler(conn protocol.Connection, downloads *deviceDownloadState, f
 		} else {
 			l.Debugf("Device %v folder %s is delta index compatible (mlv=%d)", conn.DeviceID().Short(), folder.Description(), startInfo.local.MaxSequence)
 			startSequence = startInfo.local.MaxSequence
+			startPosition = startSequence
+
+			// If they acknowledged exactly what they say they have, we
+			// also know how far beyond that we had looked at our index
+			// without finding anything to send them.
+			if acks != nil {
+				if p, ok := acks.get(conn.DeviceID(), folder.ID); ok && p.IndexID == myIndexID && p.Subtree == startInfo.remote.IndexSubtree && p.Sequence == startSequence && p.Position <= mySequence {
+					l.Debugf("Device %v folder %s acknowledged our index up to %d", conn.DeviceID().Short(), folder.Description(), p.Position)
+					startPosition = p.Position
+				}
+			}
 		}
 	} else if startInfo.local.IndexID != 0 {
 		// They say they've seen an index ID from us, but it's
@@ -110,13 +134,23 @@ func newIndexHandler(conn protocol.Connection, downloads *deviceDownloadState, f
 		fset.SetIndexID(conn.DeviceID(), startInfo.remote.IndexID)
 	}
 
+	if startInfo.remote.IndexSubtree != "" {
+		l.Debugf("Device %v folder %s wants only the index for %q", conn.DeviceID().Short(), folder.Description(), startInfo.remote.IndexSubtree)
+	}
+
 	return &indexHandler{
 		conn:                     conn,
 		downloads:                downloads,
 		folder:                   folder.ID,
 		folderIsReceiveEncrypted: folder.Type == config.FolderTypeReceiveEncrypted,
-		localPrevSequence:        startSequence,
+		compressed:               compression != protocol.CompressionNever,
+		subtree:                  startInfo.remote.IndexSubtree,
+		localPrevSequence:        startPosition,
 		sentPrevSequence:         startSequence,
+		acks:                     acks,
+		indexID:                  myIndexID,
+		unacked:                  make(map[int64]int64),
+		freezes:                  freezes,
 		evLogger:                 evLogger,
 
 		fset:   fset,
@@ -161,23 +195,36 @@ func (s *indexHandler) Serve(ctx context.Context) (err error) {
 		}
 	}()
 
-	// We need to send one index, regardless of whether there is something to send or not
-	fset, err := s.waitForFileset(ctx)
-	if err != nil {
-		return err
-	}
-	err = s.sendIndexTo(ctx, fset)
-
-	// Subscribe to LocalIndexUpdated (we have new information to send) and
+	// Subscribe to LocalIndexUpdated (we have new information to send),
+	// FolderUnfrozen (we may send what was held back) and
 	// DeviceDisconnected (it might be us who disconnected, so we should
 	// exit).
-	sub := s.evLogger.Subscribe(events.LocalIndexUpdated | events.DeviceDisconnected)
+	sub := s.evLogger.Subscribe(events.LocalIndexUpdated | events.FolderUnfrozen | events.DeviceDisconnected)
 	defer sub.Unsubscribe()
 
 	evChan := sub.C()
 	ticker := time.NewTicker(time.Minute)
 	defer ticker.Stop()
 
+	// We need to send one index, regardless of whether there is something
+	// to send or not, once the folder isn't frozen.
+	for {
+		if _, frozen := s.freezes.frozen(s.folder); !frozen {
+			break
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-evChan:
+		case <-ticker.C:
+		}
+	}
+	fset, err := s.waitForFileset(ctx)
+	if err != nil {
+		return err
+	}
+	err = s.sendIndexTo(ctx, fset)
+
 	for err == nil {
 		fset, err = s.waitForFileset(ctx)
 		if err != nil {
@@ -185,10 +232,11 @@ func (s *indexHandler) Serve(ctx context.Context) (err error) {
 		}
 
 		// While we have sent a sequence at least equal to the one
-		// currently in the database, wait for the local index to update. The
-		// local index may update for other folders than the one we are
-		// sending for.
-		if fset.Sequence(protocol.LocalDeviceID) <= s.localPrevSequence {
+		// currently in the database, or the folder is frozen, wait for
+		// the local index to update or the freeze to end. The local index
+		// may update for other folders than the one we are sending for.
+		_, frozen := s.freezes.frozen(s.folder)
+		if frozen || fset.Sequence(protocol.LocalDeviceID) <= s.localPrevSequence {
 			select {
 			case <-ctx.Done():
 				return ctx.Err()
@@ -241,7 +289,12 @@ func (s *indexHandler) pause() {
 func (s *indexHandler) sendIndexTo(ctx context.Context, fset *db.FileSet) error {
 	initial := s.localPrevSequence == 0
 	batch := db.NewFileInfoBatch(nil)
+	if s.compressed {
+		// Group more files per message, as they compress well together.
+		batch.SetLimits(db.MaxCompressedBatchSizeFiles, db.MaxCompressedBatchSizeBytes)
+	}
 	var batchError error
+	var iterated int64 // set for the last flush, once all is iterated
 	batch.SetFlushFunc(func(fs []protocol.FileInfo) error {
 		select {
 		case <-ctx.Done():
@@ -281,6 +334,11 @@ func (s *indexHandler) sendIndexTo(ctx context.Context, fset *db.FileSet) error
 			return err
 		}
 		s.sentPrevSequence = lastSequence
+		if iterated > 0 {
+			s.expectAck(lastSequence, iterated)
+		} else {
+			s.expectAck(lastSequence, lastSequence)
+		}
 		return nil
 	})
 
@@ -338,6 +396,12 @@ func (s *indexHandler) sendIndexTo(ctx context.Context, fset *db.FileSet) error
 			return true
 		}
 
+		// The other side has asked for only part of the folder. Skipping
+		// leaves a hole in the sequence, same as above.
+		if !inIndexSubtree(f.Name, s.subtree) {
+			return true
+		}
+
 		f = prepareFileInfoForIndex(f)
 
 		previousWasDelete = f.IsDeleted()
@@ -349,6 +413,7 @@ func (s *indexHandler) sendIndexTo(ctx context.Context, fset *db.FileSet) error
 		return err
 	}
 
+	iterated = snap.Sequence(protocol.LocalDeviceID)
 	if err := batch.Flush(); err != nil {
 		return err
 	}
@@ -358,11 +423,48 @@ func (s *indexHandler) sendIndexTo(ctx context.Context, fset *db.FileSet) error
 	// however it's possible that a higher sequence exists, just doesn't need to
 	// be sent (e.g. in a receive-only folder, when a local change was
 	// reverted). No point trying to send nothing again.
-	s.localPrevSequence = snap.Sequence(protocol.LocalDeviceID)
+	s.localPrevSequence = iterated
 
 	return nil
 }
 
+// expectAck remembers the position to resume from once the message
+// ending with the sequence is acknowledged.
+func (s *indexHandler) expectAck(sequence, position int64) {
+	if s.acks == nil {
+		return
+	}
+	s.ackMut.Lock()
+	s.unacked[sequence] = position
+	s.ackMut.Unlock()
+}
+
+// acknowledged is called when the peer has processed the messages up to
+// the one ending with the sequence, and saves the progress.
+func (s *indexHandler) acknowledged(sequence int64) {
+	if s.acks == nil {
+		return
+	}
+	s.ackMut.Lock()
+	position, ok := s.unacked[sequence]
+	for seq := range s.unacked {
+		if seq <= sequence {
+			delete(s.unacked, seq)
+		}
+	}
+	s.ackMut.Unlock()
+	if !ok {
+		l.Debugf("%v: Ignoring acknowledgement of unknown sequence %d", s, sequence)
+		return
+	}
+	s.acks.put(s.conn.DeviceID(), s.folder, indexProgress{
+		IndexID:  s.indexID,
+		Subtree:  s.subtree,
+		Sequence: sequence,
+		Position: position,
+	})
+}
+
 func (s *indexHandler) receive(fs []protocol.FileInfo, update bool, op string, prevSequence, lastSequence int64) error {
 	deviceID := s.conn.DeviceID()
 
@@ -445,6 +547,7 @@ func (s *indexHandler) receive(fs []protocol.FileInfo, update bool, op string, p
 	}
 
 	fset.Update(deviceID, fs)
+	runner.CancelSupersededPulls(fs)
 	seq := fset.Sequence(deviceID)
 
 	// Check that the sequence we get back is what we put in...
@@ -466,6 +569,12 @@ func (s *indexHandler) receive(fs []protocol.FileInfo, update bool, op string, p
 		"version":  seq, // legacy for sequence
 	})
 
+	if s.acks != nil && lastSequence > 0 {
+		if err := s.conn.IndexAck(context.Background(), &protocol.IndexAck{Folder: s.folder, Sequence: lastSequence}); err != nil {
+			l.Debugf("%v: Acknowledging index: %v", s, err)
+		}
+	}
+
 	return nil
 }
 
@@ -481,6 +590,15 @@ func (s *indexHandler) logSequenceAnomaly(msg string, extra map[string]any) {
 	})
 }
 
+// inIndexSubtree returns whether the name is at or under the subtree, or is
+// one of the directories leading to it. An empty subtree is everything.
+func inIndexSubtree(name, subtree string) bool {
+	if subtree == "" || name == subtree {
+		return true
+	}
+	return strings.HasPrefix(name, subtree+"/") || strings.HasPrefix(subtree, name+"/")
+}
+
 func prepareFileInfoForIndex(f protocol.FileInfo) protocol.FileInfo {
 	// Mark the file as invalid if any of the local bad stuff flags are set.
 	f.RawInvalid = f.IsInvalid()
@@ -509,6 +627,9 @@ type indexHandlerRegistry struct {
 	evLogger      events.Logger
 	conn          protocol.Connection
 	downloads     *deviceDownloadState
+	compression   protocol.Compression
+	freezes       *folderFreezes
+	acks          *indexAcks
 	indexHandlers *serviceMap[string, *indexHandler]
 	startInfos    map[string]*clusterConfigDeviceInfo
 	folderStates  map[string]*indexHandlerFolderState
@@ -521,11 +642,13 @@ type indexHandlerFolderState struct {
 	runner service
 }
 
-func newIndexHandlerRegistry(conn protocol.Connection, downloads *deviceDownloadState, evLogger events.Logger) *indexHandlerRegistry {
+func newIndexHandlerRegistry(conn protocol.Connection, downloads *deviceDownloadState, compression protocol.Compression, freezes *folderFreezes, evLogger events.Logger) *indexHandlerRegistry {
 	r := &indexHandlerRegistry{
 		evLogger:      evLogger,
 		conn:          conn,
 		downloads:     downloads,
+		compression:   compression,
+		freezes:       freezes,
 		indexHandlers: newServiceMap[string, *indexHandler](evLogger),
 		startInfos:    make(map[string]*clusterConfigDeviceInfo),
 		folderStates:  make(map[string]*indexHandlerFolderState),
@@ -548,13 +671,23 @@ func (r *indexHandlerRegistry) startLocked(folder config.FolderConfiguration, fs
 	r.indexHandlers.RemoveAndWait(folder.ID, 0)
 	delete(r.startInfos, folder.ID)
 
-	is := newIndexHandler(r.conn, r.downloads, folder, fset, runner, startInfo, r.evLogger)
+	is := newIndexHandler(r.conn, r.downloads, folder, fset, runner, startInfo, r.compression, r.freezes, r.acks, r.evLogger)
 	r.indexHandlers.Add(folder.ID, is)
 
 	// This new connection might help us get in sync.
 	runner.SchedulePull()
 }
 
+// SetIndexAcks makes the index handlers started from now on acknowledge
+// the index messages they receive, and keep the progress acknowledged by
+// the device in acks. A nil acks turns this off, for devices that don't
+// support it.
+func (r *indexHandlerRegistry) SetIndexAcks(acks *indexAcks) {
+	r.mut.Lock()
+	r.acks = acks
+	r.mut.Unlock()
+}
+
 // AddIndexInfo starts an index handler for given folder, unless it is paused.
 // If it is paused, the given startInfo is stored to start the sender once the
 // folder is resumed.
@@ -678,6 +811,18 @@ func (r *indexHandlerRegistry) ReceiveIndex(folder string, fs []protocol.FileInf
 	return is.receive(fs, update, op, prevSequence, lastSequence)
 }
 
+// IndexAck passes the acknowledgement to the index handler for the folder.
+func (r *indexHandlerRegistry) IndexAck(folder string, sequence int64) {
+	r.mut.Lock()
+	is, ok := r.indexHandlers.Get(folder)
+	r.mut.Unlock()
+	if !ok {
+		l.Debugf("Index acknowledgement for nonexistent or paused folder %q", folder)
+		return
+	}
+	is.acknowledged(sequence)
+}
+
 // makeForgetUpdate takes an index update and constructs a download progress update
 // causing to forget any progress for files which we've just been sent.
 func makeForgetUpdate(files []protocol.FileInfo) []protocol.FileDownloadProgressUpdate {
@@ -0,0 +1,294 @@
+// Copyright (C) 2026 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package model
+
+import (
+	"bufio"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/syncthing/syncthing/lib/db"
+	"github.com/syncthing/syncthing/lib/fs"
+	"github.com/syncthing/syncthing/lib/protocol"
+	"github.com/syncthing/syncthing/lib/sync"
+)
+
+const (
+	inPlaceJournalMagic      = "STJRNL01"
+	inPlaceJournalHeaderSize = len(inPlaceJournalMagic) + 8 + 8 // magic, size, modification time
+	inPlaceRecordHeaderSize  = 8 + 4                            // offset, length
+	inPlaceJournalsKeyPrefix = "inPlaceJournals/"
+)
+
+var errInPlaceJournalCorrupt = errors.New("not an in-place journal")
+
+// inPlaceJournalName returns the name of the journal for changes made in
+// place to the named file. It's a temporary file name, so the scanner
+// leaves it alone.
+func inPlaceJournalName(name string) string {
+	return strings.TrimSuffix(fs.TempName(name), ".tmp") + ".journal"
+}
+
+// An inPlaceJournal makes changes to a file in place recoverable. Before
+// any of the original contents is overwritten or cut off, it is saved to
+// the journal, so that the file can be restored to what it was if syncing
+// it fails or is interrupted. The journal starts with a header of the
+// original size and modification time, followed by records of an offset,
+// a length and the original contents there.
+type inPlaceJournal struct {
+	fd     fs.File // the journal
+	target fs.File // the file changed in place
+	size   int64   // original size of the target
+	fsync  bool
+	mut    sync.Mutex // serialises writes to the journal
+}
+
+// createInPlaceJournal creates the journal for changes to the target,
+// which currently has the given modification time.
+func createInPlaceJournal(filesystem fs.Filesystem, journalName string, target fs.File, modTime time.Time, fsync bool) (*inPlaceJournal, error) {
+	info, err := target.Stat()
+	if err != nil {
+		return nil, err
+	}
+	fd, err := filesystem.OpenFile(journalName, fs.OptWriteOnly|fs.OptCreate|fs.OptTruncate, 0o600)
+	if err != nil {
+		return nil, err
+	}
+	j := &inPlaceJournal{
+		fd:     fd,
+		target: target,
+		size:   info.Size(),
+		fsync:  fsync,
+		mut:    sync.NewMutex(),
+	}
+
+	hdr := make([]byte, inPlaceJournalHeaderSize)
+	n := copy(hdr, inPlaceJournalMagic)
+	binary.BigEndian.PutUint64(hdr[n:], uint64(j.size))
+	binary.BigEndian.PutUint64(hdr[n+8:], uint64(modTime.UnixNano()))
+	if err := j.append(hdr); err != nil {
+		fd.Close()
+		return nil, err
+	}
+	return j, nil
+}
+
+// WriteAt saves the original contents of the range to the journal before
+// writing to the target.
+func (j *inPlaceJournal) WriteAt(p []byte, off int64) (int, error) {
+	if err := j.save(off, int64(len(p))); err != nil {
+		return 0, err
+	}
+	return j.target.WriteAt(p, off)
+}
+
+// Truncate saves the original contents that are cut off to the journal
+// before setting the size of the target.
+func (j *inPlaceJournal) Truncate(size int64) error {
+	for off := size; off < j.size; off += protocol.MaxBlockSize {
+		if err := j.save(off, protocol.MaxBlockSize); err != nil {
+			return err
+		}
+	}
+	return j.target.Truncate(size)
+}
+
+func (j *inPlaceJournal) Close() error {
+	return j.fd.Close()
+}
+
+func (j *inPlaceJournal) save(off, length int64) error {
+	if off >= j.size {
+		// Beyond the original contents, which the size in the header
+		// takes care of.
+		return nil
+	}
+	length = min(length, j.size-off)
+	rec := make([]byte, inPlaceRecordHeaderSize+length)
+	binary.BigEndian.PutUint64(rec, uint64(off))
+	binary.BigEndian.PutUint32(rec[8:], uint32(length))
+	if _, err := j.target.ReadAt(rec[inPlaceRecordHeaderSize:], off); err != nil {
+		return fmt.Errorf("reading original contents: %w", err)
+	}
+	return j.append(rec)
+}
+
+func (j *inPlaceJournal) append(bs []byte) error {
+	j.mut.Lock()
+	defer j.mut.Unlock()
+	if _, err := j.fd.Write(bs); err != nil {
+		return fmt.Errorf("writing journal: %w", err)
+	}
+	if j.fsync {
+		if err := j.fd.Sync(); err != nil {
+			return fmt.Errorf("writing journal: %w", err)
+		}
+	}
+	return nil
+}
+
+// recoverInPlace restores the named file to what it was before it was
+// changed in place, using the journal if there is one, and removes the
+// journal.
+func recoverInPlace(filesystem fs.Filesystem, name string) error {
+	journalName := inPlaceJournalName(name)
+	fd, err := filesystem.Open(journalName)
+	if fs.IsNotExist(err) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	if err := restoreInPlace(filesystem, name, fd); err != nil {
+		fd.Close()
+		return err
+	}
+	fd.Close()
+	return filesystem.Remove(journalName)
+}
+
+func restoreInPlace(filesystem fs.Filesystem, name string, journal fs.File) error {
+	r := bufio.NewReader(journal)
+	hdr := make([]byte, inPlaceJournalHeaderSize)
+	if _, err := io.ReadFull(r, hdr); err != nil {
+		// The header is written before anything is changed, so nothing
+		// has been.
+		return nil
+	}
+	if string(hdr[:len(inPlaceJournalMagic)]) != inPlaceJournalMagic {
+		return errInPlaceJournalCorrupt
+	}
+	size := int64(binary.BigEndian.Uint64(hdr[len(inPlaceJournalMagic):]))
+	modTime := time.Unix(0, int64(binary.BigEndian.Uint64(hdr[len(inPlaceJournalMagic)+8:])))
+
+	type record struct {
+		offset int64
+		length int
+		pos    int64 // of the contents in the journal
+	}
+	var records []record
+	pos := int64(inPlaceJournalHeaderSize)
+	rec := make([]byte, inPlaceRecordHeaderSize)
+	for {
+		if _, err := io.ReadFull(r, rec); err != nil {
+			break
+		}
+		length := int(binary.BigEndian.Uint32(rec[8:]))
+		if n, err := r.Discard(length); err != nil || n != length {
+			// A record is complete before the contents are changed, so
+			// those of an incomplete one haven't been.
+			break
+		}
+		records = append(records, record{int64(binary.BigEndian.Uint64(rec)), length, pos + inPlaceRecordHeaderSize})
+		pos += int64(inPlaceRecordHeaderSize + length)
+	}
+
+	target, err := filesystem.OpenFile(name, fs.OptReadWrite, 0o666)
+	if fs.IsNotExist(err) {
+		// Gone since, there's nothing to restore.
+		return nil
+	} else if err != nil {
+		return err
+	}
+	defer target.Close()
+
+	// The same range may have been saved more than once, the first record
+	// of it holding the original contents.
+	var buf []byte
+	for _, rec := range slices.Backward(records) {
+		buf = slices.Grow(buf[:0], rec.length)[:rec.length]
+		if _, err := journal.ReadAt(buf, rec.pos); err != nil {
+			return fmt.Errorf("reading journal: %w", err)
+		}
+		if _, err := target.WriteAt(buf, rec.offset); err != nil {
+			return err
+		}
+	}
+	if err := target.Truncate(size); err != nil {
+		return err
+	}
+	if err := target.Sync(); err != nil {
+		l.Debugf("fsync failed: %v", err)
+	}
+	return filesystem.Chtimes(name, modTime, modTime)
+}
+
+// inPlaceJournals keeps track, in the database, of the files of a folder
+// being changed in place, so they can be restored after a crash.
+type inPlaceJournals struct {
+	kv  *db.NamespacedKV
+	key string
+	mut sync.Mutex
+}
+
+func newInPlaceJournals(kv *db.NamespacedKV, folder string) *inPlaceJournals {
+	return &inPlaceJournals{
+		kv:  kv,
+		key: inPlaceJournalsKeyPrefix + folder,
+		mut: sync.NewMutex(),
+	}
+}
+
+func (j *inPlaceJournals) list() []string {
+	j.mut.Lock()
+	defer j.mut.Unlock()
+	return j.listLocked()
+}
+
+func (j *inPlaceJournals) has(name string) bool {
+	return slices.Contains(j.list(), name)
+}
+
+func (j *inPlaceJournals) add(name string) error {
+	j.mut.Lock()
+	defer j.mut.Unlock()
+	names := j.listLocked()
+	if slices.Contains(names, name) {
+		return nil
+	}
+	return j.saveLocked(append(names, name))
+}
+
+func (j *inPlaceJournals) remove(name string) error {
+	j.mut.Lock()
+	defer j.mut.Unlock()
+	names := j.listLocked()
+	if i := slices.Index(names, name); i >= 0 {
+		return j.saveLocked(slices.Delete(names, i, i+1))
+	}
+	return nil
+}
+
+func (j *inPlaceJournals) listLocked() []string {
+	bs, ok, err := j.kv.Bytes(j.key)
+	if err != nil || !ok {
+		return nil
+	}
+	var names []string
+	if err := json.Unmarshal(bs, &names); err != nil {
+		l.Debugln("Loading in-place journals:", err)
+		return nil
+	}
+	return names
+}
+
+func (j *inPlaceJournals) saveLocked(names []string) error {
+	if len(names) == 0 {
+		return j.kv.Delete(j.key)
+	}
+	bs, err := json.Marshal(names)
+	if err != nil {
+		return err
+	}
+	return j.kv.PutBytes(j.key, bs)
+}
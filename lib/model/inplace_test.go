@@ -0,0 +1,70 @@
+// Copyright (C) 2026 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package model
+
+import (
+	"bytes"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/syncthing/syncthing/lib/fs"
+	"github.com/syncthing/syncthing/lib/rand"
+)
+
+func TestInPlaceJournalRecover(t *testing.T) {
+	_, f, wcfgCancel := setupSendReceiveFolder(t)
+	defer wcfgCancel()
+
+	name := "image"
+	orig := make([]byte, 3000)
+	_, _ = rand.Read(orig)
+	writeFile(t, f.mtimefs, name, orig)
+	modTime := time.Unix(1234567890, 0)
+	must(t, f.mtimefs.Chtimes(name, modTime, modTime))
+
+	// Change the file in place and "crash" halfway
+	must(t, f.journals.add(name))
+	fd, err := f.mtimefs.OpenFile(name, fs.OptReadWrite, 0o666)
+	must(t, err)
+	j, err := createInPlaceJournal(f.mtimefs, inPlaceJournalName(name), fd, modTime, true)
+	must(t, err)
+	must(t, j.Truncate(2500))
+	for _, data := range [][]byte{bytes.Repeat([]byte{1}, 1000), bytes.Repeat([]byte{2}, 1000)} {
+		if _, err := j.WriteAt(data, 500); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err := j.WriteAt(bytes.Repeat([]byte{3}, 1000), 1500); err != nil {
+		t.Fatal(err)
+	}
+	// An incomplete record, written when we crashed
+	_, err = j.fd.Write([]byte{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 0, 1, 2, 3})
+	must(t, err)
+	must(t, j.Close())
+	must(t, fd.Close())
+
+	f.recoverInPlaceJournals()
+
+	fd, err = f.mtimefs.Open(name)
+	must(t, err)
+	bs, err := io.ReadAll(fd)
+	fd.Close()
+	must(t, err)
+	if !bytes.Equal(bs, orig) {
+		t.Error("original contents not restored")
+	}
+	if info, err := f.mtimefs.Lstat(name); err != nil || !info.ModTime().Equal(modTime) {
+		t.Error("original modification time not restored", err)
+	}
+	if _, err := f.mtimefs.Lstat(inPlaceJournalName(name)); !fs.IsNotExist(err) {
+		t.Error("journal should be removed:", err)
+	}
+	if names := f.journals.list(); len(names) != 0 {
+		t.Error("unexpected in-place journals", names)
+	}
+}
@@ -25,6 +25,13 @@ var (
 		Help:      "Current folder summary data (counts for global/local/need files/directories/symlinks/deleted/bytes)",
 	}, []string{"folder", "scope", "type"})
 
+	metricFolderCompletion = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "syncthing",
+		Subsystem: "model",
+		Name:      "folder_completion_ratio",
+		Help:      "Ratio of the global data a remote device has, per folder ID and device ID",
+	}, []string{"folder", "device"})
+
 	metricFolderPulls = promauto.NewCounterVec(prometheus.CounterOpts{
 		Namespace: "syncthing",
 		Subsystem: "model",
@@ -38,6 +45,19 @@ var (
 		Help:      "Total time spent in folder pull iterations, per folder ID",
 	}, []string{"folder"})
 
+	metricFolderSupersededPulls = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "syncthing",
+		Subsystem: "model",
+		Name:      "folder_superseded_pulls_total",
+		Help:      "Total number of file pulls cancelled because the file changed again remotely, per folder ID",
+	}, []string{"folder"})
+	metricFolderSupersededBytesAvoided = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "syncthing",
+		Subsystem: "model",
+		Name:      "folder_superseded_bytes_avoided_total",
+		Help:      "Total amount of data not transferred for obsolete file versions due to cancelled pulls, per folder ID",
+	}, []string{"folder"})
+
 	metricFolderScans = promauto.NewCounterVec(prometheus.CounterOpts{
 		Namespace: "syncthing",
 		Subsystem: "model",
@@ -83,6 +103,8 @@ func registerFolderMetrics(folderID string) {
 	metricFolderState.WithLabelValues(folderID)
 	metricFolderPulls.WithLabelValues(folderID)
 	metricFolderPullSeconds.WithLabelValues(folderID)
+	metricFolderSupersededPulls.WithLabelValues(folderID)
+	metricFolderSupersededBytesAvoided.WithLabelValues(folderID)
 	metricFolderScans.WithLabelValues(folderID)
 	metricFolderScanSeconds.WithLabelValues(folderID)
 	metricFolderProcessedBytesTotal.WithLabelValues(folderID, metricSourceNetwork)
@@ -3,6 +3,7 @@ package mocks
 
 import (
 	"context"
+	"io"
 	"net"
 	"sync"
 	"time"
@@ -12,11 +13,24 @@ import (
 	"github.com/syncthing/syncthing/lib/model"
 	"github.com/syncthing/syncthing/lib/protocol"
 	"github.com/syncthing/syncthing/lib/stats"
+	"github.com/syncthing/syncthing/lib/ur"
 	"github.com/syncthing/syncthing/lib/ur/contract"
 	"github.com/syncthing/syncthing/lib/versioner"
 )
 
 type Model struct {
+	AcceptConfigPushStub        func(protocol.DeviceID, int64) error
+	acceptConfigPushMutex       sync.RWMutex
+	acceptConfigPushArgsForCall []struct {
+		arg1 protocol.DeviceID
+		arg2 int64
+	}
+	acceptConfigPushReturns struct {
+		result1 error
+	}
+	acceptConfigPushReturnsOnCall map[int]struct {
+		result1 error
+	}
 	AddConnectionStub        func(protocol.Connection, protocol.Hello)
 	addConnectionMutex       sync.RWMutex
 	addConnectionArgsForCall []struct {
@@ -38,12 +52,36 @@ type Model struct {
 		result1 []model.Availability
 		result2 error
 	}
+	BlockSourceStatisticsStub        func() map[protocol.DeviceID]model.BlockSourceStats
+	blockSourceStatisticsMutex       sync.RWMutex
+	blockSourceStatisticsArgsForCall []struct {
+	}
+	blockSourceStatisticsReturns struct {
+		result1 map[protocol.DeviceID]model.BlockSourceStats
+	}
+	blockSourceStatisticsReturnsOnCall map[int]struct {
+		result1 map[protocol.DeviceID]model.BlockSourceStats
+	}
 	BringToFrontStub        func(string, string)
 	bringToFrontMutex       sync.RWMutex
 	bringToFrontArgsForCall []struct {
 		arg1 string
 		arg2 string
 	}
+	CleanFolderStub        func(string, model.CleanOptions) (model.CleanResult, error)
+	cleanFolderMutex       sync.RWMutex
+	cleanFolderArgsForCall []struct {
+		arg1 string
+		arg2 model.CleanOptions
+	}
+	cleanFolderReturns struct {
+		result1 model.CleanResult
+		result2 error
+	}
+	cleanFolderReturnsOnCall map[int]struct {
+		result1 model.CleanResult
+		result2 error
+	}
 	ClosedStub        func(protocol.Connection, error)
 	closedMutex       sync.RWMutex
 	closedArgsForCall []struct {
@@ -62,6 +100,26 @@ type Model struct {
 	clusterConfigReturnsOnCall map[int]struct {
 		result1 error
 	}
+	ClusterTopologyStub        func() model.ClusterTopology
+	clusterTopologyMutex       sync.RWMutex
+	clusterTopologyArgsForCall []struct {
+	}
+	clusterTopologyReturns struct {
+		result1 model.ClusterTopology
+	}
+	clusterTopologyReturnsOnCall map[int]struct {
+		result1 model.ClusterTopology
+	}
+	CompactDatabaseStub        func() error
+	compactDatabaseMutex       sync.RWMutex
+	compactDatabaseArgsForCall []struct {
+	}
+	compactDatabaseReturns struct {
+		result1 error
+	}
+	compactDatabaseReturnsOnCall map[int]struct {
+		result1 error
+	}
 	CompletionStub        func(protocol.DeviceID, string) (model.FolderCompletion, error)
 	completionMutex       sync.RWMutex
 	completionArgsForCall []struct {
@@ -76,6 +134,31 @@ type Model struct {
 		result1 model.FolderCompletion
 		result2 error
 	}
+	ConfigPushStub        func(protocol.Connection, *protocol.ConfigPush) error
+	configPushMutex       sync.RWMutex
+	configPushArgsForCall []struct {
+		arg1 protocol.Connection
+		arg2 *protocol.ConfigPush
+	}
+	configPushReturns struct {
+		result1 error
+	}
+	configPushReturnsOnCall map[int]struct {
+		result1 error
+	}
+	ConflictsStub        func(string) ([]model.Conflict, error)
+	conflictsMutex       sync.RWMutex
+	conflictsArgsForCall []struct {
+		arg1 string
+	}
+	conflictsReturns struct {
+		result1 []model.Conflict
+		result2 error
+	}
+	conflictsReturnsOnCall map[int]struct {
+		result1 []model.Conflict
+		result2 error
+	}
 	ConnectedToStub        func(protocol.DeviceID) bool
 	connectedToMutex       sync.RWMutex
 	connectedToArgsForCall []struct {
@@ -175,6 +258,24 @@ type Model struct {
 		result1 map[protocol.DeviceID]stats.DeviceStatistics
 		result2 error
 	}
+	DismissConfigPushStub        func(protocol.DeviceID, int64) error
+	dismissConfigPushMutex       sync.RWMutex
+	dismissConfigPushArgsForCall []struct {
+		arg1 protocol.DeviceID
+		arg2 int64
+	}
+	dismissConfigPushReturns struct {
+		result1 error
+	}
+	dismissConfigPushReturnsOnCall map[int]struct {
+		result1 error
+	}
+	DismissForwardedUsageReportStub        func(protocol.DeviceID, time.Time)
+	dismissForwardedUsageReportMutex       sync.RWMutex
+	dismissForwardedUsageReportArgsForCall []struct {
+		arg1 protocol.DeviceID
+		arg2 time.Time
+	}
 	DismissPendingDeviceStub        func(protocol.DeviceID) error
 	dismissPendingDeviceMutex       sync.RWMutex
 	dismissPendingDeviceArgsForCall []struct {
@@ -210,6 +311,58 @@ type Model struct {
 	downloadProgressReturnsOnCall map[int]struct {
 		result1 error
 	}
+	DumpIndexStub        func(string, io.Writer, db.IndexDumpFormat) error
+	dumpIndexMutex       sync.RWMutex
+	dumpIndexArgsForCall []struct {
+		arg1 string
+		arg2 io.Writer
+		arg3 db.IndexDumpFormat
+	}
+	dumpIndexReturns struct {
+		result1 error
+	}
+	dumpIndexReturnsOnCall map[int]struct {
+		result1 error
+	}
+	ExportIndexSnapshotStub        func(string, io.Writer) error
+	exportIndexSnapshotMutex       sync.RWMutex
+	exportIndexSnapshotArgsForCall []struct {
+		arg1 string
+		arg2 io.Writer
+	}
+	exportIndexSnapshotReturns struct {
+		result1 error
+	}
+	exportIndexSnapshotReturnsOnCall map[int]struct {
+		result1 error
+	}
+	FileHistoryStub        func(string, string) ([]model.FileHistoryEntry, error)
+	fileHistoryMutex       sync.RWMutex
+	fileHistoryArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	fileHistoryReturns struct {
+		result1 []model.FileHistoryEntry
+		result2 error
+	}
+	fileHistoryReturnsOnCall map[int]struct {
+		result1 []model.FileHistoryEntry
+		result2 error
+	}
+	FolderErrorDigestsStub        func(string) ([]model.FileErrorDigest, error)
+	folderErrorDigestsMutex       sync.RWMutex
+	folderErrorDigestsArgsForCall []struct {
+		arg1 string
+	}
+	folderErrorDigestsReturns struct {
+		result1 []model.FileErrorDigest
+		result2 error
+	}
+	folderErrorDigestsReturnsOnCall map[int]struct {
+		result1 []model.FileErrorDigest
+		result2 error
+	}
 	FolderErrorsStub        func(string) ([]model.FileError, error)
 	folderErrorsMutex       sync.RWMutex
 	folderErrorsArgsForCall []struct {
@@ -223,6 +376,16 @@ type Model struct {
 		result1 []model.FileError
 		result2 error
 	}
+	FolderFreezesStub        func() []model.FolderFreeze
+	folderFreezesMutex       sync.RWMutex
+	folderFreezesArgsForCall []struct {
+	}
+	folderFreezesReturns struct {
+		result1 []model.FolderFreeze
+	}
+	folderFreezesReturnsOnCall map[int]struct {
+		result1 []model.FolderFreeze
+	}
 	FolderProgressBytesCompletedStub        func(string) int64
 	folderProgressBytesCompletedMutex       sync.RWMutex
 	folderProgressBytesCompletedArgsForCall []struct {
@@ -246,6 +409,43 @@ type Model struct {
 		result1 map[string]stats.FolderStatistics
 		result2 error
 	}
+	ForwardUsageReportStub        func(protocol.DeviceID, []byte) error
+	forwardUsageReportMutex       sync.RWMutex
+	forwardUsageReportArgsForCall []struct {
+		arg1 protocol.DeviceID
+		arg2 []byte
+	}
+	forwardUsageReportReturns struct {
+		result1 error
+	}
+	forwardUsageReportReturnsOnCall map[int]struct {
+		result1 error
+	}
+	ForwardedUsageReportsStub        func() []ur.ForwardedReport
+	forwardedUsageReportsMutex       sync.RWMutex
+	forwardedUsageReportsArgsForCall []struct {
+	}
+	forwardedUsageReportsReturns struct {
+		result1 []ur.ForwardedReport
+	}
+	forwardedUsageReportsReturnsOnCall map[int]struct {
+		result1 []ur.ForwardedReport
+	}
+	FreezeFolderStub        func(string, string, time.Time) (model.FolderFreeze, error)
+	freezeFolderMutex       sync.RWMutex
+	freezeFolderArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 time.Time
+	}
+	freezeFolderReturns struct {
+		result1 model.FolderFreeze
+		result2 error
+	}
+	freezeFolderReturnsOnCall map[int]struct {
+		result1 model.FolderFreeze
+		result2 error
+	}
 	GetFolderVersionsStub        func(string) (map[string][]versioner.FileVersion, error)
 	getFolderVersionsMutex       sync.RWMutex
 	getFolderVersionsArgsForCall []struct {
@@ -289,6 +489,20 @@ type Model struct {
 		result1 []*model.TreeEntry
 		result2 error
 	}
+	ImportIndexSnapshotStub        func(string, io.Reader) (db.IndexSnapshotHeader, error)
+	importIndexSnapshotMutex       sync.RWMutex
+	importIndexSnapshotArgsForCall []struct {
+		arg1 string
+		arg2 io.Reader
+	}
+	importIndexSnapshotReturns struct {
+		result1 db.IndexSnapshotHeader
+		result2 error
+	}
+	importIndexSnapshotReturnsOnCall map[int]struct {
+		result1 db.IndexSnapshotHeader
+		result2 error
+	}
 	IndexStub        func(protocol.Connection, *protocol.Index) error
 	indexMutex       sync.RWMutex
 	indexArgsForCall []struct {
@@ -301,6 +515,18 @@ type Model struct {
 	indexReturnsOnCall map[int]struct {
 		result1 error
 	}
+	IndexAckStub        func(protocol.Connection, *protocol.IndexAck) error
+	indexAckMutex       sync.RWMutex
+	indexAckArgsForCall []struct {
+		arg1 protocol.Connection
+		arg2 *protocol.IndexAck
+	}
+	indexAckReturns struct {
+		result1 error
+	}
+	indexAckReturnsOnCall map[int]struct {
+		result1 error
+	}
 	IndexUpdateStub        func(protocol.Connection, *protocol.IndexUpdate) error
 	indexUpdateMutex       sync.RWMutex
 	indexUpdateArgsForCall []struct {
@@ -380,6 +606,16 @@ type Model struct {
 	overrideArgsForCall []struct {
 		arg1 string
 	}
+	PendingConfigPushesStub        func() []model.PendingConfigPush
+	pendingConfigPushesMutex       sync.RWMutex
+	pendingConfigPushesArgsForCall []struct {
+	}
+	pendingConfigPushesReturns struct {
+		result1 []model.PendingConfigPush
+	}
+	pendingConfigPushesReturnsOnCall map[int]struct {
+		result1 []model.PendingConfigPush
+	}
 	PendingDevicesStub        func() (map[protocol.DeviceID]db.ObservedDevice, error)
 	pendingDevicesMutex       sync.RWMutex
 	pendingDevicesArgsForCall []struct {
@@ -405,6 +641,75 @@ type Model struct {
 		result1 map[string]db.PendingFolder
 		result2 error
 	}
+	PullPrioritiesStub        func(string) ([]model.PullPriority, error)
+	pullPrioritiesMutex       sync.RWMutex
+	pullPrioritiesArgsForCall []struct {
+		arg1 string
+	}
+	pullPrioritiesReturns struct {
+		result1 []model.PullPriority
+		result2 error
+	}
+	pullPrioritiesReturnsOnCall map[int]struct {
+		result1 []model.PullPriority
+		result2 error
+	}
+	PullQueueStub        func(string, int, int) (model.PullQueue, error)
+	pullQueueMutex       sync.RWMutex
+	pullQueueArgsForCall []struct {
+		arg1 string
+		arg2 int
+		arg3 int
+	}
+	pullQueueReturns struct {
+		result1 model.PullQueue
+		result2 error
+	}
+	pullQueueReturnsOnCall map[int]struct {
+		result1 model.PullQueue
+		result2 error
+	}
+	PushConfigStub        func(protocol.DeviceID, model.PushedConfig) (int64, error)
+	pushConfigMutex       sync.RWMutex
+	pushConfigArgsForCall []struct {
+		arg1 protocol.DeviceID
+		arg2 model.PushedConfig
+	}
+	pushConfigReturns struct {
+		result1 int64
+		result2 error
+	}
+	pushConfigReturnsOnCall map[int]struct {
+		result1 int64
+		result2 error
+	}
+	PushToBackStub        func(string, string) error
+	pushToBackMutex       sync.RWMutex
+	pushToBackArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	pushToBackReturns struct {
+		result1 error
+	}
+	pushToBackReturnsOnCall map[int]struct {
+		result1 error
+	}
+	ReceiveOnlyChangesStub        func(string, int, int) ([]model.ReceiveOnlyChange, error)
+	receiveOnlyChangesMutex       sync.RWMutex
+	receiveOnlyChangesArgsForCall []struct {
+		arg1 string
+		arg2 int
+		arg3 int
+	}
+	receiveOnlyChangesReturns struct {
+		result1 []model.ReceiveOnlyChange
+		result2 error
+	}
+	receiveOnlyChangesReturnsOnCall map[int]struct {
+		result1 []model.ReceiveOnlyChange
+		result2 error
+	}
 	RemoteNeedFolderFilesStub        func(string, protocol.DeviceID, int, int) ([]db.FileInfoTruncated, error)
 	remoteNeedFolderFilesMutex       sync.RWMutex
 	remoteNeedFolderFilesArgsForCall []struct {
@@ -468,6 +773,19 @@ type Model struct {
 	resetFolderReturnsOnCall map[int]struct {
 		result1 error
 	}
+	ResolveConflictStub        func(string, string, bool) error
+	resolveConflictMutex       sync.RWMutex
+	resolveConflictArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 bool
+	}
+	resolveConflictReturns struct {
+		result1 error
+	}
+	resolveConflictReturnsOnCall map[int]struct {
+		result1 error
+	}
 	RestoreFolderVersionsStub        func(string, map[string]time.Time) (map[string]error, error)
 	restoreFolderVersionsMutex       sync.RWMutex
 	restoreFolderVersionsArgsForCall []struct {
@@ -487,6 +805,18 @@ type Model struct {
 	revertArgsForCall []struct {
 		arg1 string
 	}
+	RevertPathsStub        func(string, []string) error
+	revertPathsMutex       sync.RWMutex
+	revertPathsArgsForCall []struct {
+		arg1 string
+		arg2 []string
+	}
+	revertPathsReturns struct {
+		result1 error
+	}
+	revertPathsReturnsOnCall map[int]struct {
+		result1 error
+	}
 	ScanFolderStub        func(string) error
 	scanFolderMutex       sync.RWMutex
 	scanFolderArgsForCall []struct {
@@ -543,6 +873,41 @@ type Model struct {
 	setIgnoresReturnsOnCall map[int]struct {
 		result1 error
 	}
+	SetPullPriorityStub        func(string, string, int) error
+	setPullPriorityMutex       sync.RWMutex
+	setPullPriorityArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 int
+	}
+	setPullPriorityReturns struct {
+		result1 error
+	}
+	setPullPriorityReturnsOnCall map[int]struct {
+		result1 error
+	}
+	SkipPullStub        func(string, string) error
+	skipPullMutex       sync.RWMutex
+	skipPullArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	skipPullReturns struct {
+		result1 error
+	}
+	skipPullReturnsOnCall map[int]struct {
+		result1 error
+	}
+	StartupStatusStub        func() model.StartupStatus
+	startupStatusMutex       sync.RWMutex
+	startupStatusArgsForCall []struct {
+	}
+	startupStatusReturns struct {
+		result1 model.StartupStatus
+	}
+	startupStatusReturnsOnCall map[int]struct {
+		result1 model.StartupStatus
+	}
 	StateStub        func(string) (string, time.Time, error)
 	stateMutex       sync.RWMutex
 	stateArgsForCall []struct {
@@ -558,6 +923,49 @@ type Model struct {
 		result2 time.Time
 		result3 error
 	}
+	TrafficStatisticsStub        func() []model.TrafficStatistics
+	trafficStatisticsMutex       sync.RWMutex
+	trafficStatisticsArgsForCall []struct {
+	}
+	trafficStatisticsReturns struct {
+		result1 []model.TrafficStatistics
+	}
+	trafficStatisticsReturnsOnCall map[int]struct {
+		result1 []model.TrafficStatistics
+	}
+	TransferStatisticsStub        func() []model.TransferStatistics
+	transferStatisticsMutex       sync.RWMutex
+	transferStatisticsArgsForCall []struct {
+	}
+	transferStatisticsReturns struct {
+		result1 []model.TransferStatistics
+	}
+	transferStatisticsReturnsOnCall map[int]struct {
+		result1 []model.TransferStatistics
+	}
+	UnfreezeFolderStub        func(string) error
+	unfreezeFolderMutex       sync.RWMutex
+	unfreezeFolderArgsForCall []struct {
+		arg1 string
+	}
+	unfreezeFolderReturns struct {
+		result1 error
+	}
+	unfreezeFolderReturnsOnCall map[int]struct {
+		result1 error
+	}
+	UsageReportStub        func(protocol.Connection, *protocol.UsageReport) error
+	usageReportMutex       sync.RWMutex
+	usageReportArgsForCall []struct {
+		arg1 protocol.Connection
+		arg2 *protocol.UsageReport
+	}
+	usageReportReturns struct {
+		result1 error
+	}
+	usageReportReturnsOnCall map[int]struct {
+		result1 error
+	}
 	UsageReportingStatsStub        func(*contract.Report, int, bool)
 	usageReportingStatsMutex       sync.RWMutex
 	usageReportingStatsArgsForCall []struct {
@@ -565,6 +973,18 @@ type Model struct {
 		arg2 int
 		arg3 bool
 	}
+	VerifyDatabaseStub        func() (db.VerifyResult, error)
+	verifyDatabaseMutex       sync.RWMutex
+	verifyDatabaseArgsForCall []struct {
+	}
+	verifyDatabaseReturns struct {
+		result1 db.VerifyResult
+		result2 error
+	}
+	verifyDatabaseReturnsOnCall map[int]struct {
+		result1 db.VerifyResult
+		result2 error
+	}
 	WatchErrorStub        func(string) error
 	watchErrorMutex       sync.RWMutex
 	watchErrorArgsForCall []struct {
@@ -580,6 +1000,68 @@ type Model struct {
 	invocationsMutex sync.RWMutex
 }
 
+func (fake *Model) AcceptConfigPush(arg1 protocol.DeviceID, arg2 int64) error {
+	fake.acceptConfigPushMutex.Lock()
+	ret, specificReturn := fake.acceptConfigPushReturnsOnCall[len(fake.acceptConfigPushArgsForCall)]
+	fake.acceptConfigPushArgsForCall = append(fake.acceptConfigPushArgsForCall, struct {
+		arg1 protocol.DeviceID
+		arg2 int64
+	}{arg1, arg2})
+	stub := fake.AcceptConfigPushStub
+	fakeReturns := fake.acceptConfigPushReturns
+	fake.recordInvocation("AcceptConfigPush", []interface{}{arg1, arg2})
+	fake.acceptConfigPushMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *Model) AcceptConfigPushCallCount() int {
+	fake.acceptConfigPushMutex.RLock()
+	defer fake.acceptConfigPushMutex.RUnlock()
+	return len(fake.acceptConfigPushArgsForCall)
+}
+
+func (fake *Model) AcceptConfigPushCalls(stub func(protocol.DeviceID, int64) error) {
+	fake.acceptConfigPushMutex.Lock()
+	defer fake.acceptConfigPushMutex.Unlock()
+	fake.AcceptConfigPushStub = stub
+}
+
+func (fake *Model) AcceptConfigPushArgsForCall(i int) (protocol.DeviceID, int64) {
+	fake.acceptConfigPushMutex.RLock()
+	defer fake.acceptConfigPushMutex.RUnlock()
+	argsForCall := fake.acceptConfigPushArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *Model) AcceptConfigPushReturns(result1 error) {
+	fake.acceptConfigPushMutex.Lock()
+	defer fake.acceptConfigPushMutex.Unlock()
+	fake.AcceptConfigPushStub = nil
+	fake.acceptConfigPushReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *Model) AcceptConfigPushReturnsOnCall(i int, result1 error) {
+	fake.acceptConfigPushMutex.Lock()
+	defer fake.acceptConfigPushMutex.Unlock()
+	fake.AcceptConfigPushStub = nil
+	if fake.acceptConfigPushReturnsOnCall == nil {
+		fake.acceptConfigPushReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.acceptConfigPushReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *Model) AddConnection(arg1 protocol.Connection, arg2 protocol.Hello) {
 	fake.addConnectionMutex.Lock()
 	fake.addConnectionArgsForCall = append(fake.addConnectionArgsForCall, struct {
@@ -679,6 +1161,59 @@ func (fake *Model) AvailabilityReturnsOnCall(i int, result1 []model.Availability
 	}{result1, result2}
 }
 
+func (fake *Model) BlockSourceStatistics() map[protocol.DeviceID]model.BlockSourceStats {
+	fake.blockSourceStatisticsMutex.Lock()
+	ret, specificReturn := fake.blockSourceStatisticsReturnsOnCall[len(fake.blockSourceStatisticsArgsForCall)]
+	fake.blockSourceStatisticsArgsForCall = append(fake.blockSourceStatisticsArgsForCall, struct {
+	}{})
+	stub := fake.BlockSourceStatisticsStub
+	fakeReturns := fake.blockSourceStatisticsReturns
+	fake.recordInvocation("BlockSourceStatistics", []interface{}{})
+	fake.blockSourceStatisticsMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *Model) BlockSourceStatisticsCallCount() int {
+	fake.blockSourceStatisticsMutex.RLock()
+	defer fake.blockSourceStatisticsMutex.RUnlock()
+	return len(fake.blockSourceStatisticsArgsForCall)
+}
+
+func (fake *Model) BlockSourceStatisticsCalls(stub func() map[protocol.DeviceID]model.BlockSourceStats) {
+	fake.blockSourceStatisticsMutex.Lock()
+	defer fake.blockSourceStatisticsMutex.Unlock()
+	fake.BlockSourceStatisticsStub = stub
+}
+
+func (fake *Model) BlockSourceStatisticsReturns(result1 map[protocol.DeviceID]model.BlockSourceStats) {
+	fake.blockSourceStatisticsMutex.Lock()
+	defer fake.blockSourceStatisticsMutex.Unlock()
+	fake.BlockSourceStatisticsStub = nil
+	fake.blockSourceStatisticsReturns = struct {
+		result1 map[protocol.DeviceID]model.BlockSourceStats
+	}{result1}
+}
+
+func (fake *Model) BlockSourceStatisticsReturnsOnCall(i int, result1 map[protocol.DeviceID]model.BlockSourceStats) {
+	fake.blockSourceStatisticsMutex.Lock()
+	defer fake.blockSourceStatisticsMutex.Unlock()
+	fake.BlockSourceStatisticsStub = nil
+	if fake.blockSourceStatisticsReturnsOnCall == nil {
+		fake.blockSourceStatisticsReturnsOnCall = make(map[int]struct {
+			result1 map[protocol.DeviceID]model.BlockSourceStats
+		})
+	}
+	fake.blockSourceStatisticsReturnsOnCall[i] = struct {
+		result1 map[protocol.DeviceID]model.BlockSourceStats
+	}{result1}
+}
+
 func (fake *Model) BringToFront(arg1 string, arg2 string) {
 	fake.bringToFrontMutex.Lock()
 	fake.bringToFrontArgsForCall = append(fake.bringToFrontArgsForCall, struct {
@@ -712,17 +1247,82 @@ func (fake *Model) BringToFrontArgsForCall(i int) (string, string) {
 	return argsForCall.arg1, argsForCall.arg2
 }
 
-func (fake *Model) Closed(arg1 protocol.Connection, arg2 error) {
-	fake.closedMutex.Lock()
-	fake.closedArgsForCall = append(fake.closedArgsForCall, struct {
-		arg1 protocol.Connection
-		arg2 error
+func (fake *Model) CleanFolder(arg1 string, arg2 model.CleanOptions) (model.CleanResult, error) {
+	fake.cleanFolderMutex.Lock()
+	ret, specificReturn := fake.cleanFolderReturnsOnCall[len(fake.cleanFolderArgsForCall)]
+	fake.cleanFolderArgsForCall = append(fake.cleanFolderArgsForCall, struct {
+		arg1 string
+		arg2 model.CleanOptions
 	}{arg1, arg2})
-	stub := fake.ClosedStub
-	fake.recordInvocation("Closed", []interface{}{arg1, arg2})
-	fake.closedMutex.Unlock()
+	stub := fake.CleanFolderStub
+	fakeReturns := fake.cleanFolderReturns
+	fake.recordInvocation("CleanFolder", []interface{}{arg1, arg2})
+	fake.cleanFolderMutex.Unlock()
 	if stub != nil {
-		fake.ClosedStub(arg1, arg2)
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *Model) CleanFolderCallCount() int {
+	fake.cleanFolderMutex.RLock()
+	defer fake.cleanFolderMutex.RUnlock()
+	return len(fake.cleanFolderArgsForCall)
+}
+
+func (fake *Model) CleanFolderCalls(stub func(string, model.CleanOptions) (model.CleanResult, error)) {
+	fake.cleanFolderMutex.Lock()
+	defer fake.cleanFolderMutex.Unlock()
+	fake.CleanFolderStub = stub
+}
+
+func (fake *Model) CleanFolderArgsForCall(i int) (string, model.CleanOptions) {
+	fake.cleanFolderMutex.RLock()
+	defer fake.cleanFolderMutex.RUnlock()
+	argsForCall := fake.cleanFolderArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *Model) CleanFolderReturns(result1 model.CleanResult, result2 error) {
+	fake.cleanFolderMutex.Lock()
+	defer fake.cleanFolderMutex.Unlock()
+	fake.CleanFolderStub = nil
+	fake.cleanFolderReturns = struct {
+		result1 model.CleanResult
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *Model) CleanFolderReturnsOnCall(i int, result1 model.CleanResult, result2 error) {
+	fake.cleanFolderMutex.Lock()
+	defer fake.cleanFolderMutex.Unlock()
+	fake.CleanFolderStub = nil
+	if fake.cleanFolderReturnsOnCall == nil {
+		fake.cleanFolderReturnsOnCall = make(map[int]struct {
+			result1 model.CleanResult
+			result2 error
+		})
+	}
+	fake.cleanFolderReturnsOnCall[i] = struct {
+		result1 model.CleanResult
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *Model) Closed(arg1 protocol.Connection, arg2 error) {
+	fake.closedMutex.Lock()
+	fake.closedArgsForCall = append(fake.closedArgsForCall, struct {
+		arg1 protocol.Connection
+		arg2 error
+	}{arg1, arg2})
+	stub := fake.ClosedStub
+	fake.recordInvocation("Closed", []interface{}{arg1, arg2})
+	fake.closedMutex.Unlock()
+	if stub != nil {
+		fake.ClosedStub(arg1, arg2)
 	}
 }
 
@@ -807,6 +1407,112 @@ func (fake *Model) ClusterConfigReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *Model) ClusterTopology() model.ClusterTopology {
+	fake.clusterTopologyMutex.Lock()
+	ret, specificReturn := fake.clusterTopologyReturnsOnCall[len(fake.clusterTopologyArgsForCall)]
+	fake.clusterTopologyArgsForCall = append(fake.clusterTopologyArgsForCall, struct {
+	}{})
+	stub := fake.ClusterTopologyStub
+	fakeReturns := fake.clusterTopologyReturns
+	fake.recordInvocation("ClusterTopology", []interface{}{})
+	fake.clusterTopologyMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *Model) ClusterTopologyCallCount() int {
+	fake.clusterTopologyMutex.RLock()
+	defer fake.clusterTopologyMutex.RUnlock()
+	return len(fake.clusterTopologyArgsForCall)
+}
+
+func (fake *Model) ClusterTopologyCalls(stub func() model.ClusterTopology) {
+	fake.clusterTopologyMutex.Lock()
+	defer fake.clusterTopologyMutex.Unlock()
+	fake.ClusterTopologyStub = stub
+}
+
+func (fake *Model) ClusterTopologyReturns(result1 model.ClusterTopology) {
+	fake.clusterTopologyMutex.Lock()
+	defer fake.clusterTopologyMutex.Unlock()
+	fake.ClusterTopologyStub = nil
+	fake.clusterTopologyReturns = struct {
+		result1 model.ClusterTopology
+	}{result1}
+}
+
+func (fake *Model) ClusterTopologyReturnsOnCall(i int, result1 model.ClusterTopology) {
+	fake.clusterTopologyMutex.Lock()
+	defer fake.clusterTopologyMutex.Unlock()
+	fake.ClusterTopologyStub = nil
+	if fake.clusterTopologyReturnsOnCall == nil {
+		fake.clusterTopologyReturnsOnCall = make(map[int]struct {
+			result1 model.ClusterTopology
+		})
+	}
+	fake.clusterTopologyReturnsOnCall[i] = struct {
+		result1 model.ClusterTopology
+	}{result1}
+}
+
+func (fake *Model) CompactDatabase() error {
+	fake.compactDatabaseMutex.Lock()
+	ret, specificReturn := fake.compactDatabaseReturnsOnCall[len(fake.compactDatabaseArgsForCall)]
+	fake.compactDatabaseArgsForCall = append(fake.compactDatabaseArgsForCall, struct {
+	}{})
+	stub := fake.CompactDatabaseStub
+	fakeReturns := fake.compactDatabaseReturns
+	fake.recordInvocation("CompactDatabase", []interface{}{})
+	fake.compactDatabaseMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *Model) CompactDatabaseCallCount() int {
+	fake.compactDatabaseMutex.RLock()
+	defer fake.compactDatabaseMutex.RUnlock()
+	return len(fake.compactDatabaseArgsForCall)
+}
+
+func (fake *Model) CompactDatabaseCalls(stub func() error) {
+	fake.compactDatabaseMutex.Lock()
+	defer fake.compactDatabaseMutex.Unlock()
+	fake.CompactDatabaseStub = stub
+}
+
+func (fake *Model) CompactDatabaseReturns(result1 error) {
+	fake.compactDatabaseMutex.Lock()
+	defer fake.compactDatabaseMutex.Unlock()
+	fake.CompactDatabaseStub = nil
+	fake.compactDatabaseReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *Model) CompactDatabaseReturnsOnCall(i int, result1 error) {
+	fake.compactDatabaseMutex.Lock()
+	defer fake.compactDatabaseMutex.Unlock()
+	fake.CompactDatabaseStub = nil
+	if fake.compactDatabaseReturnsOnCall == nil {
+		fake.compactDatabaseReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.compactDatabaseReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *Model) Completion(arg1 protocol.DeviceID, arg2 string) (model.FolderCompletion, error) {
 	fake.completionMutex.Lock()
 	ret, specificReturn := fake.completionReturnsOnCall[len(fake.completionArgsForCall)]
@@ -872,6 +1578,132 @@ func (fake *Model) CompletionReturnsOnCall(i int, result1 model.FolderCompletion
 	}{result1, result2}
 }
 
+func (fake *Model) ConfigPush(arg1 protocol.Connection, arg2 *protocol.ConfigPush) error {
+	fake.configPushMutex.Lock()
+	ret, specificReturn := fake.configPushReturnsOnCall[len(fake.configPushArgsForCall)]
+	fake.configPushArgsForCall = append(fake.configPushArgsForCall, struct {
+		arg1 protocol.Connection
+		arg2 *protocol.ConfigPush
+	}{arg1, arg2})
+	stub := fake.ConfigPushStub
+	fakeReturns := fake.configPushReturns
+	fake.recordInvocation("ConfigPush", []interface{}{arg1, arg2})
+	fake.configPushMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *Model) ConfigPushCallCount() int {
+	fake.configPushMutex.RLock()
+	defer fake.configPushMutex.RUnlock()
+	return len(fake.configPushArgsForCall)
+}
+
+func (fake *Model) ConfigPushCalls(stub func(protocol.Connection, *protocol.ConfigPush) error) {
+	fake.configPushMutex.Lock()
+	defer fake.configPushMutex.Unlock()
+	fake.ConfigPushStub = stub
+}
+
+func (fake *Model) ConfigPushArgsForCall(i int) (protocol.Connection, *protocol.ConfigPush) {
+	fake.configPushMutex.RLock()
+	defer fake.configPushMutex.RUnlock()
+	argsForCall := fake.configPushArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *Model) ConfigPushReturns(result1 error) {
+	fake.configPushMutex.Lock()
+	defer fake.configPushMutex.Unlock()
+	fake.ConfigPushStub = nil
+	fake.configPushReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *Model) ConfigPushReturnsOnCall(i int, result1 error) {
+	fake.configPushMutex.Lock()
+	defer fake.configPushMutex.Unlock()
+	fake.ConfigPushStub = nil
+	if fake.configPushReturnsOnCall == nil {
+		fake.configPushReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.configPushReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *Model) Conflicts(arg1 string) ([]model.Conflict, error) {
+	fake.conflictsMutex.Lock()
+	ret, specificReturn := fake.conflictsReturnsOnCall[len(fake.conflictsArgsForCall)]
+	fake.conflictsArgsForCall = append(fake.conflictsArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.ConflictsStub
+	fakeReturns := fake.conflictsReturns
+	fake.recordInvocation("Conflicts", []interface{}{arg1})
+	fake.conflictsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *Model) ConflictsCallCount() int {
+	fake.conflictsMutex.RLock()
+	defer fake.conflictsMutex.RUnlock()
+	return len(fake.conflictsArgsForCall)
+}
+
+func (fake *Model) ConflictsCalls(stub func(string) ([]model.Conflict, error)) {
+	fake.conflictsMutex.Lock()
+	defer fake.conflictsMutex.Unlock()
+	fake.ConflictsStub = stub
+}
+
+func (fake *Model) ConflictsArgsForCall(i int) string {
+	fake.conflictsMutex.RLock()
+	defer fake.conflictsMutex.RUnlock()
+	argsForCall := fake.conflictsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *Model) ConflictsReturns(result1 []model.Conflict, result2 error) {
+	fake.conflictsMutex.Lock()
+	defer fake.conflictsMutex.Unlock()
+	fake.ConflictsStub = nil
+	fake.conflictsReturns = struct {
+		result1 []model.Conflict
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *Model) ConflictsReturnsOnCall(i int, result1 []model.Conflict, result2 error) {
+	fake.conflictsMutex.Lock()
+	defer fake.conflictsMutex.Unlock()
+	fake.ConflictsStub = nil
+	if fake.conflictsReturnsOnCall == nil {
+		fake.conflictsReturnsOnCall = make(map[int]struct {
+			result1 []model.Conflict
+			result2 error
+		})
+	}
+	fake.conflictsReturnsOnCall[i] = struct {
+		result1 []model.Conflict
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *Model) ConnectedTo(arg1 protocol.DeviceID) bool {
 	fake.connectedToMutex.Lock()
 	ret, specificReturn := fake.connectedToReturnsOnCall[len(fake.connectedToArgsForCall)]
@@ -1342,6 +2174,101 @@ func (fake *Model) DeviceStatisticsReturnsOnCall(i int, result1 map[protocol.Dev
 	}{result1, result2}
 }
 
+func (fake *Model) DismissConfigPush(arg1 protocol.DeviceID, arg2 int64) error {
+	fake.dismissConfigPushMutex.Lock()
+	ret, specificReturn := fake.dismissConfigPushReturnsOnCall[len(fake.dismissConfigPushArgsForCall)]
+	fake.dismissConfigPushArgsForCall = append(fake.dismissConfigPushArgsForCall, struct {
+		arg1 protocol.DeviceID
+		arg2 int64
+	}{arg1, arg2})
+	stub := fake.DismissConfigPushStub
+	fakeReturns := fake.dismissConfigPushReturns
+	fake.recordInvocation("DismissConfigPush", []interface{}{arg1, arg2})
+	fake.dismissConfigPushMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *Model) DismissConfigPushCallCount() int {
+	fake.dismissConfigPushMutex.RLock()
+	defer fake.dismissConfigPushMutex.RUnlock()
+	return len(fake.dismissConfigPushArgsForCall)
+}
+
+func (fake *Model) DismissConfigPushCalls(stub func(protocol.DeviceID, int64) error) {
+	fake.dismissConfigPushMutex.Lock()
+	defer fake.dismissConfigPushMutex.Unlock()
+	fake.DismissConfigPushStub = stub
+}
+
+func (fake *Model) DismissConfigPushArgsForCall(i int) (protocol.DeviceID, int64) {
+	fake.dismissConfigPushMutex.RLock()
+	defer fake.dismissConfigPushMutex.RUnlock()
+	argsForCall := fake.dismissConfigPushArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *Model) DismissConfigPushReturns(result1 error) {
+	fake.dismissConfigPushMutex.Lock()
+	defer fake.dismissConfigPushMutex.Unlock()
+	fake.DismissConfigPushStub = nil
+	fake.dismissConfigPushReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *Model) DismissConfigPushReturnsOnCall(i int, result1 error) {
+	fake.dismissConfigPushMutex.Lock()
+	defer fake.dismissConfigPushMutex.Unlock()
+	fake.DismissConfigPushStub = nil
+	if fake.dismissConfigPushReturnsOnCall == nil {
+		fake.dismissConfigPushReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.dismissConfigPushReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *Model) DismissForwardedUsageReport(arg1 protocol.DeviceID, arg2 time.Time) {
+	fake.dismissForwardedUsageReportMutex.Lock()
+	fake.dismissForwardedUsageReportArgsForCall = append(fake.dismissForwardedUsageReportArgsForCall, struct {
+		arg1 protocol.DeviceID
+		arg2 time.Time
+	}{arg1, arg2})
+	stub := fake.DismissForwardedUsageReportStub
+	fake.recordInvocation("DismissForwardedUsageReport", []interface{}{arg1, arg2})
+	fake.dismissForwardedUsageReportMutex.Unlock()
+	if stub != nil {
+		fake.DismissForwardedUsageReportStub(arg1, arg2)
+	}
+}
+
+func (fake *Model) DismissForwardedUsageReportCallCount() int {
+	fake.dismissForwardedUsageReportMutex.RLock()
+	defer fake.dismissForwardedUsageReportMutex.RUnlock()
+	return len(fake.dismissForwardedUsageReportArgsForCall)
+}
+
+func (fake *Model) DismissForwardedUsageReportCalls(stub func(protocol.DeviceID, time.Time)) {
+	fake.dismissForwardedUsageReportMutex.Lock()
+	defer fake.dismissForwardedUsageReportMutex.Unlock()
+	fake.DismissForwardedUsageReportStub = stub
+}
+
+func (fake *Model) DismissForwardedUsageReportArgsForCall(i int) (protocol.DeviceID, time.Time) {
+	fake.dismissForwardedUsageReportMutex.RLock()
+	defer fake.dismissForwardedUsageReportMutex.RUnlock()
+	argsForCall := fake.dismissForwardedUsageReportArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
 func (fake *Model) DismissPendingDevice(arg1 protocol.DeviceID) error {
 	fake.dismissPendingDeviceMutex.Lock()
 	ret, specificReturn := fake.dismissPendingDeviceReturnsOnCall[len(fake.dismissPendingDeviceArgsForCall)]
@@ -1527,82 +2454,82 @@ func (fake *Model) DownloadProgressReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
-func (fake *Model) FolderErrors(arg1 string) ([]model.FileError, error) {
-	fake.folderErrorsMutex.Lock()
-	ret, specificReturn := fake.folderErrorsReturnsOnCall[len(fake.folderErrorsArgsForCall)]
-	fake.folderErrorsArgsForCall = append(fake.folderErrorsArgsForCall, struct {
+func (fake *Model) DumpIndex(arg1 string, arg2 io.Writer, arg3 db.IndexDumpFormat) error {
+	fake.dumpIndexMutex.Lock()
+	ret, specificReturn := fake.dumpIndexReturnsOnCall[len(fake.dumpIndexArgsForCall)]
+	fake.dumpIndexArgsForCall = append(fake.dumpIndexArgsForCall, struct {
 		arg1 string
-	}{arg1})
-	stub := fake.FolderErrorsStub
-	fakeReturns := fake.folderErrorsReturns
-	fake.recordInvocation("FolderErrors", []interface{}{arg1})
-	fake.folderErrorsMutex.Unlock()
+		arg2 io.Writer
+		arg3 db.IndexDumpFormat
+	}{arg1, arg2, arg3})
+	stub := fake.DumpIndexStub
+	fakeReturns := fake.dumpIndexReturns
+	fake.recordInvocation("DumpIndex", []interface{}{arg1, arg2, arg3})
+	fake.dumpIndexMutex.Unlock()
 	if stub != nil {
-		return stub(arg1)
+		return stub(arg1, arg2, arg3)
 	}
 	if specificReturn {
-		return ret.result1, ret.result2
+		return ret.result1
 	}
-	return fakeReturns.result1, fakeReturns.result2
+	return fakeReturns.result1
 }
 
-func (fake *Model) FolderErrorsCallCount() int {
-	fake.folderErrorsMutex.RLock()
-	defer fake.folderErrorsMutex.RUnlock()
-	return len(fake.folderErrorsArgsForCall)
+func (fake *Model) DumpIndexCallCount() int {
+	fake.dumpIndexMutex.RLock()
+	defer fake.dumpIndexMutex.RUnlock()
+	return len(fake.dumpIndexArgsForCall)
 }
 
-func (fake *Model) FolderErrorsCalls(stub func(string) ([]model.FileError, error)) {
-	fake.folderErrorsMutex.Lock()
-	defer fake.folderErrorsMutex.Unlock()
-	fake.FolderErrorsStub = stub
+func (fake *Model) DumpIndexCalls(stub func(string, io.Writer, db.IndexDumpFormat) error) {
+	fake.dumpIndexMutex.Lock()
+	defer fake.dumpIndexMutex.Unlock()
+	fake.DumpIndexStub = stub
 }
 
-func (fake *Model) FolderErrorsArgsForCall(i int) string {
-	fake.folderErrorsMutex.RLock()
-	defer fake.folderErrorsMutex.RUnlock()
-	argsForCall := fake.folderErrorsArgsForCall[i]
-	return argsForCall.arg1
+func (fake *Model) DumpIndexArgsForCall(i int) (string, io.Writer, db.IndexDumpFormat) {
+	fake.dumpIndexMutex.RLock()
+	defer fake.dumpIndexMutex.RUnlock()
+	argsForCall := fake.dumpIndexArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
 }
 
-func (fake *Model) FolderErrorsReturns(result1 []model.FileError, result2 error) {
-	fake.folderErrorsMutex.Lock()
-	defer fake.folderErrorsMutex.Unlock()
-	fake.FolderErrorsStub = nil
-	fake.folderErrorsReturns = struct {
-		result1 []model.FileError
-		result2 error
-	}{result1, result2}
+func (fake *Model) DumpIndexReturns(result1 error) {
+	fake.dumpIndexMutex.Lock()
+	defer fake.dumpIndexMutex.Unlock()
+	fake.DumpIndexStub = nil
+	fake.dumpIndexReturns = struct {
+		result1 error
+	}{result1}
 }
 
-func (fake *Model) FolderErrorsReturnsOnCall(i int, result1 []model.FileError, result2 error) {
-	fake.folderErrorsMutex.Lock()
-	defer fake.folderErrorsMutex.Unlock()
-	fake.FolderErrorsStub = nil
-	if fake.folderErrorsReturnsOnCall == nil {
-		fake.folderErrorsReturnsOnCall = make(map[int]struct {
-			result1 []model.FileError
-			result2 error
+func (fake *Model) DumpIndexReturnsOnCall(i int, result1 error) {
+	fake.dumpIndexMutex.Lock()
+	defer fake.dumpIndexMutex.Unlock()
+	fake.DumpIndexStub = nil
+	if fake.dumpIndexReturnsOnCall == nil {
+		fake.dumpIndexReturnsOnCall = make(map[int]struct {
+			result1 error
 		})
 	}
-	fake.folderErrorsReturnsOnCall[i] = struct {
-		result1 []model.FileError
-		result2 error
-	}{result1, result2}
+	fake.dumpIndexReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
 }
 
-func (fake *Model) FolderProgressBytesCompleted(arg1 string) int64 {
-	fake.folderProgressBytesCompletedMutex.Lock()
-	ret, specificReturn := fake.folderProgressBytesCompletedReturnsOnCall[len(fake.folderProgressBytesCompletedArgsForCall)]
-	fake.folderProgressBytesCompletedArgsForCall = append(fake.folderProgressBytesCompletedArgsForCall, struct {
+func (fake *Model) ExportIndexSnapshot(arg1 string, arg2 io.Writer) error {
+	fake.exportIndexSnapshotMutex.Lock()
+	ret, specificReturn := fake.exportIndexSnapshotReturnsOnCall[len(fake.exportIndexSnapshotArgsForCall)]
+	fake.exportIndexSnapshotArgsForCall = append(fake.exportIndexSnapshotArgsForCall, struct {
 		arg1 string
-	}{arg1})
-	stub := fake.FolderProgressBytesCompletedStub
-	fakeReturns := fake.folderProgressBytesCompletedReturns
-	fake.recordInvocation("FolderProgressBytesCompleted", []interface{}{arg1})
-	fake.folderProgressBytesCompletedMutex.Unlock()
+		arg2 io.Writer
+	}{arg1, arg2})
+	stub := fake.ExportIndexSnapshotStub
+	fakeReturns := fake.exportIndexSnapshotReturns
+	fake.recordInvocation("ExportIndexSnapshot", []interface{}{arg1, arg2})
+	fake.exportIndexSnapshotMutex.Unlock()
 	if stub != nil {
-		return stub(arg1)
+		return stub(arg1, arg2)
 	}
 	if specificReturn {
 		return ret.result1
@@ -1610,13 +2537,320 @@ func (fake *Model) FolderProgressBytesCompleted(arg1 string) int64 {
 	return fakeReturns.result1
 }
 
-func (fake *Model) FolderProgressBytesCompletedCallCount() int {
-	fake.folderProgressBytesCompletedMutex.RLock()
-	defer fake.folderProgressBytesCompletedMutex.RUnlock()
-	return len(fake.folderProgressBytesCompletedArgsForCall)
+func (fake *Model) ExportIndexSnapshotCallCount() int {
+	fake.exportIndexSnapshotMutex.RLock()
+	defer fake.exportIndexSnapshotMutex.RUnlock()
+	return len(fake.exportIndexSnapshotArgsForCall)
 }
 
-func (fake *Model) FolderProgressBytesCompletedCalls(stub func(string) int64) {
+func (fake *Model) ExportIndexSnapshotCalls(stub func(string, io.Writer) error) {
+	fake.exportIndexSnapshotMutex.Lock()
+	defer fake.exportIndexSnapshotMutex.Unlock()
+	fake.ExportIndexSnapshotStub = stub
+}
+
+func (fake *Model) ExportIndexSnapshotArgsForCall(i int) (string, io.Writer) {
+	fake.exportIndexSnapshotMutex.RLock()
+	defer fake.exportIndexSnapshotMutex.RUnlock()
+	argsForCall := fake.exportIndexSnapshotArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *Model) ExportIndexSnapshotReturns(result1 error) {
+	fake.exportIndexSnapshotMutex.Lock()
+	defer fake.exportIndexSnapshotMutex.Unlock()
+	fake.ExportIndexSnapshotStub = nil
+	fake.exportIndexSnapshotReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *Model) ExportIndexSnapshotReturnsOnCall(i int, result1 error) {
+	fake.exportIndexSnapshotMutex.Lock()
+	defer fake.exportIndexSnapshotMutex.Unlock()
+	fake.ExportIndexSnapshotStub = nil
+	if fake.exportIndexSnapshotReturnsOnCall == nil {
+		fake.exportIndexSnapshotReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.exportIndexSnapshotReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *Model) FileHistory(arg1 string, arg2 string) ([]model.FileHistoryEntry, error) {
+	fake.fileHistoryMutex.Lock()
+	ret, specificReturn := fake.fileHistoryReturnsOnCall[len(fake.fileHistoryArgsForCall)]
+	fake.fileHistoryArgsForCall = append(fake.fileHistoryArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.FileHistoryStub
+	fakeReturns := fake.fileHistoryReturns
+	fake.recordInvocation("FileHistory", []interface{}{arg1, arg2})
+	fake.fileHistoryMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *Model) FileHistoryCallCount() int {
+	fake.fileHistoryMutex.RLock()
+	defer fake.fileHistoryMutex.RUnlock()
+	return len(fake.fileHistoryArgsForCall)
+}
+
+func (fake *Model) FileHistoryCalls(stub func(string, string) ([]model.FileHistoryEntry, error)) {
+	fake.fileHistoryMutex.Lock()
+	defer fake.fileHistoryMutex.Unlock()
+	fake.FileHistoryStub = stub
+}
+
+func (fake *Model) FileHistoryArgsForCall(i int) (string, string) {
+	fake.fileHistoryMutex.RLock()
+	defer fake.fileHistoryMutex.RUnlock()
+	argsForCall := fake.fileHistoryArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *Model) FileHistoryReturns(result1 []model.FileHistoryEntry, result2 error) {
+	fake.fileHistoryMutex.Lock()
+	defer fake.fileHistoryMutex.Unlock()
+	fake.FileHistoryStub = nil
+	fake.fileHistoryReturns = struct {
+		result1 []model.FileHistoryEntry
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *Model) FileHistoryReturnsOnCall(i int, result1 []model.FileHistoryEntry, result2 error) {
+	fake.fileHistoryMutex.Lock()
+	defer fake.fileHistoryMutex.Unlock()
+	fake.FileHistoryStub = nil
+	if fake.fileHistoryReturnsOnCall == nil {
+		fake.fileHistoryReturnsOnCall = make(map[int]struct {
+			result1 []model.FileHistoryEntry
+			result2 error
+		})
+	}
+	fake.fileHistoryReturnsOnCall[i] = struct {
+		result1 []model.FileHistoryEntry
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *Model) FolderErrorDigests(arg1 string) ([]model.FileErrorDigest, error) {
+	fake.folderErrorDigestsMutex.Lock()
+	ret, specificReturn := fake.folderErrorDigestsReturnsOnCall[len(fake.folderErrorDigestsArgsForCall)]
+	fake.folderErrorDigestsArgsForCall = append(fake.folderErrorDigestsArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.FolderErrorDigestsStub
+	fakeReturns := fake.folderErrorDigestsReturns
+	fake.recordInvocation("FolderErrorDigests", []interface{}{arg1})
+	fake.folderErrorDigestsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *Model) FolderErrorDigestsCallCount() int {
+	fake.folderErrorDigestsMutex.RLock()
+	defer fake.folderErrorDigestsMutex.RUnlock()
+	return len(fake.folderErrorDigestsArgsForCall)
+}
+
+func (fake *Model) FolderErrorDigestsCalls(stub func(string) ([]model.FileErrorDigest, error)) {
+	fake.folderErrorDigestsMutex.Lock()
+	defer fake.folderErrorDigestsMutex.Unlock()
+	fake.FolderErrorDigestsStub = stub
+}
+
+func (fake *Model) FolderErrorDigestsArgsForCall(i int) string {
+	fake.folderErrorDigestsMutex.RLock()
+	defer fake.folderErrorDigestsMutex.RUnlock()
+	argsForCall := fake.folderErrorDigestsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *Model) FolderErrorDigestsReturns(result1 []model.FileErrorDigest, result2 error) {
+	fake.folderErrorDigestsMutex.Lock()
+	defer fake.folderErrorDigestsMutex.Unlock()
+	fake.FolderErrorDigestsStub = nil
+	fake.folderErrorDigestsReturns = struct {
+		result1 []model.FileErrorDigest
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *Model) FolderErrorDigestsReturnsOnCall(i int, result1 []model.FileErrorDigest, result2 error) {
+	fake.folderErrorDigestsMutex.Lock()
+	defer fake.folderErrorDigestsMutex.Unlock()
+	fake.FolderErrorDigestsStub = nil
+	if fake.folderErrorDigestsReturnsOnCall == nil {
+		fake.folderErrorDigestsReturnsOnCall = make(map[int]struct {
+			result1 []model.FileErrorDigest
+			result2 error
+		})
+	}
+	fake.folderErrorDigestsReturnsOnCall[i] = struct {
+		result1 []model.FileErrorDigest
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *Model) FolderErrors(arg1 string) ([]model.FileError, error) {
+	fake.folderErrorsMutex.Lock()
+	ret, specificReturn := fake.folderErrorsReturnsOnCall[len(fake.folderErrorsArgsForCall)]
+	fake.folderErrorsArgsForCall = append(fake.folderErrorsArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.FolderErrorsStub
+	fakeReturns := fake.folderErrorsReturns
+	fake.recordInvocation("FolderErrors", []interface{}{arg1})
+	fake.folderErrorsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *Model) FolderErrorsCallCount() int {
+	fake.folderErrorsMutex.RLock()
+	defer fake.folderErrorsMutex.RUnlock()
+	return len(fake.folderErrorsArgsForCall)
+}
+
+func (fake *Model) FolderErrorsCalls(stub func(string) ([]model.FileError, error)) {
+	fake.folderErrorsMutex.Lock()
+	defer fake.folderErrorsMutex.Unlock()
+	fake.FolderErrorsStub = stub
+}
+
+func (fake *Model) FolderErrorsArgsForCall(i int) string {
+	fake.folderErrorsMutex.RLock()
+	defer fake.folderErrorsMutex.RUnlock()
+	argsForCall := fake.folderErrorsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *Model) FolderErrorsReturns(result1 []model.FileError, result2 error) {
+	fake.folderErrorsMutex.Lock()
+	defer fake.folderErrorsMutex.Unlock()
+	fake.FolderErrorsStub = nil
+	fake.folderErrorsReturns = struct {
+		result1 []model.FileError
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *Model) FolderErrorsReturnsOnCall(i int, result1 []model.FileError, result2 error) {
+	fake.folderErrorsMutex.Lock()
+	defer fake.folderErrorsMutex.Unlock()
+	fake.FolderErrorsStub = nil
+	if fake.folderErrorsReturnsOnCall == nil {
+		fake.folderErrorsReturnsOnCall = make(map[int]struct {
+			result1 []model.FileError
+			result2 error
+		})
+	}
+	fake.folderErrorsReturnsOnCall[i] = struct {
+		result1 []model.FileError
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *Model) FolderFreezes() []model.FolderFreeze {
+	fake.folderFreezesMutex.Lock()
+	ret, specificReturn := fake.folderFreezesReturnsOnCall[len(fake.folderFreezesArgsForCall)]
+	fake.folderFreezesArgsForCall = append(fake.folderFreezesArgsForCall, struct {
+	}{})
+	stub := fake.FolderFreezesStub
+	fakeReturns := fake.folderFreezesReturns
+	fake.recordInvocation("FolderFreezes", []interface{}{})
+	fake.folderFreezesMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *Model) FolderFreezesCallCount() int {
+	fake.folderFreezesMutex.RLock()
+	defer fake.folderFreezesMutex.RUnlock()
+	return len(fake.folderFreezesArgsForCall)
+}
+
+func (fake *Model) FolderFreezesCalls(stub func() []model.FolderFreeze) {
+	fake.folderFreezesMutex.Lock()
+	defer fake.folderFreezesMutex.Unlock()
+	fake.FolderFreezesStub = stub
+}
+
+func (fake *Model) FolderFreezesReturns(result1 []model.FolderFreeze) {
+	fake.folderFreezesMutex.Lock()
+	defer fake.folderFreezesMutex.Unlock()
+	fake.FolderFreezesStub = nil
+	fake.folderFreezesReturns = struct {
+		result1 []model.FolderFreeze
+	}{result1}
+}
+
+func (fake *Model) FolderFreezesReturnsOnCall(i int, result1 []model.FolderFreeze) {
+	fake.folderFreezesMutex.Lock()
+	defer fake.folderFreezesMutex.Unlock()
+	fake.FolderFreezesStub = nil
+	if fake.folderFreezesReturnsOnCall == nil {
+		fake.folderFreezesReturnsOnCall = make(map[int]struct {
+			result1 []model.FolderFreeze
+		})
+	}
+	fake.folderFreezesReturnsOnCall[i] = struct {
+		result1 []model.FolderFreeze
+	}{result1}
+}
+
+func (fake *Model) FolderProgressBytesCompleted(arg1 string) int64 {
+	fake.folderProgressBytesCompletedMutex.Lock()
+	ret, specificReturn := fake.folderProgressBytesCompletedReturnsOnCall[len(fake.folderProgressBytesCompletedArgsForCall)]
+	fake.folderProgressBytesCompletedArgsForCall = append(fake.folderProgressBytesCompletedArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.FolderProgressBytesCompletedStub
+	fakeReturns := fake.folderProgressBytesCompletedReturns
+	fake.recordInvocation("FolderProgressBytesCompleted", []interface{}{arg1})
+	fake.folderProgressBytesCompletedMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *Model) FolderProgressBytesCompletedCallCount() int {
+	fake.folderProgressBytesCompletedMutex.RLock()
+	defer fake.folderProgressBytesCompletedMutex.RUnlock()
+	return len(fake.folderProgressBytesCompletedArgsForCall)
+}
+
+func (fake *Model) FolderProgressBytesCompletedCalls(stub func(string) int64) {
 	fake.folderProgressBytesCompletedMutex.Lock()
 	defer fake.folderProgressBytesCompletedMutex.Unlock()
 	fake.FolderProgressBytesCompletedStub = stub
@@ -1708,6 +2942,192 @@ func (fake *Model) FolderStatisticsReturnsOnCall(i int, result1 map[string]stats
 	}{result1, result2}
 }
 
+func (fake *Model) ForwardUsageReport(arg1 protocol.DeviceID, arg2 []byte) error {
+	var arg2Copy []byte
+	if arg2 != nil {
+		arg2Copy = make([]byte, len(arg2))
+		copy(arg2Copy, arg2)
+	}
+	fake.forwardUsageReportMutex.Lock()
+	ret, specificReturn := fake.forwardUsageReportReturnsOnCall[len(fake.forwardUsageReportArgsForCall)]
+	fake.forwardUsageReportArgsForCall = append(fake.forwardUsageReportArgsForCall, struct {
+		arg1 protocol.DeviceID
+		arg2 []byte
+	}{arg1, arg2Copy})
+	stub := fake.ForwardUsageReportStub
+	fakeReturns := fake.forwardUsageReportReturns
+	fake.recordInvocation("ForwardUsageReport", []interface{}{arg1, arg2Copy})
+	fake.forwardUsageReportMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *Model) ForwardUsageReportCallCount() int {
+	fake.forwardUsageReportMutex.RLock()
+	defer fake.forwardUsageReportMutex.RUnlock()
+	return len(fake.forwardUsageReportArgsForCall)
+}
+
+func (fake *Model) ForwardUsageReportCalls(stub func(protocol.DeviceID, []byte) error) {
+	fake.forwardUsageReportMutex.Lock()
+	defer fake.forwardUsageReportMutex.Unlock()
+	fake.ForwardUsageReportStub = stub
+}
+
+func (fake *Model) ForwardUsageReportArgsForCall(i int) (protocol.DeviceID, []byte) {
+	fake.forwardUsageReportMutex.RLock()
+	defer fake.forwardUsageReportMutex.RUnlock()
+	argsForCall := fake.forwardUsageReportArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *Model) ForwardUsageReportReturns(result1 error) {
+	fake.forwardUsageReportMutex.Lock()
+	defer fake.forwardUsageReportMutex.Unlock()
+	fake.ForwardUsageReportStub = nil
+	fake.forwardUsageReportReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *Model) ForwardUsageReportReturnsOnCall(i int, result1 error) {
+	fake.forwardUsageReportMutex.Lock()
+	defer fake.forwardUsageReportMutex.Unlock()
+	fake.ForwardUsageReportStub = nil
+	if fake.forwardUsageReportReturnsOnCall == nil {
+		fake.forwardUsageReportReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.forwardUsageReportReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *Model) ForwardedUsageReports() []ur.ForwardedReport {
+	fake.forwardedUsageReportsMutex.Lock()
+	ret, specificReturn := fake.forwardedUsageReportsReturnsOnCall[len(fake.forwardedUsageReportsArgsForCall)]
+	fake.forwardedUsageReportsArgsForCall = append(fake.forwardedUsageReportsArgsForCall, struct {
+	}{})
+	stub := fake.ForwardedUsageReportsStub
+	fakeReturns := fake.forwardedUsageReportsReturns
+	fake.recordInvocation("ForwardedUsageReports", []interface{}{})
+	fake.forwardedUsageReportsMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *Model) ForwardedUsageReportsCallCount() int {
+	fake.forwardedUsageReportsMutex.RLock()
+	defer fake.forwardedUsageReportsMutex.RUnlock()
+	return len(fake.forwardedUsageReportsArgsForCall)
+}
+
+func (fake *Model) ForwardedUsageReportsCalls(stub func() []ur.ForwardedReport) {
+	fake.forwardedUsageReportsMutex.Lock()
+	defer fake.forwardedUsageReportsMutex.Unlock()
+	fake.ForwardedUsageReportsStub = stub
+}
+
+func (fake *Model) ForwardedUsageReportsReturns(result1 []ur.ForwardedReport) {
+	fake.forwardedUsageReportsMutex.Lock()
+	defer fake.forwardedUsageReportsMutex.Unlock()
+	fake.ForwardedUsageReportsStub = nil
+	fake.forwardedUsageReportsReturns = struct {
+		result1 []ur.ForwardedReport
+	}{result1}
+}
+
+func (fake *Model) ForwardedUsageReportsReturnsOnCall(i int, result1 []ur.ForwardedReport) {
+	fake.forwardedUsageReportsMutex.Lock()
+	defer fake.forwardedUsageReportsMutex.Unlock()
+	fake.ForwardedUsageReportsStub = nil
+	if fake.forwardedUsageReportsReturnsOnCall == nil {
+		fake.forwardedUsageReportsReturnsOnCall = make(map[int]struct {
+			result1 []ur.ForwardedReport
+		})
+	}
+	fake.forwardedUsageReportsReturnsOnCall[i] = struct {
+		result1 []ur.ForwardedReport
+	}{result1}
+}
+
+func (fake *Model) FreezeFolder(arg1 string, arg2 string, arg3 time.Time) (model.FolderFreeze, error) {
+	fake.freezeFolderMutex.Lock()
+	ret, specificReturn := fake.freezeFolderReturnsOnCall[len(fake.freezeFolderArgsForCall)]
+	fake.freezeFolderArgsForCall = append(fake.freezeFolderArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 time.Time
+	}{arg1, arg2, arg3})
+	stub := fake.FreezeFolderStub
+	fakeReturns := fake.freezeFolderReturns
+	fake.recordInvocation("FreezeFolder", []interface{}{arg1, arg2, arg3})
+	fake.freezeFolderMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *Model) FreezeFolderCallCount() int {
+	fake.freezeFolderMutex.RLock()
+	defer fake.freezeFolderMutex.RUnlock()
+	return len(fake.freezeFolderArgsForCall)
+}
+
+func (fake *Model) FreezeFolderCalls(stub func(string, string, time.Time) (model.FolderFreeze, error)) {
+	fake.freezeFolderMutex.Lock()
+	defer fake.freezeFolderMutex.Unlock()
+	fake.FreezeFolderStub = stub
+}
+
+func (fake *Model) FreezeFolderArgsForCall(i int) (string, string, time.Time) {
+	fake.freezeFolderMutex.RLock()
+	defer fake.freezeFolderMutex.RUnlock()
+	argsForCall := fake.freezeFolderArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *Model) FreezeFolderReturns(result1 model.FolderFreeze, result2 error) {
+	fake.freezeFolderMutex.Lock()
+	defer fake.freezeFolderMutex.Unlock()
+	fake.FreezeFolderStub = nil
+	fake.freezeFolderReturns = struct {
+		result1 model.FolderFreeze
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *Model) FreezeFolderReturnsOnCall(i int, result1 model.FolderFreeze, result2 error) {
+	fake.freezeFolderMutex.Lock()
+	defer fake.freezeFolderMutex.Unlock()
+	fake.FreezeFolderStub = nil
+	if fake.freezeFolderReturnsOnCall == nil {
+		fake.freezeFolderReturnsOnCall = make(map[int]struct {
+			result1 model.FolderFreeze
+			result2 error
+		})
+	}
+	fake.freezeFolderReturnsOnCall[i] = struct {
+		result1 model.FolderFreeze
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *Model) GetFolderVersions(arg1 string) (map[string][]versioner.FileVersion, error) {
 	fake.getFolderVersionsMutex.Lock()
 	ret, specificReturn := fake.getFolderVersionsReturnsOnCall[len(fake.getFolderVersionsArgsForCall)]
@@ -1878,28 +3298,93 @@ func (fake *Model) GlobalDirectoryTreeArgsForCall(i int) (string, string, int, b
 	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
 }
 
-func (fake *Model) GlobalDirectoryTreeReturns(result1 []*model.TreeEntry, result2 error) {
-	fake.globalDirectoryTreeMutex.Lock()
-	defer fake.globalDirectoryTreeMutex.Unlock()
-	fake.GlobalDirectoryTreeStub = nil
-	fake.globalDirectoryTreeReturns = struct {
-		result1 []*model.TreeEntry
+func (fake *Model) GlobalDirectoryTreeReturns(result1 []*model.TreeEntry, result2 error) {
+	fake.globalDirectoryTreeMutex.Lock()
+	defer fake.globalDirectoryTreeMutex.Unlock()
+	fake.GlobalDirectoryTreeStub = nil
+	fake.globalDirectoryTreeReturns = struct {
+		result1 []*model.TreeEntry
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *Model) GlobalDirectoryTreeReturnsOnCall(i int, result1 []*model.TreeEntry, result2 error) {
+	fake.globalDirectoryTreeMutex.Lock()
+	defer fake.globalDirectoryTreeMutex.Unlock()
+	fake.GlobalDirectoryTreeStub = nil
+	if fake.globalDirectoryTreeReturnsOnCall == nil {
+		fake.globalDirectoryTreeReturnsOnCall = make(map[int]struct {
+			result1 []*model.TreeEntry
+			result2 error
+		})
+	}
+	fake.globalDirectoryTreeReturnsOnCall[i] = struct {
+		result1 []*model.TreeEntry
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *Model) ImportIndexSnapshot(arg1 string, arg2 io.Reader) (db.IndexSnapshotHeader, error) {
+	fake.importIndexSnapshotMutex.Lock()
+	ret, specificReturn := fake.importIndexSnapshotReturnsOnCall[len(fake.importIndexSnapshotArgsForCall)]
+	fake.importIndexSnapshotArgsForCall = append(fake.importIndexSnapshotArgsForCall, struct {
+		arg1 string
+		arg2 io.Reader
+	}{arg1, arg2})
+	stub := fake.ImportIndexSnapshotStub
+	fakeReturns := fake.importIndexSnapshotReturns
+	fake.recordInvocation("ImportIndexSnapshot", []interface{}{arg1, arg2})
+	fake.importIndexSnapshotMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *Model) ImportIndexSnapshotCallCount() int {
+	fake.importIndexSnapshotMutex.RLock()
+	defer fake.importIndexSnapshotMutex.RUnlock()
+	return len(fake.importIndexSnapshotArgsForCall)
+}
+
+func (fake *Model) ImportIndexSnapshotCalls(stub func(string, io.Reader) (db.IndexSnapshotHeader, error)) {
+	fake.importIndexSnapshotMutex.Lock()
+	defer fake.importIndexSnapshotMutex.Unlock()
+	fake.ImportIndexSnapshotStub = stub
+}
+
+func (fake *Model) ImportIndexSnapshotArgsForCall(i int) (string, io.Reader) {
+	fake.importIndexSnapshotMutex.RLock()
+	defer fake.importIndexSnapshotMutex.RUnlock()
+	argsForCall := fake.importIndexSnapshotArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *Model) ImportIndexSnapshotReturns(result1 db.IndexSnapshotHeader, result2 error) {
+	fake.importIndexSnapshotMutex.Lock()
+	defer fake.importIndexSnapshotMutex.Unlock()
+	fake.ImportIndexSnapshotStub = nil
+	fake.importIndexSnapshotReturns = struct {
+		result1 db.IndexSnapshotHeader
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *Model) GlobalDirectoryTreeReturnsOnCall(i int, result1 []*model.TreeEntry, result2 error) {
-	fake.globalDirectoryTreeMutex.Lock()
-	defer fake.globalDirectoryTreeMutex.Unlock()
-	fake.GlobalDirectoryTreeStub = nil
-	if fake.globalDirectoryTreeReturnsOnCall == nil {
-		fake.globalDirectoryTreeReturnsOnCall = make(map[int]struct {
-			result1 []*model.TreeEntry
+func (fake *Model) ImportIndexSnapshotReturnsOnCall(i int, result1 db.IndexSnapshotHeader, result2 error) {
+	fake.importIndexSnapshotMutex.Lock()
+	defer fake.importIndexSnapshotMutex.Unlock()
+	fake.ImportIndexSnapshotStub = nil
+	if fake.importIndexSnapshotReturnsOnCall == nil {
+		fake.importIndexSnapshotReturnsOnCall = make(map[int]struct {
+			result1 db.IndexSnapshotHeader
 			result2 error
 		})
 	}
-	fake.globalDirectoryTreeReturnsOnCall[i] = struct {
-		result1 []*model.TreeEntry
+	fake.importIndexSnapshotReturnsOnCall[i] = struct {
+		result1 db.IndexSnapshotHeader
 		result2 error
 	}{result1, result2}
 }
@@ -1966,6 +3451,68 @@ func (fake *Model) IndexReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *Model) IndexAck(arg1 protocol.Connection, arg2 *protocol.IndexAck) error {
+	fake.indexAckMutex.Lock()
+	ret, specificReturn := fake.indexAckReturnsOnCall[len(fake.indexAckArgsForCall)]
+	fake.indexAckArgsForCall = append(fake.indexAckArgsForCall, struct {
+		arg1 protocol.Connection
+		arg2 *protocol.IndexAck
+	}{arg1, arg2})
+	stub := fake.IndexAckStub
+	fakeReturns := fake.indexAckReturns
+	fake.recordInvocation("IndexAck", []interface{}{arg1, arg2})
+	fake.indexAckMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *Model) IndexAckCallCount() int {
+	fake.indexAckMutex.RLock()
+	defer fake.indexAckMutex.RUnlock()
+	return len(fake.indexAckArgsForCall)
+}
+
+func (fake *Model) IndexAckCalls(stub func(protocol.Connection, *protocol.IndexAck) error) {
+	fake.indexAckMutex.Lock()
+	defer fake.indexAckMutex.Unlock()
+	fake.IndexAckStub = stub
+}
+
+func (fake *Model) IndexAckArgsForCall(i int) (protocol.Connection, *protocol.IndexAck) {
+	fake.indexAckMutex.RLock()
+	defer fake.indexAckMutex.RUnlock()
+	argsForCall := fake.indexAckArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *Model) IndexAckReturns(result1 error) {
+	fake.indexAckMutex.Lock()
+	defer fake.indexAckMutex.Unlock()
+	fake.IndexAckStub = nil
+	fake.indexAckReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *Model) IndexAckReturnsOnCall(i int, result1 error) {
+	fake.indexAckMutex.Lock()
+	defer fake.indexAckMutex.Unlock()
+	fake.IndexAckStub = nil
+	if fake.indexAckReturnsOnCall == nil {
+		fake.indexAckReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.indexAckReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *Model) IndexUpdate(arg1 protocol.Connection, arg2 *protocol.IndexUpdate) error {
 	fake.indexUpdateMutex.Lock()
 	ret, specificReturn := fake.indexUpdateReturnsOnCall[len(fake.indexUpdateArgsForCall)]
@@ -2328,6 +3875,59 @@ func (fake *Model) OverrideArgsForCall(i int) string {
 	return argsForCall.arg1
 }
 
+func (fake *Model) PendingConfigPushes() []model.PendingConfigPush {
+	fake.pendingConfigPushesMutex.Lock()
+	ret, specificReturn := fake.pendingConfigPushesReturnsOnCall[len(fake.pendingConfigPushesArgsForCall)]
+	fake.pendingConfigPushesArgsForCall = append(fake.pendingConfigPushesArgsForCall, struct {
+	}{})
+	stub := fake.PendingConfigPushesStub
+	fakeReturns := fake.pendingConfigPushesReturns
+	fake.recordInvocation("PendingConfigPushes", []interface{}{})
+	fake.pendingConfigPushesMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *Model) PendingConfigPushesCallCount() int {
+	fake.pendingConfigPushesMutex.RLock()
+	defer fake.pendingConfigPushesMutex.RUnlock()
+	return len(fake.pendingConfigPushesArgsForCall)
+}
+
+func (fake *Model) PendingConfigPushesCalls(stub func() []model.PendingConfigPush) {
+	fake.pendingConfigPushesMutex.Lock()
+	defer fake.pendingConfigPushesMutex.Unlock()
+	fake.PendingConfigPushesStub = stub
+}
+
+func (fake *Model) PendingConfigPushesReturns(result1 []model.PendingConfigPush) {
+	fake.pendingConfigPushesMutex.Lock()
+	defer fake.pendingConfigPushesMutex.Unlock()
+	fake.PendingConfigPushesStub = nil
+	fake.pendingConfigPushesReturns = struct {
+		result1 []model.PendingConfigPush
+	}{result1}
+}
+
+func (fake *Model) PendingConfigPushesReturnsOnCall(i int, result1 []model.PendingConfigPush) {
+	fake.pendingConfigPushesMutex.Lock()
+	defer fake.pendingConfigPushesMutex.Unlock()
+	fake.PendingConfigPushesStub = nil
+	if fake.pendingConfigPushesReturnsOnCall == nil {
+		fake.pendingConfigPushesReturnsOnCall = make(map[int]struct {
+			result1 []model.PendingConfigPush
+		})
+	}
+	fake.pendingConfigPushesReturnsOnCall[i] = struct {
+		result1 []model.PendingConfigPush
+	}{result1}
+}
+
 func (fake *Model) PendingDevices() (map[protocol.DeviceID]db.ObservedDevice, error) {
 	fake.pendingDevicesMutex.Lock()
 	ret, specificReturn := fake.pendingDevicesReturnsOnCall[len(fake.pendingDevicesArgsForCall)]
@@ -2448,6 +4048,329 @@ func (fake *Model) PendingFoldersReturnsOnCall(i int, result1 map[string]db.Pend
 	}{result1, result2}
 }
 
+func (fake *Model) PullPriorities(arg1 string) ([]model.PullPriority, error) {
+	fake.pullPrioritiesMutex.Lock()
+	ret, specificReturn := fake.pullPrioritiesReturnsOnCall[len(fake.pullPrioritiesArgsForCall)]
+	fake.pullPrioritiesArgsForCall = append(fake.pullPrioritiesArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.PullPrioritiesStub
+	fakeReturns := fake.pullPrioritiesReturns
+	fake.recordInvocation("PullPriorities", []interface{}{arg1})
+	fake.pullPrioritiesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *Model) PullPrioritiesCallCount() int {
+	fake.pullPrioritiesMutex.RLock()
+	defer fake.pullPrioritiesMutex.RUnlock()
+	return len(fake.pullPrioritiesArgsForCall)
+}
+
+func (fake *Model) PullPrioritiesCalls(stub func(string) ([]model.PullPriority, error)) {
+	fake.pullPrioritiesMutex.Lock()
+	defer fake.pullPrioritiesMutex.Unlock()
+	fake.PullPrioritiesStub = stub
+}
+
+func (fake *Model) PullPrioritiesArgsForCall(i int) string {
+	fake.pullPrioritiesMutex.RLock()
+	defer fake.pullPrioritiesMutex.RUnlock()
+	argsForCall := fake.pullPrioritiesArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *Model) PullPrioritiesReturns(result1 []model.PullPriority, result2 error) {
+	fake.pullPrioritiesMutex.Lock()
+	defer fake.pullPrioritiesMutex.Unlock()
+	fake.PullPrioritiesStub = nil
+	fake.pullPrioritiesReturns = struct {
+		result1 []model.PullPriority
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *Model) PullPrioritiesReturnsOnCall(i int, result1 []model.PullPriority, result2 error) {
+	fake.pullPrioritiesMutex.Lock()
+	defer fake.pullPrioritiesMutex.Unlock()
+	fake.PullPrioritiesStub = nil
+	if fake.pullPrioritiesReturnsOnCall == nil {
+		fake.pullPrioritiesReturnsOnCall = make(map[int]struct {
+			result1 []model.PullPriority
+			result2 error
+		})
+	}
+	fake.pullPrioritiesReturnsOnCall[i] = struct {
+		result1 []model.PullPriority
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *Model) PullQueue(arg1 string, arg2 int, arg3 int) (model.PullQueue, error) {
+	fake.pullQueueMutex.Lock()
+	ret, specificReturn := fake.pullQueueReturnsOnCall[len(fake.pullQueueArgsForCall)]
+	fake.pullQueueArgsForCall = append(fake.pullQueueArgsForCall, struct {
+		arg1 string
+		arg2 int
+		arg3 int
+	}{arg1, arg2, arg3})
+	stub := fake.PullQueueStub
+	fakeReturns := fake.pullQueueReturns
+	fake.recordInvocation("PullQueue", []interface{}{arg1, arg2, arg3})
+	fake.pullQueueMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *Model) PullQueueCallCount() int {
+	fake.pullQueueMutex.RLock()
+	defer fake.pullQueueMutex.RUnlock()
+	return len(fake.pullQueueArgsForCall)
+}
+
+func (fake *Model) PullQueueCalls(stub func(string, int, int) (model.PullQueue, error)) {
+	fake.pullQueueMutex.Lock()
+	defer fake.pullQueueMutex.Unlock()
+	fake.PullQueueStub = stub
+}
+
+func (fake *Model) PullQueueArgsForCall(i int) (string, int, int) {
+	fake.pullQueueMutex.RLock()
+	defer fake.pullQueueMutex.RUnlock()
+	argsForCall := fake.pullQueueArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *Model) PullQueueReturns(result1 model.PullQueue, result2 error) {
+	fake.pullQueueMutex.Lock()
+	defer fake.pullQueueMutex.Unlock()
+	fake.PullQueueStub = nil
+	fake.pullQueueReturns = struct {
+		result1 model.PullQueue
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *Model) PullQueueReturnsOnCall(i int, result1 model.PullQueue, result2 error) {
+	fake.pullQueueMutex.Lock()
+	defer fake.pullQueueMutex.Unlock()
+	fake.PullQueueStub = nil
+	if fake.pullQueueReturnsOnCall == nil {
+		fake.pullQueueReturnsOnCall = make(map[int]struct {
+			result1 model.PullQueue
+			result2 error
+		})
+	}
+	fake.pullQueueReturnsOnCall[i] = struct {
+		result1 model.PullQueue
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *Model) PushConfig(arg1 protocol.DeviceID, arg2 model.PushedConfig) (int64, error) {
+	fake.pushConfigMutex.Lock()
+	ret, specificReturn := fake.pushConfigReturnsOnCall[len(fake.pushConfigArgsForCall)]
+	fake.pushConfigArgsForCall = append(fake.pushConfigArgsForCall, struct {
+		arg1 protocol.DeviceID
+		arg2 model.PushedConfig
+	}{arg1, arg2})
+	stub := fake.PushConfigStub
+	fakeReturns := fake.pushConfigReturns
+	fake.recordInvocation("PushConfig", []interface{}{arg1, arg2})
+	fake.pushConfigMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *Model) PushConfigCallCount() int {
+	fake.pushConfigMutex.RLock()
+	defer fake.pushConfigMutex.RUnlock()
+	return len(fake.pushConfigArgsForCall)
+}
+
+func (fake *Model) PushConfigCalls(stub func(protocol.DeviceID, model.PushedConfig) (int64, error)) {
+	fake.pushConfigMutex.Lock()
+	defer fake.pushConfigMutex.Unlock()
+	fake.PushConfigStub = stub
+}
+
+func (fake *Model) PushConfigArgsForCall(i int) (protocol.DeviceID, model.PushedConfig) {
+	fake.pushConfigMutex.RLock()
+	defer fake.pushConfigMutex.RUnlock()
+	argsForCall := fake.pushConfigArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *Model) PushConfigReturns(result1 int64, result2 error) {
+	fake.pushConfigMutex.Lock()
+	defer fake.pushConfigMutex.Unlock()
+	fake.PushConfigStub = nil
+	fake.pushConfigReturns = struct {
+		result1 int64
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *Model) PushConfigReturnsOnCall(i int, result1 int64, result2 error) {
+	fake.pushConfigMutex.Lock()
+	defer fake.pushConfigMutex.Unlock()
+	fake.PushConfigStub = nil
+	if fake.pushConfigReturnsOnCall == nil {
+		fake.pushConfigReturnsOnCall = make(map[int]struct {
+			result1 int64
+			result2 error
+		})
+	}
+	fake.pushConfigReturnsOnCall[i] = struct {
+		result1 int64
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *Model) PushToBack(arg1 string, arg2 string) error {
+	fake.pushToBackMutex.Lock()
+	ret, specificReturn := fake.pushToBackReturnsOnCall[len(fake.pushToBackArgsForCall)]
+	fake.pushToBackArgsForCall = append(fake.pushToBackArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.PushToBackStub
+	fakeReturns := fake.pushToBackReturns
+	fake.recordInvocation("PushToBack", []interface{}{arg1, arg2})
+	fake.pushToBackMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *Model) PushToBackCallCount() int {
+	fake.pushToBackMutex.RLock()
+	defer fake.pushToBackMutex.RUnlock()
+	return len(fake.pushToBackArgsForCall)
+}
+
+func (fake *Model) PushToBackCalls(stub func(string, string) error) {
+	fake.pushToBackMutex.Lock()
+	defer fake.pushToBackMutex.Unlock()
+	fake.PushToBackStub = stub
+}
+
+func (fake *Model) PushToBackArgsForCall(i int) (string, string) {
+	fake.pushToBackMutex.RLock()
+	defer fake.pushToBackMutex.RUnlock()
+	argsForCall := fake.pushToBackArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *Model) PushToBackReturns(result1 error) {
+	fake.pushToBackMutex.Lock()
+	defer fake.pushToBackMutex.Unlock()
+	fake.PushToBackStub = nil
+	fake.pushToBackReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *Model) PushToBackReturnsOnCall(i int, result1 error) {
+	fake.pushToBackMutex.Lock()
+	defer fake.pushToBackMutex.Unlock()
+	fake.PushToBackStub = nil
+	if fake.pushToBackReturnsOnCall == nil {
+		fake.pushToBackReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.pushToBackReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *Model) ReceiveOnlyChanges(arg1 string, arg2 int, arg3 int) ([]model.ReceiveOnlyChange, error) {
+	fake.receiveOnlyChangesMutex.Lock()
+	ret, specificReturn := fake.receiveOnlyChangesReturnsOnCall[len(fake.receiveOnlyChangesArgsForCall)]
+	fake.receiveOnlyChangesArgsForCall = append(fake.receiveOnlyChangesArgsForCall, struct {
+		arg1 string
+		arg2 int
+		arg3 int
+	}{arg1, arg2, arg3})
+	stub := fake.ReceiveOnlyChangesStub
+	fakeReturns := fake.receiveOnlyChangesReturns
+	fake.recordInvocation("ReceiveOnlyChanges", []interface{}{arg1, arg2, arg3})
+	fake.receiveOnlyChangesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *Model) ReceiveOnlyChangesCallCount() int {
+	fake.receiveOnlyChangesMutex.RLock()
+	defer fake.receiveOnlyChangesMutex.RUnlock()
+	return len(fake.receiveOnlyChangesArgsForCall)
+}
+
+func (fake *Model) ReceiveOnlyChangesCalls(stub func(string, int, int) ([]model.ReceiveOnlyChange, error)) {
+	fake.receiveOnlyChangesMutex.Lock()
+	defer fake.receiveOnlyChangesMutex.Unlock()
+	fake.ReceiveOnlyChangesStub = stub
+}
+
+func (fake *Model) ReceiveOnlyChangesArgsForCall(i int) (string, int, int) {
+	fake.receiveOnlyChangesMutex.RLock()
+	defer fake.receiveOnlyChangesMutex.RUnlock()
+	argsForCall := fake.receiveOnlyChangesArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *Model) ReceiveOnlyChangesReturns(result1 []model.ReceiveOnlyChange, result2 error) {
+	fake.receiveOnlyChangesMutex.Lock()
+	defer fake.receiveOnlyChangesMutex.Unlock()
+	fake.ReceiveOnlyChangesStub = nil
+	fake.receiveOnlyChangesReturns = struct {
+		result1 []model.ReceiveOnlyChange
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *Model) ReceiveOnlyChangesReturnsOnCall(i int, result1 []model.ReceiveOnlyChange, result2 error) {
+	fake.receiveOnlyChangesMutex.Lock()
+	defer fake.receiveOnlyChangesMutex.Unlock()
+	fake.ReceiveOnlyChangesStub = nil
+	if fake.receiveOnlyChangesReturnsOnCall == nil {
+		fake.receiveOnlyChangesReturnsOnCall = make(map[int]struct {
+			result1 []model.ReceiveOnlyChange
+			result2 error
+		})
+	}
+	fake.receiveOnlyChangesReturnsOnCall[i] = struct {
+		result1 []model.ReceiveOnlyChange
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *Model) RemoteNeedFolderFiles(arg1 string, arg2 protocol.DeviceID, arg3 int, arg4 int) ([]db.FileInfoTruncated, error) {
 	fake.remoteNeedFolderFilesMutex.Lock()
 	ret, specificReturn := fake.remoteNeedFolderFilesReturnsOnCall[len(fake.remoteNeedFolderFilesArgsForCall)]
@@ -2705,16 +4628,79 @@ func (fake *Model) ResetFolderReturns(result1 error) {
 	}{result1}
 }
 
-func (fake *Model) ResetFolderReturnsOnCall(i int, result1 error) {
-	fake.resetFolderMutex.Lock()
-	defer fake.resetFolderMutex.Unlock()
-	fake.ResetFolderStub = nil
-	if fake.resetFolderReturnsOnCall == nil {
-		fake.resetFolderReturnsOnCall = make(map[int]struct {
+func (fake *Model) ResetFolderReturnsOnCall(i int, result1 error) {
+	fake.resetFolderMutex.Lock()
+	defer fake.resetFolderMutex.Unlock()
+	fake.ResetFolderStub = nil
+	if fake.resetFolderReturnsOnCall == nil {
+		fake.resetFolderReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.resetFolderReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *Model) ResolveConflict(arg1 string, arg2 string, arg3 bool) error {
+	fake.resolveConflictMutex.Lock()
+	ret, specificReturn := fake.resolveConflictReturnsOnCall[len(fake.resolveConflictArgsForCall)]
+	fake.resolveConflictArgsForCall = append(fake.resolveConflictArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 bool
+	}{arg1, arg2, arg3})
+	stub := fake.ResolveConflictStub
+	fakeReturns := fake.resolveConflictReturns
+	fake.recordInvocation("ResolveConflict", []interface{}{arg1, arg2, arg3})
+	fake.resolveConflictMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *Model) ResolveConflictCallCount() int {
+	fake.resolveConflictMutex.RLock()
+	defer fake.resolveConflictMutex.RUnlock()
+	return len(fake.resolveConflictArgsForCall)
+}
+
+func (fake *Model) ResolveConflictCalls(stub func(string, string, bool) error) {
+	fake.resolveConflictMutex.Lock()
+	defer fake.resolveConflictMutex.Unlock()
+	fake.ResolveConflictStub = stub
+}
+
+func (fake *Model) ResolveConflictArgsForCall(i int) (string, string, bool) {
+	fake.resolveConflictMutex.RLock()
+	defer fake.resolveConflictMutex.RUnlock()
+	argsForCall := fake.resolveConflictArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *Model) ResolveConflictReturns(result1 error) {
+	fake.resolveConflictMutex.Lock()
+	defer fake.resolveConflictMutex.Unlock()
+	fake.ResolveConflictStub = nil
+	fake.resolveConflictReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *Model) ResolveConflictReturnsOnCall(i int, result1 error) {
+	fake.resolveConflictMutex.Lock()
+	defer fake.resolveConflictMutex.Unlock()
+	fake.ResolveConflictStub = nil
+	if fake.resolveConflictReturnsOnCall == nil {
+		fake.resolveConflictReturnsOnCall = make(map[int]struct {
 			result1 error
 		})
 	}
-	fake.resetFolderReturnsOnCall[i] = struct {
+	fake.resolveConflictReturnsOnCall[i] = struct {
 		result1 error
 	}{result1}
 }
@@ -2816,6 +4802,73 @@ func (fake *Model) RevertArgsForCall(i int) string {
 	return argsForCall.arg1
 }
 
+func (fake *Model) RevertPaths(arg1 string, arg2 []string) error {
+	var arg2Copy []string
+	if arg2 != nil {
+		arg2Copy = make([]string, len(arg2))
+		copy(arg2Copy, arg2)
+	}
+	fake.revertPathsMutex.Lock()
+	ret, specificReturn := fake.revertPathsReturnsOnCall[len(fake.revertPathsArgsForCall)]
+	fake.revertPathsArgsForCall = append(fake.revertPathsArgsForCall, struct {
+		arg1 string
+		arg2 []string
+	}{arg1, arg2Copy})
+	stub := fake.RevertPathsStub
+	fakeReturns := fake.revertPathsReturns
+	fake.recordInvocation("RevertPaths", []interface{}{arg1, arg2Copy})
+	fake.revertPathsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *Model) RevertPathsCallCount() int {
+	fake.revertPathsMutex.RLock()
+	defer fake.revertPathsMutex.RUnlock()
+	return len(fake.revertPathsArgsForCall)
+}
+
+func (fake *Model) RevertPathsCalls(stub func(string, []string) error) {
+	fake.revertPathsMutex.Lock()
+	defer fake.revertPathsMutex.Unlock()
+	fake.RevertPathsStub = stub
+}
+
+func (fake *Model) RevertPathsArgsForCall(i int) (string, []string) {
+	fake.revertPathsMutex.RLock()
+	defer fake.revertPathsMutex.RUnlock()
+	argsForCall := fake.revertPathsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *Model) RevertPathsReturns(result1 error) {
+	fake.revertPathsMutex.Lock()
+	defer fake.revertPathsMutex.Unlock()
+	fake.RevertPathsStub = nil
+	fake.revertPathsReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *Model) RevertPathsReturnsOnCall(i int, result1 error) {
+	fake.revertPathsMutex.Lock()
+	defer fake.revertPathsMutex.Unlock()
+	fake.RevertPathsStub = nil
+	if fake.revertPathsReturnsOnCall == nil {
+		fake.revertPathsReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.revertPathsReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *Model) ScanFolder(arg1 string) error {
 	fake.scanFolderMutex.Lock()
 	ret, specificReturn := fake.scanFolderReturnsOnCall[len(fake.scanFolderArgsForCall)]
@@ -3125,6 +5178,184 @@ func (fake *Model) SetIgnoresReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *Model) SetPullPriority(arg1 string, arg2 string, arg3 int) error {
+	fake.setPullPriorityMutex.Lock()
+	ret, specificReturn := fake.setPullPriorityReturnsOnCall[len(fake.setPullPriorityArgsForCall)]
+	fake.setPullPriorityArgsForCall = append(fake.setPullPriorityArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 int
+	}{arg1, arg2, arg3})
+	stub := fake.SetPullPriorityStub
+	fakeReturns := fake.setPullPriorityReturns
+	fake.recordInvocation("SetPullPriority", []interface{}{arg1, arg2, arg3})
+	fake.setPullPriorityMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *Model) SetPullPriorityCallCount() int {
+	fake.setPullPriorityMutex.RLock()
+	defer fake.setPullPriorityMutex.RUnlock()
+	return len(fake.setPullPriorityArgsForCall)
+}
+
+func (fake *Model) SetPullPriorityCalls(stub func(string, string, int) error) {
+	fake.setPullPriorityMutex.Lock()
+	defer fake.setPullPriorityMutex.Unlock()
+	fake.SetPullPriorityStub = stub
+}
+
+func (fake *Model) SetPullPriorityArgsForCall(i int) (string, string, int) {
+	fake.setPullPriorityMutex.RLock()
+	defer fake.setPullPriorityMutex.RUnlock()
+	argsForCall := fake.setPullPriorityArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *Model) SetPullPriorityReturns(result1 error) {
+	fake.setPullPriorityMutex.Lock()
+	defer fake.setPullPriorityMutex.Unlock()
+	fake.SetPullPriorityStub = nil
+	fake.setPullPriorityReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *Model) SetPullPriorityReturnsOnCall(i int, result1 error) {
+	fake.setPullPriorityMutex.Lock()
+	defer fake.setPullPriorityMutex.Unlock()
+	fake.SetPullPriorityStub = nil
+	if fake.setPullPriorityReturnsOnCall == nil {
+		fake.setPullPriorityReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.setPullPriorityReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *Model) SkipPull(arg1 string, arg2 string) error {
+	fake.skipPullMutex.Lock()
+	ret, specificReturn := fake.skipPullReturnsOnCall[len(fake.skipPullArgsForCall)]
+	fake.skipPullArgsForCall = append(fake.skipPullArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.SkipPullStub
+	fakeReturns := fake.skipPullReturns
+	fake.recordInvocation("SkipPull", []interface{}{arg1, arg2})
+	fake.skipPullMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *Model) SkipPullCallCount() int {
+	fake.skipPullMutex.RLock()
+	defer fake.skipPullMutex.RUnlock()
+	return len(fake.skipPullArgsForCall)
+}
+
+func (fake *Model) SkipPullCalls(stub func(string, string) error) {
+	fake.skipPullMutex.Lock()
+	defer fake.skipPullMutex.Unlock()
+	fake.SkipPullStub = stub
+}
+
+func (fake *Model) SkipPullArgsForCall(i int) (string, string) {
+	fake.skipPullMutex.RLock()
+	defer fake.skipPullMutex.RUnlock()
+	argsForCall := fake.skipPullArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *Model) SkipPullReturns(result1 error) {
+	fake.skipPullMutex.Lock()
+	defer fake.skipPullMutex.Unlock()
+	fake.SkipPullStub = nil
+	fake.skipPullReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *Model) SkipPullReturnsOnCall(i int, result1 error) {
+	fake.skipPullMutex.Lock()
+	defer fake.skipPullMutex.Unlock()
+	fake.SkipPullStub = nil
+	if fake.skipPullReturnsOnCall == nil {
+		fake.skipPullReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.skipPullReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *Model) StartupStatus() model.StartupStatus {
+	fake.startupStatusMutex.Lock()
+	ret, specificReturn := fake.startupStatusReturnsOnCall[len(fake.startupStatusArgsForCall)]
+	fake.startupStatusArgsForCall = append(fake.startupStatusArgsForCall, struct {
+	}{})
+	stub := fake.StartupStatusStub
+	fakeReturns := fake.startupStatusReturns
+	fake.recordInvocation("StartupStatus", []interface{}{})
+	fake.startupStatusMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *Model) StartupStatusCallCount() int {
+	fake.startupStatusMutex.RLock()
+	defer fake.startupStatusMutex.RUnlock()
+	return len(fake.startupStatusArgsForCall)
+}
+
+func (fake *Model) StartupStatusCalls(stub func() model.StartupStatus) {
+	fake.startupStatusMutex.Lock()
+	defer fake.startupStatusMutex.Unlock()
+	fake.StartupStatusStub = stub
+}
+
+func (fake *Model) StartupStatusReturns(result1 model.StartupStatus) {
+	fake.startupStatusMutex.Lock()
+	defer fake.startupStatusMutex.Unlock()
+	fake.StartupStatusStub = nil
+	fake.startupStatusReturns = struct {
+		result1 model.StartupStatus
+	}{result1}
+}
+
+func (fake *Model) StartupStatusReturnsOnCall(i int, result1 model.StartupStatus) {
+	fake.startupStatusMutex.Lock()
+	defer fake.startupStatusMutex.Unlock()
+	fake.StartupStatusStub = nil
+	if fake.startupStatusReturnsOnCall == nil {
+		fake.startupStatusReturnsOnCall = make(map[int]struct {
+			result1 model.StartupStatus
+		})
+	}
+	fake.startupStatusReturnsOnCall[i] = struct {
+		result1 model.StartupStatus
+	}{result1}
+}
+
 func (fake *Model) State(arg1 string) (string, time.Time, error) {
 	fake.stateMutex.Lock()
 	ret, specificReturn := fake.stateReturnsOnCall[len(fake.stateArgsForCall)]
@@ -3192,6 +5423,235 @@ func (fake *Model) StateReturnsOnCall(i int, result1 string, result2 time.Time,
 	}{result1, result2, result3}
 }
 
+func (fake *Model) TrafficStatistics() []model.TrafficStatistics {
+	fake.trafficStatisticsMutex.Lock()
+	ret, specificReturn := fake.trafficStatisticsReturnsOnCall[len(fake.trafficStatisticsArgsForCall)]
+	fake.trafficStatisticsArgsForCall = append(fake.trafficStatisticsArgsForCall, struct {
+	}{})
+	stub := fake.TrafficStatisticsStub
+	fakeReturns := fake.trafficStatisticsReturns
+	fake.recordInvocation("TrafficStatistics", []interface{}{})
+	fake.trafficStatisticsMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *Model) TrafficStatisticsCallCount() int {
+	fake.trafficStatisticsMutex.RLock()
+	defer fake.trafficStatisticsMutex.RUnlock()
+	return len(fake.trafficStatisticsArgsForCall)
+}
+
+func (fake *Model) TrafficStatisticsCalls(stub func() []model.TrafficStatistics) {
+	fake.trafficStatisticsMutex.Lock()
+	defer fake.trafficStatisticsMutex.Unlock()
+	fake.TrafficStatisticsStub = stub
+}
+
+func (fake *Model) TrafficStatisticsReturns(result1 []model.TrafficStatistics) {
+	fake.trafficStatisticsMutex.Lock()
+	defer fake.trafficStatisticsMutex.Unlock()
+	fake.TrafficStatisticsStub = nil
+	fake.trafficStatisticsReturns = struct {
+		result1 []model.TrafficStatistics
+	}{result1}
+}
+
+func (fake *Model) TrafficStatisticsReturnsOnCall(i int, result1 []model.TrafficStatistics) {
+	fake.trafficStatisticsMutex.Lock()
+	defer fake.trafficStatisticsMutex.Unlock()
+	fake.TrafficStatisticsStub = nil
+	if fake.trafficStatisticsReturnsOnCall == nil {
+		fake.trafficStatisticsReturnsOnCall = make(map[int]struct {
+			result1 []model.TrafficStatistics
+		})
+	}
+	fake.trafficStatisticsReturnsOnCall[i] = struct {
+		result1 []model.TrafficStatistics
+	}{result1}
+}
+
+func (fake *Model) TransferStatistics() []model.TransferStatistics {
+	fake.transferStatisticsMutex.Lock()
+	ret, specificReturn := fake.transferStatisticsReturnsOnCall[len(fake.transferStatisticsArgsForCall)]
+	fake.transferStatisticsArgsForCall = append(fake.transferStatisticsArgsForCall, struct {
+	}{})
+	stub := fake.TransferStatisticsStub
+	fakeReturns := fake.transferStatisticsReturns
+	fake.recordInvocation("TransferStatistics", []interface{}{})
+	fake.transferStatisticsMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *Model) TransferStatisticsCallCount() int {
+	fake.transferStatisticsMutex.RLock()
+	defer fake.transferStatisticsMutex.RUnlock()
+	return len(fake.transferStatisticsArgsForCall)
+}
+
+func (fake *Model) TransferStatisticsCalls(stub func() []model.TransferStatistics) {
+	fake.transferStatisticsMutex.Lock()
+	defer fake.transferStatisticsMutex.Unlock()
+	fake.TransferStatisticsStub = stub
+}
+
+func (fake *Model) TransferStatisticsReturns(result1 []model.TransferStatistics) {
+	fake.transferStatisticsMutex.Lock()
+	defer fake.transferStatisticsMutex.Unlock()
+	fake.TransferStatisticsStub = nil
+	fake.transferStatisticsReturns = struct {
+		result1 []model.TransferStatistics
+	}{result1}
+}
+
+func (fake *Model) TransferStatisticsReturnsOnCall(i int, result1 []model.TransferStatistics) {
+	fake.transferStatisticsMutex.Lock()
+	defer fake.transferStatisticsMutex.Unlock()
+	fake.TransferStatisticsStub = nil
+	if fake.transferStatisticsReturnsOnCall == nil {
+		fake.transferStatisticsReturnsOnCall = make(map[int]struct {
+			result1 []model.TransferStatistics
+		})
+	}
+	fake.transferStatisticsReturnsOnCall[i] = struct {
+		result1 []model.TransferStatistics
+	}{result1}
+}
+
+func (fake *Model) UnfreezeFolder(arg1 string) error {
+	fake.unfreezeFolderMutex.Lock()
+	ret, specificReturn := fake.unfreezeFolderReturnsOnCall[len(fake.unfreezeFolderArgsForCall)]
+	fake.unfreezeFolderArgsForCall = append(fake.unfreezeFolderArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.UnfreezeFolderStub
+	fakeReturns := fake.unfreezeFolderReturns
+	fake.recordInvocation("UnfreezeFolder", []interface{}{arg1})
+	fake.unfreezeFolderMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *Model) UnfreezeFolderCallCount() int {
+	fake.unfreezeFolderMutex.RLock()
+	defer fake.unfreezeFolderMutex.RUnlock()
+	return len(fake.unfreezeFolderArgsForCall)
+}
+
+func (fake *Model) UnfreezeFolderCalls(stub func(string) error) {
+	fake.unfreezeFolderMutex.Lock()
+	defer fake.unfreezeFolderMutex.Unlock()
+	fake.UnfreezeFolderStub = stub
+}
+
+func (fake *Model) UnfreezeFolderArgsForCall(i int) string {
+	fake.unfreezeFolderMutex.RLock()
+	defer fake.unfreezeFolderMutex.RUnlock()
+	argsForCall := fake.unfreezeFolderArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *Model) UnfreezeFolderReturns(result1 error) {
+	fake.unfreezeFolderMutex.Lock()
+	defer fake.unfreezeFolderMutex.Unlock()
+	fake.UnfreezeFolderStub = nil
+	fake.unfreezeFolderReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *Model) UnfreezeFolderReturnsOnCall(i int, result1 error) {
+	fake.unfreezeFolderMutex.Lock()
+	defer fake.unfreezeFolderMutex.Unlock()
+	fake.UnfreezeFolderStub = nil
+	if fake.unfreezeFolderReturnsOnCall == nil {
+		fake.unfreezeFolderReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.unfreezeFolderReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *Model) UsageReport(arg1 protocol.Connection, arg2 *protocol.UsageReport) error {
+	fake.usageReportMutex.Lock()
+	ret, specificReturn := fake.usageReportReturnsOnCall[len(fake.usageReportArgsForCall)]
+	fake.usageReportArgsForCall = append(fake.usageReportArgsForCall, struct {
+		arg1 protocol.Connection
+		arg2 *protocol.UsageReport
+	}{arg1, arg2})
+	stub := fake.UsageReportStub
+	fakeReturns := fake.usageReportReturns
+	fake.recordInvocation("UsageReport", []interface{}{arg1, arg2})
+	fake.usageReportMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *Model) UsageReportCallCount() int {
+	fake.usageReportMutex.RLock()
+	defer fake.usageReportMutex.RUnlock()
+	return len(fake.usageReportArgsForCall)
+}
+
+func (fake *Model) UsageReportCalls(stub func(protocol.Connection, *protocol.UsageReport) error) {
+	fake.usageReportMutex.Lock()
+	defer fake.usageReportMutex.Unlock()
+	fake.UsageReportStub = stub
+}
+
+func (fake *Model) UsageReportArgsForCall(i int) (protocol.Connection, *protocol.UsageReport) {
+	fake.usageReportMutex.RLock()
+	defer fake.usageReportMutex.RUnlock()
+	argsForCall := fake.usageReportArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *Model) UsageReportReturns(result1 error) {
+	fake.usageReportMutex.Lock()
+	defer fake.usageReportMutex.Unlock()
+	fake.UsageReportStub = nil
+	fake.usageReportReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *Model) UsageReportReturnsOnCall(i int, result1 error) {
+	fake.usageReportMutex.Lock()
+	defer fake.usageReportMutex.Unlock()
+	fake.UsageReportStub = nil
+	if fake.usageReportReturnsOnCall == nil {
+		fake.usageReportReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.usageReportReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *Model) UsageReportingStats(arg1 *contract.Report, arg2 int, arg3 bool) {
 	fake.usageReportingStatsMutex.Lock()
 	fake.usageReportingStatsArgsForCall = append(fake.usageReportingStatsArgsForCall, struct {
@@ -3226,6 +5686,62 @@ func (fake *Model) UsageReportingStatsArgsForCall(i int) (*contract.Report, int,
 	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
 }
 
+func (fake *Model) VerifyDatabase() (db.VerifyResult, error) {
+	fake.verifyDatabaseMutex.Lock()
+	ret, specificReturn := fake.verifyDatabaseReturnsOnCall[len(fake.verifyDatabaseArgsForCall)]
+	fake.verifyDatabaseArgsForCall = append(fake.verifyDatabaseArgsForCall, struct {
+	}{})
+	stub := fake.VerifyDatabaseStub
+	fakeReturns := fake.verifyDatabaseReturns
+	fake.recordInvocation("VerifyDatabase", []interface{}{})
+	fake.verifyDatabaseMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *Model) VerifyDatabaseCallCount() int {
+	fake.verifyDatabaseMutex.RLock()
+	defer fake.verifyDatabaseMutex.RUnlock()
+	return len(fake.verifyDatabaseArgsForCall)
+}
+
+func (fake *Model) VerifyDatabaseCalls(stub func() (db.VerifyResult, error)) {
+	fake.verifyDatabaseMutex.Lock()
+	defer fake.verifyDatabaseMutex.Unlock()
+	fake.VerifyDatabaseStub = stub
+}
+
+func (fake *Model) VerifyDatabaseReturns(result1 db.VerifyResult, result2 error) {
+	fake.verifyDatabaseMutex.Lock()
+	defer fake.verifyDatabaseMutex.Unlock()
+	fake.VerifyDatabaseStub = nil
+	fake.verifyDatabaseReturns = struct {
+		result1 db.VerifyResult
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *Model) VerifyDatabaseReturnsOnCall(i int, result1 db.VerifyResult, result2 error) {
+	fake.verifyDatabaseMutex.Lock()
+	defer fake.verifyDatabaseMutex.Unlock()
+	fake.VerifyDatabaseStub = nil
+	if fake.verifyDatabaseReturnsOnCall == nil {
+		fake.verifyDatabaseReturnsOnCall = make(map[int]struct {
+			result1 db.VerifyResult
+			result2 error
+		})
+	}
+	fake.verifyDatabaseReturnsOnCall[i] = struct {
+		result1 db.VerifyResult
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *Model) WatchError(arg1 string) error {
 	fake.watchErrorMutex.Lock()
 	ret, specificReturn := fake.watchErrorReturnsOnCall[len(fake.watchErrorArgsForCall)]
@@ -3290,18 +5806,32 @@ func (fake *Model) WatchErrorReturnsOnCall(i int, result1 error) {
 func (fake *Model) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
+	fake.acceptConfigPushMutex.RLock()
+	defer fake.acceptConfigPushMutex.RUnlock()
 	fake.addConnectionMutex.RLock()
 	defer fake.addConnectionMutex.RUnlock()
 	fake.availabilityMutex.RLock()
 	defer fake.availabilityMutex.RUnlock()
+	fake.blockSourceStatisticsMutex.RLock()
+	defer fake.blockSourceStatisticsMutex.RUnlock()
 	fake.bringToFrontMutex.RLock()
 	defer fake.bringToFrontMutex.RUnlock()
+	fake.cleanFolderMutex.RLock()
+	defer fake.cleanFolderMutex.RUnlock()
 	fake.closedMutex.RLock()
 	defer fake.closedMutex.RUnlock()
 	fake.clusterConfigMutex.RLock()
 	defer fake.clusterConfigMutex.RUnlock()
+	fake.clusterTopologyMutex.RLock()
+	defer fake.clusterTopologyMutex.RUnlock()
+	fake.compactDatabaseMutex.RLock()
+	defer fake.compactDatabaseMutex.RUnlock()
 	fake.completionMutex.RLock()
 	defer fake.completionMutex.RUnlock()
+	fake.configPushMutex.RLock()
+	defer fake.configPushMutex.RUnlock()
+	fake.conflictsMutex.RLock()
+	defer fake.conflictsMutex.RUnlock()
 	fake.connectedToMutex.RLock()
 	defer fake.connectedToMutex.RUnlock()
 	fake.connectionStatsMutex.RLock()
@@ -3318,26 +5848,50 @@ func (fake *Model) Invocations() map[string][][]interface{} {
 	defer fake.delayScanMutex.RUnlock()
 	fake.deviceStatisticsMutex.RLock()
 	defer fake.deviceStatisticsMutex.RUnlock()
+	fake.dismissConfigPushMutex.RLock()
+	defer fake.dismissConfigPushMutex.RUnlock()
+	fake.dismissForwardedUsageReportMutex.RLock()
+	defer fake.dismissForwardedUsageReportMutex.RUnlock()
 	fake.dismissPendingDeviceMutex.RLock()
 	defer fake.dismissPendingDeviceMutex.RUnlock()
 	fake.dismissPendingFolderMutex.RLock()
 	defer fake.dismissPendingFolderMutex.RUnlock()
 	fake.downloadProgressMutex.RLock()
 	defer fake.downloadProgressMutex.RUnlock()
+	fake.dumpIndexMutex.RLock()
+	defer fake.dumpIndexMutex.RUnlock()
+	fake.exportIndexSnapshotMutex.RLock()
+	defer fake.exportIndexSnapshotMutex.RUnlock()
+	fake.fileHistoryMutex.RLock()
+	defer fake.fileHistoryMutex.RUnlock()
+	fake.folderErrorDigestsMutex.RLock()
+	defer fake.folderErrorDigestsMutex.RUnlock()
 	fake.folderErrorsMutex.RLock()
 	defer fake.folderErrorsMutex.RUnlock()
+	fake.folderFreezesMutex.RLock()
+	defer fake.folderFreezesMutex.RUnlock()
 	fake.folderProgressBytesCompletedMutex.RLock()
 	defer fake.folderProgressBytesCompletedMutex.RUnlock()
 	fake.folderStatisticsMutex.RLock()
 	defer fake.folderStatisticsMutex.RUnlock()
+	fake.forwardUsageReportMutex.RLock()
+	defer fake.forwardUsageReportMutex.RUnlock()
+	fake.forwardedUsageReportsMutex.RLock()
+	defer fake.forwardedUsageReportsMutex.RUnlock()
+	fake.freezeFolderMutex.RLock()
+	defer fake.freezeFolderMutex.RUnlock()
 	fake.getFolderVersionsMutex.RLock()
 	defer fake.getFolderVersionsMutex.RUnlock()
 	fake.getMtimeMappingMutex.RLock()
 	defer fake.getMtimeMappingMutex.RUnlock()
 	fake.globalDirectoryTreeMutex.RLock()
 	defer fake.globalDirectoryTreeMutex.RUnlock()
+	fake.importIndexSnapshotMutex.RLock()
+	defer fake.importIndexSnapshotMutex.RUnlock()
 	fake.indexMutex.RLock()
 	defer fake.indexMutex.RUnlock()
+	fake.indexAckMutex.RLock()
+	defer fake.indexAckMutex.RUnlock()
 	fake.indexUpdateMutex.RLock()
 	defer fake.indexUpdateMutex.RUnlock()
 	fake.loadIgnoresMutex.RLock()
@@ -3350,10 +5904,22 @@ func (fake *Model) Invocations() map[string][][]interface{} {
 	defer fake.onHelloMutex.RUnlock()
 	fake.overrideMutex.RLock()
 	defer fake.overrideMutex.RUnlock()
+	fake.pendingConfigPushesMutex.RLock()
+	defer fake.pendingConfigPushesMutex.RUnlock()
 	fake.pendingDevicesMutex.RLock()
 	defer fake.pendingDevicesMutex.RUnlock()
 	fake.pendingFoldersMutex.RLock()
 	defer fake.pendingFoldersMutex.RUnlock()
+	fake.pullPrioritiesMutex.RLock()
+	defer fake.pullPrioritiesMutex.RUnlock()
+	fake.pullQueueMutex.RLock()
+	defer fake.pullQueueMutex.RUnlock()
+	fake.pushConfigMutex.RLock()
+	defer fake.pushConfigMutex.RUnlock()
+	fake.pushToBackMutex.RLock()
+	defer fake.pushToBackMutex.RUnlock()
+	fake.receiveOnlyChangesMutex.RLock()
+	defer fake.receiveOnlyChangesMutex.RUnlock()
 	fake.remoteNeedFolderFilesMutex.RLock()
 	defer fake.remoteNeedFolderFilesMutex.RUnlock()
 	fake.requestMutex.RLock()
@@ -3362,10 +5928,14 @@ func (fake *Model) Invocations() map[string][][]interface{} {
 	defer fake.requestGlobalMutex.RUnlock()
 	fake.resetFolderMutex.RLock()
 	defer fake.resetFolderMutex.RUnlock()
+	fake.resolveConflictMutex.RLock()
+	defer fake.resolveConflictMutex.RUnlock()
 	fake.restoreFolderVersionsMutex.RLock()
 	defer fake.restoreFolderVersionsMutex.RUnlock()
 	fake.revertMutex.RLock()
 	defer fake.revertMutex.RUnlock()
+	fake.revertPathsMutex.RLock()
+	defer fake.revertPathsMutex.RUnlock()
 	fake.scanFolderMutex.RLock()
 	defer fake.scanFolderMutex.RUnlock()
 	fake.scanFolderSubdirsMutex.RLock()
@@ -3376,10 +5946,26 @@ func (fake *Model) Invocations() map[string][][]interface{} {
 	defer fake.serveMutex.RUnlock()
 	fake.setIgnoresMutex.RLock()
 	defer fake.setIgnoresMutex.RUnlock()
+	fake.setPullPriorityMutex.RLock()
+	defer fake.setPullPriorityMutex.RUnlock()
+	fake.skipPullMutex.RLock()
+	defer fake.skipPullMutex.RUnlock()
+	fake.startupStatusMutex.RLock()
+	defer fake.startupStatusMutex.RUnlock()
 	fake.stateMutex.RLock()
 	defer fake.stateMutex.RUnlock()
+	fake.trafficStatisticsMutex.RLock()
+	defer fake.trafficStatisticsMutex.RUnlock()
+	fake.transferStatisticsMutex.RLock()
+	defer fake.transferStatisticsMutex.RUnlock()
+	fake.unfreezeFolderMutex.RLock()
+	defer fake.unfreezeFolderMutex.RUnlock()
+	fake.usageReportMutex.RLock()
+	defer fake.usageReportMutex.RUnlock()
 	fake.usageReportingStatsMutex.RLock()
 	defer fake.usageReportingStatsMutex.RUnlock()
+	fake.verifyDatabaseMutex.RLock()
+	defer fake.verifyDatabaseMutex.RUnlock()
 	fake.watchErrorMutex.RLock()
 	defer fake.watchErrorMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
@@ -21,6 +21,7 @@ import (
 	"path/filepath"
 	"reflect"
 	"runtime"
+	"slices"
 	"strings"
 	stdsync "sync"
 	"sync/atomic"
@@ -37,12 +38,12 @@ import (
 	"github.com/syncthing/syncthing/lib/ignore"
 	"github.com/syncthing/syncthing/lib/osutil"
 	"github.com/syncthing/syncthing/lib/protocol"
-	"github.com/syncthing/syncthing/lib/rand"
 	"github.com/syncthing/syncthing/lib/scanner"
 	"github.com/syncthing/syncthing/lib/semaphore"
 	"github.com/syncthing/syncthing/lib/stats"
 	"github.com/syncthing/syncthing/lib/svcutil"
 	"github.com/syncthing/syncthing/lib/sync"
+	"github.com/syncthing/syncthing/lib/ur"
 	"github.com/syncthing/syncthing/lib/ur/contract"
 	"github.com/syncthing/syncthing/lib/versioner"
 )
@@ -50,17 +51,28 @@ import (
 type service interface {
 	suture.Service
 	BringToFront(string)
+	PushToBack(string)
+	SkipPull(string) bool // false if the file isn't queued
+	SkippedPulls() []string
+	SetPullPriority(pattern string, priority int) error
+	PullPriorities() []PullPriority
+	CancelSupersededPulls(files []protocol.FileInfo) // files were updated remotely, stop pulling obsolete versions
 	Override()
 	Revert()
+	RevertPaths(paths []string) error
 	DelayScan(d time.Duration)
 	ScheduleScan()
 	SchedulePull()                                    // something relevant changed, we should try a pull
 	Jobs(page, perpage int) ([]string, []string, int) // In progress, Queued, skipped
+	JobCount() int                                    // In progress and queued
 	Scan(subs []string) error
 	Errors() []FileError
+	ErrorDigests() []FileErrorDigest
 	WatchError() error
 	ScheduleForceRescan(path string)
 	GetStatistics() (stats.FolderStatistics, error)
+	ResolveConflict(name string, keepConflict bool) error
+	Clean(opts CleanOptions) (CleanResult, error)
 
 	getState() (folderState, time.Time, error)
 }
@@ -82,18 +94,34 @@ type Model interface {
 	ScanFolderSubdirs(folder string, subs []string) error
 	State(folder string) (string, time.Time, error)
 	FolderErrors(folder string) ([]FileError, error)
+	FolderErrorDigests(folder string) ([]FileErrorDigest, error)
 	WatchError(folder string) error
 	Override(folder string)
 	Revert(folder string)
+	RevertPaths(folder string, paths []string) error
+	ReceiveOnlyChanges(folder string, page, perpage int) ([]ReceiveOnlyChange, error)
 	BringToFront(folder, file string)
+	PushToBack(folder, file string) error
+	SkipPull(folder, file string) error
+	PullQueue(folder string, page, perpage int) (PullQueue, error)
+	SetPullPriority(folder, pattern string, priority int) error
+	PullPriorities(folder string) ([]PullPriority, error)
 	LoadIgnores(folder string) ([]string, []string, error)
 	CurrentIgnores(folder string) ([]string, []string, error)
 	SetIgnores(folder string, content []string) error
 
 	GetFolderVersions(folder string) (map[string][]versioner.FileVersion, error)
+	Conflicts(folder string) ([]Conflict, error)
+	ResolveConflict(folder, name string, keepConflict bool) error
+	CleanFolder(folder string, opts CleanOptions) (CleanResult, error)
 	RestoreFolderVersions(folder string, versions map[string]time.Time) (map[string]error, error)
 
 	DBSnapshot(folder string) (*db.Snapshot, error)
+	ExportIndexSnapshot(folder string, w io.Writer) error
+	ImportIndexSnapshot(folder string, r io.Reader) (db.IndexSnapshotHeader, error)
+	DumpIndex(folder string, w io.Writer, format db.IndexDumpFormat) error
+	CompactDatabase() error
+	VerifyDatabase() (db.VerifyResult, error)
 	NeedFolderFiles(folder string, page, perpage int) ([]db.FileInfoTruncated, []db.FileInfoTruncated, []db.FileInfoTruncated, error)
 	RemoteNeedFolderFiles(folder string, device protocol.DeviceID, page, perpage int) ([]db.FileInfoTruncated, error)
 	LocalChangedFolderFiles(folder string, page, perpage int) ([]db.FileInfoTruncated, error)
@@ -101,14 +129,23 @@ type Model interface {
 
 	CurrentFolderFile(folder string, file string) (protocol.FileInfo, bool, error)
 	CurrentGlobalFile(folder string, file string) (protocol.FileInfo, bool, error)
+	FileHistory(folder, file string) ([]FileHistoryEntry, error)
 	GetMtimeMapping(folder string, file string) (fs.MtimeMapping, error)
 	Availability(folder string, file protocol.FileInfo, block protocol.BlockInfo) ([]Availability, error)
 
 	Completion(device protocol.DeviceID, folder string) (FolderCompletion, error)
 	ConnectionStats() map[string]interface{}
 	DeviceStatistics() (map[protocol.DeviceID]stats.DeviceStatistics, error)
+	ClusterTopology() ClusterTopology
 	FolderStatistics() (map[string]stats.FolderStatistics, error)
+	TransferStatistics() []TransferStatistics
+	TrafficStatistics() []TrafficStatistics
+	BlockSourceStatistics() map[protocol.DeviceID]BlockSourceStats
 	UsageReportingStats(report *contract.Report, version int, preview bool)
+	StartupStatus() StartupStatus
+	ForwardUsageReport(device protocol.DeviceID, report []byte) error
+	ForwardedUsageReports() []ur.ForwardedReport
+	DismissForwardedUsageReport(device protocol.DeviceID, received time.Time)
 	ConnectedTo(remoteID protocol.DeviceID) bool
 
 	PendingDevices() (map[protocol.DeviceID]db.ObservedDevice, error)
@@ -116,6 +153,15 @@ type Model interface {
 	DismissPendingDevice(device protocol.DeviceID) error
 	DismissPendingFolder(device protocol.DeviceID, folder string) error
 
+	PushConfig(device protocol.DeviceID, cfg PushedConfig) (int64, error)
+	PendingConfigPushes() []PendingConfigPush
+	AcceptConfigPush(device protocol.DeviceID, id int64) error
+	DismissConfigPush(device protocol.DeviceID, id int64) error
+
+	FreezeFolder(folder, reason string, until time.Time) (FolderFreeze, error)
+	UnfreezeFolder(folder string) error
+	FolderFreezes() []FolderFreeze
+
 	GlobalDirectoryTree(folder, prefix string, levels int, dirsOnly bool) ([]*TreeEntry, error)
 
 	RequestGlobal(ctx context.Context, deviceID protocol.DeviceID, folder, name string, blockNo int, offset int64, size int, hash []byte, weakHash uint32, fromTemporary bool) ([]byte, error)
@@ -140,11 +186,19 @@ type model struct {
 	globalRequestLimiter *semaphore.Semaphore
 	// folderIOLimiter limits the number of concurrent I/O heavy operations,
 	// such as scans and pulls.
-	folderIOLimiter *semaphore.Semaphore
-	fatalChan       chan error
-	started         chan struct{}
-	keyGen          *protocol.KeyGenerator
-	promotionTimer  *time.Timer
+	folderIOLimiter   *semaphore.Semaphore
+	fatalChan         chan error
+	started           chan struct{}
+	keyGen            *protocol.KeyGenerator
+	promotionTimer    *time.Timer
+	transferQuotas    *transferQuotas
+	trafficStats      *trafficStats
+	configPushes      *configPushes
+	freezes           *folderFreezes
+	usageReports      *forwardedUsageReports
+	indexAcks         *indexAcks
+	sessionExtensions *sessionExtensions
+	startup           *startupTracker
 
 	// fields protected by mut
 	mut                            sync.RWMutex
@@ -160,11 +214,16 @@ type model struct {
 	connections                    map[string]protocol.Connection                         // connection ID -> connection
 	deviceConnIDs                  map[protocol.DeviceID][]string                         // device -> connection IDs (invariant: if the key exists, the value is len >= 1, with the primary connection at the start of the slice)
 	promotedConnID                 map[protocol.DeviceID]string                           // device -> latest promoted connection ID
+	connRequestStripes             map[protocol.DeviceID]*atomic.Uint64                   // device -> number of requests sent, for striping over connections
 	connRequestLimiters            map[protocol.DeviceID]*semaphore.Semaphore
-	closed                         map[string]chan struct{} // connection ID -> closed channel
+	connRequestWindows             map[string]*requestWindow // connection ID -> window of outgoing requests
+	closed                         map[string]chan struct{}  // connection ID -> closed channel
 	helloMessages                  map[protocol.DeviceID]protocol.Hello
+	clockOffsets                   map[protocol.DeviceID]time.Duration // device -> clock offset measured on the latest connection, kept after disconnecting
 	deviceDownloads                map[protocol.DeviceID]*deviceDownloadState
 	remoteFolderStates             map[protocol.DeviceID]map[string]remoteFolderState // deviceID -> folders
+	remoteClusterConfigs           map[protocol.DeviceID][]protocol.Folder            // deviceID -> folders in the last cluster config
+	remoteExtensions               map[protocol.DeviceID][]string                     // deviceID -> extensions in the last cluster config
 	indexHandlers                  *serviceMap[protocol.DeviceID, *indexHandlerRegistry]
 
 	// for testing only
@@ -178,12 +237,15 @@ type folderFactory func(*model, *db.FileSet, *ignore.Matcher, config.FolderConfi
 var folderFactories = make(map[config.FolderType]folderFactory)
 
 var (
-	errDeviceUnknown    = errors.New("unknown device")
-	errDevicePaused     = errors.New("device is paused")
-	ErrFolderPaused     = errors.New("folder is paused")
-	ErrFolderNotRunning = errors.New("folder is not running")
-	ErrFolderMissing    = errors.New("no such folder")
-	errNoVersioner      = errors.New("folder has no versioner")
+	errDeviceUnknown     = errors.New("unknown device")
+	errDevicePaused      = errors.New("device is paused")
+	ErrFolderPaused      = errors.New("folder is paused")
+	ErrFolderNotRunning  = errors.New("folder is not running")
+	ErrFolderMissing     = errors.New("no such folder")
+	errNoVersioner       = errors.New("folder has no versioner")
+	errNotQueued         = errors.New("file is not queued for pulling")
+	errNotReceiveOnly    = errors.New("folder is not receive only")
+	errSnapshotEncrypted = errors.New("index snapshots are not supported for encrypted folders")
 	// errors about why a connection is closed
 	errStopped                            = errors.New("Syncthing is being stopped")
 	errEncryptionInvConfigLocal           = errors.New("can't encrypt outgoing data because local data is encrypted (folder-type receive-encrypted)")
@@ -224,6 +286,13 @@ func NewModel(cfg config.Wrapper, id protocol.DeviceID, ldb *db.Lowlevel, protec
 		started:              make(chan struct{}),
 		keyGen:               keyGen,
 		promotionTimer:       time.NewTimer(0),
+		transferQuotas:       newTransferQuotas(cfg, db.NewMiscDataNamespace(ldb)),
+		trafficStats:         newTrafficStats(cfg, db.NewMiscDataNamespace(ldb)),
+		configPushes:         newConfigPushes(db.NewMiscDataNamespace(ldb)),
+		usageReports:         newForwardedUsageReports(db.NewMiscDataNamespace(ldb)),
+		indexAcks:            newIndexAcks(db.NewMiscDataNamespace(ldb)),
+		sessionExtensions:    newSessionExtensions(db.NewMiscDataNamespace(ldb)),
+		startup:              newStartupTracker(),
 
 		// fields protected by mut
 		mut:                            sync.NewRWMutex(),
@@ -238,13 +307,19 @@ func NewModel(cfg config.Wrapper, id protocol.DeviceID, ldb *db.Lowlevel, protec
 		connections:                    make(map[string]protocol.Connection),
 		deviceConnIDs:                  make(map[protocol.DeviceID][]string),
 		promotedConnID:                 make(map[protocol.DeviceID]string),
+		connRequestStripes:             make(map[protocol.DeviceID]*atomic.Uint64),
 		connRequestLimiters:            make(map[protocol.DeviceID]*semaphore.Semaphore),
+		connRequestWindows:             make(map[string]*requestWindow),
 		closed:                         make(map[string]chan struct{}),
 		helloMessages:                  make(map[protocol.DeviceID]protocol.Hello),
+		clockOffsets:                   make(map[protocol.DeviceID]time.Duration),
 		deviceDownloads:                make(map[protocol.DeviceID]*deviceDownloadState),
 		remoteFolderStates:             make(map[protocol.DeviceID]map[string]remoteFolderState),
+		remoteClusterConfigs:           make(map[protocol.DeviceID][]protocol.Folder),
+		remoteExtensions:               make(map[protocol.DeviceID][]string),
 		indexHandlers:                  newServiceMap[protocol.DeviceID, *indexHandlerRegistry](evLogger),
 	}
+	m.freezes = newFolderFreezes(db.NewMiscDataNamespace(ldb), m.folderThawed)
 	for devID, cfg := range cfg.Devices() {
 		m.deviceStatRefs[devID] = stats.NewDeviceStatisticsReference(m.db, devID)
 		m.setConnRequestLimitersLocked(cfg)
@@ -252,6 +327,9 @@ func NewModel(cfg config.Wrapper, id protocol.DeviceID, ldb *db.Lowlevel, protec
 	m.Add(m.folderRunners)
 	m.Add(m.progressEmitter)
 	m.Add(m.indexHandlers)
+	m.Add(svcutil.AsService(m.transferQuotas.serve, "transferQuotas"))
+	m.Add(svcutil.AsService(m.trafficStats.serve, "trafficStats"))
+	m.Add(svcutil.AsService(m.freezes.serve, "folderFreezes"))
 	m.Add(svcutil.AsService(m.serve, m.String()))
 
 	return m
@@ -270,6 +348,10 @@ func (m *model) serve(ctx context.Context) error {
 
 	close(m.started)
 
+	staleDeviceTicker := time.NewTicker(staleDeviceCheckInterval)
+	defer staleDeviceTicker.Stop()
+	staleDevices := make(map[protocol.DeviceID]time.Time)
+
 	for {
 		select {
 		case <-ctx.Done():
@@ -281,26 +363,32 @@ func (m *model) serve(ctx context.Context) error {
 		case <-m.promotionTimer.C:
 			l.Debugln("promotion timer fired")
 			m.promoteConnections()
+		case <-staleDeviceTicker.C:
+			m.pruneStaleDevices(staleDevices)
 		}
 	}
 }
 
 func (m *model) initFolders(cfg config.Configuration) error {
 	clusterConfigDevices := make(deviceIDSet, len(cfg.Devices))
+	var folders []config.FolderConfiguration
 	for _, folderCfg := range cfg.Folders {
 		if folderCfg.Paused {
 			folderCfg.CreateRoot()
 			continue
 		}
-		err := m.newFolder(folderCfg, cfg.Options.CacheIgnoredFiles)
-		if err != nil {
-			return err
-		}
+		folders = append(folders, folderCfg)
 		clusterConfigDevices.add(folderCfg.DeviceIDs())
 	}
+	err := m.startFolders(folders, cfg.Options.MaxFolderConcurrency(), cfg.Options.CacheIgnoredFiles)
+	m.startup.setDone()
+	if err != nil {
+		return err
+	}
 
 	ignoredDevices := observedDeviceSet(m.cfg.IgnoredDevices())
 	m.cleanPending(cfg.DeviceMap(), cfg.FolderMap(), ignoredDevices, nil)
+	m.cleanPendingConfigPushes(cfg.DeviceMap())
 
 	m.sendClusterConfig(clusterConfigDevices.AsSlice())
 	return nil
@@ -328,7 +416,7 @@ func (m *model) fatal(err error) {
 
 // Need to hold lock on m.mut when calling this.
 func (m *model) addAndStartFolderLocked(cfg config.FolderConfiguration, fset *db.FileSet, cacheIgnoredFiles bool) {
-	ignores := ignore.New(cfg.Filesystem(nil), ignore.WithCache(cacheIgnoredFiles))
+	ignores := ignore.New(cfg.Filesystem(nil), ignore.WithCache(cacheIgnoredFiles), ignore.WithGitignoreSyntax(cfg.GitignoreSyntax))
 	if cfg.Type != config.FolderTypeReceiveEncrypted {
 		if err := ignores.Load(".stignore"); err != nil && !fs.IsNotExist(err) {
 			l.Warnln("Loading ignores:", err)
@@ -530,6 +618,28 @@ func (m *model) restartFolder(from, to config.FolderConfiguration, cacheIgnoredF
 	fsetNil := fset == nil
 
 	m.cleanupFolderLocked(from)
+
+	if !fsetNil {
+		for _, dev := range to.Devices {
+			if was, _ := from.Device(dev.DeviceID); dev.IndexOnly && !was.IndexOnly {
+				// Changes from index only devices are not accepted, so
+				// forget what they have sent before.
+				fset.Drop(dev.DeviceID)
+			}
+		}
+	}
+
+	if !fsetNil && from.IndexSubtree != to.IndexSubtree {
+		// What we have from other devices was filtered by the old subtree.
+		// Without it, the cluster configs we send ask for complete indexes
+		// again, filtered by the new subtree.
+		for _, id := range from.DeviceIDs() {
+			if id != m.id {
+				fset.Drop(id)
+			}
+		}
+	}
+
 	if !to.Paused {
 		if fsetNil {
 			// Create a new fset. Might take a while and we do it under
@@ -686,9 +796,11 @@ func (m *model) UsageReportingStats(report *contract.Report, version int, previe
 type ConnectionStats struct {
 	protocol.Statistics // Total for primary + secondaries
 
-	Connected     bool   `json:"connected"`
-	Paused        bool   `json:"paused"`
-	ClientVersion string `json:"clientVersion"`
+	Connected     bool     `json:"connected"`
+	Paused        bool     `json:"paused"`
+	ClientVersion string   `json:"clientVersion"`
+	Extensions    []string `json:"extensions"`   // the protocol extensions used with the device
+	ClockOffsetS  float64  `json:"clockOffsetS"` // how far the device's clock is ahead of ours, as last measured
 
 	Address string `json:"address"` // mirror values from Primary, for compatibility with <1.24.0
 	Type    string `json:"type"`    // mirror values from Primary, for compatibility with <1.24.0
@@ -701,10 +813,24 @@ type ConnectionStats struct {
 
 type ConnectionInfo struct {
 	protocol.Statistics
-	Address string `json:"address"`
-	Type    string `json:"type"`
-	IsLocal bool   `json:"isLocal"`
-	Crypto  string `json:"crypto"`
+	Address      string  `json:"address"`
+	Type         string  `json:"type"`
+	IsLocal      bool    `json:"isLocal"`
+	Crypto       string  `json:"crypto"`
+	ClockOffsetS float64 `json:"clockOffsetS"`
+}
+
+// clockOffset returns how far the clock of the device with the given short
+// ID was ahead of ours when last measured, or zero if unknown.
+func (m *model) clockOffset(short protocol.ShortID) time.Duration {
+	m.mut.RLock()
+	defer m.mut.RUnlock()
+	for device, offset := range m.clockOffsets {
+		if device.Short() == short {
+			return offset
+		}
+	}
+	return 0
 }
 
 // ConnectionStats returns a map with connection statistics for each device.
@@ -729,6 +855,8 @@ func (m *model) ConnectionStats() map[string]interface{} {
 			Connected:     ok,
 			Paused:        deviceCfg.Paused,
 			ClientVersion: strings.TrimSpace(versionString),
+			Extensions:    m.extensionsRLocked(device),
+			ClockOffsetS:  m.clockOffsets[device].Seconds(),
 		}
 		if ok {
 			conn := m.connections[connIDs[0]]
@@ -738,6 +866,7 @@ func (m *model) ConnectionStats() map[string]interface{} {
 			cs.Primary.Crypto = conn.Crypto()
 			cs.Primary.Statistics = conn.Statistics()
 			cs.Primary.Address = conn.RemoteAddr().String()
+			cs.Primary.ClockOffsetS = conn.ClockOffset().Seconds()
 
 			cs.Type = cs.Primary.Type
 			cs.IsLocal = cs.Primary.IsLocal
@@ -748,11 +877,12 @@ func (m *model) ConnectionStats() map[string]interface{} {
 			for _, connID := range connIDs[1:] {
 				conn = m.connections[connID]
 				sec := ConnectionInfo{
-					Statistics: conn.Statistics(),
-					Address:    conn.RemoteAddr().String(),
-					Type:       conn.Type(),
-					IsLocal:    conn.IsLocal(),
-					Crypto:     conn.Crypto(),
+					Statistics:   conn.Statistics(),
+					Address:      conn.RemoteAddr().String(),
+					Type:         conn.Type(),
+					IsLocal:      conn.IsLocal(),
+					Crypto:       conn.Crypto(),
+					ClockOffsetS: conn.ClockOffset().Seconds(),
 				}
 				if sec.At.After(cs.At) {
 					cs.At = sec.At
@@ -781,6 +911,22 @@ func (m *model) ConnectionStats() map[string]interface{} {
 	return res
 }
 
+// extensionsRLocked returns the protocol extensions used with the device,
+// those announced by both sides in the hello and the cluster config.
+func (m *model) extensionsRLocked(device protocol.DeviceID) []string {
+	hello, ok := m.helloMessages[device]
+	if !ok {
+		return []string{}
+	}
+	return protocol.NegotiateExtensions(hello.Extensions, m.remoteExtensions[device])
+}
+
+func (m *model) hasExtension(device protocol.DeviceID, name string) bool {
+	m.mut.RLock()
+	defer m.mut.RUnlock()
+	return slices.Contains(m.extensionsRLocked(device), name)
+}
+
 // DeviceStatistics returns statistics about each device
 func (m *model) DeviceStatistics() (map[protocol.DeviceID]stats.DeviceStatistics, error) {
 	m.mut.RLock()
@@ -801,6 +947,25 @@ func (m *model) DeviceStatistics() (map[protocol.DeviceID]stats.DeviceStatistics
 	return res, nil
 }
 
+// TransferStatistics returns the traffic per folder and device in the
+// current quota period.
+func (m *model) TransferStatistics() []TransferStatistics {
+	return m.transferQuotas.statistics()
+}
+
+// TrafficStatistics returns the traffic per device, and per device and
+// folder, since counting began.
+func (m *model) TrafficStatistics() []TrafficStatistics {
+	return m.trafficStats.statistics()
+}
+
+// BlockSourceStatistics returns how well requests to each device went
+// recently, and the resulting weight when selecting devices to pull blocks
+// from.
+func (m *model) BlockSourceStatistics() map[protocol.DeviceID]BlockSourceStats {
+	return activity.blockSourceStats()
+}
+
 // FolderStatistics returns statistics about each folder
 func (m *model) FolderStatistics() (map[string]stats.FolderStatistics, error) {
 	res := make(map[string]stats.FolderStatistics)
@@ -947,6 +1112,12 @@ func (m *model) folderCompletion(device protocol.DeviceID, folder string) (Folde
 	if need.Bytes < 0 {
 		need.Bytes = 0
 	}
+	if fcfg, ok := m.cfg.Folder(folder); ok {
+		if dev, _ := fcfg.Device(device); dev.IndexOnly {
+			// Index only devices are not expected to have the data.
+			need = db.Counts{}
+		}
+	}
 
 	comp := newFolderCompletion(snap.GlobalSize(), need, snap.Sequence(device), state)
 
@@ -966,6 +1137,91 @@ func (m *model) DBSnapshot(folder string) (*db.Snapshot, error) {
 	return rf.Snapshot()
 }
 
+// ExportIndexSnapshot writes a portable snapshot of the local index of the
+// folder to w, for seeding the index on another device.
+func (m *model) ExportIndexSnapshot(folder string, w io.Writer) error {
+	m.mut.RLock()
+	err := m.checkFolderRunningRLocked(folder)
+	cfg := m.folderCfgs[folder]
+	rf := m.folderFiles[folder]
+	m.mut.RUnlock()
+	if err != nil {
+		return err
+	}
+	if cfg.Type == config.FolderTypeReceiveEncrypted {
+		return errSnapshotEncrypted
+	}
+	return rf.ExportIndex(w, m.id, prepareFileInfoForIndex)
+}
+
+// DumpIndex writes everything the database holds for the folder to w, in
+// the given format, for debugging and auditing.
+func (m *model) DumpIndex(folder string, w io.Writer, format db.IndexDumpFormat) error {
+	m.mut.RLock()
+	err := m.checkFolderRunningRLocked(folder)
+	rf := m.folderFiles[folder]
+	m.mut.RUnlock()
+	if err != nil {
+		return err
+	}
+	return rf.DumpIndex(w, format, m.id)
+}
+
+// CompactDatabase compacts the database backend while running.
+func (m *model) CompactDatabase() error {
+	return m.db.CompactWithProgress()
+}
+
+// VerifyDatabase checks the index entries of all folders in the database
+// for corruption.
+func (m *model) VerifyDatabase() (db.VerifyResult, error) {
+	return m.db.Verify()
+}
+
+// ImportIndexSnapshot reads an index snapshot exported by another device
+// and stores it as that device's index for the folder. The device must
+// share the folder with us, not be an untrusted device, and must not
+// currently be connected.
+func (m *model) ImportIndexSnapshot(folder string, r io.Reader) (db.IndexSnapshotHeader, error) {
+	m.mut.RLock()
+	err := m.checkFolderRunningRLocked(folder)
+	cfg := m.folderCfgs[folder]
+	rf := m.folderFiles[folder]
+	m.mut.RUnlock()
+	if err != nil {
+		return db.IndexSnapshotHeader{}, err
+	}
+	if cfg.Type == config.FolderTypeReceiveEncrypted {
+		return db.IndexSnapshotHeader{}, errSnapshotEncrypted
+	}
+
+	hdr, err := rf.ImportIndex(r, func(hdr db.IndexSnapshotHeader) error {
+		if hdr.DeviceID == m.id {
+			return errors.New("index snapshot was exported by this device")
+		}
+		dev, ok := cfg.Device(hdr.DeviceID)
+		if !ok {
+			return fmt.Errorf("folder %v is not shared with device %v", cfg.Description(), hdr.DeviceID.Short())
+		}
+		if dev.EncryptionPassword != "" {
+			return errSnapshotEncrypted
+		}
+		if m.ConnectedTo(hdr.DeviceID) {
+			return fmt.Errorf("device %v is connected, disconnect it before importing", hdr.DeviceID.Short())
+		}
+		return nil
+	})
+	if err != nil {
+		return hdr, err
+	}
+
+	l.Infof("Imported index snapshot for folder %v from device %v (sequence %d)", cfg.Description(), hdr.DeviceID.Short(), hdr.Sequence)
+	if runner, ok := m.folderRunners.Get(folder); ok {
+		runner.SchedulePull()
+	}
+	return hdr, nil
+}
+
 func (m *model) FolderProgressBytesCompleted(folder string) int64 {
 	return m.progressEmitter.BytesCompleted(folder)
 }
@@ -1041,6 +1297,53 @@ func (m *model) NeedFolderFiles(folder string, page, perpage int) ([]db.FileInfo
 	return progress, queued, rest, nil
 }
 
+// PullQueue is a page of the files being pulled in a folder, and those
+// waiting in the job queue.
+type PullQueue struct {
+	Progress []db.FileInfoTruncated
+	Queued   []db.FileInfoTruncated
+	Skipped  []string // skipped for the current pull
+	Total    int      // in progress and queued
+}
+
+// PullQueue returns a paginated list of the files currently being pulled
+// and queued to be pulled, in the order they are pulled.
+func (m *model) PullQueue(folder string, page, perpage int) (PullQueue, error) {
+	m.mut.RLock()
+	err := m.checkFolderRunningRLocked(folder)
+	runner, _ := m.folderRunners.Get(folder)
+	rf := m.folderFiles[folder]
+	m.mut.RUnlock()
+	if err != nil {
+		return PullQueue{}, err
+	}
+
+	snap, err := rf.Snapshot()
+	if err != nil {
+		return PullQueue{}, err
+	}
+	defer snap.Release()
+
+	progressNames, queuedNames, _ := runner.Jobs(page, perpage)
+	res := PullQueue{
+		Progress: make([]db.FileInfoTruncated, 0, len(progressNames)),
+		Queued:   make([]db.FileInfoTruncated, 0, len(queuedNames)),
+		Skipped:  runner.SkippedPulls(),
+		Total:    runner.JobCount(),
+	}
+	for _, name := range progressNames {
+		if f, ok := snap.GetGlobalTruncated(name); ok {
+			res.Progress = append(res.Progress, f)
+		}
+	}
+	for _, name := range queuedNames {
+		if f, ok := snap.GetGlobalTruncated(name); ok {
+			res.Queued = append(res.Queued, f)
+		}
+	}
+	return res, nil
+}
+
 // RemoteNeedFolderFiles returns paginated list of currently needed files for a
 // remote device to become synced with a folder.
 func (m *model) RemoteNeedFolderFiles(folder string, device protocol.DeviceID, page, perpage int) ([]db.FileInfoTruncated, error) {
@@ -1160,6 +1463,9 @@ func (m *model) handleIndex(conn protocol.Connection, folder string, fs []protoc
 	} else if cfg.Paused {
 		l.Debugf("%v for paused folder (ID %q) sent from device %q.", op, folder, deviceID)
 		return fmt.Errorf("%s: %w", folder, ErrFolderPaused)
+	} else if dev, _ := cfg.Device(deviceID); dev.IndexOnly {
+		l.Debugf("%v for folder (ID %q) from index only device %q ignored.", op, folder, deviceID)
+		return nil
 	}
 
 	m.mut.RLock()
@@ -1203,6 +1509,17 @@ func (m *model) ClusterConfig(conn protocol.Connection, cm *protocol.ClusterConf
 	l.Debugf("Handling ClusterConfig from %v at %s", deviceID.Short(), conn)
 	indexHandlerRegistry := m.ensureIndexHandler(conn)
 
+	// Index messages are acknowledged, and index sending resumes from the
+	// acknowledged progress, with devices that support it.
+	m.mut.RLock()
+	hello := m.helloMessages[deviceID]
+	m.mut.RUnlock()
+	if slices.Contains(protocol.NegotiateExtensions(hello.Extensions, cm.Extensions), protocol.ExtensionIndexAck) {
+		indexHandlerRegistry.SetIndexAcks(m.indexAcks)
+	} else {
+		indexHandlerRegistry.SetIndexAcks(nil)
+	}
+
 	deviceCfg, ok := m.cfg.Device(deviceID)
 	if !ok {
 		l.Debugf("Device %s disappeared from config while processing cluster-config", deviceID.Short())
@@ -1249,7 +1566,7 @@ func (m *model) ClusterConfig(conn protocol.Connection, cm *protocol.ClusterConf
 			haveFcfg := cfg.FolderMap()
 			for _, folder := range cm.Folders {
 				from, ok := haveFcfg[folder.ID]
-				if to, changed := m.handleAutoAccepts(deviceID, folder, ccDeviceInfos[folder.ID], from, ok, cfg.Defaults.Folder); changed {
+				if to, changed := m.handleAutoAccepts(deviceID, folder, ccDeviceInfos[folder.ID], from, ok, cfg.Defaults.Folder, deviceCfg.Introducer); changed {
 					changedFcfg[folder.ID] = to
 				}
 			}
@@ -1280,8 +1597,12 @@ func (m *model) ClusterConfig(conn protocol.Connection, cm *protocol.ClusterConf
 
 	m.mut.Lock()
 	m.remoteFolderStates[deviceID] = states
+	m.remoteClusterConfigs[deviceID] = cm.Folders
+	m.remoteExtensions[deviceID] = cm.Extensions
 	m.mut.Unlock()
 
+	m.recordSessionExtensions(deviceID)
+
 	m.evLogger.Log(events.ClusterConfigReceived, ClusterConfigReceivedEventData{
 		Device: deviceID,
 	})
@@ -1346,7 +1667,8 @@ func (m *model) ensureIndexHandler(conn protocol.Connection) *indexHandlerRegist
 	}
 
 	// Create a new index handler for this device.
-	indexHandlerRegistry = newIndexHandlerRegistry(conn, m.deviceDownloads[deviceID], m.evLogger)
+	deviceCfg, _ := m.cfg.Device(deviceID)
+	indexHandlerRegistry = newIndexHandlerRegistry(conn, m.deviceDownloads[deviceID], deviceCfg.Compression, m.freezes, m.evLogger)
 	for id, fcfg := range m.folderCfgs {
 		l.Debugln("Registering folder", id, "for", deviceID.Short())
 		runner, _ := m.folderRunners.Get(id)
@@ -1662,13 +1984,23 @@ func (m *model) handleIntroductions(introducerCfg config.DeviceConfiguration, cm
 
 			foldersDevices.set(device.ID, folder.ID)
 
-			if _, ok := devices[device.ID]; !ok {
+			if deviceCfg, ok := devices[device.ID]; !ok {
 				// The device is currently unknown. Add it to the config.
 				devices[device.ID] = m.introduceDevice(device, introducerCfg)
-			} else if fcfg.SharedWith(device.ID) {
-				// We already share the folder with this device, so
-				// nothing to do.
-				continue
+			} else {
+				if device.ID != introducerCfg.DeviceID && len(deviceCfg.Introducers()) > 0 && deviceCfg.AddIntroduction(introducerCfg.DeviceID, time.Now().Truncate(time.Second)) {
+					// Another introducer introduced the device, record
+					// that this one vouches for it too, so that it
+					// stays as long as either of them does.
+					l.Infof("Device %v is also vouched for by introducer %v", device.ID, introducerCfg.DeviceID)
+					devices[device.ID] = deviceCfg
+					changed = true
+				}
+				if fcfg.SharedWith(device.ID) {
+					// We already share the folder with this device, so
+					// nothing to do.
+					continue
+				}
 			}
 
 			if fcfg.Type != config.FolderTypeReceiveEncrypted && device.EncryptionPasswordToken != nil {
@@ -1714,7 +2046,14 @@ func (*model) handleDeintroductions(introducerCfg config.DeviceConfiguration, fo
 			if !foldersDevices.has(folderCfg.Devices[k].DeviceID, folderCfg.ID) {
 				// We could not find that folder shared on the
 				// introducer with the device that was introduced to us.
-				// We should follow and unshare as well.
+				// We should follow and unshare as well, unless either
+				// is protected.
+				if folderCfg.Protected || devices[folderCfg.Devices[k].DeviceID].Protected {
+					// Keeping the share keeps the device as well.
+					l.Infof("Not unsharing protected folder %s with %v, though introducer %v no longer shares the folder with that device", folderCfg.Description(), folderCfg.Devices[k].DeviceID, folderCfg.Devices[k].IntroducedBy)
+					devicesNotIntroduced[folderCfg.Devices[k].DeviceID] = struct{}{}
+					continue
+				}
 				l.Infof("Unsharing folder %s with %v as introducer %v no longer shares the folder with that device", folderCfg.Description(), folderCfg.Devices[k].DeviceID, folderCfg.Devices[k].IntroducedBy)
 				folderCfg.Devices = append(folderCfg.Devices[:k], folderCfg.Devices[k+1:]...)
 				folders[folderID] = folderCfg
@@ -1728,27 +2067,41 @@ func (*model) handleDeintroductions(introducerCfg config.DeviceConfiguration, fo
 	// shares any folder with them. Yet do not remove if we share other
 	// folders that haven't been introduced by the introducer.
 	for deviceID, device := range devices {
-		if device.IntroducedBy == introducerCfg.DeviceID {
-			if !foldersDevices.hasDevice(deviceID) {
-				if _, ok := devicesNotIntroduced[deviceID]; !ok {
-					// The introducer no longer shares any folder with the
-					// device, remove the device.
-					l.Infof("Removing device %v as introducer %v no longer shares any folders with that device", deviceID, device.IntroducedBy)
-					changed = true
-					delete(devices, deviceID)
-					continue
-				}
-				l.Infof("Would have removed %v as %v no longer shares any folders, yet there are other folders that are shared with this device that haven't been introduced by this introducer.", deviceID, device.IntroducedBy)
-			}
+		if !device.VouchedForBy(introducerCfg.DeviceID) || foldersDevices.hasDevice(deviceID) {
+			continue
+		}
+		if len(device.Introducers()) > 1 {
+			// Other introducers still vouch for the device, only retract
+			// this introduction.
+			device.RemoveIntroduction(introducerCfg.DeviceID)
+			l.Infof("Introducer %v no longer shares any folders with device %v, keeping it as it is still vouched for by %v", introducerCfg.DeviceID, deviceID, device.IntroducedBy)
+			devices[deviceID] = device
+			changed = true
+			continue
 		}
+		if device.Protected {
+			l.Infof("Not removing protected device %v, though introducer %v no longer shares any folders with it", deviceID, introducerCfg.DeviceID)
+			continue
+		}
+		if _, ok := devicesNotIntroduced[deviceID]; !ok {
+			// The introducer no longer shares any folder with the
+			// device, remove the device.
+			l.Infof("Removing device %v as introducer %v no longer shares any folders with that device", deviceID, introducerCfg.DeviceID)
+			changed = true
+			delete(devices, deviceID)
+			continue
+		}
+		l.Infof("Would have removed %v as %v no longer shares any folders, yet there are other folders that are shared with this device that haven't been introduced by this introducer.", deviceID, introducerCfg.DeviceID)
 	}
 
 	return folders, devices, changed
 }
 
 // handleAutoAccepts handles adding and sharing folders for devices that have
-// AutoAcceptFolders set to true.
-func (m *model) handleAutoAccepts(deviceID protocol.DeviceID, folder protocol.Folder, ccDeviceInfos *clusterConfigDeviceInfo, cfg config.FolderConfiguration, haveCfg bool, defaultFolderCfg config.FolderConfiguration) (config.FolderConfiguration, bool) {
+// AutoAcceptFolders set to true. New folders from introducers use the
+// versioning and ignore patterns they propagate, if any, instead of the
+// defaults.
+func (m *model) handleAutoAccepts(deviceID protocol.DeviceID, folder protocol.Folder, ccDeviceInfos *clusterConfigDeviceInfo, cfg config.FolderConfiguration, haveCfg bool, defaultFolderCfg config.FolderConfiguration, introducer bool) (config.FolderConfiguration, bool) {
 	if !haveCfg {
 		defaultPathFs := fs.NewFilesystem(defaultFolderCfg.FilesystemType, defaultFolderCfg.Path)
 		var pathAlternatives []string
@@ -1793,8 +2146,11 @@ func (m *model) handleAutoAccepts(deviceID protocol.DeviceID, folder protocol.Fo
 				fcfg.Versioning.Reset()
 				// Other necessary settings are ensured by FolderConfiguration itself
 			} else {
-				ignores := m.cfg.DefaultIgnores()
-				if err := m.setIgnores(fcfg, ignores.Lines); err != nil {
+				ignores := m.cfg.DefaultIgnores().Lines
+				if introducer {
+					ignores = applyFolderDefaults(&fcfg, folder.Defaults, ignores)
+				}
+				if err := m.setIgnores(fcfg, ignores); err != nil {
 					l.Warnf("Failed to apply default ignores to auto-accepted folder %s at path %s: %v", folder.Description(), fcfg.Path, err)
 				}
 			}
@@ -1830,6 +2186,28 @@ func (m *model) handleAutoAccepts(deviceID protocol.DeviceID, folder protocol.Fo
 	}
 }
 
+// applyFolderDefaults applies the versioning propagated by an introducer to
+// a new folder, and returns the ignore lines to use instead of the given
+// ones, if it propagated any. Versioning types that run commands, like
+// external versioning, are never taken from another device.
+func applyFolderDefaults(fcfg *config.FolderConfiguration, defaults protocol.FolderDefaults, ignores []string) []string {
+	switch defaults.VersioningType {
+	case "simple", "staggered", "trashcan":
+		fcfg.Versioning.Type = defaults.VersioningType
+		fcfg.Versioning.Params = make(map[string]string, len(defaults.VersioningParams))
+		for k, v := range defaults.VersioningParams {
+			fcfg.Versioning.Params[k] = v
+		}
+		if defaults.VersioningCleanupIntervalS > 0 {
+			fcfg.Versioning.CleanupIntervalS = defaults.VersioningCleanupIntervalS
+		}
+	}
+	if len(defaults.IgnoreLines) > 0 {
+		return defaults.IgnoreLines
+	}
+	return ignores
+}
+
 func (m *model) introduceDevice(device protocol.Device, introducerCfg config.DeviceConfiguration) config.DeviceConfiguration {
 	addresses := []string{"dynamic"}
 	for _, addr := range device.Addresses {
@@ -1843,9 +2221,10 @@ func (m *model) introduceDevice(device protocol.Device, introducerCfg config.Dev
 	newDeviceCfg.DeviceID = device.ID
 	newDeviceCfg.Name = device.Name
 	newDeviceCfg.Compression = introducerCfg.Compression
+	newDeviceCfg.CompressionAlgorithm = introducerCfg.CompressionAlgorithm
 	newDeviceCfg.Addresses = addresses
 	newDeviceCfg.CertName = device.CertName
-	newDeviceCfg.IntroducedBy = introducerCfg.DeviceID
+	newDeviceCfg.AddIntroduction(introducerCfg.DeviceID, time.Now().Truncate(time.Second))
 
 	// The introducers' introducers are also our introducers.
 	if device.Introducer {
@@ -1872,6 +2251,7 @@ func (m *model) Closed(conn protocol.Connection, err error) {
 	closed := m.closed[connID]
 	delete(m.closed, connID)
 	delete(m.connections, connID)
+	delete(m.connRequestWindows, connID)
 
 	removedIsPrimary := m.promotedConnID[deviceID] == connID
 	remainingConns := without(m.deviceConnIDs[deviceID], connID)
@@ -1888,8 +2268,11 @@ func (m *model) Closed(conn protocol.Connection, err error) {
 		delete(m.deviceConnIDs, deviceID)
 		delete(m.promotedConnID, deviceID)
 		delete(m.connRequestLimiters, deviceID)
+		delete(m.connRequestStripes, deviceID)
 		delete(m.helloMessages, deviceID)
 		delete(m.remoteFolderStates, deviceID)
+		delete(m.remoteClusterConfigs, deviceID)
+		delete(m.remoteExtensions, deviceID)
 		delete(m.deviceDownloads, deviceID)
 	} else {
 		// Some connections remain
@@ -1904,6 +2287,7 @@ func (m *model) Closed(conn protocol.Connection, err error) {
 	m.mut.RLock()
 	m.deviceDidCloseRLocked(deviceID, time.Since(conn.EstablishedAt()))
 	m.mut.RUnlock()
+	m.trafficStats.closed(conn)
 
 	k := map[bool]string{false: "secondary", true: "primary"}[removedIsPrimary]
 	l.Infof("Lost %s connection to %s at %s: %v (%d remain)", k, deviceID.Short(), conn, err, len(remainingConns))
@@ -1976,6 +2360,19 @@ func (m *model) Request(conn protocol.Connection, req *protocol.Request) (out pr
 		return nil, protocol.ErrGeneric
 	}
 
+	if deviceID != protocol.LocalDeviceID {
+		if m.transferQuotas.exceeded(folderCfg, deviceID) {
+			l.Debugf("Request from %s for file %s in folder %q over transfer quota", deviceID.Short(), req.Name, req.Folder)
+			return nil, protocol.ErrGeneric
+		}
+		defer func() {
+			if err == nil {
+				m.transferQuotas.add(req.Folder, deviceID, 0, int64(req.Size))
+				m.trafficStats.addFolder(req.Folder, deviceID, 0, int64(req.Size))
+			}
+		}()
+	}
+
 	// Make sure the path is valid and in canonical form
 	if name, err := fs.Canonicalize(req.Name); err != nil {
 		l.Debugf("Request from %s in folder %q for invalid filename %s", deviceID.Short(), req.Folder, req.Name)
@@ -2215,7 +2612,7 @@ func (m *model) LoadIgnores(folder string) ([]string, []string, error) {
 	}
 
 	if !ignoresOk {
-		ignores = ignore.New(cfg.Filesystem(nil))
+		ignores = ignore.New(cfg.Filesystem(nil), ignore.WithGitignoreSyntax(cfg.GitignoreSyntax))
 	}
 
 	err := ignores.Load(".stignore")
@@ -2327,12 +2724,17 @@ func (m *model) AddConnection(conn protocol.Connection, hello protocol.Hello) {
 	m.mut.Lock()
 
 	m.connections[connID] = conn
+	m.connRequestWindows[connID] = newRequestWindow()
 	m.closed[connID] = closed
 	m.helloMessages[deviceID] = hello
+	m.clockOffsets[deviceID] = conn.ClockOffset()
 	m.deviceConnIDs[deviceID] = append(m.deviceConnIDs[deviceID], connID)
 	if m.deviceDownloads[deviceID] == nil {
 		m.deviceDownloads[deviceID] = newDeviceDownloadState()
 	}
+	if m.connRequestStripes[deviceID] == nil {
+		m.connRequestStripes[deviceID] = new(atomic.Uint64)
+	}
 
 	event := map[string]string{
 		"id":            deviceID.String(),
@@ -2356,6 +2758,7 @@ func (m *model) AddConnection(conn protocol.Connection, hello protocol.Hello) {
 	}
 
 	m.mut.Unlock()
+	m.trafficStats.connected(conn)
 
 	if (deviceCfg.Name == "" || m.cfg.Options().OverwriteRemoteDevNames) && hello.DeviceName != "" {
 		m.cfg.Modify(func(cfg *config.Configuration) {
@@ -2464,20 +2867,50 @@ func (m *model) deviceDidCloseRLocked(deviceID protocol.DeviceID, duration time.
 }
 
 func (m *model) RequestGlobal(ctx context.Context, deviceID protocol.DeviceID, folder, name string, blockNo int, offset int64, size int, hash []byte, weakHash uint32, fromTemporary bool) ([]byte, error) {
+	m.mut.RLock()
+	folderCfg, cfgOK := m.folderCfgs[folder]
+	m.mut.RUnlock()
+	if cfgOK && m.transferQuotas.exceeded(folderCfg, deviceID) {
+		return nil, fmt.Errorf("requestGlobal: device %s: %w", deviceID.Short(), errQuotaExceeded)
+	}
+
 	conn, connOK := m.requestConnectionForDevice(deviceID)
 	if !connOK {
 		return nil, fmt.Errorf("requestGlobal: no connection to device: %s", deviceID.Short())
 	}
 
+	// Wait for room among the requests in flight on the connection. The
+	// window may be gone if the connection was closed just now, in which
+	// case the request will fail anyway.
+	m.mut.RLock()
+	window, windowOK := m.connRequestWindows[conn.ConnectionID()]
+	m.mut.RUnlock()
+	if windowOK {
+		if err := window.take(ctx); err != nil {
+			return nil, err
+		}
+	}
+
 	l.Debugf("%v REQ(out): %s (%s): %q / %q b=%d o=%d s=%d h=%x wh=%x ft=%t", m, deviceID.Short(), conn, folder, name, blockNo, offset, size, hash, weakHash, fromTemporary)
-	return conn.Request(ctx, &protocol.Request{Folder: folder, Name: name, BlockNo: blockNo, Offset: offset, Size: size, Hash: hash, WeakHash: weakHash, FromTemporary: fromTemporary})
+	t0 := time.Now()
+	data, err := conn.Request(ctx, &protocol.Request{Folder: folder, Name: name, BlockNo: blockNo, Offset: offset, Size: size, Hash: hash, WeakHash: weakHash, FromTemporary: fromTemporary})
+	if windowOK {
+		window.done(time.Since(t0), err)
+	}
+	if err == nil {
+		m.transferQuotas.add(folder, deviceID, int64(len(data)), 0)
+		m.trafficStats.addFolder(folder, deviceID, int64(len(data)), 0)
+	}
+	return data, err
 }
 
 // requestConnectionForDevice returns a connection to the given device, to
 // be used for sending a request. If there is only one device connection,
 // this is the one to use. If there are multiple then we avoid the first
-// ("primary") connection, which is dedicated to index data, and pick a
-// random one of the others.
+// ("primary") connection, which is dedicated to index data, and stripe the
+// requests over the others in turn. Consecutive blocks thus travel over
+// different connections, spreading the load evenly and working around per
+// connection throttling.
 func (m *model) requestConnectionForDevice(deviceID protocol.DeviceID) (protocol.Connection, bool) {
 	m.mut.RLock()
 	defer m.mut.RUnlock()
@@ -2491,8 +2924,9 @@ func (m *model) requestConnectionForDevice(deviceID protocol.DeviceID) (protocol
 	// connection.
 	connID := connIDs[0]
 	if len(connIDs) > 1 {
-		// Pick a random connection of the non-primary ones
-		idx := rand.Intn(len(connIDs)-1) + 1
+		// Pick the next connection of the non-primary ones
+		n := m.connRequestStripes[deviceID].Add(1)
+		idx := int(n%uint64(len(connIDs)-1)) + 1
 		connID = connIDs[idx]
 	}
 
@@ -2557,8 +2991,8 @@ func (m *model) DelayScan(folder string, next time.Duration) {
 }
 
 // numHashers returns the number of hasher routines to use for a given folder,
-// taking into account configuration and available CPU cores.
-func (m *model) numHashers(folder string) int {
+// taking into account configuration, the storage and available CPU cores.
+func (m *model) numHashers(folder string, rotational bool) int {
 	m.mut.RLock()
 	folderCfg := m.folderCfgs[folder]
 	numFolders := max(1, len(m.folderCfgs))
@@ -2569,6 +3003,12 @@ func (m *model) numHashers(folder string) int {
 		return folderCfg.Hashers
 	}
 
+	if rotational {
+		// Concurrent reads make a spinning disk seek back and forth, one
+		// hasher reading sequentially is faster.
+		return 1
+	}
+
 	if build.IsWindows || build.IsDarwin || build.IsIOS || build.IsAndroid {
 		// Interactive operating systems; don't load the system too heavily by
 		// default.
@@ -2594,7 +3034,9 @@ func (m *model) generateClusterConfig(device protocol.DeviceID) (*protocol.Clust
 }
 
 func (m *model) generateClusterConfigRLocked(device protocol.DeviceID) (*protocol.ClusterConfig, map[string]string) {
-	message := &protocol.ClusterConfig{}
+	message := &protocol.ClusterConfig{
+		Extensions: protocol.SupportedExtensions(),
+	}
 	folders := m.cfg.FolderList()
 	passwords := make(map[string]string, len(folders))
 	for _, folderCfg := range folders {
@@ -2626,6 +3068,18 @@ func (m *model) generateClusterConfigRLocked(device protocol.DeviceID) (*protoco
 		// another cluster config once the folder is started.
 		protocolFolder.Paused = folderCfg.Paused || fs == nil
 
+		if folderCfg.PropagateDefaults && folderCfg.Type != config.FolderTypeReceiveEncrypted {
+			// Where the versions are kept is up to each device.
+			protocolFolder.Defaults = protocol.FolderDefaults{
+				VersioningType:             folderCfg.Versioning.Type,
+				VersioningParams:           folderCfg.Versioning.Params,
+				VersioningCleanupIntervalS: folderCfg.Versioning.CleanupIntervalS,
+			}
+			if ignores, ok := m.folderIgnores[folderCfg.ID]; ok {
+				protocolFolder.Defaults.IgnoreLines = ignores.Lines()
+			}
+		}
+
 		for _, folderDevice := range folderCfg.Devices {
 			deviceCfg, _ := m.cfg.Device(folderDevice.DeviceID)
 
@@ -2651,6 +3105,7 @@ func (m *model) generateClusterConfigRLocked(device protocol.DeviceID) (*protoco
 				if deviceCfg.DeviceID == m.id {
 					protocolDevice.IndexID = fs.IndexID(protocol.LocalDeviceID)
 					protocolDevice.MaxSequence = fs.Sequence(protocol.LocalDeviceID)
+					protocolDevice.IndexSubtree = folderCfg.IndexSubtree
 				} else {
 					protocolDevice.IndexID = fs.IndexID(deviceCfg.DeviceID)
 					protocolDevice.MaxSequence = fs.Sequence(deviceCfg.DeviceID)
@@ -2691,6 +3146,17 @@ func (m *model) FolderErrors(folder string) ([]FileError, error) {
 	return runner.Errors(), nil
 }
 
+func (m *model) FolderErrorDigests(folder string) ([]FileErrorDigest, error) {
+	m.mut.RLock()
+	err := m.checkFolderRunningRLocked(folder)
+	runner, _ := m.folderRunners.Get(folder)
+	m.mut.RUnlock()
+	if err != nil {
+		return nil, err
+	}
+	return runner.ErrorDigests(), nil
+}
+
 func (m *model) WatchError(folder string) error {
 	m.mut.RLock()
 	err := m.checkFolderRunningRLocked(folder)
@@ -2836,6 +3302,44 @@ func (m *model) GetFolderVersions(folder string) (map[string][]versioner.FileVer
 	return ver.GetVersions()
 }
 
+// Conflicts returns the conflict copies present in the folder, awaiting
+// manual resolution.
+func (m *model) Conflicts(folder string) ([]Conflict, error) {
+	snap, err := m.DBSnapshot(folder)
+	if err != nil {
+		return nil, err
+	}
+	defer snap.Release()
+	return conflictsFromSnapshot(snap), nil
+}
+
+// ResolveConflict resolves the conflict represented by the given conflict
+// copy, keeping either the conflict copy or the current version of the
+// original file.
+func (m *model) ResolveConflict(folder, name string, keepConflict bool) error {
+	m.mut.RLock()
+	err := m.checkFolderRunningRLocked(folder)
+	runner, _ := m.folderRunners.Get(folder)
+	m.mut.RUnlock()
+	if err != nil {
+		return err
+	}
+	return runner.ResolveConflict(name, keepConflict)
+}
+
+// CleanFolder removes stale temporary files, orphaned versions and old
+// conflict copies from the folder, as selected by the options.
+func (m *model) CleanFolder(folder string, opts CleanOptions) (CleanResult, error) {
+	m.mut.RLock()
+	err := m.checkFolderRunningRLocked(folder)
+	runner, _ := m.folderRunners.Get(folder)
+	m.mut.RUnlock()
+	if err != nil {
+		return CleanResult{}, err
+	}
+	return runner.Clean(opts)
+}
+
 func (m *model) RestoreFolderVersions(folder string, versions map[string]time.Time) (map[string]error, error) {
 	m.mut.RLock()
 	err := m.checkFolderRunningRLocked(folder)
@@ -2894,6 +3398,9 @@ func (m *model) availabilityInSnapshot(cfg config.FolderConfiguration, snap *db.
 func (m *model) availabilityInSnapshotRLocked(cfg config.FolderConfiguration, snap *db.Snapshot, file protocol.FileInfo, block protocol.BlockInfo) []Availability {
 	var availabilities []Availability
 	for _, device := range snap.Availability(file.Name) {
+		if dev, _ := cfg.Device(device); dev.IndexOnly {
+			continue
+		}
 		if _, ok := m.remoteFolderStates[device]; !ok {
 			continue
 		}
@@ -2907,6 +3414,9 @@ func (m *model) availabilityInSnapshotRLocked(cfg config.FolderConfiguration, sn
 	}
 
 	for _, device := range cfg.Devices {
+		if device.IndexOnly {
+			continue
+		}
 		if m.deviceDownloads[device.DeviceID].Has(cfg.ID, file.Name, file.Version, int(block.Offset/int64(file.BlockSize()))) {
 			availabilities = append(availabilities, Availability{ID: device.DeviceID, FromTemporary: true})
 		}
@@ -2926,6 +3436,61 @@ func (m *model) BringToFront(folder, file string) {
 	}
 }
 
+// PushToBack moves the given file to the back of the job queue, behind the
+// other files of the same priority.
+func (m *model) PushToBack(folder, file string) error {
+	m.mut.RLock()
+	err := m.checkFolderRunningRLocked(folder)
+	runner, _ := m.folderRunners.Get(folder)
+	m.mut.RUnlock()
+	if err != nil {
+		return err
+	}
+	runner.PushToBack(file)
+	return nil
+}
+
+// SkipPull removes the given file from the job queue for the rest of the
+// current pull. It is retried on the next pull.
+func (m *model) SkipPull(folder, file string) error {
+	m.mut.RLock()
+	err := m.checkFolderRunningRLocked(folder)
+	runner, _ := m.folderRunners.Get(folder)
+	m.mut.RUnlock()
+	if err != nil {
+		return err
+	}
+	if !runner.SkipPull(file) {
+		return fmt.Errorf("%q: %w", file, errNotQueued)
+	}
+	return nil
+}
+
+// SetPullPriority sets the priority in the job queue for files matching
+// the given pattern.
+func (m *model) SetPullPriority(folder, pattern string, priority int) error {
+	m.mut.RLock()
+	err := m.checkFolderRunningRLocked(folder)
+	runner, _ := m.folderRunners.Get(folder)
+	m.mut.RUnlock()
+	if err != nil {
+		return err
+	}
+	return runner.SetPullPriority(pattern, priority)
+}
+
+// PullPriorities returns the patterns and priorities set for the job queue.
+func (m *model) PullPriorities(folder string) ([]PullPriority, error) {
+	m.mut.RLock()
+	err := m.checkFolderRunningRLocked(folder)
+	runner, _ := m.folderRunners.Get(folder)
+	m.mut.RUnlock()
+	if err != nil {
+		return nil, err
+	}
+	return runner.PullPriorities(), nil
+}
+
 func (m *model) ResetFolder(folder string) error {
 	m.mut.RLock()
 	defer m.mut.RUnlock()
@@ -3039,7 +3604,11 @@ func (m *model) CommitConfiguration(from, to config.Configuration) bool {
 			if toCfg.Paused {
 				eventType = events.FolderPaused
 			}
-			m.evLogger.Log(eventType, map[string]string{"id": toCfg.ID, "label": toCfg.Label})
+			data := map[string]string{"id": toCfg.ID, "label": toCfg.Label}
+			if toCfg.Paused {
+				data["reason"] = toCfg.PausedReason.String()
+			}
+			m.evLogger.Log(eventType, data)
 		}
 	}
 
@@ -3056,6 +3625,12 @@ func (m *model) CommitConfiguration(from, to config.Configuration) bool {
 			continue
 		}
 		delete(fromDevices, deviceID)
+		if !toCfg.Paused && toCfg.CompressionAlgorithm != fromCfg.CompressionAlgorithm {
+			// The algorithm is chosen when connecting, from those the
+			// device announces in its hello.
+			l.Infof("Reconnecting to %v to use %v compression", deviceID, toCfg.CompressionAlgorithm)
+			closeDevices = append(closeDevices, deviceID)
+		}
 		if fromCfg.Paused == toCfg.Paused {
 			continue
 		}
@@ -3114,6 +3689,7 @@ func (m *model) CommitConfiguration(from, to config.Configuration) bool {
 
 	ignoredDevices := observedDeviceSet(to.IgnoredDevices)
 	m.cleanPending(toDevices, toFolders, ignoredDevices, removedFolders)
+	m.cleanPendingConfigPushes(toDevices)
 
 	m.globalRequestLimiter.SetCapacity(1024 * to.Options.MaxConcurrentIncomingRequestKiB())
 	m.folderIOLimiter.SetCapacity(to.Options.MaxFolderConcurrency())
@@ -17,6 +17,7 @@ import (
 	"os"
 	"path/filepath"
 	"runtime/pprof"
+	"slices"
 	"sort"
 	"strconv"
 	"strings"
@@ -46,7 +47,7 @@ func newState(t testing.TB, cfg config.Configuration) (*testModel, context.Cance
 	m := setupModel(t, wcfg)
 
 	for _, dev := range cfg.Devices {
-		m.AddConnection(newFakeConnection(dev.DeviceID, m), protocol.Hello{})
+		m.AddConnection(newFakeConnection(dev.DeviceID, m), protocol.Hello{Extensions: protocol.SupportedExtensions()})
 	}
 
 	return m, cancel
@@ -862,6 +863,120 @@ func TestIntroducer(t *testing.T) {
 	}
 }
 
+func TestIntroducerProtected(t *testing.T) {
+	for _, tc := range []struct {
+		name            string
+		folderProtected bool
+		deviceProtected bool
+	}{
+		{"folder", true, false},
+		{"device", false, true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			m, cancel := newState(t, config.Configuration{
+				Version: config.CurrentVersion,
+				Devices: []config.DeviceConfiguration{
+					{
+						DeviceID:   device1,
+						Introducer: true,
+					},
+					{
+						DeviceID:     device2,
+						IntroducedBy: device1,
+						Protected:    tc.deviceProtected,
+					},
+				},
+				Folders: []config.FolderConfiguration{
+					{
+						FilesystemType: fs.FilesystemTypeFake,
+						ID:             "folder1",
+						Path:           "testdata",
+						Devices: []config.FolderDeviceConfiguration{
+							{DeviceID: device1},
+							{DeviceID: device2, IntroducedBy: device1},
+						},
+						Protected: tc.folderProtected,
+					},
+				},
+			})
+			defer cleanupModel(m)
+			defer cancel()
+			m.ClusterConfig(device1Conn, &protocol.ClusterConfig{})
+
+			if _, ok := m.cfg.Device(device2); !ok {
+				t.Error("device 2 should not have been removed")
+			}
+			if folder, _ := m.cfg.Folder("folder1"); !folder.SharedWith(device2) {
+				t.Error("expected device 2 not to be removed from folder 1")
+			}
+		})
+	}
+}
+
+func TestIntroducedByTwoIntroducers(t *testing.T) {
+	device3, err := protocol.DeviceIDFromString("AIBAEAQ-CAIBAEC-AQCAIBA-EAQCAIA-BAEAQCA-IBAEAQC-CAIBAEA-QCAIBA7")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	m, cancel := newState(t, config.Configuration{
+		Version: config.CurrentVersion,
+		Devices: []config.DeviceConfiguration{
+			{DeviceID: device1, Introducer: true},
+			{DeviceID: device2, Introducer: true},
+		},
+		Folders: []config.FolderConfiguration{
+			{
+				FilesystemType: fs.FilesystemTypeFake,
+				ID:             "folder1",
+				Path:           "testdata",
+				Devices: []config.FolderDeviceConfiguration{
+					{DeviceID: device1},
+					{DeviceID: device2},
+				},
+			},
+		},
+	})
+	defer cleanupModel(m)
+	defer cancel()
+
+	introduce := func(conn protocol.Connection, introducer protocol.DeviceID, devices ...protocol.DeviceID) {
+		t.Helper()
+		cc := basicClusterConfig(myID, introducer, "folder1")
+		for _, dev := range devices {
+			cc.Folders[0].Devices = append(cc.Folders[0].Devices, protocol.Device{ID: dev})
+		}
+		if err := m.ClusterConfig(conn, cc); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	introduce(device1Conn, device1, device3)
+	introduce(device2Conn, device2, device3)
+	dev, ok := m.cfg.Device(device3)
+	if !ok {
+		t.Fatal("expected device 3 to be introduced")
+	}
+	if intros := dev.Introducers(); len(intros) != 2 || intros[0] != device1 || intros[1] != device2 || dev.IntroducedBy != device1 {
+		t.Fatalf("expected device 3 to be vouched for by both introducers, got %v", intros)
+	}
+
+	// One introducer retracting the device only retracts its introduction.
+	introduce(device1Conn, device1)
+	dev, ok = m.cfg.Device(device3)
+	if !ok {
+		t.Fatal("expected device 3 to be kept while device 2 vouches for it")
+	}
+	if intros := dev.Introducers(); len(intros) != 1 || intros[0] != device2 || dev.IntroducedBy != device2 {
+		t.Errorf("expected device 3 to be vouched for by device 2 only, got %v", intros)
+	}
+
+	introduce(device2Conn, device2)
+	if _, ok := m.cfg.Device(device3); ok {
+		t.Error("expected device 3 to be removed when no introducer vouches for it")
+	}
+}
+
 func TestIssue4897(t *testing.T) {
 	m, cancel := newState(t, config.Configuration{
 		Version: config.CurrentVersion,
@@ -1315,6 +1430,94 @@ func TestAutoAcceptPausedWhenFolderConfigNotChanged(t *testing.T) {
 	}
 }
 
+func TestAutoAcceptIntroducerDefaults(t *testing.T) {
+	tcfg := defaultAutoAcceptCfg.Copy()
+	tcfg.Devices[1].Introducer = true
+	m, cancel := newState(t, tcfg)
+	defer cleanupModel(m)
+	defer cancel()
+
+	defaults := protocol.FolderDefaults{
+		VersioningType:   "simple",
+		VersioningParams: map[string]string{"keep": "3"},
+		IgnoreLines:      []string{"*.tmp"},
+	}
+	fromIntroducer, fromOther, external := srand.String(8), srand.String(8), srand.String(8)
+	cc := createClusterConfig(device1, fromIntroducer, external)
+	cc.Folders[0].Defaults = defaults
+	cc.Folders[1].Defaults = protocol.FolderDefaults{VersioningType: "external", VersioningParams: map[string]string{"command": "rm -rf /"}}
+	m.ClusterConfig(device1Conn, cc)
+	cc = createClusterConfig(device2, fromOther)
+	cc.Folders[0].Defaults = defaults
+	m.ClusterConfig(device2Conn, cc)
+
+	fcfg, ok := m.cfg.Folder(fromIntroducer)
+	if !ok {
+		t.Fatal("expected folder to be accepted")
+	}
+	if fcfg.Versioning.Type != "simple" || fcfg.Versioning.Params["keep"] != "3" {
+		t.Errorf("expected the introducer's versioning, got %+v", fcfg.Versioning)
+	}
+	// The fake filesystem doesn't keep contents, only sizes.
+	if info, err := fcfg.Filesystem(nil).Stat(".stignore"); err != nil || info.Size() != int64(len("*.tmp\n")) {
+		t.Errorf("expected the introducer's ignores to be written, got %v", err)
+	}
+
+	if fcfg, ok := m.cfg.Folder(external); !ok || fcfg.Versioning.Type != "" {
+		t.Errorf("expected external versioning not to be taken from the introducer, got %+v", fcfg.Versioning)
+	}
+
+	if fcfg, ok := m.cfg.Folder(fromOther); !ok || fcfg.Versioning.Type != "" {
+		t.Errorf("expected the defaults of a non-introducer to be ignored, got %+v", fcfg.Versioning)
+	}
+	if fcfg, ok := m.cfg.Folder(fromOther); ok {
+		if _, err := fcfg.Filesystem(nil).Stat(".stignore"); !fs.IsNotExist(err) {
+			t.Errorf("expected no ignores to be written, got %v", err)
+		}
+	}
+}
+
+func TestClusterConfigPropagatesDefaults(t *testing.T) {
+	m, cancel := newState(t, config.Configuration{
+		Version: config.CurrentVersion,
+		Devices: []config.DeviceConfiguration{{DeviceID: device1}},
+		Folders: []config.FolderConfiguration{
+			{
+				FilesystemType: fs.FilesystemTypeFake,
+				ID:             "folder1",
+				Path:           "testdata1",
+				Devices:        []config.FolderDeviceConfiguration{{DeviceID: device1}},
+				Versioning: config.VersioningConfiguration{
+					Type:   "trashcan",
+					Params: map[string]string{"cleanoutDays": "7"},
+					FSPath: "/var/versions",
+				},
+				PropagateDefaults: true,
+			},
+			{
+				FilesystemType: fs.FilesystemTypeFake,
+				ID:             "folder2",
+				Path:           "testdata2",
+				Devices:        []config.FolderDeviceConfiguration{{DeviceID: device1}},
+				Versioning:     config.VersioningConfiguration{Type: "trashcan"},
+			},
+		},
+	})
+	defer cleanupModel(m)
+	defer cancel()
+
+	cm, _ := m.generateClusterConfig(device1)
+	if len(cm.Folders) != 2 {
+		t.Fatalf("expected two folders, got %d", len(cm.Folders))
+	}
+	if d := cm.Folders[0].Defaults; d.VersioningType != "trashcan" || d.VersioningParams["cleanoutDays"] != "7" {
+		t.Errorf("expected versioning to be propagated, got %+v", d)
+	}
+	if d := cm.Folders[1].Defaults; d.VersioningType != "" {
+		t.Errorf("expected nothing to be propagated, got %+v", d)
+	}
+}
+
 func TestAutoAcceptEnc(t *testing.T) {
 	tcfg := defaultAutoAcceptCfg.Copy()
 	m, cancel := newState(t, tcfg)
@@ -2148,6 +2351,70 @@ func TestIndexesForUnknownDevicesDropped(t *testing.T) {
 	}
 }
 
+func TestCompressionAlgorithmChangeReconnects(t *testing.T) {
+	wcfg, cancel := newConfigWrapper(defaultCfg)
+	defer cancel()
+	m := setupModel(t, wcfg)
+	defer cleanupModel(m)
+
+	fc := newFakeConnection(device1, m)
+	m.AddConnection(fc, protocol.Hello{})
+
+	dcfg, _ := wcfg.Device(device1)
+	dcfg.CompressionAlgorithm = protocol.CompressionAlgorithmZstd
+	setDevice(t, wcfg, dcfg)
+
+	select {
+	case <-fc.closed:
+	case <-time.After(10 * time.Second):
+		t.Fatal("connection not closed after changing the compression algorithm")
+	}
+}
+
+func TestRequestConnectionStriping(t *testing.T) {
+	wcfg, cancel := newConfigWrapper(defaultCfg)
+	defer cancel()
+	m := setupModel(t, wcfg)
+	defer cleanupModel(m)
+
+	primary := newFakeConnection(device1, m)
+	m.AddConnection(primary, protocol.Hello{})
+
+	// With a single connection, it's used for requests too.
+	if conn, ok := m.requestConnectionForDevice(device1); !ok || conn != primary {
+		t.Fatal("expected the primary connection to be used")
+	}
+
+	others := []*fakeConnection{newFakeConnection(device1, m), newFakeConnection(device1, m)}
+	for _, conn := range others {
+		m.AddConnection(conn, protocol.Hello{})
+	}
+
+	// With more connections, requests alternate between the non-primary
+	// ones.
+	var prev protocol.Connection
+	counts := make(map[protocol.Connection]int)
+	for i := 0; i < 10; i++ {
+		conn, ok := m.requestConnectionForDevice(device1)
+		if !ok {
+			t.Fatal("no connection")
+		}
+		if conn == primary {
+			t.Fatal("request sent over the primary connection")
+		}
+		if conn == prev {
+			t.Fatal("consecutive requests sent over the same connection")
+		}
+		prev = conn
+		counts[conn]++
+	}
+	for _, conn := range others {
+		if counts[conn] != 5 {
+			t.Errorf("expected 5 requests per connection, got %d", counts[conn])
+		}
+	}
+}
+
 func TestSharedWithClearedOnDisconnect(t *testing.T) {
 	wcfg, cancel := newConfigWrapper(defaultCfg)
 	defer cancel()
@@ -2972,7 +3239,7 @@ func TestConnCloseOnRestart(t *testing.T) {
 	nw := &testutil.NoopRW{}
 	ci := &protocolmocks.ConnectionInfo{}
 	ci.ConnectionIDReturns(srand.String(16))
-	m.AddConnection(protocol.NewConnection(device1, br, nw, testutil.NoopCloser{}, m, ci, protocol.CompressionNever, nil, m.keyGen), protocol.Hello{})
+	m.AddConnection(protocol.NewConnection(device1, br, nw, testutil.NoopCloser{}, m, ci, protocol.CompressionNever, protocol.MessageCompressionLZ4, nil, m.keyGen), protocol.Hello{})
 	m.mut.RLock()
 	if len(m.closed) != 1 {
 		t.Fatalf("Expected just one conn (len(m.closed) == %v)", len(m.closed))
@@ -4141,3 +4408,225 @@ type modtimeTruncatingFileInfo struct {
 func (fi modtimeTruncatingFileInfo) ModTime() time.Time {
 	return fi.FileInfo.ModTime().Truncate(fi.trunc)
 }
+
+func TestIndexSubtree(t *testing.T) {
+	w, fcfg, wCancel := newDefaultCfgWrapper()
+	defer wCancel()
+	ffs := fcfg.Filesystem(nil)
+	must(t, ffs.MkdirAll("Camera", 0o755))
+	must(t, ffs.MkdirAll("Other", 0o755))
+	writeFile(t, ffs, "Camera/a.jpg", []byte("a"))
+	writeFile(t, ffs, "Other/b.txt", []byte("b"))
+	writeFile(t, ffs, "Camera2", []byte("c"))
+	m := setupModel(t, w)
+	defer cleanupModelAndRemoveDir(m, ffs.URI())
+
+	received := make(chan []protocol.FileInfo, 10)
+	fc := newFakeConnection(device1, m)
+	fc.setIndexFn(func(_ context.Context, _ string, fs []protocol.FileInfo) error {
+		received <- fs
+		return nil
+	})
+	m.AddConnection(fc, protocol.Hello{})
+	cc := basicClusterConfig(myID, device1, "default")
+	cc.Folders[0].Devices[1].IndexSubtree = "Camera"
+	m.ClusterConfig(fc, cc)
+
+	var names []string
+	select {
+	case fs := <-received:
+		for _, f := range fs {
+			names = append(names, f.Name)
+		}
+	case <-time.After(10 * time.Second):
+		t.Fatal("timed out waiting for index")
+	}
+	sort.Strings(names)
+	if expected := []string{"Camera", "Camera/a.jpg"}; !slices.Equal(names, expected) {
+		t.Errorf("sent %v, expected %v", names, expected)
+	}
+}
+
+func TestClusterConfigIndexSubtree(t *testing.T) {
+	w, fcfg, wCancel := newDefaultCfgWrapper()
+	defer wCancel()
+	fcfg.IndexSubtree = "/Camera/"
+	waiter, err := w.Modify(func(cfg *config.Configuration) {
+		cfg.SetFolder(fcfg)
+	})
+	must(t, err)
+	waiter.Wait()
+	m := setupModel(t, w)
+	defer cleanupModel(m)
+
+	cc, _ := m.generateClusterConfig(device1)
+	for _, dev := range cc.Folders[0].Devices {
+		expected := ""
+		if dev.ID == myID {
+			expected = "Camera"
+		}
+		if dev.IndexSubtree != expected {
+			t.Errorf("device %v has subtree %q, expected %q", dev.ID, dev.IndexSubtree, expected)
+		}
+	}
+}
+
+func TestInIndexSubtree(t *testing.T) {
+	cases := []struct {
+		name, subtree string
+		in            bool
+	}{
+		{"anything", "", true},
+		{"Camera", "Camera", true},
+		{"Camera/a.jpg", "Camera", true},
+		{"Camera2", "Camera", false},
+		{"Other/b.txt", "Camera", false},
+		{"Media", "Media/Camera", true},
+		{"Media/Music", "Media/Camera", false},
+	}
+	for _, tc := range cases {
+		if in := inIndexSubtree(tc.name, tc.subtree); in != tc.in {
+			t.Errorf("inIndexSubtree(%q, %q) = %v, expected %v", tc.name, tc.subtree, in, tc.in)
+		}
+	}
+}
+
+func TestIndexOnlyDevice(t *testing.T) {
+	w, fcfg, wCancel := newDefaultCfgWrapper()
+	defer wCancel()
+	m, fc := setupModelWithConnectionFromWrapper(t, w)
+	defer cleanupModelAndRemoveDir(m, fcfg.Filesystem(nil).URI())
+
+	fc.addFile("foo", 0o644, protocol.FileInfoTypeFile, []byte("data"))
+	fc.sendIndexUpdate()
+
+	// The file may have been pulled meanwhile, so check for what the
+	// device sent rather than the global state.
+	hasRemote := func() (protocol.FileInfo, bool) {
+		t.Helper()
+		snap, err := m.DBSnapshot("default")
+		must(t, err)
+		defer snap.Release()
+		return snap.Get(device1, "foo")
+	}
+	file, ok := hasRemote()
+	if !ok {
+		t.Fatal("file from a normal device should be in the database")
+	}
+	if av := m.testAvailability("default", file, file.Blocks[0]); len(av) != 1 {
+		t.Errorf("expected the normal device to be available, got %v", av)
+	}
+
+	fcfg = fcfg.Copy()
+	for i := range fcfg.Devices {
+		if fcfg.Devices[i].DeviceID == device1 {
+			fcfg.Devices[i].IndexOnly = true
+		}
+	}
+	setFolder(t, w, fcfg)
+
+	// What the device sent before is forgotten, and new changes are
+	// ignored.
+	if _, ok := hasRemote(); ok {
+		t.Error("file from an index only device should have been dropped")
+	}
+	fc.sendIndexUpdate()
+	if _, ok := hasRemote(); ok {
+		t.Error("index from an index only device should have been ignored")
+	}
+
+	if av := m.testAvailability("default", file, file.Blocks[0]); len(av) != 0 {
+		t.Errorf("index only device should not be available, got %v", av)
+	}
+
+	comp, err := m.Completion(device1, "default")
+	must(t, err)
+	if comp.CompletionPct != 100 || comp.NeedBytes != 0 {
+		t.Errorf("index only device should be complete, got %v", comp.Map())
+	}
+}
+
+func TestClusterTopology(t *testing.T) {
+	m, fc, fcfg, wcfgCancel := setupModelWithConnection(t)
+	defer wcfgCancel()
+	defer cleanupModelAndRemoveDir(m, fcfg.Filesystem(nil).URI())
+
+	// device1 shares the folder with a device we do not know.
+	far := protocol.NewDeviceID([]byte("far"))
+	cc := basicClusterConfig(myID, device1, fcfg.ID)
+	cc.Folders[0].Devices = append(cc.Folders[0].Devices, protocol.Device{ID: far, Name: "far"})
+	must(t, m.ClusterConfig(fc, cc))
+
+	topo := m.ClusterTopology()
+
+	devices := make(map[protocol.DeviceID]TopologyDevice)
+	for _, dev := range topo.Devices {
+		devices[dev.ID] = dev
+	}
+	if dev := devices[myID]; !dev.Local || !dev.Configured {
+		t.Errorf("unexpected local device %+v", dev)
+	}
+	if dev := devices[device1]; !dev.Configured || !dev.Connected || dev.LastSeen.IsZero() {
+		t.Errorf("unexpected connected device %+v", dev)
+	}
+	if dev, ok := devices[far]; !ok || dev.Configured || dev.Name != "far" {
+		t.Errorf("unexpected remote only device %+v", dev)
+	}
+
+	linked := func(a, b protocol.DeviceID) []string {
+		for _, link := range topo.Links {
+			if link.From == a && link.To == b || link.From == b && link.To == a {
+				return link.Folders
+			}
+		}
+		return nil
+	}
+	if folders := linked(myID, device1); !slices.Equal(folders, []string{fcfg.ID}) {
+		t.Errorf("expected us and device1 to share %v, got %v", fcfg.ID, folders)
+	}
+	if folders := linked(device1, far); !slices.Equal(folders, []string{fcfg.ID}) {
+		t.Errorf("expected device1 and the remote only device to share %v, got %v", fcfg.ID, folders)
+	}
+	if folders := linked(myID, far); folders != nil {
+		t.Errorf("expected no link between us and the remote only device, got %v", folders)
+	}
+
+	m.Closed(fc, protocol.ErrTimeout)
+	topo = m.ClusterTopology()
+	for _, dev := range topo.Devices {
+		if dev.ID == far {
+			t.Error("remote only device should be gone after disconnecting")
+		}
+	}
+}
+
+func TestFileHistory(t *testing.T) {
+	m, fc, fcfg, wcfgCancel := setupModelWithConnection(t)
+	defer wcfgCancel()
+	defer cleanupModelAndRemoveDir(m, fcfg.Filesystem(nil).URI())
+
+	fc.addFile("foo", 0o644, protocol.FileInfoTypeFile, []byte("data"))
+	fc.sendIndexUpdate()
+
+	history, err := m.FileHistory(fcfg.ID, "foo")
+	must(t, err)
+	var remote *FileHistoryEntry
+	for i := range history {
+		if history[i].Source == FileHistoryIndex && history[i].Device == device1 {
+			remote = &history[i]
+		}
+	}
+	if remote == nil {
+		t.Fatalf("expected an index entry from device1, got %v", history)
+	}
+	if remote.Size != 4 || remote.Version.Counter(device1.Short()) == 0 {
+		t.Errorf("unexpected index entry %+v", remote)
+	}
+
+	if history, err := m.FileHistory(fcfg.ID, "bar"); err != nil || len(history) != 0 {
+		t.Errorf("expected no history for an unknown file, got %v, %v", history, err)
+	}
+	if _, err := m.FileHistory("nonexistent", "foo"); err == nil {
+		t.Error("expected an error for an unknown folder")
+	}
+}
@@ -7,23 +7,46 @@
 package model
 
 import (
+	"path"
+	"path/filepath"
 	"sort"
+	"strings"
 	"time"
 
+	"github.com/gobwas/glob"
+
 	"github.com/syncthing/syncthing/lib/rand"
 	"github.com/syncthing/syncthing/lib/sync"
 )
 
+// The queued jobs are kept ordered by priority, highest first. Within a
+// priority level the order is given by the pull order of the folder.
 type jobQueue struct {
-	progress []string
-	queued   []jobQueueEntry
-	mut      sync.Mutex
+	progress   []string
+	queued     []jobQueueEntry
+	priorities []jobQueuePriority
+	skipped    map[string]struct{}
+	mut        sync.Mutex
 }
 
 type jobQueueEntry struct {
 	name     string
 	size     int64
 	modified int64
+	priority int
+}
+
+type jobQueuePriority struct {
+	pattern  string
+	glob     glob.Glob
+	priority int
+}
+
+// PullPriority is a pattern and the priority given to files matching it
+// when pulling.
+type PullPriority struct {
+	Pattern  string `json:"pattern"`
+	Priority int    `json:"priority"`
 }
 
 func newJobQueue() *jobQueue {
@@ -34,9 +57,91 @@ func newJobQueue() *jobQueue {
 
 func (q *jobQueue) Push(file string, size int64, modified time.Time) {
 	q.mut.Lock()
+	defer q.mut.Unlock()
+
+	if _, ok := q.skipped[file]; ok {
+		return
+	}
+
 	// The range of UnixNano covers a range of reasonable timestamps.
-	q.queued = append(q.queued, jobQueueEntry{file, size, modified.UnixNano()})
-	q.mut.Unlock()
+	entry := jobQueueEntry{file, size, modified.UnixNano(), q.priorityLocked(file)}
+
+	// Insert after all entries of the same or higher priority.
+	i := sort.Search(len(q.queued), func(i int) bool {
+		return q.queued[i].priority < entry.priority
+	})
+	q.queued = append(q.queued, jobQueueEntry{})
+	copy(q.queued[i+1:], q.queued[i:])
+	q.queued[i] = entry
+}
+
+// SetPriority sets the priority of files matching the given glob pattern.
+// Patterns without a slash match the file name only, others the whole path.
+// Files with a higher priority are pulled first, the default is zero.
+// Setting the priority of a pattern to zero removes it. If a file matches
+// several patterns, the one set first applies.
+func (q *jobQueue) SetPriority(pattern string, priority int) error {
+	pattern = filepath.ToSlash(pattern)
+	g, err := glob.Compile(pattern, '/')
+	if err != nil {
+		return err
+	}
+
+	q.mut.Lock()
+	defer q.mut.Unlock()
+
+	found := false
+	for i, cur := range q.priorities {
+		if cur.pattern == pattern {
+			if priority == 0 {
+				q.priorities = append(q.priorities[:i], q.priorities[i+1:]...)
+			} else {
+				q.priorities[i].priority = priority
+			}
+			found = true
+			break
+		}
+	}
+	if !found && priority != 0 {
+		q.priorities = append(q.priorities, jobQueuePriority{pattern, g, priority})
+	}
+
+	for i := range q.queued {
+		q.queued[i].priority = q.priorityLocked(q.queued[i].name)
+	}
+	sort.Stable(highestPriorityFirst(q.queued))
+
+	return nil
+}
+
+// Priorities returns the currently set patterns and their priorities.
+func (q *jobQueue) Priorities() []PullPriority {
+	q.mut.Lock()
+	defer q.mut.Unlock()
+
+	res := make([]PullPriority, len(q.priorities))
+	for i, p := range q.priorities {
+		res[i] = PullPriority{p.pattern, p.priority}
+	}
+	return res
+}
+
+func (q *jobQueue) priorityLocked(file string) int {
+	if len(q.priorities) == 0 {
+		return 0
+	}
+	file = filepath.ToSlash(file)
+	base := path.Base(file)
+	for _, p := range q.priorities {
+		name := file
+		if !strings.Contains(p.pattern, "/") {
+			name = base
+		}
+		if p.glob.Match(name) {
+			return p.priority
+		}
+	}
+	return 0
 }
 
 func (q *jobQueue) Pop() (string, bool) {
@@ -54,6 +159,32 @@ func (q *jobQueue) Pop() (string, bool) {
 	return f, true
 }
 
+// PopSmall is like Pop, but returns the first queued file no larger than
+// maxSize, if any.
+func (q *jobQueue) PopSmall(maxSize int64) (string, bool) {
+	return q.popMatching(func(e jobQueueEntry) bool { return e.size <= maxSize })
+}
+
+// PopLarge is like Pop, but returns the first queued file larger than
+// maxSize, if any.
+func (q *jobQueue) PopLarge(maxSize int64) (string, bool) {
+	return q.popMatching(func(e jobQueueEntry) bool { return e.size > maxSize })
+}
+
+func (q *jobQueue) popMatching(match func(jobQueueEntry) bool) (string, bool) {
+	q.mut.Lock()
+	defer q.mut.Unlock()
+
+	for i, cur := range q.queued {
+		if match(cur) {
+			q.queued = append(q.queued[:i], q.queued[i+1:]...)
+			q.progress = append(q.progress, cur.name)
+			return cur.name, true
+		}
+	}
+	return "", false
+}
+
 func (q *jobQueue) BringToFront(filename string) {
 	q.mut.Lock()
 	defer q.mut.Unlock()
@@ -61,6 +192,11 @@ func (q *jobQueue) BringToFront(filename string) {
 	for i, cur := range q.queued {
 		if cur.name == filename {
 			if i > 0 {
+				// Keep the queue ordered by priority by giving the selected
+				// element at least the priority of the current first one.
+				if first := q.queued[0].priority; cur.priority < first {
+					cur.priority = first
+				}
 				// Shift the elements before the selected element one step to
 				// the right, overwriting the selected element
 				copy(q.queued[1:i+1], q.queued[0:])
@@ -72,6 +208,73 @@ func (q *jobQueue) BringToFront(filename string) {
 	}
 }
 
+// PushToBack moves the given file behind all other queued files of the same
+// priority.
+func (q *jobQueue) PushToBack(filename string) {
+	q.mut.Lock()
+	defer q.mut.Unlock()
+
+	for i, cur := range q.queued {
+		if cur.name == filename {
+			// The last index with the same or a higher priority
+			j := i + sort.Search(len(q.queued)-i, func(k int) bool {
+				return q.queued[i+k].priority < cur.priority
+			}) - 1
+			copy(q.queued[i:j], q.queued[i+1:j+1])
+			q.queued[j] = cur
+			return
+		}
+	}
+}
+
+// Skip removes the given file from the queue, and keeps it from being queued
+// again until ResetSkipped is called. It returns false if the file wasn't
+// queued.
+func (q *jobQueue) Skip(filename string) bool {
+	q.mut.Lock()
+	defer q.mut.Unlock()
+
+	for i, cur := range q.queued {
+		if cur.name == filename {
+			q.queued = append(q.queued[:i], q.queued[i+1:]...)
+			if q.skipped == nil {
+				q.skipped = make(map[string]struct{})
+			}
+			q.skipped[filename] = struct{}{}
+			return true
+		}
+	}
+	return false
+}
+
+// IsSkipped returns whether the given file has been skipped.
+func (q *jobQueue) IsSkipped(filename string) bool {
+	q.mut.Lock()
+	defer q.mut.Unlock()
+	_, ok := q.skipped[filename]
+	return ok
+}
+
+// Skipped returns the skipped files, sorted by name.
+func (q *jobQueue) Skipped() []string {
+	q.mut.Lock()
+	defer q.mut.Unlock()
+
+	res := make([]string, 0, len(q.skipped))
+	for name := range q.skipped {
+		res = append(res, name)
+	}
+	sort.Strings(res)
+	return res
+}
+
+// ResetSkipped allows the skipped files to be queued again.
+func (q *jobQueue) ResetSkipped() {
+	q.mut.Lock()
+	defer q.mut.Unlock()
+	q.skipped = nil
+}
+
 func (q *jobQueue) Done(file string) {
 	q.mut.Lock()
 	defer q.mut.Unlock()
@@ -132,6 +335,7 @@ func (q *jobQueue) Shuffle() {
 	defer q.mut.Unlock()
 
 	rand.Shuffle(q.queued)
+	sort.Stable(highestPriorityFirst(q.queued))
 }
 
 func (q *jobQueue) Reset() {
@@ -141,6 +345,13 @@ func (q *jobQueue) Reset() {
 	q.queued = nil
 }
 
+// Len returns the number of files in progress and queued.
+func (q *jobQueue) Len() int {
+	q.mut.Lock()
+	defer q.mut.Unlock()
+	return len(q.progress) + len(q.queued)
+}
+
 func (q *jobQueue) lenQueued() int {
 	q.mut.Lock()
 	defer q.mut.Unlock()
@@ -158,6 +369,7 @@ func (q *jobQueue) SortSmallestFirst() {
 	defer q.mut.Unlock()
 
 	sort.Sort(smallestFirst(q.queued))
+	sort.Stable(highestPriorityFirst(q.queued))
 }
 
 func (q *jobQueue) SortLargestFirst() {
@@ -165,6 +377,7 @@ func (q *jobQueue) SortLargestFirst() {
 	defer q.mut.Unlock()
 
 	sort.Sort(sort.Reverse(smallestFirst(q.queued)))
+	sort.Stable(highestPriorityFirst(q.queued))
 }
 
 func (q *jobQueue) SortOldestFirst() {
@@ -172,6 +385,7 @@ func (q *jobQueue) SortOldestFirst() {
 	defer q.mut.Unlock()
 
 	sort.Sort(oldestFirst(q.queued))
+	sort.Stable(highestPriorityFirst(q.queued))
 }
 
 func (q *jobQueue) SortNewestFirst() {
@@ -179,6 +393,7 @@ func (q *jobQueue) SortNewestFirst() {
 	defer q.mut.Unlock()
 
 	sort.Sort(sort.Reverse(oldestFirst(q.queued)))
+	sort.Stable(highestPriorityFirst(q.queued))
 }
 
 // The usual sort.Interface boilerplate
@@ -194,3 +409,9 @@ type oldestFirst []jobQueueEntry
 func (q oldestFirst) Len() int           { return len(q) }
 func (q oldestFirst) Less(a, b int) bool { return q[a].modified < q[b].modified }
 func (q oldestFirst) Swap(a, b int)      { q[a], q[b] = q[b], q[a] }
+
+type highestPriorityFirst []jobQueueEntry
+
+func (q highestPriorityFirst) Len() int           { return len(q) }
+func (q highestPriorityFirst) Less(a, b int) bool { return q[a].priority > q[b].priority }
+func (q highestPriorityFirst) Swap(a, b int)      { q[a], q[b] = q[b], q[a] }
@@ -163,6 +163,74 @@ func TestBringToFront(t *testing.T) {
 	}
 }
 
+func TestPushToBack(t *testing.T) {
+	q := newJobQueue()
+	if err := q.SetPriority("*.docx", 10); err != nil {
+		t.Fatal(err)
+	}
+	q.Push("a.docx", 0, time.Time{})
+	q.Push("b.docx", 0, time.Time{})
+	q.Push("c.docx", 0, time.Time{})
+	q.Push("d.txt", 0, time.Time{})
+	q.Push("e.txt", 0, time.Time{})
+
+	check := func(what string, expected []string) {
+		t.Helper()
+		_, actual, _ := q.Jobs(1, 100)
+		if diff, equal := messagediff.PrettyDiff(expected, actual); !equal {
+			t.Errorf("%s diff:\n%s", what, diff)
+		}
+	}
+
+	// Files stay ahead of those with a lower priority.
+	q.PushToBack("a.docx")
+	check("PushToBack(a.docx)", []string{"b.docx", "c.docx", "a.docx", "d.txt", "e.txt"})
+
+	q.PushToBack("d.txt")
+	check("PushToBack(d.txt)", []string{"b.docx", "c.docx", "a.docx", "e.txt", "d.txt"})
+
+	q.PushToBack("d.txt") // corner case: last element
+	q.PushToBack("nonexistent")
+	check("PushToBack(d.txt)", []string{"b.docx", "c.docx", "a.docx", "e.txt", "d.txt"})
+}
+
+func TestSkip(t *testing.T) {
+	q := newJobQueue()
+	q.Push("f1", 0, time.Time{})
+	q.Push("f2", 0, time.Time{})
+	q.Push("f3", 0, time.Time{})
+
+	if !q.Skip("f2") {
+		t.Fatal("skipping a queued file failed")
+	}
+	if q.Skip("f4") {
+		t.Error("skipping a file that isn't queued succeeded")
+	}
+	if !q.IsSkipped("f2") || q.IsSkipped("f1") {
+		t.Error("wrong skipped state")
+	}
+
+	// Skipped files aren't queued again, even across a reset.
+	q.Reset()
+	q.Push("f1", 0, time.Time{})
+	q.Push("f2", 0, time.Time{})
+	if _, queued, _ := q.Jobs(1, 100); !slices.Equal(queued, []string{"f1"}) {
+		t.Errorf("unexpected queue %v", queued)
+	}
+	if skipped := q.Skipped(); !slices.Equal(skipped, []string{"f2"}) {
+		t.Errorf("unexpected skipped files %v", skipped)
+	}
+	if n := q.Len(); n != 1 {
+		t.Errorf("Len() = %d, expected 1", n)
+	}
+
+	q.ResetSkipped()
+	q.Push("f2", 0, time.Time{})
+	if _, queued, _ := q.Jobs(1, 100); !slices.Equal(queued, []string{"f1", "f2"}) {
+		t.Errorf("unexpected queue %v", queued)
+	}
+}
+
 func TestShuffle(t *testing.T) {
 	q := newJobQueue()
 	q.Push("f1", 0, time.Time{})
@@ -220,6 +288,94 @@ func TestSortBySize(t *testing.T) {
 	}
 }
 
+func TestPriority(t *testing.T) {
+	q := newJobQueue()
+	if err := q.SetPriority("*.docx", 10); err != nil {
+		t.Fatal(err)
+	}
+	q.Push("a.iso", 40, time.Time{})
+	q.Push("b.docx", 30, time.Time{})
+	q.Push("c.txt", 20, time.Time{})
+	q.Push("d.docx", 10, time.Time{})
+
+	check := func(what string, expected []string) {
+		t.Helper()
+		_, actual, _ := q.Jobs(1, 100)
+		if diff, equal := messagediff.PrettyDiff(expected, actual); !equal {
+			t.Errorf("%s diff:\n%s", what, diff)
+		}
+	}
+
+	check("Push()", []string{"b.docx", "d.docx", "a.iso", "c.txt"})
+
+	// The pull order applies within a priority level.
+	q.SortSmallestFirst()
+	check("SortSmallestFirst()", []string{"d.docx", "b.docx", "c.txt", "a.iso"})
+
+	// Patterns with a slash match the full path, and negative priorities
+	// come after the default.
+	if err := q.SetPriority("dir/*", 5); err != nil {
+		t.Fatal(err)
+	}
+	if err := q.SetPriority("*.iso", -1); err != nil {
+		t.Fatal(err)
+	}
+	q.Push("dir/e.iso", 50, time.Time{})
+	q.Push("f.txt", 5, time.Time{})
+	check("SetPriority()", []string{"d.docx", "b.docx", "dir/e.iso", "c.txt", "f.txt", "a.iso"})
+
+	// Bringing to front trumps priorities.
+	q.BringToFront("a.iso")
+	check("BringToFront()", []string{"a.iso", "d.docx", "b.docx", "dir/e.iso", "c.txt", "f.txt"})
+
+	// Changing patterns resorts the whole queue.
+	if err := q.SetPriority("*.docx", 0); err != nil {
+		t.Fatal(err)
+	}
+	q.SortLargestFirst()
+	check("SetPriority(0)", []string{"dir/e.iso", "b.docx", "c.txt", "d.docx", "f.txt", "a.iso"})
+
+	expected := []PullPriority{{"dir/*", 5}, {"*.iso", -1}}
+	if diff, equal := messagediff.PrettyDiff(expected, q.Priorities()); !equal {
+		t.Errorf("Priorities() diff:\n%s", diff)
+	}
+
+	if err := q.SetPriority("[", 1); err == nil {
+		t.Error("expected error for invalid pattern")
+	}
+}
+
+func TestPopSmall(t *testing.T) {
+	q := newJobQueue()
+	q.Push("large1", 1<<30, time.Time{})
+	q.Push("small1", 1<<10, time.Time{})
+	q.Push("large2", 1<<30, time.Time{})
+	q.Push("small2", 1<<20, time.Time{})
+
+	for _, exp := range []string{"small1", "small2"} {
+		if n, ok := q.PopSmall(1 << 20); !ok || n != exp {
+			t.Fatalf("PopSmall returned %q, %v, expected %q", n, ok, exp)
+		}
+	}
+	if n, ok := q.PopSmall(1 << 20); ok {
+		t.Fatalf("PopSmall returned %q, expected nothing", n)
+	}
+
+	progress, queued, _ := q.Jobs(1, 100)
+	if !slices.Equal(progress, []string{"small1", "small2"}) {
+		t.Errorf("unexpected progress %v", progress)
+	}
+	if !slices.Equal(queued, []string{"large1", "large2"}) {
+		t.Errorf("unexpected queue %v", queued)
+	}
+
+	q.Push("small3", 0, time.Time{})
+	q.BringToFront("small3")
+	if n, ok := q.PopLarge(1 << 20); !ok || n != "large1" {
+		t.Fatalf("PopLarge returned %q, %v, expected large1", n, ok)
+	}
+}
+
 func TestSortByAge(t *testing.T) {
 	q := newJobQueue()
 	q.Push("f1", 0, time.Unix(20, 0))
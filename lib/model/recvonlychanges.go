@@ -0,0 +1,168 @@
+// Copyright (C) 2026 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package model
+
+import (
+	"time"
+
+	"github.com/syncthing/syncthing/lib/config"
+	"github.com/syncthing/syncthing/lib/osutil"
+	"github.com/syncthing/syncthing/lib/protocol"
+)
+
+// The kinds of local changes in a receive only folder.
+const (
+	ReceiveOnlyAdded    = "added"    // the file doesn't exist globally
+	ReceiveOnlyDeleted  = "deleted"  // the file was deleted locally
+	ReceiveOnlyModified = "modified" // the contents differ
+	ReceiveOnlyMetadata = "metadata" // only the modification time, permissions or similar differ
+)
+
+// ReceiveOnlyChange is a local change held back in a receive only folder,
+// compared to the global version that reverting it would restore. For
+// modified files, ChangedBlocks and ChangedBytes tell how much of the local
+// data is not in the global version.
+type ReceiveOnlyChange struct {
+	Name          string                `json:"name"`
+	Type          protocol.FileInfoType `json:"type"`
+	Action        string                `json:"action"`
+	LocalSize     int64                 `json:"localSize"`
+	LocalModTime  time.Time             `json:"localModTime"`
+	GlobalSize    int64                 `json:"globalSize"`
+	GlobalModTime time.Time             `json:"globalModTime,omitempty"`
+	ChangedBlocks int                   `json:"changedBlocks,omitempty"`
+	TotalBlocks   int                   `json:"totalBlocks,omitempty"`
+	ChangedBytes  int64                 `json:"changedBytes,omitempty"`
+}
+
+// ReceiveOnlyChanges returns the local changes held back in the receive
+// only folder, with how each differs from the global version.
+func (m *model) ReceiveOnlyChanges(folder string, page, perpage int) ([]ReceiveOnlyChange, error) {
+	m.mut.RLock()
+	rf, ok := m.folderFiles[folder]
+	cfg := m.folderCfgs[folder]
+	m.mut.RUnlock()
+
+	if !ok {
+		return nil, ErrFolderMissing
+	}
+	if cfg.Type != config.FolderTypeReceiveOnly {
+		return nil, errNotReceiveOnly
+	}
+
+	snap, err := rf.Snapshot()
+	if err != nil {
+		return nil, err
+	}
+	defer snap.Release()
+
+	if snap.ReceiveOnlyChangedSize().TotalItems() == 0 {
+		return nil, nil
+	}
+
+	p := newPager(page, perpage)
+	changes := make([]ReceiveOnlyChange, 0, perpage)
+
+	snap.WithHave(protocol.LocalDeviceID, func(intf protocol.FileIntf) bool {
+		if !intf.IsReceiveOnlyChanged() {
+			return true
+		}
+		if p.skip() {
+			return true
+		}
+		gf, ok := snap.GetGlobal(intf.FileName())
+		changes = append(changes, receiveOnlyChange(intf.(protocol.FileInfo), gf, ok))
+		return !p.done()
+	})
+
+	return changes, nil
+}
+
+func receiveOnlyChange(fi, gf protocol.FileInfo, hasGlobal bool) ReceiveOnlyChange {
+	change := ReceiveOnlyChange{
+		Name:         fi.Name,
+		Type:         fi.Type,
+		LocalSize:    fi.FileSize(),
+		LocalModTime: fi.ModTime(),
+	}
+	if hasGlobal && gf.IsReceiveOnlyChanged() {
+		// The global file is our own, there is nothing else to revert to.
+		hasGlobal = false
+	}
+	if hasGlobal && !gf.IsDeleted() {
+		change.GlobalSize = gf.FileSize()
+		change.GlobalModTime = gf.ModTime()
+	}
+
+	switch {
+	case fi.IsDeleted():
+		change.Action = ReceiveOnlyDeleted
+	case !hasGlobal || gf.IsDeleted():
+		change.Action = ReceiveOnlyAdded
+	case fi.Type != gf.Type:
+		change.Action = ReceiveOnlyModified
+	case fi.IsSymlink():
+		change.Action = ReceiveOnlyMetadata
+		if fi.SymlinkTarget != gf.SymlinkTarget {
+			change.Action = ReceiveOnlyModified
+		}
+	case fi.IsDirectory() || fi.BlocksEqual(gf):
+		change.Action = ReceiveOnlyMetadata
+	default:
+		change.Action = ReceiveOnlyModified
+		change.TotalBlocks = len(fi.Blocks)
+		change.ChangedBlocks, change.ChangedBytes = changedBlocks(fi, gf)
+	}
+	return change
+}
+
+// changedBlocks returns the number and total size of the blocks in the
+// local file that aren't in the global one. Files with different block
+// sizes can't be compared block by block, and are entirely changed.
+func changedBlocks(fi, gf protocol.FileInfo) (int, int64) {
+	if fi.BlockSize() != gf.BlockSize() {
+		return len(fi.Blocks), fi.FileSize()
+	}
+	global := make(map[string]struct{}, len(gf.Blocks))
+	for _, b := range gf.Blocks {
+		global[string(b.Hash)] = struct{}{}
+	}
+	var n int
+	var bytes int64
+	for _, b := range fi.Blocks {
+		if _, ok := global[string(b.Hash)]; !ok {
+			n++
+			bytes += int64(b.Size)
+		}
+	}
+	return n, bytes
+}
+
+// RevertPaths reverts the local changes to the given paths in the receive
+// only folder, and anything below them, keeping the other local changes.
+func (m *model) RevertPaths(folder string, paths []string) error {
+	m.mut.RLock()
+	err := m.checkFolderRunningRLocked(folder)
+	runner, _ := m.folderRunners.Get(folder)
+	cfg := m.folderCfgs[folder]
+	m.mut.RUnlock()
+	if err != nil {
+		return err
+	}
+	if cfg.Type != config.FolderTypeReceiveOnly {
+		return errNotReceiveOnly
+	}
+	if len(paths) == 0 {
+		return nil
+	}
+
+	native := make([]string, len(paths))
+	for i, path := range paths {
+		native[i] = osutil.NativeFilename(path)
+	}
+	return runner.RevertPaths(native)
+}
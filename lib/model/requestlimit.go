@@ -0,0 +1,137 @@
+// Copyright (C) 2026 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package model
+
+import (
+	"context"
+	"runtime/debug"
+	"time"
+
+	"github.com/syncthing/syncthing/lib/protocol"
+	"github.com/syncthing/syncthing/lib/semaphore"
+	"github.com/syncthing/syncthing/lib/sync"
+	"github.com/syncthing/syncthing/lib/ur"
+)
+
+const (
+	// How often the request limit is adjusted, given enough requests.
+	requestLimitInterval    = time.Second
+	requestLimitMinRequests = 4
+	// The most pending request data we'll ever allow, regardless of
+	// memory, and the share of memory we'll use at most.
+	maxAdaptivePendingBytes = 256 << 20
+	adaptivePendingMemDiv   = 64
+)
+
+// requestLimit limits the amount of data in pending block requests. An
+// adaptive limit is adjusted to about twice the bandwidth-delay product of
+// the requests, measured as the throughput times the smallest latency seen
+// so far, as latencies beyond that are due to queueing. That is enough data
+// in flight to keep the links busy, while more would just sit in queues
+// using memory. The limit stays within the given bounds.
+type requestLimit struct {
+	sem      *semaphore.Semaphore
+	min, max int
+
+	mut         sync.Mutex
+	limit       int
+	windowStart time.Time
+	bytes       int
+	requests    int
+	minLatency  time.Duration
+}
+
+func newRequestLimit(initial, minBytes, maxBytes int) *requestLimit {
+	initial = min(max(initial, minBytes), maxBytes)
+	return &requestLimit{
+		sem:   semaphore.New(initial),
+		min:   minBytes,
+		max:   maxBytes,
+		mut:   sync.NewMutex(),
+		limit: initial,
+	}
+}
+
+// newFixedRequestLimit returns a request limit that is never adjusted.
+func newFixedRequestLimit(bytes int) *requestLimit {
+	return newRequestLimit(bytes, bytes, bytes)
+}
+
+// adaptivePendingBytesMax returns the most pending request data an adaptive
+// limit may grow to, given the memory available to us, but not less than
+// the initial amount.
+func adaptivePendingBytesMax(initial int) int {
+	budget := ur.MemorySize() / adaptivePendingMemDiv
+	if limit := debug.SetMemoryLimit(-1); budget == 0 || limit/adaptivePendingMemDiv < budget {
+		// A memory limit is set for the process, respect that rather
+		// than the size of physical memory.
+		budget = limit / adaptivePendingMemDiv
+	}
+	return max(int(min(budget, maxAdaptivePendingBytes)), initial)
+}
+
+func (r *requestLimit) TakeWithContext(ctx context.Context, bytes int) error {
+	return r.sem.TakeWithContext(ctx, bytes)
+}
+
+func (r *requestLimit) Give(bytes int) {
+	r.sem.Give(bytes)
+}
+
+// requestDone records a successful request of the given size and latency.
+func (r *requestLimit) requestDone(bytes int, latency time.Duration) {
+	if r.min == r.max {
+		return
+	}
+	r.mut.Lock()
+	defer r.mut.Unlock()
+	r.recordLocked(time.Now(), bytes, latency)
+}
+
+func (r *requestLimit) recordLocked(now time.Time, bytes int, latency time.Duration) {
+	if r.minLatency == 0 || latency < r.minLatency {
+		r.minLatency = latency
+	}
+	if r.windowStart.IsZero() {
+		// Start measuring with this request, so that any idle time before
+		// it doesn't count against the throughput.
+		r.windowStart = now
+		return
+	}
+	r.bytes += bytes
+	r.requests++
+
+	elapsed := now.Sub(r.windowStart)
+	if elapsed < requestLimitInterval || r.requests < requestLimitMinRequests {
+		return
+	}
+
+	throughput := float64(r.bytes) / elapsed.Seconds()
+	target := int(2 * throughput * r.minLatency.Seconds())
+	// Don't change too abruptly in either direction, as the measurement
+	// itself is limited by the current limit.
+	target = min(max(target, r.limit/2, r.min), 2*r.limit, r.max)
+	if target != r.limit {
+		l.Debugf("adjusting pending request limit from %d to %d bytes (%.0f B/s, %v)", r.limit, target, throughput, r.minLatency)
+		r.limit = target
+		r.sem.SetCapacity(target)
+	}
+
+	r.windowStart = time.Time{}
+	r.bytes = 0
+	r.requests = 0
+}
+
+// newPullerRequestLimit returns the request limit for a puller with the
+// given amount of pending data. It's adaptive, unless the amount was
+// configured explicitly.
+func (f *sendReceiveFolder) newPullerRequestLimit(pendingBytes int) *requestLimit {
+	if !f.adaptivePending {
+		return newFixedRequestLimit(pendingBytes)
+	}
+	return newRequestLimit(pendingBytes, min(pendingBytes, protocol.MaxBlockSize), adaptivePendingBytesMax(pendingBytes))
+}
@@ -0,0 +1,77 @@
+// Copyright (C) 2026 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package model
+
+import (
+	"testing"
+	"time"
+)
+
+// simulateRequests records a second worth of requests of the given size,
+// as many as the limit allows in flight on a link with the given latency
+// and bandwidth, advancing the clock, and returns the resulting limit.
+func simulateRequests(r *requestLimit, clock *time.Time, size int, latency time.Duration, bandwidth float64) int {
+	inFlight := r.limit / size
+	lat := latency
+	if rate := float64(inFlight*size) / latency.Seconds(); rate > bandwidth {
+		// The link is saturated and requests queue up.
+		lat = time.Duration(float64(inFlight*size) / bandwidth * float64(time.Second))
+	}
+	interval := lat / time.Duration(inFlight)
+
+	r.mut.Lock()
+	defer r.mut.Unlock()
+	for end := clock.Add(time.Second); clock.Before(end); *clock = clock.Add(interval) {
+		r.recordLocked(*clock, size, lat)
+	}
+	return r.limit
+}
+
+func TestAdaptiveRequestLimit(t *testing.T) {
+	const mib = 1 << 20
+	const block = mib / 8
+
+	// A fast link with high latency needs more data in flight than the
+	// initial 4 MiB: 100 MiB/s at 200 ms is a bandwidth-delay product of
+	// 20 MiB, so we should settle at about 40 MiB.
+	clock := time.Now()
+	r := newRequestLimit(4*mib, block, 256*mib)
+	var limit int
+	for i := 0; i < 10; i++ {
+		limit = simulateRequests(r, &clock, block, 200*time.Millisecond, 100*mib)
+	}
+	if limit < 30*mib || limit > 50*mib {
+		t.Errorf("limit %d MiB, expected about 40 MiB", limit/mib)
+	}
+
+	// It shrinks again when the link is slower.
+	for i := 0; i < 10; i++ {
+		limit = simulateRequests(r, &clock, block, 200*time.Millisecond, 10*mib)
+	}
+	if limit < 2*mib || limit > 6*mib {
+		t.Errorf("limit %d MiB, expected about 4 MiB", limit/mib)
+	}
+
+	// It never exceeds the maximum.
+	r = newRequestLimit(4*mib, block, 16*mib)
+	for i := 0; i < 10; i++ {
+		limit = simulateRequests(r, &clock, block, 200*time.Millisecond, 100*mib)
+	}
+	if limit != 16*mib {
+		t.Errorf("limit %d MiB, expected the maximum 16 MiB", limit/mib)
+	}
+}
+
+func TestFixedRequestLimit(t *testing.T) {
+	r := newFixedRequestLimit(4 << 20)
+	for i := 0; i < 100; i++ {
+		r.requestDone(128<<10, time.Second)
+	}
+	if r.limit != 4<<20 {
+		t.Errorf("fixed limit changed to %d", r.limit)
+	}
+}
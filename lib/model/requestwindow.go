@@ -0,0 +1,117 @@
+// Copyright (C) 2026 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package model
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/syncthing/syncthing/lib/protocol"
+	"github.com/syncthing/syncthing/lib/semaphore"
+	"github.com/syncthing/syncthing/lib/sync"
+)
+
+const (
+	// The number of concurrent requests a connection starts out with, and
+	// the bounds for it.
+	initialRequestWindow = 16
+	minRequestWindow     = 2
+	maxRequestWindow     = 512
+	// Requests taking longer than this multiple of the lowest latency seen
+	// are taken to be queueing up somewhere along the way.
+	requestWindowQueueFactor = 2
+)
+
+// requestWindow limits the number of concurrent requests sent over a
+// connection, adjusting the limit much like TCP congestion control. The
+// window grows by one for each successful request until the first sign of
+// congestion, and after that by one per window's worth of requests. It
+// shrinks by a quarter when latency rises well above the lowest seen, as
+// requests are then queueing rather than using more bandwidth, and by half
+// when requests fail. It shrinks at most once per round trip, as the
+// requests already in flight were sent before the previous adjustment.
+type requestWindow struct {
+	sem *semaphore.Semaphore
+
+	mut          sync.Mutex
+	window       float64
+	limit        int
+	slowStart    bool
+	minLatency   time.Duration
+	lastDecrease time.Time
+}
+
+func newRequestWindow() *requestWindow {
+	return &requestWindow{
+		sem:       semaphore.New(initialRequestWindow),
+		mut:       sync.NewMutex(),
+		window:    initialRequestWindow,
+		limit:     initialRequestWindow,
+		slowStart: true,
+	}
+}
+
+// take waits for room in the window for another request.
+func (w *requestWindow) take(ctx context.Context) error {
+	return w.sem.TakeWithContext(ctx, 1)
+}
+
+// done records the outcome of a request started with take, making room for
+// another one.
+func (w *requestWindow) done(latency time.Duration, err error) {
+	w.mut.Lock()
+	w.recordLocked(time.Now(), latency, err)
+	w.mut.Unlock()
+	w.sem.Give(1)
+}
+
+func (w *requestWindow) recordLocked(now time.Time, latency time.Duration, err error) {
+	switch {
+	case err != nil:
+		if !isCongestionError(err) {
+			return
+		}
+		w.decreaseLocked(now, latency, 0.5)
+	case w.minLatency != 0 && latency > requestWindowQueueFactor*w.minLatency:
+		w.decreaseLocked(now, latency, 0.75)
+	default:
+		if w.minLatency == 0 || latency < w.minLatency {
+			w.minLatency = latency
+		}
+		if w.slowStart {
+			w.window++
+		} else {
+			w.window += 1 / w.window
+		}
+		w.window = min(w.window, maxRequestWindow)
+	}
+
+	if limit := int(w.window); limit != w.limit {
+		l.Debugf("adjusting request window from %d to %d (%v, lowest %v, err %v)", w.limit, limit, latency, w.minLatency, err)
+		w.limit = limit
+		w.sem.SetCapacity(limit)
+	}
+}
+
+func (w *requestWindow) decreaseLocked(now time.Time, latency time.Duration, factor float64) {
+	w.slowStart = false
+	if now.Sub(w.lastDecrease) < latency {
+		return
+	}
+	w.window = max(w.window*factor, minRequestWindow)
+	w.lastDecrease = now
+}
+
+// isCongestionError returns whether a failed request hints at the device or
+// the link being overwhelmed, as opposed to the request itself being wrong
+// or given up on.
+func isCongestionError(err error) bool {
+	return !errors.Is(err, protocol.ErrNoSuchFile) &&
+		!errors.Is(err, protocol.ErrInvalid) &&
+		!errors.Is(err, context.Canceled)
+}
@@ -0,0 +1,79 @@
+// Copyright (C) 2026 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package model
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/syncthing/syncthing/lib/protocol"
+)
+
+func TestRequestWindow(t *testing.T) {
+	const rtt = 100 * time.Millisecond
+	clock := time.Now()
+	w := newRequestWindow()
+	record := func(latency time.Duration, err error) int {
+		clock = clock.Add(rtt)
+		w.mut.Lock()
+		defer w.mut.Unlock()
+		w.recordLocked(clock, latency, err)
+		return w.limit
+	}
+
+	// Successful requests at a steady latency grow the window by one each
+	// to start with.
+	for i := 0; i < 16; i++ {
+		record(rtt, nil)
+	}
+	if w.limit != 2*initialRequestWindow {
+		t.Errorf("window %d after slow start, expected %d", w.limit, 2*initialRequestWindow)
+	}
+
+	// Latency rising well above the lowest seen shrinks it by a quarter,
+	// and ends the slow start.
+	if limit := record(3*rtt, nil); limit != 24 {
+		t.Errorf("window %d after queueing, expected 24", limit)
+	}
+	for i := 0; i < 25; i++ {
+		record(rtt, nil)
+	}
+	if w.limit != 25 {
+		t.Errorf("window %d after about a window's worth of requests, expected 25", w.limit)
+	}
+
+	// Errors halve it, but only once per round trip.
+	if limit := record(rtt, protocol.ErrTimeout); limit != 12 {
+		t.Errorf("window %d after an error, expected 12", limit)
+	}
+	w.mut.Lock()
+	w.recordLocked(clock.Add(rtt/2), rtt, protocol.ErrGeneric)
+	w.mut.Unlock()
+	if w.limit != 12 {
+		t.Errorf("window %d after a second error in the same round trip, expected 12", w.limit)
+	}
+
+	// Errors about the request itself don't count.
+	for _, err := range []error{protocol.ErrNoSuchFile, protocol.ErrInvalid, fmt.Errorf("pull: %w", context.Canceled)} {
+		if limit := record(rtt, err); limit != 12 {
+			t.Errorf("window %d after %v, expected 12", limit, err)
+		}
+	}
+
+	// It never shrinks below the minimum.
+	for i := 0; i < 10; i++ {
+		record(rtt, protocol.ErrTimeout)
+	}
+	if w.limit != minRequestWindow {
+		t.Errorf("window %d after many errors, expected %d", w.limit, minRequestWindow)
+	}
+	if avail := w.sem.Available(); avail != minRequestWindow {
+		t.Errorf("%d requests available, expected %d", avail, minRequestWindow)
+	}
+}
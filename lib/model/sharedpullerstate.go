@@ -34,6 +34,8 @@ type sharedPullerState struct {
 	sparse      bool
 	created     time.Time
 	fsync       bool
+	appendFrom  int64 // Where the temporary file starts, when appending to the existing file
+	inPlace     bool  // Whether the existing file is changed in place instead
 
 	// Mutable, must be locked for access
 	err               error           // The first error we hit
@@ -90,16 +92,22 @@ type PullerProgress struct {
 // lockedWriterAt adds a lock to protect from closing the fd at the same time as writing.
 // WriteAt() is goroutine safe by itself, but not against for example Close().
 type lockedWriterAt struct {
-	mut sync.RWMutex
-	fd  fs.File
+	mut     sync.RWMutex
+	fd      fs.File
+	offset  int64           // offset in the final file of the start of fd
+	journal *inPlaceJournal // set when fd is the final file, changed in place
 }
 
 // WriteAt itself is goroutine safe, thus just needs to acquire a read-lock to
-// prevent closing concurrently (see SyncClose).
+// prevent closing concurrently (see SyncClose). The offset is in the final
+// file.
 func (w *lockedWriterAt) WriteAt(p []byte, off int64) (n int, err error) {
 	w.mut.RLock()
 	defer w.mut.RUnlock()
-	return w.fd.WriteAt(p, off)
+	if w.journal != nil {
+		return w.journal.WriteAt(p, off)
+	}
+	return w.fd.WriteAt(p, off-w.offset)
 }
 
 // SyncClose ensures that no more writes are happening before going ahead and
@@ -114,7 +122,13 @@ func (w *lockedWriterAt) SyncClose(fsync bool) error {
 			l.Debugf("fsync failed: %v", err)
 		}
 	}
-	return w.fd.Close()
+	err := w.fd.Close()
+	if w.journal != nil {
+		if jerr := w.journal.Close(); err == nil {
+			err = jerr
+		}
+	}
+	return err
 }
 
 // tempFile returns the fd for the temporary file, reusing an open fd
@@ -147,6 +161,10 @@ func (s *sharedPullerState) addWriterLocked() error {
 
 // tempFileInWritableDir should only be called from tempFile.
 func (s *sharedPullerState) tempFileInWritableDir(_ string) error {
+	if s.inPlace {
+		return s.inPlaceFileInWritableDir()
+	}
+
 	// The permissions to use for the temporary file should be those of the
 	// final file, except we need user read & write at minimum. The
 	// permissions will be set to the final value later, but in the meantime
@@ -190,7 +208,7 @@ func (s *sharedPullerState) tempFileInWritableDir(_ string) error {
 	// Don't truncate symlink files, as that will mean that the path will
 	// contain a bunch of nulls.
 	if s.sparse && !s.file.IsSymlink() {
-		size := s.file.Size
+		size := s.file.Size - s.appendFrom
 		// Trailer added to encrypted files
 		if len(s.file.Encrypted) > 0 {
 			size += encryptionTrailerSize(s.file)
@@ -222,7 +240,37 @@ func (s *sharedPullerState) tempFileInWritableDir(_ string) error {
 	}
 
 	// Same fd will be used by all writers
-	s.writer = &lockedWriterAt{sync.NewRWMutex(), fd}
+	s.writer = &lockedWriterAt{sync.NewRWMutex(), fd, s.appendFrom, nil}
+	return nil
+}
+
+// inPlaceFileInWritableDir opens the existing file to be changed in place,
+// journaled, instead of a temporary file. It should only be called from
+// tempFileInWritableDir.
+func (s *sharedPullerState) inPlaceFileInWritableDir() error {
+	fd, err := s.fs.OpenFile(s.realName, fs.OptReadWrite, 0o666)
+	if err != nil {
+		return fmt.Errorf("opening file: %w", err)
+	}
+	journalName := inPlaceJournalName(s.realName)
+	journal, err := createInPlaceJournal(s.fs, journalName, fd, s.curFile.ModTime(), s.fsync)
+	if err != nil {
+		fd.Close()
+		return fmt.Errorf("creating journal: %w", err)
+	}
+	s.fs.Hide(journalName)
+
+	// A smaller file must be truncated, while a larger one may be extended
+	// up front like a temporary file.
+	if s.file.Size < journal.size || (s.sparse && s.file.Size > journal.size) {
+		if err := journal.Truncate(s.file.Size); err != nil {
+			journal.Close()
+			fd.Close()
+			return fmt.Errorf("resizing file: %w", err)
+		}
+	}
+
+	s.writer = &lockedWriterAt{sync.NewRWMutex(), fd, 0, journal}
 	return nil
 }
 
@@ -243,6 +291,24 @@ func (s *sharedPullerState) failLocked(err error) {
 	s.err = err
 }
 
+// cancel marks the sharedPullerState as failed with the given error, unless
+// it has already failed or been closed. It returns whether the state was
+// cancelled, and if so the number of bytes that were still to be done.
+func (s *sharedPullerState) cancel(err error) (bool, int64) {
+	s.mut.Lock()
+	defer s.mut.Unlock()
+
+	if s.closed || s.err != nil {
+		return false, 0
+	}
+	s.err = err
+
+	total := s.reused + s.copyTotal + s.pullTotal
+	remaining := s.copyNeeded + s.pullNeeded
+	file := len(s.file.Blocks)
+	return true, blocksToSize(total, file, s.file.BlockSize(), s.file.Size) - blocksToSize(total-remaining, file, s.file.BlockSize(), s.file.Size)
+}
+
 func (s *sharedPullerState) failed() error {
 	s.mut.RLock()
 	err := s.err
@@ -441,6 +507,10 @@ func (s *sharedPullerState) AvailableUpdated() time.Time {
 
 // Available returns blocks available in the current temporary file
 func (s *sharedPullerState) Available() []int {
+	if s.inPlace || s.appendFrom > 0 {
+		// There is no temporary file with the blocks at their offsets
+		return nil
+	}
 	s.mut.RLock()
 	blocks := s.available
 	s.mut.RUnlock()
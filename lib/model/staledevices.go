@@ -0,0 +1,98 @@
+// Copyright (C) 2026 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package model
+
+import (
+	"slices"
+	"time"
+
+	"github.com/syncthing/syncthing/lib/config"
+	"github.com/syncthing/syncthing/lib/events"
+	"github.com/syncthing/syncthing/lib/protocol"
+	"github.com/syncthing/syncthing/lib/stats"
+)
+
+// How often to look for devices that haven't been seen for the configured
+// number of days.
+const staleDeviceCheckInterval = time.Hour
+
+// pruneStaleDevices announces the devices that haven't been seen for the
+// configured number of days with a StaleDevice event, and removes them
+// when so configured, unless they are protected. Removing a device from
+// the configuration restarts the folders it shared, which drops its index
+// data. Flagged holds the last seen time of the devices already announced,
+// so that each is announced only once until it's seen again.
+func (m *model) pruneStaleDevices(flagged map[protocol.DeviceID]time.Time) {
+	opts := m.cfg.Options()
+	if opts.StaleDeviceDays <= 0 {
+		clear(flagged)
+		return
+	}
+
+	devStats, err := m.DeviceStatistics()
+	if err != nil {
+		l.Infoln("Checking for stale devices:", err)
+		return
+	}
+	cutoff := time.Now().Add(-time.Duration(opts.StaleDeviceDays) * 24 * time.Hour)
+
+	var remove []protocol.DeviceID
+	for id, devCfg := range m.cfg.Devices() {
+		st, ok := devStats[id]
+		if id == m.id || devCfg.ExemptFromPruning || !ok || !isStale(st, cutoff) {
+			delete(flagged, id)
+			continue
+		}
+		removed := opts.StaleDeviceRemove && !devCfg.Protected
+		if removed {
+			l.Infof("Removing device %v, last seen %v", devCfg.Description(), st.LastSeen)
+			remove = append(remove, id)
+		} else if flagged[id].Equal(st.LastSeen) {
+			continue
+		} else {
+			l.Infof("Device %v is stale, last seen %v", devCfg.Description(), st.LastSeen)
+			flagged[id] = st.LastSeen
+		}
+		m.evLogger.Log(events.StaleDevice, map[string]interface{}{
+			"device":   id.String(),
+			"name":     devCfg.Name,
+			"lastSeen": st.LastSeen,
+			"removed":  removed,
+		})
+	}
+	if len(remove) == 0 {
+		return
+	}
+
+	waiter, err := m.cfg.Modify(func(cfg *config.Configuration) {
+		cfg.Devices = slices.DeleteFunc(cfg.Devices, func(dev config.DeviceConfiguration) bool {
+			return slices.Contains(remove, dev.DeviceID)
+		})
+	})
+	if err != nil {
+		l.Warnln("Removing stale devices:", err)
+		return
+	}
+	waiter.Wait()
+
+	for _, id := range remove {
+		if err := stats.NewDeviceStatisticsReference(m.db, id).Forget(); err != nil {
+			l.Debugln("Forgetting statistics of removed device", id, err)
+		}
+		delete(flagged, id)
+	}
+}
+
+// isStale returns whether the device was last seen before the cutoff.
+// Devices that were never seen aren't stale, as there is no telling how
+// long they have been waiting to connect for the first time.
+func isStale(st stats.DeviceStatistics, cutoff time.Time) bool {
+	if st.LastSeen.Unix() <= 0 {
+		return false
+	}
+	return st.LastSeen.Before(cutoff)
+}
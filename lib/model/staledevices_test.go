@@ -0,0 +1,88 @@
+// Copyright (C) 2026 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package model
+
+import (
+	"testing"
+	"time"
+
+	"github.com/syncthing/syncthing/lib/config"
+	"github.com/syncthing/syncthing/lib/db"
+	"github.com/syncthing/syncthing/lib/events"
+	"github.com/syncthing/syncthing/lib/protocol"
+)
+
+func TestPruneStaleDevices(t *testing.T) {
+	w, fcfg, wCancel := newDefaultCfgWrapper()
+	defer wCancel()
+	waiter, _ := w.Modify(func(cfg *config.Configuration) {
+		cfg.SetDevice(newDeviceConfiguration(cfg.Defaults.Device, device2, "device2"))
+		cfg.Options.StaleDeviceDays = 7
+	})
+	waiter.Wait()
+	m := setupModel(t, w)
+	defer cleanupModelAndRemoveDir(m, fcfg.Filesystem(nil).URI())
+
+	// Device1 was last seen a month ago, device2 was never seen.
+	lastSeen := time.Now().Add(-30 * 24 * time.Hour).Truncate(time.Second)
+	if err := db.NewDeviceStatisticsNamespace(m.db, device1.String()).PutTime("lastSeen", lastSeen); err != nil {
+		t.Fatal(err)
+	}
+
+	sub := m.evLogger.Subscribe(events.StaleDevice)
+	defer sub.Unsubscribe()
+	flagged := make(map[protocol.DeviceID]time.Time)
+
+	m.pruneStaleDevices(flagged)
+	ev, err := sub.Poll(time.Second)
+	if err != nil {
+		t.Fatal("expected a stale device event:", err)
+	}
+	data := ev.Data.(map[string]interface{})
+	if data["device"] != device1.String() || data["removed"] != false {
+		t.Errorf("unexpected event data %v", data)
+	}
+
+	// A device is flagged only once.
+	m.pruneStaleDevices(flagged)
+	if _, err := sub.Poll(100 * time.Millisecond); err != events.ErrTimeout {
+		t.Error("stale device flagged twice")
+	}
+
+	// Exempt devices are kept.
+	waiter, _ = w.Modify(func(cfg *config.Configuration) {
+		cfg.Options.StaleDeviceRemove = true
+		dev, _, _ := cfg.Device(device1)
+		dev.ExemptFromPruning = true
+		cfg.SetDevice(dev)
+	})
+	waiter.Wait()
+	m.pruneStaleDevices(flagged)
+	if _, ok := w.Device(device1); !ok {
+		t.Fatal("exempt device removed")
+	}
+
+	waiter, _ = w.Modify(func(cfg *config.Configuration) {
+		dev, _, _ := cfg.Device(device1)
+		dev.ExemptFromPruning = false
+		cfg.SetDevice(dev)
+	})
+	waiter.Wait()
+	m.pruneStaleDevices(flagged)
+	if _, ok := w.Device(device1); ok {
+		t.Error("stale device not removed")
+	}
+	if _, ok := w.Device(device2); !ok {
+		t.Error("never seen device removed")
+	}
+	if ev, err := sub.Poll(time.Second); err != nil || ev.Data.(map[string]interface{})["removed"] != true {
+		t.Error("expected a stale device event for the removal")
+	}
+	if _, ok, _ := db.NewDeviceStatisticsNamespace(m.db, device1.String()).Time("lastSeen"); ok {
+		t.Error("statistics of removed device kept")
+	}
+}
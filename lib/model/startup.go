@@ -0,0 +1,149 @@
+// Copyright (C) 2026 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package model
+
+import (
+	"time"
+
+	"github.com/syncthing/syncthing/lib/config"
+	"github.com/syncthing/syncthing/lib/sync"
+)
+
+// The states a folder goes through when starting up.
+const (
+	FolderStartupPending = "pending" // waiting for a worker
+	FolderStartupLoading = "loading" // loading the index and starting the folder
+	FolderStartupStarted = "started"
+	FolderStartupFailed  = "failed"
+)
+
+// FolderStartup is the startup progress of a folder.
+type FolderStartup struct {
+	State     string    `json:"state"`
+	Started   time.Time `json:"started,omitempty"`
+	DurationS float64   `json:"durationS"`
+	Error     string    `json:"error,omitempty"`
+}
+
+// StartupStatus is the progress of starting the folders that are
+// configured when Syncthing starts. Paused folders aren't started and thus
+// not included.
+type StartupStatus struct {
+	Done    bool                     `json:"done"`
+	Folders map[string]FolderStartup `json:"folders"`
+}
+
+// startupTracker keeps track of the startup progress of the folders. It is
+// safe for use from multiple goroutines.
+type startupTracker struct {
+	mut     sync.Mutex
+	done    bool
+	folders map[string]FolderStartup
+}
+
+func newStartupTracker() *startupTracker {
+	return &startupTracker{
+		mut:     sync.NewMutex(),
+		folders: make(map[string]FolderStartup),
+	}
+}
+
+func (t *startupTracker) pending(folder string) {
+	t.mut.Lock()
+	t.folders[folder] = FolderStartup{State: FolderStartupPending}
+	t.mut.Unlock()
+}
+
+func (t *startupTracker) loading(folder string) {
+	t.mut.Lock()
+	t.folders[folder] = FolderStartup{State: FolderStartupLoading, Started: time.Now().Truncate(time.Second)}
+	t.mut.Unlock()
+}
+
+func (t *startupTracker) finished(folder string, t0 time.Time, err error) {
+	t.mut.Lock()
+	defer t.mut.Unlock()
+	st := t.folders[folder]
+	st.State = FolderStartupStarted
+	st.DurationS = time.Since(t0).Seconds()
+	if err != nil {
+		st.State = FolderStartupFailed
+		st.Error = err.Error()
+	}
+	t.folders[folder] = st
+}
+
+func (t *startupTracker) setDone() {
+	t.mut.Lock()
+	t.done = true
+	t.mut.Unlock()
+}
+
+func (t *startupTracker) status() StartupStatus {
+	t.mut.Lock()
+	defer t.mut.Unlock()
+	res := StartupStatus{
+		Done:    t.done,
+		Folders: make(map[string]FolderStartup, len(t.folders)),
+	}
+	for folder, st := range t.folders {
+		if st.State == FolderStartupLoading {
+			st.DurationS = time.Since(st.Started).Seconds()
+		}
+		res.Folders[folder] = st
+	}
+	return res
+}
+
+// startFolders starts the given folders, several at a time as loading the
+// index of a large folder can take a while. It returns the first error,
+// after all folders have been tried.
+func (m *model) startFolders(folders []config.FolderConfiguration, concurrency int, cacheIgnoredFiles bool) error {
+	if concurrency <= 0 || concurrency > len(folders) {
+		concurrency = len(folders)
+	}
+
+	for _, folderCfg := range folders {
+		m.startup.pending(folderCfg.ID)
+	}
+
+	queue := make(chan config.FolderConfiguration)
+	var firstErr error
+	errMut := sync.NewMutex()
+	wg := sync.NewWaitGroup()
+	for i := 0; i < concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for folderCfg := range queue {
+				m.startup.loading(folderCfg.ID)
+				t0 := time.Now()
+				err := m.newFolder(folderCfg, cacheIgnoredFiles)
+				m.startup.finished(folderCfg.ID, t0, err)
+				if err != nil {
+					errMut.Lock()
+					if firstErr == nil {
+						firstErr = err
+					}
+					errMut.Unlock()
+				}
+			}
+		}()
+	}
+	for _, folderCfg := range folders {
+		queue <- folderCfg
+	}
+	close(queue)
+	wg.Wait()
+
+	return firstErr
+}
+
+// StartupStatus returns the progress of starting the folders.
+func (m *model) StartupStatus() StartupStatus {
+	return m.startup.status()
+}
@@ -0,0 +1,54 @@
+// Copyright (C) 2026 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package model
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestStartupStatus(t *testing.T) {
+	w, cancel := newConfigWrapper(defaultCfg)
+	defer cancel()
+	cfg := w.RawCopy()
+	cfg.Options.RawMaxFolderConcurrency = 2
+	cfg.Folders = nil
+	for i := 0; i < 5; i++ {
+		fcfg := newFolderConfig()
+		fcfg.ID = fmt.Sprintf("folder%d", i)
+		cfg.Folders = append(cfg.Folders, fcfg)
+	}
+	paused := newFolderConfig()
+	paused.ID = "paused"
+	paused.Paused = true
+	cfg.Folders = append(cfg.Folders, paused)
+	replace(t, w, cfg)
+
+	m := newModel(t, w, myID, nil)
+	if st := m.StartupStatus(); st.Done || len(st.Folders) != 0 {
+		t.Errorf("Expected nothing before starting, got %+v", st)
+	}
+	m.ServeBackground()
+	defer cleanupModel(m)
+	<-m.started
+
+	st := m.StartupStatus()
+	if !st.Done {
+		t.Error("Expected startup to be done")
+	}
+	if len(st.Folders) != 5 {
+		t.Errorf("Expected the five unpaused folders, got %+v", st.Folders)
+	}
+	for id, fst := range st.Folders {
+		if fst.State != FolderStartupStarted || fst.Error != "" || fst.Started.IsZero() {
+			t.Errorf("Unexpected startup status for %v: %+v", id, fst)
+		}
+		if _, ok := m.folderRunners.Get(id); !ok {
+			t.Errorf("Folder %v isn't running", id)
+		}
+	}
+}
@@ -0,0 +1,153 @@
+// Copyright (C) 2026 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package model
+
+import (
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/syncthing/syncthing/lib/protocol"
+)
+
+// ClusterTopology is the graph of devices known to us, either from our own
+// configuration or from the cluster configs of connected devices, and the
+// folders they share with each other.
+type ClusterTopology struct {
+	Devices []TopologyDevice `json:"devices"`
+	Links   []TopologyLink   `json:"links"`
+}
+
+// TopologyDevice is a device in the cluster topology. Connection details,
+// the version and when the device was last seen are only known for
+// configured devices.
+type TopologyDevice struct {
+	ID             protocol.DeviceID `json:"id"`
+	Name           string            `json:"name"`
+	Local          bool              `json:"local"`
+	Configured     bool              `json:"configured"`
+	Connected      bool              `json:"connected"`
+	Paused         bool              `json:"paused"`
+	ConnectionType string            `json:"connectionType,omitempty"`
+	ClientVersion  string            `json:"clientVersion,omitempty"`
+	LastSeen       time.Time         `json:"lastSeen"`
+}
+
+// TopologyLink is a pair of devices sharing the given folders, as stated by
+// either of them.
+type TopologyLink struct {
+	From    protocol.DeviceID `json:"from"`
+	To      protocol.DeviceID `json:"to"`
+	Folders []string          `json:"folders"`
+}
+
+type topologyEdge struct {
+	from, to protocol.DeviceID
+}
+
+// ClusterTopology returns the devices and folder sharing links of the
+// cluster, as far as we can see it.
+func (m *model) ClusterTopology() ClusterTopology {
+	devCfgs := m.cfg.Devices()
+	folderCfgs := m.cfg.Folders()
+
+	m.mut.RLock()
+	defer m.mut.RUnlock()
+
+	devices := make(map[protocol.DeviceID]*TopologyDevice)
+	addDevice := func(id protocol.DeviceID, name string) *TopologyDevice {
+		dev, ok := devices[id]
+		if !ok {
+			dev = &TopologyDevice{ID: id, Local: id == m.id}
+			devices[id] = dev
+		}
+		if dev.Name == "" {
+			dev.Name = name
+		}
+		return dev
+	}
+
+	for _, devCfg := range devCfgs {
+		dev := addDevice(devCfg.DeviceID, devCfg.Name)
+		dev.Configured = true
+		if dev.Local {
+			dev.Connected = true
+			continue
+		}
+		dev.Paused = devCfg.Paused
+		if connIDs, ok := m.deviceConnIDs[devCfg.DeviceID]; ok {
+			dev.Connected = true
+			dev.ConnectionType = m.connections[connIDs[0]].Type()
+			dev.LastSeen = time.Now().Truncate(time.Second)
+		} else if sr, ok := m.deviceStatRefs[devCfg.DeviceID]; ok {
+			if stats, err := sr.GetStatistics(); err == nil {
+				dev.LastSeen = stats.LastSeen
+			}
+		}
+		if hello, ok := m.helloMessages[devCfg.DeviceID]; ok {
+			dev.ClientVersion = hello.ClientVersion
+			if hello.ClientName != "syncthing" {
+				dev.ClientVersion = hello.ClientName + " " + hello.ClientVersion
+			}
+			dev.ClientVersion = strings.TrimSpace(dev.ClientVersion)
+		}
+	}
+
+	links := make(map[topologyEdge][]string)
+	addLink := func(a, b protocol.DeviceID, folder string) {
+		if a == b {
+			return
+		}
+		if a.Compare(b) > 0 {
+			a, b = b, a
+		}
+		edge := topologyEdge{a, b}
+		if !slices.Contains(links[edge], folder) {
+			links[edge] = append(links[edge], folder)
+		}
+	}
+
+	// Our side: the folders we share with each device.
+	for _, folderCfg := range folderCfgs {
+		for _, dev := range folderCfg.Devices {
+			addLink(m.id, dev.DeviceID, folderCfg.ID)
+		}
+	}
+
+	// Their side: the devices each connected device shares the folders
+	// with, which may include devices we know nothing else about.
+	for remote, folders := range m.remoteClusterConfigs {
+		for _, folder := range folders {
+			for _, dev := range folder.Devices {
+				addDevice(dev.ID, dev.Name)
+				addLink(remote, dev.ID, folder.ID)
+			}
+		}
+	}
+
+	res := ClusterTopology{
+		Devices: make([]TopologyDevice, 0, len(devices)),
+		Links:   make([]TopologyLink, 0, len(links)),
+	}
+	for _, dev := range devices {
+		res.Devices = append(res.Devices, *dev)
+	}
+	slices.SortFunc(res.Devices, func(a, b TopologyDevice) int {
+		return a.ID.Compare(b.ID)
+	})
+	for edge, folders := range links {
+		slices.Sort(folders)
+		res.Links = append(res.Links, TopologyLink{From: edge.from, To: edge.to, Folders: folders})
+	}
+	slices.SortFunc(res.Links, func(a, b TopologyLink) int {
+		if c := a.From.Compare(b.From); c != 0 {
+			return c
+		}
+		return a.To.Compare(b.To)
+	})
+	return res
+}
@@ -0,0 +1,254 @@
+// Copyright (C) 2026 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package model
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"time"
+
+	"github.com/syncthing/syncthing/lib/config"
+	"github.com/syncthing/syncthing/lib/db"
+	"github.com/syncthing/syncthing/lib/protocol"
+	"github.com/syncthing/syncthing/lib/sync"
+)
+
+const (
+	trafficStatsKey          = "trafficStats"
+	trafficStatsSaveInterval = time.Minute
+)
+
+// TrafficStatistics is the traffic with a device since counting began.
+// The totals are everything sent over the connections to the device,
+// including protocol overhead, while the folder numbers are the file data
+// only.
+type TrafficStatistics struct {
+	Device        protocol.DeviceID         `json:"device"`
+	InBytesTotal  int64                     `json:"inBytesTotal"`
+	OutBytesTotal int64                     `json:"outBytesTotal"`
+	Folders       []FolderTrafficStatistics `json:"folders"`
+	Since         time.Time                 `json:"since"`
+}
+
+// FolderTrafficStatistics is the file data transferred with a device in a
+// folder.
+type FolderTrafficStatistics struct {
+	Folder   string `json:"folder"`
+	InBytes  int64  `json:"inBytes"`
+	OutBytes int64  `json:"outBytes"`
+}
+
+// connTraffic is the part of a connection's traffic already counted.
+type connTraffic struct {
+	conn    protocol.Connection
+	in, out int64
+}
+
+// trafficStats counts the bytes transferred per device, and per device and
+// folder, for good. Like the transfer quotas the counters are saved to the
+// database periodically. Connection totals are sampled at the same time
+// and when the connection closes.
+type trafficStats struct {
+	cfg config.Wrapper
+	kv  *db.NamespacedKV
+
+	mut     sync.Mutex
+	devices map[protocol.DeviceID]transferCounts
+	folders map[transferKey]transferCounts
+	conns   map[string]*connTraffic // connection ID -> counted so far
+	since   time.Time
+	dirty   bool
+}
+
+// trafficStatsState is the serialised form of the counters.
+type trafficStatsState struct {
+	Since   time.Time               `json:"since"`
+	Devices []transferQuotasCounter `json:"devices"`
+	Folders []transferQuotasCounter `json:"folders"`
+}
+
+func newTrafficStats(cfg config.Wrapper, kv *db.NamespacedKV) *trafficStats {
+	s := &trafficStats{
+		cfg:     cfg,
+		kv:      kv,
+		mut:     sync.NewMutex(),
+		devices: make(map[protocol.DeviceID]transferCounts),
+		folders: make(map[transferKey]transferCounts),
+		conns:   make(map[string]*connTraffic),
+	}
+	s.load()
+	if s.since.IsZero() {
+		s.since = time.Now().Truncate(time.Second)
+		s.dirty = true
+	}
+	return s
+}
+
+func (s *trafficStats) load() {
+	bs, ok, err := s.kv.Bytes(trafficStatsKey)
+	if err != nil || !ok {
+		return
+	}
+	var state trafficStatsState
+	if err := json.Unmarshal(bs, &state); err != nil {
+		l.Debugln("Loading traffic statistics:", err)
+		return
+	}
+	s.since = state.Since
+	for _, c := range state.Devices {
+		s.devices[c.Device] = transferCounts{c.In, c.Out}
+	}
+	for _, c := range state.Folders {
+		s.folders[transferKey{c.Folder, c.Device}] = transferCounts{c.In, c.Out}
+	}
+}
+
+func (s *trafficStats) serve(ctx context.Context) error {
+	t := time.NewTicker(trafficStatsSaveInterval)
+	defer t.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			s.sample()
+			s.save()
+			return nil
+		case <-t.C:
+			s.sample()
+			s.save()
+		}
+	}
+}
+
+func (s *trafficStats) save() {
+	s.mut.Lock()
+	if !s.dirty {
+		s.mut.Unlock()
+		return
+	}
+	state := trafficStatsState{
+		Since:   s.since,
+		Devices: make([]transferQuotasCounter, 0, len(s.devices)),
+		Folders: make([]transferQuotasCounter, 0, len(s.folders)),
+	}
+	for device, c := range s.devices {
+		state.Devices = append(state.Devices, transferQuotasCounter{Device: device, In: c.in, Out: c.out})
+	}
+	for key, c := range s.folders {
+		state.Folders = append(state.Folders, transferQuotasCounter{key.folder, key.device, c.in, c.out})
+	}
+	s.dirty = false
+	s.mut.Unlock()
+
+	bs, err := json.Marshal(state)
+	if err == nil {
+		err = s.kv.PutBytes(trafficStatsKey, bs)
+	}
+	if err != nil {
+		l.Warnln("Saving traffic statistics:", err)
+	}
+}
+
+// addFolder counts file data transferred with the device in the folder.
+func (s *trafficStats) addFolder(folder string, device protocol.DeviceID, in, out int64) {
+	key := transferKey{folder, device}
+	s.mut.Lock()
+	c := s.folders[key]
+	c.in += in
+	c.out += out
+	s.folders[key] = c
+	s.dirty = true
+	s.mut.Unlock()
+}
+
+// connected starts counting the traffic on the connection.
+func (s *trafficStats) connected(conn protocol.Connection) {
+	s.mut.Lock()
+	s.conns[conn.ConnectionID()] = &connTraffic{conn: conn}
+	s.mut.Unlock()
+}
+
+// closed counts the remaining traffic on the connection and stops
+// tracking it.
+func (s *trafficStats) closed(conn protocol.Connection) {
+	s.mut.Lock()
+	defer s.mut.Unlock()
+	if ct, ok := s.conns[conn.ConnectionID()]; ok {
+		s.sampleLocked(ct)
+		delete(s.conns, conn.ConnectionID())
+	}
+}
+
+// sample counts the traffic on the connections since the last sample.
+func (s *trafficStats) sample() {
+	s.mut.Lock()
+	defer s.mut.Unlock()
+	for _, ct := range s.conns {
+		s.sampleLocked(ct)
+	}
+}
+
+func (s *trafficStats) sampleLocked(ct *connTraffic) {
+	st := ct.conn.Statistics()
+	in, out := st.InBytesTotal-ct.in, st.OutBytesTotal-ct.out
+	if in == 0 && out == 0 {
+		return
+	}
+	ct.in, ct.out = st.InBytesTotal, st.OutBytesTotal
+	device := ct.conn.DeviceID()
+	c := s.devices[device]
+	c.in += in
+	c.out += out
+	s.devices[device] = c
+	s.dirty = true
+}
+
+// statistics returns the counters for the configured devices, and any
+// other device traffic was counted for, sorted by device and folder.
+func (s *trafficStats) statistics() []TrafficStatistics {
+	devices := s.cfg.Devices()
+	myID := s.cfg.MyID()
+
+	s.sample()
+	s.mut.Lock()
+	defer s.mut.Unlock()
+
+	byDevice := make(map[protocol.DeviceID]*TrafficStatistics)
+	get := func(device protocol.DeviceID) *TrafficStatistics {
+		if ts, ok := byDevice[device]; ok {
+			return ts
+		}
+		ts := &TrafficStatistics{Device: device, Folders: []FolderTrafficStatistics{}, Since: s.since}
+		byDevice[device] = ts
+		return ts
+	}
+	for device := range devices {
+		if device != myID {
+			get(device)
+		}
+	}
+	for device, c := range s.devices {
+		ts := get(device)
+		ts.InBytesTotal, ts.OutBytesTotal = c.in, c.out
+	}
+	for key, c := range s.folders {
+		ts := get(key.device)
+		ts.Folders = append(ts.Folders, FolderTrafficStatistics{Folder: key.folder, InBytes: c.in, OutBytes: c.out})
+	}
+
+	res := make([]TrafficStatistics, 0, len(byDevice))
+	for _, ts := range byDevice {
+		sort.Slice(ts.Folders, func(a, b int) bool {
+			return ts.Folders[a].Folder < ts.Folders[b].Folder
+		})
+		res = append(res, *ts)
+	}
+	sort.Slice(res, func(a, b int) bool {
+		return res[a].Device.Compare(res[b].Device) < 0
+	})
+	return res
+}
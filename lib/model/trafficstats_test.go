@@ -0,0 +1,68 @@
+// Copyright (C) 2026 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package model
+
+import (
+	"testing"
+
+	"github.com/syncthing/syncthing/lib/db"
+	"github.com/syncthing/syncthing/lib/db/backend"
+	"github.com/syncthing/syncthing/lib/events"
+	"github.com/syncthing/syncthing/lib/protocol"
+)
+
+func TestTrafficStats(t *testing.T) {
+	w, fcfg, cancel := newDefaultCfgWrapper()
+	defer cancel()
+
+	ldb, err := db.NewLowlevel(backend.OpenMemory(), events.NoopLogger)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ldb.Close()
+	kv := db.NewMiscDataNamespace(ldb)
+
+	s := newTrafficStats(w, kv)
+	since := s.since
+
+	conn := newFakeConnection(device1, nil)
+	conn.StatisticsReturns(protocol.Statistics{InBytesTotal: 100, OutBytesTotal: 200})
+	s.connected(conn)
+	s.sample()
+	conn.StatisticsReturns(protocol.Statistics{InBytesTotal: 150, OutBytesTotal: 300})
+	s.closed(conn)
+	s.addFolder(fcfg.ID, device1, 10, 20)
+
+	// Counters survive a restart, connections closed since don't count
+	// again.
+	s.save()
+	s = newTrafficStats(w, kv)
+	s.sample()
+	if !s.since.Equal(since) {
+		t.Errorf("expected counting since %v, got %v", since, s.since)
+	}
+
+	stats := s.statistics()
+	var dev1 *TrafficStatistics
+	for i := range stats {
+		if stats[i].Device == myID {
+			t.Error("unexpected statistics for own device")
+		}
+		if stats[i].Device == device1 {
+			dev1 = &stats[i]
+		}
+	}
+	if dev1 == nil {
+		t.Fatal("missing statistics for", device1)
+	}
+	if dev1.InBytesTotal != 150 || dev1.OutBytesTotal != 300 {
+		t.Errorf("unexpected totals %+v", dev1)
+	}
+	if len(dev1.Folders) != 1 || dev1.Folders[0] != (FolderTrafficStatistics{Folder: fcfg.ID, InBytes: 10, OutBytes: 20}) {
+		t.Errorf("unexpected folder statistics %+v", dev1.Folders)
+	}
+}
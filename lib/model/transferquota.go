@@ -0,0 +1,225 @@
+// Copyright (C) 2026 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package model
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sort"
+	"time"
+
+	"github.com/syncthing/syncthing/lib/config"
+	"github.com/syncthing/syncthing/lib/db"
+	"github.com/syncthing/syncthing/lib/protocol"
+	"github.com/syncthing/syncthing/lib/sync"
+)
+
+const (
+	transferQuotasKey          = "transferQuotas"
+	transferQuotasSaveInterval = time.Minute
+)
+
+var errQuotaExceeded = errors.New("monthly transfer quota exceeded")
+
+// TransferStatistics is the traffic with a device in a folder during the
+// current quota period.
+type TransferStatistics struct {
+	Folder      string            `json:"folder"`
+	Device      protocol.DeviceID `json:"device"`
+	InBytes     int64             `json:"inBytes"`
+	OutBytes    int64             `json:"outBytes"`
+	QuotaBytes  int64             `json:"quotaBytes"` // zero means unlimited
+	Exceeded    bool              `json:"exceeded"`
+	PeriodStart time.Time         `json:"periodStart"`
+}
+
+type transferKey struct {
+	folder string
+	device protocol.DeviceID
+}
+
+type transferCounts struct {
+	in, out int64
+}
+
+// transferQuotas counts the bytes transferred per folder and device, and
+// tells when a device has used up its monthly quota in a folder. The
+// counters live in memory and are saved to the database periodically, so
+// they survive restarts, give or take the last interval.
+type transferQuotas struct {
+	cfg config.Wrapper
+	kv  *db.NamespacedKV
+
+	mut         sync.Mutex
+	counts      map[transferKey]transferCounts
+	periodStart time.Time
+	dirty       bool
+}
+
+// transferQuotasState is the serialised form of the counters.
+type transferQuotasState struct {
+	PeriodStart time.Time               `json:"periodStart"`
+	Counters    []transferQuotasCounter `json:"counters"`
+}
+
+type transferQuotasCounter struct {
+	Folder string            `json:"folder"`
+	Device protocol.DeviceID `json:"device"`
+	In     int64             `json:"in"`
+	Out    int64             `json:"out"`
+}
+
+func newTransferQuotas(cfg config.Wrapper, kv *db.NamespacedKV) *transferQuotas {
+	q := &transferQuotas{
+		cfg:    cfg,
+		kv:     kv,
+		mut:    sync.NewMutex(),
+		counts: make(map[transferKey]transferCounts),
+	}
+	q.load()
+	q.rollover(time.Now())
+	return q
+}
+
+func (q *transferQuotas) load() {
+	bs, ok, err := q.kv.Bytes(transferQuotasKey)
+	if err != nil || !ok {
+		return
+	}
+	var state transferQuotasState
+	if err := json.Unmarshal(bs, &state); err != nil {
+		l.Debugln("Loading transfer quota counters:", err)
+		return
+	}
+	q.periodStart = state.PeriodStart
+	for _, c := range state.Counters {
+		q.counts[transferKey{c.Folder, c.Device}] = transferCounts{c.In, c.Out}
+	}
+}
+
+func (q *transferQuotas) serve(ctx context.Context) error {
+	t := time.NewTicker(transferQuotasSaveInterval)
+	defer t.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			q.save()
+			return nil
+		case now := <-t.C:
+			q.rollover(now)
+			q.save()
+		}
+	}
+}
+
+func (q *transferQuotas) save() {
+	q.mut.Lock()
+	if !q.dirty {
+		q.mut.Unlock()
+		return
+	}
+	state := transferQuotasState{
+		PeriodStart: q.periodStart,
+		Counters:    make([]transferQuotasCounter, 0, len(q.counts)),
+	}
+	for key, c := range q.counts {
+		state.Counters = append(state.Counters, transferQuotasCounter{key.folder, key.device, c.in, c.out})
+	}
+	q.dirty = false
+	q.mut.Unlock()
+
+	bs, err := json.Marshal(state)
+	if err == nil {
+		err = q.kv.PutBytes(transferQuotasKey, bs)
+	}
+	if err != nil {
+		l.Warnln("Saving transfer quota counters:", err)
+	}
+}
+
+// rollover resets the counters when a new quota period has begun.
+func (q *transferQuotas) rollover(now time.Time) {
+	start := q.cfg.Options().QuotaPeriodStart(now)
+	q.mut.Lock()
+	defer q.mut.Unlock()
+	if q.periodStart.Equal(start) {
+		return
+	}
+	l.Debugln("Starting new transfer quota period at", start)
+	q.periodStart = start
+	clear(q.counts)
+	q.dirty = true
+}
+
+func (q *transferQuotas) add(folder string, device protocol.DeviceID, in, out int64) {
+	key := transferKey{folder, device}
+	q.mut.Lock()
+	c := q.counts[key]
+	c.in += in
+	c.out += out
+	q.counts[key] = c
+	q.dirty = true
+	q.mut.Unlock()
+}
+
+// exceeded returns whether the given device has used up its quota in the
+// folder, given by the folder configuration.
+func (q *transferQuotas) exceeded(folderCfg config.FolderConfiguration, device protocol.DeviceID) bool {
+	quota := transferQuotaBytes(folderCfg, device)
+	if quota <= 0 {
+		return false
+	}
+	q.mut.Lock()
+	c := q.counts[transferKey{folderCfg.ID, device}]
+	q.mut.Unlock()
+	return c.in+c.out >= quota
+}
+
+func transferQuotaBytes(folderCfg config.FolderConfiguration, device protocol.DeviceID) int64 {
+	dev, ok := folderCfg.Device(device)
+	if !ok {
+		return 0
+	}
+	return dev.MonthlyQuotaMiB << 20
+}
+
+// statistics returns the counters for all devices sharing the configured
+// folders, sorted by folder and device.
+func (q *transferQuotas) statistics() []TransferStatistics {
+	folders := q.cfg.Folders()
+
+	q.mut.Lock()
+	defer q.mut.Unlock()
+
+	res := make([]TransferStatistics, 0)
+	for _, folderCfg := range folders {
+		for _, dev := range folderCfg.Devices {
+			if dev.DeviceID == q.cfg.MyID() {
+				continue
+			}
+			c := q.counts[transferKey{folderCfg.ID, dev.DeviceID}]
+			quota := dev.MonthlyQuotaMiB << 20
+			res = append(res, TransferStatistics{
+				Folder:      folderCfg.ID,
+				Device:      dev.DeviceID,
+				InBytes:     c.in,
+				OutBytes:    c.out,
+				QuotaBytes:  quota,
+				Exceeded:    quota > 0 && c.in+c.out >= quota,
+				PeriodStart: q.periodStart,
+			})
+		}
+	}
+	sort.Slice(res, func(a, b int) bool {
+		if res[a].Folder != res[b].Folder {
+			return res[a].Folder < res[b].Folder
+		}
+		return res[a].Device.Compare(res[b].Device) < 0
+	})
+	return res
+}
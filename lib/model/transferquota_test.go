@@ -0,0 +1,97 @@
+// Copyright (C) 2026 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package model
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/syncthing/syncthing/lib/config"
+	"github.com/syncthing/syncthing/lib/db"
+	"github.com/syncthing/syncthing/lib/db/backend"
+	"github.com/syncthing/syncthing/lib/events"
+	"github.com/syncthing/syncthing/lib/protocol"
+)
+
+func TestTransferQuotas(t *testing.T) {
+	w, fcfg, cancel := newDefaultCfgWrapper()
+	defer cancel()
+	fcfg.Devices = []config.FolderDeviceConfiguration{{DeviceID: myID}, {DeviceID: device1, MonthlyQuotaMiB: 1}}
+	setFolder(t, w, fcfg)
+	fcfg, _ = w.Folder(fcfg.ID)
+	setQuota := func(mib int64) {
+		for i := range fcfg.Devices {
+			if fcfg.Devices[i].DeviceID == device1 {
+				fcfg.Devices[i].MonthlyQuotaMiB = mib
+			}
+		}
+	}
+
+	ldb, err := db.NewLowlevel(backend.OpenMemory(), events.NoopLogger)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ldb.Close()
+	kv := db.NewMiscDataNamespace(ldb)
+
+	q := newTransferQuotas(w, kv)
+	q.add(fcfg.ID, device1, 512<<10, 0)
+	if q.exceeded(fcfg, device1) {
+		t.Fatal("quota exceeded too early")
+	}
+	q.add(fcfg.ID, device1, 0, 512<<10)
+	if !q.exceeded(fcfg, device1) {
+		t.Fatal("quota not exceeded")
+	}
+
+	// Counters survive a restart.
+	q.save()
+	q = newTransferQuotas(w, kv)
+	stats := q.statistics()
+	if len(stats) != 1 {
+		t.Fatalf("expected statistics for one device, got %d", len(stats))
+	}
+	if s := stats[0]; s.Device != device1 || s.InBytes != 512<<10 || s.OutBytes != 512<<10 || s.QuotaBytes != 1<<20 || !s.Exceeded {
+		t.Errorf("unexpected statistics %+v", s)
+	}
+
+	// Raising the quota lets traffic through again.
+	setQuota(2)
+	if q.exceeded(fcfg, device1) {
+		t.Error("quota exceeded after raising it")
+	}
+	setQuota(1)
+
+	// A new period resets the counters.
+	q.rollover(q.periodStart.AddDate(0, 1, 0))
+	if q.exceeded(fcfg, device1) {
+		t.Error("quota still exceeded in the next period")
+	}
+}
+
+func TestRequestOverQuota(t *testing.T) {
+	w, fcfg, wCancel := newDefaultCfgWrapper()
+	defer wCancel()
+	for i := range fcfg.Devices {
+		if fcfg.Devices[i].DeviceID == device1 {
+			fcfg.Devices[i].MonthlyQuotaMiB = 1
+		}
+	}
+	setFolder(t, w, fcfg)
+	m, fc := setupModelWithConnectionFromWrapper(t, w)
+	defer cleanupModel(m)
+
+	m.transferQuotas.add(fcfg.ID, device1, 1<<20, 0)
+
+	if _, err := m.Request(fc, &protocol.Request{Folder: fcfg.ID, Name: "foo", Size: 10}); err != protocol.ErrGeneric {
+		t.Errorf("expected request over quota to fail with a generic error, got %v", err)
+	}
+	if _, err := m.RequestGlobal(context.Background(), device1, fcfg.ID, "foo", 0, 0, 10, nil, 0, false); !errors.Is(err, errQuotaExceeded) {
+		t.Errorf("expected outgoing request over quota to fail, got %v", err)
+	}
+}
@@ -0,0 +1,153 @@
+// Copyright (C) 2026 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package model
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"slices"
+	"time"
+
+	"github.com/syncthing/syncthing/lib/db"
+	"github.com/syncthing/syncthing/lib/protocol"
+	"github.com/syncthing/syncthing/lib/sync"
+	"github.com/syncthing/syncthing/lib/ur"
+	"github.com/syncthing/syncthing/lib/ur/contract"
+)
+
+const (
+	forwardedUsageReportsKey  = "forwardedUsageReports"
+	usageReportForwardTimeout = time.Minute
+)
+
+var errUsageReportNotSupported = errors.New("device does not accept forwarded usage reports")
+
+// forwardedUsageReports keeps the latest report forwarded by each device
+// until it's uploaded or dismissed. They are saved to the database, so
+// they survive restarts while waiting.
+type forwardedUsageReports struct {
+	kv *db.NamespacedKV
+
+	mut     sync.Mutex
+	reports []ur.ForwardedReport
+}
+
+func newForwardedUsageReports(kv *db.NamespacedKV) *forwardedUsageReports {
+	r := &forwardedUsageReports{
+		kv:  kv,
+		mut: sync.NewMutex(),
+	}
+	bs, ok, err := kv.Bytes(forwardedUsageReportsKey)
+	if err != nil || !ok {
+		return r
+	}
+	if err := json.Unmarshal(bs, &r.reports); err != nil {
+		l.Debugln("Loading forwarded usage reports:", err)
+	}
+	return r
+}
+
+// add keeps the report, replacing the previous one from the same device.
+func (r *forwardedUsageReports) add(report ur.ForwardedReport) {
+	r.mut.Lock()
+	defer r.mut.Unlock()
+	r.reports = slices.DeleteFunc(r.reports, func(e ur.ForwardedReport) bool {
+		return e.Device == report.Device
+	})
+	r.reports = append(r.reports, report)
+	r.saveLocked()
+}
+
+// dismiss drops the report from the device received at the given time, if
+// it's still the latest one.
+func (r *forwardedUsageReports) dismiss(device protocol.DeviceID, received time.Time) {
+	r.mut.Lock()
+	defer r.mut.Unlock()
+	n := len(r.reports)
+	r.reports = slices.DeleteFunc(r.reports, func(e ur.ForwardedReport) bool {
+		return e.Device == device && e.Received.Equal(received)
+	})
+	if len(r.reports) != n {
+		r.saveLocked()
+	}
+}
+
+func (r *forwardedUsageReports) list() []ur.ForwardedReport {
+	r.mut.Lock()
+	defer r.mut.Unlock()
+	return slices.Clone(r.reports)
+}
+
+func (r *forwardedUsageReports) saveLocked() {
+	bs, err := json.Marshal(r.reports)
+	if err == nil {
+		err = r.kv.PutBytes(forwardedUsageReportsKey, bs)
+	}
+	if err != nil {
+		l.Warnln("Saving forwarded usage reports:", err)
+	}
+}
+
+// UsageReport keeps a usage report forwarded by a device, if we accept
+// usage reports from it. Reports that wouldn't be accepted by the usage
+// reporting server anyway are dropped.
+func (m *model) UsageReport(conn protocol.Connection, msg *protocol.UsageReport) error {
+	deviceID := conn.DeviceID()
+	if dcfg, ok := m.cfg.Device(deviceID); !ok || !dcfg.AcceptUsageReports {
+		l.Infof("Ignoring usage report forwarded by %v, which we don't accept usage reports from", deviceID)
+		return nil
+	}
+
+	var report contract.Report
+	if err := json.Unmarshal(msg.Report, &report); err != nil || report.UniqueID == "" || report.Version == "" || report.Platform == "" {
+		l.Infof("Ignoring invalid usage report forwarded by %v", deviceID)
+		return nil
+	}
+
+	m.usageReports.add(ur.ForwardedReport{
+		Device:   deviceID,
+		Received: time.Now().Truncate(time.Second),
+		Report:   json.RawMessage(msg.Report),
+	})
+	l.Debugf("Received usage report forwarded by %v", deviceID)
+	return nil
+}
+
+// ForwardUsageReport sends our usage report to a connected device, for it
+// to upload on our behalf.
+func (m *model) ForwardUsageReport(device protocol.DeviceID, report []byte) error {
+	m.mut.RLock()
+	var conn protocol.Connection
+	if connIDs, ok := m.deviceConnIDs[device]; ok {
+		conn = m.connections[connIDs[0]]
+	}
+	m.mut.RUnlock()
+	if conn == nil {
+		return errNotConnected
+	}
+	if !m.hasExtension(device, protocol.ExtensionUsageReport) {
+		return errUsageReportNotSupported
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), usageReportForwardTimeout)
+	defer cancel()
+	return conn.UsageReport(ctx, &protocol.UsageReport{Report: report})
+}
+
+// ForwardedUsageReports returns the usage reports forwarded by other
+// devices, waiting to be uploaded or submitted manually.
+func (m *model) ForwardedUsageReports() []ur.ForwardedReport {
+	return m.usageReports.list()
+}
+
+// DismissForwardedUsageReport drops the report forwarded by the device at
+// the given time, once it's been uploaded or submitted. A newer report
+// from the device is kept.
+func (m *model) DismissForwardedUsageReport(device protocol.DeviceID, received time.Time) {
+	m.usageReports.dismiss(device, received)
+}
@@ -0,0 +1,113 @@
+// Copyright (C) 2026 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package model
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/syncthing/syncthing/lib/config"
+	"github.com/syncthing/syncthing/lib/db"
+	"github.com/syncthing/syncthing/lib/protocol"
+)
+
+func TestForwardedUsageReports(t *testing.T) {
+	tcfg := defaultAutoAcceptCfg.Copy()
+	tcfg.Devices[1].AcceptUsageReports = true
+	m, cancel := newState(t, tcfg)
+	defer cleanupModel(m)
+	defer cancel()
+
+	valid := []byte(`{"uniqueID":"abcd1234","version":"v1.27.0","platform":"linux-amd64"}`)
+
+	// Reports from devices we don't accept them from are ignored, as are
+	// those the usage reporting server would reject.
+	for _, tc := range []struct {
+		device protocol.DeviceID
+		report []byte
+	}{
+		{device2, valid},
+		{device1, []byte(`not json`)},
+		{device1, []byte(`{"uniqueID":"abcd1234","version":"v1.27.0"}`)},
+	} {
+		if err := m.UsageReport(newFakeConnection(tc.device, m), &protocol.UsageReport{Report: tc.report}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if reports := m.ForwardedUsageReports(); len(reports) != 0 {
+		t.Fatalf("expected no forwarded reports, got %v", reports)
+	}
+
+	fc := newFakeConnection(device1, m)
+	for i := 0; i < 2; i++ {
+		// The second one replaces the first.
+		if err := m.UsageReport(fc, &protocol.UsageReport{Report: valid}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	reports := m.ForwardedUsageReports()
+	if len(reports) != 1 || reports[0].Device != device1 || string(reports[0].Report) != string(valid) {
+		t.Fatalf("unexpected forwarded reports %v", reports)
+	}
+
+	// The reports are kept in the database.
+	if reports := newForwardedUsageReports(db.NewMiscDataNamespace(m.db)).list(); len(reports) != 1 {
+		t.Error("forwarded report not persisted")
+	}
+
+	m.DismissForwardedUsageReport(device1, reports[0].Received.Add(-time.Second))
+	if reports := m.ForwardedUsageReports(); len(reports) != 1 {
+		t.Fatalf("report dismissed by the wrong time, got %v", reports)
+	}
+	m.DismissForwardedUsageReport(device1, reports[0].Received)
+	if reports := m.ForwardedUsageReports(); len(reports) != 0 {
+		t.Fatalf("expected no forwarded reports after dismissal, got %v", reports)
+	}
+}
+
+func TestForwardUsageReport(t *testing.T) {
+	m, cancel := newState(t, config.Configuration{
+		Version: config.CurrentVersion,
+		Devices: []config.DeviceConfiguration{{DeviceID: myID}, {DeviceID: device1}},
+	})
+	defer cleanupModel(m)
+	defer cancel()
+
+	report := []byte(`{"uniqueID":"abcd1234"}`)
+	m.mut.RLock()
+	fc := m.connections[m.deviceConnIDs[device1][0]].(*fakeConnection)
+	m.mut.RUnlock()
+	if err := m.ClusterConfig(fc, &protocol.ClusterConfig{Extensions: protocol.SupportedExtensions()}); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := m.ForwardUsageReport(device1, report); err != nil {
+		t.Fatal(err)
+	}
+	if n := fc.UsageReportCallCount(); n != 1 {
+		t.Fatalf("expected one report to be sent, got %d", n)
+	}
+	if _, sent := fc.UsageReportArgsForCall(0); string(sent.Report) != string(report) {
+		t.Errorf("unexpected report sent: %s", sent.Report)
+	}
+
+	if err := m.ForwardUsageReport(device2, report); !errors.Is(err, errNotConnected) {
+		t.Errorf("expected %v, got %v", errNotConnected, err)
+	}
+
+	// Devices not announcing the extension don't get reports.
+	if err := m.ClusterConfig(fc, &protocol.ClusterConfig{}); err != nil {
+		t.Fatal(err)
+	}
+	if err := m.ForwardUsageReport(device1, report); !errors.Is(err, errUsageReportNotSupported) {
+		t.Errorf("expected %v, got %v", errUsageReportNotSupported, err)
+	}
+	if n := fc.UsageReportCallCount(); n != 1 {
+		t.Errorf("expected no further report to be sent, got %d", n)
+	}
+}
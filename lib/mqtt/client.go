@@ -0,0 +1,350 @@
+// Copyright (C) 2026 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package mqtt
+
+import (
+	"bufio"
+	"context"
+	"crypto/tls"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/syncthing/syncthing/lib/dialer"
+	"github.com/syncthing/syncthing/lib/tlsutil"
+)
+
+// Control packet types of MQTT 3.1.1, shifted into the upper nibble of the
+// first header byte.
+const (
+	packetConnect    = 1 << 4
+	packetConnack    = 2 << 4
+	packetPublish    = 3 << 4
+	packetPingreq    = 12 << 4
+	packetPingresp   = 13 << 4
+	packetDisconnect = 14 << 4
+)
+
+const (
+	connectFlagCleanSession = 0x02
+	connectFlagWill         = 0x04
+	connectFlagWillRetain   = 0x20
+	connectFlagPassword     = 0x40
+	connectFlagUsername     = 0x80
+
+	publishFlagRetain = 0x01
+
+	protocolLevel = 4
+	maxRemaining  = 268435455
+	writeTimeout  = 10 * time.Second
+)
+
+var (
+	errClosed          = errors.New("connection closed")
+	errPacketTooLarge  = errors.New("packet too large")
+	errUnexpectedReply = errors.New("unexpected reply from broker")
+)
+
+// Options are the connection parameters for Dial.
+type Options struct {
+	ClientID  string
+	Username  string
+	Password  string
+	KeepAlive time.Duration
+
+	// The will is published by the broker when the connection is lost
+	// without a disconnect.
+	WillTopic   string
+	WillPayload []byte
+	WillRetain  bool
+}
+
+// Client is a minimal MQTT 3.1.1 client, which only publishes at QoS 0.
+type Client struct {
+	conn    net.Conn
+	writeMu sync.Mutex
+	done    chan struct{}
+	errMut  sync.Mutex
+	err     error
+	once    sync.Once
+}
+
+// Dial connects to the broker at the given URL, which is either
+// tcp://host[:port] or tls://host[:port], and waits for the broker to accept
+// the connection.
+func Dial(ctx context.Context, brokerURL string, opts Options) (*Client, error) {
+	u, err := url.Parse(brokerURL)
+	if err != nil {
+		return nil, err
+	}
+
+	var useTLS bool
+	port := "1883"
+	switch u.Scheme {
+	case "tcp", "mqtt":
+	case "tls", "ssl", "mqtts":
+		useTLS = true
+		port = "8883"
+	default:
+		return nil, fmt.Errorf("unsupported broker scheme %q", u.Scheme)
+	}
+	if u.Port() != "" {
+		port = u.Port()
+	}
+	addr := net.JoinHostPort(u.Hostname(), port)
+
+	conn, err := dialer.DialContext(ctx, "tcp", addr)
+	if err != nil {
+		return nil, err
+	}
+	if useTLS {
+		tlsCfg := tlsutil.SecureDefaultWithTLS12()
+		tlsCfg.ServerName = u.Hostname()
+		tlsConn := tls.Client(conn, tlsCfg)
+		if err := tlsConn.HandshakeContext(ctx); err != nil {
+			conn.Close()
+			return nil, err
+		}
+		conn = tlsConn
+	}
+
+	if deadline, ok := ctx.Deadline(); ok {
+		conn.SetDeadline(deadline)
+	}
+	r := bufio.NewReader(conn)
+	if err := handshake(conn, r, opts); err != nil {
+		conn.Close()
+		return nil, err
+	}
+	conn.SetDeadline(time.Time{})
+
+	c := &Client{
+		conn: conn,
+		done: make(chan struct{}),
+	}
+	go c.readLoop(r)
+	if opts.KeepAlive > 0 {
+		go c.pingLoop(opts.KeepAlive)
+	}
+	return c, nil
+}
+
+func handshake(conn net.Conn, r *bufio.Reader, opts Options) error {
+	var flags byte = connectFlagCleanSession
+	var payload []byte
+	payload = appendString(payload, opts.ClientID)
+	if opts.WillTopic != "" {
+		flags |= connectFlagWill
+		if opts.WillRetain {
+			flags |= connectFlagWillRetain
+		}
+		payload = appendString(payload, opts.WillTopic)
+		payload = appendBytes(payload, opts.WillPayload)
+	}
+	if opts.Username != "" {
+		flags |= connectFlagUsername
+		payload = appendString(payload, opts.Username)
+		if opts.Password != "" {
+			flags |= connectFlagPassword
+			payload = appendString(payload, opts.Password)
+		}
+	}
+
+	var body []byte
+	body = appendString(body, "MQTT")
+	body = append(body, protocolLevel, flags)
+	body = binary.BigEndian.AppendUint16(body, uint16(opts.KeepAlive/time.Second))
+	body = append(body, payload...)
+	if err := writePacket(conn, packetConnect, body); err != nil {
+		return err
+	}
+
+	typ, reply, err := readPacket(r)
+	if err != nil {
+		return err
+	}
+	if typ&0xf0 != packetConnack || len(reply) != 2 {
+		return errUnexpectedReply
+	}
+	if code := reply[1]; code != 0 {
+		return fmt.Errorf("connection refused by broker: %s", connackError(code))
+	}
+	return nil
+}
+
+// Publish sends a message at QoS 0, i.e. without waiting for it to be
+// acknowledged.
+func (c *Client) Publish(topic string, payload []byte, retain bool) error {
+	var typ byte = packetPublish
+	if retain {
+		typ |= publishFlagRetain
+	}
+	body := appendString(nil, topic)
+	body = append(body, payload...)
+	return c.write(typ, body)
+}
+
+// Done is closed when the connection is lost or closed, after which Err
+// returns the reason.
+func (c *Client) Done() <-chan struct{} {
+	return c.done
+}
+
+// Err returns why the connection was lost, or nil while it is up.
+func (c *Client) Err() error {
+	c.errMut.Lock()
+	defer c.errMut.Unlock()
+	return c.err
+}
+
+// Close disconnects from the broker, such that it does not publish the
+// will.
+func (c *Client) Close() error {
+	c.write(packetDisconnect, nil)
+	c.fail(errClosed)
+	return nil
+}
+
+func (c *Client) write(typ byte, body []byte) error {
+	select {
+	case <-c.done:
+		return c.Err()
+	default:
+	}
+	c.writeMu.Lock()
+	defer c.writeMu.Unlock()
+	c.conn.SetWriteDeadline(time.Now().Add(writeTimeout))
+	if err := writePacket(c.conn, typ, body); err != nil {
+		c.fail(err)
+		return err
+	}
+	return nil
+}
+
+func (c *Client) readLoop(r *bufio.Reader) {
+	for {
+		typ, _, err := readPacket(r)
+		if err != nil {
+			c.fail(err)
+			return
+		}
+		if typ&0xf0 != packetPingresp {
+			c.fail(errUnexpectedReply)
+			return
+		}
+		// Clear the deadline for the ping response.
+		c.conn.SetReadDeadline(time.Time{})
+	}
+}
+
+func (c *Client) pingLoop(interval time.Duration) {
+	t := time.NewTicker(interval)
+	defer t.Stop()
+	for {
+		select {
+		case <-t.C:
+			// Expect the response before the next ping is due.
+			c.conn.SetReadDeadline(time.Now().Add(interval))
+			if err := c.write(packetPingreq, nil); err != nil {
+				return
+			}
+		case <-c.done:
+			return
+		}
+	}
+}
+
+func (c *Client) fail(err error) {
+	c.once.Do(func() {
+		c.errMut.Lock()
+		c.err = err
+		c.errMut.Unlock()
+		c.conn.Close()
+		close(c.done)
+	})
+}
+
+func writePacket(w io.Writer, typ byte, body []byte) error {
+	if len(body) > maxRemaining {
+		return errPacketTooLarge
+	}
+	buf := make([]byte, 0, 5+len(body))
+	buf = append(buf, typ)
+	n := len(body)
+	for {
+		b := byte(n % 128)
+		n /= 128
+		if n > 0 {
+			b |= 0x80
+		}
+		buf = append(buf, b)
+		if n == 0 {
+			break
+		}
+	}
+	buf = append(buf, body...)
+	_, err := w.Write(buf)
+	return err
+}
+
+func readPacket(r *bufio.Reader) (byte, []byte, error) {
+	typ, err := r.ReadByte()
+	if err != nil {
+		return 0, nil, err
+	}
+	var n, mult int = 0, 1
+	for i := 0; ; i++ {
+		if i == 4 {
+			return 0, nil, errPacketTooLarge
+		}
+		b, err := r.ReadByte()
+		if err != nil {
+			return 0, nil, err
+		}
+		n += int(b&0x7f) * mult
+		mult *= 128
+		if b&0x80 == 0 {
+			break
+		}
+	}
+	body := make([]byte, n)
+	if _, err := io.ReadFull(r, body); err != nil {
+		return 0, nil, err
+	}
+	return typ, body, nil
+}
+
+func appendString(b []byte, s string) []byte {
+	return appendBytes(b, []byte(s))
+}
+
+func appendBytes(b, s []byte) []byte {
+	b = binary.BigEndian.AppendUint16(b, uint16(len(s)))
+	return append(b, s...)
+}
+
+func connackError(code byte) string {
+	switch code {
+	case 1:
+		return "unacceptable protocol version"
+	case 2:
+		return "identifier rejected"
+	case 3:
+		return "server unavailable"
+	case 4:
+		return "bad user name or password"
+	case 5:
+		return "not authorized"
+	default:
+		return fmt.Sprintf("code %d", code)
+	}
+}
@@ -0,0 +1,137 @@
+// Copyright (C) 2026 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package mqtt
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"net"
+	"testing"
+	"time"
+)
+
+type packet struct {
+	typ  byte
+	body []byte
+}
+
+// fakeBroker accepts one connection, replies to the connect with the given
+// return code and passes on all packets received.
+func fakeBroker(t *testing.T, code byte) (string, <-chan packet) {
+	t.Helper()
+	lst, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { lst.Close() })
+
+	packets := make(chan packet, 10)
+	go func() {
+		defer close(packets)
+		conn, err := lst.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		r := bufio.NewReader(conn)
+		for {
+			typ, body, err := readPacket(r)
+			if err != nil {
+				return
+			}
+			packets <- packet{typ, body}
+			if typ == packetConnect {
+				writePacket(conn, packetConnack, []byte{0, code})
+			}
+		}
+	}()
+	return "tcp://" + lst.Addr().String(), packets
+}
+
+func TestClientPublish(t *testing.T) {
+	url, packets := fakeBroker(t, 0)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	c, err := Dial(ctx, url, Options{
+		ClientID:    "test",
+		Username:    "user",
+		Password:    "pass",
+		KeepAlive:   time.Minute,
+		WillTopic:   "st/status",
+		WillPayload: []byte("offline"),
+		WillRetain:  true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	p := <-packets
+	if p.typ != packetConnect {
+		t.Fatalf("expected connect, got %x", p.typ)
+	}
+	var expected []byte
+	expected = appendString(expected, "MQTT")
+	expected = append(expected, protocolLevel, connectFlagCleanSession|connectFlagWill|connectFlagWillRetain|connectFlagUsername|connectFlagPassword, 0, 60)
+	expected = appendString(expected, "test")
+	expected = appendString(expected, "st/status")
+	expected = appendString(expected, "offline")
+	expected = appendString(expected, "user")
+	expected = appendString(expected, "pass")
+	if !bytes.Equal(p.body, expected) {
+		t.Errorf("unexpected connect packet\n%x\n%x", p.body, expected)
+	}
+
+	if err := c.Publish("st/foo", []byte("bar"), true); err != nil {
+		t.Fatal(err)
+	}
+	p = <-packets
+	if p.typ != packetPublish|publishFlagRetain {
+		t.Errorf("expected retained publish, got %x", p.typ)
+	}
+	if expected := append(appendString(nil, "st/foo"), "bar"...); !bytes.Equal(p.body, expected) {
+		t.Errorf("unexpected publish packet %x", p.body)
+	}
+
+	c.Close()
+	p = <-packets
+	if p.typ != packetDisconnect {
+		t.Errorf("expected disconnect, got %x", p.typ)
+	}
+	select {
+	case <-c.Done():
+	default:
+		t.Error("client should be done after close")
+	}
+}
+
+func TestClientRefused(t *testing.T) {
+	url, _ := fakeBroker(t, 5)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	if _, err := Dial(ctx, url, Options{ClientID: "test"}); err == nil {
+		t.Error("expected refused connection to fail")
+	}
+}
+
+func TestPacketLength(t *testing.T) {
+	for _, n := range []int{0, 127, 128, 16383, 16384, 2097152} {
+		var buf bytes.Buffer
+		if err := writePacket(&buf, packetPublish, make([]byte, n)); err != nil {
+			t.Fatal(err)
+		}
+		typ, body, err := readPacket(bufio.NewReader(&buf))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if typ != packetPublish || len(body) != n {
+			t.Errorf("length %d: got type %x length %d", n, typ, len(body))
+		}
+	}
+}
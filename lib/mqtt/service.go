@@ -0,0 +1,234 @@
+// Copyright (C) 2026 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+// Package mqtt publishes events to an MQTT broker, for home automation
+// systems to follow the state of Syncthing.
+package mqtt
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/thejerf/suture/v4"
+
+	"github.com/syncthing/syncthing/lib/config"
+	"github.com/syncthing/syncthing/lib/events"
+	"github.com/syncthing/syncthing/lib/protocol"
+)
+
+const (
+	keepAlive      = time.Minute
+	dialTimeout    = 30 * time.Second
+	reconnectDelay = time.Minute
+
+	statusOnline  = "online"
+	statusOffline = "offline"
+)
+
+// defaultEvents are published when no event types are configured.
+const defaultEvents = events.FolderSummary | events.FolderErrors | events.DeviceConnected | events.DeviceDisconnected
+
+var errEventsClosed = errors.New("event subscription closed")
+
+type service struct {
+	cfg      config.Wrapper
+	myID     protocol.DeviceID
+	evLogger events.Logger
+	changed  chan struct{}
+}
+
+// NewService returns a service publishing the configured events to the
+// configured MQTT broker, under the topic prefix:
+//
+//	<prefix>/status                      "online" or "offline" (retained)
+//	<prefix>/folder/<folder>/summary     folder summary (retained)
+//	<prefix>/folder/<folder>/errors      folder errors, digested by cause
+//	<prefix>/device/<device>/connected   "true" or "false" (retained)
+//	<prefix>/event/<type>                any other event
+func NewService(cfg config.Wrapper, myID protocol.DeviceID, evLogger events.Logger) suture.Service {
+	return &service{
+		cfg:      cfg,
+		myID:     myID,
+		evLogger: evLogger,
+		changed:  make(chan struct{}, 1),
+	}
+}
+
+func (s *service) Serve(ctx context.Context) error {
+	s.cfg.Subscribe(s)
+	defer s.cfg.Unsubscribe(s)
+
+	for {
+		opts := s.cfg.Options()
+		var delay <-chan time.Time
+		if opts.MQTTBrokerURL != "" {
+			err := s.publish(ctx, opts)
+			if errors.Is(err, errEventsClosed) {
+				return nil
+			}
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			if err != nil {
+				l.Infof("Publishing events to MQTT broker %s: %v", opts.MQTTBrokerURL, err)
+				delay = time.After(reconnectDelay)
+			}
+		}
+
+		if delay != nil || opts.MQTTBrokerURL == "" {
+			select {
+			case <-delay:
+			case <-s.changed:
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+	}
+}
+
+// publish connects to the broker and publishes events until the connection
+// is lost or the configuration changes.
+func (s *service) publish(ctx context.Context, opts config.OptionsConfiguration) error {
+	prefix := strings.TrimSuffix(opts.MQTTTopicPrefix, "/")
+	statusTopic := prefix + "/status"
+
+	dialCtx, cancel := context.WithTimeout(ctx, dialTimeout)
+	client, err := Dial(dialCtx, opts.MQTTBrokerURL, Options{
+		ClientID:    "syncthing-" + s.myID.Short().String(),
+		Username:    opts.MQTTUsername,
+		Password:    opts.MQTTPassword,
+		KeepAlive:   keepAlive,
+		WillTopic:   statusTopic,
+		WillPayload: []byte(statusOffline),
+		WillRetain:  true,
+	})
+	cancel()
+	if err != nil {
+		return err
+	}
+	defer func() {
+		// The will only covers a lost connection.
+		client.Publish(statusTopic, []byte(statusOffline), true)
+		client.Close()
+	}()
+	l.Debugln("Connected to MQTT broker", opts.MQTTBrokerURL)
+
+	if err := client.Publish(statusTopic, []byte(statusOnline), true); err != nil {
+		return err
+	}
+
+	sub := s.evLogger.Subscribe(eventMask(opts.MQTTEvents))
+	defer sub.Unsubscribe()
+
+	for {
+		select {
+		case ev, ok := <-sub.C():
+			if !ok {
+				return errEventsClosed
+			}
+			topic, payload, retain, err := message(prefix, ev)
+			if err != nil {
+				l.Debugf("Failed to encode %v event: %v", ev.Type, err)
+				continue
+			}
+			if err := client.Publish(topic, payload, retain); err != nil {
+				return err
+			}
+		case <-client.Done():
+			return client.Err()
+		case <-s.changed:
+			return nil
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+func (s *service) CommitConfiguration(from, to config.Configuration) bool {
+	if from.Options.MQTTBrokerURL != to.Options.MQTTBrokerURL ||
+		from.Options.MQTTTopicPrefix != to.Options.MQTTTopicPrefix ||
+		from.Options.MQTTUsername != to.Options.MQTTUsername ||
+		from.Options.MQTTPassword != to.Options.MQTTPassword ||
+		!slices.Equal(from.Options.MQTTEvents, to.Options.MQTTEvents) {
+		select {
+		case s.changed <- struct{}{}:
+		default:
+		}
+	}
+	return true
+}
+
+func (*service) String() string {
+	return "mqtt.service"
+}
+
+func eventMask(names []string) events.EventType {
+	var mask events.EventType
+	for _, name := range names {
+		t := events.UnmarshalEventType(name)
+		if t == 0 {
+			l.Infof("Ignoring unknown event type %q for MQTT", name)
+		}
+		mask |= t
+	}
+	if mask == 0 {
+		return defaultEvents
+	}
+	return mask
+}
+
+// message returns the topic and payload to publish for the event, and
+// whether the broker should retain it as the current state.
+func message(prefix string, ev events.Event) (string, []byte, bool, error) {
+	bs, err := json.Marshal(ev.Data)
+	if err != nil {
+		return "", nil, false, err
+	}
+	// Not all event data are objects, in which case there are no fields
+	// and the generic topic is used.
+	var fields map[string]json.RawMessage
+	_ = json.Unmarshal(bs, &fields)
+	field := func(key string) string {
+		var s string
+		_ = json.Unmarshal(fields[key], &s)
+		return topicLevel(s)
+	}
+
+	switch ev.Type {
+	case events.FolderSummary:
+		if folder := field("folder"); folder != "" {
+			return prefix + "/folder/" + folder + "/summary", fields["summary"], true, nil
+		}
+	case events.FolderErrors:
+		if folder := field("folder"); folder != "" {
+			return prefix + "/folder/" + folder + "/errors", fields["digests"], false, nil
+		}
+	case events.DeviceConnected:
+		if device := field("id"); device != "" {
+			return prefix + "/device/" + device + "/connected", []byte("true"), true, nil
+		}
+	case events.DeviceDisconnected:
+		if device := field("id"); device != "" {
+			return prefix + "/device/" + device + "/connected", []byte("false"), true, nil
+		}
+	}
+
+	bs, err = json.Marshal(ev)
+	if err != nil {
+		return "", nil, false, err
+	}
+	return prefix + "/event/" + ev.Type.String(), bs, false, nil
+}
+
+// topicLevel makes the string usable as a single topic level, by replacing
+// the level separator and wildcards.
+func topicLevel(s string) string {
+	return strings.NewReplacer("/", "_", "+", "_", "#", "_").Replace(s)
+}
@@ -0,0 +1,65 @@
+// Copyright (C) 2026 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package mqtt
+
+import (
+	"testing"
+
+	"github.com/syncthing/syncthing/lib/events"
+)
+
+func TestMessage(t *testing.T) {
+	cases := []struct {
+		event   events.Event
+		topic   string
+		payload string
+		retain  bool
+	}{
+		{
+			events.Event{Type: events.FolderSummary, Data: map[string]interface{}{"folder": "a/b", "summary": map[string]int{"needFiles": 1}}},
+			"st/folder/a_b/summary", `{"needFiles":1}`, true,
+		},
+		{
+			events.Event{Type: events.DeviceConnected, Data: map[string]string{"id": "DEVICE", "addr": "1.2.3.4:22000"}},
+			"st/device/DEVICE/connected", "true", true,
+		},
+		{
+			events.Event{Type: events.DeviceDisconnected, Data: map[string]string{"id": "DEVICE", "error": "gone"}},
+			"st/device/DEVICE/connected", "false", true,
+		},
+		{
+			events.Event{Type: events.FolderErrors, Data: map[string]interface{}{"folder": "f", "count": 1, "digests": []string{"x"}}},
+			"st/folder/f/errors", `["x"]`, false,
+		},
+		{
+			events.Event{Type: events.ConfigSaved, Data: "foo"},
+			"st/event/ConfigSaved", "", false,
+		},
+	}
+
+	for _, tc := range cases {
+		topic, payload, retain, err := message("st", tc.event)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if topic != tc.topic || retain != tc.retain {
+			t.Errorf("%v: got topic %q retain %v, expected %q %v", tc.event.Type, topic, retain, tc.topic, tc.retain)
+		}
+		if tc.payload != "" && string(payload) != tc.payload {
+			t.Errorf("%v: got payload %s, expected %s", tc.event.Type, payload, tc.payload)
+		}
+	}
+}
+
+func TestEventMask(t *testing.T) {
+	if mask := eventMask(nil); mask != defaultEvents {
+		t.Errorf("expected default events, got %v", mask)
+	}
+	if mask := eventMask([]string{"StateChanged", "NotAnEvent"}); mask != events.StateChanged {
+		t.Errorf("unexpected mask %v", mask)
+	}
+}
@@ -35,3 +35,21 @@ type Device interface {
 	GetExternalIPv4Address(ctx context.Context) (net.IP, error)
 	SupportsIPVersion(version IPVersion) bool
 }
+
+// Preferences of the port mapping protocols, lower is better.
+const (
+	PreferencePCP = iota
+	PreferencePMP
+	PreferenceUPnP
+)
+
+// A GatewayDevice knows the address of the gateway it maps ports on. Of the
+// devices reaching the same gateway, only the preferred one that succeeds
+// is used for a mapping, so that a gateway speaking several protocols
+// doesn't get duplicate mappings, and another protocol takes over when the
+// preferred one fails.
+type GatewayDevice interface {
+	Device
+	GatewayIP() net.IP
+	Preference() int
+}
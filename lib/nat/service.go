@@ -13,6 +13,7 @@ import (
 	"math/rand"
 	"net"
 	"slices"
+	"strings"
 	stdsync "sync"
 	"time"
 
@@ -283,44 +284,95 @@ func (s *Service) acquireNewLocked(ctx context.Context, mapping *Mapping, nats m
 	leaseTime := time.Duration(s.cfg.Options().NATLeaseM) * time.Minute
 	addrMap := mapping.extAddresses
 
-	for id, nat := range nats {
-		select {
-		case <-ctx.Done():
-			return false
-		default:
-		}
-
-		if _, ok := addrMap[id]; ok {
+	for _, group := range gatewayGroups(nats) {
+		if slices.ContainsFunc(group, func(nat Device) bool {
+			_, ok := addrMap[nat.ID()]
+			return ok
+		}) {
+			// Already mapped on this gateway
 			continue
 		}
 
-		// Only perform mappings on the nat's that have the right local IP
-		// address
-		localIP := nat.GetLocalIPv4Address()
-		if !mapping.validGateway(localIP) && nat.SupportsIPVersion(IPv4Only) {
-			l.Debugf("Skipping %s for %s because of IP mismatch. %s != %s", id, mapping, mapping.address.IP, localIP)
-			continue
-		}
+		// Try the devices reaching the gateway in order of preference,
+		// until one of them succeeds.
+		for _, nat := range group {
+			select {
+			case <-ctx.Done():
+				return false
+			default:
+			}
 
-		l.Debugf("Trying to open port %s on %s", mapping, id)
+			id := nat.ID()
 
-		if !nat.SupportsIPVersion(mapping.ipVersion) {
-			l.Debugf("Skipping firewall traversal on gateway %s because it doesn't match the listener address family", nat.ID())
-			continue
+			// Only perform mappings on the nat's that have the right local IP
+			// address
+			localIP := nat.GetLocalIPv4Address()
+			if !mapping.validGateway(localIP) && nat.SupportsIPVersion(IPv4Only) {
+				l.Debugf("Skipping %s for %s because of IP mismatch. %s != %s", id, mapping, mapping.address.IP, localIP)
+				continue
+			}
+
+			l.Debugf("Trying to open port %s on %s", mapping, id)
+
+			if !nat.SupportsIPVersion(mapping.ipVersion) {
+				l.Debugf("Skipping firewall traversal on gateway %s because it doesn't match the listener address family", nat.ID())
+				continue
+			}
+
+			addrs, err := s.tryNATDevice(ctx, nat, mapping.address, 0, leaseTime)
+			if err != nil {
+				l.Infof("Failed to acquire %s open port on %s: %s", mapping, id, err)
+				continue
+			}
+
+			l.Debugf("Opened port %s -> %v on %s", mapping, addrs, id)
+			mapping.setAddressLocked(id, addrs)
+			change = true
+			break
 		}
+	}
 
-		addrs, err := s.tryNATDevice(ctx, nat, mapping.address, 0, leaseTime)
-		if err != nil {
-			l.Infof("Failed to acquire %s open port on %s: %s", mapping, id, err)
-			continue
+	return change
+}
+
+// gatewayGroups returns the devices grouped by the gateway and address
+// family they map ports for, each group ordered by preference. Devices not
+// telling their gateway are in groups of their own.
+func gatewayGroups(nats map[string]Device) [][]Device {
+	groups := make(map[string][]Device)
+	for id, nat := range nats {
+		key := id
+		if gw, ok := nat.(GatewayDevice); ok && gw.GatewayIP() != nil {
+			key = fmt.Sprintf("%s/%v", gw.GatewayIP(), nat.SupportsIPVersion(IPv6Only))
 		}
+		groups[key] = append(groups[key], nat)
+	}
 
-		l.Debugf("Opened port %s -> %v on %s", mapping, addrs, id)
-		mapping.setAddressLocked(id, addrs)
-		change = true
+	keys := make([]string, 0, len(groups))
+	for key := range groups {
+		keys = append(keys, key)
 	}
+	slices.Sort(keys)
+
+	res := make([][]Device, 0, len(groups))
+	for _, key := range keys {
+		group := groups[key]
+		slices.SortFunc(group, func(a, b Device) int {
+			if pa, pb := preference(a), preference(b); pa != pb {
+				return pa - pb
+			}
+			return strings.Compare(a.ID(), b.ID())
+		})
+		res = append(res, group)
+	}
+	return res
+}
 
-	return change
+func preference(nat Device) int {
+	if gw, ok := nat.(GatewayDevice); ok {
+		return gw.Preference()
+	}
+	return PreferenceUPnP
 }
 
 // tryNATDevice tries to acquire a port mapping for the given internal address to
@@ -0,0 +1,89 @@
+// Copyright (C) 2026 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package nat
+
+import (
+	"context"
+	"errors"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/syncthing/syncthing/lib/config"
+	"github.com/syncthing/syncthing/lib/events"
+	"github.com/syncthing/syncthing/lib/protocol"
+)
+
+type fakeGateway struct {
+	id         string
+	gateway    net.IP
+	preference int
+	fail       bool
+	calls      int
+}
+
+func (f *fakeGateway) ID() string                       { return f.id }
+func (f *fakeGateway) GatewayIP() net.IP                { return f.gateway }
+func (f *fakeGateway) Preference() int                  { return f.preference }
+func (*fakeGateway) GetLocalIPv4Address() net.IP        { return net.ParseIP("192.168.0.2") }
+func (*fakeGateway) SupportsIPVersion(v IPVersion) bool { return v != IPv6Only }
+
+func (f *fakeGateway) AddPortMapping(_ context.Context, _ Protocol, _, externalPort int, _ string, _ time.Duration) (int, error) {
+	f.calls++
+	if f.fail {
+		return 0, errors.New("failed")
+	}
+	return externalPort, nil
+}
+
+func (*fakeGateway) AddPinhole(_ context.Context, _ Protocol, _ Address, _ time.Duration) ([]net.IP, error) {
+	return nil, errors.New("unsupported")
+}
+
+func (*fakeGateway) GetExternalIPv4Address(_ context.Context) (net.IP, error) {
+	return net.ParseIP("192.0.2.1"), nil
+}
+
+func TestAcquireFailover(t *testing.T) {
+	w := config.Wrap("", config.Configuration{}, protocol.LocalDeviceID, events.NoopLogger)
+	natSvc := NewService(protocol.EmptyDeviceID, w)
+
+	gw := net.ParseIP("192.168.0.1")
+	pcp := &fakeGateway{id: "pcp", gateway: gw, preference: PreferencePCP, fail: true}
+	pmp := &fakeGateway{id: "pmp", gateway: gw, preference: PreferencePMP}
+	upnp := &fakeGateway{id: "upnp", gateway: gw, preference: PreferenceUPnP}
+	other := &fakeGateway{id: "other", gateway: net.ParseIP("192.168.1.1"), preference: PreferenceUPnP}
+	nats := map[string]Device{"pcp": pcp, "pmp": pmp, "upnp": upnp, "other": other}
+
+	m := natSvc.NewMapping(TCP, IPv4Only, net.IPv4zero, 22000)
+	if !natSvc.acquireNewLocked(context.Background(), m, nats) {
+		t.Fatal("expected a change")
+	}
+
+	if pcp.calls == 0 {
+		t.Error("the preferred device was not tried")
+	}
+	if _, ok := m.extAddresses["pmp"]; !ok {
+		t.Error("expected a mapping on the fallback device")
+	}
+	if upnp.calls != 0 {
+		t.Error("expected no mapping attempt after the fallback succeeded")
+	}
+	if _, ok := m.extAddresses["other"]; !ok {
+		t.Error("expected a mapping on the other gateway")
+	}
+
+	// Already mapped gateways are left alone
+	pmp.calls, upnp.calls = 0, 0
+	pcp.fail = false
+	if natSvc.acquireNewLocked(context.Background(), m, nats) {
+		t.Error("expected no change")
+	}
+	if pmp.calls != 0 || upnp.calls != 0 {
+		t.Error("expected no new mapping attempts")
+	}
+}
@@ -0,0 +1,382 @@
+// Copyright (C) 2026 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+// Package pcp implements port mapping using the Port Control Protocol
+// (RFC 6887), the successor of NAT-PMP.
+package pcp
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"net"
+	"time"
+
+	"github.com/jackpal/gateway"
+
+	"github.com/syncthing/syncthing/lib/nat"
+	"github.com/syncthing/syncthing/lib/osutil"
+	"github.com/syncthing/syncthing/lib/svcutil"
+	"github.com/syncthing/syncthing/lib/sync"
+)
+
+const (
+	serverPort = 5351
+	version    = 2
+
+	opAnnounce = 0
+	opMap      = 1
+
+	headerSize = 24
+	mapSize    = 36
+
+	// Initial retransmission interval, doubled for every retry
+	initialRetry = 250 * time.Millisecond
+)
+
+func init() {
+	nat.Register(Discover)
+}
+
+func Discover(ctx context.Context, renewal, timeout time.Duration) []nat.Device {
+	var ip net.IP
+	err := svcutil.CallWithContext(ctx, func() error {
+		var err error
+		ip, err = gateway.DiscoverGateway()
+		return err
+	})
+	if err != nil {
+		l.Debugln("Failed to discover gateway", err)
+		return nil
+	}
+	if ip == nil || ip.IsUnspecified() {
+		return nil
+	}
+
+	l.Debugln("Discovered gateway at", ip)
+
+	c := newClient(&net.UDPAddr{IP: ip, Port: serverPort}, timeout)
+	// An ANNOUNCE is answered by any PCP server. Gateways speaking only
+	// NAT-PMP answer with an unsupported version error instead.
+	localIP, _, err := c.request(ctx, opAnnounce, 0, nil)
+	if err != nil {
+		l.Debugln("No PCP on gateway:", err)
+		return nil
+	}
+
+	return []nat.Device{&wrapper{
+		renewal:   renewal,
+		localIP:   localIP,
+		gatewayIP: ip,
+		client:    c,
+		nonces:    make(map[mappingKey][12]byte),
+		mut:       sync.NewMutex(),
+	}}
+}
+
+type mappingKey struct {
+	protocol     nat.Protocol
+	internalPort int
+}
+
+type wrapper struct {
+	renewal   time.Duration
+	localIP   net.IP
+	gatewayIP net.IP
+	client    *client
+
+	// The nonce identifies the mapping to the server, and must be the
+	// same when renewing it.
+	nonces     map[mappingKey][12]byte
+	externalIP net.IP
+	mut        sync.Mutex
+}
+
+func (w *wrapper) ID() string {
+	return fmt.Sprintf("PCP@%s", w.gatewayIP.String())
+}
+
+func (w *wrapper) GatewayIP() net.IP {
+	return w.gatewayIP
+}
+
+func (*wrapper) Preference() int {
+	return nat.PreferencePCP
+}
+
+func (w *wrapper) GetLocalIPv4Address() net.IP {
+	return w.localIP
+}
+
+func (w *wrapper) AddPortMapping(ctx context.Context, protocol nat.Protocol, internalPort, externalPort int, _ string, duration time.Duration) (int, error) {
+	// As for NAT-PMP, a lifetime of zero removes the mapping.
+	if duration == 0 {
+		duration = w.renewal
+	}
+
+	key := mappingKey{protocol, internalPort}
+	w.mut.Lock()
+	nonce, ok := w.nonces[key]
+	if !ok {
+		if _, err := rand.Read(nonce[:]); err != nil {
+			w.mut.Unlock()
+			return 0, err
+		}
+		w.nonces[key] = nonce
+	}
+	w.mut.Unlock()
+
+	req := mapRequest{
+		nonce:        nonce,
+		protocol:     protocolNumber(protocol),
+		internalPort: uint16(internalPort),
+		externalPort: uint16(externalPort),
+		externalIP:   net.IPv4zero,
+	}
+	_, payload, err := w.client.request(ctx, opMap, uint32(duration/time.Second), req.marshal())
+	if err != nil {
+		return 0, err
+	}
+	resp, err := parseMap(payload)
+	if err != nil {
+		return 0, err
+	}
+	if resp.nonce != nonce || resp.protocol != req.protocol || resp.internalPort != req.internalPort {
+		return 0, errors.New("response does not match request")
+	}
+
+	w.mut.Lock()
+	w.externalIP = resp.externalIP
+	w.mut.Unlock()
+	return int(resp.externalPort), nil
+}
+
+func (*wrapper) AddPinhole(_ context.Context, _ nat.Protocol, _ nat.Address, _ time.Duration) ([]net.IP, error) {
+	return nil, errors.New("adding IPv6 pinholes is unsupported on PCP")
+}
+
+func (*wrapper) SupportsIPVersion(version nat.IPVersion) bool {
+	// The gateway is discovered by its IPv4 address, so we can only map
+	// IPv4 ports on it.
+	return version == nat.IPvAny || version == nat.IPv4Only
+}
+
+// GetExternalIPv4Address returns the address assigned to the last mapping,
+// as PCP has no separate request for it.
+func (w *wrapper) GetExternalIPv4Address(_ context.Context) (net.IP, error) {
+	w.mut.Lock()
+	defer w.mut.Unlock()
+	if w.externalIP == nil {
+		return net.IPv4zero, errors.New("no mapping yet")
+	}
+	return w.externalIP, nil
+}
+
+type client struct {
+	server  *net.UDPAddr
+	timeout time.Duration
+}
+
+func newClient(server *net.UDPAddr, timeout time.Duration) *client {
+	return &client{server: server, timeout: timeout}
+}
+
+// request sends the request to the server, retransmitting until there is
+// an answer or the timeout expires, and returns the local address used and
+// the opcode specific payload of the response.
+func (c *client) request(ctx context.Context, opcode byte, lifetime uint32, payload []byte) (net.IP, []byte, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	conn, err := (&net.Dialer{}).DialContext(ctx, "udp", c.server.String())
+	if err != nil {
+		return nil, nil, err
+	}
+	defer conn.Close()
+	go func() {
+		<-ctx.Done()
+		conn.Close()
+	}()
+
+	// The server checks that the client address in the request is the one
+	// it came from, to detect NATs between us.
+	localIP, err := osutil.IPFromAddr(conn.LocalAddr())
+	if err != nil {
+		return nil, nil, err
+	}
+	bs := marshalRequest(opcode, lifetime, localIP, payload)
+
+	buf := make([]byte, 1100) // maximum PCP message size
+	retry := initialRetry
+	for {
+		if _, err := conn.Write(bs); err != nil {
+			return nil, nil, contextErr(ctx, err)
+		}
+		_ = conn.SetReadDeadline(time.Now().Add(retry))
+		n, err := conn.Read(buf)
+		var nerr net.Error
+		if errors.As(err, &nerr) && nerr.Timeout() && ctx.Err() == nil {
+			retry *= 2
+			continue
+		}
+		if err != nil {
+			return nil, nil, contextErr(ctx, err)
+		}
+		resp, err := parseResponse(buf[:n])
+		if err != nil {
+			return nil, nil, err
+		}
+		if resp.opcode != opcode {
+			return nil, nil, fmt.Errorf("response for opcode %d, expected %d", resp.opcode, opcode)
+		}
+		if resp.result != resultSuccess {
+			return nil, nil, resp.result
+		}
+		return localIP, resp.payload, nil
+	}
+}
+
+func contextErr(ctx context.Context, err error) error {
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+	return err
+}
+
+func marshalRequest(opcode byte, lifetime uint32, clientIP net.IP, payload []byte) []byte {
+	bs := make([]byte, headerSize+len(payload))
+	bs[0] = version
+	bs[1] = opcode
+	binary.BigEndian.PutUint32(bs[4:], lifetime)
+	copy(bs[8:24], clientIP.To16())
+	copy(bs[headerSize:], payload)
+	return bs
+}
+
+type response struct {
+	opcode  byte
+	result  resultCode
+	payload []byte
+}
+
+func parseResponse(bs []byte) (response, error) {
+	// NAT-PMP servers answer with a shorter version zero response, which
+	// has the result code at the same offset.
+	if len(bs) >= 4 && bs[0] != version {
+		if bs[0] == 0 {
+			return response{}, resultUnsupportedVersion
+		}
+		return response{}, fmt.Errorf("unsupported version %d", bs[0])
+	}
+	if len(bs) < headerSize || len(bs)%4 != 0 {
+		return response{}, errors.New("malformed response")
+	}
+	if bs[1]&0x80 == 0 {
+		return response{}, errors.New("not a response")
+	}
+	return response{
+		opcode:  bs[1] &^ 0x80,
+		result:  resultCode(bs[3]),
+		payload: bs[headerSize:],
+	}, nil
+}
+
+type mapRequest struct {
+	nonce        [12]byte
+	protocol     byte
+	internalPort uint16
+	externalPort uint16
+	externalIP   net.IP
+}
+
+func (m mapRequest) marshal() []byte {
+	bs := make([]byte, mapSize)
+	copy(bs, m.nonce[:])
+	bs[12] = m.protocol
+	binary.BigEndian.PutUint16(bs[16:], m.internalPort)
+	binary.BigEndian.PutUint16(bs[18:], m.externalPort)
+	copy(bs[20:36], m.externalIP.To16())
+	return bs
+}
+
+func parseMap(bs []byte) (mapRequest, error) {
+	if len(bs) < mapSize {
+		return mapRequest{}, errors.New("short MAP response")
+	}
+	var m mapRequest
+	copy(m.nonce[:], bs)
+	m.protocol = bs[12]
+	m.internalPort = binary.BigEndian.Uint16(bs[16:])
+	m.externalPort = binary.BigEndian.Uint16(bs[18:])
+	m.externalIP = net.IP(append([]byte(nil), bs[20:36]...))
+	if ip4 := m.externalIP.To4(); ip4 != nil {
+		m.externalIP = ip4
+	}
+	return m, nil
+}
+
+func protocolNumber(protocol nat.Protocol) byte {
+	if protocol == nat.UDP {
+		return 17
+	}
+	return 6
+}
+
+type resultCode byte
+
+const (
+	resultSuccess resultCode = iota
+	resultUnsupportedVersion
+	resultNotAuthorized
+	resultMalformedRequest
+	resultUnsupportedOpcode
+	resultUnsupportedOption
+	resultMalformedOption
+	resultNetworkFailure
+	resultNoResources
+	resultUnsupportedProtocol
+	resultUserExceededQuota
+	resultCannotProvideExternal
+	resultAddressMismatch
+	resultExcessiveRemotePeers
+)
+
+func (r resultCode) Error() string {
+	switch r {
+	case resultSuccess:
+		return "success"
+	case resultUnsupportedVersion:
+		return "unsupported version"
+	case resultNotAuthorized:
+		return "not authorized"
+	case resultMalformedRequest:
+		return "malformed request"
+	case resultUnsupportedOpcode:
+		return "unsupported opcode"
+	case resultUnsupportedOption:
+		return "unsupported option"
+	case resultMalformedOption:
+		return "malformed option"
+	case resultNetworkFailure:
+		return "network failure"
+	case resultNoResources:
+		return "no resources"
+	case resultUnsupportedProtocol:
+		return "unsupported protocol"
+	case resultUserExceededQuota:
+		return "user exceeded quota"
+	case resultCannotProvideExternal:
+		return "cannot provide external address"
+	case resultAddressMismatch:
+		return "address mismatch"
+	case resultExcessiveRemotePeers:
+		return "excessive remote peers"
+	default:
+		return fmt.Sprintf("result code %d", byte(r))
+	}
+}
@@ -0,0 +1,133 @@
+// Copyright (C) 2026 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package pcp
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/syncthing/syncthing/lib/nat"
+	"github.com/syncthing/syncthing/lib/sync"
+)
+
+// fakeServer answers MAP requests with the given external port, or with
+// a NAT-PMP unsupported version error when pmpOnly is set.
+func fakeServer(t *testing.T, pmpOnly bool) *net.UDPAddr {
+	t.Helper()
+	conn, err := net.ListenUDP("udp4", &net.UDPAddr{IP: net.IPv4(127, 0, 0, 1)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { conn.Close() })
+
+	go func() {
+		buf := make([]byte, 1100)
+		first := true
+		for {
+			n, addr, err := conn.ReadFromUDP(buf)
+			if err != nil {
+				return
+			}
+			if first {
+				// Drop the first request to exercise retransmission
+				first = false
+				continue
+			}
+			req := buf[:n]
+			if pmpOnly {
+				conn.WriteToUDP([]byte{0, 0x80 | req[1], 0, 1}, addr)
+				continue
+			}
+			resp := make([]byte, n)
+			copy(resp, req)
+			resp[1] |= 0x80
+			resp[2] = 0
+			resp[3] = byte(resultSuccess)
+			copy(resp[8:headerSize], make([]byte, 16))
+			if req[1] == opMap {
+				// Assign the suggested port on 192.0.2.1
+				copy(resp[headerSize+20:], net.IPv4(192, 0, 2, 1).To16())
+			}
+			conn.WriteToUDP(resp, addr)
+		}
+	}()
+
+	return conn.LocalAddr().(*net.UDPAddr)
+}
+
+func TestMapping(t *testing.T) {
+	w := &wrapper{
+		renewal:   time.Minute,
+		gatewayIP: net.IPv4(127, 0, 0, 1),
+		client:    newClient(fakeServer(t, false), 5*time.Second),
+		nonces:    make(map[mappingKey][12]byte),
+		mut:       sync.NewMutex(),
+	}
+
+	port, err := w.AddPortMapping(context.Background(), nat.TCP, 22000, 34567, "", time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if port != 34567 {
+		t.Errorf("got port %d", port)
+	}
+	ip, err := w.GetExternalIPv4Address(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !ip.Equal(net.IPv4(192, 0, 2, 1)) {
+		t.Errorf("got external address %v", ip)
+	}
+
+	// Renewals use the same nonce
+	nonce := w.nonces[mappingKey{nat.TCP, 22000}]
+	if _, err := w.AddPortMapping(context.Background(), nat.TCP, 22000, 34567, "", time.Hour); err != nil {
+		t.Fatal(err)
+	}
+	if w.nonces[mappingKey{nat.TCP, 22000}] != nonce || len(w.nonces) != 1 {
+		t.Error("nonce changed on renewal")
+	}
+}
+
+func TestPMPOnlyServer(t *testing.T) {
+	c := newClient(fakeServer(t, true), 5*time.Second)
+	_, _, err := c.request(context.Background(), opAnnounce, 0, nil)
+	if !errors.Is(err, resultUnsupportedVersion) {
+		t.Errorf("expected unsupported version, got %v", err)
+	}
+}
+
+func TestMarshalRequest(t *testing.T) {
+	req := mapRequest{
+		nonce:        [12]byte{1, 2, 3},
+		protocol:     6,
+		internalPort: 22000,
+		externalPort: 1234,
+		externalIP:   net.IPv4zero,
+	}
+	bs := marshalRequest(opMap, 3600, net.IPv4(192, 168, 0, 2), req.marshal())
+	if len(bs) != headerSize+mapSize {
+		t.Fatalf("got length %d", len(bs))
+	}
+	if bs[0] != version || bs[1] != opMap || binary.BigEndian.Uint32(bs[4:]) != 3600 {
+		t.Errorf("bad header %x", bs[:headerSize])
+	}
+	if !net.IP(bs[8:24]).Equal(net.IPv4(192, 168, 0, 2)) {
+		t.Errorf("bad client address %x", bs[8:24])
+	}
+	m, err := parseMap(bs[headerSize:])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if m.nonce != req.nonce || m.protocol != 6 || m.internalPort != 22000 || m.externalPort != 1234 || !m.externalIP.Equal(net.IPv4zero) {
+		t.Errorf("bad MAP payload %+v", m)
+	}
+}
@@ -92,6 +92,14 @@ func (w *wrapper) ID() string {
 	return fmt.Sprintf("NAT-PMP@%s", w.gatewayIP.String())
 }
 
+func (w *wrapper) GatewayIP() net.IP {
+	return w.gatewayIP
+}
+
+func (*wrapper) Preference() int {
+	return nat.PreferencePMP
+}
+
 func (w *wrapper) GetLocalIPv4Address() net.IP {
 	return w.localIP
 }
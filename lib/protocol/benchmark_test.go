@@ -60,9 +60,9 @@ func benchmarkRequestsTLS(b *testing.B, conn0, conn1 net.Conn) {
 
 func benchmarkRequestsConnPair(b *testing.B, conn0, conn1 net.Conn) {
 	// Start up Connections on them
-	c0 := NewConnection(LocalDeviceID, conn0, conn0, testutil.NoopCloser{}, new(fakeModel), new(mockedConnectionInfo), CompressionMetadata, nil, testKeyGen)
+	c0 := NewConnection(LocalDeviceID, conn0, conn0, testutil.NoopCloser{}, new(fakeModel), new(mockedConnectionInfo), CompressionMetadata, MessageCompressionLZ4, nil, testKeyGen)
 	c0.Start()
-	c1 := NewConnection(LocalDeviceID, conn1, conn1, testutil.NoopCloser{}, new(fakeModel), new(mockedConnectionInfo), CompressionMetadata, nil, testKeyGen)
+	c1 := NewConnection(LocalDeviceID, conn1, conn1, testutil.NoopCloser{}, new(fakeModel), new(mockedConnectionInfo), CompressionMetadata, MessageCompressionLZ4, nil, testKeyGen)
 	c1.Start()
 
 	// Satisfy the assertions in the protocol by sending an initial cluster config
@@ -194,3 +194,15 @@ func (*fakeModel) Closed(Connection, error) {
 func (*fakeModel) DownloadProgress(Connection, *DownloadProgress) error {
 	return nil
 }
+
+func (*fakeModel) ConfigPush(Connection, *ConfigPush) error {
+	return nil
+}
+
+func (*fakeModel) UsageReport(Connection, *UsageReport) error {
+	return nil
+}
+
+func (*fakeModel) IndexAck(Connection, *IndexAck) error {
+	return nil
+}
@@ -35,17 +35,23 @@ const (
 	MessageTypeDownloadProgress MessageType = 5
 	MessageTypePing             MessageType = 6
 	MessageTypeClose            MessageType = 7
+	MessageTypeConfigPush       MessageType = 8
+	MessageTypeUsageReport      MessageType = 9
+	MessageTypeIndexAck         MessageType = 10
 )
 
 var MessageType_name = map[int32]string{
-	0: "MESSAGE_TYPE_CLUSTER_CONFIG",
-	1: "MESSAGE_TYPE_INDEX",
-	2: "MESSAGE_TYPE_INDEX_UPDATE",
-	3: "MESSAGE_TYPE_REQUEST",
-	4: "MESSAGE_TYPE_RESPONSE",
-	5: "MESSAGE_TYPE_DOWNLOAD_PROGRESS",
-	6: "MESSAGE_TYPE_PING",
-	7: "MESSAGE_TYPE_CLOSE",
+	0:  "MESSAGE_TYPE_CLUSTER_CONFIG",
+	1:  "MESSAGE_TYPE_INDEX",
+	2:  "MESSAGE_TYPE_INDEX_UPDATE",
+	3:  "MESSAGE_TYPE_REQUEST",
+	4:  "MESSAGE_TYPE_RESPONSE",
+	5:  "MESSAGE_TYPE_DOWNLOAD_PROGRESS",
+	6:  "MESSAGE_TYPE_PING",
+	7:  "MESSAGE_TYPE_CLOSE",
+	8:  "MESSAGE_TYPE_CONFIG_PUSH",
+	9:  "MESSAGE_TYPE_USAGE_REPORT",
+	10: "MESSAGE_TYPE_INDEX_ACK",
 }
 
 var MessageType_value = map[string]int32{
@@ -57,6 +63,9 @@ var MessageType_value = map[string]int32{
 	"MESSAGE_TYPE_DOWNLOAD_PROGRESS": 5,
 	"MESSAGE_TYPE_PING":              6,
 	"MESSAGE_TYPE_CLOSE":             7,
+	"MESSAGE_TYPE_CONFIG_PUSH":       8,
+	"MESSAGE_TYPE_USAGE_REPORT":      9,
+	"MESSAGE_TYPE_INDEX_ACK":         10,
 }
 
 func (x MessageType) String() string {
@@ -72,16 +81,19 @@ type MessageCompression int32
 const (
 	MessageCompressionNone MessageCompression = 0
 	MessageCompressionLZ4  MessageCompression = 1
+	MessageCompressionZstd MessageCompression = 2
 )
 
 var MessageCompression_name = map[int32]string{
 	0: "MESSAGE_COMPRESSION_NONE",
 	1: "MESSAGE_COMPRESSION_LZ4",
+	2: "MESSAGE_COMPRESSION_ZSTD",
 }
 
 var MessageCompression_value = map[string]int32{
 	"MESSAGE_COMPRESSION_NONE": 0,
 	"MESSAGE_COMPRESSION_LZ4":  1,
+	"MESSAGE_COMPRESSION_ZSTD": 2,
 }
 
 func (x MessageCompression) String() string {
@@ -120,6 +132,30 @@ func (Compression) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_311ef540e10d9705, []int{2}
 }
 
+// The hash function used for the block hashes of a file. Anything but
+// SHA-256 is only used in folders shared with devices supporting the
+// corresponding extension.
+type BlockHashAlgorithm int32
+
+const (
+	BlockHashSHA256  BlockHashAlgorithm = 0
+	BlockHashBLAKE2b BlockHashAlgorithm = 1
+)
+
+var BlockHashAlgorithm_name = map[int32]string{
+	0: "BLOCK_HASH_ALGORITHM_SHA256",
+	1: "BLOCK_HASH_ALGORITHM_BLAKE2B",
+}
+
+var BlockHashAlgorithm_value = map[string]int32{
+	"BLOCK_HASH_ALGORITHM_SHA256":  0,
+	"BLOCK_HASH_ALGORITHM_BLAKE2B": 1,
+}
+
+func (BlockHashAlgorithm) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_311ef540e10d9705, []int{3}
+}
+
 type FileInfoType int32
 
 const (
@@ -151,7 +187,7 @@ func (x FileInfoType) String() string {
 }
 
 func (FileInfoType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_311ef540e10d9705, []int{3}
+	return fileDescriptor_311ef540e10d9705, []int{4}
 }
 
 type ErrorCode int32
@@ -182,7 +218,7 @@ func (x ErrorCode) String() string {
 }
 
 func (ErrorCode) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_311ef540e10d9705, []int{4}
+	return fileDescriptor_311ef540e10d9705, []int{5}
 }
 
 type FileDownloadProgressUpdateType int32
@@ -207,7 +243,33 @@ func (x FileDownloadProgressUpdateType) String() string {
 }
 
 func (FileDownloadProgressUpdateType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_311ef540e10d9705, []int{5}
+	return fileDescriptor_311ef540e10d9705, []int{6}
+}
+
+// The preferred message compression algorithm, when compressing. Auto uses
+// zstd over the internet and the faster LZ4 on the LAN.
+type CompressionAlgorithm int32
+
+const (
+	CompressionAlgorithmAuto CompressionAlgorithm = 0
+	CompressionAlgorithmLZ4  CompressionAlgorithm = 1
+	CompressionAlgorithmZstd CompressionAlgorithm = 2
+)
+
+var CompressionAlgorithm_name = map[int32]string{
+	0: "COMPRESSION_ALGORITHM_AUTO",
+	1: "COMPRESSION_ALGORITHM_LZ4",
+	2: "COMPRESSION_ALGORITHM_ZSTD",
+}
+
+var CompressionAlgorithm_value = map[string]int32{
+	"COMPRESSION_ALGORITHM_AUTO": 0,
+	"COMPRESSION_ALGORITHM_LZ4":  1,
+	"COMPRESSION_ALGORITHM_ZSTD": 2,
+}
+
+func (CompressionAlgorithm) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_311ef540e10d9705, []int{7}
 }
 
 type Hello struct {
@@ -216,6 +278,10 @@ type Hello struct {
 	ClientVersion  string `protobuf:"bytes,3,opt,name=client_version,json=clientVersion,proto3" json:"clientVersion" xml:"clientVersion"`
 	NumConnections int    `protobuf:"varint,4,opt,name=num_connections,json=numConnections,proto3,casttype=int" json:"numConnections" xml:"numConnections"`
 	Timestamp      int64  `protobuf:"varint,5,opt,name=timestamp,proto3" json:"timestamp" xml:"timestamp"`
+	// the message compressions the device can decode
+	Compressions []MessageCompression `protobuf:"varint,6,rep,packed,name=compressions,proto3,enum=protocol.MessageCompression" json:"compressions" xml:"compression"`
+	// the protocol extensions the device supports
+	Extensions []string `protobuf:"bytes,7,rep,name=extensions,proto3" json:"extensions" xml:"extension"`
 }
 
 func (m *Hello) Reset()         { *m = Hello{} }
@@ -292,6 +358,8 @@ var xxx_messageInfo_Header proto.InternalMessageInfo
 type ClusterConfig struct {
 	Folders   []Folder `protobuf:"bytes,1,rep,name=folders,proto3" json:"folders" xml:"folder"`
 	Secondary bool     `protobuf:"varint,2,opt,name=secondary,proto3" json:"secondary" xml:"secondary"`
+	// the protocol extensions the device uses in this session
+	Extensions []string `protobuf:"bytes,3,rep,name=extensions,proto3" json:"extensions" xml:"extension"`
 }
 
 func (m *ClusterConfig) Reset()         { *m = ClusterConfig{} }
@@ -328,14 +396,17 @@ func (m *ClusterConfig) XXX_DiscardUnknown() {
 var xxx_messageInfo_ClusterConfig proto.InternalMessageInfo
 
 type Folder struct {
-	ID                 string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id" xml:"id"`
-	Label              string   `protobuf:"bytes,2,opt,name=label,proto3" json:"label" xml:"label"`
-	ReadOnly           bool     `protobuf:"varint,3,opt,name=read_only,json=readOnly,proto3" json:"readOnly" xml:"readOnly"`
-	IgnorePermissions  bool     `protobuf:"varint,4,opt,name=ignore_permissions,json=ignorePermissions,proto3" json:"ignorePermissions" xml:"ignorePermissions"`
-	IgnoreDelete       bool     `protobuf:"varint,5,opt,name=ignore_delete,json=ignoreDelete,proto3" json:"ignoreDelete" xml:"ignoreDelete"`
-	DisableTempIndexes bool     `protobuf:"varint,6,opt,name=disable_temp_indexes,json=disableTempIndexes,proto3" json:"disableTempIndexes" xml:"disableTempIndexes"`
-	Paused             bool     `protobuf:"varint,7,opt,name=paused,proto3" json:"paused" xml:"paused"`
-	Devices            []Device `protobuf:"bytes,16,rep,name=devices,proto3" json:"devices" xml:"device"`
+	ID                 string `protobuf:"bytes,1,opt,name=id,proto3" json:"id" xml:"id"`
+	Label              string `protobuf:"bytes,2,opt,name=label,proto3" json:"label" xml:"label"`
+	ReadOnly           bool   `protobuf:"varint,3,opt,name=read_only,json=readOnly,proto3" json:"readOnly" xml:"readOnly"`
+	IgnorePermissions  bool   `protobuf:"varint,4,opt,name=ignore_permissions,json=ignorePermissions,proto3" json:"ignorePermissions" xml:"ignorePermissions"`
+	IgnoreDelete       bool   `protobuf:"varint,5,opt,name=ignore_delete,json=ignoreDelete,proto3" json:"ignoreDelete" xml:"ignoreDelete"`
+	DisableTempIndexes bool   `protobuf:"varint,6,opt,name=disable_temp_indexes,json=disableTempIndexes,proto3" json:"disableTempIndexes" xml:"disableTempIndexes"`
+	Paused             bool   `protobuf:"varint,7,opt,name=paused,proto3" json:"paused" xml:"paused"`
+	// Settings for devices that have us as introducer to use, when they
+	// add the folder. Empty unless we propagate them.
+	Defaults FolderDefaults `protobuf:"bytes,8,opt,name=defaults,proto3" json:"defaults" xml:"defaults"`
+	Devices  []Device       `protobuf:"bytes,16,rep,name=devices,proto3" json:"devices" xml:"device"`
 }
 
 func (m *Folder) Reset()         { *m = Folder{} }
@@ -371,6 +442,46 @@ func (m *Folder) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Folder proto.InternalMessageInfo
 
+type FolderDefaults struct {
+	VersioningType             string            `protobuf:"bytes,1,opt,name=versioning_type,json=versioningType,proto3" json:"versioningType" xml:"versioningType"`
+	VersioningParams           map[string]string `protobuf:"bytes,2,rep,name=versioning_params,json=versioningParams,proto3" json:"versioningParams" xml:"versioningParam" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	VersioningCleanupIntervalS int               `protobuf:"varint,3,opt,name=versioning_cleanup_interval_s,json=versioningCleanupIntervalS,proto3,casttype=int" json:"versioningCleanupIntervalS" xml:"versioningCleanupIntervalS"`
+	IgnoreLines                []string          `protobuf:"bytes,4,rep,name=ignore_lines,json=ignoreLines,proto3" json:"ignoreLines" xml:"ignoreLine"`
+}
+
+func (m *FolderDefaults) Reset()         { *m = FolderDefaults{} }
+func (m *FolderDefaults) String() string { return proto.CompactTextString(m) }
+func (*FolderDefaults) ProtoMessage()    {}
+func (*FolderDefaults) Descriptor() ([]byte, []int) {
+	return fileDescriptor_311ef540e10d9705, []int{4}
+}
+func (m *FolderDefaults) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FolderDefaults) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FolderDefaults.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FolderDefaults) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FolderDefaults.Merge(m, src)
+}
+func (m *FolderDefaults) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *FolderDefaults) XXX_DiscardUnknown() {
+	xxx_messageInfo_FolderDefaults.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FolderDefaults proto.InternalMessageInfo
+
 type Device struct {
 	ID                       DeviceID    `protobuf:"bytes,1,opt,name=id,proto3,customtype=DeviceID" json:"id" xml:"id"`
 	Name                     string      `protobuf:"bytes,2,opt,name=name,proto3" json:"name" xml:"name"`
@@ -382,13 +493,14 @@ type Device struct {
 	IndexID                  IndexID     `protobuf:"varint,8,opt,name=index_id,json=indexId,proto3,customtype=IndexID" json:"indexId" xml:"indexId"`
 	SkipIntroductionRemovals bool        `protobuf:"varint,9,opt,name=skip_introduction_removals,json=skipIntroductionRemovals,proto3" json:"skipIntroductionRemovals" xml:"skipIntroductionRemovals"`
 	EncryptionPasswordToken  []byte      `protobuf:"bytes,10,opt,name=encryption_password_token,json=encryptionPasswordToken,proto3" json:"encryptionPasswordToken" xml:"encryptionPasswordToken"`
+	IndexSubtree             string      `protobuf:"bytes,11,opt,name=index_subtree,json=indexSubtree,proto3" json:"indexSubtree" xml:"indexSubtree"`
 }
 
 func (m *Device) Reset()         { *m = Device{} }
 func (m *Device) String() string { return proto.CompactTextString(m) }
 func (*Device) ProtoMessage()    {}
 func (*Device) Descriptor() ([]byte, []int) {
-	return fileDescriptor_311ef540e10d9705, []int{4}
+	return fileDescriptor_311ef540e10d9705, []int{5}
 }
 func (m *Device) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -427,7 +539,7 @@ func (m *Index) Reset()         { *m = Index{} }
 func (m *Index) String() string { return proto.CompactTextString(m) }
 func (*Index) ProtoMessage()    {}
 func (*Index) Descriptor() ([]byte, []int) {
-	return fileDescriptor_311ef540e10d9705, []int{5}
+	return fileDescriptor_311ef540e10d9705, []int{6}
 }
 func (m *Index) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -467,7 +579,7 @@ func (m *IndexUpdate) Reset()         { *m = IndexUpdate{} }
 func (m *IndexUpdate) String() string { return proto.CompactTextString(m) }
 func (*IndexUpdate) ProtoMessage()    {}
 func (*IndexUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_311ef540e10d9705, []int{6}
+	return fileDescriptor_311ef540e10d9705, []int{7}
 }
 func (m *IndexUpdate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -497,21 +609,22 @@ func (m *IndexUpdate) XXX_DiscardUnknown() {
 var xxx_messageInfo_IndexUpdate proto.InternalMessageInfo
 
 type FileInfo struct {
-	Name          string       `protobuf:"bytes,1,opt,name=name,proto3" json:"name" xml:"name"`
-	Size          int64        `protobuf:"varint,3,opt,name=size,proto3" json:"size" xml:"size"`
-	ModifiedS     int64        `protobuf:"varint,5,opt,name=modified_s,json=modifiedS,proto3" json:"modifiedS" xml:"modifiedS"`
-	ModifiedBy    ShortID      `protobuf:"varint,12,opt,name=modified_by,json=modifiedBy,proto3,customtype=ShortID" json:"modifiedBy" xml:"modifiedBy"`
-	Version       Vector       `protobuf:"bytes,9,opt,name=version,proto3" json:"version" xml:"version"`
-	Sequence      int64        `protobuf:"varint,10,opt,name=sequence,proto3" json:"sequence" xml:"sequence"`
-	Blocks        []BlockInfo  `protobuf:"bytes,16,rep,name=blocks,proto3" json:"blocks" xml:"block"`
-	SymlinkTarget string       `protobuf:"bytes,17,opt,name=symlink_target,json=symlinkTarget,proto3" json:"symlinkTarget" xml:"symlinkTarget"`
-	BlocksHash    []byte       `protobuf:"bytes,18,opt,name=blocks_hash,json=blocksHash,proto3" json:"blocksHash" xml:"blocksHash"`
-	Encrypted     []byte       `protobuf:"bytes,19,opt,name=encrypted,proto3" json:"encrypted" xml:"encrypted"`
-	Type          FileInfoType `protobuf:"varint,2,opt,name=type,proto3,enum=protocol.FileInfoType" json:"type" xml:"type"`
-	Permissions   uint32       `protobuf:"varint,4,opt,name=permissions,proto3" json:"permissions" xml:"permissions"`
-	ModifiedNs    int          `protobuf:"varint,11,opt,name=modified_ns,json=modifiedNs,proto3,casttype=int" json:"modifiedNs" xml:"modifiedNs"`
-	RawBlockSize  int          `protobuf:"varint,13,opt,name=block_size,json=blockSize,proto3,casttype=int" json:"blockSize" xml:"blockSize"`
-	Platform      PlatformData `protobuf:"bytes,14,opt,name=platform,proto3" json:"platform" xml:"platform"`
+	Name          string             `protobuf:"bytes,1,opt,name=name,proto3" json:"name" xml:"name"`
+	Size          int64              `protobuf:"varint,3,opt,name=size,proto3" json:"size" xml:"size"`
+	ModifiedS     int64              `protobuf:"varint,5,opt,name=modified_s,json=modifiedS,proto3" json:"modifiedS" xml:"modifiedS"`
+	ModifiedBy    ShortID            `protobuf:"varint,12,opt,name=modified_by,json=modifiedBy,proto3,customtype=ShortID" json:"modifiedBy" xml:"modifiedBy"`
+	Version       Vector             `protobuf:"bytes,9,opt,name=version,proto3" json:"version" xml:"version"`
+	Sequence      int64              `protobuf:"varint,10,opt,name=sequence,proto3" json:"sequence" xml:"sequence"`
+	Blocks        []BlockInfo        `protobuf:"bytes,16,rep,name=blocks,proto3" json:"blocks" xml:"block"`
+	SymlinkTarget string             `protobuf:"bytes,17,opt,name=symlink_target,json=symlinkTarget,proto3" json:"symlinkTarget" xml:"symlinkTarget"`
+	BlocksHash    []byte             `protobuf:"bytes,18,opt,name=blocks_hash,json=blocksHash,proto3" json:"blocksHash" xml:"blocksHash"`
+	Encrypted     []byte             `protobuf:"bytes,19,opt,name=encrypted,proto3" json:"encrypted" xml:"encrypted"`
+	Type          FileInfoType       `protobuf:"varint,2,opt,name=type,proto3,enum=protocol.FileInfoType" json:"type" xml:"type"`
+	Permissions   uint32             `protobuf:"varint,4,opt,name=permissions,proto3" json:"permissions" xml:"permissions"`
+	ModifiedNs    int                `protobuf:"varint,11,opt,name=modified_ns,json=modifiedNs,proto3,casttype=int" json:"modifiedNs" xml:"modifiedNs"`
+	RawBlockSize  int                `protobuf:"varint,13,opt,name=block_size,json=blockSize,proto3,casttype=int" json:"blockSize" xml:"blockSize"`
+	HashAlgorithm BlockHashAlgorithm `protobuf:"varint,20,opt,name=hash_algorithm,json=hashAlgorithm,proto3,enum=protocol.BlockHashAlgorithm" json:"hashAlgorithm" xml:"hashAlgorithm"`
+	Platform      PlatformData       `protobuf:"bytes,14,opt,name=platform,proto3" json:"platform" xml:"platform"`
 	// The local_flags fields stores flags that are relevant to the local
 	// host only. It is not part of the protocol, doesn't get sent or
 	// received (we make sure to zero it), nonetheless we need it on our
@@ -534,7 +647,7 @@ type FileInfo struct {
 func (m *FileInfo) Reset()      { *m = FileInfo{} }
 func (*FileInfo) ProtoMessage() {}
 func (*FileInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_311ef540e10d9705, []int{7}
+	return fileDescriptor_311ef540e10d9705, []int{8}
 }
 func (m *FileInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -573,7 +686,7 @@ type BlockInfo struct {
 func (m *BlockInfo) Reset()      { *m = BlockInfo{} }
 func (*BlockInfo) ProtoMessage() {}
 func (*BlockInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_311ef540e10d9705, []int{8}
+	return fileDescriptor_311ef540e10d9705, []int{9}
 }
 func (m *BlockInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -610,7 +723,7 @@ func (m *Vector) Reset()         { *m = Vector{} }
 func (m *Vector) String() string { return proto.CompactTextString(m) }
 func (*Vector) ProtoMessage()    {}
 func (*Vector) Descriptor() ([]byte, []int) {
-	return fileDescriptor_311ef540e10d9705, []int{9}
+	return fileDescriptor_311ef540e10d9705, []int{10}
 }
 func (m *Vector) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -648,7 +761,7 @@ func (m *Counter) Reset()         { *m = Counter{} }
 func (m *Counter) String() string { return proto.CompactTextString(m) }
 func (*Counter) ProtoMessage()    {}
 func (*Counter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_311ef540e10d9705, []int{10}
+	return fileDescriptor_311ef540e10d9705, []int{11}
 }
 func (m *Counter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -690,7 +803,7 @@ func (m *PlatformData) Reset()         { *m = PlatformData{} }
 func (m *PlatformData) String() string { return proto.CompactTextString(m) }
 func (*PlatformData) ProtoMessage()    {}
 func (*PlatformData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_311ef540e10d9705, []int{11}
+	return fileDescriptor_311ef540e10d9705, []int{12}
 }
 func (m *PlatformData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -733,7 +846,7 @@ func (m *UnixData) Reset()         { *m = UnixData{} }
 func (m *UnixData) String() string { return proto.CompactTextString(m) }
 func (*UnixData) ProtoMessage()    {}
 func (*UnixData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_311ef540e10d9705, []int{12}
+	return fileDescriptor_311ef540e10d9705, []int{13}
 }
 func (m *UnixData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -774,7 +887,7 @@ func (m *WindowsData) Reset()         { *m = WindowsData{} }
 func (m *WindowsData) String() string { return proto.CompactTextString(m) }
 func (*WindowsData) ProtoMessage()    {}
 func (*WindowsData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_311ef540e10d9705, []int{13}
+	return fileDescriptor_311ef540e10d9705, []int{14}
 }
 func (m *WindowsData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -811,7 +924,7 @@ func (m *XattrData) Reset()         { *m = XattrData{} }
 func (m *XattrData) String() string { return proto.CompactTextString(m) }
 func (*XattrData) ProtoMessage()    {}
 func (*XattrData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_311ef540e10d9705, []int{14}
+	return fileDescriptor_311ef540e10d9705, []int{15}
 }
 func (m *XattrData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -849,7 +962,7 @@ func (m *Xattr) Reset()         { *m = Xattr{} }
 func (m *Xattr) String() string { return proto.CompactTextString(m) }
 func (*Xattr) ProtoMessage()    {}
 func (*Xattr) Descriptor() ([]byte, []int) {
-	return fileDescriptor_311ef540e10d9705, []int{15}
+	return fileDescriptor_311ef540e10d9705, []int{16}
 }
 func (m *Xattr) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -894,7 +1007,7 @@ func (m *Request) Reset()         { *m = Request{} }
 func (m *Request) String() string { return proto.CompactTextString(m) }
 func (*Request) ProtoMessage()    {}
 func (*Request) Descriptor() ([]byte, []int) {
-	return fileDescriptor_311ef540e10d9705, []int{16}
+	return fileDescriptor_311ef540e10d9705, []int{17}
 }
 func (m *Request) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -933,7 +1046,7 @@ func (m *Response) Reset()         { *m = Response{} }
 func (m *Response) String() string { return proto.CompactTextString(m) }
 func (*Response) ProtoMessage()    {}
 func (*Response) Descriptor() ([]byte, []int) {
-	return fileDescriptor_311ef540e10d9705, []int{17}
+	return fileDescriptor_311ef540e10d9705, []int{18}
 }
 func (m *Response) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -971,7 +1084,7 @@ func (m *DownloadProgress) Reset()         { *m = DownloadProgress{} }
 func (m *DownloadProgress) String() string { return proto.CompactTextString(m) }
 func (*DownloadProgress) ProtoMessage()    {}
 func (*DownloadProgress) Descriptor() ([]byte, []int) {
-	return fileDescriptor_311ef540e10d9705, []int{18}
+	return fileDescriptor_311ef540e10d9705, []int{19}
 }
 func (m *DownloadProgress) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1012,7 +1125,7 @@ func (m *FileDownloadProgressUpdate) Reset()         { *m = FileDownloadProgress
 func (m *FileDownloadProgressUpdate) String() string { return proto.CompactTextString(m) }
 func (*FileDownloadProgressUpdate) ProtoMessage()    {}
 func (*FileDownloadProgressUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_311ef540e10d9705, []int{19}
+	return fileDescriptor_311ef540e10d9705, []int{20}
 }
 func (m *FileDownloadProgressUpdate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1048,7 +1161,7 @@ func (m *Ping) Reset()         { *m = Ping{} }
 func (m *Ping) String() string { return proto.CompactTextString(m) }
 func (*Ping) ProtoMessage()    {}
 func (*Ping) Descriptor() ([]byte, []int) {
-	return fileDescriptor_311ef540e10d9705, []int{20}
+	return fileDescriptor_311ef540e10d9705, []int{21}
 }
 func (m *Ping) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1085,7 +1198,7 @@ func (m *Close) Reset()         { *m = Close{} }
 func (m *Close) String() string { return proto.CompactTextString(m) }
 func (*Close) ProtoMessage()    {}
 func (*Close) Descriptor() ([]byte, []int) {
-	return fileDescriptor_311ef540e10d9705, []int{21}
+	return fileDescriptor_311ef540e10d9705, []int{22}
 }
 func (m *Close) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1114,17 +1227,137 @@ func (m *Close) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Close proto.InternalMessageInfo
 
+type ConfigPush struct {
+	ID      int64    `protobuf:"varint,1,opt,name=id,proto3" json:"id" xml:"id"`
+	Folders []Folder `protobuf:"bytes,2,rep,name=folders,proto3" json:"folders" xml:"folder"`
+	Devices []Device `protobuf:"bytes,3,rep,name=devices,proto3" json:"devices" xml:"device"`
+}
+
+func (m *ConfigPush) Reset()         { *m = ConfigPush{} }
+func (m *ConfigPush) String() string { return proto.CompactTextString(m) }
+func (*ConfigPush) ProtoMessage()    {}
+func (*ConfigPush) Descriptor() ([]byte, []int) {
+	return fileDescriptor_311ef540e10d9705, []int{23}
+}
+func (m *ConfigPush) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConfigPush) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConfigPush.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ConfigPush) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConfigPush.Merge(m, src)
+}
+func (m *ConfigPush) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *ConfigPush) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConfigPush.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConfigPush proto.InternalMessageInfo
+
+type UsageReport struct {
+	// the report, as JSON, as it would have been uploaded
+	Report []byte `protobuf:"bytes,1,opt,name=report,proto3" json:"report" xml:"report"`
+}
+
+func (m *UsageReport) Reset()         { *m = UsageReport{} }
+func (m *UsageReport) String() string { return proto.CompactTextString(m) }
+func (*UsageReport) ProtoMessage()    {}
+func (*UsageReport) Descriptor() ([]byte, []int) {
+	return fileDescriptor_311ef540e10d9705, []int{24}
+}
+func (m *UsageReport) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UsageReport) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UsageReport.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UsageReport) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UsageReport.Merge(m, src)
+}
+func (m *UsageReport) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *UsageReport) XXX_DiscardUnknown() {
+	xxx_messageInfo_UsageReport.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UsageReport proto.InternalMessageInfo
+
+type IndexAck struct {
+	Folder string `protobuf:"bytes,1,opt,name=folder,proto3" json:"folder" xml:"folder"`
+	// the last sequence of the index message that was processed
+	Sequence int64 `protobuf:"varint,2,opt,name=sequence,proto3" json:"sequence" xml:"sequence"`
+}
+
+func (m *IndexAck) Reset()         { *m = IndexAck{} }
+func (m *IndexAck) String() string { return proto.CompactTextString(m) }
+func (*IndexAck) ProtoMessage()    {}
+func (*IndexAck) Descriptor() ([]byte, []int) {
+	return fileDescriptor_311ef540e10d9705, []int{25}
+}
+func (m *IndexAck) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IndexAck) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IndexAck.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *IndexAck) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IndexAck.Merge(m, src)
+}
+func (m *IndexAck) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *IndexAck) XXX_DiscardUnknown() {
+	xxx_messageInfo_IndexAck.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IndexAck proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterEnum("protocol.MessageType", MessageType_name, MessageType_value)
 	proto.RegisterEnum("protocol.MessageCompression", MessageCompression_name, MessageCompression_value)
 	proto.RegisterEnum("protocol.Compression", Compression_name, Compression_value)
+	proto.RegisterEnum("protocol.BlockHashAlgorithm", BlockHashAlgorithm_name, BlockHashAlgorithm_value)
 	proto.RegisterEnum("protocol.FileInfoType", FileInfoType_name, FileInfoType_value)
 	proto.RegisterEnum("protocol.ErrorCode", ErrorCode_name, ErrorCode_value)
 	proto.RegisterEnum("protocol.FileDownloadProgressUpdateType", FileDownloadProgressUpdateType_name, FileDownloadProgressUpdateType_value)
+	proto.RegisterEnum("protocol.CompressionAlgorithm", CompressionAlgorithm_name, CompressionAlgorithm_value)
 	proto.RegisterType((*Hello)(nil), "protocol.Hello")
 	proto.RegisterType((*Header)(nil), "protocol.Header")
 	proto.RegisterType((*ClusterConfig)(nil), "protocol.ClusterConfig")
 	proto.RegisterType((*Folder)(nil), "protocol.Folder")
+	proto.RegisterType((*FolderDefaults)(nil), "protocol.FolderDefaults")
+	proto.RegisterMapType((map[string]string)(nil), "protocol.FolderDefaults.VersioningParamsEntry")
 	proto.RegisterType((*Device)(nil), "protocol.Device")
 	proto.RegisterType((*Index)(nil), "protocol.Index")
 	proto.RegisterType((*IndexUpdate)(nil), "protocol.IndexUpdate")
@@ -1143,218 +1376,260 @@ func init() {
 	proto.RegisterType((*FileDownloadProgressUpdate)(nil), "protocol.FileDownloadProgressUpdate")
 	proto.RegisterType((*Ping)(nil), "protocol.Ping")
 	proto.RegisterType((*Close)(nil), "protocol.Close")
+	proto.RegisterType((*ConfigPush)(nil), "protocol.ConfigPush")
+	proto.RegisterType((*UsageReport)(nil), "protocol.UsageReport")
+	proto.RegisterType((*IndexAck)(nil), "protocol.IndexAck")
 }
 
 func init() { proto.RegisterFile("lib/protocol/bep.proto", fileDescriptor_311ef540e10d9705) }
 
 var fileDescriptor_311ef540e10d9705 = []byte{
-	// 3292 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x5a, 0x4d, 0x6c, 0x23, 0x47,
-	0x76, 0x16, 0x7f, 0x45, 0x95, 0x7e, 0x86, 0xaa, 0xf9, 0xa3, 0x39, 0x63, 0x35, 0x53, 0x3b, 0x9b,
-	0xc8, 0xda, 0xec, 0x78, 0xad, 0xf5, 0x6e, 0x1c, 0xdb, 0xb1, 0x21, 0xfe, 0x48, 0xc3, 0xb5, 0x86,
-	0x94, 0x8b, 0x9a, 0xf1, 0x7a, 0x80, 0x80, 0x68, 0xb1, 0x4b, 0x54, 0x63, 0xc8, 0x6e, 0xa6, 0xbb,
-	0xa9, 0x9f, 0x45, 0x2e, 0xc1, 0x02, 0x8b, 0x40, 0x87, 0x20, 0xd8, 0x53, 0x10, 0xac, 0x90, 0xc5,
-	0x5e, 0x72, 0x0b, 0x90, 0x43, 0x2e, 0x7b, 0xca, 0x71, 0x6e, 0x19, 0x2c, 0x10, 0x20, 0xc8, 0xa1,
-	0x01, 0xcf, 0x5c, 0x12, 0xe6, 0xc6, 0x63, 0x0e, 0x41, 0x50, 0xaf, 0xaa, 0xab, 0xab, 0xf5, 0xe3,
-	0x68, 0xec, 0x5b, 0x6e, 0xac, 0xef, 0x7d, 0xef, 0x55, 0x75, 0xbd, 0x57, 0xef, 0xbd, 0x2a, 0x09,
-	0xdd, 0x19, 0xd8, 0x7b, 0xef, 0x8e, 0x3c, 0x37, 0x70, 0x7b, 0xee, 0xe0, 0xdd, 0x3d, 0x36, 0x7a,
-	0x08, 0x03, 0x5c, 0x88, 0xb0, 0xf2, 0x1c, 0x3b, 0x0e, 0x04, 0x58, 0xfe, 0x8e, 0xc7, 0x46, 0xae,
-	0x2f, 0xe8, 0x7b, 0xe3, 0xfd, 0x77, 0xfb, 0x6e, 0xdf, 0x85, 0x01, 0xfc, 0x12, 0x24, 0xf2, 0x3f,
-	0x69, 0x94, 0x7b, 0xc4, 0x06, 0x03, 0x17, 0xd7, 0xd0, 0xbc, 0xc5, 0x0e, 0xed, 0x1e, 0xeb, 0x3a,
-	0xe6, 0x90, 0x95, 0x52, 0x95, 0xd4, 0xea, 0x5c, 0x95, 0x4c, 0x42, 0x03, 0x09, 0xb8, 0x65, 0x0e,
-	0xd9, 0x34, 0x34, 0x8a, 0xc7, 0xc3, 0xc1, 0x87, 0x24, 0x86, 0x08, 0xd5, 0xe4, 0xdc, 0x48, 0x6f,
-	0x60, 0x33, 0x27, 0x10, 0x46, 0xd2, 0xb1, 0x11, 0x01, 0x27, 0x8c, 0xc4, 0x10, 0xa1, 0x9a, 0x1c,
-	0xb7, 0xd1, 0x92, 0x34, 0x72, 0xc8, 0x3c, 0xdf, 0x76, 0x9d, 0x52, 0x06, 0xec, 0xac, 0x4e, 0x42,
-	0x63, 0x51, 0x48, 0x9e, 0x0a, 0xc1, 0x34, 0x34, 0x6e, 0x6a, 0xa6, 0x24, 0x4a, 0x68, 0x92, 0x85,
-	0x9f, 0xa1, 0x1b, 0xce, 0x78, 0xd8, 0xed, 0xb9, 0x8e, 0xc3, 0x7a, 0x81, 0xed, 0x3a, 0x7e, 0x29,
-	0x5b, 0x49, 0xad, 0xe6, 0xaa, 0xef, 0x4d, 0x42, 0x63, 0xc9, 0x19, 0x0f, 0x6b, 0xb1, 0x64, 0x1a,
-	0x1a, 0xb7, 0xc0, 0x64, 0x12, 0x26, 0xff, 0x1d, 0x1a, 0x19, 0xdb, 0x09, 0xe8, 0x39, 0x3a, 0xfe,
-	0x04, 0xcd, 0x05, 0xf6, 0x90, 0xf9, 0x81, 0x39, 0x1c, 0x95, 0x72, 0x95, 0xd4, 0x6a, 0xa6, 0x5a,
-	0x99, 0x84, 0x46, 0x0c, 0x4e, 0x43, 0xe3, 0x06, 0x18, 0x54, 0x08, 0xa1, 0xb1, 0x94, 0xfc, 0x63,
-	0x0a, 0xe5, 0x1f, 0x31, 0xd3, 0x62, 0x1e, 0xde, 0x40, 0xd9, 0xe0, 0x64, 0x24, 0xb6, 0x7e, 0x69,
-	0xfd, 0xf6, 0xc3, 0xc8, 0xa9, 0x0f, 0x1f, 0x33, 0xdf, 0x37, 0xfb, 0x6c, 0xf7, 0x64, 0xc4, 0xaa,
-	0x77, 0x26, 0xa1, 0x01, 0xb4, 0x69, 0x68, 0x20, 0x61, 0xf7, 0x64, 0xc4, 0x08, 0x05, 0x0c, 0x5b,
-	0x68, 0xbe, 0xe7, 0x0e, 0x47, 0x1e, 0xf3, 0x61, 0xdf, 0xd2, 0x60, 0xe9, 0xfe, 0x05, 0x4b, 0xb5,
-	0x98, 0x53, 0x7d, 0x30, 0x09, 0x0d, 0x5d, 0x69, 0x1a, 0x1a, 0xcb, 0x62, 0x4f, 0x63, 0x8c, 0x50,
-	0x9d, 0x41, 0x7e, 0x95, 0x42, 0x8b, 0xb5, 0xc1, 0xd8, 0x0f, 0x98, 0x57, 0x73, 0x9d, 0x7d, 0xbb,
-	0x8f, 0x3f, 0x43, 0xb3, 0xfb, 0xee, 0xc0, 0x62, 0x9e, 0x5f, 0x4a, 0x55, 0x32, 0xab, 0xf3, 0xeb,
-	0xc5, 0x78, 0xce, 0x4d, 0x10, 0x54, 0x8d, 0x17, 0xa1, 0x31, 0x33, 0x09, 0x8d, 0x88, 0x38, 0x0d,
-	0x8d, 0x05, 0x98, 0x47, 0x8c, 0x09, 0x8d, 0x04, 0x7c, 0x4b, 0x7d, 0xd6, 0x73, 0x1d, 0xcb, 0xf4,
-	0x4e, 0xe0, 0x13, 0x0a, 0x62, 0x4b, 0x15, 0xa8, 0xb6, 0x54, 0x21, 0x84, 0xc6, 0x52, 0xf2, 0xdb,
-	0x2c, 0xca, 0x8b, 0x49, 0xf1, 0x43, 0x94, 0xb6, 0x2d, 0x19, 0xcb, 0x2b, 0xaf, 0x42, 0x23, 0xdd,
-	0xac, 0x4f, 0x42, 0x23, 0x6d, 0x5b, 0xd3, 0xd0, 0x28, 0x80, 0x09, 0xdb, 0x22, 0xbf, 0x7c, 0xf9,
-	0x20, 0xdd, 0xac, 0xd3, 0xb4, 0x6d, 0xe1, 0x87, 0x28, 0x37, 0x30, 0xf7, 0xd8, 0x40, 0x46, 0x6e,
-	0x69, 0x12, 0x1a, 0x02, 0x98, 0x86, 0xc6, 0x3c, 0xf0, 0x61, 0x44, 0xa8, 0x40, 0xf1, 0x47, 0x68,
-	0xce, 0x63, 0xa6, 0xd5, 0x75, 0x9d, 0xc1, 0x09, 0x44, 0x69, 0xa1, 0xba, 0x32, 0x09, 0x8d, 0x02,
-	0x07, 0xdb, 0xce, 0x80, 0xaf, 0x74, 0x09, 0xd4, 0x22, 0x80, 0x50, 0x25, 0xc3, 0x5d, 0x84, 0xed,
-	0xbe, 0xe3, 0x7a, 0xac, 0x3b, 0x62, 0xde, 0xd0, 0x86, 0xbd, 0x15, 0x91, 0x59, 0xa8, 0xfe, 0x60,
-	0x12, 0x1a, 0xcb, 0x42, 0xba, 0x13, 0x0b, 0xa7, 0xa1, 0x71, 0x57, 0xac, 0xfa, 0xbc, 0x84, 0xd0,
-	0x8b, 0x6c, 0xfc, 0x19, 0x5a, 0x94, 0x13, 0x58, 0x6c, 0xc0, 0x02, 0x06, 0xf1, 0x59, 0xa8, 0xfe,
-	0xfe, 0x24, 0x34, 0x16, 0x84, 0xa0, 0x0e, 0xf8, 0x34, 0x34, 0xb0, 0x66, 0x56, 0x80, 0x84, 0x26,
-	0x38, 0xd8, 0x42, 0xb7, 0x2c, 0xdb, 0x37, 0xf7, 0x06, 0xac, 0x1b, 0xb0, 0xe1, 0xa8, 0x6b, 0x3b,
-	0x16, 0x3b, 0x66, 0x7e, 0x29, 0x0f, 0x36, 0xd7, 0x27, 0xa1, 0x81, 0xa5, 0x7c, 0x97, 0x0d, 0x47,
-	0x4d, 0x21, 0x9d, 0x86, 0x46, 0x49, 0x24, 0x8c, 0x0b, 0x22, 0x42, 0x2f, 0xe1, 0xe3, 0x75, 0x94,
-	0x1f, 0x99, 0x63, 0x9f, 0x59, 0xa5, 0x59, 0xb0, 0x5b, 0x9e, 0x84, 0x86, 0x44, 0x54, 0xc0, 0x88,
-	0x21, 0xa1, 0x12, 0xe7, 0xc1, 0x27, 0x52, 0x90, 0x5f, 0x2a, 0x9e, 0x0f, 0xbe, 0x3a, 0x08, 0xe2,
-	0xe0, 0x93, 0x44, 0x65, 0x4b, 0x8c, 0x09, 0x8d, 0x04, 0xe4, 0x9f, 0xf3, 0x28, 0x2f, 0x94, 0x70,
-	0x55, 0x05, 0xcf, 0x42, 0x75, 0x9d, 0x1b, 0xf8, 0xf7, 0xd0, 0x28, 0x08, 0x59, 0xb3, 0x7e, 0x55,
-	0x30, 0xfd, 0xe5, 0xcb, 0x07, 0x29, 0x2d, 0xa0, 0xd6, 0x50, 0x56, 0xcb, 0x84, 0x70, 0x78, 0x1d,
-	0x91, 0x03, 0xc5, 0xe1, 0x75, 0x20, 0xfb, 0x01, 0x86, 0x3f, 0x46, 0x73, 0xa6, 0x65, 0xf1, 0x43,
-	0xc6, 0xfc, 0x52, 0xa6, 0x92, 0xe1, 0x31, 0xcb, 0xe3, 0x5e, 0x81, 0xd3, 0xd0, 0x58, 0x04, 0x2d,
-	0x89, 0x10, 0x1a, 0xcb, 0xf0, 0x9f, 0x26, 0x8f, 0x7e, 0xf6, 0x7c, 0x12, 0xf9, 0x76, 0x67, 0x9e,
-	0x47, 0x7a, 0x8f, 0x79, 0x32, 0xaf, 0xe7, 0xc4, 0x81, 0xe2, 0x91, 0xce, 0x41, 0x99, 0xd5, 0x45,
-	0xa4, 0x47, 0x00, 0xa1, 0x4a, 0x86, 0xb7, 0xd0, 0xc2, 0xd0, 0x3c, 0xee, 0xfa, 0xec, 0xcf, 0xc6,
-	0xcc, 0xe9, 0x31, 0x88, 0x99, 0x8c, 0x58, 0xc5, 0xd0, 0x3c, 0xee, 0x48, 0x58, 0xad, 0x42, 0xc3,
-	0x08, 0xd5, 0x19, 0xb8, 0x8a, 0x90, 0xed, 0x04, 0x9e, 0x6b, 0x8d, 0x7b, 0xcc, 0x93, 0x21, 0x02,
-	0xe5, 0x25, 0x46, 0x55, 0x79, 0x89, 0x21, 0x42, 0x35, 0x39, 0xee, 0xa3, 0x02, 0xc4, 0x6e, 0xd7,
-	0xb6, 0x4a, 0x85, 0x4a, 0x6a, 0x35, 0x5b, 0xdd, 0x96, 0xce, 0x9d, 0x85, 0x28, 0x04, 0xdf, 0x46,
-	0x3f, 0x79, 0xcc, 0x00, 0xbb, 0x69, 0xa9, 0xdd, 0x97, 0x63, 0x9e, 0x37, 0x22, 0xda, 0xdf, 0xc6,
-	0x3f, 0x69, 0xc4, 0xc7, 0x7f, 0x8e, 0xca, 0xfe, 0x73, 0x9b, 0x9f, 0x14, 0x31, 0x37, 0x2f, 0x18,
-	0x5d, 0x8f, 0x0d, 0xdd, 0x43, 0x73, 0xe0, 0x97, 0xe6, 0x60, 0xf1, 0x9f, 0x4c, 0x42, 0xa3, 0xc4,
-	0x59, 0x4d, 0x8d, 0x44, 0x25, 0x67, 0x1a, 0x1a, 0x2b, 0x22, 0xcf, 0x5d, 0x41, 0x20, 0xf4, 0x4a,
-	0x5d, 0x7c, 0x8c, 0xde, 0x62, 0x4e, 0xcf, 0x3b, 0x19, 0xc1, 0xb4, 0x23, 0xd3, 0xf7, 0x8f, 0x5c,
-	0xcf, 0xea, 0x06, 0xee, 0x73, 0xe6, 0x94, 0x10, 0x04, 0xf5, 0xc7, 0x93, 0xd0, 0xb8, 0x1b, 0x93,
-	0x76, 0x24, 0x67, 0x97, 0x53, 0xa6, 0xa1, 0xf1, 0x36, 0xcc, 0x7d, 0x85, 0x9c, 0xd0, 0xab, 0x34,
-	0xc9, 0xbf, 0xa4, 0x50, 0x0e, 0x36, 0x83, 0x9f, 0x66, 0x91, 0xd4, 0x65, 0x0a, 0x86, 0xd3, 0x2c,
-	0x90, 0x0b, 0xe9, 0x5f, 0xe2, 0xb8, 0x81, 0x72, 0xfb, 0xf6, 0x80, 0xf9, 0xa5, 0x34, 0x9c, 0x65,
-	0xac, 0x15, 0x12, 0x7b, 0xc0, 0x9a, 0xce, 0xbe, 0x5b, 0xbd, 0x27, 0x4f, 0xb3, 0x20, 0xaa, 0xb3,
-	0xc4, 0x47, 0x84, 0x0a, 0x90, 0xe7, 0xbe, 0x81, 0xe9, 0x07, 0x71, 0xcc, 0x65, 0x20, 0xe6, 0x20,
-	0xf7, 0x71, 0x81, 0x16, 0x74, 0x58, 0x26, 0xf6, 0x18, 0x24, 0x34, 0xc1, 0x21, 0xbf, 0x49, 0xa3,
-	0x79, 0xf8, 0xa2, 0x27, 0x23, 0xcb, 0x0c, 0xd8, 0xff, 0x97, 0xef, 0xe2, 0xc6, 0x46, 0x1e, 0x3b,
-	0x8c, 0x8d, 0x65, 0x63, 0x63, 0x5c, 0x70, 0xc1, 0x98, 0x0e, 0x12, 0x9a, 0xe0, 0x90, 0x5f, 0x2c,
-	0xa2, 0x42, 0xf4, 0x29, 0x2a, 0xef, 0xa5, 0xae, 0x91, 0xf7, 0xd6, 0x50, 0xd6, 0xb7, 0x7f, 0x16,
-	0x7d, 0x09, 0x70, 0xf9, 0x58, 0x71, 0xf9, 0x80, 0x50, 0xc0, 0xf0, 0xa7, 0x08, 0x0d, 0x5d, 0xcb,
-	0xde, 0xb7, 0x99, 0xd5, 0xf5, 0xf5, 0x7e, 0x2b, 0x42, 0x3b, 0xaa, 0x39, 0x50, 0x08, 0xa1, 0xb1,
-	0x94, 0xa7, 0x49, 0x65, 0x60, 0xef, 0xa4, 0xb4, 0x00, 0x09, 0xe0, 0xe3, 0x28, 0x01, 0x74, 0x0e,
-	0x5c, 0x2f, 0x80, 0x53, 0xaf, 0xa6, 0xa9, 0x9e, 0xa8, 0x8c, 0x12, 0x43, 0x84, 0x1f, 0x78, 0x49,
-	0xa6, 0x1a, 0x15, 0x6f, 0xa3, 0xd9, 0xa8, 0x69, 0xe5, 0x07, 0x3c, 0x51, 0x8b, 0x9e, 0xb2, 0x5e,
-	0xe0, 0x7a, 0xd5, 0x4a, 0x54, 0x8b, 0x0e, 0x55, 0x13, 0x2b, 0xf2, 0xca, 0x61, 0xd4, 0xbe, 0x46,
-	0x12, 0xfc, 0x21, 0x2a, 0x28, 0xd7, 0x20, 0xf8, 0x56, 0xc8, 0xb9, 0x7e, 0xec, 0x96, 0x25, 0xd9,
-	0x07, 0x45, 0x2e, 0x51, 0x32, 0xfc, 0x13, 0x94, 0xdf, 0x1b, 0xb8, 0xbd, 0xe7, 0x51, 0x51, 0xbc,
-	0x19, 0x2f, 0xa4, 0xca, 0x71, 0x88, 0xb8, 0xb7, 0xe5, 0x5a, 0x24, 0x55, 0x75, 0x39, 0x30, 0x24,
-	0x54, 0xc2, 0xbc, 0x23, 0xf7, 0x4f, 0x86, 0x03, 0xdb, 0x79, 0xde, 0x0d, 0x4c, 0xaf, 0xcf, 0x82,
-	0xd2, 0x72, 0xdc, 0x91, 0x4b, 0xc9, 0x2e, 0x08, 0x54, 0x47, 0x9e, 0x40, 0x09, 0x4d, 0xb2, 0xf8,
-	0x3d, 0x41, 0x98, 0xee, 0x1e, 0x98, 0xfe, 0x41, 0x09, 0x43, 0x3a, 0x82, 0x44, 0x2e, 0xe0, 0x47,
-	0xa6, 0x7f, 0xa0, 0xb6, 0x3d, 0x86, 0x08, 0xd5, 0xe4, 0xbc, 0x4f, 0x94, 0x29, 0x88, 0x59, 0xa5,
-	0x9b, 0x60, 0x02, 0x42, 0x41, 0x81, 0x2a, 0x14, 0x14, 0x42, 0x68, 0x2c, 0xc5, 0x55, 0xd9, 0x6f,
-	0x8b, 0x2e, 0xf9, 0xce, 0xc5, 0x03, 0x79, 0x8d, 0x86, 0x7b, 0x13, 0xcd, 0x9f, 0x6f, 0xde, 0x16,
-	0x45, 0x61, 0x1b, 0x25, 0xda, 0x36, 0x51, 0xd8, 0x46, 0x7a, 0xc3, 0xa6, 0x33, 0xf0, 0x4f, 0xb4,
-	0xb0, 0x74, 0xfc, 0xd2, 0x3c, 0x5c, 0x4f, 0xde, 0xd1, 0xe3, 0xb0, 0xe5, 0x5f, 0x88, 0xc3, 0x56,
-	0x7c, 0x2d, 0xd1, 0x68, 0x78, 0x1f, 0x89, 0x5d, 0xea, 0xc2, 0xa9, 0x5a, 0x04, 0x53, 0x5b, 0xaf,
-	0x42, 0x63, 0x81, 0x9a, 0x47, 0xe0, 0xfa, 0x8e, 0xfd, 0x33, 0xc6, 0x37, 0x6a, 0x2f, 0x1a, 0xa8,
-	0x8d, 0x52, 0x48, 0x64, 0xf8, 0x97, 0x2f, 0x1f, 0x24, 0xd4, 0x68, 0xac, 0x84, 0x9f, 0xa2, 0xc2,
-	0x68, 0x60, 0x06, 0xfb, 0xae, 0x37, 0x2c, 0x2d, 0x41, 0xb0, 0x6b, 0x7b, 0xb8, 0x23, 0x25, 0x75,
-	0x33, 0x30, 0xab, 0x44, 0x86, 0x99, 0xe2, 0xab, 0xc8, 0x8d, 0x00, 0x42, 0x95, 0x0c, 0xd7, 0xd1,
-	0xfc, 0xc0, 0xed, 0x99, 0x83, 0xee, 0xfe, 0xc0, 0xec, 0xfb, 0xa5, 0xff, 0x98, 0x85, 0x4d, 0x85,
-	0xe8, 0x00, 0x7c, 0x93, 0xc3, 0x6a, 0x33, 0x62, 0x88, 0x50, 0x4d, 0x8e, 0x1f, 0xa1, 0x05, 0x79,
-	0x8c, 0x44, 0x8c, 0xfd, 0xe7, 0x2c, 0x44, 0x08, 0xf8, 0x46, 0x0a, 0x64, 0x94, 0x2d, 0xeb, 0xa7,
-	0x4f, 0x84, 0x99, 0xce, 0xc0, 0x9f, 0xa3, 0x1b, 0xb6, 0xe3, 0x5a, 0xac, 0xdb, 0x3b, 0x30, 0x9d,
-	0x3e, 0xe3, 0xfe, 0x99, 0xcc, 0xc2, 0x69, 0x84, 0xf8, 0x07, 0x59, 0x0d, 0x44, 0xe0, 0xa3, 0x9b,
-	0xb2, 0x49, 0xd0, 0x50, 0x42, 0x93, 0x2c, 0x7c, 0x8c, 0xb4, 0xea, 0xd9, 0x0d, 0x3c, 0xd3, 0x1e,
-	0x30, 0x4f, 0xf8, 0xeb, 0xbf, 0x66, 0xc1, 0x61, 0x9f, 0x4e, 0x42, 0xe3, 0x76, 0xcc, 0xd9, 0x15,
-	0x14, 0xe9, 0xac, 0x7b, 0xe7, 0x2a, 0xb3, 0x26, 0x55, 0x11, 0x71, 0xb9, 0x32, 0xfe, 0x31, 0x6f,
-	0x96, 0x79, 0x43, 0x6f, 0xc9, 0xce, 0xfd, 0xbe, 0x68, 0x8b, 0x01, 0x52, 0xa9, 0x48, 0x8e, 0xa1,
-	0x2f, 0x86, 0x5f, 0x98, 0xa2, 0x59, 0xdb, 0x39, 0x34, 0x07, 0x76, 0xd4, 0x99, 0x7f, 0xf0, 0x2a,
-	0x34, 0x10, 0x35, 0x8f, 0x9a, 0x02, 0x15, 0x8d, 0x12, 0xfc, 0xd4, 0x1a, 0x25, 0x18, 0xf3, 0x46,
-	0x49, 0x63, 0xd2, 0x88, 0xc7, 0xd3, 0x8a, 0xe3, 0x26, 0x2e, 0x3f, 0x05, 0x30, 0x0d, 0xdb, 0xea,
-	0xb8, 0xc9, 0x8b, 0x8f, 0xd8, 0xd6, 0x04, 0x4a, 0x68, 0x92, 0xf5, 0x61, 0xf6, 0x6f, 0x7e, 0x6d,
-	0xcc, 0x90, 0xaf, 0x52, 0x68, 0x4e, 0xa5, 0x38, 0x5e, 0x5d, 0xc0, 0xff, 0x19, 0x70, 0x3f, 0x9c,
-	0xe6, 0x03, 0xe1, 0x77, 0x71, 0x9a, 0x0f, 0xc0, 0xe1, 0x80, 0xf1, 0xba, 0xee, 0xee, 0xef, 0xfb,
-	0x2c, 0x80, 0xba, 0x95, 0x11, 0x75, 0x5d, 0x20, 0xaa, 0xae, 0x8b, 0x21, 0xa1, 0x12, 0xc7, 0xef,
-	0xc9, 0xea, 0x95, 0x06, 0xb7, 0xbd, 0x7d, 0x79, 0xf5, 0x8a, 0x9c, 0x22, 0x8a, 0xd8, 0x47, 0x68,
-	0xee, 0x88, 0x99, 0xcf, 0x45, 0x5c, 0x8a, 0x94, 0x01, 0x79, 0x9d, 0x83, 0x32, 0x26, 0xc5, 0xe9,
-	0x88, 0x00, 0x42, 0x95, 0x4c, 0x7e, 0xe3, 0x33, 0x94, 0x17, 0xe5, 0x04, 0xef, 0xa0, 0x42, 0xcf,
-	0x1d, 0x3b, 0x41, 0x7c, 0xf7, 0x5e, 0xd6, 0x9b, 0x7e, 0x90, 0x54, 0x7f, 0x2f, 0x3a, 0x80, 0x11,
-	0x55, 0xf9, 0x48, 0x02, 0xbc, 0x5b, 0x97, 0x22, 0xf2, 0xf3, 0x14, 0x9a, 0x95, 0x8a, 0xf8, 0x91,
-	0xba, 0x03, 0x65, 0xab, 0x1f, 0x9c, 0xab, 0x92, 0x5f, 0x7f, 0x9f, 0xd6, 0x2b, 0xa4, 0xbc, 0x5a,
-	0x1f, 0x9a, 0x83, 0xb1, 0xd8, 0xa8, 0xac, 0xb8, 0x5a, 0x03, 0xa0, 0x8a, 0x0e, 0x8c, 0x08, 0x15,
-	0x28, 0xf9, 0x79, 0x16, 0x2d, 0xe8, 0x49, 0x84, 0xa7, 0xeb, 0xb1, 0x63, 0x1f, 0xc3, 0x62, 0x12,
-	0xfd, 0xd3, 0x13, 0xc7, 0x3e, 0x86, 0x34, 0x53, 0x7e, 0x11, 0x1a, 0x29, 0xee, 0x00, 0xce, 0x53,
-	0x0e, 0xe0, 0x03, 0x42, 0x01, 0xc3, 0x9f, 0xa3, 0xd9, 0x23, 0xdb, 0xb1, 0xdc, 0x23, 0x1f, 0x96,
-	0x31, 0xaf, 0x5f, 0x90, 0xbe, 0x10, 0x02, 0xb0, 0x54, 0x91, 0x96, 0x22, 0xb6, 0xda, 0x2e, 0x39,
-	0x26, 0x34, 0x92, 0xe0, 0x2d, 0x94, 0x1b, 0xd8, 0xce, 0xf8, 0x18, 0x02, 0x2c, 0x51, 0x66, 0x7f,
-	0x6a, 0x06, 0x81, 0x07, 0xe6, 0xee, 0x4b, 0x73, 0x82, 0x19, 0xbf, 0x25, 0xf0, 0x11, 0xa1, 0x02,
-	0xc5, 0x9f, 0xa1, 0xbc, 0x65, 0x7a, 0x47, 0xb6, 0xb8, 0xbb, 0x5d, 0x61, 0x69, 0x45, 0x5a, 0x92,
-	0xd4, 0xf8, 0x1e, 0x0b, 0x43, 0x42, 0x25, 0x8e, 0x19, 0x9a, 0xdd, 0xf7, 0x18, 0xdb, 0xf3, 0x2d,
-	0x68, 0x92, 0xae, 0xb0, 0xf6, 0x63, 0x6e, 0x8d, 0xdf, 0x76, 0x36, 0x3d, 0xc6, 0xaa, 0x1d, 0xb8,
-	0xed, 0x48, 0x35, 0xf5, 0xc5, 0x72, 0x0c, 0xb7, 0x1d, 0x49, 0xa3, 0x11, 0x09, 0x77, 0x51, 0xde,
-	0x61, 0x01, 0x9f, 0x25, 0x7f, 0xf5, 0x2c, 0xeb, 0x72, 0x96, 0x7c, 0x8b, 0x05, 0x62, 0x12, 0xa9,
-	0xa4, 0x56, 0x2f, 0x86, 0x7c, 0x0a, 0xc9, 0xa1, 0x92, 0x41, 0x7e, 0x91, 0x46, 0x85, 0xc8, 0xbf,
-	0xbc, 0xf9, 0x73, 0x8f, 0x1c, 0xe6, 0xe9, 0x2f, 0x94, 0x50, 0xf1, 0x01, 0x95, 0xb7, 0x50, 0x51,
-	0xc8, 0x14, 0x42, 0x68, 0x2c, 0xe5, 0x06, 0xfa, 0x9e, 0x3b, 0x1e, 0xe9, 0xaf, 0x93, 0x60, 0x00,
-	0xd0, 0x84, 0x01, 0x85, 0x10, 0x1a, 0x4b, 0xf1, 0x47, 0x28, 0x33, 0xb6, 0x2d, 0x70, 0x75, 0xae,
-	0xfa, 0xce, 0xab, 0xd0, 0xc8, 0x3c, 0x81, 0x13, 0xc0, 0xd1, 0x69, 0x68, 0xcc, 0x89, 0x80, 0xb3,
-	0x2d, 0xad, 0x7c, 0x72, 0x06, 0xe5, 0x72, 0xae, 0xdc, 0xb7, 0x2d, 0xf9, 0xf4, 0x08, 0xca, 0x5b,
-	0x42, 0xb9, 0xaf, 0x29, 0xf7, 0x93, 0xca, 0x5b, 0x5c, 0x99, 0x63, 0xbf, 0x4a, 0xa1, 0x79, 0x2d,
-	0x42, 0xbf, 0xfd, 0x5e, 0x6c, 0xa3, 0x25, 0x61, 0xc0, 0xf6, 0xbb, 0xf0, 0x81, 0xf2, 0xa9, 0x0d,
-	0x9a, 0x7f, 0x90, 0x34, 0xfd, 0x2d, 0x8e, 0xab, 0xe6, 0x5f, 0x07, 0x09, 0x4d, 0x70, 0x48, 0x07,
-	0xcd, 0x29, 0x87, 0xe3, 0x4d, 0x94, 0x3f, 0xe6, 0x83, 0x28, 0x21, 0xdd, 0x38, 0x17, 0x15, 0x71,
-	0xdb, 0x29, 0x68, 0xea, 0x40, 0xc0, 0x90, 0x50, 0x09, 0x93, 0x1e, 0xca, 0x01, 0xff, 0x8d, 0x6e,
-	0x13, 0x89, 0x3c, 0xb3, 0xf0, 0x7f, 0xe7, 0x99, 0xbf, 0xc8, 0xa2, 0x59, 0xca, 0x9b, 0x66, 0x3f,
-	0xc0, 0x3f, 0x52, 0xd9, 0x2e, 0x57, 0xfd, 0xee, 0x55, 0xe9, 0x2d, 0xf6, 0x4e, 0xf4, 0xc8, 0x13,
-	0x5f, 0x07, 0xd3, 0xd7, 0xbe, 0x0e, 0x46, 0x9f, 0x94, 0xb9, 0xc6, 0x27, 0xc5, 0x65, 0x29, 0xfb,
-	0xc6, 0x65, 0x29, 0x77, 0xfd, 0xb2, 0x14, 0x55, 0xca, 0xfc, 0x35, 0x2a, 0x65, 0x1b, 0x2d, 0xed,
-	0x7b, 0xee, 0x10, 0x9e, 0x02, 0x5d, 0xcf, 0xf4, 0x4e, 0x64, 0x57, 0x00, 0xa5, 0x9b, 0x4b, 0x76,
-	0x23, 0x81, 0x2a, 0xdd, 0x09, 0x94, 0xd0, 0x24, 0x2b, 0x59, 0x13, 0x0b, 0x6f, 0x56, 0x13, 0xf1,
-	0x27, 0xa8, 0x20, 0x3a, 0x5e, 0xc7, 0x85, 0x6b, 0x57, 0xae, 0xfa, 0x1d, 0x9e, 0xca, 0x00, 0x6b,
-	0xb9, 0x2a, 0x95, 0xc9, 0xb1, 0xfa, 0xec, 0x88, 0x40, 0xfe, 0x21, 0x85, 0x0a, 0x94, 0xf9, 0x23,
-	0xd7, 0xf1, 0xd9, 0x37, 0x0d, 0x82, 0x35, 0x94, 0xb5, 0xcc, 0xc0, 0x94, 0x61, 0x07, 0xbb, 0xc7,
-	0xc7, 0x6a, 0xf7, 0xf8, 0x80, 0x50, 0xc0, 0xf0, 0xa7, 0x28, 0xdb, 0x73, 0x2d, 0xe1, 0xfc, 0x25,
-	0x3d, 0x69, 0x36, 0x3c, 0xcf, 0xf5, 0x6a, 0xae, 0x25, 0xaf, 0x1d, 0x9c, 0xa4, 0x0c, 0xf0, 0x01,
-	0xa1, 0x80, 0x91, 0xbf, 0x4f, 0xa1, 0x62, 0xdd, 0x3d, 0x72, 0x06, 0xae, 0x69, 0xed, 0x78, 0x6e,
-	0xdf, 0x63, 0xbe, 0xff, 0x8d, 0x5e, 0x25, 0xba, 0x68, 0x76, 0x0c, 0x6f, 0x1a, 0xd1, 0xbb, 0xc4,
-	0x83, 0xe4, 0x35, 0xe8, 0xfc, 0x24, 0xe2, 0x01, 0x24, 0x7e, 0x4f, 0x95, 0xca, 0xca, 0xbe, 0x18,
-	0x13, 0x1a, 0x09, 0xc8, 0x6f, 0x32, 0xa8, 0x7c, 0xb5, 0x21, 0x3c, 0x44, 0xf3, 0x82, 0xd9, 0xd5,
-	0xfe, 0xf4, 0xb1, 0x7a, 0x9d, 0x35, 0xc0, 0xe5, 0x0c, 0x2e, 0x05, 0x63, 0x35, 0x56, 0x97, 0x82,
-	0x18, 0x22, 0x54, 0x93, 0xbf, 0xd1, 0x73, 0xac, 0x76, 0x95, 0xcf, 0x7c, 0xfb, 0xab, 0x7c, 0x07,
-	0x2d, 0x8a, 0x10, 0x8d, 0xde, 0xcd, 0xb3, 0x95, 0xcc, 0x6a, 0xae, 0xfa, 0x90, 0x67, 0xdb, 0x3d,
-	0xd1, 0xac, 0x46, 0x2f, 0xe6, 0xcb, 0x71, 0xb0, 0x0a, 0x30, 0x8a, 0xb6, 0xe2, 0x0c, 0x4d, 0x70,
-	0xf1, 0x66, 0xe2, 0xa6, 0x27, 0x8e, 0xfa, 0x1f, 0x5c, 0xf3, 0x66, 0xa7, 0xdd, 0xe4, 0x48, 0x1e,
-	0x65, 0x77, 0x6c, 0xa7, 0x4f, 0x3e, 0x42, 0xb9, 0xda, 0xc0, 0xf5, 0x21, 0xe3, 0x78, 0xcc, 0xf4,
-	0x5d, 0x47, 0x0f, 0x25, 0x81, 0x28, 0x57, 0x8b, 0x21, 0xa1, 0x12, 0x5f, 0xfb, 0x6d, 0x06, 0xcd,
-	0x6b, 0x7f, 0xa9, 0xc2, 0x7f, 0x82, 0xee, 0x3d, 0x6e, 0x74, 0x3a, 0x1b, 0x5b, 0x8d, 0xee, 0xee,
-	0x97, 0x3b, 0x8d, 0x6e, 0x6d, 0xfb, 0x49, 0x67, 0xb7, 0x41, 0xbb, 0xb5, 0x76, 0x6b, 0xb3, 0xb9,
-	0x55, 0x9c, 0x29, 0xdf, 0x3f, 0x3d, 0xab, 0x94, 0x34, 0x8d, 0xe4, 0x9f, 0x94, 0xfe, 0x10, 0xe1,
-	0x84, 0x7a, 0xb3, 0x55, 0x6f, 0xfc, 0xb4, 0x98, 0x2a, 0xdf, 0x3a, 0x3d, 0xab, 0x14, 0x35, 0x2d,
-	0xf1, 0xd2, 0xf8, 0xc7, 0xe8, 0xad, 0x8b, 0xec, 0xee, 0x93, 0x9d, 0xfa, 0xc6, 0x6e, 0xa3, 0x98,
-	0x2e, 0x97, 0x4f, 0xcf, 0x2a, 0x77, 0xce, 0x2b, 0xc9, 0x10, 0xfc, 0x01, 0xba, 0x95, 0x50, 0xa5,
-	0x8d, 0xcf, 0x9f, 0x34, 0x3a, 0xbb, 0xc5, 0x4c, 0xf9, 0xce, 0xe9, 0x59, 0x05, 0x6b, 0x5a, 0x51,
-	0x99, 0x58, 0x47, 0xb7, 0xcf, 0x69, 0x74, 0x76, 0xda, 0xad, 0x4e, 0xa3, 0x98, 0x2d, 0xdf, 0x3d,
-	0x3d, 0xab, 0xdc, 0x4c, 0xa8, 0xc8, 0xac, 0x52, 0x43, 0x2b, 0x09, 0x9d, 0x7a, 0xfb, 0x8b, 0xd6,
-	0x76, 0x7b, 0xa3, 0xde, 0xdd, 0xa1, 0xed, 0x2d, 0xda, 0xe8, 0x74, 0x8a, 0xb9, 0xb2, 0x71, 0x7a,
-	0x56, 0xb9, 0xa7, 0x29, 0x5f, 0x38, 0xe1, 0x6b, 0x68, 0x39, 0x61, 0x64, 0xa7, 0xd9, 0xda, 0x2a,
-	0xe6, 0xcb, 0x37, 0x4f, 0xcf, 0x2a, 0x37, 0x34, 0x3d, 0xee, 0xcb, 0x0b, 0xfb, 0x57, 0xdb, 0x6e,
-	0x77, 0x1a, 0xc5, 0xd9, 0x0b, 0xfb, 0x07, 0x0e, 0x5f, 0xfb, 0xbb, 0x14, 0xc2, 0x17, 0xff, 0x38,
-	0x88, 0x3f, 0x40, 0xa5, 0xc8, 0x48, 0xad, 0xfd, 0x78, 0x87, 0xaf, 0xb3, 0xd9, 0x6e, 0x75, 0x5b,
-	0xed, 0x56, 0xa3, 0x38, 0x93, 0xd8, 0x55, 0x4d, 0xab, 0xe5, 0x3a, 0x0c, 0xb7, 0xd1, 0xdd, 0xcb,
-	0x34, 0xb7, 0x9f, 0xbd, 0x5f, 0x4c, 0x95, 0xd7, 0x4f, 0xcf, 0x2a, 0xb7, 0x2f, 0x2a, 0x6e, 0x3f,
-	0x7b, 0xff, 0x77, 0x7f, 0xf5, 0xdd, 0xcb, 0x05, 0x6b, 0xbc, 0x01, 0xd2, 0x97, 0xf6, 0x1e, 0xba,
-	0xa5, 0x1b, 0x7e, 0xdc, 0xd8, 0xdd, 0xa8, 0x6f, 0xec, 0x6e, 0x14, 0x67, 0x84, 0x0f, 0x34, 0xea,
-	0x63, 0x16, 0x98, 0x90, 0x76, 0xbf, 0x87, 0x96, 0x13, 0x5f, 0xd1, 0x78, 0xda, 0xa0, 0x51, 0x44,
-	0xe9, 0xeb, 0x67, 0x87, 0xcc, 0xc3, 0xdf, 0x47, 0x58, 0x27, 0x6f, 0x6c, 0x7f, 0xb1, 0xf1, 0x65,
-	0xa7, 0x98, 0x2e, 0xdf, 0x3e, 0x3d, 0xab, 0x2c, 0x6b, 0xec, 0x8d, 0xc1, 0x91, 0x79, 0xe2, 0xaf,
-	0xfd, 0x53, 0x1a, 0x2d, 0xe8, 0xef, 0x46, 0xf8, 0xfb, 0xe8, 0xe6, 0x66, 0x73, 0x9b, 0x47, 0xe2,
-	0x66, 0x5b, 0x78, 0x80, 0x0f, 0x8b, 0x33, 0x62, 0x3a, 0x9d, 0xca, 0x7f, 0xe3, 0x3f, 0x42, 0xa5,
-	0x73, 0xf4, 0x7a, 0x93, 0x36, 0x6a, 0xbb, 0x6d, 0xfa, 0x65, 0x31, 0x55, 0x7e, 0x8b, 0x6f, 0x98,
-	0xae, 0x53, 0xb7, 0x3d, 0x48, 0x41, 0x27, 0xf8, 0x13, 0x74, 0xef, 0x9c, 0x62, 0xe7, 0xcb, 0xc7,
-	0xdb, 0xcd, 0xd6, 0x67, 0x62, 0xbe, 0x74, 0xf9, 0xed, 0xd3, 0xb3, 0xca, 0x5d, 0x5d, 0xb7, 0x23,
-	0x9e, 0xe2, 0x38, 0x54, 0x48, 0xe1, 0x47, 0xa8, 0x72, 0x85, 0x7e, 0xbc, 0x80, 0x4c, 0x99, 0x9c,
-	0x9e, 0x55, 0xee, 0x5f, 0x62, 0x44, 0xad, 0xa3, 0x90, 0xc2, 0x3f, 0x44, 0x77, 0x2e, 0xb7, 0x14,
-	0x9d, 0x8b, 0x4b, 0xf4, 0xd7, 0xfe, 0x35, 0x85, 0xe6, 0x54, 0xd5, 0xe3, 0x9b, 0xd6, 0xa0, 0xb4,
-	0xcd, 0x93, 0x44, 0xbd, 0xd1, 0x6d, 0xb5, 0xbb, 0x30, 0x8a, 0x36, 0x4d, 0xf1, 0x5a, 0x2e, 0xfc,
-	0xe4, 0x31, 0xae, 0xd1, 0xb7, 0x1a, 0xad, 0x06, 0x6d, 0xd6, 0x22, 0x8f, 0x2a, 0xf6, 0x16, 0x73,
-	0x98, 0x67, 0xf7, 0xf0, 0xfb, 0xe8, 0x6e, 0xd2, 0x78, 0xe7, 0x49, 0xed, 0x51, 0xb4, 0x4b, 0xb0,
-	0x40, 0x6d, 0x82, 0xce, 0xb8, 0x77, 0x00, 0x8e, 0xf9, 0x51, 0x42, 0xab, 0xd9, 0x7a, 0xba, 0xb1,
-	0xdd, 0xac, 0x0b, 0xad, 0x4c, 0xb9, 0x74, 0x7a, 0x56, 0xb9, 0xa5, 0xb4, 0xe4, 0x03, 0x07, 0x57,
-	0x5b, 0xfb, 0x5d, 0x0a, 0xad, 0x7c, 0x7d, 0xf1, 0xc2, 0x5f, 0xa0, 0x77, 0x60, 0xbf, 0x2e, 0xa4,
-	0x02, 0x99, 0xb7, 0xc4, 0x1e, 0x6e, 0xec, 0xec, 0x34, 0x5a, 0xf5, 0xe2, 0x4c, 0x79, 0xf5, 0xf4,
-	0xac, 0xf2, 0xe0, 0xeb, 0x4d, 0x6e, 0x8c, 0x46, 0xcc, 0xb1, 0xae, 0x69, 0x78, 0xb3, 0x4d, 0xb7,
-	0x1a, 0xbb, 0xc5, 0xd4, 0x75, 0x0c, 0x6f, 0xba, 0x5e, 0x9f, 0x05, 0xd5, 0xc7, 0x2f, 0xbe, 0x5a,
-	0x99, 0x79, 0xf9, 0xd5, 0xca, 0xcc, 0x8b, 0x57, 0x2b, 0xa9, 0x97, 0xaf, 0x56, 0x52, 0x7f, 0xfd,
-	0x7a, 0x65, 0xe6, 0xd7, 0xaf, 0x57, 0x52, 0x2f, 0x5f, 0xaf, 0xcc, 0xfc, 0xdb, 0xeb, 0x95, 0x99,
-	0x67, 0xdf, 0xeb, 0xdb, 0xc1, 0xc1, 0x78, 0xef, 0x61, 0xcf, 0x1d, 0xbe, 0xeb, 0x9f, 0x38, 0xbd,
-	0xe0, 0xc0, 0x76, 0xfa, 0xda, 0x2f, 0xfd, 0x1f, 0x58, 0xf6, 0xf2, 0xf0, 0xeb, 0x87, 0xff, 0x1b,
-	0x00, 0x00, 0xff, 0xff, 0xa6, 0x37, 0xc7, 0xbf, 0xd7, 0x22, 0x00, 0x00,
+	// 3916 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x5a, 0xcd, 0x6f, 0x23, 0x47,
+	0x76, 0x57, 0x8b, 0xa4, 0x48, 0x95, 0x34, 0x1a, 0xaa, 0xe6, 0x8b, 0xe6, 0xcc, 0xa8, 0xb9, 0xb5,
+	0x33, 0x1b, 0x59, 0x9b, 0x1d, 0xaf, 0xb5, 0xb6, 0xe3, 0xd8, 0x8e, 0x0d, 0x7e, 0x49, 0xa2, 0x47,
+	0x43, 0xca, 0x45, 0x6a, 0x6c, 0x0f, 0x10, 0x34, 0x5a, 0xec, 0x12, 0xd5, 0x18, 0xb2, 0x9b, 0xe9,
+	0x6e, 0xea, 0xc3, 0xc8, 0x25, 0x58, 0x20, 0x08, 0x84, 0xc4, 0x08, 0x16, 0x39, 0x04, 0x41, 0x04,
+	0x2c, 0x16, 0x01, 0x12, 0xe4, 0x90, 0x20, 0x87, 0xfc, 0x03, 0x39, 0xf9, 0x96, 0x81, 0x81, 0x04,
+	0x49, 0x0e, 0x1d, 0x78, 0xe6, 0x92, 0x30, 0x87, 0x00, 0x02, 0x72, 0xc9, 0x29, 0xa8, 0x8f, 0xae,
+	0xae, 0xa6, 0x24, 0x5b, 0xe3, 0xb9, 0xe5, 0x24, 0xd6, 0xef, 0xfd, 0xde, 0xeb, 0xfa, 0x78, 0xf5,
+	0x5e, 0xbd, 0x2a, 0x81, 0x9b, 0x7d, 0x7b, 0xe7, 0x8d, 0xa1, 0xe7, 0x06, 0x6e, 0xd7, 0xed, 0xbf,
+	0xb1, 0x43, 0x86, 0x0f, 0x58, 0x03, 0xe6, 0x22, 0xac, 0x38, 0x4b, 0x0e, 0x03, 0x0e, 0x16, 0x7f,
+	0xe8, 0x91, 0xa1, 0xeb, 0x73, 0xfa, 0xce, 0x68, 0xf7, 0x8d, 0x9e, 0xdb, 0x73, 0x59, 0x83, 0xfd,
+	0xe2, 0x24, 0xf4, 0xd7, 0x69, 0x90, 0xd9, 0x20, 0xfd, 0xbe, 0x0b, 0xab, 0x60, 0xce, 0x22, 0xfb,
+	0x76, 0x97, 0x18, 0x8e, 0x39, 0x20, 0x05, 0xad, 0xa4, 0x2d, 0xcf, 0x56, 0xd0, 0x38, 0xd4, 0x01,
+	0x87, 0x9b, 0xe6, 0x80, 0x9c, 0x86, 0x7a, 0xfe, 0x70, 0xd0, 0x7f, 0x0f, 0xc5, 0x10, 0xc2, 0x8a,
+	0x9c, 0x1a, 0xe9, 0xf6, 0x6d, 0xe2, 0x04, 0xdc, 0xc8, 0x74, 0x6c, 0x84, 0xc3, 0x09, 0x23, 0x31,
+	0x84, 0xb0, 0x22, 0x87, 0x2d, 0xb0, 0x20, 0x8c, 0xec, 0x13, 0xcf, 0xb7, 0x5d, 0xa7, 0x90, 0x62,
+	0x76, 0x96, 0xc7, 0xa1, 0x7e, 0x85, 0x4b, 0x1e, 0x73, 0xc1, 0x69, 0xa8, 0x5f, 0x53, 0x4c, 0x09,
+	0x14, 0xe1, 0x24, 0x0b, 0x3e, 0x01, 0x57, 0x9d, 0xd1, 0xc0, 0xe8, 0xba, 0x8e, 0x43, 0xba, 0x81,
+	0xed, 0x3a, 0x7e, 0x21, 0x5d, 0xd2, 0x96, 0x33, 0x95, 0x37, 0xc7, 0xa1, 0xbe, 0xe0, 0x8c, 0x06,
+	0xd5, 0x58, 0x72, 0x1a, 0xea, 0xd7, 0x99, 0xc9, 0x24, 0x8c, 0xfe, 0x37, 0xd4, 0x53, 0xb6, 0x13,
+	0xe0, 0x09, 0x3a, 0xfc, 0x10, 0xcc, 0x06, 0xf6, 0x80, 0xf8, 0x81, 0x39, 0x18, 0x16, 0x32, 0x25,
+	0x6d, 0x39, 0x55, 0x29, 0x8d, 0x43, 0x3d, 0x06, 0x4f, 0x43, 0xfd, 0x2a, 0x33, 0x28, 0x11, 0x84,
+	0x63, 0x29, 0xec, 0x81, 0xf9, 0xae, 0x3b, 0x18, 0x7a, 0xc4, 0xf7, 0x59, 0xc7, 0x66, 0x4a, 0xa9,
+	0xe5, 0x85, 0xd5, 0x3b, 0x0f, 0xa2, 0x15, 0x7d, 0xf0, 0x88, 0xf8, 0xbe, 0xd9, 0x23, 0xd5, 0x98,
+	0x54, 0xb9, 0x3f, 0x0e, 0xf5, 0x84, 0xd6, 0x69, 0xa8, 0x2f, 0xf2, 0x79, 0x88, 0x41, 0x84, 0x13,
+	0x14, 0x58, 0x06, 0x80, 0x1c, 0x06, 0xc4, 0xe1, 0x9f, 0xc9, 0x96, 0x52, 0xcb, 0xb3, 0x95, 0x1f,
+	0xd0, 0x95, 0x89, 0x51, 0xd9, 0x55, 0x09, 0x21, 0xac, 0x88, 0xd1, 0xdf, 0x69, 0x60, 0x66, 0x83,
+	0x98, 0x16, 0xf1, 0x60, 0x19, 0xa4, 0x83, 0xa3, 0x21, 0x77, 0x93, 0x85, 0xd5, 0x1b, 0x67, 0xba,
+	0xdb, 0x39, 0x1a, 0x92, 0xca, 0xcd, 0x71, 0xa8, 0x33, 0xda, 0x69, 0xa8, 0x03, 0x3e, 0x07, 0x47,
+	0x43, 0x82, 0x30, 0xc3, 0xa0, 0x05, 0xe6, 0x94, 0x0e, 0x32, 0x5f, 0xf9, 0xae, 0x81, 0xdf, 0x1b,
+	0x87, 0xba, 0xaa, 0x74, 0xfe, 0xb8, 0x55, 0x06, 0xfa, 0x77, 0x0d, 0x5c, 0xa9, 0xf6, 0x47, 0x7e,
+	0x40, 0xbc, 0xaa, 0xeb, 0xec, 0xda, 0x3d, 0xf8, 0x10, 0x64, 0x77, 0xdd, 0xbe, 0x45, 0x3c, 0xbf,
+	0xa0, 0x95, 0x52, 0xcb, 0x73, 0xab, 0xf9, 0xf8, 0x9b, 0x6b, 0x4c, 0x50, 0xd1, 0xbf, 0x0a, 0xf5,
+	0xa9, 0x71, 0xa8, 0x47, 0xc4, 0xd3, 0x50, 0x9f, 0x67, 0xdf, 0xe1, 0x6d, 0x84, 0x23, 0x01, 0x5d,
+	0x7e, 0x9f, 0x74, 0x5d, 0xc7, 0x32, 0xbd, 0x23, 0x36, 0x84, 0x1c, 0x5f, 0x7e, 0x09, 0xca, 0x39,
+	0x95, 0x08, 0xc2, 0xb1, 0x74, 0x62, 0x55, 0x52, 0xdf, 0x67, 0x55, 0xfe, 0x24, 0x03, 0x66, 0x78,
+	0xbf, 0xe1, 0x03, 0x30, 0x6d, 0x5b, 0x62, 0xeb, 0x2e, 0x3d, 0x0f, 0xf5, 0xe9, 0x46, 0x6d, 0x1c,
+	0xea, 0xd3, 0xb6, 0x75, 0x1a, 0xea, 0x39, 0x66, 0xc3, 0xb6, 0xd0, 0x2f, 0x9e, 0xdd, 0x9b, 0x6e,
+	0xd4, 0xf0, 0xb4, 0x6d, 0xc1, 0x07, 0x20, 0xd3, 0x37, 0x77, 0x48, 0x5f, 0x6c, 0xd4, 0xc2, 0x38,
+	0xd4, 0x39, 0x70, 0x1a, 0xea, 0x73, 0x8c, 0xcf, 0x5a, 0x08, 0x73, 0x14, 0xbe, 0x0f, 0x66, 0x3d,
+	0x62, 0x5a, 0x86, 0xeb, 0xf4, 0x8f, 0xd8, 0xa6, 0xcc, 0x55, 0x96, 0xc6, 0xa1, 0x9e, 0xa3, 0x60,
+	0xcb, 0xe9, 0xd3, 0xc1, 0x2e, 0x30, 0xb5, 0x08, 0x40, 0x58, 0xca, 0xa0, 0x01, 0xa0, 0xdd, 0x73,
+	0x5c, 0x8f, 0x18, 0x43, 0xe2, 0x0d, 0x6c, 0xdf, 0x97, 0x1b, 0x31, 0x57, 0xf9, 0xe9, 0x38, 0xd4,
+	0x17, 0xb9, 0x74, 0x2b, 0x16, 0x9e, 0x86, 0xfa, 0x2d, 0xde, 0xeb, 0x49, 0x09, 0xc2, 0x67, 0xd9,
+	0xf0, 0x21, 0xb8, 0x22, 0x3e, 0x60, 0x91, 0x3e, 0x09, 0x08, 0xdb, 0x8e, 0xb9, 0xca, 0x8f, 0xe8,
+	0x6e, 0xe1, 0x82, 0x1a, 0xc3, 0x4f, 0x43, 0x1d, 0x2a, 0x66, 0x39, 0x88, 0x70, 0x82, 0x03, 0x2d,
+	0x70, 0xdd, 0xb2, 0x7d, 0x73, 0xa7, 0x4f, 0x8c, 0x80, 0x0c, 0x86, 0x86, 0xed, 0x58, 0xe4, 0x90,
+	0xd0, 0xfd, 0x49, 0x6d, 0xae, 0x8e, 0x43, 0x1d, 0x0a, 0x79, 0x87, 0x0c, 0x86, 0x0d, 0x2e, 0x3d,
+	0x0d, 0xf5, 0x02, 0x8f, 0x8f, 0x67, 0x44, 0x08, 0x9f, 0xc3, 0x87, 0xab, 0x60, 0x66, 0x68, 0x8e,
+	0x7c, 0x62, 0x15, 0xb2, 0xcc, 0x6e, 0x71, 0x1c, 0xea, 0x02, 0x91, 0x3e, 0xc7, 0x9b, 0x08, 0x0b,
+	0x1c, 0x7e, 0x06, 0x72, 0x16, 0xd9, 0x35, 0x47, 0xfd, 0xc0, 0x2f, 0xe4, 0x4a, 0xda, 0xf2, 0xdc,
+	0x6a, 0x61, 0xd2, 0x81, 0x6b, 0x42, 0x5e, 0x41, 0xc2, 0x91, 0xa5, 0x86, 0x5c, 0xa1, 0x08, 0x40,
+	0x58, 0xca, 0xe8, 0xce, 0xe0, 0xb1, 0xdc, 0x2f, 0xe4, 0x27, 0x77, 0x46, 0x8d, 0x09, 0xe2, 0x9d,
+	0x21, 0x88, 0xb2, 0x97, 0xbc, 0x8d, 0x70, 0x24, 0x40, 0xff, 0x90, 0x06, 0x0b, 0xc9, 0xde, 0xc0,
+	0x36, 0xb8, 0x2a, 0x22, 0xba, 0xed, 0xf4, 0x0c, 0x19, 0x3f, 0x66, 0x2b, 0x2b, 0x34, 0x0e, 0xc7,
+	0xa2, 0x0e, 0x0f, 0x19, 0x3c, 0x0e, 0x27, 0x61, 0x84, 0x27, 0x78, 0xf0, 0x4b, 0x0d, 0x2c, 0x2a,
+	0x56, 0x87, 0xa6, 0x67, 0x0e, 0xfc, 0xc2, 0x34, 0xeb, 0xff, 0x83, 0x8b, 0x26, 0xe6, 0xc1, 0x63,
+	0xa9, 0xb1, 0xc5, 0x14, 0xea, 0x4e, 0xe0, 0x1d, 0x55, 0xde, 0x14, 0xa3, 0xcb, 0xef, 0x4f, 0x88,
+	0x4f, 0x43, 0xfd, 0xc6, 0x44, 0x6f, 0x98, 0x00, 0xe1, 0x33, 0x54, 0xf8, 0x87, 0x1a, 0xb8, 0xab,
+	0x74, 0xa8, 0xdb, 0x27, 0xa6, 0x33, 0xa2, 0x0e, 0x14, 0x10, 0x6f, 0xdf, 0xec, 0x1b, 0x3e, 0xdb,
+	0x39, 0x99, 0x4a, 0x63, 0x1c, 0xea, 0xc5, 0x98, 0x58, 0xe5, 0xbc, 0x86, 0xa0, 0xb5, 0x4f, 0x43,
+	0xbd, 0x34, 0xf1, 0xc9, 0x49, 0x8a, 0x4c, 0x4a, 0xdf, 0x62, 0x06, 0xae, 0x01, 0xe1, 0xd8, 0x46,
+	0xdf, 0x76, 0x08, 0xdd, 0x70, 0x34, 0xc6, 0xfc, 0x90, 0x46, 0x52, 0x8e, 0x6f, 0x52, 0x58, 0x26,
+	0xe5, 0x18, 0x43, 0x58, 0x25, 0x14, 0x7d, 0x70, 0xe3, 0xdc, 0x49, 0x83, 0x3f, 0x02, 0xa9, 0xa7,
+	0xe4, 0x48, 0xac, 0xe4, 0xf5, 0x71, 0xa8, 0xd3, 0xe6, 0x69, 0xa8, 0xcf, 0x32, 0x7b, 0x4f, 0xc9,
+	0x11, 0xc2, 0x14, 0xa1, 0xc1, 0x66, 0xdf, 0xec, 0x8f, 0x88, 0x1a, 0x6c, 0x18, 0x20, 0x83, 0x0d,
+	0x6b, 0x21, 0xcc, 0xd1, 0xf7, 0xa6, 0xdf, 0xd5, 0xd0, 0x1f, 0x65, 0xc1, 0x0c, 0xf7, 0x3c, 0x58,
+	0x91, 0xb1, 0x6d, 0xbe, 0xb2, 0x4a, 0xd7, 0xe9, 0xdf, 0x42, 0x3d, 0xc7, 0x65, 0x8d, 0xda, 0x45,
+	0xb1, 0xee, 0x0f, 0x9e, 0xdd, 0xd3, 0x94, 0x78, 0xb7, 0x02, 0xd2, 0xca, 0xb9, 0x84, 0xa5, 0x27,
+	0xc7, 0x1c, 0xc4, 0xe9, 0xc9, 0x61, 0x67, 0x11, 0x86, 0xc1, 0x0f, 0xc0, 0xac, 0x69, 0x59, 0x34,
+	0x8d, 0x90, 0x28, 0x30, 0xd3, 0x58, 0x17, 0x83, 0xa7, 0xa1, 0x7e, 0x85, 0x69, 0x09, 0x04, 0xe1,
+	0x58, 0x06, 0x7f, 0x3b, 0x99, 0xdc, 0xd2, 0x93, 0x69, 0xf2, 0xd5, 0xb2, 0x1a, 0x0d, 0xc4, 0x5d,
+	0xe2, 0x89, 0x53, 0x56, 0x86, 0xc7, 0x7b, 0xba, 0xcd, 0x29, 0x28, 0xce, 0x58, 0x7c, 0x9b, 0x47,
+	0x00, 0xc2, 0x52, 0x06, 0xd7, 0xc1, 0xfc, 0xc0, 0x3c, 0x34, 0x7c, 0xf2, 0x3b, 0x23, 0xe2, 0x74,
+	0x09, 0x0b, 0x69, 0x29, 0xde, 0x8b, 0x81, 0x79, 0xd8, 0x16, 0xb0, 0xec, 0x85, 0x82, 0x21, 0xac,
+	0x32, 0x60, 0x05, 0x00, 0xdb, 0x09, 0x3c, 0xd7, 0x1a, 0x75, 0x89, 0x27, 0x22, 0x18, 0x3b, 0xec,
+	0xc5, 0x68, 0xec, 0x57, 0x12, 0x42, 0x58, 0x91, 0xc3, 0x1e, 0xc8, 0xb1, 0xd0, 0x6a, 0xd8, 0x16,
+	0x8b, 0x66, 0xe9, 0xca, 0xa6, 0x58, 0xdc, 0x2c, 0x0b, 0x92, 0x6c, 0x6d, 0xa3, 0x9f, 0x34, 0xf0,
+	0x30, 0x76, 0xc3, 0x92, 0xb3, 0x2f, 0xda, 0x34, 0xad, 0x45, 0xb4, 0x3f, 0x8b, 0x7f, 0xe2, 0x88,
+	0x0f, 0x7f, 0x17, 0x14, 0xfd, 0xa7, 0xf6, 0xd0, 0x88, 0xbe, 0x4d, 0x8f, 0x6f, 0x86, 0x47, 0x06,
+	0xee, 0xbe, 0xd9, 0xf7, 0x0b, 0xb3, 0xac, 0xf3, 0x1f, 0x8e, 0x43, 0xbd, 0x40, 0x59, 0x0d, 0x85,
+	0x84, 0x05, 0xe7, 0x34, 0xd4, 0x97, 0x78, 0x26, 0xbf, 0x80, 0x80, 0xf0, 0x85, 0xba, 0xf0, 0x10,
+	0xbc, 0x46, 0x9c, 0xae, 0x77, 0x34, 0x64, 0x9f, 0x1d, 0x9a, 0xbe, 0x7f, 0xe0, 0x7a, 0x96, 0x11,
+	0xb8, 0x4f, 0x89, 0x53, 0x00, 0xcc, 0xa9, 0x3f, 0x18, 0x87, 0xfa, 0xad, 0x98, 0xb4, 0x25, 0x38,
+	0x1d, 0x4a, 0x39, 0x0d, 0xf5, 0xbb, 0xec, 0xdb, 0x17, 0xc8, 0x11, 0xbe, 0x48, 0x93, 0x65, 0x45,
+	0x36, 0xc1, 0xfe, 0x68, 0x27, 0xf0, 0x08, 0x29, 0xcc, 0x31, 0x77, 0xe1, 0x59, 0x91, 0x0a, 0xda,
+	0x1c, 0x8f, 0xb3, 0xa2, 0x02, 0xd2, 0xac, 0xa8, 0x36, 0xff, 0x51, 0x03, 0x19, 0x36, 0xb3, 0x34,
+	0x73, 0xf1, 0x33, 0x90, 0xd8, 0xf8, 0x2c, 0x73, 0x71, 0xe4, 0xcc, 0x69, 0x49, 0xe0, 0xb0, 0x0e,
+	0x32, 0xbb, 0x76, 0x9f, 0x44, 0xd1, 0x19, 0x2a, 0xd1, 0xd9, 0xee, 0x93, 0x86, 0xb3, 0xeb, 0x56,
+	0x6e, 0x8b, 0x08, 0xcc, 0x89, 0x72, 0x63, 0xd2, 0x16, 0xc2, 0x1c, 0xa4, 0x23, 0xea, 0x9b, 0x7e,
+	0x10, 0x3b, 0x70, 0x8a, 0x39, 0x30, 0x1b, 0x11, 0x15, 0x28, 0x1e, 0x0c, 0xc5, 0x21, 0x26, 0x06,
+	0x11, 0x4e, 0x70, 0xd0, 0xaf, 0xa6, 0xc1, 0x1c, 0x1b, 0xd1, 0xf6, 0xd0, 0x32, 0x03, 0xf2, 0xff,
+	0x65, 0x5c, 0xd4, 0xd8, 0xd0, 0x23, 0xfb, 0xb1, 0xb1, 0x74, 0x6c, 0x8c, 0x0a, 0xce, 0x18, 0x53,
+	0x41, 0x84, 0x13, 0x1c, 0xf4, 0x3f, 0x57, 0x40, 0x2e, 0x1a, 0x8a, 0x0c, 0xa2, 0xda, 0x25, 0x82,
+	0xe8, 0x0a, 0x48, 0xfb, 0xf6, 0x17, 0xd1, 0x48, 0x18, 0x97, 0xb6, 0x25, 0x97, 0x36, 0x10, 0x66,
+	0x18, 0xfc, 0x08, 0x80, 0x81, 0x6b, 0xd9, 0xbb, 0x36, 0xb1, 0x0c, 0x5f, 0x2d, 0xa5, 0x22, 0xb4,
+	0x2d, 0x4f, 0xc2, 0x12, 0x41, 0x38, 0x96, 0xd2, 0x98, 0x2b, 0x0d, 0xec, 0x1c, 0x15, 0xe6, 0x59,
+	0x34, 0xf9, 0x20, 0x8a, 0x26, 0xed, 0x3d, 0xd7, 0x0b, 0x58, 0x08, 0x91, 0x9f, 0xa9, 0x1c, 0xc9,
+	0xf0, 0x14, 0x43, 0x88, 0x46, 0x0f, 0x41, 0xc6, 0x0a, 0x15, 0x6e, 0x82, 0x6c, 0x54, 0x8f, 0xce,
+	0x96, 0xb4, 0xe4, 0xe9, 0xe8, 0x31, 0xe9, 0x06, 0xae, 0x57, 0x29, 0x45, 0xa7, 0xa3, 0x7d, 0x59,
+	0x9f, 0x5e, 0x51, 0x73, 0x38, 0xc2, 0x91, 0x04, 0xbe, 0x07, 0x72, 0x72, 0x69, 0x00, 0x1b, 0x2b,
+	0x0b, 0xe0, 0x7e, 0xbc, 0x2c, 0x0b, 0xa2, 0x6c, 0x88, 0x96, 0x44, 0xca, 0xe0, 0xc7, 0x60, 0x66,
+	0xa7, 0xef, 0x76, 0x9f, 0x46, 0xc7, 0xb4, 0x6b, 0x71, 0x47, 0x2a, 0x14, 0x67, 0x1e, 0x77, 0x57,
+	0xf4, 0x45, 0x50, 0x65, 0x92, 0x65, 0x4d, 0x84, 0x05, 0x4c, 0x8b, 0x6d, 0xff, 0x68, 0xd0, 0xb7,
+	0x9d, 0xa7, 0x46, 0x60, 0x7a, 0x3d, 0x12, 0x14, 0x16, 0xe3, 0x62, 0x5b, 0x48, 0x3a, 0x4c, 0x20,
+	0x8b, 0xed, 0x04, 0x8a, 0x70, 0x92, 0x45, 0xaf, 0x00, 0xb8, 0x69, 0x63, 0xcf, 0xf4, 0xf7, 0x0a,
+	0x90, 0xc5, 0x36, 0x96, 0x15, 0x38, 0xbc, 0x61, 0xfa, 0x7b, 0x72, 0xda, 0x63, 0x08, 0x61, 0x45,
+	0x4e, 0xcb, 0x2a, 0x11, 0xcf, 0x88, 0x55, 0xb8, 0xc6, 0x4c, 0x30, 0x57, 0x90, 0x60, 0x5c, 0x14,
+	0x45, 0x08, 0xc2, 0xb1, 0x14, 0x56, 0x44, 0x79, 0xca, 0x8b, 0xca, 0x9b, 0x67, 0x37, 0xe4, 0x25,
+	0xea, 0xd3, 0x35, 0x30, 0x37, 0x59, 0xa8, 0x5c, 0xe1, 0x59, 0x72, 0x98, 0x28, 0x51, 0x78, 0x96,
+	0x1c, 0xaa, 0xc5, 0x89, 0xca, 0x80, 0x1f, 0x2b, 0x6e, 0xe9, 0xf8, 0x2c, 0xfc, 0x66, 0x2a, 0xaf,
+	0xab, 0x7e, 0xd8, 0xf4, 0xcf, 0xf8, 0x61, 0x33, 0xbe, 0x71, 0x50, 0x68, 0x70, 0x17, 0xf0, 0x59,
+	0x32, 0xd8, 0xae, 0xba, 0xc2, 0x4c, 0xad, 0x3f, 0x0f, 0xf5, 0x79, 0x6c, 0x1e, 0xb0, 0xa5, 0x6f,
+	0xdb, 0x5f, 0x10, 0x3a, 0x51, 0x3b, 0x51, 0x43, 0x4e, 0x94, 0x44, 0x22, 0xc3, 0xbf, 0x78, 0x76,
+	0x2f, 0xa1, 0x86, 0x63, 0x25, 0x38, 0x00, 0x0b, 0x74, 0xf5, 0x0c, 0xb3, 0xdf, 0x73, 0x3d, 0x3b,
+	0xd8, 0x1b, 0x14, 0xae, 0x4f, 0x96, 0xe7, 0x4c, 0x8f, 0x2e, 0x56, 0x39, 0xe2, 0x70, 0x9f, 0xd9,
+	0x53, 0x21, 0xe9, 0x33, 0x09, 0x14, 0xe1, 0x24, 0x0b, 0x3e, 0x06, 0xb9, 0x61, 0xdf, 0x0c, 0x76,
+	0x5d, 0x6f, 0x50, 0x58, 0x60, 0x7b, 0x4b, 0x59, 0xb2, 0x2d, 0x21, 0xa9, 0x99, 0x81, 0x19, 0x17,
+	0x34, 0x11, 0x5f, 0x6e, 0x94, 0x08, 0x40, 0x58, 0xca, 0x60, 0x0d, 0xcc, 0xf5, 0xdd, 0xae, 0xd9,
+	0x37, 0x76, 0xfb, 0x66, 0xcf, 0x2f, 0xfc, 0x47, 0x96, 0xad, 0x21, 0x73, 0x46, 0x86, 0xaf, 0x51,
+	0x58, 0xce, 0x7d, 0x0c, 0x21, 0xac, 0xc8, 0xe1, 0x06, 0x98, 0x17, 0xbb, 0x96, 0xbb, 0xf4, 0x7f,
+	0x66, 0x99, 0x43, 0x32, 0x57, 0x10, 0x02, 0xe1, 0xd4, 0x8b, 0xea, 0x66, 0xe7, 0x5e, 0xad, 0x32,
+	0xe0, 0x27, 0xe0, 0xaa, 0xed, 0xb8, 0x16, 0x31, 0xba, 0x7b, 0xa6, 0xd3, 0x23, 0xd4, 0x1d, 0xc6,
+	0x59, 0xb6, 0xf9, 0xd9, 0xd4, 0x31, 0x59, 0x95, 0x89, 0x9a, 0xbe, 0x9c, 0xba, 0x04, 0x8a, 0x70,
+	0x92, 0x05, 0x0f, 0x81, 0x92, 0xf9, 0x8d, 0xc0, 0x33, 0xed, 0x3e, 0xf1, 0xb8, 0x7b, 0xfc, 0x57,
+	0x96, 0xf9, 0xc7, 0x47, 0xe3, 0x50, 0xbf, 0x11, 0x73, 0x3a, 0x9c, 0x22, 0x7c, 0xe3, 0xf6, 0xc4,
+	0xa9, 0x42, 0x91, 0x4a, 0x07, 0x3c, 0x5f, 0x19, 0xbe, 0x43, 0xab, 0x45, 0x5a, 0x2b, 0x5b, 0xa2,
+	0x28, 0xbe, 0xc3, 0xeb, 0x42, 0x06, 0xc9, 0xc8, 0x27, 0xda, 0xac, 0x30, 0x64, 0xbf, 0x20, 0x06,
+	0x59, 0xdb, 0xd9, 0x37, 0xfb, 0x76, 0x54, 0xf4, 0xbe, 0xfb, 0x3c, 0xd4, 0x01, 0x36, 0x0f, 0x1a,
+	0x1c, 0xe5, 0x87, 0x3c, 0xf6, 0x53, 0x39, 0xe4, 0xb1, 0x36, 0x3d, 0xe4, 0x29, 0x4c, 0x1c, 0xf1,
+	0x68, 0x14, 0x73, 0xdc, 0xc4, 0xbd, 0x42, 0x8e, 0x99, 0x66, 0xd3, 0xea, 0xb8, 0xc9, 0x3b, 0x05,
+	0x3e, 0xad, 0x09, 0x14, 0xe1, 0x24, 0xeb, 0xbd, 0xf4, 0x9f, 0xfe, 0x52, 0x9f, 0x42, 0xdf, 0x68,
+	0x60, 0x56, 0x46, 0x54, 0x9a, 0xcc, 0xd8, 0xfa, 0xa7, 0xd8, 0xf2, 0xb3, 0xe0, 0xb1, 0xc7, 0xd7,
+	0x1d, 0x48, 0x1f, 0x47, 0x98, 0x61, 0xf4, 0x18, 0xe1, 0xee, 0xee, 0xfa, 0x24, 0x60, 0x69, 0x32,
+	0xc5, 0x8f, 0x11, 0x1c, 0x91, 0xc7, 0x08, 0xde, 0x44, 0x58, 0xe0, 0xf0, 0x4d, 0x91, 0x2c, 0xa7,
+	0xd9, 0xb2, 0xdd, 0x3d, 0x3f, 0x59, 0x46, 0x8b, 0xc2, 0x44, 0xb4, 0x0e, 0x38, 0x20, 0xe6, 0x53,
+	0xee, 0x97, 0x3c, 0x42, 0xb1, 0x34, 0x42, 0x41, 0xe1, 0x93, 0x7c, 0x77, 0x44, 0x00, 0xc2, 0x52,
+	0x26, 0xc6, 0xf8, 0x04, 0xcc, 0xf0, 0xec, 0x05, 0xb7, 0x40, 0xae, 0xeb, 0x8e, 0x9c, 0x20, 0xbe,
+	0x19, 0x5b, 0x54, 0x0b, 0x16, 0x26, 0xa9, 0xfc, 0x20, 0xda, 0x80, 0x11, 0x55, 0xae, 0x91, 0x00,
+	0x68, 0xa5, 0x21, 0x44, 0xe8, 0xe7, 0x1a, 0xc8, 0x0a, 0x45, 0xb8, 0x21, 0xeb, 0xb7, 0x74, 0xe5,
+	0xdd, 0x89, 0xa4, 0xfc, 0xed, 0x57, 0x55, 0x6a, 0x42, 0x16, 0xb7, 0x56, 0x71, 0x21, 0x99, 0xfe,
+	0xce, 0x42, 0x12, 0xfd, 0x3c, 0x0d, 0xe6, 0xd5, 0x20, 0x42, 0xb3, 0xc3, 0xc8, 0xb1, 0x0f, 0x59,
+	0x67, 0x12, 0xc7, 0xb5, 0x6d, 0xc7, 0x3e, 0x64, 0x61, 0xa6, 0xf8, 0x55, 0xa8, 0x6b, 0x74, 0x01,
+	0x28, 0x4f, 0x2e, 0x00, 0x6d, 0x20, 0xcc, 0x30, 0xf8, 0x09, 0xc8, 0x1e, 0xd8, 0x8e, 0xe5, 0x1e,
+	0xf8, 0xac, 0x1b, 0x73, 0x6a, 0x71, 0xf7, 0x29, 0x17, 0x30, 0x4b, 0x25, 0x61, 0x29, 0x62, 0xcb,
+	0xe9, 0x12, 0x6d, 0x84, 0x23, 0x09, 0x5c, 0x07, 0x99, 0xbe, 0xed, 0x8c, 0x0e, 0x99, 0x83, 0x25,
+	0xb2, 0xfa, 0x67, 0x66, 0x10, 0x78, 0xcc, 0xdc, 0x1d, 0x61, 0x8e, 0x33, 0xe5, 0x80, 0x59, 0x8b,
+	0x5e, 0xd3, 0xd1, 0xbf, 0xf0, 0x21, 0x98, 0xb1, 0x4c, 0xef, 0xc0, 0xe6, 0x75, 0xe7, 0x05, 0x96,
+	0x96, 0x84, 0x25, 0x41, 0x8d, 0x2f, 0x72, 0x58, 0x13, 0x61, 0x81, 0x43, 0x02, 0xb2, 0xbb, 0x1e,
+	0x21, 0x3b, 0xbe, 0x55, 0xc8, 0x5c, 0x6c, 0xed, 0x1d, 0x6a, 0x8d, 0x56, 0x6a, 0x6b, 0x1e, 0x21,
+	0x95, 0x36, 0xab, 0xd4, 0x84, 0x9a, 0x1c, 0xb1, 0x68, 0xb3, 0x4a, 0x4d, 0xd0, 0x70, 0x44, 0x82,
+	0x06, 0x98, 0x71, 0x48, 0xb0, 0xe3, 0xf3, 0x60, 0x72, 0xc1, 0x57, 0x56, 0xc5, 0x57, 0x66, 0x9a,
+	0x24, 0xe0, 0x1f, 0x11, 0x4a, 0xb2, 0xf7, 0xbc, 0x49, 0x3f, 0x21, 0x38, 0x58, 0x30, 0xd0, 0xef,
+	0x4f, 0x83, 0x5c, 0xb4, 0xbe, 0xf4, 0xac, 0xe9, 0x1e, 0x38, 0xc4, 0x53, 0xdf, 0x3a, 0xd8, 0x01,
+	0x83, 0xa1, 0xa2, 0x82, 0xe6, 0x79, 0x53, 0x22, 0x08, 0xc7, 0x52, 0x6a, 0xa0, 0xe7, 0xb9, 0xa3,
+	0xa1, 0xfa, 0xce, 0xc1, 0x0c, 0x30, 0x34, 0x61, 0x40, 0x22, 0x08, 0xc7, 0x52, 0xf8, 0x3e, 0x48,
+	0x8d, 0x6c, 0x4b, 0x5c, 0x05, 0xbd, 0xfe, 0x3c, 0xd4, 0x53, 0xdb, 0x6c, 0x07, 0x50, 0x54, 0x5e,
+	0x9e, 0x8c, 0x6c, 0x4b, 0xc9, 0xd6, 0x94, 0x81, 0xa9, 0x9c, 0x2a, 0xf7, 0x6c, 0xab, 0x90, 0x8e,
+	0x95, 0xd7, 0xb9, 0x72, 0x4f, 0x51, 0xee, 0x25, 0x95, 0xd7, 0xa9, 0x32, 0xc5, 0xfe, 0x5c, 0x03,
+	0x73, 0x8a, 0x87, 0xbe, 0xfa, 0x5c, 0x6c, 0x82, 0x05, 0x6e, 0xc0, 0xf6, 0x0d, 0x36, 0x40, 0x71,
+	0x11, 0xce, 0x6a, 0x0d, 0x26, 0x69, 0xf8, 0xeb, 0x14, 0x97, 0xb5, 0x86, 0x0a, 0x22, 0x9c, 0xe0,
+	0xa0, 0x36, 0x98, 0x95, 0x0b, 0x0e, 0xd7, 0xc0, 0xcc, 0x21, 0x6d, 0x44, 0x01, 0xe9, 0xea, 0x84,
+	0x57, 0xc4, 0xa7, 0x5c, 0x4e, 0x93, 0x1b, 0x82, 0x35, 0x11, 0x16, 0x30, 0xea, 0x82, 0x0c, 0xe3,
+	0xbf, 0x54, 0xf1, 0x92, 0x88, 0x33, 0xf3, 0xdf, 0x1d, 0x67, 0x7e, 0x2f, 0x0d, 0xb2, 0x98, 0x9e,
+	0xd1, 0xfd, 0x00, 0xbe, 0x2d, 0xa3, 0x5d, 0xa6, 0x72, 0xff, 0xa2, 0xf0, 0x16, 0xaf, 0x4e, 0x74,
+	0x41, 0x15, 0x57, 0x9f, 0xd3, 0x97, 0xae, 0x3e, 0xa3, 0x21, 0xa5, 0x2e, 0x31, 0xa4, 0x38, 0x2d,
+	0xa5, 0x5f, 0x3a, 0x2d, 0x65, 0x2e, 0x9f, 0x96, 0xa2, 0x4c, 0x39, 0x73, 0x89, 0x4c, 0xd9, 0x02,
+	0x0b, 0xbb, 0x9e, 0x3b, 0x60, 0xb7, 0xec, 0xae, 0x47, 0x9f, 0x51, 0xb2, 0x71, 0xea, 0xa6, 0x92,
+	0x4e, 0x24, 0x90, 0xa9, 0x3b, 0x81, 0x22, 0x9c, 0x64, 0x25, 0x73, 0x62, 0xee, 0xe5, 0x72, 0x22,
+	0xfc, 0x10, 0xe4, 0xf8, 0x01, 0xdb, 0x71, 0x59, 0x95, 0x97, 0x61, 0x37, 0xa5, 0x59, 0x86, 0x35,
+	0x5d, 0x19, 0xca, 0x44, 0x5b, 0x0e, 0x3b, 0x22, 0xa0, 0xbf, 0xd1, 0x40, 0x0e, 0x13, 0x7f, 0xe8,
+	0x3a, 0x3e, 0xf9, 0xbe, 0x4e, 0xb0, 0x02, 0xd2, 0x96, 0x19, 0x98, 0x85, 0xe9, 0x78, 0xf6, 0x68,
+	0x5b, 0xce, 0x1e, 0x6d, 0x20, 0xcc, 0x30, 0xf8, 0x11, 0x48, 0x77, 0x5d, 0x8b, 0x2f, 0xfe, 0x82,
+	0x1a, 0x34, 0xeb, 0x9e, 0xe7, 0x7a, 0x55, 0xd7, 0x12, 0x55, 0x0e, 0x25, 0x49, 0x03, 0xb4, 0x81,
+	0x30, 0xc3, 0xd0, 0x5f, 0x6a, 0x20, 0x5f, 0x73, 0x0f, 0x9c, 0xbe, 0x6b, 0x5a, 0x5b, 0x9e, 0xdb,
+	0xa3, 0x37, 0x8c, 0xdf, 0xeb, 0x12, 0xc4, 0x00, 0xd9, 0x11, 0xbb, 0x42, 0x89, 0xae, 0x41, 0xee,
+	0x25, 0xab, 0xae, 0xc9, 0x8f, 0xf0, 0xfb, 0x96, 0xf8, 0x41, 0x41, 0x28, 0x4b, 0xfb, 0xbc, 0x8d,
+	0x70, 0x24, 0x40, 0xbf, 0x4a, 0x81, 0xe2, 0xc5, 0x86, 0xe0, 0x00, 0xcc, 0x71, 0xa6, 0xa1, 0x3c,
+	0x4c, 0x2e, 0x5f, 0xa6, 0x0f, 0xac, 0x16, 0x64, 0x45, 0xc1, 0x48, 0xb6, 0x65, 0x51, 0x10, 0x43,
+	0x08, 0x2b, 0xf2, 0x97, 0xba, 0x4a, 0x56, 0x6e, 0x0e, 0x52, 0xaf, 0x7e, 0x73, 0xd0, 0x06, 0x57,
+	0xb8, 0x8b, 0x46, 0x4f, 0x52, 0xf4, 0x46, 0x3f, 0x53, 0x79, 0x40, 0xa3, 0xed, 0x0e, 0x3f, 0xac,
+	0x46, 0x8f, 0x51, 0x8b, 0xb1, 0xb3, 0x72, 0x30, 0xf2, 0xb6, 0xfc, 0x14, 0x4e, 0x70, 0xe1, 0x5a,
+	0xa2, 0xb0, 0xe4, 0x5b, 0xfd, 0xd7, 0x2e, 0x59, 0x48, 0x2a, 0x85, 0x23, 0x9a, 0x01, 0xe9, 0x2d,
+	0xdb, 0xe9, 0xa1, 0xf7, 0x41, 0xa6, 0xda, 0x77, 0x7d, 0x16, 0x71, 0x3c, 0x62, 0xfa, 0xae, 0xa3,
+	0xba, 0x12, 0x47, 0xe4, 0x52, 0xf3, 0x26, 0xc2, 0x02, 0x47, 0xff, 0xac, 0x01, 0xc0, 0x1f, 0x6b,
+	0xb7, 0x46, 0xfe, 0x9e, 0xf2, 0xaa, 0x99, 0xba, 0xd4, 0xab, 0xa6, 0xf2, 0xc0, 0x3b, 0xfd, 0xca,
+	0x0f, 0xbc, 0xca, 0x9b, 0x58, 0xea, 0x95, 0xdf, 0xc4, 0xca, 0x60, 0x6e, 0x9b, 0xbe, 0x69, 0x63,
+	0x32, 0x74, 0xbd, 0x80, 0xcf, 0x0d, 0xfd, 0x25, 0x9e, 0x35, 0xc4, 0xdc, 0x50, 0x44, 0x99, 0x1b,
+	0xda, 0x64, 0x73, 0xc3, 0x7e, 0x7c, 0x01, 0x72, 0x6c, 0xcd, 0xca, 0xdd, 0xa7, 0xdf, 0x6b, 0x9b,
+	0xaa, 0xf7, 0x4e, 0xd3, 0x2f, 0x77, 0xef, 0xb4, 0xf2, 0x22, 0x0d, 0xe6, 0x94, 0xf7, 0x7d, 0xf8,
+	0x5b, 0xe0, 0xf6, 0xa3, 0x7a, 0xbb, 0x5d, 0x5e, 0xaf, 0x1b, 0x9d, 0xcf, 0xb7, 0xea, 0x46, 0x75,
+	0x73, 0xbb, 0xdd, 0xa9, 0x63, 0xa3, 0xda, 0x6a, 0xae, 0x35, 0xd6, 0xf3, 0x53, 0xc5, 0x3b, 0xc7,
+	0x27, 0xa5, 0x82, 0xa2, 0x91, 0x7c, 0x88, 0xff, 0x75, 0x00, 0x13, 0xea, 0x8d, 0x66, 0xad, 0xfe,
+	0x59, 0x5e, 0x2b, 0x5e, 0x3f, 0x3e, 0x29, 0xe5, 0x15, 0x2d, 0x7e, 0xe1, 0xfc, 0x9b, 0xe0, 0xb5,
+	0xb3, 0x6c, 0x63, 0x7b, 0xab, 0x56, 0xee, 0xd4, 0xf3, 0xd3, 0xc5, 0xe2, 0xf1, 0x49, 0xe9, 0xe6,
+	0xa4, 0x92, 0x08, 0x0d, 0x3f, 0x05, 0xd7, 0x13, 0xaa, 0xb8, 0xfe, 0xc9, 0x76, 0xbd, 0xdd, 0xc9,
+	0xa7, 0x8a, 0x37, 0x8f, 0x4f, 0x4a, 0x50, 0xd1, 0x8a, 0xd2, 0xf7, 0x2a, 0xb8, 0x31, 0xa1, 0xd1,
+	0xde, 0x6a, 0x35, 0xdb, 0xf5, 0x7c, 0xba, 0x78, 0xeb, 0xf8, 0xa4, 0x74, 0x2d, 0xa1, 0x22, 0xa2,
+	0x7d, 0x15, 0x2c, 0x25, 0x74, 0x6a, 0xad, 0x4f, 0x9b, 0x9b, 0xad, 0x72, 0xcd, 0xd8, 0xc2, 0xad,
+	0x75, 0x5c, 0x6f, 0xb7, 0xf3, 0x99, 0xa2, 0x7e, 0x7c, 0x52, 0xba, 0xad, 0x28, 0x9f, 0x89, 0xbc,
+	0x2b, 0x60, 0x31, 0x61, 0x64, 0xab, 0xd1, 0x5c, 0xcf, 0xcf, 0x14, 0xaf, 0x1d, 0x9f, 0x94, 0xae,
+	0x2a, 0x7a, 0x74, 0x8f, 0x9d, 0x99, 0xbf, 0xea, 0x66, 0xab, 0x5d, 0xcf, 0x67, 0xcf, 0xcc, 0x1f,
+	0xdf, 0x88, 0xbf, 0x01, 0x0a, 0x49, 0x36, 0x5b, 0x24, 0x63, 0x6b, 0xbb, 0xbd, 0x91, 0xcf, 0x15,
+	0x5f, 0x3b, 0x3e, 0x29, 0xdd, 0x50, 0x75, 0xe2, 0xed, 0x37, 0x39, 0xf1, 0xdb, 0xec, 0x27, 0xae,
+	0x6f, 0xb5, 0x70, 0x27, 0x3f, 0x7b, 0x66, 0xe2, 0x55, 0x07, 0xff, 0x19, 0xb8, 0x79, 0xce, 0x9a,
+	0x95, 0xab, 0x0f, 0xf3, 0xe0, 0xcc, 0x3c, 0x46, 0x5e, 0xbd, 0xf2, 0xaf, 0x1a, 0x80, 0x67, 0xff,
+	0xf7, 0x03, 0xbe, 0x1b, 0xf7, 0xbf, 0xda, 0x7a, 0xb4, 0x45, 0x27, 0xb4, 0xd1, 0x6a, 0x1a, 0xcd,
+	0x56, 0xb3, 0x9e, 0x9f, 0x4a, 0xf4, 0x42, 0xd1, 0x6a, 0xba, 0x0e, 0xfd, 0x7f, 0xa2, 0x5b, 0xe7,
+	0x69, 0x6e, 0x3e, 0x79, 0x2b, 0xaf, 0x15, 0x57, 0x95, 0x81, 0x2b, 0x8a, 0x9b, 0x4f, 0xde, 0xfa,
+	0xfa, 0xcb, 0xfb, 0xe7, 0x0b, 0x2e, 0xea, 0xca, 0x93, 0x76, 0xa7, 0x36, 0xe1, 0x89, 0x8a, 0xe2,
+	0x13, 0x3f, 0xb0, 0x56, 0xe8, 0xd9, 0x5b, 0x1d, 0xd4, 0x9b, 0xe0, 0xba, 0x6a, 0xe1, 0x51, 0xbd,
+	0x53, 0xae, 0x95, 0x3b, 0xe5, 0xfc, 0x14, 0x9f, 0x1e, 0x85, 0xfa, 0x88, 0x04, 0x26, 0xcb, 0xf8,
+	0x3f, 0x06, 0x8b, 0x89, 0xf1, 0xd7, 0x1f, 0xd7, 0x71, 0xb4, 0x69, 0xd4, 0x91, 0x93, 0x7d, 0xe2,
+	0xc1, 0x9f, 0x00, 0xa8, 0x92, 0xcb, 0x9b, 0x9f, 0x96, 0x3f, 0x6f, 0xe7, 0xa7, 0x8b, 0x37, 0x8e,
+	0x4f, 0x4a, 0x8b, 0x0a, 0xbb, 0xdc, 0x3f, 0x30, 0x8f, 0xfc, 0x95, 0xbf, 0xd5, 0x00, 0x3c, 0x7b,
+	0xaf, 0x07, 0xd7, 0xc1, 0xed, 0xca, 0x66, 0xab, 0xfa, 0xd0, 0xd8, 0x28, 0xb7, 0x37, 0x8c, 0xf2,
+	0xe6, 0x7a, 0x0b, 0x37, 0x3a, 0x1b, 0x8f, 0x8c, 0xf6, 0x46, 0x79, 0xf5, 0xed, 0x77, 0xf2, 0x53,
+	0xc5, 0x1f, 0x51, 0xf7, 0x94, 0x8a, 0x1c, 0xfe, 0xfa, 0xcb, 0xfb, 0x93, 0x10, 0xfc, 0x18, 0xdc,
+	0x39, 0xd7, 0x50, 0x65, 0xb3, 0xfc, 0xb0, 0xbe, 0x5a, 0xc9, 0x6b, 0xc5, 0x65, 0x3a, 0x0c, 0xa9,
+	0xc6, 0xf1, 0x9d, 0xaf, 0xbf, 0xbc, 0x7f, 0x06, 0x2b, 0xa6, 0xff, 0xea, 0x2f, 0x96, 0xa6, 0x56,
+	0xfe, 0x7e, 0x1a, 0xcc, 0xab, 0x77, 0xba, 0xf0, 0x27, 0xe0, 0xda, 0x5a, 0x63, 0x93, 0xba, 0xda,
+	0x5a, 0x8b, 0x3b, 0x1d, 0x6d, 0xe6, 0xa7, 0xf8, 0x04, 0xa9, 0x54, 0xfa, 0x9b, 0xee, 0x8a, 0x09,
+	0x7a, 0xad, 0x81, 0xeb, 0xd5, 0x4e, 0x0b, 0x7f, 0x9e, 0xd7, 0xf8, 0xae, 0x50, 0x75, 0x6a, 0xb6,
+	0xc7, 0xf2, 0xf5, 0x11, 0xfc, 0x10, 0xdc, 0x9e, 0x50, 0x6c, 0x7f, 0xfe, 0x68, 0xb3, 0xd1, 0x7c,
+	0xc8, 0xbf, 0x37, 0x5d, 0xbc, 0x7b, 0x7c, 0x52, 0xba, 0xa5, 0xea, 0xb6, 0xf9, 0x35, 0x39, 0x85,
+	0x72, 0x1a, 0xdc, 0x00, 0xa5, 0x0b, 0xf4, 0xe3, 0x0e, 0xa4, 0x8a, 0xe8, 0xf8, 0xa4, 0x74, 0xe7,
+	0x1c, 0x23, 0xb2, 0x1f, 0x39, 0x8d, 0x6e, 0xb2, 0xf3, 0x2d, 0x45, 0xc1, 0xea, 0x1c, 0xfd, 0x95,
+	0x7f, 0xd2, 0xc0, 0xac, 0x3c, 0x22, 0xd2, 0x49, 0xab, 0x63, 0xdc, 0xa2, 0x91, 0xbb, 0x56, 0x37,
+	0x9a, 0x2d, 0x83, 0xb5, 0xa2, 0x49, 0x93, 0xbc, 0xa6, 0xcb, 0x7e, 0xd2, 0xc0, 0xa3, 0xd0, 0xd7,
+	0xeb, 0xcd, 0x3a, 0x6e, 0x54, 0x23, 0x1f, 0x94, 0xec, 0x75, 0xe2, 0x10, 0xcf, 0xee, 0xc2, 0xb7,
+	0xc0, 0xad, 0xa4, 0xf1, 0xf6, 0x76, 0x75, 0x23, 0x9a, 0x25, 0xd6, 0x41, 0xe5, 0x03, 0xed, 0x51,
+	0x77, 0x8f, 0x2d, 0xcc, 0xdb, 0x09, 0xad, 0x46, 0xf3, 0x71, 0x79, 0xb3, 0x51, 0xe3, 0x5a, 0xa9,
+	0x62, 0xe1, 0xf8, 0xa4, 0x74, 0x5d, 0x6a, 0x89, 0xdb, 0x40, 0xaa, 0xb6, 0xf2, 0xb5, 0x06, 0x96,
+	0xbe, 0xfd, 0xa4, 0x07, 0x3f, 0x05, 0xaf, 0xb3, 0xf9, 0x3a, 0x13, 0x9f, 0x45, 0x32, 0xe1, 0x73,
+	0x58, 0xde, 0xda, 0xaa, 0x37, 0x6b, 0xf9, 0x29, 0xe6, 0x91, 0xf7, 0xbe, 0xdd, 0x64, 0x79, 0x38,
+	0x24, 0x8e, 0x75, 0x49, 0xc3, 0x6b, 0x2d, 0xbc, 0x5e, 0xef, 0xe4, 0xb5, 0xcb, 0x18, 0x5e, 0x73,
+	0xe9, 0x93, 0xca, 0xca, 0x7f, 0x6b, 0xe0, 0x7a, 0x62, 0xb3, 0x46, 0x1b, 0xf3, 0x03, 0x50, 0x4c,
+	0x6e, 0xef, 0x68, 0x43, 0x95, 0xb7, 0x3b, 0xad, 0x28, 0xff, 0x9e, 0xa7, 0x59, 0x1e, 0x05, 0x2e,
+	0xdc, 0x06, 0xaf, 0x9d, 0xaf, 0xcd, 0x23, 0xe3, 0x3b, 0xd4, 0x81, 0xcf, 0x53, 0xe6, 0xb1, 0xf1,
+	0x22, 0xd1, 0xc5, 0x9d, 0x12, 0xf1, 0xf1, 0xc2, 0x4e, 0xd1, 0x08, 0xc9, 0xb7, 0x75, 0xe5, 0xd1,
+	0x57, 0xdf, 0x2c, 0x4d, 0x3d, 0xfb, 0x66, 0x69, 0xea, 0xab, 0xe7, 0x4b, 0xda, 0xb3, 0xe7, 0x4b,
+	0xda, 0x1f, 0xbf, 0x58, 0x9a, 0xfa, 0xe5, 0x8b, 0x25, 0xed, 0xd9, 0x8b, 0xa5, 0xa9, 0x7f, 0x79,
+	0xb1, 0x34, 0xf5, 0xe4, 0xc7, 0x3d, 0x3b, 0xd8, 0x1b, 0xed, 0x3c, 0xe8, 0xba, 0x83, 0x37, 0xfc,
+	0x23, 0xa7, 0x1b, 0xec, 0xd9, 0x4e, 0x4f, 0xf9, 0xa5, 0xfe, 0xa7, 0xec, 0xce, 0x0c, 0xfb, 0xf5,
+	0xb3, 0xff, 0x1b, 0x00, 0x13, 0x8b, 0xe7, 0x3c, 0x40, 0x2b, 0x00, 0x00,
 }
 
 func (m *Hello) Marshal() (dAtA []byte, err error) {
@@ -1377,6 +1652,33 @@ func (m *Hello) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Extensions) > 0 {
+		for iNdEx := len(m.Extensions) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Extensions[iNdEx])
+			copy(dAtA[i:], m.Extensions[iNdEx])
+			i = encodeVarintBep(dAtA, i, uint64(len(m.Extensions[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.Compressions) > 0 {
+		dAtA2 := make([]byte, len(m.Compressions)*10)
+		var j1 int
+		for _, num := range m.Compressions {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintBep(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x32
+	}
 	if m.Timestamp != 0 {
 		i = encodeVarintBep(dAtA, i, uint64(m.Timestamp))
 		i--
@@ -1464,6 +1766,15 @@ func (m *ClusterConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Extensions) > 0 {
+		for iNdEx := len(m.Extensions) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Extensions[iNdEx])
+			copy(dAtA[i:], m.Extensions[iNdEx])
+			i = encodeVarintBep(dAtA, i, uint64(len(m.Extensions[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if m.Secondary {
 		i--
 		if m.Secondary {
@@ -1527,6 +1838,16 @@ func (m *Folder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			dAtA[i] = 0x82
 		}
 	}
+	{
+		size, err := m.Defaults.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintBep(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
 	if m.Paused {
 		i--
 		if m.Paused {
@@ -1594,6 +1915,69 @@ func (m *Folder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *FolderDefaults) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FolderDefaults) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FolderDefaults) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.IgnoreLines) > 0 {
+		for iNdEx := len(m.IgnoreLines) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.IgnoreLines[iNdEx])
+			copy(dAtA[i:], m.IgnoreLines[iNdEx])
+			i = encodeVarintBep(dAtA, i, uint64(len(m.IgnoreLines[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.VersioningCleanupIntervalS != 0 {
+		i = encodeVarintBep(dAtA, i, uint64(m.VersioningCleanupIntervalS))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.VersioningParams) > 0 {
+		for k := range m.VersioningParams {
+			v := m.VersioningParams[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintBep(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintBep(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintBep(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.VersioningType) > 0 {
+		i -= len(m.VersioningType)
+		copy(dAtA[i:], m.VersioningType)
+		i = encodeVarintBep(dAtA, i, uint64(len(m.VersioningType)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Device) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
@@ -1614,6 +1998,13 @@ func (m *Device) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.IndexSubtree) > 0 {
+		i -= len(m.IndexSubtree)
+		copy(dAtA[i:], m.IndexSubtree)
+		i = encodeVarintBep(dAtA, i, uint64(len(m.IndexSubtree)))
+		i--
+		dAtA[i] = 0x5a
+	}
 	if len(m.EncryptionPasswordToken) > 0 {
 		i -= len(m.EncryptionPasswordToken)
 		copy(dAtA[i:], m.EncryptionPasswordToken)
@@ -1845,6 +2236,13 @@ func (m *FileInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0xc0
 	}
+	if m.HashAlgorithm != 0 {
+		i = encodeVarintBep(dAtA, i, uint64(m.HashAlgorithm))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa0
+	}
 	if len(m.Encrypted) > 0 {
 		i -= len(m.Encrypted)
 		copy(dAtA[i:], m.Encrypted)
@@ -2632,30 +3030,151 @@ func (m *Close) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintBep(dAtA []byte, offset int, v uint64) int {
-	offset -= sovBep(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *ConfigPush) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *Hello) ProtoSize() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *ConfigPush) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConfigPush) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.DeviceName)
-	if l > 0 {
-		n += 1 + l + sovBep(uint64(l))
+	if len(m.Devices) > 0 {
+		for iNdEx := len(m.Devices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Devices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBep(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
 	}
-	l = len(m.ClientName)
-	if l > 0 {
-		n += 1 + l + sovBep(uint64(l))
+	if len(m.Folders) > 0 {
+		for iNdEx := len(m.Folders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Folders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBep(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.ID != 0 {
+		i = encodeVarintBep(dAtA, i, uint64(m.ID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UsageReport) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UsageReport) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UsageReport) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Report) > 0 {
+		i -= len(m.Report)
+		copy(dAtA[i:], m.Report)
+		i = encodeVarintBep(dAtA, i, uint64(len(m.Report)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *IndexAck) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IndexAck) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IndexAck) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Sequence != 0 {
+		i = encodeVarintBep(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Folder) > 0 {
+		i -= len(m.Folder)
+		copy(dAtA[i:], m.Folder)
+		i = encodeVarintBep(dAtA, i, uint64(len(m.Folder)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintBep(dAtA []byte, offset int, v uint64) int {
+	offset -= sovBep(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Hello) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DeviceName)
+	if l > 0 {
+		n += 1 + l + sovBep(uint64(l))
+	}
+	l = len(m.ClientName)
+	if l > 0 {
+		n += 1 + l + sovBep(uint64(l))
 	}
 	l = len(m.ClientVersion)
 	if l > 0 {
@@ -2667,6 +3186,19 @@ func (m *Hello) ProtoSize() (n int) {
 	if m.Timestamp != 0 {
 		n += 1 + sovBep(uint64(m.Timestamp))
 	}
+	if len(m.Compressions) > 0 {
+		l = 0
+		for _, e := range m.Compressions {
+			l += sovBep(uint64(e))
+		}
+		n += 1 + sovBep(uint64(l)) + l
+	}
+	if len(m.Extensions) > 0 {
+		for _, s := range m.Extensions {
+			l = len(s)
+			n += 1 + l + sovBep(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -2700,6 +3232,12 @@ func (m *ClusterConfig) ProtoSize() (n int) {
 	if m.Secondary {
 		n += 2
 	}
+	if len(m.Extensions) > 0 {
+		for _, s := range m.Extensions {
+			l = len(s)
+			n += 1 + l + sovBep(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -2732,6 +3270,8 @@ func (m *Folder) ProtoSize() (n int) {
 	if m.Paused {
 		n += 2
 	}
+	l = m.Defaults.ProtoSize()
+	n += 1 + l + sovBep(uint64(l))
 	if len(m.Devices) > 0 {
 		for _, e := range m.Devices {
 			l = e.ProtoSize()
@@ -2741,6 +3281,36 @@ func (m *Folder) ProtoSize() (n int) {
 	return n
 }
 
+func (m *FolderDefaults) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.VersioningType)
+	if l > 0 {
+		n += 1 + l + sovBep(uint64(l))
+	}
+	if len(m.VersioningParams) > 0 {
+		for k, v := range m.VersioningParams {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovBep(uint64(len(k))) + 1 + len(v) + sovBep(uint64(len(v)))
+			n += mapEntrySize + 1 + sovBep(uint64(mapEntrySize))
+		}
+	}
+	if m.VersioningCleanupIntervalS != 0 {
+		n += 1 + sovBep(uint64(m.VersioningCleanupIntervalS))
+	}
+	if len(m.IgnoreLines) > 0 {
+		for _, s := range m.IgnoreLines {
+			l = len(s)
+			n += 1 + l + sovBep(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *Device) ProtoSize() (n int) {
 	if m == nil {
 		return 0
@@ -2782,6 +3352,10 @@ func (m *Device) ProtoSize() (n int) {
 	if l > 0 {
 		n += 1 + l + sovBep(uint64(l))
 	}
+	l = len(m.IndexSubtree)
+	if l > 0 {
+		n += 1 + l + sovBep(uint64(l))
+	}
 	return n
 }
 
@@ -2897,6 +3471,9 @@ func (m *FileInfo) ProtoSize() (n int) {
 	if l > 0 {
 		n += 2 + l + sovBep(uint64(l))
 	}
+	if m.HashAlgorithm != 0 {
+		n += 2 + sovBep(uint64(m.HashAlgorithm))
+	}
 	if m.LocalFlags != 0 {
 		n += 2 + sovBep(uint64(m.LocalFlags))
 	}
@@ -3194,6 +3771,59 @@ func (m *Close) ProtoSize() (n int) {
 	return n
 }
 
+func (m *ConfigPush) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ID != 0 {
+		n += 1 + sovBep(uint64(m.ID))
+	}
+	if len(m.Folders) > 0 {
+		for _, e := range m.Folders {
+			l = e.ProtoSize()
+			n += 1 + l + sovBep(uint64(l))
+		}
+	}
+	if len(m.Devices) > 0 {
+		for _, e := range m.Devices {
+			l = e.ProtoSize()
+			n += 1 + l + sovBep(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *UsageReport) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Report)
+	if l > 0 {
+		n += 1 + l + sovBep(uint64(l))
+	}
+	return n
+}
+
+func (m *IndexAck) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Folder)
+	if l > 0 {
+		n += 1 + l + sovBep(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovBep(uint64(m.Sequence))
+	}
+	return n
+}
+
 func sovBep(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -3363,20 +3993,121 @@ func (m *Hello) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipBep(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthBep
+		case 6:
+			if wireType == 0 {
+				var v MessageCompression
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowBep
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= MessageCompression(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Compressions = append(m.Compressions, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowBep
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthBep
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthBep
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.Compressions) == 0 {
+					m.Compressions = make([]MessageCompression, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v MessageCompression
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowBep
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= MessageCompression(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Compressions = append(m.Compressions, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Compressions", wireType)
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Extensions", wireType)
 			}
-			iNdEx += skippy
-		}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBep
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBep
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBep
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Extensions = append(m.Extensions, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBep(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBep
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
 
 	if iNdEx > l {
@@ -3555,6 +4286,38 @@ func (m *ClusterConfig) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.Secondary = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Extensions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBep
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBep
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBep
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Extensions = append(m.Extensions, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBep(dAtA[iNdEx:])
@@ -3769,6 +4532,39 @@ func (m *Folder) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.Paused = bool(v != 0)
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Defaults", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBep
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBep
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBep
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Defaults.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 16:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Devices", wireType)
@@ -3824,7 +4620,7 @@ func (m *Folder) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Device) Unmarshal(dAtA []byte) error {
+func (m *FolderDefaults) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3847,48 +4643,15 @@ func (m *Device) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Device: wiretype end group for non-group")
+			return fmt.Errorf("proto: FolderDefaults: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Device: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FolderDefaults: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowBep
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthBep
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthBep
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.ID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VersioningType", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3916,13 +4679,13 @@ func (m *Device) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.VersioningType = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VersioningParams", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBep
@@ -3932,29 +4695,124 @@ func (m *Device) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthBep
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthBep
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
+			if m.VersioningParams == nil {
+				m.VersioningParams = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowBep
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowBep
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthBep
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthBep
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowBep
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthBep
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthBep
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipBep(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthBep
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.VersioningParams[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Compression", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VersioningCleanupIntervalS", wireType)
 			}
-			m.Compression = 0
+			m.VersioningCleanupIntervalS = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBep
@@ -3964,14 +4822,14 @@ func (m *Device) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Compression |= Compression(b&0x7F) << shift
+				m.VersioningCleanupIntervalS |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CertName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IgnoreLines", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3999,42 +4857,240 @@ func (m *Device) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CertName = string(dAtA[iNdEx:postIndex])
+			m.IgnoreLines = append(m.IgnoreLines, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxSequence", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBep(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.MaxSequence = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowBep
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.MaxSequence |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBep
 			}
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Introducer", wireType)
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowBep
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Device) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBep
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Device: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Device: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBep
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBep
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBep
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBep
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBep
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBep
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBep
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBep
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBep
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Compression", wireType)
+			}
+			m.Compression = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBep
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Compression |= Compression(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CertName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBep
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBep
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBep
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CertName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSequence", wireType)
+			}
+			m.MaxSequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBep
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxSequence |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Introducer", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBep
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -4113,6 +5169,38 @@ func (m *Device) Unmarshal(dAtA []byte) error {
 				m.EncryptionPasswordToken = []byte{}
 			}
 			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexSubtree", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBep
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBep
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBep
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IndexSubtree = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBep(dAtA[iNdEx:])
@@ -4896,11 +5984,11 @@ func (m *FileInfo) Unmarshal(dAtA []byte) error {
 				m.Encrypted = []byte{}
 			}
 			iNdEx = postIndex
-		case 1000:
+		case 20:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LocalFlags", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HashAlgorithm", wireType)
 			}
-			m.LocalFlags = 0
+			m.HashAlgorithm = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBep
@@ -4910,13 +5998,32 @@ func (m *FileInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LocalFlags |= uint32(b&0x7F) << shift
+				m.HashAlgorithm |= BlockHashAlgorithm(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 1001:
-			if wireType != 2 {
+		case 1000:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LocalFlags", wireType)
+			}
+			m.LocalFlags = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBep
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LocalFlags |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 1001:
+			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field VersionHash", wireType)
 			}
 			var byteLen int
@@ -6903,6 +8010,328 @@ func (m *Close) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ConfigPush) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBep
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConfigPush: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConfigPush: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBep
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ID |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Folders", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBep
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBep
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBep
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Folders = append(m.Folders, Folder{})
+			if err := m.Folders[len(m.Folders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Devices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBep
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBep
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBep
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Devices = append(m.Devices, Device{})
+			if err := m.Devices[len(m.Devices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBep(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBep
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UsageReport) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBep
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UsageReport: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UsageReport: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Report", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBep
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBep
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBep
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Report = append(m.Report[:0], dAtA[iNdEx:postIndex]...)
+			if m.Report == nil {
+				m.Report = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBep(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBep
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IndexAck) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBep
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IndexAck: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IndexAck: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Folder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBep
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBep
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBep
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Folder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBep
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBep(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBep
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipBep(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
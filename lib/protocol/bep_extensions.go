@@ -206,7 +206,7 @@ func WinsConflict(f, other FileIntf) bool {
 }
 
 type FileInfoComparison struct {
-	ModTimeWindow   time.Duration
+	ModTime         ModTimeComparison
 	IgnorePerms     bool
 	IgnoreBlocks    bool
 	IgnoreFlags     uint32
@@ -215,7 +215,7 @@ type FileInfoComparison struct {
 }
 
 func (f FileInfo) IsEquivalent(other FileInfo, modTimeWindow time.Duration) bool {
-	return f.isEquivalent(other, FileInfoComparison{ModTimeWindow: modTimeWindow})
+	return f.isEquivalent(other, FileInfoComparison{ModTime: ModTimeComparison{Window: modTimeWindow}})
 }
 
 func (f FileInfo) IsEquivalentOptional(other FileInfo, comp FileInfoComparison) bool {
@@ -232,7 +232,7 @@ func (f FileInfo) IsEquivalentOptional(other FileInfo, comp FileInfoComparison)
 //   - permissions, unless they are ignored
 //
 // A file is not "equivalent", if it has different
-//   - modification time (difference bigger than modTimeWindow)
+//   - modification time (as compared by the ModTimeComparison)
 //   - size
 //   - blocks, unless there are no blocks to compare (scanning)
 //   - os data
@@ -292,7 +292,7 @@ func (f FileInfo) isEquivalent(other FileInfo, comp FileInfoComparison) bool {
 
 	switch f.Type {
 	case FileInfoTypeFile:
-		return f.Size == other.Size && ModTimeEqual(f.ModTime(), other.ModTime(), comp.ModTimeWindow) && (comp.IgnoreBlocks || f.BlocksEqual(other))
+		return f.Size == other.Size && comp.ModTime.Equal(f.ModTime(), other.ModTime()) && (comp.IgnoreBlocks || f.BlocksEqual(other))
 	case FileInfoTypeSymlink:
 		return f.SymlinkTarget == other.SymlinkTarget
 	case FileInfoTypeDirectory:
@@ -302,17 +302,6 @@ func (f FileInfo) isEquivalent(other FileInfo, comp FileInfoComparison) bool {
 	return false
 }
 
-func ModTimeEqual(a, b time.Time, modTimeWindow time.Duration) bool {
-	if a.Equal(b) {
-		return true
-	}
-	diff := a.Sub(b)
-	if diff < 0 {
-		diff *= -1
-	}
-	return diff < modTimeWindow
-}
-
 func PermsEqual(a, b uint32) bool {
 	if build.IsWindows {
 		// There is only writeable and read only, represented for user, group
@@ -0,0 +1,70 @@
+// Copyright (C) 2026 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package protocol
+
+import (
+	"crypto/sha256"
+	"hash"
+
+	"golang.org/x/crypto/blake2b"
+)
+
+var blockHashAlgorithmMarshal = map[BlockHashAlgorithm]string{
+	BlockHashSHA256:  "sha256",
+	BlockHashBLAKE2b: "blake2b",
+}
+
+var blockHashAlgorithmUnmarshal = map[string]BlockHashAlgorithm{
+	"sha256":  BlockHashSHA256,
+	"blake2b": BlockHashBLAKE2b,
+}
+
+func (a BlockHashAlgorithm) String() string {
+	if s, ok := blockHashAlgorithmMarshal[a]; ok {
+		return s
+	}
+	return "unknown"
+}
+
+func (a BlockHashAlgorithm) MarshalText() ([]byte, error) {
+	return []byte(blockHashAlgorithmMarshal[a]), nil
+}
+
+func (a *BlockHashAlgorithm) UnmarshalText(bs []byte) error {
+	*a = blockHashAlgorithmUnmarshal[string(bs)]
+	return nil
+}
+
+// New returns a hash function computing block hashes with the algorithm.
+// Both algorithms give 32 byte hashes. BLAKE2b is considerably faster than
+// SHA-256 on CPUs without SHA instructions, such as many ARM devices.
+func (a BlockHashAlgorithm) New() hash.Hash {
+	if a == BlockHashBLAKE2b {
+		h, _ := blake2b.New256(nil) // only fails for keys that are too long
+		return h
+	}
+	return sha256.New()
+}
+
+// Sum returns the block hash of the data.
+func (a BlockHashAlgorithm) Sum(data []byte) []byte {
+	if a == BlockHashBLAKE2b {
+		sum := blake2b.Sum256(data)
+		return sum[:]
+	}
+	sum := sha256.Sum256(data)
+	return sum[:]
+}
+
+// Extension returns the protocol extension a device must support to use
+// the algorithm, or the empty string for SHA-256 which all devices do.
+func (a BlockHashAlgorithm) Extension() string {
+	if a == BlockHashBLAKE2b {
+		return ExtensionBlockHashBLAKE2b
+	}
+	return ""
+}
@@ -15,6 +15,9 @@ type TestModel struct {
 	fromTemporary bool
 	indexFn       func(string, []FileInfo)
 	ccFn          func(*ClusterConfig)
+	pushFn        func(*ConfigPush)
+	reportFn      func(*UsageReport)
+	ackFn         func(*IndexAck)
 	closedCh      chan struct{}
 	closedErr     error
 }
@@ -65,6 +68,27 @@ func (*TestModel) DownloadProgress(Connection, *DownloadProgress) error {
 	return nil
 }
 
+func (t *TestModel) ConfigPush(_ Connection, push *ConfigPush) error {
+	if t.pushFn != nil {
+		t.pushFn(push)
+	}
+	return nil
+}
+
+func (t *TestModel) UsageReport(_ Connection, report *UsageReport) error {
+	if t.reportFn != nil {
+		t.reportFn(report)
+	}
+	return nil
+}
+
+func (t *TestModel) IndexAck(_ Connection, ack *IndexAck) error {
+	if t.ackFn != nil {
+		t.ackFn(ack)
+	}
+	return nil
+}
+
 func (t *TestModel) closedError() error {
 	select {
 	case <-t.closedCh:
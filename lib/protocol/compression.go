@@ -37,3 +37,58 @@ func (c *Compression) UnmarshalText(bs []byte) error {
 	*c = compressionUnmarshal[string(bs)]
 	return nil
 }
+
+// SupportedMessageCompressions are the message compressions we can decode,
+// as advertised in our Hello. Devices not advertising any only support
+// LZ4.
+var SupportedMessageCompressions = []MessageCompression{MessageCompressionLZ4, MessageCompressionZstd}
+
+var compressionAlgorithmMarshal = map[CompressionAlgorithm]string{
+	CompressionAlgorithmAuto: "auto",
+	CompressionAlgorithmLZ4:  "lz4",
+	CompressionAlgorithmZstd: "zstd",
+}
+
+var compressionAlgorithmUnmarshal = map[string]CompressionAlgorithm{
+	"auto": CompressionAlgorithmAuto,
+	"lz4":  CompressionAlgorithmLZ4,
+	"zstd": CompressionAlgorithmZstd,
+}
+
+func (a CompressionAlgorithm) String() string {
+	if s, ok := compressionAlgorithmMarshal[a]; ok {
+		return s
+	}
+	return "unknown"
+}
+
+func (a CompressionAlgorithm) MarshalText() ([]byte, error) {
+	return []byte(compressionAlgorithmMarshal[a]), nil
+}
+
+func (a *CompressionAlgorithm) UnmarshalText(bs []byte) error {
+	*a = compressionAlgorithmUnmarshal[string(bs)]
+	return nil
+}
+
+// MessageCompression returns the message compression to use towards a
+// device advertising the given compressions in its Hello, on a LAN
+// connection or not. The preferred algorithm is used if the device can
+// decode it, otherwise LZ4 which all devices can.
+func (a CompressionAlgorithm) MessageCompression(remote []MessageCompression, lan bool) MessageCompression {
+	preferred := MessageCompressionZstd
+	switch a {
+	case CompressionAlgorithmLZ4:
+		preferred = MessageCompressionLZ4
+	case CompressionAlgorithmAuto:
+		if lan {
+			preferred = MessageCompressionLZ4
+		}
+	}
+	for _, c := range remote {
+		if c == preferred {
+			return preferred
+		}
+	}
+	return MessageCompressionLZ4
+}
@@ -159,6 +159,18 @@ func (e encryptedModel) ClusterConfig(config *ClusterConfig) error {
 	return e.model.ClusterConfig(config)
 }
 
+func (e encryptedModel) ConfigPush(push *ConfigPush) error {
+	return e.model.ConfigPush(push)
+}
+
+func (e encryptedModel) UsageReport(report *UsageReport) error {
+	return e.model.UsageReport(report)
+}
+
+func (e encryptedModel) IndexAck(ack *IndexAck) error {
+	return e.model.IndexAck(ack)
+}
+
 func (e encryptedModel) Closed(err error) {
 	e.model.Closed(err)
 }
@@ -258,6 +270,18 @@ func (e encryptedConnection) ClusterConfig(config *ClusterConfig) {
 	e.conn.ClusterConfig(config)
 }
 
+func (e encryptedConnection) ConfigPush(ctx context.Context, push *ConfigPush) error {
+	return e.conn.ConfigPush(ctx, push)
+}
+
+func (e encryptedConnection) UsageReport(ctx context.Context, report *UsageReport) error {
+	return e.conn.UsageReport(ctx, report)
+}
+
+func (e encryptedConnection) IndexAck(ctx context.Context, ack *IndexAck) error {
+	return e.conn.IndexAck(ctx, ack)
+}
+
 func (e encryptedConnection) Close(err error) {
 	e.conn.Close(err)
 }
@@ -0,0 +1,76 @@
+// Copyright (C) 2026 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package protocol
+
+import (
+	"slices"
+	"sync"
+)
+
+// Extensions are optional protocol features, such as new message types,
+// known by name. Devices announce the extensions they support in the Hello
+// and those they use for the session in the ClusterConfig, and a feature
+// is only used with a device that announces it in both. That way features
+// can be introduced without all devices being upgraded at once, as older
+// ones simply never announce them.
+const (
+	// ExtensionConfigPush is the ConfigPush message.
+	ExtensionConfigPush = "configPush"
+	// ExtensionUsageReport is the UsageReport message.
+	ExtensionUsageReport = "usageReport"
+	// ExtensionBlockHashBLAKE2b is block hashes computed with BLAKE2b.
+	ExtensionBlockHashBLAKE2b = "blockHashBlake2b"
+	// ExtensionIndexAck is the IndexAck message.
+	ExtensionIndexAck = "indexAck"
+)
+
+var (
+	extensionsMut sync.Mutex
+	extensions    = make(map[string]struct{})
+)
+
+func init() {
+	RegisterExtension(ExtensionConfigPush)
+	RegisterExtension(ExtensionUsageReport)
+	RegisterExtension(ExtensionBlockHashBLAKE2b)
+	RegisterExtension(ExtensionIndexAck)
+}
+
+// RegisterExtension makes the extension supported, to be announced to
+// other devices.
+func RegisterExtension(name string) {
+	if name == "" {
+		panic("bug: registering an extension without a name")
+	}
+	extensionsMut.Lock()
+	extensions[name] = struct{}{}
+	extensionsMut.Unlock()
+}
+
+// SupportedExtensions returns the registered extensions, sorted.
+func SupportedExtensions() []string {
+	extensionsMut.Lock()
+	defer extensionsMut.Unlock()
+	res := make([]string, 0, len(extensions))
+	for name := range extensions {
+		res = append(res, name)
+	}
+	slices.Sort(res)
+	return res
+}
+
+// NegotiateExtensions returns the registered extensions that are in each
+// of the given lists announced by another device, sorted.
+func NegotiateExtensions(announced ...[]string) []string {
+	res := SupportedExtensions()
+	for _, names := range announced {
+		res = slices.DeleteFunc(res, func(name string) bool {
+			return !slices.Contains(names, name)
+		})
+	}
+	return res
+}
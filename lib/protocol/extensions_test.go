@@ -0,0 +1,42 @@
+// Copyright (C) 2026 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package protocol
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestNegotiateExtensions(t *testing.T) {
+	RegisterExtension("testA")
+	RegisterExtension("testB")
+	defer func() {
+		extensionsMut.Lock()
+		delete(extensions, "testA")
+		delete(extensions, "testB")
+		extensionsMut.Unlock()
+	}()
+
+	if supported := SupportedExtensions(); !slices.Contains(supported, ExtensionConfigPush) || !slices.IsSorted(supported) {
+		t.Errorf("unexpected supported extensions %v", supported)
+	}
+
+	cases := []struct {
+		announced [][]string
+		expected  []string
+	}{
+		{[][]string{{"testB", "unknown", "testA"}}, []string{"testA", "testB"}},
+		{[][]string{{"testA", "testB"}, {"testB"}}, []string{"testB"}},
+		{[][]string{{"testA"}, nil}, []string{}},
+		{[][]string{nil}, []string{}},
+	}
+	for _, tc := range cases {
+		if res := NegotiateExtensions(tc.announced...); !slices.Equal(res, tc.expected) {
+			t.Errorf("NegotiateExtensions(%v) == %v, expected %v", tc.announced, res, tc.expected)
+		}
+	}
+}
@@ -0,0 +1,87 @@
+// Copyright (C) 2026 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package protocol
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+)
+
+// The seed corpora are in testdata/fuzz, where go test also picks up
+// anything found by fuzzing, and are run as part of the normal tests. To
+// fuzz, run e.g. go test -fuzz FuzzReadMessage ./lib/protocol.
+
+func FuzzReadMessage(f *testing.F) {
+	for _, msg := range []message{
+		&ClusterConfig{Folders: []Folder{{ID: "default", Devices: []Device{{ID: LocalDeviceID}}}}},
+		&Index{Folder: "default", Files: []FileInfo{{Name: "a/b", Size: 10, Blocks: []BlockInfo{{Size: 10}}}}},
+		&Request{ID: 1, Folder: "default", Name: "a/b", Size: 10},
+		&Response{ID: 1, Data: bytes.Repeat([]byte("data"), 100)},
+		&Ping{},
+	} {
+		for _, compression := range []Compression{CompressionNever, CompressionAlways} {
+			buf := new(bytes.Buffer)
+			c := &rawConnection{cw: &countingWriter{Writer: buf}, compression: compression}
+			if err := c.writeMessage(msg); err != nil {
+				f.Fatal(err)
+			}
+			f.Add(buf.Bytes())
+		}
+	}
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		c := &rawConnection{cr: &countingReader{Reader: bytes.NewReader(data)}}
+		fourByteBuf := make([]byte, 4)
+		for {
+			msg, err := c.readMessage(fourByteBuf)
+			if errors.Is(err, errUnknownMessage) {
+				continue
+			}
+			if err != nil {
+				return
+			}
+
+			// Whatever is accepted must survive a round trip, and still be
+			// within bounds.
+			bs, err := msg.Marshal()
+			if err != nil {
+				t.Fatalf("marshalling accepted %T: %v", msg, err)
+			}
+			again, _ := newMessage(typeOf(msg))
+			if err := again.Unmarshal(bs); err != nil {
+				t.Fatalf("unmarshalling accepted %T: %v", msg, err)
+			}
+			if err := validateMessage(again); err != nil {
+				t.Fatalf("accepted %T out of bounds after a round trip: %v", msg, err)
+			}
+		}
+	})
+}
+
+func FuzzLZ4Decompress(f *testing.F) {
+	f.Add([]byte{})
+	f.Add([]byte{0, 0, 0})
+	f.Add([]byte{0xff, 0xff, 0xff, 0xff, 0})
+	comp := make([]byte, 1024)
+	n, err := lz4Compress(bytes.Repeat([]byte("compressible"), 50), comp)
+	if err != nil {
+		f.Fatal(err)
+	}
+	f.Add(comp[:n])
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		res, err := lz4Decompress(data)
+		if err != nil {
+			return
+		}
+		if len(data) < 4 || uint32(len(res)) != uint32(data[0])<<24|uint32(data[1])<<16|uint32(data[2])<<8|uint32(data[3]) {
+			t.Fatalf("decompressed %d bytes, not the announced length", len(res))
+		}
+		BufferPool.Put(res)
+	})
+}
@@ -0,0 +1,191 @@
+// Copyright (C) 2026 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package protocol
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// ErrMalformedMessage matches the errors closing a connection because the
+// other side sent something that can't be decoded or breaks the protocol,
+// as opposed to network errors and such.
+var ErrMalformedMessage = errors.New("malformed message")
+
+var (
+	errNegativeSize    = errors.New("negative size")
+	errNegativeOffset  = errors.New("negative offset")
+	errInvalidBlock    = errors.New("block with negative offset or size")
+	errTooLarge        = errors.New("size exceeds the maximum message length")
+	errNegativeBlockNo = errors.New("negative block index")
+)
+
+type malformedError struct {
+	err error
+}
+
+func malformed(err error) error {
+	return &malformedError{err: err}
+}
+
+func (e *malformedError) Error() string {
+	return e.err.Error()
+}
+
+func (e *malformedError) Unwrap() error {
+	return e.err
+}
+
+func (*malformedError) Is(target error) bool {
+	return target == ErrMalformedMessage
+}
+
+// validateMessage checks the bounds of the numbers in a message just
+// decoded, which no well behaved device would exceed, so that nothing
+// further on has to deal with negative sizes and the like.
+func validateMessage(msg message) error {
+	switch msg := msg.(type) {
+	case *Index:
+		return validateFileInfos(msg.Files)
+	case *IndexUpdate:
+		return validateFileInfos(msg.Files)
+	case *Request:
+		switch {
+		case msg.Offset < 0:
+			return errNegativeOffset
+		case msg.Size < 0:
+			return errNegativeSize
+		case msg.Size > MaxMessageLen:
+			return errTooLarge
+		case msg.BlockNo < 0:
+			return errNegativeBlockNo
+		}
+	case *DownloadProgress:
+		for _, update := range msg.Updates {
+			if update.BlockSize < 0 {
+				return fmt.Errorf("%q: %w", update.Name, errNegativeSize)
+			}
+			for _, idx := range update.BlockIndexes {
+				if idx < 0 {
+					return fmt.Errorf("%q: %w", update.Name, errNegativeBlockNo)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func validateFileInfos(fs []FileInfo) error {
+	for _, f := range fs {
+		if f.Size < 0 || f.RawBlockSize < 0 || f.EncryptionTrailerSize < 0 {
+			return fmt.Errorf("%q: %w", f.Name, errNegativeSize)
+		}
+		for _, b := range f.Blocks {
+			if b.Offset < 0 || b.Size < 0 {
+				return fmt.Errorf("%q: %w", f.Name, errInvalidBlock)
+			}
+		}
+	}
+	return nil
+}
+
+const (
+	// Devices may send this many malformed messages, after which we back
+	// off from them. One is forgiven per recovery interval.
+	malformedBudget         = 3
+	malformedRecoveryPeriod = 10 * time.Minute
+	malformedMinBackoff     = time.Minute
+	malformedMaxBackoff     = time.Hour
+)
+
+// MalformedBudget keeps track of the connections closed because of
+// malformed messages from each device. A few are forgiven over time, but a
+// device that keeps sending them is backed off from: no connections are
+// made to or accepted from it for a while, which doubles every time it
+// happens again.
+type MalformedBudget struct {
+	timeNow func() time.Time // can be overridden for testing
+
+	mut     sync.Mutex
+	devices map[DeviceID]*malformedDevice
+}
+
+type malformedDevice struct {
+	used    float64 // the part of the budget used, recovering over time
+	updated time.Time
+	backoff time.Duration // the last backoff, doubled on the next
+	until   time.Time
+}
+
+func NewMalformedBudget() *MalformedBudget {
+	return &MalformedBudget{
+		timeNow: time.Now,
+		devices: make(map[DeviceID]*malformedDevice),
+	}
+}
+
+// Record counts a malformed message from the device against its budget.
+// It returns the time until which the device is backed off from, which is
+// zero unless the budget was exceeded.
+func (b *MalformedBudget) Record(device DeviceID) time.Time {
+	b.mut.Lock()
+	defer b.mut.Unlock()
+
+	now := b.timeNow()
+	d := b.recoverLocked(device, now)
+	if d == nil {
+		d = &malformedDevice{updated: now}
+		b.devices[device] = d
+	}
+	d.used++
+	if d.used <= malformedBudget {
+		return time.Time{}
+	}
+
+	d.backoff = min(max(2*d.backoff, malformedMinBackoff), malformedMaxBackoff)
+	d.until = now.Add(d.backoff)
+	// Start over after the backoff with the budget used up, so that the
+	// next malformed message backs off again unless some time has passed.
+	d.used = malformedBudget
+	return d.until
+}
+
+// BackingOff returns true if connections with the device should be
+// avoided, for having sent too many malformed messages.
+func (b *MalformedBudget) BackingOff(device DeviceID) bool {
+	b.mut.Lock()
+	defer b.mut.Unlock()
+
+	now := b.timeNow()
+	d := b.recoverLocked(device, now)
+	return d != nil && now.Before(d.until)
+}
+
+// recoverLocked forgives the device for the time passed since it was last
+// seen, and forgets about it entirely when it is completely forgiven.
+func (b *MalformedBudget) recoverLocked(device DeviceID, now time.Time) *malformedDevice {
+	d, ok := b.devices[device]
+	if !ok {
+		return nil
+	}
+	if now.Before(d.until) {
+		// The budget doesn't recover during the backoff.
+		return d
+	}
+	if d.updated.Before(d.until) {
+		d.updated = d.until
+	}
+	d.used -= float64(now.Sub(d.updated)) / float64(malformedRecoveryPeriod)
+	d.updated = now
+	if d.used <= 0 {
+		delete(b.devices, device)
+		return nil
+	}
+	return d
+}
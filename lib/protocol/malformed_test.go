@@ -0,0 +1,98 @@
+// Copyright (C) 2026 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package protocol
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestReadMalformedMessage(t *testing.T) {
+	t.Parallel()
+
+	read := func(msg message) error {
+		buf := new(bytes.Buffer)
+		c := &rawConnection{cr: &countingReader{Reader: buf}, cw: &countingWriter{Writer: buf}}
+		if err := c.writeMessage(msg); err != nil {
+			t.Fatal(err)
+		}
+		_, err := c.readMessage(make([]byte, 4))
+		return err
+	}
+
+	for _, msg := range []message{
+		&Request{Folder: "default", Name: "a", Size: -1},
+		&Request{Folder: "default", Name: "a", Offset: -1},
+		&IndexUpdate{Folder: "default", Files: []FileInfo{{Name: "a", Size: -1}}},
+		&Index{Folder: "default", Files: []FileInfo{{Name: "a", Blocks: []BlockInfo{{Offset: -1}}}}},
+		&DownloadProgress{Folder: "default", Updates: []FileDownloadProgressUpdate{{Name: "a", BlockIndexes: []int{-1}}}},
+	} {
+		if err := read(msg); !errors.Is(err, ErrMalformedMessage) {
+			t.Errorf("%v: expected a malformed message error, got %v", msg, err)
+		}
+	}
+
+	if err := read(&Request{Folder: "default", Name: "a", Size: 128}); err != nil {
+		t.Error("a well formed request should be accepted:", err)
+	}
+
+	// Running out of data isn't the other side's fault.
+	c := &rawConnection{cr: &countingReader{Reader: bytes.NewReader([]byte{0, 2, 8})}}
+	if _, err := c.readMessage(make([]byte, 4)); err == nil || errors.Is(err, ErrMalformedMessage) {
+		t.Errorf("expected a read error, got %v", err)
+	}
+}
+
+func TestMalformedBudget(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	b := NewMalformedBudget()
+	b.timeNow = func() time.Time { return now }
+
+	// The budget is used up without backing off.
+	for i := 0; i < malformedBudget; i++ {
+		if until := b.Record(LocalDeviceID); !until.IsZero() {
+			t.Fatalf("unexpected backoff after %d malformed messages", i+1)
+		}
+	}
+	if b.BackingOff(LocalDeviceID) {
+		t.Fatal("unexpected backoff within the budget")
+	}
+
+	// Then we back off, for other devices only.
+	if until := b.Record(LocalDeviceID); until != now.Add(malformedMinBackoff) {
+		t.Fatalf("expected backoff until %v, got %v", now.Add(malformedMinBackoff), until)
+	}
+	if !b.BackingOff(LocalDeviceID) {
+		t.Fatal("expected backoff")
+	}
+	if b.BackingOff(EmptyDeviceID) {
+		t.Fatal("unexpected backoff for another device")
+	}
+
+	// After the backoff one more malformed message backs off again, twice
+	// as long.
+	now = now.Add(malformedMinBackoff)
+	if b.BackingOff(LocalDeviceID) {
+		t.Fatal("the backoff should be over")
+	}
+	if until := b.Record(LocalDeviceID); until != now.Add(2*malformedMinBackoff) {
+		t.Fatalf("expected backoff until %v, got %v", now.Add(2*malformedMinBackoff), until)
+	}
+
+	// The budget recovers over time, and the device is forgotten.
+	now = now.Add(2*malformedMinBackoff + malformedBudget*malformedRecoveryPeriod)
+	if b.BackingOff(LocalDeviceID) || len(b.devices) != 0 {
+		t.Fatal("the device should have been forgiven")
+	}
+	if until := b.Record(LocalDeviceID); !until.IsZero() {
+		t.Fatal("unexpected backoff after recovering")
+	}
+}
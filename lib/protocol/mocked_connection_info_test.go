@@ -8,6 +8,16 @@ import (
 )
 
 type mockedConnectionInfo struct {
+	ClockOffsetStub        func() time.Duration
+	clockOffsetMutex       sync.RWMutex
+	clockOffsetArgsForCall []struct {
+	}
+	clockOffsetReturns struct {
+		result1 time.Duration
+	}
+	clockOffsetReturnsOnCall map[int]struct {
+		result1 time.Duration
+	}
 	ConnectionIDStub        func() string
 	connectionIDMutex       sync.RWMutex
 	connectionIDArgsForCall []struct {
@@ -102,6 +112,59 @@ type mockedConnectionInfo struct {
 	invocationsMutex sync.RWMutex
 }
 
+func (fake *mockedConnectionInfo) ClockOffset() time.Duration {
+	fake.clockOffsetMutex.Lock()
+	ret, specificReturn := fake.clockOffsetReturnsOnCall[len(fake.clockOffsetArgsForCall)]
+	fake.clockOffsetArgsForCall = append(fake.clockOffsetArgsForCall, struct {
+	}{})
+	stub := fake.ClockOffsetStub
+	fakeReturns := fake.clockOffsetReturns
+	fake.recordInvocation("ClockOffset", []interface{}{})
+	fake.clockOffsetMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *mockedConnectionInfo) ClockOffsetCallCount() int {
+	fake.clockOffsetMutex.RLock()
+	defer fake.clockOffsetMutex.RUnlock()
+	return len(fake.clockOffsetArgsForCall)
+}
+
+func (fake *mockedConnectionInfo) ClockOffsetCalls(stub func() time.Duration) {
+	fake.clockOffsetMutex.Lock()
+	defer fake.clockOffsetMutex.Unlock()
+	fake.ClockOffsetStub = stub
+}
+
+func (fake *mockedConnectionInfo) ClockOffsetReturns(result1 time.Duration) {
+	fake.clockOffsetMutex.Lock()
+	defer fake.clockOffsetMutex.Unlock()
+	fake.ClockOffsetStub = nil
+	fake.clockOffsetReturns = struct {
+		result1 time.Duration
+	}{result1}
+}
+
+func (fake *mockedConnectionInfo) ClockOffsetReturnsOnCall(i int, result1 time.Duration) {
+	fake.clockOffsetMutex.Lock()
+	defer fake.clockOffsetMutex.Unlock()
+	fake.ClockOffsetStub = nil
+	if fake.clockOffsetReturnsOnCall == nil {
+		fake.clockOffsetReturnsOnCall = make(map[int]struct {
+			result1 time.Duration
+		})
+	}
+	fake.clockOffsetReturnsOnCall[i] = struct {
+		result1 time.Duration
+	}{result1}
+}
+
 func (fake *mockedConnectionInfo) ConnectionID() string {
 	fake.connectionIDMutex.Lock()
 	ret, specificReturn := fake.connectionIDReturnsOnCall[len(fake.connectionIDArgsForCall)]
@@ -582,6 +645,8 @@ func (fake *mockedConnectionInfo) TypeReturnsOnCall(i int, result1 string) {
 func (fake *mockedConnectionInfo) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
+	fake.clockOffsetMutex.RLock()
+	defer fake.clockOffsetMutex.RUnlock()
 	fake.connectionIDMutex.RLock()
 	defer fake.connectionIDMutex.RUnlock()
 	fake.cryptoMutex.RLock()
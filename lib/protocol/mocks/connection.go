@@ -11,6 +11,16 @@ import (
 )
 
 type Connection struct {
+	ClockOffsetStub        func() time.Duration
+	clockOffsetMutex       sync.RWMutex
+	clockOffsetArgsForCall []struct {
+	}
+	clockOffsetReturns struct {
+		result1 time.Duration
+	}
+	clockOffsetReturnsOnCall map[int]struct {
+		result1 time.Duration
+	}
 	CloseStub        func(error)
 	closeMutex       sync.RWMutex
 	closeArgsForCall []struct {
@@ -31,6 +41,18 @@ type Connection struct {
 	clusterConfigArgsForCall []struct {
 		arg1 *protocol.ClusterConfig
 	}
+	ConfigPushStub        func(context.Context, *protocol.ConfigPush) error
+	configPushMutex       sync.RWMutex
+	configPushArgsForCall []struct {
+		arg1 context.Context
+		arg2 *protocol.ConfigPush
+	}
+	configPushReturns struct {
+		result1 error
+	}
+	configPushReturnsOnCall map[int]struct {
+		result1 error
+	}
 	ConnectionIDStub        func() string
 	connectionIDMutex       sync.RWMutex
 	connectionIDArgsForCall []struct {
@@ -89,6 +111,18 @@ type Connection struct {
 	indexReturnsOnCall map[int]struct {
 		result1 error
 	}
+	IndexAckStub        func(context.Context, *protocol.IndexAck) error
+	indexAckMutex       sync.RWMutex
+	indexAckArgsForCall []struct {
+		arg1 context.Context
+		arg2 *protocol.IndexAck
+	}
+	indexAckReturns struct {
+		result1 error
+	}
+	indexAckReturnsOnCall map[int]struct {
+		result1 error
+	}
 	IndexUpdateStub        func(context.Context, *protocol.IndexUpdate) error
 	indexUpdateMutex       sync.RWMutex
 	indexUpdateArgsForCall []struct {
@@ -194,10 +228,75 @@ type Connection struct {
 	typeReturnsOnCall map[int]struct {
 		result1 string
 	}
+	UsageReportStub        func(context.Context, *protocol.UsageReport) error
+	usageReportMutex       sync.RWMutex
+	usageReportArgsForCall []struct {
+		arg1 context.Context
+		arg2 *protocol.UsageReport
+	}
+	usageReportReturns struct {
+		result1 error
+	}
+	usageReportReturnsOnCall map[int]struct {
+		result1 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
 
+func (fake *Connection) ClockOffset() time.Duration {
+	fake.clockOffsetMutex.Lock()
+	ret, specificReturn := fake.clockOffsetReturnsOnCall[len(fake.clockOffsetArgsForCall)]
+	fake.clockOffsetArgsForCall = append(fake.clockOffsetArgsForCall, struct {
+	}{})
+	stub := fake.ClockOffsetStub
+	fakeReturns := fake.clockOffsetReturns
+	fake.recordInvocation("ClockOffset", []interface{}{})
+	fake.clockOffsetMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *Connection) ClockOffsetCallCount() int {
+	fake.clockOffsetMutex.RLock()
+	defer fake.clockOffsetMutex.RUnlock()
+	return len(fake.clockOffsetArgsForCall)
+}
+
+func (fake *Connection) ClockOffsetCalls(stub func() time.Duration) {
+	fake.clockOffsetMutex.Lock()
+	defer fake.clockOffsetMutex.Unlock()
+	fake.ClockOffsetStub = stub
+}
+
+func (fake *Connection) ClockOffsetReturns(result1 time.Duration) {
+	fake.clockOffsetMutex.Lock()
+	defer fake.clockOffsetMutex.Unlock()
+	fake.ClockOffsetStub = nil
+	fake.clockOffsetReturns = struct {
+		result1 time.Duration
+	}{result1}
+}
+
+func (fake *Connection) ClockOffsetReturnsOnCall(i int, result1 time.Duration) {
+	fake.clockOffsetMutex.Lock()
+	defer fake.clockOffsetMutex.Unlock()
+	fake.ClockOffsetStub = nil
+	if fake.clockOffsetReturnsOnCall == nil {
+		fake.clockOffsetReturnsOnCall = make(map[int]struct {
+			result1 time.Duration
+		})
+	}
+	fake.clockOffsetReturnsOnCall[i] = struct {
+		result1 time.Duration
+	}{result1}
+}
+
 func (fake *Connection) Close(arg1 error) {
 	fake.closeMutex.Lock()
 	fake.closeArgsForCall = append(fake.closeArgsForCall, struct {
@@ -315,6 +414,68 @@ func (fake *Connection) ClusterConfigArgsForCall(i int) *protocol.ClusterConfig
 	return argsForCall.arg1
 }
 
+func (fake *Connection) ConfigPush(arg1 context.Context, arg2 *protocol.ConfigPush) error {
+	fake.configPushMutex.Lock()
+	ret, specificReturn := fake.configPushReturnsOnCall[len(fake.configPushArgsForCall)]
+	fake.configPushArgsForCall = append(fake.configPushArgsForCall, struct {
+		arg1 context.Context
+		arg2 *protocol.ConfigPush
+	}{arg1, arg2})
+	stub := fake.ConfigPushStub
+	fakeReturns := fake.configPushReturns
+	fake.recordInvocation("ConfigPush", []interface{}{arg1, arg2})
+	fake.configPushMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *Connection) ConfigPushCallCount() int {
+	fake.configPushMutex.RLock()
+	defer fake.configPushMutex.RUnlock()
+	return len(fake.configPushArgsForCall)
+}
+
+func (fake *Connection) ConfigPushCalls(stub func(context.Context, *protocol.ConfigPush) error) {
+	fake.configPushMutex.Lock()
+	defer fake.configPushMutex.Unlock()
+	fake.ConfigPushStub = stub
+}
+
+func (fake *Connection) ConfigPushArgsForCall(i int) (context.Context, *protocol.ConfigPush) {
+	fake.configPushMutex.RLock()
+	defer fake.configPushMutex.RUnlock()
+	argsForCall := fake.configPushArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *Connection) ConfigPushReturns(result1 error) {
+	fake.configPushMutex.Lock()
+	defer fake.configPushMutex.Unlock()
+	fake.ConfigPushStub = nil
+	fake.configPushReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *Connection) ConfigPushReturnsOnCall(i int, result1 error) {
+	fake.configPushMutex.Lock()
+	defer fake.configPushMutex.Unlock()
+	fake.ConfigPushStub = nil
+	if fake.configPushReturnsOnCall == nil {
+		fake.configPushReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.configPushReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *Connection) ConnectionID() string {
 	fake.connectionIDMutex.Lock()
 	ret, specificReturn := fake.connectionIDReturnsOnCall[len(fake.connectionIDArgsForCall)]
@@ -622,6 +783,68 @@ func (fake *Connection) IndexReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *Connection) IndexAck(arg1 context.Context, arg2 *protocol.IndexAck) error {
+	fake.indexAckMutex.Lock()
+	ret, specificReturn := fake.indexAckReturnsOnCall[len(fake.indexAckArgsForCall)]
+	fake.indexAckArgsForCall = append(fake.indexAckArgsForCall, struct {
+		arg1 context.Context
+		arg2 *protocol.IndexAck
+	}{arg1, arg2})
+	stub := fake.IndexAckStub
+	fakeReturns := fake.indexAckReturns
+	fake.recordInvocation("IndexAck", []interface{}{arg1, arg2})
+	fake.indexAckMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *Connection) IndexAckCallCount() int {
+	fake.indexAckMutex.RLock()
+	defer fake.indexAckMutex.RUnlock()
+	return len(fake.indexAckArgsForCall)
+}
+
+func (fake *Connection) IndexAckCalls(stub func(context.Context, *protocol.IndexAck) error) {
+	fake.indexAckMutex.Lock()
+	defer fake.indexAckMutex.Unlock()
+	fake.IndexAckStub = stub
+}
+
+func (fake *Connection) IndexAckArgsForCall(i int) (context.Context, *protocol.IndexAck) {
+	fake.indexAckMutex.RLock()
+	defer fake.indexAckMutex.RUnlock()
+	argsForCall := fake.indexAckArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *Connection) IndexAckReturns(result1 error) {
+	fake.indexAckMutex.Lock()
+	defer fake.indexAckMutex.Unlock()
+	fake.IndexAckStub = nil
+	fake.indexAckReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *Connection) IndexAckReturnsOnCall(i int, result1 error) {
+	fake.indexAckMutex.Lock()
+	defer fake.indexAckMutex.Unlock()
+	fake.IndexAckStub = nil
+	if fake.indexAckReturnsOnCall == nil {
+		fake.indexAckReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.indexAckReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *Connection) IndexUpdate(arg1 context.Context, arg2 *protocol.IndexUpdate) error {
 	fake.indexUpdateMutex.Lock()
 	ret, specificReturn := fake.indexUpdateReturnsOnCall[len(fake.indexUpdateArgsForCall)]
@@ -1176,15 +1399,81 @@ func (fake *Connection) TypeReturnsOnCall(i int, result1 string) {
 	}{result1}
 }
 
+func (fake *Connection) UsageReport(arg1 context.Context, arg2 *protocol.UsageReport) error {
+	fake.usageReportMutex.Lock()
+	ret, specificReturn := fake.usageReportReturnsOnCall[len(fake.usageReportArgsForCall)]
+	fake.usageReportArgsForCall = append(fake.usageReportArgsForCall, struct {
+		arg1 context.Context
+		arg2 *protocol.UsageReport
+	}{arg1, arg2})
+	stub := fake.UsageReportStub
+	fakeReturns := fake.usageReportReturns
+	fake.recordInvocation("UsageReport", []interface{}{arg1, arg2})
+	fake.usageReportMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *Connection) UsageReportCallCount() int {
+	fake.usageReportMutex.RLock()
+	defer fake.usageReportMutex.RUnlock()
+	return len(fake.usageReportArgsForCall)
+}
+
+func (fake *Connection) UsageReportCalls(stub func(context.Context, *protocol.UsageReport) error) {
+	fake.usageReportMutex.Lock()
+	defer fake.usageReportMutex.Unlock()
+	fake.UsageReportStub = stub
+}
+
+func (fake *Connection) UsageReportArgsForCall(i int) (context.Context, *protocol.UsageReport) {
+	fake.usageReportMutex.RLock()
+	defer fake.usageReportMutex.RUnlock()
+	argsForCall := fake.usageReportArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *Connection) UsageReportReturns(result1 error) {
+	fake.usageReportMutex.Lock()
+	defer fake.usageReportMutex.Unlock()
+	fake.UsageReportStub = nil
+	fake.usageReportReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *Connection) UsageReportReturnsOnCall(i int, result1 error) {
+	fake.usageReportMutex.Lock()
+	defer fake.usageReportMutex.Unlock()
+	fake.UsageReportStub = nil
+	if fake.usageReportReturnsOnCall == nil {
+		fake.usageReportReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.usageReportReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *Connection) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
+	fake.clockOffsetMutex.RLock()
+	defer fake.clockOffsetMutex.RUnlock()
 	fake.closeMutex.RLock()
 	defer fake.closeMutex.RUnlock()
 	fake.closedMutex.RLock()
 	defer fake.closedMutex.RUnlock()
 	fake.clusterConfigMutex.RLock()
 	defer fake.clusterConfigMutex.RUnlock()
+	fake.configPushMutex.RLock()
+	defer fake.configPushMutex.RUnlock()
 	fake.connectionIDMutex.RLock()
 	defer fake.connectionIDMutex.RUnlock()
 	fake.cryptoMutex.RLock()
@@ -1197,6 +1486,8 @@ func (fake *Connection) Invocations() map[string][][]interface{} {
 	defer fake.establishedAtMutex.RUnlock()
 	fake.indexMutex.RLock()
 	defer fake.indexMutex.RUnlock()
+	fake.indexAckMutex.RLock()
+	defer fake.indexAckMutex.RUnlock()
 	fake.indexUpdateMutex.RLock()
 	defer fake.indexUpdateMutex.RUnlock()
 	fake.isLocalMutex.RLock()
@@ -1219,6 +1510,8 @@ func (fake *Connection) Invocations() map[string][][]interface{} {
 	defer fake.transportMutex.RUnlock()
 	fake.typeMutex.RLock()
 	defer fake.typeMutex.RUnlock()
+	fake.usageReportMutex.RLock()
+	defer fake.usageReportMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
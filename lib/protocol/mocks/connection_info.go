@@ -10,6 +10,16 @@ import (
 )
 
 type ConnectionInfo struct {
+	ClockOffsetStub        func() time.Duration
+	clockOffsetMutex       sync.RWMutex
+	clockOffsetArgsForCall []struct {
+	}
+	clockOffsetReturns struct {
+		result1 time.Duration
+	}
+	clockOffsetReturnsOnCall map[int]struct {
+		result1 time.Duration
+	}
 	ConnectionIDStub        func() string
 	connectionIDMutex       sync.RWMutex
 	connectionIDArgsForCall []struct {
@@ -104,6 +114,59 @@ type ConnectionInfo struct {
 	invocationsMutex sync.RWMutex
 }
 
+func (fake *ConnectionInfo) ClockOffset() time.Duration {
+	fake.clockOffsetMutex.Lock()
+	ret, specificReturn := fake.clockOffsetReturnsOnCall[len(fake.clockOffsetArgsForCall)]
+	fake.clockOffsetArgsForCall = append(fake.clockOffsetArgsForCall, struct {
+	}{})
+	stub := fake.ClockOffsetStub
+	fakeReturns := fake.clockOffsetReturns
+	fake.recordInvocation("ClockOffset", []interface{}{})
+	fake.clockOffsetMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *ConnectionInfo) ClockOffsetCallCount() int {
+	fake.clockOffsetMutex.RLock()
+	defer fake.clockOffsetMutex.RUnlock()
+	return len(fake.clockOffsetArgsForCall)
+}
+
+func (fake *ConnectionInfo) ClockOffsetCalls(stub func() time.Duration) {
+	fake.clockOffsetMutex.Lock()
+	defer fake.clockOffsetMutex.Unlock()
+	fake.ClockOffsetStub = stub
+}
+
+func (fake *ConnectionInfo) ClockOffsetReturns(result1 time.Duration) {
+	fake.clockOffsetMutex.Lock()
+	defer fake.clockOffsetMutex.Unlock()
+	fake.ClockOffsetStub = nil
+	fake.clockOffsetReturns = struct {
+		result1 time.Duration
+	}{result1}
+}
+
+func (fake *ConnectionInfo) ClockOffsetReturnsOnCall(i int, result1 time.Duration) {
+	fake.clockOffsetMutex.Lock()
+	defer fake.clockOffsetMutex.Unlock()
+	fake.ClockOffsetStub = nil
+	if fake.clockOffsetReturnsOnCall == nil {
+		fake.clockOffsetReturnsOnCall = make(map[int]struct {
+			result1 time.Duration
+		})
+	}
+	fake.clockOffsetReturnsOnCall[i] = struct {
+		result1 time.Duration
+	}{result1}
+}
+
 func (fake *ConnectionInfo) ConnectionID() string {
 	fake.connectionIDMutex.Lock()
 	ret, specificReturn := fake.connectionIDReturnsOnCall[len(fake.connectionIDArgsForCall)]
@@ -584,6 +647,8 @@ func (fake *ConnectionInfo) TypeReturnsOnCall(i int, result1 string) {
 func (fake *ConnectionInfo) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
+	fake.clockOffsetMutex.RLock()
+	defer fake.clockOffsetMutex.RUnlock()
 	fake.connectionIDMutex.RLock()
 	defer fake.connectionIDMutex.RUnlock()
 	fake.cryptoMutex.RLock()
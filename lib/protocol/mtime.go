@@ -0,0 +1,59 @@
+// Copyright (C) 2026 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package protocol
+
+import "time"
+
+// The offsets by which daylight saving time shifts the clock. It's an hour
+// almost everywhere, and half an hour in a few places.
+var dstOffsets = []time.Duration{30 * time.Minute, time.Hour}
+
+// ModTimeComparison describes how modification times are compared, taking
+// into account the limitations of the filesystem they were read from.
+type ModTimeComparison struct {
+	// Times are equal if they differ by less than the window, usually
+	// the precision with which the filesystem stores modification times.
+	Window time.Duration
+	// If IgnoreDSTOffsets is set, times that differ by a daylight saving
+	// time offset (within the window) are also equal. This is required for
+	// filesystems such as FAT which store times in local time, where all
+	// modification times appear to shift when daylight saving time starts
+	// or ends.
+	IgnoreDSTOffsets bool
+}
+
+// Equal returns true if the two times are to be considered equal.
+func (c ModTimeComparison) Equal(a, b time.Time) bool {
+	if a.Equal(b) {
+		return true
+	}
+	diff := absDuration(a.Sub(b))
+	if diff < c.Window {
+		return true
+	}
+	if c.IgnoreDSTOffsets {
+		for _, offset := range dstOffsets {
+			if absDuration(diff-offset) < c.Window {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// ModTimeEqual returns true if the two times differ by less than the given
+// window.
+func ModTimeEqual(a, b time.Time, modTimeWindow time.Duration) bool {
+	return ModTimeComparison{Window: modTimeWindow}.Equal(a, b)
+}
+
+func absDuration(d time.Duration) time.Duration {
+	if d < 0 {
+		return -d
+	}
+	return d
+}
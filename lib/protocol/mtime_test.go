@@ -0,0 +1,45 @@
+// Copyright (C) 2026 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package protocol
+
+import (
+	"testing"
+	"time"
+)
+
+func TestModTimeComparison(t *testing.T) {
+	base := time.Date(2026, 3, 29, 1, 30, 0, 123456789, time.UTC)
+	exact := ModTimeComparison{}
+	window := ModTimeComparison{Window: 2 * time.Second}
+	fat := ModTimeComparison{Window: 2 * time.Second, IgnoreDSTOffsets: true}
+
+	cases := []struct {
+		comp  ModTimeComparison
+		other time.Time
+		eq    bool
+	}{
+		{exact, base, true},
+		{exact, base.Add(time.Nanosecond), false},
+		{window, base.Truncate(2 * time.Second), true},
+		{window, base.Add(-2 * time.Second), false},
+		{window, base.Add(time.Hour), false},
+		{fat, base.Add(time.Hour), true},
+		{fat, base.Add(-time.Hour).Truncate(2 * time.Second), true},
+		{fat, base.Add(30 * time.Minute), true},
+		{fat, base.Add(2 * time.Hour), false},
+		{fat, base.Add(time.Hour + 3*time.Second), false},
+	}
+
+	for i, tc := range cases {
+		if eq := tc.comp.Equal(base, tc.other); eq != tc.eq {
+			t.Errorf("%d: %+v.Equal(%v, %v) == %v, expected %v", i, tc.comp, base, tc.other, eq, tc.eq)
+		}
+		if eq := tc.comp.Equal(tc.other, base); eq != tc.eq {
+			t.Errorf("%d: %+v.Equal(%v, %v) == %v, expected %v", i, tc.comp, tc.other, base, eq, tc.eq)
+		}
+	}
+}
@@ -24,6 +24,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/klauspost/compress/zstd"
 	lz4 "github.com/pierrec/lz4/v4"
 )
 
@@ -77,9 +78,20 @@ func init() {
 
 // BlockSize returns the block size to use for the given file size
 func BlockSize(fileSize int64) int {
+	return BlockSizeFor(fileSize, DesiredPerFileBlocks)
+}
+
+// BlockSizeFor returns the block size to use for the given file size,
+// aiming for fewer than the given number of blocks per file, or
+// DesiredPerFileBlocks if zero. The block size is still always one of
+// BlockSizes.
+func BlockSizeFor(fileSize int64, desiredBlocks int) int {
+	if desiredBlocks <= 0 {
+		desiredBlocks = DesiredPerFileBlocks
+	}
 	var blockSize int
 	for _, blockSize = range BlockSizes {
-		if fileSize < DesiredPerFileBlocks*int64(blockSize) {
+		if fileSize < int64(desiredBlocks)*int64(blockSize) {
 			break
 		}
 	}
@@ -134,6 +146,12 @@ type Model interface {
 	Closed(conn Connection, err error)
 	// The peer device sent progress updates for the files it is currently downloading
 	DownloadProgress(conn Connection, p *DownloadProgress) error
+	// The peer device pushed configuration for us to apply
+	ConfigPush(conn Connection, push *ConfigPush) error
+	// The peer device forwarded its usage report for us to upload
+	UsageReport(conn Connection, report *UsageReport) error
+	// The peer device processed an index message we sent
+	IndexAck(conn Connection, ack *IndexAck) error
 }
 
 // rawModel is the Model interface, but without the initial Connection
@@ -145,6 +163,9 @@ type rawModel interface {
 	ClusterConfig(*ClusterConfig) error
 	Closed(err error)
 	DownloadProgress(*DownloadProgress) error
+	ConfigPush(*ConfigPush) error
+	UsageReport(*UsageReport) error
+	IndexAck(*IndexAck) error
 }
 
 type RequestResponse interface {
@@ -179,6 +200,18 @@ type Connection interface {
 	// further by the caller.
 	DownloadProgress(ctx context.Context, dp *DownloadProgress)
 
+	// Send a Config Push message to the peer device, which applies it if
+	// it allows us to manage it and the push is approved.
+	ConfigPush(ctx context.Context, push *ConfigPush) error
+
+	// Send a Usage Report message to the peer device, which uploads it
+	// on our behalf if it accepts usage reports from us.
+	UsageReport(ctx context.Context, report *UsageReport) error
+
+	// Send an Index Ack message to the peer device, telling it up to
+	// which sequence we have processed its index for the folder.
+	IndexAck(ctx context.Context, ack *IndexAck) error
+
 	Start()
 	SetFolderPasswords(passwords map[string]string)
 	Close(err error)
@@ -199,6 +232,7 @@ type ConnectionInfo interface {
 	Crypto() string
 	EstablishedAt() time.Time
 	ConnectionID() string
+	ClockOffset() time.Duration
 }
 
 type rawConnection struct {
@@ -229,7 +263,8 @@ type rawConnection struct {
 	closeOnce             sync.Once
 	sendCloseOnce         sync.Once
 	compression           Compression
-	startStopMut          sync.Mutex // start and stop must be serialized
+	messageCompression    MessageCompression // the algorithm used when compressing
+	startStopMut          sync.Mutex         // start and stop must be serialized
 
 	loopWG sync.WaitGroup // Need to ensure no leftover routines in testing
 }
@@ -265,7 +300,7 @@ const (
 // Should not be modified in production code, just for testing.
 var CloseTimeout = 10 * time.Second
 
-func NewConnection(deviceID DeviceID, reader io.Reader, writer io.Writer, closer io.Closer, model Model, connInfo ConnectionInfo, compress Compression, passwords map[string]string, keyGen *KeyGenerator) Connection {
+func NewConnection(deviceID DeviceID, reader io.Reader, writer io.Writer, closer io.Closer, model Model, connInfo ConnectionInfo, compress Compression, msgCompression MessageCompression, passwords map[string]string, keyGen *KeyGenerator) Connection {
 	// We create the wrapper for the model first, as it needs to be passed
 	// in at the lowest level in the stack. At the end of construction,
 	// before returning, we add the connection to cwm so that it can be used
@@ -279,7 +314,7 @@ func NewConnection(deviceID DeviceID, reader io.Reader, writer io.Writer, closer
 
 	// We do the wire format conversion first (outermost) so that the
 	// metadata is in wire format when it reaches the encryption step.
-	rc := newRawConnection(deviceID, reader, writer, closer, em, connInfo, compress)
+	rc := newRawConnection(deviceID, reader, writer, closer, em, connInfo, compress, msgCompression)
 	ec := newEncryptedConnection(rc, rc, em.folderKeys, keyGen)
 	wc := wireFormatConnection{ec}
 
@@ -287,7 +322,7 @@ func NewConnection(deviceID DeviceID, reader io.Reader, writer io.Writer, closer
 	return wc
 }
 
-func newRawConnection(deviceID DeviceID, reader io.Reader, writer io.Writer, closer io.Closer, receiver rawModel, connInfo ConnectionInfo, compress Compression) *rawConnection {
+func newRawConnection(deviceID DeviceID, reader io.Reader, writer io.Writer, closer io.Closer, receiver rawModel, connInfo ConnectionInfo, compress Compression, msgCompression MessageCompression) *rawConnection {
 	idString := deviceID.String()
 	cr := &countingReader{Reader: reader, idString: idString}
 	cw := &countingWriter{Writer: writer, idString: idString}
@@ -310,6 +345,7 @@ func newRawConnection(deviceID DeviceID, reader io.Reader, writer io.Writer, clo
 		dispatcherLoopStopped: make(chan struct{}),
 		closed:                make(chan struct{}),
 		compression:           compress,
+		messageCompression:    msgCompression,
 		loopWG:                sync.WaitGroup{},
 	}
 }
@@ -435,6 +471,21 @@ func (c *rawConnection) DownloadProgress(ctx context.Context, dp *DownloadProgre
 	c.send(ctx, dp, nil)
 }
 
+// ConfigPush sends configuration for the peer to apply.
+func (c *rawConnection) ConfigPush(ctx context.Context, push *ConfigPush) error {
+	return c.sendErr(ctx, push)
+}
+
+// UsageReport forwards a usage report for the peer to upload.
+func (c *rawConnection) UsageReport(ctx context.Context, report *UsageReport) error {
+	return c.sendErr(ctx, report)
+}
+
+// IndexAck acknowledges the index messages received for a folder.
+func (c *rawConnection) IndexAck(ctx context.Context, ack *IndexAck) error {
+	return c.sendErr(ctx, ack)
+}
+
 func (c *rawConnection) ping() bool {
 	return c.send(context.Background(), &Ping{}, nil)
 }
@@ -480,7 +531,7 @@ func (c *rawConnection) dispatcherLoop() (err error) {
 
 		msgContext, err := messageContext(msg)
 		if err != nil {
-			return fmt.Errorf("protocol error: %w", err)
+			return malformed(fmt.Errorf("protocol error: %w", err))
 		}
 		l.Debugf("handle %v message", msgContext)
 
@@ -529,6 +580,15 @@ func (c *rawConnection) dispatcherLoop() (err error) {
 
 		case *DownloadProgress:
 			err = c.model.DownloadProgress(msg)
+
+		case *ConfigPush:
+			err = c.model.ConfigPush(msg)
+
+		case *UsageReport:
+			err = c.model.UsageReport(msg)
+
+		case *IndexAck:
+			err = c.model.IndexAck(msg)
 		}
 		if err != nil {
 			return newHandleError(err, msgContext)
@@ -553,9 +613,9 @@ func (c *rawConnection) readMessageAfterHeader(hdr Header, fourByteBuf []byte) (
 	}
 	msgLen := int32(binary.BigEndian.Uint32(fourByteBuf))
 	if msgLen < 0 {
-		return nil, fmt.Errorf("negative message length %d", msgLen)
+		return nil, malformed(fmt.Errorf("negative message length %d", msgLen))
 	} else if msgLen > MaxMessageLen {
-		return nil, fmt.Errorf("message length %d exceeds maximum %d", msgLen, MaxMessageLen)
+		return nil, malformed(fmt.Errorf("message length %d exceeds maximum %d", msgLen, MaxMessageLen))
 	}
 
 	// Then comes the message
@@ -576,12 +636,21 @@ func (c *rawConnection) readMessageAfterHeader(hdr Header, fourByteBuf []byte) (
 		decomp, err := lz4Decompress(buf)
 		BufferPool.Put(buf)
 		if err != nil {
-			return nil, fmt.Errorf("decompressing message: %w", err)
+			return nil, malformed(fmt.Errorf("decompressing message: %w", err))
+		}
+		buf = decomp
+
+	case MessageCompressionZstd:
+		decomp, err := zstdDecompress(buf)
+		BufferPool.Put(buf)
+		if err != nil {
+			return nil, malformed(fmt.Errorf("decompressing message: %w", err))
 		}
 		buf = decomp
 
 	default:
-		return nil, fmt.Errorf("unknown message compression %d", hdr.Compression)
+		BufferPool.Put(buf)
+		return nil, malformed(fmt.Errorf("unknown message compression %d", hdr.Compression))
 	}
 
 	// ... and is then unmarshalled
@@ -595,10 +664,16 @@ func (c *rawConnection) readMessageAfterHeader(hdr Header, fourByteBuf []byte) (
 	}
 	if err := msg.Unmarshal(buf); err != nil {
 		BufferPool.Put(buf)
-		return nil, fmt.Errorf("unmarshalling message: %w", err)
+		return nil, malformed(fmt.Errorf("unmarshalling message: %w", err))
 	}
 	BufferPool.Put(buf)
 
+	// ... and must be within bounds.
+
+	if err := validateMessage(msg); err != nil {
+		return nil, malformed(fmt.Errorf("invalid %T message: %w", msg, err))
+	}
+
 	return msg, nil
 }
 
@@ -610,7 +685,7 @@ func (c *rawConnection) readHeader(fourByteBuf []byte) (Header, error) {
 	}
 	hdrLen := int16(binary.BigEndian.Uint16(fourByteBuf))
 	if hdrLen < 0 {
-		return Header{}, fmt.Errorf("negative header length %d", hdrLen)
+		return Header{}, malformed(fmt.Errorf("negative header length %d", hdrLen))
 	}
 
 	// Then comes the header
@@ -625,7 +700,7 @@ func (c *rawConnection) readHeader(fourByteBuf []byte) (Header, error) {
 	err := hdr.Unmarshal(buf)
 	BufferPool.Put(buf)
 	if err != nil {
-		return Header{}, fmt.Errorf("unmarshalling header: %w", err)
+		return Header{}, malformed(fmt.Errorf("unmarshalling header: %w", err))
 	}
 
 	metricDeviceRecvDecompressedBytes.WithLabelValues(c.idString).Add(float64(2 + len(buf)))
@@ -747,6 +822,20 @@ func (c *rawConnection) send(ctx context.Context, msg message, done chan struct{
 	return false
 }
 
+// sendErr is send for messages that don't signal completion, returning
+// why the message couldn't be queued.
+func (c *rawConnection) sendErr(ctx context.Context, msg message) error {
+	if !c.send(ctx, msg, nil) {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		default:
+			return ErrClosed
+		}
+	}
+	return nil
+}
+
 func (c *rawConnection) writerLoop() {
 	select {
 	case cc := <-c.clusterConfigBox:
@@ -861,9 +950,14 @@ func (c *rawConnection) writeMessage(msg message) error {
 // The first return value indicates whether compression succeeded.
 // If not, the caller should retry without compression.
 func (c *rawConnection) writeCompressedMessage(msg message, marshaled []byte) (ok bool, err error) {
+	// Zstd when negotiated, otherwise LZ4 which all devices support.
+	compression := MessageCompressionLZ4
+	if c.messageCompression == MessageCompressionZstd {
+		compression = MessageCompressionZstd
+	}
 	hdr := Header{
 		Type:        typeOf(msg),
-		Compression: MessageCompressionLZ4,
+		Compression: compression,
 	}
 	hdrSize := hdr.ProtoSize()
 	if hdrSize > 1<<16-1 {
@@ -881,7 +975,12 @@ func (c *rawConnection) writeCompressedMessage(msg message, marshaled []byte) (o
 	buf := BufferPool.Get(maxCompressed)
 	defer BufferPool.Put(buf)
 
-	compressedSize, err := lz4Compress(marshaled, buf[cOverhead:])
+	var compressedSize int
+	if compression == MessageCompressionZstd {
+		compressedSize, err = zstdCompress(marshaled, buf[cOverhead:])
+	} else {
+		compressedSize, err = lz4Compress(marshaled, buf[cOverhead:])
+	}
 	totSize := compressedSize + cOverhead
 	if err != nil {
 		return false, nil
@@ -922,6 +1021,12 @@ func typeOf(msg message) MessageType {
 		return MessageTypePing
 	case *Close:
 		return MessageTypeClose
+	case *ConfigPush:
+		return MessageTypeConfigPush
+	case *UsageReport:
+		return MessageTypeUsageReport
+	case *IndexAck:
+		return MessageTypeIndexAck
 	default:
 		panic("bug: unknown message type")
 	}
@@ -945,6 +1050,12 @@ func newMessage(t MessageType) (message, error) {
 		return new(Ping), nil
 	case MessageTypeClose:
 		return new(Close), nil
+	case MessageTypeConfigPush:
+		return new(ConfigPush), nil
+	case MessageTypeUsageReport:
+		return new(UsageReport), nil
+	case MessageTypeIndexAck:
+		return new(IndexAck), nil
 	default:
 		return nil, errUnknownMessage
 	}
@@ -1107,7 +1218,13 @@ func lz4Compress(src, buf []byte) (int, error) {
 }
 
 func lz4Decompress(src []byte) ([]byte, error) {
+	if len(src) < 4 {
+		return nil, errors.New("compressed data too short")
+	}
 	size := binary.BigEndian.Uint32(src)
+	if size > MaxMessageLen {
+		return nil, fmt.Errorf("decompressed length %d exceeds maximum %d", size, MaxMessageLen)
+	}
 	buf := BufferPool.Get(int(size))
 
 	n, err := lz4.UncompressBlock(src[4:], buf)
@@ -1115,12 +1232,62 @@ func lz4Decompress(src []byte) ([]byte, error) {
 		BufferPool.Put(buf)
 		return nil, err
 	}
+	if n != int(size) {
+		BufferPool.Put(buf)
+		return nil, fmt.Errorf("decompressed length %d, expected %d", n, size)
+	}
 
 	return buf[:n], nil
 }
 
+var (
+	zstdEncoder, _ = zstd.NewWriter(nil, zstd.WithEncoderConcurrency(1), zstd.WithLowerEncoderMem(true))
+	zstdDecoder, _ = zstd.NewReader(nil, zstd.WithDecoderConcurrency(0), zstd.WithDecoderMaxMemory(MaxMessageLen))
+)
+
+// zstdCompress compresses like lz4Compress, prefixing the zstd frame by the
+// size of the uncompressed data.
+func zstdCompress(src, buf []byte) (int, error) {
+	if len(buf) < 4 {
+		return -1, errNotCompressible
+	}
+	out := zstdEncoder.EncodeAll(src, buf[4:4])
+	if len(out) > len(buf)-4 {
+		return -1, errNotCompressible
+	}
+	// EncodeAll may have grown the buffer even though the result fits.
+	copy(buf[4:], out)
+
+	binary.BigEndian.PutUint32(buf, uint32(len(src)))
+
+	return len(out) + 4, nil
+}
+
+func zstdDecompress(src []byte) ([]byte, error) {
+	if len(src) < 4 {
+		return nil, errors.New("compressed data too short")
+	}
+	size := binary.BigEndian.Uint32(src)
+	if size > MaxMessageLen {
+		return nil, fmt.Errorf("decompressed length %d exceeds maximum %d", size, MaxMessageLen)
+	}
+	buf := BufferPool.Get(int(size))
+
+	out, err := zstdDecoder.DecodeAll(src[4:], buf[:0])
+	if err != nil {
+		BufferPool.Put(buf)
+		return nil, err
+	}
+	if len(out) != int(size) {
+		BufferPool.Put(buf)
+		return nil, fmt.Errorf("decompressed length %d, expected %d", len(out), size)
+	}
+
+	return out, nil
+}
+
 func newProtocolError(err error, msgContext string) error {
-	return fmt.Errorf("protocol error on %v: %w", msgContext, err)
+	return malformed(fmt.Errorf("protocol error on %v: %w", msgContext, err))
 }
 
 func newHandleError(err error, msgContext string) error {
@@ -1145,6 +1312,12 @@ func messageContext(msg message) (string, error) {
 		return "ping", nil
 	case *Close:
 		return "close", nil
+	case *ConfigPush:
+		return "config-push", nil
+	case *UsageReport:
+		return "usage-report", nil
+	case *IndexAck:
+		return fmt.Sprintf("index-ack for %v", msg.Folder), nil
 	default:
 		return "", errors.New("unknown or empty message")
 	}
@@ -1181,3 +1354,15 @@ func (c *connectionWrappingModel) Closed(err error) {
 func (c *connectionWrappingModel) DownloadProgress(p *DownloadProgress) error {
 	return c.model.DownloadProgress(c.conn, p)
 }
+
+func (c *connectionWrappingModel) ConfigPush(push *ConfigPush) error {
+	return c.model.ConfigPush(c.conn, push)
+}
+
+func (c *connectionWrappingModel) UsageReport(report *UsageReport) error {
+	return c.model.UsageReport(c.conn, report)
+}
+
+func (c *connectionWrappingModel) IndexAck(ack *IndexAck) error {
+	return c.model.IndexAck(c.conn, ack)
+}
@@ -6,6 +6,7 @@ import (
 	"bytes"
 	"context"
 	"crypto/sha256"
+	"encoding/binary"
 	"encoding/hex"
 	"encoding/json"
 	"errors"
@@ -32,10 +33,10 @@ func TestPing(t *testing.T) {
 	ar, aw := io.Pipe()
 	br, bw := io.Pipe()
 
-	c0 := getRawConnection(NewConnection(c0ID, ar, bw, testutil.NoopCloser{}, newTestModel(), new(mockedConnectionInfo), CompressionAlways, nil, testKeyGen))
+	c0 := getRawConnection(NewConnection(c0ID, ar, bw, testutil.NoopCloser{}, newTestModel(), new(mockedConnectionInfo), CompressionAlways, MessageCompressionLZ4, nil, testKeyGen))
 	c0.Start()
 	defer closeAndWait(c0, ar, bw)
-	c1 := getRawConnection(NewConnection(c1ID, br, aw, testutil.NoopCloser{}, newTestModel(), new(mockedConnectionInfo), CompressionAlways, nil, testKeyGen))
+	c1 := getRawConnection(NewConnection(c1ID, br, aw, testutil.NoopCloser{}, newTestModel(), new(mockedConnectionInfo), CompressionAlways, MessageCompressionLZ4, nil, testKeyGen))
 	c1.Start()
 	defer closeAndWait(c1, ar, bw)
 	c0.ClusterConfig(&ClusterConfig{})
@@ -58,10 +59,10 @@ func TestClose(t *testing.T) {
 	ar, aw := io.Pipe()
 	br, bw := io.Pipe()
 
-	c0 := getRawConnection(NewConnection(c0ID, ar, bw, testutil.NoopCloser{}, m0, new(mockedConnectionInfo), CompressionAlways, nil, testKeyGen))
+	c0 := getRawConnection(NewConnection(c0ID, ar, bw, testutil.NoopCloser{}, m0, new(mockedConnectionInfo), CompressionAlways, MessageCompressionLZ4, nil, testKeyGen))
 	c0.Start()
 	defer closeAndWait(c0, ar, bw)
-	c1 := NewConnection(c1ID, br, aw, testutil.NoopCloser{}, m1, new(mockedConnectionInfo), CompressionAlways, nil, testKeyGen)
+	c1 := NewConnection(c1ID, br, aw, testutil.NoopCloser{}, m1, new(mockedConnectionInfo), CompressionAlways, MessageCompressionLZ4, nil, testKeyGen)
 	c1.Start()
 	defer closeAndWait(c1, ar, bw)
 	c0.ClusterConfig(&ClusterConfig{})
@@ -103,7 +104,7 @@ func TestCloseOnBlockingSend(t *testing.T) {
 	m := newTestModel()
 
 	rw := testutil.NewBlockingRW()
-	c := getRawConnection(NewConnection(c0ID, rw, rw, testutil.NoopCloser{}, m, new(mockedConnectionInfo), CompressionAlways, nil, testKeyGen))
+	c := getRawConnection(NewConnection(c0ID, rw, rw, testutil.NoopCloser{}, m, new(mockedConnectionInfo), CompressionAlways, MessageCompressionLZ4, nil, testKeyGen))
 	c.Start()
 	defer closeAndWait(c, rw)
 
@@ -154,10 +155,10 @@ func TestCloseRace(t *testing.T) {
 	ar, aw := io.Pipe()
 	br, bw := io.Pipe()
 
-	c0 := getRawConnection(NewConnection(c0ID, ar, bw, testutil.NoopCloser{}, m0, new(mockedConnectionInfo), CompressionNever, nil, testKeyGen))
+	c0 := getRawConnection(NewConnection(c0ID, ar, bw, testutil.NoopCloser{}, m0, new(mockedConnectionInfo), CompressionNever, MessageCompressionLZ4, nil, testKeyGen))
 	c0.Start()
 	defer closeAndWait(c0, ar, bw)
-	c1 := NewConnection(c1ID, br, aw, testutil.NoopCloser{}, m1, new(mockedConnectionInfo), CompressionNever, nil, testKeyGen)
+	c1 := NewConnection(c1ID, br, aw, testutil.NoopCloser{}, m1, new(mockedConnectionInfo), CompressionNever, MessageCompressionLZ4, nil, testKeyGen)
 	c1.Start()
 	defer closeAndWait(c1, ar, bw)
 	c0.ClusterConfig(&ClusterConfig{})
@@ -194,7 +195,7 @@ func TestClusterConfigFirst(t *testing.T) {
 	m := newTestModel()
 
 	rw := testutil.NewBlockingRW()
-	c := getRawConnection(NewConnection(c0ID, rw, &testutil.NoopRW{}, testutil.NoopCloser{}, m, new(mockedConnectionInfo), CompressionAlways, nil, testKeyGen))
+	c := getRawConnection(NewConnection(c0ID, rw, &testutil.NoopRW{}, testutil.NoopCloser{}, m, new(mockedConnectionInfo), CompressionAlways, MessageCompressionLZ4, nil, testKeyGen))
 	c.Start()
 	defer closeAndWait(c, rw)
 
@@ -234,6 +235,111 @@ func TestClusterConfigFirst(t *testing.T) {
 	}
 }
 
+func TestConfigPush(t *testing.T) {
+	received := make(chan *ConfigPush, 1)
+	m0 := newTestModel()
+	m0.pushFn = func(push *ConfigPush) {
+		received <- push
+	}
+	m1 := newTestModel()
+
+	ar, aw := io.Pipe()
+	br, bw := io.Pipe()
+
+	c0 := NewConnection(c0ID, ar, bw, testutil.NoopCloser{}, m0, new(mockedConnectionInfo), CompressionNever, MessageCompressionLZ4, nil, testKeyGen)
+	c0.Start()
+	defer closeAndWait(c0, ar, bw)
+	c1 := NewConnection(c1ID, br, aw, testutil.NoopCloser{}, m1, new(mockedConnectionInfo), CompressionNever, MessageCompressionLZ4, nil, testKeyGen)
+	c1.Start()
+	defer closeAndWait(c1, ar, bw)
+	c0.ClusterConfig(&ClusterConfig{})
+	c1.ClusterConfig(&ClusterConfig{})
+
+	push := &ConfigPush{
+		ID:      42,
+		Folders: []Folder{{ID: "default", Label: "Default", Devices: []Device{{ID: c1ID}}}},
+		Devices: []Device{{ID: c1ID, Name: "admin", Addresses: []string{"dynamic"}}},
+	}
+	if err := c1.ConfigPush(context.Background(), push); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case got := <-received:
+		if got.ID != 42 || len(got.Folders) != 1 || got.Folders[0].Devices[0].ID != c1ID || got.Devices[0].Name != "admin" {
+			t.Errorf("unexpected push %v", got)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("timed out before receiving config push")
+	}
+}
+
+func TestUsageReport(t *testing.T) {
+	received := make(chan *UsageReport, 1)
+	m0 := newTestModel()
+	m0.reportFn = func(report *UsageReport) {
+		received <- report
+	}
+	m1 := newTestModel()
+
+	ar, aw := io.Pipe()
+	br, bw := io.Pipe()
+
+	c0 := NewConnection(c0ID, ar, bw, testutil.NoopCloser{}, m0, new(mockedConnectionInfo), CompressionNever, MessageCompressionLZ4, nil, testKeyGen)
+	c0.Start()
+	defer closeAndWait(c0, ar, bw)
+	c1 := NewConnection(c1ID, br, aw, testutil.NoopCloser{}, m1, new(mockedConnectionInfo), CompressionNever, MessageCompressionLZ4, nil, testKeyGen)
+	c1.Start()
+	defer closeAndWait(c1, ar, bw)
+	c0.ClusterConfig(&ClusterConfig{})
+	c1.ClusterConfig(&ClusterConfig{})
+
+	report := []byte(`{"uniqueID":"abcd1234"}`)
+	if err := c1.UsageReport(context.Background(), &UsageReport{Report: report}); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case got := <-received:
+		if !bytes.Equal(got.Report, report) {
+			t.Errorf("unexpected report %s", got.Report)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("timed out before receiving usage report")
+	}
+}
+
+func TestIndexAck(t *testing.T) {
+	received := make(chan *IndexAck, 1)
+	m0 := newTestModel()
+	m0.ackFn = func(ack *IndexAck) {
+		received <- ack
+	}
+	m1 := newTestModel()
+
+	ar, aw := io.Pipe()
+	br, bw := io.Pipe()
+
+	c0 := NewConnection(c0ID, ar, bw, testutil.NoopCloser{}, m0, new(mockedConnectionInfo), CompressionNever, MessageCompressionLZ4, nil, testKeyGen)
+	c0.Start()
+	defer closeAndWait(c0, ar, bw)
+	c1 := NewConnection(c1ID, br, aw, testutil.NoopCloser{}, m1, new(mockedConnectionInfo), CompressionNever, MessageCompressionLZ4, nil, testKeyGen)
+	c1.Start()
+	defer closeAndWait(c1, ar, bw)
+	c0.ClusterConfig(&ClusterConfig{})
+	c1.ClusterConfig(&ClusterConfig{})
+
+	if err := c1.IndexAck(context.Background(), &IndexAck{Folder: "default", Sequence: 42}); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case got := <-received:
+		if got.Folder != "default" || got.Sequence != 42 {
+			t.Errorf("unexpected ack %+v", got)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("timed out before receiving index ack")
+	}
+}
+
 // TestCloseTimeout checks that calling Close times out and proceeds, if sending
 // the close message does not succeed.
 func TestCloseTimeout(t *testing.T) {
@@ -246,7 +352,7 @@ func TestCloseTimeout(t *testing.T) {
 	m := newTestModel()
 
 	rw := testutil.NewBlockingRW()
-	c := getRawConnection(NewConnection(c0ID, rw, rw, testutil.NoopCloser{}, m, new(mockedConnectionInfo), CompressionAlways, nil, testKeyGen))
+	c := getRawConnection(NewConnection(c0ID, rw, rw, testutil.NoopCloser{}, m, new(mockedConnectionInfo), CompressionAlways, MessageCompressionLZ4, nil, testKeyGen))
 	c.Start()
 	defer closeAndWait(c, rw)
 
@@ -348,10 +454,21 @@ func TestMarshalClusterConfigMessage(t *testing.T) {
 		if len(m1.Folders) == 0 {
 			m1.Folders = nil
 		}
+		if len(m1.Extensions) == 0 {
+			m1.Extensions = nil
+		}
 		for i := range m1.Folders {
 			if len(m1.Folders[i].Devices) == 0 {
 				m1.Folders[i].Devices = nil
 			}
+			defaults := &m1.Folders[i].Defaults
+			if len(defaults.VersioningParams) == 0 {
+				defaults.VersioningParams = nil
+			}
+			if len(defaults.IgnoreLines) == 0 {
+				defaults.IgnoreLines = nil
+			}
+			defaults.VersioningCleanupIntervalS = int(int32(defaults.VersioningCleanupIntervalS))
 			for j := range m1.Folders[i].Devices {
 				if len(m1.Folders[i].Devices[j].Addresses) == 0 {
 					m1.Folders[i].Devices[j].Addresses = nil
@@ -441,12 +558,22 @@ func testMarshal(t *testing.T, prefix string, m1, m2 message) bool {
 }
 
 func TestWriteCompressed(t *testing.T) {
-	for _, random := range []bool{false, true} {
+	for _, tc := range []struct {
+		random      bool
+		compression MessageCompression
+	}{
+		{false, MessageCompressionLZ4},
+		{true, MessageCompressionLZ4},
+		{false, MessageCompressionZstd},
+		{true, MessageCompressionZstd},
+	} {
+		random := tc.random
 		buf := new(bytes.Buffer)
 		c := &rawConnection{
-			cr:          &countingReader{Reader: buf},
-			cw:          &countingWriter{Writer: buf},
-			compression: CompressionAlways,
+			cr:                 &countingReader{Reader: buf},
+			cw:                 &countingWriter{Writer: buf},
+			compression:        CompressionAlways,
+			messageCompression: tc.compression,
 		}
 
 		msg := &Response{Data: make([]byte, 10240)}
@@ -506,6 +633,73 @@ func TestLZ4Compression(t *testing.T) {
 	}
 }
 
+func TestZstdCompression(t *testing.T) {
+	for i := 0; i < 10; i++ {
+		dataLen := 150 + rand.Intn(150)
+		data := make([]byte, dataLen)
+		_, err := io.ReadFull(rand.Reader, data[100:])
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		comp := make([]byte, 4+dataLen)
+		compLen, err := zstdCompress(data, comp)
+		if err != nil {
+			t.Errorf("compressing %d bytes: %v", dataLen, err)
+			continue
+		}
+
+		res, err := zstdDecompress(comp[:compLen])
+		if err != nil {
+			t.Errorf("decompressing %d bytes to %d: %v", compLen, dataLen, err)
+			continue
+		}
+		if !bytes.Equal(data, res) {
+			t.Error("Incorrect decompressed data")
+		}
+	}
+
+	// Random data doesn't fit in less space.
+	data := make([]byte, 1024)
+	_, _ = io.ReadFull(rand.Reader, data)
+	if _, err := zstdCompress(data, make([]byte, 4+len(data)-len(data)/32)); err != errNotCompressible {
+		t.Errorf("expected %v, got %v", errNotCompressible, err)
+	}
+
+	// The claimed size must match.
+	comp := make([]byte, 4+len(data)*2)
+	n, err := zstdCompress(data, comp)
+	if err != nil {
+		t.Fatal(err)
+	}
+	binary.BigEndian.PutUint32(comp, uint32(len(data)-1))
+	if _, err := zstdDecompress(comp[:n]); err == nil {
+		t.Error("expected an error for the wrong decompressed length")
+	}
+}
+
+func TestCompressionAlgorithmNegotiation(t *testing.T) {
+	legacy := []MessageCompression(nil)
+	cases := []struct {
+		algo   CompressionAlgorithm
+		remote []MessageCompression
+		lan    bool
+		expect MessageCompression
+	}{
+		{CompressionAlgorithmAuto, SupportedMessageCompressions, false, MessageCompressionZstd},
+		{CompressionAlgorithmAuto, SupportedMessageCompressions, true, MessageCompressionLZ4},
+		{CompressionAlgorithmAuto, legacy, false, MessageCompressionLZ4},
+		{CompressionAlgorithmZstd, SupportedMessageCompressions, true, MessageCompressionZstd},
+		{CompressionAlgorithmZstd, legacy, false, MessageCompressionLZ4},
+		{CompressionAlgorithmLZ4, SupportedMessageCompressions, false, MessageCompressionLZ4},
+	}
+	for _, tc := range cases {
+		if res := tc.algo.MessageCompression(tc.remote, tc.lan); res != tc.expect {
+			t.Errorf("%v with %v, lan %v: got %v, expected %v", tc.algo, tc.remote, tc.lan, res, tc.expect)
+		}
+	}
+}
+
 func TestLZ4CompressionUpdate(t *testing.T) {
 	uncompressed := []byte("this is some arbitrary yet fairly compressible data")
 
@@ -655,6 +849,27 @@ func TestBlockSize(t *testing.T) {
 	}
 }
 
+func TestBlockSizeFor(t *testing.T) {
+	cases := []struct {
+		fileSize  int64
+		blocks    int
+		blockSize int
+	}{
+		{1 << GiB, 0, 1 << MiB},
+		{1 << GiB, 100, 16 << MiB},
+		{100 << MiB, 100, 2 << MiB},
+		{1 << MiB, 1, 2 << MiB},
+		{500 << GiB, 1 << 20, 512 << KiB},
+	}
+
+	for _, tc := range cases {
+		size := BlockSizeFor(tc.fileSize, tc.blocks)
+		if size != tc.blockSize {
+			t.Errorf("BlockSizeFor(%d, %d), size=%d, expected %d", tc.fileSize, tc.blocks, size, tc.blockSize)
+		}
+	}
+}
+
 var blockSize int
 
 func BenchmarkBlockSize(b *testing.B) {
@@ -899,7 +1114,7 @@ func TestClusterConfigAfterClose(t *testing.T) {
 	m := newTestModel()
 
 	rw := testutil.NewBlockingRW()
-	c := getRawConnection(NewConnection(c0ID, rw, rw, testutil.NoopCloser{}, m, new(mockedConnectionInfo), CompressionAlways, nil, testKeyGen))
+	c := getRawConnection(NewConnection(c0ID, rw, rw, testutil.NoopCloser{}, m, new(mockedConnectionInfo), CompressionAlways, MessageCompressionLZ4, nil, testKeyGen))
 	c.Start()
 	defer closeAndWait(c, rw)
 
@@ -923,7 +1138,7 @@ func TestDispatcherToCloseDeadlock(t *testing.T) {
 	// the model callbacks (ClusterConfig).
 	m := newTestModel()
 	rw := testutil.NewBlockingRW()
-	c := getRawConnection(NewConnection(c0ID, rw, &testutil.NoopRW{}, testutil.NoopCloser{}, m, new(mockedConnectionInfo), CompressionAlways, nil, testKeyGen))
+	c := getRawConnection(NewConnection(c0ID, rw, &testutil.NoopRW{}, testutil.NoopCloser{}, m, new(mockedConnectionInfo), CompressionAlways, MessageCompressionLZ4, nil, testKeyGen))
 	m.ccFn = func(*ClusterConfig) {
 		c.Close(errManual)
 	}
@@ -19,6 +19,36 @@ import (
 	"github.com/syncthing/syncthing/lib/relay/protocol"
 )
 
+// The number of relays from the top of the list to probe at once.
+const relayProbeParallelism = 3
+
+// lastRelays holds the relay last joined from each pool, to try first when
+// the client is restarted.
+var (
+	lastRelays    = make(map[string]string)
+	lastRelaysMut sync.Mutex
+)
+
+func getLastRelay(pool *url.URL) string {
+	lastRelaysMut.Lock()
+	defer lastRelaysMut.Unlock()
+	return lastRelays[pool.String()]
+}
+
+func setLastRelay(pool *url.URL, addr string) {
+	lastRelaysMut.Lock()
+	lastRelays[pool.String()] = addr
+	lastRelaysMut.Unlock()
+}
+
+func clearLastRelay(pool *url.URL, addr string) {
+	lastRelaysMut.Lock()
+	if lastRelays[pool.String()] == addr {
+		delete(lastRelays, pool.String())
+	}
+	lastRelaysMut.Unlock()
+}
+
 type dynamicClient struct {
 	commonClient
 
@@ -41,69 +71,144 @@ func newDynamicClient(uri *url.URL, certs []tls.Certificate, invitations chan pr
 }
 
 func (c *dynamicClient) serve(ctx context.Context) error {
-	uri := *c.pooladdr
-
-	// Trim off the `dynamic+` prefix
-	uri.Scheme = uri.Scheme[8:]
+	// Reconnect to the relay we last used, if it still takes us, without
+	// the whole lookup and latency measurements.
+	if addr := getLastRelay(c.pooladdr); addr != "" {
+		l.Debugln(c, "trying last used relay", addr)
+		if client := c.probeRelays(ctx, []string{addr}); client != nil {
+			c.serveClient(ctx, client)
+		} else {
+			clearLastRelay(c.pooladdr, addr)
+		}
+		if ctx.Err() != nil {
+			return nil
+		}
+	}
 
 	l.Debugln(c, "looking up dynamic relays")
 
-	req, err := http.NewRequestWithContext(ctx, "GET", uri.String(), nil)
+	addrs, err := lookupDynamicRelays(ctx, c.pooladdr)
 	if err != nil {
 		l.Debugln(c, "failed to lookup dynamic relays", err)
 		return err
 	}
+
+	addrs = relayAddressesOrder(ctx, addrs)
+	for len(addrs) > 0 {
+		select {
+		case <-ctx.Done():
+			l.Debugln(c, "stopping")
+			return nil
+		default:
+		}
+
+		n := min(relayProbeParallelism, len(addrs))
+		client := c.probeRelays(ctx, addrs[:n])
+		addrs = addrs[n:]
+		if client != nil {
+			c.serveClient(ctx, client)
+		}
+	}
+	l.Debugln(c, "could not find a connectable relay")
+	return errors.New("could not find a connectable relay")
+}
+
+// lookupDynamicRelays returns the addresses of the relays in the dynamic
+// pool.
+func lookupDynamicRelays(ctx context.Context, pool *url.URL) ([]string, error) {
+	uri := *pool
+
+	// Trim off the `dynamic+` prefix
+	uri.Scheme = uri.Scheme[8:]
+
+	req, err := http.NewRequestWithContext(ctx, "GET", uri.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 	data, err := http.DefaultClient.Do(req)
 	if err != nil {
-		l.Debugln(c, "failed to lookup dynamic relays", err)
-		return err
+		return nil, err
 	}
 
 	var ann dynamicAnnouncement
 	err = json.NewDecoder(data.Body).Decode(&ann)
 	data.Body.Close()
 	if err != nil {
-		l.Debugln(c, "failed to lookup dynamic relays", err)
-		return err
+		return nil, err
 	}
 
 	var addrs []string
 	for _, relayAnn := range ann.Relays {
 		ruri, err := url.Parse(relayAnn.URL)
 		if err != nil {
-			l.Debugln(c, "failed to parse dynamic relay address", relayAnn.URL, err)
+			l.Debugln("failed to parse dynamic relay address", relayAnn.URL, err)
 			continue
 		}
-		l.Debugln(c, "found", ruri)
+		l.Debugln(pool, "found", ruri)
 		addrs = append(addrs, ruri.String())
 	}
+	return addrs, nil
+}
 
-	for _, addr := range relayAddressesOrder(ctx, addrs) {
-		select {
-		case <-ctx.Done():
-			l.Debugln(c, "stopping")
-			return nil
-		default:
+// probeRelays connects to the given relays concurrently, and returns a
+// client joined to the first one that accepts us, or nil if none does.
+// The connections to the others are closed.
+func (c *dynamicClient) probeRelays(ctx context.Context, addrs []string) *staticClient {
+	ctx, cancel := context.WithCancel(ctx)
+	results := make(chan *staticClient, len(addrs))
+	for _, addr := range addrs {
+		go func() {
 			ruri, err := url.Parse(addr)
 			if err != nil {
 				l.Debugln(c, "skipping relay", addr, err)
-				continue
+				results <- nil
+				return
 			}
 			client := newStaticClient(ruri, c.certs, c.invitations, c.timeout)
-			c.mut.Lock()
-			c.client = client
-			c.mut.Unlock()
-
-			err = c.client.Serve(ctx)
-			l.Debugf("Disconnected from %s://%s: %v", c.client.URI().Scheme, c.client.URI().Host, err)
+			if err := client.establish(ctx); err != nil {
+				results <- nil
+				return
+			}
+			results <- client
+		}()
+	}
 
-			c.mut.Lock()
-			c.client = nil
-			c.mut.Unlock()
+	for i := range addrs {
+		client := <-results
+		if client == nil {
+			continue
 		}
+		// Stop the remaining attempts, and close any connections that
+		// succeed regardless once they are done.
+		cancel()
+		go func() {
+			for range addrs[i+1:] {
+				if other := <-results; other != nil {
+					other.disconnect()
+				}
+			}
+		}()
+		return client
 	}
-	l.Debugln(c, "could not find a connectable relay")
-	return errors.New("could not find a connectable relay")
+	cancel()
+	return nil
+}
+
+// serveClient runs the given client, joined to a relay, until it
+// disconnects. The relay is remembered, to be tried first next time.
+func (c *dynamicClient) serveClient(ctx context.Context, client *staticClient) {
+	setLastRelay(c.pooladdr, client.URI().String())
+
+	c.mut.Lock()
+	c.client = client
+	c.mut.Unlock()
+
+	err := client.Serve(ctx)
+	l.Debugf("Disconnected from %s://%s: %v", client.URI().Scheme, client.URI().Host, err)
+
+	c.mut.Lock()
+	c.client = nil
+	c.mut.Unlock()
 }
 
 func (c *dynamicClient) Error() error {
@@ -0,0 +1,128 @@
+// Copyright (C) 2026 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package client
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/syncthing/syncthing/lib/relay/protocol"
+	"github.com/syncthing/syncthing/lib/tlsutil"
+)
+
+// startFakeRelay starts a relay that accepts everyone joining it and then
+// keeps quiet, and returns its address.
+func startFakeRelay(t *testing.T, cert tls.Certificate) string {
+	t.Helper()
+	ln, err := tls.Listen("tcp", "127.0.0.1:0", &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		NextProtos:   []string{protocol.ProtocolName},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { ln.Close() })
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				defer conn.Close()
+				if _, err := protocol.ReadMessage(conn); err != nil {
+					return
+				}
+				if err := protocol.WriteMessage(conn, protocol.Response{}); err != nil {
+					return
+				}
+				_, _ = conn.Read(make([]byte, 1))
+			}()
+		}
+	}()
+	return fmt.Sprintf("relay://%s", ln.Addr())
+}
+
+// deadRelayAddress returns the address of a relay that refuses
+// connections.
+func deadRelayAddress(t *testing.T) string {
+	t.Helper()
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	addr := ln.Addr().String()
+	ln.Close()
+	return "relay://" + addr
+}
+
+func TestDynamicClientProbing(t *testing.T) {
+	cert, err := tlsutil.NewCertificateInMemory("relay", 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	good := startFakeRelay(t, cert)
+	dead1, dead2 := deadRelayAddress(t), deadRelayAddress(t)
+
+	pool := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		fmt.Fprintf(w, `{"relays": [{"url": %q}, {"url": %q}, {"url": %q}]}`, dead1, good, dead2)
+	}))
+	defer pool.Close()
+
+	poolURI, err := url.Parse("dynamic+" + pool.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := newDynamicClient(poolURI, []tls.Certificate{cert}, make(chan protocol.SessionInvitation), 5*time.Second)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	go c.Serve(ctx)
+
+	waitJoined := func() {
+		t.Helper()
+		deadline := time.Now().Add(10 * time.Second)
+		for time.Now().Before(deadline) {
+			if uri := c.URI(); uri != nil {
+				if uri.String() != good {
+					t.Fatalf("joined %v, expected %v", uri, good)
+				}
+				return
+			}
+			time.Sleep(10 * time.Millisecond)
+		}
+		t.Fatal("didn't join a relay")
+	}
+	waitJoined()
+	cancel()
+
+	if last := getLastRelay(poolURI); last != good {
+		t.Errorf("last relay is %q, expected %q", last, good)
+	}
+
+	// A new client rejoins the same relay without the pool.
+	pool.Close()
+	c = newDynamicClient(poolURI, []tls.Certificate{cert}, make(chan protocol.SessionInvitation), 5*time.Second)
+	ctx, cancel = context.WithCancel(context.Background())
+	defer cancel()
+	go c.Serve(ctx)
+	waitJoined()
+}
+
+func TestProbeRelaysNoneAvailable(t *testing.T) {
+	poolURI, _ := url.Parse("dynamic+https://relays.example.com/endpoint")
+	c := newDynamicClient(poolURI, nil, make(chan protocol.SessionInvitation), time.Second)
+	if client := c.probeRelays(context.Background(), []string{deadRelayAddress(t), "relay://[invalid"}); client != nil {
+		t.Errorf("expected no client, got %v", client)
+	}
+}
@@ -5,6 +5,7 @@ package client
 import (
 	"context"
 	"crypto/tls"
+	"errors"
 	"fmt"
 	"net"
 	"net/url"
@@ -69,14 +70,18 @@ func GetInvitationFromRelay(ctx context.Context, uri *url.URL, id syncthingproto
 		if len(ip) == 0 || ip.IsUnspecified() {
 			msg.Address, _ = osutil.IPFromAddr(conn.RemoteAddr())
 		}
+		recordInvitation(invitationAddress(msg))
 		return msg, nil
 	default:
 		return protocol.SessionInvitation{}, fmt.Errorf("protocol error: unexpected message %v", msg)
 	}
 }
 
+// JoinSession joins the relayed connection described by the invitation.
+// Traffic over the returned connection is included in the relay
+// statistics.
 func JoinSession(ctx context.Context, invitation protocol.SessionInvitation) (net.Conn, error) {
-	addr := net.JoinHostPort(net.IP(invitation.Address).String(), strconv.Itoa(int(invitation.Port)))
+	addr := invitationAddress(invitation)
 
 	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
 	defer cancel()
@@ -107,12 +112,16 @@ func JoinSession(ctx context.Context, invitation protocol.SessionInvitation) (ne
 		if msg.Code != 0 {
 			return nil, fmt.Errorf("incorrect response code %d: %s", msg.Code, msg.Message)
 		}
-		return conn, nil
+		return newStatsConn(conn, addr), nil
 	default:
 		return nil, fmt.Errorf("protocol error: expecting response got %v", msg)
 	}
 }
 
+func invitationAddress(invitation protocol.SessionInvitation) string {
+	return net.JoinHostPort(net.IP(invitation.Address).String(), strconv.Itoa(int(invitation.Port)))
+}
+
 func TestRelay(ctx context.Context, uri *url.URL, certs []tls.Certificate, sleep, timeout time.Duration, times int) error {
 	id := syncthingprotocol.NewDeviceID(certs[0].Certificate[0])
 	c, err := NewClient(uri, certs, timeout)
@@ -146,6 +155,42 @@ func TestRelay(ctx context.Context, uri *url.URL, certs []tls.Certificate, sleep
 	return fmt.Errorf("getting invitation: %w", err) // last of the above errors
 }
 
+// CheckRelay checks that the relay can be reached and validates, without
+// joining it, or for a dynamic pool that it lists any relays. It returns a
+// short description of what was found.
+func CheckRelay(ctx context.Context, uri *url.URL, certs []tls.Certificate, timeout time.Duration) (string, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	switch uri.Scheme {
+	case "relay":
+		rconn, err := dialer.DialContext(ctx, "tcp", uri.Host)
+		if err != nil {
+			return "", err
+		}
+		conn := tls.Client(rconn, configForCerts(certs))
+		defer conn.Close()
+		conn.SetDeadline(time.Now().Add(timeout))
+		if err := performHandshakeAndValidation(conn, uri); err != nil {
+			return "", err
+		}
+		return conn.RemoteAddr().String(), nil
+
+	case "dynamic+http", "dynamic+https":
+		addrs, err := lookupDynamicRelays(ctx, uri)
+		if err != nil {
+			return "", err
+		}
+		if len(addrs) == 0 {
+			return "", errors.New("no relays in pool")
+		}
+		return fmt.Sprintf("%d relays in pool", len(addrs)), nil
+
+	default:
+		return "", fmt.Errorf("unsupported relay scheme: %v", uri.Scheme)
+	}
+}
+
 func configForCerts(certs []tls.Certificate) *tls.Config {
 	return &tls.Config{
 		Certificates:           certs,
@@ -47,26 +47,21 @@ func newStaticClient(uri *url.URL, certs []tls.Certificate, invitations chan pro
 }
 
 func (c *staticClient) serve(ctx context.Context) error {
-	if err := c.connect(ctx); err != nil {
-		l.Debugf("Could not connect to relay %s: %s", c.uri, err)
-		return err
+	// The connection may have been established up front, when probing
+	// relays.
+	if c.conn == nil {
+		if err := c.establish(ctx); err != nil {
+			return err
+		}
 	}
-
-	l.Debugln(c, "connected", c.conn.RemoteAddr())
 	defer c.disconnect()
 
-	if err := c.join(); err != nil {
-		l.Debugf("Could not join relay %s: %s", c.uri, err)
-		return err
-	}
-
-	if err := c.conn.SetDeadline(time.Time{}); err != nil {
-		l.Debugln("Relay set deadline:", err)
-		return err
-	}
-
 	l.Infof("Joined relay %s://%s", c.uri.Scheme, c.uri.Host)
 
+	relayAddr := c.conn.RemoteAddr().String()
+	recordConnected(relayAddr, true)
+	defer recordConnected(relayAddr, false)
+
 	messages := make(chan interface{})
 	errorsc := make(chan error, 1)
 
@@ -93,6 +88,7 @@ func (c *staticClient) serve(ctx context.Context) error {
 				if len(ip) == 0 || ip.IsUnspecified() {
 					msg.Address, _ = osutil.IPFromAddr(c.conn.RemoteAddr())
 				}
+				recordInvitation(invitationAddress(msg))
 				select {
 				case c.invitations <- msg:
 				case <-ctx.Done():
@@ -132,6 +128,30 @@ func (c *staticClient) URI() *url.URL {
 	return c.uri
 }
 
+// establish connects to and joins the relay.
+func (c *staticClient) establish(ctx context.Context) error {
+	if err := c.connect(ctx); err != nil {
+		l.Debugf("Could not connect to relay %s: %s", c.uri, err)
+		return err
+	}
+
+	l.Debugln(c, "connected", c.conn.RemoteAddr())
+
+	if err := c.join(); err != nil {
+		l.Debugf("Could not join relay %s: %s", c.uri, err)
+		c.disconnect()
+		return err
+	}
+
+	if err := c.conn.SetDeadline(time.Time{}); err != nil {
+		l.Debugln("Relay set deadline:", err)
+		c.disconnect()
+		return err
+	}
+
+	return nil
+}
+
 func (c *staticClient) connect(ctx context.Context) error {
 	if c.uri.Scheme != "relay" {
 		return fmt.Errorf("unsupported relay scheme: %v", c.uri.Scheme)
@@ -173,6 +193,7 @@ func (c *staticClient) connect(ctx context.Context) error {
 func (c *staticClient) disconnect() {
 	l.Debugln(c, "disconnecting")
 	c.conn.Close()
+	c.conn = nil
 }
 
 func (c *staticClient) join() error {
@@ -0,0 +1,153 @@
+// Copyright (C) 2026 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package client
+
+import (
+	"net"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// RelayStatistics are the counters for a single relay, identified by its
+// address, since startup. A relay session is the permanent connection we
+// keep to a relay while listening via it; relayed connections are joined
+// through it after an invitation.
+type RelayStatistics struct {
+	Address       string    `json:"address"`
+	Connected     bool      `json:"connected"`     // whether we have a relay session
+	ConnectedAt   time.Time `json:"connectedAt"`   // start of the relay session, if any
+	Invitations   int64     `json:"invitations"`   // handled in either direction
+	Connections   int64     `json:"connections"`   // relayed connections joined
+	Active        int       `json:"active"`        // relayed connections currently open
+	DurationS     float64   `json:"durationS"`     // accumulated duration of relayed connections
+	InBytesTotal  int64     `json:"inBytesTotal"`  // received over relayed connections
+	OutBytesTotal int64     `json:"outBytesTotal"` // sent over relayed connections
+}
+
+type relayCounters struct {
+	in, out atomic.Int64
+
+	// protected by statsMut
+	connectedAt time.Time
+	invitations int64
+	connections int64
+	active      map[*statsConn]struct{}
+	duration    time.Duration // of closed connections
+}
+
+var (
+	stats    = make(map[string]*relayCounters)
+	statsMut sync.Mutex
+)
+
+// countersLocked returns the counters for the given relay address. Must
+// be called with statsMut held.
+func countersLocked(addr string) *relayCounters {
+	c, ok := stats[addr]
+	if !ok {
+		c = &relayCounters{active: make(map[*statsConn]struct{})}
+		stats[addr] = c
+	}
+	return c
+}
+
+func recordConnected(addr string, connected bool) {
+	statsMut.Lock()
+	c := countersLocked(addr)
+	if connected {
+		c.connectedAt = time.Now().Truncate(time.Second)
+	} else {
+		c.connectedAt = time.Time{}
+	}
+	statsMut.Unlock()
+}
+
+func recordInvitation(addr string) {
+	statsMut.Lock()
+	countersLocked(addr).invitations++
+	statsMut.Unlock()
+}
+
+// Statistics returns the counters of all relays used since startup, sorted
+// by address.
+func Statistics() []RelayStatistics {
+	now := time.Now()
+	statsMut.Lock()
+	defer statsMut.Unlock()
+	res := make([]RelayStatistics, 0, len(stats))
+	for addr, c := range stats {
+		duration := c.duration
+		for conn := range c.active {
+			duration += now.Sub(conn.started)
+		}
+		res = append(res, RelayStatistics{
+			Address:       addr,
+			Connected:     !c.connectedAt.IsZero(),
+			ConnectedAt:   c.connectedAt,
+			Invitations:   c.invitations,
+			Connections:   c.connections,
+			Active:        len(c.active),
+			DurationS:     duration.Seconds(),
+			InBytesTotal:  c.in.Load(),
+			OutBytesTotal: c.out.Load(),
+		})
+	}
+	sort.Slice(res, func(a, b int) bool {
+		return res[a].Address < res[b].Address
+	})
+	return res
+}
+
+// statsConn counts the traffic of a relayed connection.
+type statsConn struct {
+	net.Conn
+	counters *relayCounters
+	started  time.Time
+	once     sync.Once
+}
+
+func newStatsConn(conn net.Conn, addr string) *statsConn {
+	statsMut.Lock()
+	defer statsMut.Unlock()
+	c := &statsConn{
+		Conn:     conn,
+		counters: countersLocked(addr),
+		started:  time.Now(),
+	}
+	c.counters.connections++
+	c.counters.active[c] = struct{}{}
+	return c
+}
+
+func (c *statsConn) Read(bs []byte) (int, error) {
+	n, err := c.Conn.Read(bs)
+	c.counters.in.Add(int64(n))
+	return n, err
+}
+
+func (c *statsConn) Write(bs []byte) (int, error) {
+	n, err := c.Conn.Write(bs)
+	c.counters.out.Add(int64(n))
+	return n, err
+}
+
+func (c *statsConn) Close() error {
+	c.once.Do(func() {
+		statsMut.Lock()
+		delete(c.counters.active, c)
+		c.counters.duration += time.Since(c.started)
+		statsMut.Unlock()
+	})
+	return c.Conn.Close()
+}
+
+// NetConn returns the underlying connection, for setting socket options.
+func (c *statsConn) NetConn() net.Conn {
+	return c.Conn
+}
@@ -0,0 +1,65 @@
+// Copyright (C) 2026 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package client
+
+import (
+	"io"
+	"net"
+	"testing"
+)
+
+func relayStatistics(t *testing.T, addr string) RelayStatistics {
+	t.Helper()
+	for _, s := range Statistics() {
+		if s.Address == addr {
+			return s
+		}
+	}
+	t.Fatalf("no statistics for %s", addr)
+	return RelayStatistics{}
+}
+
+func TestStatistics(t *testing.T) {
+	const addr = "192.0.2.42:22067"
+
+	recordConnected(addr, true)
+	recordInvitation(addr)
+
+	local, remote := net.Pipe()
+	defer remote.Close()
+	conn := newStatsConn(local, addr)
+	go func() {
+		_, _ = remote.Write(make([]byte, 100))
+		_, _ = io.ReadFull(remote, make([]byte, 42))
+	}()
+	if _, err := io.ReadFull(conn, make([]byte, 100)); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := conn.Write(make([]byte, 42)); err != nil {
+		t.Fatal(err)
+	}
+
+	s := relayStatistics(t, addr)
+	if !s.Connected || s.Invitations != 1 || s.Connections != 1 || s.Active != 1 {
+		t.Errorf("unexpected statistics with an open connection: %+v", s)
+	}
+	if s.InBytesTotal != 100 || s.OutBytesTotal != 42 {
+		t.Errorf("expected 100 bytes in and 42 out, got %d and %d", s.InBytesTotal, s.OutBytesTotal)
+	}
+
+	conn.Close()
+	conn.Close()
+	recordConnected(addr, false)
+
+	s = relayStatistics(t, addr)
+	if s.Connected || !s.ConnectedAt.IsZero() || s.Active != 0 || s.Connections != 1 {
+		t.Errorf("unexpected statistics after closing: %+v", s)
+	}
+	if s.InBytesTotal != 100 || s.OutBytesTotal != 42 {
+		t.Errorf("counters changed after closing: %+v", s)
+	}
+}
@@ -7,16 +7,31 @@
 package scanner
 
 import (
+	"bufio"
 	"context"
-	"errors"
+	"time"
 
 	"github.com/syncthing/syncthing/lib/fs"
 	"github.com/syncthing/syncthing/lib/protocol"
+	"github.com/syncthing/syncthing/lib/semaphore"
 	"github.com/syncthing/syncthing/lib/sync"
 )
 
-// HashFile hashes the files and returns a list of blocks representing the file.
-func HashFile(ctx context.Context, folderID string, fs fs.Filesystem, path string, blockSize int, counter Counter, useWeakHashes bool) ([]protocol.BlockInfo, error) {
+// HashFile hashes the files with the given algorithm and returns a list of
+// blocks representing the file.
+func HashFile(ctx context.Context, folderID string, fs fs.Filesystem, path string, algo protocol.BlockHashAlgorithm, blockSize int, counter Counter, useWeakHashes bool) ([]protocol.BlockInfo, error) {
+	return hashFile(ctx, folderID, fs, path, algo, blockSize, counter, useWeakHashes, 1, 0)
+}
+
+// The size of the reads when hashing files on rotational disks, large
+// enough that another hasher or the puller reading elsewhere on the disk
+// costs few seeks.
+const rotationalReadSize = 4 << 20
+
+// hashFile is HashFile, using up to the given number of workers to hash
+// large files. Files hashed by a single worker are read in chunks of the
+// given size, if any.
+func hashFile(ctx context.Context, folderID string, fs fs.Filesystem, path string, algo protocol.BlockHashAlgorithm, blockSize int, counter Counter, useWeakHashes bool, workers, readSize int) ([]protocol.BlockInfo, error) {
 	fd, err := fs.Open(path)
 	if err != nil {
 		l.Debugln("open:", err)
@@ -36,12 +51,21 @@ func HashFile(ctx context.Context, folderID string, fs fs.Filesystem, path strin
 
 	// Hash the file. This may take a while for large files.
 
-	blocks, err := Blocks(ctx, fd, blockSize, size, counter, useWeakHashes)
+	t0 := time.Now()
+	var blocks []protocol.BlockInfo
+	if workers > 1 {
+		blocks, err = BlocksParallel(ctx, algo, fd, blockSize, size, counter, useWeakHashes, workers)
+	} else if readSize > 0 {
+		blocks, err = BlocksWithHash(ctx, algo, bufio.NewReaderSize(fd, readSize), blockSize, size, counter, useWeakHashes)
+	} else {
+		blocks, err = BlocksWithHash(ctx, algo, fd, blockSize, size, counter, useWeakHashes)
+	}
 	if err != nil {
 		l.Debugln("blocks:", err)
 		return nil, err
 	}
 
+	metricHashSeconds.WithLabelValues(folderID).Add(time.Since(t0).Seconds())
 	metricHashedBytes.WithLabelValues(folderID).Add(float64(size))
 
 	// Recheck the size and modtime again. If they differ, the file changed
@@ -53,7 +77,7 @@ func HashFile(ctx context.Context, folderID string, fs fs.Filesystem, path strin
 		return nil, err
 	}
 	if size != fi.Size() || !modTime.Equal(fi.ModTime()) {
-		return nil, errors.New("file changed during hashing")
+		return nil, errChangedDuringHashing
 	}
 
 	return blocks, nil
@@ -62,26 +86,34 @@ func HashFile(ctx context.Context, folderID string, fs fs.Filesystem, path strin
 // The parallel hasher reads FileInfo structures from the inbox, hashes the
 // file to populate the Blocks element and sends it to the outbox. A number of
 // workers are used in parallel. The outbox will become closed when the inbox
-// is closed and all items handled.
+// is closed and all items handled. Workers that are idle lend themselves to
+// those hashing large files, so that a single large file can use all of them,
+// unless the files are on a rotational disk.
 type parallelHasher struct {
-	folderID string
-	fs       fs.Filesystem
-	outbox   chan<- ScanResult
-	inbox    <-chan protocol.FileInfo
-	counter  Counter
-	done     chan<- struct{}
-	wg       sync.WaitGroup
+	folderID   string
+	fs         fs.Filesystem
+	outbox     chan<- ScanResult
+	inbox      <-chan protocol.FileInfo
+	counter    Counter
+	done       chan<- struct{}
+	wg         sync.WaitGroup
+	workers    int
+	rotational bool
+	busy       *semaphore.Semaphore // one unit per worker currently hashing
 }
 
-func newParallelHasher(ctx context.Context, folderID string, fs fs.Filesystem, workers int, outbox chan<- ScanResult, inbox <-chan protocol.FileInfo, counter Counter, done chan<- struct{}) {
+func newParallelHasher(ctx context.Context, folderID string, fs fs.Filesystem, workers int, rotational bool, outbox chan<- ScanResult, inbox <-chan protocol.FileInfo, counter Counter, done chan<- struct{}) {
 	ph := &parallelHasher{
-		folderID: folderID,
-		fs:       fs,
-		outbox:   outbox,
-		inbox:    inbox,
-		counter:  counter,
-		done:     done,
-		wg:       sync.NewWaitGroup(),
+		folderID:   folderID,
+		fs:         fs,
+		outbox:     outbox,
+		inbox:      inbox,
+		counter:    counter,
+		done:       done,
+		wg:         sync.NewWaitGroup(),
+		workers:    workers,
+		rotational: rotational,
+		busy:       semaphore.New(workers),
 	}
 
 	ph.wg.Add(workers)
@@ -108,7 +140,7 @@ func (ph *parallelHasher) hashFiles(ctx context.Context) {
 				panic("Bug. Asked to hash a directory or a deleted file.")
 			}
 
-			blocks, err := HashFile(ctx, ph.folderID, ph.fs, f.Name, f.BlockSize(), ph.counter, true)
+			blocks, err := ph.hashFile(ctx, f)
 			if err != nil {
 				handleError(ctx, "hashing", f.Name, err, ph.outbox)
 				continue
@@ -139,6 +171,23 @@ func (ph *parallelHasher) hashFiles(ctx context.Context) {
 	}
 }
 
+// hashFile hashes the file, borrowing the idle workers if it is large and
+// not on a rotational disk.
+func (ph *parallelHasher) hashFile(ctx context.Context, f protocol.FileInfo) ([]protocol.BlockInfo, error) {
+	if ph.rotational {
+		return hashFile(ctx, ph.folderID, ph.fs, f.Name, f.HashAlgorithm, f.BlockSize(), ph.counter, true, 1, rotationalReadSize)
+	}
+
+	ph.busy.Take(1)
+	workers := 1
+	if f.Size >= parallelHashMinSize {
+		workers += ph.busy.TakeAvailable(ph.workers - 1)
+	}
+	defer ph.busy.Give(workers)
+
+	return hashFile(ctx, ph.folderID, ph.fs, f.Name, f.HashAlgorithm, f.BlockSize(), ph.counter, true, workers, 0)
+}
+
 func (ph *parallelHasher) closeWhenDone() {
 	ph.wg.Wait()
 	// In case the hasher aborted on context, wait for filesystem
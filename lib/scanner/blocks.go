@@ -9,35 +9,53 @@ package scanner
 import (
 	"bytes"
 	"context"
-	"crypto/sha256"
+	"errors"
 	"hash"
-	"hash/adler32"
 	"io"
+	"sync/atomic"
 
 	"github.com/syncthing/syncthing/lib/protocol"
+	"github.com/syncthing/syncthing/lib/sync"
+	"github.com/syncthing/syncthing/lib/weakhash"
 )
 
 var SHA256OfNothing = []uint8{0xe3, 0xb0, 0xc4, 0x42, 0x98, 0xfc, 0x1c, 0x14, 0x9a, 0xfb, 0xf4, 0xc8, 0x99, 0x6f, 0xb9, 0x24, 0x27, 0xae, 0x41, 0xe4, 0x64, 0x9b, 0x93, 0x4c, 0xa4, 0x95, 0x99, 0x1b, 0x78, 0x52, 0xb8, 0x55}
 
+var (
+	// Files at least this large may be hashed by several workers in
+	// parallel, each hashing one chunk of the file at a time.
+	parallelHashMinSize int64 = 4 * protocol.MaxBlockSize
+	// The size of the chunks, rounded down to a multiple of the block size.
+	parallelHashChunkSize int64 = protocol.MaxBlockSize
+)
+
+var errChangedDuringHashing = errors.New("file changed during hashing")
+
 type Counter interface {
 	Update(bytes int64)
 }
 
-// Blocks returns the blockwise hash of the reader.
+// Blocks returns the blockwise SHA-256 hash of the reader.
 func Blocks(ctx context.Context, r io.Reader, blocksize int, sizehint int64, counter Counter, useWeakHashes bool) ([]protocol.BlockInfo, error) {
+	return BlocksWithHash(ctx, protocol.BlockHashSHA256, r, blocksize, sizehint, counter, useWeakHashes)
+}
+
+// BlocksWithHash returns the blockwise hash of the reader, computed with
+// the given algorithm.
+func BlocksWithHash(ctx context.Context, algo protocol.BlockHashAlgorithm, r io.Reader, blocksize int, sizehint int64, counter Counter, useWeakHashes bool) ([]protocol.BlockInfo, error) {
 	if counter == nil {
 		counter = &noopCounter{}
 	}
 
-	hf := sha256.New()
-	const hashLength = sha256.Size
+	hf := algo.New()
+	hashLength := hf.Size()
 
 	var weakHf hash.Hash32 = noopHash{}
 	var multiHf io.Writer = hf
 	if useWeakHashes {
 		// Use an actual weak hash function, make the multiHf
 		// write to both hash functions.
-		weakHf = adler32.New()
+		weakHf = weakhash.New()
 		multiHf = io.MultiWriter(hf, weakHf)
 	}
 
@@ -54,7 +72,7 @@ func Blocks(ctx context.Context, r io.Reader, blocksize int, sizehint int64, cou
 			numBlocks++
 		}
 		blocks = make([]protocol.BlockInfo, 0, numBlocks)
-		hashes = make([]byte, 0, hashLength*numBlocks)
+		hashes = make([]byte, 0, int64(hashLength)*numBlocks)
 	}
 
 	// A 32k buffer is used for copying into the hash function.
@@ -105,24 +123,98 @@ func Blocks(ctx context.Context, r io.Reader, blocksize int, sizehint int64, cou
 		blocks = append(blocks, protocol.BlockInfo{
 			Offset: 0,
 			Size:   0,
-			Hash:   SHA256OfNothing,
+			Hash:   algo.Sum(nil),
 		})
 	}
 
 	return blocks, nil
 }
 
+// BlocksParallel returns the blockwise hash of the first size bytes of the
+// reader, same as BlocksWithHash. Large files are split into chunks which are
+// hashed by up to the given number of workers concurrently, the results being
+// assembled in order.
+func BlocksParallel(ctx context.Context, algo protocol.BlockHashAlgorithm, r io.ReaderAt, blocksize int, size int64, counter Counter, useWeakHashes bool, workers int) ([]protocol.BlockInfo, error) {
+	chunkSize := max(parallelHashChunkSize/int64(blocksize), 1) * int64(blocksize)
+	numChunks := int((size + chunkSize - 1) / chunkSize)
+	workers = min(workers, numChunks)
+	if workers <= 1 || size < parallelHashMinSize {
+		return BlocksWithHash(ctx, algo, io.NewSectionReader(r, 0, size), blocksize, size, counter, useWeakHashes)
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	chunks := make([][]protocol.BlockInfo, numChunks)
+	var next atomic.Int64
+	var firstErr error
+	errMut := sync.NewMutex()
+	wg := sync.NewWaitGroup()
+	wg.Add(workers)
+	for i := 0; i < workers; i++ {
+		go func() {
+			defer wg.Done()
+			for {
+				idx := next.Add(1) - 1
+				if idx >= int64(numChunks) {
+					return
+				}
+				offset := idx * chunkSize
+				length := min(chunkSize, size-offset)
+				blocks, err := BlocksWithHash(ctx, algo, io.NewSectionReader(r, offset, length), blocksize, length, counter, useWeakHashes)
+				if err == nil && blocksLength(blocks) != length {
+					// The file was truncated while we were hashing it.
+					err = errChangedDuringHashing
+				}
+				if err != nil {
+					errMut.Lock()
+					if firstErr == nil {
+						firstErr = err
+					}
+					errMut.Unlock()
+					cancel()
+					return
+				}
+				for j := range blocks {
+					blocks[j].Offset += offset
+				}
+				chunks[idx] = blocks
+			}
+		}()
+	}
+	wg.Wait()
+
+	if firstErr != nil {
+		return nil, firstErr
+	}
+
+	blocks := make([]protocol.BlockInfo, 0, (size+int64(blocksize)-1)/int64(blocksize))
+	for _, chunk := range chunks {
+		blocks = append(blocks, chunk...)
+	}
+	return blocks, nil
+}
+
+func blocksLength(blocks []protocol.BlockInfo) int64 {
+	var n int64
+	for _, b := range blocks {
+		n += int64(b.Size)
+	}
+	return n
+}
+
 // Validate quickly validates buf against the 32-bit weakHash, if not zero,
 // else against the cryptohash hash, if len(hash)>0. It is satisfied if
-// either hash matches or neither hash is given.
+// either hash matches or neither hash is given. The weak hash may have been
+// computed with any weak hash algorithm, the cryptohash with SHA-256 or
+// BLAKE2b.
 func Validate(buf, hash []byte, weakHash uint32) bool {
-	if weakHash != 0 && adler32.Checksum(buf) == weakHash {
+	if weakHash != 0 && weakhash.Matches(buf, weakHash) {
 		return true
 	}
 
 	if len(hash) > 0 {
-		hbuf := sha256.Sum256(buf)
-		return bytes.Equal(hbuf[:], hash)
+		return bytes.Equal(protocol.BlockHashSHA256.Sum(buf), hash) || bytes.Equal(protocol.BlockHashBLAKE2b.Sum(buf), hash)
 	}
 
 	return true
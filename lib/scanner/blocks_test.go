@@ -18,6 +18,7 @@ import (
 	"testing/quick"
 
 	rollingAdler32 "github.com/chmduquesne/rollinghash/adler32"
+	"github.com/syncthing/syncthing/lib/fs"
 	"github.com/syncthing/syncthing/lib/protocol"
 )
 
@@ -106,6 +107,83 @@ func TestBlocks(t *testing.T) {
 	}
 }
 
+func TestBlocksParallel(t *testing.T) {
+	oldMin, oldChunk := parallelHashMinSize, parallelHashChunkSize
+	defer func() {
+		parallelHashMinSize, parallelHashChunkSize = oldMin, oldChunk
+	}()
+	parallelHashMinSize, parallelHashChunkSize = 1000, 1000
+
+	data := make([]byte, 10000+123)
+	rand.Read(data)
+
+	for _, blocksize := range []int{7, 128, 1000, 4096} {
+		expected, err := Blocks(context.Background(), bytes.NewReader(data), blocksize, int64(len(data)), nil, true)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, workers := range []int{1, 2, 3, 16} {
+			blocks, err := BlocksParallel(context.Background(), protocol.BlockHashSHA256, bytes.NewReader(data), blocksize, int64(len(data)), nil, true, workers)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !blocksEqual(blocks, expected) {
+				t.Errorf("blocksize %d, %d workers: blocks differ from sequential hashing", blocksize, workers)
+			}
+			for i := range blocks {
+				if blocks[i].Offset != expected[i].Offset || blocks[i].WeakHash != expected[i].WeakHash {
+					t.Errorf("blocksize %d, %d workers: block %d is %v, not %v", blocksize, workers, i, blocks[i], expected[i])
+					break
+				}
+			}
+		}
+	}
+
+	// A file shorter than announced is an error, not a short block list.
+	_, err := BlocksParallel(context.Background(), protocol.BlockHashSHA256, bytes.NewReader(data[:5000]), 128, int64(len(data)), nil, true, 4)
+	if err == nil {
+		t.Error("expected an error for a truncated file")
+	}
+}
+
+func TestHashFileRotational(t *testing.T) {
+	testFs := fs.NewFilesystem(fs.FilesystemTypeFake, "TestHashFileRotational?content=true")
+	data := make([]byte, 3<<20+123)
+	rand.Read(data)
+	fd, err := testFs.Create("file")
+	if err != nil {
+		t.Fatal(err)
+	}
+	fd.Write(data)
+	fd.Close()
+
+	expected, err := Blocks(context.Background(), bytes.NewReader(data), protocol.MinBlockSize, int64(len(data)), nil, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, readSize := range []int{1000, rotationalReadSize} {
+		blocks, err := hashFile(context.Background(), "folder", testFs, "file", protocol.BlockHashSHA256, protocol.MinBlockSize, nil, true, 1, readSize)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !blocksEqual(blocks, expected) {
+			t.Errorf("read size %d: blocks differ from unbuffered hashing", readSize)
+		}
+	}
+}
+
+func blocksEqual(a, b []protocol.BlockInfo) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i].Size != b[i].Size || !bytes.Equal(a[i].Hash, b[i].Hash) {
+			return false
+		}
+	}
+	return true
+}
+
 func TestAdler32Variants(t *testing.T) {
 	// Verify that the two adler32 functions give matching results for a few
 	// different blocks of data.
@@ -19,6 +19,13 @@ var (
 		Help:      "Total amount of data hashed, per folder",
 	}, []string{"folder"})
 
+	metricHashSeconds = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "syncthing",
+		Subsystem: "scanner",
+		Name:      "hash_seconds_total",
+		Help:      "Total time spent hashing file data, per folder",
+	}, []string{"folder"})
+
 	metricScannedItems = promauto.NewCounterVec(prometheus.CounterOpts{
 		Namespace: "syncthing",
 		Subsystem: "scanner",
@@ -31,5 +38,6 @@ func registerFolderMetrics(folderID string) {
 	// Register metrics for this folder, so that counters are present even
 	// when zero.
 	metricHashedBytes.WithLabelValues(folderID)
+	metricHashSeconds.WithLabelValues(folderID)
 	metricScannedItems.WithLabelValues(folderID)
 }
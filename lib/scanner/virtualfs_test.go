@@ -151,6 +151,17 @@ func (f *fakeFile) Read(bs []byte) (int, error) {
 	return len(bs), nil
 }
 
+func (f *fakeFile) ReadAt(bs []byte, offset int64) (int, error) {
+	remaining := f.size - offset
+	if remaining <= 0 {
+		return 0, io.EOF
+	}
+	if remaining < int64(len(bs)) {
+		return int(remaining), io.EOF
+	}
+	return len(bs), nil
+}
+
 func (f *fakeFile) Stat() (fs.FileInfo, error) {
 	return fakeInfo{f.name, f.size}, nil
 }
@@ -159,6 +170,5 @@ func (*fakeFile) Write([]byte) (int, error)          { return 0, errNotSupp }
 func (*fakeFile) WriteAt([]byte, int64) (int, error) { return 0, errNotSupp }
 func (*fakeFile) Close() error                       { return nil }
 func (*fakeFile) Truncate(_ int64) error             { return errNotSupp }
-func (*fakeFile) ReadAt([]byte, int64) (int, error)  { return 0, errNotSupp }
 func (*fakeFile) Seek(int64, int) (int64, error)     { return 0, errNotSupp }
 func (*fakeFile) Sync() error                        { return nil }
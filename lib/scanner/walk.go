@@ -45,8 +45,21 @@ type Config struct {
 	// When AutoNormalize is set, file names that are in UTF8 but incorrect
 	// normalization form will be corrected.
 	AutoNormalize bool
+	// The Unicode normalization form file names are expected to be in.
+	Normalization Normalization
+	// The number of blocks to aim for per file when choosing the block
+	// size, or protocol.DesiredPerFileBlocks if zero.
+	BlocksPerFile int
 	// Number of routines to use for hashing
 	Hashers int
+	// The algorithm to hash new and changed files with. When it's SHA-256,
+	// files hashed with another algorithm are rehashed as well, as not all
+	// devices may be able to verify them.
+	BlockHash protocol.BlockHashAlgorithm
+	// If Rotational is true, the filesystem is on a rotational disk: each
+	// file is read start to end in large reads by a single hasher, instead
+	// of large files being split among the idle hashers, to avoid seeking.
+	Rotational bool
 	// Our vector clock id
 	ShortID protocol.ShortID
 	// Optional progress tick interval which defines how often FolderScanProgress
@@ -54,8 +67,8 @@ type Config struct {
 	ProgressTickIntervalS int
 	// Local flags to set on scanned files
 	LocalFlags uint32
-	// Modification time is to be considered unchanged if the difference is lower.
-	ModTimeWindow time.Duration
+	// How to compare modification times to decide whether they changed.
+	ModTime protocol.ModTimeComparison
 	// Event logger to which the scan progress events are sent
 	EventLogger events.Logger
 	// If ScanOwnership is true, we pick up ownership information on files while scanning.
@@ -64,8 +77,27 @@ type Config struct {
 	ScanXattrs bool
 	// Filter for extended attributes
 	XattrFilter XattrFilter
+	// If PermissionsProfile is not nil, permission bits on disk that
+	// correspond to the previously scanned ones are reported unchanged.
+	PermissionsProfile PermissionsProfile
 }
 
+// Normalization is the Unicode normalization form expected for file names
+// on disk.
+type Normalization int
+
+const (
+	// NormalizationNative expects NFD on macOS and NFC everywhere else.
+	NormalizationNative Normalization = iota
+	NormalizationNFC
+	NormalizationNFD
+	// NormalizationPreserve leaves file names as they are on disk, as long
+	// as the filesystem resolves the native normalization form of the name
+	// to the same file. Names that can't be accessed in the native form are
+	// reported and skipped, as they would otherwise appear to be deleted.
+	NormalizationPreserve
+)
+
 type CurrentFiler interface {
 	// CurrentFile returns the file as seen at last scan.
 	CurrentFile(name string) (protocol.FileInfo, bool)
@@ -77,6 +109,11 @@ type XattrFilter interface {
 	GetMaxTotalSize() int
 }
 
+type PermissionsProfile interface {
+	// ToDisk returns the permission bits on disk for the given synced ones.
+	ToDisk(perms uint32, isDir bool) uint32
+}
+
 type ScanResult struct {
 	File protocol.FileInfo
 	Err  error
@@ -133,7 +170,7 @@ func (w *walker) walk(ctx context.Context) chan ScanResult {
 	// We're not required to emit scan progress events, just kick off hashers,
 	// and feed inputs directly from the walker.
 	if w.ProgressTickIntervalS < 0 {
-		newParallelHasher(ctx, w.Folder, w.Filesystem, w.Hashers, finishedChan, toHashChan, nil, nil)
+		newParallelHasher(ctx, w.Folder, w.Filesystem, w.Hashers, w.Rotational, finishedChan, toHashChan, nil, nil)
 		return finishedChan
 	}
 
@@ -167,7 +204,7 @@ func (w *walker) walk(ctx context.Context) chan ScanResult {
 		done := make(chan struct{})
 		progress := newByteCounter()
 
-		newParallelHasher(ctx, w.Folder, w.Filesystem, w.Hashers, finishedChan, realToHashChan, progress, done)
+		newParallelHasher(ctx, w.Folder, w.Filesystem, w.Hashers, w.Rotational, finishedChan, realToHashChan, progress, done)
 
 		// A routine which actually emits the FolderScanProgress events
 		// every w.ProgressTicker ticks, until the hasher routines terminate.
@@ -257,7 +294,8 @@ func (w *walker) walkAndHashFiles(ctx context.Context, toHashChan chan<- protoco
 	now := time.Now()
 	ignoredParent := ""
 
-	return func(path string, info fs.FileInfo, err error) error {
+	var walkFn fs.WalkFunc
+	walkFn = func(path string, info fs.FileInfo, err error) error {
 		select {
 		case <-ctx.Done():
 			return ctx.Err()
@@ -296,7 +334,7 @@ func (w *walker) walkAndHashFiles(ctx context.Context, toHashChan chan<- protoco
 		// Just in case the filesystem doesn't produce the normalization the OS
 		// uses, and we use internally.
 		nonNormPath := path
-		path = normalizePath(path)
+		path = w.normalizePath(path)
 
 		if m := w.Matcher.Match(path); m.IsIgnored() {
 			l.Debugln(w, "ignored (patterns):", path)
@@ -325,16 +363,31 @@ func (w *walker) walkAndHashFiles(ctx context.Context, toHashChan chan<- protoco
 		}
 
 		if path != nonNormPath {
-			if !w.AutoNormalize {
+			if w.Normalization == NormalizationPreserve {
+				if !w.resolvesToSameFile(path, info) {
+					handleError(ctx, "normalizing path", nonNormPath, errUTF8Normalization, finishedChan)
+					return skip
+				}
+			} else if !w.AutoNormalize {
 				// We're not authorized to do anything about it, so complain and skip.
 				handleError(ctx, "normalizing path", nonNormPath, errUTF8Normalization, finishedChan)
 				return skip
-			}
-
-			path, err = w.applyNormalization(nonNormPath, path, info)
-			if err != nil {
-				handleError(ctx, "normalizing path", nonNormPath, err, finishedChan)
-				return skip
+			} else {
+				normPath, err := w.applyNormalization(nonNormPath, path, info)
+				if err != nil {
+					handleError(ctx, "normalizing path", nonNormPath, err, finishedChan)
+					return skip
+				}
+				if info.IsDir() {
+					// The walk would continue below the old name, which
+					// no longer exists. Walk the renamed directory instead,
+					// so that its contents are handled in the same pass.
+					if err := w.Filesystem.Walk(normPath, walkFn); err != nil {
+						return err
+					}
+					return fs.SkipDir
+				}
+				path = normPath
 			}
 		}
 
@@ -372,6 +425,7 @@ func (w *walker) walkAndHashFiles(ctx context.Context, toHashChan chan<- protoco
 
 		return nil
 	}
+	return walkFn
 }
 
 func (w *walker) handleItem(ctx context.Context, path string, info fs.FileInfo, toHashChan chan<- protocol.FileInfo, finishedChan chan<- ScanResult) error {
@@ -399,7 +453,7 @@ func (w *walker) handleItem(ctx context.Context, path string, info fs.FileInfo,
 func (w *walker) walkRegular(ctx context.Context, relPath string, info fs.FileInfo, toHashChan chan<- protocol.FileInfo) error {
 	curFile, hasCurFile := w.CurrentFiler.CurrentFile(relPath)
 
-	blockSize := protocol.BlockSize(info.Size())
+	blockSize := protocol.BlockSizeFor(info.Size(), w.BlocksPerFile)
 
 	if hasCurFile {
 		// Check if we should retain current block size.
@@ -422,11 +476,13 @@ func (w *walker) walkRegular(ctx context.Context, relPath string, info fs.FileIn
 	f = w.updateFileInfo(f, curFile)
 	f.NoPermissions = w.IgnorePerms
 	f.RawBlockSize = blockSize
+	f.HashAlgorithm = w.BlockHash
 	l.Debugln(w, "checking:", f)
 
 	if hasCurFile {
-		if curFile.IsEquivalentOptional(f, protocol.FileInfoComparison{
-			ModTimeWindow:   w.ModTimeWindow,
+		fallback := w.BlockHash == protocol.BlockHashSHA256 && curFile.HashAlgorithm != protocol.BlockHashSHA256
+		if !fallback && curFile.IsEquivalentOptional(f, protocol.FileInfoComparison{
+			ModTime:         w.ModTime,
 			IgnorePerms:     w.IgnorePerms,
 			IgnoreBlocks:    true,
 			IgnoreFlags:     w.LocalFlags,
@@ -473,7 +529,7 @@ func (w *walker) walkDir(ctx context.Context, relPath string, info fs.FileInfo,
 
 	if hasCurFile {
 		if curFile.IsEquivalentOptional(f, protocol.FileInfoComparison{
-			ModTimeWindow:   w.ModTimeWindow,
+			ModTime:         w.ModTime,
 			IgnorePerms:     w.IgnorePerms,
 			IgnoreBlocks:    true,
 			IgnoreFlags:     w.LocalFlags,
@@ -528,7 +584,7 @@ func (w *walker) walkSymlink(ctx context.Context, relPath string, info fs.FileIn
 
 	if hasCurFile {
 		if curFile.IsEquivalentOptional(f, protocol.FileInfoComparison{
-			ModTimeWindow:   w.ModTimeWindow,
+			ModTime:         w.ModTime,
 			IgnorePerms:     w.IgnorePerms,
 			IgnoreBlocks:    true,
 			IgnoreFlags:     w.LocalFlags,
@@ -562,6 +618,17 @@ func (w *walker) walkSymlink(ctx context.Context, relPath string, info fs.FileIn
 	return nil
 }
 
+func (w *walker) normalizePath(path string) string {
+	switch w.Normalization {
+	case NormalizationNFC:
+		return norm.NFC.String(path)
+	case NormalizationNFD:
+		return norm.NFD.String(path)
+	default:
+		return normalizePath(path)
+	}
+}
+
 func normalizePath(path string) string {
 	if build.IsDarwin || build.IsIOS {
 		// Mac OS X file names should always be NFD normalized.
@@ -573,6 +640,13 @@ func normalizePath(path string) string {
 	return norm.NFC.String(path)
 }
 
+// resolvesToSameFile returns true if the file can be accessed under the
+// normalized name normPath, without renaming it.
+func (w *walker) resolvesToSameFile(normPath string, info fs.FileInfo) bool {
+	normInfo, err := w.Filesystem.Lstat(normPath)
+	return err == nil && w.Filesystem.SameFile(info, normInfo)
+}
+
 // applyNormalization fixes the normalization of the file on disk, i.e. ensures
 // the file at path ends up named normPath. It shouldn't but may happen that the
 // file ends up with a different name, in which case that one should be scanned.
@@ -621,6 +695,12 @@ func (w *walker) updateFileInfo(dst, src protocol.FileInfo) protocol.FileInfo {
 		// from there.
 		dst.Permissions |= (src.Permissions & 0o111)
 	}
+	if w.PermissionsProfile != nil && src.Name != "" && !src.IsDeleted() && !src.NoPermissions && src.Type == dst.Type &&
+		protocol.PermsEqual(w.PermissionsProfile.ToDisk(src.Permissions, dst.IsDirectory()), dst.Permissions) {
+		// The permissions on disk are what we'd set for the previously
+		// scanned or pulled item, so keep announcing the synced ones.
+		dst.Permissions = src.Permissions
+	}
 	dst.Version = src.Version.Update(w.ShortID)
 	dst.ModifiedBy = w.ShortID
 	dst.LocalFlags = w.LocalFlags
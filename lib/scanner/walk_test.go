@@ -27,6 +27,7 @@ import (
 	"github.com/syncthing/syncthing/lib/ignore"
 	"github.com/syncthing/syncthing/lib/protocol"
 	"github.com/syncthing/syncthing/lib/rand"
+	"golang.org/x/crypto/blake2b"
 	"golang.org/x/text/unicode/norm"
 )
 
@@ -272,6 +273,94 @@ func TestNormalization(t *testing.T) {
 	}
 }
 
+func TestNormalizationPolicy(t *testing.T) {
+	if build.IsDarwin {
+		t.Skip("Test requires a normalization sensitive filesystem")
+	}
+
+	for _, tc := range []struct {
+		normalization Normalization
+		form, other   norm.Form
+	}{
+		{NormalizationNFC, norm.NFC, norm.NFD},
+		{NormalizationNFD, norm.NFD, norm.NFC},
+	} {
+		testFs := fs.NewFilesystem(fs.FilesystemTypeFake, rand.String(16))
+
+		// A directory in the wrong form, with more of them inside it.
+		name := "\xC3\x84"
+		other := tc.other.String(name)
+		if err := testFs.MkdirAll(filepath.Join("dir-"+other, "sub-"+other), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		fs.WriteFile(testFs, filepath.Join("dir-"+other, "sub-"+other, "file-"+other), []byte("test"), 0o644)
+
+		cfg, cancel := testConfig()
+		cfg.Filesystem = testFs
+		cfg.AutoNormalize = true
+		cfg.Normalization = tc.normalization
+		var files []protocol.FileInfo
+		for res := range Walk(context.TODO(), cfg) {
+			if res.Err != nil {
+				t.Error(res.Err)
+				continue
+			}
+			files = append(files, res.File)
+		}
+		cancel()
+
+		// Everything is renamed and scanned in a single pass.
+		if len(files) != 3 {
+			t.Fatalf("expected 3 items, got %d", len(files))
+		}
+		for _, f := range files {
+			if !tc.form.IsNormalString(f.Name) {
+				t.Errorf("name %q is not normalized", f.Name)
+			}
+		}
+		want := tc.form.String(filepath.Join("dir-"+name, "sub-"+name, "file-"+name))
+		if _, err := testFs.Lstat(want); err != nil {
+			t.Error("file not renamed on disk:", err)
+		}
+	}
+}
+
+func TestNormalizationPreserve(t *testing.T) {
+	if build.IsDarwin {
+		t.Skip("Test requires a normalization sensitive filesystem")
+	}
+
+	const inNFD = "\x41\xCC\x88"
+
+	testFs := fs.NewFilesystem(fs.FilesystemTypeFake, rand.String(16))
+	fs.WriteFile(testFs, "file-"+inNFD, []byte("test"), 0o644)
+
+	cfg, cancel := testConfig()
+	defer cancel()
+	cfg.Filesystem = testFs
+	cfg.AutoNormalize = true
+	cfg.Normalization = NormalizationPreserve
+
+	// The file can't be accessed by its native name, so it must be
+	// reported rather than scanned or renamed.
+	var errs int
+	for res := range Walk(context.TODO(), cfg) {
+		if res.Err == nil {
+			t.Errorf("unexpected scan result %v", res.File.Name)
+		} else if !errors.Is(res.Err, errUTF8Normalization) {
+			t.Error("unexpected error:", res.Err)
+		} else {
+			errs++
+		}
+	}
+	if errs != 1 {
+		t.Errorf("expected one error, got %d", errs)
+	}
+	if _, err := testFs.Lstat("file-" + inNFD); err != nil {
+		t.Error("file must not be renamed:", err)
+	}
+}
+
 func TestNormalizationDarwinCaseFS(t *testing.T) {
 	// This tests that normalization works on Darwin, through a CaseFS.
 
@@ -438,6 +527,37 @@ func TestBlocksizeHysteresis(t *testing.T) {
 	runTest(512 << 10)
 }
 
+func TestBlocksPerFile(t *testing.T) {
+	sf := fs.NewWalkFilesystem(&singleFileFS{
+		name:     "testfile.dat",
+		filesize: 8 << 20,
+	})
+
+	cfg, cancel := testConfig()
+	defer cancel()
+	cfg.Filesystem = sf
+	cfg.BlocksPerFile = 4
+
+	var files []protocol.FileInfo
+	for res := range Walk(context.TODO(), cfg) {
+		if res.Err != nil {
+			t.Fatal(res.Err)
+		}
+		files = append(files, res.File)
+	}
+	if len(files) != 1 {
+		t.Fatalf("expected one file, not %d", len(files))
+	}
+	// Fewer than four blocks means 4 MiB ones, instead of the default 128
+	// KiB for a file this size.
+	if s := files[0].BlockSize(); s != 4<<20 {
+		t.Errorf("incorrect block size %d != expected %d", s, 4<<20)
+	}
+	if len(files[0].Blocks) != 2 {
+		t.Errorf("expected two blocks, not %d", len(files[0].Blocks))
+	}
+}
+
 func TestWalkReceiveOnly(t *testing.T) {
 	sf := fs.NewWalkFilesystem(&singleFileFS{
 		name:     "testfile.dat",
@@ -488,6 +608,112 @@ func TestWalkReceiveOnly(t *testing.T) {
 	}
 }
 
+func TestWalkBlockHash(t *testing.T) {
+	sf := fs.NewWalkFilesystem(&singleFileFS{
+		name:     "testfile.dat",
+		filesize: 1024,
+	})
+	current := make(fakeCurrentFiler)
+
+	walk := func(algo protocol.BlockHashAlgorithm) []protocol.FileInfo {
+		t.Helper()
+		cfg, cancel := testConfig()
+		defer cancel()
+		cfg.Filesystem = sf
+		cfg.CurrentFiler = current
+		cfg.BlockHash = algo
+		var files []protocol.FileInfo
+		for res := range Walk(context.TODO(), cfg) {
+			if res.Err != nil {
+				t.Fatal(res.Err)
+			}
+			files = append(files, res.File)
+		}
+		return files
+	}
+
+	files := walk(protocol.BlockHashBLAKE2b)
+	if len(files) != 1 || files[0].HashAlgorithm != protocol.BlockHashBLAKE2b {
+		t.Fatal("expected the file to be hashed with BLAKE2b")
+	}
+	data := make([]byte, 1024)
+	sum := blake2b.Sum256(data)
+	if !bytes.Equal(files[0].Blocks[0].Hash, sum[:]) {
+		t.Errorf("block hash %x is not the BLAKE2b hash %x", files[0].Blocks[0].Hash, sum)
+	}
+	current[files[0].Name] = files[0]
+
+	// Unchanged files are kept as they are with either algorithm, except
+	// when falling back to SHA-256.
+	if files := walk(protocol.BlockHashBLAKE2b); len(files) != 0 {
+		t.Error("unchanged file rescanned")
+	}
+	files = walk(protocol.BlockHashSHA256)
+	if len(files) != 1 || files[0].HashAlgorithm != protocol.BlockHashSHA256 {
+		t.Fatal("expected the file to be rehashed with SHA-256")
+	}
+	if sum := sha256.Sum256(data); !bytes.Equal(files[0].Blocks[0].Hash, sum[:]) {
+		t.Errorf("block hash %x is not the SHA-256 hash %x", files[0].Blocks[0].Hash, sum)
+	}
+	current[files[0].Name] = files[0]
+	if files := walk(protocol.BlockHashBLAKE2b); len(files) != 0 {
+		t.Error("unchanged file rescanned")
+	}
+
+	if !Validate(data, sum[:], 0) {
+		t.Error("BLAKE2b hash not validated")
+	}
+}
+
+type fixedPermissionsProfile uint32
+
+func (p fixedPermissionsProfile) ToDisk(uint32, bool) uint32 {
+	return uint32(p)
+}
+
+func TestWalkPermissionsProfile(t *testing.T) {
+	testFs := fs.NewFilesystem(fs.FilesystemTypeFake, rand.String(16))
+	fs.WriteFile(testFs, "file", []byte("test"), 0o644)
+
+	cfg, cancel := testConfig()
+	defer cancel()
+	cfg.Filesystem = testFs
+	cfg.PermissionsProfile = fixedPermissionsProfile(0o644)
+
+	walk := func(cur protocol.FileInfo) []protocol.FileInfo {
+		t.Helper()
+		cfg.CurrentFiler = fakeCurrentFiler{cur.Name: cur}
+		var files []protocol.FileInfo
+		for res := range Walk(context.TODO(), cfg) {
+			if res.Err != nil {
+				t.Fatal(res.Err)
+			}
+			files = append(files, res.File)
+		}
+		return files
+	}
+
+	files := walk(protocol.FileInfo{})
+	if len(files) != 1 || files[0].Permissions != 0o644 {
+		t.Fatalf("unexpected scan result %v", files)
+	}
+
+	// The synced permissions map to what is on disk, so the file is
+	// unchanged.
+	cur := files[0]
+	cur.Permissions = 0o600
+	if files := walk(cur); len(files) != 0 {
+		t.Errorf("expected no changes, got %v", files)
+	}
+
+	// A local change is picked up.
+	cfg.PermissionsProfile = fixedPermissionsProfile(0o640)
+	files = walk(cur)
+	if len(files) != 1 || files[0].Permissions != 0o644 {
+		t.Errorf("unexpected scan result %v", files)
+	}
+}
+
 func TestScanOwnershipPOSIX(t *testing.T) {
 	// This test works on all operating systems because the FakeFS is always POSIXy.
 
@@ -632,16 +858,19 @@ const (
 
 func BenchmarkHashFile(b *testing.B) {
 	testFs := newDataFs()
-	b.ResetTimer()
 
-	for i := 0; i < b.N; i++ {
-		if _, err := HashFile(context.TODO(), "", testFs, testdataName, protocol.MinBlockSize, nil, true); err != nil {
-			b.Fatal(err)
-		}
-	}
+	for _, algo := range []protocol.BlockHashAlgorithm{protocol.BlockHashSHA256, protocol.BlockHashBLAKE2b} {
+		b.Run(algo.String(), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				if _, err := HashFile(context.TODO(), "", testFs, testdataName, algo, protocol.MinBlockSize, nil, true); err != nil {
+					b.Fatal(err)
+				}
+			}
 
-	b.SetBytes(testdataSize)
-	b.ReportAllocs()
+			b.SetBytes(testdataSize)
+			b.ReportAllocs()
+		})
+	}
 }
 
 func newDataFs() fs.Filesystem {
@@ -77,6 +77,19 @@ func (s *Semaphore) takeInner(ctx context.Context, size int) error {
 	return nil
 }
 
+// TakeAvailable takes whatever is available right now, up to size, without
+// waiting, and returns the amount taken.
+func (s *Semaphore) TakeAvailable(size int) int {
+	s.mut.Lock()
+	defer s.mut.Unlock()
+	size = min(size, s.available)
+	if size < 0 {
+		size = 0
+	}
+	s.available -= size
+	return size
+}
+
 func (s *Semaphore) Give(size int) {
 	s.mut.Lock()
 	if size > s.max {
@@ -121,3 +121,25 @@ func TestByteSemaphoreGiveMore(t *testing.T) {
 		t.Errorf("bad state after large take + give with adjustment")
 	}
 }
+
+func TestSemaphoreTakeAvailable(t *testing.T) {
+	t.Parallel()
+
+	s := New(100)
+	s.Take(70)
+
+	if n := s.TakeAvailable(50); n != 30 {
+		t.Errorf("took %d, expected 30", n)
+	}
+	if n := s.TakeAvailable(50); n != 0 {
+		t.Errorf("took %d from an exhausted semaphore", n)
+	}
+
+	s.Give(100)
+	if n := s.TakeAvailable(20); n != 20 {
+		t.Errorf("took %d, expected 20", n)
+	}
+	if s.available != 80 {
+		t.Errorf("bad state after take available")
+	}
+}
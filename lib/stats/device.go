@@ -84,3 +84,12 @@ func (s *DeviceStatisticsReference) GetStatistics() (DeviceStatistics, error) {
 		LastConnectionDurationS: lastConnDuration.Seconds(),
 	}, nil
 }
+
+// Forget removes the statistics of the device from the database.
+func (s *DeviceStatisticsReference) Forget() error {
+	l.Debugln("stats.DeviceStatisticsReference.Forget:", s.device)
+	if err := s.ns.Delete(lastSeenKey); err != nil {
+		return err
+	}
+	return s.ns.Delete(connDurationKey)
+}
@@ -87,6 +87,17 @@ func (s *FolderStatisticsReference) GetLastScanTime() (time.Time, error) {
 	return lastScan, nil
 }
 
+// GetUnicodeNormalization returns the Unicode normalization policy that was
+// last applied to the folder contents, or an empty string if none was.
+func (s *FolderStatisticsReference) GetUnicodeNormalization() (string, error) {
+	policy, _, err := s.ns.String("unicodeNormalization")
+	return policy, err
+}
+
+func (s *FolderStatisticsReference) SetUnicodeNormalization(policy string) error {
+	return s.ns.PutString("unicodeNormalization", policy)
+}
+
 func (s *FolderStatisticsReference) GetStatistics() (FolderStatistics, error) {
 	lastFile, err := s.GetLastFile()
 	if err != nil {
@@ -8,6 +8,7 @@ package stun
 
 import (
 	"context"
+	"errors"
 	"net"
 	"time"
 
@@ -286,7 +287,45 @@ func (s *Service) String() string {
 }
 
 func (s *Service) isCurrentNATTypePunchable() bool {
-	return s.natType == NATNone || s.natType == NATPortRestricted || s.natType == NATRestricted || s.natType == NATFull || s.natType == NATSymmetricUDPFirewall
+	return IsPunchable(s.natType)
+}
+
+// Check sends a binding request to the STUN server from a socket of its
+// own, returning our address as seen by the server.
+func Check(ctx context.Context, addr string) (*Host, error) {
+	udpAddr, err := net.ResolveUDPAddr("udp", addr)
+	if err != nil {
+		return nil, err
+	}
+	conn, err := net.ListenPacket("udp", ":0")
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	client := stun.NewClientWithConnection(conn)
+	client.SetSoftwareName("")
+	client.SetServerAddr(udpAddr.String())
+
+	var extAddr *Host
+	err = svcutil.CallWithContext(ctx, func() error {
+		var err error
+		extAddr, err = client.Keepalive()
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	if extAddr == nil {
+		return nil, errors.New("no address in response")
+	}
+	return extAddr, nil
+}
+
+// IsPunchable returns true if the NAT type keeps the external port the same
+// for all destinations, so that holes can be punched through it.
+func IsPunchable(natType NATType) bool {
+	return natType == NATNone || natType == NATPortRestricted || natType == NATRestricted || natType == NATFull || natType == NATSymmetricUDPFirewall
 }
 
 func areDifferent(first, second *Host) bool {
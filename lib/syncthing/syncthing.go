@@ -34,12 +34,15 @@ import (
 	"github.com/syncthing/syncthing/lib/locations"
 	"github.com/syncthing/syncthing/lib/logger"
 	"github.com/syncthing/syncthing/lib/model"
+	"github.com/syncthing/syncthing/lib/mqtt"
 	"github.com/syncthing/syncthing/lib/osutil"
 	"github.com/syncthing/syncthing/lib/protocol"
 	"github.com/syncthing/syncthing/lib/svcutil"
 	"github.com/syncthing/syncthing/lib/tlsutil"
 	"github.com/syncthing/syncthing/lib/upgrade"
 	"github.com/syncthing/syncthing/lib/ur"
+	"github.com/syncthing/syncthing/lib/weakhash"
+	"github.com/syncthing/syncthing/lib/webhook"
 )
 
 const (
@@ -57,6 +60,9 @@ type Options struct {
 	ProfilerAddr   string
 	ResetDeltaIdxs bool
 	Verbose        bool
+	// Keep the events for the API in the database, to be available
+	// across restarts
+	PersistentEvents bool
 	// null duration means use default value
 	DBRecheckInterval    time.Duration
 	DBIndirectGCInterval time.Duration
@@ -124,6 +130,9 @@ func (a *App) Start() error {
 func (a *App) startup() error {
 	a.mainService.Add(ur.NewFailureHandler(a.cfg, a.evLogger))
 
+	a.mainService.Add(webhook.NewCompletionService(a.cfg, a.evLogger))
+	a.mainService.Add(webhook.NewEventService(a.cfg, a.evLogger))
+
 	a.mainService.Add(a.ll)
 
 	if a.opts.AuditWriter != nil {
@@ -140,8 +149,14 @@ func (a *App) startup() error {
 	// Event subscription for the API; must start early to catch the early
 	// events. The LocalChangeDetected event might overwhelm the event
 	// receiver in some situations so we will not subscribe to it here.
-	defaultSub := events.NewBufferedSubscription(a.evLogger.Subscribe(api.DefaultEventMask), api.EventSubBufferSize)
-	diskSub := events.NewBufferedSubscription(a.evLogger.Subscribe(api.DiskEventMask), api.EventSubBufferSize)
+	var defaultSub, diskSub events.BufferedSubscription
+	if a.opts.PersistentEvents {
+		defaultSub = events.NewPersistentBufferedSubscription(a.evLogger.Subscribe(api.DefaultEventMask), api.EventSubBufferSize, db.NewEventLogNamespace(a.ll, "default"))
+		diskSub = events.NewPersistentBufferedSubscription(a.evLogger.Subscribe(api.DiskEventMask), api.EventSubBufferSize, db.NewEventLogNamespace(a.ll, "disk"))
+	} else {
+		defaultSub = events.NewBufferedSubscription(a.evLogger.Subscribe(api.DefaultEventMask), api.EventSubBufferSize)
+		diskSub = events.NewBufferedSubscription(a.evLogger.Subscribe(api.DiskEventMask), api.EventSubBufferSize)
+	}
 
 	// Attempt to increase the limit on number of open files to the maximum
 	// allowed, in case we have many peers. We don't really care enough to
@@ -165,6 +180,8 @@ func (a *App) startup() error {
 		return err
 	}
 
+	a.mainService.Add(mqtt.NewService(a.cfg, a.myID, a.evLogger))
+
 	if len(a.opts.ProfilerAddr) > 0 {
 		go func() {
 			l.Debugln("Starting profiler on", a.opts.ProfilerAddr)
@@ -177,6 +194,9 @@ func (a *App) startup() error {
 		}()
 	}
 
+	algo := weakhash.SelectFastest(context.Background(), 150*time.Millisecond)
+	l.Infof("Using %v for weak hashes", algo)
+
 	perf := ur.CpuBench(context.Background(), 3, 150*time.Millisecond, true)
 	l.Infof("Hashing performance is %.02f MB/s", perf)
 
@@ -192,6 +212,7 @@ func (a *App) startup() error {
 
 	protectedFiles := []string{
 		locations.Get(locations.Database),
+		locations.Get(locations.SQLiteDB),
 		locations.Get(locations.ConfigFile),
 		locations.Get(locations.CertFile),
 		locations.Get(locations.KeyFile),
@@ -274,7 +295,7 @@ func (a *App) startup() error {
 
 	connRegistry := registry.New()
 	discoveryManager := discover.NewManager(a.myID, a.cfg, a.cert, a.evLogger, addrLister, connRegistry)
-	connectionsService := connections.NewService(a.cfg, a.myID, m, tlsCfg, discoveryManager, bepProtocolName, tlsDefaultCommonName, a.evLogger, connRegistry, keyGen)
+	connectionsService := connections.NewService(a.cfg, a.myID, m, tlsCfg, discoveryManager, bepProtocolName, tlsDefaultCommonName, a.evLogger, connRegistry, keyGen, miscDB, db.NewIdentityLogNamespace(a.ll))
 
 	addrLister.AddressLister = connectionsService
 
@@ -151,3 +151,14 @@ func copyFile(src, dst string) error {
 func OpenDBBackend(path string, tuning config.Tuning) (backend.Backend, error) {
 	return backend.Open(path, backend.Tuning(tuning))
 }
+
+// OpenConfiguredDBBackend opens the database with the backend and tuning
+// set in the options. The SQLite database takes over the contents of the
+// LevelDB database the first time it's opened.
+func OpenConfiguredDBBackend(opts config.OptionsConfiguration) (backend.Backend, error) {
+	ldbPath := locations.Get(locations.Database)
+	if opts.DatabaseBackend == config.DatabaseBackendSQLite {
+		return backend.OpenSQLiteMigrating(locations.Get(locations.SQLiteDB), ldbPath, backend.Tuning(opts.DatabaseTuning))
+	}
+	return OpenDBBackend(ldbPath, opts.DatabaseTuning)
+}
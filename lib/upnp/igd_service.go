@@ -38,6 +38,7 @@ import (
 	"errors"
 	"fmt"
 	"net"
+	"net/url"
 	"time"
 
 	"github.com/syncthing/syncthing/lib/nat"
@@ -267,6 +268,19 @@ func (s *IGDService) SupportsIPVersion(version nat.IPVersion) bool {
 	return true
 }
 
+// GatewayIP returns the address of the device, from the control URL
+func (s *IGDService) GatewayIP() net.IP {
+	u, err := url.Parse(s.URL)
+	if err != nil {
+		return nil
+	}
+	return net.ParseIP(u.Hostname())
+}
+
+func (*IGDService) Preference() int {
+	return nat.PreferenceUPnP
+}
+
 // ID returns a unique ID for the service
 func (s *IGDService) ID() string {
 	return s.UUID + "/" + s.Device.FriendlyName + "/" + s.ServiceID + "/" + s.URN + "/" + s.URL
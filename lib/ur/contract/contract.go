@@ -89,6 +89,8 @@ type Report struct {
 	Uptime  int    `json:"uptime,omitempty" metric:"uptime_seconds,summary" since:"3"`
 	NATType string `json:"natType,omitempty" metric:"nat_detection,gaugeVec:type" since:"3"`
 
+	WeakHashAlgorithm string `json:"weakHashAlgorithm,omitempty" metric:"weakhash_algorithm,gaugeVec:algorithm" since:"3"`
+
 	AlwaysLocalNets            bool `json:"alwaysLocalNets,omitempty" metric:"feature_count{feature=AlwaysLocalNets},gauge" since:"3"`
 	CacheIgnoredFiles          bool `json:"cacheIgnoredFiles,omitempty" metric:"feature_count{feature=CacheIgnoredFiles},gauge" since:"3"`
 	OverwriteRemoteDeviceNames bool `json:"overwriteRemoteDeviceNames,omitempty" metric:"feature_count{feature=OverwriteRemoteDeviceNames},gauge" since:"3"`
@@ -129,6 +131,8 @@ type Report struct {
 		SyncXattrs              int            `json:"syncXattrs,omitempty" metric:"folder_feature{feature=SyncXattrs},summary" since:"3"`
 		SendOwnership           int            `json:"sendOwnership,omitempty" metric:"folder_feature{feature=SendOwnership},summary" since:"3"`
 		SyncOwnership           int            `json:"syncOwnership,omitempty" metric:"folder_feature{feature=SyncOwnership},summary" since:"3"`
+		PluginVersioning        int            `json:"pluginVersioning,omitempty" metric:"folder_feature{feature=VersioningPlugin},summary" since:"3"`
+		ResticVersioning        int            `json:"resticVersioning,omitempty" metric:"folder_feature{feature=VersioningRestic},summary" since:"3"`
 	} `json:"folderUsesV3,omitempty" since:"3"`
 
 	DeviceUsesV3 struct {
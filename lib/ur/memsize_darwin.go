@@ -8,7 +8,9 @@ package ur
 
 import "golang.org/x/sys/unix"
 
-func memorySize() int64 {
+// MemorySize returns the amount of physical memory in bytes, or zero if
+// it cannot be determined.
+func MemorySize() int64 {
 	mem, err := unix.SysctlUint64("hw.memsize")
 	if err != nil {
 		return 0
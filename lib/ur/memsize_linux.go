@@ -13,7 +13,9 @@ import (
 	"strings"
 )
 
-func memorySize() int64 {
+// MemorySize returns the amount of physical memory in bytes, or zero if
+// it cannot be determined.
+func MemorySize() int64 {
 	f, err := os.Open("/proc/meminfo")
 	if err != nil {
 		return 0
@@ -12,7 +12,9 @@ import (
 	"strings"
 )
 
-func memorySize() int64 {
+// MemorySize returns the amount of physical memory in bytes, or zero if
+// it cannot be determined.
+func MemorySize() int64 {
 	cmd := exec.Command("/sbin/sysctl", "hw.physmem64")
 	out, err := cmd.Output()
 	if err != nil {
@@ -14,7 +14,9 @@ import (
 	"strconv"
 )
 
-func memorySize() int64 {
+// MemorySize returns the amount of physical memory in bytes, or zero if
+// it cannot be determined.
+func MemorySize() int64 {
 	cmd := exec.Command("prtconf", "-m")
 	out, err := cmd.CombinedOutput()
 	if err != nil {
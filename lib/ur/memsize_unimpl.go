@@ -9,6 +9,8 @@
 
 package ur
 
-func memorySize() int64 {
+// MemorySize returns the amount of physical memory in bytes, or zero if
+// it cannot be determined.
+func MemorySize() int64 {
 	return 0
 }
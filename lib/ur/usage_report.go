@@ -11,6 +11,8 @@ import (
 	"context"
 	"crypto/tls"
 	"encoding/json"
+	"fmt"
+	"io"
 	"math/rand"
 	"net"
 	"net/http"
@@ -29,6 +31,7 @@ import (
 	"github.com/syncthing/syncthing/lib/scanner"
 	"github.com/syncthing/syncthing/lib/upgrade"
 	"github.com/syncthing/syncthing/lib/ur/contract"
+	"github.com/syncthing/syncthing/lib/weakhash"
 )
 
 // Current version number of the usage report, for acceptance purposes. If
@@ -41,6 +44,17 @@ var StartTime = time.Now().Truncate(time.Second)
 type Model interface {
 	DBSnapshot(folder string) (*db.Snapshot, error)
 	UsageReportingStats(report *contract.Report, version int, preview bool)
+	ForwardUsageReport(device protocol.DeviceID, report []byte) error
+	ForwardedUsageReports() []ForwardedReport
+	DismissForwardedUsageReport(device protocol.DeviceID, received time.Time)
+}
+
+// ForwardedReport is the usage report of a device without Internet access,
+// forwarded for us to upload, or to keep for manual submission.
+type ForwardedReport struct {
+	Device   protocol.DeviceID `json:"device"`
+	Received time.Time         `json:"received"`
+	Report   json.RawMessage   `json:"report"`
 }
 
 type Service struct {
@@ -116,7 +130,7 @@ func (s *Service) reportData(ctx context.Context, urVersion int, preview bool) (
 	report.MemoryUsageMiB = int((mem.Sys - mem.HeapReleased) / 1024 / 1024)
 	report.SHA256Perf = CpuBench(ctx, 5, 125*time.Millisecond, false)
 	report.HashPerf = CpuBench(ctx, 5, 125*time.Millisecond, true)
-	report.MemorySize = int(memorySize() / 1024 / 1024)
+	report.MemorySize = int(MemorySize() / 1024 / 1024)
 	report.NumCPU = runtime.NumCPU()
 
 	for _, cfg := range s.cfg.Folders() {
@@ -150,6 +164,8 @@ func (s *Service) reportData(ctx context.Context, urVersion int, preview bool) (
 			report.FolderUses.ExternalVersioning++
 		case "trashcan":
 			report.FolderUses.TrashcanVersioning++
+		case "plugin", "restic":
+			// Counted with the v3 folder uses
 		default:
 			l.Warnf("Unhandled versioning type for usage reports: %s", cfg.Versioning.Type)
 		}
@@ -214,12 +230,13 @@ func (s *Service) reportData(ctx context.Context, urVersion int, preview bool) (
 	if urVersion >= 3 {
 		report.Uptime = s.UptimeS()
 		report.NATType = s.connectionsService.NATType()
+		report.WeakHashAlgorithm = weakhash.Selected().String()
 		report.AlwaysLocalNets = len(opts.AlwaysLocalNets) > 0
 		report.CacheIgnoredFiles = opts.CacheIgnoredFiles
 		report.OverwriteRemoteDeviceNames = opts.OverwriteRemoteDevNames
 		report.ProgressEmitterEnabled = opts.ProgressUpdateIntervalS > -1
 		report.CustomDefaultFolderPath = defaultFolder.Path != "~"
-		report.CustomTrafficClass = opts.TrafficClass != 0
+		report.CustomTrafficClass = opts.TrafficClass != 0 || opts.TrafficClassLAN != 0 || opts.TrafficClassWAN != 0
 		report.CustomTempIndexMinBlocks = opts.TempIndexMinBlocks != 10
 		report.TemporariesDisabled = opts.KeepTemporariesH == 0
 		report.TemporariesCustom = opts.KeepTemporariesH != 24
@@ -286,6 +303,12 @@ func (s *Service) reportData(ctx context.Context, urVersion int, preview bool) (
 			if cfg.SyncOwnership {
 				report.FolderUsesV3.SyncOwnership++
 			}
+			switch cfg.Versioning.Type {
+			case "plugin":
+				report.FolderUsesV3.PluginVersioning++
+			case "restic":
+				report.FolderUsesV3.ResticVersioning++
+			}
 		}
 		sort.Ints(report.FolderUsesV3.FsWatcherDelays)
 
@@ -364,6 +387,52 @@ func (s *Service) sendUsageReport(ctx context.Context) error {
 		return err
 	}
 
+	if devices := s.forwardDevices(); len(devices) > 0 {
+		// Another device uploads the report for us, typically as we
+		// don't have Internet access ourselves.
+		for _, device := range devices {
+			if err = s.model.ForwardUsageReport(device, b.Bytes()); err == nil {
+				l.Debugln("Forwarded usage report to", device)
+				return nil
+			}
+			l.Debugf("Forwarding usage report to %v: %v", device, err)
+		}
+		return fmt.Errorf("forwarding: %w", err)
+	}
+
+	return s.post(ctx, &b)
+}
+
+// forwardDevices returns the devices to forward our usage report to, in
+// the order to try them.
+func (s *Service) forwardDevices() []protocol.DeviceID {
+	var devices []protocol.DeviceID
+	for _, dev := range s.cfg.DeviceList() {
+		if dev.ForwardUsageReports && dev.DeviceID != s.cfg.MyID() {
+			devices = append(devices, dev.DeviceID)
+		}
+	}
+	return devices
+}
+
+// uploadForwardedReports uploads the usage reports forwarded by other
+// devices, unless they are kept for manual submission.
+func (s *Service) uploadForwardedReports(ctx context.Context) {
+	if s.cfg.Options().URStoreForwarded {
+		return
+	}
+	for _, report := range s.model.ForwardedUsageReports() {
+		if err := s.post(ctx, bytes.NewReader(report.Report)); err != nil {
+			l.Infof("Uploading usage report forwarded by %v: %v", report.Device, err)
+			continue
+		}
+		s.model.DismissForwardedUsageReport(report.Device, report.Received)
+		l.Infof("Uploaded usage report forwarded by %v", report.Device)
+	}
+}
+
+// post uploads a usage report to the usage reporting server.
+func (s *Service) post(ctx context.Context, body io.Reader) error {
 	client := &http.Client{
 		Transport: &http.Transport{
 			DialContext: dialer.DialContext,
@@ -375,7 +444,7 @@ func (s *Service) sendUsageReport(ctx context.Context) error {
 			},
 		},
 	}
-	req, err := http.NewRequestWithContext(ctx, "POST", s.cfg.Options().URURL, &b)
+	req, err := http.NewRequestWithContext(ctx, "POST", s.cfg.Options().URURL, body)
 	if err != nil {
 		return err
 	}
@@ -408,6 +477,9 @@ func (s *Service) Serve(ctx context.Context) error {
 					l.Infof("Sent usage report (version %d)", s.cfg.Options().URAccepted)
 				}
 			}
+			// The reports forwarded by others go along with ours, whether
+			// or not we report ourselves.
+			s.uploadForwardedReports(ctx)
 			t.Reset(24 * time.Hour) // next report tomorrow
 		}
 	}
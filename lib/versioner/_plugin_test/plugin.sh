@@ -0,0 +1,39 @@
+#!/bin/sh
+
+# A versioner plugin for the tests, keeping the versions of files at the
+# top of the folder in a "versions" directory next to it.
+
+req=$(cat)
+field() {
+	printf '%s' "$req" | sed -n "s/.*\"$1\":\"\([^\"]*\)\".*/\1/p"
+}
+folder=$(field folderPath)
+file=$(field filePath)
+store="$folder/../versions"
+
+case $(field op) in
+archive)
+	mkdir -p "$store"
+	mv "$folder/$file" "$store/$file"
+	;;
+versions)
+	printf '{"versions":{'
+	sep=""
+	for f in "$store"/*; do
+		[ -f "$f" ] || continue
+		printf '%s"%s":[{"versionTime":"2026-01-02T03:04:05Z","modTime":"2026-01-02T03:04:05Z","size":%d}]' "$sep" "$(basename "$f")" "$(($(wc -c < "$f")))"
+		sep=","
+	done
+	printf '}}'
+	;;
+restore)
+	if [ ! -f "$store/$file" ]; then
+		printf '{"error":"no version of %s"}' "$file"
+		exit 0
+	fi
+	mv "$store/$file" "$folder/$file"
+	;;
+*)
+	printf '{"unsupported":true}'
+	;;
+esac
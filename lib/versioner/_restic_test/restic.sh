@@ -0,0 +1,55 @@
+#!/bin/sh
+
+# Enough of restic for the tests, keeping snapshots of single files as
+# plain files in the repository directory.
+
+repo="$RESTIC_REPOSITORY"
+cmd=$1
+shift
+
+case $cmd in
+backup)
+	while [ $# -gt 0 ]; do
+		case $1 in
+		--stdin-filename) path=$2; shift ;;
+		--tag) tag=$2; shift ;;
+		esac
+		shift
+	done
+	n=$(ls "$repo" | grep -c '\.json$')
+	id="snap$n"
+	cat > "$repo/$id.data"
+	size=$(($(wc -c < "$repo/$id.data")))
+	printf '{"id":"%s","time":"2026-01-02T03:04:0%d.5Z","paths":["%s"],"tags":["%s"],"summary":{"total_bytes_processed":%d}}' "$id" "$n" "$path" "$tag" "$size" > "$repo/$id.json"
+	;;
+snapshots)
+	while [ $# -gt 0 ]; do
+		case $1 in
+		--path) path=$2; shift ;;
+		esac
+		shift
+	done
+	printf '['
+	sep=""
+	for f in "$repo"/*.json; do
+		[ -f "$f" ] || continue
+		if [ -n "$path" ] && ! grep -q "\"paths\":\[\"$path\"\]" "$f"; then
+			continue
+		fi
+		printf '%s' "$sep"
+		cat "$f"
+		sep=","
+	done
+	printf ']'
+	;;
+dump)
+	cat "$repo/$1.data"
+	;;
+forget)
+	echo "$@" > "$repo/forget"
+	;;
+*)
+	echo "unknown command $cmd" >&2
+	exit 1
+	;;
+esac
@@ -88,16 +88,7 @@ func (v external) Archive(filePath string) error {
 	}
 
 	cmd := exec.Command(words[0], words[1:]...)
-	env := os.Environ()
-	// filter STGUIAUTH and STGUIAPIKEY from environment variables
-	var filteredEnv []string
-
-	for _, x := range env {
-		if !strings.HasPrefix(x, "STGUIAUTH=") && !strings.HasPrefix(x, "STGUIAPIKEY=") {
-			filteredEnv = append(filteredEnv, x)
-		}
-	}
-	cmd.Env = filteredEnv
+	cmd.Env = commandEnv()
 	combinedOutput, err := cmd.CombinedOutput()
 	l.Debugln("external command output:", string(combinedOutput))
 	if err != nil {
@@ -125,3 +116,15 @@ func (external) Restore(_ string, _ time.Time) error {
 func (external) Clean(_ context.Context) error {
 	return nil
 }
+
+// commandEnv returns the environment for versioning commands, which is our
+// own without the GUI credentials.
+func commandEnv() []string {
+	var filteredEnv []string
+	for _, x := range os.Environ() {
+		if !strings.HasPrefix(x, "STGUIAUTH=") && !strings.HasPrefix(x, "STGUIAPIKEY=") {
+			filteredEnv = append(filteredEnv, x)
+		}
+	}
+	return filteredEnv
+}
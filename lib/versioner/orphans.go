@@ -0,0 +1,82 @@
+// Copyright (C) 2026 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package versioner
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/syncthing/syncthing/lib/config"
+	"github.com/syncthing/syncthing/lib/fs"
+)
+
+// RemoveOrphans removes the versions archived before the cutoff of files
+// that no longer exist in the folder, according to the exists function.
+// When dryRun is set nothing is removed. It returns the paths of the
+// versions, relative to the versions directory, sorted. Only the versioners
+// keeping versions in a directory have anything to remove.
+func RemoveOrphans(ctx context.Context, cfg config.FolderConfiguration, cutoff time.Time, exists func(name string) bool, dryRun bool) ([]string, error) {
+	switch cfg.Versioning.Type {
+	case "simple", "staggered", "trashcan":
+	default:
+		return nil, nil
+	}
+
+	versionsFs := versionerFsFromFolderCfg(cfg)
+	if _, err := versionsFs.Lstat("."); fs.IsNotExist(err) {
+		return nil, nil
+	}
+
+	var orphans []string
+	dirTracker := make(emptyDirTracker)
+	walkFn := func(path string, info fs.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		default:
+		}
+
+		if info.IsDir() && !info.IsSymlink() {
+			dirTracker.addDir(path)
+			return nil
+		}
+
+		name, tag := UntagFilename(path)
+		versionTime := info.ModTime()
+		if name == "" || tag == "" {
+			// Untagged, as kept by the trash can
+			name = path
+		} else if t, err := time.ParseInLocation(TimeFormat, tag, time.Local); err == nil {
+			versionTime = t
+		}
+
+		if versionTime.After(cutoff) || exists(name) {
+			dirTracker.addFile(path)
+			return nil
+		}
+		orphans = append(orphans, path)
+		if dryRun {
+			dirTracker.addFile(path)
+			return nil
+		}
+		return versionsFs.Remove(path)
+	}
+
+	if err := versionsFs.Walk(".", walkFn); err != nil {
+		return orphans, err
+	}
+	if !dryRun {
+		dirTracker.deleteEmptyDirs(versionsFs)
+	}
+
+	sort.Strings(orphans)
+	return orphans, nil
+}
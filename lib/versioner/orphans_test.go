@@ -0,0 +1,87 @@
+// Copyright (C) 2026 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package versioner
+
+import (
+	"context"
+	"path/filepath"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/syncthing/syncthing/lib/config"
+	"github.com/syncthing/syncthing/lib/fs"
+)
+
+func TestRemoveOrphans(t *testing.T) {
+	now := time.Now()
+	old := now.Add(-48 * time.Hour).Format(TimeFormat)
+	recent := now.Add(-time.Hour).Format(TimeFormat)
+
+	for _, dryRun := range []bool{true, false} {
+		versionsDir := t.TempDir()
+		cfg := config.FolderConfiguration{
+			FilesystemType: fs.FilesystemTypeBasic,
+			Path:           t.TempDir(),
+			Versioning: config.VersioningConfiguration{
+				Type:   "simple",
+				FSType: fs.FilesystemTypeBasic,
+				FSPath: versionsDir,
+			},
+		}
+		versionsFs := fs.NewFilesystem(fs.FilesystemTypeBasic, versionsDir)
+
+		orphan := TagFilename("deleted.txt", old)
+		nested := filepath.Join("dir", TagFilename("gone", old))
+		kept := []string{
+			TagFilename("existing.txt", old),    // file still exists
+			TagFilename("deleted2.txt", recent), // too recent
+		}
+		if err := versionsFs.MkdirAll("dir", 0o755); err != nil {
+			t.Fatal(err)
+		}
+		for _, name := range append([]string{orphan, nested}, kept...) {
+			writeFile(t, versionsFs, name, "data")
+		}
+
+		exists := func(name string) bool { return name == "existing.txt" }
+		removed, err := RemoveOrphans(context.Background(), cfg, now.Add(-24*time.Hour), exists, dryRun)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if expected := []string{orphan, nested}; !slices.Equal(removed, expected) {
+			t.Fatalf("dry run %v: got %v, expected %v", dryRun, removed, expected)
+		}
+
+		for _, name := range kept {
+			if _, err := versionsFs.Lstat(name); err != nil {
+				t.Errorf("dry run %v: %s should be kept: %v", dryRun, name, err)
+			}
+		}
+		_, err = versionsFs.Lstat(orphan)
+		if dryRun && err != nil {
+			t.Errorf("dry run: %s should not be removed: %v", orphan, err)
+		} else if !dryRun && !fs.IsNotExist(err) {
+			t.Errorf("%s should be removed: %v", orphan, err)
+		}
+		if _, err := versionsFs.Lstat("dir"); !dryRun && !fs.IsNotExist(err) {
+			t.Errorf("empty directory should be removed: %v", err)
+		}
+	}
+}
+
+func TestRemoveOrphansUnsupported(t *testing.T) {
+	cfg := config.FolderConfiguration{
+		FilesystemType: fs.FilesystemTypeBasic,
+		Path:           t.TempDir(),
+		Versioning:     config.VersioningConfiguration{Type: "external"},
+	}
+	removed, err := RemoveOrphans(context.Background(), cfg, time.Now(), func(string) bool { return false }, false)
+	if err != nil || len(removed) != 0 {
+		t.Errorf("expected nothing for external versioning, got %v, %v", removed, err)
+	}
+}
@@ -0,0 +1,242 @@
+// Copyright (C) 2026 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package versioner
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os/exec"
+	"strings"
+	"time"
+
+	"github.com/syncthing/syncthing/lib/build"
+	"github.com/syncthing/syncthing/lib/config"
+	"github.com/syncthing/syncthing/lib/fs"
+
+	"github.com/kballard/go-shellquote"
+)
+
+func init() {
+	// Register the constructor for this type of versioner with the name "plugin"
+	factories["plugin"] = newPlugin
+}
+
+// PluginProtocolVersion is the version of the protocol spoken with
+// versioner plugins, sent with every request.
+const PluginProtocolVersion = 1
+
+// The operations a versioner plugin is asked to perform, one per run.
+const (
+	// Take the file away from the folder and keep it as a version.
+	PluginOpArchive = "archive"
+	// List the versions kept, like Versioner.GetVersions.
+	PluginOpVersions = "versions"
+	// Put the version of the file from the given time back in the folder.
+	PluginOpRestore = "restore"
+	// Apply the retention scheme, removing versions no longer needed.
+	PluginOpClean = "clean"
+)
+
+// PluginRequest is written as JSON to the standard input of a versioner
+// plugin. A plugin is a program implementing its own retention scheme,
+// configured per folder as the "plugin" versioning type with the command
+// to run in the "command" parameter. It is run once per operation.
+type PluginRequest struct {
+	Version          int               `json:"version"`
+	Op               string            `json:"op"`
+	FolderID         string            `json:"folderID"`
+	FolderPath       string            `json:"folderPath"`
+	FolderFilesystem string            `json:"folderFilesystem"`
+	Params           map[string]string `json:"params,omitempty"` // the versioning parameters, except the command
+	FilePath         string            `json:"filePath,omitempty"`
+	VersionTime      time.Time         `json:"versionTime"`
+}
+
+// PluginResponse is read as JSON from the standard output of a versioner
+// plugin, after it exits successfully. No output is the same as an empty
+// response.
+type PluginResponse struct {
+	// Error fails the operation with the given message.
+	Error string `json:"error,omitempty"`
+	// Unsupported tells that the plugin doesn't implement the operation,
+	// e.g. when versions can't be listed or restored.
+	Unsupported bool `json:"unsupported,omitempty"`
+	// Versions answers the versions operation.
+	Versions map[string][]FileVersion `json:"versions,omitempty"`
+}
+
+type plugin struct {
+	command    string
+	folderID   string
+	params     map[string]string
+	filesystem fs.Filesystem
+}
+
+func newPlugin(cfg config.FolderConfiguration) Versioner {
+	command := cfg.Versioning.Params["command"]
+
+	if build.IsWindows {
+		command = strings.ReplaceAll(command, `\`, `\\`)
+	}
+
+	params := make(map[string]string, len(cfg.Versioning.Params))
+	for k, v := range cfg.Versioning.Params {
+		if k != "command" {
+			params[k] = v
+		}
+	}
+
+	s := plugin{
+		command:    command,
+		folderID:   cfg.ID,
+		params:     params,
+		filesystem: cfg.Filesystem(nil),
+	}
+
+	l.Debugf("instantiated %#v", s)
+	return s
+}
+
+// Archive has the plugin take the named file away. If this function
+// returns nil, the named file does not exist any more (has been archived).
+func (v plugin) Archive(filePath string) error {
+	info, err := v.filesystem.Lstat(filePath)
+	if fs.IsNotExist(err) {
+		l.Debugln("not archiving nonexistent file", filePath)
+		return nil
+	} else if err != nil {
+		return err
+	}
+	if info.IsSymlink() {
+		panic("bug: attempting to version a symlink")
+	}
+
+	l.Debugln("archiving", filePath)
+
+	resp, err := v.run(context.Background(), PluginRequest{Op: PluginOpArchive, FilePath: filePath})
+	if err != nil {
+		return err
+	}
+	if resp.Unsupported {
+		return errors.New("plugin doesn't support archiving")
+	}
+
+	// return error if the file was not removed
+	if _, err = v.filesystem.Lstat(filePath); fs.IsNotExist(err) {
+		return nil
+	}
+	return errors.New("file was not removed by plugin")
+}
+
+func (v plugin) GetVersions() (map[string][]FileVersion, error) {
+	resp, err := v.run(context.Background(), PluginRequest{Op: PluginOpVersions})
+	if err != nil {
+		return nil, err
+	}
+	if resp.Unsupported {
+		return nil, ErrRestorationNotSupported
+	}
+	if resp.Versions == nil {
+		return map[string][]FileVersion{}, nil
+	}
+	return resp.Versions, nil
+}
+
+func (v plugin) Restore(filePath string, versionTime time.Time) error {
+	// If something already exists where we are restoring to, have it
+	// versioned first, like the built in versioners do.
+	if info, err := v.filesystem.Lstat(filePath); err == nil {
+		switch {
+		case info.IsDir():
+			return ErrDirectory
+		case info.IsSymlink():
+			if err := v.filesystem.Remove(filePath); err != nil {
+				return fmt.Errorf("removing existing symlink: %w", err)
+			}
+		case info.IsRegular():
+			if err := v.Archive(filePath); err != nil {
+				return fmt.Errorf("archiving existing file: %w", err)
+			}
+		default:
+			panic("bug: unknown item type")
+		}
+	} else if !fs.IsNotExist(err) {
+		return err
+	}
+
+	resp, err := v.run(context.Background(), PluginRequest{Op: PluginOpRestore, FilePath: filePath, VersionTime: versionTime})
+	if err != nil {
+		return err
+	}
+	if resp.Unsupported {
+		return ErrRestorationNotSupported
+	}
+
+	if _, err := v.filesystem.Lstat(filePath); err != nil {
+		return fmt.Errorf("file was not restored by plugin: %w", err)
+	}
+	return nil
+}
+
+func (v plugin) Clean(ctx context.Context) error {
+	// Plugins without a retention scheme of their own simply don't
+	// support cleaning, which is fine.
+	_, err := v.run(ctx, PluginRequest{Op: PluginOpClean})
+	return err
+}
+
+// run runs the plugin for the request and returns its response, or an
+// error if it couldn't be run, failed, or responded with an error.
+func (v plugin) run(ctx context.Context, req PluginRequest) (PluginResponse, error) {
+	if v.command == "" {
+		return PluginResponse{}, errors.New("command is empty, please enter a valid command")
+	}
+
+	words, err := shellquote.Split(v.command)
+	if err != nil {
+		return PluginResponse{}, fmt.Errorf("command is invalid: %w", err)
+	}
+
+	req.Version = PluginProtocolVersion
+	req.FolderID = v.folderID
+	req.FolderPath = v.filesystem.URI()
+	req.FolderFilesystem = v.filesystem.Type().String()
+	req.Params = v.params
+	input, err := json.Marshal(req)
+	if err != nil {
+		return PluginResponse{}, err
+	}
+
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, words[0], words[1:]...)
+	cmd.Env = commandEnv()
+	cmd.Stdin = bytes.NewReader(input)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	err = cmd.Run()
+	l.Debugf("plugin %s output: %s, stderr: %s", req.Op, stdout.String(), stderr.String())
+	if err != nil {
+		if stderr.Len() > 0 {
+			return PluginResponse{}, fmt.Errorf("%v: %v", err, strings.TrimSpace(stderr.String()))
+		}
+		return PluginResponse{}, err
+	}
+
+	var resp PluginResponse
+	if out := bytes.TrimSpace(stdout.Bytes()); len(out) > 0 {
+		if err := json.Unmarshal(out, &resp); err != nil {
+			return PluginResponse{}, fmt.Errorf("invalid response from plugin: %w", err)
+		}
+	}
+	if resp.Error != "" {
+		return PluginResponse{}, errors.New(resp.Error)
+	}
+	return resp, nil
+}
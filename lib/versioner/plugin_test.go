@@ -0,0 +1,96 @@
+// Copyright (C) 2026 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package versioner
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/syncthing/syncthing/lib/build"
+	"github.com/syncthing/syncthing/lib/config"
+	"github.com/syncthing/syncthing/lib/fs"
+)
+
+func TestPlugin(t *testing.T) {
+	if build.IsWindows {
+		t.Skip("the test plugin is a shell script")
+	}
+
+	command, err := filepath.Abs("_plugin_test/plugin.sh")
+	if err != nil {
+		t.Fatal(err)
+	}
+	dir := t.TempDir()
+	folder := filepath.Join(dir, "folder")
+	if err := os.Mkdir(folder, 0o755); err != nil {
+		t.Fatal(err)
+	}
+	file := filepath.Join(folder, "file.txt")
+	if err := os.WriteFile(file, []byte("hello"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	v, err := New(config.FolderConfiguration{
+		ID:             "folder",
+		FilesystemType: fs.FilesystemTypeBasic,
+		Path:           folder,
+		Versioning: config.VersioningConfiguration{
+			Type:   "plugin",
+			Params: map[string]string{"command": command, "keep": "3"},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := v.Archive("file.txt"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := os.Lstat(file); !os.IsNotExist(err) {
+		t.Error("file should have been taken away by the plugin")
+	}
+
+	versions, err := v.GetVersions()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(versions["file.txt"]) != 1 || versions["file.txt"][0].Size != 5 {
+		t.Fatalf("unexpected versions %v", versions)
+	}
+
+	if err := v.Restore("file.txt", versions["file.txt"][0].VersionTime); err != nil {
+		t.Fatal(err)
+	}
+	if bs, err := os.ReadFile(file); err != nil || string(bs) != "hello" {
+		t.Errorf("file should have been restored, got %q, %v", bs, err)
+	}
+
+	// The plugin responds with an error when it has no version.
+	if err := v.Restore("other.txt", time.Now()); err == nil {
+		t.Error("restoring a file without versions should fail")
+	}
+
+	// The test plugin doesn't clean, which isn't an error.
+	if err := v.Clean(context.Background()); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestPluginNoCommand(t *testing.T) {
+	v := newPlugin(config.FolderConfiguration{
+		FilesystemType: fs.FilesystemTypeBasic,
+		Path:           t.TempDir(),
+		Versioning:     config.VersioningConfiguration{Type: "plugin"},
+	})
+	if _, err := v.GetVersions(); err == nil || errors.Is(err, ErrRestorationNotSupported) {
+		t.Error("expected an error for the missing command, got", err)
+	}
+}
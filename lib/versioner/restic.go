@@ -0,0 +1,268 @@
+// Copyright (C) 2026 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package versioner
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os/exec"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/syncthing/syncthing/lib/config"
+	"github.com/syncthing/syncthing/lib/fs"
+	"github.com/syncthing/syncthing/lib/osutil"
+)
+
+func init() {
+	// Register the constructor for this type of versioner with the name "restic"
+	factories["restic"] = newRestic
+}
+
+// The retention policy parameters, passed on to restic forget as the
+// corresponding --keep-* options. Versions are grouped by file, so that
+// the policy applies to the versions of each file separately.
+var resticKeepParams = [][2]string{
+	{"keepLast", "--keep-last"},
+	{"keepHourly", "--keep-hourly"},
+	{"keepDaily", "--keep-daily"},
+	{"keepWeekly", "--keep-weekly"},
+	{"keepMonthly", "--keep-monthly"},
+	{"keepYearly", "--keep-yearly"},
+}
+
+// The restic versioner stores versions as snapshots in a restic
+// repository, local or remote, which deduplicates and encrypts them. Each
+// version is a snapshot of just the file, tagged with the folder ID, so
+// that one repository can be shared by several folders and devices.
+type restic struct {
+	folderFs     fs.Filesystem
+	binary       string
+	repository   string
+	passwordFile string
+	tag          string
+	keep         []string
+}
+
+func newRestic(cfg config.FolderConfiguration) Versioner {
+	params := cfg.Versioning.Params
+	binary := params["resticPath"]
+	if binary == "" {
+		binary = "restic"
+	}
+
+	var keep []string
+	for _, p := range resticKeepParams {
+		if n, err := strconv.Atoi(params[p[0]]); err == nil && n > 0 {
+			keep = append(keep, p[1], strconv.Itoa(n))
+		}
+	}
+
+	s := &restic{
+		folderFs:     cfg.Filesystem(nil),
+		binary:       binary,
+		repository:   params["repository"],
+		passwordFile: params["passwordFile"],
+		tag:          "syncthing:" + cfg.ID,
+		keep:         keep,
+	}
+
+	l.Debugf("instantiated %#v", s)
+	return s
+}
+
+func (r *restic) String() string {
+	return fmt.Sprintf("restic@%p", r)
+}
+
+// Archive stores the named file in the repository and removes it. If this
+// function returns nil, the named file does not exist any more (has been
+// archived).
+func (r *restic) Archive(filePath string) error {
+	info, err := r.folderFs.Lstat(filePath)
+	if fs.IsNotExist(err) {
+		l.Debugln("not archiving nonexistent file", filePath)
+		return nil
+	} else if err != nil {
+		return err
+	}
+	if info.IsSymlink() {
+		panic("bug: attempting to version a symlink")
+	}
+
+	l.Debugln("archiving", filePath)
+
+	fd, err := r.folderFs.Open(filePath)
+	if err != nil {
+		return err
+	}
+	err = r.run(context.Background(), fd, nil, "backup", "--stdin", "--stdin-filename", resticPath(filePath), "--tag", r.tag)
+	fd.Close()
+	if err != nil {
+		return err
+	}
+
+	return r.folderFs.Remove(filePath)
+}
+
+// resticSnapshot is a snapshot as listed by restic snapshots --json.
+type resticSnapshot struct {
+	ID      string    `json:"id"`
+	Time    time.Time `json:"time"`
+	Paths   []string  `json:"paths"`
+	Summary *struct {
+		TotalBytesProcessed int64 `json:"total_bytes_processed"`
+	} `json:"summary"`
+}
+
+func (r *restic) snapshots(ctx context.Context, paths ...string) ([]resticSnapshot, error) {
+	args := []string{"snapshots", "--json", "--tag", r.tag}
+	for _, p := range paths {
+		args = append(args, "--path", p)
+	}
+	var out bytes.Buffer
+	if err := r.run(ctx, nil, &out, args...); err != nil {
+		return nil, err
+	}
+	var snapshots []resticSnapshot
+	if err := json.Unmarshal(out.Bytes(), &snapshots); err != nil {
+		return nil, fmt.Errorf("parsing snapshots: %w", err)
+	}
+	return snapshots, nil
+}
+
+func (r *restic) GetVersions() (map[string][]FileVersion, error) {
+	snapshots, err := r.snapshots(context.Background())
+	if err != nil {
+		return nil, err
+	}
+
+	files := make(map[string][]FileVersion)
+	for _, snap := range snapshots {
+		if len(snap.Paths) != 1 {
+			// Not one of ours.
+			continue
+		}
+		name := osutil.NormalizedFilename(filepath.FromSlash(strings.TrimPrefix(snap.Paths[0], "/")))
+		version := FileVersion{
+			VersionTime: snap.Time.Truncate(time.Second),
+			// The snapshot is taken when the file is replaced or
+			// deleted, which is the best we know about when it was
+			// last modified.
+			ModTime: snap.Time.Truncate(time.Second),
+		}
+		if snap.Summary != nil {
+			version.Size = snap.Summary.TotalBytesProcessed
+		}
+		files[name] = append(files[name], version)
+	}
+	return files, nil
+}
+
+func (r *restic) Restore(filePath string, versionTime time.Time) error {
+	snapshots, err := r.snapshots(context.Background(), resticPath(filePath))
+	if err != nil {
+		return err
+	}
+	var snapshot *resticSnapshot
+	for i, snap := range snapshots {
+		if snap.Time.Truncate(time.Second).Equal(versionTime) && (snapshot == nil || snap.Time.After(snapshot.Time)) {
+			snapshot = &snapshots[i]
+		}
+	}
+	if snapshot == nil {
+		return errNotFound
+	}
+
+	// If something already exists where we are restoring to, archive the
+	// existing file for versioning, remove if it's a symlink, or fail if
+	// it's a directory.
+	if info, err := r.folderFs.Lstat(filePath); err == nil {
+		switch {
+		case info.IsDir():
+			return ErrDirectory
+		case info.IsSymlink():
+			if err := r.folderFs.Remove(filePath); err != nil {
+				return fmt.Errorf("removing existing symlink: %w", err)
+			}
+		case info.IsRegular():
+			if err := r.Archive(filePath); err != nil {
+				return fmt.Errorf("archiving existing file: %w", err)
+			}
+		default:
+			panic("bug: unknown item type")
+		}
+	} else if !fs.IsNotExist(err) {
+		return err
+	}
+
+	filePath = osutil.NativeFilename(filePath)
+	if err := r.folderFs.MkdirAll(filepath.Dir(filePath), 0o755); err != nil && !fs.IsExist(err) {
+		return err
+	}
+	fd, err := osutil.CreateAtomicFilesystem(r.folderFs, filePath)
+	if err != nil {
+		return err
+	}
+	if err := r.run(context.Background(), nil, fd, "dump", snapshot.ID, resticPath(filePath)); err != nil {
+		// Closing commits what was written, which nothing was in the way
+		// of, so just remove it.
+		if fd.Close() == nil {
+			_ = r.folderFs.Remove(filePath)
+		}
+		return err
+	}
+	return fd.Close()
+}
+
+// Clean applies the retention policy, if any, removing the versions it
+// doesn't keep from the repository.
+func (r *restic) Clean(ctx context.Context) error {
+	if len(r.keep) == 0 {
+		return nil
+	}
+	args := append([]string{"forget", "--tag", r.tag, "--group-by", "paths,tags", "--prune"}, r.keep...)
+	return r.run(ctx, nil, nil, args...)
+}
+
+// run runs restic with the given arguments against the repository.
+func (r *restic) run(ctx context.Context, stdin io.Reader, stdout io.Writer, args ...string) error {
+	if r.repository == "" {
+		return errors.New("repository is empty, please enter a valid repository")
+	}
+
+	var stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, r.binary, args...)
+	cmd.Env = append(commandEnv(), "RESTIC_REPOSITORY="+r.repository)
+	if r.passwordFile != "" {
+		cmd.Env = append(cmd.Env, "RESTIC_PASSWORD_FILE="+r.passwordFile)
+	}
+	cmd.Stdin = stdin
+	cmd.Stdout = stdout
+	cmd.Stderr = &stderr
+	err := cmd.Run()
+	l.Debugf("restic %s stderr: %s", args[0], stderr.String())
+	if err != nil {
+		if stderr.Len() > 0 {
+			return fmt.Errorf("restic %s: %v: %v", args[0], err, strings.TrimSpace(stderr.String()))
+		}
+		return fmt.Errorf("restic %s: %w", args[0], err)
+	}
+	return nil
+}
+
+// resticPath is the path of the file in the snapshots of its versions.
+func resticPath(filePath string) string {
+	return path.Join("/", filepath.ToSlash(filePath))
+}
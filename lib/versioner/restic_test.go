@@ -0,0 +1,112 @@
+// Copyright (C) 2026 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package versioner
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/syncthing/syncthing/lib/build"
+	"github.com/syncthing/syncthing/lib/config"
+	"github.com/syncthing/syncthing/lib/fs"
+)
+
+func TestRestic(t *testing.T) {
+	if build.IsWindows {
+		t.Skip("the test restic is a shell script")
+	}
+
+	binary, err := filepath.Abs("_restic_test/restic.sh")
+	if err != nil {
+		t.Fatal(err)
+	}
+	dir := t.TempDir()
+	folder := filepath.Join(dir, "folder")
+	repo := filepath.Join(dir, "repo")
+	for _, d := range []string{filepath.Join(folder, "dir"), repo} {
+		if err := os.MkdirAll(d, 0o755); err != nil {
+			t.Fatal(err)
+		}
+	}
+	file := filepath.Join(folder, "dir", "file.txt")
+	name := filepath.Join("dir", "file.txt")
+
+	v, err := New(config.FolderConfiguration{
+		ID:             "folder",
+		FilesystemType: fs.FilesystemTypeBasic,
+		Path:           folder,
+		Versioning: config.VersioningConfiguration{
+			Type: "restic",
+			Params: map[string]string{
+				"resticPath": binary,
+				"repository": repo,
+				"keepLast":   "3",
+				"keepDaily":  "7",
+				"keepYearly": "invalid",
+			},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, content := range []string{"first", "second version"} {
+		if err := os.WriteFile(file, []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+		if err := v.Archive(name); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := os.Lstat(file); !os.IsNotExist(err) {
+			t.Fatal("file should have been removed after archiving")
+		}
+	}
+
+	versions, err := v.GetVersions()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(versions) != 1 || len(versions[name]) != 2 {
+		t.Fatalf("expected two versions of %s, got %v", name, versions)
+	}
+	first := versions[name][0]
+	if first.Size != int64(len("first")) || first.VersionTime.Nanosecond() != 0 {
+		t.Errorf("unexpected version %+v", first)
+	}
+
+	// Restoring over an existing file archives that first.
+	if err := os.WriteFile(file, []byte("current"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := v.Restore(name, first.VersionTime); err != nil {
+		t.Fatal(err)
+	}
+	if bs, err := os.ReadFile(file); err != nil || string(bs) != "first" {
+		t.Errorf("expected the first version to be restored, got %q, %v", bs, err)
+	}
+	if versions, err := v.GetVersions(); err != nil || len(versions[name]) != 3 {
+		t.Errorf("expected the replaced file to be archived, got %v, %v", versions, err)
+	}
+
+	if err := v.Restore(name, first.VersionTime.Add(-1)); err == nil {
+		t.Error("restoring a version that doesn't exist should fail")
+	}
+
+	if err := v.Clean(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	bs, err := os.ReadFile(filepath.Join(repo, "forget"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if args := strings.TrimSpace(string(bs)); args != "--tag syncthing:folder --group-by paths,tags --prune --keep-last 3 --keep-daily 7" {
+		t.Errorf("unexpected forget arguments %q", args)
+	}
+}
@@ -17,6 +17,9 @@ import (
 	"github.com/syncthing/syncthing/lib/config"
 )
 
+// Versioner keeps the old versions of files replaced or deleted in a folder.
+// Each versioning type registers a factory for its implementation; the
+// "plugin" type has an external program implement it, see PluginRequest.
 type Versioner interface {
 	Archive(filePath string) error
 	GetVersions() (map[string][]FileVersion, error)
@@ -0,0 +1,32 @@
+// Copyright (C) 2026 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+//go:build !purego
+
+package weakhash
+
+import "golang.org/x/sys/cpu"
+
+var hasVectorAdler = cpu.X86.HasAVX2
+
+// rollAdlerAVX2 rolls n bytes, a multiple of eight, from enter into the
+// window and from leave out of it, storing the Adler-32 of each window in
+// adlers. It returns a and b after the last one. Size is the window size
+// modulo the modulus.
+//
+//go:noescape
+func rollAdlerAVX2(enter, leave *byte, adlers *uint32, n int, a, b, size uint32) (uint32, uint32)
+
+// rollAdlerVector rolls the windows of rollAdler eight at a time with
+// vector instructions, if available, and returns how many it rolled.
+func (r *roller) rollAdlerVector(data []byte, adlers []uint32) int {
+	n := len(adlers) &^ 7
+	if !hasVectorAdler || n == 0 {
+		return 0
+	}
+	r.a, r.b = rollAdlerAVX2(&data[r.size], &data[0], &adlers[0], n, r.a, r.b, uint32(r.size)%adlerMod)
+	return n
+}
@@ -0,0 +1,136 @@
+// Copyright (C) 2026 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+//go:build !purego
+
+#include "textflag.h"
+
+// Eight windows are hashed from the state (a, b) before them. With d_t the
+// difference between the byte entering and the one leaving at step t, and
+// l_t the one leaving, window j has
+//
+//	a_j = a + D_j
+//	b_j = b + j*a + S_j - j - size*L_j
+//
+// where D, S and L are the prefix sums of d, D and l over the eight steps.
+// Adding bias, a multiple of the modulus larger than size*L_8 + S_8 can be,
+// keeps b_j positive and below 2^28. It is then reduced using 2^16 = 15
+// modulo 65521, which leaves it below twice the modulus.
+
+DATA adlerSteps<>+0(SB)/4, $1
+DATA adlerSteps<>+4(SB)/4, $2
+DATA adlerSteps<>+8(SB)/4, $3
+DATA adlerSteps<>+12(SB)/4, $4
+DATA adlerSteps<>+16(SB)/4, $5
+DATA adlerSteps<>+20(SB)/4, $6
+DATA adlerSteps<>+24(SB)/4, $7
+DATA adlerSteps<>+28(SB)/4, $8
+GLOBL adlerSteps<>(SB), RODATA|NOPTR, $32
+
+#define MOD 65521
+#define BIAS (2048*MOD)
+
+// PREFIXSUM replaces the eight lanes of R by their prefix sums, using T.
+#define PREFIXSUM(R, T) \
+	VPSLLDQ    $4, R, T       \
+	VPADDD     T, R, R        \
+	VPSLLDQ    $8, R, T       \
+	VPADDD     T, R, R        \
+	VPSHUFD    $0xff, R, T    \
+	VPERM2I128 $0x08, T, T, T \
+	VPADDD     T, R, R
+
+// REDUCE subtracts the modulus M from the lanes of R that are at least M,
+// using T.
+#define REDUCE(R, M, T) \
+	VPSUBD  M, R, T \
+	VPMINUD T, R, R
+
+// func rollAdlerAVX2(enter, leave *byte, adlers *uint32, n int, a, b, size uint32) (uint32, uint32)
+TEXT ·rollAdlerAVX2(SB), NOSPLIT, $0-56
+	MOVQ enter+0(FP), SI
+	MOVQ leave+8(FP), DI
+	MOVQ adlers+16(FP), DX
+	MOVQ n+24(FP), CX
+
+	MOVL         a+32(FP), AX
+	VMOVD        AX, X0
+	VPBROADCASTD X0, Y0
+	MOVL         b+36(FP), AX
+	VMOVD        AX, X1
+	VPBROADCASTD X1, Y1
+	MOVL         size+40(FP), AX
+	VMOVD        AX, X2
+	VPBROADCASTD X2, Y2
+	VMOVDQU      adlerSteps<>(SB), Y3
+
+	MOVL         $MOD, AX
+	VMOVD        AX, X10
+	VPBROADCASTD X10, Y10
+
+	// Y9 holds bias - j.
+	MOVL         $BIAS, AX
+	VMOVD        AX, X9
+	VPBROADCASTD X9, Y9
+	VPSUBD       Y3, Y9, Y9
+
+	MOVL         $0xffff, AX
+	VMOVD        AX, X12
+	VPBROADCASTD X12, Y12
+
+	MOVL         $7, AX
+	VMOVD        AX, X11
+	VPBROADCASTD X11, Y11
+
+loop:
+	VPMOVZXBD (SI), Y4
+	VPMOVZXBD (DI), Y5
+	VPSUBD    Y5, Y4, Y4
+	PREFIXSUM(Y4, Y13)
+	VMOVDQA   Y4, Y6
+	PREFIXSUM(Y6, Y13)
+	PREFIXSUM(Y5, Y13)
+
+	// a_j
+	VPADDD Y0, Y4, Y7
+	VPADDD Y10, Y7, Y7
+	REDUCE(Y7, Y10, Y13)
+	REDUCE(Y7, Y10, Y13)
+
+	// b_j
+	VPMULLD Y3, Y0, Y8
+	VPADDD  Y1, Y8, Y8
+	VPADDD  Y6, Y8, Y8
+	VPADDD  Y9, Y8, Y8
+	VPMULLD Y2, Y5, Y13
+	VPSUBD  Y13, Y8, Y8
+	VPSRLD  $16, Y8, Y13
+	VPAND   Y12, Y8, Y8
+	VPSLLD  $4, Y13, Y14
+	VPSUBD  Y13, Y14, Y14
+	VPADDD  Y14, Y8, Y8
+	REDUCE(Y8, Y10, Y13)
+
+	VPSLLD  $16, Y8, Y13
+	VPOR    Y7, Y13, Y13
+	VMOVDQU Y13, (DX)
+
+	// The last window's state is the next one to hash from.
+	VPERMD Y7, Y11, Y0
+	VPERMD Y8, Y11, Y1
+
+	ADDQ $8, SI
+	ADDQ $8, DI
+	ADDQ $32, DX
+	SUBQ $8, CX
+	JNZ  loop
+
+	VMOVD X0, AX
+	MOVL  AX, ret+48(FP)
+	VMOVD X1, AX
+	MOVL  AX, ret1+52(FP)
+	VZEROUPPER
+	RET
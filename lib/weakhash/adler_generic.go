@@ -0,0 +1,17 @@
+// Copyright (C) 2026 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+//go:build !amd64 || purego
+
+package weakhash
+
+var hasVectorAdler = false
+
+// rollAdlerVector rolls the windows of rollAdler eight at a time with
+// vector instructions, if available, and returns how many it rolled.
+func (*roller) rollAdlerVector(_ []byte, _ []uint32) int {
+	return 0
+}
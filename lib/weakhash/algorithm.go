@@ -0,0 +1,125 @@
+// Copyright (C) 2026 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package weakhash
+
+import (
+	"context"
+	"hash"
+	"hash/adler32"
+	"math/rand"
+	"sync/atomic"
+	"time"
+)
+
+// Algorithm is a rolling hash function usable for weak hashes.
+type Algorithm int32
+
+const (
+	Adler32 Algorithm = iota
+	Buzhash
+)
+
+var algorithms = []Algorithm{Adler32, Buzhash}
+
+func (a Algorithm) String() string {
+	switch a {
+	case Adler32:
+		return "adler32"
+	case Buzhash:
+		return "buzhash"
+	default:
+		return "unknown"
+	}
+}
+
+// New returns a hash for computing the weak hash of a block using the
+// algorithm.
+func (a Algorithm) New() hash.Hash32 {
+	if a == Buzhash {
+		return newBuzhash()
+	}
+	return adler32.New()
+}
+
+// Checksum returns the weak hash of data using the algorithm.
+func (a Algorithm) Checksum(data []byte) uint32 {
+	if a == Buzhash {
+		return buzhashChecksum(data)
+	}
+	return adler32.Checksum(data)
+}
+
+var selected atomic.Int32
+
+// Selected returns the algorithm used for weak hashes of local blocks.
+// Remote devices may have selected a different one, which is why Find and
+// Matches consider all of them.
+func Selected() Algorithm {
+	return Algorithm(selected.Load())
+}
+
+// Select sets the algorithm used for weak hashes of local blocks.
+func Select(a Algorithm) {
+	selected.Store(int32(a))
+}
+
+// New returns a hash for computing the weak hash of a block using the
+// selected algorithm.
+func New() hash.Hash32 {
+	return Selected().New()
+}
+
+// Matches returns whether the weak hash of data, using any algorithm, is
+// the given hash.
+func Matches(data []byte, weakHash uint32) bool {
+	for _, a := range algorithms {
+		if a.Checksum(data) == weakHash {
+			return true
+		}
+	}
+	return false
+}
+
+// SelectFastest benchmarks block hashing with each algorithm for the given
+// duration and selects the fastest one. The current selection is kept if
+// the context is cancelled before all algorithms have been measured.
+func SelectFastest(ctx context.Context, duration time.Duration) Algorithm {
+	bs := make([]byte, 128<<10)
+	r := rand.New(rand.NewSource(time.Now().UnixNano()))
+	r.Read(bs)
+
+	best, bestPerf := Adler32, 0.0
+	for _, a := range algorithms {
+		perf := benchOnce(ctx, a, duration, bs)
+		if perf == 0 {
+			return Selected()
+		}
+		if perf > bestPerf {
+			best, bestPerf = a, perf
+		}
+	}
+	Select(best)
+	return best
+}
+
+// benchOnce returns the hashing performance of the algorithm in bytes per
+// second, or zero if the context is done.
+func benchOnce(ctx context.Context, a Algorithm, duration time.Duration, bs []byte) float64 {
+	hf := a.New()
+	t0 := time.Now()
+	b := 0
+	for time.Since(t0) < duration {
+		if ctx.Err() != nil {
+			return 0
+		}
+		hf.Reset()
+		hf.Write(bs)
+		hf.Sum32()
+		b += len(bs)
+	}
+	return float64(b) / time.Since(t0).Seconds()
+}
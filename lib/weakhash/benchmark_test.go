@@ -42,6 +42,61 @@ func BenchmarkFind1MFile(b *testing.B) {
 	}
 }
 
+func BenchmarkFind1MFileManyHashes(b *testing.B) {
+	// As many hashes as there are blocks in a 1 GiB file.
+	hashes := make([]uint32, 1<<13)
+	for i := range hashes {
+		hashes[i] = rand.Uint32()
+	}
+
+	b.ReportAllocs()
+	b.SetBytes(1 << 20)
+	for i := 0; i < b.N; i++ {
+		fd, err := os.Open(testFile)
+		if err != nil {
+			b.Fatal(err)
+		}
+		_, err = Find(context.Background(), fd, hashes, size)
+		if err != nil {
+			b.Fatal(err)
+		}
+		fd.Close()
+	}
+}
+
+func BenchmarkRollAdler(b *testing.B) {
+	data := make([]byte, size+adlerBatch)
+	rand.New(rand.NewSource(1)).Read(data)
+	adlers := make([]uint32, adlerBatch)
+
+	vector := hasVectorAdler
+	defer func() {
+		hasVectorAdler = vector
+	}()
+
+	for _, test := range []struct {
+		name   string
+		roll   func(*roller, []byte, []uint32)
+		vector bool
+	}{
+		{"modulo", rollAdlerBytewise, false},
+		{"bytewise", (*roller).rollAdler, false},
+		{"vector", (*roller).rollAdler, true},
+	} {
+		if test.vector && !vector {
+			continue
+		}
+		b.Run(test.name, func(b *testing.B) {
+			hasVectorAdler = test.vector
+			r := newRoller(data[:size])
+			b.SetBytes(adlerBatch)
+			for i := 0; i < b.N; i++ {
+				test.roll(r, data, adlers)
+			}
+		})
+	}
+}
+
 type RollingHash interface {
 	hash.Hash
 	Roll(byte)
@@ -0,0 +1,68 @@
+// Copyright (C) 2026 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package weakhash
+
+import (
+	"hash"
+	"math/bits"
+
+	"github.com/chmduquesne/rollinghash/buzhash32"
+)
+
+// buzhashTable is the byte table of the rolling buzhash32 implementation
+// with its default seed, so that block hashes and rolled hashes agree.
+var buzhashTable = buzhash32.GenerateHashes(1)
+
+// buzhash computes the same value as buzhash32 over everything written to
+// it, but in constant space. The rolling implementation keeps the whole
+// window around and rehashes it on every write, which is fine for rolling
+// but not for hashing blocks of up to 16 MiB in chunks.
+type buzhash struct {
+	sum uint32
+}
+
+var _ hash.Hash32 = (*buzhash)(nil)
+
+func newBuzhash() *buzhash {
+	return &buzhash{}
+}
+
+func (d *buzhash) Write(data []byte) (int, error) {
+	sum := d.sum
+	for _, c := range data {
+		sum = bits.RotateLeft32(sum, 1) ^ buzhashTable[c]
+	}
+	d.sum = sum
+	return len(data), nil
+}
+
+func (d *buzhash) Sum32() uint32 {
+	return d.sum
+}
+
+func (d *buzhash) Sum(b []byte) []byte {
+	v := d.sum
+	return append(b, byte(v>>24), byte(v>>16), byte(v>>8), byte(v))
+}
+
+func (d *buzhash) Reset() {
+	d.sum = 0
+}
+
+func (*buzhash) Size() int {
+	return Size
+}
+
+func (*buzhash) BlockSize() int {
+	return 1
+}
+
+func buzhashChecksum(data []byte) uint32 {
+	var d buzhash
+	d.Write(data)
+	return d.sum
+}
@@ -7,11 +7,10 @@
 package weakhash
 
 import (
-	"bufio"
 	"context"
+	"hash/adler32"
 	"io"
-
-	"github.com/chmduquesne/rollinghash/adler32"
+	"math/bits"
 )
 
 const (
@@ -19,37 +18,49 @@ const (
 
 	// don't track more hits than this for any given weakhash
 	maxWeakhashFinderHits = 10
+
+	// how much of the file to read and roll over at a time
+	findChunkSize = 1 << 20
+
+	// the modulus of Adler-32
+	adlerMod = 65521
 )
 
 // Find finds all the blocks of the given size within io.Reader that matches
 // the hashes provided, and returns a hash -> slice of offsets within reader
-// map, that produces the same weak hash.
+// map, that produces the same weak hash. As the hashes may come from devices
+// using any weak hash algorithm, all of them are rolled.
+//
+// The file is read a chunk at a time and both hashes are rolled over it
+// together, with the state in local variables and the parts of each roll
+// that depend only on the leaving byte looked up from tables. That's what
+// the rolling hash implementations do a byte at a time, behind an
+// interface, with a circular buffer for the window. Adler-32 is computed
+// for a batch of windows at a time, eight windows per step with AVX2 where
+// available. Each rolled hash is checked against a bloom filter of the
+// hashes to find before looking it up, as almost none of them match.
 func Find(ctx context.Context, ir io.Reader, hashesToFind []uint32, size int) (map[uint32][]int64, error) {
 	if ir == nil || len(hashesToFind) == 0 {
 		return nil, nil
 	}
 
-	r := bufio.NewReader(ir)
-	hf := adler32.New()
-
-	n, err := io.CopyN(hf, r, int64(size))
-	if err == io.EOF {
+	buf := make([]byte, size+findChunkSize)
+	_, err := io.ReadFull(ir, buf[:size])
+	if err == io.EOF || err == io.ErrUnexpectedEOF {
 		return nil, nil
 	}
 	if err != nil {
 		return nil, err
 	}
-	if n != int64(size) {
-		return nil, io.ErrShortBuffer
-	}
 
-	offsets := make(map[uint32][]int64)
-	for _, hashToFind := range hashesToFind {
-		offsets[hashToFind] = make([]int64, 0, maxWeakhashFinderHits)
-	}
+	m := newMatcher(hashesToFind)
+	r := newRoller(buf[:size])
+	m.match(r.adler(), 0)
+	m.match(r.buzhash, 0)
 
-	var i int64
-	var hash uint32
+	// The window is kept at the start of the buffer, followed by the
+	// bytes that enter it next.
+	var offset int64
 	for {
 		select {
 		case <-ctx.Done():
@@ -57,21 +68,161 @@ func Find(ctx context.Context, ir io.Reader, hashesToFind []uint32, size int) (m
 		default:
 		}
 
-		hash = hf.Sum32()
-		if existing, ok := offsets[hash]; ok && len(existing) < maxWeakhashFinderHits {
-			offsets[hash] = append(existing, i)
+		n, err := io.ReadFull(ir, buf[size:])
+		if n > 0 {
+			r.roll(buf[:size+n], offset, m)
+			offset += int64(n)
+			copy(buf, buf[n:n+size])
 		}
-		i++
-
-		bt, err := r.ReadByte()
-		if err == io.EOF {
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
 			break
 		} else if err != nil {
-			return offsets, err
+			return m.offsets, err
 		}
-		hf.Roll(bt)
 	}
-	return offsets, nil
+	return m.offsets, nil
+}
+
+// roller rolls Adler-32 and buzhash over a window, computing the same
+// values as the rolling hash implementations.
+type roller struct {
+	size    int
+	a, b    uint32
+	buzhash uint32
+
+	// adlerLeave is the amount the leaving byte takes off b, and
+	// buzhashLeave the leaving byte's table entry rotated by the window
+	// size.
+	adlerLeave   [256]uint32
+	buzhashLeave [256]uint32
+
+	// adlers holds the Adler-32 of the windows in the current batch.
+	adlers [adlerBatch]uint32
+}
+
+func newRoller(window []byte) *roller {
+	sum := adler32.Checksum(window)
+	r := &roller{
+		size:    len(window),
+		a:       sum & 0xffff,
+		b:       sum >> 16,
+		buzhash: buzhashChecksum(window),
+	}
+	n := uint32(len(window)) % adlerMod
+	rot := len(window) % 32
+	for c := range r.adlerLeave {
+		r.adlerLeave[c] = n * uint32(c) % adlerMod
+		r.buzhashLeave[c] = bits.RotateLeft32(buzhashTable[c], rot)
+	}
+	return r
+}
+
+func (r *roller) adler() uint32 {
+	return r.b<<16 | r.a
+}
+
+// roll rolls the bytes following the window at the start of data into it,
+// one at a time, matching the hashes of each window. The offset is that of
+// the start of data.
+func (r *roller) roll(data []byte, offset int64, m *matcher) {
+	for start := 0; start < len(data)-r.size; start += adlerBatch {
+		end := min(start+adlerBatch, len(data)-r.size)
+		adlers := r.adlers[:end-start]
+		r.rollAdler(data[start:r.size+end], adlers)
+
+		buz := r.buzhash
+		for i, adler := range adlers {
+			enter, leave := data[r.size+start+i], data[start+i]
+			buz = bits.RotateLeft32(buz, 1) ^ r.buzhashLeave[leave] ^ buzhashTable[enter]
+
+			pos := offset + int64(start+i+1)
+			if m.mayContain(adler) {
+				m.match(adler, pos)
+			}
+			if m.mayContain(buz) {
+				m.match(buz, pos)
+			}
+		}
+		r.buzhash = buz
+	}
+}
+
+// adlerBatch is the number of windows whose Adler-32 is computed before
+// matching them.
+const adlerBatch = 256
+
+// rollAdler rolls the bytes following the window at the start of data
+// into it, storing the Adler-32 of each window in adlers. Where the CPU
+// allows, most of them are computed with vector instructions. The rest
+// are rolled a byte at a time, with a and b kept below the modulus by
+// subtracting it rather than by division.
+func (r *roller) rollAdler(data []byte, adlers []uint32) {
+	n := r.rollAdlerVector(data, adlers)
+	a, b := r.a, r.b
+	for i := n; i < len(adlers); i++ {
+		enter, leave := data[r.size+i], data[i]
+		a += adlerMod + uint32(enter) - uint32(leave)
+		if a >= adlerMod {
+			a -= adlerMod
+		}
+		if a >= adlerMod {
+			a -= adlerMod
+		}
+		b += adlerMod - r.adlerLeave[leave] + a - 1
+		if b >= adlerMod {
+			b -= adlerMod
+		}
+		if b >= adlerMod {
+			b -= adlerMod
+		}
+		adlers[i] = b<<16 | a
+	}
+	r.a, r.b = a, b
+}
+
+// matcher collects the offsets of the hashes to find. A bloom filter of
+// them rules out most other hashes without looking them up. It is a
+// blocked one, setting two bits in a single word per hash, so that each
+// check is one multiplication and one memory access.
+type matcher struct {
+	filter  []uint64
+	shift   uint
+	offsets map[uint32][]int64
+}
+
+func newMatcher(hashes []uint32) *matcher {
+	// About 16 bits per hash, for a false positive rate around 1%.
+	log := bits.Len(uint(len(hashes)-1) / 4)
+	m := &matcher{
+		filter:  make([]uint64, 1<<log),
+		shift:   uint(64 - log),
+		offsets: make(map[uint32][]int64, len(hashes)),
+	}
+	for _, hash := range hashes {
+		m.offsets[hash] = make([]int64, 0, maxWeakhashFinderHits)
+		word, mask := m.probe(hash)
+		m.filter[word] |= mask
+	}
+	return m
+}
+
+// probe returns the filter word and bits for the hash, from multiplicative
+// hashing.
+func (m *matcher) probe(hash uint32) (uint64, uint64) {
+	h := uint64(hash) * 0x9e3779b97f4a7c15
+	return h >> m.shift, 1<<(h&63) | 1<<((h>>6)&63)
+}
+
+func (m *matcher) mayContain(hash uint32) bool {
+	word, mask := m.probe(hash)
+	return m.filter[word]&mask == mask
+}
+
+func (m *matcher) match(hash uint32, offset int64) {
+	existing, ok := m.offsets[hash]
+	if ok && len(existing) < maxWeakhashFinderHits && (len(existing) == 0 || existing[len(existing)-1] != offset) {
+		m.offsets[hash] = append(existing, offset)
+	}
 }
 
 func NewFinder(ctx context.Context, ir io.ReadSeeker, size int, hashesToFind []uint32) (*Finder, error) {
@@ -12,10 +12,17 @@ package weakhash
 import (
 	"bytes"
 	"context"
+	"hash/adler32"
 	"io"
+	"math/rand"
 	"os"
 	"reflect"
 	"testing"
+	"time"
+
+	"github.com/chmduquesne/rollinghash"
+	rollingadler32 "github.com/chmduquesne/rollinghash/adler32"
+	"github.com/chmduquesne/rollinghash/buzhash32"
 )
 
 var payload = []byte("abcdefghijklmnopqrstuvwxyzabcdefghijklmnopqrstuvwxyzabcdefghijklmnopqrstuvwxyzabcdefghijklmnopqrstuvwxyz")
@@ -66,3 +73,215 @@ func TestFinder(t *testing.T) {
 		t.Errorf("Not equal: %#v != %#v", actual, expected)
 	}
 }
+
+func TestBuzhashMatchesRolling(t *testing.T) {
+	// Block hashes must be findable by rolling, however they were written.
+	rolling := buzhash32.New()
+	_, _ = rolling.Write(payload[:16])
+	for i := 0; i+16 <= len(payload); i++ {
+		if i > 0 {
+			rolling.Roll(payload[i+15])
+		}
+		hf := Buzhash.New()
+		_, _ = hf.Write(payload[i : i+8])
+		_, _ = hf.Write(payload[i+8 : i+16])
+		if hf.Sum32() != rolling.Sum32() {
+			t.Fatalf("mismatch at %d: %d != %d", i, hf.Sum32(), rolling.Sum32())
+		}
+		if Buzhash.Checksum(payload[i:i+16]) != rolling.Sum32() {
+			t.Fatalf("checksum mismatch at %d", i)
+		}
+	}
+}
+
+func TestRollAdler(t *testing.T) {
+	// Runs of bytes leaving and entering the window that take the sums
+	// furthest from zero either way, as well as random data.
+	ones := bytes.Repeat([]byte{0xff}, 3*adlerBatch)
+	zeros := make([]byte, 3*adlerBatch)
+	random := make([]byte, 3*adlerBatch)
+	rand.New(rand.NewSource(1)).Read(random)
+	patterns := map[string][]byte{
+		"falling": append(append([]byte{}, ones...), zeros...),
+		"rising":  append(append([]byte{}, zeros...), ones...),
+		"random":  random,
+	}
+
+	withVectorAdler(t, func(t *testing.T) {
+		for name, data := range patterns {
+			for _, size := range []int{1, 7, adlerBatch - 1, adlerBatch, adlerBatch + 1, 2 * adlerBatch} {
+				// Batches that aren't a multiple of the vector width,
+				// too.
+				for _, batch := range []int{adlerBatch, 13} {
+					r := newRoller(data[:size])
+					for start := 0; start < len(data)-size; start += batch {
+						end := min(start+batch, len(data)-size)
+						adlers := make([]uint32, end-start)
+						r.rollAdler(data[start:size+end], adlers)
+						for i, adler := range adlers {
+							pos := start + i + 1
+							if expected := adler32.Checksum(data[pos : pos+size]); adler != expected {
+								t.Fatalf("%s, size %d: hash at %d is %08x, expected %08x", name, size, pos, adler, expected)
+							}
+						}
+					}
+				}
+			}
+		}
+	})
+}
+
+func TestRollAdlerAnyState(t *testing.T) {
+	// The sums must not go negative or overflow, whatever the state rolled
+	// from, the bytes rolled through and the window size.
+	withVectorAdler(t, func(t *testing.T) {
+		for _, size := range []int{1, 257, 2 * adlerBatch, adlerMod - 1, adlerMod} {
+			// Bytes of 0xff leaving the window while zeros enter it, and
+			// the other way around.
+			falling := make([]byte, size+adlerBatch)
+			copy(falling, bytes.Repeat([]byte{0xff}, min(size, adlerBatch)))
+			rising := make([]byte, size+adlerBatch)
+			copy(rising[size:], bytes.Repeat([]byte{0xff}, adlerBatch))
+			for _, data := range [][]byte{falling, rising} {
+				for _, state := range [][2]uint32{{0, 0}, {0, adlerMod - 1}, {adlerMod - 1, 0}, {adlerMod - 1, adlerMod - 1}} {
+					r := newRoller(data[:size])
+					r.a, r.b = state[0], state[1]
+					expected := make([]uint32, len(data)-size)
+					rollAdlerBytewise(r, data, expected)
+					r.a, r.b = state[0], state[1]
+					adlers := make([]uint32, len(data)-size)
+					r.rollAdler(data, adlers)
+					for i := range adlers {
+						if adlers[i] != expected[i] {
+							t.Fatalf("size %d, state %v: hash at %d is %08x, expected %08x", size, state, i, adlers[i], expected[i])
+						}
+					}
+				}
+			}
+		}
+	})
+}
+
+// withVectorAdler runs fn rolling Adler-32 a byte at a time and, if the
+// CPU allows, with vector instructions.
+func withVectorAdler(t *testing.T, fn func(t *testing.T)) {
+	vector := hasVectorAdler
+	defer func() {
+		hasVectorAdler = vector
+	}()
+
+	hasVectorAdler = false
+	t.Run("bytewise", fn)
+	if vector {
+		hasVectorAdler = true
+		t.Run("vector", fn)
+	}
+}
+
+// rollAdlerBytewise is rollAdler rolling a byte at a time, as the rolling
+// hash implementations do, taking the modulo of each sum.
+func rollAdlerBytewise(r *roller, data []byte, adlers []uint32) {
+	a, b := r.a, r.b
+	for i := range adlers {
+		enter, leave := data[r.size+i], data[i]
+		a = (a + adlerMod + uint32(enter) - uint32(leave)) % adlerMod
+		b = (b + adlerMod - r.adlerLeave[leave] + a - 1) % adlerMod
+		adlers[i] = b<<16 | a
+	}
+	r.a, r.b = a, b
+}
+
+func TestFindAnyAlgorithm(t *testing.T) {
+	block := payload[3:11]
+	for _, a := range algorithms {
+		hash := a.Checksum(block)
+		offsets, err := Find(context.Background(), bytes.NewReader(payload), []uint32{hash}, len(block))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if expected := []int64{3, 29, 55, 81}; !reflect.DeepEqual(offsets[hash], expected) {
+			t.Errorf("%v: %v != %v", a, offsets[hash], expected)
+		}
+		if !Matches(block, hash) {
+			t.Errorf("%v: block does not match its hash", a)
+		}
+	}
+}
+
+func TestFindMatchesRolling(t *testing.T) {
+	// Data spanning a few chunks, with some blocks repeated at offsets
+	// within and across chunk boundaries.
+	data := make([]byte, 2*findChunkSize+12345)
+	rand.New(rand.NewSource(1)).Read(data)
+	const blockSize = 4096
+	for _, offset := range []int{10000, findChunkSize - 10, findChunkSize + 5000, 2*findChunkSize + 8000} {
+		copy(data[offset:], data[:blockSize])
+	}
+
+	var hashes []uint32
+	for i := 0; i+blockSize <= len(data); i += 100 * blockSize {
+		for _, a := range algorithms {
+			hashes = append(hashes, a.Checksum(data[i:i+blockSize]))
+		}
+	}
+	hashes = append(hashes, 1, 2, 3)
+
+	offsets, err := Find(context.Background(), bytes.NewReader(data), hashes, blockSize)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := findRolling(data, hashes, blockSize)
+	if !reflect.DeepEqual(offsets, expected) {
+		t.Errorf("offsets differ from rolling the hashes")
+	}
+	if got := offsets[adler32.Checksum(data[:blockSize])]; len(got) != 5 {
+		t.Errorf("found the repeated block at %v", got)
+	}
+}
+
+// findRolling finds the hashes a byte at a time using the rolling hash
+// implementations.
+func findRolling(data []byte, hashes []uint32, size int) map[uint32][]int64 {
+	hfs := []rollinghash.Hash32{rollingadler32.New(), buzhash32.New()}
+	for _, hf := range hfs {
+		_, _ = hf.Write(data[:size])
+	}
+	offsets := make(map[uint32][]int64)
+	for _, hash := range hashes {
+		offsets[hash] = make([]int64, 0, maxWeakhashFinderHits)
+	}
+	for i := 0; ; i++ {
+		for _, hf := range hfs {
+			hash := hf.Sum32()
+			existing, ok := offsets[hash]
+			if ok && len(existing) < maxWeakhashFinderHits && (len(existing) == 0 || existing[len(existing)-1] != int64(i)) {
+				offsets[hash] = append(existing, int64(i))
+			}
+		}
+		if i+size >= len(data) {
+			return offsets
+		}
+		for _, hf := range hfs {
+			hf.Roll(data[i+size])
+		}
+	}
+}
+
+func TestSelectFastest(t *testing.T) {
+	defer Select(Adler32)
+
+	algo := SelectFastest(context.Background(), 10*time.Millisecond)
+	if algo != Adler32 && algo != Buzhash {
+		t.Fatal("unexpected algorithm", algo)
+	}
+	if Selected() != algo {
+		t.Errorf("selected %v, not %v", Selected(), algo)
+	}
+
+	Select(Buzhash)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if algo := SelectFastest(ctx, time.Second); algo != Buzhash {
+		t.Errorf("cancelled benchmark changed the selection to %v", algo)
+	}
+}
@@ -0,0 +1,199 @@
+// Copyright (C) 2026 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+// Package webhook calls configured HTTP endpoints on selected events.
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/thejerf/suture/v4"
+
+	"github.com/syncthing/syncthing/lib/config"
+	"github.com/syncthing/syncthing/lib/dialer"
+	"github.com/syncthing/syncthing/lib/events"
+	"github.com/syncthing/syncthing/lib/protocol"
+	"github.com/syncthing/syncthing/lib/tlsutil"
+)
+
+var sendTimeout = 30 * time.Second
+
+// CompletionEvent is the JSON body posted to a completion webhook.
+type CompletionEvent struct {
+	Folder       string    `json:"folder"`
+	FolderLabel  string    `json:"folderLabel"`
+	Device       string    `json:"device"`
+	Condition    string    `json:"condition"`
+	Completion   float64   `json:"completion"`
+	ThresholdPct float64   `json:"thresholdPct"`
+	GlobalBytes  int64     `json:"globalBytes"`
+	NeedBytes    int64     `json:"needBytes"`
+	Time         time.Time `json:"time"`
+}
+
+type completionKey struct {
+	folder string
+	device protocol.DeviceID
+}
+
+type completionService struct {
+	cfg      config.Wrapper
+	evLogger events.Logger
+	client   *http.Client
+	// last completion seen per folder and device
+	last map[completionKey]float64
+}
+
+// NewCompletionService returns a service calling the completion webhooks
+// of the folders, as the completion on remote devices changes.
+func NewCompletionService(cfg config.Wrapper, evLogger events.Logger) suture.Service {
+	return &completionService{
+		cfg:      cfg,
+		evLogger: evLogger,
+		client: &http.Client{
+			Transport: &http.Transport{
+				DialContext:     dialer.DialContext,
+				Proxy:           http.ProxyFromEnvironment,
+				TLSClientConfig: tlsutil.SecureDefaultWithTLS12(),
+			},
+		},
+		last: make(map[completionKey]float64),
+	}
+}
+
+func (s *completionService) Serve(ctx context.Context) error {
+	sub := s.evLogger.Subscribe(events.FolderCompletion)
+	defer sub.Unsubscribe()
+
+	for {
+		select {
+		case ev, ok := <-sub.C():
+			if !ok {
+				return nil
+			}
+			for _, ce := range s.handle(ev) {
+				go s.send(ctx, ce.url, ce.event)
+			}
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+func (*completionService) String() string {
+	return "webhook.completionService"
+}
+
+type pendingCall struct {
+	url   string
+	event CompletionEvent
+}
+
+// handle records the completion from the event and returns the webhooks
+// to call for it. Webhooks fire on transitions only, so the first
+// completion seen for a folder and device is just recorded.
+func (s *completionService) handle(ev events.Event) []pendingCall {
+	data, ok := ev.Data.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+	folder, _ := data["folder"].(string)
+	device, err := protocol.DeviceIDFromString(fmt.Sprint(data["device"]))
+	if err != nil {
+		return nil
+	}
+	completion, ok := data["completion"].(float64)
+	if !ok {
+		return nil
+	}
+	key := completionKey{folder, device}
+	if fmt.Sprint(data["remoteState"]) != "valid" {
+		// Paused or not yet shared, the completion isn't meaningful.
+		delete(s.last, key)
+		return nil
+	}
+
+	prev, seen := s.last[key]
+	s.last[key] = completion
+	if !seen {
+		return nil
+	}
+
+	fcfg, ok := s.cfg.Folder(folder)
+	if !ok {
+		return nil
+	}
+	globalBytes, _ := data["globalBytes"].(int64)
+	needBytes, _ := data["needBytes"].(int64)
+
+	var calls []pendingCall
+	for _, hook := range fcfg.CompletionWebhooks {
+		if !hook.Matches(device) || !fires(hook, prev, completion) {
+			continue
+		}
+		calls = append(calls, pendingCall{
+			url: hook.URL,
+			event: CompletionEvent{
+				Folder:       folder,
+				FolderLabel:  fcfg.Label,
+				Device:       device.String(),
+				Condition:    hook.Condition.String(),
+				Completion:   completion,
+				ThresholdPct: hook.ThresholdPct,
+				GlobalBytes:  globalBytes,
+				NeedBytes:    needBytes,
+				Time:         ev.Time,
+			},
+		})
+	}
+	return calls
+}
+
+// fires returns true if the change in completion from prev to cur meets the
+// condition of the webhook.
+func fires(hook config.CompletionWebhook, prev, cur float64) bool {
+	switch hook.Condition {
+	case config.CompletionConditionCompleted:
+		return prev < 100 && cur >= 100
+	case config.CompletionConditionBelow:
+		return prev >= hook.ThresholdPct && cur < hook.ThresholdPct
+	default:
+		return false
+	}
+}
+
+func (s *completionService) send(ctx context.Context, url string, event CompletionEvent) {
+	bs, err := json.Marshal(event)
+	if err != nil {
+		panic(err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, sendTimeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(bs))
+	if err != nil {
+		l.Infof("Failed to call completion webhook for folder %s: %v", event.Folder, err)
+		return
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		l.Infof("Failed to call completion webhook for folder %s: %v", event.Folder, err)
+		return
+	}
+	resp.Body.Close()
+	if resp.StatusCode >= 300 {
+		l.Infof("Completion webhook for folder %s returned %s", event.Folder, resp.Status)
+		return
+	}
+	l.Debugf("Called completion webhook %s for folder %s, device %s (%s)", url, event.Folder, event.Device, event.Condition)
+}
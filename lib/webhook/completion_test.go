@@ -0,0 +1,127 @@
+// Copyright (C) 2026 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/syncthing/syncthing/lib/config"
+	"github.com/syncthing/syncthing/lib/events"
+	"github.com/syncthing/syncthing/lib/protocol"
+)
+
+var (
+	device1, _ = protocol.DeviceIDFromString("AIR6LPZ-7K4PTTV-UXQSMUU-CPQ5YWH-OEDFIIQ-JUG777G-2YQXXR5-YD6AWQR")
+	device2, _ = protocol.DeviceIDFromString("GYRZZQB-IRNPV4Z-T7TC52W-EQYJ3TT-FDQW6MW-DFLMU42-SSSU6EM-FBK2VAY")
+)
+
+func newTestService(hooks ...config.CompletionWebhook) *completionService {
+	cfg := config.Configuration{
+		Folders: []config.FolderConfiguration{{
+			ID:                 "default",
+			Label:              "Default",
+			CompletionWebhooks: hooks,
+		}},
+	}
+	w := config.Wrap("", cfg, protocol.LocalDeviceID, events.NoopLogger)
+	return NewCompletionService(w, events.NoopLogger).(*completionService)
+}
+
+func completionEvent(device protocol.DeviceID, completion float64, state string) events.Event {
+	return events.Event{
+		Time: time.Now(),
+		Type: events.FolderCompletion,
+		Data: map[string]interface{}{
+			"folder":      "default",
+			"device":      device.String(),
+			"completion":  completion,
+			"globalBytes": int64(1000),
+			"needBytes":   int64(1000 * (100 - completion) / 100),
+			"remoteState": state,
+		},
+	}
+}
+
+func TestCompletionTransitions(t *testing.T) {
+	s := newTestService(
+		config.CompletionWebhook{URL: "http://completed", DeviceID: device1, Condition: config.CompletionConditionCompleted, ThresholdPct: 100},
+		config.CompletionWebhook{URL: "http://below", Condition: config.CompletionConditionBelow, ThresholdPct: 50},
+	)
+
+	steps := []struct {
+		device     protocol.DeviceID
+		completion float64
+		state      string
+		expected   []string
+	}{
+		// The first value is the baseline
+		{device1, 40, "valid", nil},
+		{device1, 100, "valid", []string{"http://completed"}},
+		{device1, 100, "valid", nil},
+		{device1, 30, "valid", []string{"http://below"}},
+		{device1, 20, "valid", nil},
+		// Paused devices reset the baseline
+		{device1, 0, "paused", nil},
+		{device1, 100, "valid", nil},
+		// The completed hook is for device1 only
+		{device2, 80, "valid", nil},
+		{device2, 100, "valid", nil},
+		{device2, 49.5, "valid", []string{"http://below"}},
+	}
+
+	for i, step := range steps {
+		calls := s.handle(completionEvent(step.device, step.completion, step.state))
+		if len(calls) != len(step.expected) {
+			t.Fatalf("%d: expected %d calls, got %v", i, len(step.expected), calls)
+		}
+		for j, call := range calls {
+			if call.url != step.expected[j] {
+				t.Errorf("%d: expected call to %s, got %s", i, step.expected[j], call.url)
+			}
+			if call.event.Device != step.device.String() || call.event.Completion != step.completion {
+				t.Errorf("%d: unexpected event %+v", i, call.event)
+			}
+		}
+	}
+}
+
+func TestCompletionSend(t *testing.T) {
+	received := make(chan CompletionEvent, 1)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("unexpected method %s", r.Method)
+		}
+		var ev CompletionEvent
+		if err := json.NewDecoder(r.Body).Decode(&ev); err != nil {
+			t.Error(err)
+		}
+		received <- ev
+	}))
+	defer srv.Close()
+
+	s := newTestService(config.CompletionWebhook{URL: srv.URL, Condition: config.CompletionConditionCompleted, ThresholdPct: 100})
+	s.handle(completionEvent(device1, 50, "valid"))
+	calls := s.handle(completionEvent(device1, 100, "valid"))
+	if len(calls) != 1 {
+		t.Fatalf("expected one call, got %v", calls)
+	}
+	s.send(context.Background(), calls[0].url, calls[0].event)
+
+	select {
+	case ev := <-received:
+		if ev.Folder != "default" || ev.FolderLabel != "Default" || ev.Condition != "completed" || ev.Completion != 100 {
+			t.Errorf("unexpected event %+v", ev)
+		}
+	default:
+		t.Fatal("webhook was not called")
+	}
+}
@@ -0,0 +1,209 @@
+// Copyright (C) 2026 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"reflect"
+
+	"github.com/thejerf/suture/v4"
+
+	"github.com/syncthing/syncthing/lib/config"
+	"github.com/syncthing/syncthing/lib/dialer"
+	"github.com/syncthing/syncthing/lib/events"
+	"github.com/syncthing/syncthing/lib/tlsutil"
+)
+
+const (
+	// SignatureHeader carries the hex encoded HMAC-SHA256 of the body,
+	// prefixed with "sha256=", for webhooks with a secret.
+	SignatureHeader = "X-Syncthing-Signature"
+	// EventHeader carries the type of the posted event.
+	EventHeader = "X-Syncthing-Event"
+
+	// Events waiting to be posted, beyond which further ones are dropped.
+	eventQueueSize = 256
+)
+
+type eventHook struct {
+	url    string
+	secret string
+	mask   events.EventType
+}
+
+type eventCall struct {
+	hook  eventHook
+	event events.Event
+}
+
+type eventService struct {
+	cfg      config.Wrapper
+	evLogger events.Logger
+	client   *http.Client
+	changed  chan struct{}
+}
+
+// NewEventService returns a service posting the events selected by each of
+// the configured event webhooks. Events are posted one at a time, in order.
+func NewEventService(cfg config.Wrapper, evLogger events.Logger) suture.Service {
+	return &eventService{
+		cfg:      cfg,
+		evLogger: evLogger,
+		client: &http.Client{
+			Transport: &http.Transport{
+				DialContext:     dialer.DialContext,
+				Proxy:           http.ProxyFromEnvironment,
+				TLSClientConfig: tlsutil.SecureDefaultWithTLS12(),
+			},
+		},
+		changed: make(chan struct{}, 1),
+	}
+}
+
+func (s *eventService) Serve(ctx context.Context) error {
+	s.cfg.Subscribe(s)
+	defer s.cfg.Unsubscribe(s)
+
+	queue := make(chan eventCall, eventQueueSize)
+	go s.sendLoop(ctx, queue)
+
+	for {
+		hooks := eventHooks(s.cfg.Options().EventWebhooks)
+		var mask events.EventType
+		for _, hook := range hooks {
+			mask |= hook.mask
+		}
+		var sub events.Subscription
+		var evs <-chan events.Event
+		if mask != 0 {
+			sub = s.evLogger.Subscribe(mask)
+			evs = sub.C()
+		}
+
+	loop:
+		for {
+			select {
+			case ev, ok := <-evs:
+				if !ok {
+					return nil
+				}
+				for _, hook := range hooks {
+					if hook.mask&ev.Type == 0 {
+						continue
+					}
+					select {
+					case queue <- eventCall{hook, ev}:
+					default:
+						l.Debugf("Dropping %v event for webhook %s, queue full", ev.Type, hook.url)
+					}
+				}
+			case <-s.changed:
+				break loop
+			case <-ctx.Done():
+				if sub != nil {
+					sub.Unsubscribe()
+				}
+				return ctx.Err()
+			}
+		}
+		if sub != nil {
+			sub.Unsubscribe()
+		}
+	}
+}
+
+func (s *eventService) CommitConfiguration(from, to config.Configuration) bool {
+	if !reflect.DeepEqual(from.Options.EventWebhooks, to.Options.EventWebhooks) {
+		select {
+		case s.changed <- struct{}{}:
+		default:
+		}
+	}
+	return true
+}
+
+func (*eventService) String() string {
+	return "webhook.eventService"
+}
+
+// eventHooks returns the webhooks with an URL and at least one known event
+// type.
+func eventHooks(cfgs []config.EventWebhook) []eventHook {
+	var hooks []eventHook
+	for _, cfg := range cfgs {
+		hook := eventHook{url: cfg.URL, secret: cfg.Secret}
+		for _, name := range cfg.Events {
+			t := events.UnmarshalEventType(name)
+			if t == 0 {
+				l.Infof("Ignoring unknown event type %q for webhook %s", name, cfg.URL)
+			}
+			hook.mask |= t
+		}
+		if hook.url != "" && hook.mask != 0 {
+			hooks = append(hooks, hook)
+		}
+	}
+	return hooks
+}
+
+func (s *eventService) sendLoop(ctx context.Context, queue <-chan eventCall) {
+	for {
+		select {
+		case call := <-queue:
+			s.send(ctx, call.hook, call.event)
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (s *eventService) send(ctx context.Context, hook eventHook, ev events.Event) {
+	bs, err := json.Marshal(ev)
+	if err != nil {
+		l.Infof("Failed to encode %v event for webhook %s: %v", ev.Type, hook.url, err)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, sendTimeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, hook.url, bytes.NewReader(bs))
+	if err != nil {
+		l.Infof("Failed to call event webhook %s: %v", hook.url, err)
+		return
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(EventHeader, ev.Type.String())
+	if hook.secret != "" {
+		req.Header.Set(SignatureHeader, Signature(hook.secret, bs))
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		l.Infof("Failed to call event webhook %s: %v", hook.url, err)
+		return
+	}
+	resp.Body.Close()
+	if resp.StatusCode >= 300 {
+		l.Infof("Event webhook %s returned %s", hook.url, resp.Status)
+		return
+	}
+	l.Debugf("Called event webhook %s with %v event %d", hook.url, ev.Type, ev.GlobalID)
+}
+
+// Signature returns the value of the signature header for the body posted
+// to a webhook with the given secret.
+func Signature(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,95 @@
+// Copyright (C) 2026 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/syncthing/syncthing/lib/config"
+	"github.com/syncthing/syncthing/lib/events"
+	"github.com/syncthing/syncthing/lib/protocol"
+)
+
+func TestEventHooks(t *testing.T) {
+	hooks := eventHooks([]config.EventWebhook{
+		{URL: "http://a", Events: []string{"ItemFinished", "FolderCompletion"}},
+		{URL: "http://b", Events: []string{"NotAnEvent"}},
+		{URL: "", Events: []string{"ItemFinished"}},
+	})
+	if len(hooks) != 1 {
+		t.Fatalf("expected one hook, got %v", hooks)
+	}
+	if hooks[0].mask != events.ItemFinished|events.FolderCompletion {
+		t.Errorf("unexpected mask %v", hooks[0].mask)
+	}
+}
+
+func TestEventSend(t *testing.T) {
+	type request struct {
+		header http.Header
+		body   []byte
+	}
+	received := make(chan request, 10)
+	srv := httptest.NewServer(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			t.Error(err)
+		}
+		received <- request{r.Header, body}
+	}))
+	defer srv.Close()
+
+	evLogger := events.NewLogger()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go evLogger.Serve(ctx)
+
+	cfg := config.Configuration{
+		Options: config.OptionsConfiguration{
+			EventWebhooks: []config.EventWebhook{{URL: srv.URL, Events: []string{"ItemFinished"}, Secret: "s3cret"}},
+		},
+	}
+	w := config.Wrap("", cfg, protocol.LocalDeviceID, events.NoopLogger)
+	go NewEventService(w, evLogger).Serve(ctx)
+
+	// The service subscribes in the background, so keep logging until
+	// the first event arrives.
+	var req request
+	timeout := time.After(10 * time.Second)
+loop:
+	for {
+		evLogger.Log(events.ConfigSaved, "ignored")
+		evLogger.Log(events.ItemFinished, map[string]string{"item": "foo"})
+		select {
+		case req = <-received:
+			break loop
+		case <-time.After(10 * time.Millisecond):
+		case <-timeout:
+			t.Fatal("webhook was not called")
+		}
+	}
+
+	if typ := req.header.Get(EventHeader); typ != "ItemFinished" {
+		t.Errorf("unexpected event header %q", typ)
+	}
+	if sig := req.header.Get(SignatureHeader); sig != Signature("s3cret", req.body) {
+		t.Errorf("incorrect signature %q", sig)
+	}
+	var ev events.Event
+	if err := json.Unmarshal(req.body, &ev); err != nil {
+		t.Fatal(err)
+	}
+	if ev.Type != events.ItemFinished {
+		t.Errorf("unexpected event %+v", ev)
+	}
+}